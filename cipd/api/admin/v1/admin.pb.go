@@ -10,6 +10,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	mapper "go.chromium.org/luci/appengine/mapper"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -39,6 +40,9 @@ const (
 	MapperKind_FIND_MALFORMED_TAGS MapperKind = 2
 	// Exports all tags into a BigQuery table 'exported_tags'.
 	MapperKind_EXPORT_TAGS_TO_BQ MapperKind = 3
+	// Deletes old instances according to prefix retention policies. In dry run
+	// mode only records what would have been deleted.
+	MapperKind_ENFORCE_RETENTION_POLICIES MapperKind = 4
 )
 
 var MapperKind_name = map[int32]string{
@@ -46,13 +50,15 @@ var MapperKind_name = map[int32]string{
 	1: "ENUMERATE_PACKAGES",
 	2: "FIND_MALFORMED_TAGS",
 	3: "EXPORT_TAGS_TO_BQ",
+	4: "ENFORCE_RETENTION_POLICIES",
 }
 
 var MapperKind_value = map[string]int32{
-	"MAPPER_KIND_UNSPECIFIED": 0,
-	"ENUMERATE_PACKAGES":      1,
-	"FIND_MALFORMED_TAGS":     2,
-	"EXPORT_TAGS_TO_BQ":       3,
+	"MAPPER_KIND_UNSPECIFIED":    0,
+	"ENUMERATE_PACKAGES":         1,
+	"FIND_MALFORMED_TAGS":        2,
+	"EXPORT_TAGS_TO_BQ":          3,
+	"ENFORCE_RETENTION_POLICIES": 4,
 }
 
 func (x MapperKind) String() string {
@@ -312,6 +318,109 @@ func (m *TagFixReport_Tag) GetFixedTag() string {
 	return ""
 }
 
+// Result of running ENFORCE_RETENTION_POLICIES mapper, see GetRetentionReport.
+type RetentionReport struct {
+	Instances            []*RetentionReport_Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *RetentionReport) Reset()         { *m = RetentionReport{} }
+func (m *RetentionReport) String() string { return proto.CompactTextString(m) }
+func (*RetentionReport) ProtoMessage()    {}
+func (*RetentionReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c583be32ae6c76, []int{4}
+}
+
+func (m *RetentionReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetentionReport.Unmarshal(m, b)
+}
+func (m *RetentionReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetentionReport.Marshal(b, m, deterministic)
+}
+func (m *RetentionReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionReport.Merge(m, src)
+}
+func (m *RetentionReport) XXX_Size() int {
+	return xxx_messageInfo_RetentionReport.Size(m)
+}
+func (m *RetentionReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionReport proto.InternalMessageInfo
+
+func (m *RetentionReport) GetInstances() []*RetentionReport_Instance {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+type RetentionReport_Instance struct {
+	Pkg                  string               `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Instance             string               `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	RegisteredTs         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=registered_ts,json=registeredTs,proto3" json:"registered_ts,omitempty"`
+	Deleted              bool                 `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RetentionReport_Instance) Reset()         { *m = RetentionReport_Instance{} }
+func (m *RetentionReport_Instance) String() string { return proto.CompactTextString(m) }
+func (*RetentionReport_Instance) ProtoMessage()    {}
+func (*RetentionReport_Instance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3c583be32ae6c76, []int{4, 0}
+}
+
+func (m *RetentionReport_Instance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetentionReport_Instance.Unmarshal(m, b)
+}
+func (m *RetentionReport_Instance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetentionReport_Instance.Marshal(b, m, deterministic)
+}
+func (m *RetentionReport_Instance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionReport_Instance.Merge(m, src)
+}
+func (m *RetentionReport_Instance) XXX_Size() int {
+	return xxx_messageInfo_RetentionReport_Instance.Size(m)
+}
+func (m *RetentionReport_Instance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionReport_Instance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionReport_Instance proto.InternalMessageInfo
+
+func (m *RetentionReport_Instance) GetPkg() string {
+	if m != nil {
+		return m.Pkg
+	}
+	return ""
+}
+
+func (m *RetentionReport_Instance) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+func (m *RetentionReport_Instance) GetRegisteredTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.RegisteredTs
+	}
+	return nil
+}
+
+func (m *RetentionReport_Instance) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("cipd.MapperKind", MapperKind_name, MapperKind_value)
 	proto.RegisterType((*JobConfig)(nil), "cipd.JobConfig")
@@ -319,6 +428,8 @@ func init() {
 	proto.RegisterType((*JobState)(nil), "cipd.JobState")
 	proto.RegisterType((*TagFixReport)(nil), "cipd.TagFixReport")
 	proto.RegisterType((*TagFixReport_Tag)(nil), "cipd.TagFixReport.Tag")
+	proto.RegisterType((*RetentionReport)(nil), "cipd.RetentionReport")
+	proto.RegisterType((*RetentionReport_Instance)(nil), "cipd.RetentionReport.Instance")
 }
 
 func init() {
@@ -326,43 +437,52 @@ func init() {
 }
 
 var fileDescriptor_d3c583be32ae6c76 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xdd, 0x6e, 0xd3, 0x4c,
-	0x10, 0xfd, 0x5c, 0x27, 0x69, 0x3c, 0xf9, 0x28, 0x61, 0x51, 0xdb, 0x28, 0x15, 0xa8, 0x8a, 0x90,
-	0x08, 0x15, 0xb2, 0x21, 0x20, 0xee, 0xdd, 0xc6, 0xa9, 0x92, 0xd6, 0x6d, 0xd8, 0xb8, 0x12, 0xe2,
-	0xc6, 0x5a, 0xdb, 0x1b, 0x77, 0x9b, 0x7a, 0xd7, 0x38, 0x36, 0x6a, 0x1f, 0x8a, 0x87, 0xe0, 0xcd,
-	0xd0, 0xee, 0xb6, 0xa6, 0x05, 0xee, 0x66, 0xe6, 0x9c, 0x99, 0x39, 0xf3, 0x03, 0xa3, 0x54, 0xd8,
-	0xf1, 0x65, 0x21, 0x32, 0x56, 0x65, 0xb6, 0x28, 0x52, 0xe7, 0xba, 0x8a, 0x99, 0x13, 0xb3, 0x3c,
-	0x71, 0x48, 0xce, 0x1c, 0x92, 0x64, 0x8c, 0x3b, 0xdf, 0xdf, 0x6b, 0xc3, 0xce, 0x0b, 0x51, 0x0a,
-	0xd4, 0x90, 0x70, 0x7f, 0x2f, 0x15, 0x22, 0xbd, 0xa6, 0x8e, 0x8a, 0x45, 0xd5, 0xd2, 0xa1, 0x59,
-	0x5e, 0xde, 0x6a, 0x4a, 0xff, 0xe3, 0x3f, 0xcb, 0x92, 0x3c, 0xa7, 0x3c, 0x65, 0x9c, 0x3a, 0x99,
-	0x34, 0x0b, 0x27, 0xa3, 0xeb, 0x35, 0x49, 0xe9, 0x5a, 0x67, 0x0d, 0x12, 0xb0, 0x66, 0x22, 0x3a,
-	0x12, 0x7c, 0xc9, 0x52, 0xf4, 0x0a, 0x1a, 0x2b, 0xc6, 0x93, 0x9e, 0xb1, 0x6f, 0x0c, 0xb7, 0x46,
-	0x5d, 0x5b, 0x36, 0xb5, 0x7d, 0x95, 0x77, 0xc2, 0x78, 0x82, 0x15, 0x8a, 0x7a, 0xb0, 0x19, 0x8b,
-	0x2c, 0xa3, 0xbc, 0xec, 0x6d, 0xec, 0x1b, 0x43, 0x0b, 0xdf, 0xbb, 0x68, 0x17, 0x36, 0x93, 0xe2,
-	0x36, 0x2c, 0x2a, 0xde, 0x33, 0xf7, 0x8d, 0x61, 0x1b, 0xb7, 0x92, 0xe2, 0x16, 0x57, 0x7c, 0xf0,
-	0x12, 0x9a, 0x33, 0x11, 0x4d, 0xc7, 0x68, 0x1b, 0x5a, 0x57, 0x22, 0x0a, 0x99, 0xee, 0x61, 0xe2,
-	0xe6, 0x95, 0x88, 0xa6, 0xc9, 0x60, 0x05, 0xed, 0x99, 0x88, 0x16, 0x25, 0x29, 0x29, 0x7a, 0x0d,
-	0xad, 0x58, 0xc9, 0x51, 0x94, 0xce, 0xe8, 0xa9, 0x96, 0x51, 0xab, 0xc4, 0x77, 0x30, 0xfa, 0x04,
-	0x0d, 0xc6, 0x97, 0x42, 0x89, 0xe8, 0x8c, 0x06, 0x76, 0x3d, 0xaa, 0xad, 0x47, 0xb5, 0xeb, 0x51,
-	0x65, 0x6f, 0xbe, 0x14, 0x58, 0xf1, 0x07, 0x3f, 0x0c, 0xf8, 0x3f, 0x20, 0xe9, 0x84, 0xdd, 0x60,
-	0x9a, 0x8b, 0xa2, 0x44, 0x6f, 0xa1, 0xb9, 0x64, 0x37, 0x54, 0x6a, 0x32, 0x87, 0x9d, 0xd1, 0x8e,
-	0x6e, 0xf8, 0x90, 0x22, 0x1d, 0xac, 0x49, 0x7d, 0x01, 0x66, 0x40, 0x52, 0xd4, 0x05, 0x33, 0x5f,
-	0x69, 0x8d, 0x16, 0x96, 0x26, 0xea, 0x43, 0x9b, 0xf1, 0x75, 0x49, 0x78, 0x4c, 0xef, 0x16, 0x53,
-	0xfb, 0xe8, 0x05, 0x40, 0x54, 0x88, 0x15, 0xe5, 0x61, 0x49, 0x52, 0xb5, 0x1c, 0x0b, 0x5b, 0x3a,
-	0x22, 0x8b, 0xed, 0x81, 0xa5, 0x8a, 0x2b, 0xb4, 0xa1, 0x73, 0x55, 0x20, 0x20, 0xe9, 0xc1, 0x37,
-	0x80, 0xdf, 0x37, 0x40, 0x7b, 0xb0, 0xeb, 0xbb, 0xf3, 0xb9, 0x87, 0xc3, 0x93, 0xe9, 0xd9, 0x38,
-	0xbc, 0x38, 0x5b, 0xcc, 0xbd, 0xa3, 0xe9, 0x64, 0xea, 0x8d, 0xbb, 0xff, 0xa1, 0x1d, 0x40, 0xde,
-	0xd9, 0x85, 0xef, 0x61, 0x37, 0xf0, 0xc2, 0xb9, 0x7b, 0x74, 0xe2, 0x1e, 0x7b, 0x8b, 0xae, 0x81,
-	0x76, 0xe1, 0xf9, 0x44, 0xb2, 0x7d, 0xf7, 0x74, 0x72, 0x8e, 0x7d, 0x6f, 0x1c, 0x06, 0xee, 0xf1,
-	0xa2, 0xbb, 0x81, 0xb6, 0xe1, 0x99, 0xf7, 0x65, 0x7e, 0x8e, 0x03, 0x15, 0x08, 0x83, 0xf3, 0xf0,
-	0xf0, 0x73, 0xd7, 0x1c, 0xfd, 0x34, 0xa0, 0xe9, 0xca, 0xf7, 0x43, 0x6f, 0xc0, 0x3a, 0x25, 0x15,
-	0x8f, 0x2f, 0x67, 0x22, 0x42, 0x7f, 0x9e, 0xa2, 0xdf, 0xa9, 0x03, 0xd3, 0x31, 0x72, 0xa0, 0xed,
-	0x46, 0xa2, 0x28, 0x25, 0xf3, 0x21, 0xd0, 0xdf, 0xb1, 0xf5, 0xdf, 0xda, 0xf7, 0x7f, 0x6b, 0x7b,
-	0xf2, 0x6f, 0xd1, 0x01, 0x74, 0x8e, 0x69, 0x59, 0x1f, 0xfe, 0x51, 0xce, 0x56, 0xed, 0x68, 0xf0,
-	0x1d, 0x3c, 0x99, 0xb0, 0x1b, 0x9f, 0x14, 0x2b, 0xb5, 0x94, 0xf5, 0x63, 0x36, 0xfa, 0xfb, 0x64,
-	0x87, 0xcd, 0xaf, 0x26, 0xc9, 0x59, 0xd4, 0x52, 0x4d, 0x3f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x85, 0x05, 0x29, 0x1a, 0x76, 0x03, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x5d, 0x4f, 0xdb, 0x48,
+	0x14, 0x5d, 0x93, 0x0f, 0xe2, 0x1b, 0x3e, 0xb2, 0xb3, 0x02, 0x22, 0xa3, 0x65, 0x51, 0xb4, 0xd2,
+	0x66, 0xd1, 0xca, 0xde, 0xcd, 0x56, 0x55, 0x1f, 0x2a, 0x55, 0x26, 0x71, 0x22, 0x07, 0xf2, 0xd1,
+	0x89, 0x91, 0xaa, 0xbe, 0x58, 0x76, 0x3c, 0x31, 0x43, 0xf0, 0x8c, 0x65, 0x4f, 0x2a, 0xf8, 0x19,
+	0x55, 0x7f, 0x47, 0x7f, 0x56, 0x7f, 0x45, 0x5f, 0x2a, 0x8f, 0x93, 0x00, 0x81, 0x97, 0xbe, 0xcd,
+	0xbd, 0xe7, 0x9c, 0xb9, 0xe7, 0x8e, 0xce, 0x40, 0x2b, 0xe4, 0xfa, 0xf4, 0x3a, 0xe1, 0x11, 0x5d,
+	0x44, 0x3a, 0x4f, 0x42, 0xe3, 0x76, 0x31, 0xa5, 0xc6, 0x94, 0xc6, 0x81, 0xe1, 0xc5, 0xd4, 0xf0,
+	0x82, 0x88, 0x32, 0xe3, 0xd3, 0x7f, 0xf9, 0x41, 0x8f, 0x13, 0x2e, 0x38, 0x2a, 0x66, 0xb0, 0x76,
+	0x1c, 0x72, 0x1e, 0xde, 0x12, 0x43, 0xf6, 0xfc, 0xc5, 0xcc, 0x20, 0x51, 0x2c, 0xee, 0x73, 0x8a,
+	0xf6, 0xc7, 0x26, 0x28, 0x68, 0x44, 0x52, 0xe1, 0x45, 0xf1, 0x92, 0xf0, 0xea, 0xc5, 0xb9, 0x5e,
+	0x1c, 0x13, 0x16, 0x52, 0x46, 0x8c, 0x28, 0x3b, 0x26, 0x46, 0x44, 0xd2, 0xd4, 0x0b, 0x49, 0x9a,
+	0xab, 0x1a, 0x01, 0xa8, 0x7d, 0xee, 0xb7, 0x39, 0x9b, 0xd1, 0x10, 0xfd, 0x09, 0xc5, 0x39, 0x65,
+	0x41, 0x5d, 0x39, 0x55, 0x9a, 0x7b, 0xad, 0x9a, 0x9e, 0xb9, 0xd2, 0x07, 0x52, 0x77, 0x41, 0x59,
+	0x80, 0x25, 0x8a, 0xea, 0xb0, 0x3d, 0xe5, 0x51, 0x44, 0x98, 0xa8, 0x6f, 0x9d, 0x2a, 0x4d, 0x15,
+	0xaf, 0x4a, 0x74, 0x04, 0xdb, 0x41, 0x72, 0xef, 0x26, 0x0b, 0x56, 0x2f, 0x9c, 0x2a, 0xcd, 0x0a,
+	0x2e, 0x07, 0xc9, 0x3d, 0x5e, 0xb0, 0xc6, 0x09, 0x94, 0xfa, 0xdc, 0xb7, 0x3b, 0xe8, 0x00, 0xca,
+	0x37, 0xdc, 0x77, 0x69, 0x3e, 0xa3, 0x80, 0x4b, 0x37, 0xdc, 0xb7, 0x83, 0xc6, 0x1c, 0x2a, 0x7d,
+	0xee, 0x4f, 0x84, 0x27, 0x08, 0xfa, 0x0b, 0xca, 0x53, 0x69, 0x47, 0x52, 0xaa, 0xad, 0xfd, 0xdc,
+	0xc6, 0xda, 0x25, 0x5e, 0xc2, 0xe8, 0x35, 0x14, 0x29, 0x9b, 0x71, 0x69, 0xa2, 0xda, 0x6a, 0xe8,
+	0xeb, 0x55, 0xf5, 0x7c, 0x55, 0x7d, 0xbd, 0x6a, 0x36, 0x9b, 0xcd, 0x38, 0x96, 0xfc, 0xc6, 0x57,
+	0x05, 0x76, 0x1c, 0x2f, 0xec, 0xd2, 0x3b, 0x4c, 0x62, 0x9e, 0x08, 0xf4, 0x0f, 0x94, 0x66, 0xf4,
+	0x8e, 0x64, 0x9e, 0x0a, 0xcd, 0x6a, 0xeb, 0x30, 0x1f, 0xf8, 0x98, 0x92, 0x15, 0x38, 0x27, 0x69,
+	0x1c, 0x0a, 0x8e, 0x17, 0xa2, 0x1a, 0x14, 0xe2, 0x79, 0xee, 0x51, 0xc5, 0xd9, 0x11, 0x69, 0x50,
+	0xa1, 0x2c, 0x15, 0x1e, 0x9b, 0x92, 0xe5, 0xc3, 0xac, 0x6b, 0xf4, 0x3b, 0x80, 0x9f, 0xf0, 0x39,
+	0x61, 0xae, 0xf0, 0x42, 0xf9, 0x38, 0x2a, 0x56, 0xf3, 0x4e, 0x76, 0xd9, 0x31, 0xa8, 0xf2, 0x72,
+	0x89, 0x16, 0x73, 0xad, 0x6c, 0x38, 0x5e, 0xd8, 0xf8, 0xa6, 0xc0, 0x3e, 0x26, 0x82, 0x30, 0x41,
+	0x39, 0x5b, 0x5a, 0x7e, 0x0b, 0xea, 0xea, 0xee, 0x74, 0x69, 0xfb, 0x24, 0xb7, 0xbd, 0xc1, 0xd4,
+	0xed, 0x25, 0x0d, 0x3f, 0x08, 0xb4, 0x2f, 0x0a, 0x54, 0x56, 0xfd, 0x9f, 0x5c, 0xe4, 0x1d, 0xec,
+	0x26, 0x24, 0xa4, 0xa9, 0x20, 0x49, 0x66, 0x37, 0x95, 0xbb, 0x54, 0x5b, 0x9a, 0x9e, 0xc7, 0x53,
+	0x5f, 0xc5, 0x53, 0x77, 0x56, 0xf1, 0xc4, 0x3b, 0x0f, 0x02, 0x27, 0xcd, 0xd2, 0x13, 0x90, 0x5b,
+	0x22, 0x48, 0x20, 0x17, 0xad, 0xe0, 0x55, 0x79, 0xf6, 0x59, 0x01, 0x78, 0x08, 0x1b, 0x3a, 0x86,
+	0xa3, 0x81, 0x39, 0x1e, 0x5b, 0xd8, 0xbd, 0xb0, 0x87, 0x1d, 0xf7, 0x6a, 0x38, 0x19, 0x5b, 0x6d,
+	0xbb, 0x6b, 0x5b, 0x9d, 0xda, 0x2f, 0xe8, 0x10, 0x90, 0x35, 0xbc, 0x1a, 0x58, 0xd8, 0x74, 0x2c,
+	0x77, 0x6c, 0xb6, 0x2f, 0xcc, 0x9e, 0x35, 0xa9, 0x29, 0xe8, 0x08, 0x7e, 0xeb, 0x66, 0xec, 0x81,
+	0x79, 0xd9, 0x1d, 0xe1, 0x81, 0xd5, 0x71, 0x1d, 0xb3, 0x37, 0xa9, 0x6d, 0xa1, 0x03, 0xf8, 0xd5,
+	0xfa, 0x30, 0x1e, 0x61, 0x47, 0x36, 0x5c, 0x67, 0xe4, 0x9e, 0xbf, 0xaf, 0x15, 0xd0, 0x09, 0x68,
+	0xd6, 0xb0, 0x3b, 0xc2, 0x6d, 0xcb, 0xc5, 0x96, 0x63, 0x0d, 0x1d, 0x7b, 0x34, 0x74, 0xc7, 0xa3,
+	0x4b, 0xbb, 0x6d, 0x5b, 0x93, 0x5a, 0xb1, 0xf5, 0x5d, 0x81, 0x92, 0x99, 0x7d, 0x54, 0xf4, 0x37,
+	0xa8, 0x97, 0xde, 0x82, 0x4d, 0xaf, 0xfb, 0xdc, 0x47, 0x9b, 0x99, 0xd4, 0xaa, 0xeb, 0x86, 0xdd,
+	0x41, 0x06, 0x54, 0x4c, 0x9f, 0x27, 0x22, 0x63, 0x3e, 0x06, 0xb4, 0xc3, 0x67, 0xaf, 0x64, 0x65,
+	0x3f, 0x1c, 0x9d, 0x41, 0xb5, 0x47, 0xc4, 0xfa, 0x07, 0x3c, 0xd1, 0xec, 0xad, 0x8b, 0x1c, 0xfc,
+	0x17, 0x76, 0xbb, 0xf4, 0x6e, 0xe0, 0x25, 0x73, 0x99, 0x8e, 0xf4, 0x29, 0x1b, 0x3d, 0xcf, 0x2e,
+	0x7a, 0x03, 0xa8, 0x47, 0xc4, 0x66, 0x82, 0x9e, 0xc8, 0x0e, 0x5e, 0xcc, 0xce, 0x79, 0xe9, 0x63,
+	0xc1, 0x8b, 0xa9, 0x5f, 0x96, 0x76, 0xff, 0xff, 0x31, 0x00, 0xa7, 0xb3, 0x6a, 0xc1, 0xda, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -385,6 +505,9 @@ type AdminClient interface {
 	GetJobState(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*JobState, error)
 	// Fixes (right inside the handler) tags marked by the given mapper job.
 	FixMarkedTags(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*TagFixReport, error)
+	// Returns instances deleted (or, for dry runs, to be deleted) by the given
+	// ENFORCE_RETENTION_POLICIES mapper job.
+	GetRetentionReport(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*RetentionReport, error)
 }
 type adminPRPCClient struct {
 	client *prpc.Client
@@ -430,6 +553,15 @@ func (c *adminPRPCClient) FixMarkedTags(ctx context.Context, in *JobID, opts ...
 	return out, nil
 }
 
+func (c *adminPRPCClient) GetRetentionReport(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*RetentionReport, error) {
+	out := new(RetentionReport)
+	err := c.client.Call(ctx, "cipd.Admin", "GetRetentionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type adminClient struct {
 	cc *grpc.ClientConn
 }
@@ -474,6 +606,15 @@ func (c *adminClient) FixMarkedTags(ctx context.Context, in *JobID, opts ...grpc
 	return out, nil
 }
 
+func (c *adminClient) GetRetentionReport(ctx context.Context, in *JobID, opts ...grpc.CallOption) (*RetentionReport, error) {
+	out := new(RetentionReport)
+	err := c.cc.Invoke(ctx, "/cipd.Admin/GetRetentionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	// Launches a mapping job that examines and/or fixes datastore entities.
@@ -484,6 +625,9 @@ type AdminServer interface {
 	GetJobState(context.Context, *JobID) (*JobState, error)
 	// Fixes (right inside the handler) tags marked by the given mapper job.
 	FixMarkedTags(context.Context, *JobID) (*TagFixReport, error)
+	// Returns instances deleted (or, for dry runs, to be deleted) by the given
+	// ENFORCE_RETENTION_POLICIES mapper job.
+	GetRetentionReport(context.Context, *JobID) (*RetentionReport, error)
 }
 
 // UnimplementedAdminServer can be embedded to have forward compatible implementations.
@@ -502,6 +646,9 @@ func (*UnimplementedAdminServer) GetJobState(ctx context.Context, req *JobID) (*
 func (*UnimplementedAdminServer) FixMarkedTags(ctx context.Context, req *JobID) (*TagFixReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FixMarkedTags not implemented")
 }
+func (*UnimplementedAdminServer) GetRetentionReport(ctx context.Context, req *JobID) (*RetentionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionReport not implemented")
+}
 
 func RegisterAdminServer(s prpc.Registrar, srv AdminServer) {
 	s.RegisterService(&_Admin_serviceDesc, srv)
@@ -579,6 +726,24 @@ func _Admin_FixMarkedTags_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetRetentionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetRetentionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Admin/GetRetentionReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetRetentionReport(ctx, req.(*JobID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cipd.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -599,6 +764,10 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FixMarkedTags",
 			Handler:    _Admin_FixMarkedTags_Handler,
 		},
+		{
+			MethodName: "GetRetentionReport",
+			Handler:    _Admin_GetRetentionReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/cipd/api/admin/v1/admin.proto",
@@ -21,64 +21,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-type EventKind int32
-
-const (
-	EventKind_EVENT_KIND_UNSPECIFIED EventKind = 0
-	// Prefix events: relate to some CIPD prefix.
-	EventKind_PREFIX_ACL_CHANGED EventKind = 100
-	// Package events: relate to a package (as a whole).
-	EventKind_PACKAGE_CREATED  EventKind = 200
-	EventKind_PACKAGE_DELETED  EventKind = 201
-	EventKind_PACKAGE_HIDDEN   EventKind = 202
-	EventKind_PACKAGE_UNHIDDEN EventKind = 203
-	// Instance events: relate to a particular package instance.
-	EventKind_INSTANCE_CREATED      EventKind = 300
-	EventKind_INSTANCE_DELETED      EventKind = 301
-	EventKind_INSTANCE_REF_SET      EventKind = 302
-	EventKind_INSTANCE_REF_UNSET    EventKind = 303
-	EventKind_INSTANCE_TAG_ATTACHED EventKind = 304
-	EventKind_INSTANCE_TAG_DETACHED EventKind = 305
-)
-
-var EventKind_name = map[int32]string{
-	0:   "EVENT_KIND_UNSPECIFIED",
-	100: "PREFIX_ACL_CHANGED",
-	200: "PACKAGE_CREATED",
-	201: "PACKAGE_DELETED",
-	202: "PACKAGE_HIDDEN",
-	203: "PACKAGE_UNHIDDEN",
-	300: "INSTANCE_CREATED",
-	301: "INSTANCE_DELETED",
-	302: "INSTANCE_REF_SET",
-	303: "INSTANCE_REF_UNSET",
-	304: "INSTANCE_TAG_ATTACHED",
-	305: "INSTANCE_TAG_DETACHED",
-}
-
-var EventKind_value = map[string]int32{
-	"EVENT_KIND_UNSPECIFIED": 0,
-	"PREFIX_ACL_CHANGED":     100,
-	"PACKAGE_CREATED":        200,
-	"PACKAGE_DELETED":        201,
-	"PACKAGE_HIDDEN":         202,
-	"PACKAGE_UNHIDDEN":       203,
-	"INSTANCE_CREATED":       300,
-	"INSTANCE_DELETED":       301,
-	"INSTANCE_REF_SET":       302,
-	"INSTANCE_REF_UNSET":     303,
-	"INSTANCE_TAG_ATTACHED":  304,
-	"INSTANCE_TAG_DETACHED":  305,
-}
-
-func (x EventKind) String() string {
-	return proto.EnumName(EventKind_name, int32(x))
-}
-
-func (EventKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e76d2755b4d4b01e, []int{0}
-}
-
 // Event in a global structured event log.
 //
 // It exists in both BigQuery (for adhoc queries) and in Datastore (for showing
@@ -97,11 +39,16 @@ type Event struct {
 	Ref      string               `protobuf:"bytes,6,opt,name=ref,proto3" json:"ref,omitempty"`
 	Tag      string               `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
 	// An ACL diff for PREFIX_ACL_CHANGED.
-	GrantedRole          []*PrefixMetadata_ACL `protobuf:"bytes,8,rep,name=granted_role,json=grantedRole,proto3" json:"granted_role,omitempty"`
-	RevokedRole          []*PrefixMetadata_ACL `protobuf:"bytes,9,rep,name=revoked_role,json=revokedRole,proto3" json:"revoked_role,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	GrantedRole []*PrefixMetadata_ACL `protobuf:"bytes,8,rep,name=granted_role,json=grantedRole,proto3" json:"granted_role,omitempty"`
+	RevokedRole []*PrefixMetadata_ACL `protobuf:"bytes,9,rep,name=revoked_role,json=revokedRole,proto3" json:"revoked_role,omitempty"`
+	// Metadata entry details for INSTANCE_METADATA_*. The value itself is not
+	// logged, since it can be large.
+	MdKey                string   `protobuf:"bytes,10,opt,name=md_key,json=mdKey,proto3" json:"md_key,omitempty"`
+	MdContentType        string   `protobuf:"bytes,11,opt,name=md_content_type,json=mdContentType,proto3" json:"md_content_type,omitempty"`
+	MdFingerprint        string   `protobuf:"bytes,12,opt,name=md_fingerprint,json=mdFingerprint,proto3" json:"md_fingerprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Event) Reset()         { *m = Event{} }
@@ -192,8 +139,28 @@ func (m *Event) GetRevokedRole() []*PrefixMetadata_ACL {
 	return nil
 }
 
+func (m *Event) GetMdKey() string {
+	if m != nil {
+		return m.MdKey
+	}
+	return ""
+}
+
+func (m *Event) GetMdContentType() string {
+	if m != nil {
+		return m.MdContentType
+	}
+	return ""
+}
+
+func (m *Event) GetMdFingerprint() string {
+	if m != nil {
+		return m.MdFingerprint
+	}
+	return ""
+}
+
 func init() {
-	proto.RegisterEnum("cipd.EventKind", EventKind_name, EventKind_value)
 	proto.RegisterType((*Event)(nil), "cipd.Event")
 }
 
@@ -202,36 +169,29 @@ func init() {
 }
 
 var fileDescriptor_e76d2755b4d4b01e = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x6d, 0x81, 0xdd, 0x65, 0x30, 0xbb, 0xcd, 0xe8, 0xae, 0x4d, 0x2f, 0x12, 0xbd, 0x10,
-	0x0f, 0x53, 0xc5, 0xa3, 0xa7, 0xb1, 0x1d, 0xa0, 0x01, 0x1b, 0x52, 0x8a, 0x31, 0x5e, 0x9a, 0x59,
-	0x3a, 0x94, 0x06, 0xe8, 0x34, 0xc3, 0xc0, 0xfa, 0xa1, 0x5c, 0xff, 0x7c, 0x05, 0x4f, 0xfe, 0xf9,
-	0x52, 0x66, 0x5a, 0x8a, 0x12, 0x0f, 0xee, 0xed, 0x7d, 0x7f, 0xcf, 0xfb, 0x3c, 0x4f, 0xda, 0x0c,
-	0xe8, 0x26, 0x1c, 0xcd, 0x16, 0x82, 0xaf, 0xd3, 0xed, 0x1a, 0x71, 0x91, 0xd8, 0xab, 0xed, 0x2c,
-	0xb5, 0x67, 0x69, 0x1e, 0xdb, 0x34, 0xdf, 0x0f, 0xbb, 0x17, 0x36, 0xdb, 0xb1, 0x4c, 0x6e, 0x50,
-	0x2e, 0xb8, 0xe4, 0xb0, 0xae, 0xa8, 0xf5, 0xfc, 0x6e, 0x4e, 0xc1, 0x72, 0x5e, 0xfa, 0xac, 0xc7,
-	0x09, 0xe7, 0xc9, 0x8a, 0xd9, 0xc5, 0x76, 0xbd, 0x9d, 0xdb, 0x32, 0x5d, 0xb3, 0x8d, 0xa4, 0xeb,
-	0xbc, 0x3c, 0x78, 0xf2, 0x4d, 0x07, 0x0d, 0xa2, 0x9a, 0xe0, 0x53, 0x50, 0x5f, 0xa6, 0x59, 0x6c,
-	0x6a, 0x6d, 0xad, 0x73, 0xde, 0xbd, 0x40, 0x2a, 0x0d, 0x15, 0xd2, 0x30, 0xcd, 0xe2, 0xa0, 0x10,
-	0xa1, 0x01, 0x6a, 0x37, 0x0b, 0x6e, 0xea, 0x6d, 0xad, 0xd3, 0x0c, 0xd4, 0x08, 0x11, 0xa8, 0xdf,
-	0x2c, 0x58, 0x66, 0xd6, 0xda, 0x5a, 0xa7, 0xd5, 0xb5, 0x50, 0x59, 0x88, 0xaa, 0x42, 0x14, 0x56,
-	0x85, 0x41, 0x71, 0x07, 0x4d, 0x70, 0x9a, 0xd3, 0xd9, 0x92, 0x26, 0xcc, 0xac, 0x17, 0x29, 0xd5,
-	0x0a, 0x2d, 0x70, 0x96, 0x66, 0x1b, 0x49, 0xb3, 0x19, 0x33, 0x1b, 0x85, 0x74, 0xd8, 0x55, 0xaf,
-	0x60, 0x73, 0xf3, 0xa4, 0xec, 0x15, 0x6c, 0xae, 0x88, 0xa4, 0x89, 0x79, 0x5a, 0x12, 0x49, 0x13,
-	0xf8, 0x0a, 0xdc, 0x4f, 0x04, 0xcd, 0x24, 0x8b, 0x23, 0xc1, 0x57, 0xcc, 0x3c, 0x6b, 0xd7, 0x3a,
-	0xad, 0xae, 0x59, 0x7e, 0xc8, 0x58, 0xb0, 0x79, 0xfa, 0xe1, 0x0d, 0x93, 0x34, 0xa6, 0x92, 0x22,
-	0xec, 0x8c, 0x82, 0xd6, 0xfe, 0x3a, 0xe0, 0x2b, 0xa6, 0xcc, 0x82, 0xed, 0xf8, 0xb2, 0x32, 0x37,
-	0xff, 0x67, 0xde, 0x5f, 0x2b, 0xf3, 0xb3, 0x5b, 0x1d, 0x34, 0x0f, 0x7f, 0x0a, 0x5a, 0xe0, 0x8a,
-	0xbc, 0x25, 0x7e, 0x18, 0x0d, 0x3d, 0xdf, 0x8d, 0xa6, 0xfe, 0x64, 0x4c, 0x1c, 0xaf, 0xe7, 0x11,
-	0xd7, 0xb8, 0x07, 0xaf, 0x00, 0x1c, 0x07, 0xa4, 0xe7, 0xbd, 0x8b, 0xb0, 0x33, 0x8a, 0x9c, 0x01,
-	0xf6, 0xfb, 0xc4, 0x35, 0x62, 0xf8, 0x10, 0x5c, 0x8c, 0xb1, 0x33, 0xc4, 0x7d, 0x12, 0x39, 0x01,
-	0xc1, 0x21, 0x71, 0x8d, 0xef, 0xda, 0xdf, 0xd4, 0x25, 0x23, 0xa2, 0xe8, 0x0f, 0x0d, 0x3e, 0x00,
-	0xe7, 0x15, 0x1d, 0x78, 0xae, 0x4b, 0x7c, 0xe3, 0xa7, 0x06, 0x2f, 0x81, 0x51, 0xc1, 0xa9, 0xbf,
-	0xc7, 0xbf, 0x0a, 0xec, 0xf9, 0x93, 0x10, 0xfb, 0xce, 0x9f, 0xe0, 0x8f, 0xfa, 0x11, 0xae, 0x92,
-	0x6f, 0x8f, 0x71, 0x40, 0x7a, 0xd1, 0x84, 0x84, 0xc6, 0x27, 0x1d, 0x3e, 0x02, 0xf0, 0x08, 0x4f,
-	0x7d, 0x25, 0x7c, 0xd6, 0xa1, 0x05, 0x2e, 0x0f, 0x42, 0x88, 0xfb, 0x11, 0x0e, 0x43, 0xec, 0x0c,
-	0x88, 0x6b, 0x7c, 0xf9, 0x57, 0x73, 0xc9, 0x5e, 0xfb, 0xaa, 0xbf, 0x6e, 0xbc, 0xaf, 0xd1, 0x3c,
-	0xbd, 0x3e, 0x29, 0x1e, 0xc9, 0xcb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x79, 0x1b, 0x6e,
-	0x11, 0x03, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x4f, 0x6f, 0xd4, 0x30,
+	0x14, 0xc4, 0xb5, 0xcd, 0xee, 0xb6, 0x75, 0x4a, 0x8b, 0x2c, 0x21, 0x59, 0xb9, 0x10, 0x81, 0x40,
+	0x39, 0x39, 0x22, 0x48, 0x5c, 0x7a, 0x82, 0x0a, 0x2e, 0x05, 0xa9, 0x8a, 0x7a, 0xe2, 0x12, 0xb9,
+	0xf1, 0x8b, 0xd7, 0x4a, 0xfc, 0x47, 0x5e, 0xef, 0x2e, 0xf9, 0x46, 0x7c, 0x4c, 0x64, 0x27, 0x81,
+	0x23, 0x70, 0xb3, 0x7f, 0x6f, 0xc6, 0x7e, 0x33, 0xa8, 0x12, 0x86, 0xb6, 0x3b, 0x67, 0x94, 0x3c,
+	0x28, 0x6a, 0x9c, 0x28, 0x87, 0x43, 0x2b, 0xcb, 0x56, 0x5a, 0x5e, 0x32, 0x3b, 0x1f, 0x8e, 0xef,
+	0x4a, 0x38, 0x82, 0xf6, 0x7b, 0x6a, 0x9d, 0xf1, 0x06, 0xaf, 0x03, 0xcd, 0x5e, 0x0a, 0x63, 0xc4,
+	0x00, 0x65, 0x64, 0x4f, 0x87, 0xae, 0xf4, 0x52, 0xc1, 0xde, 0x33, 0x65, 0x27, 0x59, 0x76, 0xfb,
+	0x6f, 0x4f, 0x5b, 0x07, 0x9d, 0xfc, 0xd1, 0x28, 0xf0, 0x8c, 0x33, 0xcf, 0x66, 0xf3, 0x87, 0xff,
+	0xd8, 0xab, 0xe9, 0xa5, 0xe6, 0x93, 0xef, 0xd5, 0xcf, 0x04, 0x6d, 0x3e, 0x07, 0x88, 0x5f, 0xa3,
+	0x75, 0xe0, 0x64, 0x95, 0xaf, 0x8a, 0xeb, 0xea, 0x86, 0x06, 0x0b, 0x8d, 0xa3, 0x7b, 0xa9, 0x79,
+	0x1d, 0x87, 0xf8, 0x39, 0x4a, 0x4e, 0x3b, 0x43, 0xce, 0xf2, 0x55, 0x71, 0x59, 0x87, 0x23, 0xa6,
+	0x68, 0x7d, 0xda, 0x81, 0x26, 0x49, 0xbe, 0x2a, 0xd2, 0x2a, 0xa3, 0x53, 0x4a, 0xba, 0xa4, 0xa4,
+	0x8f, 0x4b, 0xca, 0x3a, 0xea, 0x30, 0x41, 0xe7, 0x96, 0xb5, 0x3d, 0x13, 0x40, 0xd6, 0xf1, 0x95,
+	0xe5, 0x8a, 0x33, 0x74, 0x21, 0xf5, 0xde, 0x33, 0xdd, 0x02, 0xd9, 0xc4, 0xd1, 0xef, 0x7b, 0xf8,
+	0xd7, 0x41, 0x47, 0xb6, 0xd3, 0xbf, 0x0e, 0xba, 0x40, 0x3c, 0x13, 0xe4, 0x7c, 0x22, 0x9e, 0x09,
+	0x7c, 0x8b, 0xae, 0x84, 0x63, 0xda, 0x03, 0x6f, 0x9c, 0x19, 0x80, 0x5c, 0xe4, 0x49, 0x91, 0x56,
+	0x64, 0x0a, 0xf2, 0x10, 0x5b, 0xfb, 0xb6, 0x94, 0xf6, 0xf1, 0xee, 0x6b, 0x9d, 0xce, 0xea, 0xda,
+	0x0c, 0x10, 0xcc, 0x0e, 0x8e, 0xa6, 0x5f, 0xcc, 0x97, 0x7f, 0x33, 0xcf, 0xea, 0x68, 0x7e, 0x81,
+	0xb6, 0x8a, 0x37, 0x3d, 0x8c, 0x04, 0xc5, 0x75, 0x36, 0x8a, 0xdf, 0xc3, 0x88, 0xdf, 0xa2, 0x1b,
+	0xc5, 0x9b, 0xd6, 0x68, 0x1f, 0x4a, 0xf7, 0xa3, 0x05, 0x92, 0xc6, 0xf9, 0x33, 0xc5, 0xef, 0x26,
+	0xfa, 0x38, 0x5a, 0xc0, 0x6f, 0xd0, 0xb5, 0xe2, 0x4d, 0x27, 0xb5, 0x00, 0x67, 0x9d, 0xd4, 0x9e,
+	0x5c, 0x2d, 0xb2, 0x2f, 0x7f, 0xe0, 0xa7, 0xcd, 0xf7, 0x84, 0x59, 0xf9, 0x70, 0xf6, 0xb4, 0x8d,
+	0xe5, 0xbe, 0xff, 0x35, 0x00, 0xef, 0x27, 0x1d, 0xb3, 0x8c, 0x02, 0x00, 0x00,
 }
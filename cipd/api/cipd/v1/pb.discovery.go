@@ -12,1686 +12,1675 @@ func init() {
 			"cipd.Storage", "cipd.Repository",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 13, 120, 36, 87,
-			117, 32, 170, 250, 233, 30, 233, 74, 163, 209, 148, 70, 63, 211,
-			227, 153, 185, 110, 99, 70, 26, 247, 180, 230, 199, 54, 120, 12,
-			198, 45, 169, 103, 166, 109, 141, 36, 186, 91, 118, 108, 175, 145,
-			75, 221, 183, 213, 149, 169, 174, 106, 170, 170, 165, 105, 251, 57,
-			121, 172, 49, 73, 8, 33, 56, 235, 15, 19, 66, 62, 63, 214,
-			129, 221, 176, 14, 75, 8, 44, 129, 56, 132, 56, 196, 235, 133,
-			108, 66, 76, 252, 242, 67, 248, 249, 242, 150, 60, 18, 242, 96,
-			89, 194, 11, 251, 32, 235, 247, 157, 115, 239, 173, 170, 254, 209,
-			140, 237, 216, 73, 222, 91, 207, 71, 98, 157, 234, 170, 123, 207,
-			61, 231, 220, 115, 206, 189, 247, 220, 115, 200, 207, 158, 39, 115,
-			155, 110, 182, 82, 247, 220, 134, 213, 106, 100, 93, 111, 115, 206,
-			110, 85, 172, 185, 138, 213, 172, 206, 153, 77, 241, 199, 214, 137,
-			185, 138, 233, 103, 155, 158, 27, 184, 134, 14, 143, 210, 183, 147,
-			161, 149, 141, 31, 101, 149, 160, 200, 106, 198, 53, 100, 168, 110,
-			250, 245, 117, 211, 222, 116, 167, 21, 170, 204, 140, 158, 28, 205,
-			194, 107, 217, 115, 166, 95, 207, 217, 155, 110, 113, 176, 46, 254,
-			50, 14, 18, 82, 103, 23, 215, 171, 214, 38, 243, 131, 105, 149,
-			42, 51, 67, 197, 161, 58, 187, 184, 136, 15, 210, 23, 200, 248,
-			89, 22, 240, 182, 215, 138, 75, 69, 246, 230, 22, 243, 3, 227,
-			8, 73, 186, 248, 12, 219, 31, 62, 185, 135, 183, 31, 226, 80,
-			20, 63, 27, 215, 144, 189, 85, 119, 219, 177, 93, 179, 186, 94,
-			179, 108, 230, 152, 13, 38, 122, 25, 147, 63, 156, 17, 207, 211,
-			71, 229, 40, 214, 138, 75, 128, 152, 111, 109, 58, 172, 186, 222,
-			242, 108, 236, 102, 168, 56, 196, 159, 172, 121, 118, 250, 71, 137,
-			49, 207, 54, 45, 103, 173, 9, 77, 188, 8, 188, 98, 52, 82,
-			47, 77, 163, 116, 139, 140, 159, 177, 28, 203, 175, 119, 118, 150,
-			37, 227, 45, 124, 176, 238, 54, 153, 103, 6, 150, 235, 172, 91,
-			85, 129, 234, 94, 254, 211, 138, 252, 165, 80, 53, 178, 132, 212,
-			92, 175, 194, 214, 161, 97, 236, 180, 15, 130, 67, 248, 10, 32,
-			145, 206, 147, 241, 5, 211, 169, 48, 251, 31, 212, 109, 250, 63,
-			43, 100, 207, 90, 231, 83, 227, 74, 50, 210, 231, 227, 97, 55,
-			134, 237, 65, 66, 68, 55, 64, 127, 33, 24, 252, 201, 154, 103,
-			27, 71, 73, 210, 15, 204, 160, 229, 79, 107, 72, 61, 131, 15,
-			132, 119, 84, 194, 95, 138, 226, 141, 24, 87, 244, 75, 115, 229,
-			42, 178, 155, 121, 158, 235, 173, 55, 152, 239, 155, 155, 108, 58,
-			129, 221, 142, 224, 195, 243, 252, 217, 209, 27, 201, 160, 228, 145,
-			177, 159, 76, 156, 203, 149, 206, 173, 231, 150, 206, 174, 172, 175,
-			45, 151, 86, 243, 11, 133, 51, 133, 252, 226, 216, 128, 49, 72,
-			244, 210, 185, 220, 137, 49, 197, 32, 36, 89, 58, 151, 59, 121,
-			221, 245, 99, 234, 209, 22, 25, 137, 163, 104, 28, 36, 251, 215,
-			86, 151, 86, 114, 139, 235, 165, 114, 174, 188, 86, 234, 106, 100,
-			55, 25, 226, 63, 23, 150, 207, 142, 41, 0, 222, 150, 47, 22,
-			206, 220, 1, 160, 10, 224, 234, 218, 252, 82, 161, 116, 46, 191,
-			56, 166, 25, 195, 100, 87, 190, 88, 92, 41, 230, 23, 199, 116,
-			99, 132, 12, 46, 228, 150, 23, 242, 75, 249, 197, 177, 196, 201,
-			159, 82, 201, 174, 82, 224, 122, 230, 38, 51, 78, 147, 145, 248,
-			148, 50, 246, 115, 106, 244, 153, 102, 169, 14, 66, 193, 187, 55,
-			145, 225, 152, 212, 27, 211, 252, 247, 222, 137, 144, 154, 136, 179,
-			35, 226, 251, 205, 100, 36, 46, 201, 178, 239, 62, 210, 125, 137,
-			22, 226, 66, 41, 91, 232, 35, 168, 59, 180, 48, 159, 184, 83,
-			51, 155, 214, 45, 239, 93, 32, 187, 140, 196, 232, 192, 87, 21,
-			133, 124, 86, 39, 202, 136, 161, 141, 14, 24, 39, 63, 169, 211,
-			5, 183, 217, 246, 172, 205, 122, 64, 79, 30, 63, 241, 26, 90,
-			174, 51, 186, 180, 182, 80, 160, 185, 86, 80, 119, 61, 63, 75,
-			8, 93, 178, 42, 204, 241, 89, 149, 182, 156, 42, 243, 104, 80,
-			103, 52, 215, 52, 43, 240, 38, 255, 37, 67, 111, 99, 158, 111,
-			185, 14, 61, 153, 61, 78, 103, 224, 133, 180, 248, 41, 61, 123,
-			35, 161, 109, 183, 69, 27, 102, 155, 58, 110, 64, 91, 62, 163,
-			65, 221, 242, 41, 40, 40, 202, 46, 86, 88, 51, 160, 150, 67,
-			43, 110, 163, 105, 91, 48, 48, 186, 109, 5, 117, 236, 69, 180,
-			145, 37, 244, 14, 209, 130, 187, 17, 152, 150, 67, 77, 90, 113,
-			155, 109, 234, 214, 226, 175, 81, 51, 32, 132, 226, 191, 122, 16,
-			52, 79, 207, 205, 109, 111, 111, 103, 77, 196, 148, 107, 119, 254,
-			158, 63, 183, 84, 88, 200, 47, 151, 242, 199, 78, 102, 143, 19,
-			66, 215, 28, 155, 249, 62, 245, 216, 155, 91, 150, 199, 170, 116,
-			163, 77, 205, 102, 211, 182, 42, 230, 134, 205, 168, 109, 110, 83,
-			215, 163, 230, 166, 199, 88, 149, 6, 46, 224, 186, 237, 89, 129,
-			229, 108, 102, 168, 239, 214, 130, 109, 211, 99, 132, 86, 45, 63,
-			240, 172, 141, 86, 208, 65, 38, 137, 153, 229, 119, 188, 224, 58,
-			212, 116, 104, 58, 87, 162, 133, 82, 154, 206, 231, 74, 133, 82,
-			134, 208, 219, 11, 229, 115, 43, 107, 101, 122, 123, 174, 88, 204,
-			45, 151, 11, 249, 18, 93, 41, 210, 133, 149, 229, 197, 66, 185,
-			176, 178, 92, 162, 43, 103, 104, 110, 249, 14, 122, 107, 97, 121,
-			49, 67, 153, 21, 212, 153, 71, 217, 197, 166, 7, 216, 187, 30,
-			181, 128, 128, 172, 154, 37, 180, 196, 88, 71, 247, 53, 151, 163,
-			227, 55, 89, 197, 170, 89, 21, 106, 155, 206, 102, 203, 220, 100,
-			116, 211, 221, 98, 158, 99, 57, 155, 180, 201, 188, 134, 229, 3,
-			19, 125, 106, 58, 85, 66, 109, 171, 97, 5, 40, 68, 126, 239,
-			136, 178, 132, 12, 18, 69, 53, 180, 177, 193, 17, 248, 107, 208,
-			208, 140, 129, 20, 25, 34, 234, 224, 48, 255, 243, 191, 107, 68,
-			77, 14, 24, 250, 225, 129, 59, 149, 212, 223, 104, 52, 127, 177,
-			233, 250, 204, 167, 21, 215, 9, 152, 19, 28, 51, 171, 85, 64,
-			28, 105, 236, 243, 201, 74, 131, 186, 25, 32, 192, 124, 236, 172,
-			105, 86, 46, 192, 115, 16, 21, 148, 196, 156, 109, 211, 133, 194,
-			234, 98, 248, 139, 229, 248, 1, 200, 140, 79, 103, 204, 74, 208,
-			50, 109, 218, 172, 183, 125, 171, 98, 218, 252, 163, 89, 106, 122,
-			140, 122, 204, 183, 170, 48, 72, 203, 65, 217, 35, 178, 199, 12,
-			12, 181, 163, 43, 143, 53, 93, 223, 10, 92, 175, 77, 171, 102,
-			96, 82, 63, 240, 90, 149, 160, 5, 24, 253, 104, 203, 15, 168,
-			199, 106, 204, 99, 32, 165, 65, 157, 53, 232, 70, 155, 192, 31,
-			150, 71, 185, 77, 7, 36, 207, 187, 126, 32, 69, 83, 168, 33,
-			234, 51, 111, 203, 170, 48, 154, 91, 45, 128, 48, 180, 96, 54,
-			89, 78, 192, 60, 199, 180, 237, 54, 136, 28, 188, 189, 97, 86,
-			46, 48, 167, 202, 233, 95, 113, 61, 143, 249, 77, 215, 65, 204,
-			139, 171, 11, 180, 193, 130, 186, 91, 245, 113, 72, 185, 133, 37,
-			20, 36, 187, 13, 50, 105, 86, 27, 150, 227, 103, 248, 204, 225,
-			115, 10, 102, 163, 91, 35, 52, 174, 109, 56, 129, 45, 159, 154,
-			182, 237, 110, 115, 113, 222, 96, 28, 155, 170, 229, 177, 74, 192,
-			113, 169, 216, 22, 115, 2, 31, 126, 174, 89, 142, 105, 91, 247,
-			50, 66, 185, 53, 162, 161, 213, 242, 169, 31, 152, 94, 192, 39,
-			76, 12, 251, 44, 33, 132, 104, 201, 1, 197, 208, 14, 15, 238,
-			33, 111, 85, 136, 158, 28, 80, 7, 12, 237, 42, 245, 117, 169,
-			109, 186, 234, 185, 213, 22, 48, 204, 164, 220, 191, 160, 107, 197,
-			37, 142, 88, 197, 116, 66, 116, 160, 107, 22, 84, 234, 48, 81,
-			184, 189, 2, 210, 22, 89, 208, 242, 28, 159, 46, 175, 148, 215,
-			207, 172, 172, 45, 47, 82, 110, 244, 104, 197, 173, 50, 106, 33,
-			205, 61, 118, 196, 167, 142, 75, 253, 86, 165, 30, 125, 58, 66,
-			18, 128, 133, 98, 104, 87, 37, 13, 9, 169, 134, 118, 213, 248,
-			171, 37, 164, 25, 218, 85, 39, 78, 147, 207, 12, 34, 194, 138,
-			161, 157, 86, 111, 78, 125, 116, 144, 22, 28, 43, 176, 204, 0,
-			80, 118, 122, 168, 0, 88, 173, 8, 113, 144, 63, 90, 62, 181,
-			196, 55, 92, 184, 56, 61, 225, 57, 231, 168, 111, 129, 212, 195,
-			172, 228, 95, 0, 131, 131, 58, 35, 2, 93, 24, 60, 124, 22,
-			176, 70, 211, 245, 76, 175, 77, 109, 183, 130, 189, 209, 153, 166,
-			231, 110, 89, 85, 86, 165, 91, 150, 73, 143, 68, 62, 195, 17,
-			186, 93, 183, 42, 117, 234, 215, 221, 150, 93, 165, 27, 192, 48,
-			160, 163, 25, 199, 26, 40, 109, 57, 244, 172, 235, 110, 218, 145,
-			108, 22, 153, 223, 106, 224, 60, 20, 82, 130, 190, 110, 197, 181,
-			103, 185, 32, 214, 80, 130, 4, 142, 178, 41, 148, 52, 147, 86,
-			76, 219, 6, 116, 227, 82, 150, 17, 168, 108, 91, 182, 77, 109,
-			179, 229, 84, 234, 56, 73, 232, 22, 243, 64, 251, 152, 66, 54,
-			241, 25, 31, 241, 17, 159, 130, 155, 6, 138, 17, 245, 20, 243,
-			182, 152, 71, 125, 171, 10, 138, 134, 22, 106, 113, 42, 94, 112,
-			220, 109, 174, 30, 248, 39, 241, 118, 168, 21, 208, 109, 83, 136,
-			174, 192, 212, 180, 61, 102, 86, 219, 25, 106, 5, 4, 69, 76,
-			144, 16, 222, 69, 34, 10, 20, 104, 205, 98, 118, 53, 75, 11,
-			142, 148, 70, 63, 162, 17, 216, 30, 143, 53, 237, 54, 69, 91,
-			73, 168, 185, 109, 182, 133, 190, 138, 228, 76, 246, 69, 217, 69,
-			203, 15, 124, 152, 24, 30, 11, 90, 168, 95, 115, 75, 197, 124,
-			110, 241, 142, 245, 252, 143, 20, 74, 229, 146, 144, 91, 130, 130,
-			219, 59, 198, 170, 203, 124, 231, 8, 31, 107, 52, 212, 54, 11,
-			232, 76, 147, 121, 117, 179, 233, 11, 97, 143, 232, 183, 225, 86,
-			219, 20, 84, 219, 38, 115, 64, 54, 185, 153, 129, 87, 106, 118,
-			123, 22, 70, 79, 253, 0, 56, 2, 36, 112, 155, 204, 233, 39,
-			205, 168, 14, 113, 90, 131, 138, 244, 152, 217, 144, 108, 7, 101,
-			152, 165, 183, 215, 153, 35, 85, 66, 151, 64, 196, 245, 215, 54,
-			239, 199, 174, 180, 108, 51, 96, 4, 91, 245, 112, 234, 226, 107,
-			30, 243, 91, 54, 24, 208, 62, 28, 68, 45, 239, 208, 46, 55,
-			70, 124, 45, 117, 141, 229, 11, 93, 136, 214, 196, 180, 28, 224,
-			247, 5, 6, 255, 1, 142, 108, 122, 166, 19, 16, 161, 209, 66,
-			49, 168, 84, 192, 78, 6, 46, 221, 174, 187, 12, 196, 171, 233,
-			250, 62, 131, 255, 161, 42, 7, 99, 142, 68, 146, 19, 136, 194,
-			248, 3, 156, 66, 4, 148, 21, 171, 120, 44, 200, 162, 109, 237,
-			198, 14, 102, 114, 195, 245, 192, 28, 213, 220, 80, 217, 40, 160,
-			66, 146, 123, 37, 164, 26, 218, 105, 227, 85, 18, 210, 12, 237,
-			244, 220, 77, 228, 63, 36, 80, 217, 168, 134, 118, 171, 58, 159,
-			250, 183, 9, 49, 145, 164, 253, 99, 92, 249, 119, 179, 41, 35,
-			232, 129, 38, 45, 240, 169, 195, 182, 133, 64, 1, 249, 22, 132,
-			246, 6, 51, 193, 46, 54, 89, 37, 16, 250, 20, 155, 222, 121,
-			246, 203, 105, 79, 107, 150, 231, 7, 132, 110, 176, 26, 140, 9,
-			166, 56, 116, 20, 159, 226, 89, 122, 198, 180, 236, 150, 199, 160,
-			221, 170, 11, 164, 19, 60, 231, 238, 157, 148, 10, 18, 19, 173,
-			192, 165, 32, 27, 173, 38, 168, 32, 116, 221, 17, 103, 148, 254,
-			50, 176, 20, 117, 9, 232, 206, 42, 232, 61, 112, 18, 80, 112,
-			44, 84, 156, 209, 64, 112, 110, 138, 33, 98, 167, 23, 24, 107,
-			210, 166, 139, 88, 18, 124, 29, 5, 170, 141, 154, 0, 25, 110,
-			90, 65, 228, 3, 113, 221, 18, 184, 92, 29, 181, 123, 52, 9,
-			199, 156, 85, 201, 37, 237, 142, 152, 126, 161, 58, 238, 153, 73,
-			114, 10, 163, 42, 128, 54, 242, 176, 172, 2, 11, 96, 155, 130,
-			33, 241, 222, 184, 59, 140, 147, 24, 248, 86, 113, 27, 141, 150,
-			3, 186, 18, 135, 236, 185, 173, 205, 58, 61, 194, 121, 124, 132,
-			112, 85, 213, 137, 112, 92, 58, 124, 75, 88, 36, 222, 24, 184,
-			221, 161, 121, 239, 232, 31, 244, 5, 120, 22, 64, 250, 12, 221,
-			104, 5, 212, 51, 209, 173, 236, 192, 46, 54, 42, 43, 240, 153,
-			93, 11, 69, 28, 172, 228, 173, 161, 61, 69, 49, 14, 237, 169,
-			170, 25, 218, 173, 39, 110, 38, 223, 215, 80, 196, 53, 67, 187,
-			67, 157, 79, 253, 181, 70, 227, 171, 23, 106, 110, 184, 94, 112,
-			105, 105, 71, 245, 24, 208, 134, 187, 197, 124, 96, 111, 224, 82,
-			185, 216, 227, 18, 4, 204, 64, 197, 15, 118, 151, 134, 75, 72,
-			241, 35, 200, 80, 197, 102, 38, 184, 177, 77, 208, 69, 237, 152,
-			97, 221, 52, 189, 13, 115, 147, 101, 67, 14, 3, 34, 13, 23,
-			29, 189, 10, 72, 32, 111, 163, 131, 210, 113, 161, 158, 217, 174,
-			155, 1, 106, 19, 43, 64, 35, 177, 193, 102, 1, 223, 69, 151,
-			129, 19, 18, 160, 217, 148, 170, 58, 162, 162, 31, 90, 138, 10,
-			18, 3, 148, 181, 71, 107, 166, 101, 131, 255, 30, 202, 219, 105,
-			88, 206, 188, 120, 153, 163, 148, 158, 201, 21, 150, 242, 139, 235,
-			171, 197, 124, 184, 142, 144, 205, 244, 242, 22, 201, 23, 174, 177,
-			1, 163, 112, 253, 45, 214, 85, 156, 26, 51, 86, 150, 101, 133,
-			58, 225, 152, 111, 48, 24, 167, 244, 21, 171, 212, 113, 183, 103,
-			67, 33, 209, 20, 67, 187, 35, 20, 18, 77, 53, 180, 59, 66,
-			33, 209, 64, 44, 78, 220, 76, 126, 66, 37, 106, 98, 192, 208,
-			171, 3, 117, 37, 245, 203, 10, 45, 181, 154, 77, 23, 157, 75,
-			152, 154, 208, 184, 105, 111, 186, 158, 21, 212, 27, 194, 123, 22,
-			78, 231, 37, 150, 19, 124, 233, 26, 48, 207, 180, 169, 99, 54,
-			24, 215, 136, 22, 176, 62, 48, 157, 160, 103, 154, 72, 191, 179,
-			226, 58, 220, 235, 239, 82, 149, 132, 54, 205, 160, 238, 199, 252,
-			246, 44, 57, 185, 50, 247, 146, 254, 67, 223, 57, 1, 110, 106,
-			53, 177, 155, 12, 19, 61, 129, 174, 51, 83, 175, 0, 130, 37,
-			184, 7, 203, 212, 41, 9, 169, 134, 198, 82, 7, 196, 139, 138,
-			161, 213, 194, 23, 21, 132, 146, 18, 82, 13, 173, 22, 190, 168,
-			26, 218, 102, 248, 34, 124, 182, 169, 14, 74, 8, 126, 75, 29,
-			32, 183, 16, 85, 31, 48, 116, 123, 192, 85, 82, 55, 209, 92,
-			124, 221, 227, 70, 62, 57, 95, 80, 93, 142, 11, 132, 104, 58,
-			224, 109, 15, 238, 37, 111, 32, 186, 142, 67, 106, 168, 251, 211,
-			39, 161, 165, 144, 177, 124, 213, 29, 169, 70, 84, 134, 129, 75,
-			55, 89, 128, 48, 240, 144, 144, 221, 36, 1, 13, 232, 134, 222,
-			80, 237, 113, 192, 26, 192, 36, 52, 72, 36, 164, 24, 90, 99,
-			120, 159, 132, 52, 67, 107, 76, 77, 147, 155, 177, 103, 197, 208,
-			28, 117, 127, 250, 84, 216, 34, 120, 200, 176, 24, 242, 208, 223,
-			171, 179, 139, 224, 248, 224, 226, 158, 101, 55, 233, 17, 115, 163,
-			82, 101, 181, 108, 54, 123, 68, 118, 173, 232, 134, 238, 168, 141,
-			253, 162, 121, 37, 1, 45, 14, 74, 8, 218, 31, 146, 104, 129,
-			149, 119, 166, 166, 9, 33, 170, 174, 24, 186, 55, 112, 175, 130,
-			196, 128, 183, 188, 193, 3, 100, 129, 232, 186, 2, 196, 8, 212,
-			169, 212, 245, 221, 84, 142, 185, 181, 49, 215, 48, 116, 111, 113,
-			121, 148, 229, 88, 41, 72, 144, 64, 245, 14, 98, 207, 10, 18,
-			36, 80, 135, 37, 164, 24, 90, 48, 98, 72, 72, 51, 180, 96,
-			98, 146, 124, 92, 193, 238, 21, 67, 107, 171, 135, 83, 255, 70,
-			1, 31, 180, 233, 49, 159, 193, 220, 224, 126, 154, 112, 186, 96,
-			221, 128, 166, 118, 67, 216, 79, 225, 255, 47, 8, 182, 47, 90,
-			62, 174, 154, 65, 139, 212, 153, 89, 101, 30, 17, 75, 77, 167,
-			98, 183, 170, 194, 155, 217, 180, 182, 208, 123, 228, 251, 207, 89,
-			212, 233, 230, 5, 230, 211, 13, 207, 221, 246, 153, 231, 83, 223,
-			220, 226, 222, 3, 26, 195, 112, 211, 129, 136, 79, 241, 51, 57,
-			96, 96, 67, 91, 13, 166, 196, 160, 128, 13, 109, 193, 6, 5,
-			217, 208, 30, 74, 73, 72, 51, 180, 246, 193, 67, 100, 152, 168,
-			186, 106, 36, 238, 31, 120, 155, 194, 249, 0, 99, 191, 127, 112,
-			47, 249, 184, 74, 116, 93, 85, 7, 12, 253, 65, 69, 157, 78,
-			125, 80, 165, 57, 185, 56, 61, 87, 46, 175, 150, 248, 18, 213,
-			237, 117, 184, 65, 197, 156, 1, 62, 112, 79, 44, 84, 192, 98,
-			47, 137, 154, 173, 160, 206, 156, 64, 172, 121, 178, 52, 127, 177,
-			105, 121, 160, 136, 106, 1, 172, 111, 220, 6, 140, 82, 236, 202,
-			128, 199, 225, 215, 93, 47, 160, 102, 195, 109, 57, 124, 11, 193,
-			18, 132, 234, 245, 128, 76, 219, 6, 146, 33, 91, 192, 233, 2,
-			191, 167, 209, 96, 85, 88, 117, 218, 109, 238, 84, 49, 190, 226,
-			67, 148, 54, 91, 38, 184, 197, 98, 19, 171, 14, 148, 6, 83,
-			216, 52, 189, 192, 2, 63, 221, 11, 245, 90, 180, 231, 145, 165,
-			139, 46, 88, 49, 66, 205, 0, 108, 38, 218, 222, 166, 233, 97,
-			119, 89, 66, 70, 73, 2, 200, 166, 27, 137, 7, 21, 245, 254,
-			113, 228, 140, 170, 14, 36, 144, 142, 131, 18, 84, 0, 28, 10,
-			127, 213, 0, 156, 156, 34, 119, 19, 53, 161, 24, 201, 119, 40,
-			3, 15, 43, 202, 75, 175, 71, 135, 137, 150, 80, 20, 67, 127,
-			135, 146, 0, 23, 92, 79, 192, 68, 211, 127, 70, 81, 41, 32,
-			146, 192, 73, 1, 224, 1, 9, 170, 0, 30, 58, 76, 178, 248,
-			174, 98, 232, 63, 171, 168, 99, 105, 26, 174, 126, 128, 7, 220,
-			206, 133, 62, 155, 227, 110, 19, 241, 185, 194, 63, 24, 150, 160,
-			10, 224, 232, 30, 242, 26, 108, 77, 53, 244, 119, 65, 107, 179,
-			125, 214, 188, 97, 179, 124, 121, 220, 217, 44, 224, 241, 174, 168,
-			89, 222, 208, 232, 30, 242, 90, 108, 86, 51, 244, 135, 160, 217,
-			163, 113, 93, 81, 55, 161, 65, 230, 208, 102, 107, 195, 230, 22,
-			90, 104, 233, 133, 92, 73, 182, 171, 41, 248, 169, 108, 87, 83,
-			1, 28, 221, 3, 234, 57, 161, 168, 186, 161, 255, 28, 180, 123,
-			130, 239, 167, 208, 109, 248, 127, 53, 51, 48, 109, 202, 184, 23,
-			91, 109, 121, 114, 253, 39, 172, 62, 74, 184, 108, 30, 180, 221,
-			207, 41, 234, 144, 4, 85, 0, 71, 247, 144, 27, 177, 249, 132,
-			161, 255, 43, 104, 254, 88, 151, 91, 4, 14, 92, 232, 15, 193,
-			210, 60, 238, 41, 202, 166, 19, 10, 126, 29, 130, 42, 128, 163,
-			123, 200, 8, 81, 117, 205, 72, 62, 162, 12, 252, 138, 162, 0,
-			247, 117, 24, 227, 35, 202, 96, 138, 60, 160, 19, 93, 215, 128,
-			253, 143, 41, 234, 84, 234, 111, 181, 23, 168, 105, 155, 173, 208,
-			208, 133, 251, 134, 86, 13, 23, 231, 142, 88, 187, 239, 188, 9,
-			144, 65, 7, 27, 167, 169, 88, 128, 161, 234, 220, 97, 59, 128,
-			239, 196, 155, 219, 102, 59, 220, 20, 192, 173, 114, 112, 247, 97,
-			213, 107, 249, 62, 208, 125, 198, 114, 248, 254, 196, 243, 217, 51,
-			152, 205, 144, 248, 123, 231, 215, 74, 101, 234, 183, 154, 77, 187,
-			77, 195, 83, 57, 222, 69, 6, 70, 107, 11, 115, 43, 23, 243,
-			216, 30, 184, 185, 164, 215, 23, 139, 45, 152, 91, 98, 159, 89,
-			46, 251, 225, 189, 14, 65, 151, 42, 41, 220, 23, 224, 190, 93,
-			104, 88, 162, 37, 190, 89, 185, 32, 185, 34, 112, 54, 125, 84,
-			84, 184, 161, 217, 189, 248, 158, 233, 220, 192, 153, 5, 207, 176,
-			99, 211, 19, 84, 101, 150, 144, 61, 36, 1, 66, 160, 27, 201,
-			199, 20, 245, 17, 229, 10, 212, 71, 26, 88, 74, 16, 139, 97,
-			9, 42, 0, 142, 24, 18, 212, 0, 156, 152, 36, 207, 41, 40,
-			67, 138, 161, 63, 174, 168, 251, 83, 255, 85, 161, 185, 184, 235,
-			18, 184, 252, 96, 195, 165, 85, 230, 89, 91, 177, 57, 142, 62,
-			134, 152, 46, 92, 113, 248, 116, 187, 206, 156, 104, 238, 144, 190,
-			27, 89, 184, 124, 192, 45, 159, 80, 206, 86, 112, 87, 215, 180,
-			65, 248, 194, 65, 91, 190, 52, 218, 66, 98, 54, 220, 160, 30,
-			253, 12, 171, 159, 35, 33, 155, 143, 160, 163, 27, 26, 121, 241,
-			86, 54, 246, 59, 74, 71, 195, 12, 42, 117, 18, 255, 46, 164,
-			159, 162, 27, 201, 199, 21, 245, 49, 101, 74, 80, 72, 73, 34,
-			73, 136, 4, 145, 66, 195, 251, 36, 168, 1, 56, 53, 141, 211,
-			83, 55, 146, 31, 81, 6, 126, 83, 76, 79, 208, 17, 31, 81,
-			6, 15, 144, 55, 18, 93, 215, 97, 118, 126, 84, 81, 175, 76,
-			45, 0, 97, 173, 42, 88, 205, 154, 197, 60, 32, 75, 191, 141,
-			170, 248, 134, 80, 236, 68, 14, 38, 155, 192, 85, 71, 94, 127,
-			84, 81, 63, 162, 28, 68, 108, 116, 180, 76, 31, 149, 150, 73,
-			71, 94, 127, 84, 25, 186, 66, 130, 26, 128, 135, 41, 249, 191,
-			85, 196, 72, 49, 244, 39, 20, 245, 64, 234, 255, 84, 113, 134,
-			179, 32, 131, 199, 13, 96, 27, 253, 112, 251, 36, 112, 169, 127,
-			193, 106, 70, 19, 174, 99, 147, 19, 168, 143, 199, 6, 166, 239,
-			183, 26, 140, 59, 51, 161, 249, 136, 41, 236, 200, 63, 138, 166,
-			10, 172, 199, 118, 60, 42, 144, 7, 10, 124, 127, 215, 109, 6,
-			86, 67, 232, 96, 49, 11, 125, 198, 229, 12, 119, 68, 2, 175,
-			229, 139, 85, 118, 216, 59, 218, 180, 25, 120, 153, 93, 52, 27,
-			77, 155, 101, 184, 92, 90, 225, 150, 25, 184, 20, 184, 222, 199,
-			37, 109, 254, 162, 112, 15, 164, 235, 1, 162, 84, 101, 14, 88,
-			172, 150, 15, 180, 112, 227, 234, 42, 75, 115, 145, 207, 32, 28,
-			20, 190, 241, 231, 19, 92, 103, 230, 139, 231, 11, 165, 82, 97,
-			101, 121, 125, 49, 191, 92, 200, 47, 134, 108, 3, 17, 123, 66,
-			81, 63, 170, 92, 41, 24, 3, 34, 246, 132, 156, 162, 58, 138,
-			216, 19, 202, 200, 164, 4, 53, 0, 247, 167, 80, 196, 18, 70,
-			242, 51, 202, 192, 239, 8, 17, 3, 91, 241, 25, 41, 98, 9,
-			16, 177, 39, 95, 82, 17, 75, 160, 136, 61, 169, 168, 159, 17,
-			34, 150, 64, 17, 123, 82, 138, 88, 2, 69, 236, 73, 41, 98,
-			9, 20, 177, 39, 65, 196, 0, 215, 164, 145, 124, 74, 193, 19,
-			89, 192, 53, 169, 24, 250, 83, 202, 224, 20, 249, 215, 160, 105,
-			146, 128, 236, 231, 21, 53, 149, 250, 87, 168, 105, 220, 166, 249,
-			230, 22, 19, 75, 19, 225, 91, 203, 1, 248, 156, 234, 59, 108,
-			155, 152, 32, 173, 32, 37, 177, 93, 15, 19, 207, 118, 165, 188,
-			224, 214, 41, 74, 77, 135, 218, 132, 97, 210, 178, 199, 160, 43,
-			240, 0, 253, 104, 19, 148, 143, 62, 137, 163, 255, 188, 162, 62,
-			165, 236, 199, 241, 37, 113, 244, 159, 151, 163, 79, 226, 232, 63,
-			175, 12, 77, 72, 80, 3, 112, 122, 63, 249, 18, 31, 162, 98,
-			232, 207, 128, 191, 253, 123, 138, 56, 3, 10, 245, 125, 204, 166,
-			188, 128, 243, 10, 26, 184, 36, 190, 29, 221, 227, 170, 47, 187,
-			93, 30, 57, 63, 146, 17, 231, 190, 209, 145, 1, 78, 13, 177,
-			65, 6, 168, 201, 189, 97, 211, 246, 93, 129, 27, 233, 220, 33,
-			238, 165, 13, 72, 241, 51, 138, 250, 121, 37, 37, 70, 175, 36,
-			112, 184, 146, 54, 10, 142, 94, 184, 197, 73, 148, 226, 103, 192,
-			45, 158, 67, 210, 168, 134, 254, 44, 112, 255, 74, 202, 67, 39,
-			118, 218, 235, 11, 187, 83, 117, 35, 249, 172, 162, 62, 163, 76,
-			139, 6, 213, 36, 54, 49, 42, 65, 5, 192, 61, 146, 21, 224,
-			58, 62, 11, 172, 248, 12, 103, 133, 102, 232, 95, 2, 223, 232,
-			87, 21, 122, 198, 245, 98, 155, 64, 220, 49, 145, 235, 193, 46,
-			159, 41, 242, 48, 163, 173, 82, 169, 183, 44, 39, 190, 182, 104,
-			249, 172, 214, 178, 99, 71, 94, 49, 35, 184, 163, 245, 35, 220,
-			244, 209, 150, 19, 88, 118, 215, 1, 155, 220, 125, 10, 9, 160,
-			233, 70, 242, 75, 138, 250, 108, 72, 111, 45, 137, 99, 26, 150,
-			160, 2, 160, 48, 236, 73, 85, 195, 17, 79, 76, 226, 26, 60,
-			9, 30, 239, 87, 64, 215, 95, 135, 195, 23, 129, 37, 225, 224,
-			77, 90, 111, 53, 76, 208, 8, 102, 21, 5, 15, 189, 96, 42,
-			226, 102, 66, 20, 192, 192, 125, 69, 81, 191, 36, 108, 99, 18,
-			212, 17, 52, 43, 89, 14, 22, 239, 43, 202, 208, 164, 4, 53,
-			0, 247, 167, 54, 146, 40, 192, 167, 200, 175, 27, 228, 192, 38,
-			202, 250, 28, 62, 217, 104, 213, 230, 64, 131, 182, 69, 244, 217,
-			30, 254, 99, 86, 254, 152, 222, 69, 18, 121, 248, 125, 126, 139,
-			140, 87, 220, 70, 182, 235, 247, 121, 130, 191, 174, 2, 184, 170,
-			220, 121, 100, 211, 10, 234, 173, 141, 108, 197, 109, 204, 109, 186,
-			182, 233, 108, 70, 221, 52, 131, 118, 147, 249, 188, 183, 239, 43,
-			202, 191, 86, 181, 179, 171, 243, 31, 82, 15, 241, 169, 151, 93,
-			21, 239, 101, 111, 103, 182, 125, 43, 48, 165, 12, 239, 223, 242,
-			63, 198, 72, 210, 208, 15, 13, 156, 26, 35, 191, 55, 130, 81,
-			37, 135, 6, 140, 147, 191, 61, 66, 87, 229, 148, 156, 111, 213,
-			106, 96, 44, 142, 137, 89, 124, 196, 23, 139, 41, 176, 104, 149,
-			186, 233, 108, 162, 219, 216, 0, 247, 50, 30, 138, 114, 252, 181,
-			114, 218, 23, 156, 74, 150, 226, 201, 63, 254, 134, 103, 168, 184,
-			7, 145, 37, 24, 231, 225, 159, 158, 155, 171, 178, 45, 102, 195,
-			164, 240, 37, 13, 96, 144, 82, 47, 28, 219, 224, 72, 204, 225,
-			206, 106, 24, 136, 33, 13, 180, 208, 50, 190, 219, 242, 42, 124,
-			187, 120, 195, 114, 64, 77, 2, 94, 242, 80, 221, 245, 240, 191,
-			110, 43, 32, 180, 225, 86, 67, 19, 159, 225, 30, 21, 243, 26,
-			86, 0, 170, 32, 220, 156, 69, 29, 141, 62, 158, 107, 219, 238,
-			54, 168, 237, 138, 235, 84, 45, 126, 116, 142, 49, 35, 13, 22,
-			156, 22, 17, 43, 71, 187, 16, 195, 9, 47, 48, 194, 179, 237,
-			6, 15, 62, 192, 232, 23, 104, 213, 220, 112, 183, 24, 70, 193,
-			136, 3, 73, 199, 13, 172, 10, 203, 112, 157, 101, 91, 60, 4,
-			33, 222, 163, 8, 116, 136, 208, 169, 90, 126, 197, 54, 173, 6,
-			243, 178, 59, 33, 97, 57, 113, 90, 72, 36, 154, 252, 8, 63,
-			194, 131, 68, 136, 252, 131, 240, 32, 114, 153, 85, 117, 43, 173,
-			6, 115, 130, 208, 139, 154, 115, 61, 234, 226, 201, 68, 195, 12,
-			152, 103, 153, 182, 31, 145, 90, 198, 11, 197, 162, 112, 80, 53,
-			138, 65, 45, 139, 80, 153, 112, 199, 207, 173, 117, 200, 150, 227,
-			70, 191, 33, 221, 173, 0, 15, 102, 29, 222, 20, 44, 119, 249,
-			206, 126, 184, 67, 204, 156, 170, 235, 249, 12, 132, 162, 233, 185,
-			13, 55, 192, 77, 249, 42, 58, 134, 220, 255, 175, 210, 154, 231,
-			54, 8, 167, 130, 140, 18, 146, 18, 20, 197, 225, 52, 61, 11,
-			4, 203, 3, 217, 113, 98, 33, 56, 168, 72, 207, 21, 74, 180,
-			180, 114, 166, 124, 123, 174, 152, 167, 133, 18, 93, 45, 174, 220,
-			86, 88, 204, 47, 210, 249, 59, 104, 249, 92, 158, 46, 172, 172,
-			222, 81, 44, 156, 61, 87, 166, 231, 86, 150, 22, 243, 197, 18,
-			205, 45, 47, 210, 133, 149, 229, 114, 177, 48, 191, 86, 94, 41,
-			150, 72, 24, 99, 4, 191, 228, 150, 239, 160, 249, 31, 89, 45,
-			230, 75, 24, 88, 84, 56, 191, 186, 84, 200, 47, 198, 194, 141,
-			50, 180, 176, 188, 176, 180, 182, 88, 88, 62, 155, 161, 243, 107,
-			101, 186, 188, 82, 38, 116, 169, 112, 190, 80, 206, 47, 210, 242,
-			74, 6, 187, 237, 253, 142, 174, 156, 161, 231, 243, 197, 133, 115,
-			185, 229, 114, 110, 190, 176, 84, 40, 223, 129, 29, 158, 41, 148,
-			151, 161, 179, 51, 43, 69, 66, 115, 116, 53, 87, 44, 23, 22,
-			214, 150, 114, 69, 186, 186, 86, 92, 93, 41, 229, 41, 140, 108,
-			177, 80, 90, 88, 202, 21, 206, 231, 23, 179, 180, 176, 76, 151,
-			87, 104, 254, 182, 252, 114, 153, 150, 206, 229, 150, 150, 58, 7,
-			74, 232, 202, 237, 203, 249, 162, 8, 139, 10, 135, 73, 231, 243,
-			116, 169, 144, 155, 95, 202, 67, 87, 56, 206, 197, 66, 49, 191,
-			80, 134, 1, 69, 127, 45, 20, 22, 243, 203, 229, 220, 82, 134,
-			80, 140, 58, 204, 45, 101, 104, 254, 71, 242, 231, 87, 151, 114,
-			197, 59, 50, 162, 209, 82, 254, 141, 107, 249, 229, 114, 33, 183,
-			68, 23, 115, 231, 115, 103, 243, 37, 58, 115, 57, 170, 172, 22,
-			87, 22, 214, 138, 249, 243, 128, 245, 202, 25, 90, 90, 155, 47,
-			149, 11, 229, 181, 114, 158, 158, 93, 89, 89, 68, 98, 151, 242,
-			197, 219, 10, 11, 249, 210, 141, 116, 105, 165, 132, 4, 91, 43,
-			229, 51, 132, 46, 230, 202, 57, 236, 122, 181, 184, 114, 166, 80,
-			46, 221, 8, 127, 207, 175, 149, 10, 72, 184, 194, 114, 57, 95,
-			44, 174, 173, 150, 11, 43, 203, 179, 244, 220, 202, 237, 249, 219,
-			242, 69, 186, 144, 91, 43, 229, 23, 145, 194, 43, 203, 48, 90,
-			144, 149, 252, 74, 241, 14, 104, 22, 232, 128, 28, 200, 208, 219,
-			207, 229, 203, 231, 242, 69, 32, 42, 82, 43, 7, 100, 40, 149,
-			139, 133, 133, 114, 252, 181, 149, 34, 45, 175, 20, 203, 36, 54,
-			78, 186, 156, 63, 187, 84, 56, 155, 95, 94, 200, 195, 207, 43,
-			208, 204, 237, 133, 82, 126, 150, 230, 138, 133, 18, 188, 80, 192,
-			142, 233, 237, 185, 59, 232, 202, 26, 142, 26, 24, 181, 86, 202,
-			19, 254, 119, 76, 116, 51, 200, 79, 90, 56, 67, 115, 139, 183,
-			21, 0, 115, 241, 246, 234, 74, 169, 84, 16, 226, 130, 100, 91,
-			56, 39, 104, 30, 70, 151, 209, 193, 41, 17, 93, 150, 30, 184,
-			17, 163, 203, 174, 230, 127, 242, 135, 87, 13, 220, 36, 67, 206,
-			224, 79, 254, 240, 85, 3, 25, 124, 168, 240, 63, 249, 195, 171,
-			7, 174, 193, 135, 226, 79, 254, 240, 213, 3, 105, 124, 72, 248,
-			159, 252, 225, 145, 129, 43, 241, 225, 171, 248, 159, 252, 225, 204,
-			192, 97, 124, 120, 152, 255, 249, 3, 21, 79, 71, 180, 83, 3,
-			99, 169, 111, 171, 52, 199, 99, 46, 172, 10, 69, 251, 41, 253,
-			2, 110, 2, 218, 110, 11, 67, 46, 60, 118, 76, 236, 16, 152,
-			91, 174, 85, 165, 85, 6, 46, 12, 168, 191, 22, 198, 26, 6,
-			172, 74, 58, 191, 71, 245, 219, 118, 91, 30, 205, 173, 22, 252,
-			44, 205, 209, 160, 221, 196, 208, 54, 177, 96, 3, 63, 40, 90,
-			92, 137, 85, 164, 199, 227, 65, 9, 21, 90, 77, 68, 28, 49,
-			248, 152, 137, 165, 78, 110, 181, 32, 2, 40, 178, 232, 239, 200,
-			40, 58, 105, 141, 228, 226, 242, 140, 235, 210, 251, 196, 209, 31,
-			245, 154, 21, 58, 111, 122, 51, 93, 78, 70, 22, 125, 140, 89,
-			177, 90, 242, 233, 14, 191, 223, 200, 155, 185, 95, 108, 2, 221,
-			82, 90, 89, 70, 75, 130, 59, 17, 209, 186, 245, 30, 124, 251,
-			30, 220, 239, 70, 90, 224, 139, 194, 43, 188, 231, 190, 251, 239,
-			137, 29, 39, 157, 26, 220, 29, 186, 78, 239, 186, 138, 92, 209,
-			237, 58, 241, 165, 250, 78, 190, 211, 123, 20, 146, 44, 225, 27,
-			198, 141, 36, 137, 75, 86, 127, 90, 161, 218, 204, 240, 201, 171,
-			186, 29, 169, 44, 127, 49, 123, 6, 223, 202, 59, 129, 215, 46,
-			138, 79, 82, 111, 36, 195, 177, 199, 198, 24, 209, 46, 176, 182,
-			8, 241, 134, 63, 141, 12, 73, 108, 153, 118, 139, 137, 24, 244,
-			201, 158, 198, 111, 131, 95, 139, 252, 165, 211, 234, 107, 149, 244,
-			71, 85, 146, 192, 135, 198, 141, 132, 56, 45, 219, 94, 231, 13,
-			240, 219, 5, 169, 158, 6, 150, 91, 182, 141, 239, 159, 27, 40,
-			14, 57, 18, 48, 174, 34, 35, 78, 171, 177, 193, 188, 245, 168,
-			127, 229, 220, 64, 113, 152, 63, 13, 95, 226, 139, 74, 241, 146,
-			6, 136, 195, 75, 252, 41, 127, 233, 48, 33, 27, 174, 43, 209,
-			208, 169, 50, 51, 8, 93, 193, 51, 254, 194, 235, 176, 149, 86,
-			37, 16, 175, 36, 112, 168, 83, 59, 208, 81, 52, 223, 170, 4,
-			225, 40, 193, 81, 16, 223, 38, 241, 219, 222, 81, 46, 89, 126,
-			16, 142, 210, 150, 192, 124, 146, 232, 23, 44, 167, 154, 190, 145,
-			12, 133, 111, 24, 89, 146, 196, 198, 36, 71, 119, 34, 186, 120,
-			235, 232, 1, 50, 20, 18, 209, 24, 37, 100, 121, 109, 105, 105,
-			253, 182, 220, 210, 90, 126, 108, 96, 254, 45, 74, 127, 239, 122,
-			152, 143, 70, 186, 215, 39, 46, 239, 94, 243, 65, 223, 200, 255,
-			211, 220, 120, 1, 142, 246, 191, 61, 200, 29, 237, 117, 229, 21,
-			71, 251, 21, 71, 251, 21, 71, 251, 21, 71, 251, 21, 71, 251,
-			21, 71, 251, 31, 209, 209, 14, 253, 95, 248, 83, 58, 218, 231,
-			164, 247, 13, 127, 74, 71, 59, 244, 190, 175, 14, 189, 239, 87,
-			139, 135, 226, 79, 233, 104, 135, 222, 247, 145, 208, 251, 158, 137,
-			188, 111, 248, 243, 215, 52, 30, 134, 116, 106, 224, 122, 37, 245,
-			65, 141, 222, 195, 77, 238, 61, 145, 235, 136, 59, 175, 50, 94,
-			65, 236, 220, 162, 81, 207, 96, 28, 151, 229, 243, 131, 200, 26,
-			63, 148, 240, 137, 136, 77, 111, 152, 77, 60, 172, 107, 59, 102,
-			3, 92, 106, 187, 141, 254, 113, 149, 127, 235, 99, 20, 56, 134,
-			103, 200, 155, 50, 126, 38, 236, 156, 208, 6, 42, 224, 13, 134,
-			199, 168, 174, 188, 3, 97, 82, 199, 12, 172, 45, 214, 229, 215,
-			114, 23, 59, 60, 99, 177, 28, 66, 253, 138, 103, 53, 17, 177,
-			176, 121, 106, 91, 23, 192, 45, 14, 135, 195, 183, 166, 69, 67,
-			50, 144, 63, 140, 73, 69, 39, 186, 10, 86, 202, 22, 123, 195,
-			102, 208, 237, 79, 243, 243, 25, 232, 107, 3, 181, 233, 38, 3,
-			237, 76, 162, 235, 85, 104, 182, 229, 24, 194, 0, 89, 137, 82,
-			246, 114, 174, 186, 228, 133, 229, 199, 189, 244, 14, 7, 125, 148,
-			220, 32, 227, 189, 174, 83, 105, 42, 67, 215, 28, 215, 171, 50,
-			224, 20, 112, 192, 173, 237, 204, 129, 120, 164, 215, 117, 234, 169,
-			177, 88, 164, 215, 117, 234, 190, 88, 164, 215, 117, 19, 7, 98,
-			145, 94, 215, 29, 58, 76, 126, 71, 229, 241, 86, 55, 13, 44,
-			43, 169, 255, 160, 210, 123, 208, 175, 235, 18, 154, 29, 58, 22,
-			242, 33, 174, 162, 112, 131, 70, 40, 56, 213, 25, 96, 48, 122,
-			206, 25, 206, 36, 12, 18, 51, 41, 120, 193, 204, 4, 255, 132,
-			122, 172, 210, 242, 124, 16, 1, 193, 67, 33, 137, 174, 71, 77,
-			18, 218, 104, 41, 98, 57, 97, 203, 248, 9, 20, 239, 189, 35,
-			206, 199, 165, 62, 11, 168, 235, 48, 201, 97, 66, 183, 76, 207,
-			50, 157, 192, 207, 80, 115, 195, 199, 173, 120, 92, 209, 181, 229,
-			15, 212, 114, 170, 184, 152, 196, 99, 65, 220, 166, 190, 44, 31,
-			5, 117, 36, 27, 17, 17, 193, 69, 69, 49, 180, 155, 6, 119,
-			147, 52, 209, 117, 101, 112, 192, 208, 111, 86, 207, 107, 169, 125,
-			216, 30, 120, 221, 33, 226, 60, 216, 19, 222, 81, 12, 237, 230,
-			193, 17, 114, 68, 134, 182, 205, 235, 7, 83, 41, 90, 140, 147,
-			30, 168, 217, 249, 25, 178, 117, 94, 223, 29, 139, 87, 155, 31,
-			157, 142, 197, 171, 205, 31, 184, 130, 204, 202, 112, 181, 69, 253,
-			138, 212, 21, 157, 77, 86, 221, 214, 134, 205, 186, 26, 85, 18,
-			240, 46, 137, 197, 132, 45, 14, 79, 197, 98, 194, 22, 83, 7,
-			68, 163, 170, 161, 157, 233, 109, 84, 156, 182, 117, 54, 170, 38,
-			224, 221, 16, 82, 12, 237, 76, 216, 168, 170, 25, 218, 153, 212,
-			1, 114, 20, 27, 213, 12, 237, 156, 62, 157, 58, 216, 217, 168,
-			16, 151, 174, 86, 181, 4, 188, 44, 195, 215, 52, 197, 208, 206,
-			137, 40, 66, 5, 99, 100, 207, 77, 78, 145, 99, 216, 170, 110,
-			104, 183, 232, 87, 164, 104, 15, 170, 82, 5, 118, 54, 172, 39,
-			225, 253, 16, 82, 12, 237, 150, 16, 93, 93, 51, 180, 91, 82,
-			7, 68, 195, 9, 67, 91, 210, 15, 118, 55, 236, 177, 38, 63,
-			227, 18, 98, 18, 54, 156, 72, 194, 251, 146, 99, 9, 197, 208,
-			150, 66, 142, 37, 52, 67, 91, 58, 112, 5, 249, 101, 133, 71,
-			245, 150, 7, 110, 87, 82, 239, 83, 232, 61, 225, 34, 11, 197,
-			205, 164, 190, 229, 108, 218, 44, 112, 29, 202, 156, 86, 35, 118,
-			83, 32, 148, 83, 238, 48, 134, 2, 35, 181, 20, 9, 197, 22,
-			183, 53, 90, 142, 240, 229, 46, 45, 233, 157, 221, 227, 107, 247,
-			64, 219, 98, 87, 1, 227, 110, 203, 137, 81, 146, 146, 113, 183,
-			183, 169, 123, 83, 187, 233, 114, 151, 188, 138, 48, 220, 219, 212,
-			145, 88, 24, 238, 109, 123, 198, 200, 191, 84, 48, 194, 80, 255,
-			23, 3, 235, 74, 106, 139, 222, 19, 46, 72, 197, 112, 183, 61,
-			179, 217, 100, 30, 53, 61, 183, 229, 84, 227, 212, 13, 3, 250,
-			67, 237, 119, 233, 145, 116, 182, 140, 175, 153, 158, 135, 81, 67,
-			50, 176, 241, 95, 12, 238, 37, 167, 69, 92, 163, 246, 38, 245,
-			138, 212, 49, 224, 108, 87, 119, 151, 80, 191, 35, 50, 184, 79,
-			123, 147, 26, 66, 73, 67, 123, 211, 240, 152, 132, 20, 67, 123,
-			211, 222, 41, 9, 105, 134, 246, 166, 212, 129, 112, 75, 230, 223,
-			207, 145, 195, 221, 91, 50, 129, 213, 96, 126, 96, 54, 154, 59,
-			237, 202, 220, 72, 134, 202, 242, 29, 99, 154, 236, 242, 25, 172,
-			104, 124, 220, 250, 208, 138, 18, 52, 246, 145, 132, 99, 58, 174,
-			143, 123, 26, 137, 34, 7, 230, 127, 172, 255, 58, 125, 52, 108,
-			81, 46, 213, 175, 185, 252, 82, 61, 196, 244, 5, 44, 210, 63,
-			126, 140, 236, 50, 18, 135, 48, 188, 244, 149, 85, 250, 43, 171,
-			244, 87, 86, 233, 175, 172, 210, 95, 89, 165, 191, 178, 74, 255,
-			39, 94, 165, 207, 71, 171, 244, 249, 75, 175, 210, 231, 162, 85,
-			250, 220, 11, 88, 165, 127, 236, 0, 174, 210, 19, 247, 130, 229,
-			75, 125, 240, 0, 205, 209, 208, 228, 118, 46, 186, 154, 174, 229,
-			240, 88, 106, 171, 1, 86, 166, 202, 154, 204, 169, 50, 126, 217,
-			1, 111, 230, 193, 243, 123, 93, 135, 225, 249, 85, 197, 180, 153,
-			83, 53, 189, 76, 247, 74, 88, 248, 1, 168, 61, 107, 158, 89,
-			137, 108, 132, 252, 1, 76, 0, 56, 5, 8, 131, 141, 116, 109,
-			110, 226, 44, 135, 174, 149, 23, 104, 190, 233, 86, 234, 157, 247,
-			43, 28, 176, 44, 96, 255, 194, 235, 248, 171, 158, 107, 179, 102,
-			96, 85, 232, 89, 143, 109, 186, 176, 194, 162, 11, 2, 39, 177,
-			80, 100, 23, 3, 6, 29, 130, 198, 140, 94, 146, 136, 19, 12,
-			102, 222, 54, 189, 42, 158, 212, 181, 153, 233, 193, 122, 174, 187,
-			75, 140, 14, 229, 129, 213, 54, 109, 88, 78, 43, 16, 151, 213,
-			174, 63, 78, 194, 33, 217, 46, 172, 55, 241, 234, 157, 205, 204,
-			102, 52, 84, 143, 209, 180, 223, 96, 166, 199, 170, 105, 234, 187,
-			220, 212, 58, 110, 252, 45, 66, 3, 140, 91, 178, 124, 234, 48,
-			134, 215, 77, 93, 113, 225, 163, 9, 86, 148, 239, 83, 20, 209,
-			253, 176, 124, 161, 172, 143, 31, 63, 126, 226, 24, 254, 175, 124,
-			252, 248, 105, 252, 223, 157, 48, 138, 27, 110, 184, 225, 134, 99,
-			39, 78, 30, 59, 117, 162, 124, 242, 212, 233, 235, 110, 56, 125,
-			221, 13, 217, 27, 228, 191, 59, 179, 132, 206, 183, 129, 224, 129,
-			103, 85, 112, 143, 35, 16, 40, 121, 208, 124, 134, 110, 51, 202,
-			28, 31, 239, 14, 195, 211, 109, 134, 139, 237, 138, 235, 108, 49,
-			47, 192, 16, 62, 206, 85, 183, 65, 105, 241, 204, 2, 61, 117,
-			234, 212, 13, 224, 36, 201, 232, 71, 95, 36, 56, 185, 75, 122,
-			59, 219, 219, 219, 89, 139, 5, 53, 204, 241, 226, 213, 42, 240,
-			127, 240, 81, 54, 184, 24, 220, 61, 243, 124, 222, 194, 248, 214,
-			171, 104, 158, 239, 209, 248, 24, 236, 202, 79, 88, 79, 156, 166,
-			11, 110, 163, 217, 10, 88, 76, 164, 17, 183, 213, 149, 82, 225,
-			71, 232, 61, 32, 65, 51, 179, 247, 100, 133, 227, 18, 189, 20,
-			58, 144, 226, 228, 51, 114, 125, 125, 22, 172, 11, 230, 205, 224,
-			231, 203, 107, 75, 75, 179, 179, 125, 223, 67, 25, 158, 57, 62,
-			123, 99, 12, 167, 147, 151, 195, 105, 147, 5, 208, 138, 91, 171,
-			154, 237, 24, 110, 98, 79, 2, 126, 218, 50, 109, 26, 108, 137,
-			30, 59, 94, 127, 117, 176, 149, 161, 136, 208, 141, 47, 118, 72,
-			91, 217, 96, 11, 160, 75, 141, 136, 191, 212, 242, 89, 133, 30,
-			165, 39, 142, 31, 239, 28, 225, 169, 29, 71, 120, 187, 229, 156,
-			58, 73, 239, 57, 203, 130, 82, 219, 15, 88, 3, 126, 206, 249,
-			103, 44, 155, 149, 59, 25, 113, 166, 176, 148, 47, 23, 206, 231,
-			105, 45, 16, 104, 236, 244, 205, 171, 107, 129, 196, 116, 173, 176,
-			92, 190, 254, 90, 26, 88, 149, 11, 62, 125, 61, 157, 153, 153,
-			225, 79, 102, 107, 65, 182, 186, 125, 206, 218, 172, 47, 154, 1,
-			126, 53, 75, 95, 247, 58, 122, 234, 228, 44, 253, 95, 40, 254,
-			182, 228, 110, 203, 159, 36, 221, 230, 230, 104, 14, 240, 173, 98,
-			186, 10, 171, 114, 1, 102, 214, 137, 227, 199, 99, 122, 201, 207,
-			134, 47, 48, 212, 71, 39, 174, 239, 157, 114, 97, 107, 240, 249,
-			137, 235, 175, 189, 246, 218, 215, 156, 186, 254, 248, 241, 112, 254,
-			139, 107, 250, 107, 142, 117, 81, 182, 114, 195, 107, 142, 119, 183,
-			146, 125, 113, 204, 156, 225, 227, 167, 51, 51, 156, 40, 115, 200,
-			44, 248, 55, 75, 143, 197, 209, 185, 140, 4, 67, 59, 64, 46,
-			217, 206, 213, 177, 118, 80, 0, 102, 59, 4, 224, 218, 29, 5,
-			224, 22, 115, 203, 164, 247, 112, 70, 102, 43, 45, 207, 99, 78,
-			0, 175, 156, 183, 108, 219, 242, 99, 2, 0, 234, 146, 54, 240,
-			41, 125, 61, 221, 249, 131, 75, 136, 57, 125, 125, 244, 52, 235,
-			176, 237, 249, 150, 101, 87, 153, 55, 51, 11, 3, 43, 9, 10,
-			137, 46, 56, 97, 102, 101, 176, 4, 165, 240, 206, 50, 31, 187,
-			229, 4, 48, 114, 241, 38, 31, 186, 24, 54, 82, 96, 54, 187,
-			1, 45, 35, 46, 17, 13, 174, 219, 145, 6, 98, 20, 210, 136,
-			210, 213, 118, 80, 23, 219, 31, 113, 242, 199, 209, 159, 153, 237,
-			230, 205, 89, 22, 44, 68, 212, 152, 153, 69, 13, 136, 27, 9,
-			231, 205, 102, 211, 114, 54, 9, 161, 5, 135, 63, 225, 43, 82,
-			30, 4, 28, 163, 83, 187, 201, 58, 173, 88, 180, 103, 198, 23,
-			46, 132, 222, 37, 53, 248, 243, 84, 196, 162, 171, 44, 45, 243,
-			76, 69, 25, 222, 12, 127, 10, 157, 165, 239, 3, 35, 122, 255,
-			177, 251, 26, 174, 19, 212, 239, 63, 118, 95, 213, 108, 223, 95,
-			190, 175, 238, 182, 188, 251, 79, 223, 215, 176, 156, 251, 79, 223,
-			231, 179, 202, 253, 119, 101, 239, 3, 199, 0, 4, 249, 254, 187,
-			239, 76, 19, 186, 141, 55, 217, 248, 215, 252, 2, 254, 182, 217,
-			246, 101, 254, 172, 208, 238, 215, 220, 22, 230, 114, 178, 2, 31,
-			44, 188, 205, 168, 232, 41, 67, 177, 171, 12, 161, 188, 179, 12,
-			197, 222, 120, 6, 41, 236, 18, 45, 241, 189, 204, 115, 143, 53,
-			205, 106, 85, 220, 99, 222, 118, 101, 107, 204, 172, 212, 249, 190,
-			189, 244, 88, 76, 59, 180, 238, 153, 216, 190, 243, 166, 75, 91,
-			120, 58, 113, 131, 252, 148, 95, 184, 231, 15, 79, 244, 247, 107,
-			102, 51, 4, 251, 119, 197, 29, 33, 222, 83, 250, 206, 52, 245,
-			91, 181, 154, 117, 49, 182, 49, 140, 169, 133, 172, 6, 3, 55,
-			139, 206, 164, 215, 202, 11, 233, 217, 27, 59, 158, 146, 120, 92,
-			188, 216, 168, 14, 220, 83, 92, 24, 124, 92, 111, 90, 247, 50,
-			79, 134, 233, 11, 82, 182, 124, 134, 174, 213, 12, 230, 97, 168,
-			138, 228, 21, 27, 109, 2, 104, 204, 242, 120, 239, 166, 103, 57,
-			129, 188, 57, 216, 37, 74, 38, 110, 137, 197, 187, 194, 171, 166,
-			94, 60, 167, 17, 122, 49, 129, 139, 217, 92, 154, 1, 191, 235,
-			4, 125, 194, 183, 124, 65, 44, 199, 224, 247, 224, 129, 23, 101,
-			106, 53, 159, 113, 123, 223, 113, 42, 147, 62, 121, 252, 196, 107,
-			64, 103, 158, 184, 174, 124, 252, 196, 233, 83, 199, 79, 159, 184,
-			46, 123, 252, 196, 157, 105, 33, 221, 62, 69, 56, 84, 186, 77,
-			211, 15, 8, 197, 55, 177, 127, 215, 161, 183, 152, 78, 203, 244,
-			218, 244, 196, 117, 25, 76, 165, 151, 21, 19, 200, 220, 50, 75,
-			120, 222, 147, 193, 173, 252, 184, 179, 99, 210, 69, 204, 46, 17,
-			203, 250, 4, 222, 23, 23, 246, 152, 31, 234, 7, 38, 120, 147,
-			85, 122, 87, 224, 22, 74, 43, 37, 156, 99, 51, 179, 209, 156,
-			10, 55, 124, 178, 13, 247, 94, 203, 182, 77, 156, 92, 204, 57,
-			182, 86, 154, 171, 186, 21, 127, 238, 118, 182, 49, 23, 97, 50,
-			87, 148, 145, 252, 115, 103, 109, 119, 195, 180, 215, 121, 210, 67,
-			127, 14, 240, 153, 139, 117, 114, 55, 9, 3, 197, 10, 82, 209,
-			240, 227, 15, 129, 209, 61, 224, 153, 161, 27, 45, 255, 184, 71,
-			142, 71, 156, 161, 136, 193, 50, 112, 66, 251, 141, 240, 174, 123,
-			252, 192, 171, 225, 151, 177, 1, 185, 21, 63, 219, 228, 122, 13,
-			134, 114, 114, 206, 182, 54, 60, 211, 107, 227, 198, 92, 182, 30,
-			52, 236, 171, 240, 47, 249, 237, 108, 236, 92, 11, 245, 162, 232,
-			195, 111, 178, 10, 61, 114, 245, 29, 199, 174, 110, 28, 187, 186,
-			90, 190, 250, 220, 233, 171, 207, 159, 190, 186, 148, 189, 186, 118,
-			231, 145, 44, 93, 178, 46, 176, 109, 203, 199, 99, 57, 228, 20,
-			242, 136, 167, 137, 146, 153, 108, 110, 113, 171, 38, 138, 234, 17,
-			159, 222, 117, 79, 161, 180, 34, 13, 253, 25, 174, 170, 170, 2,
-			156, 153, 189, 231, 238, 25, 18, 79, 61, 248, 163, 110, 149, 51,
-			2, 254, 56, 6, 88, 205, 153, 77, 11, 249, 33, 159, 226, 112,
-			230, 56, 174, 115, 189, 109, 227, 56, 101, 7, 199, 142, 17, 58,
-			11, 52, 12, 147, 32, 242, 239, 2, 6, 43, 165, 38, 78, 13,
-			183, 38, 243, 63, 161, 240, 200, 9, 230, 203, 204, 119, 82, 191,
-			18, 18, 29, 211, 221, 59, 184, 151, 188, 79, 145, 231, 116, 63,
-			174, 238, 75, 189, 83, 137, 31, 2, 72, 153, 119, 107, 40, 234,
-			72, 93, 158, 58, 35, 242, 57, 72, 127, 167, 131, 158, 111, 249,
-			120, 80, 138, 118, 107, 135, 5, 5, 233, 183, 162, 184, 147, 231,
-			14, 240, 173, 45, 214, 113, 20, 248, 227, 234, 189, 97, 210, 135,
-			4, 96, 187, 43, 118, 20, 248, 227, 131, 123, 98, 71, 129, 63,
-			110, 140, 147, 175, 43, 34, 235, 131, 254, 160, 162, 26, 169, 255,
-			93, 161, 203, 174, 115, 204, 97, 155, 252, 164, 182, 99, 237, 104,
-			138, 145, 82, 88, 62, 245, 211, 177, 89, 186, 44, 62, 148, 122,
-			91, 236, 150, 115, 209, 139, 26, 195, 141, 65, 158, 109, 11, 47,
-			218, 59, 241, 62, 177, 105, 241, 161, 72, 147, 80, 193, 251, 254,
-			53, 215, 131, 149, 162, 92, 30, 119, 19, 79, 44, 189, 50, 226,
-			255, 72, 7, 129, 70, 101, 106, 138, 196, 131, 138, 250, 227, 251,
-			100, 170, 10, 126, 19, 127, 151, 4, 145, 12, 131, 187, 37, 136,
-			55, 241, 199, 246, 134, 59, 246, 15, 255, 146, 74, 142, 63, 191,
-			148, 200, 30, 107, 186, 241, 156, 200, 169, 75, 93, 92, 73, 93,
-			50, 52, 51, 117, 185, 83, 130, 212, 11, 77, 211, 156, 62, 66,
-			118, 175, 122, 172, 102, 93, 148, 89, 124, 39, 73, 178, 137, 15,
-			68, 96, 166, 128, 210, 191, 168, 146, 81, 254, 230, 121, 22, 152,
-			85, 51, 48, 119, 122, 213, 160, 100, 184, 102, 57, 155, 176, 96,
-			182, 28, 153, 187, 57, 254, 200, 184, 145, 12, 183, 154, 48, 89,
-			215, 97, 0, 24, 73, 217, 47, 142, 49, 180, 122, 69, 194, 95,
-			135, 7, 198, 225, 240, 227, 150, 207, 60, 140, 177, 28, 146, 47,
-			172, 249, 204, 51, 50, 68, 55, 43, 182, 63, 157, 192, 128, 70,
-			145, 139, 182, 19, 247, 108, 110, 97, 169, 136, 111, 165, 242, 68,
-			203, 45, 44, 25, 135, 136, 238, 185, 182, 140, 28, 37, 252, 163,
-			162, 107, 179, 34, 62, 55, 14, 17, 2, 184, 87, 172, 166, 105,
-			251, 211, 42, 213, 160, 211, 232, 73, 122, 157, 76, 21, 156, 58,
-			243, 172, 128, 85, 187, 232, 180, 72, 198, 155, 204, 91, 231, 212,
-			89, 111, 136, 199, 34, 222, 114, 95, 63, 244, 138, 123, 155, 204,
-			235, 124, 148, 254, 9, 133, 76, 0, 62, 126, 193, 145, 28, 227,
-			225, 202, 198, 77, 36, 1, 40, 202, 8, 206, 153, 8, 247, 158,
-			119, 241, 105, 248, 144, 127, 150, 202, 146, 145, 248, 227, 203, 145,
-			34, 221, 36, 123, 151, 44, 63, 120, 94, 114, 99, 92, 65, 134,
-			194, 179, 126, 20, 133, 193, 98, 244, 192, 184, 154, 140, 138, 196,
-			39, 235, 117, 171, 90, 101, 14, 202, 194, 96, 113, 183, 120, 122,
-			14, 31, 166, 151, 136, 17, 239, 81, 140, 59, 69, 6, 69, 46,
-			82, 62, 244, 161, 98, 8, 227, 111, 248, 54, 147, 204, 10, 225,
-			244, 81, 50, 186, 202, 223, 147, 200, 79, 147, 93, 226, 75, 129,
-			189, 4, 211, 159, 80, 200, 96, 65, 196, 124, 239, 252, 154, 113,
-			13, 25, 148, 145, 225, 59, 37, 208, 14, 95, 48, 174, 34, 187,
-			61, 182, 105, 249, 1, 243, 88, 117, 125, 163, 205, 35, 137, 139,
-			35, 209, 195, 249, 182, 241, 134, 142, 151, 2, 95, 164, 168, 190,
-			212, 36, 137, 53, 80, 246, 211, 143, 43, 100, 186, 40, 30, 200,
-			17, 132, 164, 59, 30, 102, 201, 230, 76, 22, 147, 132, 191, 207,
-			207, 150, 187, 114, 101, 31, 237, 25, 161, 200, 75, 30, 182, 29,
-			13, 240, 36, 25, 10, 51, 129, 139, 201, 221, 63, 231, 114, 113,
-			80, 166, 5, 79, 219, 100, 31, 176, 88, 182, 230, 95, 150, 53,
-			198, 1, 50, 212, 52, 55, 217, 186, 111, 221, 203, 166, 247, 225,
-			233, 230, 32, 60, 40, 89, 247, 50, 227, 32, 33, 248, 35, 222,
-			216, 157, 158, 224, 89, 194, 225, 73, 25, 30, 164, 27, 100, 162,
-			171, 55, 65, 152, 12, 25, 10, 19, 229, 138, 249, 212, 61, 206,
-			232, 5, 227, 213, 100, 143, 195, 46, 6, 235, 177, 174, 246, 97,
-			87, 187, 225, 241, 106, 216, 221, 207, 40, 100, 178, 196, 76, 175,
-			82, 127, 1, 227, 59, 72, 244, 192, 220, 228, 226, 59, 124, 114,
-			136, 99, 81, 54, 55, 139, 248, 248, 31, 52, 124, 151, 76, 245,
-			160, 243, 178, 18, 224, 211, 10, 209, 138, 172, 102, 24, 68, 199,
-			76, 251, 124, 168, 248, 119, 156, 2, 234, 206, 179, 74, 187, 220,
-			172, 58, 76, 134, 249, 241, 42, 159, 83, 194, 44, 200, 71, 243,
-			109, 48, 58, 225, 11, 129, 47, 2, 239, 47, 105, 116, 228, 235,
-			101, 63, 125, 51, 25, 91, 100, 54, 11, 24, 116, 42, 88, 247,
-			130, 6, 147, 190, 134, 236, 1, 145, 43, 178, 218, 229, 121, 159,
-			62, 65, 198, 162, 151, 5, 103, 14, 18, 221, 99, 53, 201, 148,
-			33, 57, 99, 107, 69, 124, 156, 254, 105, 133, 104, 101, 115, 179,
-			207, 181, 138, 125, 241, 107, 21, 67, 226, 250, 4, 208, 203, 12,
-			2, 179, 82, 143, 235, 32, 34, 31, 113, 122, 133, 47, 60, 47,
-			253, 19, 126, 92, 246, 211, 109, 178, 55, 135, 80, 217, 220, 124,
-			30, 178, 254, 130, 244, 167, 156, 24, 90, 223, 137, 1, 93, 47,
-			178, 127, 154, 174, 111, 37, 19, 69, 240, 129, 183, 152, 200, 242,
-			126, 249, 238, 167, 201, 174, 45, 254, 174, 20, 24, 1, 166, 223,
-			68, 38, 206, 178, 80, 69, 197, 138, 92, 188, 52, 99, 73, 63,
-			172, 146, 161, 85, 207, 173, 48, 223, 119, 61, 99, 148, 168, 97,
-			189, 5, 213, 170, 26, 215, 144, 4, 166, 48, 20, 69, 40, 38,
-			164, 155, 34, 222, 207, 130, 121, 96, 69, 254, 14, 136, 138, 188,
-			101, 14, 162, 242, 60, 252, 57, 249, 122, 217, 55, 230, 72, 146,
-			103, 187, 16, 34, 182, 211, 93, 152, 162, 120, 13, 228, 25, 195,
-			245, 68, 21, 6, 14, 164, 207, 144, 4, 226, 100, 76, 144, 189,
-			165, 114, 174, 156, 239, 42, 153, 48, 76, 118, 173, 230, 151, 163,
-			130, 9, 165, 181, 133, 133, 124, 126, 49, 191, 56, 166, 26, 132,
-			36, 121, 250, 199, 49, 45, 253, 37, 133, 76, 45, 138, 88, 208,
-			200, 110, 190, 164, 82, 116, 21, 217, 45, 163, 77, 215, 113, 74,
-			115, 167, 103, 68, 62, 132, 105, 223, 241, 18, 202, 156, 222, 249,
-			18, 72, 183, 49, 71, 198, 195, 151, 154, 146, 55, 92, 183, 13,
-			22, 13, 249, 83, 200, 53, 63, 253, 113, 133, 76, 247, 142, 78,
-			104, 152, 184, 141, 87, 46, 99, 227, 165, 54, 82, 251, 106, 163,
-			203, 76, 20, 99, 14, 188, 233, 16, 93, 29, 95, 218, 211, 37,
-			98, 197, 216, 43, 48, 25, 36, 218, 60, 105, 239, 75, 60, 25,
-			254, 157, 74, 38, 187, 59, 120, 17, 84, 201, 18, 194, 243, 114,
-			0, 95, 119, 44, 165, 82, 17, 29, 212, 140, 107, 201, 110, 241,
-			62, 143, 150, 233, 103, 230, 96, 230, 143, 240, 183, 230, 241, 37,
-			80, 221, 226, 43, 52, 254, 58, 70, 124, 137, 142, 209, 252, 31,
-			38, 195, 54, 219, 52, 43, 237, 117, 191, 110, 158, 16, 243, 132,
-			240, 71, 165, 186, 121, 194, 120, 61, 49, 34, 60, 215, 77, 219,
-			50, 125, 230, 79, 39, 227, 124, 136, 240, 29, 11, 241, 205, 241,
-			23, 143, 190, 129, 232, 176, 30, 48, 246, 145, 177, 226, 202, 82,
-			247, 76, 35, 36, 89, 204, 231, 22, 243, 69, 94, 227, 228, 246,
-			98, 161, 156, 47, 142, 169, 198, 16, 73, 96, 20, 198, 152, 118,
-			212, 39, 70, 175, 175, 105, 92, 69, 14, 23, 243, 103, 11, 165,
-			114, 49, 87, 46, 172, 44, 247, 47, 125, 50, 74, 8, 127, 41,
-			95, 204, 47, 142, 41, 198, 36, 49, 100, 134, 176, 216, 115, 213,
-			24, 35, 35, 203, 43, 229, 117, 158, 227, 22, 102, 246, 201, 255,
-			72, 8, 41, 134, 69, 11, 140, 155, 200, 222, 179, 44, 232, 90,
-			171, 141, 199, 151, 99, 178, 96, 72, 223, 53, 154, 177, 74, 82,
-			168, 159, 251, 47, 250, 250, 54, 116, 80, 10, 78, 255, 111, 230,
-			201, 190, 53, 92, 198, 118, 61, 239, 219, 255, 14, 88, 45, 146,
-			177, 179, 44, 232, 88, 252, 245, 199, 229, 192, 37, 150, 137, 198,
-			27, 8, 137, 22, 92, 198, 20, 127, 181, 103, 209, 151, 154, 238,
-			253, 65, 52, 112, 35, 25, 62, 103, 85, 153, 88, 103, 133, 35,
-			232, 88, 118, 165, 122, 239, 26, 226, 157, 86, 227, 245, 100, 247,
-			154, 83, 255, 135, 124, 206, 125, 181, 23, 247, 249, 60, 25, 235,
-			94, 55, 25, 93, 51, 62, 117, 40, 190, 94, 234, 179, 190, 58,
-			71, 118, 119, 172, 47, 140, 84, 68, 169, 238, 37, 128, 228, 68,
-			255, 5, 201, 50, 217, 211, 229, 170, 27, 87, 240, 247, 251, 47,
-			40, 164, 140, 237, 228, 223, 103, 201, 208, 2, 230, 226, 1, 245,
-			19, 169, 237, 29, 169, 113, 35, 25, 10, 29, 95, 99, 146, 191,
-			223, 237, 9, 239, 248, 241, 13, 100, 80, 186, 177, 198, 68, 52,
-			202, 152, 15, 156, 154, 236, 126, 44, 240, 124, 61, 33, 145, 3,
-			41, 37, 176, 199, 165, 188, 132, 12, 144, 200, 9, 148, 159, 247,
-			184, 133, 151, 248, 124, 180, 211, 145, 51, 228, 116, 233, 231, 222,
-			165, 186, 196, 195, 184, 137, 140, 118, 186, 110, 242, 243, 190, 14,
-			93, 111, 57, 165, 55, 194, 106, 163, 211, 72, 27, 7, 229, 24,
-			250, 186, 38, 82, 34, 119, 180, 237, 183, 146, 209, 78, 251, 38,
-			81, 234, 107, 86, 83, 87, 244, 255, 145, 55, 38, 75, 37, 189,
-			237, 255, 81, 120, 173, 164, 15, 37, 95, 169, 149, 244, 74, 173,
-			164, 127, 140, 90, 73, 67, 68, 213, 6, 12, 109, 223, 174, 87,
-			225, 159, 138, 161, 77, 236, 186, 26, 255, 84, 13, 109, 114, 215,
-			12, 254, 169, 25, 218, 212, 174, 215, 146, 191, 26, 199, 186, 74,
-			137, 179, 3, 95, 87, 149, 212, 151, 198, 233, 121, 211, 193, 11,
-			100, 241, 186, 72, 97, 92, 177, 229, 81, 211, 247, 221, 10, 175,
-			69, 35, 119, 105, 177, 200, 70, 84, 67, 9, 239, 228, 152, 13,
-			86, 165, 21, 215, 182, 89, 69, 214, 103, 193, 155, 71, 162, 178,
-			133, 88, 191, 137, 131, 146, 77, 207, 109, 53, 249, 173, 58, 172,
-			204, 20, 107, 77, 28, 214, 138, 84, 21, 118, 20, 40, 12, 132,
-			149, 55, 62, 162, 24, 96, 16, 101, 215, 193, 8, 64, 143, 96,
-			44, 60, 38, 90, 4, 233, 7, 193, 117, 54, 109, 87, 222, 11,
-			201, 198, 113, 22, 154, 128, 35, 95, 113, 157, 138, 199, 2, 120,
-			92, 49, 61, 135, 167, 162, 21, 71, 58, 173, 74, 93, 34, 127,
-			90, 212, 146, 48, 169, 199, 68, 129, 168, 76, 172, 196, 68, 152,
-			80, 15, 197, 218, 10, 124, 202, 11, 217, 209, 25, 64, 173, 116,
-			46, 119, 2, 199, 224, 218, 192, 95, 73, 232, 89, 81, 248, 169,
-			51, 68, 113, 163, 77, 67, 79, 83, 92, 179, 147, 17, 17, 50,
-			10, 95, 242, 32, 76, 145, 38, 207, 213, 195, 242, 56, 189, 45,
-			4, 110, 44, 194, 194, 231, 249, 216, 120, 230, 58, 204, 207, 151,
-			14, 169, 82, 88, 76, 199, 6, 38, 227, 180, 197, 0, 219, 253,
-			170, 103, 53, 92, 63, 176, 219, 120, 59, 17, 68, 23, 67, 3,
-			107, 102, 5, 43, 136, 184, 177, 124, 251, 40, 101, 21, 183, 209,
-			128, 230, 109, 203, 145, 41, 251, 120, 245, 8, 76, 144, 7, 109,
-			116, 90, 20, 90, 92, 93, 152, 197, 98, 6, 71, 241, 216, 157,
-			251, 236, 192, 5, 31, 68, 10, 73, 203, 201, 40, 170, 150, 136,
-			136, 18, 84, 110, 157, 201, 217, 241, 39, 208, 39, 92, 123, 241,
-			168, 9, 17, 128, 32, 210, 75, 2, 169, 175, 61, 78, 43, 117,
-			211, 163, 185, 210, 66, 161, 32, 3, 7, 103, 179, 28, 133, 21,
-			212, 6, 253, 58, 228, 39, 210, 27, 166, 207, 174, 191, 118, 134,
-			191, 64, 175, 161, 119, 221, 189, 209, 14, 216, 125, 30, 171, 133,
-			245, 42, 239, 159, 13, 17, 96, 30, 163, 71, 248, 39, 152, 141,
-			117, 173, 184, 116, 204, 55, 107, 60, 158, 161, 229, 136, 88, 16,
-			204, 195, 122, 13, 190, 0, 210, 106, 6, 204, 49, 99, 97, 249,
-			64, 21, 33, 109, 18, 47, 84, 252, 241, 33, 93, 219, 103, 72,
-			252, 252, 144, 215, 220, 9, 234, 116, 129, 7, 29, 8, 41, 192,
-			201, 137, 197, 109, 124, 102, 111, 137, 144, 210, 123, 173, 38, 5,
-			39, 202, 218, 146, 231, 150, 120, 207, 213, 172, 242, 27, 9, 166,
-			29, 211, 18, 96, 225, 218, 34, 101, 44, 222, 113, 224, 227, 168,
-			184, 142, 223, 106, 112, 153, 0, 131, 74, 23, 150, 10, 161, 24,
-			200, 11, 13, 50, 125, 48, 23, 44, 18, 166, 39, 174, 64, 115,
-			230, 134, 219, 10, 132, 126, 234, 147, 118, 156, 16, 42, 220, 90,
-			113, 11, 193, 118, 221, 11, 252, 134, 172, 231, 186, 1, 154, 32,
-			52, 137, 62, 134, 117, 137, 186, 12, 51, 44, 187, 153, 165, 105,
-			115, 110, 99, 174, 146, 158, 197, 0, 24, 210, 157, 230, 179, 67,
-			233, 113, 169, 193, 219, 23, 45, 219, 198, 86, 240, 43, 89, 65,
-			204, 113, 195, 42, 106, 105, 44, 155, 98, 109, 49, 222, 87, 26,
-			239, 116, 166, 179, 89, 254, 135, 233, 180, 69, 244, 137, 111, 53,
-			44, 152, 204, 97, 13, 9, 159, 7, 226, 200, 139, 22, 168, 2,
-			108, 211, 15, 176, 157, 152, 246, 171, 155, 216, 165, 229, 0, 95,
-			125, 171, 130, 249, 231, 241, 90, 13, 112, 146, 27, 176, 12, 13,
-			234, 88, 8, 134, 79, 107, 183, 22, 48, 135, 132, 179, 187, 202,
-			28, 55, 96, 48, 68, 219, 12, 240, 126, 74, 188, 174, 157, 197,
-			235, 86, 56, 34, 246, 183, 227, 82, 50, 1, 205, 81, 243, 204,
-			185, 192, 117, 109, 159, 159, 167, 218, 150, 211, 186, 120, 204, 108,
-			84, 175, 191, 54, 189, 83, 35, 116, 9, 94, 162, 94, 203, 65,
-			171, 8, 150, 26, 222, 39, 92, 190, 2, 38, 114, 200, 203, 140,
-			144, 60, 17, 46, 143, 7, 113, 196, 101, 161, 80, 71, 129, 194,
-			103, 14, 150, 99, 173, 82, 7, 20, 46, 218, 81, 63, 112, 221,
-			62, 5, 230, 104, 222, 172, 212, 41, 179, 89, 67, 196, 142, 199,
-			135, 138, 34, 211, 4, 221, 53, 195, 243, 71, 130, 106, 148, 63,
-			34, 217, 197, 233, 47, 176, 14, 144, 34, 52, 45, 158, 204, 98,
-			228, 7, 158, 217, 227, 172, 144, 83, 129, 202, 77, 96, 84, 163,
-			65, 150, 138, 208, 57, 208, 152, 177, 42, 7, 174, 3, 146, 110,
-			58, 178, 216, 20, 94, 154, 113, 109, 126, 239, 103, 38, 183, 176,
-			52, 43, 74, 134, 137, 140, 255, 62, 230, 213, 166, 120, 96, 137,
-			211, 12, 43, 87, 241, 94, 120, 198, 237, 22, 214, 69, 144, 62,
-			9, 207, 16, 204, 113, 229, 225, 82, 40, 182, 194, 228, 35, 193,
-			28, 6, 3, 196, 236, 252, 157, 17, 78, 216, 52, 86, 18, 196,
-			93, 12, 236, 84, 100, 143, 242, 110, 22, 111, 101, 43, 110, 3,
-			84, 77, 110, 97, 41, 238, 54, 128, 150, 32, 178, 91, 49, 191,
-			16, 23, 191, 247, 235, 200, 53, 226, 215, 56, 205, 14, 36, 73,
-			204, 228, 184, 181, 176, 45, 28, 138, 211, 142, 134, 178, 193, 108,
-			119, 27, 72, 27, 159, 211, 115, 85, 152, 213, 224, 175, 193, 242,
-			158, 224, 149, 81, 36, 141, 188, 86, 133, 116, 20, 87, 39, 229,
-			28, 174, 89, 14, 88, 235, 8, 43, 160, 3, 216, 91, 43, 0,
-			107, 231, 91, 85, 214, 57, 79, 48, 225, 49, 22, 255, 200, 114,
-			209, 196, 187, 100, 88, 44, 204, 146, 193, 103, 54, 96, 39, 135,
-			135, 130, 18, 179, 227, 221, 198, 53, 19, 221, 148, 51, 229, 29,
-			175, 56, 105, 58, 40, 18, 67, 37, 86, 71, 241, 236, 160, 65,
-			126, 160, 202, 58, 138, 171, 234, 205, 169, 255, 75, 13, 43, 22,
-			69, 50, 218, 201, 177, 30, 137, 225, 133, 133, 208, 113, 231, 90,
-			143, 39, 114, 14, 203, 65, 240, 203, 68, 40, 23, 181, 48, 121,
-			24, 46, 156, 240, 34, 31, 114, 95, 42, 187, 26, 129, 33, 195,
-			228, 147, 7, 208, 25, 174, 1, 183, 45, 153, 149, 30, 198, 86,
-			195, 252, 4, 136, 77, 79, 190, 231, 232, 118, 186, 172, 125, 199,
-			209, 1, 77, 200, 81, 137, 113, 13, 214, 49, 216, 111, 111, 183,
-			27, 220, 146, 144, 94, 100, 133, 78, 221, 145, 64, 150, 8, 105,
-			237, 66, 149, 196, 74, 46, 73, 28, 163, 242, 145, 171, 201, 169,
-			88, 249, 200, 213, 233, 171, 99, 229, 35, 87, 143, 223, 68, 126,
-			168, 201, 242, 145, 119, 169, 197, 212, 183, 180, 23, 206, 167, 216,
-			204, 238, 24, 234, 63, 91, 254, 45, 131, 221, 225, 57, 169, 123,
-			56, 217, 169, 15, 124, 177, 216, 10, 105, 17, 94, 231, 238, 198,
-			52, 195, 235, 50, 97, 113, 0, 158, 118, 153, 117, 125, 214, 135,
-			66, 25, 34, 2, 211, 132, 77, 225, 87, 93, 96, 198, 250, 62,
-			107, 224, 236, 149, 73, 247, 123, 80, 144, 20, 154, 177, 2, 18,
-			105, 116, 75, 110, 106, 130, 7, 212, 100, 94, 0, 74, 27, 227,
-			177, 250, 116, 63, 219, 81, 250, 239, 174, 36, 141, 149, 254, 187,
-			235, 202, 108, 172, 244, 223, 93, 55, 172, 146, 39, 118, 201, 210,
-			127, 111, 86, 23, 83, 31, 222, 69, 249, 46, 41, 46, 79, 43,
-			184, 153, 245, 255, 135, 185, 93, 142, 23, 107, 172, 179, 202, 5,
-			159, 30, 137, 5, 77, 29, 233, 44, 101, 215, 185, 237, 27, 38,
-			59, 41, 8, 199, 169, 135, 103, 157, 211, 166, 171, 238, 70, 120,
-			181, 54, 234, 46, 92, 133, 137, 193, 135, 94, 41, 86, 60, 224,
-			99, 19, 193, 245, 177, 175, 50, 113, 122, 117, 40, 138, 126, 229,
-			213, 186, 213, 90, 136, 116, 71, 117, 54, 218, 198, 122, 2, 129,
-			223, 137, 158, 47, 42, 5, 200, 210, 188, 24, 69, 71, 132, 183,
-			159, 165, 37, 151, 54, 77, 95, 230, 70, 140, 127, 137, 209, 213,
-			40, 54, 60, 252, 222, 10, 172, 184, 144, 115, 74, 250, 189, 218,
-			22, 154, 99, 125, 26, 12, 245, 106, 76, 6, 59, 233, 75, 118,
-			160, 74, 119, 105, 147, 62, 106, 34, 86, 165, 23, 203, 168, 130,
-			243, 106, 195, 250, 61, 194, 87, 204, 98, 44, 9, 195, 179, 172,
-			103, 233, 170, 137, 213, 79, 100, 154, 204, 190, 194, 130, 57, 49,
-			193, 87, 4, 164, 226, 85, 133, 171, 174, 195, 120, 177, 94, 234,
-			183, 184, 123, 38, 83, 86, 246, 111, 40, 156, 103, 60, 8, 175,
-			26, 167, 78, 71, 1, 196, 55, 39, 83, 177, 2, 136, 111, 62,
-			48, 27, 43, 128, 248, 230, 107, 231, 201, 131, 186, 44, 128, 248,
-			99, 234, 153, 212, 223, 69, 22, 193, 228, 249, 93, 132, 227, 210,
-			173, 57, 197, 221, 239, 238, 89, 190, 230, 224, 58, 168, 231, 144,
-			71, 148, 226, 229, 121, 128, 132, 34, 224, 179, 94, 56, 163, 51,
-			161, 106, 20, 227, 38, 212, 164, 182, 139, 8, 240, 76, 66, 88,
-			104, 130, 7, 8, 227, 141, 2, 218, 125, 230, 18, 134, 116, 3,
-			150, 34, 164, 190, 45, 232, 90, 8, 164, 199, 110, 202, 155, 153,
-			236, 98, 19, 255, 230, 195, 19, 170, 148, 187, 68, 220, 189, 234,
-			144, 68, 139, 39, 56, 66, 156, 229, 140, 131, 197, 111, 204, 6,
-			136, 106, 175, 148, 31, 190, 97, 31, 194, 155, 157, 225, 217, 79,
-			219, 178, 196, 159, 141, 229, 180, 54, 218, 162, 180, 146, 233, 240,
-			118, 103, 227, 197, 60, 185, 20, 117, 176, 160, 215, 126, 57, 110,
-			199, 110, 159, 184, 122, 224, 251, 114, 51, 135, 107, 158, 0, 205,
-			129, 235, 241, 237, 38, 241, 180, 187, 2, 104, 84, 13, 241, 199,
-			146, 147, 177, 106, 136, 63, 54, 245, 170, 88, 53, 196, 31, 155,
-			91, 36, 143, 113, 211, 192, 203, 48, 229, 82, 15, 239, 162, 75,
-			88, 79, 184, 99, 119, 207, 111, 109, 72, 253, 76, 163, 24, 185,
-			216, 246, 99, 36, 51, 252, 107, 177, 11, 138, 53, 195, 69, 109,
-			220, 42, 243, 43, 204, 169, 98, 161, 35, 215, 139, 242, 23, 217,
-			109, 28, 80, 236, 103, 177, 156, 22, 44, 193, 91, 252, 152, 247,
-			202, 102, 23, 173, 138, 187, 233, 153, 205, 58, 223, 239, 235, 89,
-			120, 128, 174, 14, 17, 191, 43, 109, 166, 51, 232, 207, 243, 255,
-			128, 83, 47, 254, 96, 115, 181, 244, 221, 25, 116, 230, 69, 202,
-			174, 180, 153, 38, 178, 60, 144, 216, 61, 186, 79, 182, 116, 26,
-			154, 154, 219, 128, 47, 36, 21, 248, 163, 74, 250, 238, 251, 129,
-			143, 178, 29, 199, 117, 142, 197, 198, 149, 221, 161, 157, 29, 176,
-			233, 110, 91, 254, 202, 59, 17, 55, 205, 68, 79, 29, 189, 68,
-			98, 134, 244, 238, 224, 92, 200, 182, 45, 43, 92, 83, 68, 98,
-			39, 22, 204, 188, 24, 162, 79, 217, 22, 44, 242, 81, 189, 203,
-			142, 76, 41, 97, 189, 178, 42, 248, 202, 23, 23, 178, 226, 113,
-			103, 6, 50, 210, 193, 102, 217, 38, 206, 55, 215, 149, 54, 159,
-			147, 93, 212, 108, 238, 88, 101, 118, 246, 73, 186, 186, 162, 51,
-			224, 112, 243, 59, 9, 188, 154, 140, 168, 241, 96, 49, 207, 244,
-			42, 245, 118, 52, 75, 177, 166, 57, 171, 206, 102, 112, 136, 72,
-			205, 222, 241, 136, 233, 221, 185, 18, 144, 184, 226, 53, 234, 109,
-			215, 187, 128, 5, 78, 58, 230, 46, 191, 194, 81, 243, 24, 140,
-			145, 215, 23, 20, 42, 37, 44, 239, 47, 34, 239, 69, 253, 104,
-			94, 73, 63, 90, 125, 9, 117, 82, 1, 149, 4, 174, 39, 240,
-			77, 214, 115, 101, 182, 47, 46, 37, 192, 36, 197, 82, 103, 201,
-			49, 9, 98, 169, 179, 189, 105, 9, 106, 0, 30, 123, 3, 249,
-			151, 124, 249, 150, 48, 244, 95, 80, 212, 249, 212, 119, 21, 26,
-			59, 73, 166, 13, 19, 6, 17, 95, 135, 154, 62, 229, 177, 195,
-			161, 19, 229, 177, 176, 2, 46, 58, 160, 252, 132, 65, 158, 81,
-			203, 157, 66, 110, 183, 165, 234, 137, 220, 54, 179, 86, 99, 21,
-			240, 108, 3, 95, 84, 235, 153, 137, 150, 184, 226, 174, 2, 86,
-			148, 52, 55, 108, 54, 219, 225, 78, 246, 241, 29, 195, 221, 20,
-			174, 98, 66, 89, 239, 113, 4, 73, 132, 66, 72, 177, 132, 2,
-			68, 72, 238, 149, 160, 10, 160, 65, 37, 168, 1, 120, 205, 205,
-			88, 158, 50, 57, 160, 242, 242, 92, 139, 169, 15, 42, 180, 227,
-			248, 188, 63, 205, 228, 140, 50, 55, 77, 75, 84, 192, 57, 2,
-			164, 219, 98, 152, 160, 164, 22, 167, 250, 203, 58, 200, 36, 214,
-			17, 75, 142, 75, 80, 5, 112, 159, 20, 139, 36, 150, 21, 59,
-			54, 79, 30, 229, 245, 191, 119, 25, 250, 199, 97, 144, 239, 74,
-			208, 142, 67, 254, 144, 233, 113, 124, 102, 76, 188, 219, 202, 43,
-			240, 219, 54, 178, 52, 220, 68, 200, 208, 192, 220, 244, 51, 212,
-			99, 53, 159, 80, 22, 84, 102, 35, 105, 137, 132, 191, 107, 147,
-			164, 229, 84, 221, 44, 93, 17, 213, 112, 227, 219, 125, 220, 47,
-			171, 102, 132, 156, 108, 186, 14, 210, 7, 232, 25, 10, 102, 84,
-			49, 169, 110, 242, 36, 15, 1, 115, 208, 253, 4, 31, 214, 99,
-			230, 5, 204, 78, 231, 182, 124, 218, 180, 28, 135, 85, 105, 221,
-			242, 3, 215, 195, 84, 230, 11, 133, 213, 69, 34, 19, 2, 240,
-			13, 107, 238, 183, 183, 124, 121, 148, 228, 184, 65, 120, 136, 84,
-			49, 253, 22, 88, 153, 12, 159, 176, 150, 67, 217, 197, 192, 99,
-			13, 198, 43, 94, 101, 9, 93, 112, 113, 83, 135, 239, 236, 193,
-			28, 146, 218, 45, 190, 47, 3, 58, 11, 221, 30, 155, 113, 127,
-			57, 220, 47, 227, 116, 226, 231, 18, 152, 204, 135, 187, 34, 241,
-			170, 80, 66, 98, 204, 106, 195, 146, 59, 125, 167, 57, 58, 238,
-			182, 195, 60, 31, 87, 80, 93, 202, 6, 85, 43, 168, 36, 225,
-			232, 74, 45, 211, 93, 236, 185, 119, 37, 213, 227, 36, 241, 163,
-			49, 108, 16, 251, 67, 139, 86, 88, 190, 45, 183, 84, 88, 92,
-			207, 21, 207, 174, 97, 226, 21, 241, 153, 116, 207, 97, 17, 102,
-			218, 224, 219, 225, 161, 103, 191, 170, 210, 130, 60, 221, 94, 11,
-			23, 216, 93, 10, 72, 104, 40, 206, 187, 84, 0, 67, 113, 222,
-			165, 1, 120, 108, 158, 124, 40, 137, 226, 60, 104, 232, 79, 131,
-			56, 255, 66, 146, 202, 96, 18, 63, 190, 247, 30, 30, 222, 57,
-			221, 116, 154, 137, 74, 121, 135, 133, 202, 184, 117, 36, 178, 246,
-			98, 184, 14, 1, 57, 217, 54, 69, 189, 213, 208, 255, 105, 179,
-			96, 22, 79, 120, 113, 9, 132, 137, 52, 176, 62, 126, 79, 237,
-			110, 44, 149, 31, 43, 101, 22, 207, 187, 200, 46, 178, 74, 43,
-			96, 93, 153, 153, 228, 209, 29, 114, 234, 68, 71, 129, 51, 137,
-			64, 191, 81, 110, 180, 195, 26, 250, 221, 161, 53, 200, 138, 147,
-			48, 245, 104, 60, 172, 43, 44, 60, 20, 43, 102, 21, 242, 7,
-			151, 98, 78, 53, 172, 182, 205, 137, 131, 255, 122, 74, 152, 241,
-			243, 172, 176, 112, 214, 234, 66, 84, 55, 206, 145, 245, 195, 215,
-			221, 102, 168, 34, 68, 59, 178, 102, 0, 162, 119, 42, 166, 25,
-			250, 213, 12, 15, 171, 126, 103, 248, 18, 164, 123, 140, 92, 23,
-			139, 227, 178, 88, 193, 72, 92, 133, 201, 253, 12, 62, 96, 26,
-			197, 186, 81, 199, 221, 142, 215, 214, 236, 56, 240, 21, 2, 16,
-			227, 250, 70, 27, 247, 97, 65, 61, 129, 117, 206, 68, 107, 156,
-			222, 48, 58, 209, 89, 116, 48, 129, 51, 42, 150, 45, 83, 74,
-			5, 94, 227, 195, 237, 19, 190, 58, 16, 203, 14, 215, 19, 214,
-			226, 50, 134, 130, 244, 153, 205, 189, 134, 98, 80, 129, 201, 146,
-			156, 148, 160, 10, 224, 212, 97, 9, 106, 0, 30, 157, 39, 95,
-			225, 214, 112, 200, 208, 191, 160, 168, 183, 164, 254, 64, 17, 78,
-			125, 199, 238, 113, 40, 124, 153, 142, 26, 246, 53, 203, 195, 169,
-			188, 227, 192, 228, 34, 202, 245, 184, 206, 155, 253, 135, 14, 77,
-			110, 50, 244, 85, 42, 33, 115, 34, 37, 20, 82, 99, 72, 129,
-			1, 134, 122, 102, 72, 5, 112, 223, 140, 4, 53, 0, 79, 157,
-			35, 79, 114, 111, 138, 96, 133, 175, 213, 212, 175, 69, 187, 225,
-			189, 71, 219, 232, 231, 241, 122, 199, 182, 45, 150, 211, 96, 35,
-			195, 51, 29, 192, 247, 141, 45, 230, 89, 226, 4, 167, 147, 166,
-			59, 109, 239, 243, 59, 249, 65, 92, 132, 8, 221, 196, 219, 212,
-			244, 205, 45, 230, 181, 103, 255, 191, 65, 113, 130, 117, 209, 146,
-			19, 18, 84, 1, 156, 204, 72, 16, 203, 164, 189, 102, 153, 252,
-			14, 95, 146, 14, 27, 250, 119, 20, 245, 186, 212, 199, 118, 209,
-			5, 177, 71, 201, 213, 171, 199, 106, 60, 86, 100, 75, 164, 158,
-			189, 40, 215, 113, 124, 123, 0, 43, 238, 242, 224, 139, 70, 139,
-			39, 35, 226, 33, 39, 152, 18, 138, 239, 84, 34, 169, 195, 15,
-			123, 244, 40, 207, 128, 43, 182, 32, 208, 27, 64, 119, 67, 4,
-			119, 196, 78, 122, 187, 50, 46, 167, 109, 192, 51, 192, 227, 190,
-			180, 143, 125, 167, 185, 221, 173, 113, 205, 31, 30, 24, 86, 67,
-			44, 122, 25, 158, 165, 121, 60, 138, 146, 141, 225, 112, 28, 18,
-			173, 23, 210, 57, 126, 144, 149, 158, 79, 83, 25, 217, 2, 182,
-			30, 87, 219, 53, 188, 111, 31, 80, 64, 17, 247, 144, 65, 96,
-			240, 76, 89, 24, 28, 179, 82, 23, 110, 36, 158, 126, 187, 34,
-			77, 130, 137, 253, 240, 146, 251, 33, 33, 248, 13, 111, 219, 110,
-			139, 250, 233, 60, 96, 67, 252, 152, 174, 90, 126, 197, 221, 98,
-			158, 24, 167, 240, 106, 112, 91, 8, 220, 26, 97, 113, 107, 146,
-			144, 60, 197, 176, 221, 230, 225, 90, 29, 147, 70, 236, 147, 213,
-			90, 182, 221, 38, 124, 167, 80, 28, 76, 184, 126, 112, 204, 139,
-			133, 58, 83, 17, 212, 110, 57, 155, 47, 218, 163, 17, 218, 181,
-			115, 59, 254, 37, 118, 110, 68, 219, 49, 145, 226, 180, 64, 33,
-			196, 245, 107, 151, 251, 67, 105, 223, 61, 94, 209, 108, 220, 40,
-			241, 37, 20, 223, 110, 18, 212, 16, 216, 228, 230, 87, 138, 229,
-			104, 220, 225, 71, 224, 45, 163, 176, 213, 248, 222, 91, 116, 49,
-			160, 223, 73, 149, 204, 44, 211, 99, 13, 25, 174, 236, 248, 106,
-			126, 195, 195, 90, 158, 184, 61, 212, 227, 60, 135, 83, 126, 88,
-			129, 89, 156, 220, 35, 65, 21, 192, 49, 169, 115, 135, 53, 0,
-			15, 157, 34, 223, 225, 38, 103, 196, 208, 127, 0, 75, 214, 191,
-			80, 104, 81, 172, 70, 80, 42, 197, 150, 46, 53, 17, 127, 112,
-			223, 249, 0, 229, 162, 2, 17, 21, 239, 253, 179, 21, 136, 254,
-			222, 238, 136, 2, 99, 14, 9, 52, 162, 2, 56, 38, 87, 168,
-			35, 26, 128, 215, 220, 76, 254, 59, 39, 208, 110, 67, 127, 64,
-			85, 111, 76, 253, 181, 180, 201, 176, 246, 226, 245, 157, 120, 237,
-			245, 254, 102, 25, 166, 175, 184, 138, 23, 89, 232, 23, 69, 40,
-			97, 76, 254, 9, 8, 181, 91, 129, 177, 39, 71, 37, 168, 2,
-			184, 231, 144, 4, 53, 0, 103, 111, 32, 223, 28, 70, 66, 141,
-			26, 250, 47, 169, 234, 98, 234, 207, 135, 41, 143, 121, 70, 131,
-			27, 197, 24, 162, 85, 238, 212, 117, 64, 147, 146, 101, 115, 114,
-			249, 23, 172, 102, 228, 253, 69, 33, 25, 230, 38, 63, 245, 71,
-			163, 238, 49, 154, 190, 192, 218, 167, 241, 62, 96, 154, 54, 77,
-			171, 207, 124, 138, 133, 12, 118, 155, 25, 25, 245, 20, 183, 51,
-			64, 89, 180, 233, 124, 155, 47, 244, 58, 35, 125, 137, 14, 185,
-			235, 73, 99, 68, 98, 89, 35, 78, 3, 41, 77, 192, 18, 205,
-			95, 44, 144, 4, 198, 142, 137, 107, 112, 237, 232, 196, 84, 67,
-			135, 47, 199, 23, 147, 240, 125, 203, 177, 222, 220, 130, 121, 222,
-			93, 251, 55, 252, 144, 111, 12, 87, 234, 248, 122, 183, 169, 116,
-			8, 197, 75, 49, 242, 180, 45, 22, 46, 24, 210, 47, 178, 45,
-			97, 214, 157, 240, 72, 138, 123, 55, 118, 59, 110, 48, 195, 93,
-			36, 110, 138, 124, 230, 248, 12, 19, 189, 32, 189, 194, 131, 218,
-			190, 68, 19, 172, 8, 93, 178, 12, 53, 43, 158, 235, 3, 139,
-			237, 200, 176, 206, 134, 39, 121, 109, 90, 117, 65, 6, 209, 143,
-			226, 231, 99, 156, 34, 167, 169, 111, 54, 56, 137, 68, 106, 218,
-			24, 153, 9, 109, 180, 236, 192, 194, 122, 105, 81, 188, 163, 32,
-			1, 126, 231, 122, 49, 11, 29, 118, 139, 94, 75, 24, 21, 103,
-			183, 249, 206, 10, 157, 105, 241, 3, 24, 152, 228, 179, 252, 184,
-			65, 134, 68, 113, 148, 68, 36, 37, 44, 50, 172, 74, 134, 31,
-			72, 19, 142, 126, 139, 111, 94, 8, 195, 31, 57, 27, 209, 209,
-			50, 6, 56, 116, 121, 176, 50, 55, 20, 198, 78, 243, 102, 67,
-			63, 34, 90, 196, 68, 30, 11, 70, 182, 52, 154, 174, 23, 152,
-			142, 40, 235, 0, 136, 135, 146, 220, 71, 70, 113, 172, 72, 50,
-			238, 116, 0, 37, 95, 90, 167, 3, 48, 16, 242, 24, 242, 230,
-			127, 90, 183, 227, 127, 6, 39, 99, 84, 1, 109, 31, 238, 139,
-			143, 170, 0, 134, 251, 226, 163, 26, 128, 199, 230, 201, 191, 228,
-			135, 163, 123, 12, 253, 215, 192, 52, 124, 87, 163, 252, 62, 75,
-			63, 211, 192, 199, 214, 166, 219, 204, 99, 29, 171, 55, 156, 3,
-			184, 249, 208, 103, 203, 208, 115, 91, 129, 229, 48, 152, 192, 252,
-			4, 52, 144, 118, 162, 227, 168, 61, 156, 90, 51, 225, 254, 35,
-			180, 82, 69, 108, 194, 77, 113, 158, 183, 213, 173, 84, 76, 95,
-			168, 133, 120, 105, 235, 154, 117, 17, 56, 227, 87, 60, 220, 112,
-			242, 69, 188, 102, 182, 123, 58, 100, 8, 141, 110, 237, 240, 29,
-			13, 219, 221, 150, 51, 98, 163, 205, 247, 23, 74, 194, 12, 136,
-			184, 20, 192, 134, 11, 107, 41, 131, 177, 176, 114, 202, 66, 35,
-			179, 47, 86, 254, 157, 238, 189, 140, 127, 2, 249, 23, 50, 177,
-			71, 1, 33, 8, 37, 102, 143, 10, 96, 40, 49, 123, 52, 0,
-			143, 205, 147, 191, 226, 1, 86, 99, 134, 254, 219, 170, 250, 134,
-			212, 159, 105, 180, 140, 10, 41, 90, 9, 138, 8, 121, 83, 38,
-			111, 18, 167, 38, 34, 126, 254, 18, 150, 31, 150, 169, 93, 141,
-			72, 157, 133, 129, 49, 226, 16, 50, 39, 126, 60, 38, 195, 198,
-			99, 134, 84, 28, 109, 167, 205, 141, 74, 149, 213, 178, 217, 108,
-			54, 157, 149, 95, 193, 74, 3, 76, 167, 124, 71, 44, 37, 195,
-			223, 209, 4, 242, 159, 228, 141, 133, 19, 217, 19, 199, 179, 167,
-			210, 255, 28, 93, 195, 14, 63, 63, 198, 105, 129, 250, 229, 212,
-			26, 152, 152, 144, 59, 129, 219, 199, 72, 135, 130, 49, 166, 0,
-			175, 147, 251, 36, 168, 2, 56, 113, 84, 130, 26, 128, 215, 189,
-			158, 188, 149, 111, 10, 237, 53, 244, 255, 164, 170, 55, 167, 190,
-			167, 208, 85, 30, 181, 206, 43, 141, 108, 130, 55, 190, 86, 92,
-			234, 136, 10, 145, 193, 211, 120, 244, 213, 115, 76, 16, 70, 209,
-			255, 179, 167, 127, 76, 134, 57, 85, 246, 42, 64, 134, 144, 102,
-			123, 85, 0, 67, 154, 237, 213, 0, 188, 238, 38, 242, 199, 92,
-			253, 26, 134, 254, 167, 170, 90, 74, 61, 173, 135, 27, 105, 177,
-			72, 16, 17, 174, 223, 187, 181, 13, 100, 89, 196, 20, 222, 34,
-			254, 155, 23, 217, 194, 61, 133, 112, 111, 31, 135, 19, 237, 64,
-			201, 162, 85, 136, 182, 233, 243, 83, 98, 220, 122, 14, 41, 190,
-			109, 118, 68, 52, 96, 9, 138, 54, 221, 174, 187, 13, 126, 174,
-			197, 151, 235, 34, 142, 22, 131, 56, 227, 219, 121, 232, 242, 193,
-			83, 204, 226, 128, 58, 20, 124, 135, 200, 238, 137, 13, 65, 167,
-			138, 1, 47, 1, 158, 57, 152, 14, 223, 175, 59, 111, 182, 121,
-			168, 73, 199, 22, 147, 111, 53, 226, 146, 73, 249, 69, 159, 10,
-			227, 17, 109, 25, 116, 240, 69, 184, 4, 38, 45, 56, 74, 36,
-			37, 58, 169, 128, 86, 167, 102, 218, 62, 147, 49, 109, 225, 47,
-			98, 55, 156, 39, 60, 237, 38, 8, 143, 184, 250, 231, 40, 132,
-			151, 211, 233, 134, 2, 146, 21, 238, 110, 27, 42, 128, 83, 89,
-			9, 106, 0, 222, 240, 70, 242, 22, 174, 211, 199, 13, 253, 191,
-			168, 234, 249, 212, 127, 83, 47, 41, 134, 252, 14, 18, 222, 55,
-			137, 5, 75, 211, 181, 216, 53, 37, 241, 171, 207, 236, 218, 49,
-			30, 191, 197, 37, 160, 42, 174, 151, 196, 233, 248, 138, 219, 22,
-			115, 219, 198, 21, 224, 65, 168, 55, 198, 85, 0, 67, 189, 49,
-			174, 1, 120, 221, 173, 228, 155, 188, 178, 83, 242, 219, 234, 192,
-			255, 80, 149, 212, 79, 43, 24, 147, 47, 22, 25, 150, 211, 181,
-			139, 77, 115, 11, 75, 62, 55, 175, 45, 31, 179, 85, 138, 75,
-			22, 113, 23, 143, 239, 23, 97, 43, 252, 170, 96, 247, 78, 56,
-			46, 203, 55, 25, 134, 231, 202, 168, 220, 13, 30, 168, 20, 139,
-			133, 202, 146, 147, 43, 115, 47, 233, 63, 66, 134, 121, 109, 40,
-			253, 219, 106, 98, 136, 140, 136, 226, 80, 250, 127, 85, 213, 41,
-			160, 11, 47, 7, 5, 160, 33, 65, 21, 192, 137, 73, 242, 139,
-			10, 190, 172, 24, 250, 223, 170, 234, 110, 158, 87, 211, 172, 50,
-			143, 175, 133, 184, 209, 233, 61, 177, 192, 24, 33, 25, 229, 32,
-			195, 251, 248, 66, 91, 92, 117, 32, 241, 213, 33, 198, 253, 225,
-			161, 63, 95, 105, 215, 96, 201, 188, 209, 10, 248, 189, 5, 206,
-			128, 174, 102, 128, 31, 179, 156, 231, 9, 158, 23, 242, 111, 85,
-			117, 80, 130, 42, 128, 195, 35, 228, 39, 57, 246, 170, 161, 127,
-			31, 176, 191, 151, 222, 14, 68, 23, 200, 87, 93, 202, 182, 152,
-			215, 230, 33, 49, 210, 185, 149, 99, 203, 208, 166, 221, 146, 161,
-			155, 120, 64, 32, 215, 213, 25, 34, 79, 17, 251, 44, 116, 185,
-			14, 23, 163, 105, 184, 91, 124, 153, 29, 34, 10, 132, 252, 126,
-			132, 40, 199, 108, 120, 132, 124, 140, 35, 170, 25, 250, 223, 171,
-			234, 72, 234, 3, 10, 93, 225, 39, 241, 59, 32, 186, 29, 141,
-			67, 32, 138, 81, 76, 221, 180, 170, 185, 30, 233, 23, 231, 29,
-			6, 232, 199, 196, 142, 111, 82, 224, 134, 94, 123, 231, 134, 58,
-			228, 84, 140, 66, 83, 16, 237, 93, 18, 84, 1, 36, 195, 100,
-			4, 139, 106, 36, 223, 162, 13, 188, 85, 83, 64, 4, 117, 144,
-			178, 183, 104, 131, 19, 228, 245, 34, 89, 171, 254, 128, 166, 238,
-			75, 205, 97, 130, 102, 17, 181, 5, 163, 236, 58, 84, 15, 93,
-			82, 188, 108, 147, 37, 100, 143, 204, 166, 154, 124, 64, 83, 223,
-			162, 77, 201, 244, 170, 9, 108, 112, 80, 130, 10, 128, 67, 123,
-			36, 168, 1, 104, 140, 147, 159, 83, 176, 186, 98, 242, 237, 218,
-			192, 191, 211, 148, 212, 3, 74, 119, 252, 172, 21, 139, 91, 151,
-			187, 160, 176, 172, 7, 117, 214, 227, 124, 71, 65, 138, 133, 32,
-			140, 121, 147, 121, 130, 187, 238, 67, 116, 144, 187, 51, 218, 110,
-			152, 23, 43, 212, 223, 174, 13, 78, 146, 81, 162, 235, 138, 54,
-			96, 36, 127, 90, 83, 127, 78, 211, 112, 0, 138, 6, 227, 249,
-			105, 0, 230, 72, 18, 64, 160, 223, 207, 104, 186, 145, 58, 140,
-			186, 75, 70, 38, 91, 62, 94, 127, 146, 182, 28, 248, 180, 151,
-			236, 226, 31, 232, 70, 242, 103, 52, 253, 167, 181, 61, 100, 143,
-			124, 148, 196, 70, 6, 163, 7, 10, 60, 24, 218, 29, 61, 208,
-			224, 193, 216, 94, 242, 83, 138, 232, 87, 49, 244, 135, 52, 253,
-			170, 84, 27, 44, 150, 199, 165, 9, 47, 84, 199, 207, 42, 131,
-			232, 10, 58, 174, 97, 185, 15, 202, 175, 189, 57, 129, 215, 22,
-			177, 54, 120, 207, 47, 253, 186, 11, 150, 83, 189, 233, 244, 235,
-			112, 207, 243, 166, 52, 50, 156, 166, 177, 205, 211, 176, 2, 193,
-			147, 47, 208, 188, 167, 249, 122, 36, 68, 78, 209, 17, 151, 145,
-			232, 65, 2, 30, 236, 30, 143, 30, 32, 182, 251, 14, 69, 15,
-			52, 120, 112, 101, 154, 188, 77, 17, 37, 30, 245, 71, 64, 10,
-			47, 10, 49, 224, 36, 108, 196, 132, 33, 146, 129, 30, 73, 196,
-			152, 240, 211, 113, 111, 54, 118, 227, 46, 140, 243, 203, 80, 223,
-			13, 231, 84, 203, 15, 168, 3, 19, 26, 76, 87, 90, 138, 179,
-			130, 204, 121, 36, 198, 111, 20, 231, 71, 164, 56, 99, 77, 73,
-			253, 17, 41, 206, 88, 84, 82, 127, 4, 196, 249, 131, 138, 40,
-			43, 169, 191, 79, 83, 247, 167, 30, 81, 104, 206, 161, 110, 211,
-			124, 115, 75, 22, 28, 17, 87, 91, 162, 45, 87, 51, 126, 60,
-			40, 215, 139, 184, 161, 24, 27, 121, 220, 35, 233, 151, 255, 6,
-			132, 188, 233, 177, 45, 80, 44, 252, 198, 32, 246, 96, 218, 212,
-			221, 98, 30, 106, 40, 44, 21, 134, 53, 225, 252, 112, 152, 138,
-			110, 36, 223, 167, 169, 143, 104, 251, 196, 64, 128, 99, 239, 139,
-			134, 169, 224, 64, 134, 194, 95, 53, 0, 167, 166, 201, 3, 138,
-			40, 116, 169, 191, 95, 83, 51, 169, 22, 189, 93, 184, 153, 17,
-			197, 193, 237, 14, 79, 36, 2, 172, 4, 235, 243, 34, 3, 220,
-			51, 118, 204, 205, 200, 193, 194, 154, 115, 94, 134, 90, 155, 142,
-			11, 78, 58, 207, 238, 206, 247, 234, 2, 183, 239, 136, 195, 49,
-			168, 186, 145, 124, 191, 166, 190, 79, 219, 47, 176, 84, 147, 136,
-			214, 1, 9, 42, 0, 94, 113, 68, 130, 26, 128, 71, 175, 33,
-			239, 86, 68, 93, 77, 253, 131, 192, 170, 159, 80, 104, 1, 153,
-			18, 180, 37, 167, 220, 26, 44, 19, 80, 58, 58, 70, 18, 142,
-			241, 229, 28, 150, 166, 27, 201, 15, 106, 234, 251, 181, 140, 64,
-			92, 75, 32, 166, 146, 53, 160, 232, 63, 24, 177, 70, 195, 113,
-			76, 77, 131, 98, 224, 133, 61, 245, 15, 105, 234, 116, 234, 62,
-			180, 206, 92, 232, 64, 33, 182, 159, 151, 58, 68, 109, 208, 224,
-			149, 27, 68, 253, 30, 51, 188, 227, 105, 134, 87, 36, 91, 59,
-			107, 28, 75, 120, 237, 128, 10, 199, 37, 4, 147, 0, 14, 143,
-			74, 80, 1, 112, 207, 184, 4, 53, 0, 39, 167, 208, 96, 169,
-			70, 242, 195, 218, 192, 199, 133, 193, 2, 70, 126, 88, 27, 60,
-			76, 62, 164, 138, 66, 148, 250, 199, 52, 245, 100, 234, 23, 84,
-			186, 202, 188, 99, 221, 102, 50, 28, 178, 176, 1, 102, 120, 23,
-			76, 22, 13, 222, 8, 109, 171, 205, 156, 205, 160, 222, 19, 108,
-			142, 252, 18, 171, 23, 160, 68, 199, 141, 161, 142, 27, 172, 115,
-			213, 180, 180, 147, 184, 167, 221, 38, 225, 245, 2, 80, 176, 150,
-			112, 67, 187, 226, 213, 197, 135, 51, 104, 100, 205, 128, 35, 38,
-			106, 34, 10, 181, 70, 240, 156, 170, 201, 253, 96, 228, 155, 192,
-			142, 154, 124, 65, 86, 55, 183, 248, 246, 84, 187, 247, 126, 177,
-			112, 202, 120, 225, 77, 32, 86, 8, 38, 1, 28, 222, 47, 65,
-			5, 192, 212, 49, 9, 106, 0, 30, 63, 129, 60, 208, 140, 228,
-			175, 107, 3, 191, 37, 120, 0, 82, 247, 235, 218, 224, 65, 180,
-			138, 26, 88, 197, 79, 106, 234, 111, 8, 45, 169, 161, 85, 252,
-			164, 70, 192, 104, 38, 1, 4, 30, 125, 74, 211, 13, 52, 122,
-			154, 48, 122, 159, 210, 244, 79, 106, 211, 104, 2, 52, 97, 244,
-			62, 37, 141, 158, 38, 140, 222, 167, 164, 209, 211, 132, 209, 251,
-			20, 24, 189, 101, 232, 20, 218, 252, 180, 166, 166, 83, 55, 199,
-			234, 63, 99, 220, 129, 119, 201, 171, 47, 221, 55, 212, 4, 109,
-			52, 164, 205, 167, 37, 109, 52, 196, 231, 211, 218, 240, 148, 4,
-			21, 0, 167, 15, 74, 80, 3, 144, 94, 73, 238, 38, 32, 216,
-			201, 223, 213, 6, 254, 179, 166, 188, 44, 139, 4, 29, 166, 198,
-			239, 106, 131, 251, 209, 117, 214, 117, 24, 248, 211, 96, 28, 239,
-			189, 140, 139, 6, 210, 110, 99, 160, 78, 135, 129, 164, 152, 183,
-			73, 126, 137, 251, 196, 190, 75, 68, 241, 11, 86, 197, 124, 38,
-			13, 102, 58, 62, 77, 243, 120, 208, 90, 87, 187, 210, 60, 234,
-			200, 198, 167, 53, 245, 119, 53, 174, 100, 117, 52, 143, 79, 75,
-			229, 164, 35, 209, 158, 150, 230, 81, 71, 162, 61, 13, 230, 177,
-			140, 227, 80, 12, 253, 115, 154, 58, 145, 58, 67, 11, 53, 190,
-			129, 145, 17, 42, 101, 167, 203, 36, 181, 216, 86, 67, 252, 250,
-			160, 105, 219, 33, 78, 96, 203, 62, 167, 169, 79, 11, 91, 166,
-			163, 45, 251, 156, 166, 38, 37, 136, 221, 238, 26, 147, 160, 6,
-			224, 248, 62, 242, 6, 196, 73, 53, 244, 223, 211, 212, 84, 234,
-			4, 110, 165, 120, 45, 44, 226, 128, 215, 54, 69, 228, 124, 20,
-			154, 19, 210, 219, 111, 217, 65, 216, 61, 152, 161, 223, 211, 212,
-			207, 105, 19, 162, 3, 53, 129, 77, 202, 238, 97, 212, 191, 167,
-			237, 10, 127, 213, 0, 156, 222, 143, 115, 44, 97, 36, 191, 160,
-			13, 60, 43, 230, 88, 66, 49, 244, 47, 104, 131, 41, 146, 39,
-			186, 158, 0, 174, 63, 163, 169, 135, 83, 175, 161, 75, 221, 183,
-			99, 194, 107, 51, 66, 31, 139, 196, 19, 2, 79, 60, 87, 21,
-			82, 158, 64, 41, 127, 70, 74, 121, 2, 25, 246, 140, 54, 188,
-			87, 130, 10, 128, 70, 74, 130, 26, 128, 7, 15, 145, 219, 17,
-			5, 197, 208, 255, 8, 80, 40, 92, 30, 133, 74, 221, 178, 99,
-			119, 82, 102, 100, 85, 206, 192, 51, 45, 12, 90, 61, 50, 119,
-			100, 54, 68, 10, 60, 198, 63, 138, 144, 2, 142, 253, 81, 132,
-			148, 130, 253, 134, 72, 1, 199, 254, 8, 144, 122, 8, 214, 12,
-			73, 35, 249, 231, 218, 192, 87, 52, 37, 117, 146, 118, 102, 173,
-			19, 89, 62, 162, 37, 127, 239, 109, 139, 151, 103, 190, 38, 21,
-			67, 255, 115, 88, 50, 140, 16, 93, 79, 2, 227, 190, 172, 169,
-			19, 40, 31, 73, 156, 50, 95, 214, 212, 63, 215, 166, 113, 56,
-			73, 228, 192, 151, 229, 148, 73, 34, 7, 190, 172, 13, 141, 73,
-			80, 3, 112, 124, 31, 44, 250, 213, 132, 98, 36, 191, 174, 13,
-			124, 83, 83, 82, 55, 209, 222, 164, 144, 242, 192, 22, 232, 223,
-			19, 47, 91, 92, 93, 136, 69, 224, 190, 60, 187, 25, 192, 168,
-			175, 107, 137, 41, 220, 205, 64, 39, 254, 47, 53, 245, 213, 184,
-			4, 229, 174, 242, 95, 106, 234, 149, 18, 84, 1, 124, 213, 213,
-			36, 135, 239, 42, 134, 254, 13, 77, 221, 159, 62, 213, 185, 99,
-			5, 222, 99, 252, 228, 54, 190, 131, 139, 161, 248, 142, 187, 77,
-			68, 139, 10, 111, 99, 68, 130, 42, 128, 83, 211, 228, 53, 216,
-			129, 106, 232, 127, 5, 29, 204, 118, 118, 208, 125, 189, 87, 134,
-			94, 174, 220, 42, 155, 5, 212, 254, 74, 83, 247, 73, 16, 27,
-			154, 154, 38, 55, 99, 179, 154, 161, 255, 53, 52, 123, 178, 179,
-			217, 40, 204, 33, 30, 112, 46, 67, 166, 49, 48, 71, 182, 15,
-			166, 244, 175, 53, 117, 84, 130, 42, 128, 83, 211, 36, 79, 84,
-			125, 151, 145, 252, 182, 54, 240, 125, 77, 73, 189, 38, 74, 192,
-			99, 133, 53, 47, 121, 128, 99, 252, 60, 61, 10, 45, 149, 27,
-			152, 32, 144, 187, 20, 67, 255, 182, 54, 56, 70, 174, 39, 186,
-			190, 11, 248, 242, 29, 208, 187, 51, 52, 23, 79, 33, 19, 197,
-			19, 117, 166, 199, 16, 170, 109, 23, 138, 238, 119, 52, 245, 219,
-			26, 238, 72, 193, 131, 4, 182, 52, 40, 65, 5, 64, 33, 186,
-			187, 80, 116, 191, 3, 162, 123, 51, 246, 170, 24, 250, 119, 193,
-			193, 62, 201, 207, 172, 120, 165, 32, 121, 73, 173, 227, 92, 68,
-			106, 86, 17, 154, 31, 246, 15, 154, 253, 187, 154, 250, 29, 161,
-			90, 119, 169, 74, 18, 219, 28, 150, 32, 118, 49, 178, 79, 130,
-			26, 128, 83, 211, 168, 217, 119, 1, 223, 190, 167, 169, 7, 82,
-			39, 112, 129, 12, 30, 125, 92, 152, 58, 144, 152, 113, 91, 65,
-			179, 197, 237, 208, 108, 216, 61, 104, 246, 239, 105, 234, 119, 197,
-			2, 99, 23, 106, 246, 239, 69, 195, 135, 17, 126, 79, 27, 154,
-			148, 160, 6, 224, 254, 148, 232, 94, 51, 244, 191, 211, 212, 108,
-			234, 68, 180, 70, 218, 233, 104, 162, 127, 247, 176, 16, 248, 59,
-			77, 253, 158, 176, 181, 187, 84, 45, 137, 77, 134, 160, 2, 224,
-			21, 179, 18, 196, 14, 51, 199, 208, 176, 12, 26, 201, 31, 104,
-			3, 63, 165, 115, 195, 50, 168, 24, 250, 15, 180, 65, 74, 60,
-			162, 235, 131, 32, 14, 207, 105, 42, 77, 85, 233, 74, 43, 168,
-			184, 145, 60, 132, 1, 249, 153, 48, 197, 66, 152, 29, 133, 103,
-			46, 177, 108, 30, 47, 130, 39, 54, 176, 30, 23, 185, 103, 120,
-			218, 34, 113, 90, 193, 51, 61, 97, 18, 51, 49, 152, 65, 20,
-			165, 231, 52, 245, 7, 26, 30, 196, 194, 131, 36, 98, 177, 79,
-			130, 10, 128, 19, 7, 36, 168, 1, 120, 232, 48, 121, 80, 65,
-			148, 21, 67, 127, 64, 87, 167, 83, 219, 232, 167, 243, 200, 123,
-			214, 17, 232, 15, 186, 190, 79, 144, 126, 120, 51, 79, 30, 24,
-			133, 57, 163, 162, 205, 207, 44, 6, 32, 130, 39, 9, 3, 237,
-			115, 131, 34, 28, 5, 8, 228, 3, 186, 250, 156, 70, 5, 158,
-			32, 144, 15, 232, 194, 142, 13, 162, 64, 62, 160, 15, 143, 75,
-			80, 3, 112, 114, 138, 124, 148, 143, 66, 53, 244, 159, 212, 85,
-			154, 122, 76, 137, 13, 163, 179, 195, 16, 223, 248, 117, 147, 216,
-			77, 137, 238, 83, 71, 66, 3, 119, 147, 33, 245, 113, 245, 32,
-			53, 142, 188, 160, 17, 184, 241, 219, 31, 157, 167, 145, 241, 97,
-			19, 153, 121, 74, 144, 54, 28, 50, 76, 130, 159, 212, 213, 7,
-			244, 105, 49, 40, 88, 101, 255, 164, 174, 238, 149, 160, 2, 160,
-			33, 25, 7, 98, 255, 147, 250, 161, 195, 232, 38, 15, 25, 201,
-			159, 213, 7, 222, 171, 191, 76, 110, 242, 144, 98, 232, 63, 171,
-			15, 94, 1, 10, 95, 31, 2, 177, 126, 72, 87, 39, 82, 179,
-			116, 89, 232, 184, 200, 19, 16, 142, 113, 71, 76, 190, 24, 226,
-			16, 202, 230, 67, 186, 250, 179, 58, 70, 28, 194, 131, 4, 54,
-			53, 40, 65, 5, 64, 161, 230, 134, 80, 54, 31, 210, 199, 247,
-			145, 91, 176, 91, 197, 208, 31, 214, 213, 169, 212, 235, 232, 114,
-			171, 177, 193, 19, 132, 197, 66, 145, 92, 121, 253, 213, 117, 240,
-			156, 162, 137, 10, 183, 202, 106, 166, 184, 1, 125, 226, 248, 241,
-			16, 19, 144, 175, 135, 117, 245, 33, 125, 66, 244, 5, 142, 209,
-			195, 186, 40, 189, 52, 132, 242, 245, 176, 62, 184, 87, 130, 26,
-			128, 251, 38, 73, 29, 49, 81, 13, 253, 231, 117, 117, 127, 234,
-			78, 122, 155, 105, 183, 144, 2, 71, 186, 42, 80, 28, 137, 174,
-			241, 53, 61, 182, 133, 183, 233, 34, 39, 129, 208, 133, 206, 243,
-			108, 112, 117, 27, 140, 159, 48, 240, 232, 40, 142, 39, 8, 197,
-			207, 235, 234, 195, 250, 148, 192, 4, 52, 227, 207, 71, 20, 3,
-			162, 252, 188, 62, 52, 46, 65, 13, 192, 201, 105, 84, 77, 196,
-			72, 254, 162, 62, 240, 75, 66, 53, 17, 197, 208, 127, 81, 31,
-			60, 72, 138, 68, 215, 9, 240, 240, 81, 93, 77, 167, 22, 195,
-			204, 109, 177, 109, 125, 225, 113, 110, 72, 167, 64, 78, 9, 171,
-			177, 195, 77, 21, 232, 157, 160, 3, 252, 168, 156, 163, 4, 21,
-			207, 163, 114, 142, 18, 100, 238, 163, 250, 190, 131, 18, 212, 0,
-			164, 87, 114, 197, 67, 96, 32, 143, 233, 234, 161, 212, 182, 160,
-			105, 32, 82, 103, 152, 62, 61, 18, 39, 171, 229, 208, 126, 249,
-			139, 251, 16, 17, 51, 84, 138, 164, 1, 34, 7, 99, 199, 65,
-			60, 158, 98, 137, 107, 184, 130, 224, 4, 5, 227, 49, 93, 125,
-			84, 79, 11, 60, 65, 48, 30, 147, 4, 39, 40, 24, 143, 233,
-			67, 211, 18, 212, 0, 60, 112, 16, 103, 225, 176, 145, 252, 144,
-			62, 240, 155, 47, 215, 44, 28, 86, 12, 253, 67, 250, 224, 33,
-			178, 64, 116, 125, 24, 56, 248, 56, 204, 194, 235, 250, 207, 66,
-			30, 207, 217, 113, 53, 102, 70, 166, 129, 149, 166, 111, 24, 103,
-			228, 227, 186, 250, 33, 157, 235, 217, 97, 156, 145, 143, 203, 225,
-			14, 35, 211, 30, 151, 51, 114, 24, 153, 246, 56, 204, 200, 239,
-			170, 136, 131, 98, 232, 31, 3, 107, 241, 95, 84, 30, 141, 10,
-			179, 223, 117, 47, 160, 122, 159, 225, 151, 241, 90, 78, 60, 132,
-			20, 119, 174, 114, 75, 75, 192, 7, 159, 117, 6, 2, 224, 129,
-			254, 34, 103, 125, 152, 100, 48, 150, 169, 149, 223, 236, 20, 227,
-			242, 185, 28, 178, 90, 205, 170, 224, 209, 49, 174, 108, 249, 21,
-			246, 128, 196, 50, 182, 129, 42, 16, 225, 145, 53, 203, 6, 199,
-			110, 163, 157, 165, 57, 219, 142, 167, 80, 12, 67, 204, 48, 64,
-			64, 68, 129, 19, 234, 179, 166, 233, 241, 74, 214, 172, 73, 205,
-			90, 192, 228, 9, 51, 79, 215, 130, 184, 244, 68, 139, 113, 44,
-			124, 76, 125, 205, 75, 148, 122, 177, 152, 86, 30, 25, 135, 10,
-			105, 131, 5, 242, 38, 13, 162, 203, 183, 52, 113, 92, 56, 108,
-			220, 203, 134, 215, 227, 211, 108, 24, 151, 116, 31, 147, 211, 108,
-			24, 45, 227, 199, 116, 177, 219, 55, 140, 2, 250, 49, 93, 236,
-			246, 13, 163, 128, 126, 12, 44, 227, 45, 200, 48, 213, 208, 63,
-			241, 210, 232, 208, 97, 212, 77, 159, 208, 213, 143, 9, 131, 53,
-			140, 186, 233, 19, 82, 135, 14, 163, 110, 250, 132, 212, 161, 195,
-			168, 155, 62, 33, 117, 232, 48, 64, 79, 252, 99, 232, 208, 97,
-			116, 239, 158, 208, 213, 79, 8, 29, 58, 140, 251, 188, 79, 68,
-			50, 14, 238, 221, 19, 82, 135, 14, 163, 123, 247, 132, 212, 161,
-			35, 70, 242, 51, 250, 192, 127, 20, 58, 116, 68, 49, 244, 207,
-			232, 131, 135, 81, 135, 142, 192, 12, 124, 242, 165, 213, 161, 35,
-			168, 67, 159, 148, 204, 29, 65, 29, 250, 164, 212, 161, 35, 56,
-			29, 159, 148, 58, 116, 4, 167, 227, 147, 160, 67, 127, 66, 65,
-			132, 20, 67, 127, 10, 116, 104, 251, 242, 58, 180, 127, 222, 246,
-			62, 100, 196, 194, 254, 47, 76, 139, 142, 160, 22, 125, 74, 87,
-			159, 20, 90, 116, 4, 181, 232, 83, 146, 228, 35, 40, 164, 79,
-			73, 45, 58, 130, 66, 250, 20, 104, 209, 223, 81, 136, 170, 239,
-			54, 146, 127, 160, 15, 124, 73, 87, 82, 85, 90, 124, 126, 151,
-			208, 250, 222, 61, 235, 244, 222, 118, 186, 123, 246, 50, 237, 84,
-			236, 86, 12, 253, 15, 244, 193, 97, 50, 71, 116, 125, 55, 136,
-			202, 31, 234, 170, 145, 186, 50, 84, 214, 226, 42, 83, 79, 108,
-			33, 82, 112, 55, 42, 230, 63, 212, 213, 63, 208, 121, 245, 199,
-			221, 168, 152, 255, 80, 82, 112, 55, 74, 194, 31, 234, 67, 225,
-			175, 26, 128, 99, 123, 241, 168, 121, 55, 8, 194, 23, 193, 54,
-			204, 117, 116, 39, 169, 196, 115, 224, 202, 187, 84, 209, 137, 95,
-			216, 57, 176, 239, 139, 186, 250, 135, 186, 33, 154, 7, 246, 125,
-			49, 234, 92, 193, 246, 133, 85, 216, 141, 236, 251, 34, 88, 133,
-			211, 216, 185, 106, 232, 207, 194, 204, 206, 244, 203, 255, 28, 235,
-			53, 22, 21, 22, 246, 12, 58, 229, 89, 93, 253, 162, 240, 203,
-			118, 163, 19, 252, 172, 46, 22, 162, 187, 81, 167, 60, 171, 139,
-			133, 232, 110, 212, 41, 207, 234, 83, 211, 228, 44, 246, 172, 25,
-			250, 159, 64, 207, 55, 68, 11, 209, 216, 177, 18, 207, 192, 220,
-			121, 164, 212, 119, 69, 184, 27, 85, 198, 159, 232, 234, 179, 250,
-			126, 209, 17, 168, 140, 63, 137, 8, 0, 42, 227, 79, 244, 33,
-			137, 134, 134, 29, 79, 77, 147, 51, 136, 134, 110, 232, 127, 166,
-			171, 153, 212, 107, 163, 5, 41, 116, 188, 243, 121, 221, 14, 88,
-			232, 186, 145, 252, 51, 93, 253, 147, 16, 11, 61, 137, 45, 31,
-			144, 160, 2, 160, 56, 119, 219, 141, 7, 59, 127, 166, 31, 189,
-			6, 21, 215, 168, 145, 252, 138, 62, 240, 127, 8, 197, 53, 170,
-			24, 250, 87, 244, 193, 105, 148, 198, 81, 144, 198, 175, 189, 0,
-			105, 28, 69, 105, 252, 154, 174, 126, 69, 231, 59, 133, 163, 40,
-			141, 95, 147, 244, 24, 69, 105, 252, 154, 148, 198, 81, 148, 198,
-			175, 73, 105, 28, 5, 158, 253, 197, 139, 150, 198, 81, 148, 198,
-			191, 208, 213, 175, 9, 105, 28, 69, 105, 252, 139, 168, 115, 5,
-			219, 23, 210, 56, 138, 210, 248, 23, 32, 141, 64, 134, 61, 70,
-			242, 47, 245, 129, 191, 18, 100, 216, 163, 24, 250, 95, 234, 131,
-			83, 228, 36, 209, 245, 61, 64, 134, 111, 0, 94, 175, 186, 196,
-			58, 6, 227, 23, 195, 37, 204, 30, 164, 196, 55, 116, 245, 47,
-			5, 79, 246, 32, 37, 190, 33, 145, 217, 131, 148, 248, 134, 68,
-			102, 15, 82, 226, 27, 18, 153, 49, 35, 249, 55, 250, 192, 183,
-			5, 50, 99, 138, 161, 255, 13, 240, 228, 118, 162, 235, 99, 128,
-			204, 183, 192, 149, 42, 208, 40, 151, 72, 173, 195, 142, 112, 217,
-			169, 236, 96, 71, 240, 220, 177, 133, 1, 149, 113, 139, 50, 134,
-			22, 229, 91, 210, 162, 140, 161, 69, 249, 150, 116, 23, 198, 16,
-			223, 111, 73, 119, 97, 12, 241, 253, 22, 184, 11, 15, 106, 68,
-			213, 247, 26, 201, 191, 215, 7, 126, 34, 161, 164, 62, 128, 14,
-			30, 87, 197, 225, 109, 45, 188, 172, 213, 113, 67, 10, 149, 113,
-			60, 218, 244, 86, 214, 246, 119, 186, 5, 148, 137, 146, 248, 136,
-			109, 117, 75, 214, 14, 239, 206, 128, 196, 253, 37, 16, 139, 48,
-			67, 98, 20, 114, 45, 174, 241, 74, 248, 100, 186, 39, 190, 52,
-			188, 58, 68, 58, 144, 43, 215, 161, 185, 0, 188, 76, 60, 157,
-			148, 146, 9, 238, 226, 140, 233, 247, 220, 75, 155, 141, 109, 62,
-			218, 60, 167, 152, 25, 208, 107, 143, 31, 39, 116, 163, 29, 188,
-			108, 161, 108, 250, 94, 197, 208, 255, 30, 108, 201, 42, 209, 245,
-			189, 184, 171, 164, 171, 123, 83, 243, 64, 221, 248, 181, 175, 88,
-			234, 245, 200, 192, 29, 195, 219, 79, 194, 116, 207, 220, 101, 30,
-			187, 247, 248, 177, 27, 214, 255, 197, 177, 187, 175, 145, 138, 102,
-			47, 223, 51, 210, 213, 191, 23, 198, 102, 47, 10, 245, 115, 82,
-			168, 247, 242, 61, 35, 125, 104, 68, 130, 26, 128, 123, 198, 200,
-			9, 196, 71, 49, 244, 183, 36, 212, 241, 212, 85, 194, 235, 8,
-			67, 240, 169, 233, 109, 88, 129, 103, 122, 210, 117, 8, 59, 132,
-			41, 253, 150, 132, 250, 156, 190, 87, 52, 9, 83, 250, 45, 137,
-			176, 67, 5, 219, 28, 26, 149, 160, 6, 224, 94, 3, 13, 204,
-			94, 48, 48, 111, 77, 160, 129, 65, 53, 207, 143, 161, 67, 110,
-			131, 158, 71, 14, 246, 209, 169, 123, 209, 192, 188, 53, 161, 190,
-			37, 49, 46, 218, 6, 167, 245, 173, 81, 207, 48, 154, 183, 38,
-			132, 102, 223, 139, 6, 230, 173, 137, 169, 105, 242, 90, 236, 89,
-			51, 244, 183, 37, 212, 76, 234, 104, 164, 217, 161, 43, 208, 236,
-			33, 2, 253, 251, 5, 139, 242, 182, 132, 250, 214, 196, 126, 209,
-			178, 150, 196, 182, 14, 72, 80, 1, 80, 232, 242, 189, 104, 81,
-			222, 150, 16, 186, 220, 48, 146, 111, 79, 12, 60, 148, 224, 122,
-			195, 80, 12, 253, 237, 137, 193, 253, 104, 108, 12, 144, 134, 119,
-			36, 212, 137, 212, 107, 81, 156, 67, 245, 133, 97, 2, 174, 93,
-			237, 186, 248, 181, 205, 226, 49, 123, 145, 229, 53, 80, 6, 222,
-			145, 80, 223, 158, 224, 24, 25, 40, 3, 239, 144, 132, 49, 80,
-			6, 222, 145, 16, 138, 205, 64, 25, 120, 71, 98, 124, 31, 90,
-			20, 3, 168, 246, 78, 96, 201, 149, 136, 197, 243, 232, 14, 36,
-			224, 157, 9, 245, 29, 137, 9, 209, 32, 44, 99, 222, 153, 16,
-			134, 222, 64, 9, 120, 103, 66, 24, 122, 3, 37, 224, 157, 192,
-			135, 121, 236, 78, 53, 244, 119, 37, 212, 233, 212, 181, 116, 165,
-			223, 125, 84, 222, 229, 12, 30, 93, 199, 83, 134, 6, 204, 147,
-			39, 99, 6, 72, 2, 52, 18, 130, 73, 0, 133, 94, 52, 80,
-			14, 222, 149, 16, 122, 209, 64, 57, 120, 87, 66, 4, 77, 140,
-			27, 201, 135, 19, 3, 191, 32, 248, 49, 174, 24, 250, 195, 192,
-			143, 115, 68, 215, 199, 129, 31, 239, 6, 126, 156, 126, 190, 252,
-			224, 55, 150, 196, 66, 207, 115, 27, 130, 68, 227, 200, 145, 119,
-			39, 212, 135, 5, 71, 198, 145, 35, 239, 150, 28, 25, 71, 142,
-			188, 91, 114, 100, 28, 57, 242, 110, 224, 200, 9, 196, 67, 49,
-			244, 247, 0, 71, 174, 234, 225, 200, 142, 29, 2, 79, 222, 147,
-			80, 223, 45, 120, 50, 142, 60, 121, 143, 228, 201, 56, 242, 228,
-			61, 146, 39, 227, 200, 147, 247, 72, 158, 140, 3, 79, 222, 187,
-			51, 79, 68, 167, 151, 228, 201, 56, 242, 228, 189, 146, 39, 227,
-			200, 147, 247, 74, 158, 140, 35, 79, 222, 43, 121, 50, 142, 60,
-			121, 47, 240, 228, 110, 162, 234, 251, 140, 228, 251, 19, 3, 31,
-			76, 188, 76, 123, 47, 251, 20, 67, 127, 127, 98, 240, 32, 41,
-			17, 93, 223, 7, 76, 126, 12, 152, 156, 239, 101, 114, 184, 175,
-			220, 51, 239, 248, 58, 201, 181, 49, 154, 36, 10, 67, 19, 228,
-			223, 135, 252, 126, 44, 161, 190, 63, 129, 201, 100, 224, 65, 2,
-			187, 25, 148, 160, 2, 160, 224, 247, 62, 228, 247, 99, 192, 239,
-			101, 68, 73, 49, 244, 15, 0, 74, 55, 35, 74, 93, 183, 162,
-			248, 178, 206, 181, 183, 24, 63, 112, 232, 42, 247, 81, 115, 61,
-			185, 109, 31, 98, 3, 194, 240, 129, 132, 250, 152, 16, 134, 125,
-			168, 162, 63, 16, 97, 163, 96, 135, 33, 54, 32, 12, 31, 0,
-			108, 128, 25, 19, 70, 242, 241, 196, 192, 175, 190, 92, 204, 152,
-			80, 12, 253, 113, 96, 198, 45, 68, 215, 39, 128, 25, 31, 134,
-			145, 191, 238, 249, 206, 184, 109, 211, 193, 149, 238, 38, 11, 240,
-			130, 81, 200, 131, 9, 228, 193, 135, 19, 234, 227, 130, 7, 19,
-			200, 131, 15, 203, 81, 79, 32, 15, 62, 44, 71, 61, 129, 60,
-			248, 48, 140, 250, 20, 98, 162, 24, 250, 71, 96, 206, 93, 221,
-			51, 231, 46, 209, 37, 16, 250, 35, 9, 245, 195, 130, 208, 19,
-			56, 235, 62, 34, 103, 221, 4, 18, 250, 35, 114, 214, 77, 32,
-			161, 63, 2, 179, 238, 183, 97, 173, 60, 105, 36, 63, 153, 24,
-			248, 124, 66, 73, 109, 208, 176, 102, 101, 20, 12, 139, 229, 103,
-			196, 173, 26, 190, 80, 190, 196, 149, 92, 220, 229, 34, 180, 201,
-			60, 126, 129, 68, 148, 114, 138, 60, 166, 151, 135, 155, 147, 138,
-			161, 127, 50, 49, 184, 23, 3, 158, 38, 245, 1, 35, 249, 169,
-			132, 250, 91, 9, 30, 240, 52, 137, 65, 212, 159, 74, 236, 26,
-			193, 128, 167, 73, 30, 70, 253, 27, 9, 61, 133, 177, 75, 147,
-			34, 238, 249, 55, 18, 250, 68, 244, 64, 133, 7, 211, 251, 195,
-			47, 20, 67, 127, 34, 254, 133, 194, 31, 12, 71, 15, 84, 120,
-			16, 251, 66, 53, 244, 223, 140, 127, 1, 77, 252, 102, 66, 223,
-			29, 61, 192, 55, 98, 95, 104, 134, 254, 233, 248, 23, 96, 207,
-			63, 157, 208, 73, 244, 64, 133, 7, 211, 251, 113, 239, 104, 18,
-			247, 142, 18, 234, 88, 106, 145, 22, 228, 86, 103, 103, 233, 19,
-			190, 235, 37, 56, 42, 215, 101, 21, 171, 89, 93, 239, 168, 213,
-			121, 122, 235, 68, 154, 75, 210, 36, 10, 239, 147, 49, 218, 161,
-			240, 62, 41, 133, 119, 146, 239, 30, 37, 134, 134, 37, 168, 1,
-			56, 186, 7, 189, 170, 73, 24, 226, 103, 19, 170, 145, 202, 160,
-			240, 134, 98, 35, 34, 25, 67, 68, 226, 231, 149, 89, 217, 51,
-			200, 240, 103, 19, 234, 147, 137, 49, 209, 54, 200, 240, 103, 19,
-			98, 43, 112, 18, 41, 254, 217, 196, 224, 110, 9, 106, 0, 142,
-			237, 197, 9, 60, 9, 180, 124, 10, 188, 170, 215, 69, 94, 85,
-			216, 33, 149, 53, 122, 195, 178, 37, 51, 29, 81, 11, 174, 71,
-			29, 55, 152, 13, 49, 1, 255, 238, 169, 132, 250, 217, 132, 33,
-			250, 2, 27, 242, 148, 244, 179, 38, 145, 147, 79, 73, 63, 107,
-			18, 109, 200, 83, 224, 103, 221, 132, 152, 104, 134, 254, 116, 66,
-			125, 85, 234, 56, 47, 240, 35, 139, 243, 114, 106, 100, 100, 54,
-			221, 78, 238, 68, 126, 237, 36, 122, 121, 79, 39, 212, 167, 18,
-			25, 209, 62, 120, 121, 79, 39, 212, 105, 9, 42, 0, 238, 63,
-			44, 65, 236, 47, 125, 21, 185, 22, 123, 215, 13, 253, 115, 224,
-			72, 191, 26, 123, 231, 119, 149, 100, 215, 72, 114, 207, 3, 179,
-			202, 124, 63, 58, 187, 159, 196, 93, 130, 207, 37, 212, 167, 19,
-			175, 18, 173, 234, 9, 108, 71, 114, 93, 87, 0, 20, 190, 244,
-			36, 238, 18, 124, 14, 124, 105, 240, 100, 166, 140, 228, 239, 39,
-			6, 254, 76, 120, 50, 83, 138, 161, 255, 126, 98, 240, 48, 174,
-			51, 166, 64, 68, 191, 0, 122, 117, 254, 197, 232, 85, 254, 91,
-			205, 197, 226, 53, 28, 213, 41, 20, 208, 47, 36, 212, 223, 79,
-			96, 168, 10, 60, 72, 96, 31, 131, 18, 84, 0, 20, 218, 117,
-			10, 5, 244, 11, 160, 93, 111, 64, 124, 20, 67, 127, 6, 180,
-			235, 53, 151, 212, 174, 125, 59, 6, 249, 124, 38, 161, 126, 65,
-			232, 216, 41, 148, 207, 103, 164, 142, 157, 66, 249, 124, 70, 234,
-			216, 41, 148, 207, 103, 64, 199, 230, 176, 99, 213, 208, 159, 133,
-			142, 79, 129, 124, 226, 225, 173, 136, 78, 195, 157, 104, 185, 2,
-			147, 193, 108, 105, 44, 132, 206, 143, 232, 67, 4, 112, 95, 43,
-			161, 62, 35, 220, 255, 41, 92, 118, 60, 155, 16, 177, 107, 83,
-			124, 95, 43, 177, 75, 34, 128, 251, 90, 17, 2, 154, 161, 255,
-			241, 11, 64, 0, 171, 128, 119, 33, 0, 146, 249, 199, 9, 245,
-			217, 16, 1, 45, 129, 141, 74, 4, 64, 50, 255, 56, 66, 64,
-			195, 46, 197, 198, 218, 20, 72, 230, 159, 38, 212, 195, 169, 27,
-			158, 31, 2, 177, 146, 201, 93, 104, 128, 176, 254, 105, 66, 253,
-			227, 16, 13, 16, 214, 63, 141, 208, 0, 97, 253, 211, 196, 174,
-			148, 4, 53, 0, 15, 30, 66, 97, 157, 54, 146, 95, 78, 12,
-			252, 55, 33, 172, 211, 138, 161, 127, 57, 49, 72, 113, 230, 78,
-			131, 176, 126, 21, 188, 207, 227, 93, 194, 33, 147, 174, 138, 69,
-			153, 8, 156, 168, 89, 54, 207, 158, 46, 16, 155, 70, 209, 252,
-			106, 66, 253, 114, 130, 239, 88, 79, 227, 62, 201, 87, 165, 43,
-			58, 141, 162, 249, 213, 132, 216, 136, 159, 70, 209, 252, 42, 248,
-			158, 63, 175, 96, 247, 138, 161, 127, 29, 186, 127, 187, 194, 211,
-			148, 197, 111, 188, 198, 167, 201, 243, 223, 207, 33, 81, 146, 161,
-			85, 30, 226, 33, 146, 221, 117, 222, 248, 172, 155, 62, 237, 40,
-			227, 77, 95, 255, 122, 140, 174, 204, 74, 204, 21, 29, 113, 11,
-			193, 36, 128, 194, 167, 158, 70, 201, 255, 186, 244, 169, 167, 81,
-			242, 191, 14, 227, 250, 56, 31, 151, 106, 232, 223, 132, 113, 125,
-			80, 17, 233, 122, 186, 54, 85, 250, 141, 75, 174, 138, 47, 176,
-			54, 31, 67, 152, 16, 198, 33, 225, 239, 178, 8, 59, 175, 100,
-			215, 113, 22, 50, 251, 130, 198, 140, 11, 140, 238, 49, 131, 192,
-			126, 51, 26, 51, 216, 128, 111, 70, 99, 6, 110, 125, 51, 26,
-			51, 76, 175, 111, 194, 152, 255, 55, 62, 102, 205, 208, 191, 3,
-			70, 224, 33, 133, 150, 34, 43, 200, 118, 188, 146, 220, 25, 224,
-			36, 46, 39, 119, 17, 196, 242, 136, 200, 218, 243, 188, 7, 22,
-			235, 174, 123, 120, 154, 142, 40, 134, 96, 18, 192, 225, 125, 18,
-			84, 0, 156, 56, 44, 65, 28, 79, 250, 42, 244, 204, 247, 27,
-			201, 239, 39, 6, 254, 215, 228, 203, 228, 153, 239, 87, 12, 253,
-			251, 224, 153, 55, 137, 174, 239, 135, 73, 249, 67, 176, 32, 27,
-			56, 41, 251, 92, 3, 238, 86, 222, 168, 184, 49, 112, 168, 235,
-			166, 0, 61, 114, 169, 138, 103, 71, 196, 52, 222, 143, 211, 248,
-			135, 9, 245, 251, 194, 127, 223, 143, 22, 230, 135, 210, 194, 236,
-			199, 105, 252, 67, 105, 97, 246, 227, 52, 254, 33, 88, 152, 121,
-			196, 87, 49, 244, 231, 64, 207, 94, 139, 248, 10, 84, 47, 107,
-			104, 36, 190, 28, 3, 48, 53, 207, 37, 212, 31, 10, 83, 179,
-			31, 39, 220, 115, 210, 212, 236, 199, 9, 247, 156, 52, 53, 251,
-			113, 194, 61, 7, 138, 22, 52, 92, 202, 72, 62, 144, 196, 58,
-			182, 64, 204, 148, 98, 232, 15, 36, 7, 15, 161, 134, 75, 1,
-			49, 31, 76, 190, 104, 13, 151, 66, 210, 60, 152, 84, 31, 72,
-			242, 163, 254, 20, 106, 184, 7, 147, 66, 138, 82, 72, 154, 7,
-			147, 66, 195, 165, 144, 52, 15, 38, 39, 167, 200, 191, 209, 176,
-			123, 197, 208, 31, 74, 170, 7, 82, 239, 209, 64, 195, 117, 198,
-			24, 178, 139, 129, 103, 98, 46, 94, 65, 51, 238, 155, 246, 70,
-			27, 34, 234, 117, 211, 7, 140, 55, 93, 138, 59, 255, 162, 172,
-			31, 86, 116, 17, 87, 11, 240, 71, 60, 214, 197, 163, 116, 30,
-			87, 37, 243, 66, 243, 46, 194, 124, 83, 97, 190, 7, 94, 29,
-			56, 204, 66, 205, 211, 3, 243, 99, 127, 44, 87, 22, 235, 82,
-			22, 53, 105, 211, 42, 86, 13, 164, 126, 171, 217, 116, 61, 76,
-			45, 64, 98, 247, 138, 68, 94, 227, 170, 204, 250, 40, 95, 51,
-			229, 209, 61, 52, 57, 43, 110, 58, 139, 126, 155, 86, 229, 2,
-			54, 177, 193, 124, 44, 33, 182, 233, 242, 174, 228, 199, 120, 166,
-			221, 91, 247, 158, 31, 55, 96, 154, 95, 31, 171, 126, 96, 234,
-			39, 211, 182, 170, 97, 246, 227, 114, 189, 229, 203, 250, 194, 12,
-			236, 173, 47, 147, 87, 157, 185, 163, 16, 242, 25, 4, 240, 161,
-			164, 250, 96, 114, 90, 112, 18, 4, 240, 161, 164, 16, 192, 20,
-			191, 33, 152, 28, 153, 148, 160, 6, 224, 254, 20, 174, 75, 82,
-			160, 240, 31, 78, 170, 135, 82, 139, 180, 20, 101, 224, 232, 182,
-			102, 207, 155, 223, 28, 35, 112, 126, 30, 78, 170, 15, 37, 15,
-			136, 62, 65, 31, 63, 28, 97, 132, 145, 93, 201, 145, 253, 18,
-			212, 0, 188, 226, 32, 46, 170, 83, 0, 61, 2, 114, 127, 53,
-			45, 89, 247, 134, 202, 184, 167, 99, 177, 3, 47, 186, 4, 119,
-			231, 145, 164, 250, 112, 242, 144, 104, 20, 220, 157, 71, 146, 98,
-			65, 146, 66, 29, 249, 72, 114, 80, 10, 187, 134, 157, 76, 78,
-			145, 103, 20, 236, 83, 55, 244, 71, 147, 234, 254, 212, 239, 40,
-			241, 98, 167, 253, 251, 158, 49, 125, 172, 116, 202, 183, 90, 102,
-			195, 123, 132, 50, 55, 144, 107, 75, 50, 133, 213, 36, 208, 0,
-			160, 36, 2, 147, 55, 204, 202, 133, 109, 211, 171, 98, 6, 82,
-			51, 224, 177, 159, 109, 34, 211, 154, 227, 181, 75, 182, 205, 188,
-			176, 21, 188, 83, 185, 197, 60, 171, 198, 211, 208, 185, 181, 126,
-			242, 36, 242, 166, 135, 36, 1, 215, 235, 209, 164, 250, 72, 40,
-			23, 224, 122, 61, 154, 20, 170, 49, 133, 174, 215, 163, 73, 177,
-			243, 157, 66, 215, 235, 209, 228, 212, 52, 249, 166, 138, 36, 73,
-			24, 250, 47, 39, 213, 76, 234, 207, 85, 186, 32, 183, 184, 96,
-			38, 71, 117, 240, 58, 201, 82, 49, 237, 74, 139, 231, 75, 229,
-			89, 173, 115, 75, 75, 56, 27, 124, 57, 19, 68, 197, 151, 216,
-			116, 19, 119, 72, 241, 72, 52, 214, 98, 104, 44, 98, 89, 198,
-			165, 50, 169, 136, 132, 189, 252, 158, 184, 80, 207, 130, 54, 189,
-			72, 161, 234, 185, 220, 68, 141, 202, 226, 200, 78, 248, 212, 12,
-			168, 205, 76, 63, 86, 62, 242, 72, 68, 245, 35, 162, 16, 33,
-			114, 24, 69, 6, 187, 154, 65, 173, 134, 81, 20, 188, 134, 238,
-			186, 95, 55, 79, 112, 141, 193, 239, 162, 97, 189, 95, 39, 58,
-			242, 228, 196, 79, 232, 72, 237, 16, 76, 2, 56, 44, 89, 147,
-			80, 0, 156, 56, 34, 65, 13, 192, 163, 215, 108, 36, 49, 225,
-			249, 41, 242, 197, 20, 57, 185, 233, 102, 43, 117, 207, 109, 88,
-			173, 6, 175, 15, 222, 170, 88, 220, 110, 154, 77, 241, 199, 214,
-			137, 57, 188, 206, 234, 243, 186, 243, 134, 14, 79, 83, 199, 159,
-			223, 151, 30, 107, 186, 252, 187, 212, 97, 94, 189, 126, 78, 86,
-			175, 159, 11, 157, 59, 254, 66, 250, 73, 149, 36, 242, 208, 147,
-			113, 21, 209, 47, 88, 78, 117, 90, 161, 202, 204, 232, 73, 81,
-			113, 30, 127, 186, 213, 114, 170, 69, 252, 209, 24, 35, 218, 118,
-			221, 157, 86, 169, 50, 51, 84, 132, 63, 141, 44, 209, 193, 16,
-			76, 107, 84, 153, 25, 62, 153, 234, 41, 151, 95, 150, 29, 22,
-			241, 61, 99, 154, 236, 18, 50, 51, 173, 99, 43, 18, 52, 82,
-			100, 80, 90, 206, 233, 4, 254, 20, 194, 208, 175, 199, 106, 211,
-			73, 222, 175, 199, 106, 240, 36, 48, 55, 167, 119, 241, 39, 129,
-			185, 105, 220, 72, 70, 68, 205, 207, 117, 207, 181, 217, 244, 32,
-			213, 102, 134, 79, 78, 243, 129, 116, 221, 51, 205, 45, 44, 21,
-			135, 197, 219, 69, 215, 102, 240, 177, 199, 182, 220, 11, 242, 227,
-			161, 203, 125, 44, 222, 134, 143, 143, 254, 138, 74, 134, 66, 74,
-			25, 41, 50, 153, 191, 45, 191, 92, 94, 191, 181, 176, 188, 184,
-			190, 182, 92, 90, 205, 47, 20, 206, 20, 242, 139, 99, 3, 198,
-			36, 49, 86, 139, 249, 51, 133, 31, 89, 207, 45, 44, 173, 47,
-			156, 203, 45, 159, 205, 47, 142, 85, 141, 125, 100, 207, 106, 110,
-			225, 214, 220, 217, 252, 250, 66, 49, 159, 43, 231, 23, 199, 158,
-			86, 226, 79, 23, 243, 75, 121, 120, 250, 159, 20, 99, 156, 140,
-			202, 167, 231, 10, 139, 139, 249, 229, 177, 207, 41, 198, 4, 25,
-			147, 15, 215, 150, 197, 227, 207, 227, 227, 194, 114, 169, 156, 91,
-			94, 136, 26, 126, 92, 237, 120, 44, 91, 254, 149, 206, 199, 197,
-			252, 153, 245, 82, 190, 60, 246, 97, 213, 152, 34, 70, 199, 227,
-			181, 101, 248, 225, 223, 171, 70, 138, 76, 132, 63, 148, 115, 103,
-			215, 115, 229, 114, 110, 225, 92, 126, 113, 236, 35, 189, 191, 45,
-			230, 197, 111, 191, 170, 206, 39, 238, 212, 204, 166, 117, 203, 111,
-			237, 35, 73, 67, 31, 29, 200, 43, 228, 179, 58, 81, 70, 12,
-			109, 116, 192, 56, 249, 73, 157, 46, 184, 205, 182, 103, 109, 214,
-			3, 122, 242, 248, 9, 126, 120, 182, 180, 182, 80, 160, 185, 86,
-			80, 119, 61, 159, 151, 97, 194, 122, 240, 241, 26, 77, 57, 172,
-			184, 47, 127, 201, 80, 185, 131, 126, 50, 123, 156, 7, 60, 166,
-			197, 79, 233, 217, 27, 9, 109, 139, 202, 254, 224, 98, 180, 176,
-			162, 141, 37, 10, 33, 179, 139, 21, 214, 196, 164, 75, 152, 118,
-			218, 138, 92, 185, 32, 94, 136, 158, 222, 33, 90, 112, 55, 240,
-			166, 171, 73, 43, 110, 179, 45, 21, 174, 44, 129, 111, 6, 68,
-			100, 148, 169, 7, 65, 243, 244, 220, 220, 246, 246, 118, 214, 68,
-			76, 249, 12, 230, 239, 249, 115, 75, 133, 133, 252, 114, 41, 127,
-			236, 100, 246, 56, 175, 76, 198, 171, 170, 241, 160, 80, 204, 205,
-			210, 108, 218, 86, 5, 3, 173, 108, 115, 27, 43, 12, 110, 122,
-			76, 148, 209, 117, 48, 15, 135, 229, 108, 102, 194, 114, 240, 164,
-			163, 244, 127, 79, 37, 125, 140, 230, 136, 189, 192, 55, 148, 211,
-			185, 18, 45, 148, 210, 116, 62, 87, 42, 148, 50, 132, 222, 94,
-			40, 159, 91, 89, 43, 211, 219, 115, 197, 98, 110, 185, 92, 200,
-			151, 232, 74, 145, 134, 9, 122, 74, 116, 229, 12, 205, 45, 223,
-			65, 65, 212, 51, 178, 42, 22, 187, 8, 118, 20, 171, 96, 137,
-			242, 97, 89, 130, 181, 102, 227, 221, 203, 12, 74, 34, 26, 180,
-			66, 109, 211, 217, 108, 129, 41, 217, 116, 183, 152, 135, 219, 137,
-			241, 106, 97, 166, 83, 37, 241, 136, 211, 222, 17, 101, 9, 25,
-			36, 138, 106, 104, 99, 131, 35, 240, 215, 160, 161, 25, 3, 41,
-			50, 68, 212, 193, 225, 240, 79, 109, 192, 208, 246, 237, 186, 1,
-			255, 84, 12, 109, 98, 215, 12, 33, 152, 126, 71, 159, 30, 184,
-			70, 33, 132, 103, 167, 209, 166, 19, 163, 100, 88, 36, 167, 209,
-			246, 171, 7, 201, 136, 204, 77, 163, 237, 87, 167, 37, 164, 26,
-			218, 254, 3, 87, 144, 27, 68, 98, 26, 237, 10, 245, 255, 101,
-			239, 106, 150, 218, 56, 130, 176, 183, 123, 37, 75, 35, 2, 210,
-			72, 178, 177, 208, 207, 104, 9, 22, 34, 20, 169, 242, 15, 84,
-			44, 35, 176, 193, 85, 246, 37, 254, 9, 169, 202, 141, 90, 208,
-			26, 214, 200, 136, 242, 226, 196, 92, 147, 107, 46, 57, 230, 53,
-			242, 2, 121, 130, 60, 64, 222, 38, 53, 61, 211, 163, 93, 18,
-			156, 28, 114, 244, 205, 141, 122, 166, 119, 102, 189, 61, 95, 207,
-			76, 127, 189, 208, 88, 101, 238, 6, 227, 191, 31, 92, 38, 40,
-			165, 72, 203, 229, 224, 206, 48, 105, 12, 54, 161, 198, 18, 72,
-			108, 222, 108, 80, 170, 21, 213, 180, 236, 208, 246, 18, 95, 61,
-			249, 123, 191, 97, 170, 156, 77, 162, 66, 245, 195, 241, 196, 80,
-			44, 206, 48, 211, 11, 118, 160, 194, 146, 238, 176, 62, 111, 71,
-			135, 18, 21, 220, 178, 63, 233, 9, 81, 78, 17, 65, 162, 114,
-			138, 190, 196, 174, 83, 244, 61, 45, 149, 89, 2, 137, 93, 167,
-			152, 147, 24, 56, 197, 156, 167, 37, 201, 18, 72, 12, 234, 243,
-			226, 41, 41, 230, 37, 46, 65, 187, 49, 152, 166, 120, 253, 243,
-			200, 174, 44, 6, 236, 6, 152, 247, 116, 87, 108, 37, 15, 18,
-			151, 26, 45, 251, 56, 215, 37, 222, 134, 182, 253, 233, 186, 167,
-			37, 86, 188, 14, 18, 111, 59, 197, 130, 196, 158, 83, 44, 120,
-			90, 98, 197, 2, 72, 236, 57, 197, 162, 196, 101, 167, 88, 244,
-			180, 196, 111, 174, 8, 18, 151, 157, 162, 144, 216, 119, 138, 194,
-			211, 210, 77, 150, 64, 98, 223, 41, 150, 36, 174, 56, 197, 146,
-			167, 37, 86, 44, 129, 196, 149, 70, 75, 252, 10, 196, 96, 227,
-			223, 191, 246, 196, 107, 252, 12, 138, 86, 27, 195, 135, 109, 235,
-			36, 184, 138, 239, 35, 51, 143, 106, 60, 57, 178, 84, 48, 38,
-			203, 143, 128, 248, 228, 252, 88, 61, 142, 143, 94, 210, 69, 237,
-			101, 226, 134, 26, 29, 79, 14, 233, 182, 116, 28, 37, 125, 83,
-			69, 252, 84, 237, 134, 231, 161, 14, 21, 34, 163, 148, 28, 83,
-			213, 22, 65, 142, 38, 58, 80, 223, 62, 179, 167, 30, 148, 214,
-			111, 55, 136, 236, 214, 102, 20, 234, 167, 48, 23, 198, 93, 47,
-			142, 171, 223, 29, 193, 38, 209, 187, 216, 212, 58, 177, 131, 9,
-			19, 21, 39, 150, 66, 104, 234, 75, 19, 147, 153, 37, 108, 121,
-			79, 19, 63, 83, 73, 154, 81, 244, 193, 108, 239, 11, 67, 231,
-			131, 247, 11, 159, 233, 9, 165, 99, 40, 92, 7, 19, 227, 83,
-			210, 189, 191, 14, 247, 231, 244, 140, 250, 148, 112, 143, 235, 80,
-			98, 201, 147, 184, 62, 83, 102, 9, 37, 174, 87, 107, 148, 48,
-			72, 31, 244, 6, 84, 130, 62, 29, 180, 101, 201, 49, 248, 204,
-			135, 120, 96, 30, 70, 111, 195, 120, 60, 12, 132, 53, 232, 249,
-			210, 223, 128, 245, 186, 237, 212, 203, 233, 126, 10, 44, 233, 94,
-			139, 51, 44, 161, 196, 141, 185, 178, 248, 197, 35, 139, 32, 113,
-			0, 75, 141, 159, 136, 218, 106, 108, 176, 118, 108, 137, 192, 40,
-			100, 209, 35, 127, 127, 70, 20, 143, 241, 120, 28, 39, 209, 225,
-			196, 68, 45, 135, 49, 121, 201, 53, 245, 117, 120, 58, 49, 127,
-			78, 12, 201, 113, 120, 192, 119, 176, 13, 166, 53, 31, 153, 138,
-			222, 198, 231, 76, 250, 165, 116, 12, 48, 142, 212, 249, 187, 240,
-			52, 9, 15, 109, 72, 107, 6, 163, 195, 173, 1, 108, 84, 236,
-			3, 235, 175, 118, 0, 11, 44, 121, 18, 7, 77, 197, 18, 74,
-			28, 44, 126, 78, 153, 127, 228, 89, 54, 161, 30, 220, 73, 121,
-			167, 83, 75, 16, 61, 229, 97, 213, 227, 177, 120, 104, 197, 62,
-			89, 159, 45, 163, 47, 253, 77, 24, 44, 217, 222, 49, 167, 59,
-			228, 105, 212, 174, 106, 179, 200, 239, 13, 181, 177, 106, 77, 172,
-			146, 101, 95, 226, 16, 110, 4, 157, 76, 138, 232, 179, 93, 154,
-			60, 7, 73, 86, 216, 140, 239, 75, 127, 8, 155, 252, 182, 252,
-			156, 110, 205, 102, 180, 163, 27, 22, 121, 240, 62, 74, 28, 214,
-			234, 214, 76, 78, 226, 22, 84, 180, 25, 71, 70, 154, 53, 161,
-			161, 146, 51, 163, 163, 134, 45, 24, 222, 176, 93, 229, 168, 53,
-			155, 209, 110, 114, 203, 253, 167, 200, 161, 196, 173, 185, 50, 37,
-			116, 145, 155, 220, 134, 74, 176, 98, 153, 164, 151, 227, 83, 213,
-			59, 121, 240, 125, 143, 232, 138, 250, 89, 139, 26, 103, 57, 139,
-			121, 95, 250, 219, 176, 197, 15, 159, 207, 233, 142, 216, 162, 118,
-			153, 219, 206, 98, 30, 37, 110, 207, 149, 233, 234, 37, 185, 204,
-			29, 184, 215, 88, 82, 143, 76, 245, 250, 81, 252, 250, 181, 74,
-			189, 169, 20, 114, 53, 46, 88, 183, 241, 117, 35, 39, 229, 37,
-			238, 148, 2, 150, 60, 137, 59, 139, 95, 178, 132, 18, 119, 238,
-			220, 181, 95, 106, 65, 226, 46, 220, 179, 63, 21, 124, 45, 113,
-			39, 133, 188, 196, 93, 215, 137, 246, 200, 187, 174, 147, 2, 74,
-			220, 189, 115, 215, 69, 85, 127, 204, 138, 123, 255, 49, 170, 250,
-			96, 194, 219, 76, 92, 245, 175, 81, 210, 159, 158, 40, 61, 177,
-			45, 247, 194, 35, 57, 43, 32, 54, 145, 82, 241, 21, 196, 163,
-			76, 232, 2, 151, 66, 151, 84, 192, 131, 217, 128, 167, 44, 240,
-			36, 186, 176, 97, 144, 254, 167, 172, 137, 28, 221, 105, 180, 241,
-			143, 17, 100, 71, 148, 120, 111, 127, 255, 224, 194, 6, 65, 130,
-			255, 244, 248, 66, 14, 82, 10, 231, 9, 197, 68, 31, 15, 197,
-			92, 227, 189, 132, 193, 248, 143, 51, 6, 140, 47, 126, 20, 140,
-			127, 245, 9, 140, 127, 2, 227, 255, 51, 24, 95, 22, 191, 123,
-			6, 221, 44, 92, 91, 244, 26, 191, 121, 42, 245, 169, 217, 27,
-			231, 116, 91, 230, 240, 56, 122, 107, 216, 135, 122, 252, 25, 211,
-			17, 211, 254, 195, 55, 147, 131, 120, 52, 236, 77, 161, 13, 37,
-			164, 36, 83, 150, 241, 179, 201, 153, 221, 240, 58, 184, 80, 79,
-			190, 123, 241, 252, 213, 158, 246, 150, 223, 236, 239, 61, 223, 127,
-			252, 146, 200, 158, 162, 119, 234, 205, 228, 128, 0, 208, 229, 122,
-			21, 182, 228, 162, 176, 96, 41, 60, 13, 199, 23, 73, 108, 54,
-			215, 76, 193, 12, 198, 32, 11, 133, 170, 88, 99, 12, 210, 132,
-			114, 208, 85, 203, 103, 39, 71, 171, 180, 16, 173, 170, 120, 212,
-			167, 203, 210, 239, 13, 205, 164, 58, 137, 46, 68, 10, 166, 52,
-			97, 129, 215, 161, 107, 57, 221, 190, 144, 130, 41, 205, 98, 41,
-			5, 83, 154, 179, 115, 214, 144, 39, 177, 5, 55, 130, 238, 165,
-			229, 142, 33, 202, 163, 209, 139, 195, 167, 107, 107, 107, 25, 120,
-			210, 130, 102, 57, 5, 79, 90, 25, 120, 210, 114, 11, 158, 134,
-			39, 173, 90, 93, 124, 193, 232, 164, 13, 245, 160, 125, 105, 69,
-			207, 242, 236, 164, 113, 67, 27, 90, 188, 222, 233, 213, 178, 237,
-			172, 232, 103, 110, 187, 213, 91, 67, 133, 118, 181, 38, 150, 24,
-			55, 116, 160, 18, 204, 211, 17, 165, 126, 201, 142, 16, 142, 78,
-			46, 211, 232, 160, 3, 237, 122, 10, 29, 116, 50, 232, 160, 227,
-			86, 55, 141, 14, 58, 115, 101, 209, 99, 116, 160, 160, 26, 52,
-			148, 189, 38, 127, 165, 5, 13, 12, 20, 116, 42, 41, 96, 160,
-			50, 192, 64, 21, 103, 83, 192, 64, 85, 164, 157, 167, 28, 133,
-			74, 65, 155, 114, 92, 194, 17, 145, 154, 159, 175, 94, 1, 22,
-			53, 46, 232, 130, 170, 166, 112, 65, 55, 131, 11, 186, 197, 90,
-			10, 23, 116, 111, 206, 11, 197, 184, 32, 128, 213, 160, 202, 121,
-			149, 230, 162, 179, 182, 150, 6, 0, 1, 116, 111, 241, 34, 79,
-			45, 22, 82, 0, 32, 104, 246, 82, 0, 32, 152, 238, 82, 254,
-			21, 0, 0, 255, 255, 252, 101, 255, 63, 208, 255, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 9, 116, 36, 89,
+			121, 38, 170, 88, 50, 42, 117, 165, 170, 146, 66, 123, 214, 118,
+			43, 139, 70, 82, 181, 42, 171, 171, 186, 27, 232, 234, 133, 86,
+			73, 234, 46, 209, 213, 85, 133, 164, 162, 141, 61, 61, 58, 161,
+			204, 72, 41, 168, 84, 68, 18, 17, 89, 170, 196, 199, 239, 120,
+			24, 214, 193, 24, 183, 89, 108, 14, 15, 195, 96, 192, 152, 7,
+			131, 129, 241, 216, 248, 224, 13, 48, 120, 140, 49, 54, 131, 109,
+			192, 128, 159, 177, 121, 140, 193, 96, 108, 252, 108, 227, 229, 188,
+			119, 190, 255, 46, 17, 153, 82, 109, 77, 183, 205, 120, 232, 211,
+			231, 148, 254, 200, 136, 187, 252, 247, 191, 255, 118, 255, 255, 191,
+			236, 245, 15, 177, 227, 235, 81, 165, 186, 17, 71, 155, 65, 107,
+			179, 18, 197, 235, 199, 27, 173, 106, 112, 188, 26, 52, 107, 199,
+			189, 166, 252, 227, 242, 137, 227, 85, 47, 169, 52, 227, 40, 141,
+			92, 27, 143, 202, 143, 176, 222, 243, 107, 207, 243, 171, 233, 146,
+			95, 119, 111, 101, 189, 27, 94, 178, 177, 234, 53, 214, 163, 113,
+			131, 27, 83, 123, 78, 238, 169, 224, 181, 202, 25, 47, 217, 152,
+			109, 172, 71, 75, 197, 13, 249, 151, 123, 128, 177, 13, 255, 202,
+			106, 45, 88, 247, 147, 116, 220, 228, 198, 84, 239, 82, 239, 134,
+			127, 101, 158, 30, 148, 47, 177, 161, 7, 253, 84, 180, 125, 113,
+			233, 236, 146, 255, 252, 150, 159, 164, 238, 36, 115, 34, 122, 70,
+			237, 247, 157, 220, 43, 218, 215, 99, 88, 146, 63, 187, 183, 178,
+			193, 90, 180, 21, 54, 34, 175, 182, 90, 15, 26, 126, 232, 109,
+			250, 178, 151, 1, 245, 195, 3, 242, 121, 249, 168, 154, 197, 197,
+			165, 179, 24, 88, 18, 172, 135, 126, 109, 181, 21, 55, 168, 155,
+			222, 165, 94, 241, 228, 98, 220, 40, 63, 143, 185, 167, 253, 245,
+			32, 188, 216, 68, 19, 143, 99, 92, 57, 28, 153, 215, 198, 81,
+			185, 197, 134, 30, 8, 194, 32, 217, 232, 236, 172, 194, 134, 90,
+			244, 96, 53, 106, 250, 177, 151, 6, 81, 184, 26, 212, 228, 80,
+			7, 197, 79, 231, 213, 47, 139, 53, 183, 194, 88, 61, 138, 171,
+			254, 42, 122, 30, 55, 119, 30, 96, 47, 189, 130, 133, 42, 47,
+			176, 161, 57, 47, 172, 250, 141, 239, 168, 219, 242, 39, 12, 182,
+			247, 98, 231, 83, 247, 48, 235, 223, 225, 227, 62, 253, 108, 177,
+			6, 252, 203, 110, 128, 127, 73, 24, 226, 201, 197, 184, 225, 30,
+			101, 78, 146, 122, 105, 43, 25, 183, 8, 123, 174, 152, 136, 232,
+			104, 153, 126, 89, 146, 111, 228, 86, 197, 190, 246, 170, 28, 97,
+			187, 253, 56, 142, 226, 213, 77, 63, 73, 188, 117, 127, 188, 64,
+			221, 246, 211, 195, 135, 197, 179, 163, 119, 179, 162, 90, 35, 119,
+			130, 141, 156, 153, 93, 62, 179, 58, 123, 246, 193, 243, 171, 23,
+			207, 45, 95, 88, 152, 91, 124, 96, 113, 97, 126, 160, 199, 45,
+			50, 123, 249, 204, 236, 137, 1, 195, 101, 204, 89, 62, 51, 123,
+			242, 206, 167, 13, 152, 71, 91, 172, 63, 63, 68, 247, 0, 155,
+			184, 120, 225, 236, 249, 217, 249, 213, 229, 149, 217, 149, 139, 203,
+			93, 141, 236, 102, 189, 226, 231, 197, 115, 15, 14, 24, 238, 110,
+			214, 251, 156, 133, 165, 197, 7, 158, 11, 208, 4, 120, 225, 226,
+			233, 179, 139, 203, 103, 22, 230, 7, 44, 183, 143, 237, 90, 88,
+			90, 58, 191, 180, 48, 63, 96, 187, 253, 172, 56, 55, 123, 110,
+			110, 225, 236, 194, 252, 64, 225, 228, 203, 77, 182, 107, 57, 141,
+			98, 111, 221, 119, 79, 177, 254, 252, 150, 114, 39, 4, 54, 118,
+			216, 102, 165, 14, 68, 97, 83, 220, 199, 250, 114, 84, 239, 142,
+			139, 223, 183, 111, 132, 210, 72, 126, 57, 178, 117, 191, 159, 245,
+			231, 41, 89, 245, 189, 3, 117, 95, 163, 133, 60, 81, 170, 22,
+			118, 32, 212, 171, 180, 112, 186, 240, 253, 150, 215, 12, 158, 245,
+			214, 89, 182, 203, 45, 236, 233, 249, 99, 195, 96, 31, 177, 153,
+			209, 239, 90, 123, 122, 220, 147, 31, 176, 249, 92, 212, 108, 199,
+			193, 250, 70, 202, 79, 222, 118, 226, 233, 124, 101, 195, 231, 103,
+			47, 206, 45, 242, 217, 86, 186, 17, 197, 73, 133, 49, 126, 54,
+			168, 250, 97, 226, 215, 120, 43, 172, 249, 49, 79, 55, 124, 62,
+			219, 244, 170, 120, 83, 252, 50, 195, 159, 227, 199, 73, 16, 133,
+			252, 100, 229, 54, 62, 133, 23, 202, 242, 167, 242, 244, 221, 140,
+			183, 163, 22, 223, 244, 218, 60, 140, 82, 222, 74, 124, 158, 110,
+			4, 9, 7, 131, 226, 254, 149, 170, 223, 76, 121, 16, 242, 106,
+			180, 217, 108, 4, 152, 24, 223, 10, 210, 13, 158, 102, 205, 87,
+			24, 127, 174, 108, 33, 90, 75, 189, 32, 228, 30, 175, 70, 205,
+			54, 143, 234, 249, 215, 184, 151, 50, 198, 233, 191, 141, 52, 109,
+			158, 58, 126, 124, 107, 107, 171, 226, 209, 72, 5, 119, 23, 239,
+			37, 199, 207, 46, 206, 45, 156, 91, 94, 56, 118, 178, 114, 27,
+			99, 252, 98, 216, 240, 147, 132, 199, 254, 243, 91, 65, 236, 215,
+			248, 90, 155, 123, 205, 102, 35, 168, 122, 107, 13, 159, 55, 188,
+			45, 30, 197, 220, 91, 143, 125, 191, 198, 211, 8, 99, 221, 138,
+			131, 52, 8, 215, 103, 120, 18, 213, 211, 45, 47, 246, 25, 175,
+			5, 73, 26, 7, 107, 173, 180, 3, 77, 106, 100, 65, 210, 241,
+			66, 20, 114, 47, 228, 229, 217, 101, 190, 184, 92, 230, 167, 103,
+			151, 23, 151, 103, 24, 127, 100, 113, 229, 204, 249, 139, 43, 252,
+			145, 217, 165, 165, 217, 115, 43, 139, 11, 203, 252, 252, 18, 159,
+			59, 127, 110, 126, 113, 101, 241, 252, 185, 101, 126, 254, 1, 62,
+			123, 238, 185, 252, 161, 197, 115, 243, 51, 220, 15, 210, 13, 63,
+			230, 254, 149, 102, 140, 209, 71, 49, 15, 128, 64, 191, 86, 97,
+			124, 217, 247, 59, 186, 175, 71, 98, 213, 146, 166, 95, 13, 234,
+			65, 149, 55, 188, 112, 189, 229, 173, 251, 124, 61, 186, 236, 199,
+			97, 16, 174, 243, 166, 31, 111, 6, 9, 22, 49, 225, 94, 88,
+			99, 188, 17, 108, 6, 41, 17, 81, 178, 125, 70, 21, 198, 138,
+			204, 48, 93, 107, 160, 103, 55, 254, 42, 186, 150, 219, 83, 98,
+			189, 204, 44, 246, 137, 63, 191, 109, 49, 211, 233, 113, 237, 67,
+			61, 223, 111, 148, 254, 194, 226, 11, 87, 154, 81, 226, 39, 188,
+			26, 133, 169, 31, 166, 199, 188, 90, 13, 3, 39, 28, 39, 98,
+			179, 242, 116, 195, 75, 9, 240, 19, 234, 172, 233, 85, 47, 97,
+			148, 32, 21, 162, 196, 217, 70, 131, 207, 45, 94, 152, 215, 191,
+			4, 97, 146, 130, 102, 18, 62, 229, 85, 211, 150, 215, 224, 205,
+			141, 118, 18, 84, 189, 6, 209, 87, 50, 205, 189, 216, 231, 177,
+			159, 4, 53, 76, 50, 8, 137, 246, 152, 234, 113, 6, 83, 237,
+			232, 42, 246, 155, 81, 18, 164, 81, 220, 230, 53, 47, 245, 120,
+			146, 198, 173, 106, 218, 138, 253, 132, 63, 175, 149, 164, 60, 246,
+			235, 126, 236, 131, 74, 211, 13, 127, 147, 175, 181, 25, 62, 15,
+			98, 46, 100, 58, 6, 249, 112, 148, 164, 138, 52, 37, 27, 226,
+			137, 31, 95, 14, 170, 62, 159, 189, 176, 200, 131, 4, 155, 160,
+			198, 131, 48, 245, 227, 208, 107, 52, 218, 32, 57, 188, 189, 230,
+			85, 47, 249, 97, 13, 131, 98, 188, 26, 197, 177, 159, 52, 163,
+			144, 70, 190, 116, 97, 142, 111, 250, 233, 70, 84, 75, 104, 74,
+			179, 115, 103, 137, 144, 26, 109, 208, 164, 87, 219, 12, 194, 100,
+			70, 236, 28, 177, 167, 176, 27, 163, 58, 227, 121, 110, 35, 16,
+			28, 36, 220, 107, 52, 162, 45, 65, 206, 107, 190, 24, 77, 45,
+			136, 253, 106, 42, 198, 82, 109, 4, 126, 152, 38, 248, 185, 30,
+			132, 94, 35, 120, 129, 207, 184, 144, 70, 92, 75, 173, 132, 39,
+			169, 23, 131, 222, 59, 71, 95, 97, 140, 49, 203, 233, 49, 92,
+			235, 80, 113, 47, 123, 145, 193, 108, 167, 199, 236, 113, 173, 35,
+			230, 61, 165, 45, 126, 33, 142, 106, 45, 44, 152, 199, 133, 126,
+			193, 47, 46, 157, 21, 3, 171, 122, 161, 30, 14, 186, 246, 211,
+			234, 6, 54, 138, 16, 103, 64, 237, 146, 159, 182, 226, 48, 225,
+			231, 206, 175, 172, 62, 112, 254, 226, 185, 121, 140, 33, 109, 129,
+			170, 106, 62, 15, 8, 231, 177, 63, 153, 240, 48, 226, 73, 171,
+			186, 145, 125, 218, 207, 10, 24, 133, 225, 90, 71, 28, 87, 65,
+			166, 107, 29, 25, 122, 170, 130, 44, 215, 58, 114, 226, 20, 251,
+			181, 34, 13, 216, 112, 173, 83, 230, 253, 165, 247, 21, 249, 98,
+			24, 164, 129, 151, 98, 200, 225, 54, 44, 96, 84, 231, 37, 57,
+			168, 31, 131, 132, 7, 242, 155, 26, 70, 36, 241, 137, 133, 23,
+			43, 154, 4, 160, 122, 236, 74, 241, 5, 22, 56, 221, 240, 153,
+			28, 46, 240, 142, 207, 82, 127, 179, 25, 197, 94, 220, 230, 141,
+			168, 74, 189, 241, 169, 102, 28, 93, 14, 106, 126, 141, 95, 14,
+			60, 62, 153, 233, 12, 147, 124, 107, 35, 168, 110, 240, 100, 35,
+			106, 53, 106, 124, 13, 11, 134, 101, 245, 242, 163, 6, 166, 131,
+			144, 63, 24, 69, 235, 141, 140, 54, 151, 252, 164, 181, 73, 251,
+			80, 82, 9, 233, 186, 213, 168, 49, 45, 8, 177, 78, 20, 36,
+			199, 168, 154, 34, 74, 243, 120, 213, 107, 52, 48, 220, 60, 149,
+			205, 200, 161, 108, 5, 141, 6, 111, 120, 173, 176, 186, 65, 155,
+			132, 95, 246, 99, 112, 31, 79, 210, 38, 61, 19, 51, 158, 76,
+			56, 212, 52, 30, 97, 127, 138, 221, 226, 199, 60, 9, 106, 62,
+			240, 187, 88, 207, 99, 241, 82, 24, 109, 9, 246, 32, 62, 201,
+			183, 195, 131, 148, 111, 121, 146, 116, 229, 72, 189, 70, 236, 123,
+			181, 246, 12, 15, 82, 70, 36, 38, 81, 136, 119, 9, 137, 114,
+			8, 188, 30, 248, 141, 90, 133, 47, 134, 138, 26, 147, 12, 71,
+			144, 94, 177, 223, 108, 180, 57, 201, 74, 198, 189, 45, 175, 45,
+			249, 85, 70, 103, 170, 47, 238, 95, 9, 146, 52, 193, 86, 138,
+			253, 180, 69, 252, 117, 246, 236, 210, 194, 236, 252, 115, 87, 23,
+			190, 111, 113, 121, 101, 89, 210, 45, 118, 249, 78, 115, 172, 69,
+			126, 18, 78, 138, 185, 102, 83, 109, 251, 41, 159, 106, 250, 241,
+			134, 215, 76, 120, 144, 159, 247, 100, 194, 215, 162, 90, 155, 7,
+			9, 227, 235, 126, 8, 218, 36, 238, 64, 223, 214, 27, 237, 105,
+			204, 158, 39, 41, 86, 4, 40, 136, 154, 126, 184, 19, 53, 99,
+			193, 49, 180, 56, 5, 139, 140, 125, 111, 83, 45, 59, 152, 97,
+			133, 63, 178, 225, 135, 138, 37, 116, 17, 68, 158, 127, 209, 202,
+			87, 189, 70, 181, 213, 240, 82, 159, 81, 171, 64, 68, 12, 212,
+			18, 59, 110, 53, 32, 64, 119, 88, 65, 224, 98, 54, 228, 93,
+			106, 140, 252, 90, 241, 154, 32, 145, 188, 144, 164, 137, 23, 132,
+			88, 239, 75, 62, 254, 1, 31, 89, 143, 189, 48, 101, 146, 163,
+			169, 241, 121, 213, 42, 228, 100, 26, 241, 173, 141, 200, 191, 236,
+			199, 188, 25, 37, 137, 143, 255, 209, 253, 38, 132, 57, 33, 73,
+			109, 32, 158, 198, 62, 161, 209, 75, 24, 247, 120, 226, 87, 99,
+			63, 173, 144, 108, 237, 30, 29, 118, 242, 102, 20, 67, 28, 213,
+			35, 205, 108, 12, 176, 16, 103, 80, 65, 166, 107, 157, 114, 159,
+			162, 32, 203, 181, 78, 29, 191, 143, 253, 183, 2, 49, 27, 211,
+			181, 30, 50, 79, 151, 222, 81, 144, 27, 73, 201, 63, 95, 48,
+			255, 238, 101, 154, 145, 248, 192, 111, 65, 154, 240, 208, 223, 146,
+			4, 5, 244, 205, 73, 238, 13, 49, 225, 95, 105, 250, 213, 84,
+			242, 83, 106, 250, 234, 187, 95, 109, 123, 94, 15, 226, 36, 101,
+			124, 205, 175, 99, 78, 216, 226, 232, 40, 191, 197, 43, 252, 1,
+			47, 104, 180, 98, 31, 123, 191, 22, 1, 117, 114, 205, 133, 122,
+			167, 168, 130, 229, 72, 43, 141, 56, 104, 163, 213, 132, 6, 69,
+			170, 59, 141, 153, 168, 127, 5, 10, 33, 58, 2, 143, 12, 106,
+			224, 123, 80, 18, 136, 112, 2, 146, 88, 217, 68, 176, 194, 74,
+			64, 81, 167, 151, 124, 191, 201, 155, 17, 141, 146, 97, 9, 197,
+			214, 104, 19, 39, 192, 0, 183, 188, 32, 205, 116, 32, 193, 91,
+			210, 72, 176, 163, 246, 54, 78, 34, 70, 238, 215, 216, 53, 229,
+			142, 220, 126, 154, 29, 119, 47, 145, 222, 194, 196, 10, 208, 198,
+			2, 204, 42, 72, 0, 236, 137, 154, 226, 241, 170, 55, 82, 87,
+			196, 38, 198, 186, 85, 163, 205, 205, 86, 8, 94, 73, 83, 142,
+			163, 214, 250, 6, 159, 4, 190, 90, 201, 36, 88, 178, 223, 168,
+			117, 14, 56, 235, 122, 134, 39, 129, 148, 72, 162, 49, 168, 221,
+			90, 188, 119, 244, 15, 126, 1, 205, 2, 168, 159, 225, 107, 173,
+			148, 199, 30, 68, 104, 231, 232, 114, 179, 10, 210, 196, 111, 212,
+			53, 137, 155, 134, 107, 61, 164, 229, 41, 145, 177, 150, 167, 166,
+			229, 90, 15, 157, 184, 159, 253, 189, 69, 36, 110, 185, 214, 115,
+			205, 211, 165, 175, 90, 60, 111, 189, 112, 111, 45, 138, 211, 107,
+			83, 59, 144, 183, 152, 242, 205, 232, 178, 159, 96, 121, 211, 136,
+			43, 99, 79, 80, 16, 120, 33, 49, 126, 200, 93, 174, 77, 72,
+			249, 35, 104, 168, 218, 240, 189, 48, 225, 173, 38, 120, 81, 59,
+			39, 88, 215, 189, 120, 205, 91, 247, 43, 122, 133, 49, 235, 77,
+			40, 112, 177, 95, 5, 5, 138, 54, 162, 250, 85, 136, 122, 106,
+			107, 195, 75, 137, 155, 4, 41, 25, 40, 107, 254, 52, 198, 59,
+			31, 249, 80, 66, 210, 13, 236, 28, 197, 170, 245, 87, 164, 129,
+			9, 73, 81, 37, 100, 128, 89, 199, 188, 238, 5, 13, 191, 150,
+			163, 183, 83, 48, 103, 30, 63, 205, 113, 206, 31, 152, 93, 60,
+			187, 48, 191, 122, 97, 105, 65, 219, 17, 170, 153, 109, 95, 99,
+			235, 133, 92, 219, 216, 24, 145, 182, 191, 165, 93, 37, 176, 49,
+			21, 84, 252, 138, 100, 39, 98, 228, 107, 62, 230, 41, 5, 131,
+			95, 227, 97, 180, 53, 173, 137, 196, 50, 92, 235, 185, 154, 72,
+			44, 211, 181, 158, 171, 137, 196, 2, 89, 156, 184, 159, 189, 212,
+			100, 102, 161, 199, 181, 107, 61, 27, 70, 233, 103, 13, 190, 220,
+			106, 54, 35, 82, 46, 177, 53, 209, 56, 124, 71, 113, 144, 110,
+			108, 74, 237, 89, 42, 157, 215, 48, 39, 128, 200, 179, 65, 234,
+			199, 94, 131, 195, 19, 38, 20, 231, 0, 58, 85, 234, 133, 233,
+			182, 109, 162, 244, 206, 106, 20, 10, 173, 191, 139, 85, 50, 222,
+			244, 210, 141, 36, 167, 183, 87, 216, 201, 243, 199, 159, 208, 255,
+			72, 119, 46, 64, 119, 174, 21, 118, 179, 62, 102, 23, 72, 117,
+			246, 205, 253, 64, 24, 0, 195, 181, 124, 115, 76, 65, 166, 107,
+			249, 165, 125, 242, 69, 195, 181, 234, 250, 69, 72, 159, 186, 233,
+			40, 200, 116, 173, 186, 126, 209, 116, 173, 117, 253, 34, 246, 240,
+			186, 89, 84, 16, 126, 43, 237, 99, 207, 98, 166, 221, 227, 218,
+			141, 158, 200, 40, 221, 199, 103, 243, 118, 79, 148, 233, 228, 32,
+			152, 235, 175, 2, 99, 150, 141, 41, 53, 138, 131, 236, 153, 204,
+			182, 105, 74, 155, 230, 68, 249, 36, 90, 210, 11, 43, 172, 238,
+			140, 53, 18, 51, 76, 35, 190, 238, 167, 180, 212, 88, 67, 162,
+			41, 52, 224, 184, 214, 166, 169, 33, 195, 181, 54, 251, 134, 21,
+			100, 185, 214, 230, 216, 56, 187, 159, 186, 50, 92, 43, 52, 39,
+			202, 183, 235, 38, 184, 151, 112, 88, 63, 113, 213, 75, 124, 190,
+			225, 95, 129, 166, 67, 214, 188, 95, 89, 231, 147, 222, 90, 181,
+			230, 215, 43, 149, 202, 164, 106, 221, 40, 160, 137, 162, 130, 208,
+			96, 239, 144, 130, 44, 215, 10, 199, 198, 25, 99, 166, 109, 184,
+			118, 220, 243, 2, 3, 43, 104, 3, 251, 113, 113, 31, 155, 99,
+			182, 109, 96, 186, 169, 57, 86, 122, 90, 55, 30, 51, 181, 39,
+			175, 252, 105, 5, 150, 12, 32, 177, 141, 208, 136, 131, 86, 250,
+			20, 100, 184, 86, 218, 239, 42, 200, 114, 173, 116, 100, 148, 253,
+			188, 65, 253, 25, 174, 213, 54, 15, 149, 126, 198, 224, 139, 117,
+			14, 255, 128, 15, 114, 71, 23, 90, 143, 130, 41, 64, 210, 115,
+			77, 138, 68, 169, 210, 207, 201, 149, 156, 15, 18, 50, 132, 161,
+			222, 108, 248, 94, 205, 143, 153, 180, 30, 195, 106, 163, 85, 147,
+			10, 202, 122, 112, 153, 20, 66, 225, 82, 174, 16, 155, 246, 46,
+			249, 9, 95, 139, 163, 173, 196, 143, 19, 158, 120, 151, 105, 139,
+			209, 75, 153, 31, 129, 201, 79, 233, 51, 53, 67, 32, 186, 45,
+			17, 109, 152, 64, 97, 187, 183, 164, 32, 203, 181, 218, 7, 14,
+			178, 62, 102, 218, 166, 91, 248, 161, 158, 151, 24, 2, 211, 32,
+			223, 31, 42, 14, 178, 159, 55, 153, 109, 155, 102, 143, 107, 191,
+			216, 48, 199, 75, 111, 55, 249, 172, 50, 48, 207, 172, 172, 92,
+			88, 22, 102, 102, 180, 93, 105, 6, 155, 120, 0, 152, 6, 151,
+			9, 50, 221, 91, 250, 131, 184, 215, 74, 55, 252, 48, 149, 118,
+			75, 5, 142, 140, 0, 238, 0, 175, 158, 194, 70, 137, 54, 125,
+			222, 10, 165, 103, 5, 90, 67, 178, 17, 197, 41, 247, 54, 163,
+			86, 40, 220, 0, 129, 196, 204, 118, 45, 198, 107, 52, 128, 35,
+			90, 7, 40, 78, 208, 93, 54, 55, 253, 26, 172, 77, 112, 23,
+			70, 238, 56, 12, 60, 32, 190, 190, 222, 242, 160, 218, 74, 71,
+			212, 6, 80, 11, 113, 214, 244, 226, 52, 128, 174, 29, 107, 222,
+			148, 249, 45, 42, 124, 62, 130, 36, 98, 220, 75, 33, 247, 72,
+			126, 54, 189, 152, 186, 171, 48, 182, 155, 21, 128, 182, 2, 225,
+			173, 168, 64, 3, 96, 239, 144, 2, 45, 128, 163, 99, 236, 81,
+			102, 22, 12, 215, 249, 17, 163, 231, 53, 134, 241, 196, 243, 190,
+			62, 102, 21, 12, 195, 181, 127, 196, 40, 64, 109, 182, 11, 216,
+			58, 246, 43, 13, 147, 99, 32, 128, 12, 2, 247, 41, 208, 4,
+			120, 240, 16, 171, 208, 187, 134, 107, 191, 202, 48, 7, 202, 92,
+			91, 44, 208, 28, 133, 108, 210, 122, 86, 24, 109, 49, 249, 57,
+			186, 122, 149, 97, 246, 41, 208, 4, 184, 103, 47, 123, 58, 181,
+			102, 186, 246, 143, 161, 181, 233, 78, 146, 129, 60, 202, 154, 37,
+			29, 50, 232, 108, 214, 52, 232, 75, 213, 172, 104, 104, 207, 94,
+			246, 12, 106, 214, 114, 237, 199, 208, 236, 209, 92, 179, 176, 131,
+			248, 154, 239, 135, 188, 217, 90, 107, 8, 169, 42, 57, 235, 220,
+			236, 178, 106, 215, 50, 232, 83, 213, 174, 101, 2, 220, 179, 23,
+			44, 181, 96, 152, 182, 107, 255, 56, 218, 61, 129, 207, 98, 159,
+			111, 249, 177, 207, 235, 94, 234, 53, 56, 57, 244, 19, 94, 107,
+			197, 202, 102, 147, 146, 154, 40, 90, 53, 15, 254, 245, 227, 134,
+			217, 171, 64, 19, 224, 158, 189, 236, 110, 106, 190, 224, 218, 175,
+			70, 243, 199, 186, 84, 153, 45, 47, 201, 116, 24, 152, 211, 121,
+			237, 78, 53, 93, 48, 232, 107, 13, 154, 0, 247, 236, 101, 253,
+			204, 180, 45, 215, 249, 9, 163, 231, 221, 134, 193, 250, 152, 101,
+			99, 142, 63, 97, 20, 75, 236, 63, 218, 204, 182, 45, 44, 255,
+			79, 27, 230, 88, 233, 111, 172, 155, 228, 157, 205, 150, 22, 78,
+			82, 16, 205, 64, 241, 129, 65, 29, 74, 123, 251, 234, 134, 251,
+			12, 41, 197, 180, 45, 165, 209, 68, 188, 241, 42, 38, 60, 121,
+			4, 200, 33, 160, 13, 121, 114, 111, 67, 69, 135, 165, 10, 175,
+			106, 184, 206, 167, 224, 228, 246, 18, 63, 63, 220, 171, 218, 249,
+			211, 51, 44, 255, 222, 195, 23, 151, 87, 120, 210, 106, 194, 1,
+			161, 79, 210, 68, 23, 51, 64, 70, 195, 79, 59, 12, 112, 106,
+			15, 170, 41, 219, 174, 63, 229, 140, 220, 150, 244, 13, 43, 83,
+			29, 228, 209, 65, 232, 138, 5, 105, 91, 190, 70, 143, 181, 228,
+			200, 204, 114, 175, 122, 73, 173, 138, 28, 179, 151, 16, 99, 34,
+			39, 100, 183, 193, 60, 53, 41, 187, 17, 115, 152, 134, 89, 159,
+			183, 47, 137, 53, 74, 230, 100, 65, 242, 217, 63, 45, 9, 31,
+			52, 97, 128, 40, 250, 93, 5, 90, 0, 71, 70, 217, 255, 7,
+			225, 103, 97, 247, 189, 203, 48, 39, 74, 223, 52, 248, 108, 94,
+			187, 128, 71, 8, 216, 143, 120, 205, 143, 131, 203, 138, 122, 224,
+			44, 132, 86, 32, 119, 135, 224, 19, 9, 223, 130, 167, 67, 111,
+			21, 182, 131, 167, 2, 76, 0, 150, 21, 188, 50, 154, 172, 206,
+			147, 227, 213, 107, 128, 214, 244, 28, 131, 68, 9, 97, 73, 32,
+			107, 81, 186, 145, 253, 12, 3, 101, 82, 175, 234, 36, 233, 162,
+			90, 104, 203, 183, 42, 185, 223, 137, 24, 54, 189, 20, 110, 181,
+			220, 119, 26, 93, 134, 67, 24, 208, 32, 33, 164, 111, 88, 129,
+			22, 192, 177, 113, 218, 124, 182, 235, 252, 156, 209, 243, 203, 114,
+			243, 129, 3, 252, 156, 81, 220, 199, 158, 205, 108, 219, 198, 222,
+			123, 159, 97, 30, 46, 205, 1, 143, 65, 13, 50, 176, 30, 248,
+			49, 176, 176, 147, 235, 40, 163, 133, 54, 207, 157, 145, 97, 43,
+			201, 161, 217, 36, 102, 222, 167, 196, 140, 77, 43, 249, 62, 163,
+			119, 191, 2, 45, 244, 120, 136, 179, 191, 133, 44, 183, 177, 146,
+			31, 52, 204, 125, 165, 175, 152, 208, 99, 18, 63, 157, 225, 129,
+			84, 205, 19, 237, 191, 72, 35, 158, 92, 10, 154, 217, 238, 233,
+			240, 50, 2, 183, 228, 183, 247, 146, 164, 181, 73, 123, 79, 121,
+			178, 253, 90, 158, 251, 102, 218, 76, 70, 247, 65, 114, 13, 95,
+			189, 242, 232, 11, 7, 107, 212, 76, 131, 77, 201, 80, 229, 150,
+			130, 99, 9, 84, 68, 46, 137, 52, 110, 37, 210, 204, 213, 189,
+			195, 165, 198, 167, 240, 178, 127, 197, 219, 108, 54, 124, 56, 78,
+			253, 16, 175, 75, 228, 66, 31, 32, 131, 27, 198, 20, 95, 184,
+			34, 101, 187, 210, 27, 64, 40, 53, 63, 132, 248, 105, 225, 176,
+			150, 71, 121, 222, 83, 225, 179, 153, 192, 151, 218, 133, 240, 188,
+			37, 12, 172, 241, 194, 194, 210, 195, 139, 203, 203, 139, 231, 207,
+			173, 206, 47, 156, 91, 92, 152, 215, 171, 4, 2, 250, 160, 218,
+			111, 54, 20, 49, 251, 131, 70, 255, 168, 2, 45, 252, 58, 1,
+			197, 204, 180, 11, 174, 243, 107, 70, 207, 135, 37, 1, 129, 207,
+			255, 154, 34, 160, 2, 8, 232, 67, 79, 40, 1, 21, 136, 128,
+			62, 164, 8, 168, 64, 4, 244, 33, 69, 64, 5, 34, 160, 15,
+			25, 135, 56, 13, 205, 113, 157, 143, 26, 116, 224, 9, 193, 226,
+			24, 174, 253, 81, 163, 56, 198, 222, 2, 46, 225, 96, 108, 31,
+			55, 204, 82, 233, 213, 196, 37, 162, 166, 247, 252, 150, 47, 13,
+			1, 169, 231, 170, 241, 98, 213, 130, 228, 106, 94, 9, 15, 180,
+			8, 26, 200, 57, 21, 160, 49, 70, 177, 162, 6, 242, 76, 18,
+			77, 116, 112, 56, 108, 11, 190, 2, 23, 35, 57, 166, 189, 36,
+			243, 49, 138, 117, 112, 104, 178, 31, 87, 147, 117, 104, 178, 31,
+			55, 122, 71, 20, 104, 97, 10, 227, 19, 236, 143, 196, 140, 12,
+			215, 254, 20, 52, 223, 223, 54, 228, 137, 138, 230, 196, 57, 110,
+			127, 19, 222, 127, 158, 70, 250, 208, 103, 71, 165, 249, 92, 212,
+			165, 27, 243, 32, 119, 138, 154, 57, 224, 137, 206, 165, 187, 9,
+			67, 83, 158, 86, 175, 145, 68, 82, 18, 177, 78, 127, 235, 118,
+			84, 24, 5, 154, 157, 66, 5, 72, 242, 83, 74, 63, 117, 136,
+			36, 63, 101, 140, 142, 177, 227, 132, 9, 211, 181, 127, 31, 107,
+			123, 152, 139, 184, 131, 78, 247, 77, 199, 18, 138, 239, 77, 135,
+			190, 216, 35, 155, 3, 227, 249, 125, 99, 175, 66, 180, 105, 1,
+			28, 159, 96, 191, 38, 16, 109, 185, 246, 31, 65, 39, 121, 175,
+			193, 31, 136, 226, 156, 195, 4, 222, 145, 86, 162, 12, 173, 46,
+			93, 37, 211, 236, 50, 183, 162, 98, 49, 65, 152, 215, 225, 91,
+			137, 95, 111, 53, 114, 199, 67, 57, 105, 116, 85, 49, 196, 232,
+			172, 32, 228, 173, 48, 13, 26, 249, 217, 18, 59, 0, 221, 145,
+			103, 73, 204, 200, 114, 104, 10, 125, 114, 130, 80, 186, 254, 72,
+			9, 84, 199, 180, 104, 130, 35, 163, 100, 188, 58, 80, 44, 191,
+			8, 46, 124, 39, 205, 150, 28, 183, 185, 185, 122, 124, 163, 181,
+			233, 129, 251, 123, 53, 58, 67, 34, 101, 147, 203, 144, 18, 221,
+			163, 93, 160, 86, 212, 250, 65, 210, 124, 209, 232, 29, 85, 160,
+			5, 112, 162, 180, 230, 144, 15, 250, 118, 246, 123, 123, 217, 211,
+			110, 44, 54, 203, 191, 236, 135, 233, 234, 165, 32, 172, 229, 67,
+			180, 142, 254, 165, 201, 122, 23, 240, 211, 67, 65, 88, 115, 75,
+			108, 116, 225, 57, 11, 231, 86, 86, 113, 116, 222, 21, 116, 50,
+			202, 220, 11, 75, 11, 15, 44, 126, 223, 234, 236, 220, 217, 213,
+			185, 51, 179, 231, 30, 92, 152, 31, 168, 185, 195, 108, 239, 133,
+			217, 185, 135, 102, 31, 92, 88, 157, 91, 90, 152, 93, 89, 152,
+			31, 248, 77, 35, 255, 116, 126, 225, 236, 2, 158, 254, 119, 195,
+			29, 98, 123, 212, 211, 51, 139, 243, 243, 11, 231, 6, 126, 203,
+			112, 71, 216, 128, 122, 120, 241, 156, 124, 252, 113, 122, 188, 120,
+			110, 121, 5, 14, 76, 221, 240, 187, 204, 142, 199, 170, 229, 119,
+			119, 62, 94, 90, 120, 96, 117, 121, 97, 101, 224, 191, 152, 238,
+			24, 115, 59, 30, 95, 60, 135, 31, 222, 99, 186, 37, 54, 162,
+			127, 88, 153, 125, 112, 117, 118, 101, 101, 118, 14, 1, 51, 63,
+			183, 253, 183, 249, 5, 249, 219, 123, 77, 247, 16, 43, 233, 223,
+			30, 94, 88, 153, 157, 159, 93, 153, 205, 62, 126, 223, 85, 94,
+			208, 45, 188, 223, 84, 129, 38, 175, 234, 103, 142, 107, 239, 233,
+			169, 92, 51, 206, 228, 174, 239, 197, 153, 124, 47, 206, 228, 137,
+			137, 51, 249, 175, 134, 240, 28, 79, 244, 84, 12, 120, 188, 176,
+			225, 19, 176, 72, 226, 12, 137, 50, 2, 41, 106, 132, 30, 241,
+			70, 4, 140, 250, 62, 39, 6, 1, 62, 60, 239, 215, 3, 40,
+			30, 137, 223, 244, 112, 116, 218, 104, 243, 122, 28, 109, 138, 23,
+			72, 92, 145, 74, 112, 33, 246, 235, 193, 149, 135, 253, 212, 35,
+			145, 54, 69, 15, 233, 29, 97, 154, 194, 240, 156, 22, 103, 204,
+			202, 155, 19, 194, 245, 146, 169, 163, 213, 40, 172, 7, 235, 73,
+			37, 115, 243, 78, 20, 246, 72, 167, 108, 143, 107, 149, 204, 3,
+			210, 13, 139, 159, 74, 230, 184, 130, 76, 215, 42, 237, 219, 207,
+			238, 82, 110, 222, 3, 230, 190, 210, 140, 28, 143, 156, 232, 41,
+			121, 178, 4, 109, 47, 137, 54, 229, 140, 155, 244, 74, 133, 229,
+			156, 194, 7, 204, 225, 156, 83, 248, 192, 88, 9, 142, 82, 120,
+			126, 93, 139, 155, 19, 165, 219, 249, 5, 25, 10, 179, 189, 93,
+			79, 135, 201, 76, 145, 190, 178, 181, 17, 53, 124, 233, 232, 151,
+			158, 100, 110, 14, 230, 60, 201, 124, 100, 92, 206, 206, 114, 173,
+			195, 230, 132, 252, 9, 39, 2, 135, 245, 139, 56, 17, 56, 172,
+			95, 180, 93, 171, 172, 95, 180, 13, 64, 3, 10, 50, 93, 171,
+			172, 95, 44, 32, 162, 68, 189, 88, 64, 96, 135, 233, 42, 8,
+			129, 29, 35, 227, 236, 12, 189, 232, 184, 214, 83, 205, 35, 165,
+			187, 249, 162, 12, 22, 218, 1, 99, 94, 94, 0, 119, 7, 23,
+			233, 9, 58, 6, 154, 82, 189, 56, 166, 107, 61, 245, 80, 89,
+			14, 103, 151, 107, 77, 154, 71, 228, 79, 187, 12, 64, 234, 197,
+			93, 166, 107, 77, 234, 23, 139, 174, 53, 165, 95, 44, 26, 128,
+			212, 139, 69, 211, 181, 166, 244, 139, 189, 174, 53, 173, 95, 236,
+			53, 0, 169, 149, 235, 53, 93, 107, 90, 191, 200, 92, 235, 168,
+			126, 145, 25, 128, 212, 1, 1, 51, 93, 235, 168, 126, 177, 207,
+			181, 110, 213, 47, 246, 25, 128, 212, 139, 125, 166, 107, 221, 170,
+			95, 236, 119, 173, 25, 253, 98, 191, 1, 72, 29, 23, 244, 155,
+			174, 53, 163, 95, 220, 237, 90, 199, 244, 139, 187, 13, 64, 234,
+			197, 221, 166, 107, 29, 59, 84, 214, 50, 253, 239, 12, 118, 104,
+			157, 116, 207, 227, 244, 100, 173, 85, 63, 158, 6, 155, 126, 146,
+			122, 155, 77, 41, 188, 247, 138, 23, 42, 234, 133, 242, 221, 172,
+			119, 69, 189, 227, 142, 179, 93, 137, 95, 141, 194, 90, 66, 97,
+			191, 214, 146, 2, 221, 97, 86, 8, 189, 48, 74, 40, 206, 183,
+			176, 36, 128, 211, 255, 7, 27, 170, 70, 155, 149, 174, 54, 79,
+			239, 209, 45, 94, 192, 163, 11, 198, 247, 223, 186, 30, 164, 27,
+			173, 181, 74, 53, 218, 60, 190, 30, 33, 56, 46, 27, 98, 51,
+			109, 55, 253, 36, 27, 233, 223, 27, 198, 91, 76, 235, 193, 11,
+			167, 223, 105, 30, 20, 231, 67, 149, 11, 242, 221, 202, 35, 126,
+			163, 241, 80, 24, 109, 133, 43, 248, 70, 207, 252, 109, 167, 216,
+			221, 55, 166, 205, 136, 237, 186, 186, 41, 89, 76, 94, 165, 41,
+			93, 15, 121, 165, 199, 169, 49, 149, 127, 202, 98, 123, 58, 89,
+			155, 59, 202, 28, 49, 20, 25, 171, 44, 33, 151, 179, 190, 122,
+			16, 174, 251, 113, 51, 14, 66, 21, 192, 158, 127, 228, 222, 205,
+			250, 90, 205, 154, 151, 250, 171, 192, 24, 133, 43, 247, 157, 44,
+			117, 175, 65, 69, 47, 193, 18, 19, 175, 227, 129, 123, 72, 127,
+			220, 74, 252, 152, 226, 151, 123, 213, 11, 23, 19, 63, 118, 103,
+			152, 237, 85, 27, 201, 120, 129, 91, 83, 125, 39, 101, 64, 110,
+			231, 216, 43, 179, 115, 103, 151, 232, 45, 247, 126, 54, 16, 251,
+			56, 205, 64, 220, 117, 51, 106, 4, 213, 246, 184, 67, 3, 146,
+			193, 178, 75, 234, 215, 11, 244, 227, 210, 94, 253, 186, 120, 224,
+			222, 203, 118, 231, 249, 119, 50, 190, 139, 58, 30, 19, 159, 19,
+			235, 63, 151, 251, 125, 169, 243, 237, 210, 2, 179, 102, 231, 206,
+			186, 7, 153, 29, 71, 13, 95, 102, 7, 48, 217, 119, 212, 240,
+			151, 232, 185, 123, 144, 49, 224, 179, 26, 52, 189, 6, 72, 216,
+			154, 234, 93, 202, 61, 41, 111, 177, 189, 93, 35, 117, 247, 177,
+			94, 132, 61, 172, 54, 188, 68, 68, 223, 23, 150, 138, 120, 112,
+			214, 75, 82, 253, 99, 234, 173, 171, 230, 232, 199, 21, 111, 61,
+			113, 143, 178, 193, 154, 223, 240, 83, 127, 149, 14, 48, 86, 107,
+			94, 91, 68, 149, 23, 150, 246, 138, 31, 102, 241, 124, 222, 107,
+			39, 229, 143, 25, 108, 112, 219, 36, 221, 91, 88, 1, 250, 54,
+			54, 161, 53, 181, 71, 197, 151, 107, 109, 123, 73, 252, 234, 186,
+			204, 142, 253, 186, 26, 0, 253, 237, 30, 102, 125, 91, 254, 218,
+			70, 20, 93, 66, 204, 26, 117, 219, 123, 166, 103, 137, 201, 135,
+			8, 117, 63, 194, 250, 155, 173, 181, 164, 181, 182, 154, 70, 205,
+			160, 42, 136, 224, 76, 207, 82, 159, 120, 186, 130, 135, 238, 45,
+			108, 143, 106, 71, 24, 138, 50, 118, 125, 183, 124, 186, 76, 15,
+			79, 23, 153, 147, 122, 241, 186, 159, 30, 125, 38, 179, 151, 128,
+			234, 97, 54, 176, 116, 254, 236, 66, 151, 13, 192, 152, 3, 207,
+			238, 194, 146, 136, 95, 127, 100, 105, 113, 101, 97, 105, 192, 116,
+			123, 89, 225, 252, 35, 231, 22, 150, 6, 44, 165, 222, 126, 234,
+			164, 136, 163, 254, 89, 227, 123, 250, 237, 247, 244, 219, 127, 9,
+			253, 182, 151, 153, 86, 143, 107, 13, 247, 76, 67, 235, 181, 12,
+			215, 26, 233, 185, 159, 253, 164, 212, 122, 247, 247, 220, 106, 148,
+			94, 97, 112, 16, 183, 118, 85, 106, 45, 77, 112, 109, 62, 59,
+			119, 22, 42, 39, 159, 197, 11, 240, 246, 195, 201, 9, 7, 120,
+			232, 115, 21, 185, 70, 135, 33, 224, 70, 9, 205, 50, 211, 244,
+			164, 6, 9, 131, 173, 141, 163, 120, 248, 14, 55, 252, 56, 144,
+			129, 191, 136, 217, 74, 90, 107, 226, 45, 63, 175, 217, 238, 47,
+			244, 74, 37, 161, 7, 10, 171, 82, 52, 240, 211, 1, 173, 241,
+			244, 152, 174, 117, 96, 100, 148, 253, 159, 134, 82, 109, 15, 155,
+			187, 75, 63, 106, 240, 37, 58, 115, 166, 179, 37, 25, 8, 220,
+			173, 149, 145, 249, 160, 135, 169, 68, 38, 159, 242, 43, 235, 21,
+			222, 8, 40, 6, 155, 101, 111, 207, 32, 232, 153, 131, 37, 138,
+			191, 192, 143, 166, 69, 204, 19, 204, 69, 137, 170, 174, 102, 128,
+			185, 76, 191, 197, 137, 244, 97, 29, 41, 129, 85, 59, 220, 215,
+			207, 94, 106, 40, 245, 249, 22, 115, 119, 233, 5, 252, 17, 224,
+			70, 142, 188, 22, 65, 215, 140, 219, 169, 12, 159, 245, 112, 172,
+			172, 39, 54, 195, 155, 13, 196, 46, 147, 223, 139, 130, 248, 228,
+			108, 146, 25, 237, 121, 219, 54, 235, 25, 238, 165, 169, 87, 221,
+			144, 83, 65, 84, 20, 172, 143, 68, 143, 18, 72, 188, 165, 35,
+			158, 227, 150, 190, 126, 246, 126, 67, 169, 225, 71, 205, 254, 210,
+			219, 12, 126, 126, 43, 188, 198, 40, 183, 178, 73, 200, 81, 98,
+			220, 219, 176, 84, 143, 98, 70, 216, 108, 122, 49, 204, 32, 69,
+			6, 180, 52, 93, 164, 33, 98, 237, 55, 163, 26, 130, 238, 174,
+			218, 80, 39, 45, 101, 230, 194, 81, 115, 151, 130, 160, 210, 178,
+			62, 246, 99, 134, 8, 81, 57, 209, 243, 239, 141, 210, 127, 52,
+			120, 167, 26, 0, 83, 88, 183, 94, 147, 246, 29, 162, 117, 97,
+			26, 85, 163, 16, 242, 193, 239, 38, 114, 233, 210, 133, 81, 238,
+			39, 42, 66, 78, 141, 117, 199, 41, 197, 126, 181, 21, 39, 193,
+			101, 191, 209, 206, 5, 186, 156, 40, 142, 226, 248, 218, 238, 177,
+			122, 92, 251, 164, 121, 151, 133, 161, 3, 50, 92, 235, 36, 219,
+			205, 42, 204, 1, 132, 184, 144, 59, 108, 183, 116, 136, 182, 175,
+			32, 15, 114, 89, 204, 206, 157, 229, 53, 63, 169, 198, 193, 26,
+			109, 169, 61, 108, 151, 120, 223, 193, 7, 197, 12, 54, 92, 235,
+			142, 222, 221, 25, 108, 185, 214, 29, 3, 131, 236, 101, 134, 236,
+			192, 112, 173, 103, 216, 71, 74, 109, 14, 237, 73, 108, 152, 245,
+			56, 106, 53, 19, 209, 217, 134, 10, 206, 144, 12, 205, 175, 17,
+			11, 0, 175, 88, 0, 137, 249, 97, 26, 211, 129, 35, 167, 21,
+			218, 228, 229, 123, 32, 219, 239, 59, 117, 207, 101, 175, 209, 242,
+			239, 43, 139, 152, 153, 50, 181, 121, 170, 82, 169, 148, 193, 79,
+			202, 224, 50, 4, 229, 134, 110, 216, 24, 74, 127, 6, 23, 92,
+			235, 25, 187, 135, 50, 24, 67, 29, 62, 152, 193, 150, 107, 61,
+			227, 112, 153, 189, 216, 80, 1, 67, 247, 153, 195, 165, 43, 202,
+			230, 85, 49, 192, 122, 173, 179, 37, 166, 49, 85, 120, 217, 59,
+			190, 118, 188, 90, 198, 92, 206, 69, 169, 127, 42, 159, 26, 160,
+			63, 36, 132, 251, 60, 142, 34, 132, 131, 69, 106, 169, 55, 113,
+			98, 20, 98, 231, 194, 47, 93, 46, 203, 24, 156, 30, 120, 228,
+			173, 251, 100, 132, 138, 64, 255, 125, 189, 123, 21, 100, 185, 214,
+			125, 238, 16, 123, 155, 161, 226, 142, 230, 204, 137, 210, 79, 220,
+			192, 249, 66, 135, 241, 121, 89, 106, 0, 81, 189, 115, 146, 152,
+			200, 69, 25, 248, 118, 145, 84, 230, 46, 130, 199, 161, 119, 76,
+			150, 45, 156, 62, 94, 181, 74, 61, 120, 13, 14, 209, 68, 251,
+			25, 114, 190, 186, 225, 133, 235, 106, 111, 201, 224, 166, 57, 61,
+			35, 112, 184, 185, 94, 21, 72, 101, 88, 174, 53, 55, 54, 206,
+			94, 40, 102, 100, 186, 214, 25, 115, 166, 212, 18, 81, 224, 192,
+			155, 198, 35, 162, 0, 104, 103, 227, 44, 10, 191, 64, 47, 21,
+			145, 47, 72, 136, 241, 66, 111, 61, 11, 217, 195, 201, 153, 31,
+			207, 240, 96, 61, 140, 224, 7, 35, 231, 118, 211, 75, 100, 248,
+			221, 78, 147, 211, 195, 53, 29, 12, 98, 159, 130, 12, 215, 58,
+			179, 127, 82, 65, 150, 107, 157, 57, 122, 43, 123, 173, 24, 174,
+			229, 90, 15, 155, 19, 165, 151, 26, 124, 17, 136, 8, 210, 182,
+			194, 127, 84, 215, 49, 224, 29, 131, 214, 211, 121, 50, 103, 96,
+			21, 48, 46, 133, 112, 176, 182, 135, 53, 194, 17, 13, 249, 240,
+			216, 56, 246, 47, 102, 96, 187, 214, 179, 205, 241, 210, 15, 146,
+			0, 23, 84, 3, 133, 170, 125, 67, 156, 137, 28, 52, 155, 94,
+			179, 137, 25, 195, 155, 133, 32, 118, 236, 110, 124, 237, 41, 1,
+			201, 91, 87, 103, 12, 129, 204, 155, 193, 72, 104, 40, 26, 114,
+			92, 235, 217, 125, 123, 20, 100, 184, 214, 179, 247, 170, 128, 56,
+			219, 114, 173, 103, 143, 142, 177, 175, 136, 41, 20, 92, 235, 17,
+			115, 178, 244, 89, 131, 47, 181, 148, 110, 65, 86, 6, 70, 21,
+			53, 106, 153, 92, 3, 113, 74, 118, 156, 233, 73, 122, 142, 68,
+			253, 97, 35, 184, 228, 19, 50, 40, 0, 30, 139, 26, 133, 136,
+			252, 14, 170, 129, 140, 238, 132, 40, 223, 244, 227, 117, 191, 70,
+			27, 94, 252, 216, 214, 220, 65, 56, 3, 153, 136, 240, 13, 253,
+			4, 74, 140, 68, 226, 86, 16, 38, 21, 190, 216, 145, 66, 36,
+			190, 158, 201, 13, 18, 125, 92, 130, 166, 93, 143, 176, 215, 98,
+			141, 161, 130, 131, 153, 14, 42, 200, 112, 173, 71, 220, 178, 130,
+			44, 215, 122, 228, 150, 167, 178, 79, 9, 156, 56, 174, 245, 168,
+			121, 188, 244, 17, 3, 27, 73, 132, 208, 39, 136, 140, 239, 176,
+			29, 17, 24, 221, 74, 165, 167, 138, 111, 120, 205, 166, 31, 230,
+			50, 216, 114, 104, 201, 219, 102, 50, 196, 85, 41, 104, 167, 192,
+			9, 168, 9, 124, 71, 94, 65, 37, 241, 130, 132, 35, 32, 1,
+			157, 123, 141, 6, 227, 194, 78, 202, 248, 40, 156, 223, 144, 235,
+			146, 158, 58, 114, 228, 214, 252, 70, 20, 174, 67, 64, 234, 233,
+			59, 54, 38, 165, 33, 76, 177, 111, 191, 130, 12, 215, 122, 244,
+			192, 81, 5, 89, 174, 245, 232, 177, 10, 251, 49, 75, 132, 76,
+			6, 61, 45, 163, 244, 31, 45, 222, 101, 225, 202, 129, 200, 163,
+			175, 171, 83, 138, 204, 25, 35, 154, 130, 206, 206, 84, 164, 93,
+			72, 169, 221, 53, 232, 227, 65, 84, 67, 70, 160, 200, 109, 243,
+			116, 144, 204, 243, 162, 181, 10, 152, 179, 106, 24, 159, 201, 118,
+			68, 98, 93, 80, 23, 202, 141, 52, 143, 234, 81, 163, 17, 109,
+			209, 34, 36, 60, 141, 91, 62, 133, 103, 31, 149, 29, 70, 13,
+			65, 178, 94, 200, 39, 183, 25, 216, 147, 28, 102, 118, 37, 255,
+			62, 40, 213, 219, 140, 194, 117, 62, 169, 45, 249, 201, 124, 232,
+			57, 197, 234, 175, 7, 73, 234, 199, 126, 110, 246, 89, 35, 144,
+			203, 97, 68, 202, 31, 111, 70, 65, 8, 83, 10, 235, 25, 164,
+			221, 239, 64, 207, 23, 115, 128, 210, 72, 123, 159, 154, 148, 93,
+			227, 225, 164, 84, 95, 32, 1, 130, 226, 24, 123, 68, 5, 174,
+			94, 50, 71, 75, 207, 226, 103, 162, 45, 190, 137, 88, 195, 235,
+			15, 16, 93, 101, 230, 3, 209, 215, 150, 215, 78, 56, 186, 146,
+			244, 98, 144, 32, 189, 100, 238, 202, 5, 179, 94, 42, 14, 230,
+			130, 89, 47, 13, 143, 176, 159, 211, 193, 172, 207, 55, 121, 233,
+			45, 134, 246, 223, 38, 50, 251, 44, 84, 150, 107, 34, 39, 182,
+			195, 238, 204, 43, 50, 8, 192, 20, 214, 158, 199, 235, 45, 97,
+			13, 240, 242, 37, 191, 125, 138, 212, 25, 82, 94, 68, 176, 8,
+			253, 114, 201, 111, 211, 175, 101, 153, 208, 198, 68, 208, 141, 159,
+			104, 75, 66, 89, 216, 1, 102, 215, 214, 147, 131, 174, 243, 124,
+			185, 25, 68, 84, 235, 243, 251, 212, 228, 128, 223, 231, 187, 251,
+			20, 100, 185, 214, 243, 15, 30, 98, 203, 52, 83, 19, 1, 190,
+			7, 75, 15, 100, 216, 6, 213, 232, 12, 22, 153, 143, 163, 217,
+			167, 151, 145, 110, 133, 63, 140, 113, 175, 249, 72, 106, 10, 210,
+			224, 114, 22, 84, 107, 22, 208, 170, 194, 52, 140, 131, 180, 56,
+			161, 32, 132, 13, 239, 63, 192, 254, 219, 46, 138, 170, 117, 94,
+			97, 244, 252, 172, 97, 148, 126, 102, 23, 223, 230, 245, 233, 220,
+			143, 80, 36, 183, 110, 144, 121, 177, 78, 238, 229, 229, 88, 215,
+			108, 199, 103, 216, 127, 30, 127, 214, 242, 249, 115, 199, 18, 63,
+			14, 100, 42, 1, 141, 68, 159, 39, 203, 205, 67, 172, 75, 173,
+			37, 165, 103, 81, 176, 56, 40, 47, 148, 225, 190, 23, 206, 47,
+			175, 208, 89, 155, 15, 57, 30, 241, 73, 229, 48, 162, 100, 73,
+			152, 184, 232, 141, 196, 61, 62, 227, 115, 141, 168, 85, 99, 252,
+			66, 107, 109, 185, 181, 166, 250, 203, 197, 99, 166, 17, 159, 204,
+			59, 166, 38, 249, 20, 173, 254, 36, 52, 226, 201, 25, 62, 41,
+			41, 158, 226, 185, 24, 159, 140, 253, 250, 164, 110, 198, 75, 165,
+			207, 34, 225, 205, 168, 41, 35, 233, 214, 188, 36, 75, 210, 35,
+			46, 13, 83, 147, 63, 34, 198, 169, 198, 158, 201, 181, 92, 176,
+			133, 79, 121, 138, 73, 10, 155, 204, 7, 129, 171, 137, 110, 120,
+			9, 227, 147, 223, 119, 108, 110, 241, 194, 252, 177, 229, 96, 61,
+			244, 16, 3, 124, 138, 39, 27, 222, 201, 59, 159, 118, 239, 61,
+			27, 254, 149, 251, 38, 101, 64, 183, 160, 222, 51, 15, 207, 206,
+			29, 19, 21, 27, 20, 147, 80, 141, 1, 167, 12, 148, 141, 193,
+			182, 51, 12, 38, 228, 93, 155, 172, 168, 161, 38, 42, 158, 80,
+			102, 84, 101, 180, 74, 97, 79, 50, 232, 148, 117, 44, 118, 133,
+			117, 227, 90, 76, 52, 67, 120, 43, 81, 209, 170, 152, 140, 206,
+			160, 246, 170, 85, 68, 86, 11, 107, 146, 216, 52, 226, 84, 200,
+			87, 72, 135, 125, 180, 142, 8, 98, 65, 112, 68, 150, 233, 188,
+			214, 222, 222, 148, 56, 141, 107, 169, 96, 144, 46, 73, 154, 70,
+			60, 146, 185, 80, 212, 54, 70, 87, 139, 163, 102, 83, 136, 153,
+			121, 191, 17, 192, 104, 38, 170, 77, 218, 33, 60, 239, 97, 212,
+			18, 70, 150, 160, 248, 132, 151, 189, 148, 55, 124, 232, 194, 81,
+			88, 245, 203, 21, 177, 175, 18, 114, 193, 121, 177, 136, 66, 108,
+			165, 12, 136, 143, 226, 154, 31, 87, 96, 168, 241, 73, 200, 188,
+			73, 25, 65, 42, 215, 132, 232, 3, 116, 76, 239, 225, 209, 102,
+			133, 2, 166, 41, 76, 238, 21, 70, 113, 130, 93, 81, 1, 240,
+			175, 50, 204, 67, 165, 231, 109, 59, 9, 189, 198, 86, 149, 113,
+			137, 136, 22, 107, 207, 116, 191, 16, 67, 225, 13, 73, 229, 1,
+			58, 249, 165, 206, 118, 101, 140, 135, 105, 246, 216, 212, 181, 6,
+			29, 128, 50, 238, 208, 4, 143, 183, 95, 101, 140, 148, 20, 104,
+			225, 215, 3, 7, 217, 231, 193, 229, 161, 201, 219, 175, 69, 148,
+			201, 39, 12, 29, 235, 71, 195, 104, 11, 81, 220, 161, 13, 229,
+			82, 216, 72, 248, 41, 63, 103, 226, 83, 40, 39, 140, 27, 133,
+			104, 236, 155, 238, 215, 133, 135, 104, 199, 48, 9, 81, 46, 32,
+			185, 20, 96, 149, 121, 16, 202, 156, 7, 100, 138, 208, 210, 32,
+			46, 135, 154, 241, 16, 118, 12, 61, 58, 246, 235, 156, 212, 210,
+			114, 146, 194, 207, 89, 150, 129, 225, 240, 203, 168, 128, 27, 211,
+			52, 108, 215, 126, 109, 134, 26, 68, 51, 189, 214, 232, 27, 84,
+			32, 77, 222, 29, 85, 160, 5, 112, 162, 196, 246, 0, 51, 197,
+			30, 4, 72, 155, 255, 217, 176, 196, 207, 69, 32, 242, 39, 140,
+			226, 30, 118, 59, 126, 70, 116, 249, 235, 13, 123, 95, 233, 150,
+			206, 52, 7, 98, 127, 157, 11, 73, 234, 26, 181, 97, 154, 5,
+			250, 74, 131, 6, 192, 62, 53, 0, 4, 60, 189, 222, 152, 40,
+			177, 111, 138, 181, 177, 92, 251, 205, 134, 189, 191, 244, 39, 134,
+			224, 146, 106, 227, 210, 166, 67, 103, 114, 211, 118, 17, 78, 26,
+			193, 10, 97, 188, 172, 54, 227, 241, 123, 228, 95, 247, 29, 167,
+			79, 147, 227, 247, 208, 191, 247, 149, 85, 156, 177, 252, 157, 111,
+			74, 105, 118, 195, 187, 87, 213, 113, 96, 59, 50, 139, 109, 237,
+			229, 198, 44, 195, 179, 104, 32, 26, 63, 86, 129, 166, 172, 65,
+			3, 96, 223, 152, 2, 9, 33, 165, 125, 236, 39, 16, 167, 106,
+			34, 66, 234, 29, 134, 185, 191, 244, 50, 202, 57, 33, 230, 168,
+			139, 24, 32, 5, 133, 75, 198, 169, 24, 107, 34, 194, 15, 186,
+			185, 180, 98, 203, 222, 90, 68, 242, 155, 47, 41, 79, 122, 80,
+			239, 18, 95, 50, 44, 117, 38, 155, 152, 226, 51, 39, 158, 198,
+			171, 27, 94, 236, 85, 201, 7, 9, 61, 189, 194, 248, 115, 2,
+			81, 113, 32, 149, 142, 190, 40, 244, 97, 1, 147, 139, 146, 252,
+			122, 224, 250, 210, 14, 82, 70, 240, 12, 167, 44, 191, 52, 226,
+			116, 4, 178, 44, 133, 3, 235, 54, 58, 145, 127, 220, 229, 253,
+			211, 104, 68, 212, 215, 59, 84, 212, 151, 73, 234, 254, 59, 140,
+			94, 133, 70, 219, 2, 88, 218, 167, 207, 73, 191, 50, 194, 78,
+			222, 196, 25, 102, 114, 115, 199, 163, 223, 201, 17, 236, 227, 62,
+			91, 125, 135, 197, 10, 196, 138, 220, 35, 204, 198, 115, 121, 8,
+			184, 237, 208, 140, 126, 116, 7, 152, 181, 181, 17, 201, 115, 85,
+			252, 233, 86, 152, 13, 105, 112, 3, 7, 169, 244, 30, 206, 200,
+			165, 34, 34, 143, 79, 21, 232, 150, 88, 81, 169, 139, 242, 180,
+			76, 195, 232, 55, 246, 235, 116, 56, 218, 187, 100, 197, 126, 29,
+			79, 82, 111, 125, 124, 151, 120, 146, 122, 235, 238, 221, 172, 159,
+			18, 235, 253, 218, 42, 220, 8, 227, 197, 235, 156, 193, 246, 201,
+			183, 225, 219, 196, 199, 177, 127, 57, 186, 164, 62, 238, 189, 222,
+			199, 242, 109, 250, 120, 132, 57, 155, 181, 213, 75, 126, 123, 156,
+			209, 112, 10, 155, 181, 135, 252, 182, 251, 84, 182, 119, 179, 182,
+			42, 83, 15, 87, 211, 118, 211, 31, 239, 163, 223, 119, 111, 214,
+			100, 26, 219, 74, 187, 233, 227, 176, 112, 179, 182, 154, 59, 164,
+			30, 239, 87, 175, 61, 144, 61, 84, 39, 124, 159, 25, 16, 1,
+			108, 79, 187, 230, 1, 223, 51, 190, 119, 192, 247, 189, 3, 190,
+			39, 239, 128, 111, 129, 254, 52, 93, 107, 180, 231, 65, 252, 201,
+			122, 92, 107, 116, 215, 110, 246, 122, 83, 156, 121, 240, 158, 167,
+			25, 165, 31, 49, 133, 150, 3, 68, 123, 124, 189, 17, 173, 229,
+			243, 254, 106, 210, 241, 211, 136, 214, 165, 87, 68, 228, 54, 225,
+			109, 202, 63, 57, 29, 172, 63, 187, 5, 5, 150, 82, 16, 188,
+			218, 70, 84, 229, 207, 111, 249, 113, 64, 85, 147, 66, 242, 15,
+			204, 123, 169, 135, 244, 41, 95, 228, 41, 36, 27, 228, 255, 192,
+			169, 26, 100, 26, 191, 184, 40, 157, 237, 248, 177, 44, 51, 230,
+			225, 77, 40, 243, 186, 79, 150, 7, 89, 52, 89, 43, 48, 95,
+			210, 64, 86, 129, 162, 186, 29, 219, 44, 61, 15, 73, 23, 242,
+			204, 41, 163, 94, 242, 93, 33, 147, 8, 226, 134, 20, 99, 225,
+			75, 12, 194, 154, 127, 37, 8, 215, 115, 135, 47, 188, 72, 137,
+			211, 54, 37, 78, 31, 54, 71, 164, 223, 169, 199, 1, 212, 167,
+			32, 28, 229, 245, 15, 40, 8, 209, 105, 67, 195, 236, 105, 202,
+			115, 95, 54, 7, 203, 64, 2, 15, 58, 93, 199, 234, 108, 1,
+			94, 211, 83, 247, 248, 155, 94, 208, 184, 175, 172, 188, 94, 70,
+			1, 31, 230, 93, 233, 229, 222, 126, 5, 89, 174, 85, 222, 59,
+			192, 30, 211, 174, 244, 167, 154, 183, 224, 212, 106, 201, 247, 26,
+			228, 102, 214, 169, 38, 164, 239, 98, 110, 173, 38, 116, 171, 205,
+			160, 209, 8, 68, 156, 19, 4, 108, 53, 32, 202, 171, 240, 115,
+			8, 113, 162, 199, 100, 56, 49, 238, 173, 41, 157, 67, 152, 8,
+			82, 89, 246, 55, 131, 84, 157, 213, 34, 227, 29, 177, 255, 105,
+			236, 133, 137, 87, 149, 118, 152, 244, 158, 83, 152, 92, 222, 179,
+			254, 212, 253, 60, 231, 89, 127, 234, 145, 167, 200, 228, 109, 11,
+			33, 107, 35, 229, 147, 57, 47, 15, 212, 110, 236, 44, 101, 220,
+			11, 114, 145, 113, 213, 71, 229, 80, 166, 85, 87, 112, 129, 79,
+			117, 184, 192, 167, 122, 213, 82, 192, 5, 62, 53, 52, 204, 102,
+			148, 7, 124, 218, 28, 45, 31, 202, 251, 58, 248, 226, 60, 45,
+			189, 86, 224, 143, 170, 118, 237, 2, 94, 87, 237, 34, 118, 112,
+			186, 87, 121, 100, 225, 151, 158, 30, 30, 145, 237, 22, 112, 160,
+			56, 136, 118, 97, 18, 144, 213, 208, 217, 38, 98, 173, 117, 187,
+			5, 122, 93, 181, 139, 80, 195, 163, 122, 97, 11, 56, 81, 221,
+			59, 128, 156, 81, 56, 55, 17, 82, 55, 88, 62, 42, 61, 72,
+			72, 249, 155, 188, 116, 234, 242, 36, 26, 223, 156, 238, 236, 2,
+			33, 216, 186, 11, 167, 128, 47, 85, 23, 136, 51, 188, 85, 119,
+			225, 88, 174, 117, 235, 222, 1, 118, 146, 186, 216, 229, 90, 21,
+			243, 142, 210, 45, 240, 92, 210, 57, 97, 80, 175, 243, 28, 182,
+			115, 81, 236, 122, 109, 119, 217, 248, 72, 67, 142, 107, 85, 250,
+			148, 115, 26, 161, 138, 149, 35, 199, 21, 100, 185, 86, 229, 228,
+			237, 114, 3, 21, 93, 235, 184, 121, 135, 252, 169, 104, 3, 82,
+			141, 20, 29, 215, 58, 174, 27, 65, 24, 227, 113, 221, 72, 209,
+			114, 173, 227, 39, 111, 103, 151, 169, 145, 94, 215, 186, 221, 28,
+			41, 5, 92, 9, 120, 233, 141, 171, 249, 169, 23, 52, 146, 78,
+			164, 232, 208, 242, 163, 21, 146, 176, 228, 152, 147, 73, 80, 58,
+			211, 161, 17, 173, 175, 251, 53, 85, 191, 33, 208, 133, 195, 26,
+			94, 188, 174, 28, 95, 61, 102, 111, 1, 29, 43, 148, 34, 126,
+			242, 118, 125, 86, 215, 107, 185, 214, 237, 238, 176, 156, 38, 115,
+			173, 59, 204, 131, 242, 39, 86, 0, 164, 62, 67, 52, 229, 29,
+			189, 227, 10, 194, 249, 234, 190, 3, 242, 179, 62, 215, 186, 83,
+			4, 236, 2, 40, 0, 82, 159, 33, 182, 242, 206, 222, 49, 5,
+			89, 174, 117, 103, 105, 255, 5, 83, 107, 186, 127, 182, 155, 221,
+			113, 131, 26, 229, 21, 148, 171, 240, 107, 55, 165, 235, 150, 63,
+			107, 176, 190, 5, 249, 229, 138, 183, 238, 238, 97, 166, 174, 59,
+			106, 6, 181, 14, 93, 208, 236, 210, 5, 115, 26, 164, 213, 169,
+			65, 14, 48, 11, 74, 152, 208, 43, 241, 39, 226, 46, 105, 133,
+			164, 66, 41, 0, 247, 16, 235, 19, 49, 10, 126, 109, 117, 173,
+			45, 181, 74, 166, 30, 157, 110, 187, 119, 231, 94, 72, 17, 84,
+			119, 221, 32, 65, 245, 250, 74, 162, 244, 180, 215, 247, 9, 61,
+			237, 200, 247, 2, 177, 190, 23, 136, 245, 47, 28, 136, 245, 171,
+			50, 244, 100, 95, 207, 17, 163, 244, 62, 131, 231, 182, 154, 246,
+			147, 123, 60, 169, 110, 248, 155, 20, 56, 193, 39, 125, 249, 198,
+			42, 180, 163, 213, 123, 158, 23, 173, 5, 181, 251, 38, 51, 29,
+			140, 124, 71, 73, 118, 124, 149, 243, 18, 183, 249, 194, 247, 93,
+			56, 191, 180, 2, 71, 213, 242, 234, 202, 249, 213, 211, 207, 166,
+			115, 93, 63, 230, 207, 139, 214, 72, 83, 147, 252, 143, 228, 63,
+			186, 187, 236, 197, 65, 212, 130, 95, 156, 180, 58, 47, 244, 26,
+			237, 4, 103, 84, 117, 136, 37, 25, 164, 69, 202, 210, 190, 226,
+			16, 138, 39, 64, 63, 114, 173, 253, 230, 64, 249, 48, 159, 106,
+			94, 90, 23, 71, 158, 51, 60, 168, 77, 99, 169, 245, 97, 201,
+			37, 191, 173, 216, 43, 78, 112, 246, 107, 134, 135, 182, 246, 247,
+			106, 237, 202, 114, 173, 253, 123, 246, 202, 150, 41, 224, 107, 180,
+			124, 184, 75, 136, 43, 93, 106, 182, 118, 161, 122, 166, 82, 169,
+			116, 232, 81, 7, 116, 203, 208, 163, 14, 104, 49, 142, 144, 132,
+			3, 195, 35, 236, 86, 165, 70, 29, 52, 71, 202, 7, 187, 52,
+			17, 213, 178, 136, 0, 81, 205, 226, 32, 228, 160, 110, 22, 10,
+			206, 65, 173, 117, 64, 193, 57, 56, 52, 204, 110, 81, 10, 206,
+			33, 115, 176, 60, 14, 215, 55, 199, 186, 233, 120, 12, 58, 103,
+			82, 13, 66, 141, 57, 164, 27, 132, 226, 126, 72, 203, 108, 168,
+			49, 135, 246, 14, 176, 73, 165, 198, 112, 115, 168, 92, 226, 196,
+			31, 175, 209, 36, 52, 24, 174, 155, 132, 6, 195, 123, 245, 57,
+			187, 229, 90, 124, 208, 149, 83, 47, 64, 155, 157, 40, 31, 36,
+			63, 142, 87, 67, 57, 168, 32, 157, 185, 138, 102, 90, 40, 232,
+			192, 53, 169, 192, 28, 214, 49, 7, 80, 96, 14, 143, 141, 51,
+			174, 20, 152, 178, 57, 83, 30, 210, 153, 200, 136, 236, 160, 230,
+			85, 91, 14, 189, 162, 244, 68, 104, 42, 101, 29, 129, 1, 77,
+			165, 124, 244, 86, 45, 230, 126, 193, 96, 251, 186, 69, 21, 249,
+			152, 175, 22, 238, 191, 139, 21, 22, 240, 251, 233, 203, 59, 135,
+			238, 51, 250, 85, 133, 237, 79, 94, 63, 108, 159, 122, 123, 28,
+			33, 251, 95, 46, 176, 253, 221, 35, 23, 166, 213, 213, 134, 254,
+			147, 6, 115, 150, 233, 13, 247, 110, 230, 8, 19, 133, 34, 164,
+			251, 78, 30, 217, 38, 217, 196, 139, 149, 7, 232, 173, 5, 168,
+			67, 75, 242, 147, 210, 179, 89, 95, 238, 177, 146, 185, 70, 38,
+			115, 103, 148, 204, 133, 224, 238, 59, 57, 186, 173, 241, 231, 128,
+			200, 150, 196, 75, 167, 204, 103, 24, 229, 247, 153, 172, 64, 15,
+			221, 187, 25, 11, 91, 141, 198, 42, 253, 38, 93, 81, 219, 229,
+			238, 185, 86, 163, 65, 239, 159, 233, 89, 234, 13, 21, 224, 30,
+			97, 253, 97, 107, 115, 205, 143, 229, 231, 232, 223, 64, 100, 182,
+			120, 170, 95, 130, 88, 8, 215, 229, 75, 42, 196, 187, 79, 60,
+			125, 142, 84, 16, 216, 90, 20, 169, 97, 64, 159, 40, 162, 43,
+			60, 19, 47, 220, 195, 250, 5, 182, 229, 43, 80, 47, 250, 78,
+			142, 109, 27, 169, 192, 163, 108, 190, 85, 77, 245, 44, 113, 196,
+			45, 191, 117, 174, 162, 93, 156, 13, 146, 84, 207, 178, 161, 128,
+			211, 142, 240, 211, 33, 243, 68, 191, 225, 86, 152, 67, 141, 169,
+			21, 189, 26, 210, 229, 91, 71, 247, 177, 94, 141, 68, 119, 15,
+			99, 231, 46, 158, 61, 187, 250, 156, 217, 179, 23, 23, 6, 122,
+			78, 255, 7, 99, 103, 226, 238, 19, 179, 81, 212, 125, 226, 250,
+			212, 45, 38, 125, 183, 248, 167, 185, 246, 56, 232, 252, 221, 31,
+			55, 217, 109, 55, 166, 137, 162, 50, 178, 36, 126, 161, 133, 94,
+			107, 107, 151, 174, 185, 123, 174, 239, 172, 189, 217, 155, 25, 74,
+			143, 195, 113, 252, 29, 121, 132, 203, 147, 108, 183, 240, 91, 202,
+			2, 236, 87, 203, 153, 41, 175, 178, 177, 69, 21, 144, 211, 233,
+			233, 116, 231, 217, 80, 211, 143, 87, 187, 58, 144, 52, 54, 188,
+			147, 115, 116, 105, 176, 233, 199, 157, 143, 202, 47, 53, 216, 8,
+			252, 164, 201, 98, 168, 70, 68, 69, 134, 125, 247, 62, 86, 128,
+			171, 86, 81, 237, 84, 150, 121, 178, 237, 93, 122, 170, 31, 138,
+			207, 74, 21, 214, 159, 127, 124, 189, 68, 150, 114, 147, 13, 98,
+			207, 220, 16, 94, 220, 253, 172, 87, 71, 207, 146, 199, 187, 184,
+			148, 61, 128, 211, 86, 86, 26, 91, 221, 8, 106, 53, 233, 1,
+			47, 46, 237, 150, 79, 207, 208, 195, 242, 89, 230, 230, 123, 148,
+			243, 46, 177, 162, 212, 7, 196, 212, 123, 151, 52, 12, 211, 71,
+			157, 83, 168, 220, 24, 5, 151, 143, 178, 61, 50, 249, 81, 13,
+			62, 103, 12, 25, 29, 198, 80, 249, 23, 12, 86, 92, 220, 193,
+			102, 234, 124, 205, 189, 181, 203, 210, 218, 225, 62, 134, 204, 244,
+			58, 194, 118, 103, 17, 53, 48, 157, 136, 123, 46, 245, 103, 15,
+			79, 183, 221, 103, 118, 188, 148, 38, 227, 246, 85, 24, 92, 102,
+			62, 229, 26, 88, 73, 202, 239, 50, 216, 248, 146, 124, 160, 102,
+			160, 81, 119, 155, 190, 105, 194, 160, 155, 38, 164, 139, 94, 188,
+			47, 78, 202, 187, 238, 155, 56, 186, 109, 134, 242, 110, 15, 221,
+			118, 54, 193, 147, 172, 87, 223, 166, 33, 143, 52, 118, 190, 183,
+			96, 169, 168, 174, 214, 40, 55, 216, 48, 150, 88, 181, 150, 92,
+			119, 105, 144, 255, 212, 244, 214, 253, 213, 36, 120, 129, 63, 62,
+			76, 25, 129, 69, 60, 88, 14, 94, 224, 227, 166, 13, 252, 189,
+			74, 101, 57, 198, 71, 232, 203, 94, 60, 89, 193, 131, 242, 38,
+			27, 233, 234, 77, 34, 102, 134, 245, 170, 105, 168, 253, 212, 61,
+			207, 236, 5, 156, 77, 132, 254, 149, 116, 53, 215, 213, 48, 117,
+			181, 27, 143, 47, 232, 238, 94, 105, 176, 209, 101, 223, 139, 171,
+			27, 55, 49, 191, 3, 204, 214, 169, 93, 125, 39, 123, 197, 40,
+			86, 188, 245, 37, 122, 252, 29, 77, 63, 98, 99, 219, 134, 243,
+			164, 34, 224, 87, 12, 102, 225, 26, 29, 151, 217, 240, 194, 201,
+			169, 210, 223, 121, 12, 152, 87, 223, 85, 214, 245, 118, 213, 33,
+			214, 167, 194, 124, 177, 167, 100, 86, 161, 122, 36, 220, 17, 250,
+			133, 20, 201, 133, 215, 219, 79, 76, 189, 190, 146, 148, 239, 103,
+			3, 243, 20, 238, 135, 171, 85, 228, 210, 221, 212, 100, 202, 183,
+			178, 189, 32, 185, 37, 191, 126, 253, 181, 47, 159, 96, 3, 217,
+			203, 114, 101, 14, 200, 76, 59, 35, 79, 15, 24, 14, 61, 46,
+			191, 194, 96, 22, 156, 64, 219, 85, 73, 237, 190, 49, 175, 225,
+			190, 177, 174, 231, 190, 185, 62, 255, 209, 31, 175, 36, 229, 54,
+			27, 156, 37, 8, 217, 136, 215, 157, 239, 205, 241, 79, 181, 49,
+			172, 29, 55, 6, 186, 158, 247, 255, 117, 186, 254, 130, 193, 6,
+			212, 54, 81, 130, 251, 122, 11, 210, 175, 22, 228, 48, 235, 239,
+			56, 229, 20, 43, 210, 39, 159, 209, 25, 103, 87, 98, 174, 189,
+			61, 49, 183, 107, 85, 11, 215, 91, 85, 231, 166, 86, 245, 199,
+			13, 54, 34, 150, 85, 205, 238, 9, 198, 239, 73, 86, 212, 250,
+			145, 37, 117, 240, 14, 230, 163, 251, 213, 239, 209, 160, 230, 253,
+			239, 178, 65, 53, 217, 16, 54, 240, 147, 52, 34, 151, 217, 151,
+			252, 182, 216, 1, 189, 75, 244, 119, 249, 89, 108, 184, 179, 71,
+			201, 54, 242, 163, 55, 110, 112, 244, 15, 177, 145, 37, 63, 137,
+			26, 151, 125, 233, 32, 189, 254, 248, 199, 217, 46, 153, 211, 162,
+			24, 159, 4, 203, 255, 158, 141, 60, 232, 107, 81, 123, 113, 233,
+			236, 245, 27, 187, 25, 100, 148, 95, 99, 178, 222, 11, 113, 132,
+			186, 255, 81, 188, 205, 7, 126, 43, 43, 64, 131, 17, 237, 236,
+			81, 122, 136, 126, 191, 2, 53, 199, 95, 18, 239, 64, 68, 168,
+			42, 74, 171, 105, 114, 3, 209, 24, 76, 189, 190, 146, 184, 199,
+			153, 35, 10, 175, 141, 219, 215, 180, 99, 151, 228, 107, 96, 3,
+			84, 70, 73, 185, 213, 9, 40, 63, 192, 10, 52, 38, 119, 132,
+			13, 226, 254, 172, 238, 44, 230, 62, 182, 235, 194, 194, 185, 236,
+			242, 172, 229, 139, 115, 115, 11, 11, 243, 11, 243, 3, 38, 178,
+			154, 69, 41, 240, 1, 171, 252, 50, 147, 141, 205, 203, 236, 50,
+			133, 252, 39, 22, 243, 238, 17, 182, 91, 229, 175, 173, 66, 6,
+			73, 229, 189, 95, 61, 132, 248, 234, 120, 137, 120, 167, 221, 249,
+			18, 184, 180, 123, 156, 13, 233, 151, 154, 106, 109, 132, 140, 46,
+			46, 185, 234, 39, 189, 106, 9, 221, 129, 167, 62, 208, 228, 13,
+			102, 86, 92, 26, 80, 63, 40, 194, 46, 255, 185, 193, 198, 183,
+			163, 66, 238, 143, 188, 98, 107, 92, 71, 177, 85, 34, 216, 220,
+			81, 4, 95, 71, 58, 184, 199, 25, 203, 205, 205, 230, 86, 134,
+			93, 61, 179, 165, 220, 43, 29, 140, 167, 112, 131, 140, 231, 223,
+			131, 25, 138, 169, 206, 81, 133, 206, 39, 120, 183, 253, 95, 38,
+			27, 237, 238, 224, 113, 96, 178, 194, 152, 40, 91, 7, 194, 185,
+			90, 175, 189, 85, 217, 65, 221, 189, 131, 237, 150, 239, 175, 5,
+			161, 23, 183, 199, 173, 237, 159, 128, 181, 244, 139, 183, 78, 211,
+			75, 208, 113, 228, 87, 100, 36, 128, 242, 172, 37, 217, 49, 153,
+			9, 135, 88, 95, 195, 95, 247, 170, 237, 213, 100, 195, 59, 33,
+			55, 34, 19, 143, 150, 55, 188, 19, 238, 189, 204, 149, 45, 196,
+			126, 125, 213, 107, 4, 94, 226, 39, 227, 14, 183, 118, 26, 239,
+			128, 30, 239, 172, 120, 177, 252, 163, 134, 176, 149, 41, 130, 35,
+			185, 158, 173, 172, 75, 46, 152, 215, 44, 185, 240, 157, 104, 254,
+			30, 115, 243, 227, 145, 43, 119, 132, 57, 226, 240, 94, 74, 136,
+			190, 92, 207, 75, 242, 167, 27, 213, 245, 143, 38, 204, 221, 110,
+			71, 186, 71, 216, 161, 165, 133, 7, 23, 151, 87, 150, 102, 145,
+			158, 191, 243, 213, 128, 123, 24, 19, 47, 45, 224, 194, 63, 195,
+			29, 101, 174, 170, 198, 155, 123, 110, 186, 3, 172, 31, 247, 37,
+			136, 59, 32, 192, 237, 78, 126, 161, 159, 177, 37, 125, 169, 151,
+			123, 31, 27, 124, 208, 151, 254, 2, 181, 73, 220, 33, 181, 215,
+			114, 126, 139, 210, 142, 254, 23, 247, 2, 43, 145, 204, 218, 217,
+			161, 179, 99, 67, 7, 20, 173, 239, 252, 205, 105, 54, 188, 83,
+			194, 160, 187, 99, 255, 87, 25, 213, 60, 27, 120, 208, 79, 59,
+			28, 59, 59, 143, 101, 223, 53, 92, 64, 238, 51, 25, 203, 156,
+			41, 174, 44, 114, 146, 119, 175, 136, 54, 198, 183, 255, 32, 27,
+			184, 155, 245, 157, 9, 106, 190, 244, 161, 232, 25, 116, 184, 84,
+			74, 219, 125, 167, 228, 212, 119, 239, 101, 187, 47, 134, 27, 223,
+			201, 231, 194, 14, 123, 124, 159, 159, 102, 3, 221, 62, 17, 183,
+			139, 73, 149, 14, 42, 182, 126, 21, 223, 201, 25, 182, 187, 195,
+			119, 224, 150, 50, 76, 233, 135, 93, 43, 209, 245, 155, 108, 233,
+			28, 219, 219, 101, 134, 187, 251, 69, 91, 219, 172, 243, 14, 26,
+			219, 246, 171, 108, 175, 194, 122, 231, 168, 202, 0, 56, 102, 38,
+			157, 174, 138, 141, 187, 89, 175, 54, 106, 93, 41, 90, 186, 173,
+			220, 171, 126, 124, 23, 43, 42, 19, 213, 149, 90, 85, 102, 178,
+			170, 79, 187, 30, 203, 113, 222, 203, 88, 102, 28, 42, 10, 220,
+			102, 46, 94, 181, 231, 123, 25, 155, 247, 187, 63, 159, 247, 111,
+			244, 243, 5, 182, 167, 211, 134, 113, 229, 34, 237, 104, 217, 92,
+			171, 153, 121, 127, 167, 102, 230, 253, 155, 107, 166, 63, 175, 182,
+			171, 187, 63, 59, 85, 121, 209, 68, 105, 167, 159, 52, 74, 247,
+			116, 106, 236, 106, 52, 59, 234, 241, 165, 46, 154, 119, 239, 99,
+			123, 58, 117, 116, 245, 249, 142, 154, 251, 246, 59, 84, 159, 205,
+			6, 186, 21, 44, 87, 18, 107, 247, 115, 213, 198, 193, 171, 253,
+			44, 103, 244, 16, 219, 211, 169, 103, 184, 251, 58, 191, 232, 80,
+			111, 74, 251, 119, 254, 177, 147, 231, 145, 64, 211, 36, 147, 23,
+			132, 219, 120, 94, 167, 132, 84, 225, 40, 159, 251, 73, 83, 84,
+			6, 122, 251, 174, 239, 221, 176, 250, 189, 27, 86, 255, 37, 110,
+			88, 213, 1, 41, 183, 100, 129, 195, 79, 205, 2, 135, 69, 189,
+			32, 203, 181, 198, 122, 238, 162, 63, 109, 215, 26, 239, 185, 135,
+			254, 44, 184, 214, 68, 207, 89, 21, 89, 60, 177, 107, 55, 251,
+			243, 33, 186, 174, 181, 176, 216, 243, 51, 150, 81, 250, 163, 33,
+			89, 242, 32, 145, 101, 36, 229, 233, 137, 74, 179, 9, 144, 62,
+			153, 68, 85, 92, 84, 82, 235, 40, 81, 49, 171, 222, 197, 138,
+			120, 20, 142, 81, 227, 213, 168, 209, 240, 171, 42, 230, 33, 95,
+			118, 72, 186, 2, 100, 210, 48, 213, 17, 193, 159, 242, 194, 215,
+			92, 107, 50, 195, 48, 109, 55, 85, 42, 119, 46, 222, 69, 7,
+			182, 42, 106, 161, 42, 88, 81, 136, 148, 57, 84, 168, 1, 175,
+			84, 41, 101, 68, 217, 225, 122, 35, 218, 84, 85, 160, 115, 189,
+			40, 43, 68, 12, 94, 151, 137, 9, 194, 170, 23, 135, 244, 54,
+			195, 240, 60, 113, 233, 163, 28, 252, 41, 20, 179, 166, 15, 98,
+			95, 222, 59, 59, 147, 187, 185, 78, 23, 250, 32, 186, 71, 252,
+			178, 204, 182, 156, 194, 208, 112, 107, 54, 205, 65, 228, 143, 203,
+			129, 36, 211, 242, 62, 89, 20, 58, 144, 119, 1, 136, 24, 94,
+			109, 83, 32, 197, 81, 236, 21, 153, 149, 35, 46, 239, 83, 107,
+			160, 235, 137, 168, 0, 107, 125, 235, 230, 246, 22, 40, 145, 77,
+			214, 198, 160, 18, 222, 117, 63, 22, 37, 188, 25, 178, 100, 203,
+			26, 43, 139, 243, 229, 220, 196, 84, 184, 177, 156, 96, 123, 27,
+			22, 19, 62, 181, 25, 37, 200, 19, 23, 153, 116, 248, 37, 245,
+			227, 186, 87, 5, 91, 106, 70, 73, 87, 102, 23, 46, 189, 67,
+			243, 141, 32, 84, 181, 203, 69, 129, 38, 170, 20, 142, 54, 58,
+			101, 22, 42, 195, 79, 203, 36, 124, 212, 166, 22, 214, 25, 178,
+			243, 18, 236, 49, 66, 173, 64, 163, 188, 12, 49, 196, 109, 155,
+			242, 162, 163, 206, 43, 160, 232, 39, 48, 28, 180, 198, 229, 5,
+			166, 50, 113, 93, 22, 205, 7, 170, 239, 184, 141, 178, 171, 248,
+			236, 242, 220, 226, 162, 68, 89, 50, 45, 211, 237, 207, 19, 187,
+			216, 169, 67, 145, 202, 138, 124, 223, 167, 221, 49, 37, 94, 224,
+			183, 242, 31, 120, 116, 173, 157, 250, 63, 24, 251, 117, 125, 13,
+			254, 15, 77, 235, 1, 32, 185, 122, 82, 124, 50, 9, 50, 186,
+			184, 116, 246, 88, 226, 213, 125, 90, 128, 86, 216, 164, 160, 28,
+			2, 38, 111, 165, 23, 64, 173, 94, 234, 135, 42, 155, 21, 205,
+			0, 43, 146, 218, 212, 184, 72, 50, 228, 167, 116, 199, 14, 83,
+			18, 105, 143, 72, 22, 37, 92, 205, 129, 170, 148, 28, 38, 177,
+			130, 118, 252, 205, 196, 111, 92, 150, 121, 187, 47, 8, 154, 28,
+			186, 103, 128, 7, 244, 17, 133, 236, 123, 181, 90, 32, 111, 196,
+			200, 184, 4, 95, 81, 87, 174, 53, 197, 101, 192, 98, 30, 184,
+			119, 173, 181, 41, 104, 2, 210, 150, 207, 157, 93, 212, 100, 32,
+			25, 143, 46, 222, 32, 8, 139, 233, 219, 154, 170, 24, 132, 200,
+			18, 21, 252, 105, 135, 155, 144, 144, 114, 44, 73, 20, 124, 9,
+			215, 128, 69, 151, 68, 246, 38, 42, 0, 145, 140, 194, 228, 120,
+			210, 78, 82, 127, 83, 94, 247, 54, 149, 15, 38, 155, 166, 176,
+			100, 150, 109, 234, 184, 179, 220, 1, 216, 128, 160, 26, 12, 150,
+			226, 230, 208, 10, 125, 165, 170, 138, 132, 145, 220, 138, 140, 151,
+			41, 55, 21, 105, 192, 120, 43, 41, 207, 64, 202, 148, 43, 21,
+			241, 7, 42, 0, 136, 20, 228, 36, 216, 12, 80, 26, 94, 95,
+			77, 151, 80, 139, 196, 94, 165, 140, 102, 188, 129, 252, 63, 180,
+			147, 227, 126, 178, 70, 68, 16, 130, 84, 147, 160, 74, 87, 98,
+			65, 64, 209, 74, 10, 9, 135, 130, 245, 116, 191, 164, 224, 87,
+			81, 61, 197, 165, 21, 106, 119, 215, 252, 48, 194, 45, 138, 188,
+			217, 240, 82, 132, 177, 119, 148, 2, 65, 253, 122, 156, 66, 128,
+			22, 235, 81, 92, 225, 15, 100, 215, 89, 48, 94, 14, 194, 122,
+			236, 29, 79, 163, 168, 145, 136, 112, 138, 70, 16, 182, 174, 28,
+			243, 54, 107, 79, 187, 163, 124, 181, 70, 248, 89, 188, 196, 227,
+			86, 72, 21, 79, 32, 202, 241, 62, 19, 244, 149, 250, 242, 90,
+			43, 85, 60, 95, 86, 108, 168, 70, 33, 20, 179, 32, 10, 243,
+			204, 23, 250, 144, 46, 5, 18, 130, 225, 146, 134, 149, 164, 81,
+			164, 121, 143, 36, 169, 172, 88, 68, 195, 223, 36, 201, 81, 239,
+			152, 42, 112, 157, 52, 193, 187, 166, 46, 133, 209, 86, 72, 172,
+			81, 253, 72, 104, 151, 1, 31, 170, 140, 4, 101, 190, 210, 147,
+			233, 172, 230, 30, 237, 10, 181, 21, 100, 41, 55, 201, 70, 211,
+			10, 159, 107, 197, 200, 164, 4, 199, 204, 221, 180, 22, 133, 160,
+			116, 47, 84, 119, 216, 226, 224, 39, 142, 26, 84, 199, 131, 79,
+			205, 206, 157, 157, 150, 55, 17, 203, 42, 94, 40, 154, 130, 74,
+			115, 112, 7, 208, 54, 147, 153, 120, 232, 5, 191, 48, 89, 245,
+			71, 41, 45, 226, 222, 147, 174, 188, 78, 57, 51, 169, 153, 132,
+			62, 38, 136, 202, 34, 44, 191, 196, 51, 162, 105, 44, 19, 156,
+			49, 11, 75, 186, 194, 16, 122, 184, 95, 190, 133, 16, 38, 112,
+			79, 164, 43, 228, 212, 6, 112, 9, 157, 78, 42, 247, 23, 93,
+			11, 151, 108, 255, 58, 211, 157, 100, 254, 117, 199, 32, 89, 78,
+			228, 68, 117, 181, 87, 197, 84, 66, 45, 151, 112, 103, 87, 35,
+			218, 2, 106, 243, 123, 250, 120, 173, 60, 45, 174, 245, 133, 87,
+			132, 113, 63, 108, 109, 18, 25, 170, 18, 73, 152, 82, 34, 51,
+			148, 213, 30, 70, 145, 156, 56, 167, 209, 17, 30, 32, 111, 145,
+			43, 132, 157, 86, 243, 59, 247, 9, 101, 48, 200, 192, 84, 34,
+			77, 42, 135, 128, 114, 29, 58, 53, 151, 146, 157, 245, 244, 100,
+			181, 30, 45, 199, 117, 75, 114, 166, 226, 178, 119, 170, 30, 224,
+			169, 186, 52, 121, 212, 116, 96, 36, 55, 148, 220, 245, 236, 139,
+			69, 151, 253, 163, 169, 174, 103, 95, 50, 239, 47, 125, 221, 212,
+			23, 161, 102, 52, 218, 185, 98, 219, 40, 38, 151, 169, 44, 184,
+			30, 244, 51, 232, 178, 145, 160, 120, 74, 31, 22, 116, 161, 8,
+			78, 230, 65, 103, 197, 151, 20, 179, 171, 179, 238, 92, 226, 25,
+			81, 132, 97, 43, 80, 23, 103, 97, 110, 184, 40, 85, 74, 153,
+			109, 183, 216, 136, 219, 206, 242, 87, 106, 139, 225, 128, 19, 138,
+			161, 228, 86, 13, 134, 14, 245, 187, 189, 91, 232, 29, 196, 86,
+			183, 13, 86, 242, 212, 171, 34, 40, 72, 103, 118, 26, 42, 203,
+			221, 228, 170, 198, 40, 111, 158, 55, 92, 107, 201, 25, 83, 144,
+			233, 90, 75, 227, 183, 40, 200, 114, 173, 165, 219, 238, 99, 255,
+			100, 169, 91, 233, 31, 53, 151, 74, 223, 176, 110, 126, 157, 114,
+			59, 187, 99, 170, 223, 181, 235, 135, 178, 125, 178, 86, 222, 182,
+			149, 204, 173, 33, 146, 236, 165, 53, 166, 113, 33, 7, 186, 125,
+			241, 102, 228, 205, 208, 184, 191, 140, 180, 202, 196, 239, 250, 108,
+			7, 12, 205, 176, 44, 213, 8, 57, 72, 190, 15, 113, 135, 29,
+			155, 36, 254, 38, 237, 94, 117, 81, 88, 103, 91, 185, 180, 250,
+			169, 32, 101, 25, 71, 207, 74, 168, 54, 99, 92, 71, 67, 57,
+			145, 116, 229, 192, 14, 221, 79, 119, 220, 40, 254, 168, 195, 115,
+			55, 138, 63, 122, 184, 162, 32, 212, 213, 186, 235, 2, 251, 224,
+			46, 117, 163, 120, 98, 206, 151, 254, 203, 46, 89, 141, 142, 42,
+			219, 86, 201, 7, 248, 111, 97, 111, 175, 200, 210, 136, 116, 7,
+			252, 134, 95, 189, 148, 240, 201, 92, 180, 67, 87, 241, 148, 78,
+			111, 185, 188, 56, 71, 21, 123, 99, 219, 215, 172, 115, 219, 116,
+			93, 13, 168, 52, 209, 92, 119, 218, 10, 147, 147, 215, 90, 41,
+			21, 140, 66, 39, 188, 42, 36, 123, 254, 43, 117, 81, 144, 248,
+			36, 155, 55, 219, 241, 214, 230, 110, 182, 166, 7, 173, 250, 162,
+			244, 94, 222, 134, 153, 11, 35, 179, 99, 120, 40, 81, 131, 251,
+			207, 136, 106, 215, 124, 78, 97, 241, 76, 106, 251, 21, 190, 28,
+			81, 245, 66, 241, 184, 227, 75, 74, 3, 32, 178, 129, 140, 15,
+			194, 32, 13, 242, 68, 46, 48, 153, 108, 231, 182, 104, 206, 223,
+			161, 65, 205, 87, 179, 54, 186, 240, 203, 174, 130, 149, 238, 219,
+			23, 119, 96, 19, 185, 202, 125, 91, 30, 85, 73, 164, 90, 89,
+			57, 92, 69, 114, 23, 35, 171, 129, 102, 133, 66, 74, 23, 188,
+			4, 246, 25, 147, 163, 221, 145, 88, 160, 75, 98, 92, 107, 24,
+			148, 172, 9, 67, 57, 55, 181, 40, 68, 202, 33, 63, 31, 194,
+			39, 64, 234, 89, 44, 57, 243, 206, 13, 233, 125, 214, 162, 125,
+			89, 203, 99, 71, 111, 116, 240, 249, 196, 41, 41, 200, 116, 173,
+			100, 223, 180, 130, 44, 215, 74, 238, 56, 205, 94, 98, 203, 123,
+			213, 237, 31, 54, 204, 7, 74, 127, 151, 137, 4, 220, 158, 149,
+			105, 46, 249, 101, 217, 240, 244, 133, 40, 221, 219, 92, 86, 87,
+			220, 118, 56, 70, 159, 170, 210, 197, 146, 19, 8, 145, 32, 181,
+			209, 41, 205, 27, 229, 196, 81, 142, 171, 17, 209, 0, 68, 142,
+			39, 114, 83, 160, 131, 111, 146, 105, 58, 61, 195, 187, 207, 170,
+			20, 50, 209, 149, 116, 15, 134, 109, 137, 216, 197, 84, 169, 236,
+			30, 202, 14, 97, 247, 249, 87, 154, 244, 55, 100, 146, 230, 165,
+			208, 117, 148, 126, 213, 65, 138, 48, 201, 66, 38, 88, 149, 218,
+			114, 176, 126, 115, 66, 64, 102, 163, 115, 81, 248, 157, 4, 165,
+			84, 103, 167, 188, 36, 187, 32, 92, 186, 255, 24, 6, 40, 170,
+			252, 120, 161, 104, 119, 58, 119, 105, 187, 36, 163, 142, 37, 232,
+			28, 145, 84, 32, 50, 25, 150, 128, 86, 37, 58, 59, 106, 172,
+			192, 245, 209, 0, 7, 37, 127, 147, 124, 218, 177, 225, 69, 173,
+			21, 208, 129, 225, 218, 63, 108, 56, 163, 10, 52, 1, 142, 61,
+			69, 129, 22, 192, 227, 243, 236, 167, 133, 120, 176, 93, 251, 53,
+			134, 57, 91, 122, 205, 46, 14, 103, 119, 162, 180, 67, 224, 170,
+			150, 47, 36, 154, 47, 241, 151, 249, 40, 51, 178, 17, 95, 75,
+			87, 41, 12, 21, 94, 11, 98, 208, 121, 205, 79, 170, 126, 88,
+			163, 187, 172, 163, 56, 95, 40, 153, 230, 148, 251, 89, 154, 212,
+			114, 85, 130, 132, 39, 148, 42, 199, 27, 254, 149, 160, 26, 173,
+			199, 94, 115, 67, 248, 252, 182, 25, 31, 224, 215, 122, 224, 63,
+			80, 246, 202, 51, 164, 251, 139, 127, 170, 199, 107, 234, 15, 255,
+			120, 189, 252, 232, 12, 217, 75, 178, 254, 107, 217, 43, 51, 117,
+			139, 169, 244, 32, 253, 160, 106, 233, 20, 255, 1, 106, 229, 209,
+			25, 57, 79, 245, 168, 90, 126, 244, 135, 64, 92, 170, 157, 48,
+			10, 143, 229, 230, 85, 185, 74, 59, 87, 25, 77, 119, 219, 234,
+			87, 209, 9, 218, 226, 186, 167, 142, 94, 50, 74, 35, 124, 171,
+			238, 104, 229, 84, 163, 252, 114, 86, 242, 39, 163, 60, 105, 52,
+			171, 2, 215, 56, 190, 103, 130, 197, 171, 142, 116, 2, 255, 118,
+			114, 149, 235, 10, 102, 173, 105, 53, 234, 48, 248, 103, 88, 199,
+			50, 171, 54, 85, 133, 101, 213, 52, 161, 61, 166, 205, 34, 213,
+			29, 57, 129, 206, 62, 89, 87, 87, 124, 10, 74, 183, 151, 226,
+			214, 109, 26, 179, 100, 100, 27, 129, 31, 195, 51, 208, 206, 54,
+			106, 35, 162, 26, 121, 211, 51, 84, 121, 128, 176, 185, 125, 62,
+			114, 135, 231, 52, 73, 217, 32, 198, 74, 23, 118, 111, 69, 241,
+			37, 72, 206, 78, 134, 34, 46, 120, 170, 199, 62, 230, 40, 238,
+			57, 151, 92, 69, 5, 25, 72, 1, 161, 74, 92, 208, 42, 225,
+			141, 252, 68, 219, 104, 133, 145, 230, 138, 117, 11, 35, 81, 124,
+			221, 111, 36, 190, 222, 210, 168, 151, 244, 26, 195, 25, 80, 160,
+			9, 112, 176, 172, 64, 11, 224, 177, 103, 178, 23, 10, 19, 174,
+			224, 218, 111, 52, 204, 211, 165, 111, 25, 60, 119, 8, 207, 55,
+			61, 76, 34, 215, 61, 38, 36, 82, 42, 180, 34, 21, 251, 40,
+			87, 150, 64, 50, 146, 18, 138, 183, 179, 227, 125, 201, 47, 165,
+			77, 164, 184, 79, 166, 186, 121, 245, 186, 95, 133, 118, 155, 38,
+			242, 30, 210, 169, 204, 204, 229, 73, 138, 229, 166, 18, 254, 200,
+			113, 157, 238, 80, 41, 119, 208, 31, 213, 40, 37, 139, 209, 180,
+			190, 77, 25, 100, 217, 16, 52, 198, 112, 1, 233, 27, 13, 103,
+			80, 129, 38, 64, 151, 43, 208, 2, 120, 235, 253, 116, 77, 190,
+			131, 106, 13, 246, 219, 12, 115, 190, 244, 118, 131, 119, 68, 30,
+			236, 140, 51, 181, 163, 188, 117, 47, 144, 183, 127, 78, 194, 89,
+			142, 83, 0, 170, 175, 154, 195, 250, 147, 58, 73, 92, 101, 250,
+			54, 195, 25, 82, 160, 9, 112, 88, 145, 133, 99, 1, 60, 118,
+			154, 189, 169, 64, 100, 177, 203, 181, 127, 1, 147, 252, 177, 2,
+			239, 136, 143, 208, 139, 158, 31, 207, 148, 135, 138, 97, 178, 188,
+			170, 44, 238, 165, 29, 9, 51, 242, 202, 1, 68, 180, 49, 238,
+			167, 213, 233, 140, 90, 50, 226, 239, 114, 148, 180, 194, 90, 84,
+			225, 231, 161, 33, 228, 59, 202, 74, 237, 206, 72, 58, 89, 199,
+			177, 80, 174, 110, 43, 17, 166, 190, 170, 83, 22, 161, 111, 70,
+			116, 13, 14, 74, 166, 68, 124, 45, 246, 189, 75, 42, 215, 153,
+			55, 131, 48, 244, 107, 124, 35, 64, 165, 25, 200, 11, 58, 83,
+			128, 59, 39, 105, 197, 202, 105, 141, 173, 150, 110, 180, 116, 193,
+			74, 184, 96, 212, 65, 82, 213, 75, 90, 144, 50, 51, 178, 248,
+			111, 200, 253, 43, 105, 236, 227, 186, 0, 68, 108, 85, 24, 159,
+			139, 200, 177, 35, 139, 196, 110, 4, 53, 197, 221, 244, 172, 100,
+			73, 206, 168, 158, 21, 185, 206, 124, 102, 120, 83, 157, 77, 80,
+			141, 183, 153, 174, 187, 212, 181, 126, 229, 213, 54, 3, 229, 237,
+			59, 37, 134, 19, 137, 235, 26, 162, 58, 235, 194, 55, 21, 175,
+			7, 51, 145, 8, 85, 131, 73, 114, 130, 130, 36, 220, 54, 218,
+			218, 174, 39, 1, 29, 40, 18, 14, 229, 13, 253, 145, 68, 91,
+			60, 247, 156, 217, 179, 139, 243, 171, 179, 75, 15, 94, 124, 120,
+			225, 220, 138, 250, 76, 169, 232, 48, 196, 188, 6, 212, 59, 20,
+			198, 228, 60, 231, 230, 8, 234, 29, 232, 217, 89, 113, 217, 101,
+			128, 66, 53, 57, 239, 50, 1, 106, 114, 222, 101, 1, 60, 118,
+			154, 189, 211, 33, 114, 46, 186, 246, 111, 129, 156, 223, 224, 112,
+			21, 135, 147, 228, 253, 239, 146, 88, 149, 150, 155, 195, 211, 84,
+			80, 87, 170, 191, 190, 130, 89, 20, 255, 131, 33, 36, 46, 147,
+			86, 182, 8, 232, 100, 203, 35, 19, 46, 167, 255, 180, 253, 116,
+			26, 202, 152, 48, 131, 82, 44, 20, 221, 218, 33, 154, 99, 29,
+			23, 203, 242, 185, 220, 37, 205, 254, 149, 166, 95, 149, 14, 88,
+			255, 138, 95, 109, 165, 126, 87, 69, 105, 117, 124, 71, 43, 117,
+			162, 227, 234, 102, 53, 128, 157, 102, 185, 6, 49, 210, 104, 128,
+			206, 186, 163, 146, 104, 41, 78, 98, 235, 241, 124, 68, 156, 190,
+			184, 85, 222, 47, 210, 177, 62, 80, 247, 49, 117, 186, 243, 60,
+			120, 129, 188, 46, 27, 138, 136, 186, 142, 36, 35, 86, 113, 166,
+			165, 111, 17, 190, 48, 151, 21, 93, 196, 1, 149, 202, 242, 210,
+			44, 66, 182, 19, 203, 184, 9, 26, 222, 237, 57, 206, 176, 173,
+			3, 10, 96, 16, 227, 168, 205, 208, 52, 183, 205, 81, 240, 98,
+			121, 100, 150, 187, 215, 158, 44, 49, 229, 211, 16, 19, 230, 89,
+			152, 32, 15, 163, 45, 105, 177, 146, 90, 157, 209, 76, 162, 141,
+			209, 220, 170, 175, 181, 233, 210, 16, 176, 39, 72, 231, 25, 121,
+			79, 117, 194, 248, 246, 8, 68, 217, 89, 118, 56, 65, 59, 42,
+			204, 252, 22, 138, 42, 54, 113, 104, 65, 46, 20, 8, 191, 68,
+			89, 30, 81, 44, 165, 197, 117, 4, 5, 219, 193, 55, 178, 93,
+			80, 20, 13, 108, 22, 109, 18, 20, 77, 128, 99, 135, 20, 104,
+			1, 60, 122, 154, 125, 81, 72, 195, 94, 92, 169, 108, 62, 171,
+			244, 73, 67, 42, 245, 10, 47, 242, 112, 94, 14, 101, 38, 95,
+			2, 156, 215, 131, 56, 73, 175, 53, 49, 101, 71, 69, 178, 174,
+			229, 244, 119, 58, 53, 229, 104, 216, 145, 169, 232, 197, 201, 152,
+			144, 198, 70, 47, 221, 25, 173, 249, 76, 175, 9, 112, 120, 74,
+			129, 116, 133, 244, 237, 103, 216, 135, 132, 54, 197, 92, 251, 11,
+			134, 121, 161, 244, 254, 204, 35, 174, 122, 204, 240, 146, 221, 149,
+			0, 234, 20, 22, 53, 100, 164, 62, 215, 193, 120, 81, 100, 45,
+			144, 167, 56, 185, 111, 163, 250, 85, 93, 252, 83, 162, 242, 102,
+			158, 132, 152, 170, 239, 134, 10, 109, 237, 233, 255, 53, 48, 206,
+			12, 32, 209, 25, 81, 160, 9, 112, 116, 70, 129, 22, 192, 167,
+			159, 99, 31, 22, 38, 105, 159, 107, 127, 203, 48, 239, 44, 253,
+			215, 93, 124, 78, 250, 41, 5, 123, 69, 133, 46, 138, 23, 129,
+			182, 130, 11, 13, 174, 72, 195, 66, 122, 8, 102, 17, 177, 128,
+			205, 230, 241, 205, 22, 21, 82, 145, 97, 39, 84, 20, 95, 120,
+			43, 177, 131, 179, 15, 187, 87, 146, 20, 118, 166, 188, 16, 116,
+			242, 73, 234, 134, 12, 240, 200, 157, 246, 118, 218, 59, 188, 140,
+			26, 45, 73, 74, 149, 227, 85, 1, 96, 172, 13, 98, 29, 137,
+			243, 235, 67, 195, 154, 30, 197, 246, 5, 175, 240, 133, 202, 122,
+			37, 107, 140, 166, 19, 50, 245, 115, 194, 203, 179, 34, 238, 162,
+			124, 186, 204, 85, 116, 11, 84, 185, 70, 155, 215, 130, 58, 93,
+			52, 158, 102, 181, 245, 186, 106, 30, 163, 62, 184, 84, 35, 49,
+			180, 11, 234, 166, 0, 81, 187, 56, 141, 58, 202, 178, 172, 251,
+			161, 31, 67, 11, 226, 155, 222, 37, 169, 28, 234, 31, 203, 181,
+			32, 169, 226, 54, 25, 57, 79, 169, 213, 224, 168, 145, 212, 26,
+			41, 113, 235, 10, 145, 228, 2, 129, 242, 146, 70, 57, 194, 7,
+			174, 149, 175, 12, 7, 228, 109, 166, 174, 78, 193, 38, 106, 70,
+			73, 122, 44, 206, 69, 137, 115, 153, 246, 0, 71, 229, 227, 213,
+			104, 36, 119, 173, 71, 157, 222, 139, 39, 84, 185, 137, 226, 78,
+			100, 41, 92, 16, 17, 146, 253, 218, 177, 111, 168, 247, 157, 252,
+			188, 65, 189, 179, 29, 109, 66, 9, 143, 147, 196, 134, 28, 205,
+			236, 233, 243, 75, 43, 11, 243, 219, 62, 130, 182, 76, 36, 15,
+			255, 41, 118, 130, 78, 29, 217, 230, 242, 15, 82, 37, 63, 183,
+			75, 67, 159, 44, 59, 97, 205, 175, 197, 136, 225, 39, 50, 220,
+			174, 60, 235, 45, 223, 103, 96, 23, 59, 123, 21, 104, 2, 28,
+			80, 60, 183, 207, 2, 120, 240, 118, 246, 87, 66, 228, 244, 187,
+			246, 63, 195, 100, 253, 18, 234, 32, 202, 253, 13, 2, 146, 110,
+			93, 220, 58, 229, 5, 13, 168, 239, 65, 199, 189, 44, 52, 80,
+			249, 222, 119, 45, 65, 116, 45, 183, 68, 65, 191, 225, 218, 255,
+			156, 33, 168, 223, 4, 56, 160, 44, 212, 126, 11, 224, 173, 247,
+			179, 111, 11, 4, 237, 118, 237, 23, 155, 230, 221, 165, 175, 42,
+			153, 12, 219, 75, 150, 152, 170, 201, 219, 20, 118, 16, 203, 216,
+			190, 50, 67, 57, 147, 208, 143, 11, 81, 82, 152, 252, 43, 32,
+			106, 183, 129, 185, 59, 123, 20, 104, 2, 220, 123, 80, 129, 22,
+			192, 233, 187, 216, 215, 250, 8, 81, 123, 92, 251, 237, 166, 57,
+			95, 250, 124, 31, 84, 103, 200, 222, 164, 227, 122, 67, 146, 202,
+			157, 188, 14, 56, 89, 14, 26, 2, 93, 40, 249, 158, 105, 127,
+			89, 88, 134, 168, 150, 197, 87, 212, 237, 34, 249, 219, 66, 154,
+			94, 176, 195, 126, 202, 133, 13, 170, 9, 102, 61, 194, 211, 217,
+			238, 144, 51, 192, 44, 201, 116, 108, 238, 156, 214, 169, 190, 129,
+			196, 19, 111, 73, 97, 4, 183, 88, 18, 53, 90, 224, 141, 167,
+			176, 241, 69, 169, 201, 32, 83, 58, 192, 107, 48, 119, 125, 65,
+			66, 20, 102, 99, 232, 212, 229, 152, 42, 64, 197, 91, 97, 240,
+			252, 22, 246, 185, 150, 117, 50, 84, 76, 127, 136, 230, 104, 227,
+			225, 245, 252, 20, 32, 42, 67, 68, 33, 4, 158, 14, 54, 208,
+			95, 45, 206, 107, 252, 101, 178, 69, 50, 144, 53, 95, 31, 75,
+			9, 237, 166, 209, 206, 162, 108, 106, 153, 23, 73, 136, 162, 4,
+			81, 177, 184, 233, 129, 170, 212, 182, 245, 97, 237, 142, 72, 147,
+			75, 161, 85, 178, 25, 238, 85, 227, 40, 193, 18, 55, 50, 193,
+			58, 173, 79, 243, 218, 188, 22, 97, 179, 146, 30, 37, 206, 200,
+			4, 70, 78, 241, 4, 49, 86, 152, 51, 162, 163, 215, 252, 60,
+			154, 25, 223, 108, 53, 210, 160, 217, 200, 38, 156, 240, 41, 137,
+			130, 68, 214, 68, 205, 36, 180, 238, 22, 40, 153, 213, 145, 113,
+			112, 57, 16, 125, 78, 181, 196, 25, 12, 54, 185, 184, 137, 64,
+			135, 69, 137, 33, 201, 104, 74, 24, 25, 65, 85, 214, 191, 164,
+			37, 108, 243, 150, 112, 94, 72, 193, 159, 41, 27, 217, 241, 114,
+			118, 107, 82, 54, 88, 121, 179, 13, 250, 130, 17, 140, 102, 181,
+			30, 145, 25, 49, 153, 198, 66, 209, 45, 155, 168, 95, 230, 201,
+			11, 33, 104, 224, 154, 146, 119, 160, 81, 154, 43, 161, 12, 20,
+			46, 136, 245, 137, 85, 58, 48, 2, 73, 143, 122, 109, 228, 197,
+			32, 255, 251, 169, 29, 255, 59, 40, 25, 123, 12, 112, 123, 237,
+			23, 223, 99, 2, 212, 126, 241, 61, 22, 192, 99, 167, 217, 11,
+			197, 1, 233, 94, 215, 254, 121, 211, 156, 47, 125, 203, 226, 243,
+			254, 213, 68, 131, 64, 72, 155, 111, 249, 113, 70, 69, 218, 13,
+			137, 133, 221, 97, 64, 60, 142, 90, 105, 16, 250, 216, 192, 180,
+			23, 165, 241, 23, 103, 124, 141, 246, 173, 222, 90, 83, 218, 255,
+			136, 86, 112, 56, 154, 115, 138, 203, 107, 196, 170, 85, 47, 145,
+			108, 1, 157, 224, 198, 17, 112, 186, 122, 128, 50, 215, 60, 169,
+			198, 228, 112, 74, 100, 204, 102, 165, 123, 59, 204, 48, 57, 75,
+			98, 185, 65, 118, 1, 14, 237, 136, 181, 118, 238, 54, 135, 172,
+			88, 51, 70, 35, 136, 117, 25, 167, 100, 151, 124, 181, 101, 209,
+			200, 244, 227, 165, 127, 121, 54, 250, 175, 74, 255, 146, 38, 246,
+			26, 32, 2, 77, 49, 123, 77, 128, 154, 98, 246, 90, 0, 143,
+			157, 102, 159, 43, 18, 197, 12, 184, 246, 239, 152, 230, 217, 210,
+			199, 139, 59, 43, 19, 58, 156, 192, 71, 88, 173, 255, 120, 20,
+			11, 245, 229, 148, 250, 67, 135, 5, 144, 216, 64, 225, 73, 74,
+			17, 161, 2, 32, 184, 70, 37, 147, 203, 42, 110, 53, 136, 243,
+			161, 3, 136, 66, 103, 157, 37, 150, 213, 157, 136, 208, 89, 248,
+			189, 247, 113, 145, 31, 205, 215, 26, 209, 218, 245, 149, 23, 45,
+			36, 179, 105, 169, 16, 1, 16, 185, 140, 163, 82, 87, 83, 117,
+			11, 128, 28, 255, 103, 186, 66, 233, 70, 212, 168, 113, 21, 240,
+			220, 104, 139, 114, 205, 162, 36, 102, 194, 167, 68, 181, 243, 59,
+			79, 156, 228, 15, 157, 38, 155, 117, 90, 158, 230, 175, 181, 130,
+			70, 13, 113, 162, 209, 101, 63, 148, 190, 93, 29, 76, 48, 131,
+			200, 95, 186, 210, 180, 17, 173, 99, 75, 227, 102, 75, 145, 146,
+			179, 233, 133, 65, 221, 39, 141, 146, 63, 228, 183, 147, 235, 72,
+			120, 32, 73, 74, 248, 150, 76, 76, 208, 194, 93, 98, 147, 201,
+			43, 191, 50, 137, 46, 6, 63, 157, 173, 171, 112, 40, 11, 157,
+			161, 147, 30, 40, 128, 91, 222, 221, 73, 8, 212, 84, 116, 99,
+			178, 139, 117, 8, 175, 239, 201, 174, 255, 69, 101, 215, 128, 1,
+			230, 226, 12, 43, 208, 4, 56, 114, 84, 129, 22, 192, 59, 159,
+			197, 254, 179, 8, 247, 28, 116, 237, 63, 2, 39, 250, 241, 171,
+			200, 174, 109, 156, 232, 170, 114, 108, 33, 199, 16, 174, 199, 74,
+			232, 168, 36, 145, 135, 29, 107, 109, 197, 141, 196, 86, 237, 60,
+			145, 228, 207, 130, 247, 17, 34, 35, 39, 118, 212, 145, 222, 245,
+			69, 207, 191, 1, 209, 50, 104, 96, 141, 244, 130, 14, 154, 0,
+			245, 130, 14, 90, 0, 239, 124, 22, 251, 7, 97, 208, 187, 174,
+			253, 167, 166, 249, 96, 233, 107, 202, 160, 223, 182, 130, 154, 13,
+			108, 23, 42, 223, 181, 22, 252, 141, 97, 202, 53, 48, 121, 199,
+			85, 160, 9, 112, 232, 169, 10, 180, 0, 158, 88, 96, 127, 46,
+			72, 127, 200, 181, 255, 202, 52, 159, 89, 250, 156, 197, 87, 200,
+			42, 200, 220, 177, 50, 85, 13, 59, 141, 228, 140, 12, 93, 144,
+			137, 108, 215, 48, 191, 145, 26, 215, 213, 136, 98, 190, 20, 189,
+			44, 35, 129, 102, 229, 143, 199, 84, 254, 214, 14, 85, 171, 189,
+			181, 106, 205, 175, 87, 80, 183, 90, 198, 253, 204, 234, 171, 36,
+			212, 59, 210, 159, 171, 127, 39, 59, 84, 252, 36, 71, 113, 234,
+			68, 229, 196, 109, 149, 219, 203, 223, 141, 171, 219, 225, 108, 203,
+			173, 180, 28, 250, 245, 248, 51, 236, 60, 189, 58, 105, 180, 131,
+			165, 172, 9, 99, 200, 192, 90, 235, 45, 52, 100, 2, 212, 91,
+			104, 200, 2, 120, 231, 189, 236, 69, 226, 100, 102, 216, 181, 191,
+			109, 154, 247, 151, 254, 95, 92, 249, 79, 233, 99, 240, 26, 34,
+			175, 201, 175, 241, 139, 75, 103, 59, 194, 51, 85, 22, 19, 197,
+			159, 116, 208, 172, 94, 83, 100, 124, 125, 215, 227, 95, 141, 86,
+			227, 108, 216, 0, 26, 52, 206, 134, 77, 128, 26, 103, 195, 22,
+			192, 59, 239, 99, 127, 40, 108, 160, 17, 215, 126, 185, 101, 46,
+			151, 126, 211, 86, 211, 204, 43, 81, 50, 111, 78, 187, 133, 244,
+			18, 1, 45, 243, 126, 211, 15, 107, 50, 17, 75, 222, 188, 3,
+			199, 190, 62, 96, 39, 114, 202, 142, 129, 212, 181, 30, 52, 108,
+			79, 222, 23, 75, 231, 191, 170, 85, 58, 130, 207, 121, 109, 176,
+			143, 215, 218, 124, 107, 35, 218, 68, 51, 219, 110, 51, 150, 222,
+			145, 28, 99, 68, 172, 209, 12, 25, 85, 20, 120, 67, 74, 80,
+			38, 192, 229, 169, 92, 88, 131, 96, 71, 178, 15, 156, 100, 161,
+			56, 52, 123, 216, 107, 139, 144, 79, 69, 27, 112, 94, 241, 36,
+			216, 204, 83, 38, 182, 81, 226, 131, 145, 81, 104, 249, 12, 72,
+			90, 197, 44, 162, 190, 192, 234, 81, 166, 48, 209, 137, 5, 248,
+			81, 120, 221, 67, 12, 151, 58, 52, 83, 191, 200, 35, 105, 161,
+			56, 119, 35, 132, 248, 100, 242, 221, 72, 132, 215, 227, 233, 35,
+			6, 40, 75, 31, 49, 143, 152, 0, 199, 42, 10, 180, 0, 222,
+			245, 108, 246, 31, 4, 79, 31, 117, 237, 215, 88, 230, 195, 165,
+			191, 54, 175, 73, 134, 8, 220, 145, 137, 159, 138, 36, 129, 155,
+			139, 82, 45, 199, 176, 228, 175, 72, 170, 58, 38, 2, 169, 97,
+			32, 84, 253, 154, 204, 243, 204, 227, 113, 219, 156, 20, 153, 71,
+			241, 53, 167, 248, 111, 82, 255, 28, 69, 76, 161, 165, 249, 198,
+			40, 98, 10, 45, 205, 55, 70, 17, 83, 104, 221, 249, 16, 251,
+			159, 34, 120, 108, 204, 181, 223, 110, 153, 179, 165, 207, 22, 164,
+			186, 178, 253, 6, 214, 206, 60, 65, 173, 217, 93, 37, 76, 64,
+			20, 207, 160, 125, 162, 46, 166, 162, 88, 78, 221, 10, 126, 145,
+			145, 249, 80, 192, 178, 32, 49, 57, 107, 210, 96, 89, 142, 125,
+			72, 150, 129, 239, 112, 130, 84, 83, 220, 34, 167, 255, 170, 88,
+			91, 62, 59, 119, 86, 189, 41, 78, 71, 102, 40, 142, 13, 158,
+			78, 95, 135, 171, 66, 114, 68, 77, 229, 154, 5, 2, 235, 65,
+			67, 135, 130, 232, 11, 245, 81, 113, 235, 113, 30, 195, 223, 204,
+			241, 251, 14, 215, 58, 169, 155, 189, 48, 145, 152, 218, 200, 140,
+			70, 214, 121, 91, 167, 236, 139, 79, 17, 85, 228, 125, 18, 77,
+			105, 176, 235, 251, 64, 17, 219, 66, 44, 242, 66, 254, 94, 253,
+			100, 3, 177, 216, 24, 183, 23, 102, 169, 61, 148, 157, 140, 81,
+			136, 88, 247, 25, 125, 221, 115, 14, 141, 65, 194, 56, 206, 138,
+			43, 252, 33, 223, 111, 10, 1, 140, 253, 209, 10, 211, 160, 193,
+			187, 234, 133, 97, 19, 81, 83, 143, 155, 7, 194, 191, 242, 184,
+			2, 214, 36, 229, 143, 193, 167, 104, 105, 15, 209, 24, 124, 138,
+			150, 246, 16, 141, 193, 167, 104, 29, 123, 38, 123, 148, 46, 126,
+			113, 222, 109, 245, 252, 156, 101, 156, 60, 127, 252, 9, 253, 79,
+			220, 231, 220, 99, 184, 246, 187, 173, 226, 8, 187, 151, 110, 228,
+			232, 113, 237, 247, 88, 230, 112, 233, 56, 159, 205, 2, 168, 211,
+			141, 109, 241, 109, 74, 179, 20, 185, 175, 242, 10, 86, 186, 166,
+			5, 223, 23, 21, 104, 160, 185, 222, 189, 10, 180, 0, 186, 67,
+			172, 159, 153, 182, 225, 58, 239, 183, 122, 126, 201, 50, 104, 24,
+			134, 225, 218, 239, 183, 138, 135, 216, 59, 113, 201, 173, 129, 123,
+			165, 63, 96, 153, 39, 75, 111, 48, 249, 5, 63, 62, 38, 135,
+			162, 205, 23, 18, 186, 42, 176, 60, 141, 114, 124, 128, 110, 163,
+			19, 156, 91, 126, 212, 240, 195, 245, 116, 99, 91, 52, 63, 49,
+			2, 185, 72, 160, 20, 221, 54, 54, 142, 252, 84, 167, 236, 170,
+			217, 211, 161, 65, 155, 233, 20, 14, 101, 70, 225, 155, 174, 132,
+			0, 249, 33, 46, 101, 163, 209, 210, 192, 68, 26, 173, 66, 27,
+			203, 221, 253, 44, 142, 191, 228, 232, 224, 129, 131, 176, 221, 240,
+			46, 99, 104, 94, 216, 206, 134, 167, 216, 204, 180, 68, 186, 65,
+			55, 97, 127, 192, 50, 53, 232, 0, 119, 125, 19, 10, 52, 0,
+			150, 142, 41, 208, 2, 120, 219, 9, 90, 3, 211, 117, 126, 217,
+			234, 249, 136, 92, 3, 211, 112, 237, 95, 182, 138, 7, 196, 77,
+			208, 86, 143, 235, 252, 138, 101, 254, 154, 37, 111, 130, 182, 64,
+			41, 191, 98, 177, 81, 182, 135, 57, 248, 25, 107, 244, 171, 150,
+			237, 178, 189, 108, 151, 128, 29, 122, 80, 204, 30, 24, 120, 208,
+			187, 59, 123, 96, 225, 193, 192, 32, 59, 167, 46, 15, 255, 176,
+			101, 150, 75, 247, 243, 139, 161, 90, 55, 40, 123, 81, 124, 205,
+			92, 162, 238, 156, 63, 137, 8, 113, 37, 248, 135, 21, 34, 196,
+			149, 224, 31, 182, 244, 61, 202, 24, 255, 135, 173, 241, 3, 10,
+			180, 0, 242, 195, 180, 201, 44, 215, 249, 45, 171, 231, 83, 79,
+			214, 38, 179, 16, 26, 103, 21, 39, 216, 203, 112, 33, 163, 133,
+			137, 127, 2, 187, 236, 5, 215, 217, 101, 144, 120, 16, 20, 93,
+			187, 141, 83, 201, 46, 245, 37, 121, 221, 147, 136, 81, 4, 111,
+			51, 245, 107, 84, 33, 102, 211, 247, 194, 132, 151, 69, 116, 109,
+			189, 171, 93, 181, 97, 45, 218, 176, 159, 80, 27, 214, 162, 21,
+			251, 132, 218, 176, 22, 225, 232, 19, 150, 59, 196, 86, 104, 216,
+			134, 107, 127, 210, 50, 71, 74, 15, 64, 221, 36, 197, 19, 254,
+			251, 68, 134, 221, 236, 148, 137, 147, 23, 11, 249, 220, 92, 175,
+			209, 208, 67, 192, 245, 228, 159, 180, 76, 71, 129, 212, 203, 174,
+			1, 5, 90, 0, 135, 134, 233, 54, 72, 11, 247, 143, 255, 158,
+			101, 150, 74, 39, 48, 132, 52, 110, 249, 51, 42, 205, 85, 102,
+			25, 100, 178, 93, 99, 19, 137, 62, 186, 55, 220, 69, 254, 123,
+			89, 111, 160, 249, 223, 179, 118, 141, 40, 208, 2, 56, 62, 65,
+			187, 195, 118, 157, 223, 183, 122, 62, 39, 119, 135, 109, 184, 246,
+			239, 91, 197, 18, 91, 96, 182, 109, 99, 9, 255, 208, 50, 15,
+			149, 158, 206, 207, 118, 39, 14, 233, 140, 34, 153, 209, 47, 235,
+			114, 200, 97, 169, 59, 227, 209, 161, 77, 36, 251, 135, 138, 100,
+			109, 90, 142, 63, 180, 228, 85, 237, 54, 45, 199, 31, 90, 110,
+			73, 129, 22, 58, 61, 112, 144, 61, 66, 67, 48, 92, 251, 179,
+			24, 194, 226, 245, 135, 80, 221, 8, 26, 185, 116, 157, 41, 153,
+			204, 207, 211, 216, 11, 40, 158, 119, 242, 248, 164, 98, 40, 54,
+			221, 31, 255, 217, 108, 80, 88, 160, 207, 102, 131, 2, 171, 254,
+			108, 54, 40, 195, 2, 120, 224, 32, 123, 12, 215, 148, 21, 92,
+			231, 79, 172, 158, 63, 181, 140, 210, 73, 85, 253, 68, 22, 53,
+			19, 103, 237, 89, 52, 192, 14, 137, 40, 79, 206, 230, 67, 150,
+			198, 159, 88, 197, 81, 214, 207, 108, 187, 128, 133, 251, 146, 101,
+			138, 5, 47, 16, 194, 191, 164, 232, 191, 64, 8, 255, 146, 213,
+			59, 160, 64, 11, 47, 15, 13, 179, 55, 26, 204, 44, 244, 184,
+			206, 87, 173, 158, 111, 90, 70, 233, 62, 190, 189, 178, 168, 58,
+			186, 6, 186, 183, 69, 14, 47, 93, 152, 203, 197, 34, 63, 41,
+			243, 44, 96, 236, 95, 181, 10, 99, 152, 103, 1, 55, 172, 217,
+			95, 179, 76, 242, 119, 1, 50, 8, 60, 172, 64, 19, 224, 83,
+			110, 97, 179, 244, 174, 225, 218, 95, 183, 204, 137, 242, 237, 157,
+			102, 3, 44, 231, 142, 99, 128, 156, 25, 77, 73, 9, 97, 180,
+			197, 100, 139, 160, 138, 175, 91, 102, 191, 2, 77, 128, 99, 227,
+			184, 169, 180, 208, 131, 109, 251, 13, 116, 48, 221, 217, 65, 87,
+			50, 174, 14, 66, 61, 255, 144, 106, 22, 251, 243, 27, 150, 57,
+			172, 64, 106, 104, 108, 156, 221, 79, 205, 90, 174, 253, 151, 104,
+			246, 100, 103, 179, 210, 32, 89, 83, 97, 222, 194, 116, 80, 193,
+			227, 100, 29, 168, 246, 193, 153, 255, 210, 50, 247, 40, 208, 4,
+			56, 54, 206, 22, 152, 105, 59, 174, 243, 55, 86, 207, 63, 91,
+			70, 233, 233, 89, 57, 34, 112, 219, 124, 168, 103, 206, 105, 170,
+			111, 14, 150, 20, 94, 161, 117, 177, 145, 64, 243, 55, 86, 113,
+			128, 238, 251, 117, 176, 46, 127, 11, 38, 58, 197, 103, 243, 5,
+			117, 212, 182, 232, 96, 245, 199, 107, 138, 83, 59, 68, 169, 127,
+			171, 40, 213, 49, 177, 218, 127, 171, 40, 213, 33, 78, 253, 183,
+			214, 208, 48, 16, 99, 59, 192, 218, 223, 91, 230, 68, 233, 164,
+			240, 19, 226, 248, 168, 170, 179, 243, 244, 120, 225, 139, 82, 108,
+			82, 230, 36, 232, 238, 12, 135, 154, 232, 83, 32, 181, 216, 63,
+			172, 64, 11, 224, 216, 56, 113, 101, 7, 203, 251, 15, 150, 185,
+			175, 116, 130, 95, 76, 40, 95, 46, 203, 35, 240, 107, 157, 125,
+			78, 69, 173, 180, 217, 18, 34, 67, 113, 28, 135, 184, 242, 63,
+			100, 147, 195, 248, 255, 193, 234, 29, 85, 160, 5, 112, 162, 36,
+			123, 179, 92, 251, 159, 44, 179, 82, 58, 193, 31, 145, 206, 141,
+			171, 58, 123, 118, 238, 205, 114, 168, 133, 125, 10, 52, 0, 238,
+			159, 86, 32, 181, 63, 115, 140, 100, 192, 46, 215, 249, 97, 187,
+			231, 71, 109, 33, 3, 144, 60, 242, 195, 118, 145, 179, 152, 217,
+			246, 46, 44, 229, 139, 108, 147, 151, 106, 252, 124, 43, 173, 70,
+			217, 90, 234, 180, 130, 25, 93, 44, 66, 215, 121, 17, 53, 88,
+			130, 134, 136, 122, 33, 151, 23, 202, 187, 200, 42, 58, 36, 34,
+			149, 187, 7, 38, 151, 44, 199, 38, 199, 190, 139, 148, 154, 23,
+			217, 98, 67, 96, 8, 6, 198, 48, 178, 79, 129, 22, 192, 131,
+			135, 216, 139, 161, 104, 236, 2, 30, 95, 102, 155, 227, 165, 45,
+			58, 184, 135, 11, 171, 149, 248, 29, 217, 9, 224, 194, 59, 100,
+			22, 72, 5, 55, 115, 176, 233, 98, 87, 153, 35, 169, 130, 100,
+			120, 74, 254, 134, 250, 187, 67, 218, 135, 30, 52, 136, 233, 101,
+			182, 20, 40, 187, 136, 152, 94, 102, 247, 13, 41, 208, 194, 175,
+			163, 99, 236, 125, 98, 208, 166, 107, 191, 18, 104, 253, 105, 35,
+			55, 234, 206, 246, 245, 240, 242, 41, 49, 185, 108, 142, 110, 167,
+			44, 2, 185, 214, 125, 194, 45, 68, 159, 230, 5, 42, 137, 36,
+			141, 242, 25, 42, 154, 154, 176, 65, 58, 102, 41, 143, 163, 228,
+			152, 180, 28, 223, 101, 34, 187, 239, 149, 182, 57, 168, 64, 3,
+			160, 171, 150, 5, 105, 243, 175, 180, 15, 30, 34, 93, 179, 232,
+			58, 175, 177, 123, 222, 100, 63, 73, 186, 38, 210, 48, 94, 99,
+			23, 247, 131, 243, 218, 69, 208, 232, 235, 108, 115, 164, 52, 205,
+			207, 73, 102, 147, 73, 96, 169, 93, 234, 249, 34, 106, 75, 206,
+			168, 72, 252, 230, 117, 182, 220, 146, 69, 34, 180, 215, 217, 146,
+			223, 20, 137, 223, 188, 206, 30, 26, 102, 207, 162, 94, 12, 215,
+			126, 189, 109, 142, 149, 238, 225, 231, 232, 118, 62, 108, 132, 172,
+			85, 74, 43, 130, 149, 13, 167, 46, 66, 27, 80, 7, 124, 6,
+			129, 165, 158, 204, 193, 62, 113, 219, 109, 186, 99, 168, 27, 175,
+			183, 205, 93, 10, 164, 182, 139, 131, 10, 180, 0, 14, 143, 178,
+			13, 234, 216, 116, 237, 159, 178, 205, 137, 210, 247, 115, 186, 253,
+			14, 253, 78, 118, 89, 251, 147, 89, 222, 96, 51, 246, 47, 83,
+			250, 94, 38, 139, 225, 82, 233, 240, 221, 199, 62, 74, 87, 104,
+			15, 130, 30, 22, 88, 212, 79, 101, 248, 192, 214, 250, 41, 187,
+			119, 72, 129, 22, 192, 209, 113, 98, 26, 189, 174, 243, 22, 187,
+			231, 103, 37, 211, 64, 38, 200, 91, 236, 226, 1, 182, 196, 108,
+			187, 23, 11, 242, 86, 219, 44, 151, 230, 149, 126, 148, 33, 106,
+			70, 169, 109, 107, 109, 222, 113, 194, 142, 251, 234, 118, 118, 113,
+			161, 247, 94, 210, 34, 223, 170, 246, 87, 47, 241, 136, 183, 170,
+			253, 213, 75, 75, 247, 86, 123, 248, 128, 2, 45, 140, 128, 31,
+			22, 60, 162, 23, 19, 121, 135, 109, 30, 44, 109, 73, 20, 166,
+			178, 60, 135, 151, 240, 201, 60, 22, 131, 144, 239, 84, 90, 122,
+			7, 156, 113, 84, 62, 147, 117, 9, 100, 157, 199, 142, 51, 6,
+			58, 76, 150, 105, 190, 122, 14, 88, 246, 119, 40, 252, 246, 210,
+			178, 191, 195, 238, 29, 87, 160, 133, 95, 247, 29, 160, 29, 196,
+			92, 231, 61, 118, 207, 135, 158, 172, 29, 132, 68, 146, 247, 216,
+			197, 131, 108, 142, 217, 54, 195, 130, 189, 23, 59, 232, 206, 157,
+			119, 144, 8, 15, 205, 136, 61, 170, 243, 41, 153, 90, 169, 109,
+			116, 70, 187, 233, 189, 106, 118, 140, 150, 228, 189, 106, 55, 49,
+			218, 77, 239, 197, 110, 250, 22, 188, 31, 12, 75, 242, 1, 176,
+			237, 63, 51, 69, 44, 48, 54, 106, 20, 93, 34, 249, 65, 213,
+			120, 235, 81, 43, 204, 7, 160, 82, 32, 233, 236, 89, 156, 70,
+			249, 137, 12, 50, 203, 59, 10, 248, 188, 88, 88, 93, 166, 48,
+			87, 12, 150, 206, 8, 212, 52, 18, 65, 101, 126, 189, 30, 84,
+			201, 231, 13, 201, 169, 174, 103, 101, 185, 154, 111, 216, 198, 50,
+			184, 82, 56, 44, 249, 90, 187, 194, 103, 27, 141, 124, 17, 70,
+			237, 27, 165, 147, 13, 225, 231, 240, 144, 134, 222, 244, 98, 56,
+			209, 147, 212, 111, 114, 175, 46, 92, 126, 190, 204, 116, 148, 89,
+			71, 219, 98, 205, 196, 40, 18, 170, 57, 14, 154, 34, 118, 172,
+			207, 249, 168, 43, 28, 203, 162, 238, 90, 42, 149, 51, 49, 92,
+			10, 58, 16, 243, 162, 105, 227, 99, 122, 61, 191, 137, 24, 89,
+			61, 31, 80, 155, 136, 145, 204, 250, 128, 221, 183, 71, 129, 180,
+			38, 123, 135, 20, 8, 55, 138, 61, 58, 70, 252, 143, 65, 1,
+			250, 224, 19, 195, 255, 24, 233, 66, 31, 84, 252, 143, 153, 32,
+			134, 15, 42, 254, 199, 72, 148, 124, 80, 241, 63, 6, 232, 215,
+			255, 37, 248, 31, 85, 241, 181, 127, 61, 163, 96, 40, 206, 191,
+			174, 248, 31, 35, 165, 233, 215, 21, 255, 235, 115, 157, 223, 176,
+			123, 126, 91, 242, 63, 36, 105, 252, 134, 93, 60, 68, 252, 175,
+			15, 219, 233, 99, 79, 44, 255, 235, 35, 254, 247, 49, 181, 116,
+			125, 196, 255, 62, 166, 248, 95, 31, 109, 182, 143, 41, 254, 215,
+			71, 155, 237, 99, 54, 63, 204, 94, 10, 254, 215, 7, 252, 126,
+			28, 252, 175, 125, 125, 254, 183, 115, 57, 252, 29, 176, 198, 200,
+			101, 125, 83, 28, 176, 143, 56, 224, 199, 21, 134, 251, 136, 3,
+			126, 92, 113, 192, 62, 226, 128, 31, 183, 247, 29, 96, 31, 134,
+			157, 221, 239, 58, 159, 182, 123, 254, 111, 219, 40, 213, 248, 210,
+			141, 229, 163, 53, 187, 208, 189, 237, 228, 154, 93, 53, 13, 141,
+			61, 57, 140, 22, 217, 41, 159, 182, 139, 125, 236, 56, 179, 237,
+			126, 80, 198, 31, 216, 166, 91, 58, 172, 25, 173, 204, 106, 218,
+			22, 225, 64, 8, 235, 39, 166, 250, 7, 10, 97, 253, 180, 206,
+			127, 96, 247, 238, 86, 160, 133, 246, 6, 6, 201, 179, 221, 143,
+			101, 254, 12, 216, 248, 241, 142, 214, 21, 82, 68, 5, 92, 149,
+			69, 21, 232, 172, 23, 221, 23, 22, 231, 51, 89, 95, 88, 156,
+			207, 40, 6, 222, 79, 139, 243, 25, 48, 240, 83, 212, 151, 233,
+			218, 159, 195, 174, 156, 217, 169, 216, 115, 174, 147, 220, 201, 179,
+			238, 8, 154, 228, 231, 108, 179, 79, 129, 6, 64, 105, 120, 245,
+			211, 246, 255, 156, 61, 54, 206, 30, 164, 142, 144, 212, 136, 142,
+			238, 202, 12, 47, 157, 138, 35, 75, 239, 146, 228, 21, 213, 90,
+			177, 141, 118, 52, 192, 250, 105, 119, 127, 33, 155, 30, 118, 247,
+			23, 236, 94, 213, 43, 118, 247, 23, 208, 235, 3, 212, 171, 237,
+			218, 127, 108, 155, 51, 165, 103, 100, 6, 24, 38, 4, 219, 43,
+			215, 251, 245, 59, 181, 29, 106, 104, 159, 2, 13, 128, 251, 39,
+			21, 104, 1, 60, 122, 43, 169, 84, 187, 93, 231, 79, 237, 158,
+			255, 41, 89, 10, 178, 117, 254, 212, 46, 142, 19, 225, 236, 6,
+			225, 124, 249, 38, 8, 103, 55, 17, 206, 151, 213, 108, 119, 19,
+			225, 124, 89, 17, 206, 110, 34, 156, 47, 43, 194, 217, 13, 194,
+			249, 202, 227, 38, 156, 221, 180, 171, 191, 146, 245, 5, 194, 249,
+			138, 34, 156, 221, 68, 56, 95, 1, 225, 96, 146, 123, 92, 231,
+			107, 118, 207, 55, 228, 36, 17, 119, 254, 53, 187, 56, 198, 78,
+			50, 219, 222, 131, 73, 126, 29, 195, 120, 202, 206, 106, 8, 121,
+			250, 232, 108, 82, 235, 240, 123, 104, 158, 95, 87, 125, 239, 161,
+			121, 126, 93, 245, 189, 135, 230, 249, 117, 213, 247, 94, 215, 249,
+			43, 187, 231, 111, 100, 223, 136, 96, 254, 43, 32, 24, 158, 198,
+			189, 232, 251, 91, 208, 71, 22, 53, 207, 70, 79, 121, 118, 45,
+			214, 189, 122, 21, 118, 77, 17, 166, 45, 40, 35, 29, 140, 123,
+			47, 49, 238, 111, 41, 198, 189, 151, 24, 247, 183, 148, 204, 221,
+			75, 227, 253, 150, 146, 185, 123, 105, 188, 223, 130, 204, 125, 177,
+			197, 76, 123, 192, 117, 94, 88, 232, 249, 145, 130, 81, 122, 27,
+			105, 73, 16, 163, 30, 215, 9, 83, 20, 114, 172, 117, 32, 205,
+			4, 213, 46, 188, 94, 152, 174, 44, 203, 145, 43, 171, 28, 196,
+			210, 175, 212, 93, 132, 72, 40, 29, 176, 225, 117, 161, 194, 44,
+			224, 74, 102, 210, 42, 248, 100, 185, 83, 55, 203, 71, 240, 230,
+			12, 107, 202, 4, 192, 128, 82, 168, 106, 116, 126, 165, 232, 14,
+			58, 23, 202, 132, 117, 167, 134, 77, 231, 188, 94, 84, 90, 154,
+			184, 251, 29, 183, 221, 134, 250, 97, 169, 159, 60, 89, 60, 28,
+			17, 166, 47, 44, 20, 251, 216, 5, 102, 219, 3, 160, 148, 23,
+			21, 204, 193, 210, 105, 4, 65, 231, 134, 228, 229, 42, 160, 103,
+			130, 229, 24, 133, 114, 74, 9, 57, 245, 3, 222, 177, 23, 220,
+			118, 236, 174, 213, 127, 119, 236, 209, 91, 21, 147, 24, 32, 26,
+			126, 81, 65, 210, 240, 0, 209, 196, 139, 10, 189, 253, 10, 132,
+			195, 163, 176, 119, 128, 157, 160, 238, 13, 215, 126, 73, 193, 28,
+			42, 29, 145, 178, 92, 74, 55, 20, 51, 142, 215, 130, 52, 246,
+			98, 37, 144, 117, 251, 216, 159, 47, 201, 218, 199, 254, 124, 73,
+			161, 119, 143, 2, 45, 128, 131, 46, 49, 246, 1, 232, 121, 47,
+			47, 16, 99, 39, 126, 75, 56, 206, 214, 18, 12, 151, 214, 103,
+			7, 110, 55, 64, 122, 221, 203, 179, 142, 192, 87, 94, 94, 144,
+			44, 118, 128, 24, 251, 203, 11, 99, 227, 236, 25, 212, 145, 229,
+			218, 175, 40, 152, 51, 165, 163, 25, 139, 69, 203, 91, 94, 142,
+			118, 118, 98, 170, 3, 228, 220, 122, 69, 193, 220, 167, 64, 3,
+			160, 100, 170, 3, 164, 167, 189, 162, 32, 153, 234, 160, 235, 188,
+			170, 208, 243, 186, 130, 216, 243, 8, 45, 125, 85, 161, 56, 65,
+			76, 126, 16, 43, 249, 88, 193, 28, 41, 61, 131, 175, 228, 216,
+			29, 205, 120, 35, 130, 119, 170, 211, 239, 166, 226, 31, 84, 238,
+			163, 28, 207, 32, 173, 223, 99, 106, 218, 131, 180, 126, 143, 21,
+			36, 15, 26, 164, 61, 253, 88, 97, 104, 152, 56, 249, 32, 112,
+			242, 106, 224, 247, 48, 95, 185, 177, 214, 161, 165, 191, 186, 32,
+			165, 229, 32, 233, 76, 175, 46, 72, 105, 57, 72, 220, 245, 213,
+			64, 234, 105, 106, 221, 116, 237, 215, 22, 204, 241, 210, 29, 252,
+			124, 46, 170, 89, 54, 169, 122, 152, 162, 147, 72, 29, 157, 179,
+			233, 193, 152, 80, 232, 29, 52, 77, 155, 26, 209, 160, 3, 80,
+			114, 172, 65, 82, 214, 95, 91, 144, 28, 107, 144, 22, 245, 181,
+			133, 81, 184, 228, 77, 219, 117, 157, 215, 23, 122, 222, 44, 177,
+			141, 240, 212, 215, 3, 219, 103, 152, 109, 187, 192, 246, 27, 128,
+			237, 83, 55, 138, 237, 154, 159, 225, 3, 254, 13, 57, 64, 151,
+			240, 253, 6, 133, 111, 151, 240, 253, 6, 133, 111, 151, 240, 253,
+			6, 224, 27, 251, 197, 197, 112, 223, 8, 124, 31, 225, 43, 55,
+			218, 62, 48, 254, 70, 133, 113, 151, 48, 254, 70, 133, 113, 151,
+			48, 254, 70, 133, 113, 23, 251, 229, 77, 87, 199, 120, 205, 191,
+			62, 198, 93, 194, 248, 155, 20, 198, 93, 242, 180, 189, 73, 97,
+			220, 37, 140, 191, 73, 97, 220, 37, 140, 191, 9, 24, 127, 143,
+			201, 76, 123, 200, 117, 222, 89, 232, 249, 213, 130, 129, 50, 35,
+			74, 109, 215, 89, 43, 74, 96, 116, 101, 171, 60, 193, 146, 99,
+			234, 146, 223, 158, 17, 193, 236, 211, 157, 98, 4, 229, 223, 153,
+			10, 120, 13, 226, 157, 111, 239, 200, 69, 202, 11, 103, 242, 154,
+			223, 136, 182, 158, 44, 110, 142, 216, 216, 119, 22, 138, 227, 236,
+			18, 179, 237, 33, 80, 229, 187, 193, 205, 31, 253, 78, 185, 249,
+			12, 140, 30, 20, 251, 66, 65, 59, 138, 238, 185, 227, 182, 219,
+			148, 96, 34, 194, 29, 34, 194, 125, 183, 34, 220, 33, 34, 220,
+			119, 43, 70, 63, 68, 132, 251, 110, 48, 250, 135, 104, 100, 134,
+			107, 191, 167, 96, 186, 165, 123, 175, 193, 232, 209, 60, 173, 168,
+			232, 29, 249, 63, 228, 80, 8, 101, 222, 143, 238, 25, 34, 224,
+			61, 5, 105, 113, 15, 17, 73, 191, 167, 80, 220, 173, 64, 68,
+			173, 20, 6, 6, 217, 11, 97, 47, 14, 129, 166, 223, 95, 48,
+			75, 165, 20, 21, 186, 80, 32, 140, 227, 154, 100, 37, 159, 101,
+			10, 149, 48, 88, 82, 255, 74, 122, 188, 217, 240, 130, 80, 212,
+			236, 144, 215, 16, 65, 73, 58, 254, 188, 36, 10, 97, 202, 240,
+			243, 50, 250, 171, 130, 35, 109, 186, 55, 134, 130, 187, 8, 205,
+			151, 189, 70, 80, 227, 15, 47, 62, 188, 64, 157, 232, 17, 67,
+			150, 188, 63, 195, 21, 54, 193, 251, 11, 189, 35, 10, 180, 0,
+			142, 79, 176, 47, 137, 17, 91, 174, 253, 75, 216, 229, 255, 195,
+			224, 15, 100, 228, 180, 45, 247, 26, 193, 44, 109, 238, 109, 162,
+			38, 27, 2, 80, 119, 202, 17, 232, 140, 36, 237, 150, 64, 40,
+			99, 72, 187, 10, 151, 156, 72, 66, 168, 249, 113, 112, 217, 175,
+			101, 78, 8, 149, 223, 161, 177, 85, 193, 103, 114, 1, 201, 11,
+			129, 187, 87, 58, 111, 47, 203, 215, 58, 235, 200, 15, 209, 24,
+			129, 1, 243, 75, 25, 70, 96, 192, 252, 146, 146, 174, 67, 36,
+			246, 126, 9, 108, 233, 30, 66, 136, 237, 218, 31, 4, 66, 42,
+			87, 21, 227, 152, 123, 123, 71, 65, 62, 132, 67, 110, 251, 131,
+			89, 87, 8, 12, 248, 96, 214, 149, 13, 7, 13, 186, 58, 69,
+			93, 21, 92, 251, 87, 32, 200, 103, 50, 65, 46, 218, 190, 174,
+			40, 31, 50, 11, 14, 125, 188, 79, 129, 6, 64, 41, 202, 135,
+			204, 130, 5, 80, 138, 242, 97, 215, 249, 80, 161, 231, 191, 75,
+			225, 130, 112, 237, 15, 21, 138, 7, 200, 59, 53, 140, 109, 252,
+			17, 8, 151, 123, 110, 82, 148, 235, 152, 35, 45, 112, 135, 105,
+			151, 126, 68, 77, 126, 152, 118, 233, 71, 148, 120, 25, 166, 93,
+			250, 17, 136, 151, 219, 169, 99, 195, 181, 63, 10, 60, 223, 194,
+			87, 110, 188, 7, 8, 152, 143, 42, 1, 51, 76, 187, 241, 163,
+			74, 192, 12, 147, 128, 249, 40, 208, 139, 16, 162, 97, 108, 198,
+			223, 44, 152, 211, 165, 251, 59, 4, 140, 110, 86, 145, 240, 13,
+			138, 247, 97, 18, 54, 191, 169, 132, 205, 48, 9, 155, 223, 44,
+			244, 237, 83, 160, 129, 238, 246, 63, 69, 129, 22, 192, 201, 41,
+			90, 129, 17, 215, 249, 237, 66, 207, 239, 203, 21, 64, 164, 242,
+			111, 99, 5, 206, 50, 219, 30, 193, 10, 252, 14, 86, 224, 190,
+			155, 20, 239, 122, 34, 57, 17, 60, 66, 107, 240, 59, 106, 13,
+			70, 104, 13, 126, 71, 173, 193, 8, 173, 193, 239, 96, 13, 238,
+			164, 174, 13, 215, 254, 93, 172, 193, 36, 95, 185, 153, 62, 176,
+			10, 191, 171, 86, 97, 132, 86, 225, 119, 213, 42, 140, 208, 42,
+			252, 46, 86, 225, 229, 224, 48, 35, 88, 134, 79, 99, 25, 126,
+			240, 186, 203, 80, 243, 175, 189, 12, 252, 124, 216, 104, 119, 85,
+			87, 143, 98, 62, 121, 201, 111, 79, 146, 45, 53, 73, 251, 94,
+			150, 92, 23, 103, 164, 89, 200, 177, 61, 66, 43, 248, 105, 181,
+			130, 35, 180, 130, 159, 86, 43, 56, 66, 234, 194, 167, 213, 10,
+			142, 16, 167, 252, 180, 90, 193, 81, 215, 249, 76, 161, 231, 143,
+			229, 10, 34, 116, 249, 51, 133, 226, 62, 82, 208, 70, 177, 130,
+			159, 187, 41, 5, 141, 12, 114, 141, 1, 109, 148, 143, 210, 234,
+			125, 78, 173, 222, 40, 173, 222, 231, 212, 234, 141, 210, 234, 125,
+			78, 41, 104, 163, 24, 238, 231, 119, 86, 208, 174, 218, 62, 86,
+			238, 243, 106, 229, 70, 105, 229, 62, 175, 86, 110, 148, 86, 238,
+			243, 88, 57, 132, 49, 141, 98, 225, 190, 88, 48, 247, 149, 158,
+			158, 37, 243, 226, 22, 247, 44, 137, 69, 251, 49, 245, 147, 156,
+			104, 208, 125, 2, 233, 95, 84, 72, 31, 37, 83, 231, 139, 5,
+			25, 49, 52, 74, 72, 255, 98, 193, 29, 85, 160, 133, 151, 39,
+			80, 111, 220, 180, 199, 92, 231, 75, 133, 158, 255, 71, 34, 125,
+			12, 241, 55, 133, 226, 126, 246, 60, 102, 219, 99, 64, 250, 151,
+			65, 86, 255, 110, 91, 170, 113, 222, 247, 160, 132, 10, 185, 22,
+			116, 141, 141, 80, 253, 148, 170, 91, 210, 197, 253, 96, 172, 195,
+			133, 172, 118, 254, 24, 185, 34, 190, 172, 166, 48, 70, 174, 136,
+			47, 43, 186, 25, 163, 85, 250, 178, 162, 155, 49, 90, 165, 47,
+			131, 110, 112, 28, 53, 238, 58, 127, 81, 232, 249, 171, 194, 147,
+			116, 28, 53, 110, 184, 246, 95, 128, 149, 44, 51, 219, 30, 7,
+			78, 190, 1, 66, 92, 216, 78, 136, 250, 84, 188, 155, 22, 177,
+			73, 68, 118, 20, 84, 51, 233, 140, 200, 104, 102, 156, 104, 242,
+			27, 138, 38, 199, 105, 182, 223, 80, 52, 57, 78, 179, 253, 6,
+			104, 18, 60, 119, 28, 171, 249, 77, 140, 224, 126, 26, 129, 106,
+			77, 138, 123, 225, 11, 143, 26, 151, 125, 161, 174, 118, 221, 161,
+			134, 19, 44, 25, 82, 160, 59, 135, 250, 245, 205, 172, 115, 16,
+			236, 55, 179, 206, 65, 176, 223, 68, 231, 64, 245, 132, 235, 252,
+			93, 161, 231, 159, 158, 44, 84, 79, 24, 174, 253, 119, 74, 110,
+			78, 0, 213, 223, 190, 41, 185, 185, 133, 58, 37, 105, 196, 215,
+			253, 148, 114, 195, 52, 134, 39, 8, 195, 223, 86, 147, 156, 32,
+			12, 127, 91, 77, 114, 130, 48, 252, 109, 37, 55, 39, 128, 225,
+			127, 220, 89, 110, 94, 163, 7, 236, 251, 127, 84, 251, 126, 130,
+			246, 253, 63, 170, 125, 63, 65, 251, 254, 31, 177, 239, 127, 29,
+			199, 7, 37, 215, 121, 145, 211, 243, 58, 199, 40, 173, 33, 173,
+			77, 228, 50, 233, 140, 35, 104, 112, 58, 221, 137, 140, 159, 107,
+			37, 125, 211, 41, 30, 195, 237, 68, 200, 173, 209, 183, 97, 170,
+			81, 63, 89, 166, 74, 201, 112, 237, 23, 57, 197, 65, 138, 86,
+			46, 33, 76, 254, 197, 142, 249, 159, 28, 17, 173, 92, 178, 129,
+			224, 23, 59, 187, 250, 41, 90, 185, 36, 34, 219, 95, 226, 216,
+			37, 138, 69, 46, 201, 216, 244, 151, 56, 246, 72, 246, 192, 196,
+			131, 241, 9, 253, 133, 225, 218, 47, 205, 127, 1, 140, 190, 212,
+			177, 251, 178, 7, 38, 30, 228, 190, 48, 93, 251, 101, 249, 47,
+			176, 144, 47, 115, 236, 221, 217, 3, 122, 35, 247, 5, 188, 62,
+			249, 47, 160, 183, 190, 220, 177, 89, 246, 0, 46, 39, 103, 124,
+			130, 78, 207, 74, 152, 197, 43, 29, 115, 160, 52, 207, 23, 213,
+			81, 110, 102, 128, 41, 27, 68, 167, 247, 40, 59, 4, 151, 161,
+			173, 202, 251, 227, 133, 165, 123, 234, 242, 137, 178, 216, 127, 37,
+			34, 205, 87, 58, 146, 52, 75, 68, 154, 175, 116, 122, 251, 20,
+			136, 216, 21, 103, 207, 94, 210, 103, 75, 152, 209, 171, 28, 211,
+			45, 205, 208, 230, 215, 84, 66, 190, 175, 92, 191, 249, 176, 39,
+			73, 161, 37, 58, 82, 125, 149, 35, 237, 172, 18, 81, 232, 171,
+			28, 105, 103, 149, 136, 66, 95, 229, 12, 12, 146, 210, 90, 130,
+			100, 122, 204, 49, 103, 74, 247, 100, 138, 179, 110, 159, 215, 131,
+			48, 72, 160, 172, 171, 107, 219, 166, 58, 226, 20, 163, 152, 135,
+			145, 230, 237, 37, 82, 2, 30, 115, 164, 79, 172, 68, 242, 232,
+			49, 71, 42, 210, 37, 82, 2, 30, 115, 142, 222, 202, 238, 163,
+			142, 225, 51, 114, 204, 167, 148, 110, 35, 63, 239, 242, 197, 185,
+			185, 133, 5, 21, 211, 228, 207, 200, 83, 187, 46, 84, 103, 14,
+			197, 18, 57, 224, 94, 237, 152, 227, 10, 132, 75, 202, 153, 56,
+			164, 64, 106, 190, 124, 132, 221, 65, 157, 193, 155, 228, 152, 67,
+			165, 167, 82, 103, 162, 120, 142, 234, 9, 237, 83, 41, 87, 190,
+			233, 39, 73, 22, 156, 87, 34, 11, 228, 181, 217, 138, 193, 2,
+			121, 173, 35, 125, 150, 37, 58, 56, 121, 173, 51, 232, 146, 124,
+			221, 231, 58, 175, 119, 122, 126, 198, 17, 242, 117, 31, 188, 78,
+			78, 241, 16, 121, 107, 247, 129, 154, 222, 224, 152, 35, 165, 211,
+			143, 135, 193, 137, 223, 234, 17, 162, 18, 228, 200, 246, 17, 45,
+			189, 65, 141, 108, 31, 209, 210, 27, 28, 201, 230, 246, 17, 155,
+			123, 131, 51, 52, 204, 238, 162, 238, 225, 58, 114, 204, 137, 210,
+			173, 124, 229, 102, 251, 1, 41, 189, 209, 145, 204, 110, 31, 145,
+			210, 27, 29, 201, 236, 246, 17, 41, 189, 209, 25, 27, 71, 120,
+			171, 189, 15, 164, 244, 102, 244, 115, 59, 72, 137, 226, 183, 100,
+			96, 56, 157, 129, 43, 191, 134, 138, 35, 47, 227, 108, 163, 44,
+			244, 75, 221, 31, 52, 154, 55, 59, 50, 108, 124, 31, 81, 208,
+			155, 157, 93, 170, 63, 80, 208, 155, 179, 254, 44, 215, 126, 203,
+			77, 244, 7, 119, 88, 119, 127, 48, 103, 223, 146, 245, 7, 182,
+			240, 150, 172, 63, 152, 179, 111, 113, 228, 41, 224, 62, 40, 95,
+			111, 117, 204, 67, 165, 187, 110, 172, 63, 189, 141, 182, 245, 10,
+			186, 122, 107, 214, 43, 232, 234, 173, 206, 174, 146, 2, 17, 56,
+			228, 28, 56, 72, 17, 240, 251, 128, 145, 183, 59, 230, 129, 210,
+			211, 111, 172, 87, 165, 170, 118, 247, 89, 16, 237, 168, 62, 17,
+			173, 253, 118, 103, 215, 184, 2, 45, 128, 251, 246, 19, 45, 239,
+			119, 157, 119, 58, 61, 191, 33, 105, 121, 63, 124, 85, 78, 145,
+			211, 182, 221, 15, 90, 126, 151, 99, 142, 151, 110, 235, 34, 38,
+			85, 92, 94, 90, 216, 210, 108, 168, 7, 141, 6, 121, 26, 228,
+			56, 246, 147, 194, 247, 46, 71, 234, 127, 251, 137, 114, 223, 229,
+			200, 24, 130, 253, 68, 185, 239, 114, 70, 199, 216, 235, 97, 240,
+			236, 199, 250, 191, 15, 189, 253, 39, 212, 146, 236, 202, 33, 206,
+			111, 154, 27, 63, 35, 99, 250, 104, 140, 95, 16, 49, 159, 178,
+			134, 175, 58, 205, 20, 209, 86, 200, 131, 209, 153, 193, 160, 84,
+			126, 239, 189, 148, 8, 161, 39, 98, 216, 52, 54, 13, 58, 0,
+			165, 191, 116, 63, 237, 148, 247, 57, 210, 95, 186, 159, 118, 202,
+			251, 48, 175, 159, 23, 243, 50, 93, 251, 23, 49, 175, 183, 27,
+			178, 10, 161, 246, 138, 92, 125, 94, 234, 112, 98, 7, 29, 156,
+			93, 69, 9, 223, 166, 131, 223, 248, 156, 177, 91, 182, 205, 25,
+			91, 224, 23, 179, 57, 131, 223, 255, 98, 54, 103, 172, 214, 47,
+			102, 115, 198, 110, 253, 69, 204, 249, 205, 98, 206, 240, 163, 128,
+			225, 63, 102, 240, 229, 76, 158, 249, 87, 77, 242, 206, 227, 65,
+			167, 123, 119, 33, 36, 136, 25, 95, 156, 191, 169, 137, 229, 186,
+			235, 158, 158, 101, 211, 16, 53, 8, 191, 144, 211, 55, 172, 64,
+			248, 133, 156, 145, 67, 10, 164, 249, 148, 143, 176, 15, 136, 233,
+			217, 174, 253, 17, 199, 156, 46, 189, 211, 200, 172, 168, 27, 93,
+			214, 157, 205, 42, 246, 4, 47, 169, 98, 13, 219, 150, 213, 22,
+			99, 215, 160, 3, 80, 218, 100, 251, 137, 61, 125, 196, 145, 54,
+			217, 126, 18, 123, 31, 113, 164, 77, 118, 192, 117, 62, 238, 244,
+			252, 15, 231, 73, 50, 20, 14, 32, 54, 199, 41, 30, 96, 77,
+			102, 219, 7, 192, 123, 62, 9, 57, 186, 70, 188, 103, 135, 132,
+			242, 110, 153, 70, 114, 147, 66, 170, 187, 242, 18, 249, 228, 181,
+			46, 177, 157, 148, 152, 57, 64, 114, 246, 147, 74, 206, 30, 32,
+			110, 245, 73, 37, 103, 15, 16, 183, 250, 36, 228, 44, 78, 97,
+			14, 128, 252, 63, 5, 121, 116, 7, 95, 201, 146, 217, 175, 43,
+			110, 213, 240, 68, 147, 16, 184, 159, 82, 2, 247, 0, 177, 145,
+			79, 41, 129, 123, 128, 216, 200, 167, 32, 144, 192, 166, 15, 186,
+			206, 31, 56, 61, 255, 44, 217, 244, 65, 195, 181, 255, 192, 41,
+			30, 36, 54, 125, 16, 168, 250, 204, 227, 103, 211, 7, 137, 77,
+			127, 70, 145, 196, 65, 154, 248, 103, 20, 155, 62, 72, 19, 255,
+			12, 182, 246, 207, 88, 212, 155, 225, 218, 127, 230, 152, 251, 74,
+			63, 137, 235, 202, 186, 210, 32, 112, 223, 134, 71, 247, 36, 72,
+			140, 200, 51, 160, 109, 9, 17, 132, 181, 13, 47, 193, 0, 215,
+			35, 78, 145, 66, 242, 178, 110, 186, 113, 79, 38, 42, 210, 143,
+			112, 69, 137, 64, 69, 17, 147, 174, 238, 236, 16, 93, 100, 101,
+			206, 84, 25, 144, 57, 122, 174, 111, 8, 17, 87, 55, 96, 41,
+			234, 1, 238, 207, 12, 115, 93, 170, 75, 231, 80, 60, 20, 234,
+			45, 79, 90, 77, 212, 198, 4, 11, 18, 59, 18, 209, 236, 136,
+			112, 167, 59, 39, 106, 42, 101, 94, 189, 230, 169, 192, 72, 52,
+			41, 110, 150, 214, 103, 58, 205, 160, 122, 73, 223, 56, 135, 226,
+			170, 235, 196, 23, 218, 186, 15, 242, 222, 75, 11, 34, 246, 235,
+			171, 84, 126, 213, 79, 68, 252, 56, 60, 46, 45, 28, 5, 81,
+			140, 60, 206, 186, 130, 154, 190, 153, 98, 5, 23, 160, 144, 121,
+			64, 162, 87, 133, 152, 54, 218, 252, 129, 231, 46, 234, 101, 5,
+			121, 253, 153, 34, 175, 131, 68, 94, 127, 230, 244, 143, 42, 208,
+			194, 175, 19, 37, 50, 130, 14, 66, 159, 251, 138, 99, 30, 44,
+			205, 243, 229, 172, 14, 75, 183, 4, 190, 225, 229, 21, 93, 64,
+			100, 124, 37, 27, 0, 246, 204, 87, 156, 254, 9, 5, 34, 76,
+			199, 217, 127, 128, 236, 243, 131, 16, 32, 95, 5, 17, 223, 194,
+			151, 131, 23, 104, 121, 177, 173, 159, 252, 249, 214, 65, 10, 177,
+			250, 170, 178, 126, 14, 82, 214, 201, 87, 157, 162, 162, 92, 168,
+			116, 95, 5, 229, 126, 202, 32, 202, 181, 93, 251, 175, 177, 103,
+			63, 108, 228, 111, 150, 223, 185, 43, 196, 131, 100, 39, 46, 211,
+			186, 122, 133, 170, 132, 21, 53, 20, 18, 244, 181, 93, 196, 152,
+			137, 172, 176, 98, 184, 147, 122, 203, 139, 107, 116, 149, 183, 151,
+			138, 244, 148, 54, 147, 129, 183, 152, 2, 63, 231, 111, 249, 177,
+			110, 133, 78, 168, 46, 251, 49, 130, 107, 17, 9, 28, 213, 229,
+			79, 29, 196, 33, 15, 109, 52, 6, 160, 94, 254, 181, 98, 90,
+			7, 137, 127, 255, 181, 35, 15, 78, 14, 18, 255, 254, 107, 240,
+			144, 175, 33, 8, 250, 32, 244, 203, 127, 130, 1, 248, 121, 147,
+			14, 218, 200, 177, 133, 93, 232, 39, 59, 99, 161, 234, 53, 170,
+			45, 132, 8, 170, 27, 240, 17, 15, 13, 74, 78, 20, 21, 251,
+			53, 173, 143, 200, 173, 34, 118, 20, 41, 99, 249, 22, 53, 215,
+			206, 221, 222, 162, 24, 65, 85, 86, 96, 160, 237, 168, 24, 167,
+			68, 197, 246, 65, 109, 120, 201, 6, 79, 54, 254, 127, 222, 174,
+			93, 183, 137, 40, 136, 198, 139, 227, 137, 150, 6, 12, 81, 196,
+			38, 241, 189, 157, 13, 66, 72, 192, 119, 32, 81, 208, 163, 117,
+			178, 1, 11, 63, 80, 214, 72, 152, 54, 141, 37, 183, 22, 146,
+			145, 144, 171, 229, 47, 168, 104, 249, 36, 10, 116, 206, 157, 217,
+			93, 219, 226, 81, 64, 186, 92, 69, 222, 199, 220, 59, 143, 157,
+			153, 115, 230, 247, 74, 86, 77, 28, 180, 155, 4, 181, 154, 250,
+			97, 134, 190, 61, 141, 161, 115, 223, 173, 132, 220, 213, 33, 207,
+			220, 80, 158, 16, 200, 198, 247, 104, 145, 216, 129, 58, 204, 94,
+			165, 103, 179, 151, 249, 235, 244, 113, 208, 118, 31, 114, 238, 131,
+			48, 100, 187, 222, 165, 214, 137, 246, 155, 148, 118, 185, 4, 66,
+			202, 34, 140, 14, 131, 242, 31, 173, 195, 174, 45, 129, 144, 194,
+			7, 51, 82, 114, 174, 221, 186, 146, 189, 79, 242, 159, 60, 173,
+			107, 180, 155, 87, 114, 112, 47, 232, 133, 131, 255, 152, 75, 116,
+			55, 249, 214, 168, 176, 211, 32, 189, 40, 247, 108, 156, 142, 170,
+			134, 56, 229, 174, 152, 92, 108, 33, 168, 43, 110, 16, 35, 244,
+			49, 212, 240, 246, 212, 65, 205, 157, 2, 189, 2, 38, 10, 80,
+			12, 101, 104, 114, 55, 114, 146, 184, 206, 78, 82, 251, 153, 238,
+			78, 207, 136, 137, 118, 137, 53, 44, 61, 225, 232, 204, 231, 162,
+			122, 225, 232, 211, 230, 162, 32, 108, 71, 159, 54, 151, 246, 29,
+			66, 251, 28, 12, 211, 66, 34, 151, 60, 65, 185, 24, 110, 64,
+			73, 49, 202, 14, 243, 225, 172, 122, 109, 197, 3, 188, 25, 140,
+			207, 205, 16, 57, 54, 186, 47, 68, 93, 168, 163, 233, 93, 136,
+			238, 181, 163, 233, 93, 200, 97, 98, 75, 52, 12, 201, 105, 39,
+			46, 224, 81, 29, 108, 239, 82, 162, 163, 228, 227, 13, 255, 44,
+			125, 63, 24, 189, 27, 249, 113, 217, 242, 174, 247, 253, 219, 126,
+			119, 255, 162, 210, 196, 252, 44, 213, 196, 181, 142, 30, 225, 181,
+			60, 120, 54, 113, 194, 3, 198, 128, 196, 107, 253, 89, 93, 206,
+			228, 45, 229, 251, 61, 228, 20, 66, 29, 54, 209, 7, 64, 34,
+			59, 175, 61, 27, 174, 164, 197, 3, 228, 42, 45, 18, 229, 56,
+			218, 148, 79, 184, 73, 237, 112, 65, 131, 103, 111, 244, 11, 66,
+			146, 241, 196, 100, 141, 10, 242, 112, 72, 134, 107, 160, 17, 96,
+			90, 99, 158, 157, 192, 214, 195, 122, 216, 182, 120, 128, 151, 96,
+			24, 1, 231, 185, 219, 182, 223, 195, 139, 99, 135, 89, 116, 199,
+			127, 124, 62, 248, 144, 221, 199, 5, 8, 168, 208, 160, 26, 225,
+			8, 170, 109, 147, 75, 115, 103, 142, 21, 152, 165, 168, 179, 113,
+			204, 87, 44, 69, 65, 4, 142, 25, 175, 165, 40, 136, 192, 97,
+			181, 146, 107, 0, 17, 56, 250, 192, 85, 117, 210, 225, 3, 87,
+			162, 32, 2, 199, 42, 253, 74, 20, 68, 224, 219, 173, 207, 178,
+			247, 69, 66, 20, 233, 145, 224, 145, 131, 36, 126, 16, 55, 155,
+			30, 86, 96, 45, 209, 73, 114, 162, 106, 188, 241, 37, 189, 209,
+			99, 234, 89, 216, 89, 219, 113, 247, 12, 32, 215, 114, 243, 150,
+			45, 209, 63, 36, 183, 143, 108, 137, 254, 33, 73, 142, 227, 239,
+			48, 55, 30, 114, 43, 36, 234, 36, 95, 27, 127, 70, 7, 0,
+			29, 21, 30, 231, 159, 65, 163, 200, 32, 6, 98, 156, 108, 106,
+			195, 37, 125, 23, 127, 76, 243, 110, 201, 98, 19, 10, 45, 59,
+			119, 127, 196, 221, 195, 137, 41, 69, 129, 74, 75, 97, 226, 247,
+			84, 245, 66, 20, 97, 224, 25, 196, 23, 114, 124, 250, 124, 191,
+			223, 122, 123, 57, 153, 78, 158, 254, 28, 0, 100, 208, 165, 133,
+			182, 6, 1, 0},
 	)
 }
 
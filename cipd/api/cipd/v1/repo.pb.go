@@ -29,48 +29,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-// Roles used in package prefix ACLs.
-//
-// A user can have one or more such roles for a package prefix. They get
-// inherited by all subprefixes.
-type Role int32
-
-const (
-	Role_ROLE_UNSPECIFIED Role = 0
-	// Readers can fetch package instances and package metadata (e.g. list of
-	// instances, all tags, all refs), but not prefix metadata (e.g. ACLs).
-	Role_READER Role = 1
-	// Writers can do everything that readers can, plus create new packages,
-	// upload package instances, attach tags, move refs.
-	Role_WRITER Role = 2
-	// Owners can do everything that writers can, plus read prefix metadata for
-	// all parent prefixes and all subprefixes, and modify prefix metadata for
-	// all subprefixes.
-	Role_OWNER Role = 3
-)
-
-var Role_name = map[int32]string{
-	0: "ROLE_UNSPECIFIED",
-	1: "READER",
-	2: "WRITER",
-	3: "OWNER",
-}
-
-var Role_value = map[string]int32{
-	"ROLE_UNSPECIFIED": 0,
-	"READER":           1,
-	"WRITER":           2,
-	"OWNER":            3,
-}
-
-func (x Role) String() string {
-	return proto.EnumName(Role_name, int32(x))
-}
-
-func (Role) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{0}
-}
-
 // RegistrationStatus is part of RegisterInstance RPC response.
 type RegistrationStatus int32
 
@@ -100,7 +58,7 @@ func (x RegistrationStatus) String() string {
 }
 
 func (RegistrationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{1}
+	return fileDescriptor_70efefd016927455, []int{0}
 }
 
 type Processor_State int32
@@ -131,7 +89,7 @@ func (x Processor_State) String() string {
 }
 
 func (Processor_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{22, 0}
+	return fileDescriptor_70efefd016927455, []int{26, 0}
 }
 
 type PrefixRequest struct {
@@ -174,145 +132,6 @@ func (m *PrefixRequest) GetPrefix() string {
 	return ""
 }
 
-// PrefixMetadata is metadata defined at some concrete package prefix.
-//
-// It applies to this prefix and all subprefixes, recursively.
-type PrefixMetadata struct {
-	// Prefix this metadata is defined at, e.g. "a/b/c".
-	//
-	// Note: there's no metadata at the root, so prefix must never be "".
-	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	// An opaque string that identifies a particular version of this metadata.
-	//
-	// Used by UpdatePrefixMetadata to prevent an accidental overwrite of changes.
-	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	// When the metadata was modified the last time.
-	//
-	// Managed by the server, ignored when passed to UpdatePrefixMetadata.
-	UpdateTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	// Identity string of whoever modified the metadata the last time.
-	//
-	// Managed by the server, ignored when passed to UpdatePrefixMetadata.
-	UpdateUser string `protobuf:"bytes,4,opt,name=update_user,json=updateUser,proto3" json:"update_user,omitempty"`
-	// ACLs that apply to this prefix and all subprefixes, as a mapping from
-	// a role to a list of users and groups that have it.
-	Acls                 []*PrefixMetadata_ACL `protobuf:"bytes,5,rep,name=acls,proto3" json:"acls,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
-}
-
-func (m *PrefixMetadata) Reset()         { *m = PrefixMetadata{} }
-func (m *PrefixMetadata) String() string { return proto.CompactTextString(m) }
-func (*PrefixMetadata) ProtoMessage()    {}
-func (*PrefixMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{1}
-}
-
-func (m *PrefixMetadata) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrefixMetadata.Unmarshal(m, b)
-}
-func (m *PrefixMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrefixMetadata.Marshal(b, m, deterministic)
-}
-func (m *PrefixMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrefixMetadata.Merge(m, src)
-}
-func (m *PrefixMetadata) XXX_Size() int {
-	return xxx_messageInfo_PrefixMetadata.Size(m)
-}
-func (m *PrefixMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrefixMetadata.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PrefixMetadata proto.InternalMessageInfo
-
-func (m *PrefixMetadata) GetPrefix() string {
-	if m != nil {
-		return m.Prefix
-	}
-	return ""
-}
-
-func (m *PrefixMetadata) GetFingerprint() string {
-	if m != nil {
-		return m.Fingerprint
-	}
-	return ""
-}
-
-func (m *PrefixMetadata) GetUpdateTime() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdateTime
-	}
-	return nil
-}
-
-func (m *PrefixMetadata) GetUpdateUser() string {
-	if m != nil {
-		return m.UpdateUser
-	}
-	return ""
-}
-
-func (m *PrefixMetadata) GetAcls() []*PrefixMetadata_ACL {
-	if m != nil {
-		return m.Acls
-	}
-	return nil
-}
-
-type PrefixMetadata_ACL struct {
-	// Role that this ACL describes.
-	Role Role `protobuf:"varint,1,opt,name=role,proto3,enum=cipd.Role" json:"role,omitempty"`
-	// Users and groups that have the specified role.
-	//
-	// Each entry has a form "<kind>:<value>", e.g "group:..." or "user:...".
-	Principals           []string `protobuf:"bytes,2,rep,name=principals,proto3" json:"principals,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PrefixMetadata_ACL) Reset()         { *m = PrefixMetadata_ACL{} }
-func (m *PrefixMetadata_ACL) String() string { return proto.CompactTextString(m) }
-func (*PrefixMetadata_ACL) ProtoMessage()    {}
-func (*PrefixMetadata_ACL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{1, 0}
-}
-
-func (m *PrefixMetadata_ACL) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrefixMetadata_ACL.Unmarshal(m, b)
-}
-func (m *PrefixMetadata_ACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrefixMetadata_ACL.Marshal(b, m, deterministic)
-}
-func (m *PrefixMetadata_ACL) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrefixMetadata_ACL.Merge(m, src)
-}
-func (m *PrefixMetadata_ACL) XXX_Size() int {
-	return xxx_messageInfo_PrefixMetadata_ACL.Size(m)
-}
-func (m *PrefixMetadata_ACL) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrefixMetadata_ACL.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PrefixMetadata_ACL proto.InternalMessageInfo
-
-func (m *PrefixMetadata_ACL) GetRole() Role {
-	if m != nil {
-		return m.Role
-	}
-	return Role_ROLE_UNSPECIFIED
-}
-
-func (m *PrefixMetadata_ACL) GetPrincipals() []string {
-	if m != nil {
-		return m.Principals
-	}
-	return nil
-}
-
 type InheritedPrefixMetadata struct {
 	// Per-prefix metadata that applies to a prefix, ordered by prefix length.
 	//
@@ -329,7 +148,7 @@ func (m *InheritedPrefixMetadata) Reset()         { *m = InheritedPrefixMetadata
 func (m *InheritedPrefixMetadata) String() string { return proto.CompactTextString(m) }
 func (*InheritedPrefixMetadata) ProtoMessage()    {}
 func (*InheritedPrefixMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{2}
+	return fileDescriptor_70efefd016927455, []int{1}
 }
 
 func (m *InheritedPrefixMetadata) XXX_Unmarshal(b []byte) error {
@@ -369,7 +188,7 @@ func (m *RolesInPrefixResponse) Reset()         { *m = RolesInPrefixResponse{} }
 func (m *RolesInPrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*RolesInPrefixResponse) ProtoMessage()    {}
 func (*RolesInPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{3}
+	return fileDescriptor_70efefd016927455, []int{2}
 }
 
 func (m *RolesInPrefixResponse) XXX_Unmarshal(b []byte) error {
@@ -408,7 +227,7 @@ func (m *RolesInPrefixResponse_RoleInPrefix) Reset()         { *m = RolesInPrefi
 func (m *RolesInPrefixResponse_RoleInPrefix) String() string { return proto.CompactTextString(m) }
 func (*RolesInPrefixResponse_RoleInPrefix) ProtoMessage()    {}
 func (*RolesInPrefixResponse_RoleInPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{3, 0}
+	return fileDescriptor_70efefd016927455, []int{2, 0}
 }
 
 func (m *RolesInPrefixResponse_RoleInPrefix) XXX_Unmarshal(b []byte) error {
@@ -453,7 +272,7 @@ func (m *ListPrefixRequest) Reset()         { *m = ListPrefixRequest{} }
 func (m *ListPrefixRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPrefixRequest) ProtoMessage()    {}
 func (*ListPrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{4}
+	return fileDescriptor_70efefd016927455, []int{3}
 }
 
 func (m *ListPrefixRequest) XXX_Unmarshal(b []byte) error {
@@ -509,7 +328,7 @@ func (m *ListPrefixResponse) Reset()         { *m = ListPrefixResponse{} }
 func (m *ListPrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPrefixResponse) ProtoMessage()    {}
 func (*ListPrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{5}
+	return fileDescriptor_70efefd016927455, []int{4}
 }
 
 func (m *ListPrefixResponse) XXX_Unmarshal(b []byte) error {
@@ -556,7 +375,7 @@ func (m *PackageRequest) Reset()         { *m = PackageRequest{} }
 func (m *PackageRequest) String() string { return proto.CompactTextString(m) }
 func (*PackageRequest) ProtoMessage()    {}
 func (*PackageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{6}
+	return fileDescriptor_70efefd016927455, []int{5}
 }
 
 func (m *PackageRequest) XXX_Unmarshal(b []byte) error {
@@ -603,7 +422,7 @@ func (m *Instance) Reset()         { *m = Instance{} }
 func (m *Instance) String() string { return proto.CompactTextString(m) }
 func (*Instance) ProtoMessage()    {}
 func (*Instance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{7}
+	return fileDescriptor_70efefd016927455, []int{6}
 }
 
 func (m *Instance) XXX_Unmarshal(b []byte) error {
@@ -673,7 +492,7 @@ func (m *RegisterInstanceResponse) Reset()         { *m = RegisterInstanceRespon
 func (m *RegisterInstanceResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterInstanceResponse) ProtoMessage()    {}
 func (*RegisterInstanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{8}
+	return fileDescriptor_70efefd016927455, []int{7}
 }
 
 func (m *RegisterInstanceResponse) XXX_Unmarshal(b []byte) error {
@@ -733,7 +552,7 @@ func (m *ListInstancesRequest) Reset()         { *m = ListInstancesRequest{} }
 func (m *ListInstancesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInstancesRequest) ProtoMessage()    {}
 func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{9}
+	return fileDescriptor_70efefd016927455, []int{8}
 }
 
 func (m *ListInstancesRequest) XXX_Unmarshal(b []byte) error {
@@ -790,7 +609,7 @@ func (m *ListInstancesResponse) Reset()         { *m = ListInstancesResponse{} }
 func (m *ListInstancesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInstancesResponse) ProtoMessage()    {}
 func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{10}
+	return fileDescriptor_70efefd016927455, []int{9}
 }
 
 func (m *ListInstancesResponse) XXX_Unmarshal(b []byte) error {
@@ -850,7 +669,7 @@ func (m *SearchInstancesRequest) Reset()         { *m = SearchInstancesRequest{}
 func (m *SearchInstancesRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchInstancesRequest) ProtoMessage()    {}
 func (*SearchInstancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{11}
+	return fileDescriptor_70efefd016927455, []int{10}
 }
 
 func (m *SearchInstancesRequest) XXX_Unmarshal(b []byte) error {
@@ -914,7 +733,7 @@ func (m *SearchInstancesResponse) Reset()         { *m = SearchInstancesResponse
 func (m *SearchInstancesResponse) String() string { return proto.CompactTextString(m) }
 func (*SearchInstancesResponse) ProtoMessage()    {}
 func (*SearchInstancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{12}
+	return fileDescriptor_70efefd016927455, []int{11}
 }
 
 func (m *SearchInstancesResponse) XXX_Unmarshal(b []byte) error {
@@ -971,7 +790,7 @@ func (m *Ref) Reset()         { *m = Ref{} }
 func (m *Ref) String() string { return proto.CompactTextString(m) }
 func (*Ref) ProtoMessage()    {}
 func (*Ref) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{13}
+	return fileDescriptor_70efefd016927455, []int{12}
 }
 
 func (m *Ref) XXX_Unmarshal(b []byte) error {
@@ -1041,7 +860,7 @@ func (m *DeleteRefRequest) Reset()         { *m = DeleteRefRequest{} }
 func (m *DeleteRefRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRefRequest) ProtoMessage()    {}
 func (*DeleteRefRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{14}
+	return fileDescriptor_70efefd016927455, []int{13}
 }
 
 func (m *DeleteRefRequest) XXX_Unmarshal(b []byte) error {
@@ -1088,7 +907,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{15}
+	return fileDescriptor_70efefd016927455, []int{14}
 }
 
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
@@ -1128,7 +947,7 @@ func (m *ListRefsResponse) Reset()         { *m = ListRefsResponse{} }
 func (m *ListRefsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRefsResponse) ProtoMessage()    {}
 func (*ListRefsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{16}
+	return fileDescriptor_70efefd016927455, []int{15}
 }
 
 func (m *ListRefsResponse) XXX_Unmarshal(b []byte) error {
@@ -1182,7 +1001,7 @@ func (m *Tag) Reset()         { *m = Tag{} }
 func (m *Tag) String() string { return proto.CompactTextString(m) }
 func (*Tag) ProtoMessage()    {}
 func (*Tag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{17}
+	return fileDescriptor_70efefd016927455, []int{16}
 }
 
 func (m *Tag) XXX_Unmarshal(b []byte) error {
@@ -1247,7 +1066,7 @@ func (m *AttachTagsRequest) Reset()         { *m = AttachTagsRequest{} }
 func (m *AttachTagsRequest) String() string { return proto.CompactTextString(m) }
 func (*AttachTagsRequest) ProtoMessage()    {}
 func (*AttachTagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{18}
+	return fileDescriptor_70efefd016927455, []int{17}
 }
 
 func (m *AttachTagsRequest) XXX_Unmarshal(b []byte) error {
@@ -1305,7 +1124,7 @@ func (m *DetachTagsRequest) Reset()         { *m = DetachTagsRequest{} }
 func (m *DetachTagsRequest) String() string { return proto.CompactTextString(m) }
 func (*DetachTagsRequest) ProtoMessage()    {}
 func (*DetachTagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{19}
+	return fileDescriptor_70efefd016927455, []int{18}
 }
 
 func (m *DetachTagsRequest) XXX_Unmarshal(b []byte) error {
@@ -1347,6 +1166,319 @@ func (m *DetachTagsRequest) GetTags() []*Tag {
 	return nil
 }
 
+// InstanceMetadata is a key => binary blob pair attached to some instance.
+//
+// Keys don't have to be unique, only the full (key, value) pair should. Each
+// such pair is identified by its fingerprint, see below.
+type InstanceMetadata struct {
+	// Key should be a lowercase identifier-like string ([a-z0-9_\-]+), no longer
+	// than 400 bytes.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Value can be an arbitrary byte blob, no larger than 512 KB.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Content type of the value, e.g. "text/plain" or "application/json".
+	//
+	// Optional. If set, must be a valid MIME type.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Fingerprint identifies this entry among all entries attached to the
+	// instance (output only).
+	//
+	// It is a hex string derived from the key and the value. It can be used in
+	// DetachMetadata instead of key and value.
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// User that attached this entry (output only).
+	AttachedBy string `protobuf:"bytes,5,opt,name=attached_by,json=attachedBy,proto3" json:"attached_by,omitempty"`
+	// When the entry was attached (output only).
+	AttachedTs           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=attached_ts,json=attachedTs,proto3" json:"attached_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InstanceMetadata) Reset()         { *m = InstanceMetadata{} }
+func (m *InstanceMetadata) String() string { return proto.CompactTextString(m) }
+func (*InstanceMetadata) ProtoMessage()    {}
+func (*InstanceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{19}
+}
+
+func (m *InstanceMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceMetadata.Unmarshal(m, b)
+}
+func (m *InstanceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceMetadata.Marshal(b, m, deterministic)
+}
+func (m *InstanceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceMetadata.Merge(m, src)
+}
+func (m *InstanceMetadata) XXX_Size() int {
+	return xxx_messageInfo_InstanceMetadata.Size(m)
+}
+func (m *InstanceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceMetadata proto.InternalMessageInfo
+
+func (m *InstanceMetadata) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *InstanceMetadata) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *InstanceMetadata) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *InstanceMetadata) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *InstanceMetadata) GetAttachedBy() string {
+	if m != nil {
+		return m.AttachedBy
+	}
+	return ""
+}
+
+func (m *InstanceMetadata) GetAttachedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.AttachedTs
+	}
+	return nil
+}
+
+type AttachMetadataRequest struct {
+	// The package that holds the instance we attach metadata to.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance we attach metadata to.
+	Instance *ObjectRef `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// One or more metadata entries to attach (order doesn't matter).
+	Metadata             []*InstanceMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AttachMetadataRequest) Reset()         { *m = AttachMetadataRequest{} }
+func (m *AttachMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachMetadataRequest) ProtoMessage()    {}
+func (*AttachMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{20}
+}
+
+func (m *AttachMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachMetadataRequest.Unmarshal(m, b)
+}
+func (m *AttachMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachMetadataRequest.Marshal(b, m, deterministic)
+}
+func (m *AttachMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachMetadataRequest.Merge(m, src)
+}
+func (m *AttachMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_AttachMetadataRequest.Size(m)
+}
+func (m *AttachMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachMetadataRequest proto.InternalMessageInfo
+
+func (m *AttachMetadataRequest) GetPackage() string {
+	if m != nil {
+		return m.Package
+	}
+	return ""
+}
+
+func (m *AttachMetadataRequest) GetInstance() *ObjectRef {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+func (m *AttachMetadataRequest) GetMetadata() []*InstanceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type DetachMetadataRequest struct {
+	// The package that holds the instance we detach metadata from.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance we detach metadata from.
+	Instance *ObjectRef `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// One or more metadata entries to detach (order doesn't matter).
+	//
+	// Only 'fingerprint' or 'key' and 'value' fields are used.
+	Metadata             []*InstanceMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DetachMetadataRequest) Reset()         { *m = DetachMetadataRequest{} }
+func (m *DetachMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*DetachMetadataRequest) ProtoMessage()    {}
+func (*DetachMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{21}
+}
+
+func (m *DetachMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetachMetadataRequest.Unmarshal(m, b)
+}
+func (m *DetachMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetachMetadataRequest.Marshal(b, m, deterministic)
+}
+func (m *DetachMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachMetadataRequest.Merge(m, src)
+}
+func (m *DetachMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_DetachMetadataRequest.Size(m)
+}
+func (m *DetachMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetachMetadataRequest proto.InternalMessageInfo
+
+func (m *DetachMetadataRequest) GetPackage() string {
+	if m != nil {
+		return m.Package
+	}
+	return ""
+}
+
+func (m *DetachMetadataRequest) GetInstance() *ObjectRef {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+func (m *DetachMetadataRequest) GetMetadata() []*InstanceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ListMetadataRequest struct {
+	// The package that holds the instance we list metadata of.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance we list metadata of.
+	Instance *ObjectRef `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Metadata keys to fetch or empty to fetch all entries.
+	Keys                 []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMetadataRequest) Reset()         { *m = ListMetadataRequest{} }
+func (m *ListMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataRequest) ProtoMessage()    {}
+func (*ListMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{22}
+}
+
+func (m *ListMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMetadataRequest.Unmarshal(m, b)
+}
+func (m *ListMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMetadataRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataRequest.Merge(m, src)
+}
+func (m *ListMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMetadataRequest.Size(m)
+}
+func (m *ListMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMetadataRequest proto.InternalMessageInfo
+
+func (m *ListMetadataRequest) GetPackage() string {
+	if m != nil {
+		return m.Package
+	}
+	return ""
+}
+
+func (m *ListMetadataRequest) GetInstance() *ObjectRef {
+	if m != nil {
+		return m.Instance
+	}
+	return nil
+}
+
+func (m *ListMetadataRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ListMetadataResponse struct {
+	// Metadata entries, sorted by the key first, and then by the timestamp (most
+	// recent first).
+	Metadata             []*InstanceMetadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListMetadataResponse) Reset()         { *m = ListMetadataResponse{} }
+func (m *ListMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataResponse) ProtoMessage()    {}
+func (*ListMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{23}
+}
+
+func (m *ListMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMetadataResponse.Unmarshal(m, b)
+}
+func (m *ListMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMetadataResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataResponse.Merge(m, src)
+}
+func (m *ListMetadataResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMetadataResponse.Size(m)
+}
+func (m *ListMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMetadataResponse proto.InternalMessageInfo
+
+func (m *ListMetadataResponse) GetMetadata() []*InstanceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type ResolveVersionRequest struct {
 	// The package that contains the instance we are resolving version of.
 	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
@@ -1361,7 +1493,7 @@ func (m *ResolveVersionRequest) Reset()         { *m = ResolveVersionRequest{} }
 func (m *ResolveVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveVersionRequest) ProtoMessage()    {}
 func (*ResolveVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{20}
+	return fileDescriptor_70efefd016927455, []int{24}
 }
 
 func (m *ResolveVersionRequest) XXX_Unmarshal(b []byte) error {
@@ -1410,7 +1542,7 @@ func (m *GetInstanceURLRequest) Reset()         { *m = GetInstanceURLRequest{} }
 func (m *GetInstanceURLRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInstanceURLRequest) ProtoMessage()    {}
 func (*GetInstanceURLRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{21}
+	return fileDescriptor_70efefd016927455, []int{25}
 }
 
 func (m *GetInstanceURLRequest) XXX_Unmarshal(b []byte) error {
@@ -1467,7 +1599,7 @@ func (m *Processor) Reset()         { *m = Processor{} }
 func (m *Processor) String() string { return proto.CompactTextString(m) }
 func (*Processor) ProtoMessage()    {}
 func (*Processor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{22}
+	return fileDescriptor_70efefd016927455, []int{26}
 }
 
 func (m *Processor) XXX_Unmarshal(b []byte) error {
@@ -1533,7 +1665,9 @@ type DescribeInstanceRequest struct {
 	// Whether the response should include "tags" field.
 	DescribeTags bool `protobuf:"varint,4,opt,name=describe_tags,json=describeTags,proto3" json:"describe_tags,omitempty"`
 	// Whether the response should include "processors" field.
-	DescribeProcessors   bool     `protobuf:"varint,5,opt,name=describe_processors,json=describeProcessors,proto3" json:"describe_processors,omitempty"`
+	DescribeProcessors bool `protobuf:"varint,5,opt,name=describe_processors,json=describeProcessors,proto3" json:"describe_processors,omitempty"`
+	// Whether the response should include "metadata" field.
+	DescribeMetadata     bool     `protobuf:"varint,6,opt,name=describe_metadata,json=describeMetadata,proto3" json:"describe_metadata,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1543,7 +1677,7 @@ func (m *DescribeInstanceRequest) Reset()         { *m = DescribeInstanceRequest
 func (m *DescribeInstanceRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeInstanceRequest) ProtoMessage()    {}
 func (*DescribeInstanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{23}
+	return fileDescriptor_70efefd016927455, []int{27}
 }
 
 func (m *DescribeInstanceRequest) XXX_Unmarshal(b []byte) error {
@@ -1599,6 +1733,13 @@ func (m *DescribeInstanceRequest) GetDescribeProcessors() bool {
 	return false
 }
 
+func (m *DescribeInstanceRequest) GetDescribeMetadata() bool {
+	if m != nil {
+		return m.DescribeMetadata
+	}
+	return false
+}
+
 type DescribeInstanceResponse struct {
 	// The instance with all output fields filled in.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
@@ -1616,17 +1757,22 @@ type DescribeInstanceResponse struct {
 	// ID.
 	//
 	// Present only if the request has describe_processors == true.
-	Processors           []*Processor `protobuf:"bytes,4,rep,name=processors,proto3" json:"processors,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Processors []*Processor `protobuf:"bytes,4,rep,name=processors,proto3" json:"processors,omitempty"`
+	// Metadata attached to the instance, sorted by the key first, and then by
+	// the timestamp (most recent first).
+	//
+	// Present only if the request has describe_metadata == true.
+	Metadata             []*InstanceMetadata `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *DescribeInstanceResponse) Reset()         { *m = DescribeInstanceResponse{} }
 func (m *DescribeInstanceResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeInstanceResponse) ProtoMessage()    {}
 func (*DescribeInstanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{24}
+	return fileDescriptor_70efefd016927455, []int{28}
 }
 
 func (m *DescribeInstanceResponse) XXX_Unmarshal(b []byte) error {
@@ -1663,14 +1809,21 @@ func (m *DescribeInstanceResponse) GetRefs() []*Ref {
 
 func (m *DescribeInstanceResponse) GetTags() []*Tag {
 	if m != nil {
-		return m.Tags
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *DescribeInstanceResponse) GetProcessors() []*Processor {
+	if m != nil {
+		return m.Processors
 	}
 	return nil
 }
 
-func (m *DescribeInstanceResponse) GetProcessors() []*Processor {
+func (m *DescribeInstanceResponse) GetMetadata() []*InstanceMetadata {
 	if m != nil {
-		return m.Processors
+		return m.Metadata
 	}
 	return nil
 }
@@ -1691,7 +1844,7 @@ func (m *DescribeClientRequest) Reset()         { *m = DescribeClientRequest{} }
 func (m *DescribeClientRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeClientRequest) ProtoMessage()    {}
 func (*DescribeClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{25}
+	return fileDescriptor_70efefd016927455, []int{29}
 }
 
 func (m *DescribeClientRequest) XXX_Unmarshal(b []byte) error {
@@ -1769,7 +1922,7 @@ func (m *DescribeClientResponse) Reset()         { *m = DescribeClientResponse{}
 func (m *DescribeClientResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeClientResponse) ProtoMessage()    {}
 func (*DescribeClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70efefd016927455, []int{26}
+	return fileDescriptor_70efefd016927455, []int{30}
 }
 
 func (m *DescribeClientResponse) XXX_Unmarshal(b []byte) error {
@@ -1832,13 +1985,140 @@ func (m *DescribeClientResponse) GetClientRefAliases() []*ObjectRef {
 	return nil
 }
 
+type ListEventsRequest struct {
+	// A prefix or a package name to list events of, e.g. "a/b/c".
+	//
+	// Events of all packages under the prefix are returned, as well as events
+	// related to the prefix itself (such as PREFIX_ACL_CHANGED).
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// If not empty, return only events of these kinds.
+	Kinds []EventKind `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=cipd.EventKind" json:"kinds,omitempty"`
+	// Maximum number of events to return on one page, default is 100.
+	//
+	// The server scans the global event log and filters it by the prefix and
+	// kinds, visiting a bounded number of log entries per request. So a page may
+	// contain fewer events than requested, or even no events at all, while there
+	// are still more events to return. Always use 'next_page_token' (and not the
+	// page size) to check if there are more.
+	PageSize int32 `protobuf:"varint,20,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Value of 'next_page_token' from the previous response.
+	//
+	// Can be used to resume fetching.
+	PageToken            string   `protobuf:"bytes,21,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()    {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{31}
+}
+
+func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
+}
+func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsRequest.Merge(m, src)
+}
+func (m *ListEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventsRequest.Size(m)
+}
+func (m *ListEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
+
+func (m *ListEventsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetKinds() []EventKind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *ListEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	// Events, most recent first.
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Value to pass as 'page_token' in ListEventsRequest to resume fetching or
+	// empty string if there's no more results.
+	//
+	// May be set even if 'events' is empty, see ListEventsRequest.page_size.
+	NextPageToken        string   `protobuf:"bytes,20,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
+func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse) ProtoMessage()    {}
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70efefd016927455, []int{32}
+}
+
+func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
+}
+func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse.Merge(m, src)
+}
+func (m *ListEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse.Size(m)
+}
+func (m *ListEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
+
+func (m *ListEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListEventsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 func init() {
-	proto.RegisterEnum("cipd.Role", Role_name, Role_value)
 	proto.RegisterEnum("cipd.RegistrationStatus", RegistrationStatus_name, RegistrationStatus_value)
 	proto.RegisterEnum("cipd.Processor_State", Processor_State_name, Processor_State_value)
 	proto.RegisterType((*PrefixRequest)(nil), "cipd.PrefixRequest")
-	proto.RegisterType((*PrefixMetadata)(nil), "cipd.PrefixMetadata")
-	proto.RegisterType((*PrefixMetadata_ACL)(nil), "cipd.PrefixMetadata.ACL")
 	proto.RegisterType((*InheritedPrefixMetadata)(nil), "cipd.InheritedPrefixMetadata")
 	proto.RegisterType((*RolesInPrefixResponse)(nil), "cipd.RolesInPrefixResponse")
 	proto.RegisterType((*RolesInPrefixResponse_RoleInPrefix)(nil), "cipd.RolesInPrefixResponse.RoleInPrefix")
@@ -1858,6 +2138,11 @@ func init() {
 	proto.RegisterType((*Tag)(nil), "cipd.Tag")
 	proto.RegisterType((*AttachTagsRequest)(nil), "cipd.AttachTagsRequest")
 	proto.RegisterType((*DetachTagsRequest)(nil), "cipd.DetachTagsRequest")
+	proto.RegisterType((*InstanceMetadata)(nil), "cipd.InstanceMetadata")
+	proto.RegisterType((*AttachMetadataRequest)(nil), "cipd.AttachMetadataRequest")
+	proto.RegisterType((*DetachMetadataRequest)(nil), "cipd.DetachMetadataRequest")
+	proto.RegisterType((*ListMetadataRequest)(nil), "cipd.ListMetadataRequest")
+	proto.RegisterType((*ListMetadataResponse)(nil), "cipd.ListMetadataResponse")
 	proto.RegisterType((*ResolveVersionRequest)(nil), "cipd.ResolveVersionRequest")
 	proto.RegisterType((*GetInstanceURLRequest)(nil), "cipd.GetInstanceURLRequest")
 	proto.RegisterType((*Processor)(nil), "cipd.Processor")
@@ -1865,6 +2150,8 @@ func init() {
 	proto.RegisterType((*DescribeInstanceResponse)(nil), "cipd.DescribeInstanceResponse")
 	proto.RegisterType((*DescribeClientRequest)(nil), "cipd.DescribeClientRequest")
 	proto.RegisterType((*DescribeClientResponse)(nil), "cipd.DescribeClientResponse")
+	proto.RegisterType((*ListEventsRequest)(nil), "cipd.ListEventsRequest")
+	proto.RegisterType((*ListEventsResponse)(nil), "cipd.ListEventsResponse")
 }
 
 func init() {
@@ -1872,116 +2159,125 @@ func init() {
 }
 
 var fileDescriptor_70efefd016927455 = []byte{
-	// 1744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0x0e, 0x7f, 0x24, 0x8b, 0x45, 0x91, 0xa2, 0xda, 0xfa, 0x21, 0x68, 0xcb, 0x12, 0xc6, 0x48,
-	0x22, 0xd8, 0x0b, 0x72, 0xad, 0xe4, 0x12, 0x18, 0xb6, 0x43, 0x89, 0x63, 0x99, 0x58, 0x46, 0x52,
-	0x9a, 0x64, 0x16, 0xc9, 0x21, 0x44, 0x6b, 0xd8, 0xa4, 0x26, 0x26, 0x67, 0x26, 0xd3, 0x4d, 0x61,
-	0xb9, 0x2f, 0x90, 0x43, 0x90, 0x5b, 0xae, 0x79, 0x82, 0xe4, 0x1a, 0xe4, 0x96, 0x6b, 0x1e, 0x22,
-	0x2f, 0x13, 0xf4, 0xdf, 0x90, 0x1c, 0x0e, 0x25, 0x79, 0xd7, 0xc1, 0xde, 0x66, 0xbe, 0xfe, 0xaa,
-	0xab, 0xaa, 0xbb, 0xaa, 0xba, 0x0a, 0xbe, 0x1c, 0xfa, 0x55, 0xe7, 0x26, 0xf4, 0xc7, 0xee, 0x64,
-	0x5c, 0xf5, 0xc3, 0x61, 0x6d, 0x34, 0x71, 0xdc, 0x9a, 0xe3, 0x06, 0xfd, 0x1a, 0x09, 0xf4, 0xc7,
-	0xed, 0xab, 0x5a, 0x48, 0x03, 0xbf, 0x1a, 0x84, 0x3e, 0xf7, 0x51, 0x56, 0x60, 0x95, 0x27, 0x43,
-	0xdf, 0x1f, 0x8e, 0x68, 0x4d, 0x62, 0xd7, 0x93, 0x41, 0x8d, 0x8e, 0x03, 0x3e, 0x55, 0x94, 0xca,
-	0xd3, 0xf8, 0x22, 0xe3, 0xe1, 0xc4, 0xe1, 0x7a, 0xf5, 0x30, 0xbe, 0xca, 0xdd, 0x31, 0x65, 0x9c,
-	0x8c, 0x03, 0x4d, 0xa8, 0x3d, 0xcc, 0x26, 0x87, 0x30, 0x25, 0x60, 0xfd, 0x14, 0x0a, 0x57, 0x21,
-	0x1d, 0xb8, 0xdf, 0x60, 0xfa, 0xc7, 0x09, 0x65, 0x1c, 0xed, 0xc1, 0x7a, 0x20, 0x81, 0x72, 0xea,
-	0x28, 0x75, 0x9c, 0xc3, 0xfa, 0xcf, 0xfa, 0x5b, 0x1a, 0x8a, 0x8a, 0xf9, 0x2b, 0xca, 0x49, 0x9f,
-	0x70, 0xb2, 0x8a, 0x8a, 0x8e, 0x20, 0x3f, 0x70, 0xbd, 0x21, 0x0d, 0x83, 0xd0, 0xf5, 0x78, 0x39,
-	0x2d, 0x17, 0xe7, 0x21, 0xf4, 0x1a, 0xf2, 0x93, 0xa0, 0x4f, 0x38, 0xed, 0x09, 0x07, 0xca, 0x99,
-	0xa3, 0xd4, 0x71, 0xfe, 0xa4, 0x52, 0x55, 0xde, 0x55, 0x8d, 0x77, 0xd5, 0x8e, 0xf1, 0x0e, 0x83,
-	0xa2, 0x0b, 0x00, 0x1d, 0x46, 0xc2, 0x13, 0x46, 0xc3, 0x72, 0x56, 0x6e, 0xaf, 0x09, 0x5d, 0x46,
-	0x43, 0xf4, 0x05, 0x64, 0x89, 0x33, 0x62, 0xe5, 0xb5, 0xa3, 0xcc, 0x71, 0xfe, 0xa4, 0x5c, 0x15,
-	0x5e, 0x57, 0x17, 0x6d, 0xaf, 0xd6, 0xcf, 0x5a, 0x58, 0xb2, 0x2a, 0x36, 0x64, 0xea, 0x67, 0x2d,
-	0xf4, 0x0c, 0xb2, 0xa1, 0x3f, 0xa2, 0xd2, 0x95, 0xe2, 0x09, 0x28, 0x21, 0xec, 0x8f, 0x28, 0x96,
-	0x38, 0x7a, 0x06, 0x20, 0x6c, 0x77, 0xdc, 0x80, 0x8c, 0x58, 0x39, 0x7d, 0x94, 0x11, 0x4a, 0x67,
-	0x88, 0xd5, 0x83, 0xfd, 0xa6, 0x77, 0x43, 0x43, 0x97, 0xd3, 0x7e, 0xec, 0x9c, 0x1a, 0xf0, 0x38,
-	0xa0, 0x61, 0x4f, 0x9d, 0x4e, 0x6f, 0xac, 0xe1, 0x72, 0x4a, 0x9a, 0xb7, 0x93, 0x64, 0x1e, 0xde,
-	0x0e, 0x68, 0xb8, 0x08, 0x59, 0x7f, 0x4a, 0xc1, 0xae, 0xb0, 0x87, 0x35, 0x3d, 0x73, 0x63, 0x2c,
-	0xf0, 0x3d, 0x46, 0xd1, 0x5b, 0x58, 0x13, 0x26, 0x32, 0xbd, 0xe3, 0xf1, 0xcc, 0xf6, 0x25, 0xae,
-	0x44, 0x23, 0x50, 0x89, 0x55, 0xaa, 0xb0, 0x39, 0x0f, 0xdf, 0x77, 0x14, 0x56, 0x00, 0xdb, 0x2d,
-	0x97, 0xf1, 0x07, 0xc5, 0x0d, 0x7a, 0x0a, 0xb9, 0x90, 0x3a, 0x93, 0x90, 0xb9, 0xb7, 0x54, 0x86,
-	0xc2, 0x06, 0x9e, 0x01, 0xe8, 0xc7, 0x50, 0x74, 0x3d, 0x67, 0x34, 0xe9, 0xd3, 0xde, 0x8d, 0xdb,
-	0xef, 0x53, 0x4f, 0xc6, 0xc2, 0x06, 0x2e, 0x68, 0xf4, 0x83, 0x04, 0xad, 0x16, 0xa0, 0x79, 0x8d,
-	0xda, 0xef, 0x0a, 0x6c, 0x04, 0xc4, 0xf9, 0x48, 0x86, 0xda, 0xf5, 0x1c, 0x8e, 0xfe, 0xe5, 0x9a,
-	0x64, 0x53, 0x73, 0x59, 0xd1, 0xbf, 0xf5, 0x02, 0x8a, 0x57, 0x8a, 0x67, 0x8c, 0x2f, 0xc3, 0x23,
-	0x2d, 0xa9, 0xad, 0x37, 0xbf, 0xd6, 0xbf, 0x52, 0xb0, 0xd1, 0xf4, 0x18, 0x27, 0x9e, 0x43, 0x57,
-	0xd3, 0xd0, 0x4b, 0xd8, 0x70, 0x35, 0x4b, 0x3a, 0x99, 0x3f, 0xd9, 0x52, 0xc7, 0x76, 0x79, 0xfd,
-	0x07, 0xea, 0x70, 0x4c, 0x07, 0x38, 0x22, 0xa0, 0xe7, 0x50, 0x08, 0xe9, 0xd0, 0x65, 0x9c, 0x86,
-	0xb4, 0xdf, 0xbb, 0x9e, 0x4a, 0x9f, 0x73, 0x78, 0x73, 0x06, 0x9e, 0x4e, 0xd1, 0xbb, 0x05, 0x12,
-	0x67, 0x32, 0xce, 0xef, 0x4e, 0x92, 0xb9, 0x0d, 0x3a, 0xcc, 0xfa, 0x47, 0x0a, 0xca, 0x58, 0x03,
-	0xc6, 0x83, 0xe8, 0xe8, 0xbe, 0x84, 0x75, 0xc6, 0x09, 0x9f, 0x30, 0x7d, 0xc9, 0x3a, 0x49, 0x14,
-	0x3f, 0x24, 0xdc, 0xf5, 0xbd, 0xb6, 0x5c, 0xc7, 0x9a, 0x87, 0x5e, 0x2c, 0x79, 0x58, 0x54, 0x32,
-	0xd1, 0xde, 0x33, 0x07, 0x4f, 0x20, 0x37, 0x09, 0x46, 0x3e, 0xe9, 0xf7, 0xfc, 0x40, 0x27, 0xf7,
-	0xae, 0x22, 0x77, 0x25, 0x7c, 0x19, 0x50, 0xa5, 0x03, 0x6f, 0x4c, 0x34, 0x60, 0x8d, 0x60, 0x47,
-	0x5c, 0xb1, 0xd9, 0x8d, 0xdd, 0x7b, 0x35, 0xe8, 0x09, 0xe4, 0x02, 0x32, 0xa4, 0x3d, 0xe6, 0x7e,
-	0x4b, 0xcb, 0x3b, 0x47, 0xa9, 0xe3, 0x35, 0x71, 0xff, 0x43, 0xda, 0x76, 0xbf, 0xa5, 0xe8, 0x00,
-	0x40, 0x2e, 0x72, 0xff, 0x23, 0xf5, 0xca, 0xbb, 0x52, 0x52, 0xd2, 0x3b, 0x02, 0xb0, 0xc6, 0xb0,
-	0x1b, 0xd3, 0xa6, 0x0f, 0xe6, 0x0b, 0xc8, 0x19, 0x37, 0x4c, 0x3e, 0xc5, 0xfd, 0x9c, 0x11, 0xd0,
-	0x4f, 0x60, 0xcb, 0xa3, 0xdf, 0xf0, 0xde, 0x9c, 0xaa, 0x1d, 0xa9, 0xaa, 0x20, 0xe0, 0xab, 0x48,
-	0xdd, 0x5f, 0x52, 0xb0, 0xd7, 0xa6, 0x24, 0x74, 0x6e, 0x3e, 0xc1, 0xbf, 0x03, 0xc8, 0x72, 0x32,
-	0x54, 0xe1, 0x9b, 0x3f, 0xc9, 0x29, 0x2b, 0x3a, 0x64, 0x88, 0x25, 0xfc, 0xbd, 0xdc, 0xf7, 0x61,
-	0x7f, 0xc9, 0x9c, 0xff, 0xeb, 0x01, 0xfc, 0x3b, 0x05, 0x19, 0x4c, 0x07, 0x08, 0x41, 0xd6, 0x23,
-	0x63, 0xe3, 0xaa, 0xfc, 0x9e, 0x3f, 0x81, 0xf4, 0xea, 0xac, 0xca, 0xdc, 0x97, 0x55, 0x87, 0x90,
-	0x1f, 0xfb, 0x7d, 0x77, 0xe0, 0xaa, 0x9c, 0xd2, 0xcf, 0x82, 0x81, 0x4e, 0xa7, 0xe2, 0xd1, 0x89,
-	0x08, 0x5c, 0xbc, 0x0e, 0xf7, 0x3e, 0x3a, 0x86, 0xde, 0x61, 0xd6, 0x2f, 0xa1, 0xd4, 0xa0, 0x23,
-	0xca, 0xa9, 0x50, 0xaa, 0xaf, 0xee, 0x93, 0x9c, 0xb1, 0x5e, 0xc2, 0x96, 0x08, 0x39, 0x4c, 0x07,
-	0xf7, 0xdf, 0xbd, 0xf5, 0x0a, 0x4a, 0x33, 0xb2, 0xbe, 0x99, 0x03, 0xc8, 0x86, 0x74, 0x60, 0x2e,
-	0x25, 0x67, 0x32, 0x76, 0x80, 0x25, 0x6c, 0xfd, 0x39, 0x05, 0x99, 0x0e, 0x19, 0xa2, 0x12, 0x64,
-	0x3e, 0xd2, 0xa9, 0xde, 0x50, 0x7c, 0xa2, 0x1d, 0x58, 0xbb, 0x25, 0xa3, 0x89, 0xb1, 0x48, 0xfd,
-	0x88, 0xf3, 0x22, 0x9c, 0x13, 0xe7, 0x66, 0xbe, 0x06, 0x81, 0x81, 0xd4, 0x79, 0x45, 0x84, 0x07,
-	0xd5, 0x9f, 0x48, 0xb8, 0xc3, 0xac, 0x29, 0x6c, 0xd7, 0xe5, 0x5f, 0x87, 0x0c, 0x1f, 0x10, 0xeb,
-	0x9f, 0x54, 0x3f, 0x4d, 0x62, 0x64, 0x12, 0x13, 0x43, 0xa8, 0x6e, 0xd0, 0x1f, 0x46, 0xf5, 0x57,
-	0xb0, 0x8b, 0x29, 0xf3, 0x47, 0xb7, 0xf4, 0x37, 0x34, 0x64, 0xa2, 0xc0, 0xdd, 0xab, 0xbe, 0x0c,
-	0x8f, 0x6e, 0x15, 0xd7, 0x04, 0x8c, 0xfe, 0xb5, 0x7e, 0x0f, 0xbb, 0xe7, 0x34, 0x2a, 0x51, 0x5d,
-	0xdc, 0xfa, 0xbc, 0xbe, 0x58, 0x7f, 0x4d, 0x43, 0xee, 0x2a, 0xf4, 0x1d, 0xca, 0x98, 0x1f, 0xa2,
-	0x22, 0xa4, 0xdd, 0xbe, 0xde, 0x2f, 0xed, 0xf6, 0xd1, 0x4b, 0x58, 0x13, 0x95, 0x5f, 0xed, 0x53,
-	0x34, 0xf5, 0x3b, 0xe2, 0x57, 0xc5, 0xf3, 0x40, 0xb1, 0xe2, 0x88, 0x50, 0x19, 0xb8, 0x9e, 0xcb,
-	0x74, 0xa8, 0x3c, 0xa0, 0x9f, 0x33, 0xf4, 0x0e, 0x43, 0x35, 0x58, 0x0f, 0x29, 0x9b, 0x8c, 0xb8,
-	0x0e, 0xb1, 0xfd, 0x25, 0xb9, 0xb6, 0xec, 0x81, 0xb1, 0xa6, 0x89, 0x78, 0xa6, 0x61, 0xe8, 0x87,
-	0x32, 0x85, 0x73, 0x58, 0xfd, 0x58, 0xef, 0x61, 0x4d, 0xda, 0x84, 0x76, 0x61, 0xbb, 0xdd, 0xa9,
-	0x77, 0xec, 0x5e, 0xf7, 0xa2, 0x7d, 0x65, 0x9f, 0x35, 0xdf, 0x37, 0xed, 0x46, 0xe9, 0x47, 0x28,
-	0x0f, 0x8f, 0xae, 0xec, 0x8b, 0x46, 0xf3, 0xe2, 0xbc, 0x94, 0x42, 0x05, 0xc8, 0xb5, 0xbb, 0x67,
-	0x67, 0xb6, 0xdd, 0xb0, 0x1b, 0xa5, 0x34, 0x02, 0x58, 0x7f, 0x5f, 0x6f, 0xb6, 0xec, 0x46, 0x29,
-	0x63, 0xfd, 0x37, 0x05, 0xfb, 0x0d, 0xca, 0x9c, 0xd0, 0xbd, 0xa6, 0xb3, 0x77, 0xf3, 0xb3, 0x46,
-	0xd1, 0x73, 0x28, 0xf4, 0xb5, 0x86, 0x9e, 0x4c, 0x69, 0xd5, 0xf4, 0x6c, 0x1a, 0x50, 0xa4, 0xfd,
-	0x02, 0x49, 0xc6, 0x5c, 0x76, 0x91, 0x24, 0xa2, 0x1b, 0xd5, 0xe0, 0x71, 0x44, 0x0a, 0xcc, 0xdd,
-	0xa8, 0xda, 0xb6, 0x81, 0x91, 0x59, 0x8a, 0x6e, 0x8d, 0x59, 0xff, 0x4c, 0x41, 0x79, 0xd9, 0x3b,
-	0x5d, 0x61, 0xe6, 0xdf, 0xf8, 0xd4, 0x3d, 0x6f, 0xbc, 0xa9, 0x46, 0xe9, 0xc4, 0x6a, 0x74, 0x4f,
-	0xa2, 0xa0, 0x9a, 0xe8, 0xa6, 0x23, 0x73, 0xb3, 0x92, 0xb4, 0x15, 0x0b, 0x31, 0x3c, 0x47, 0x11,
-	0xc9, 0x60, 0xcc, 0x3e, 0x1b, 0xb9, 0xd4, 0xe3, 0x9f, 0x39, 0x19, 0xfe, 0x9e, 0x86, 0xbd, 0xb8,
-	0x82, 0xef, 0x70, 0x2a, 0x55, 0x00, 0x47, 0x4a, 0x8b, 0x7b, 0x5d, 0xa5, 0x35, 0xe7, 0x68, 0x05,
-	0x03, 0xf4, 0x73, 0x28, 0x68, 0xfe, 0xb5, 0xeb, 0x91, 0x70, 0x9a, 0xf4, 0xcc, 0x89, 0xcc, 0xdf,
-	0x54, 0xac, 0x53, 0x49, 0x12, 0xa5, 0x5b, 0x4b, 0xc9, 0xc7, 0x5f, 0x04, 0x46, 0x06, 0x6b, 0xc5,
-	0xf2, 0xf9, 0x3f, 0x84, 0xfc, 0x88, 0x0e, 0x89, 0x33, 0xed, 0xb1, 0x1b, 0xf2, 0x4a, 0xe7, 0x09,
-	0x28, 0xa8, 0x7d, 0x43, 0x5e, 0xa1, 0x37, 0x80, 0x66, 0x76, 0xf6, 0xc8, 0xc8, 0x25, 0x8c, 0xb2,
-	0xf2, 0xfa, 0xfc, 0x3d, 0xcc, 0xec, 0x2d, 0x45, 0xf6, 0xd6, 0x15, 0xf1, 0xc5, 0x3b, 0xc8, 0x8a,
-	0x79, 0x00, 0xed, 0x40, 0x09, 0x5f, 0xb6, 0xe2, 0x99, 0x06, 0xb0, 0x8e, 0xed, 0x7a, 0xc3, 0xc6,
-	0xa5, 0x94, 0xf8, 0xfe, 0x1a, 0x37, 0x3b, 0x36, 0x2e, 0xa5, 0x51, 0x0e, 0xd6, 0x2e, 0xbf, 0xbe,
-	0xb0, 0x71, 0x29, 0xf3, 0x82, 0x01, 0x5a, 0xee, 0x35, 0xd1, 0x73, 0x38, 0xc4, 0xf6, 0x79, 0xb3,
-	0xdd, 0xc1, 0xf5, 0x4e, 0xf3, 0xf2, 0xa2, 0x27, 0xd2, 0xb8, 0xdb, 0x8e, 0xed, 0x5e, 0x04, 0x50,
-	0x24, 0x1b, 0xdb, 0x8d, 0x52, 0x0a, 0xed, 0x01, 0xaa, 0xb7, 0x84, 0xbe, 0xdf, 0xf6, 0xe6, 0xf0,
-	0x34, 0x2a, 0xc1, 0xe6, 0xc5, 0x65, 0xa7, 0xd7, 0xbd, 0x6a, 0x5d, 0xd6, 0x45, 0x96, 0x67, 0x4e,
-	0xfe, 0x03, 0x00, 0x98, 0x06, 0x3e, 0x73, 0xb9, 0x1f, 0x4e, 0xd1, 0x5b, 0xd8, 0x3e, 0xa7, 0x3c,
-	0x36, 0xab, 0x3d, 0x9e, 0x1f, 0xc7, 0x74, 0x8c, 0x55, 0x12, 0x67, 0x34, 0x74, 0x05, 0x15, 0x59,
-	0x9f, 0x93, 0x87, 0xbe, 0xc4, 0x8d, 0x0e, 0x4c, 0xe0, 0x24, 0xcb, 0x9c, 0xc2, 0x4e, 0x57, 0x8e,
-	0xb1, 0x31, 0x3c, 0x51, 0xff, 0x0a, 0xab, 0x1a, 0x50, 0x3a, 0xa7, 0x7c, 0x61, 0xf8, 0x4b, 0xb6,
-	0xe5, 0xc9, 0x1d, 0x63, 0x22, 0x7a, 0x07, 0x30, 0x1b, 0xb8, 0xd0, 0xbe, 0xa2, 0x2e, 0x0d, 0x7d,
-	0x95, 0xf2, 0xf2, 0x82, 0xde, 0xe0, 0x35, 0xe4, 0x3f, 0xb8, 0x7d, 0xaa, 0xe7, 0xac, 0xc8, 0x83,
-	0x85, 0xb1, 0xab, 0xb2, 0xb7, 0x54, 0xe9, 0xed, 0x71, 0xc0, 0xa7, 0xe8, 0x0d, 0x14, 0xba, 0xde,
-	0xcd, 0xf7, 0x11, 0x57, 0xbd, 0xda, 0x77, 0x13, 0x3f, 0x85, 0x52, 0x7c, 0x6e, 0x42, 0xb1, 0x8c,
-	0xaf, 0x3c, 0x9b, 0x9f, 0x97, 0x12, 0xe6, 0xab, 0x0f, 0x50, 0x58, 0x98, 0x2f, 0x50, 0x65, 0x76,
-	0x52, 0xf1, 0x11, 0xc0, 0xdc, 0x44, 0xf2, 0x40, 0x72, 0x01, 0x5b, 0xb1, 0x56, 0x1d, 0x3d, 0x55,
-	0xfc, 0xe4, 0x81, 0xc2, 0xc4, 0xd8, 0xaa, 0xfe, 0xbe, 0x0a, 0xb9, 0xb3, 0x90, 0x12, 0xd9, 0xc8,
-	0xa2, 0x59, 0xd9, 0x5e, 0x79, 0x1a, 0xaf, 0x21, 0x17, 0x35, 0xbe, 0x68, 0x4f, 0xf1, 0xe3, 0x9d,
-	0xf0, 0x4a, 0xe1, 0x5f, 0xc0, 0x86, 0x69, 0x63, 0xd1, 0xee, 0xcc, 0xcb, 0xb9, 0x1e, 0xb8, 0xb2,
-	0x17, 0x87, 0xb5, 0x9d, 0x6f, 0x00, 0x66, 0x0d, 0xa4, 0x89, 0xc0, 0xa5, 0x96, 0xf2, 0x8e, 0x18,
-	0x80, 0x59, 0x13, 0x68, 0xc4, 0x97, 0xda, 0xc2, 0x3b, 0xc4, 0x8b, 0x8b, 0x8d, 0x1c, 0x32, 0xe9,
-	0x92, 0xd4, 0xde, 0x55, 0x62, 0xe1, 0x81, 0xde, 0x42, 0x71, 0xb1, 0x75, 0x33, 0xe2, 0x89, 0x0d,
-	0x5d, 0x25, 0x5e, 0xee, 0xd1, 0xaf, 0xc5, 0xb4, 0xb1, 0xf8, 0x48, 0xa3, 0x03, 0xe3, 0x43, 0x62,
-	0x6b, 0x62, 0x22, 0x72, 0xe5, 0xdb, 0xfe, 0x15, 0x14, 0x17, 0xdf, 0x37, 0x63, 0x52, 0xe2, 0xb3,
-	0x5a, 0x79, 0x9a, 0xbc, 0xa8, 0x36, 0x3b, 0x5d, 0xfb, 0x5d, 0x86, 0x04, 0xee, 0xf5, 0xba, 0x3c,
-	0xb5, 0x9f, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x82, 0x63, 0xe5, 0x0a, 0x15, 0x00, 0x00,
+	// 1875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0xdb, 0xd8,
+	0x11, 0x2e, 0xf5, 0xe3, 0x58, 0xa3, 0x9f, 0xc8, 0x27, 0x96, 0xad, 0xd2, 0x71, 0xe2, 0x32, 0xd8,
+	0xd6, 0x48, 0x0a, 0x69, 0xa3, 0xf6, 0xa6, 0x08, 0x92, 0xad, 0x6d, 0x29, 0x8e, 0x1a, 0xd7, 0x56,
+	0x29, 0xb9, 0x40, 0x7b, 0xb1, 0x02, 0x4d, 0x8d, 0x64, 0xd6, 0x12, 0xc9, 0x92, 0x47, 0xc6, 0x6a,
+	0x5f, 0xa0, 0x05, 0x8a, 0xf6, 0xa2, 0xe8, 0x5b, 0xb4, 0xf7, 0xbd, 0xeb, 0x9b, 0xec, 0x03, 0xf4,
+	0x2d, 0x0a, 0x9e, 0x1f, 0x8a, 0xa2, 0xe8, 0x48, 0xde, 0x64, 0xd1, 0xbd, 0x23, 0x67, 0xbe, 0x99,
+	0x33, 0x3f, 0x67, 0xce, 0xcc, 0x39, 0xf0, 0xf9, 0xc8, 0xa9, 0x99, 0xd7, 0x9e, 0x33, 0xb1, 0xa6,
+	0x93, 0x9a, 0xe3, 0x8d, 0xea, 0xe3, 0xa9, 0x69, 0xd5, 0x4d, 0xcb, 0x1d, 0xd4, 0x0d, 0x57, 0x7c,
+	0xdc, 0xbe, 0xac, 0x7b, 0xe8, 0x3a, 0x35, 0xd7, 0x73, 0xa8, 0x43, 0x32, 0x01, 0x4d, 0xdd, 0x1b,
+	0x39, 0xce, 0x68, 0x8c, 0x75, 0x46, 0xbb, 0x9a, 0x0e, 0xeb, 0x38, 0x71, 0xe9, 0x8c, 0x43, 0xd4,
+	0xc7, 0x71, 0xa6, 0x4f, 0xbd, 0xa9, 0x49, 0x05, 0xf7, 0x69, 0x9c, 0x4b, 0xad, 0x09, 0xfa, 0xd4,
+	0x98, 0xb8, 0x02, 0x50, 0x5f, 0xcf, 0x26, 0xd3, 0xf0, 0x85, 0x40, 0x63, 0x3d, 0x01, 0xbc, 0x45,
+	0x9b, 0x4a, 0x99, 0x57, 0xeb, 0xc9, 0xb8, 0x1e, 0x0e, 0xad, 0xaf, 0xfa, 0x13, 0xa4, 0xc6, 0xc0,
+	0xa0, 0x06, 0x17, 0xd6, 0x7e, 0x02, 0xc5, 0x0e, 0x63, 0xe8, 0xf8, 0xc7, 0x29, 0xfa, 0x94, 0xec,
+	0xc0, 0x06, 0x47, 0x56, 0x95, 0x03, 0xe5, 0x30, 0xa7, 0x8b, 0x3f, 0xad, 0x0f, 0xbb, 0x6d, 0xfb,
+	0x1a, 0x3d, 0x8b, 0xe2, 0x80, 0x4b, 0xfc, 0x5a, 0x68, 0x22, 0x4d, 0x78, 0xe4, 0xa2, 0xd7, 0x8f,
+	0x2d, 0x50, 0x55, 0x0e, 0xd2, 0x87, 0xf9, 0xc6, 0x76, 0x2d, 0x30, 0xa0, 0xb6, 0x28, 0xa2, 0x6f,
+	0xb9, 0xe8, 0x2d, 0x92, 0xb4, 0x3f, 0x29, 0x50, 0xd1, 0x9d, 0x31, 0xfa, 0x6d, 0x5b, 0x5a, 0xe4,
+	0xbb, 0x8e, 0xed, 0x23, 0x79, 0x03, 0x59, 0x2f, 0x60, 0x08, 0x8d, 0x87, 0x5c, 0x63, 0x22, 0x96,
+	0x51, 0x43, 0x22, 0x17, 0x53, 0x6b, 0x50, 0x88, 0x92, 0xc9, 0x13, 0xc8, 0x04, 0x0c, 0xe6, 0x60,
+	0xa9, 0x01, 0x73, 0x75, 0x3a, 0xa3, 0x6b, 0x2e, 0x6c, 0x9d, 0x59, 0x3e, 0x5d, 0x2b, 0x2e, 0xe4,
+	0x31, 0xe4, 0x3c, 0x34, 0xa7, 0x9e, 0x6f, 0xdd, 0x62, 0x35, 0x75, 0xa0, 0x1c, 0x6e, 0xea, 0x73,
+	0x02, 0xf9, 0x0c, 0x4a, 0x96, 0x6d, 0x8e, 0xa7, 0x03, 0xec, 0x5f, 0x5b, 0x83, 0x01, 0xda, 0xd5,
+	0x34, 0x83, 0x14, 0x05, 0xf5, 0x1d, 0x23, 0x6a, 0x67, 0x40, 0xa2, 0x2b, 0x0a, 0xbf, 0x55, 0xd8,
+	0x74, 0x0d, 0xf3, 0xc6, 0x18, 0x09, 0xd7, 0x73, 0x7a, 0xf8, 0xcf, 0x78, 0x0c, 0x8d, 0x7e, 0x35,
+	0x25, 0x78, 0xe2, 0x5f, 0x7b, 0x0e, 0xa5, 0x0e, 0xc7, 0x49, 0xe3, 0xab, 0xf0, 0x40, 0x48, 0x0a,
+	0xeb, 0xe5, 0xaf, 0xf6, 0x6f, 0x05, 0x36, 0xdb, 0xb6, 0x4f, 0x0d, 0xdb, 0xc4, 0xbb, 0x61, 0xe4,
+	0x05, 0x6c, 0x5a, 0x02, 0xc5, 0x9c, 0xcc, 0x37, 0x1e, 0xf2, 0xb0, 0x5d, 0x5c, 0xfd, 0x01, 0x4d,
+	0xaa, 0xe3, 0x50, 0x0f, 0x01, 0xe4, 0x19, 0x14, 0x3d, 0x1c, 0x59, 0x3e, 0x45, 0x0f, 0x07, 0xfd,
+	0xab, 0x19, 0xf3, 0x39, 0xa7, 0x17, 0xe6, 0xc4, 0xe3, 0x19, 0xf9, 0x62, 0x01, 0x44, 0xfd, 0x6a,
+	0x86, 0xa9, 0x55, 0x6b, 0xbc, 0xa6, 0x6a, 0xb2, 0xa6, 0x6a, 0x3d, 0x59, 0x53, 0x51, 0x05, 0x3d,
+	0x5f, 0xfb, 0x97, 0x02, 0x55, 0x5d, 0x10, 0xa4, 0x07, 0x61, 0xe8, 0x3e, 0x87, 0x0d, 0x9f, 0x1a,
+	0x74, 0xea, 0x8b, 0x24, 0x57, 0x45, 0x92, 0x19, 0xde, 0x33, 0xa8, 0xe5, 0xd8, 0x5d, 0xc6, 0xd7,
+	0x05, 0x8e, 0x3c, 0x5f, 0xf2, 0xb0, 0xc4, 0x65, 0x42, 0xdd, 0x73, 0x07, 0x1b, 0x90, 0x9b, 0xba,
+	0x63, 0xc7, 0x18, 0xf4, 0x1d, 0x97, 0x39, 0x97, 0x6f, 0x54, 0x38, 0xf8, 0x92, 0x91, 0x2f, 0x5c,
+	0xe4, 0x6b, 0xe8, 0x9b, 0x53, 0x41, 0xd0, 0xc6, 0xb0, 0x1d, 0xa4, 0x58, 0x6a, 0xf3, 0x57, 0xa6,
+	0x86, 0xec, 0x41, 0xce, 0x35, 0x46, 0xd8, 0xf7, 0xad, 0xaf, 0xb1, 0xba, 0x7d, 0xa0, 0x1c, 0x66,
+	0x83, 0xfc, 0x8f, 0xb0, 0x6b, 0x7d, 0x8d, 0x64, 0x1f, 0x80, 0x31, 0xa9, 0x73, 0x83, 0x76, 0xb5,
+	0xc2, 0x24, 0x19, 0xbc, 0x17, 0x10, 0xb4, 0x09, 0x54, 0x62, 0xab, 0x89, 0xc0, 0xfc, 0x14, 0x72,
+	0xd2, 0x0d, 0x59, 0x4f, 0x71, 0x3f, 0xe7, 0x00, 0xf2, 0x63, 0x78, 0x68, 0xe3, 0x57, 0xb4, 0x1f,
+	0x59, 0x6a, 0x9b, 0x2d, 0x55, 0x0c, 0xc8, 0x9d, 0x70, 0xb9, 0xbf, 0x2a, 0xb0, 0xd3, 0x45, 0xc3,
+	0x33, 0xaf, 0xef, 0xe1, 0xdf, 0x3e, 0x64, 0xa8, 0x31, 0xe2, 0xdb, 0x37, 0xdf, 0xc8, 0x71, 0x2b,
+	0x7a, 0xc6, 0x48, 0x67, 0xe4, 0x8f, 0x72, 0xdf, 0x81, 0xdd, 0x25, 0x73, 0xbe, 0xd3, 0x00, 0xfc,
+	0x47, 0x81, 0xb4, 0x8e, 0x43, 0x42, 0x20, 0x63, 0x1b, 0x13, 0xe9, 0x2a, 0xfb, 0x8e, 0x46, 0x20,
+	0x75, 0x77, 0x55, 0xa5, 0x57, 0x55, 0xd5, 0x53, 0xc8, 0x4f, 0x9c, 0x81, 0x35, 0xb4, 0x78, 0x4d,
+	0x65, 0x98, 0x2a, 0x90, 0xa4, 0xe3, 0x19, 0x79, 0x15, 0x01, 0x50, 0xbf, 0x9a, 0x5d, 0x59, 0x4f,
+	0xa1, 0x70, 0xcf, 0xd7, 0x7e, 0x09, 0xe5, 0x26, 0x8e, 0x91, 0x62, 0xb0, 0xa8, 0x48, 0xdd, 0xbd,
+	0x9c, 0xd1, 0x5e, 0xc0, 0xc3, 0x60, 0xcb, 0xe9, 0x38, 0x5c, 0x9d, 0x7b, 0xed, 0x25, 0x94, 0xe7,
+	0x60, 0x91, 0x99, 0x7d, 0xc8, 0x78, 0x38, 0x94, 0x49, 0xc9, 0xc9, 0x8a, 0x1d, 0xea, 0x8c, 0xac,
+	0xfd, 0x45, 0x81, 0x74, 0xcf, 0x18, 0x91, 0x32, 0xa4, 0x6f, 0x70, 0x26, 0x14, 0x06, 0x9f, 0x64,
+	0x1b, 0xb2, 0xb7, 0xc6, 0x78, 0x2a, 0x2d, 0xe2, 0x3f, 0x41, 0xbc, 0x0c, 0x4a, 0x0d, 0xf3, 0x3a,
+	0x7a, 0x06, 0x81, 0x24, 0xf1, 0x78, 0x85, 0x80, 0xb5, 0xce, 0x9f, 0x50, 0xb8, 0xe7, 0x6b, 0x33,
+	0xd8, 0x3a, 0x62, 0x7f, 0x3d, 0x63, 0xb4, 0xc6, 0x5e, 0xbf, 0xd7, 0xf9, 0x29, 0x0b, 0x23, 0x9d,
+	0x58, 0x18, 0xc1, 0xd2, 0x4d, 0xfc, 0xff, 0x2c, 0xfd, 0x8d, 0x02, 0x65, 0x59, 0x26, 0x61, 0xfb,
+	0x5f, 0x91, 0x90, 0x82, 0x4c, 0xc8, 0x8f, 0xa0, 0x60, 0x3a, 0x36, 0x45, 0x9b, 0xf6, 0xe9, 0xcc,
+	0x45, 0x91, 0x91, 0xbc, 0xa0, 0xf5, 0x66, 0x2e, 0x92, 0x03, 0xc8, 0x0f, 0x2d, 0x7b, 0x84, 0x9e,
+	0xeb, 0x59, 0x36, 0x15, 0x7b, 0x3c, 0x4a, 0x8a, 0x67, 0x35, 0xbb, 0x2a, 0xab, 0x1b, 0xf7, 0xca,
+	0xea, 0xdf, 0x15, 0xa8, 0xf0, 0xb4, 0x86, 0x93, 0xca, 0xa7, 0x8d, 0x6f, 0x03, 0x36, 0xc3, 0xf9,
+	0x88, 0xc7, 0x78, 0x67, 0xf1, 0xf0, 0x09, 0xd7, 0x0d, 0x71, 0xcc, 0x28, 0x9e, 0xf0, 0xef, 0x91,
+	0x51, 0x2e, 0x3c, 0x0a, 0x0a, 0xf8, 0x3b, 0xb2, 0x88, 0x40, 0xe6, 0x06, 0x67, 0x7c, 0x1b, 0xe6,
+	0x74, 0xf6, 0xad, 0xfd, 0x0a, 0xb6, 0x17, 0x57, 0x14, 0xc7, 0x46, 0xd4, 0x7a, 0x65, 0x4d, 0xeb,
+	0xdf, 0x43, 0x45, 0x47, 0xdf, 0x19, 0xdf, 0xe2, 0x6f, 0xd1, 0xf3, 0x83, 0x46, 0xbd, 0xd2, 0xfe,
+	0x2a, 0x3c, 0xb8, 0xe5, 0x58, 0x79, 0xf0, 0x89, 0x5f, 0xed, 0x4b, 0xa8, 0x9c, 0x62, 0xd8, 0x6a,
+	0x2f, 0xf5, 0xb3, 0x4f, 0x1b, 0x0c, 0xed, 0x1f, 0x29, 0xc8, 0x75, 0x3c, 0xc7, 0x44, 0xdf, 0x77,
+	0x3c, 0x52, 0x82, 0x94, 0x35, 0x10, 0xfa, 0x52, 0xd6, 0x80, 0xbc, 0x80, 0xac, 0x4f, 0x0d, 0xca,
+	0xf5, 0x94, 0xe4, 0x1c, 0x12, 0xe2, 0x6b, 0xc1, 0x98, 0x83, 0x3a, 0xc7, 0x04, 0xc5, 0x31, 0xb4,
+	0x6c, 0xcb, 0x17, 0xc5, 0x91, 0x5e, 0x5d, 0x1c, 0x12, 0xde, 0xf3, 0x49, 0x1d, 0x36, 0x3c, 0xf4,
+	0xa7, 0x63, 0x2a, 0x8e, 0xca, 0xdd, 0x25, 0xb9, 0x2e, 0xbb, 0x1c, 0xe9, 0x02, 0x16, 0x1c, 0x03,
+	0xe8, 0x79, 0x8e, 0x27, 0xaa, 0x94, 0xff, 0x68, 0x6f, 0x21, 0xcb, 0x6c, 0x22, 0x15, 0xd8, 0xea,
+	0xf6, 0x8e, 0x7a, 0xad, 0xfe, 0xe5, 0x79, 0xb7, 0xd3, 0x3a, 0x69, 0xbf, 0x6d, 0xb7, 0x9a, 0xe5,
+	0x1f, 0x90, 0x3c, 0x3c, 0xe8, 0xb4, 0xce, 0x9b, 0xed, 0xf3, 0xd3, 0xb2, 0x42, 0x8a, 0x90, 0xeb,
+	0x5e, 0x9e, 0x9c, 0xb4, 0x5a, 0xcd, 0x56, 0xb3, 0x9c, 0x22, 0x00, 0x1b, 0x6f, 0x8f, 0xda, 0x67,
+	0xad, 0x66, 0x39, 0xad, 0xfd, 0x39, 0x05, 0xbb, 0x4d, 0xf4, 0x4d, 0xcf, 0xba, 0xc2, 0xf9, 0xfc,
+	0xf7, 0x49, 0xb7, 0xe1, 0x33, 0x28, 0x0e, 0xc4, 0x0a, 0x7d, 0xd6, 0x9a, 0xf8, 0xf0, 0x5e, 0x90,
+	0xc4, 0xa0, 0x7d, 0x2d, 0x80, 0xd8, 0xd9, 0x99, 0x59, 0x04, 0x05, 0xa7, 0x34, 0xa9, 0xc3, 0xa3,
+	0x10, 0xe4, 0xca, 0xdc, 0xf0, 0x1e, 0xbd, 0xa9, 0x13, 0xc9, 0x0a, 0xb3, 0xe6, 0x93, 0x17, 0xb0,
+	0x15, 0x0a, 0x84, 0xdb, 0x7b, 0x83, 0xc1, 0xcb, 0x92, 0x11, 0x5e, 0x9d, 0xfe, 0xab, 0x40, 0x75,
+	0x39, 0x14, 0xa2, 0x3e, 0xa2, 0x83, 0xad, 0xb2, 0x62, 0xb0, 0x95, 0x2d, 0x38, 0x95, 0xd8, 0x82,
+	0x57, 0x74, 0x07, 0x52, 0x07, 0x88, 0xf8, 0x96, 0x39, 0x48, 0xcf, 0xa3, 0x1b, 0x7a, 0xa6, 0x47,
+	0x20, 0x0b, 0xa5, 0x9b, 0x5d, 0xb3, 0x74, 0xbf, 0x84, 0x8a, 0x74, 0xf5, 0x64, 0x6c, 0xa1, 0x4d,
+	0x3f, 0x71, 0xb5, 0xfd, 0x33, 0x05, 0x3b, 0xf1, 0x05, 0xbe, 0x45, 0x24, 0x6b, 0x00, 0x26, 0x93,
+	0x0e, 0x36, 0xce, 0x5d, 0xab, 0xe6, 0x4c, 0xb1, 0xc0, 0x90, 0xfc, 0x1c, 0x8a, 0x02, 0x7f, 0x65,
+	0xd9, 0x86, 0x37, 0x4b, 0x9a, 0x07, 0x83, 0xa3, 0xa5, 0xc0, 0x51, 0xc7, 0x0c, 0x14, 0x74, 0x43,
+	0x21, 0xc5, 0xa6, 0xe4, 0x60, 0xe7, 0xa5, 0x75, 0xb1, 0x30, 0x9b, 0x93, 0x9f, 0x42, 0x7e, 0x8c,
+	0x23, 0xc3, 0x9c, 0xf5, 0xfd, 0x6b, 0xe3, 0xa5, 0x6c, 0x97, 0x9c, 0xd4, 0xbd, 0x36, 0x5e, 0x92,
+	0xd7, 0x40, 0xe6, 0x76, 0xf6, 0x8d, 0xb1, 0x65, 0xf8, 0x18, 0x74, 0xcd, 0x74, 0x92, 0xbd, 0xe5,
+	0xd0, 0xde, 0x23, 0x0e, 0xd4, 0xfe, 0xa6, 0xf0, 0xbb, 0x72, 0x8b, 0x3d, 0x48, 0xac, 0xba, 0x2b,
+	0x7f, 0x06, 0xd9, 0x1b, 0xcb, 0x1e, 0xf0, 0xfd, 0x55, 0x92, 0xfa, 0x99, 0xec, 0x7b, 0xcb, 0x1e,
+	0xe8, 0x9c, 0xfb, 0x51, 0x93, 0xbf, 0xc1, 0x6f, 0xd2, 0xd2, 0x1e, 0x91, 0xb9, 0x67, 0xb0, 0xc1,
+	0x9f, 0x4c, 0x44, 0x87, 0xc8, 0x47, 0x56, 0xd6, 0x05, 0x6b, 0xdd, 0x59, 0xff, 0xb9, 0x0f, 0x64,
+	0xf9, 0x1e, 0x49, 0x9e, 0xc1, 0x53, 0xbd, 0x75, 0xda, 0xee, 0xf6, 0xf4, 0xa3, 0x5e, 0xfb, 0xe2,
+	0xbc, 0x1f, 0x1c, 0x6d, 0x97, 0xdd, 0xd8, 0xd9, 0x56, 0x02, 0xe0, 0xa0, 0x96, 0xde, 0x6a, 0x96,
+	0x15, 0xb2, 0x03, 0xe4, 0xe8, 0x4c, 0x6f, 0x1d, 0x35, 0x7f, 0xd7, 0x8f, 0xd0, 0x53, 0xa4, 0x0c,
+	0x85, 0xf3, 0x8b, 0x5e, 0xff, 0xb2, 0x73, 0x76, 0x71, 0x14, 0x9c, 0x7c, 0xe9, 0xc6, 0x37, 0x05,
+	0x00, 0x1d, 0x5d, 0xc7, 0xb7, 0xa8, 0xe3, 0xcd, 0xc8, 0x1b, 0xd8, 0x3a, 0x45, 0x1a, 0x7b, 0x87,
+	0x79, 0x14, 0x7d, 0x6a, 0x11, 0xb9, 0x50, 0x13, 0xdf, 0x5f, 0x48, 0x07, 0x54, 0xd6, 0xb3, 0x92,
+	0x1f, 0x74, 0x12, 0x15, 0xed, 0xcb, 0xbd, 0x9e, 0x2c, 0x73, 0x0c, 0xdb, 0x97, 0xee, 0xc0, 0xa0,
+	0x18, 0xa3, 0x27, 0xae, 0x7f, 0x87, 0x55, 0x4d, 0x28, 0x9f, 0x22, 0x5d, 0x78, 0xd8, 0x49, 0xb6,
+	0x65, 0xef, 0x03, 0x4f, 0x40, 0xe4, 0x0b, 0x80, 0xf9, 0x63, 0x0a, 0xd9, 0xe5, 0xd0, 0xa5, 0x07,
+	0x1d, 0xb5, 0xba, 0xcc, 0x10, 0x0a, 0x5e, 0x41, 0xfe, 0x9d, 0x35, 0x40, 0xf1, 0x86, 0x12, 0x7a,
+	0xb0, 0xf0, 0xa4, 0xa2, 0xee, 0x2c, 0x75, 0xbf, 0x56, 0xf0, 0x6e, 0x48, 0x5e, 0x43, 0xf1, 0xd2,
+	0xbe, 0xfe, 0x18, 0x71, 0x7e, 0x0f, 0xfb, 0x76, 0xe2, 0xc7, 0x50, 0x8e, 0xbf, 0x89, 0x90, 0xd8,
+	0x21, 0xa5, 0x3e, 0x89, 0xbe, 0x85, 0x24, 0xbc, 0x9d, 0xbc, 0x83, 0xe2, 0xc2, 0xdb, 0x01, 0x51,
+	0xe7, 0x91, 0x8a, 0x5f, 0xef, 0xd5, 0xbd, 0x44, 0x9e, 0xd0, 0x74, 0x0e, 0x0f, 0x63, 0xd7, 0x70,
+	0xf2, 0x98, 0xe3, 0x93, 0x1f, 0x0b, 0xd4, 0xfd, 0x3b, 0xb8, 0x42, 0x5f, 0x0d, 0x72, 0x27, 0x1e,
+	0x1a, 0xec, 0x92, 0x4a, 0xe6, 0xdd, 0xe9, 0xce, 0x68, 0xbc, 0x82, 0x5c, 0x78, 0xa9, 0x25, 0xa2,
+	0xb5, 0xc4, 0x6f, 0xb9, 0x77, 0x0a, 0xff, 0x02, 0x36, 0xe5, 0x15, 0x95, 0x54, 0xe6, 0x5e, 0x46,
+	0xee, 0xb7, 0xea, 0x4e, 0x9c, 0x2c, 0xec, 0x7c, 0x0d, 0x30, 0xbf, 0x1c, 0xca, 0x1d, 0xb8, 0x74,
+	0x5d, 0xfc, 0xc0, 0x1e, 0x80, 0x26, 0xc6, 0xc5, 0x9b, 0xb8, 0xae, 0x78, 0x0b, 0x4a, 0x8b, 0x77,
+	0x18, 0xb2, 0x17, 0xb5, 0x20, 0x36, 0xb2, 0x7f, 0x48, 0x4d, 0x13, 0x93, 0xd4, 0x34, 0xf1, 0x7e,
+	0x6a, 0x0a, 0xd1, 0xb1, 0x9d, 0xfc, 0x70, 0x1e, 0xb3, 0xb8, 0x0a, 0x35, 0x89, 0x15, 0x86, 0xb4,
+	0xb4, 0x38, 0xb1, 0x4b, 0x6b, 0x12, 0xe7, 0x78, 0x35, 0xb6, 0xe7, 0xc9, 0x1b, 0x28, 0x2d, 0xce,
+	0xe8, 0x52, 0x3c, 0x71, 0x72, 0x57, 0xe3, 0x6d, 0x97, 0xfc, 0x06, 0xca, 0x72, 0x28, 0x08, 0x75,
+	0xee, 0xcb, 0x70, 0x24, 0xce, 0xa0, 0xea, 0x93, 0xbb, 0xd8, 0xc2, 0xa3, 0xf7, 0x50, 0x92, 0x3c,
+	0x3e, 0x67, 0xcc, 0xe3, 0x9b, 0x30, 0xde, 0xa8, 0x8f, 0x93, 0x99, 0x8b, 0x67, 0x1e, 0x6f, 0x7b,
+	0xd1, 0x33, 0x6f, 0xa1, 0x31, 0xab, 0xd5, 0x65, 0x06, 0x57, 0x70, 0x9c, 0xfd, 0x7d, 0xda, 0x70,
+	0xad, 0x4e, 0xf6, 0x6a, 0x83, 0xe5, 0xef, 0x67, 0xff, 0x1b, 0x00, 0xc8, 0x03, 0xb5, 0xae, 0x4f,
+	0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2217,6 +2513,49 @@ type RepositoryClient interface {
 	//   INVALID_ARGUMENT if the request is malformed.
 	//   NOT_FOUND if the package or the instance doesn't exist.
 	DetachTags(ctx context.Context, in *DetachTagsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Attaches one or more metadata entries to an instance.
+	//
+	// Silently skips already attached entries (entries with the same key and
+	// value, as identified by their fingerprints).
+	//
+	// Metadata entries are "key => binary blob" pairs associated with a concrete
+	// package instance. Unlike tags, they are not used for version resolution
+	// and can hold relatively large values (up to 512 KB each), e.g. build
+	// provenance information, a changelog or a license manifest.
+	//
+	// Keys don't have to be unique: same key may be used by multiple entries
+	// (with different values) attached to the same instance.
+	//
+	// Similarly to tags, metadata can be attached only to instances that
+	// successfully passed all post-registration processing.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a WRITER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	//   FAILED_PRECONDITION if the instance is still being processed.
+	//   ABORTED if the instance has some failed processors associated with it,
+	//       such instance is effectively broken and should not be used.
+	AttachMetadata(ctx context.Context, in *AttachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Detaches one or more metadata entries if they were attached.
+	//
+	// Entries are identified by their fingerprints (if set) or by key and value
+	// otherwise.
+	//
+	// Just like DetachTags, it is allowed only by OWNERS of a prefix.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not an OWNER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	DetachMetadata(ctx context.Context, in *DetachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Lists metadata entries attached to an instance.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a READER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error)
 	// Takes a version string and resolves it into a concrete package instance.
 	//
 	// A version string can be any of:
@@ -2262,6 +2601,23 @@ type RepositoryClient interface {
 	//   ABORTED if the instance has some failed processors associated with it,
 	//       such instance is effectively broken and should not be used.
 	DescribeClient(ctx context.Context, in *DescribeClientRequest, opts ...grpc.CallOption) (*DescribeClientResponse, error)
+	// Lists events related to packages under a prefix, most recent first.
+	//
+	// Events are emitted when packages are created or deleted, instances are
+	// registered, refs are moved, tags are attached, prefix ACLs are modified,
+	// etc. The listing can optionally be filtered by an event kind.
+	//
+	// Callers must have roles READER (or above) for the prefix. Returns
+	// PERMISSION_DENIED otherwise. PREFIX_ACL_CHANGED events are returned only to
+	// OWNERS of the prefix (and silently skipped for everyone else).
+	//
+	// Pages can be shorter than requested, or even empty, before the listing is
+	// over. Keep fetching until next_page_token is empty.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a reader.
+	//   INVALID_ARGUMENT if the request is malformed.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 }
 type repositoryPRPCClient struct {
 	client *prpc.Client
@@ -2415,6 +2771,33 @@ func (c *repositoryPRPCClient) DetachTags(ctx context.Context, in *DetachTagsReq
 	return out, nil
 }
 
+func (c *repositoryPRPCClient) AttachMetadata(ctx context.Context, in *AttachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.client.Call(ctx, "cipd.Repository", "AttachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryPRPCClient) DetachMetadata(ctx context.Context, in *DetachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.client.Call(ctx, "cipd.Repository", "DetachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryPRPCClient) ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.client.Call(ctx, "cipd.Repository", "ListMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryPRPCClient) ResolveVersion(ctx context.Context, in *ResolveVersionRequest, opts ...grpc.CallOption) (*Instance, error) {
 	out := new(Instance)
 	err := c.client.Call(ctx, "cipd.Repository", "ResolveVersion", in, out, opts...)
@@ -2451,6 +2834,15 @@ func (c *repositoryPRPCClient) DescribeClient(ctx context.Context, in *DescribeC
 	return out, nil
 }
 
+func (c *repositoryPRPCClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.client.Call(ctx, "cipd.Repository", "ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type repositoryClient struct {
 	cc *grpc.ClientConn
 }
@@ -2603,6 +2995,33 @@ func (c *repositoryClient) DetachTags(ctx context.Context, in *DetachTagsRequest
 	return out, nil
 }
 
+func (c *repositoryClient) AttachMetadata(ctx context.Context, in *AttachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/AttachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DetachMetadata(ctx context.Context, in *DetachMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/DetachMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*ListMetadataResponse, error) {
+	out := new(ListMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/ListMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryClient) ResolveVersion(ctx context.Context, in *ResolveVersionRequest, opts ...grpc.CallOption) (*Instance, error) {
 	out := new(Instance)
 	err := c.cc.Invoke(ctx, "/cipd.Repository/ResolveVersion", in, out, opts...)
@@ -2639,6 +3058,15 @@ func (c *repositoryClient) DescribeClient(ctx context.Context, in *DescribeClien
 	return out, nil
 }
 
+func (c *repositoryClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/cipd.Repository/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryServer is the server API for Repository service.
 type RepositoryServer interface {
 	// Returns metadata associated with the given prefix.
@@ -2862,6 +3290,49 @@ type RepositoryServer interface {
 	//   INVALID_ARGUMENT if the request is malformed.
 	//   NOT_FOUND if the package or the instance doesn't exist.
 	DetachTags(context.Context, *DetachTagsRequest) (*empty.Empty, error)
+	// Attaches one or more metadata entries to an instance.
+	//
+	// Silently skips already attached entries (entries with the same key and
+	// value, as identified by their fingerprints).
+	//
+	// Metadata entries are "key => binary blob" pairs associated with a concrete
+	// package instance. Unlike tags, they are not used for version resolution
+	// and can hold relatively large values (up to 512 KB each), e.g. build
+	// provenance information, a changelog or a license manifest.
+	//
+	// Keys don't have to be unique: same key may be used by multiple entries
+	// (with different values) attached to the same instance.
+	//
+	// Similarly to tags, metadata can be attached only to instances that
+	// successfully passed all post-registration processing.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a WRITER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	//   FAILED_PRECONDITION if the instance is still being processed.
+	//   ABORTED if the instance has some failed processors associated with it,
+	//       such instance is effectively broken and should not be used.
+	AttachMetadata(context.Context, *AttachMetadataRequest) (*empty.Empty, error)
+	// Detaches one or more metadata entries if they were attached.
+	//
+	// Entries are identified by their fingerprints (if set) or by key and value
+	// otherwise.
+	//
+	// Just like DetachTags, it is allowed only by OWNERS of a prefix.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not an OWNER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	DetachMetadata(context.Context, *DetachMetadataRequest) (*empty.Empty, error)
+	// Lists metadata entries attached to an instance.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a READER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if the package or the instance doesn't exist.
+	ListMetadata(context.Context, *ListMetadataRequest) (*ListMetadataResponse, error)
 	// Takes a version string and resolves it into a concrete package instance.
 	//
 	// A version string can be any of:
@@ -2907,6 +3378,23 @@ type RepositoryServer interface {
 	//   ABORTED if the instance has some failed processors associated with it,
 	//       such instance is effectively broken and should not be used.
 	DescribeClient(context.Context, *DescribeClientRequest) (*DescribeClientResponse, error)
+	// Lists events related to packages under a prefix, most recent first.
+	//
+	// Events are emitted when packages are created or deleted, instances are
+	// registered, refs are moved, tags are attached, prefix ACLs are modified,
+	// etc. The listing can optionally be filtered by an event kind.
+	//
+	// Callers must have roles READER (or above) for the prefix. Returns
+	// PERMISSION_DENIED otherwise. PREFIX_ACL_CHANGED events are returned only to
+	// OWNERS of the prefix (and silently skipped for everyone else).
+	//
+	// Pages can be shorter than requested, or even empty, before the listing is
+	// over. Keep fetching until next_page_token is empty.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a reader.
+	//   INVALID_ARGUMENT if the request is malformed.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 }
 
 // UnimplementedRepositoryServer can be embedded to have forward compatible implementations.
@@ -2961,6 +3449,15 @@ func (*UnimplementedRepositoryServer) AttachTags(ctx context.Context, req *Attac
 func (*UnimplementedRepositoryServer) DetachTags(ctx context.Context, req *DetachTagsRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DetachTags not implemented")
 }
+func (*UnimplementedRepositoryServer) AttachMetadata(ctx context.Context, req *AttachMetadataRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachMetadata not implemented")
+}
+func (*UnimplementedRepositoryServer) DetachMetadata(ctx context.Context, req *DetachMetadataRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachMetadata not implemented")
+}
+func (*UnimplementedRepositoryServer) ListMetadata(ctx context.Context, req *ListMetadataRequest) (*ListMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadata not implemented")
+}
 func (*UnimplementedRepositoryServer) ResolveVersion(ctx context.Context, req *ResolveVersionRequest) (*Instance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveVersion not implemented")
 }
@@ -2973,6 +3470,9 @@ func (*UnimplementedRepositoryServer) DescribeInstance(ctx context.Context, req
 func (*UnimplementedRepositoryServer) DescribeClient(ctx context.Context, req *DescribeClientRequest) (*DescribeClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeClient not implemented")
 }
+func (*UnimplementedRepositoryServer) ListEvents(ctx context.Context, req *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
 
 func RegisterRepositoryServer(s prpc.Registrar, srv RepositoryServer) {
 	s.RegisterService(&_Repository_serviceDesc, srv)
@@ -3266,6 +3766,60 @@ func _Repository_DetachTags_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repository_AttachMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).AttachMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/AttachMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).AttachMetadata(ctx, req.(*AttachMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_DetachMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).DetachMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/DetachMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).DetachMetadata(ctx, req.(*DetachMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ListMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/ListMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListMetadata(ctx, req.(*ListMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Repository_ResolveVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveVersionRequest)
 	if err := dec(in); err != nil {
@@ -3338,6 +3892,24 @@ func _Repository_DescribeClient_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repository_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cipd.Repository/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Repository_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cipd.Repository",
 	HandlerType: (*RepositoryServer)(nil),
@@ -3406,6 +3978,18 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DetachTags",
 			Handler:    _Repository_DetachTags_Handler,
 		},
+		{
+			MethodName: "AttachMetadata",
+			Handler:    _Repository_AttachMetadata_Handler,
+		},
+		{
+			MethodName: "DetachMetadata",
+			Handler:    _Repository_DetachMetadata_Handler,
+		},
+		{
+			MethodName: "ListMetadata",
+			Handler:    _Repository_ListMetadata_Handler,
+		},
 		{
 			MethodName: "ResolveVersion",
 			Handler:    _Repository_ResolveVersion_Handler,
@@ -3422,6 +4006,10 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeClient",
 			Handler:    _Repository_DescribeClient_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Repository_ListEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/cipd/api/cipd/v1/repo.proto",
@@ -124,3 +124,13 @@ func (impl *adminImpl) FixMarkedTags(ctx context.Context, id *api.JobID) (*api.T
 	}
 	return &api.TagFixReport{Fixed: tags}, nil
 }
+
+// GetRetentionReport implements the corresponding RPC method, see the proto
+// doc.
+func (impl *adminImpl) GetRetentionReport(ctx context.Context, id *api.JobID) (*api.RetentionReport, error) {
+	instances, err := retentionReport(ctx, mapper.JobID(id.JobId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &api.RetentionReport{Instances: instances}, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/gae/service/datastore"
+	"go.chromium.org/luci/appengine/mapper"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	api "go.chromium.org/luci/cipd/api/admin/v1"
+	repoapi "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/metadata"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+)
+
+func init() {
+	initMapper(mapperDef{
+		Kind: api.MapperKind_ENFORCE_RETENTION_POLICIES,
+		Func: enforceRetentionPoliciesMapper,
+		Config: mapper.JobConfig{
+			Query:         mapper.Query{Kind: "Package"},
+			ShardCount:    64,
+			PageSize:      16, // each package may have a lot of instances to visit
+			TrackProgress: true,
+		},
+	})
+}
+
+// retainedInstance is a root entity created for each instance deleted (or
+// to be deleted, in dry run mode) by some ENFORCE_RETENTION_POLICIES job.
+//
+// Same as markedTag, these entities are queried by the job ID once the job is
+// done. See GetRetentionReport.
+type retainedInstance struct {
+	_kind  string                `gae:"$kind,mapper.RetentionVictim"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	ID  string       `gae:"$id"` // see .genID()
+	Job mapper.JobID // ID of a mapping job that produced it, for queries
+
+	Key          *datastore.Key `gae:",noindex"` // key of the Instance entity
+	RegisteredTs time.Time      `gae:",noindex"` // when the instance was registered
+	Deleted      bool           `gae:",noindex"` // true if actually deleted
+}
+
+// genID derives an ID for this retainedInstance entity.
+func (r *retainedInstance) genID() {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n%s", r.Job, r.Key.Encode())
+	r.ID = base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+var (
+	// Maximum number of retainedInstance entities to accumulate before storing
+	// them. Replaced in tests.
+	victimsBatchSize = 100
+)
+
+func enforceRetentionPoliciesMapper(ctx context.Context, job mapper.JobID, cfg *api.JobConfig, keys []*datastore.Key) error {
+	meta := metadata.GetStorage()
+	for _, key := range keys {
+		if err := enforceRetentionPolicy(ctx, meta, job, cfg.DryRun, key.StringID()); err != nil {
+			return errors.Annotate(err, "when processing package %q", key.StringID()).Err()
+		}
+	}
+	return nil
+}
+
+// effectiveRetentionPolicy returns the retention policy that applies to the
+// package or nil if there's none.
+//
+// The policy defined in the most nested prefix wins.
+func effectiveRetentionPolicy(ctx context.Context, meta metadata.Storage, pkg string) (*repoapi.RetentionPolicy, error) {
+	md, err := meta.GetMetadata(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(md) - 1; i >= 0; i-- {
+		if md[i].RetentionPolicy != nil {
+			return md[i].RetentionPolicy, nil
+		}
+	}
+	return nil, nil
+}
+
+// keptByTags is true if any of the instance tags matches any of the
+// RetentionPolicy.KeepTags entries.
+func keptByTags(tags []*model.Tag, keepTags []string) bool {
+	for _, t := range tags {
+		for _, k := range keepTags {
+			if t.Tag == k || strings.HasPrefix(t.Tag, k+":") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// enforceRetentionPolicy deletes old instances of a single package according to
+// its retention policy.
+//
+// Records all deleted instances (or instances that would be deleted, in dry run
+// mode) as retainedInstance entities.
+func enforceRetentionPolicy(ctx context.Context, meta metadata.Storage, job mapper.JobID, dryRun bool, pkg string) error {
+	policy, err := effectiveRetentionPolicy(ctx, meta, pkg)
+	if err != nil || policy == nil {
+		return err
+	}
+	cutoff := clock.Now(ctx).Add(-time.Duration(policy.DeleteAfterDays) * 24 * time.Hour)
+
+	refs, err := model.ListPackageRefs(ctx, pkg)
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]bool, len(refs))
+	for _, r := range refs {
+		referenced[r.InstanceID] = true
+	}
+
+	// Victims are stored in batches as we go, so that deleted instances are
+	// recorded even if the job fails midway, and so that a package with lots of
+	// victims doesn't produce a giant Put. Their IDs are derived from the job
+	// and the instance, so storing them again on retries is harmless.
+	var victims []*retainedInstance
+	flush := func() error {
+		if len(victims) == 0 {
+			return nil
+		}
+		if err := datastore.Put(ctx, victims); err != nil {
+			return errors.Annotate(err, "failed to store %d retainedInstance(s)", len(victims)).Tag(transient.Tag).Err()
+		}
+		victims = nil
+		return nil
+	}
+
+	var cursor datastore.Cursor
+	idx := 0
+	for {
+		var insts []*model.Instance
+		if insts, cursor, err = model.ListInstances(ctx, pkg, 500, cursor); err != nil {
+			return err
+		}
+
+		for _, inst := range insts {
+			if len(victims) >= victimsBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+
+			// Instances are ordered by registration time, most recent first.
+			idx++
+			if idx <= int(policy.KeepLast) || inst.RegisteredTs.After(cutoff) || referenced[inst.InstanceID] {
+				continue
+			}
+			if len(policy.KeepTags) != 0 {
+				tags, err := model.ListInstanceTags(ctx, inst)
+				if err != nil {
+					return err
+				}
+				if keptByTags(tags, policy.KeepTags) {
+					continue
+				}
+			}
+
+			victim := &retainedInstance{
+				Job:          job,
+				Key:          datastore.KeyForObj(ctx, inst),
+				RegisteredTs: inst.RegisteredTs,
+			}
+			victim.genID()
+			victims = append(victims, victim)
+
+			if dryRun {
+				logging.Infof(ctx, "Would delete %s:%s", pkg, inst.InstanceID)
+				continue
+			}
+
+			// The instance may have been deleted or got a ref since we checked. This
+			// is fine, just skip it.
+			switch err := model.DeleteInstance(ctx, inst); {
+			case err == nil:
+				logging.Infof(ctx, "Deleted %s:%s", pkg, inst.InstanceID)
+				victim.Deleted = true
+			case grpcutil.Code(err) == codes.NotFound || grpcutil.Code(err) == codes.FailedPrecondition:
+				logging.Warningf(ctx, "Skipping %s:%s - %s", pkg, inst.InstanceID, err)
+			default:
+				if ferr := flush(); ferr != nil {
+					logging.WithError(ferr).Errorf(ctx, "Failed to record victims before bailing out")
+				}
+				return err
+			}
+		}
+
+		if cursor == nil {
+			break
+		}
+	}
+
+	return flush()
+}
+
+// retentionReport fetches all retainedInstance entities produced by the job.
+func retentionReport(ctx context.Context, job mapper.JobID) ([]*api.RetentionReport_Instance, error) {
+	var ents []retainedInstance
+	q := datastore.NewQuery("mapper.RetentionVictim").Eq("Job", job)
+	if err := datastore.GetAll(ctx, q, &ents); err != nil {
+		return nil, errors.Annotate(err, "failed to query the report").Tag(transient.Tag).Err()
+	}
+
+	out := make([]*api.RetentionReport_Instance, len(ents))
+	for i, e := range ents {
+		out[i] = &api.RetentionReport_Instance{
+			Pkg:          e.Key.Parent().StringID(),
+			Instance:     e.Key.StringID(),
+			RegisteredTs: google.NewTimestamp(e.RegisteredTs),
+			Deleted:      e.Deleted,
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Pkg != out[j].Pkg {
+			return out[i].Pkg < out[j].Pkg
+		}
+		return out[i].Instance < out[j].Instance
+	})
+	return out, nil
+}
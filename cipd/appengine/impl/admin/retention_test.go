@@ -0,0 +1,152 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.chromium.org/gae/service/datastore"
+	"go.chromium.org/luci/common/proto/google"
+
+	api "go.chromium.org/luci/cipd/api/admin/v1"
+	repoapi "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/metadata"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func init() {
+	// Store victims one by one, to exercise batching.
+	victimsBatchSize = 1
+}
+
+func TestEnforceRetentionPolicies(t *testing.T) {
+	t.Parallel()
+
+	Convey("With instances", t, func() {
+		ctx, admin := SetupTest()
+
+		_, err := metadata.GetStorage().UpdateMetadata(ctx, "a", func(md *repoapi.PrefixMetadata) error {
+			md.UpdateTime = google.NewTimestamp(testutil.TestTime)
+			md.RetentionPolicy = &repoapi.RetentionPolicy{
+				KeepLast:        1,
+				KeepTags:        []string{"release"},
+				DeleteAfterDays: 10,
+			}
+			return nil
+		})
+		So(err, ShouldBeNil)
+
+		// Registers an instance 'age' days old.
+		register := func(pkg, chr string, age int, tags ...string) *model.Instance {
+			_, inst, err := model.RegisterInstance(ctx, &model.Instance{
+				InstanceID:   strings.Repeat(chr, 40),
+				Package:      model.PackageKey(ctx, pkg),
+				RegisteredTs: testutil.TestTime.Add(-time.Duration(age) * 24 * time.Hour),
+			}, nil)
+			So(err, ShouldBeNil)
+			for _, t := range tags {
+				So(model.AttachTags(ctx, inst, []*repoapi.Tag{
+					{Key: strings.Split(t, ":")[0], Value: strings.Split(t, ":")[1]},
+				}), ShouldBeNil)
+			}
+			return inst
+		}
+
+		register("a/pkg", "a", 30)                  // kept as the most recent one
+		register("a/pkg", "b", 31)                  // deleted
+		refd := register("a/pkg", "c", 32)          // kept, has a ref
+		register("a/pkg", "d", 33, "release:1.0")   // kept, has a tag
+		register("a/pkg", "e", 34, "something:1.0") // deleted
+		register("b/pkg", "a", 30)                  // kept, no policy
+		register("b/pkg", "b", 31)                  // kept, no policy
+		So(model.SetRef(ctx, "stable", refd), ShouldBeNil)
+
+		exists := func(pkg, chr string) bool {
+			res, err := datastore.Exists(ctx, datastore.KeyForObj(ctx, &model.Instance{
+				InstanceID: strings.Repeat(chr, 40),
+				Package:    model.PackageKey(ctx, pkg),
+			}))
+			So(err, ShouldBeNil)
+			return res.All()
+		}
+
+		expectedReport := func(deleted bool) []*api.RetentionReport_Instance {
+			return []*api.RetentionReport_Instance{
+				{
+					Pkg:          "a/pkg",
+					Instance:     strings.Repeat("b", 40),
+					RegisteredTs: google.NewTimestamp(testutil.TestTime.Add(-31 * 24 * time.Hour)),
+					Deleted:      deleted,
+				},
+				{
+					Pkg:          "a/pkg",
+					Instance:     strings.Repeat("e", 40),
+					RegisteredTs: google.NewTimestamp(testutil.TestTime.Add(-34 * 24 * time.Hour)),
+					Deleted:      deleted,
+				},
+			}
+		}
+
+		Convey("Dry run", func() {
+			jobID, err := RunMapper(ctx, admin, &api.JobConfig{
+				Kind:   api.MapperKind_ENFORCE_RETENTION_POLICIES,
+				DryRun: true,
+			})
+			So(err, ShouldBeNil)
+
+			report, err := admin.GetRetentionReport(ctx, &api.JobID{JobId: int64(jobID)})
+			So(err, ShouldBeNil)
+			So(report.Instances, ShouldResemble, expectedReport(false))
+
+			So(exists("a/pkg", "b"), ShouldBeTrue)
+			So(exists("a/pkg", "e"), ShouldBeTrue)
+		})
+
+		Convey("Real run", func() {
+			jobID, err := RunMapper(ctx, admin, &api.JobConfig{
+				Kind: api.MapperKind_ENFORCE_RETENTION_POLICIES,
+			})
+			So(err, ShouldBeNil)
+
+			report, err := admin.GetRetentionReport(ctx, &api.JobID{JobId: int64(jobID)})
+			So(err, ShouldBeNil)
+			So(report.Instances, ShouldResemble, expectedReport(true))
+
+			for _, chr := range []string{"a", "c", "d"} {
+				So(exists("a/pkg", chr), ShouldBeTrue)
+			}
+			So(exists("a/pkg", "b"), ShouldBeFalse)
+			So(exists("a/pkg", "e"), ShouldBeFalse)
+			So(exists("b/pkg", "a"), ShouldBeTrue)
+			So(exists("b/pkg", "b"), ShouldBeTrue)
+
+			// Deletions are in the event log.
+			ev, err := model.QueryEvents(ctx, model.NewEventsQuery())
+			So(err, ShouldBeNil)
+			deleted := 0
+			for _, e := range ev {
+				if e.Kind == repoapi.EventKind_INSTANCE_DELETED {
+					deleted++
+				}
+			}
+			So(deleted, ShouldEqual, 2)
+		})
+	})
+}
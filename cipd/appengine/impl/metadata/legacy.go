@@ -15,6 +15,7 @@
 package metadata
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
@@ -67,6 +68,11 @@ func init() {
 //   * Empty ACLs are removed from PrefixMetadata.
 //
 // These differences shouldn't have semantic importance for users through.
+//
+// Retention policies and event notifications have no counterpart in the Python
+// version of the backend. They are stored in separate prefixRetentionPolicy
+// entities (one per prefix), which the Python code doesn't know about and
+// ignores.
 type legacyStorageImpl struct {
 }
 
@@ -79,18 +85,18 @@ type legacyStorageImpl struct {
 //
 // The result also always includes the hardcoded root metadata.
 func (legacyStorageImpl) GetMetadata(ctx context.Context, prefix string) ([]*api.PrefixMetadata, error) {
-	md, _, err := getMetadataImpl(ctx, prefix)
+	md, _, _, err := getMetadataImpl(ctx, prefix)
 	return md, err
 }
 
 // getMetadataImpl implements GetMetadata.
 //
-// As a bonus it returns all packageACL entities it fetched. This is used by
-// VisitMetadata to avoid unnecessary refetches.
-func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata, []*packageACL, error) {
+// As a bonus it returns all packageACL and prefixRetentionPolicy entities it
+// fetched. This is used by VisitMetadata to avoid unnecessary refetches.
+func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata, []*packageACL, []*prefixRetentionPolicy, error) {
 	prefix, err := common.ValidatePackagePrefix(prefix)
 	if err != nil {
-		return nil, nil, errors.Annotate(err, "bad prefix given to GetMetadata").Err()
+		return nil, nil, nil, errors.Annotate(err, "bad prefix given to GetMetadata").Err()
 	}
 
 	// Grab all subprefixes, i.e. ["a", "a/b", "a/b/c"]
@@ -108,28 +114,44 @@ func getMetadataImpl(ctx context.Context, prefix string) ([]*api.PrefixMetadata,
 
 	// And finish with it if nothing else is requested.
 	if len(pfxs) == 0 {
-		return out, nil, nil
+		return out, nil, nil, nil
 	}
 
 	// Prepare the keys.
 	ents := make([]*packageACL, 0, len(pfxs)*len(legacyRoles))
-	for _, p := range pfxs {
+	rets := make([]*prefixRetentionPolicy, len(pfxs))
+	for i, p := range pfxs {
 		ents = prefixACLs(ctx, p, ents)
+		rets[i] = retentionPolicyEntity(ctx, p)
 	}
 
 	// Fetch everything. ErrNoSuchEntity errors are fine, everything else is not.
-	if err = datastore.Get(ctx, ents); isInternalDSError(err) {
-		return nil, nil, errors.Annotate(err, "datastore error when fetching PackageACL").Tag(transient.Tag).Err()
+	err = parallel.FanOutIn(func(tasks chan<- func() error) {
+		tasks <- func() error {
+			if err := datastore.Get(ctx, ents); isInternalDSError(err) {
+				return errors.Annotate(err, "datastore error when fetching PackageACL").Err()
+			}
+			return nil
+		}
+		tasks <- func() error {
+			if err := datastore.Get(ctx, rets); isInternalDSError(err) {
+				return errors.Annotate(err, "datastore error when fetching PrefixRetentionPolicy").Err()
+			}
+			return nil
+		}
+	})
+	if err != nil {
+		return nil, nil, nil, transient.Tag.Apply(err)
 	}
 
 	// Combine the result into a bunch of PrefixMetadata structs.
 	legLen := len(legacyRoles)
 	for i, pfx := range pfxs {
-		if md := mergeIntoPrefixMetadata(ctx, pfx, ents[i*legLen:(i+1)*legLen]); md != nil {
+		if md := mergeIntoPrefixMetadata(ctx, pfx, ents[i*legLen:(i+1)*legLen], rets[i]); md != nil {
 			out = append(out, md)
 		}
 	}
-	return out, ents, nil
+	return out, ents, rets, nil
 }
 
 // VisitMetadata is part of Storage interface.
@@ -141,7 +163,7 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 
 	// Visit 'prefix' directly first, per VisitMetadata contract. There's a chance
 	// we won't need to recurse deeper at all and can skip all expensive fetches.
-	md, ents, err := getMetadataImpl(ctx, prefix)
+	md, ents, rets, err := getMetadataImpl(ctx, prefix)
 	if err != nil {
 		return err
 	}
@@ -172,8 +194,10 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 	// The traversal will be started form "a/b", but we still need the nodes
 	// leading to the root to get all inherited metadata.
 	gr.insert(ctx, ents)
+	gr.insertRetentionPolicies(ctx, rets)
 
 	// Fetch each per-role subtree separately, they have different key prefixes.
+	// Retention policies are fetched in parallel too.
 	err = parallel.FanOutIn(func(tasks chan<- func() error) {
 		mu := sync.Mutex{}
 		for _, role := range legacyRoles {
@@ -188,6 +212,15 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 				return err
 			}
 		}
+		tasks <- func() error {
+			listing, err := listRetentionPoliciesByPrefix(ctx, prefix)
+			if err == nil {
+				mu.Lock()
+				gr.insertRetentionPolicies(ctx, listing)
+				mu.Unlock()
+			}
+			return err
+		}
 	})
 	if err != nil {
 		return errors.Annotate(err, "failed to fetch metadata").Tag(transient.Tag).Err()
@@ -218,9 +251,10 @@ func (legacyStorageImpl) VisitMetadata(ctx context.Context, prefix string, cb Vi
 
 // UpdateMetadata is part of Storage interface.
 //
-// It assembles prefix metadata from a bunch of packageACL entities, passes it
-// to the callback for modification, then deconstructs it back into a bunch of
-// packageACL entities, to be saved in the datastore. All done transactionally.
+// It assembles prefix metadata from a bunch of packageACL entities (and
+// a prefixRetentionPolicy entity), passes it to the callback for modification,
+// then deconstructs it back into a bunch of entities, to be saved in the
+// datastore. All done transactionally.
 func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb func(m *api.PrefixMetadata) error) (*api.PrefixMetadata, error) {
 	prefix, err := common.ValidatePackagePrefix(prefix)
 	if err != nil {
@@ -242,12 +276,16 @@ func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb f
 		if err := datastore.Get(ctx, ents); isInternalDSError(err) {
 			return errors.Annotate(err, "datastore error when fetching PackageACL").Err()
 		}
+		ret := retentionPolicyEntity(ctx, prefix)
+		if err := datastore.Get(ctx, ret); err != nil && err != datastore.ErrNoSuchEntity {
+			return errors.Annotate(err, "datastore error when fetching PrefixRetentionPolicy").Err()
+		}
 
 		// Convert it to PrefixMetadata object. This will be nil if there's no
 		// existing metadata, in which case we construct the default metadata
 		// with no fingerprint (to indicate it is new), see UpdateMetadata doc in
 		// Storage interface.
-		updated = mergeIntoPrefixMetadata(ctx, prefix, ents)
+		updated = mergeIntoPrefixMetadata(ctx, prefix, ents, ret)
 		if updated == nil {
 			updated = &api.PrefixMetadata{Prefix: prefix}
 		}
@@ -277,7 +315,10 @@ func (legacyStorageImpl) UpdateMetadata(ctx context.Context, prefix string, cb f
 		if err := applyACLDiff(ctx, ents, updated); err != nil {
 			return errors.Annotate(err, "failed to update PackageACL entities").Err()
 		}
-		updated = mergeIntoPrefixMetadata(ctx, prefix, ents)
+		if err := applyRetentionPolicyDiff(ctx, ret, updated); err != nil {
+			return errors.Annotate(err, "failed to update PrefixRetentionPolicy entity").Err()
+		}
+		updated = mergeIntoPrefixMetadata(ctx, prefix, ents, ret)
 		return nil
 	}, &datastore.TransactionOptions{XG: true})
 
@@ -353,6 +394,23 @@ func (e *packageACL) parseKey() (role, prefix string, err error) {
 	return
 }
 
+// prefixRetentionPolicy contains a retention policy and event notifications
+// configuration for some package prefix.
+//
+// It has no counterpart in the Python version of the backend.
+type prefixRetentionPolicy struct {
+	_kind  string                `gae:"$kind,PrefixRetentionPolicy"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	ID     string         `gae:"$id"`     // "<pkg/path>"
+	Parent *datastore.Key `gae:"$parent"` // see rootKey()
+
+	Policy     []byte    `gae:"policy,noindex"` // serialized api.RetentionPolicy, empty if none
+	Notify     []byte    `gae:"notify,noindex"` // serialized api.PrefixMetadata with only Notifications, empty if none
+	ModifiedBy string    `gae:"modified_by"`
+	ModifiedTS time.Time `gae:"modified_ts"`
+}
+
 // isInternalDSError is true for datastore errors that aren't entirely
 // ErrNoSuchEntity.
 func isInternalDSError(err error) bool {
@@ -381,6 +439,15 @@ func rootMetadata() *api.PrefixMetadata {
 	return proto.Clone(rootMeta).(*api.PrefixMetadata)
 }
 
+// retentionPolicyEntity returns an empty prefixRetentionPolicy entity with the
+// key populated.
+func retentionPolicyEntity(ctx context.Context, prefix string) *prefixRetentionPolicy {
+	return &prefixRetentionPolicy{
+		ID:     prefix,
+		Parent: rootKey(ctx),
+	}
+}
+
 // rootKey returns a key of the root entity that stores ACL hierarchy.
 func rootKey(ctx context.Context) *datastore.Key {
 	return datastore.NewKey(ctx, "PackageACLRoot", "acls", 0, nil)
@@ -402,15 +469,18 @@ func prefixACLs(ctx context.Context, prefix string, in []*packageACL) []*package
 	return in
 }
 
-// mergeIntoPrefixMetadata takes exactly len(legacyRoles) entities and combines
-// them into a single PrefixMetadata object if at least one of the entities is
-// not empty. Returns nil of all entities are empty.
+// mergeIntoPrefixMetadata takes exactly len(legacyRoles) entities and
+// a retention policy entity (may be nil) and combines them into a single
+// PrefixMetadata object if at least one of the entities is not empty. Returns
+// nil of all entities are empty.
 //
 // The entities are expected to have IDs matching ones generated by
-// prefixACLs(ctx, prefix). Panics otherwise.
+// prefixACLs(ctx, prefix) and retentionPolicyEntity(ctx, prefix). Panics
+// otherwise.
 //
-// Logs and skips invalid principal names (should not be happening in reality).
-func mergeIntoPrefixMetadata(ctx context.Context, prefix string, ents []*packageACL) *api.PrefixMetadata {
+// Logs and skips invalid principal names, unparsable retention policies and
+// notifications (should not be happening in reality).
+func mergeIntoPrefixMetadata(ctx context.Context, prefix string, ents []*packageACL, ret *prefixRetentionPolicy) *api.PrefixMetadata {
 	if len(ents) != len(legacyRoles) {
 		panic(fmt.Sprintf("expecting %d entities, got %d", len(legacyRoles), len(ents)))
 	}
@@ -462,7 +532,36 @@ func mergeIntoPrefixMetadata(ctx context.Context, prefix string, ents []*package
 		}
 	}
 
-	// If modTime is zero, then none of packageACL entities exist. Return nil in
+	// Retention policy entity contributes to the modification time too. Note that
+	// an existing entity with empty Policy means there's no policy anymore.
+	if ret != nil && !ret.ModifiedTS.IsZero() {
+		if ret.ID != prefix {
+			panic(fmt.Sprintf("expecting key %q, got %q", prefix, ret.ID))
+		}
+		if modTime.IsZero() || modTime.Before(ret.ModifiedTS) {
+			modTime = ret.ModifiedTS
+			md.UpdateUser = ret.ModifiedBy
+			md.UpdateTime = google.NewTimestamp(modTime)
+		}
+		if len(ret.Policy) != 0 {
+			policy := &api.RetentionPolicy{}
+			if err := proto.Unmarshal(ret.Policy, policy); err != nil {
+				logging.Errorf(ctx, "Bad PrefixRetentionPolicy %q - %s", ret.ID, err)
+			} else {
+				md.RetentionPolicy = policy
+			}
+		}
+		if len(ret.Notify) != 0 {
+			notify := &api.PrefixMetadata{}
+			if err := proto.Unmarshal(ret.Notify, notify); err != nil {
+				logging.Errorf(ctx, "Bad notifications in PrefixRetentionPolicy %q - %s", ret.ID, err)
+			} else {
+				md.Notifications = notify.Notifications
+			}
+		}
+	}
+
+	// If modTime is zero, then none of the entities exist. Return nil in
 	// this case to indicate there's no metadata at all. Note that it is possible
 	// that some packageACL entities exist, but have empty ACLs. We consider this
 	// as NOT empty metadata (we still have modification time and modifying user
@@ -531,6 +630,43 @@ func applyACLDiff(ctx context.Context, ents []*packageACL, md *api.PrefixMetadat
 	return datastore.Put(ctx, toPut)
 }
 
+// applyRetentionPolicyDiff compares the retention policy and notifications in
+// 'md' to the ones stored in 'ent' and Puts 'ent' into the datastore (updating
+// it) if they are different.
+//
+// 'ent' is expected to have ID matching md.Prefix. Panics otherwise.
+func applyRetentionPolicyDiff(ctx context.Context, ent *prefixRetentionPolicy, md *api.PrefixMetadata) error {
+	if ent.ID != md.Prefix {
+		panic(fmt.Sprintf("expecting key %q, got %q", md.Prefix, ent.ID))
+	}
+
+	var policy, notify []byte
+	var err error
+	if md.RetentionPolicy != nil {
+		if policy, err = proto.Marshal(md.RetentionPolicy); err != nil {
+			return err
+		}
+	}
+	if len(md.Notifications) != 0 {
+		// Notifications are repeated, wrap them into a message to serialize.
+		notify, err = proto.Marshal(&api.PrefixMetadata{Notifications: md.Notifications})
+		if err != nil {
+			return err
+		}
+	}
+	if bytes.Equal(policy, ent.Policy) && bytes.Equal(notify, ent.Notify) {
+		return nil // no changes, do not touch the entity
+	}
+
+	// Note: when the policy is removed, we still keep the entity (with empty
+	// Policy) to remember who removed it and when, just like we do for ACLs.
+	ent.Policy = policy
+	ent.Notify = notify
+	ent.ModifiedBy = md.UpdateUser
+	ent.ModifiedTS = google.TimeFromProto(md.UpdateTime)
+	return datastore.Put(ctx, ent)
+}
+
 func isEqualStrSlice(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -580,6 +716,41 @@ func listACLsByPrefix(ctx context.Context, role, prefix string) (acls []*package
 	return
 }
 
+// listRetentionPoliciesByPrefix returns prefixRetentionPolicy entities under
+// the given prefix.
+//
+// Same as listACLsByPrefix, the policy for the prefix itself is NOT listed.
+// Only policies strictly underneath are.
+//
+// The return value is sorted by prefix.
+func listRetentionPoliciesByPrefix(ctx context.Context, prefix string) (rets []*prefixRetentionPolicy, err error) {
+	if prefix, err = common.ValidatePackagePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	keyPfx := ""
+	if prefix != "" {
+		keyPfx = prefix + "/"
+	}
+
+	root := rootKey(ctx)
+
+	q := datastore.NewQuery("PrefixRetentionPolicy").Ancestor(root)
+	q = q.Gt("__key__", datastore.KeyForObj(ctx, &prefixRetentionPolicy{
+		ID:     keyPfx + "\x00",
+		Parent: root,
+	}))
+	q = q.Lt("__key__", datastore.KeyForObj(ctx, &prefixRetentionPolicy{
+		ID:     keyPfx + "\xff",
+		Parent: root,
+	}))
+
+	if err = datastore.GetAll(ctx, q, &rets); err != nil {
+		return nil, errors.Annotate(err, "failed to query the list of retention policies").Tag(transient.Tag).Err()
+	}
+	return
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Metadata graph used by VisitMetadata implementation.
 
@@ -588,8 +759,9 @@ func listACLsByPrefix(ctx context.Context, role, prefix string) (acls []*package
 // It can be in non-frozen (== under construction) and frozen (== constructed)
 // states.
 type metadataNode struct {
-	prefix string        // this node's full prefix, e.g. "a/b/c"
-	acls   []*packageACL // exactly len(legacyRoles) items with node's ACLs, nil when frozen
+	prefix string                 // this node's full prefix, e.g. "a/b/c"
+	acls   []*packageACL          // exactly len(legacyRoles) items with node's ACLs, nil when frozen
+	ret    *prefixRetentionPolicy // node's retention policy or nil if not set
 
 	parent   *metadataNode
 	children map[string]*metadataNode // direct children of the node
@@ -660,6 +832,17 @@ func (n *metadataNode) attachACL(role string, e *packageACL) {
 	panic(fmt.Sprintf("unexpected impossible role %q", role))
 }
 
+// attachRetentionPolicy attaches a prefixRetentionPolicy to this node.
+//
+// It is merged into PrefixMetadata in 'freeze'.
+func (n *metadataNode) attachRetentionPolicy(e *prefixRetentionPolicy) {
+	n.assertNonFrozen()
+	if n.ret != nil {
+		panic(fmt.Sprintf("retention policy at %q is already attached", n.prefix))
+	}
+	n.ret = e
+}
+
 // freeze marks the node and its subtree as fully constructed, calculating their
 // PrefixMetadata from attached ACLs.
 //
@@ -669,9 +852,10 @@ func (n *metadataNode) freeze(ctx context.Context) {
 
 	// md may be already non-nil for the root, this is fine.
 	if n.md == nil {
-		n.md = mergeIntoPrefixMetadata(ctx, n.prefix, n.acls)
+		n.md = mergeIntoPrefixMetadata(ctx, n.prefix, n.acls, n.ret)
 	}
 	n.acls = nil // mark as frozen, release unnecessary memory
+	n.ret = nil
 
 	for _, child := range n.children {
 		child.freeze(ctx)
@@ -783,6 +967,25 @@ func (g *metadataGraph) insert(ctx context.Context, ents []*packageACL) {
 	}
 }
 
+// insertRetentionPolicies attaches retention policies in the given entities to
+// nodes in the graph.
+//
+// Silently ignores empty entities (based on ModifiedTS value). Logs and ignores
+// broken ones. The context is used only for logging.
+func (g *metadataGraph) insertRetentionPolicies(ctx context.Context, ents []*prefixRetentionPolicy) {
+	for _, e := range ents {
+		if e.ModifiedTS.IsZero() {
+			continue // zero body, no such entity in the datastore, skip
+		}
+		pfx, err := common.ValidatePackagePrefix(e.ID)
+		if err != nil || pfx == "" || pfx != e.ID {
+			logging.Errorf(ctx, "Skipping bad PrefixRetentionPolicy entity %q", e.ID)
+			continue
+		}
+		g.node(pfx).attachRetentionPolicy(e)
+	}
+}
+
 // freeze finalizes graph construction by calculating all PrefixMetadata items.
 //
 // The graph is not modifiable after this point, but it becomes traversable.
@@ -317,6 +317,94 @@ func TestLegacyMetadata(t *testing.T) {
 			So(md, ShouldResembleProto, []*api.PrefixMetadata{rootMeta, expected})
 		})
 
+		Convey("UpdateMetadata sets and removes retention policy", func() {
+			policy := &api.RetentionPolicy{
+				KeepLast:        5,
+				KeepTags:        []string{"release"},
+				DeleteAfterDays: 30,
+			}
+
+			updated, err := impl.UpdateMetadata(ctx, "a/b", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts.Add(time.Hour))
+				md.UpdateUser = "user:updater@example.com"
+				md.RetentionPolicy = policy
+				return nil
+			})
+			So(err, ShouldBeNil)
+
+			withPolicy := &api.PrefixMetadata{
+				Prefix:          "a/b",
+				UpdateTime:      google.NewTimestamp(ts.Add(time.Hour)),
+				UpdateUser:      "user:updater@example.com",
+				RetentionPolicy: policy,
+			}
+			withPolicy.Fingerprint = CalculateFingerprint(*withPolicy)
+			So(updated, ShouldResembleProto, withPolicy)
+			So(updated.Fingerprint, ShouldNotEqual, expected["a/b"].Fingerprint)
+
+			// GetMetadata sees it.
+			md, err := impl.GetMetadata(ctx, "a/b/c/d")
+			So(err, ShouldBeNil)
+			So(md, ShouldResembleProto, []*api.PrefixMetadata{
+				rootMeta,
+				expected["a"],
+				withPolicy,
+				expected["a/b/c/d"],
+			})
+
+			// ACL entities were not touched.
+			legacy := prefixACLs(ctx, "a/b", nil)
+			datastore.Get(ctx, legacy)
+			So(legacy[0].ModifiedTS, ShouldResemble, ts)
+
+			// Removing the policy keeps the modification info around.
+			updated, err = impl.UpdateMetadata(ctx, "a/b", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts.Add(2 * time.Hour))
+				md.UpdateUser = "user:remover@example.com"
+				md.RetentionPolicy = nil
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(updated.RetentionPolicy, ShouldBeNil)
+			So(updated.UpdateUser, ShouldEqual, "user:remover@example.com")
+		})
+
+		Convey("UpdateMetadata sets and removes notifications", func() {
+			notifications := []*api.EventNotification{
+				{
+					Kinds:  []api.EventKind{api.EventKind_INSTANCE_REF_SET},
+					Refs:   []string{"stable"},
+					Target: &api.EventNotification_WebhookUrl{WebhookUrl: "https://example.com/hook"},
+				},
+			}
+
+			updated, err := impl.UpdateMetadata(ctx, "a/b", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts.Add(time.Hour))
+				md.UpdateUser = "user:updater@example.com"
+				md.Notifications = notifications
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(updated.Notifications, ShouldResembleProto, notifications)
+			So(updated.Fingerprint, ShouldNotEqual, expected["a/b"].Fingerprint)
+
+			// GetMetadata sees it.
+			md, err := impl.GetMetadata(ctx, "a/b/c")
+			So(err, ShouldBeNil)
+			So(md[2], ShouldResembleProto, updated)
+
+			// Removing them keeps the modification info around.
+			updated, err = impl.UpdateMetadata(ctx, "a/b", func(md *api.PrefixMetadata) error {
+				md.UpdateTime = google.NewTimestamp(ts.Add(2 * time.Hour))
+				md.UpdateUser = "user:remover@example.com"
+				md.Notifications = nil
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(updated.Notifications, ShouldBeNil)
+			So(updated.UpdateUser, ShouldEqual, "user:remover@example.com")
+		})
+
 		Convey("UpdateMetadata call with failing callback", func() {
 			cbErr := errors.New("blah")
 			updated, err := impl.UpdateMetadata(ctx, "z", func(md *api.PrefixMetadata) error {
@@ -472,6 +560,30 @@ func TestVisitMetadata(t *testing.T) {
 			})
 		})
 
+		Convey("Retention policies are visited too", func() {
+			So(datastore.Put(ctx, &prefixRetentionPolicy{
+				ID:         "a/b/c",
+				Parent:     rootKey(ctx),
+				Policy:     []byte{0x18, 0x07}, // delete_after_days: 7
+				ModifiedTS: ts,
+			}), ShouldBeNil)
+
+			policies := map[string]int32{}
+			err := impl.VisitMetadata(ctx, "a", func(p string, md []*api.PrefixMetadata) (bool, error) {
+				for _, m := range md {
+					if m.RetentionPolicy != nil {
+						policies[p] = m.RetentionPolicy.DeleteAfterDays
+					}
+				}
+				return true, nil
+			})
+			So(err, ShouldBeNil)
+			So(policies, ShouldResemble, map[string]int32{
+				"a/b/c":   7,
+				"a/b/c/d": 7, // inherited
+			})
+		})
+
 		Convey("Callback return value is respected, stopping right away", func() {
 			seen := []string{}
 			err := impl.VisitMetadata(ctx, "a", func(p string, md []*api.PrefixMetadata) (bool, error) {
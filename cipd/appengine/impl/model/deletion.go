@@ -24,6 +24,7 @@ import (
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/common/sync/parallel"
+	"go.chromium.org/luci/grpc/grpcutil"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 )
@@ -49,6 +50,7 @@ func DeletePackage(c context.Context, pkg string) error {
 	// we don't have metadata with dangling pointers to deleted instances).
 	err := deleteEntityKinds(c, pkg, []string{
 		"InstanceTag",
+		"InstanceMetadata",
 		"PackageRef",
 		"ProcessingResult",
 	})
@@ -65,6 +67,7 @@ func DeletePackage(c context.Context, pkg string) error {
 	return Txn(c, "DeletePackage", func(c context.Context) error {
 		err := deleteEntityKinds(c, pkg, []string{
 			"InstanceTag",
+			"InstanceMetadata",
 			"PackageRef",
 			"ProcessingResult",
 			"PackageInstance",
@@ -82,8 +85,103 @@ func DeletePackage(c context.Context, pkg string) error {
 	})
 }
 
+// DeleteInstance deletes an instance along with all its tags, metadata and
+// processing results.
+//
+// An instance may have more tags and metadata than a single transaction can
+// delete, so, similarly to DeletePackage, they are deleted non-transactionally
+// in batches first. The instance itself is then deleted transactionally, along
+// with whatever children were created in the meantime.
+//
+// Refuses to delete instances that have refs pointing to them: refs must be
+// moved or deleted first. If a ref is set while the children are being
+// deleted, the instance survives, but may lose some of its tags and metadata.
+// Emits INSTANCE_DELETED event.
+//
+// Returns grpc-tagged errors (in particular NotFound if there's no such
+// instance and FailedPrecondition if the instance has refs).
+func DeleteInstance(c context.Context, inst *Instance) error {
+	if err := checkInstanceDeletable(c, inst); err != nil {
+		return err
+	}
+	if err := deleteInstanceChildren(c, inst); err != nil {
+		return err
+	}
+
+	return Txn(c, "DeleteInstance", func(c context.Context) error {
+		if err := checkInstanceDeletable(c, inst); err != nil {
+			return err
+		}
+		if err := deleteInstanceChildren(c, inst); err != nil {
+			return err
+		}
+		if err := datastore.Delete(c, datastore.KeyForObj(c, inst)); err != nil {
+			return errors.Annotate(err, "failed to delete the instance").Tag(transient.Tag).Err()
+		}
+
+		return EmitEvent(c, &api.Event{
+			Kind:     api.EventKind_INSTANCE_DELETED,
+			Package:  inst.Package.StringID(),
+			Instance: inst.InstanceID,
+		})
+	})
+}
+
+// checkInstanceDeletable returns a grpc-tagged error if the instance doesn't
+// exist or is referenced by a ref.
+func checkInstanceDeletable(c context.Context, inst *Instance) error {
+	if err := CheckInstanceExists(c, inst); err != nil {
+		return err
+	}
+	switch refs, err := ListInstanceRefs(c, inst); {
+	case err != nil:
+		return err
+	case len(refs) != 0:
+		return errors.Reason("the instance is referenced by ref %q", refs[0].Name).
+			Tag(grpcutil.FailedPreconditionTag).Err()
+	}
+	return nil
+}
+
+// deleteInstanceChildren deletes all child entities of the instance (its tags,
+// metadata, etc.), but not the instance itself, in batches.
+func deleteInstanceChildren(c context.Context, inst *Instance) error {
+	root := datastore.KeyForObj(c, inst)
+
+	var batch []*datastore.Key
+	flush := func() error {
+		if err := datastore.Delete(c, batch); err != nil {
+			return errors.Annotate(err, "failed to delete %d child entities", len(batch)).Tag(transient.Tag).Err()
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	var flushErr error
+	q := datastore.NewQuery("").Ancestor(root).KeysOnly(true)
+	err := datastore.Run(c, q, func(k *datastore.Key) error {
+		if k.Equal(root) {
+			return nil
+		}
+		if batch = append(batch, k); len(batch) >= deletionBatchSize {
+			flushErr = flush()
+		}
+		return flushErr
+	})
+	switch {
+	case flushErr != nil:
+		return flushErr
+	case err != nil:
+		return errors.Annotate(err, "failed to query child entities").Tag(transient.Tag).Err()
+	case len(batch) > 0:
+		return flush()
+	}
+	return nil
+}
+
 var (
-	// Number of keys to delete at once in deleteKinds. Replaced in tests.
+	// Number of keys to delete at once in deleteEntityKinds and
+	// deleteInstanceChildren. Replaced in tests.
 	deletionBatchSize = 256
 )
 
@@ -31,13 +31,15 @@ import (
 	. "go.chromium.org/luci/common/testing/assertions"
 )
 
+func init() {
+	// Use tiny batches, so that deletions in tests span multiple batches. This
+	// is set once for all tests, since they run in parallel.
+	deletionBatchSize = 3
+}
+
 func TestDeletePackage(t *testing.T) {
 	t.Parallel()
 
-	// No other test is hiting DeletePackage, so its fine to change the global
-	// variable here in this parallel test.
-	deletionBatchSize = 3
-
 	Convey("Works", t, func() {
 		ctx, _, _ := testutil.TestingContext()
 
@@ -63,9 +65,9 @@ func TestDeletePackage(t *testing.T) {
 			return
 		}
 
-		// This will create 20 entities to be deleted. For deletionBatchSize == 3
-		// set above, it means we'll have 6 full batches and one incomplete final
-		// batch, thus covering all important code paths.
+		// This will create 24 entities to be deleted. For deletionBatchSize == 3
+		// set above, it means we'll have a bunch of full batches and incomplete
+		// final batches, thus covering all important code paths.
 		for _, chr := range []string{"a", "b", "c", "d"} {
 			reg, inst, _ := RegisterInstance(ctx, &Instance{
 				InstanceID: strings.Repeat(chr, 40),
@@ -83,6 +85,9 @@ func TestDeletePackage(t *testing.T) {
 				{Key: "k1", Value: chr},
 				{Key: "k2", Value: chr},
 			}), ShouldBeNil)
+			So(AttachMetadata(ctx, inst, []*api.InstanceMetadata{
+				{Key: "md", Value: []byte(chr)},
+			}), ShouldBeNil)
 		}
 
 		// Some unrelated instance in a different package to be left alone.
@@ -93,7 +98,7 @@ func TestDeletePackage(t *testing.T) {
 		So(reg, ShouldBeTrue)
 
 		// Before the deletion.
-		So(entitiesCount(PackageKey(ctx, "pkg")), ShouldEqual, 21)
+		So(entitiesCount(PackageKey(ctx, "pkg")), ShouldEqual, 25)
 		So(entitiesCount(PackageKey(ctx, "another-pkg")), ShouldEqual, 2)
 
 		So(DeletePackage(ctx, "pkg"), ShouldBeNil)
@@ -119,3 +124,91 @@ func TestDeletePackage(t *testing.T) {
 		So(GetEvents(ctx), ShouldHaveLength, len(events))
 	})
 }
+
+func TestDeleteInstance(t *testing.T) {
+	t.Parallel()
+
+	Convey("With datastore", t, func() {
+		ctx, _, _ := testutil.TestingContext()
+
+		register := func(chr string) *Instance {
+			_, inst, err := RegisterInstance(ctx, &Instance{
+				InstanceID: strings.Repeat(chr, 40),
+				Package:    PackageKey(ctx, "pkg"),
+			}, nil)
+			So(err, ShouldBeNil)
+			So(AttachTags(ctx, inst, []*api.Tag{{Key: "k", Value: chr}}), ShouldBeNil)
+			So(AttachMetadata(ctx, inst, []*api.InstanceMetadata{
+				{Key: "md", Value: []byte(chr)},
+			}), ShouldBeNil)
+			return inst
+		}
+
+		children := func(inst *Instance) (count int) {
+			q := datastore.NewQuery("").Ancestor(datastore.KeyForObj(ctx, inst)).KeysOnly(true)
+			So(datastore.Run(ctx, q, func(k *datastore.Key) {
+				if !strings.HasPrefix(k.Kind(), "__") {
+					count++
+				}
+			}), ShouldBeNil)
+			return
+		}
+
+		inst1 := register("a")
+		inst2 := register("b")
+		So(children(inst1), ShouldEqual, 3)
+
+		Convey("Deletes the instance and its children", func() {
+			So(DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			}), ShouldBeNil)
+			So(children(inst1), ShouldEqual, 0)
+			So(children(inst2), ShouldEqual, 3)
+
+			events := GetEvents(ctx)
+			So(events[len(events)-1], ShouldResembleProto, &api.Event{
+				Kind:     api.EventKind_INSTANCE_DELETED,
+				Package:  "pkg",
+				Instance: inst1.InstanceID,
+				Who:      string(testutil.TestUser),
+				When:     google.NewTimestamp(testutil.TestTime),
+			})
+
+			err := DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			})
+			So(grpcutil.Code(err), ShouldEqual, codes.NotFound)
+		})
+
+		Convey("Deletes children in batches", func() {
+			So(AttachTags(ctx, inst1, []*api.Tag{
+				{Key: "k", Value: "1"},
+				{Key: "k", Value: "2"},
+				{Key: "k", Value: "3"},
+				{Key: "k", Value: "4"},
+				{Key: "k", Value: "5"},
+			}), ShouldBeNil)
+			So(children(inst1), ShouldEqual, 8)
+
+			So(DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			}), ShouldBeNil)
+			So(children(inst1), ShouldEqual, 0)
+			So(children(inst2), ShouldEqual, 3)
+		})
+
+		Convey("Refuses to delete referenced instances", func() {
+			So(SetRef(ctx, "latest", inst1), ShouldBeNil)
+			err := DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			})
+			So(grpcutil.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(err, ShouldErrLike, `referenced by ref "latest"`)
+			So(children(inst1), ShouldEqual, 3)
+		})
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/bigquery"
@@ -137,6 +138,63 @@ func QueryEvents(c context.Context, q *datastore.Query) ([]*api.Event, error) {
 	return out, nil
 }
 
+// eventsScanLimit is how many Event entities ListEvents visits at most before
+// giving up and returning a partial page.
+const eventsScanLimit = 2000
+
+// ListEvents returns events related to packages under the given prefix
+// (including events related to the prefix itself), most recent first.
+//
+// 'filter', if not nil, is called for each candidate event entity and should
+// return false to skip it.
+//
+// The filtering happens in memory while scanning the global events log, so the
+// returned page can be smaller than 'pageSize' (even empty) if ListEvents gives
+// up scanning after visiting too many entities. The returned cursor is non-nil
+// in that case and it can be used to resume the scan.
+func ListEvents(c context.Context, prefix string, filter func(*Event) bool, pageSize int32, cursor datastore.Cursor) (out []*api.Event, nextCur datastore.Cursor, err error) {
+	if pageSize <= 0 {
+		panic("pageSize must be positive")
+	}
+
+	q := NewEventsQuery().Order("-When")
+	if cursor != nil {
+		q = q.Start(cursor)
+	}
+
+	scanned := 0
+	err = datastore.Run(c, q, func(e *Event, cb datastore.CursorCB) error {
+		scanned++
+		if pkg := e.Package.StringID(); prefix == "" || pkg == prefix || strings.HasPrefix(pkg, prefix+"/") {
+			if filter == nil || filter(e) {
+				ev := &api.Event{}
+				if err := proto.Unmarshal(e.Event, ev); err != nil {
+					return errors.Annotate(err, "failed to unmarshal Event with ID %d", e.ID).Err()
+				}
+				out = append(out, ev)
+			}
+		}
+		if len(out) >= int(pageSize) || scanned >= eventsScanLimit {
+			if nextCur, err = cb(); err != nil {
+				return err
+			}
+			return datastore.Stop
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, transient.Tag.Apply(err)
+	}
+	return
+}
+
+// EventsNotifier, if not nil, is called by Events.Flush with flushed events.
+//
+// It is called as part of the same transaction as Flush (if any), and thus can
+// be used to transactionally enqueue tasks that send notifications about the
+// events. It is set when initializing the service. It is nil in unit tests.
+var EventsNotifier func(c context.Context, ev []*api.Event) error
+
 // Events collects events emitted in some transaction and then flushes them.
 type Events struct {
 	ev []*api.Event
@@ -149,7 +207,8 @@ func (t *Events) Emit(e *api.Event) {
 	t.ev = append(t.ev, e)
 }
 
-// Flush sends all pending events to the datastore/bigquery.
+// Flush sends all pending events to the datastore/bigquery and to
+// the EventsNotifier, if any.
 //
 // Returned errors are tagged as transient. On success, clears the pending
 // events queue.
@@ -174,6 +233,9 @@ func (t *Events) Flush(c context.Context) error {
 	err := parallel.FanOutIn(func(tasks chan<- func() error) {
 		tasks <- func() error { return datastore.Put(c, entities) }
 		tasks <- func() error { return eventsLog.Insert(c, rows...) }
+		if EventsNotifier != nil {
+			tasks <- func() error { return EventsNotifier(c, t.ev) }
+		}
 	})
 	if err != nil {
 		return transient.Tag.Apply(err)
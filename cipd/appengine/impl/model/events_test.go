@@ -93,6 +93,55 @@ func TestEvents(t *testing.T) {
 				},
 			})
 		})
+
+		Convey("Listing events", func() {
+			ev := Events{}
+			for _, pkg := range []string{"a", "a/b", "a/b/c", "ab/c", "z"} {
+				ev.Emit(&api.Event{
+					Kind:    api.EventKind_PACKAGE_CREATED,
+					Package: pkg,
+				})
+				ev.Emit(&api.Event{
+					Kind:    api.EventKind_INSTANCE_REF_SET,
+					Package: pkg,
+					Ref:     "stable",
+				})
+			}
+			So(ev.Flush(ctx), ShouldBeNil)
+			datastore.GetTestable(ctx).CatchupIndexes()
+
+			list := func(prefix string, filter func(*Event) bool) (out []string) {
+				var cur datastore.Cursor
+				for {
+					evs, next, err := ListEvents(ctx, prefix, filter, 3, cur)
+					So(err, ShouldBeNil)
+					for _, e := range evs {
+						out = append(out, e.Package+" "+e.Kind.String())
+					}
+					if next == nil {
+						return
+					}
+					cur = next
+				}
+			}
+
+			So(list("a/b", nil), ShouldResemble, []string{
+				"a/b/c INSTANCE_REF_SET",
+				"a/b/c PACKAGE_CREATED",
+				"a/b INSTANCE_REF_SET",
+				"a/b PACKAGE_CREATED",
+			})
+
+			So(list("", func(e *Event) bool {
+				return e.Kind == api.EventKind_INSTANCE_REF_SET
+			}), ShouldResemble, []string{
+				"z INSTANCE_REF_SET",
+				"ab/c INSTANCE_REF_SET",
+				"a/b/c INSTANCE_REF_SET",
+				"a/b INSTANCE_REF_SET",
+				"a INSTANCE_REF_SET",
+			})
+		})
 	})
 }
 
@@ -0,0 +1,266 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"go.chromium.org/gae/service/datastore"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/auth"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// InstanceMetadata represents a metadata entry (a key and a binary value)
+// attached to some package instance.
+//
+// Just like tags, metadata entries exist as separate entities, since there can
+// be a lot of them, and they can be large. Unlike tags, they are not used for
+// version resolution, and thus their values are not indexed.
+//
+// ID is a fingerprint of the entry (see common.InstanceMetadataFingerprint).
+// The parent entity is the corresponding Instance.
+//
+// For the same reasons as with tags, we double check 'Key' and 'Value' fields
+// in all entities we touch, to safeguard against fingerprint collisions.
+type InstanceMetadata struct {
+	_kind  string                `gae:"$kind,InstanceMetadata"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	Fingerprint string         `gae:"$id"`     // see common.InstanceMetadataFingerprint
+	Instance    *datastore.Key `gae:"$parent"` // key of corresponding Instance entity
+
+	Key         string    `gae:"key"`                  // the metadata key
+	Value       []byte    `gae:"value,noindex"`        // the metadata payload
+	ContentType string    `gae:"content_type,noindex"` // a content type of the value
+	AttachedBy  string    `gae:"attached_by"`          // who added this entry
+	AttachedTs  time.Time `gae:"attached_ts"`          // when it was added
+}
+
+// Proto returns cipd.InstanceMetadata proto with information from this entity.
+func (md *InstanceMetadata) Proto() *api.InstanceMetadata {
+	return &api.InstanceMetadata{
+		Key:         md.Key,
+		Value:       md.Value,
+		ContentType: md.ContentType,
+		Fingerprint: md.Fingerprint,
+		AttachedBy:  md.AttachedBy,
+		AttachedTs:  google.NewTimestamp(md.AttachedTs),
+	}
+}
+
+// AttachMetadata transactionally attaches a bunch of metadata entries to an
+// instance.
+//
+// Assumes inputs are already validated. Launches a transaction inside (and thus
+// can't be a part of a transaction itself). Updates 'inst' in-place with the
+// most recent instance state.
+//
+// Returns gRPC-tagged errors:
+//    NotFound if there's no such instance or package.
+//    FailedPrecondition if some processors are still running.
+//    Aborted if some processors have failed.
+//    Internal on fingerprint collision.
+func AttachMetadata(c context.Context, inst *Instance, md []*api.InstanceMetadata) error {
+	return Txn(c, "AttachMetadata", func(c context.Context) error {
+		if err := CheckInstanceReady(c, inst); err != nil {
+			return err
+		}
+
+		_, missing, err := checkExistingMetadata(c, inst, md)
+		if err != nil {
+			return err
+		}
+
+		events := Events{}
+		now := clock.Now(c).UTC()
+		who := string(auth.CurrentIdentity(c))
+		for _, ent := range missing {
+			ent.AttachedBy = who
+			ent.AttachedTs = now
+			events.Emit(&api.Event{
+				Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+				Package:       inst.Package.StringID(),
+				Instance:      inst.InstanceID,
+				MdKey:         ent.Key,
+				MdContentType: ent.ContentType,
+				MdFingerprint: ent.Fingerprint,
+			})
+		}
+
+		if err := datastore.Put(c, missing); err != nil {
+			return transient.Tag.Apply(err)
+		}
+		return events.Flush(c)
+	})
+}
+
+// DetachMetadata detaches a bunch of metadata entries from an instance.
+//
+// Entries are identified either by their fingerprints (if set) or by key and
+// value. Silently skips entries that are not attached.
+//
+// Assumes inputs are already validated. Launches a transaction inside (and thus
+// can't be a part of a transaction itself).
+//
+// 'inst' is used only for its key.
+func DetachMetadata(c context.Context, inst *Instance, md []*api.InstanceMetadata) error {
+	return Txn(c, "DetachMetadata", func(c context.Context) error {
+		existing, _, err := checkExistingMetadata(c, inst, md)
+		if err != nil {
+			return err
+		}
+
+		if err := datastore.Delete(c, existing); err != nil {
+			return transient.Tag.Apply(err)
+		}
+
+		events := Events{}
+		for _, ent := range existing {
+			events.Emit(&api.Event{
+				Kind:          api.EventKind_INSTANCE_METADATA_DETACHED,
+				Package:       inst.Package.StringID(),
+				Instance:      inst.InstanceID,
+				MdKey:         ent.Key,
+				MdContentType: ent.ContentType,
+				MdFingerprint: ent.Fingerprint,
+			})
+		}
+		return events.Flush(c)
+	})
+}
+
+// ListMetadata returns metadata entries attached to an instance, sorting them
+// by the key first, and then by the timestamp (most recent first).
+//
+// If 'keys' is not empty, returns only entries with these keys.
+//
+// Returns an empty list if there's no such instance at all.
+func ListMetadata(c context.Context, inst *Instance, keys []string) (out []*InstanceMetadata, err error) {
+	// Similarly to ListInstanceTags, we sort in memory to avoid composite
+	// indexes. The number of metadata entries per instance is expected to be
+	// small.
+	q := datastore.NewQuery("InstanceMetadata").Ancestor(datastore.KeyForObj(c, inst))
+	if err := datastore.GetAll(c, q, &out); err != nil {
+		return nil, errors.Annotate(err, "datastore query failed").Tag(transient.Tag).Err()
+	}
+
+	if len(keys) != 0 {
+		want := stringset.NewFromSlice(keys...)
+		filtered := out[:0]
+		for _, md := range out {
+			if want.Has(md.Key) {
+				filtered = append(filtered, md)
+			}
+		}
+		out = filtered
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Key != out[j].Key {
+			return out[i].Key < out[j].Key
+		}
+		if !out[i].AttachedTs.Equal(out[j].AttachedTs) {
+			return out[i].AttachedTs.After(out[j].AttachedTs)
+		}
+		return out[i].Fingerprint < out[j].Fingerprint
+	})
+	return out, nil
+}
+
+// checkExistingMetadata takes a list of metadata entries and returns the ones
+// that exist in the datastore and ones that don't.
+//
+// Entries in the input list are identified by their fingerprints, or, if the
+// fingerprint is not set, by key and value.
+//
+// Checks 'Key' and 'Value' fields on existing entities, thus safeguarding
+// against malicious collisions in InstanceMetadataFingerprint. Entries that
+// were identified only by a fingerprint are not checked.
+//
+// Entities in 'miss' list have only their key, 'Key', 'Value' and
+// 'ContentType' fields set and nothing more. Entries that are identified only
+// by a fingerprint are never returned in 'miss' list.
+func checkExistingMetadata(c context.Context, inst *Instance, md []*api.InstanceMetadata) (exist, miss []*InstanceMetadata, err error) {
+	instKey := datastore.KeyForObj(c, inst)
+
+	// Skip duplicates, they would result in duplicate events otherwise.
+	seen := stringset.New(len(md))
+	ents := make([]*InstanceMetadata, 0, len(md))
+	expected := make([]*api.InstanceMetadata, 0, len(md))
+	for _, m := range md {
+		fp := m.Fingerprint
+		if fp == "" {
+			fp = common.InstanceMetadataFingerprint(m.Key, m.Value)
+		}
+		if !seen.Add(fp) {
+			continue
+		}
+		ents = append(ents, &InstanceMetadata{
+			Fingerprint: fp,
+			Instance:    instKey,
+		})
+		expected = append(expected, m)
+	}
+
+	missing := make([]bool, len(ents))
+	if err := datastore.Get(c, ents); err != nil {
+		merr, ok := err.(errors.MultiError)
+		if !ok {
+			return nil, nil, errors.Annotate(err, "failed to fetch metadata").Tag(transient.Tag).Err()
+		}
+		for i, err := range merr {
+			switch err {
+			case nil:
+			case datastore.ErrNoSuchEntity:
+				missing[i] = true
+			default:
+				return nil, nil, errors.Annotate(err, "failed to fetch metadata with fingerprint %q", ents[i].Fingerprint).Tag(transient.Tag).Err()
+			}
+		}
+	}
+
+	for i, ent := range ents {
+		m := expected[i]
+		onlyFP := m.Key == "" && len(m.Value) == 0
+		switch {
+		case missing[i] && onlyFP:
+			// Nothing to attach, only the fingerprint is known. Skip.
+		case missing[i]:
+			ent.Key = m.Key
+			ent.Value = m.Value
+			ent.ContentType = m.ContentType
+			miss = append(miss, ent)
+		case onlyFP || (ent.Key == m.Key && bytes.Equal(ent.Value, m.Value)):
+			exist = append(exist, ent)
+		default:
+			return nil, nil, errors.Reason("metadata with key %q collides with an existing entry with fingerprint %q, refusing to touch it", m.Key, ent.Fingerprint).
+				Tag(grpcutil.InternalTag).Err()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,239 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/gae/service/datastore"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestInstanceMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With datastore", t, func() {
+		digest := strings.Repeat("a", 40)
+
+		ctx, tc, as := testutil.TestingContext()
+
+		putInst := func(pkg, iid string, pendingProcs []string) *Instance {
+			inst := &Instance{
+				InstanceID:        iid,
+				Package:           PackageKey(ctx, pkg),
+				ProcessorsPending: pendingProcs,
+			}
+			So(datastore.Put(ctx, &Package{Name: pkg}, inst), ShouldBeNil)
+			return inst
+		}
+
+		md := func(kv ...string) []*api.InstanceMetadata {
+			out := make([]*api.InstanceMetadata, len(kv))
+			for i, s := range kv {
+				chunks := strings.SplitN(s, ":", 2)
+				out[i] = &api.InstanceMetadata{
+					Key:   chunks[0],
+					Value: []byte(chunks[1]),
+				}
+			}
+			return out
+		}
+
+		fp := func(kv string) string {
+			chunks := strings.SplitN(kv, ":", 2)
+			return common.InstanceMetadataFingerprint(chunks[0], []byte(chunks[1]))
+		}
+
+		getMD := func(inst *Instance, kv string) *InstanceMetadata {
+			ent := &InstanceMetadata{
+				Fingerprint: fp(kv),
+				Instance:    datastore.KeyForObj(ctx, inst),
+			}
+			err := datastore.Get(ctx, ent)
+			if err == datastore.ErrNoSuchEntity {
+				return nil
+			}
+			So(err, ShouldBeNil)
+			return ent
+		}
+
+		listKeys := func(inst *Instance, keys ...string) []string {
+			ents, err := ListMetadata(ctx, inst, keys)
+			So(err, ShouldBeNil)
+			out := make([]string, len(ents))
+			for i, e := range ents {
+				out[i] = e.Key + ":" + string(e.Value)
+			}
+			return out
+		}
+
+		Convey("Proto() works", func() {
+			inst := putInst("pkg", digest, nil)
+			ent := &InstanceMetadata{
+				Fingerprint: fp("k:v"),
+				Instance:    datastore.KeyForObj(ctx, inst),
+				Key:         "k",
+				Value:       []byte("v"),
+				ContentType: "text/plain",
+				AttachedBy:  string(testutil.TestUser),
+				AttachedTs:  testutil.TestTime,
+			}
+			So(ent.Proto(), ShouldResembleProto, &api.InstanceMetadata{
+				Key:         "k",
+				Value:       []byte("v"),
+				ContentType: "text/plain",
+				Fingerprint: fp("k:v"),
+				AttachedBy:  string(testutil.TestUser),
+				AttachedTs:  google.NewTimestamp(testutil.TestTime),
+			})
+		})
+
+		Convey("AttachMetadata happy paths", func() {
+			inst := putInst("pkg", digest, nil)
+
+			So(AttachMetadata(ctx, inst, []*api.InstanceMetadata{
+				{Key: "a", Value: []byte("0"), ContentType: "text/plain"},
+			}), ShouldBeNil)
+			So(getMD(inst, "a:0"), ShouldResemble, &InstanceMetadata{
+				Fingerprint: fp("a:0"),
+				Instance:    datastore.KeyForObj(ctx, inst),
+				Key:         "a",
+				Value:       []byte("0"),
+				ContentType: "text/plain",
+				AttachedBy:  string(testutil.TestUser),
+				AttachedTs:  testutil.TestTime,
+			})
+
+			// Reattaching existing ones is noop, new ones are added.
+			tc.Add(time.Second)
+			So(AttachMetadata(as("def@example.com"), inst, md("a:0", "a:1", "a:1")), ShouldBeNil)
+			So(getMD(inst, "a:0").AttachedBy, ShouldEqual, string(testutil.TestUser))
+			So(getMD(inst, "a:1").AttachedBy, ShouldEqual, "user:def@example.com")
+
+			So(GetEvents(ctx), ShouldResembleProto, []*api.Event{
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdFingerprint: fp("a:1"),
+					Who:           "user:def@example.com",
+					When:          google.NewTimestamp(testutil.TestTime.Add(time.Second)),
+				},
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_ATTACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdContentType: "text/plain",
+					MdFingerprint: fp("a:0"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime),
+				},
+			})
+		})
+
+		Convey("AttachMetadata to not ready instance", func() {
+			inst := putInst("pkg", digest, []string{"proc"})
+			err := AttachMetadata(ctx, inst, md("a:0"))
+			So(grpcutil.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(getMD(inst, "a:0"), ShouldBeNil)
+		})
+
+		Convey("DetachMetadata happy paths", func() {
+			inst := putInst("pkg", digest, nil)
+			So(AttachMetadata(ctx, inst, md("a:0", "a:1", "a:2")), ShouldBeNil)
+
+			// By key and value.
+			tc.Add(time.Second)
+			So(DetachMetadata(ctx, inst, md("a:0", "a:missing")), ShouldBeNil)
+			So(getMD(inst, "a:0"), ShouldBeNil)
+
+			// By fingerprint.
+			tc.Add(time.Second)
+			So(DetachMetadata(ctx, inst, []*api.InstanceMetadata{
+				{Fingerprint: fp("a:1")},
+				{Fingerprint: fp("a:missing")},
+			}), ShouldBeNil)
+			So(getMD(inst, "a:1"), ShouldBeNil)
+			So(getMD(inst, "a:2"), ShouldNotBeNil)
+
+			So(GetEvents(ctx)[:2], ShouldResembleProto, []*api.Event{
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_DETACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdFingerprint: fp("a:1"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime.Add(2 * time.Second)),
+				},
+				{
+					Kind:          api.EventKind_INSTANCE_METADATA_DETACHED,
+					Package:       "pkg",
+					Instance:      digest,
+					MdKey:         "a",
+					MdFingerprint: fp("a:0"),
+					Who:           string(testutil.TestUser),
+					When:          google.NewTimestamp(testutil.TestTime.Add(time.Second)),
+				},
+			})
+		})
+
+		Convey("Handles fingerprint collisions", func() {
+			inst := putInst("pkg", digest, nil)
+
+			// Forcefully construct a collision.
+			So(datastore.Put(ctx, &InstanceMetadata{
+				Fingerprint: fp("a:0"),
+				Instance:    datastore.KeyForObj(ctx, inst),
+				Key:         "a",
+				Value:       []byte("something else"),
+			}), ShouldBeNil)
+
+			err := AttachMetadata(ctx, inst, md("a:0"))
+			So(grpcutil.Code(err), ShouldEqual, codes.Internal)
+			So(err, ShouldErrLike, "collides with an existing entry")
+
+			err = DetachMetadata(ctx, inst, md("a:0"))
+			So(grpcutil.Code(err), ShouldEqual, codes.Internal)
+		})
+
+		Convey("ListMetadata works", func() {
+			inst := putInst("pkg", digest, nil)
+			So(listKeys(inst), ShouldHaveLength, 0)
+
+			So(AttachMetadata(ctx, inst, md("b:0", "a:0")), ShouldBeNil)
+			tc.Add(time.Second)
+			So(AttachMetadata(ctx, inst, md("b:1", "c:0")), ShouldBeNil)
+
+			So(listKeys(inst), ShouldResemble, []string{"a:0", "b:1", "b:0", "c:0"})
+			So(listKeys(inst, "b", "c"), ShouldResemble, []string{"b:1", "b:0", "c:0"})
+			So(listKeys(inst, "zzz"), ShouldHaveLength, 0)
+		})
+	})
+}
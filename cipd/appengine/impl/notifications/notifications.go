@@ -0,0 +1,307 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notifications implements sending notifications about CIPD events to
+// webhooks and Cloud PubSub topics configured in the prefix metadata.
+//
+// Notifications are sent asynchronously via two kinds of tasks:
+//   * NotifyEvents is enqueued transactionally when events are emitted. It
+//     looks up EventNotification configs that apply to the events and enqueues
+//     a DeliverNotification task per (event, target) pair.
+//   * DeliverNotification actually sends a single event to a single target,
+//     retrying on transient errors.
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context/ctxhttp"
+
+	"go.chromium.org/luci/appengine/tq"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/auth"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/metadata"
+	"go.chromium.org/luci/cipd/appengine/impl/notifications/tasks"
+	"go.chromium.org/luci/cipd/appengine/impl/settings"
+)
+
+// pubsubScopes are OAuth scopes used when publishing notifications to PubSub.
+var pubsubScopes = []string{"https://www.googleapis.com/auth/pubsub"}
+
+// signatureHeader is a header with HMAC-SHA256 of a webhook request body.
+const signatureHeader = "X-CIPD-Signature"
+
+// Notifier sends notifications about events to targets configured in the
+// prefix metadata.
+type Notifier struct {
+	tq   *tq.Dispatcher
+	meta metadata.Storage
+
+	// Mocking points for tests. See New() for the real implementation.
+	//
+	// 'transport' returns an unauthenticated transport if 'scopes' is empty.
+	transport    func(ctx context.Context, scopes []string) (http.RoundTripper, error)
+	topicAllowed func(ctx context.Context, topic string) (bool, error)
+}
+
+// New returns a Notifier that uses the given dispatcher to enqueue tasks.
+//
+// Registers its tasks in the dispatcher.
+func New(d *tq.Dispatcher) *Notifier {
+	n := &Notifier{
+		tq:   d,
+		meta: metadata.GetStorage(),
+		transport: func(ctx context.Context, scopes []string) (http.RoundTripper, error) {
+			if len(scopes) == 0 {
+				return auth.GetRPCTransport(ctx, auth.NoAuth)
+			}
+			return auth.GetRPCTransport(ctx, auth.AsSelf, auth.WithScopes(scopes...))
+		},
+		topicAllowed: func(ctx context.Context, topic string) (bool, error) {
+			cfg, err := settings.Get(ctx)
+			if err != nil {
+				return false, err
+			}
+			return cfg.PubSubTopicAllowed(topic), nil
+		},
+	}
+	n.registerTasks()
+	return n
+}
+
+// registerTasks adds tasks to the tq Dispatcher.
+func (n *Notifier) registerTasks() {
+	// See queue.yaml for "event-notifications" task queue definition.
+	n.tq.RegisterTask(&tasks.NotifyEvents{}, func(ctx context.Context, m proto.Message) error {
+		return n.notifyEventsTask(ctx, m.(*tasks.NotifyEvents))
+	}, "event-notifications", nil)
+	n.tq.RegisterTask(&tasks.DeliverNotification{}, func(ctx context.Context, m proto.Message) error {
+		return n.deliverNotificationTask(ctx, m.(*tasks.DeliverNotification))
+	}, "event-notifications", nil)
+}
+
+// Notify enqueues a task to send notifications about the given events.
+//
+// If called within a transaction, the task is enqueued transactionally. Used
+// as model.EventsNotifier.
+func (n *Notifier) Notify(ctx context.Context, ev []*api.Event) error {
+	if len(ev) == 0 {
+		return nil
+	}
+	return n.tq.AddTask(ctx, &tq.Task{
+		Payload: &tasks.NotifyEvents{Events: ev},
+		Title:   fmt.Sprintf("%s-%d", ev[0].Kind, len(ev)),
+	})
+}
+
+// notifyEventsTask enqueues DeliverNotification tasks for the given events.
+func (n *Notifier) notifyEventsTask(ctx context.Context, t *tasks.NotifyEvents) error {
+	// Events emitted by a single transaction usually relate to a single package.
+	metas := map[string][]*api.PrefixMetadata{}
+
+	var out []*tq.Task
+	for _, ev := range t.Events {
+		md, ok := metas[ev.Package]
+		if !ok {
+			var err error
+			if md, err = n.meta.GetMetadata(ctx, ev.Package); err != nil {
+				return errors.Annotate(err, "failed to fetch metadata of %q", ev.Package).Err()
+			}
+			metas[ev.Package] = md
+		}
+		for _, target := range targets(ev, md) {
+			out = append(out, &tq.Task{
+				// Make sure retries of NotifyEvents don't produce duplicates.
+				DeduplicationKey: fmt.Sprintf("%s:%s:%d:%s",
+					ev.Kind, ev.Package, google.TimeFromProto(ev.When).UnixNano(), targetKey(target)),
+				Payload: &tasks.DeliverNotification{Event: ev, Target: target},
+				Title:   ev.Kind.String(),
+			})
+		}
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+	logging.Infof(ctx, "Enqueuing %d notifications", len(out))
+	return n.tq.AddTask(ctx, out...)
+}
+
+// deliverNotificationTask sends a notification about an event to the target.
+func (n *Notifier) deliverNotificationTask(ctx context.Context, t *tasks.DeliverNotification) error {
+	body, err := (&jsonpb.Marshaler{}).MarshalToString(t.Event)
+	if err != nil {
+		return errors.Annotate(err, "failed to serialize the event").Err()
+	}
+
+	// Webhooks are called anonymously, so that the service credentials are not
+	// sent to arbitrary URLs. PubSub topics are checked against the allowlist,
+	// since publishing is done using the service credentials.
+	var req *http.Request
+	var scopes []string
+	switch target := t.Target.Target.(type) {
+	case *api.EventNotification_WebhookUrl:
+		req, err = http.NewRequest("POST", target.WebhookUrl, bytes.NewReader([]byte(body)))
+		if err == nil {
+			req.Header.Set(signatureHeader, signature(t.Target.WebhookSecret, body))
+		}
+	case *api.EventNotification_PubsubTopic:
+		switch allowed, err := n.topicAllowed(ctx, target.PubsubTopic); {
+		case err != nil:
+			return errors.Annotate(err, "failed to check whether the topic is allowed").Tag(transient.Tag).Err()
+		case !allowed:
+			// Misconfigured target. Retrying won't help, give up.
+			logging.Errorf(ctx, "Dropping the notification, %s is not in an allowed project", targetKey(t.Target))
+			return nil
+		}
+		scopes = pubsubScopes
+		req, err = pubsubRequest(target.PubsubTopic, t.Event, body)
+	default:
+		return errors.Reason("unrecognized notification target %v", t.Target).Err()
+	}
+	if err != nil {
+		return errors.Annotate(err, "failed to prepare the request").Err()
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	tr, err := n.transport(ctx, scopes)
+	if err != nil {
+		return errors.Annotate(err, "failed to get the transport").Tag(transient.Tag).Err()
+	}
+	resp, err := ctxhttp.Do(ctx, &http.Client{Transport: tr}, req)
+	if err != nil {
+		return errors.Annotate(err, "failed to send the notification to %s", targetKey(t.Target)).Tag(transient.Tag).Err()
+	}
+	defer resp.Body.Close()
+	reply, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return errors.Reason("%s replied with HTTP %d: %s", targetKey(t.Target), resp.StatusCode, reply).Tag(transient.Tag).Err()
+	default:
+		// Misconfigured target. Retrying won't help, give up.
+		logging.Errorf(ctx, "Dropping the notification, %s replied with HTTP %d: %s", targetKey(t.Target), resp.StatusCode, reply)
+		return nil
+	}
+}
+
+// signature returns a value of X-CIPD-Signature header for the webhook request
+// body signed with the given secret.
+func signature(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// pubsubRequest prepares a request that publishes the event to a topic.
+func pubsubRequest(topic string, ev *api.Event, body string) (*http.Request, error) {
+	type message struct {
+		Data       string            `json:"data"`
+		Attributes map[string]string `json:"attributes"`
+	}
+	attrs := map[string]string{
+		"kind":    ev.Kind.String(),
+		"package": ev.Package,
+	}
+	if ev.Ref != "" {
+		attrs["ref"] = ev.Ref
+	}
+	blob, err := json.Marshal(map[string][]message{
+		"messages": {{
+			Data:       base64.StdEncoding.EncodeToString([]byte(body)),
+			Attributes: attrs,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return http.NewRequest("POST", "https://pubsub.googleapis.com/v1/"+topic+":publish", bytes.NewReader(blob))
+}
+
+// targets returns targets that should be notified about the event.
+//
+// 'metas' is metadata of the event's package and all its parent prefixes, as
+// returned by metadata.Storage. Duplicate targets are skipped.
+func targets(ev *api.Event, metas []*api.PrefixMetadata) (out []*api.EventNotification) {
+	seen := map[string]bool{}
+	for _, md := range metas {
+		for _, n := range md.Notifications {
+			if key := targetKey(n); !seen[key] && matches(n, ev) {
+				seen[key] = true
+				out = append(out, n)
+			}
+		}
+	}
+	return
+}
+
+// matches is true if the notification config wants to know about the event.
+func matches(n *api.EventNotification, ev *api.Event) bool {
+	if len(n.Kinds) != 0 && !containsKind(n.Kinds, ev.Kind) {
+		return false
+	}
+	if len(n.Refs) != 0 && (ev.Ref == "" || !containsStr(n.Refs, ev.Ref)) {
+		return false
+	}
+	return true
+}
+
+// targetKey returns a string identifying where the notification is sent to.
+func targetKey(n *api.EventNotification) string {
+	switch t := n.Target.(type) {
+	case *api.EventNotification_WebhookUrl:
+		return "webhook " + t.WebhookUrl
+	case *api.EventNotification_PubsubTopic:
+		return "pubsub " + t.PubsubTopic
+	default:
+		return "unknown"
+	}
+}
+
+func containsKind(kinds []api.EventKind, k api.EventKind) bool {
+	for _, kind := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+func containsStr(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+	return false
+}
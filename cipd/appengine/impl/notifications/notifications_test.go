@@ -0,0 +1,206 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"go.chromium.org/luci/common/retry/transient"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/notifications/tasks"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTargets(t *testing.T) {
+	t.Parallel()
+
+	Convey("Picks matching targets", t, func() {
+		hook := func(url string) *api.EventNotification {
+			return &api.EventNotification{
+				Target: &api.EventNotification_WebhookUrl{WebhookUrl: url},
+			}
+		}
+
+		stable := hook("https://example.com/stable")
+		stable.Kinds = []api.EventKind{api.EventKind_INSTANCE_REF_SET}
+		stable.Refs = []string{"stable"}
+
+		all := hook("https://example.com/all")
+
+		metas := []*api.PrefixMetadata{
+			{Prefix: "a", Notifications: []*api.EventNotification{all}},
+			{Prefix: "a/b", Notifications: []*api.EventNotification{stable, all}},
+		}
+
+		So(targets(&api.Event{
+			Kind:    api.EventKind_INSTANCE_REF_SET,
+			Package: "a/b",
+			Ref:     "stable",
+		}, metas), ShouldResembleProto, []*api.EventNotification{all, stable})
+
+		So(targets(&api.Event{
+			Kind:    api.EventKind_INSTANCE_REF_SET,
+			Package: "a/b",
+			Ref:     "latest",
+		}, metas), ShouldResembleProto, []*api.EventNotification{all})
+
+		So(targets(&api.Event{
+			Kind:    api.EventKind_INSTANCE_TAG_ATTACHED,
+			Package: "a/b",
+			Tag:     "k:v",
+		}, metas), ShouldResembleProto, []*api.EventNotification{all})
+	})
+}
+
+func TestDelivery(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fake server", t, func() {
+		ctx := context.Background()
+
+		var status int
+		var lastBody []byte
+		var lastSignature string
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			lastBody, _ = ioutil.ReadAll(r.Body)
+			lastSignature = r.Header.Get("X-CIPD-Signature")
+			rw.WriteHeader(status)
+		}))
+		defer srv.Close()
+
+		var lastScopes []string
+		var published []*http.Request
+		n := &Notifier{
+			transport: func(_ context.Context, scopes []string) (http.RoundTripper, error) {
+				lastScopes = scopes
+				if len(scopes) == 0 {
+					return http.DefaultTransport, nil
+				}
+				return roundTripper(func(r *http.Request) (*http.Response, error) {
+					published = append(published, r)
+					return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+				}), nil
+			},
+			topicAllowed: func(_ context.Context, topic string) (bool, error) {
+				return topic == "projects/p/topics/allowed", nil
+			},
+		}
+
+		ev := &api.Event{
+			Kind:    api.EventKind_INSTANCE_REF_SET,
+			Package: "a/b",
+			Ref:     "stable",
+		}
+		task := &tasks.DeliverNotification{
+			Event: ev,
+			Target: &api.EventNotification{
+				Target:        &api.EventNotification_WebhookUrl{WebhookUrl: srv.URL},
+				WebhookSecret: "0123456789abcdef",
+			},
+		}
+
+		Convey("Success", func() {
+			status = http.StatusOK
+			So(n.deliverNotificationTask(ctx, task), ShouldBeNil)
+			got := &api.Event{}
+			So(jsonpb.UnmarshalString(string(lastBody), got), ShouldBeNil)
+			So(got, ShouldResembleProto, ev)
+
+			// Sent anonymously, but signed.
+			So(lastScopes, ShouldBeEmpty)
+			mac := hmac.New(sha256.New, []byte("0123456789abcdef"))
+			mac.Write(lastBody)
+			So(lastSignature, ShouldEqual, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		})
+
+		Convey("Transient error", func() {
+			status = http.StatusInternalServerError
+			err := n.deliverNotificationTask(ctx, task)
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Fatal error is ignored", func() {
+			status = http.StatusForbidden
+			So(n.deliverNotificationTask(ctx, task), ShouldBeNil)
+		})
+
+		Convey("Allowed PubSub topic", func() {
+			task.Target = &api.EventNotification{
+				Target: &api.EventNotification_PubsubTopic{PubsubTopic: "projects/p/topics/allowed"},
+			}
+			So(n.deliverNotificationTask(ctx, task), ShouldBeNil)
+			So(lastScopes, ShouldResemble, pubsubScopes)
+			So(published, ShouldHaveLength, 1)
+			So(published[0].URL.String(), ShouldEqual, "https://pubsub.googleapis.com/v1/projects/p/topics/allowed:publish")
+		})
+
+		Convey("Disallowed PubSub topic", func() {
+			task.Target = &api.EventNotification{
+				Target: &api.EventNotification_PubsubTopic{PubsubTopic: "projects/evil/topics/t"},
+			}
+			So(n.deliverNotificationTask(ctx, task), ShouldBeNil)
+			So(lastScopes, ShouldBeNil)
+			So(published, ShouldBeEmpty)
+		})
+	})
+
+	Convey("PubSub request", t, func() {
+		ev := &api.Event{
+			Kind:    api.EventKind_INSTANCE_REF_SET,
+			Package: "a/b",
+			Ref:     "stable",
+		}
+		req, err := pubsubRequest("projects/p/topics/t", ev, "{}")
+		So(err, ShouldBeNil)
+		So(req.URL.String(), ShouldEqual, "https://pubsub.googleapis.com/v1/projects/p/topics/t:publish")
+
+		body := struct {
+			Messages []struct {
+				Data       string            `json:"data"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"messages"`
+		}{}
+		blob, _ := ioutil.ReadAll(req.Body)
+		So(json.Unmarshal(blob, &body), ShouldBeNil)
+		So(body.Messages, ShouldHaveLength, 1)
+		So(body.Messages[0].Data, ShouldEqual, base64.StdEncoding.EncodeToString([]byte("{}")))
+		So(body.Messages[0].Attributes, ShouldResemble, map[string]string{
+			"kind":    "INSTANCE_REF_SET",
+			"package": "a/b",
+			"ref":     "stable",
+		})
+	})
+}
+
+type roundTripper func(*http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
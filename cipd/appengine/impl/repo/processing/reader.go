@@ -19,6 +19,8 @@ import (
 	"io"
 	"math"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.chromium.org/luci/common/errors"
 )
 
@@ -41,9 +43,15 @@ func NewPackageReader(r io.ReaderAt, size int64) (*PackageReader, error) {
 		// in case of transient Google Storage errors.
 		return nil, err
 	}
+	// Packages built with '-compression-algo zstd' use zstd for all files
+	// (except the manifest).
+	zr.RegisterDecompressor(zstd.ZipMethodWinZip, zstdDecompressor)
 	return &PackageReader{zr}, nil
 }
 
+// zstdDecompressor is shared by all readers to reuse zstd decoders.
+var zstdDecompressor = zstd.ZipDecompressor()
+
 // Open opens some file inside the package for reading.
 //
 // Returns the ReadCloser and the uncompressed file size.
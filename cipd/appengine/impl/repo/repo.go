@@ -896,6 +896,69 @@ func (impl *repoImpl) SearchInstances(c context.Context, r *api.SearchInstancesR
 	}, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Events log.
+
+// ListEvents implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListEvents(c context.Context, r *api.ListEventsRequest) (resp *api.ListEventsResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request, decode the cursor.
+	r.Prefix, err = common.ValidatePackagePrefix(r.Prefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix' - %s", err)
+	}
+	kinds := make(map[api.EventKind]bool, len(r.Kinds))
+	for _, k := range r.Kinds {
+		if k == api.EventKind_EVENT_KIND_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'kinds' - EVENT_KIND_UNSPECIFIED is not allowed")
+		}
+		kinds[k] = true
+	}
+	switch {
+	case r.PageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'page_size' %d - it should be non-negative", r.PageSize)
+	case r.PageSize == 0:
+		r.PageSize = 100
+	}
+	var cursor datastore.Cursor
+	if r.PageToken != "" {
+		if cursor, err = datastore.DecodeCursor(c, r.PageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'page_token' - %s", err)
+		}
+	}
+
+	// Check ACLs. Only owners can see how ACLs change.
+	metas, err := impl.checkRole(c, r.Prefix, api.Role_READER)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := hasRole(c, metas, api.Role_OWNER)
+	if err != nil {
+		return nil, err
+	}
+
+	events, cursor, err := model.ListEvents(c, r.Prefix, func(e *model.Event) bool {
+		switch {
+		case e.Kind == api.EventKind_PREFIX_ACL_CHANGED && !owner:
+			return false
+		case len(kinds) != 0 && !kinds[e.Kind]:
+			return false
+		default:
+			return true
+		}
+	}, r.PageSize, cursor)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to query events").Err()
+	}
+
+	resp = &api.ListEventsResponse{Events: events}
+	if cursor != nil {
+		resp.NextPageToken = cursor.String()
+	}
+	return resp, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Refs support.
 
@@ -1073,6 +1136,147 @@ func (impl *repoImpl) DetachTags(c context.Context, r *api.DetachTagsRequest) (r
 	return &empty.Empty{}, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata support.
+
+func validateMetadataList(md []*api.InstanceMetadata, attaching bool) error {
+	if len(md) == 0 {
+		return status.Errorf(codes.InvalidArgument, "bad 'metadata' - cannot be empty")
+	}
+	for _, m := range md {
+		if !attaching && m.Fingerprint != "" {
+			if err := common.ValidateInstanceMetadataFingerprint(m.Fingerprint); err != nil {
+				return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+			}
+			continue
+		}
+		if attaching && m.Fingerprint != "" {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - 'fingerprint' is output only")
+		}
+		if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+		if err := common.ValidateInstanceMetadataLen(len(m.Value)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+		if err := common.ValidateContentType(m.ContentType); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad metadata in 'metadata' - %s", err)
+		}
+	}
+	return nil
+}
+
+func validateMultiMetadataReq(pkg string, inst *api.ObjectRef, md []*api.InstanceMetadata, attaching bool) error {
+	if err := common.ValidatePackageName(pkg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(inst, common.KnownHash); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	return validateMetadataList(md, attaching)
+}
+
+// AttachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) AttachMetadata(c context.Context, r *api.AttachMetadataRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := validateMultiMetadataReq(r.Package, r.Instance, r.Metadata, true); err != nil {
+		return nil, err
+	}
+
+	// Check ACLs.
+	if _, err := impl.checkRole(c, r.Package, api.Role_WRITER); err != nil {
+		return nil, err
+	}
+
+	// Actually attach the metadata. This will also transactionally check the
+	// instance exists and it has passed the processing successfully.
+	inst := &model.Instance{
+		InstanceID: common.ObjectRefToInstanceID(r.Instance),
+		Package:    model.PackageKey(c, r.Package),
+	}
+	if err := model.AttachMetadata(c, inst, r.Metadata); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// DetachMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) DetachMetadata(c context.Context, r *api.DetachMetadataRequest) (resp *empty.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := validateMultiMetadataReq(r.Package, r.Instance, r.Metadata, false); err != nil {
+		return nil, err
+	}
+
+	// Check ACLs. Note this is scoped to OWNERS, see the proto doc.
+	if _, err := impl.checkRole(c, r.Package, api.Role_OWNER); err != nil {
+		return nil, err
+	}
+
+	// Verify the instance exists, per DetachMetadata contract.
+	inst := &model.Instance{
+		InstanceID: common.ObjectRefToInstanceID(r.Instance),
+		Package:    model.PackageKey(c, r.Package),
+	}
+	if err := model.CheckInstanceExists(c, inst); err != nil {
+		return nil, err
+	}
+
+	// Actually detach the metadata.
+	if err := model.DetachMetadata(c, inst, r.Metadata); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListMetadata implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) ListMetadata(c context.Context, r *api.ListMetadataRequest) (resp *api.ListMetadataResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(c, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package' - %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Instance, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'instance' - %s", err)
+	}
+	for _, k := range r.Keys {
+		if err := common.ValidateInstanceMetadataKey(k); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad key in 'keys' - %s", err)
+		}
+	}
+
+	// Check ACLs.
+	if _, err := impl.checkRole(c, r.Package, api.Role_READER); err != nil {
+		return nil, err
+	}
+
+	// Make sure this instance exists.
+	inst := &model.Instance{
+		InstanceID: common.ObjectRefToInstanceID(r.Instance),
+		Package:    model.PackageKey(c, r.Package),
+	}
+	if err := model.CheckInstanceExists(c, inst); err != nil {
+		return nil, err
+	}
+
+	md, err := model.ListMetadata(c, inst, r.Keys)
+	if err != nil {
+		return nil, err
+	}
+	resp = &api.ListMetadataResponse{}
+	if len(md) != 0 {
+		resp.Metadata = make([]*api.InstanceMetadata, len(md))
+		for i, m := range md {
+			resp.Metadata[i] = m.Proto()
+		}
+	}
+	return resp, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Version resolution and instance info fetching.
 
@@ -1165,6 +1369,7 @@ func (impl *repoImpl) DescribeInstance(c context.Context, r *api.DescribeInstanc
 	var refs []*model.Ref
 	var tags []*model.Tag
 	var proc []*api.Processor
+	var md []*model.InstanceMetadata
 	err = parallel.FanOutIn(func(tasks chan<- func() error) {
 		if r.DescribeRefs {
 			tasks <- func() error {
@@ -1187,6 +1392,13 @@ func (impl *repoImpl) DescribeInstance(c context.Context, r *api.DescribeInstanc
 				return errors.Annotate(err, "failed to fetch processors").Err()
 			}
 		}
+		if r.DescribeMetadata {
+			tasks <- func() error {
+				var err error
+				md, err = model.ListMetadata(c, inst, nil)
+				return errors.Annotate(err, "failed to fetch metadata").Err()
+			}
+		}
 	})
 	if err != nil {
 		return nil, err // note: this is always Internal error
@@ -1210,6 +1422,12 @@ func (impl *repoImpl) DescribeInstance(c context.Context, r *api.DescribeInstanc
 	if len(proc) != 0 {
 		resp.Processors = proc
 	}
+	if len(md) != 0 {
+		resp.Metadata = make([]*api.InstanceMetadata, len(md))
+		for i, m := range md {
+			resp.Metadata[i] = m.Proto()
+		}
+	}
 	return resp, nil
 }
 
@@ -1455,6 +1455,125 @@ func TestSearchInstances(t *testing.T) {
 ////////////////////////////////////////////////////////////////////////////////
 // Refs support.
 
+func TestListEvents(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fakes", t, func() {
+		ctx, _, as := testutil.TestingContext()
+
+		meta := testutil.MetadataStore{}
+		meta.Populate("a", &api.PrefixMetadata{
+			Acls: []*api.PrefixMetadata_ACL{
+				{
+					Role:       api.Role_READER,
+					Principals: []string{"user:reader@example.com"},
+				},
+				{
+					Role:       api.Role_OWNER,
+					Principals: []string{"user:owner@example.com"},
+				},
+			},
+		})
+
+		ev := model.Events{}
+		ev.Emit(&api.Event{Kind: api.EventKind_PREFIX_ACL_CHANGED, Package: "a"})
+		ev.Emit(&api.Event{Kind: api.EventKind_PACKAGE_CREATED, Package: "a/b"})
+		ev.Emit(&api.Event{Kind: api.EventKind_INSTANCE_REF_SET, Package: "a/b", Ref: "stable"})
+		ev.Emit(&api.Event{Kind: api.EventKind_PACKAGE_CREATED, Package: "z"})
+		So(ev.Flush(ctx), ShouldBeNil)
+		datastore.GetTestable(ctx).CatchupIndexes()
+
+		impl := repoImpl{meta: &meta}
+
+		kinds := func(evs []*api.Event) (out []string) {
+			for _, e := range evs {
+				out = append(out, e.Package+" "+e.Kind.String())
+			}
+			return
+		}
+
+		Convey("Bad prefix", func() {
+			_, err := impl.ListEvents(as("reader@example.com"), &api.ListEventsRequest{
+				Prefix: "///",
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "bad 'prefix'")
+		})
+
+		Convey("Bad kinds", func() {
+			_, err := impl.ListEvents(as("reader@example.com"), &api.ListEventsRequest{
+				Prefix: "a",
+				Kinds:  []api.EventKind{0},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "EVENT_KIND_UNSPECIFIED is not allowed")
+		})
+
+		Convey("No access", func() {
+			_, err := impl.ListEvents(as("reader@example.com"), &api.ListEventsRequest{
+				Prefix: "z",
+			})
+			So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("Readers don't see ACL changes", func() {
+			res, err := impl.ListEvents(as("reader@example.com"), &api.ListEventsRequest{
+				Prefix: "a",
+			})
+			So(err, ShouldBeNil)
+			So(kinds(res.Events), ShouldResemble, []string{
+				"a/b INSTANCE_REF_SET",
+				"a/b PACKAGE_CREATED",
+			})
+		})
+
+		Convey("Owners see ACL changes", func() {
+			res, err := impl.ListEvents(as("owner@example.com"), &api.ListEventsRequest{
+				Prefix: "a",
+			})
+			So(err, ShouldBeNil)
+			So(kinds(res.Events), ShouldResemble, []string{
+				"a/b INSTANCE_REF_SET",
+				"a/b PACKAGE_CREATED",
+				"a PREFIX_ACL_CHANGED",
+			})
+		})
+
+		Convey("Filtering by kind", func() {
+			res, err := impl.ListEvents(as("reader@example.com"), &api.ListEventsRequest{
+				Prefix: "a/b",
+				Kinds:  []api.EventKind{api.EventKind_INSTANCE_REF_SET},
+			})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+			So(res.Events[0].Ref, ShouldEqual, "stable")
+		})
+
+		Convey("Pagination", func() {
+			res, err := impl.ListEvents(as("owner@example.com"), &api.ListEventsRequest{
+				Prefix:   "a",
+				PageSize: 2,
+			})
+			So(err, ShouldBeNil)
+			So(kinds(res.Events), ShouldResemble, []string{
+				"a/b INSTANCE_REF_SET",
+				"a/b PACKAGE_CREATED",
+			})
+			So(res.NextPageToken, ShouldNotEqual, "")
+
+			res, err = impl.ListEvents(as("owner@example.com"), &api.ListEventsRequest{
+				Prefix:    "a",
+				PageSize:  2,
+				PageToken: res.NextPageToken,
+			})
+			So(err, ShouldBeNil)
+			So(kinds(res.Events), ShouldResemble, []string{
+				"a PREFIX_ACL_CHANGED",
+			})
+		})
+	})
+}
+
 func TestRefs(t *testing.T) {
 	t.Parallel()
 
@@ -1939,6 +2058,267 @@ func TestTags(t *testing.T) {
 	})
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata support.
+
+func TestInstanceMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With fakes", t, func() {
+		ctx, _, as := testutil.TestingContext()
+
+		meta := testutil.MetadataStore{}
+		meta.Populate("a", &api.PrefixMetadata{
+			Acls: []*api.PrefixMetadata_ACL{
+				{
+					Role:       api.Role_READER,
+					Principals: []string{"user:reader@example.com"},
+				},
+				{
+					Role:       api.Role_WRITER,
+					Principals: []string{"user:writer@example.com"},
+				},
+				{
+					Role:       api.Role_OWNER,
+					Principals: []string{"user:owner@example.com"},
+				},
+			},
+		})
+
+		putInst := func(pkg, iid string, pendingProcs, failedProcs []string) *model.Instance {
+			inst := &model.Instance{
+				InstanceID:        iid,
+				Package:           model.PackageKey(ctx, pkg),
+				ProcessorsPending: pendingProcs,
+				ProcessorsFailure: failedProcs,
+			}
+			So(datastore.Put(ctx, &model.Package{Name: pkg}, inst), ShouldBeNil)
+			return inst
+		}
+
+		md := func(kv ...string) []*api.InstanceMetadata {
+			out := make([]*api.InstanceMetadata, len(kv))
+			for i, s := range kv {
+				chunks := strings.SplitN(s, ":", 2)
+				out[i] = &api.InstanceMetadata{
+					Key:   chunks[0],
+					Value: []byte(chunks[1]),
+				}
+			}
+			return out
+		}
+
+		digest := strings.Repeat("a", 40)
+		putInst("a/b/c", digest, nil, nil)
+		objRef := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA1,
+			HexDigest: digest,
+		}
+
+		impl := repoImpl{meta: &meta}
+
+		list := func(keys ...string) []*api.InstanceMetadata {
+			resp, err := impl.ListMetadata(as("reader@example.com"), &api.ListMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+				Keys:     keys,
+			})
+			So(err, ShouldBeNil)
+			return resp.Metadata
+		}
+
+		Convey("Attach/List/Detach happy path", func() {
+			_, err := impl.AttachMetadata(as("writer@example.com"), &api.AttachMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+				Metadata: []*api.InstanceMetadata{
+					{Key: "a", Value: []byte("0"), ContentType: "text/plain"},
+					{Key: "b", Value: []byte("1")},
+				},
+			})
+			So(err, ShouldBeNil)
+
+			all := list()
+			So(all, ShouldResembleProto, []*api.InstanceMetadata{
+				{
+					Key:         "a",
+					Value:       []byte("0"),
+					ContentType: "text/plain",
+					Fingerprint: common.InstanceMetadataFingerprint("a", []byte("0")),
+					AttachedBy:  "user:writer@example.com",
+					AttachedTs:  google.NewTimestamp(testutil.TestTime),
+				},
+				{
+					Key:         "b",
+					Value:       []byte("1"),
+					Fingerprint: common.InstanceMetadataFingerprint("b", []byte("1")),
+					AttachedBy:  "user:writer@example.com",
+					AttachedTs:  google.NewTimestamp(testutil.TestTime),
+				},
+			})
+			So(list("b"), ShouldResembleProto, all[1:])
+
+			// Detaching requires OWNER.
+			_, err = impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+				Metadata: []*api.InstanceMetadata{
+					{Fingerprint: all[0].Fingerprint},
+					{Key: "b", Value: []byte("1")},
+					{Key: "c", Value: []byte("missing")},
+				},
+			})
+			So(err, ShouldBeNil)
+			So(list(), ShouldHaveLength, 0)
+		})
+
+		Convey("Bad package", func() {
+			_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+				Package:  "a/b///",
+				Instance: objRef,
+				Metadata: md("a:0"),
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "bad 'package'")
+		})
+
+		Convey("Bad ObjectRef", func() {
+			_, err := impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+				Package:  "a/b/c",
+				Metadata: md("a:0"),
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "bad 'instance'")
+		})
+
+		Convey("Empty metadata list", func() {
+			_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+				Package:  "a/b/c",
+				Instance: objRef,
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(err, ShouldErrLike, "cannot be empty")
+		})
+
+		Convey("Bad metadata", func() {
+			Convey("Bad key", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: md("BAD:0"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "invalid metadata key")
+			})
+			Convey("Too large", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{
+						{Key: "k", Value: make([]byte, common.MetadataMaxLen+1)},
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "too long")
+			})
+			Convey("Bad content type", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{
+						{Key: "k", ContentType: "zzz/"},
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "bad content type")
+			})
+			Convey("Fingerprint when attaching", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{
+						{Key: "k", Fingerprint: strings.Repeat("a", 32)},
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "output only")
+			})
+			Convey("Bad fingerprint when detaching", func() {
+				_, err := impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{
+						{Fingerprint: "zzz"},
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(err, ShouldErrLike, "bad metadata fingerprint")
+			})
+		})
+
+		Convey("No access", func() {
+			Convey("AttachMetadata", func() {
+				_, err := impl.AttachMetadata(as("reader@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: md("a:0"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+				So(err, ShouldErrLike, "has no required WRITER role")
+			})
+			Convey("DetachMetadata", func() {
+				_, err := impl.DetachMetadata(as("writer@example.com"), &api.DetachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+					Metadata: md("a:0"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+				So(err, ShouldErrLike, "has no required OWNER role")
+			})
+			Convey("ListMetadata", func() {
+				_, err := impl.ListMetadata(as("someone@example.com"), &api.ListMetadataRequest{
+					Package:  "a/b/c",
+					Instance: objRef,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+			})
+		})
+
+		Convey("Missing instance", func() {
+			missingRef := &api.ObjectRef{
+				HashAlgo:  api.HashAlgo_SHA1,
+				HexDigest: strings.Repeat("b", 40),
+			}
+			Convey("AttachMetadata", func() {
+				_, err := impl.AttachMetadata(as("owner@example.com"), &api.AttachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: missingRef,
+					Metadata: md("a:0"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				So(err, ShouldErrLike, "no such instance")
+			})
+			Convey("DetachMetadata", func() {
+				_, err := impl.DetachMetadata(as("owner@example.com"), &api.DetachMetadataRequest{
+					Package:  "a/b/c",
+					Instance: missingRef,
+					Metadata: md("a:0"),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				So(err, ShouldErrLike, "no such instance")
+			})
+			Convey("ListMetadata", func() {
+				_, err := impl.ListMetadata(as("owner@example.com"), &api.ListMetadataRequest{
+					Package:  "a/b/c",
+					Instance: missingRef,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				So(err, ShouldErrLike, "no such instance")
+			})
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Version resolution and instance info fetching.
 
@@ -2271,6 +2651,10 @@ func TestDescribeInstance(t *testing.T) {
 			model.SetRef(as("ref@example.com"), "ref_a", inst)
 			model.SetRef(as("ref@example.com"), "ref_b", inst)
 
+			model.AttachMetadata(as("md@example.com"), inst, []*api.InstanceMetadata{
+				{Key: "a", Value: []byte("0"), ContentType: "text/plain"},
+			})
+
 			inst.ProcessorsSuccess = []string{"proc"}
 			datastore.Put(ctx, inst, &model.ProcessingResult{
 				ProcID:    "proc",
@@ -2285,6 +2669,7 @@ func TestDescribeInstance(t *testing.T) {
 				DescribeTags:       true,
 				DescribeRefs:       true,
 				DescribeProcessors: true,
+				DescribeMetadata:   true,
 			})
 			So(err, ShouldBeNil)
 			So(resp, ShouldResembleProto, &api.DescribeInstanceResponse{
@@ -2326,6 +2711,16 @@ func TestDescribeInstance(t *testing.T) {
 						FinishedTs: google.NewTimestamp(testutil.TestTime),
 					},
 				},
+				Metadata: []*api.InstanceMetadata{
+					{
+						Key:         "a",
+						Value:       []byte("0"),
+						ContentType: "text/plain",
+						Fingerprint: common.InstanceMetadataFingerprint("a", []byte("0")),
+						AttachedBy:  "user:md@example.com",
+						AttachedTs:  google.NewTimestamp(testutil.TestTime),
+					},
+				},
 			})
 		})
 
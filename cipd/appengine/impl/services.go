@@ -24,6 +24,8 @@ import (
 
 	"go.chromium.org/luci/cipd/appengine/impl/admin"
 	"go.chromium.org/luci/cipd/appengine/impl/cas"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/notifications"
 	"go.chromium.org/luci/cipd/appengine/impl/repo"
 )
 
@@ -50,4 +52,12 @@ var (
 	// AdminAPI is ACL-protected implementation of cipd.AdminServer that can be
 	// exposed as an external API to be used by administrators.
 	AdminAPI = admin.AdminAPI(&TQ)
+
+	// Notifier sends notifications about events to webhooks and PubSub topics
+	// configured in the prefix metadata.
+	Notifier = notifications.New(&TQ)
 )
+
+func init() {
+	model.EventsNotifier = Notifier.Notify
+}
@@ -20,6 +20,7 @@ package settings
 
 import (
 	"context"
+	"strings"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/retry/transient"
@@ -55,6 +56,13 @@ type Settings struct {
 	// This is mostly useful on dev_server running under developer's account. It
 	// doesn't have private keys for signing, but can still call signBlob API.
 	SignAs string `json:"sign_as"`
+
+	// NotificationProjects is a list of Cloud projects with PubSub topics that
+	// event notifications can be published to.
+	//
+	// Notifications are published using the CIPD service account, so it should
+	// be used only with topics that belong to trusted projects.
+	NotificationProjects []string `json:"notification_projects"`
 }
 
 // Get returns the settings from the local cache, checking they are populated.
@@ -79,6 +87,21 @@ func (s *Settings) ObjectPath(obj *api.ObjectRef) string {
 	return s.StorageGSPath + "/" + obj.HashAlgo.String() + "/" + obj.HexDigest
 }
 
+// PubSubTopicAllowed is true if event notifications can be published to the
+// given "projects/<project>/topics/<topic>" PubSub topic.
+func (s *Settings) PubSubTopicAllowed(topic string) bool {
+	parts := strings.Split(topic, "/")
+	if len(parts) != 4 || parts[0] != "projects" || parts[2] != "topics" {
+		return false
+	}
+	for _, p := range s.NotificationProjects {
+		if p == parts[1] {
+			return true
+		}
+	}
+	return false
+}
+
 type settingsPage struct {
 	portal.BasePage
 }
@@ -131,6 +154,16 @@ func (*settingsPage) Fields(context.Context) ([]portal.Field, error) {
 				"keys for signing, but can still call signBlob API.</p>",
 			Placeholder: "<email>",
 		},
+		{
+			ID:    "NotificationProjects",
+			Title: "Notification projects",
+			Type:  portal.FieldText,
+			Help: "<p>A comma-separated list of Cloud projects with PubSub topics " +
+				"that event notifications can be published to. Notifications are " +
+				"published using the CIPD service account, so list only trusted " +
+				"projects.</p>",
+			Placeholder: "<project>,<project>",
+		},
 	}, nil
 }
 
@@ -140,17 +173,25 @@ func (*settingsPage) ReadSettings(ctx context.Context) (map[string]string, error
 		return nil, err
 	}
 	return map[string]string{
-		"StorageGSPath": c.StorageGSPath,
-		"TempGSPath":    c.TempGSPath,
-		"SignAs":        c.SignAs,
+		"StorageGSPath":        c.StorageGSPath,
+		"TempGSPath":           c.TempGSPath,
+		"SignAs":               c.SignAs,
+		"NotificationProjects": strings.Join(c.NotificationProjects, ","),
 	}, nil
 }
 
 func (*settingsPage) WriteSettings(ctx context.Context, values map[string]string, who, why string) error {
+	var projects []string
+	for _, p := range strings.Split(values["NotificationProjects"], ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			projects = append(projects, p)
+		}
+	}
 	return settings.SetIfChanged(ctx, settingsKey, &Settings{
-		StorageGSPath: values["StorageGSPath"],
-		TempGSPath:    values["TempGSPath"],
-		SignAs:        values["SignAs"],
+		StorageGSPath:        values["StorageGSPath"],
+		TempGSPath:           values["TempGSPath"],
+		SignAs:               values["SignAs"],
+		NotificationProjects: projects,
 	}, who, why)
 }
 
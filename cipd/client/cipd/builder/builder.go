@@ -27,6 +27,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/logging"
 
@@ -36,6 +38,30 @@ import (
 	"go.chromium.org/luci/cipd/common"
 )
 
+// CompressionAlgo is an algorithm used to compress files inside the package.
+type CompressionAlgo string
+
+const (
+	// Deflate is the default algorithm, understood by all clients.
+	Deflate CompressionAlgo = "deflate"
+
+	// Zstd is much faster to decompress than Deflate, but packages that use it
+	// can be installed only by clients that understand the manifest format
+	// version pkg.ManifestFormatVersionZstd.
+	Zstd CompressionAlgo = "zstd"
+)
+
+// ValidateCompressionAlgo returns an error if the algorithm is not supported.
+//
+// An empty string is also accepted and means Deflate.
+func ValidateCompressionAlgo(algo CompressionAlgo) error {
+	switch algo {
+	case "", Deflate, Zstd:
+		return nil
+	}
+	return fmt.Errorf("invalid compression algorithm %q, expecting %q or %q", algo, Deflate, Zstd)
+}
+
 // Options defines options for BuildInstance function.
 type Options struct {
 	// Input is a list of files to add to the package.
@@ -53,9 +79,17 @@ type Options struct {
 	// InstallMode defines how to install the package: "copy" or "symlink".
 	InstallMode pkg.InstallMode
 
-	// CompressionLevel defines deflate compression level in range [0-9].
+	// CompressionLevel defines compression level in range [0-9].
+	//
+	// 0 disables compression. For Zstd the levels are mapped to zstd encoder
+	// speed presets: [1-2] - fastest, [3-5] - default, [6-9] - better.
 	CompressionLevel int
 
+	// CompressionAlgo defines what algorithm to use to compress files.
+	//
+	// Default is Deflate.
+	CompressionAlgo CompressionAlgo
+
 	// HashAlgo specifies what hashing algorithm to use for computing instance ID.
 	//
 	// By default it is common.DefaultHashAlgo.
@@ -82,6 +116,12 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	if err != nil {
 		return common.Pin{}, err
 	}
+	if err := ValidateCompressionAlgo(opts.CompressionAlgo); err != nil {
+		return common.Pin{}, err
+	}
+	if opts.CompressionAlgo == "" {
+		opts.CompressionAlgo = Deflate
+	}
 
 	// Make sure hash algo is supported.
 	if opts.HashAlgo == 0 {
@@ -122,7 +162,7 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	}
 
 	// Write the final zip file, calculate its hash to use for instance ID.
-	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel); err != nil {
+	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel, opts.CompressionAlgo); err != nil {
 		return common.Pin{}, err
 	}
 	return common.Pin{
@@ -136,8 +176,8 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 
 // zipInputFiles deterministically builds a zip archive out of input files and
 // writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int) error {
-	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files), level)
+func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int, algo CompressionAlgo) error {
+	logging.Infof(ctx, "About to zip %d files with %s compression level %d", len(files), algo, level)
 
 	writer := zip.NewWriter(w)
 	defer writer.Close()
@@ -146,6 +186,16 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		return flate.NewWriter(out, level)
 	})
 
+	method := zip.Deflate
+	if algo == Zstd {
+		// Note: single-threaded encoder produces deterministic output.
+		method = pkg.ZipMethodZstd
+		writer.RegisterCompressor(method, zstd.ZipCompressor(
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+			zstd.WithEncoderConcurrency(1),
+		))
+	}
+
 	// Reports zipping progress to the log each second.
 	lastReport := time.Time{}
 	progress := func(count int) {
@@ -168,10 +218,15 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		// are zero valued. See also zip.FileInfoHeader() implementation.
 		fh := zip.FileHeader{
 			Name:   in.Name(),
-			Method: zip.Deflate,
+			Method: method,
 		}
-		if level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in) {
+		switch {
+		case level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in):
 			fh.Method = zip.Store
+		case in.Name() == pkg.ManifestName:
+			// Keep the manifest readable by any zip tool (and by old clients), so
+			// they can at least see the format version.
+			fh.Method = zip.Deflate
 		}
 
 		mode := os.FileMode(0400)
@@ -348,6 +403,9 @@ func makeManifestFile(opts Options) (fs.File, error) {
 		return nil, err
 	}
 	formatVer := pkg.ManifestFormatVersion
+	if opts.CompressionAlgo == Zstd && opts.CompressionLevel != 0 {
+		formatVer = pkg.ManifestFormatVersionZstd
+	}
 	if opts.OverrideFormatVersion != "" {
 		formatVer = opts.OverrideFormatVersion
 	}
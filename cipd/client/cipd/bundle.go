@@ -0,0 +1,180 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cipd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// A bundle is a self-contained directory with everything needed to install
+// packages from some ensure file without talking to the backend. It is produced
+// by 'cipd bundle-export' and consumed by 'cipd ensure -bundle'.
+//
+// The bundle directory has the following layout:
+//   bundle.json - BundleManifest with the list of instances in the bundle.
+//   ensure.txt - the ensure file, with $ResolvedVersions pointing to versions.txt.
+//   versions.txt - resolved versions of all packages in the ensure file.
+//   instances/<instance ID> - instance files.
+const (
+	// BundleManifestFile is a name of the bundle manifest file.
+	BundleManifestFile = "bundle.json"
+	// BundleEnsureFile is a name of the ensure file inside the bundle.
+	BundleEnsureFile = "ensure.txt"
+	// BundleVersionsFile is a name of the resolved versions file in the bundle.
+	BundleVersionsFile = "versions.txt"
+
+	// bundleInstancesDir is a subdirectory with instance files.
+	bundleInstancesDir = "instances"
+	// bundleFormatVersion is a version of the bundle layout we understand.
+	bundleFormatVersion = 1
+)
+
+// BundleManifest is stored in the bundle as JSON and describes its content.
+type BundleManifest struct {
+	FormatVersion int          `json:"format_version"`
+	ServiceURL    string       `json:"service_url"`
+	Platforms     []string     `json:"platforms"`
+	Instances     []common.Pin `json:"instances"`
+
+	// Signatures maps "<package>:<instance ID>" to instance signatures.
+	//
+	// Present only if the ensure file has $RequireSigner directives.
+	Signatures map[string][][]byte `json:"signatures,omitempty"`
+}
+
+// NewBundleManifest returns a manifest of the current format version.
+func NewBundleManifest(serviceURL string, platforms []string, instances []common.Pin) *BundleManifest {
+	return &BundleManifest{
+		FormatVersion: bundleFormatVersion,
+		ServiceURL:    serviceURL,
+		Platforms:     platforms,
+		Instances:     instances,
+	}
+}
+
+// Has returns true if the bundle has the given instance.
+func (m *BundleManifest) Has(pin common.Pin) bool {
+	for _, p := range m.Instances {
+		if p == pin {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadBundleManifest reads and validates the manifest of the given bundle.
+func ReadBundleManifest(bundle string) (*BundleManifest, error) {
+	blob, err := ioutil.ReadFile(filepath.Join(bundle, BundleManifestFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%q is not a CIPD bundle: no %s", bundle, BundleManifestFile)
+		}
+		return nil, err
+	}
+	m := &BundleManifest{}
+	if err := json.Unmarshal(blob, m); err != nil {
+		return nil, fmt.Errorf("bad bundle manifest - %s", err)
+	}
+	if m.FormatVersion != bundleFormatVersion {
+		return nil, fmt.Errorf("unsupported bundle format version %d, expecting %d", m.FormatVersion, bundleFormatVersion)
+	}
+	for _, pin := range m.Instances {
+		if err := common.ValidatePin(pin, common.KnownHash); err != nil {
+			return nil, fmt.Errorf("bad bundle manifest - %s", err)
+		}
+	}
+	return m, nil
+}
+
+// WriteBundleManifest writes the manifest into the given bundle directory.
+func WriteBundleManifest(bundle string, m *BundleManifest) error {
+	blob, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(bundle, BundleManifestFile), blob, 0666)
+}
+
+// BundleInstancePath returns a path to the instance file inside the bundle.
+//
+// Instance IDs are content hashes, so same instance file can be shared by all
+// packages that have it.
+func BundleInstancePath(bundle string, pin common.Pin) string {
+	return filepath.Join(bundle, bundleInstancesDir, pin.InstanceID)
+}
+
+// fetchInstanceFromBundle opens the instance file from ClientOptions.Bundle and
+// verifies its hash. Assumes 'pin' is already validated.
+func (client *clientImpl) fetchInstanceFromBundle(ctx context.Context, pin common.Pin) (pkg.Source, error) {
+	if !client.bundleManifest.Has(pin) {
+		return nil, fmt.Errorf("%s is not in the bundle", pin)
+	}
+
+	f, err := os.Open(BundleInstancePath(client.Bundle, pin))
+	if err != nil {
+		return nil, err
+	}
+	src := bundleFile{f}
+
+	ok := false
+	defer func() {
+		if !ok {
+			src.Close(ctx, false)
+		}
+	}()
+
+	objRef := common.InstanceIDToObjectRef(pin.InstanceID)
+	hash := common.MustNewHash(objRef.HashAlgo)
+	if _, err := io.Copy(hash, src); err != nil {
+		return nil, err
+	}
+	if digest := common.HexDigest(hash); objRef.HexDigest != digest {
+		return nil, fmt.Errorf("package hash mismatch in the bundle: expecting %q, got %q", objRef.HexDigest, digest)
+	}
+	if _, err := src.Seek(0, os.SEEK_SET); err != nil {
+		return nil, err
+	}
+
+	logging.Infof(ctx, "cipd: using %s from the bundle", pin)
+	ok = true
+	return src, nil
+}
+
+// bundleFile is os.File that implements pkg.Source interface.
+//
+// Bundles are read-only from the client's point of view, so corrupted files are
+// left where they are.
+type bundleFile struct {
+	*os.File
+}
+
+// Close closes the underlying file.
+func (f bundleFile) Close(ctx context.Context, corrupt bool) error {
+	if corrupt {
+		logging.Errorf(ctx, "cipd: %s in the bundle is corrupted", f.File.Name())
+	}
+	return f.File.Close()
+}
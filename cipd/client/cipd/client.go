@@ -76,6 +76,7 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 	"go.chromium.org/luci/cipd/version"
@@ -93,6 +94,10 @@ const (
 	// TagAttachTimeout is how long to wait for an instance to be processed when
 	// attaching tags in AttachTagsWhenReady.
 	TagAttachTimeout = 3 * time.Minute
+
+	// MetadataAttachTimeout is how long to wait for an instance to be processed
+	// when attaching metadata in AttachMetadataWhenReady.
+	MetadataAttachTimeout = 3 * time.Minute
 )
 
 // Environment variable definitions
@@ -111,9 +116,10 @@ var (
 	// us to upload it again.
 	ErrBadUpload = errors.New("package file is uploaded, but servers asks us to upload it again", transient.Tag)
 
-	// ErrProcessingTimeout is returned by SetRefWhenReady or AttachTagsWhenReady
-	// if the instance processing on the backend takes longer than expected. Refs
-	// and tags can be attached only to processed instances.
+	// ErrProcessingTimeout is returned by SetRefWhenReady, AttachTagsWhenReady
+	// or AttachMetadataWhenReady if the instance processing on the backend takes
+	// longer than expected. Refs, tags and metadata can be attached only to
+	// processed instances.
 	ErrProcessingTimeout = errors.New("timeout while waiting for the instance to become ready", transient.Tag)
 
 	// ErrDownloadError is returned by FetchInstance on download errors.
@@ -152,8 +158,16 @@ type UploadSession struct {
 
 // DescribeInstanceOpts is passed to DescribeInstance.
 type DescribeInstanceOpts struct {
-	DescribeRefs bool // if true, will fetch all refs pointing to the instance
-	DescribeTags bool // if true, will fetch all tags attached to the instance
+	DescribeRefs     bool // if true, will fetch all refs pointing to the instance
+	DescribeTags     bool // if true, will fetch all tags attached to the instance
+	DescribeMetadata bool // if true, will fetch all metadata attached to the instance
+}
+
+// Metadata is a metadata entry to attach to an instance.
+type Metadata struct {
+	Key         string // a key, should match the same regexp as tag keys
+	Value       []byte // an arbitrary value, up to 512 KB
+	ContentType string // an optional content type of the value
 }
 
 // Client provides high-level CIPD client interface. Thread safe.
@@ -228,6 +242,16 @@ type Client interface {
 	// AttachTagsWhenReady attaches tags to an instance.
 	AttachTagsWhenReady(ctx context.Context, pin common.Pin, tags []string) error
 
+	// AttachMetadataWhenReady attaches metadata to an instance.
+	AttachMetadataWhenReady(ctx context.Context, pin common.Pin, md []Metadata) error
+
+	// ListMetadata returns metadata entries with given keys attached to an
+	// instance, or all entries if 'keys' is empty.
+	//
+	// The returned list is sorted by the key first, and then by the timestamp
+	// (newest first).
+	ListMetadata(ctx context.Context, pin common.Pin, keys []string) ([]MetadataInfo, error)
+
 	// FetchPackageRefs returns information about all refs defined for a package.
 	//
 	// The returned list is sorted by modification timestamp (newest first).
@@ -323,6 +347,14 @@ type ClientOptions struct {
 	// root. If both Root and CacheDir are empty, tag cache is disabled.
 	CacheDir string
 
+	// DedupDir is a directory with a store of deployed files shared by many site
+	// roots.
+	//
+	// If set, identical files deployed into different site roots are hardlinked
+	// to the same file in this store, see deployer.Options. Ignored if Root is
+	// empty.
+	DedupDir string
+
 	// Versions is optional database of (pkg, version) => instance ID resolutions.
 	//
 	// If set, it will be used for all version resolutions done by the client.
@@ -332,6 +364,25 @@ type ClientOptions struct {
 	// This is primarily used to implement $ResolvedVersions ensure file feature.
 	Versions ensure.VersionsFile
 
+	// Bundle is an optional path to a directory produced by 'cipd bundle-export'.
+	//
+	// If set, all package instances will be fetched from the bundle (verifying
+	// their hashes) instead of the backend. Fetching an instance that isn't in
+	// the bundle is an error. The instance cache is not used.
+	//
+	// Together with Versions this allows to install packages without any
+	// backend calls. This is primarily used to implement 'cipd ensure -bundle'.
+	Bundle string
+
+	// RequireSigners is an optional list of signing key IDs.
+	//
+	// If set, the client will refuse to install instances that are not signed by
+	// at least one of these keys (see 'signing' package). When installing from
+	// a Bundle, signatures are taken from the bundle manifest.
+	//
+	// This is primarily used to implement $RequireSigner ensure file feature.
+	RequireSigners []string
+
 	// AnonymousClient is http.Client that doesn't attach authentication headers.
 	//
 	// Will be used when talking to the Google Storage. We use signed URLs that do
@@ -439,12 +490,20 @@ func NewClient(opts ClientOptions) (Client, error) {
 		}
 	}
 
+	var bundleManifest *BundleManifest
+	if opts.Bundle != "" {
+		if bundleManifest, err = ReadBundleManifest(opts.Bundle); err != nil {
+			return nil, err
+		}
+	}
+
 	return &clientImpl{
-		ClientOptions: opts,
-		cas:           cas,
-		repo:          repo,
-		storage:       s,
-		deployer:      deployer.New(opts.Root),
+		ClientOptions:  opts,
+		cas:            cas,
+		repo:           repo,
+		storage:        s,
+		deployer:       deployer.NewWithOptions(opts.Root, deployer.Options{DedupDir: opts.DedupDir}),
+		bundleManifest: bundleManifest,
 	}, nil
 }
 
@@ -510,6 +569,9 @@ type clientImpl struct {
 	// instanceCache is a file-system based cache of instances.
 	instanceCache     *internal.InstanceCache
 	instanceCacheInit sync.Once
+
+	// bundleManifest is a manifest of ClientOptions.Bundle, if it is set.
+	bundleManifest *BundleManifest
 }
 
 type batchAwareOp int
@@ -1039,10 +1101,11 @@ func (client *clientImpl) DescribeInstance(ctx context.Context, pin common.Pin,
 	}
 
 	resp, err := client.repo.DescribeInstance(ctx, &api.DescribeInstanceRequest{
-		Package:      pin.PackageName,
-		Instance:     common.InstanceIDToObjectRef(pin.InstanceID),
-		DescribeRefs: opts.DescribeRefs,
-		DescribeTags: opts.DescribeTags,
+		Package:          pin.PackageName,
+		Instance:         common.InstanceIDToObjectRef(pin.InstanceID),
+		DescribeRefs:     opts.DescribeRefs,
+		DescribeTags:     opts.DescribeTags,
+		DescribeMetadata: opts.DescribeMetadata,
 	}, expectedCodes)
 	if err != nil {
 		return nil, humanErr(err)
@@ -1133,6 +1196,80 @@ func (client *clientImpl) AttachTagsWhenReady(ctx context.Context, pin common.Pi
 	return err
 }
 
+func (client *clientImpl) AttachMetadataWhenReady(ctx context.Context, pin common.Pin, md []Metadata) error {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return err
+	}
+	if len(md) == 0 {
+		return nil
+	}
+
+	apiMD := make([]*api.InstanceMetadata, len(md))
+	for i, m := range md {
+		if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+			return err
+		}
+		if err := common.ValidateInstanceMetadataLen(len(m.Value)); err != nil {
+			return err
+		}
+		if err := common.ValidateContentType(m.ContentType); err != nil {
+			return err
+		}
+		apiMD[i] = &api.InstanceMetadata{
+			Key:         m.Key,
+			Value:       m.Value,
+			ContentType: m.ContentType,
+		}
+		logging.Infof(ctx, "cipd: attaching metadata %s (%s)", m.Key,
+			common.InstanceMetadataFingerprint(m.Key, m.Value))
+	}
+
+	err := retryUntilReady(ctx, MetadataAttachTimeout, func(ctx context.Context) error {
+		_, err := client.repo.AttachMetadata(ctx, &api.AttachMetadataRequest{
+			Package:  pin.PackageName,
+			Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+			Metadata: apiMD,
+		}, expectedCodes)
+		return err
+	})
+
+	switch err {
+	case nil:
+		logging.Infof(ctx, "cipd: all metadata attached")
+	case ErrProcessingTimeout:
+		logging.Errorf(ctx, "cipd: failed to attach metadata - deadline exceeded")
+	default:
+		logging.Errorf(ctx, "cipd: failed to attach metadata - %s", err)
+	}
+	return err
+}
+
+func (client *clientImpl) ListMetadata(ctx context.Context, pin common.Pin, keys []string) ([]MetadataInfo, error) {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if err := common.ValidateInstanceMetadataKey(k); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := client.repo.ListMetadata(ctx, &api.ListMetadataRequest{
+		Package:  pin.PackageName,
+		Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+		Keys:     keys,
+	}, expectedCodes)
+	if err != nil {
+		return nil, humanErr(err)
+	}
+
+	out := make([]MetadataInfo, len(resp.Metadata))
+	for i, md := range resp.Metadata {
+		out[i] = apiMetadataToInfo(md)
+	}
+	return out, nil
+}
+
 // How long to wait between retries in retryUntilReady.
 const retryDelay = 5 * time.Second
 
@@ -1250,6 +1387,9 @@ func (client *clientImpl) FetchInstance(ctx context.Context, pin common.Pin) (pk
 	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
 		return nil, err
 	}
+	if client.Bundle != "" {
+		return client.fetchInstanceFromBundle(ctx, pin)
+	}
 	if cache := client.getInstanceCache(ctx); cache != nil {
 		return client.fetchInstanceWithCache(ctx, pin, cache)
 	}
@@ -1264,13 +1404,13 @@ func (client *clientImpl) FetchInstanceTo(ctx context.Context, pin common.Pin, o
 	// Deal with no-cache situation first, it is simple - just fetch the instance
 	// into the 'output'.
 	cache := client.getInstanceCache(ctx)
-	if cache == nil {
+	if cache == nil && client.Bundle == "" {
 		return client.remoteFetchInstance(ctx, pin, output)
 	}
 
 	// If using the cache, always fetch into the cache first, and then copy data
-	// from the cache into the output.
-	input, err := client.fetchInstanceWithCache(ctx, pin, cache)
+	// from the cache into the output. Bundles are handled in a similar way.
+	input, err := client.FetchInstance(ctx, pin)
 	if err != nil {
 		return err
 	}
@@ -1413,6 +1553,9 @@ func (client *clientImpl) fetchAndDo(ctx context.Context, pin common.Pin, cb fun
 	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
 		return err
 	}
+	if err := client.verifySignature(ctx, pin); err != nil {
+		return err
+	}
 
 	doit := func() (err error) {
 		// Fetch the package (verifying its hash) and obtain a pointer to its data.
@@ -1458,6 +1601,35 @@ func (client *clientImpl) fetchAndDo(ctx context.Context, pin common.Pin, cb fun
 	return err
 }
 
+// verifySignature checks the instance is signed by one of RequireSigners.
+//
+// Does nothing if RequireSigners is empty.
+func (client *clientImpl) verifySignature(ctx context.Context, pin common.Pin) error {
+	if len(client.RequireSigners) == 0 {
+		return nil
+	}
+
+	var sigs [][]byte
+	if client.Bundle != "" {
+		sigs = client.bundleManifest.Signatures[pin.String()]
+	} else {
+		md, err := client.ListMetadata(ctx, pin, []string{signing.MetadataKey})
+		if err != nil {
+			return errors.Annotate(err, "failed to fetch signatures of %s", pin).Err()
+		}
+		sigs = make([][]byte, len(md))
+		for i, m := range md {
+			sigs[i] = m.Value
+		}
+	}
+
+	if err := signing.Verify(pin, sigs, client.RequireSigners); err != nil {
+		logging.Errorf(ctx, "cipd: refusing to install %s - %s", pin, err)
+		return err
+	}
+	return nil
+}
+
 func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir string, pin common.Pin, maxThreads int) error {
 	if err := common.ValidateSubdir(subdir); err != nil {
 		return err
@@ -17,6 +17,8 @@ package cipd
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -32,6 +34,7 @@ import (
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/proto/google"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
@@ -41,6 +44,7 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 
@@ -464,6 +468,232 @@ func TestSetRefAndAttachTags(t *testing.T) {
 	})
 }
 
+func TestAttachMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func(c C) {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestTimeLocal)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if testclock.HasTags(t, "cipd-sleeping") {
+				tc.Add(d)
+			}
+		})
+
+		client, _, repo, _ := mockedCipdClient(c)
+
+		objRef := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("a", 64),
+		}
+		pin := common.Pin{
+			PackageName: "pkg/name",
+			InstanceID:  common.ObjectRefToInstanceID(objRef),
+		}
+
+		md := []Metadata{
+			{Key: "k1", Value: []byte("v1"), ContentType: "text/plain"},
+			{Key: "k2", Value: []byte("v2")},
+		}
+
+		attachMetadataRPC := func() rpcCall {
+			return rpcCall{
+				method: "AttachMetadata",
+				in: &api.AttachMetadataRequest{
+					Package:  "pkg/name",
+					Instance: objRef,
+					Metadata: []*api.InstanceMetadata{
+						{Key: "k1", Value: []byte("v1"), ContentType: "text/plain"},
+						{Key: "k2", Value: []byte("v2")},
+					},
+				},
+				out: &empty.Empty{},
+			}
+		}
+
+		Convey("Happy path", func() {
+			repo.expect(attachMetadataRPC())
+			So(client.AttachMetadataWhenReady(ctx, pin, md), ShouldBeNil)
+		})
+
+		Convey("Timeout", func() {
+			rpc := attachMetadataRPC()
+			rpc.err = status.Errorf(codes.FailedPrecondition, "not ready")
+			repo.expectMany(rpc)
+			So(client.AttachMetadataWhenReady(ctx, pin, md), ShouldEqual, ErrProcessingTimeout)
+		})
+
+		Convey("Fatal RPC err", func() {
+			rpc := attachMetadataRPC()
+			rpc.err = status.Errorf(codes.PermissionDenied, "boo")
+			repo.expect(rpc)
+			So(client.AttachMetadataWhenReady(ctx, pin, md), ShouldErrLike, "boo")
+		})
+
+		Convey("Noop", func() {
+			So(client.AttachMetadataWhenReady(ctx, pin, nil), ShouldBeNil)
+		})
+
+		Convey("Bad pin", func() {
+			So(client.AttachMetadataWhenReady(ctx, common.Pin{
+				PackageName: "////",
+			}, md), ShouldErrLike, "invalid package name")
+		})
+
+		Convey("Bad key", func() {
+			So(client.AttachMetadataWhenReady(ctx, pin, []Metadata{
+				{Key: "BAD"},
+			}), ShouldErrLike, "invalid metadata key")
+		})
+
+		Convey("Too large", func() {
+			So(client.AttachMetadataWhenReady(ctx, pin, []Metadata{
+				{Key: "k", Value: make([]byte, common.MetadataMaxLen+1)},
+			}), ShouldErrLike, "too long")
+		})
+
+		Convey("Bad content type", func() {
+			So(client.AttachMetadataWhenReady(ctx, pin, []Metadata{
+				{Key: "k", ContentType: "zzz/"},
+			}), ShouldErrLike, "bad content type")
+		})
+	})
+}
+
+func TestListMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func(c C) {
+		ctx := context.Background()
+		client, _, repo, _ := mockedCipdClient(c)
+
+		objRef := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("a", 64),
+		}
+		pin := common.Pin{
+			PackageName: "pkg/name",
+			InstanceID:  common.ObjectRefToInstanceID(objRef),
+		}
+
+		Convey("Works", func() {
+			repo.expect(rpcCall{
+				method: "ListMetadata",
+				in: &api.ListMetadataRequest{
+					Package:  "pkg/name",
+					Instance: objRef,
+					Keys:     []string{"k1"},
+				},
+				out: &api.ListMetadataResponse{
+					Metadata: []*api.InstanceMetadata{
+						{
+							Key:         "k1",
+							Value:       []byte("v1"),
+							ContentType: "text/plain",
+							Fingerprint: "fp",
+							AttachedBy:  "user:a@example.com",
+						},
+					},
+				},
+			})
+			md, err := client.ListMetadata(ctx, pin, []string{"k1"})
+			So(err, ShouldBeNil)
+			So(md, ShouldResemble, []MetadataInfo{
+				{
+					Fingerprint: "fp",
+					Key:         "k1",
+					Value:       []byte("v1"),
+					ContentType: "text/plain",
+					AttachedBy:  "user:a@example.com",
+				},
+			})
+		})
+
+		Convey("Bad key", func() {
+			_, err := client.ListMetadata(ctx, pin, []string{"BAD"})
+			So(err, ShouldErrLike, "invalid metadata key")
+		})
+	})
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	Convey("With mocks", t, func(c C) {
+		ctx := context.Background()
+
+		objRef := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("a", 64),
+		}
+		pin := common.Pin{
+			PackageName: "pkg/name",
+			InstanceID:  common.ObjectRefToInstanceID(objRef),
+		}
+
+		key, err := signing.GenerateKey()
+		So(err, ShouldBeNil)
+		sig, err := key.Sign(pin)
+		So(err, ShouldBeNil)
+		another, err := signing.GenerateKey()
+		So(err, ShouldBeNil)
+
+		opts, _, repo, _ := mockedClientOpts(c)
+		opts.RequireSigners = []string{key.KeyID()}
+		cl, err := NewClient(opts)
+		So(err, ShouldBeNil)
+		client := cl.(*clientImpl)
+
+		expectSignatures := func(sigs ...[]byte) {
+			md := make([]*api.InstanceMetadata, len(sigs))
+			for i, s := range sigs {
+				md[i] = &api.InstanceMetadata{Key: signing.MetadataKey, Value: s}
+			}
+			repo.expect(rpcCall{
+				method: "ListMetadata",
+				in: &api.ListMetadataRequest{
+					Package:  "pkg/name",
+					Instance: objRef,
+					Keys:     []string{signing.MetadataKey},
+				},
+				out: &api.ListMetadataResponse{Metadata: md},
+			})
+		}
+
+		Convey("Signed", func() {
+			expectSignatures(sig)
+			So(client.verifySignature(ctx, pin), ShouldBeNil)
+		})
+
+		Convey("Not signed", func() {
+			expectSignatures()
+			So(client.FetchAndDeployInstance(ctx, "", pin, 1), ShouldErrLike, "is not signed")
+		})
+
+		Convey("Signed by someone else", func() {
+			sig, err := another.Sign(pin)
+			So(err, ShouldBeNil)
+			expectSignatures(sig)
+			So(client.FetchAndDeployInstance(ctx, "", pin, 1), ShouldErrLike,
+				"is not signed by any of the required signers")
+		})
+
+		Convey("Not required", func() {
+			client.RequireSigners = nil
+			So(client.verifySignature(ctx, pin), ShouldBeNil)
+		})
+
+		Convey("From the bundle", func() {
+			client.Bundle = "some/path"
+			client.bundleManifest = NewBundleManifest("https://service.example.com", nil, []common.Pin{pin})
+
+			So(client.verifySignature(ctx, pin), ShouldErrLike, "is not signed")
+
+			client.bundleManifest.Signatures = map[string][][]byte{pin.String(): {sig}}
+			So(client.verifySignature(ctx, pin), ShouldBeNil)
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Fetching info about packages and instances.
 
@@ -775,6 +1005,51 @@ func TestDescribeInstance(t *testing.T) {
 			})
 		})
 
+		Convey("Works with metadata", func() {
+			repo.expect(rpcCall{
+				method: "DescribeInstance",
+				in: &api.DescribeInstanceRequest{
+					Package:          "a/b",
+					Instance:         fakeObjectRef("0"),
+					DescribeMetadata: true,
+				},
+				out: &api.DescribeInstanceResponse{
+					Instance: &api.Instance{
+						Package:  "a/b",
+						Instance: fakeObjectRef("0"),
+					},
+					Metadata: []*api.InstanceMetadata{
+						{
+							Key:         "k",
+							Value:       []byte("v"),
+							ContentType: "text/plain",
+							Fingerprint: "fp",
+							AttachedBy:  "user:a@example.com",
+							AttachedTs:  google.NewTimestamp(testclock.TestTimeUTC),
+						},
+					},
+				},
+			})
+
+			desc, err := client.DescribeInstance(ctx, pin, &DescribeInstanceOpts{
+				DescribeMetadata: true,
+			})
+			So(err, ShouldBeNil)
+			So(desc, ShouldResemble, &InstanceDescription{
+				InstanceInfo: InstanceInfo{Pin: pin},
+				Metadata: []MetadataInfo{
+					{
+						Fingerprint: "fp",
+						Key:         "k",
+						Value:       []byte("v"),
+						ContentType: "text/plain",
+						AttachedBy:  "user:a@example.com",
+						AttachedTs:  UnixTime(testclock.TestTimeUTC),
+					},
+				},
+			})
+		})
+
 		Convey("Bad pin", func() {
 			_, err := client.DescribeInstance(ctx, common.Pin{
 				PackageName: "a/b////",
@@ -987,6 +1262,70 @@ func TestFetchInstance(t *testing.T) {
 	// TODO
 }
 
+func TestFetchInstanceFromBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("With a bundle", t, func(c C) {
+		bundle, err := ioutil.TempDir("", "cipd_bundle")
+		So(err, ShouldBeNil)
+		c.Reset(func() {
+			os.RemoveAll(bundle)
+		})
+
+		body := []byte("instance body")
+		digest := sha256.Sum256(body)
+		pin := common.Pin{
+			PackageName: "pkg/a",
+			InstanceID: common.ObjectRefToInstanceID(&api.ObjectRef{
+				HashAlgo:  api.HashAlgo_SHA256,
+				HexDigest: hex.EncodeToString(digest[:]),
+			}),
+		}
+
+		path := BundleInstancePath(bundle, pin)
+		So(os.MkdirAll(filepath.Dir(path), 0777), ShouldBeNil)
+		So(ioutil.WriteFile(path, body, 0666), ShouldBeNil)
+		So(WriteBundleManifest(bundle, NewBundleManifest(
+			"https://service.example.com", []string{"linux-amd64"}, []common.Pin{pin})), ShouldBeNil)
+
+		opts, _, _, _ := mockedClientOpts(c)
+		opts.Bundle = bundle
+		client, err := NewClient(opts)
+		So(err, ShouldBeNil)
+
+		Convey("Works", func() {
+			src, err := client.FetchInstance(ctx, pin)
+			So(err, ShouldBeNil)
+			defer src.Close(ctx, false)
+			blob, err := ioutil.ReadAll(src)
+			So(err, ShouldBeNil)
+			So(blob, ShouldResemble, body)
+		})
+
+		Convey("Missing instance", func() {
+			_, err := client.FetchInstance(ctx, common.Pin{
+				PackageName: "pkg/a",
+				InstanceID:  fakeIID("a"),
+			})
+			So(err, ShouldErrLike, "is not in the bundle")
+		})
+
+		Convey("Corrupted instance", func() {
+			So(ioutil.WriteFile(path, []byte("huh"), 0666), ShouldBeNil)
+			_, err := client.FetchInstance(ctx, pin)
+			So(err, ShouldErrLike, "package hash mismatch in the bundle")
+		})
+
+		Convey("Not a bundle", func() {
+			So(os.Remove(filepath.Join(bundle, BundleManifestFile)), ShouldBeNil)
+			_, err := NewClient(opts)
+			So(err, ShouldErrLike, "is not a CIPD bundle")
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Instance installation.
 
@@ -0,0 +1,211 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// File system layout of a dedup store <store>:
+// <store>/
+//   sha256/
+//     ab/
+//       ab0123...     <- a blob with some non-executable file body
+//       ab4567...-x   <- a blob with some executable file body
+//     ...
+//
+// Each blob is hardlinked into guts (.cipd/pkgs/<index>/<instance>/...) of all
+// instances that have a file with the same body and attributes. Since all
+// links share the same inode, files are never modified in place (the deployer
+// only ever replaces them).
+//
+// The number of hardlinks to a blob is used as its reference counter: a blob
+// with only one link (the store itself) is not used by any site root and can
+// be deleted. This works across many site roots sharing the same store (and
+// across concurrent processes) without any extra bookkeeping.
+
+// dedupStore is a shared content-addressed store of files.
+type dedupStore struct {
+	root string // absolute path to the store directory
+}
+
+// newDedupStore returns a store rooted at the given directory.
+func newDedupStore(root string) (*dedupStore, error) {
+	root, err := filepath.Abs(filepath.Clean(root))
+	if err != nil {
+		return nil, err
+	}
+	return &dedupStore{root: root}, nil
+}
+
+// blobPath returns a path to a blob that can be used in place of the given
+// file or "" if the file can't be deduplicated.
+//
+// Only read-only regular files without custom modification time or Windows
+// attributes are deduplicated: all these attributes are shared by all
+// hardlinks.
+func (s *dedupStore) blobPath(f pkg.FileInfo) string {
+	if f.Symlink != "" || f.Writable || f.ModTime != 0 || f.WinAttrs != "" || f.Hash == "" {
+		return ""
+	}
+	if common.ValidateInstanceID(f.Hash, common.KnownHash) != nil {
+		return ""
+	}
+	ref := common.InstanceIDToObjectRef(f.Hash)
+	name := ref.HexDigest
+	if f.Executable {
+		name += "-x"
+	}
+	algo := strings.ToLower(ref.HashAlgo.String())
+	return filepath.Join(s.root, algo, ref.HexDigest[:2], name)
+}
+
+// dedup replaces files extracted into the instance directory with hardlinks to
+// blobs in the store, adding new blobs to the store as necessary.
+//
+// This is a best effort operation. Files that can't be deduplicated are left
+// untouched. If the file system doesn't support hardlinks (or the store is on
+// another volume), gives up after the first failure.
+func (s *dedupStore) dedup(ctx context.Context, fsys fs.FileSystem, instDir string, files []pkg.FileInfo) {
+	linked := 0
+	for _, f := range files {
+		blob := s.blobPath(f)
+		if blob == "" {
+			continue
+		}
+		guts := filepath.Join(instDir, filepath.FromSlash(f.Name))
+		switch err := s.link(ctx, fsys, blob, guts); {
+		case err == nil:
+			linked++
+		case isLinkUnsupported(err):
+			logging.Warningf(ctx, "Can't use the dedup store %q, hardlinks are not supported - %s", s.root, err)
+			return
+		default:
+			logging.Warningf(ctx, "Failed to deduplicate %q - %s", guts, err)
+		}
+	}
+	logging.Debugf(ctx, "Deduplicated %d files out of %d", linked, len(files))
+}
+
+// link makes the guts file share its body with the blob.
+func (s *dedupStore) link(ctx context.Context, fsys fs.FileSystem, blob, guts string) error {
+	if err := os.MkdirAll(filepath.Dir(blob), 0777); err != nil {
+		return err
+	}
+
+	// The loop handles a race with 'gc' running concurrently in another process:
+	// the blob may disappear between the two attempts below.
+	for attempt := 0; attempt < 3; attempt++ {
+		// Try to put the file into the store. This also checks for hardlinks
+		// support in general.
+		err := os.Link(guts, blob)
+		if err == nil || !os.IsExist(err) {
+			return err
+		}
+
+		// The blob is already there. Link it to a temp file next to the guts file
+		// and replace the guts file with it.
+		tmp := fmt.Sprintf("%s.dedup_%d", guts, os.Getpid())
+		switch err := os.Link(blob, tmp); {
+		case os.IsNotExist(err):
+			continue // collected by 'gc' just now, try to recreate it
+		case err != nil:
+			return err
+		}
+		if err := fsys.Replace(ctx, tmp, guts); err != nil {
+			os.Remove(tmp)
+			return err
+		}
+		return nil
+	}
+
+	return fmt.Errorf("the blob %q keeps disappearing", blob)
+}
+
+// release removes blobs of the given files if they are no longer used.
+//
+// Called after an instance directory has been removed from the guts, to
+// cleanup its blobs right away. Blobs of files that are still used by some
+// other instances are left alone.
+func (s *dedupStore) release(ctx context.Context, files []pkg.FileInfo) {
+	for _, f := range files {
+		if blob := s.blobPath(f); blob != "" {
+			s.collect(ctx, blob)
+		}
+	}
+}
+
+// gc removes all blobs that are not used by any site root.
+//
+// This is a best effort operation. Errors are logged.
+func (s *dedupStore) gc(ctx context.Context) {
+	collected := 0
+	err := filepath.Walk(s.root, func(path string, info os.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		case info.Mode().IsRegular() && s.collect(ctx, path):
+			collected++
+		}
+		return nil
+	})
+	if err != nil {
+		logging.Warningf(ctx, "Failed to scan the dedup store %q - %s", s.root, err)
+	}
+	if collected != 0 {
+		logging.Infof(ctx, "Removed %d unused files from the dedup store", collected)
+	}
+}
+
+// collect deletes the blob if it has no hardlinks pointing to it.
+//
+// Returns true if the blob was deleted.
+func (s *dedupStore) collect(ctx context.Context, blob string) bool {
+	switch n, err := linkCount(blob); {
+	case os.IsNotExist(err):
+		return false
+	case err != nil:
+		logging.Warningf(ctx, "Failed to check the link count of %q - %s", blob, err)
+		return false
+	case n > 1:
+		return false
+	}
+	err := os.Remove(blob)
+	if err != nil && !os.IsNotExist(err) {
+		// Read-only files can't be removed on Windows. Nothing else is using the
+		// blob at this point, so it is fine to make it writable.
+		if os.Chmod(blob, 0600) == nil {
+			err = os.Remove(blob)
+		}
+	}
+	if err != nil && !os.IsNotExist(err) {
+		logging.Warningf(ctx, "Failed to remove %q - %s", blob, err)
+		return false
+	}
+	return true
+}
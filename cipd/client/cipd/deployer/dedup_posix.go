@@ -0,0 +1,47 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows
+
+package deployer
+
+import (
+	"os"
+	"syscall"
+)
+
+// linkCount returns the number of hardlinks to the file.
+func linkCount(path string) (uint64, error) {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		return 0, err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, &os.PathError{Op: "lstat", Path: path, Err: syscall.ENOTSUP}
+	}
+	return uint64(st.Nlink), nil
+}
+
+// isLinkUnsupported is true if the error from os.Link indicates hardlinks
+// between given paths can't be created at all.
+func isLinkUnsupported(err error) bool {
+	if le, ok := err.(*os.LinkError); ok {
+		err = le.Err
+	}
+	// Note: ENOTSUP and EOPNOTSUPP are the same on some platforms, so can't use
+	// them both in a switch.
+	return err == syscall.EXDEV || err == syscall.EPERM ||
+		err == syscall.ENOTSUP || err == syscall.EOPNOTSUPP
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDedupStore(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on Windows: no hardlinks")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given two site roots sharing a dedup store", t, func() {
+		tempDir := mkTempDir()
+		store := filepath.Join(tempDir, "store")
+		root1 := filepath.Join(tempDir, "root1")
+		root2 := filepath.Join(tempDir, "root2")
+
+		d1 := NewWithOptions(root1, Options{DedupDir: store})
+		d2 := NewWithOptions(root2, Options{DedupDir: store})
+
+		inst := makeTestInstance("test/package", []fs.File{
+			fs.NewTestFile("some/file", "data a", fs.TestFileOpts{}),
+			fs.NewTestFile("some/executable", "data b", fs.TestFileOpts{Executable: true}),
+			fs.NewTestFile("some/writable", "data c", fs.TestFileOpts{Writable: true}),
+			fs.NewTestSymlink("some/symlink", "executable"),
+		}, pkg.InstallModeSymlink)
+
+		_, err := d1.DeployInstance(ctx, "", inst, 0)
+		So(err, ShouldBeNil)
+		_, err = d2.DeployInstance(ctx, "", inst, 0)
+		So(err, ShouldBeNil)
+
+		stat := func(path string) os.FileInfo {
+			fi, err := os.Stat(path)
+			So(err, ShouldBeNil)
+			return fi
+		}
+
+		blobs := func() (out []string) {
+			for _, p := range scanDir(store) {
+				if p[len(p)-1] != '!' {
+					out = append(out, p)
+				}
+			}
+			return
+		}
+
+		Convey("Identical files are shared", func() {
+			So(len(blobs()), ShouldEqual, 2) // 'file' and 'executable'
+
+			for _, name := range []string{"some/file", "some/executable"} {
+				So(os.SameFile(
+					stat(filepath.Join(root1, name)),
+					stat(filepath.Join(root2, name))), ShouldBeTrue)
+			}
+
+			// Writable files are not shared.
+			So(os.SameFile(
+				stat(filepath.Join(root1, "some/writable")),
+				stat(filepath.Join(root2, "some/writable"))), ShouldBeFalse)
+
+			// Files are still readable.
+			body, err := ioutil.ReadFile(filepath.Join(root2, "some", "symlink"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, "data b")
+			So(stat(filepath.Join(root2, "some", "executable")).Mode()&0100, ShouldNotEqual, 0)
+		})
+
+		Convey("Redeploying keeps files shared", func() {
+			_, err := d1.DeployInstance(ctx, "", inst, 0)
+			So(err, ShouldBeNil)
+			So(len(blobs()), ShouldEqual, 2)
+			So(os.SameFile(
+				stat(filepath.Join(root1, "some/file")),
+				stat(filepath.Join(root2, "some/file"))), ShouldBeTrue)
+		})
+
+		Convey("RemoveDeployed releases unused blobs", func() {
+			So(d1.RemoveDeployed(ctx, "", "test/package"), ShouldBeNil)
+			So(len(blobs()), ShouldEqual, 2) // still used by root2
+
+			body, err := ioutil.ReadFile(filepath.Join(root2, "some", "file"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, "data a")
+
+			So(d2.RemoveDeployed(ctx, "", "test/package"), ShouldBeNil)
+			So(blobs(), ShouldHaveLength, 0)
+		})
+
+		Convey("CleanupTrash collects unused blobs", func() {
+			// Simulate a site root being deleted without telling the deployer.
+			So(os.RemoveAll(root1), ShouldBeNil)
+			d2.CleanupTrash(ctx)
+			So(len(blobs()), ShouldEqual, 2)
+
+			So(os.RemoveAll(root2), ShouldBeNil)
+			d2.CleanupTrash(ctx)
+			So(blobs(), ShouldHaveLength, 0)
+		})
+	})
+}
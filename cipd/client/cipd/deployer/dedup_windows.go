@@ -0,0 +1,55 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build windows
+
+package deployer
+
+import (
+	"os"
+	"syscall"
+)
+
+const (
+	errorInvalidFunction syscall.Errno = 1
+	errorNotSameDevice   syscall.Errno = 17
+	errorNotSupported    syscall.Errno = 50
+)
+
+// linkCount returns the number of hardlinks to the file.
+func linkCount(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	var info syscall.ByHandleFileInformation
+	if err := syscall.GetFileInformationByHandle(syscall.Handle(f.Fd()), &info); err != nil {
+		return 0, &os.PathError{Op: "GetFileInformationByHandle", Path: path, Err: err}
+	}
+	return uint64(info.NumberOfLinks), nil
+}
+
+// isLinkUnsupported is true if the error from os.Link indicates hardlinks
+// between given paths can't be created at all.
+func isLinkUnsupported(err error) bool {
+	if le, ok := err.(*os.LinkError); ok {
+		err = le.Err
+	}
+	switch err {
+	case errorInvalidFunction, errorNotSameDevice, errorNotSupported:
+		return true
+	}
+	return false
+}
@@ -184,8 +184,28 @@ type Deployer interface {
 	CleanupTrash(ctx context.Context)
 }
 
+// Options are optional parameters of a deployer, see NewWithOptions.
+type Options struct {
+	// DedupDir is a path to a shared content-addressed store of files.
+	//
+	// If set, files extracted into the site root guts (in "symlink" install
+	// mode) are replaced with hardlinks to files in this store, so that
+	// identical files from many site roots occupy disk space only once. Unused
+	// files are removed from the store by RemoveDeployed and CleanupTrash.
+	//
+	// The store should be on the same volume as the site root, otherwise
+	// hardlinks can't be used and files are not deduplicated.
+	DedupDir string
+}
+
 // New return default Deployer implementation.
 func New(root string) Deployer {
+	return NewWithOptions(root, Options{})
+}
+
+// NewWithOptions returns default Deployer implementation configured with the
+// given options.
+func NewWithOptions(root string, opts Options) Deployer {
 	var err error
 	if root == "" {
 		err = fmt.Errorf("site root path is not provided")
@@ -195,8 +215,14 @@ func New(root string) Deployer {
 	if err != nil {
 		return errDeployer{err}
 	}
+	var dedup *dedupStore
+	if opts.DedupDir != "" {
+		if dedup, err = newDedupStore(opts.DedupDir); err != nil {
+			return errDeployer{err}
+		}
+	}
 	trashDir := filepath.Join(root, fs.SiteServiceDir, "trash")
-	return &deployerImpl{fs.NewFileSystem(root, trashDir)}
+	return &deployerImpl{fs: fs.NewFileSystem(root, trashDir), dedup: dedup}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -289,7 +315,8 @@ func writeDescription(d *description, w io.Writer) error {
 
 // deployerImpl implements Deployer interface.
 type deployerImpl struct {
-	fs fs.FileSystem
+	fs    fs.FileSystem
+	dedup *dedupStore // nil if not using the dedup store
 }
 
 func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst pkg.Instance, maxThreads int) (pin common.Pin, err error) {
@@ -365,6 +392,13 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 		return common.Pin{}, err
 	}
 
+	// Replace extracted files with hardlinks to the dedup store, if enabled. Only
+	// files in "symlink" mode can be shared: in "copy" mode they end up in the
+	// site root where anyone can modify them in place.
+	if d.dedup != nil && installMode == pkg.InstallModeSymlink {
+		d.dedup.dedup(ctx, d.fs, destPath, newManifest.Files)
+	}
+
 	// Remember currently deployed version (to remove it later). Do not freak out
 	// if it's not there (prevInstanceID == "") or broken (err != nil).
 	prevInstanceID, err := d.getCurrentInstanceID(pkgPath)
@@ -425,7 +459,9 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			d.fs.EnsureDirectoryGone(ctx, filepath.Join(pkgPath, prevInstanceID))
+			if d.fs.EnsureDirectoryGone(ctx, filepath.Join(pkgPath, prevInstanceID)) == nil && d.dedup != nil {
+				d.dedup.release(ctx, prevManifest.Files)
+			}
 		}()
 	}
 
@@ -576,7 +612,13 @@ func (d *deployerImpl) RemoveDeployed(ctx context.Context, subdir, packageName s
 		// root. We can just remove the guts thus forgetting about the package.
 		logging.Warningf(ctx, "Package %s is partially installed, removing it", packageName)
 	}
-	return d.fs.EnsureDirectoryGone(ctx, deployed.packagePath)
+	if err := d.fs.EnsureDirectoryGone(ctx, deployed.packagePath); err != nil {
+		return err
+	}
+	if d.dedup != nil && deployed.Manifest != nil {
+		d.dedup.release(ctx, deployed.Manifest.Files)
+	}
+	return nil
 }
 
 func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, pin common.Pin, maxThreads int, params RepairParams) error {
@@ -730,6 +772,9 @@ func (d *deployerImpl) TempDir(ctx context.Context, prefix string, mode os.FileM
 
 func (d *deployerImpl) CleanupTrash(ctx context.Context) {
 	d.fs.CleanupTrash(ctx)
+	if d.dedup != nil {
+		d.dedup.gc(ctx)
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
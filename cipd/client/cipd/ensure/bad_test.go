@@ -113,6 +113,12 @@ var badEnsureFiles = []struct {
 		`unrecognized paranoid mode`,
 	},
 
+	{
+		"bad signer",
+		"$RequireSigner ed25519-zzz",
+		`bad $RequireSigner`,
+	},
+
 	{
 		"too many urls",
 		f(
@@ -197,6 +203,57 @@ var badEnsureFiles = []struct {
 		`$setting found after non-$setting statements`,
 	},
 
+	{
+		"bad Var syntax",
+		"$Var zzz",
+		`expecting '$Var <name>=<value>'`,
+	},
+
+	{
+		"bad Var name",
+		"$Var a-b=c",
+		`bad $Var name "a-b"`,
+	},
+
+	{
+		"builtin Var",
+		"$Var os=linux",
+		`${os} is a builtin variable and can't be redefined`,
+	},
+
+	{
+		"duplicate Var",
+		f(
+			"$Var a=b",
+			"$Var a=c",
+		),
+		`$Var a is already defined`,
+	},
+
+	{
+		"templated Var",
+		"$Var a=${os}",
+		`the value can't have templates in it`,
+	},
+
+	{
+		"unknown var in version",
+		"some/package version:${zzz}",
+		`failed to expand package version (line 1)`,
+	},
+
+	{
+		"builtin var in version",
+		"some/package version:${platform}",
+		`failed to expand package version (line 1)`,
+	},
+
+	{
+		"builtin var condition in version",
+		"some/package version:${os=mac}",
+		`failed to expand package version (line 1)`,
+	},
+
 	{
 		"verify bad platform",
 		f(
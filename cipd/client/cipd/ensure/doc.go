@@ -62,6 +62,20 @@
 //     commit, since all hashes of all packages become part of the git commit
 //     object. Use this if you want to reduce trust in the CIPD backend and root
 //     in the git server instead.
+//   - `$RequireSigner <key-id>` instructs the CIPD client to install only
+//     instances signed by the given key (as produced by `cipd signing-key-gen`
+//     and passed to `cipd create` or `cipd pkg-register` via `-signing-key`).
+//     May be specified multiple times, in which case a signature by any of the
+//     listed keys is sufficient. An instance without such signature is not
+//     installed and `cipd ensure` fails. Use this if you don't want to trust
+//     everyone who has write access to the packages.
+//   - `$Var <name>=<value>` declares a variable that can be referenced as
+//     `${name}` in package names, versions and @Subdir directives (see below).
+//     `<value>` is its default value, it can be overridden with
+//     `-var <name>=<value>` flag passed to `cipd ensure` (and other commands
+//     that accept ensure files). Builtin variables (`os`, `arch` and
+//     `platform`) can't be redefined. Note that when using $ResolvedVersions,
+//     the versions file should be generated with the same variable values.
 //
 //
 // Package Definitions
@@ -87,6 +101,11 @@
 // Since these two often appear together, the convenience placeholder
 // `${platform}` expands to the equivalent of `${os}-${arch}`.
 //
+// Variables declared via $Var can be used in package templates too. Unlike
+// builtin ones, they can also be used in versions, e.g.
+// `some/toolchain version:${toolchain_version}`. A builtin variable in
+// a version is an error.
+//
 // All of these parameters also support the syntax ${var=possible,values}.
 // What this means is that the package line will be expanded if, and only if,
 // var equals one of the possible values. If that var does not match
@@ -125,11 +144,13 @@
 //   $ServiceURL https://chrome-infra-packages.appspot.com/
 //   $ParanoidMode CheckPresence
 //   $ResolvedVersions cipd_lock.versions
+//   $Var python_version=2.7.16
 //
 //   # This is the CIPD client itself
 //   infra/tools/cipd/${os}-${arch}  latest
 //
 //   @Subdir python
+//   infra/python/cpython/${platform}      version:${python_version}
 //   python/wheels/pip                     version:8.1.2
 //   # use the convenience placeholder
 //   python/wheels/coverage/${platform}    version:4.1
@@ -38,6 +38,8 @@ type File struct {
 	ServiceURL       string
 	ParanoidMode     deployer.ParanoidMode
 	ResolvedVersions string
+	RequireSigners   []string
+	Vars             map[string]string // $Var name => value
 
 	PackagesBySubdir map[string]PackageSlice
 	VerifyPlatforms  []template.Platform
@@ -116,6 +118,36 @@ func ParseFile(r io.Reader) (*File, error) {
 	return ret, nil
 }
 
+// OverrideVars replaces values of variables declared via $Var with the given
+// ones.
+//
+// Returns an error if some of the variables are not declared in the file.
+func (f *File) OverrideVars(vars map[string]string) error {
+	for k, v := range vars {
+		if _, ok := f.Vars[k]; !ok {
+			return fmt.Errorf("variable %q is not declared in the ensure file via $Var", k)
+		}
+		f.Vars[k] = v
+	}
+	return nil
+}
+
+// expander returns an expander that knows about the given base variables
+// (usually ${os}, ${arch} and ${platform}) and all $Var variables.
+func (f *File) expander(base template.Expander) template.Expander {
+	if len(f.Vars) == 0 {
+		return base
+	}
+	out := make(template.Expander, len(base)+len(f.Vars))
+	for k, v := range f.Vars {
+		out[k] = v
+	}
+	for k, v := range base {
+		out[k] = v
+	}
+	return out
+}
+
 // VersionResolver transforms a {PackageName, Version} tuple (corresponding to
 // the given `def`) into a resolved pin.
 //
@@ -157,9 +189,10 @@ func (f *ResolvedFile) Serialize(w io.Writer) error {
 }
 
 // Resolve takes the current unresolved File and expands all package templates
-// using the provided expander (usually template.DefaultExpander()), and also
-// resolves all versions with the provided VersionResolver, calling it
-// concurrently from multiple goroutines.
+// and versions using the provided expander (usually template.DefaultExpander())
+// extended with variables declared via $Var, and also resolves all versions
+// with the provided VersionResolver, calling it concurrently from multiple
+// goroutines.
 //
 // Returns either a single error (if something is wrong with the ensure file),
 // or a multi-error with all resolution errors, sorted by definition line
@@ -187,10 +220,15 @@ func (f *File) Resolve(rslv VersionResolver, expander template.Expander) (*Resol
 		return ret, nil
 	}
 
+	expander = f.expander(expander)
+	// Versions can refer only to $Var variables, not to builtin ones.
+	verExpander := template.Expander(f.Vars)
+
 	type resolveWorkItem struct {
 		idx    int        // index in the definition, to preserve the ordering
 		subdir string     // expanded
 		pkg    string     // expanded
+		ver    string     // expanded
 		def    PackageDef // original
 
 		pin common.Pin // resolved, pin.PackageName == pkg
@@ -223,7 +261,7 @@ func (f *File) Resolve(rslv VersionResolver, expander template.Expander) (*Resol
 		}
 
 		for _, def := range f.PackagesBySubdir[subdir] {
-			switch realPkg, err := def.Expand(expander); {
+			switch realPkg, realVer, err := def.Expand(expander, verExpander); {
 			case err == template.ErrSkipTemplate:
 				continue
 			case err != nil:
@@ -233,6 +271,7 @@ func (f *File) Resolve(rslv VersionResolver, expander template.Expander) (*Resol
 					idx:    len(toResolve),
 					subdir: realSubdir,
 					pkg:    realPkg,
+					ver:    realVer,
 					def:    def,
 				})
 			}
@@ -246,14 +285,14 @@ func (f *File) Resolve(rslv VersionResolver, expander template.Expander) (*Resol
 		for _, p := range toResolve {
 			p := p
 			tasks <- func() error {
-				p.pin, p.err = rslv(p.pkg, p.def.UnresolvedVersion)
+				p.pin, p.err = rslv(p.pkg, p.ver)
 				if p.err == nil {
 					p.err = common.ValidatePin(p.pin, common.AnyHash)
 				}
 				switch {
 				case p.err != nil:
 					p.err = errors.Annotate(p.err, "failed to resolve %s@%s (line %d)",
-						p.pkg, p.def.UnresolvedVersion, p.def.LineNo).Err()
+						p.pkg, p.ver, p.def.LineNo).Err()
 				case p.pin.PackageName != p.pkg:
 					panic(fmt.Sprintf("bad resolver, returned wrong package name %q, expecting %q", p.pin.PackageName, p.pkg))
 				}
@@ -322,6 +361,21 @@ func (f *File) Serialize(w io.Writer) error {
 			fmt.Fprintf(w, "$ResolvedVersions %s", f.ResolvedVersions)
 			needsNLs = 1
 		}
+		for _, k := range f.RequireSigners {
+			maybeAddNL()
+			fmt.Fprintf(w, "$RequireSigner %s", k)
+			needsNLs = 1
+		}
+		vars := make([]string, 0, len(f.Vars))
+		for k := range f.Vars {
+			vars = append(vars, k)
+		}
+		sort.Strings(vars)
+		for _, k := range vars {
+			maybeAddNL()
+			fmt.Fprintf(w, "$Var %s=%s", k, f.Vars[k])
+			needsNLs = 1
+		}
 
 		if needsNLs != 0 {
 			needsNLs++ // new line separator if any of $Directives were used
@@ -20,8 +20,10 @@ import (
 
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/template"
+	"go.chromium.org/luci/cipd/common"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func mustMakePlatform(v string) template.Platform {
@@ -45,7 +47,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"ServiceURL",
-		&File{"https://something.example.com", "", "", nil, nil},
+		&File{"https://something.example.com", "", "", nil, nil, nil, nil},
 		f(
 			"$ServiceURL https://something.example.com",
 		),
@@ -53,7 +55,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"simple packages",
-		&File{"", "", "", map[string]PackageSlice{
+		&File{"", "", "", nil, nil, map[string]PackageSlice{
 			"": {
 				PackageDef{"some/thing", "version", 0},
 				PackageDef{"some/other_thing", "latest", 0},
@@ -71,6 +73,8 @@ var fileSerializationTests = []struct {
 			ServiceURL:       "https://some.example.com",
 			ParanoidMode:     deployer.CheckPresence,
 			ResolvedVersions: "resolved.versions",
+			RequireSigners:   []string{"ed25519-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"},
+			Vars:             map[string]string{"b": "2", "a": "1"},
 			PackagesBySubdir: map[string]PackageSlice{
 				"": {
 					PackageDef{"some/thing", "version", 0},
@@ -89,6 +93,9 @@ var fileSerializationTests = []struct {
 			"$ServiceURL https://some.example.com",
 			"$ParanoidMode CheckPresence",
 			"$ResolvedVersions resolved.versions",
+			"$RequireSigner ed25519-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+			"$Var a=1",
+			"$Var b=2",
 			"",
 			"$VerifiedPlatform zoops-ohai",
 			"$VerifiedPlatform foos-barch",
@@ -115,3 +122,42 @@ func TestFileSerialization(t *testing.T) {
 		}
 	})
 }
+
+func TestOverrideVars(t *testing.T) {
+	t.Parallel()
+
+	Convey("With an ensure file", t, func() {
+		f, err := ParseFile(bytes.NewBufferString(f(
+			"$Var ver=1",
+			"",
+			"some/pkg/${platform} version:${ver}",
+		)))
+		So(err, ShouldBeNil)
+
+		resolve := func(plat string) *ResolvedFile {
+			rf, err := f.Resolve(testResolver, mustMakePlatform(plat).Expander())
+			So(err, ShouldBeNil)
+			return rf
+		}
+
+		Convey("Uses defaults", func() {
+			So(resolve("os-arch").PackagesBySubdir[""], ShouldResemble, common.PinSlice{
+				p("some/pkg/os-arch", "version:1"),
+			})
+		})
+
+		Convey("Overrides", func() {
+			So(f.OverrideVars(map[string]string{"ver": "2"}), ShouldBeNil)
+			So(resolve("os-arch").PackagesBySubdir[""], ShouldResemble, common.PinSlice{
+				p("some/pkg/os-arch", "version:2"),
+			})
+			So(resolve("another-arch").PackagesBySubdir[""], ShouldResemble, common.PinSlice{
+				p("some/pkg/another-arch", "version:2"),
+			})
+		})
+
+		Convey("Unknown var", func() {
+			So(f.OverrideVars(map[string]string{"zzz": "2"}), ShouldErrLike, `variable "zzz" is not declared`)
+		})
+	})
+}
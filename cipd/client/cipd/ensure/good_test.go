@@ -201,6 +201,59 @@ var goodEnsureFiles = []struct {
 		},
 	},
 
+	{
+		"RequireSigner setting",
+		f(
+			"$RequireSigner ed25519-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+			"$RequireSigner ed25519-BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBA",
+			"$RequireSigner ed25519-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+		),
+		&File{
+			RequireSigners: []string{
+				"ed25519-AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+				"ed25519-BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBA",
+			},
+			PackagesBySubdir: map[string]PackageSlice{},
+		},
+	},
+
+	{
+		"Var setting",
+		f(
+			"$Var toolchain_version=12",
+			"$Var flavor = release",
+		),
+		&File{
+			Vars: map[string]string{
+				"toolchain_version": "12",
+				"flavor":            "release",
+			},
+			PackagesBySubdir: map[string]PackageSlice{},
+		},
+	},
+
+	{
+		"Var expansion",
+		f(
+			"$Var toolchain_version=12",
+			"$Var flavor=release",
+			"$Var dir=tools",
+			"",
+			"path/to/toolchain/${flavor}/${platform} version:${toolchain_version}",
+			"path/to/other/${flavor=debug} latest",
+			"@Subdir ${dir}/${os}",
+			"path/to/${flavor}-tool ${flavor}",
+		),
+		&ResolvedFile{"", deployer.NotParanoid, common.PinSliceBySubdir{
+			"": {
+				p("path/to/toolchain/release/test_os-test_arch", "version:12"),
+			},
+			"tools/test_os": {
+				p("path/to/release-tool", "release"),
+			},
+		}},
+	},
+
 	{
 		"empty",
 		"",
@@ -17,11 +17,13 @@ package ensure
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"go.chromium.org/luci/common/errors"
 
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 )
@@ -36,12 +38,12 @@ type itemParserState struct {
 	curSubdir string
 }
 
-func subdirParser(s *itemParserState, _ *File, val string) (err error) {
+func subdirParser(s *itemParserState, f *File, val string) (err error) {
 	// We expand with the default expander here just to see if this is a plausible
 	// template. When the user uses File.ResolveWith, this will actually use the
 	// user-supplied expander.
 	tempExpanded := ""
-	if tempExpanded, err = template.DefaultExpander().Validate(val); err == nil {
+	if tempExpanded, err = f.expander(template.DefaultExpander()).Validate(val); err == nil {
 		if err = common.ValidateSubdir(tempExpanded); err == nil {
 			s.curSubdir = val
 		}
@@ -89,6 +91,47 @@ func resolvedVersionsParser(_ *itemParserState, f *File, val string) error {
 	return nil
 }
 
+func requireSignerParser(_ *itemParserState, f *File, val string) error {
+	if err := signing.ValidateKeyID(val); err != nil {
+		return fmt.Errorf("bad $RequireSigner: %s", err)
+	}
+	for _, k := range f.RequireSigners {
+		if k == val {
+			return nil
+		}
+	}
+	f.RequireSigners = append(f.RequireSigners, val)
+	return nil
+}
+
+// varNameRe is a regexp for names of variables declared via $Var.
+var varNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func varParser(_ *itemParserState, f *File, val string) error {
+	chunks := strings.SplitN(val, "=", 2)
+	if len(chunks) != 2 {
+		return fmt.Errorf("expecting '$Var <name>=<value>', got %q", val)
+	}
+	name, value := strings.TrimSpace(chunks[0]), strings.TrimSpace(chunks[1])
+	if !varNameRe.MatchString(name) {
+		return fmt.Errorf("bad $Var name %q, should match %s", name, varNameRe)
+	}
+	if _, ok := template.DefaultExpander()[name]; ok {
+		return fmt.Errorf("bad $Var: ${%s} is a builtin variable and can't be redefined", name)
+	}
+	if _, ok := f.Vars[name]; ok {
+		return fmt.Errorf("$Var %s is already defined", name)
+	}
+	if strings.ContainsAny(value, "${}") {
+		return fmt.Errorf("bad $Var %s: the value can't have templates in it", name)
+	}
+	if f.Vars == nil {
+		f.Vars = map[string]string{}
+	}
+	f.Vars[name] = value
+	return nil
+}
+
 // itemParsers is the main way that the ensure file format is extended. If you
 // need to add a new setting or directive, please add an appropriate function
 // above and then add it to this map.
@@ -98,4 +141,6 @@ var itemParsers = map[string]itemParser{
 	"$verifiedplatform": verifyParser,
 	"$paranoidmode":     paranoidModeParser,
 	"$resolvedversions": resolvedVersionsParser,
+	"$requiresigner":    requireSignerParser,
+	"$var":              varParser,
 }
@@ -44,23 +44,30 @@ func (ps PackageSlice) Len() int           { return len(ps) }
 func (ps PackageSlice) Less(i, j int) bool { return ps[i].PackageTemplate < ps[j].PackageTemplate }
 func (ps PackageSlice) Swap(i, j int)      { ps[i], ps[j] = ps[j], ps[i] }
 
-// Expand expands the package name template and checks that resulting package
-// name and version are syntactically correct.
+// Expand expands the package name template using expander and the version
+// using verExpander and checks that resulting package name and version are
+// syntactically correct.
 //
 // May return template.ErrSkipTemplate is this package definition should be
 // skipped given the current expansion variables values.
-func (p *PackageDef) Expand(expander template.Expander) (pkg string, err error) {
+func (p *PackageDef) Expand(expander, verExpander template.Expander) (pkg, ver string, err error) {
 	switch pkg, err = expander.Expand(p.PackageTemplate); {
 	case err == template.ErrSkipTemplate:
-		return "", err
+		return "", "", err
 	case err != nil:
-		return "", errors.Annotate(err, "failed to expand package template (line %d)", p.LineNo).Err()
+		return "", "", errors.Annotate(err, "failed to expand package template (line %d)", p.LineNo).Err()
+	}
+	switch ver, err = verExpander.Expand(p.UnresolvedVersion); {
+	case err == template.ErrSkipTemplate:
+		return "", "", err
+	case err != nil:
+		return "", "", errors.Annotate(err, "failed to expand package version (line %d)", p.LineNo).Err()
 	}
 	if err = common.ValidatePackageName(pkg); err != nil {
-		return "", errors.Annotate(err, "bad package name (line %d)", p.LineNo).Err()
+		return "", "", errors.Annotate(err, "bad package name (line %d)", p.LineNo).Err()
 	}
-	if err = common.ValidateInstanceVersion(p.UnresolvedVersion); err != nil {
-		return "", errors.Annotate(err, "bad package version (line %d)", p.LineNo).Err()
+	if err = common.ValidateInstanceVersion(ver); err != nil {
+		return "", "", errors.Annotate(err, "bad package version (line %d)", p.LineNo).Err()
 	}
 	return
 }
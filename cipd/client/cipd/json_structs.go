@@ -93,6 +93,22 @@ type TagInfo struct {
 	RegisteredTs UnixTime `json:"registered_ts"`
 }
 
+// MetadataInfo is returned by DescribeInstance.
+type MetadataInfo struct {
+	// Fingerprint identifies this metadata entry.
+	Fingerprint string `json:"fingerprint"`
+	// Key is the metadata key.
+	Key string `json:"key"`
+	// Value is the metadata value.
+	Value []byte `json:"value"`
+	// ContentType is the content type of the metadata value.
+	ContentType string `json:"content_type"`
+	// AttachedBy is identity of whoever attached this metadata.
+	AttachedBy string `json:"attached_by"`
+	// AttachedTs is when the metadata was attached.
+	AttachedTs UnixTime `json:"attached_ts"`
+}
+
 // RefInfo is returned by DescribeInstance and FetchPackageRefs.
 type RefInfo struct {
 	// Ref is the ref name.
@@ -121,6 +137,12 @@ type InstanceDescription struct {
 	//
 	// Present only if DescribeTags in DescribeInstanceOpts is true.
 	Tags []TagInfo `json:"tags,omitempty"`
+
+	// Metadata is a list of metadata entries attached to the instance, sorted by
+	// metadata key and creation timestamp (newest first).
+	//
+	// Present only if DescribeMetadata in DescribeInstanceOpts is true.
+	Metadata []MetadataInfo `json:"metadata,omitempty"`
 }
 
 // ClientDescription contains extended information about a CIPD client binary
@@ -186,6 +208,17 @@ func apiTagToInfo(t *api.Tag) TagInfo {
 	}
 }
 
+func apiMetadataToInfo(md *api.InstanceMetadata) MetadataInfo {
+	return MetadataInfo{
+		Fingerprint: md.Fingerprint,
+		Key:         md.Key,
+		Value:       md.Value,
+		ContentType: md.ContentType,
+		AttachedBy:  md.AttachedBy,
+		AttachedTs:  UnixTime(google.TimeFromProto(md.AttachedTs)),
+	}
+}
+
 func apiDescToInfo(d *api.DescribeInstanceResponse) *InstanceDescription {
 	desc := &InstanceDescription{
 		InstanceInfo: apiInstanceToInfo(d.Instance),
@@ -202,6 +235,12 @@ func apiDescToInfo(d *api.DescribeInstanceResponse) *InstanceDescription {
 			desc.Tags[i] = apiTagToInfo(t)
 		}
 	}
+	if len(d.Metadata) != 0 {
+		desc.Metadata = make([]MetadataInfo, len(d.Metadata))
+		for i, md := range d.Metadata {
+			desc.Metadata[i] = apiMetadataToInfo(md)
+		}
+	}
 	return desc
 }
 
@@ -30,6 +30,18 @@ const (
 
 	// ManifestFormatVersion is a version to write to the manifest file.
 	ManifestFormatVersion = "1.1"
+
+	// ManifestFormatVersionZstd is a version to write to the manifest file of
+	// packages that have files compressed with zstd.
+	//
+	// Clients that don't understand zstd can't install such packages. They
+	// fail with "unsupported compression algorithm" error when extracting files.
+	ManifestFormatVersionZstd = "1.2"
+
+	// ZipMethodZstd is the zip compression method used for zstd-compressed files.
+	//
+	// It is the value assigned to zstd by the WinZip format extension.
+	ZipMethodZstd uint16 = 93
 )
 
 // Manifest defines structure of manifest.json file.
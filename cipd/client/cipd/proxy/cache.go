@@ -0,0 +1,272 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Cache is a disk cache of instance files shared by all clients of the proxy.
+//
+// Unlike the client-side instance cache, it is bounded by the total size of
+// cached files, and it deduplicates concurrent fetches of the same instance, so
+// a thousand of clients asking for the same instance at once result in only one
+// fetch from the upstream.
+//
+// The cache state lives in memory. It is rebuilt from the cache directory when
+// the cache is opened, using files' modification time as the last access time.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	m       sync.Mutex
+	entries map[string]*cacheEntry // instance ID => the entry
+	total   int64                  // total size of all entries
+	pending map[string]*fetchOp    // instance ID => fetch in progress
+}
+
+type cacheEntry struct {
+	size       int64
+	lastAccess time.Time
+}
+
+type fetchOp struct {
+	done chan struct{}
+	err  error
+}
+
+// OpenCache opens (creating, if necessary) a cache in the given directory.
+//
+// Removes leftovers from unfinished fetches and evicts entries that don't fit
+// into 'maxSize' bytes.
+func OpenCache(ctx context.Context, dir string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cache{
+		dir:     dir,
+		maxSize: maxSize,
+		entries: make(map[string]*cacheEntry, len(files)),
+		pending: map[string]*fetchOp{},
+	}
+
+	for _, f := range files {
+		switch {
+		case f.IsDir():
+			continue
+		case strings.HasSuffix(f.Name(), ".tmp"):
+			logging.Infof(ctx, "cipd: removing unfinished fetch %s", f.Name())
+			os.Remove(filepath.Join(dir, f.Name()))
+		case common.ValidateInstanceID(f.Name(), common.AnyHash) == nil:
+			c.entries[f.Name()] = &cacheEntry{
+				size:       f.Size(),
+				lastAccess: f.ModTime(),
+			}
+			c.total += f.Size()
+		}
+	}
+
+	logging.Infof(ctx, "cipd: the cache has %d instance(s), %.1f MB", len(c.entries), float64(c.total)/1000.0/1000.0)
+
+	c.m.Lock()
+	c.evictLocked(ctx, "")
+	c.m.Unlock()
+
+	return c, nil
+}
+
+// Open opens a cached instance file for reading.
+//
+// Returns an os.IsNotExist error if there's no such instance in the cache.
+func (c *Cache) Open(ctx context.Context, instanceID string) (*os.File, error) {
+	if err := common.ValidateInstanceID(instanceID, common.AnyHash); err != nil {
+		return nil, err
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	e := c.entries[instanceID]
+	if e == nil {
+		return nil, os.ErrNotExist
+	}
+	f, err := os.Open(c.path(instanceID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Someone deleted the file from under us. Forget about it.
+			c.total -= e.size
+			delete(c.entries, instanceID)
+		}
+		return nil, err
+	}
+	c.touchLocked(ctx, instanceID, e)
+	return f, nil
+}
+
+// Fetch makes sure the instance is in the cache, calling 'fetch' to get it
+// if necessary.
+//
+// 'fetch' should write the instance body to the given writer and verify its
+// hash. Concurrent calls to Fetch for the same instance share a single 'fetch'
+// call.
+func (c *Cache) Fetch(ctx context.Context, instanceID string, fetch func(w io.Writer) error) error {
+	if err := common.ValidateInstanceID(instanceID, common.AnyHash); err != nil {
+		return err
+	}
+
+	c.m.Lock()
+	if e := c.entries[instanceID]; e != nil {
+		c.touchLocked(ctx, instanceID, e)
+		c.m.Unlock()
+		return nil
+	}
+	op := c.pending[instanceID]
+	if op != nil {
+		c.m.Unlock()
+		select {
+		case <-op.done:
+			return op.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	op = &fetchOp{done: make(chan struct{})}
+	c.pending[instanceID] = op
+	c.m.Unlock()
+
+	// Note: the fetch is done in the context of the first caller. If it gives up,
+	// all other waiters get the error too and will retry on their own.
+	size, err := c.fetchToFile(instanceID, fetch)
+
+	c.m.Lock()
+	if err == nil {
+		c.entries[instanceID] = &cacheEntry{
+			size:       size,
+			lastAccess: clock.Now(ctx),
+		}
+		c.total += size
+		c.evictLocked(ctx, instanceID)
+	}
+	delete(c.pending, instanceID)
+	c.m.Unlock()
+
+	op.err = err
+	close(op.done)
+	return err
+}
+
+// fetchToFile calls 'fetch' to populate a temp file, and then moves the file
+// into its final location.
+func (c *Cache) fetchToFile(instanceID string, fetch func(w io.Writer) error) (size int64, err error) {
+	f, err := ioutil.TempFile(c.dir, instanceID+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		f.Close() // noop if already closed
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+
+	if err = fetch(f); err != nil {
+		return
+	}
+	if size, err = f.Seek(0, io.SeekCurrent); err != nil {
+		return
+	}
+	if err = f.Close(); err != nil {
+		return
+	}
+	err = os.Rename(f.Name(), c.path(instanceID))
+	return
+}
+
+// path returns a path to the instance file in the cache.
+func (c *Cache) path(instanceID string) string {
+	return filepath.Join(c.dir, instanceID)
+}
+
+// touchLocked updates the last access time of the entry.
+//
+// Updates file's modification time too, so the access time survives restarts.
+func (c *Cache) touchLocked(ctx context.Context, instanceID string, e *cacheEntry) {
+	e.lastAccess = clock.Now(ctx)
+	if err := os.Chtimes(c.path(instanceID), e.lastAccess, e.lastAccess); err != nil {
+		logging.Warningf(ctx, "cipd: failed to touch %s - %s", instanceID, err)
+	}
+}
+
+// evictLocked removes the least recently used entries until the cache fits
+// into the size limit.
+//
+// Never evicts 'keep' entry.
+func (c *Cache) evictLocked(ctx context.Context, keep string) {
+	if c.total <= c.maxSize {
+		return
+	}
+
+	type candidate struct {
+		instanceID string
+		*cacheEntry
+	}
+	candidates := make([]candidate, 0, len(c.entries))
+	for iid, e := range c.entries {
+		if iid != keep {
+			candidates = append(candidates, candidate{iid, e})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastAccess.Before(candidates[j].lastAccess)
+	})
+
+	for _, cand := range candidates {
+		if c.total <= c.maxSize {
+			break
+		}
+		logging.Infof(ctx, "cipd: evicting %s (%.1f MB, last accessed %s ago)",
+			cand.instanceID, float64(cand.size)/1000.0/1000.0, clock.Since(ctx, cand.lastAccess))
+		// Note: on POSIX files can be removed while they are being read. On
+		// Windows this fails and the file is left on disk. It will be picked up
+		// again when the cache is reopened.
+		if err := os.Remove(c.path(cand.instanceID)); err != nil && !os.IsNotExist(err) {
+			logging.Warningf(ctx, "cipd: failed to remove %s - %s", cand.instanceID, err)
+		}
+		c.total -= cand.size
+		delete(c.entries, cand.instanceID)
+	}
+
+	if c.total > c.maxSize {
+		logging.Warningf(ctx, "cipd: the cache is over the limit: %d > %d", c.total, c.maxSize)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func fakeIID(letter string) string {
+	return common.ObjectRefToInstanceID(&api.ObjectRef{
+		HashAlgo:  api.HashAlgo_SHA256,
+		HexDigest: strings.Repeat(letter, 64),
+	})
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	Convey("With temp dir", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeLocal)
+
+		dir, err := ioutil.TempDir("", "cipd_proxy_cache")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(dir) })
+
+		put := func(c *Cache, iid, body string) {
+			So(c.Fetch(ctx, iid, func(w io.Writer) error {
+				_, err := w.Write([]byte(body))
+				return err
+			}), ShouldBeNil)
+			tc.Add(time.Second)
+		}
+
+		read := func(c *Cache, iid string) string {
+			f, err := c.Open(ctx, iid)
+			if os.IsNotExist(err) {
+				return ""
+			}
+			So(err, ShouldBeNil)
+			defer f.Close()
+			blob, err := ioutil.ReadAll(f)
+			So(err, ShouldBeNil)
+			tc.Add(time.Second)
+			return string(blob)
+		}
+
+		Convey("Fetch and Open work", func() {
+			c, err := OpenCache(ctx, dir, 100)
+			So(err, ShouldBeNil)
+
+			So(read(c, fakeIID("a")), ShouldEqual, "")
+			put(c, fakeIID("a"), "body a")
+			So(read(c, fakeIID("a")), ShouldEqual, "body a")
+
+			// Already cached, doesn't call the callback.
+			So(c.Fetch(ctx, fakeIID("a"), func(io.Writer) error {
+				panic("must not be called")
+			}), ShouldBeNil)
+		})
+
+		Convey("Failed fetch leaves no garbage", func() {
+			c, err := OpenCache(ctx, dir, 100)
+			So(err, ShouldBeNil)
+
+			err = c.Fetch(ctx, fakeIID("a"), func(w io.Writer) error {
+				w.Write([]byte("partial"))
+				return errors.New("boom")
+			})
+			So(err, ShouldErrLike, "boom")
+			So(read(c, fakeIID("a")), ShouldEqual, "")
+
+			files, err := ioutil.ReadDir(dir)
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 0)
+		})
+
+		Convey("Evicts least recently used", func() {
+			c, err := OpenCache(ctx, dir, 25)
+			So(err, ShouldBeNil)
+
+			put(c, fakeIID("a"), "0123456789")
+			put(c, fakeIID("b"), "0123456789")
+			So(read(c, fakeIID("a")), ShouldNotEqual, "") // touch 'a'
+			put(c, fakeIID("c"), "0123456789")            // evicts 'b'
+
+			So(read(c, fakeIID("a")), ShouldNotEqual, "")
+			So(read(c, fakeIID("b")), ShouldEqual, "")
+			So(read(c, fakeIID("c")), ShouldNotEqual, "")
+
+			Convey("Survives restarts", func() {
+				c, err := OpenCache(ctx, dir, 15)
+				So(err, ShouldBeNil)
+				So(read(c, fakeIID("a")), ShouldEqual, "")
+				So(read(c, fakeIID("c")), ShouldNotEqual, "")
+			})
+		})
+
+		Convey("Cleans up unfinished fetches", func() {
+			tmp := filepath.Join(dir, fakeIID("a")+".123.tmp")
+			So(ioutil.WriteFile(tmp, []byte("zzz"), 0666), ShouldBeNil)
+			_, err := OpenCache(ctx, dir, 100)
+			So(err, ShouldBeNil)
+			_, err = os.Stat(tmp)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("Deduplicates concurrent fetches", func() {
+			c, err := OpenCache(ctx, dir, 100)
+			So(err, ShouldBeNil)
+
+			calls := 0
+			started := make(chan struct{})
+			release := make(chan struct{})
+			fetch := func(w io.Writer) error {
+				calls++ // never called concurrently
+				close(started)
+				<-release
+				_, err := fmt.Fprintf(w, "body")
+				return err
+			}
+
+			// The first fetch blocks in the callback, all others should wait for it.
+			wg := sync.WaitGroup{}
+			errs := make([]error, 10)
+			for i := 0; i < len(errs); i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					errs[i] = c.Fetch(ctx, fakeIID("a"), fetch)
+				}(i)
+				if i == 0 {
+					<-started
+				}
+			}
+			close(release)
+			wg.Wait()
+
+			for _, err := range errs {
+				So(err, ShouldBeNil)
+			}
+			So(calls, ShouldEqual, 1)
+			So(read(c, fakeIID("a")), ShouldEqual, "body")
+		})
+	})
+}
@@ -0,0 +1,330 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxy implements a pull-through caching proxy for the CIPD backend.
+//
+// The proxy implements Repository and Storage pRPC services by forwarding all
+// calls to the upstream backend, passing through credentials of the caller. All
+// ACL checks are thus done by the upstream.
+//
+// Instance bodies are served from a disk cache shared by all clients of the
+// proxy: GetInstanceURL fetches the instance into the cache (if it's not there
+// yet) and returns an URL that points to the proxy itself.
+//
+// Note that cached instances are served to anyone who knows their instance ID,
+// so the proxy should be used only within a trusted network.
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context/ctxhttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Server implements api.RepositoryServer and api.StorageServer by forwarding
+// calls to the upstream and caching instance files.
+type Server struct {
+	// Repository is a client for the upstream Repository service.
+	Repository api.RepositoryClient
+	// Storage is a client for the upstream Storage service.
+	Storage api.StorageClient
+	// Cache is where to put fetched instance files.
+	Cache *Cache
+	// HTTPClient is used to fetch instances from the upstream signed URLs.
+	//
+	// Default is http.DefaultClient.
+	HTTPClient *http.Client
+	// UserAgent is put into User-Agent header when fetching instances.
+	UserAgent string
+}
+
+// instancesPath is a path of an HTTP endpoint that serves cached instances.
+const instancesPath = "/cipd-proxy/instances"
+
+// InstallHandlers installs pRPC and HTTP handlers into the router.
+func (s *Server) InstallHandlers(r *router.Router, base router.MiddlewareChain) {
+	// Remember how clients call us, to construct URLs pointing back to us.
+	base = base.Extend(func(c *router.Context, next router.Handler) {
+		scheme := "http"
+		if c.Request.TLS != nil {
+			scheme = "https"
+		}
+		c.Context = context.WithValue(c.Context, &proxyURLKey, fmt.Sprintf("%s://%s", scheme, c.Request.Host))
+		next(c)
+	})
+
+	srv := &prpc.Server{
+		// All authorization is done by the upstream based on forwarded
+		// credentials.
+		Authenticator: prpc.NoAuthentication,
+	}
+	api.RegisterRepositoryServer(srv, s)
+	api.RegisterStorageServer(srv, s)
+	srv.InstallHandlers(r, base)
+
+	r.GET(instancesPath+"/:iid", base, s.serveInstance)
+}
+
+var proxyURLKey = "proxy URL key"
+
+// forwardAuth copies the caller's credentials into the outgoing context.
+func forwardAuth(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if auth := md["authorization"]; len(auth) != 0 {
+		return metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", auth[0]))
+	}
+	return ctx
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Instance files.
+
+// GetInstanceURL implements the corresponding RPC method, see the proto doc.
+//
+// Fetches the instance into the cache and returns an URL of the cached file.
+func (s *Server) GetInstanceURL(ctx context.Context, r *api.GetInstanceURLRequest) (*api.ObjectURL, error) {
+	// Ask the upstream first, even if the instance is cached. This checks the
+	// caller has access to it.
+	resp, err := s.Repository.GetInstanceURL(forwardAuth(ctx), r)
+	if err != nil {
+		return nil, err
+	}
+
+	iid := common.ObjectRefToInstanceID(r.Instance)
+	err = s.Cache.Fetch(ctx, iid, func(w io.Writer) error {
+		return s.fetchInstance(ctx, resp.SignedUrl, r.Instance, w)
+	})
+	if err != nil {
+		logging.Errorf(ctx, "cipd: failed to fetch %s:%s - %s", r.Package, iid, err)
+		return nil, status.Errorf(codes.Unavailable, "the proxy failed to fetch the instance - %s", err)
+	}
+
+	proxyURL, _ := ctx.Value(&proxyURLKey).(string)
+	return &api.ObjectURL{
+		SignedUrl: fmt.Sprintf("%s%s/%s", proxyURL, instancesPath, iid),
+	}, nil
+}
+
+// fetchInstance downloads the instance file from the upstream signed URL,
+// verifying its hash.
+func (s *Server) fetchInstance(ctx context.Context, url string, ref *api.ObjectRef, w io.Writer) error {
+	logging.Infof(ctx, "cipd: fetching %s", common.ObjectRefToInstanceID(ref))
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	if s.UserAgent != "" {
+		req.Header.Set("User-Agent", s.UserAgent)
+	}
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := ctxhttp.Do(ctx, client, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status %d", resp.StatusCode)
+	}
+
+	hash := common.MustNewHash(ref.HashAlgo)
+	if _, err := io.Copy(io.MultiWriter(w, hash), resp.Body); err != nil {
+		return err
+	}
+	if digest := common.HexDigest(hash); digest != ref.HexDigest {
+		return fmt.Errorf("package hash mismatch: expecting %q, got %q", ref.HexDigest, digest)
+	}
+	return nil
+}
+
+// serveInstance serves the body of a cached instance.
+func (s *Server) serveInstance(c *router.Context) {
+	iid := c.Params.ByName("iid")
+	switch f, err := s.Cache.Open(c.Context, iid); {
+	case os.IsNotExist(err):
+		http.Error(c.Writer, "no such instance in the cache, call GetInstanceURL first", http.StatusNotFound)
+	case err != nil:
+		logging.Errorf(c.Context, "cipd: failed to open cached %s - %s", iid, err)
+		http.Error(c.Writer, "failed to open the instance file", http.StatusInternalServerError)
+	default:
+		defer f.Close()
+		c.Writer.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(c.Writer, c.Request, "", time.Time{}, f)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Repository service, forwarded as is.
+
+// GetPrefixMetadata implements the corresponding RPC method, see the proto doc.
+func (s *Server) GetPrefixMetadata(ctx context.Context, r *api.PrefixRequest) (*api.PrefixMetadata, error) {
+	return s.Repository.GetPrefixMetadata(forwardAuth(ctx), r)
+}
+
+// GetInheritedPrefixMetadata implements the corresponding RPC method, see the
+// proto doc.
+func (s *Server) GetInheritedPrefixMetadata(ctx context.Context, r *api.PrefixRequest) (*api.InheritedPrefixMetadata, error) {
+	return s.Repository.GetInheritedPrefixMetadata(forwardAuth(ctx), r)
+}
+
+// UpdatePrefixMetadata implements the corresponding RPC method, see the proto
+// doc.
+func (s *Server) UpdatePrefixMetadata(ctx context.Context, r *api.PrefixMetadata) (*api.PrefixMetadata, error) {
+	return s.Repository.UpdatePrefixMetadata(forwardAuth(ctx), r)
+}
+
+// GetRolesInPrefix implements the corresponding RPC method, see the proto doc.
+func (s *Server) GetRolesInPrefix(ctx context.Context, r *api.PrefixRequest) (*api.RolesInPrefixResponse, error) {
+	return s.Repository.GetRolesInPrefix(forwardAuth(ctx), r)
+}
+
+// ListPrefix implements the corresponding RPC method, see the proto doc.
+func (s *Server) ListPrefix(ctx context.Context, r *api.ListPrefixRequest) (*api.ListPrefixResponse, error) {
+	return s.Repository.ListPrefix(forwardAuth(ctx), r)
+}
+
+// HidePackage implements the corresponding RPC method, see the proto doc.
+func (s *Server) HidePackage(ctx context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return s.Repository.HidePackage(forwardAuth(ctx), r)
+}
+
+// UnhidePackage implements the corresponding RPC method, see the proto doc.
+func (s *Server) UnhidePackage(ctx context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return s.Repository.UnhidePackage(forwardAuth(ctx), r)
+}
+
+// DeletePackage implements the corresponding RPC method, see the proto doc.
+func (s *Server) DeletePackage(ctx context.Context, r *api.PackageRequest) (*empty.Empty, error) {
+	return s.Repository.DeletePackage(forwardAuth(ctx), r)
+}
+
+// RegisterInstance implements the corresponding RPC method, see the proto doc.
+func (s *Server) RegisterInstance(ctx context.Context, r *api.Instance) (*api.RegisterInstanceResponse, error) {
+	return s.Repository.RegisterInstance(forwardAuth(ctx), r)
+}
+
+// ListInstances implements the corresponding RPC method, see the proto doc.
+func (s *Server) ListInstances(ctx context.Context, r *api.ListInstancesRequest) (*api.ListInstancesResponse, error) {
+	return s.Repository.ListInstances(forwardAuth(ctx), r)
+}
+
+// SearchInstances implements the corresponding RPC method, see the proto doc.
+func (s *Server) SearchInstances(ctx context.Context, r *api.SearchInstancesRequest) (*api.SearchInstancesResponse, error) {
+	return s.Repository.SearchInstances(forwardAuth(ctx), r)
+}
+
+// CreateRef implements the corresponding RPC method, see the proto doc.
+func (s *Server) CreateRef(ctx context.Context, r *api.Ref) (*empty.Empty, error) {
+	return s.Repository.CreateRef(forwardAuth(ctx), r)
+}
+
+// DeleteRef implements the corresponding RPC method, see the proto doc.
+func (s *Server) DeleteRef(ctx context.Context, r *api.DeleteRefRequest) (*empty.Empty, error) {
+	return s.Repository.DeleteRef(forwardAuth(ctx), r)
+}
+
+// ListRefs implements the corresponding RPC method, see the proto doc.
+func (s *Server) ListRefs(ctx context.Context, r *api.ListRefsRequest) (*api.ListRefsResponse, error) {
+	return s.Repository.ListRefs(forwardAuth(ctx), r)
+}
+
+// AttachTags implements the corresponding RPC method, see the proto doc.
+func (s *Server) AttachTags(ctx context.Context, r *api.AttachTagsRequest) (*empty.Empty, error) {
+	return s.Repository.AttachTags(forwardAuth(ctx), r)
+}
+
+// DetachTags implements the corresponding RPC method, see the proto doc.
+func (s *Server) DetachTags(ctx context.Context, r *api.DetachTagsRequest) (*empty.Empty, error) {
+	return s.Repository.DetachTags(forwardAuth(ctx), r)
+}
+
+// AttachMetadata implements the corresponding RPC method, see the proto doc.
+func (s *Server) AttachMetadata(ctx context.Context, r *api.AttachMetadataRequest) (*empty.Empty, error) {
+	return s.Repository.AttachMetadata(forwardAuth(ctx), r)
+}
+
+// DetachMetadata implements the corresponding RPC method, see the proto doc.
+func (s *Server) DetachMetadata(ctx context.Context, r *api.DetachMetadataRequest) (*empty.Empty, error) {
+	return s.Repository.DetachMetadata(forwardAuth(ctx), r)
+}
+
+// ListMetadata implements the corresponding RPC method, see the proto doc.
+func (s *Server) ListMetadata(ctx context.Context, r *api.ListMetadataRequest) (*api.ListMetadataResponse, error) {
+	return s.Repository.ListMetadata(forwardAuth(ctx), r)
+}
+
+// ResolveVersion implements the corresponding RPC method, see the proto doc.
+func (s *Server) ResolveVersion(ctx context.Context, r *api.ResolveVersionRequest) (*api.Instance, error) {
+	return s.Repository.ResolveVersion(forwardAuth(ctx), r)
+}
+
+// DescribeInstance implements the corresponding RPC method, see the proto doc.
+func (s *Server) DescribeInstance(ctx context.Context, r *api.DescribeInstanceRequest) (*api.DescribeInstanceResponse, error) {
+	return s.Repository.DescribeInstance(forwardAuth(ctx), r)
+}
+
+// DescribeClient implements the corresponding RPC method, see the proto doc.
+//
+// The client binary URL is not rewritten, the client is fetched directly from
+// the upstream storage.
+func (s *Server) DescribeClient(ctx context.Context, r *api.DescribeClientRequest) (*api.DescribeClientResponse, error) {
+	return s.Repository.DescribeClient(forwardAuth(ctx), r)
+}
+
+// ListEvents implements the corresponding RPC method, see the proto doc.
+func (s *Server) ListEvents(ctx context.Context, r *api.ListEventsRequest) (*api.ListEventsResponse, error) {
+	return s.Repository.ListEvents(forwardAuth(ctx), r)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Storage service, forwarded as is.
+
+// GetObjectURL implements the corresponding RPC method, see the proto doc.
+func (s *Server) GetObjectURL(ctx context.Context, r *api.GetObjectURLRequest) (*api.ObjectURL, error) {
+	return s.Storage.GetObjectURL(forwardAuth(ctx), r)
+}
+
+// BeginUpload implements the corresponding RPC method, see the proto doc.
+func (s *Server) BeginUpload(ctx context.Context, r *api.BeginUploadRequest) (*api.UploadOperation, error) {
+	return s.Storage.BeginUpload(forwardAuth(ctx), r)
+}
+
+// FinishUpload implements the corresponding RPC method, see the proto doc.
+func (s *Server) FinishUpload(ctx context.Context, r *api.FinishUploadRequest) (*api.UploadOperation, error) {
+	return s.Storage.FinishUpload(forwardAuth(ctx), r)
+}
+
+// CancelUpload implements the corresponding RPC method, see the proto doc.
+func (s *Server) CancelUpload(ctx context.Context, r *api.CancelUploadRequest) (*api.UploadOperation, error) {
+	return s.Storage.CancelUpload(forwardAuth(ctx), r)
+}
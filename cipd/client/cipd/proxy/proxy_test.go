@@ -0,0 +1,149 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeUpstream implements only methods used by the test.
+type fakeUpstream struct {
+	api.RepositoryClient
+
+	signedURL string
+	calls     int
+}
+
+func (f *fakeUpstream) GetInstanceURL(ctx context.Context, in *api.GetInstanceURLRequest, opts ...grpc.CallOption) (*api.ObjectURL, error) {
+	f.calls++
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if auth := md["authorization"]; len(auth) == 0 || auth[0] != "Bearer good" {
+		return nil, status.Errorf(codes.PermissionDenied, "no access")
+	}
+	return &api.ObjectURL{SignedUrl: f.signedURL}, nil
+}
+
+func TestProxy(t *testing.T) {
+	t.Parallel()
+
+	Convey("With proxy", t, func() {
+		ctx := context.Background()
+
+		body := []byte("instance body")
+		digest := sha256.Sum256(body)
+		ref := &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: hex.EncodeToString(digest[:]),
+		}
+
+		gsFetches := 0
+		gs := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			gsFetches++
+			rw.Write(body)
+		}))
+		Reset(gs.Close)
+
+		dir, err := ioutil.TempDir("", "cipd_proxy")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(dir) })
+		cache, err := OpenCache(ctx, dir, 1000)
+		So(err, ShouldBeNil)
+
+		upstream := &fakeUpstream{signedURL: gs.URL + "/signed"}
+		srv := &Server{
+			Repository: upstream,
+			Cache:      cache,
+		}
+
+		r := router.New()
+		srv.InstallHandlers(r, router.MiddlewareChain{})
+		ts := httptest.NewServer(r)
+		Reset(ts.Close)
+
+		u, err := url.Parse(ts.URL)
+		So(err, ShouldBeNil)
+		client := api.NewRepositoryPRPCClient(&prpc.Client{
+			Host:    u.Host,
+			Options: &prpc.Options{Insecure: true},
+		})
+
+		getURL := func(token string) (*api.ObjectURL, error) {
+			return client.GetInstanceURL(
+				metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token)),
+				&api.GetInstanceURLRequest{Package: "a/b", Instance: ref})
+		}
+
+		fetch := func(url string) (int, string) {
+			resp, err := http.Get(url)
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			blob, err := ioutil.ReadAll(resp.Body)
+			So(err, ShouldBeNil)
+			return resp.StatusCode, string(blob)
+		}
+
+		Convey("Happy path", func() {
+			for i := 0; i < 3; i++ {
+				objURL, err := getURL("good")
+				So(err, ShouldBeNil)
+				So(objURL.SignedUrl, ShouldStartWith, ts.URL+instancesPath+"/")
+
+				code, blob := fetch(objURL.SignedUrl)
+				So(code, ShouldEqual, http.StatusOK)
+				So(blob, ShouldEqual, string(body))
+			}
+			So(upstream.calls, ShouldEqual, 3) // always asks upstream
+			So(gsFetches, ShouldEqual, 1)      // but fetches only once
+		})
+
+		Convey("Forwards credentials", func() {
+			_, err := getURL("bad")
+			So(grpcutil.Code(err), ShouldEqual, codes.PermissionDenied)
+			So(gsFetches, ShouldEqual, 0)
+		})
+
+		Convey("Hash mismatch", func() {
+			body = []byte("something else")
+			_, err := getURL("good")
+			So(grpcutil.Code(err), ShouldEqual, codes.Unavailable)
+		})
+
+		Convey("Unknown instance", func() {
+			code, _ := fetch(ts.URL + instancesPath + "/" + fakeIID("a"))
+			So(code, ShouldEqual, http.StatusNotFound)
+		})
+	})
+}
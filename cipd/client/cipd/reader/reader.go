@@ -32,6 +32,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
+
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/sync/parallel"
@@ -518,6 +520,7 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 	if err != nil {
 		return err
 	}
+	inst.zip.RegisterDecompressor(pkg.ZipMethodZstd, zstdDecompressor)
 	inst.files = make([]fs.File, len(inst.zip.File))
 	for i, zf := range inst.zip.File {
 		fiz := &fileInZip{z: zf}
@@ -533,6 +536,10 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 		inst.files[i] = fiz
 	}
 
+	if err = checkFormatVersion(inst.manifest.FormatVersion); err != nil {
+		return err
+	}
+
 	// Version "1" (legacy format) used to set the writable mode bit (0200) in
 	// zipped files, and then ignored it when unpacking. Newer versions respect
 	// the writable mode bit. Strip it off for the version "1", to preserve
@@ -584,6 +591,19 @@ func (inst *packageInstance) Close(ctx context.Context, corrupt bool) error {
 	return inst.data.Close(ctx, corrupt)
 }
 
+// zstdDecompressor is shared by all instances to reuse zstd decoders.
+var zstdDecompressor = zstd.ZipDecompressor()
+
+// checkFormatVersion returns an error if the package was built by a newer
+// client in a format this client doesn't understand.
+func checkFormatVersion(v string) error {
+	switch v {
+	case "", "1", pkg.ManifestFormatVersion, pkg.ManifestFormatVersionZstd:
+		return nil
+	}
+	return fmt.Errorf("unsupported package format version %q, the CIPD client is too old, update it", v)
+}
+
 // IsCorruptionError returns true iff err indicates corruption.
 func IsCorruptionError(err error) bool {
 	switch err {
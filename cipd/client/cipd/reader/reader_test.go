@@ -15,6 +15,7 @@
 package reader
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -23,6 +24,7 @@ import (
 	"io/ioutil"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -34,6 +36,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/cipd/common"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func stringCounts(values []string) map[string]int {
@@ -530,6 +533,65 @@ func TestPackageReading(t *testing.T) {
 		So(string(dest.fileByName(".cipdpkg/manifest.json").Bytes()),
 			shouldBeSameJSONDict, goodManifest)
 	})
+
+	Convey("ExtractFiles handles zstd packages", t, func() {
+		body := strings.Repeat("zstd compresses this well ", 100)
+		inFiles := []fs.File{
+			fs.NewTestFile("testing/qwerty", body, fs.TestFileOpts{}),
+			fs.NewTestFile("already.zip", "zzz", fs.TestFileOpts{}),
+		}
+
+		out := bytes.Buffer{}
+		pin, err := builder.BuildInstance(ctx, builder.Options{
+			Input:            inFiles,
+			Output:           &out,
+			PackageName:      "testing",
+			CompressionLevel: 5,
+			CompressionAlgo:  builder.Zstd,
+		})
+		So(err, ShouldBeNil)
+
+		inst, err := OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: VerifyHash,
+			InstanceID:       pin.InstanceID,
+		})
+		So(err, ShouldBeNil)
+		defer inst.Close(ctx, false)
+
+		// The manifest is readable by everyone, the rest uses zstd.
+		methods := map[string]uint16{}
+		for _, zf := range inst.(*packageInstance).zip.File {
+			methods[zf.Name] = zf.Method
+		}
+		So(methods, ShouldResemble, map[string]uint16{
+			"testing/qwerty":         pkg.ZipMethodZstd,
+			"already.zip":            zip.Store,
+			".cipdpkg/manifest.json": zip.Deflate,
+		})
+
+		dest := &testDestination{}
+		_, err = ExtractFilesTxn(ctx, inst.Files(), dest, 16, pkg.WithManifest)
+		So(err, ShouldBeNil)
+		So(string(dest.fileByName("testing/qwerty").Bytes()), ShouldEqual, body)
+		So(string(dest.fileByName("already.zip").Bytes()), ShouldEqual, "zzz")
+	})
+
+	Convey("Packages in unknown format are rejected", t, func() {
+		out := bytes.Buffer{}
+		_, err := builder.BuildInstance(ctx, builder.Options{
+			Output:                &out,
+			PackageName:           "testing",
+			OverrideFormatVersion: "99",
+		})
+		So(err, ShouldBeNil)
+
+		_, err = OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		So(err, ShouldErrLike, `unsupported package format version "99"`)
+		So(IsCorruptionError(err), ShouldBeFalse)
+	})
 }
 
 ////////////////////////////////////////////////////////////////////////////////
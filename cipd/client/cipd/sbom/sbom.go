@@ -0,0 +1,251 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom implements generation of software bills of materials describing
+// packages installed in a CIPD site root.
+//
+// Supports SPDX 2.3 and CycloneDX 1.4 formats (both in their JSON encoding).
+// Each installed package instance becomes a single package (or a component)
+// in the document, identified by the package name and its instance ID.
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// Format is a supported SBOM format.
+type Format string
+
+const (
+	// SPDX is SPDX 2.3 JSON format.
+	SPDX Format = "spdx"
+	// CycloneDX is CycloneDX 1.4 JSON format.
+	CycloneDX Format = "cyclonedx"
+)
+
+// Package is a package instance installed in the site root.
+type Package struct {
+	Subdir string     // a site root subdirectory the package is installed into
+	Pin    common.Pin // the installed package instance
+}
+
+// Document describes all packages installed in the site root.
+type Document struct {
+	Name       string    // a human readable name of the document, e.g. the site root path
+	ID         string    // a unique ID of the document, must be a UUID
+	Created    time.Time // when the document was generated
+	Tool       string    // a tool that generated the document, e.g. cipd.UserAgent
+	ServiceURL string    // a CIPD backend URL the packages were installed from
+	Packages   []Package // all installed packages
+}
+
+// Write serializes the document in the given format.
+func Write(w io.Writer, doc *Document, format Format) error {
+	var out interface{}
+	var err error
+	switch format {
+	case SPDX:
+		out, err = toSPDX(doc)
+	case CycloneDX:
+		out, err = toCycloneDX(doc)
+	default:
+		return fmt.Errorf("unsupported SBOM format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// downloadURL returns a URL to fetch the instance from.
+func downloadURL(serviceURL string, pin common.Pin) string {
+	return fmt.Sprintf("%s/dl/%s/+/%s", strings.TrimSuffix(serviceURL, "/"), pin.PackageName, pin.InstanceID)
+}
+
+// instanceHash returns a hash algo and hex digest of the instance.
+func instanceHash(pin common.Pin) (api.HashAlgo, string, error) {
+	if err := common.ValidateInstanceID(pin.InstanceID, common.KnownHash); err != nil {
+		return 0, "", err
+	}
+	ref := common.InstanceIDToObjectRef(pin.InstanceID)
+	return ref.HashAlgo, ref.HexDigest, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SPDX.
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string         `json:"name"`
+	SPDXID           string         `json:"SPDXID"`
+	VersionInfo      string         `json:"versionInfo"`
+	DownloadLocation string         `json:"downloadLocation"`
+	FilesAnalyzed    bool           `json:"filesAnalyzed"`
+	Checksums        []spdxChecksum `json:"checksums"`
+	Comment          string         `json:"comment,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func toSPDX(doc *Document) (*spdxDocument, error) {
+	out := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              doc.Name,
+		DocumentNamespace: "https://spdx.org/spdxdocs/cipd-" + doc.ID,
+		CreationInfo: spdxCreationInfo{
+			Created:  doc.Created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + doc.Tool},
+		},
+		Packages:      make([]spdxPackage, len(doc.Packages)),
+		Relationships: make([]spdxRelationship, len(doc.Packages)),
+	}
+	for i, p := range doc.Packages {
+		algo, digest, err := instanceHash(p.Pin)
+		if err != nil {
+			return nil, err
+		}
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		out.Packages[i] = spdxPackage{
+			Name:             p.Pin.PackageName,
+			SPDXID:           id,
+			VersionInfo:      p.Pin.InstanceID,
+			DownloadLocation: downloadURL(doc.ServiceURL, p.Pin),
+			Checksums:        []spdxChecksum{{Algorithm: algo.String(), ChecksumValue: digest}},
+			Comment:          fmt.Sprintf("Installed into %q subdirectory", p.Subdir),
+		}
+		out.Relationships[i] = spdxRelationship{
+			SPDXElementID:      out.SPDXID,
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: id,
+		}
+	}
+	return out, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// CycloneDX.
+
+type cdxDocument struct {
+	BOMFormat    string         `json:"bomFormat"`
+	SpecVersion  string         `json:"specVersion"`
+	SerialNumber string         `json:"serialNumber"`
+	Version      int            `json:"version"`
+	Metadata     cdxMetadata    `json:"metadata"`
+	Components   []cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Timestamp string    `json:"timestamp"`
+	Tools     []cdxTool `json:"tools"`
+}
+
+type cdxTool struct {
+	Name string `json:"name"`
+}
+
+type cdxComponent struct {
+	Type               string           `json:"type"`
+	BOMRef             string           `json:"bom-ref"`
+	Name               string           `json:"name"`
+	Version            string           `json:"version"`
+	Hashes             []cdxHash        `json:"hashes"`
+	ExternalReferences []cdxExternalRef `json:"externalReferences"`
+	Properties         []cdxProperty    `json:"properties"`
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cdxExternalRef struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// cdxHashAlgos maps CIPD hash algos to CycloneDX ones.
+var cdxHashAlgos = map[api.HashAlgo]string{
+	api.HashAlgo_SHA1:   "SHA-1",
+	api.HashAlgo_SHA256: "SHA-256",
+}
+
+func toCycloneDX(doc *Document) (*cdxDocument, error) {
+	out := &cdxDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: "urn:uuid:" + doc.ID,
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: doc.Created.UTC().Format(time.RFC3339),
+			Tools:     []cdxTool{{Name: doc.Tool}},
+		},
+		Components: make([]cdxComponent, len(doc.Packages)),
+	}
+	for i, p := range doc.Packages {
+		algo, digest, err := instanceHash(p.Pin)
+		if err != nil {
+			return nil, err
+		}
+		out.Components[i] = cdxComponent{
+			Type:               "application",
+			BOMRef:             fmt.Sprintf("%s/%s@%s", p.Subdir, p.Pin.PackageName, p.Pin.InstanceID),
+			Name:               p.Pin.PackageName,
+			Version:            p.Pin.InstanceID,
+			Hashes:             []cdxHash{{Alg: cdxHashAlgos[algo], Content: digest}},
+			ExternalReferences: []cdxExternalRef{{Type: "distribution", URL: downloadURL(doc.ServiceURL, p.Pin)}},
+			Properties:         []cdxProperty{{Name: "cipd:subdir", Value: p.Subdir}},
+		}
+	}
+	return out, nil
+}
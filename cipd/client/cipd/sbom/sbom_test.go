@@ -0,0 +1,126 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	Convey("With a document", t, func() {
+		sha256iid := common.ObjectRefToInstanceID(&api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("b", 64),
+		})
+		doc := &Document{
+			Name:       "/site/root",
+			ID:         "00000000-0000-0000-0000-000000000001",
+			Created:    time.Date(2019, time.December, 1, 2, 3, 4, 0, time.UTC),
+			Tool:       "cipd 2.3.0",
+			ServiceURL: "https://cipd.example.com/",
+			Packages: []Package{
+				{Subdir: "", Pin: common.Pin{PackageName: "a/pkg", InstanceID: strings.Repeat("a", 40)}},
+				{Subdir: "sub", Pin: common.Pin{PackageName: "b/pkg", InstanceID: sha256iid}},
+			},
+		}
+
+		write := func(f Format) map[string]interface{} {
+			buf := bytes.Buffer{}
+			So(Write(&buf, doc, f), ShouldBeNil)
+			out := map[string]interface{}{}
+			So(json.Unmarshal(buf.Bytes(), &out), ShouldBeNil)
+			return out
+		}
+
+		Convey("SPDX", func() {
+			out := write(SPDX)
+			So(out["spdxVersion"], ShouldEqual, "SPDX-2.3")
+			So(out["documentNamespace"], ShouldEqual, "https://spdx.org/spdxdocs/cipd-00000000-0000-0000-0000-000000000001")
+			So(out["creationInfo"], ShouldResemble, map[string]interface{}{
+				"created":  "2019-12-01T02:03:04Z",
+				"creators": []interface{}{"Tool: cipd 2.3.0"},
+			})
+			So(out["packages"], ShouldResemble, []interface{}{
+				map[string]interface{}{
+					"name":             "a/pkg",
+					"SPDXID":           "SPDXRef-Package-1",
+					"versionInfo":      strings.Repeat("a", 40),
+					"downloadLocation": "https://cipd.example.com/dl/a/pkg/+/" + strings.Repeat("a", 40),
+					"filesAnalyzed":    false,
+					"checksums": []interface{}{
+						map[string]interface{}{"algorithm": "SHA1", "checksumValue": strings.Repeat("a", 40)},
+					},
+					"comment": `Installed into "" subdirectory`,
+				},
+				map[string]interface{}{
+					"name":             "b/pkg",
+					"SPDXID":           "SPDXRef-Package-2",
+					"versionInfo":      sha256iid,
+					"downloadLocation": "https://cipd.example.com/dl/b/pkg/+/" + sha256iid,
+					"filesAnalyzed":    false,
+					"checksums": []interface{}{
+						map[string]interface{}{"algorithm": "SHA256", "checksumValue": strings.Repeat("b", 64)},
+					},
+					"comment": `Installed into "sub" subdirectory`,
+				},
+			})
+			So(out["relationships"], ShouldHaveLength, 2)
+		})
+
+		Convey("CycloneDX", func() {
+			out := write(CycloneDX)
+			So(out["bomFormat"], ShouldEqual, "CycloneDX")
+			So(out["serialNumber"], ShouldEqual, "urn:uuid:00000000-0000-0000-0000-000000000001")
+			comps := out["components"].([]interface{})
+			So(comps, ShouldHaveLength, 2)
+			So(comps[1], ShouldResemble, map[string]interface{}{
+				"type":    "application",
+				"bom-ref": "sub/b/pkg@" + sha256iid,
+				"name":    "b/pkg",
+				"version": sha256iid,
+				"hashes": []interface{}{
+					map[string]interface{}{"alg": "SHA-256", "content": strings.Repeat("b", 64)},
+				},
+				"externalReferences": []interface{}{
+					map[string]interface{}{"type": "distribution", "url": "https://cipd.example.com/dl/b/pkg/+/" + sha256iid},
+				},
+				"properties": []interface{}{
+					map[string]interface{}{"name": "cipd:subdir", "value": "sub"},
+				},
+			})
+		})
+
+		Convey("Bad format", func() {
+			So(Write(&bytes.Buffer{}, doc, "zzz"), ShouldErrLike, "unsupported SBOM format")
+		})
+
+		Convey("Bad instance ID", func() {
+			doc.Packages[0].Pin.InstanceID = "zzz"
+			So(Write(&bytes.Buffer{}, doc, SPDX), ShouldNotBeNil)
+		})
+	})
+}
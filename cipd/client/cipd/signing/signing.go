@@ -0,0 +1,192 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing implements signing of package instances and verification of
+// their signatures.
+//
+// A signature is an ed25519 signature of the package name and the instance ID.
+// Since the instance ID is a hash of the instance file, the signature covers
+// the content of the instance too (as long as the client verifies the hash of
+// the fetched file, which it always does).
+//
+// Signatures are detached: they are stored in the backend as instance metadata
+// with MetadataKey key. An instance can have many signatures, e.g. one per
+// signer.
+//
+// Keys are identified by key IDs that embed the public key itself:
+// "ed25519-<base64url of the public key>". Thus a key ID is all that is needed
+// to verify a signature, and there's no need to distribute public keys
+// separately.
+package signing
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/crypto/ed25519"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/common"
+)
+
+const (
+	// MetadataKey is an instance metadata key used to store signatures.
+	MetadataKey = "cipd_signature"
+
+	// ContentType is a content type of the signature metadata.
+	ContentType = "application/json"
+
+	// keyIDPrefix is a prefix of all key IDs.
+	keyIDPrefix = "ed25519-"
+	// payloadPrefix is prepended to the signed payload to version it.
+	payloadPrefix = "cipd instance signature v1\n"
+)
+
+// PrivateKey is a key used to sign instances.
+type PrivateKey struct {
+	key ed25519.PrivateKey
+}
+
+// keyFile is JSON representation of a private key on disk.
+type keyFile struct {
+	KeyID      string `json:"key_id"`
+	PrivateKey []byte `json:"private_key"`
+}
+
+// signature is JSON representation of a signature stored as metadata.
+type signature struct {
+	KeyID     string `json:"key_id"`
+	Signature []byte `json:"signature"`
+}
+
+// GenerateKey generates a new random private key.
+func GenerateKey() (*PrivateKey, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateKey{priv}, nil
+}
+
+// LoadPrivateKey loads a private key from a JSON file written by Save.
+func LoadPrivateKey(path string) (*PrivateKey, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	kf := keyFile{}
+	if err := json.Unmarshal(blob, &kf); err != nil {
+		return nil, errors.Annotate(err, "bad private key file %q", path).Err()
+	}
+	if len(kf.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, errors.Reason("bad private key file %q: wrong key length", path).Err()
+	}
+	k := &PrivateKey{ed25519.PrivateKey(kf.PrivateKey)}
+	if kf.KeyID != k.KeyID() {
+		return nil, errors.Reason("bad private key file %q: the key ID doesn't match the key", path).Err()
+	}
+	return k, nil
+}
+
+// Save writes the private key to a JSON file readable only by the owner.
+func (k *PrivateKey) Save(path string) error {
+	blob, err := json.MarshalIndent(&keyFile{
+		KeyID:      k.KeyID(),
+		PrivateKey: k.key,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, blob, 0600)
+}
+
+// KeyID returns the ID of the public portion of the key.
+func (k *PrivateKey) KeyID() string {
+	return keyIDPrefix + base64.RawURLEncoding.EncodeToString(k.key.Public().(ed25519.PublicKey))
+}
+
+// Sign returns a signature of the given instance, to be stored as metadata.
+func (k *PrivateKey) Sign(pin common.Pin) ([]byte, error) {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&signature{
+		KeyID:     k.KeyID(),
+		Signature: ed25519.Sign(k.key, payload(pin)),
+	})
+}
+
+// ValidateKeyID returns an error if the given string is not a valid key ID.
+func ValidateKeyID(keyID string) error {
+	_, err := publicKey(keyID)
+	return err
+}
+
+// Verify checks that at least one of the given signatures is a valid signature
+// of the instance made by one of the given keys.
+//
+// Signatures made by other keys are ignored, and so are malformed ones: anyone
+// with write access to the package can attach whatever metadata they like.
+func Verify(pin common.Pin, signatures [][]byte, keyIDs []string) error {
+	trusted := make(map[string]ed25519.PublicKey, len(keyIDs))
+	for _, id := range keyIDs {
+		pub, err := publicKey(id)
+		if err != nil {
+			return err
+		}
+		trusted[id] = pub
+	}
+
+	msg := payload(pin)
+	for _, blob := range signatures {
+		sig := signature{}
+		if json.Unmarshal(blob, &sig) != nil {
+			continue
+		}
+		if pub := trusted[sig.KeyID]; pub != nil && ed25519.Verify(pub, msg, sig.Signature) {
+			return nil
+		}
+	}
+
+	if len(signatures) == 0 {
+		return errors.Reason("%s is not signed", pin).Err()
+	}
+	return errors.Reason("%s is not signed by any of the required signers (%s)",
+		pin, strings.Join(keyIDs, ", ")).Err()
+}
+
+// publicKey extracts the public key from a key ID.
+func publicKey(keyID string) (ed25519.PublicKey, error) {
+	if !strings.HasPrefix(keyID, keyIDPrefix) {
+		return nil, fmt.Errorf("bad key ID %q: should start with %q", keyID, keyIDPrefix)
+	}
+	pub, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(keyID, keyIDPrefix))
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("bad key ID %q: not a base64-encoded ed25519 public key", keyID)
+	}
+	return ed25519.PublicKey(pub), nil
+}
+
+// payload returns the bytes that are actually signed.
+//
+// The package name is signed too, so that the signature can't be reused for
+// the same file uploaded under another package name.
+func payload(pin common.Pin) []byte {
+	return []byte(payloadPrefix + pin.PackageName + "\n" + pin.InstanceID)
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSigning(t *testing.T) {
+	t.Parallel()
+
+	Convey("With keys", t, func() {
+		k1, err := GenerateKey()
+		So(err, ShouldBeNil)
+		k2, err := GenerateKey()
+		So(err, ShouldBeNil)
+
+		pin := common.Pin{
+			PackageName: "a/b",
+			InstanceID:  strings.Repeat("a", 40),
+		}
+		sig1, err := k1.Sign(pin)
+		So(err, ShouldBeNil)
+		sig2, err := k2.Sign(pin)
+		So(err, ShouldBeNil)
+
+		Convey("Key IDs are valid", func() {
+			So(ValidateKeyID(k1.KeyID()), ShouldBeNil)
+			So(k1.KeyID(), ShouldNotEqual, k2.KeyID())
+		})
+
+		Convey("Verify OK", func() {
+			So(Verify(pin, [][]byte{sig1}, []string{k1.KeyID()}), ShouldBeNil)
+			So(Verify(pin, [][]byte{sig1, sig2}, []string{k2.KeyID()}), ShouldBeNil)
+			So(Verify(pin, [][]byte{[]byte("garbage"), sig2}, []string{k1.KeyID(), k2.KeyID()}), ShouldBeNil)
+		})
+
+		Convey("Not signed", func() {
+			So(Verify(pin, nil, []string{k1.KeyID()}), ShouldErrLike, "is not signed")
+		})
+
+		Convey("Wrong signer", func() {
+			So(Verify(pin, [][]byte{sig2}, []string{k1.KeyID()}), ShouldErrLike,
+				"is not signed by any of the required signers")
+		})
+
+		Convey("Another instance", func() {
+			another := pin
+			another.InstanceID = strings.Repeat("b", 40)
+			So(Verify(another, [][]byte{sig1}, []string{k1.KeyID()}), ShouldErrLike,
+				"is not signed by any of the required signers")
+		})
+
+		Convey("Another package", func() {
+			another := pin
+			another.PackageName = "a/c"
+			So(Verify(another, [][]byte{sig1}, []string{k1.KeyID()}), ShouldErrLike,
+				"is not signed by any of the required signers")
+		})
+
+		Convey("Bad key ID", func() {
+			So(ValidateKeyID("zzz"), ShouldErrLike, "should start with")
+			So(ValidateKeyID("ed25519-zzz"), ShouldErrLike, "not a base64-encoded ed25519 public key")
+			So(Verify(pin, [][]byte{sig1}, []string{"zzz"}), ShouldErrLike, "bad key ID")
+		})
+
+		Convey("Save and load", func() {
+			tmp, err := ioutil.TempDir("", "cipd_signing")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tmp)
+
+			path := filepath.Join(tmp, "key.json")
+			So(k1.Save(path), ShouldBeNil)
+
+			loaded, err := LoadPrivateKey(path)
+			So(err, ShouldBeNil)
+			So(loaded.KeyID(), ShouldEqual, k1.KeyID())
+
+			So(ioutil.WriteFile(path, []byte(`{"key_id": "zzz"}`), 0600), ShouldBeNil)
+			_, err = LoadPrivateKey(path)
+			So(err, ShouldErrLike, "wrong key length")
+		})
+	})
+}
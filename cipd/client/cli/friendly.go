@@ -104,6 +104,8 @@ type installationSiteConfig struct {
 	TrackedVersions map[string]string `json:",omitempty"`
 	// CacheDir contains shared cache.
 	CacheDir string `json:",omitempty"`
+	// DedupDir is an absolute path to a shared store of deployed files.
+	DedupDir string `json:",omitempty"`
 }
 
 // read loads JSON from given path.
@@ -393,6 +395,8 @@ func cmdInit(params Parameters) *subcommands.Command {
 			c.Flags.BoolVar(&c.force, "force", false, "Create the site root even if the directory is not empty or already under another site root directory.")
 			c.Flags.StringVar(&c.serviceURL, "service-url", params.ServiceURL, "Backend URL. Will be put into the site config and used for subsequent 'install' commands.")
 			c.Flags.StringVar(&c.cacheDir, "cache-dir", "", "Directory for shared cache")
+			c.Flags.StringVar(&c.dedupDir, "dedup-dir", "", "Directory for the store of deployed files shared by many site roots. "+
+				"Identical files from all such site roots are hardlinked to this store. Should be on the same volume.")
 			return c
 		},
 	}
@@ -404,6 +408,7 @@ type initRun struct {
 	force      bool
 	serviceURL string
 	cacheDir   string
+	dedupDir   string
 }
 
 func (c *initRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
@@ -414,6 +419,13 @@ func (c *initRun) Run(a subcommands.Application, args []string, _ subcommands.En
 	if len(args) == 1 {
 		rootDir = args[0]
 	}
+	dedupDir := c.dedupDir
+	if dedupDir != "" {
+		var err error
+		if dedupDir, err = filepath.Abs(dedupDir); err != nil {
+			return c.done(nil, err)
+		}
+	}
 	site, err := initInstallationSite(rootDir, c.serviceURL, c.force)
 	if err != nil {
 		return c.done(nil, err)
@@ -421,6 +433,7 @@ func (c *initRun) Run(a subcommands.Application, args []string, _ subcommands.En
 	err = site.modifyConfig(func(cfg *installationSiteConfig) error {
 		cfg.ServiceURL = c.serviceURL
 		cfg.CacheDir = c.cacheDir
+		cfg.DedupDir = dedupDir
 		return nil
 	})
 	return c.done(site.siteRoot, err)
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -29,6 +30,7 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/maruel/subcommands"
 
@@ -38,6 +40,7 @@ import (
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/fixflagpos"
+	"go.chromium.org/luci/common/flag/stringmapflag"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/common/retry/transient"
@@ -52,7 +55,9 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/ensure"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
 )
@@ -319,6 +324,9 @@ type clientOptions struct {
 	cacheDir   string
 	rootDir    string              // used only if registerFlags got withRootDir arg
 	versions   ensure.VersionsFile // mutated by loadEnsureFile
+	bundle     string              // set by 'ensure -bundle'
+
+	requireSigners []string // mutated by loadEnsureFile
 
 	authFlags authcli.Flags
 }
@@ -356,11 +364,24 @@ func (opts *clientOptions) toCIPDClientOpts(ctx context.Context) (cipd.ClientOpt
 		return cipd.ClientOptions{}, err
 	}
 
+	// The dedup store is enabled per site root through its config file.
+	var dedupDir string
+	if opts.rootDir != "" {
+		cfg, err := readConfig(opts.rootDir)
+		if err != nil {
+			return cipd.ClientOptions{}, err
+		}
+		dedupDir = cfg.DedupDir
+	}
+
 	realOpts := cipd.ClientOptions{
 		ServiceURL:          opts.resolvedServiceURL(),
 		Root:                opts.rootDir,
 		CacheDir:            opts.cacheDir,
+		DedupDir:            dedupDir,
 		Versions:            opts.versions,
+		Bundle:              opts.bundle,
+		RequireSigners:      opts.requireSigners,
 		AuthenticatedClient: client,
 		AnonymousClient:     http.DefaultClient,
 	}
@@ -424,10 +445,15 @@ type inputOptions struct {
 	preserveModTime  bool
 	preserveWritable bool
 
-	// Deflate compression level (if [1-9]) or 0 to disable compression.
+	// Compression level (if [1-9]) or 0 to disable compression.
 	//
 	// Default is 5.
 	compressionLevel int
+
+	// Compression algorithm: "deflate" or "zstd".
+	//
+	// Default is "deflate".
+	compressionAlgo string
 }
 
 func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
@@ -454,7 +480,10 @@ func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
 
 	// Options for the builder.
 	f.IntVar(&opts.compressionLevel, "compression-level", 5,
-		"Deflate compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+		"Compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+	f.StringVar(&opts.compressionAlgo, "compression-algo", string(builder.Deflate),
+		fmt.Sprintf("Compression algorithm: %q or %q. Packages compressed with %q are much faster to install, "+
+			"but only recent CIPD clients can install them.", builder.Deflate, builder.Zstd, builder.Zstd))
 }
 
 // prepareInput processes inputOptions by collecting all files to be added to
@@ -466,6 +495,9 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 	if opts.compressionLevel < 0 || opts.compressionLevel > 9 {
 		return empty, makeCLIError("invalid -compression-level: must be in [0-9] set")
 	}
+	if err := builder.ValidateCompressionAlgo(builder.CompressionAlgo(opts.compressionAlgo)); err != nil {
+		return empty, makeCLIError("invalid -compression-algo: %s", err)
+	}
 
 	// Handle -name and -in if defined. Do not allow -pkg-def in that case, since
 	// it provides same information as -name and -in. Note that -pkg-var are
@@ -496,6 +528,7 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			PackageName:      packageName,
 			InstallMode:      opts.installMode,
 			CompressionLevel: opts.compressionLevel,
+			CompressionAlgo:  builder.CompressionAlgo(opts.compressionAlgo),
 		}, nil
 	}
 
@@ -538,6 +571,7 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			VersionFile:      pkgDef.VersionFile(),
 			InstallMode:      pkgDef.InstallMode,
 			CompressionLevel: opts.compressionLevel,
+			CompressionAlgo:  builder.CompressionAlgo(opts.compressionAlgo),
 		}, nil
 	}
 
@@ -613,6 +647,111 @@ func (opts *tagsOptions) registerFlags(f *flag.FlagSet) {
 	f.Var(&opts.tags, "tag", "A tag to attach to the package instance (can be used multiple times).")
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// metadataOptions mixin.
+
+// metadataFlagValue is a parsed '-metadata' or '-metadata-path' option.
+type metadataFlagValue struct {
+	key   string
+	value string // either a literal value or a path to a file
+	path  bool   // if true, 'value' is a path to a file with the actual value
+}
+
+// metadataList holds an array of '-metadata' or '-metadata-path' options.
+//
+// Both flags share the same underlying slice, to preserve the order of
+// metadata entries as they appear in the command line.
+type metadataList struct {
+	entries *[]metadataFlagValue
+	path    bool
+}
+
+func (md *metadataList) String() string {
+	// String() for empty vars used in -help output.
+	if md.path {
+		return "key:path"
+	}
+	return "key:value"
+}
+
+// Set is called by 'flag' package when parsing command line options.
+func (md *metadataList) Set(value string) error {
+	chunks := strings.SplitN(value, ":", 2)
+	if len(chunks) != 2 {
+		return makeCLIError("%q should have form %s", value, md.String())
+	}
+	if err := common.ValidateInstanceMetadataKey(chunks[0]); err != nil {
+		return commandLineError{err}
+	}
+	*md.entries = append(*md.entries, metadataFlagValue{
+		key:   chunks[0],
+		value: chunks[1],
+		path:  md.path,
+	})
+	return nil
+}
+
+// metadataOptions defines command line arguments for commands that accept a set
+// of metadata entries.
+type metadataOptions struct {
+	metadata []metadataFlagValue
+}
+
+func (opts *metadataOptions) registerFlags(f *flag.FlagSet) {
+	f.Var(&metadataList{entries: &opts.metadata},
+		"metadata",
+		"A metadata entry (as key:value pair) to attach to the package instance (can be used multiple times). "+
+			"The value is attached as text/plain.")
+	f.Var(&metadataList{entries: &opts.metadata, path: true},
+		"metadata-path",
+		"A metadata entry (as key:path pair) to attach to the package instance (can be used multiple times). "+
+			"The value is read from the given file, its content type is derived from the file extension.")
+}
+
+// load reads files referenced by the flags and returns the metadata entries.
+func (opts *metadataOptions) load(ctx context.Context) ([]cipd.Metadata, error) {
+	out := make([]cipd.Metadata, len(opts.metadata))
+	for i, md := range opts.metadata {
+		if !md.path {
+			out[i] = cipd.Metadata{
+				Key:         md.key,
+				Value:       []byte(md.value),
+				ContentType: "text/plain",
+			}
+			continue
+		}
+
+		// Read the file, but make sure it is not larger than the limit first to
+		// avoid reading giant files into memory.
+		switch st, err := os.Stat(md.value); {
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to stat metadata file").Err()
+		case st.IsDir():
+			return nil, errors.Reason("metadata file %q is a directory", md.value).Err()
+		case st.Size() > common.MetadataMaxLen:
+			return nil, errors.Reason("metadata file %q is too large: should be <=%d bytes, got %d",
+				md.value, common.MetadataMaxLen, st.Size()).Err()
+		}
+		blob, err := ioutil.ReadFile(md.value)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to read metadata file").Err()
+		}
+
+		contentType := mime.TypeByExtension(filepath.Ext(md.value))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		logging.Debugf(ctx, "Metadata %q: %d bytes of %s from %q", md.key, len(blob), contentType, md.value)
+
+		out[i] = cipd.Metadata{
+			Key:         md.key,
+			Value:       blob,
+			ContentType: contentType,
+		}
+	}
+	return out, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // uploadOptions mixin.
 
@@ -627,6 +766,32 @@ func (opts *uploadOptions) registerFlags(f *flag.FlagSet) {
 		cipd.CASFinalizationTimeout, "Maximum time to wait for backend-side package hash verification.")
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// signingOptions mixin.
+
+// signingOptions defines command line options for commands that sign packages.
+type signingOptions struct {
+	signingKey string
+}
+
+func (opts *signingOptions) registerFlags(f *flag.FlagSet) {
+	f.StringVar(&opts.signingKey, "signing-key", "",
+		"A path to a private key (as generated by 'signing-key-gen') to sign the package instance with.")
+}
+
+// loadKey loads the private key, if it was given.
+func (opts *signingOptions) loadKey(ctx context.Context) (*signing.PrivateKey, error) {
+	if opts.signingKey == "" {
+		return nil, nil
+	}
+	key, err := signing.LoadPrivateKey(opts.signingKey)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to load the signing key").Err()
+	}
+	logging.Debugf(ctx, "Signing with %s", key.KeyID())
+	return key, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // deployOptions mixin.
 
@@ -739,7 +904,8 @@ const (
 // into a site root.
 type ensureFileOptions struct {
 	ensureFile    string
-	ensureFileOut string // used only if registerFlags got withEnsureOutFlag arg
+	ensureFileOut string              // used only if registerFlags got withEnsureOutFlag arg
+	vars          stringmapflag.Value // overrides for $Var variables
 }
 
 func (opts *ensureFileOptions) registerFlags(f *flag.FlagSet, out ensureOutFlag, list legacyListFlag) {
@@ -756,15 +922,22 @@ func (opts *ensureFileOptions) registerFlags(f *flag.FlagSet, out ensureOutFlag,
 	if list {
 		f.StringVar(&opts.ensureFile, "list", "<path>", "(DEPRECATED) A synonym for -ensure-file.")
 	}
+	f.Var(&opts.vars, "var",
+		`A "name=value" pair overriding a default value of a variable declared via `+
+			`$Var in the ensure file. Can be specified multiple times.`)
 }
 
 // loadEnsureFile parses the ensure file and mutates clientOpts to point to a
-// service URL specified in the ensure file.
+// service URL specified in the ensure file and to verify signatures of
+// instances if the ensure file requires them.
 func (opts *ensureFileOptions) loadEnsureFile(ctx context.Context, clientOpts *clientOptions, verifying verifyingEnsureFile, parseVers versionFileOpt) (*ensure.File, error) {
 	parsedFile, err := ensure.LoadEnsureFile(opts.ensureFile)
 	if err != nil {
 		return nil, err
 	}
+	if err := parsedFile.OverrideVars(opts.vars); err != nil {
+		return nil, makeCLIError("bad -var: %s", err)
+	}
 
 	// Prefer the ServiceURL from the file (if set), and log a warning if the user
 	// provided one on the command line that doesn't match the one in the file.
@@ -791,6 +964,8 @@ func (opts *ensureFileOptions) loadEnsureFile(ctx context.Context, clientOpts *c
 		logging.Debugf(ctx, "Using the resolved version file %q", filepath.Base(parsedFile.ResolvedVersions))
 	}
 
+	clientOpts.requireSigners = parsedFile.RequireSigners
+
 	return parsedFile, nil
 }
 
@@ -1027,6 +1202,7 @@ func cmdCreate(params Parameters) *subcommands.Command {
 			c.Opts.tagsOptions.registerFlags(&c.Flags)
 			c.Opts.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			c.Opts.hashOptions.registerFlags(&c.Flags)
 			return c
 		},
@@ -1039,6 +1215,7 @@ type createOpts struct {
 	tagsOptions
 	clientOptions
 	uploadOptions
+	signingOptions
 	hashOptions
 }
 
@@ -1070,11 +1247,12 @@ func buildAndUploadInstance(ctx context.Context, opts *createOpts) (common.Pin,
 		return common.Pin{}, err
 	}
 	return registerInstanceFile(ctx, f.Name(), &pin, &registerOpts{
-		refsOptions:   opts.refsOptions,
-		tagsOptions:   opts.tagsOptions,
-		clientOptions: opts.clientOptions,
-		uploadOptions: opts.uploadOptions,
-		hashOptions:   opts.hashOptions,
+		refsOptions:    opts.refsOptions,
+		tagsOptions:    opts.tagsOptions,
+		clientOptions:  opts.clientOptions,
+		uploadOptions:  opts.uploadOptions,
+		signingOptions: opts.signingOptions,
+		hashOptions:    opts.hashOptions,
 	})
 }
 
@@ -1095,6 +1273,10 @@ func cmdEnsure(params Parameters) *subcommands.Command {
 			c.clientOptions.registerFlags(&c.Flags, params, withRootDir)
 			c.ensureFileOptions.registerFlags(&c.Flags, withEnsureOutFlag, withLegacyListFlag)
 			c.deployOptions.registerFlags(&c.Flags)
+			c.Flags.StringVar(&c.clientOptions.bundle, "bundle", "",
+				`A directory produced by "cipd bundle-export" to install packages from. `+
+					`Uses the ensure file stored in the bundle and doesn't contact the backend. `+
+					`Can't be used together with -ensure-file.`)
 			return c
 		},
 	}
@@ -1108,6 +1290,12 @@ type ensureRun struct {
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if c.bundle != "" {
+		if c.ensureFile != "<path>" {
+			return c.done(nil, makeCLIError("-bundle and -ensure-file can't be used together"))
+		}
+		c.ensureFile = filepath.Join(c.bundle, cipd.BundleEnsureFile)
+	}
 	if !c.checkArgs(args, 0, 0) {
 		return 1
 	}
@@ -1275,6 +1463,193 @@ func (c *ensureFileResolveRun) Run(a subcommands.Application, args []string, env
 	return c.doneWithPinMap(pinMap, nil)
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'bundle-export' subcommand.
+
+func cmdBundleExport(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "bundle-export [options]",
+		ShortDesc: "fetches all packages from an ensure file into a bundle for offline installation",
+		LongDesc: "Fetches all packages from an \"ensure\" file into a bundle for offline installation.\n\n" +
+			`Resolves versions of all packages for all verified platforms in the ensure file ` +
+			`(or only for the current platform if there are no $VerifiedPlatform directives) ` +
+			`and puts the instance files, the resolved versions and the ensure file itself into ` +
+			`the given directory. This directory can then be transferred to a machine without ` +
+			`access to the backend and installed there via "cipd ensure -bundle <dir>".`,
+		Advanced: true,
+		CommandRun: func() subcommands.CommandRun {
+			c := &bundleExportRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
+			c.ensureFileOptions.registerFlags(&c.Flags, withoutEnsureOutFlag, withoutLegacyListFlag)
+			c.Flags.StringVar(&c.bundleDir, "bundle", "<path>", "A directory to write the bundle to. Must be empty or not exist.")
+			return c
+		},
+	}
+}
+
+type bundleExportRun struct {
+	cipdSubcommand
+	clientOptions
+	ensureFileOptions
+
+	bundleDir string
+}
+
+func (c *bundleExportRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+
+	ef, err := c.loadEnsureFile(ctx, &c.clientOptions, ignoreVerifyPlatforms, parseVersionsFile)
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	pinMap, err := exportBundle(ctx, ef, c.bundleDir, c.clientOptions)
+	if err == nil {
+		fmt.Printf("The bundle has been written to %s.\n\n", c.bundleDir)
+	}
+	return c.doneWithPinMap(pinMap, err)
+}
+
+func exportBundle(ctx context.Context, ef *ensure.File, bundle string, clientOpts clientOptions) (map[string][]pinInfo, error) {
+	// Don't mix instances from different exports, it makes the bundle manifest
+	// lie.
+	switch files, err := ioutil.ReadDir(bundle); {
+	case os.IsNotExist(err):
+		// This is fine, will create it.
+	case err != nil:
+		return nil, err
+	case len(files) != 0:
+		return nil, fmt.Errorf("the bundle directory %q is not empty", bundle)
+	}
+
+	// If the ensure file doesn't say otherwise, export for the current platform.
+	if len(ef.VerifyPlatforms) == 0 {
+		ef.VerifyPlatforms = []template.Platform{
+			{OS: platform.CurrentOS(), Arch: platform.CurrentArchitecture()},
+		}
+	}
+
+	pinMap, versions, err := resolveEnsureFile(ctx, ef, clientOpts)
+	if err != nil {
+		return pinMap, err
+	}
+
+	// Same instance may be used in many subdirs and on many platforms.
+	var pins []common.Pin
+	seen := map[common.Pin]bool{}
+	for _, infos := range pinMap {
+		for _, p := range infos {
+			if !seen[*p.Pin] {
+				seen[*p.Pin] = true
+				pins = append(pins, *p.Pin)
+			}
+		}
+	}
+	sort.Slice(pins, func(i, j int) bool {
+		if pins[i].PackageName != pins[j].PackageName {
+			return pins[i].PackageName < pins[j].PackageName
+		}
+		return pins[i].InstanceID < pins[j].InstanceID
+	})
+
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	// Grab signatures first, there's no point in fetching unsigned instances.
+	var signatures map[string][][]byte
+	if len(ef.RequireSigners) != 0 {
+		if signatures, err = fetchSignatures(ctx, client, pins, ef.RequireSigners); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, pin := range pins {
+		if err := fetchIntoBundle(ctx, client, pin, cipd.BundleInstancePath(bundle, pin)); err != nil {
+			return nil, errors.Annotate(err, "failed to fetch %s", pin).Err()
+		}
+	}
+
+	// Put the ensure file there too, pointing it to the resolved versions, so
+	// 'cipd ensure -bundle' doesn't need to resolve anything.
+	if err := saveVersionsFile(filepath.Join(bundle, cipd.BundleVersionsFile), versions); err != nil {
+		return nil, err
+	}
+	bundled := *ef
+	bundled.ServiceURL = clientOpts.resolvedServiceURL()
+	bundled.ResolvedVersions = cipd.BundleVersionsFile
+	buf := bytes.Buffer{}
+	if err := bundled.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(bundle, cipd.BundleEnsureFile), buf.Bytes(), 0666); err != nil {
+		return nil, err
+	}
+
+	// The manifest goes last, its presence indicates the bundle is complete.
+	platforms := make([]string, len(ef.VerifyPlatforms))
+	for i, plat := range ef.VerifyPlatforms {
+		platforms[i] = plat.String()
+	}
+	manifest := cipd.NewBundleManifest(bundled.ServiceURL, platforms, pins)
+	manifest.Signatures = signatures
+	if err := cipd.WriteBundleManifest(bundle, manifest); err != nil {
+		return nil, err
+	}
+
+	return pinMap, nil
+}
+
+// fetchSignatures fetches and verifies signatures of all given instances.
+//
+// Returns a map "<package>:<instance ID>" => signatures, as stored in the bundle
+// manifest.
+func fetchSignatures(ctx context.Context, client cipd.Client, pins []common.Pin, signers []string) (map[string][][]byte, error) {
+	out := make(map[string][][]byte, len(pins))
+	for _, pin := range pins {
+		md, err := client.ListMetadata(ctx, pin, []string{signing.MetadataKey})
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to fetch signatures of %s", pin).Err()
+		}
+		sigs := make([][]byte, len(md))
+		for i, m := range md {
+			sigs[i] = m.Value
+		}
+		if err := signing.Verify(pin, sigs, signers); err != nil {
+			return nil, err
+		}
+		out[pin.String()] = sigs
+	}
+	return out, nil
+}
+
+// fetchIntoBundle fetches the instance into the given file, verifying its hash.
+func fetchIntoBundle(ctx context.Context, client cipd.Client, pin common.Pin, path string) (err error) {
+	if err = os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0666)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+	return client.FetchInstanceTo(ctx, pin, f)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'puppet-check-updates' subcommand.
 
@@ -1394,7 +1769,7 @@ func cmdDescribe(params Parameters) *subcommands.Command {
 		UsageLine: "describe <package> [options]",
 		ShortDesc: "returns information about a package instance given its version",
 		LongDesc: "Returns information about a package instance given its version: " +
-			"who uploaded the instance and when and a list of attached tags.",
+			"who uploaded the instance and when, a list of attached tags and metadata.",
 		CommandRun: func() subcommands.CommandRun {
 			c := &describeRun{}
 			c.registerBaseFlags()
@@ -1437,8 +1812,9 @@ func describeInstance(ctx context.Context, pkg, version string, clientOpts clien
 	}
 
 	desc, err := client.DescribeInstance(ctx, pin, &cipd.DescribeInstanceOpts{
-		DescribeRefs: true,
-		DescribeTags: true,
+		DescribeRefs:     true,
+		DescribeTags:     true,
+		DescribeMetadata: true,
 	})
 	if err != nil {
 		return nil, err
@@ -1464,10 +1840,38 @@ func describeInstance(ctx context.Context, pkg, version string, clientOpts clien
 	} else {
 		fmt.Printf("Tags:          none\n")
 	}
+	if len(desc.Metadata) != 0 {
+		fmt.Printf("Metadata:\n")
+		for _, md := range desc.Metadata {
+			printMetadata(md)
+		}
+	} else {
+		fmt.Printf("Metadata:      none\n")
+	}
 
 	return desc, nil
 }
 
+// printMetadata prints a metadata entry as part of 'describe' output.
+//
+// Short single-line text values are printed as is, everything else is
+// summarized (use -json-output to get the full value).
+func printMetadata(md cipd.MetadataInfo) {
+	contentType := md.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	isText := false
+	if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+		isText = strings.HasPrefix(mt, "text/") || mt == "application/json"
+	}
+	if isText && len(md.Value) <= 80 && utf8.Valid(md.Value) && !bytes.ContainsAny(md.Value, "\r\n") {
+		fmt.Printf("  %s:%s\n", md.Key, md.Value)
+	} else {
+		fmt.Printf("  %s (%s, %d bytes, fingerprint %s)\n", md.Key, contentType, len(md.Value), md.Fingerprint)
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'instances' subcommand.
 
@@ -1770,6 +2174,116 @@ func (c *setTagRun) Run(a subcommands.Application, args []string, env subcommand
 	}))
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'set-metadata' subcommand.
+
+func cmdSetMetadata(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "set-metadata <package or package prefix> -metadata key:value -metadata-path key:path [options]",
+		ShortDesc: "attaches metadata to an instance",
+		LongDesc: "Attaches metadata to an instance.\n\n" +
+			"Metadata entries are key => value pairs, where values can be relatively large blobs " +
+			"(up to 512 KB), e.g. a changelog, build provenance or a license manifest. " +
+			"Use 'cipd describe' to see metadata attached to an instance.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &setMetadataRun{}
+			c.registerBaseFlags()
+			c.metadataOptions.registerFlags(&c.Flags)
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
+			c.Flags.StringVar(&c.version, "version", "<version>",
+				"Package version to resolve. Could also be a tag or a ref.")
+			return c
+		},
+	}
+}
+
+type setMetadataRun struct {
+	cipdSubcommand
+	metadataOptions
+	clientOptions
+
+	version string
+}
+
+func (c *setMetadataRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 1, 1) {
+		return 1
+	}
+	if len(c.metadata) == 0 {
+		return c.done(nil, makeCLIError("at least one -metadata or -metadata-path must be provided"))
+	}
+	pkgPrefix, err := expandTemplate(args[0])
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	ctx := cli.GetContext(a, c, env)
+
+	md, err := c.metadataOptions.load(ctx)
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	return c.doneWithPins(setRefOrTag(ctx, &setRefOrTagArgs{
+		clientOptions: c.clientOptions,
+		packagePrefix: pkgPrefix,
+		version:       c.version,
+		updatePin: func(client cipd.Client, pin common.Pin) error {
+			return client.AttachMetadataWhenReady(ctx, pin, md)
+		},
+	}))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'signing-key-gen' subcommand.
+
+func cmdSigningKeyGen() *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "signing-key-gen -out <path>",
+		ShortDesc: "generates a key for signing package instances",
+		LongDesc: "Generates a key for signing package instances.\n\n" +
+			"Writes the private key to the given file (readable only by the current user) " +
+			"and prints its key ID. Pass the private key to 'cipd create' or 'cipd pkg-register' " +
+			"via -signing-key to sign instances, and put the key ID into ensure files via " +
+			"$RequireSigner to install only instances signed by this key.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &signingKeyGenRun{}
+			c.registerBaseFlags()
+			c.Flags.StringVar(&c.out, "out", "<path>", "A path to write the private key to. Must not exist.")
+			return c
+		},
+	}
+}
+
+type signingKeyGenRun struct {
+	cipdSubcommand
+
+	out string
+}
+
+func (c *signingKeyGenRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	switch _, err := os.Stat(c.out); {
+	case err == nil:
+		return c.done(nil, fmt.Errorf("%q already exists, refusing to overwrite it", c.out))
+	case !os.IsNotExist(err):
+		return c.done(nil, err)
+	}
+	key, err := signing.GenerateKey()
+	if err != nil {
+		return c.done(nil, err)
+	}
+	if err := key.Save(c.out); err != nil {
+		return c.done(nil, err)
+	}
+	fmt.Printf("Private key: %s\n", c.out)
+	fmt.Printf("Key ID:      %s\n", key.KeyID())
+	return c.done(key.KeyID(), nil)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'ls' subcommand.
 
@@ -2467,6 +2981,7 @@ func cmdRegister(params Parameters) *subcommands.Command {
 			c.Opts.tagsOptions.registerFlags(&c.Flags)
 			c.Opts.clientOptions.registerFlags(&c.Flags, params, withoutRootDir)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			c.Opts.hashOptions.registerFlags(&c.Flags)
 			return c
 		},
@@ -2478,6 +2993,7 @@ type registerOpts struct {
 	tagsOptions
 	clientOptions
 	uploadOptions
+	signingOptions
 	hashOptions
 }
 
@@ -2514,6 +3030,11 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	}
 	inspectPin(ctx, pin)
 
+	key, err := opts.signingOptions.loadKey(ctx)
+	if err != nil {
+		return common.Pin{}, err
+	}
+
 	client, err := opts.clientOptions.makeCIPDClient(ctx)
 	if err != nil {
 		return common.Pin{}, err
@@ -2523,6 +3044,22 @@ func registerInstanceFile(ctx context.Context, instanceFile string, knownPin *co
 	if err != nil {
 		return common.Pin{}, err
 	}
+	// Sign before moving refs, so those who follow the refs never see an unsigned
+	// instance.
+	if key != nil {
+		sig, err := key.Sign(pin)
+		if err != nil {
+			return common.Pin{}, err
+		}
+		err = client.AttachMetadataWhenReady(ctx, pin, []cipd.Metadata{{
+			Key:         signing.MetadataKey,
+			Value:       sig,
+			ContentType: signing.ContentType,
+		}})
+		if err != nil {
+			return common.Pin{}, err
+		}
+	}
 	err = client.AttachTagsWhenReady(ctx, pin, opts.tagsOptions.tags)
 	if err != nil {
 		return common.Pin{}, err
@@ -2991,6 +3528,7 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdCreate(params),
 			cmdSetRef(params),
 			cmdSetTag(params),
+			cmdSetMetadata(params),
 
 			// High level local write commands.
 			{},
@@ -3002,6 +3540,7 @@ func GetApplication(params Parameters) *cli.Application {
 			{Advanced: true},
 			cmdEnsureFileVerify(params),
 			cmdEnsureFileResolve(params),
+			cmdBundleExport(params),
 
 			// User friendly subcommands that operates within a site root. Implemented
 			// in friendly.go. These are advanced because they're half-baked.
@@ -3009,6 +3548,8 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdInit(params),
 			cmdInstall(params),
 			cmdInstalled(params),
+			cmdWhich(params),
+			cmdSBOM(params),
 
 			// ACLs.
 			{Advanced: true},
@@ -3023,6 +3564,7 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdFetch(params),
 			cmdInspect(),
 			cmdRegister(params),
+			cmdSigningKeyGen(),
 
 			// Low level deployment-* commands.
 			{Advanced: true},
@@ -3032,6 +3574,7 @@ func GetApplication(params Parameters) *cli.Application {
 			// Low level misc commands.
 			{Advanced: true},
 			cmdPuppetCheckUpdates(params),
+			cmdProxy(params),
 		},
 	}
 }
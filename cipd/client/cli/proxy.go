@@ -0,0 +1,122 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/proxy"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// 'proxy' subcommand.
+
+func cmdProxy(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "proxy [options]",
+		ShortDesc: "runs a local caching proxy for the CIPD backend",
+		LongDesc: "Runs a local caching proxy for the CIPD backend.\n\n" +
+			`The proxy forwards all RPCs to the backend (along with callers' credentials) ` +
+			`and serves instance files from a shared disk cache. Point clients to it via ` +
+			`-service-url http://<listen address>.` + "\n\n" +
+			`Cached instance files are served to anyone who knows their instance IDs, ` +
+			`so run the proxy only within a trusted network.`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &proxyRun{}
+			c.registerBaseFlags()
+			c.Flags.StringVar(&c.serviceURL, "service-url", params.ServiceURL, "Upstream backend URL.")
+			c.Flags.StringVar(&c.listen, "listen", "localhost:8000", "Address to listen on.")
+			c.Flags.StringVar(&c.cacheDir, "cache-dir", "<path>", "Directory for the instance cache.")
+			c.Flags.Int64Var(&c.cacheSizeMB, "cache-size-mb", 10*1024, "Maximum size of the instance cache, in MB.")
+			return c
+		},
+	}
+}
+
+type proxyRun struct {
+	cipdSubcommand
+
+	serviceURL  string
+	listen      string
+	cacheDir    string
+	cacheSizeMB int64
+}
+
+func (c *proxyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(nil, runProxy(ctx, c.serviceURL, c.listen, c.cacheDir, c.cacheSizeMB*1000*1000))
+}
+
+func runProxy(ctx context.Context, serviceURL, listen, cacheDir string, cacheSize int64) error {
+	upstream, err := url.Parse(serviceURL)
+	if err != nil {
+		return fmt.Errorf("bad -service-url %q - %s", serviceURL, err)
+	}
+
+	cache, err := proxy.OpenCache(ctx, cacheDir, cacheSize)
+	if err != nil {
+		return err
+	}
+
+	// Note: the upstream client doesn't use our own credentials, it uses ones
+	// forwarded from callers.
+	prpcC := &prpc.Client{
+		C:    http.DefaultClient,
+		Host: upstream.Host,
+		Options: &prpc.Options{
+			UserAgent: cipd.UserAgent,
+			Insecure:  upstream.Scheme == "http",
+			Retry: func() retry.Iterator {
+				return &retry.ExponentialBackoff{
+					Limited: retry.Limited{
+						Delay:   time.Second,
+						Retries: 5,
+					},
+				}
+			},
+		},
+	}
+
+	srv := &proxy.Server{
+		Repository: api.NewRepositoryPRPCClient(prpcC),
+		Storage:    api.NewStoragePRPCClient(prpcC),
+		Cache:      cache,
+		UserAgent:  cipd.UserAgent,
+	}
+
+	r := router.NewWithRootContext(ctx)
+	srv.InstallHandlers(r, router.MiddlewareChain{})
+
+	logging.Infof(ctx, "Proxying %s at http://%s", serviceURL, listen)
+	return http.ListenAndServe(listen, r)
+}
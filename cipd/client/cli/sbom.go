@@ -0,0 +1,102 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/clock"
+
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/sbom"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// 'sbom' subcommand.
+
+func cmdSBOM(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "sbom [options]",
+		ShortDesc: "prints a software bill of materials for the site root",
+		LongDesc: "Prints a software bill of materials for all packages installed in the site root.\n\n" +
+			"Each installed package instance is identified by its package name and " +
+			"instance ID. Doesn't contact the backend.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &sbomRun{defaultServiceURL: params.ServiceURL}
+			c.registerBaseFlags()
+			c.siteRootOptions.registerFlags(&c.Flags)
+			c.Flags.StringVar(&c.format, "format", string(sbom.SPDX),
+				fmt.Sprintf("Format of the output, either %q or %q.", sbom.SPDX, sbom.CycloneDX))
+			return c
+		},
+	}
+}
+
+type sbomRun struct {
+	cipdSubcommand
+	siteRootOptions
+
+	defaultServiceURL string // used only if the site config has ServiceURL == ""
+	format            string
+}
+
+func (c *sbomRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	format := sbom.Format(c.format)
+	if format != sbom.SPDX && format != sbom.CycloneDX {
+		return c.done(nil, makeCLIError("unsupported -format %q", c.format))
+	}
+	site, err := getInstallationSite(c.rootDir, c.defaultServiceURL)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(site.sbom(ctx, format))
+}
+
+// sbom prints a software bill of materials to stdout, returning the list of
+// packages in it.
+func (site *installationSite) sbom(ctx context.Context, format sbom.Format) ([]sbom.Package, error) {
+	pkgs, err := site.deployedPackages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &sbom.Document{
+		Name:       site.siteRoot,
+		ID:         uuid.New().String(),
+		Created:    clock.Now(ctx),
+		Tool:       cipd.UserAgent,
+		ServiceURL: site.cfg.ServiceURL,
+		Packages:   make([]sbom.Package, len(pkgs)),
+	}
+	for i, p := range pkgs {
+		doc.Packages[i] = sbom.Package{Subdir: p.Subdir, Pin: p.Pin}
+	}
+
+	if err := sbom.Write(os.Stdout, doc, format); err != nil {
+		return nil, err
+	}
+	return doc.Packages, nil
+}
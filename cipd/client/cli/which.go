@@ -0,0 +1,203 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/auth/client/authcli"
+
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// deployedPackage is a package installed in a site root along with its
+// manifest.
+type deployedPackage struct {
+	Subdir   string
+	Pin      common.Pin
+	Manifest *pkg.Manifest
+}
+
+// deployedPackages returns all packages installed in the site root, ordered by
+// subdir and package name.
+//
+// Packages that are not fully installed are skipped with a warning.
+func (site *installationSite) deployedPackages(ctx context.Context) ([]deployedPackage, error) {
+	d := deployer.New(site.siteRoot)
+
+	allPins, err := d.FindDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subdirs := make([]string, 0, len(allPins))
+	for subdir := range allPins {
+		subdirs = append(subdirs, subdir)
+	}
+	sort.Strings(subdirs)
+
+	var out []deployedPackage
+	for _, subdir := range subdirs {
+		for _, pin := range allPins[subdir] {
+			state, err := d.CheckDeployed(ctx, subdir, pin.PackageName, deployer.NotParanoid, pkg.WithManifest)
+			switch {
+			case err != nil:
+				return nil, err
+			case !state.Deployed || state.Manifest == nil:
+				logging.Warningf(ctx, "Package %s in %q is not fully installed, skipping it", pin.PackageName, subdir)
+				continue
+			}
+			out = append(out, deployedPackage{
+				Subdir:   subdir,
+				Pin:      state.Pin,
+				Manifest: state.Manifest,
+			})
+		}
+	}
+	return out, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'which' subcommand.
+
+func cmdWhich(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "which <path> [options]",
+		ShortDesc: "finds a package that installed the given file",
+		LongDesc: "Finds a package that installed the given file.\n\n" +
+			"Looks through manifests of all packages installed in the site root " +
+			"(discovered based on the path if -root is not given) and reports the " +
+			"package that owns the file, its instance ID and tags attached to it.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &whichRun{defaultServiceURL: params.ServiceURL}
+			c.registerBaseFlags()
+			c.authFlags.Register(&c.Flags, params.DefaultAuthOptions)
+			c.siteRootOptions.registerFlags(&c.Flags)
+			return c
+		},
+	}
+}
+
+type whichRun struct {
+	cipdSubcommand
+	authFlags authcli.Flags
+	siteRootOptions
+
+	defaultServiceURL string // used only if the site config has ServiceURL == ""
+}
+
+// whichResult is returned by 'which' as JSON output.
+type whichResult struct {
+	Path   string     `json:"path"` // slash-separated, relative to the site root
+	Subdir string     `json:"subdir"`
+	Pin    common.Pin `json:"pin"`
+	Tags   []string   `json:"tags,omitempty"`
+}
+
+func (c *whichRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 1, 1) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+
+	abs, err := filepath.Abs(args[0])
+	if err != nil {
+		return c.done(nil, err)
+	}
+	rootDir := c.rootDir
+	if rootDir == "" {
+		if rootDir = findSiteRoot(filepath.Dir(abs)); rootDir == "" {
+			return c.done(nil, fmt.Errorf("%s is not in a site root", abs))
+		}
+	}
+	site, err := getInstallationSite(rootDir, c.defaultServiceURL)
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	res, err := site.which(ctx, abs)
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	// Tags are fetched from the backend. Don't fail if it is unreachable, the
+	// rest of the information is already useful.
+	if err := site.initClient(ctx, c.authFlags); err != nil {
+		return c.done(nil, err)
+	}
+	desc, err := site.client.DescribeInstance(ctx, res.Pin, &cipd.DescribeInstanceOpts{DescribeTags: true})
+	if err != nil {
+		logging.Warningf(ctx, "Failed to fetch tags of %s - %s", res.Pin, err)
+	} else {
+		for _, t := range desc.Tags {
+			res.Tags = append(res.Tags, t.Tag)
+		}
+	}
+
+	fmt.Printf("Path:        %s\n", res.Path)
+	fmt.Printf("Package:     %s\n", res.Pin.PackageName)
+	fmt.Printf("Instance ID: %s\n", res.Pin.InstanceID)
+	fmt.Printf("Subdir:      %q\n", res.Subdir)
+	if len(res.Tags) != 0 {
+		fmt.Printf("Tags:\n")
+		for _, t := range res.Tags {
+			fmt.Printf("  %s\n", t)
+		}
+	} else {
+		fmt.Printf("Tags:        none\n")
+	}
+
+	return c.done(res, nil)
+}
+
+// which finds a package that installed the file at the given absolute path.
+func (site *installationSite) which(ctx context.Context, abs string) (*whichResult, error) {
+	rel, err := filepath.Rel(site.siteRoot, abs)
+	if err != nil {
+		return nil, err
+	}
+	rel = filepath.ToSlash(rel)
+
+	pkgs, err := site.deployedPackages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pkgs {
+		for _, f := range p.Manifest.Files {
+			if path.Join(p.Subdir, f.Name) == rel {
+				return &whichResult{
+					Path:   rel,
+					Subdir: p.Subdir,
+					Pin:    p.Pin,
+				}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%s is not installed by any package in the site root %s", rel, site.siteRoot)
+}
@@ -15,7 +15,11 @@
 package common
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"mime"
+	"net/url"
 	"path"
 	"regexp"
 	"sort"
@@ -54,8 +58,15 @@ var (
 
 	// packageRefRe is a regular expression for a ref.
 	packageRefRe = regexp.MustCompile(`^[a-z0-9_./\-]{1,256}$`)
+
+	// pubsubTopicRe is a regular expression for a full Cloud PubSub topic name.
+	pubsubTopicRe = regexp.MustCompile(`^projects/[a-z][a-z0-9\-.:]*/topics/[A-Za-z][A-Za-z0-9\-_.~+%]{2,254}$`)
 )
 
+// minWebhookSecretLen is the minimum length of a secret used to sign webhook
+// notifications.
+const minWebhookSecretLen = 16
+
 // Pin uniquely identifies an instance of some package.
 type Pin struct {
 	PackageName string `json:"package"`
@@ -164,6 +175,73 @@ func JoinInstanceTag(t *api.Tag) string {
 	return t.Key + ":" + t.Value
 }
 
+// MetadataMaxLen is maximum allowed length of an instance metadata value.
+const MetadataMaxLen = 512 * 1024
+
+// ValidateInstanceMetadataKey returns an error if the string can't be used as
+// an instance metadata key.
+func ValidateInstanceMetadataKey(k string) error {
+	switch {
+	case len(k) > 400:
+		return fmt.Errorf("the metadata key is too long, should be <=400 chars: %q", k)
+	case !tagKeyRe.MatchString(k):
+		return fmt.Errorf("invalid metadata key %q (should match %q)", k, tagKeyReStr)
+	}
+	return nil
+}
+
+// ValidateInstanceMetadataLen returns an error if the given length is not
+// an acceptable length of an instance metadata value.
+func ValidateInstanceMetadataLen(l int) error {
+	if l > MetadataMaxLen {
+		return fmt.Errorf("the metadata value is too long: should be <=%d bytes, got %d", MetadataMaxLen, l)
+	}
+	return nil
+}
+
+// ValidateContentType returns an error if the string doesn't look like
+// a content type of an instance metadata value.
+//
+// Empty string is fine.
+func ValidateContentType(ct string) error {
+	if ct == "" {
+		return nil
+	}
+	if len(ct) > 400 {
+		return fmt.Errorf("the content type is too long, should be <=400 chars: %q", ct)
+	}
+	if _, _, err := mime.ParseMediaType(ct); err != nil {
+		return fmt.Errorf("bad content type %q - %s", ct, err)
+	}
+	return nil
+}
+
+// InstanceMetadataFingerprint calculates a fingerprint of an instance metadata
+// entry, given its key and value.
+//
+// It is the first 16 bytes of SHA256 of "<key>:<value>", hex-encoded.
+func InstanceMetadataFingerprint(key string, value []byte) string {
+	h := sha256.New()
+	h.Write([]byte(key))
+	h.Write([]byte{':'})
+	h.Write(value)
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// ValidateInstanceMetadataFingerprint returns an error if the string doesn't
+// look like an output of InstanceMetadataFingerprint.
+func ValidateInstanceMetadataFingerprint(fp string) error {
+	if len(fp) != 32 {
+		return fmt.Errorf("bad metadata fingerprint %q: expecting 32 hex chars", fp)
+	}
+	for _, c := range fp {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return fmt.Errorf("bad metadata fingerprint %q: expecting lowercase hex", fp)
+		}
+	}
+	return nil
+}
+
 // ValidateInstanceVersion return error if a string can't be used as version.
 //
 // A version can be specified as:
@@ -264,6 +342,75 @@ func NormalizePrefixMetadata(m *api.PrefixMetadata) error {
 		m.Acls[i] = perRole[api.Role(role)]
 	}
 
+	if m.RetentionPolicy != nil {
+		if err := ValidateRetentionPolicy(m.RetentionPolicy); err != nil {
+			return fmt.Errorf("bad retention policy - %s", err)
+		}
+	}
+
+	for i, n := range m.Notifications {
+		if err := ValidateEventNotification(n); err != nil {
+			return fmt.Errorf("bad notification #%d - %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRetentionPolicy returns an error if the retention policy is invalid.
+func ValidateRetentionPolicy(p *api.RetentionPolicy) error {
+	switch {
+	case p.KeepLast < 0:
+		return fmt.Errorf("keep_last should be non-negative, got %d", p.KeepLast)
+	case p.DeleteAfterDays <= 0:
+		return fmt.Errorf("delete_after_days should be positive, got %d", p.DeleteAfterDays)
+	}
+	for _, t := range p.KeepTags {
+		if strings.Contains(t, ":") {
+			if err := ValidateInstanceTag(t); err != nil {
+				return err
+			}
+		} else if !tagKeyRe.MatchString(t) {
+			return fmt.Errorf("invalid tag key %q (should match %q)", t, tagKeyReStr)
+		}
+	}
+	return nil
+}
+
+// ValidateEventNotification returns an error if the notification config is
+// invalid.
+func ValidateEventNotification(n *api.EventNotification) error {
+	for _, k := range n.Kinds {
+		if k == api.EventKind_EVENT_KIND_UNSPECIFIED {
+			return fmt.Errorf("event kind must be specified")
+		}
+	}
+	for _, r := range n.Refs {
+		if err := ValidatePackageRef(r); err != nil {
+			return err
+		}
+	}
+	switch t := n.Target.(type) {
+	case *api.EventNotification_WebhookUrl:
+		u, err := url.Parse(t.WebhookUrl)
+		switch {
+		case err != nil:
+			return fmt.Errorf("bad webhook_url - %s", err)
+		case u.Scheme != "https" || u.Host == "":
+			return fmt.Errorf("webhook_url should be an https:// URL, got %q", t.WebhookUrl)
+		case len(n.WebhookSecret) < minWebhookSecretLen:
+			return fmt.Errorf("webhook_secret should be at least %d characters long", minWebhookSecretLen)
+		}
+	case *api.EventNotification_PubsubTopic:
+		switch {
+		case !pubsubTopicRe.MatchString(t.PubsubTopic):
+			return fmt.Errorf("bad pubsub_topic %q, should be projects/<project>/topics/<topic>", t.PubsubTopic)
+		case n.WebhookSecret != "":
+			return fmt.Errorf("webhook_secret can be used only with webhook_url")
+		}
+	default:
+		return fmt.Errorf("either webhook_url or pubsub_topic is required")
+	}
 	return nil
 }
 
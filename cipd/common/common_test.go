@@ -145,6 +145,48 @@ func TestParseInstanceTag(t *testing.T) {
 	})
 }
 
+func TestInstanceMetadata(t *testing.T) {
+	t.Parallel()
+
+	Convey("ValidateInstanceMetadataKey works", t, func() {
+		So(ValidateInstanceMetadataKey("good-key_0"), ShouldBeNil)
+		So(ValidateInstanceMetadataKey(""), ShouldNotBeNil)
+		So(ValidateInstanceMetadataKey("BAD"), ShouldNotBeNil)
+		So(ValidateInstanceMetadataKey("bad:key"), ShouldNotBeNil)
+		So(ValidateInstanceMetadataKey(strings.Repeat("k", 401)), ShouldNotBeNil)
+	})
+
+	Convey("ValidateInstanceMetadataLen works", t, func() {
+		So(ValidateInstanceMetadataLen(0), ShouldBeNil)
+		So(ValidateInstanceMetadataLen(MetadataMaxLen), ShouldBeNil)
+		So(ValidateInstanceMetadataLen(MetadataMaxLen+1), ShouldErrLike, "too long")
+	})
+
+	Convey("ValidateContentType works", t, func() {
+		So(ValidateContentType(""), ShouldBeNil)
+		So(ValidateContentType("text/plain"), ShouldBeNil)
+		So(ValidateContentType("text/plain; charset=utf-8"), ShouldBeNil)
+		So(ValidateContentType("zzz/"), ShouldNotBeNil)
+		So(ValidateContentType("text/"+strings.Repeat("z", 400)), ShouldErrLike, "too long")
+	})
+
+	Convey("InstanceMetadataFingerprint works", t, func() {
+		fp := InstanceMetadataFingerprint("key", []byte("value"))
+		So(fp, ShouldHaveLength, 32)
+		So(ValidateInstanceMetadataFingerprint(fp), ShouldBeNil)
+		So(InstanceMetadataFingerprint("key", []byte("value")), ShouldEqual, fp)
+		So(InstanceMetadataFingerprint("key", []byte("another")), ShouldNotEqual, fp)
+		So(InstanceMetadataFingerprint("key:", []byte("value")), ShouldNotEqual, fp)
+	})
+
+	Convey("ValidateInstanceMetadataFingerprint works", t, func() {
+		So(ValidateInstanceMetadataFingerprint(strings.Repeat("a", 32)), ShouldBeNil)
+		So(ValidateInstanceMetadataFingerprint(strings.Repeat("a", 31)), ShouldNotBeNil)
+		So(ValidateInstanceMetadataFingerprint(strings.Repeat("A", 32)), ShouldNotBeNil)
+		So(ValidateInstanceMetadataFingerprint(strings.Repeat("z", 32)), ShouldNotBeNil)
+	})
+}
+
 func TestValidatePin(t *testing.T) {
 	t.Parallel()
 
@@ -312,6 +354,79 @@ func TestNormalizePrefixMetadata(t *testing.T) {
 			},
 		}), ShouldErrLike, `in ACL entry for role READER - ":" doesn't look like principal id`)
 	})
+
+	Convey("Retention policy", t, func() {
+		m := &api.PrefixMetadata{
+			Prefix: "abc",
+			RetentionPolicy: &api.RetentionPolicy{
+				KeepLast:        10,
+				KeepTags:        []string{"release", "version:1.0"},
+				DeleteAfterDays: 30,
+			},
+		}
+		So(NormalizePrefixMetadata(m), ShouldBeNil)
+
+		m.RetentionPolicy.KeepLast = -1
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "keep_last should be non-negative")
+
+		m.RetentionPolicy.KeepLast = 0
+		m.RetentionPolicy.DeleteAfterDays = 0
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "delete_after_days should be positive")
+
+		m.RetentionPolicy.DeleteAfterDays = 1
+		m.RetentionPolicy.KeepTags = []string{"BAD"}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, `invalid tag key "BAD"`)
+
+		m.RetentionPolicy.KeepTags = []string{"k:"}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "invalid tag value")
+	})
+
+	Convey("Notifications", t, func() {
+		m := &api.PrefixMetadata{
+			Prefix: "abc",
+			Notifications: []*api.EventNotification{
+				{
+					Kinds:         []api.EventKind{api.EventKind_INSTANCE_REF_SET},
+					Refs:          []string{"stable"},
+					Target:        &api.EventNotification_WebhookUrl{WebhookUrl: "https://example.com/hook"},
+					WebhookSecret: "0123456789abcdef",
+				},
+				{
+					Target: &api.EventNotification_PubsubTopic{PubsubTopic: "projects/some-project/topics/cipd-events"},
+				},
+			},
+		}
+		So(NormalizePrefixMetadata(m), ShouldBeNil)
+
+		m.Notifications[0].Kinds = []api.EventKind{0}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "bad notification #0 - event kind must be specified")
+
+		m.Notifications[0].Kinds = nil
+		m.Notifications[0].Refs = []string{"BAD"}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "invalid ref name")
+
+		m.Notifications[0].Refs = nil
+		m.Notifications[0].Target = &api.EventNotification_WebhookUrl{WebhookUrl: "http://example.com/hook"}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "webhook_url should be an https:// URL")
+
+		m.Notifications[0].Target = &api.EventNotification_WebhookUrl{WebhookUrl: "https://example.com/hook"}
+		m.Notifications[0].WebhookSecret = "short"
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "webhook_secret should be at least 16 characters long")
+
+		m.Notifications[0].WebhookSecret = ""
+		m.Notifications[1].WebhookSecret = "0123456789abcdef"
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "bad notification #0 - webhook_secret should be")
+
+		m.Notifications[0].WebhookSecret = "0123456789abcdef"
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "bad notification #1 - webhook_secret can be used only with webhook_url")
+		m.Notifications[1].WebhookSecret = ""
+
+		m.Notifications[0].Target = nil
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "either webhook_url or pubsub_topic is required")
+
+		m.Notifications[0].Target = &api.EventNotification_PubsubTopic{PubsubTopic: "some-topic"}
+		So(NormalizePrefixMetadata(m), ShouldErrLike, "bad pubsub_topic")
+	})
 }
 
 func TestPinToString(t *testing.T) {
@@ -143,9 +143,17 @@ func getServices(file *ast.File) ([]*service, error) {
 
 	// Export our services as a slice, ordered by when the service was first
 	// encountered in the source file.
-	services := make([]*service, len(serviceNames))
-	for i, k := range serviceNames {
-		services[i] = svcs[k]
+	//
+	// Streaming methods generate "<Service>_<Method>Client" interfaces, which
+	// match the client interface pattern but have no registration function or
+	// service descriptor. Skip them.
+	services := make([]*service, 0, len(serviceNames))
+	for _, k := range serviceNames {
+		s := svcs[k]
+		if s.registerServerFunc == nil && s.protoPackageName == "" {
+			continue
+		}
+		services = append(services, s)
 	}
 	return services, nil
 }
@@ -154,6 +154,15 @@ func New{{.Service}}PRPCClient(client *{{.PRPCSymbolPrefix}}Client) {{.Service}}
 }
 
 {{range .Methods}}
+{{if .StreamClient}}
+func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}}, opts ...grpc.CallOption) ({{.StreamClient}}, error) {
+	stream, err := c.client.CallServerStream(ctx, "{{$.ProtoPkg}}.{{$.Service}}", "{{.Name}}", in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.StreamImpl}}{stream}, nil
+}
+{{else}}
 func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}}, opts ...grpc.CallOption) (*{{.OutputMessage}}, error) {
 	out := new({{.OutputMessage}})
 	err := c.client.Call(ctx, "{{$.ProtoPkg}}.{{$.Service}}", "{{.Name}}", in, out, opts...)
@@ -163,6 +172,7 @@ func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}},
 	return out, nil
 }
 {{end}}
+{{end}}
 `))
 
 // generateClient generates pRPC implementation of a client interface.
@@ -175,6 +185,11 @@ func (t *transformer) generateClient(protoPackage, serviceName string, iface *as
 		Name          string
 		InputMessage  string
 		OutputMessage string
+
+		// StreamClient and StreamImpl are the stream client interface and its
+		// gRPC implementation of a server-streaming method.
+		StreamClient string
+		StreamImpl   string
 	}
 	methods := make([]Method, 0, len(iface.Methods.List))
 
@@ -194,23 +209,37 @@ func (t *transformer) generateClient(protoPackage, serviceName string, iface *as
 			return nil, fmt.Errorf("unexpected embedded interface in %sClient", serviceName)
 		}
 
-		inStructPtr := signature.Params.List[1].Type.(*ast.StarExpr)
+		name := m.Names[0].Name
+
+		// Client-streaming methods don't have an input message parameter.
+		inStructPtr, ok := signature.Params.List[1].Type.(*ast.StarExpr)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: client-streaming methods are not supported by pRPC", serviceName, name)
+		}
 		inStruct, err := toGoCode(inStructPtr.X)
 		if err != nil {
 			return nil, err
 		}
+		method := Method{
+			Name:         name,
+			InputMessage: inStruct,
+		}
 
-		outStructPtr := signature.Results.List[0].Type.(*ast.StarExpr)
-		outStruct, err := toGoCode(outStructPtr.X)
-		if err != nil {
-			return nil, err
+		switch out := signature.Results.List[0].Type.(type) {
+		case *ast.StarExpr:
+			if method.OutputMessage, err = toGoCode(out.X); err != nil {
+				return nil, err
+			}
+
+		default:
+			// A server-streaming method returns a stream client interface.
+			if method.StreamClient, err = toGoCode(out); err != nil {
+				return nil, err
+			}
+			method.StreamImpl = firstLower(serviceName) + name + "Client"
 		}
 
-		methods = append(methods, Method{
-			Name:          m.Names[0].Name,
-			InputMessage:  inStruct,
-			OutputMessage: outStruct,
-		})
+		methods = append(methods, method)
 	}
 
 	prpcSymbolPrefix := "prpc."
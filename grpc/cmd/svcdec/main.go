@@ -22,6 +22,8 @@
 //
 // DecoratedXYZ has the same methods as XYZServer: they call Prelude before
 // forwarding the call to the corresponding XYZServer method.
+// For server-streaming methods, the context returned by Prelude is made
+// available to the XYZServer method via its stream's Context().
 //
 // svcdec is designed to be run through go generate:
 // 	//go:generate svcdec -type GreetServer
@@ -41,9 +43,11 @@ func generate(ctx context.Context, a *svctool.GeneratorArgs) error {
 		ExtraImports: a.ExtraImports,
 	}
 	for _, svc := range a.Services {
+		name := strings.TrimSuffix(svc.TypeName, "Server")
 		args.Services = append(args.Services, &service{
-			Service:    svc,
-			StructName: "Decorated" + strings.TrimSuffix(svc.TypeName, "Server"),
+			Service:            svc,
+			StructName:         "Decorated" + name,
+			StreamStructPrefix: "decorated" + name,
 		})
 	}
 
@@ -37,6 +37,7 @@ import (
 
 {{range .Services}}
 {{$StructName := .StructName}}
+{{$StreamStructPrefix := .StreamStructPrefix}}
 type {{$StructName}} struct {
 	// Service is the service to decorate.
 	Service {{.Service.TypeName}}
@@ -53,6 +54,37 @@ type {{$StructName}} struct {
 }
 
 {{range .Methods}}
+{{if .StreamType}}
+{{$StreamStructName := printf "%s%s%s" $StreamStructPrefix .Name "Server"}}
+func (s *{{$StructName}}) {{.Name}}(req {{.InputType}}, stream {{.StreamType}}) (err error) {
+	ctx := stream.Context()
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "{{.Name}}", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		err = s.Service.{{.Name}}(req, &{{$StreamStructName}}{stream, ctx})
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "{{.Name}}", nil, err)
+	}
+	return
+}
+
+// {{$StreamStructName}} is a {{.StreamType}} whose context is the one
+// returned by the Prelude.
+type {{$StreamStructName}} struct {
+	{{.StreamType}}
+	ctx context.Context
+}
+
+func (s *{{$StreamStructName}}) Context() context.Context {
+	return s.ctx
+}
+{{else}}
 func (s *{{$StructName}}) {{.Name}}(ctx context.Context, req {{.InputType}}) (rsp {{.OutputType}}, err error) {
 	if s.Prelude != nil {
 		var newCtx context.Context
@@ -71,6 +103,7 @@ func (s *{{$StructName}}) {{.Name}}(ctx context.Context, req {{.InputType}}) (rs
 }
 {{end}}
 {{end}}
+{{end}}
 `))
 )
 
@@ -84,5 +117,8 @@ type (
 	service struct {
 		*svctool.Service
 		StructName string
+		// StreamStructPrefix prefixes the names of the structs that wrap
+		// the streams of server-streaming methods.
+		StreamStructPrefix string
 	}
 )
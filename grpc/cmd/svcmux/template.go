@@ -46,6 +46,16 @@ type {{$StructName}} struct {
 }
 
 {{range .Methods}}
+{{if .StreamType}}
+func (s *{{$StructName}}) {{.Name}}(req {{.InputType}}, stream {{.StreamType}}) error {
+	ver := svcmux.GetServiceVersion(stream.Context(), s.Default)
+	impl := s.Impls[ver]
+	if impl == nil {
+		return svcmux.NoImplementation(ver)
+	}
+	return impl.{{.Name}}(req, stream)
+}
+{{else}}
 func (s *{{$StructName}}) {{.Name}}(c context.Context, req {{.InputType}}) ({{.OutputType}}, error) {
 	ver := svcmux.GetServiceVersion(c, s.Default)
 	impl := s.Impls[ver]
@@ -56,6 +66,7 @@ func (s *{{$StructName}}) {{.Name}}(c context.Context, req {{.InputType}}) ({{.O
 }
 {{end}}
 {{end}}
+{{end}}
 `))
 )
 
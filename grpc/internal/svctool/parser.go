@@ -172,6 +172,36 @@ func (p *parser) resolveService(c context.Context, typeName string) (*Service, e
 			continue
 		}
 		results := signature.Results.List
+
+		method := &Method{
+			Node: m,
+			Name: m.Names[0].Name,
+		}
+
+		var err error
+		if _, ok := params[0].Type.(*ast.StarExpr); ok {
+			// A server-streaming method, e.g.
+			//   Watch(*Request, Service_WatchServer) error
+			if len(results) != 1 {
+				logging.Warningf(
+					c,
+					"%s.%s: return value count is %d; expected 1",
+					typeName, name, len(results))
+				continue
+			}
+			if err := p.recordImport(file, params[0].Type); err != nil {
+				return nil, err
+			}
+			if method.InputType, err = p.exprString(params[0].Type); err != nil {
+				return nil, err
+			}
+			if method.StreamType, err = p.exprString(params[1].Type); err != nil {
+				return nil, err
+			}
+			svc.Methods = append(svc.Methods, method)
+			continue
+		}
+
 		if len(results) != 2 {
 			logging.Warningf(
 				c,
@@ -180,15 +210,9 @@ func (p *parser) resolveService(c context.Context, typeName string) (*Service, e
 			continue
 		}
 
-		method := &Method{
-			Node: m,
-			Name: m.Names[0].Name,
-		}
-
 		if err := p.recordImport(file, params[1].Type); err != nil {
 			return nil, err
 		}
-		var err error
 		method.InputType, err = p.exprString(params[1].Type)
 		if err != nil {
 			return nil, err
@@ -85,6 +85,7 @@ const _ = grpc.SupportPackageIsVersion3
 
 type S1Client interface {
 	M(ctx context.Context, in *M1, opts ...grpc.CallOption) (*M2, error)
+	Watch(ctx context.Context, in *M1, opts ...grpc.CallOption) (S1_WatchClient, error)
 }
 type s1PRPCClient struct {
 	client *prpc.Client
@@ -103,6 +104,14 @@ func (c *s1PRPCClient) M(ctx context.Context, in *M1, opts ...grpc.CallOption) (
 	return out, nil
 }
 
+func (c *s1PRPCClient) Watch(ctx context.Context, in *M1, opts ...grpc.CallOption) (S1_WatchClient, error) {
+	stream, err := c.client.CallServerStream(ctx, "test.S1", "Watch", in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &s1WatchClient{stream}, nil
+}
+
 type s1Client struct {
 	cc *grpc.ClientConn
 }
@@ -120,10 +129,43 @@ func (c *s1Client) M(ctx context.Context, in *M1, opts ...grpc.CallOption) (*M2,
 	return out, nil
 }
 
+func (c *s1Client) Watch(ctx context.Context, in *M1, opts ...grpc.CallOption) (S1_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_S1_serviceDesc.Streams[0], c.cc, "/test.S1/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &s1WatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type S1_WatchClient interface {
+	Recv() (*M2, error)
+	grpc.ClientStream
+}
+
+type s1WatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *s1WatchClient) Recv() (*M2, error) {
+	m := new(M2)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for S1 service
 
 type S1Server interface {
 	M(context.Context, *M1) (*M2, error)
+	Watch(*M1, S1_WatchServer) error
 }
 
 func RegisterS1Server(s prpc.Registrar, srv S1Server) {
@@ -148,6 +190,27 @@ func _S1_M_Handler(srv interface{}, ctx context.Context, dec func(interface{}) e
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S1_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(M1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(S1Server).Watch(m, &s1WatchServer{stream})
+}
+
+type S1_WatchServer interface {
+	Send(*M2) error
+	grpc.ServerStream
+}
+
+type s1WatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *s1WatchServer) Send(m *M2) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _S1_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "test.S1",
 	HandlerType: (*S1Server)(nil),
@@ -157,7 +220,13 @@ var _S1_serviceDesc = grpc.ServiceDesc{
 			Handler:    _S1_M_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _S1_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: fileDescriptor0,
 }
 
@@ -46,6 +46,11 @@ type Method struct {
 	Node       *ast.Field
 	InputType  string
 	OutputType string
+
+	// StreamType is the type of the server stream of a server-streaming
+	// method, e.g. "Greeter_SayHelloServer". It is empty for unary methods,
+	// and OutputType is empty for server-streaming methods.
+	StreamType string
 }
 
 type Import struct {
@@ -15,6 +15,7 @@
 package prpc
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -188,6 +189,128 @@ func (c *Client) CallWithFormats(ctx context.Context, serviceName, methodName st
 	return c.call(ctx, serviceName, methodName, in, inf, outf, options)
 }
 
+// CallServerStream makes a server-streaming RPC, returning a stream whose
+// RecvMsg reads the responses as the server sends them.
+//
+// Transient errors are retried according to retry options until the server
+// starts responding. Errors after that point are returned by RecvMsg. Only
+// the binary format is supported, and PerRPCTimeout is not applied.
+//
+// opts must be created by this package.
+// Calling from multiple goroutines concurrently is safe, unless Client is mutated.
+// Called from generated code.
+//
+// If there is a Deadline applied to the Context, it will be forwarded to the
+// server using the HeaderTimeout header.
+func (c *Client) CallServerStream(ctx context.Context, serviceName, methodName string, in proto.Message,
+	opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+	options, err := c.renderOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	switch options.AcceptContentSubtype {
+	case "", mtPRPCEncodingBinary:
+	default:
+		return nil, fmt.Errorf("contentSubtype %q is not supported by streaming calls", options.AcceptContentSubtype)
+	}
+
+	reqBody, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	req := prepareRequest(c.Host, serviceName, methodName, md, len(reqBody), FormatBinary, FormatBinary, options)
+	ctx = logging.SetFields(ctx, logging.Fields{
+		"host":    c.Host,
+		"service": serviceName,
+		"method":  methodName,
+	})
+
+	limit := c.MaxContentLength
+	if limit <= 0 {
+		limit = DefaultMaxContentLength
+	}
+
+	var res *http.Response
+	err = retry.Retry(
+		ctx,
+		transient.Only(options.Retry),
+		func() error {
+			if deadline, ok := ctx.Deadline(); ok {
+				delta := deadline.Sub(clock.Now(ctx))
+				if delta <= 0 {
+					return context.DeadlineExceeded
+				}
+				req.Header.Set(HeaderTimeout, EncodeTimeout(delta))
+			}
+			logging.Debugf(ctx, "Streaming RPC %s/%s.%s", c.Host, serviceName, methodName)
+
+			// Send the request.
+			req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+			r, err := ctxhttp.Do(ctx, c.getHTTPClient(), req)
+			if c.testPostHTTP != nil {
+				err = c.testPostHTTP(ctx, err)
+			}
+			if err != nil {
+				if r != nil && r.Body != nil {
+					r.Body.Close()
+				}
+				// Treat all errors here as transient.
+				return errors.Annotate(err, "failed to send request").Tag(transient.Tag).Err()
+			}
+
+			// A stream that has started always has an OK code. Anything else is an
+			// ordinary pRPC error response.
+			if r.Header.Get(HeaderGRPCCode) != strconv.Itoa(int(codes.OK)) {
+				defer r.Body.Close()
+				body, err := ioutil.ReadAll(io.LimitReader(r.Body, int64(limit)))
+				if err != nil {
+					return fmt.Errorf("failed to read response body: %s", err)
+				}
+				if err := c.responseError(r, string(body)); err != nil {
+					return err
+				}
+				return fmt.Errorf("invalid grpc code %q", r.Header.Get(HeaderGRPCCode))
+			}
+
+			if f, err := FormatFromContentType(r.Header.Get(headerContentType)); err != nil || f != FormatBinary {
+				r.Body.Close()
+				return fmt.Errorf("unexpected response content type %q", r.Header.Get(headerContentType))
+			}
+			res = r
+			return nil
+		},
+		func(err error, sleepTime time.Duration) {
+			logging.Fields{
+				logging.ErrorKey: err,
+				"sleepTime":      sleepTime,
+			}.Warningf(ctx, "RPC failed transiently. Will retry in %s", sleepTime)
+		},
+	)
+	if err != nil {
+		// See the comment in call about unwrapping gRPC errors.
+		innerErr := errors.Unwrap(err)
+		if innerErr != context.Canceled {
+			logging.WithError(err).Warningf(ctx, "RPC failed permanently: %s", err)
+		}
+		return nil, innerErr
+	}
+
+	header := metadataFromHeaders(res.Header)
+	if options.resHeaderMetadata != nil {
+		*options.resHeaderMetadata = header
+	}
+	return &clientStream{
+		ctx:     ctx,
+		body:    res.Body,
+		r:       bufio.NewReader(res.Body),
+		header:  header,
+		maxSize: limit,
+	}, nil
+}
+
 func (c *Client) call(ctx context.Context, serviceName, methodName string, in []byte, inf, outf Format,
 	options *Options) ([]byte, error) {
 
@@ -301,44 +424,7 @@ func (c *Client) call(ctx context.Context, serviceName, methodName string, in []
 				*options.resTrailerMetadata = metadataFromHeaders(res.Trailer)
 			}
 
-			codeHeader := res.Header.Get(HeaderGRPCCode)
-			if codeHeader == "" {
-				// Not a valid pRPC response.
-				body := buf.String()
-				bodySize := c.ErrBodySize
-				if bodySize <= 0 {
-					bodySize = 256
-				}
-				if len(body) > bodySize {
-					body = body[:bodySize] + "..."
-				}
-				err := fmt.Errorf("HTTP %d: no gRPC code. Body: %q", res.StatusCode, body)
-
-				// Some HTTP codes are returned directly by hosting platforms (e.g.,
-				// AppEngine), and should be automatically retried even if a gRPC code
-				// header is not supplied.
-				if res.StatusCode >= http.StatusInternalServerError {
-					err = transient.Tag.Apply(err)
-				}
-				return err
-			}
-
-			codeInt, err := strconv.Atoi(codeHeader)
-			if err != nil {
-				// Not a valid pRPC response.
-				return fmt.Errorf("invalid grpc code %q: %s", codeHeader, err)
-			}
-
-			code := codes.Code(codeInt)
-			if code != codes.OK {
-				desc := strings.TrimSuffix(buf.String(), "\n")
-				err := grpcutil.Errf(code, "%s", desc)
-				if grpcutil.IsTransientCode(code) {
-					err = transient.Tag.Apply(err)
-				}
-				return err
-			}
-			return nil
+			return c.responseError(res, buf.String())
 		},
 		func(err error, sleepTime time.Duration) {
 			logging.Fields{
@@ -386,6 +472,48 @@ func (c *Client) call(ctx context.Context, serviceName, methodName string, in []
 	return out, nil
 }
 
+// responseError returns the error described by a pRPC response with the
+// supplied body, or nil if the response has an OK gRPC code.
+func (c *Client) responseError(res *http.Response, body string) error {
+	codeHeader := res.Header.Get(HeaderGRPCCode)
+	if codeHeader == "" {
+		// Not a valid pRPC response.
+		bodySize := c.ErrBodySize
+		if bodySize <= 0 {
+			bodySize = 256
+		}
+		if len(body) > bodySize {
+			body = body[:bodySize] + "..."
+		}
+		err := fmt.Errorf("HTTP %d: no gRPC code. Body: %q", res.StatusCode, body)
+
+		// Some HTTP codes are returned directly by hosting platforms (e.g.,
+		// AppEngine), and should be automatically retried even if a gRPC code
+		// header is not supplied.
+		if res.StatusCode >= http.StatusInternalServerError {
+			err = transient.Tag.Apply(err)
+		}
+		return err
+	}
+
+	codeInt, err := strconv.Atoi(codeHeader)
+	if err != nil {
+		// Not a valid pRPC response.
+		return fmt.Errorf("invalid grpc code %q: %s", codeHeader, err)
+	}
+
+	code := codes.Code(codeInt)
+	if code != codes.OK {
+		desc := strings.TrimSuffix(body, "\n")
+		err := grpcutil.Errf(code, "%s", desc)
+		if grpcutil.IsTransientCode(code) {
+			err = transient.Tag.Apply(err)
+		}
+		return err
+	}
+	return nil
+}
+
 // prepareRequest creates an HTTP request for an RPC,
 // except it does not set the request body.
 func prepareRequest(host, serviceName, methodName string, md metadata.MD, contentLength int, inf, outf Format, options *Options) *http.Request {
@@ -19,7 +19,7 @@
 //  - service implementation does not depend on pRPC.
 // Unlike gRPC:
 //  - supports HTTP 1.x and AppEngine 1.x.
-//  - does not support streams.
+//  - supports server-streaming methods only, see "Server streaming" below.
 //
 // Server
 //
@@ -41,6 +41,29 @@
 //
 // Protocol
 //
+// ## Server streaming
+//
+// Server-streaming methods are an extension of v1.1. They are served at the
+// same URL, and use the same request headers, as unary methods. Client- and
+// bidirectional-streaming methods are not supported.
+//
+// If the method fails before it sends its first message, the server MUST
+// respond as it would for a failed unary method. Otherwise the server MUST
+// respond with HTTP 200 and "X-Prpc-Grpc-Code: 0", and the body is a sequence
+// of frames, flushed as they are written. The last frame MUST hold the final
+// status of the stream as a google.rpc.Status message. A response that ends
+// without a status frame was interrupted, and the client MUST treat it as an
+// Unavailable error.
+//
+// Frames are encoded according to the "Accept" request header:
+//  - Binary: a 1-byte frame type (0 for a message, 1 for the status),
+//    followed by the 4-byte big-endian payload size and the payload.
+//  - JSON: after the `)]}'\n` prefix, one line per frame, holding either
+//    `{"result": <message>}` or `{"error": <status>}`.
+//  - "text/event-stream": the JSON frames, without the prefix, as Server-Sent
+//    Events, e.g. `data: {"result": <message>}\n\n`.
+// The Text encoding is not supported for server-streaming methods.
+//
 // ## v1.1
 //
 // v1.1 is small, backward-compatible amendment to the protocol to address a
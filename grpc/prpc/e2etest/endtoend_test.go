@@ -16,8 +16,12 @@ package e2etest
 
 import (
 	"context"
+	"io"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"go.chromium.org/luci/common/testing/prpctest"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -27,12 +31,24 @@ import (
 type service struct {
 	R   *HelloReply
 	err error
+
+	// streamR are the replies sent by GreetStream before it returns err.
+	streamR []*HelloReply
 }
 
 func (s *service) Greet(c context.Context, req *HelloRequest) (*HelloReply, error) {
 	return s.R, s.err
 }
 
+func (s *service) GreetStream(req *HelloRequest, stream Hello_GreetStreamServer) error {
+	for _, r := range s.streamR {
+		if err := stream.Send(r); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
 func TestEndToEnd(t *testing.T) {
 	Convey(`A client/server for the Greet service`, t, func() {
 		c := context.Background()
@@ -57,5 +73,57 @@ func TestEndToEnd(t *testing.T) {
 			So(err, ShouldBeRPCOK)
 			So(resp, ShouldResembleProto, svc.R)
 		})
+
+		Convey(`For a server-streaming method`, func() {
+			recvAll := func(stream Hello_GreetStreamClient) ([]*HelloReply, error) {
+				var replies []*HelloReply
+				for {
+					r, err := stream.Recv()
+					if err != nil {
+						if err == io.EOF {
+							err = nil
+						}
+						return replies, err
+					}
+					replies = append(replies, r)
+				}
+			}
+
+			Convey(`Can receive a stream of messages.`, func() {
+				svc.streamR = []*HelloReply{{Message: "one"}, {Message: "two"}, {Message: "three"}}
+
+				stream, err := client.GreetStream(c, &HelloRequest{Name: "stream"})
+				So(err, ShouldBeRPCOK)
+				replies, err := recvAll(stream)
+				So(err, ShouldBeNil)
+				So(replies, ShouldResembleProto, svc.streamR)
+			})
+
+			Convey(`Can receive an empty stream.`, func() {
+				stream, err := client.GreetStream(c, &HelloRequest{Name: "stream"})
+				So(err, ShouldBeRPCOK)
+				replies, err := recvAll(stream)
+				So(err, ShouldBeNil)
+				So(replies, ShouldBeEmpty)
+			})
+
+			Convey(`Returns an error that occurs before the first message.`, func() {
+				svc.err = status.Error(codes.NotFound, "not found")
+
+				_, err := client.GreetStream(c, &HelloRequest{Name: "stream"})
+				So(err, ShouldHaveRPCCode, codes.NotFound, "not found")
+			})
+
+			Convey(`Returns an error that terminates the stream.`, func() {
+				svc.streamR = []*HelloReply{{Message: "one"}}
+				svc.err = status.Error(codes.FailedPrecondition, "stopped")
+
+				stream, err := client.GreetStream(c, &HelloRequest{Name: "stream"})
+				So(err, ShouldBeRPCOK)
+				replies, err := recvAll(stream)
+				So(err, ShouldHaveRPCCode, codes.FailedPrecondition, "stopped")
+				So(replies, ShouldResembleProto, svc.streamR)
+			})
+		})
 	})
 }
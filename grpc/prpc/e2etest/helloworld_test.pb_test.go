@@ -116,7 +116,7 @@ func init() {
 }
 
 var fileDescriptor_cd20db8c12ae006e = []byte{
-	// 174 bytes of a gzipped FileDescriptorProto
+	// 186 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x49, 0xcf, 0xd7, 0x4b,
 	0xce, 0x28, 0xca, 0xcf, 0xcd, 0x2c, 0xcd, 0xd5, 0xcb, 0x2f, 0x4a, 0xd7, 0xcf, 0x29, 0x4d, 0xce,
 	0xd4, 0x4f, 0x2f, 0x2a, 0x48, 0xd6, 0x2f, 0x00, 0x11, 0xa9, 0x46, 0xa9, 0x25, 0xa9, 0xc5, 0x25,
@@ -124,10 +124,11 @@ var fileDescriptor_cd20db8c12ae006e = []byte{
 	0x51, 0x7e, 0x49, 0xbe, 0x10, 0x3b, 0x54, 0x5a, 0x49, 0x89, 0x8b, 0xc7, 0x03, 0xa4, 0x22, 0x28,
 	0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x48, 0x88, 0x8b, 0x25, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x51,
 	0x81, 0x51, 0x83, 0x33, 0x08, 0xcc, 0x56, 0x52, 0xe3, 0xe2, 0x82, 0xaa, 0x29, 0xc8, 0xa9, 0x14,
-	0x92, 0xe0, 0x62, 0xcf, 0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x87, 0x29, 0x82, 0x71, 0x8d, 0x6c, 0xb8,
+	0x92, 0xe0, 0x62, 0xcf, 0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x87, 0x29, 0x82, 0x71, 0x8d, 0x2a, 0xb9,
 	0x58, 0xc1, 0xea, 0x84, 0x8c, 0xb9, 0x58, 0xdd, 0x8b, 0x52, 0x53, 0x4b, 0x84, 0x44, 0xf5, 0xa0,
-	0xf6, 0xe8, 0x21, 0x5b, 0x22, 0x25, 0x8c, 0x2e, 0x5c, 0x90, 0x53, 0x99, 0xc4, 0x06, 0x76, 0x99,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xab, 0x37, 0xd6, 0x0f, 0xd9, 0x00, 0x00, 0x00,
+	0xf6, 0xe8, 0x21, 0x5b, 0x22, 0x25, 0x8c, 0x2e, 0x0c, 0x32, 0xd7, 0x9a, 0x8b, 0x1b, 0xac, 0x29,
+	0xb8, 0xa4, 0x28, 0x35, 0x31, 0x97, 0x14, 0xad, 0x06, 0x8c, 0x49, 0x6c, 0x60, 0x6f, 0x19, 0x03,
+	0x06, 0x00, 0xb6, 0x41, 0xc4, 0x12, 0x16, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -143,6 +144,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type HelloClient interface {
 	Greet(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
+	GreetStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Hello_GreetStreamClient, error)
 }
 type helloPRPCClient struct {
 	client *prpc.Client
@@ -161,6 +163,14 @@ func (c *helloPRPCClient) Greet(ctx context.Context, in *HelloRequest, opts ...g
 	return out, nil
 }
 
+func (c *helloPRPCClient) GreetStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Hello_GreetStreamClient, error) {
+	stream, err := c.client.CallServerStream(ctx, "e2etest.Hello", "GreetStream", in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &helloGreetStreamClient{stream}, nil
+}
+
 type helloClient struct {
 	cc *grpc.ClientConn
 }
@@ -178,9 +188,42 @@ func (c *helloClient) Greet(ctx context.Context, in *HelloRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *helloClient) GreetStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Hello_GreetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Hello_serviceDesc.Streams[0], "/e2etest.Hello/GreetStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &helloGreetStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Hello_GreetStreamClient interface {
+	Recv() (*HelloReply, error)
+	grpc.ClientStream
+}
+
+type helloGreetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *helloGreetStreamClient) Recv() (*HelloReply, error) {
+	m := new(HelloReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HelloServer is the server API for Hello service.
 type HelloServer interface {
 	Greet(context.Context, *HelloRequest) (*HelloReply, error)
+	GreetStream(*HelloRequest, Hello_GreetStreamServer) error
 }
 
 // UnimplementedHelloServer can be embedded to have forward compatible implementations.
@@ -190,6 +233,9 @@ type UnimplementedHelloServer struct {
 func (*UnimplementedHelloServer) Greet(ctx context.Context, req *HelloRequest) (*HelloReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Greet not implemented")
 }
+func (*UnimplementedHelloServer) GreetStream(req *HelloRequest, srv Hello_GreetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GreetStream not implemented")
+}
 
 func RegisterHelloServer(s prpc.Registrar, srv HelloServer) {
 	s.RegisterService(&_Hello_serviceDesc, srv)
@@ -213,6 +259,27 @@ func _Hello_Greet_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Hello_GreetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HelloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HelloServer).GreetStream(m, &helloGreetStreamServer{stream})
+}
+
+type Hello_GreetStreamServer interface {
+	Send(*HelloReply) error
+	grpc.ServerStream
+}
+
+type helloGreetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *helloGreetStreamServer) Send(m *HelloReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Hello_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "e2etest.Hello",
 	HandlerType: (*HelloServer)(nil),
@@ -222,6 +289,12 @@ var _Hello_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Hello_Greet_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GreetStream",
+			Handler:       _Hello_GreetStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "go.chromium.org/luci/grpc/prpc/e2etest/helloworld_test.proto",
 }
@@ -84,12 +84,20 @@ type Server struct {
 	// invoke handler to complete the RPC.
 	UnaryServerInterceptor grpc.UnaryServerInterceptor
 
+	// StreamServerInterceptor provides a hook to intercept the execution of
+	// a server-streaming RPC on the server. It is the responsibility of the
+	// interceptor to invoke handler to complete the RPC.
+	//
+	// UnaryServerInterceptor does not apply to streaming RPCs.
+	StreamServerInterceptor grpc.StreamServerInterceptor
+
 	mu       sync.Mutex
 	services map[string]*service
 }
 
 type service struct {
 	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
 	impl    interface{}
 }
 
@@ -99,15 +107,24 @@ type service struct {
 // desc must contain description of the service, its message types
 // and all transitive dependencies.
 //
+// Only unary and server-streaming methods are served. Client-streaming and
+// bidirectional streaming methods are ignored.
+//
 // Panics if a service of the same name is already registered.
 func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
 	serv := &service{
 		impl:    impl,
 		methods: make(map[string]grpc.MethodDesc, len(desc.Methods)),
+		streams: make(map[string]grpc.StreamDesc, len(desc.Streams)),
 	}
 	for _, m := range desc.Methods {
 		serv.methods[m.MethodName] = m
 	}
+	for _, st := range desc.Streams {
+		if st.ServerStreams && !st.ClientStreams {
+			serv.streams[st.StreamName] = st
+		}
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -170,6 +187,12 @@ func (s *Server) handlePOST(c *router.Context) {
 	serviceName := c.Params.ByName("service")
 	methodName := c.Params.ByName("method")
 	s.setAccessControlHeaders(c, false)
+	if service := s.services[serviceName]; service != nil {
+		if st, ok := service.streams[methodName]; ok {
+			s.callStream(c, serviceName, service, st)
+			return
+		}
+	}
 	res := s.call(c, serviceName, methodName)
 	if res.err != nil {
 		writeError(c.Context, c.Writer, res.err)
@@ -273,6 +296,39 @@ func (s *Server) call(c *router.Context, serviceName, methodName string) (r resp
 	return
 }
 
+// callStream calls a server-streaming method, writing its responses to c as
+// they are sent.
+func (s *Server) callStream(c *router.Context, serviceName string, service *service, desc grpc.StreamDesc) {
+	ss := &serverStream{
+		w:   c.Writer,
+		req: c.Request,
+	}
+
+	var perr *protocolError
+	if ss.format, ss.sse, perr = streamResponseFormat(c.Request.Header.Get(headerAccept)); perr != nil {
+		writeError(c.Context, c.Writer, perr)
+		return
+	}
+
+	methodCtx, err := parseHeader(c.Context, c.Request.Header)
+	if err != nil {
+		writeError(c.Context, c.Writer, withStatus(err, http.StatusBadRequest))
+		return
+	}
+	ss.ctx = context.WithValue(methodCtx, &requestContextKey, &requestContext{header: c.Writer.Header()})
+
+	if s.StreamServerInterceptor == nil {
+		ss.finish(desc.Handler(service.impl, ss))
+		return
+	}
+	info := &grpc.StreamServerInfo{
+		FullMethod:     fmt.Sprintf("/%s/%s", serviceName, desc.StreamName),
+		IsClientStream: desc.ClientStreams,
+		IsServerStream: desc.ServerStreams,
+	}
+	ss.finish(s.StreamServerInterceptor(service.impl, ss, info, desc.Handler))
+}
+
 func (s *Server) setAccessControlHeaders(c *router.Context, preflight bool) {
 	// Don't write out access control headers if the origin is unspecified.
 	const originHeader = "Origin"
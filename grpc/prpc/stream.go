@@ -0,0 +1,353 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+// This file implements the framing of server-streaming RPC responses.
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+)
+
+const (
+	// ContentTypeEventStream is the media type of a server-streaming response
+	// encoded as Server-Sent Events.
+	ContentTypeEventStream = "text/event-stream"
+
+	// streamFrameHeaderSize is the size of a binary stream frame header: a
+	// one-byte frame type, followed by a 4-byte big-endian payload size.
+	streamFrameHeaderSize = 5
+
+	// streamFrameMessage is the type of a binary stream frame holding a
+	// response message.
+	streamFrameMessage byte = 0
+	// streamFrameStatus is the type of a binary stream frame holding the
+	// google.rpc.Status that terminates the stream.
+	streamFrameStatus byte = 1
+)
+
+// streamResponseFormat returns the format to be used in a server-streaming
+// response, and whether it should be encoded as Server-Sent Events.
+func streamResponseFormat(acceptHeader string) (Format, bool, *protocolError) {
+	if acceptHeader != "" {
+		if parsed, err := parseAccept(acceptHeader); err == nil {
+			for _, at := range parsed {
+				if at.MediaType == ContentTypeEventStream {
+					return FormatJSONPB, true, nil
+				}
+			}
+		}
+	}
+
+	f, perr := responseFormat(acceptHeader)
+	if perr == nil && f == FormatText {
+		perr = errorf(http.StatusNotAcceptable, "Accept header: %q is not supported by streaming methods",
+			FormatText.MediaType())
+	}
+	return f, false, perr
+}
+
+// serverStream is a grpc.ServerStream that writes a server-streaming response
+// to an HTTP response.
+type serverStream struct {
+	ctx    context.Context
+	w      http.ResponseWriter
+	req    *http.Request
+	format Format
+	sse    bool
+
+	// started is true once the response header has been written.
+	started bool
+	// received is true once the request message has been read.
+	received bool
+}
+
+var _ grpc.ServerStream = (*serverStream)(nil)
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	if s.started {
+		return errors.New("prpc: the response header has already been sent")
+	}
+	return SetHeader(s.ctx, md)
+}
+
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	s.start()
+	return nil
+}
+
+// SetTrailer is a no-op; pRPC does not support response trailers.
+func (s *serverStream) SetTrailer(metadata.MD) {}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return grpcutil.Errf(codes.Internal, "response is not a proto.Message: %T", m)
+	}
+
+	var payload []byte
+	var err error
+	if s.format == FormatBinary {
+		payload, err = proto.Marshal(msg)
+	} else {
+		payload, err = marshalStreamJSON("result", msg)
+	}
+	if err != nil {
+		return grpcutil.Errf(codes.Internal, "failed to marshal response: %s", err)
+	}
+
+	s.start()
+	return s.writeFrame(streamFrameMessage, payload)
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return io.EOF
+	}
+	s.received = true
+
+	if m == nil {
+		return grpcutil.Errf(codes.Internal, "input message is nil")
+	}
+	// Do not collapse it to one line. There is implicit err type conversion.
+	if perr := readMessage(s.req, m.(proto.Message)); perr != nil {
+		return perr
+	}
+	return nil
+}
+
+// start writes the response header, if it hasn't been written yet.
+func (s *serverStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	h := s.w.Header()
+	h.Set(HeaderGRPCCode, strconv.Itoa(int(codes.OK)))
+	if s.sse {
+		h.Set(headerContentType, ContentTypeEventStream)
+		h.Set("Cache-Control", "no-cache")
+	} else {
+		h.Set(headerContentType, s.format.MediaType())
+	}
+	h.Set("X-Content-Type-Options", "nosniff")
+	s.w.WriteHeader(http.StatusOK)
+
+	if s.format == FormatJSONPB && !s.sse {
+		io.WriteString(s.w, JSONPBPrefix)
+	}
+}
+
+// finish terminates the response with the status of err.
+//
+// If the response hasn't started yet and err is not nil, it is written as
+// an ordinary pRPC error response.
+func (s *serverStream) finish(err error) {
+	if !s.started && err != nil {
+		writeError(s.ctx, s.w, err)
+		return
+	}
+	s.start()
+
+	st := &spb.Status{}
+	if err != nil {
+		code := errorCode(err)
+		st.Code = int32(code)
+		st.Message = grpc.ErrorDesc(err)
+
+		level := logging.Warning
+		if grpcutil.CodeStatus(code) >= 500 {
+			level = logging.Error
+			// Hide potential implementation details from the user.
+			st.Message = http.StatusText(grpcutil.CodeStatus(code))
+		}
+		logging.Logf(s.ctx, level, "prpc: terminating stream with %s error: %s", code, grpc.ErrorDesc(err))
+	}
+
+	var payload []byte
+	if s.format == FormatBinary {
+		payload, err = proto.Marshal(st)
+	} else {
+		payload, err = marshalStreamJSON("error", st)
+	}
+	if err != nil {
+		logging.WithError(err).Errorf(s.ctx, "prpc: failed to marshal stream status")
+		return
+	}
+	if err := s.writeFrame(streamFrameStatus, payload); err != nil {
+		logging.WithError(err).Errorf(s.ctx, "prpc: failed to write stream status")
+	}
+}
+
+// writeFrame writes a single frame to the response and flushes it.
+func (s *serverStream) writeFrame(frameType byte, payload []byte) error {
+	var buf bytes.Buffer
+	switch {
+	case s.format == FormatBinary:
+		var hdr [streamFrameHeaderSize]byte
+		hdr[0] = frameType
+		binary.BigEndian.PutUint32(hdr[1:], uint32(len(payload)))
+		buf.Write(hdr[:])
+		buf.Write(payload)
+
+	case s.sse:
+		buf.WriteString("data: ")
+		buf.Write(payload)
+		buf.WriteString("\n\n")
+
+	default:
+		buf.Write(payload)
+		buf.WriteByte('\n')
+	}
+
+	if _, err := s.w.Write(buf.Bytes()); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to write response: %s", err)
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// marshalStreamJSON returns msg as a single-line JSON object, wrapped in a
+// JSON object under the supplied key.
+func marshalStreamJSON(key string, msg proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{%q:", key)
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// clientStream is a grpc.ClientStream that reads a binary server-streaming
+// response.
+type clientStream struct {
+	ctx    context.Context
+	body   io.ReadCloser
+	r      *bufio.Reader
+	header metadata.MD
+
+	// maxSize is the maximum size of a single frame.
+	maxSize int
+	// err is the error that terminated the stream. It is io.EOF if the stream
+	// completed successfully.
+	err error
+}
+
+var _ grpc.ClientStream = (*clientStream)(nil)
+
+func (s *clientStream) Header() (metadata.MD, error) { return s.header, nil }
+func (s *clientStream) Trailer() metadata.MD         { return nil }
+func (s *clientStream) CloseSend() error             { return nil }
+func (s *clientStream) Context() context.Context     { return s.ctx }
+
+func (s *clientStream) SendMsg(interface{}) error {
+	return errors.New("prpc: only server-streaming methods are supported")
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	frameType, payload, err := s.readFrame()
+	if err != nil {
+		return s.fail(err)
+	}
+
+	switch frameType {
+	case streamFrameMessage:
+		if err := proto.Unmarshal(payload, m.(proto.Message)); err != nil {
+			return s.fail(errors.Annotate(err, "failed to unmarshal response").Err())
+		}
+		return nil
+
+	case streamFrameStatus:
+		st := &spb.Status{}
+		if err := proto.Unmarshal(payload, st); err != nil {
+			return s.fail(errors.Annotate(err, "failed to unmarshal stream status").Err())
+		}
+		if err := status.ErrorProto(st); err != nil {
+			return s.fail(err)
+		}
+		return s.fail(io.EOF)
+
+	default:
+		return s.fail(fmt.Errorf("unknown stream frame type %d", frameType))
+	}
+}
+
+// readFrame reads the next binary frame from the response.
+func (s *clientStream) readFrame() (byte, []byte, error) {
+	var hdr [streamFrameHeaderSize]byte
+	if _, err := io.ReadFull(s.r, hdr[:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, s.readError(err)
+	}
+
+	size := binary.BigEndian.Uint32(hdr[1:])
+	if int64(size) > int64(s.maxSize) {
+		return 0, nil, ErrResponseTooBig
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(s.r, payload); err != nil {
+		return 0, nil, s.readError(err)
+	}
+	return hdr[0], payload, nil
+}
+
+// readError converts an error reading the response body into a gRPC error.
+func (s *clientStream) readError(err error) error {
+	if cerr := s.ctx.Err(); cerr != nil {
+		return status.Error(errorCode(cerr), cerr.Error())
+	}
+	return status.Errorf(codes.Unavailable, "failed to read response: %s", err)
+}
+
+// fail terminates the stream with err, and releases the response body.
+func (s *clientStream) fail(err error) error {
+	s.err = err
+	s.body.Close()
+	return err
+}
@@ -0,0 +1,225 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/server/router"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// greetStream greets each of the names in a request, one name per message.
+type greetStream struct {
+	names []string
+	err   error
+}
+
+var greetStreamDesc = grpc.ServiceDesc{
+	ServiceName: "prpc.GreetStream",
+	HandlerType: (*interface{})(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName: "Greet",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				in := &HelloRequest{}
+				if err := stream.RecvMsg(in); err != nil {
+					return err
+				}
+				return srv.(*greetStream).greet(in, stream)
+			},
+			ServerStreams: true,
+		},
+	},
+}
+
+func (s *greetStream) greet(req *HelloRequest, stream grpc.ServerStream) error {
+	if req.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "Name unspecified")
+	}
+	for _, n := range s.names {
+		if err := stream.SendMsg(&HelloReply{Message: req.Name + " says hello to " + n}); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+func TestServerStream(t *testing.T) {
+	t.Parallel()
+
+	Convey("Server-streaming methods", t, func() {
+		c := context.Background()
+
+		svc := &greetStream{names: []string{"Alice", "Bob"}}
+		server := Server{Authenticator: NoAuthentication}
+		server.RegisterService(&greetStreamDesc, svc)
+
+		r := router.New()
+		server.InstallHandlers(r, router.NewMiddlewareChain(
+			func(ctx *router.Context, next router.Handler) {
+				ctx.Context = c
+				next(ctx)
+			},
+		))
+
+		res := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/prpc/prpc.GreetStream/Greet", bytes.NewBufferString(`{"name": "Lucy"}`))
+		So(err, ShouldBeNil)
+		req.Header.Set("Content-Type", ContentTypeJSON)
+
+		readBinary := func() ([]*HelloReply, error) {
+			cs := &clientStream{
+				ctx:     c,
+				body:    ioutil.NopCloser(res.Body),
+				r:       bufio.NewReader(res.Body),
+				maxSize: 1024,
+			}
+			var replies []*HelloReply
+			for {
+				reply := &HelloReply{}
+				switch err := cs.RecvMsg(reply); err {
+				case nil:
+					replies = append(replies, reply)
+				case io.EOF:
+					return replies, nil
+				default:
+					return replies, err
+				}
+			}
+		}
+
+		Convey("Binary", func() {
+			r.ServeHTTP(res, req)
+			So(res.Code, ShouldEqual, http.StatusOK)
+			So(res.Header().Get(HeaderGRPCCode), ShouldEqual, "0")
+			So(res.Header().Get(headerContentType), ShouldEqual, FormatBinary.MediaType())
+
+			replies, err := readBinary()
+			So(err, ShouldBeNil)
+			So(replies, ShouldResemble, []*HelloReply{
+				{Message: "Lucy says hello to Alice"},
+				{Message: "Lucy says hello to Bob"},
+			})
+		})
+
+		Convey("Binary with an error terminating the stream", func() {
+			svc.err = status.Errorf(codes.FailedPrecondition, "out of names")
+			r.ServeHTTP(res, req)
+			So(res.Code, ShouldEqual, http.StatusOK)
+
+			replies, err := readBinary()
+			So(replies, ShouldHaveLength, 2)
+			So(status.Code(err), ShouldEqual, codes.FailedPrecondition)
+			So(status.Convert(err).Message(), ShouldEqual, "out of names")
+		})
+
+		Convey("Binary with an interrupted stream", func() {
+			r.ServeHTTP(res, req)
+			res.Body.Truncate(res.Body.Len() - 1)
+
+			replies, err := readBinary()
+			So(replies, ShouldHaveLength, 2)
+			So(status.Code(err), ShouldEqual, codes.Unavailable)
+		})
+
+		Convey("JSON", func() {
+			svc.err = status.Errorf(codes.FailedPrecondition, "out of names")
+			req.Header.Set("Accept", ContentTypeJSON)
+			r.ServeHTTP(res, req)
+			So(res.Code, ShouldEqual, http.StatusOK)
+			So(res.Header().Get(headerContentType), ShouldEqual, ContentTypeJSON)
+			So(res.Body.String(), ShouldEqual, JSONPBPrefix+
+				`{"result":{"message":"Lucy says hello to Alice"}}`+"\n"+
+				`{"result":{"message":"Lucy says hello to Bob"}}`+"\n"+
+				`{"error":{"code":`+strconv.Itoa(int(codes.FailedPrecondition))+`,"message":"out of names"}}`+"\n")
+		})
+
+		Convey("Server-Sent Events", func() {
+			svc.names = svc.names[:1]
+			req.Header.Set("Accept", ContentTypeEventStream)
+			r.ServeHTTP(res, req)
+			So(res.Code, ShouldEqual, http.StatusOK)
+			So(res.Header().Get(headerContentType), ShouldEqual, ContentTypeEventStream)
+			So(res.Body.String(), ShouldEqual,
+				`data: {"result":{"message":"Lucy says hello to Alice"}}`+"\n\n"+
+					`data: {"error":{}}`+"\n\n")
+		})
+
+		Convey("An error before the first message is a pRPC error", func() {
+			req.Body = ioutil.NopCloser(bytes.NewBufferString(`{}`))
+			r.ServeHTTP(res, req)
+			So(res.Code, ShouldEqual, http.StatusBadRequest)
+			So(res.Header().Get(HeaderGRPCCode), ShouldEqual, strconv.Itoa(int(codes.InvalidArgument)))
+			So(res.Body.String(), ShouldEqual, "Name unspecified\n")
+		})
+
+		Convey("Internal errors are hidden", func() {
+			svc.err = status.Errorf(codes.Internal, "secret details")
+			req.Header.Set("Accept", ContentTypeJSON)
+			r.ServeHTTP(res, req)
+			So(res.Body.String(), ShouldEndWith,
+				`{"error":{"code":`+strconv.Itoa(int(codes.Internal))+`,"message":"Internal Server Error"}}`+"\n")
+		})
+
+		Convey("Stream interceptor", func() {
+			var methods []string
+			deny := false
+			server.StreamServerInterceptor = func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				methods = append(methods, info.FullMethod)
+				if deny {
+					return status.Errorf(codes.PermissionDenied, "denied")
+				}
+				return handler(srv, ss)
+			}
+
+			Convey("calls the handler", func() {
+				r.ServeHTTP(res, req)
+				So(res.Code, ShouldEqual, http.StatusOK)
+				So(methods, ShouldResemble, []string{"/prpc.GreetStream/Greet"})
+
+				replies, err := readBinary()
+				So(err, ShouldBeNil)
+				So(replies, ShouldHaveLength, 2)
+			})
+
+			Convey("can reject the call", func() {
+				deny = true
+				r.ServeHTTP(res, req)
+				So(res.Code, ShouldEqual, http.StatusForbidden)
+				So(res.Header().Get(HeaderGRPCCode), ShouldEqual, strconv.Itoa(int(codes.PermissionDenied)))
+			})
+		})
+
+		Convey("Text is not supported", func() {
+			req.Header.Set("Accept", FormatText.MediaType())
+			r.ServeHTTP(res, req)
+			So(res.Code, ShouldEqual, http.StatusNotAcceptable)
+		})
+	})
+}
@@ -312,10 +312,16 @@ type Archivist struct {
 	GsStagingBucket string `protobuf:"bytes,3,opt,name=gs_staging_bucket,json=gsStagingBucket,proto3" json:"gs_staging_bucket,omitempty"`
 	// Service-wide index configuration. This is used if per-project configuration
 	// is not specified.
-	ArchiveIndexConfig   *ArchiveIndexConfig `protobuf:"bytes,10,opt,name=archive_index_config,json=archiveIndexConfig,proto3" json:"archive_index_config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ArchiveIndexConfig *ArchiveIndexConfig `protobuf:"bytes,10,opt,name=archive_index_config,json=archiveIndexConfig,proto3" json:"archive_index_config,omitempty"`
+	// If true, log streams are archived in the seekable ZLIB_FRAMES format (see
+	// logpb.LogIndex.Format), rather than as plain RecordIO.
+	//
+	// A compressed frame is started at each index entry, so this should be used
+	// with a sparse archive index configuration.
+	ArchiveZlibFrames    bool     `protobuf:"varint,14,opt,name=archive_zlib_frames,json=archiveZlibFrames,proto3" json:"archive_zlib_frames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Archivist) Reset()         { *m = Archivist{} }
@@ -371,6 +377,13 @@ func (m *Archivist) GetArchiveIndexConfig() *ArchiveIndexConfig {
 	return nil
 }
 
+func (m *Archivist) GetArchiveZlibFrames() bool {
+	if m != nil {
+		return m.ArchiveZlibFrames
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "svcconfig.Config")
 	proto.RegisterType((*Coordinator)(nil), "svcconfig.Coordinator")
@@ -383,47 +396,48 @@ func init() {
 }
 
 var fileDescriptor_d90d5c2d0a680180 = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdd, 0x6a, 0xdb, 0x3c,
-	0x1c, 0xc6, 0x49, 0xbf, 0xde, 0xd7, 0x4a, 0x3f, 0x12, 0x2d, 0xed, 0xbc, 0xc2, 0xba, 0x90, 0x9d,
-	0x84, 0x51, 0x6c, 0xe8, 0x60, 0xec, 0x64, 0x1f, 0x5d, 0xda, 0x8d, 0x6d, 0x94, 0x82, 0x53, 0xd8,
-	0xa1, 0x50, 0x64, 0x45, 0x11, 0xb5, 0x2d, 0x23, 0xc9, 0xad, 0xd7, 0x6b, 0xd8, 0xd1, 0xae, 0x6f,
-	0xd7, 0xb0, 0x6b, 0x18, 0x96, 0xe4, 0x0f, 0xe8, 0x41, 0xe9, 0x51, 0xe2, 0xe7, 0xff, 0xfc, 0x9e,
-	0x48, 0x7a, 0x14, 0x83, 0x77, 0x4c, 0x04, 0x64, 0x25, 0x45, 0xca, 0x8b, 0x34, 0x10, 0x92, 0x85,
-	0x49, 0x41, 0x78, 0x98, 0x08, 0x16, 0x0b, 0x16, 0xe2, 0x9c, 0x87, 0x44, 0x64, 0x4b, 0xce, 0x42,
-	0x75, 0x43, 0xdc, 0x37, 0xfb, 0x11, 0xe4, 0x52, 0x68, 0x01, 0xbd, 0x46, 0x3f, 0xfc, 0xf0, 0xe8,
-	0x24, 0x2c, 0xc9, 0x8a, 0xdf, 0xe0, 0xc4, 0x66, 0x1d, 0xbe, 0x7f, 0x74, 0x80, 0xd2, 0x42, 0x62,
-	0x46, 0x1d, 0xff, 0xf1, 0xd1, 0xbc, 0x96, 0x38, 0x53, 0xb9, 0x90, 0xda, 0x25, 0x1c, 0x31, 0x21,
-	0x58, 0x42, 0x43, 0xf3, 0xb4, 0x28, 0x96, 0x61, 0x5c, 0x48, 0xac, 0xb9, 0xc8, 0xec, 0x7c, 0xf2,
-	0x6b, 0x0d, 0x6c, 0xcd, 0x0c, 0x0a, 0x4f, 0x80, 0xd7, 0xd0, 0x3e, 0x18, 0xf7, 0xa6, 0xfd, 0x93,
-	0x51, 0xd0, 0x24, 0x07, 0x57, 0xf5, 0x2c, 0x6a, 0x6d, 0xf0, 0x18, 0xfc, 0xe7, 0x56, 0xec, 0xf7,
-	0x0d, 0x01, 0x3b, 0xc4, 0xdc, 0x4e, 0xa2, 0xda, 0x02, 0xdf, 0x82, 0x3e, 0x11, 0x42, 0xc6, 0x3c,
-	0xc3, 0x5a, 0x48, 0x7f, 0x64, 0x88, 0x83, 0x0e, 0x31, 0x6b, 0xa7, 0x51, 0xd7, 0x5a, 0xad, 0x8d,
-	0x88, 0x24, 0xa1, 0xa4, 0xe2, 0xf6, 0xef, 0xad, 0x6d, 0x56, 0xcf, 0xa2, 0xd6, 0x56, 0x31, 0xb6,
-	0x0e, 0xae, 0xb4, 0x7f, 0x70, 0x8f, 0x39, 0xad, 0x67, 0x51, 0x6b, 0x9b, 0xfc, 0x5e, 0x07, 0xfd,
-	0xce, 0x22, 0xe0, 0x14, 0x0c, 0x70, 0x9c, 0xf2, 0x0c, 0xe1, 0x42, 0xaf, 0x10, 0x93, 0xa2, 0xc8,
-	0xcd, 0xd1, 0x78, 0xd1, 0xae, 0xd1, 0x4f, 0x0b, 0xbd, 0xfa, 0x52, 0xa9, 0xf0, 0x18, 0x40, 0x45,
-	0xe5, 0x0d, 0x27, 0xb4, 0xeb, 0xed, 0x1b, 0xef, 0xc0, 0x4d, 0x5a, 0xf7, 0x2b, 0x30, 0x94, 0x39,
-	0x41, 0x38, 0x49, 0xc4, 0x2d, 0x12, 0x92, 0x33, 0x9e, 0x29, 0x7f, 0x34, 0x5e, 0x9f, 0x7a, 0xd1,
-	0x9e, 0xcc, 0xc9, 0x69, 0xa5, 0x5f, 0x5a, 0x19, 0x7e, 0x06, 0xc3, 0x5c, 0xd2, 0x25, 0x2f, 0x11,
-	0x2d, 0x73, 0x6e, 0xdb, 0x73, 0x67, 0xf0, 0x2c, 0xb0, 0xf5, 0x06, 0x75, 0xbd, 0xc1, 0x99, 0xab,
-	0x37, 0x1a, 0x58, 0xe6, 0xbc, 0x41, 0xe0, 0x4b, 0xb0, 0x63, 0x37, 0x4a, 0x91, 0x16, 0x39, 0x27,
-	0xfe, 0x91, 0x59, 0xdc, 0xb6, 0x13, 0xaf, 0x2a, 0x0d, 0x7e, 0x07, 0xa3, 0xda, 0xa4, 0xa8, 0xd6,
-	0x09, 0x45, 0x31, 0x4d, 0xf0, 0x4f, 0xff, 0xc5, 0x43, 0xbf, 0x07, 0x1d, 0x36, 0x37, 0xd4, 0x59,
-	0x05, 0xc1, 0x73, 0x30, 0xac, 0xc3, 0x4c, 0x0a, 0x4a, 0x71, 0xe9, 0x8f, 0x1f, 0x4a, 0xda, 0x73,
-	0x8c, 0xc9, 0xb8, 0xc0, 0xe5, 0xe4, 0x6f, 0x0f, 0x78, 0x4d, 0xc3, 0xf0, 0x0d, 0x78, 0x9a, 0xe2,
-	0x12, 0x11, 0x91, 0x91, 0x42, 0x4a, 0x9a, 0x69, 0x94, 0x52, 0xa5, 0x30, 0xa3, 0xca, 0xef, 0x8d,
-	0x7b, 0xd3, 0xcd, 0x68, 0x3f, 0xc5, 0xe5, 0xac, 0x99, 0x5e, 0xb8, 0x21, 0x0c, 0xc0, 0x93, 0x8a,
-	0x73, 0x66, 0x74, 0x2b, 0xe4, 0x35, 0x95, 0xca, 0x5f, 0x33, 0xcc, 0x30, 0xc5, 0xa5, 0x73, 0xfe,
-	0xb0, 0x83, 0xaa, 0x7a, 0xa5, 0xb1, 0xa6, 0x88, 0x60, 0xb2, 0xa2, 0x48, 0xf1, 0x3b, 0xea, 0xaf,
-	0x1b, 0xf3, 0xae, 0xd1, 0x67, 0x95, 0x3c, 0xe7, 0x77, 0x14, 0x5e, 0x82, 0x83, 0xae, 0xb3, 0xd3,
-	0xd2, 0xc6, 0x43, 0x7b, 0x1d, 0xb5, 0x51, 0x6d, 0x53, 0x93, 0x3f, 0x3d, 0xe0, 0x35, 0xd7, 0x13,
-	0x4e, 0xc0, 0xb6, 0x2a, 0x16, 0x8a, 0x48, 0x9e, 0x9b, 0xd0, 0x9e, 0xad, 0xad, 0xab, 0xc1, 0x11,
-	0xd8, 0xd4, 0x58, 0x5d, 0xd7, 0xdb, 0xb1, 0x0f, 0xd5, 0x2d, 0x63, 0x0a, 0x29, 0x8d, 0x19, 0xcf,
-	0x18, 0x5a, 0x14, 0xe4, 0x9a, 0x6a, 0xb3, 0x07, 0x2f, 0xda, 0x63, 0x6a, 0x6e, 0xf5, 0x4f, 0x46,
-	0x86, 0x97, 0x6d, 0xf1, 0x3c, 0x8b, 0xa9, 0x39, 0xe0, 0x25, 0x67, 0xee, 0x45, 0xf0, 0xfc, 0xde,
-	0x1f, 0x87, 0x7e, 0xad, 0x5c, 0xf6, 0xd5, 0xd1, 0x94, 0xdf, 0xd1, 0xbe, 0x6d, 0xfc, 0xbf, 0x33,
-	0xd8, 0x8d, 0xa0, 0xa4, 0x59, 0x4c, 0x65, 0x75, 0xd3, 0x91, 0xd2, 0x92, 0xe2, 0x54, 0x2d, 0xb6,
-	0xcc, 0x39, 0xbc, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xf9, 0xf4, 0x6a, 0xa9, 0x05, 0x00,
-	0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6a, 0xdb, 0x3c,
+	0x1c, 0x27, 0x3d, 0x7d, 0x8d, 0xd2, 0xa6, 0x89, 0xbe, 0xb4, 0xf3, 0x0a, 0xeb, 0x42, 0x76, 0x13,
+	0x46, 0x71, 0xa0, 0x83, 0xb1, 0x9b, 0x1d, 0xba, 0xb4, 0x1d, 0xdb, 0x28, 0x05, 0xa7, 0x30, 0xd8,
+	0x8d, 0x50, 0x14, 0x45, 0x11, 0xb5, 0x2d, 0x23, 0xc9, 0xad, 0xd7, 0x67, 0xd8, 0xd5, 0x1e, 0x74,
+	0xcf, 0x30, 0x74, 0xf0, 0x01, 0x7a, 0x51, 0x7a, 0x95, 0xf8, 0x77, 0xb2, 0xf4, 0xff, 0x49, 0x06,
+	0xef, 0x99, 0x08, 0xc9, 0x4a, 0x8a, 0x84, 0xe7, 0x49, 0x28, 0x24, 0x9b, 0xc4, 0x39, 0xe1, 0x93,
+	0x58, 0xb0, 0x85, 0x60, 0x13, 0x9c, 0xf1, 0x09, 0x11, 0xe9, 0x92, 0xb3, 0x89, 0xba, 0x25, 0xfe,
+	0x9f, 0xfb, 0x09, 0x33, 0x29, 0xb4, 0x80, 0xed, 0x0a, 0x3f, 0xfc, 0xf8, 0xe4, 0x24, 0x2c, 0xc9,
+	0x8a, 0xdf, 0xe2, 0xd8, 0x65, 0x1d, 0x7e, 0x78, 0x72, 0x80, 0xd2, 0x42, 0x62, 0x46, 0xbd, 0xff,
+	0xd3, 0x93, 0xfd, 0x5a, 0xe2, 0x54, 0x65, 0x42, 0x6a, 0x9f, 0x70, 0xc4, 0x84, 0x60, 0x31, 0x9d,
+	0xd8, 0xa7, 0x79, 0xbe, 0x9c, 0x2c, 0x72, 0x89, 0x35, 0x17, 0xa9, 0xe3, 0x47, 0xbf, 0xd7, 0xc0,
+	0xd6, 0xd4, 0x5a, 0xe1, 0x09, 0x68, 0x57, 0xee, 0x00, 0x0c, 0x5b, 0xe3, 0xce, 0xc9, 0x20, 0xac,
+	0x92, 0xc3, 0xeb, 0x92, 0x8b, 0x6a, 0x19, 0x3c, 0x06, 0xff, 0xf9, 0x15, 0x07, 0x1d, 0xeb, 0x80,
+	0x0d, 0xc7, 0xcc, 0x31, 0x51, 0x29, 0x81, 0xef, 0x40, 0x87, 0x08, 0x21, 0x17, 0x3c, 0xc5, 0x5a,
+	0xc8, 0x60, 0x60, 0x1d, 0x07, 0x0d, 0xc7, 0xb4, 0x66, 0xa3, 0xa6, 0xd4, 0xac, 0x8d, 0x88, 0x38,
+	0xa6, 0xc4, 0xf8, 0xf6, 0x1f, 0xac, 0x6d, 0x5a, 0x72, 0x51, 0x2d, 0x33, 0x1e, 0x57, 0x07, 0x57,
+	0x3a, 0x38, 0x78, 0xe0, 0x39, 0x2d, 0xb9, 0xa8, 0x96, 0x8d, 0xfe, 0xac, 0x83, 0x4e, 0x63, 0x11,
+	0x70, 0x0c, 0x7a, 0x78, 0x91, 0xf0, 0x14, 0xe1, 0x5c, 0xaf, 0x10, 0x93, 0x22, 0xcf, 0xec, 0x68,
+	0xda, 0x51, 0xd7, 0xe2, 0xa7, 0xb9, 0x5e, 0x7d, 0x31, 0x28, 0x3c, 0x06, 0x50, 0x51, 0x79, 0xcb,
+	0x09, 0x6d, 0x6a, 0x3b, 0x56, 0xdb, 0xf3, 0x4c, 0xad, 0x7e, 0x0d, 0xfa, 0x32, 0x23, 0x08, 0xc7,
+	0xb1, 0xb8, 0x43, 0x42, 0x72, 0xc6, 0x53, 0x15, 0x0c, 0x86, 0xeb, 0xe3, 0x76, 0xb4, 0x27, 0x33,
+	0x72, 0x6a, 0xf0, 0x2b, 0x07, 0xc3, 0x0b, 0xd0, 0xcf, 0x24, 0x5d, 0xf2, 0x02, 0xd1, 0x22, 0xe3,
+	0xae, 0x3d, 0x3f, 0x83, 0xe7, 0xa1, 0xab, 0x37, 0x2c, 0xeb, 0x0d, 0xcf, 0x7c, 0xbd, 0x51, 0xcf,
+	0x79, 0xce, 0x2b, 0x0b, 0x7c, 0x05, 0x76, 0xdd, 0x46, 0x29, 0xd2, 0x22, 0xe3, 0x24, 0x38, 0xb2,
+	0x8b, 0xdb, 0xf1, 0xe0, 0xb5, 0xc1, 0xe0, 0x77, 0x30, 0x28, 0x45, 0x8a, 0x6a, 0x1d, 0x53, 0xb4,
+	0xa0, 0x31, 0xfe, 0x15, 0xbc, 0x7c, 0xec, 0x7d, 0xd0, 0xdb, 0x66, 0xd6, 0x75, 0x66, 0x4c, 0xf0,
+	0x1c, 0xf4, 0xcb, 0x30, 0x9b, 0x82, 0x12, 0x5c, 0x04, 0xc3, 0xc7, 0x92, 0xf6, 0xbc, 0xc7, 0x66,
+	0x5c, 0xe2, 0x62, 0xf4, 0xb7, 0x05, 0xda, 0x55, 0xc3, 0xf0, 0x2d, 0x78, 0x96, 0xe0, 0x02, 0x11,
+	0x91, 0x92, 0x5c, 0x4a, 0x9a, 0x6a, 0x94, 0x50, 0xa5, 0x30, 0xa3, 0x2a, 0x68, 0x0d, 0x5b, 0xe3,
+	0xcd, 0x68, 0x3f, 0xc1, 0xc5, 0xb4, 0x62, 0x2f, 0x3d, 0x09, 0x43, 0xf0, 0xbf, 0xf1, 0x79, 0x31,
+	0xba, 0x13, 0xf2, 0x86, 0x4a, 0x15, 0xac, 0x59, 0x4f, 0x3f, 0xc1, 0x85, 0x57, 0xfe, 0x70, 0x84,
+	0xa9, 0x5e, 0x69, 0xac, 0x29, 0x22, 0x98, 0xac, 0x28, 0x52, 0xfc, 0x9e, 0x06, 0xeb, 0x56, 0xdc,
+	0xb5, 0xf8, 0xd4, 0xc0, 0x33, 0x7e, 0x4f, 0xe1, 0x15, 0x38, 0x68, 0x2a, 0x1b, 0x2d, 0x6d, 0x3c,
+	0xb6, 0xd7, 0x41, 0x1d, 0x55, 0x37, 0x65, 0x2e, 0x65, 0xbb, 0x3a, 0x9e, 0x70, 0x04, 0x76, 0x54,
+	0x3e, 0x57, 0x44, 0xf2, 0xcc, 0x86, 0xb6, 0x5c, 0x6d, 0x4d, 0x0c, 0x0e, 0xc0, 0xa6, 0xc6, 0xea,
+	0xa6, 0xdc, 0x8e, 0x7b, 0x30, 0xa7, 0x8c, 0x29, 0xa4, 0x34, 0x66, 0x3c, 0x65, 0x68, 0x9e, 0x93,
+	0x1b, 0xaa, 0xed, 0x1e, 0xda, 0xd1, 0x1e, 0x53, 0x33, 0x87, 0x7f, 0xb6, 0x30, 0xbc, 0xaa, 0x8b,
+	0xe7, 0xe9, 0x82, 0xda, 0x01, 0x2f, 0x39, 0xf3, 0x1f, 0x82, 0x17, 0x0f, 0x2e, 0x0e, 0xfd, 0x6a,
+	0x54, 0xee, 0xd3, 0x51, 0x95, 0xdf, 0xc0, 0xcc, 0xbc, 0xcb, 0xc0, 0xfb, 0x98, 0xcf, 0xd1, 0x52,
+	0xe2, 0x84, 0xaa, 0xa0, 0x3b, 0x6c, 0x8d, 0xb7, 0xa3, 0xf2, 0x5c, 0xfc, 0x8c, 0xf9, 0xfc, 0xc2,
+	0x12, 0xdf, 0x36, 0xb6, 0x77, 0x7b, 0xdd, 0x08, 0x4a, 0x9a, 0x2e, 0xa8, 0x34, 0x37, 0x03, 0x29,
+	0x2d, 0x29, 0x4e, 0xd4, 0x7c, 0xcb, 0xce, 0xed, 0xcd, 0xbf, 0x01, 0x00, 0xe6, 0x07, 0xe2, 0xd8,
+	0xd9, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,116 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakelogs
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+
+	logs_api "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+)
+
+// Follow implements logs.Follow.
+//
+// The fake backend's clock does not advance on its own, so following a
+// stream that hasn't been closed blocks until ctx is cancelled.
+func (c *Client) Follow(ctx context.Context, in *logs_api.FollowRequest, _ ...grpc.CallOption) (logs_api.Logs_FollowClient, error) {
+	realIn := *in
+	realIn.Project = coordinatorTest.AllAccessProject
+
+	// Run the server against the fake backend's context, but stop it when the
+	// caller's context is done.
+	sctx, cancel := context.WithCancel(c.ctx)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-sctx.Done():
+		}
+	}()
+
+	ch := make(chan followResult)
+	go func() {
+		defer cancel()
+
+		err := c.logsServ.Follow(&realIn, &followServer{ctx: sctx, ch: ch})
+		if err == nil {
+			err = io.EOF
+		}
+		select {
+		case ch <- followResult{err: err}:
+		case <-sctx.Done():
+		}
+	}()
+
+	return &followClient{ctx: ctx, ch: ch}, nil
+}
+
+// followResult is a single response, or the error that ended the stream.
+type followResult struct {
+	resp *logs_api.FollowResponse
+	err  error
+}
+
+// followServer is the logs.Logs_FollowServer that Client.Follow passes to the
+// fake backend.
+type followServer struct {
+	grpc.ServerStream // Only Context and Send are implemented.
+
+	ctx context.Context
+	ch  chan<- followResult
+}
+
+func (s *followServer) Context() context.Context { return s.ctx }
+
+func (s *followServer) Send(resp *logs_api.FollowResponse) error {
+	select {
+	case s.ch <- followResult{resp: resp}:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// followClient is the logs.Logs_FollowClient returned by Client.Follow.
+type followClient struct {
+	grpc.ClientStream // Only Context and Recv are implemented.
+
+	ctx context.Context
+	ch  <-chan followResult
+	err error
+}
+
+func (s *followClient) Context() context.Context { return s.ctx }
+
+func (s *followClient) Recv() (*logs_api.FollowResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	select {
+	case r := <-s.ch:
+		if r.err != nil {
+			s.err = r.err
+			return nil, r.err
+		}
+		return r.resp, nil
+	case <-s.ctx.Done():
+		s.err = s.ctx.Err()
+		return nil, s.err
+	}
+}
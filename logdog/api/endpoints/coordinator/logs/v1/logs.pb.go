@@ -109,11 +109,23 @@ type GetRequest struct {
 	//
 	// A contiguous request with Index 3 will return: [3, 4], stopping because
 	// 5 is missing. A non-contiguous request will return [3, 4, 6, 7].
-	NonContiguous        bool                       `protobuf:"varint,7,opt,name=non_contiguous,json=nonContiguous,proto3" json:"non_contiguous,omitempty"`
-	GetSignedUrls        *GetRequest_SignURLRequest `protobuf:"bytes,8,opt,name=get_signed_urls,json=getSignedUrls,proto3" json:"get_signed_urls,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	NonContiguous bool                       `protobuf:"varint,7,opt,name=non_contiguous,json=nonContiguous,proto3" json:"non_contiguous,omitempty"`
+	GetSignedUrls *GetRequest_SignURLRequest `protobuf:"bytes,8,opt,name=get_signed_urls,json=getSignedUrls,proto3" json:"get_signed_urls,omitempty"`
+	// If not empty, only the records that match this filter expression are
+	// returned. The log stream must be a structured-record stream, i.e. a TEXT
+	// stream with the "application/x-ndjson" content type.
+	//
+	// The expression is a whitespace-separated list of conditions on record
+	// fields, all of which must hold, e.g. `severity>=WARNING host="foo"`. See
+	// the logdog/common/jsonlines package for its syntax.
+	//
+	// Records that don't match are removed from their log entries, but the log
+	// entries themselves are still returned, so that the returned indices remain
+	// contiguous. The byte_count constraint applies to the unfiltered entries.
+	Filter               string   `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetRequest) Reset()         { *m = GetRequest{} }
@@ -197,6 +209,13 @@ func (m *GetRequest) GetGetSignedUrls() *GetRequest_SignURLRequest {
 	return nil
 }
 
+func (m *GetRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 // If supplied, the response will contain a SignedUrls message with the
 // requested signed URLs. If signed URLs are not supported by the log's
 // current storage system, the response message will be empty.
@@ -204,6 +223,8 @@ type GetRequest_SignURLRequest struct {
 	// The lifetime that the signed URL will be bound to.. The
 	Lifetime *duration.Duration `protobuf:"bytes,1,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
 	// Return a signed URL for the log's RecordIO protobuf data.
+	//
+	// The data may be compressed, see SignedUrls.stream_format.
 	Stream bool `protobuf:"varint,2,opt,name=stream,proto3" json:"stream,omitempty"`
 	// Return a signed URL for the log's LogIndex protobuf.
 	Index                bool     `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
@@ -418,10 +439,15 @@ type GetResponse_SignedUrls struct {
 	// The signed log stream URL, if requested.
 	Stream string `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
 	// The signed log index URL, if requested.
-	Index                string   `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// The format of the data at the signed log stream URL, if requested.
+	//
+	// Clients that read the data must support this format. A ZLIB_FRAMES
+	// stream must be decompressed before it can be read as RecordIO.
+	StreamFormat         logpb.LogIndex_Format `protobuf:"varint,4,opt,name=stream_format,json=streamFormat,proto3,enum=logpb.LogIndex_Format" json:"stream_format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *GetResponse_SignedUrls) Reset()         { *m = GetResponse_SignedUrls{} }
@@ -470,6 +496,13 @@ func (m *GetResponse_SignedUrls) GetIndex() string {
 	return ""
 }
 
+func (m *GetResponse_SignedUrls) GetStreamFormat() logpb.LogIndex_Format {
+	if m != nil {
+		return m.StreamFormat
+	}
+	return logpb.LogIndex_RECORDIO
+}
+
 // QueryRequest is the request structure for the user Query endpoint.
 type QueryRequest struct {
 	// The request project to request.
@@ -834,6 +867,394 @@ func (m *QueryResponse_Stream) GetDescProto() []byte {
 	return nil
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+//
+// Search looks for lines matching a pattern in the TEXT log streams selected
+// by the request's path. Archived streams whose search index proves they
+// can't match are skipped. Streams that are not archived yet are read from
+// intermediate storage.
+type SearchRequest struct {
+	// The request project to request.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The path query selecting the log streams to search.
+	//
+	// This has the same syntax as QueryRequest's path. For example,
+	// "foo/bar/**" searches all streams with the "foo/bar" prefix.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// The pattern to search for.
+	//
+	// By default, this is a literal substring. If "regexp" is true, it is an RE2
+	// regular expression.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// If true, the pattern is an RE2 regular expression.
+	Regexp bool `protobuf:"varint,4,opt,name=regexp,proto3" json:"regexp,omitempty"`
+	// If true, the pattern is matched case-insensitively.
+	IgnoreCase bool `protobuf:"varint,5,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// MaxResults is the maximum number of matches to return.
+	//
+	// If MaxResults is zero, no upper bound will be indicated. However, the
+	// returned result count is still subject to internal constraints.
+	MaxResults int32 `protobuf:"varint,6,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// Next, if not empty, indicates that this search should continue at the
+	// point where the previous search left off.
+	Next                 string   `protobuf:"bytes,7,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc34668f0f01b99d, []int{5}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetRegexp() bool {
+	if m != nil {
+		return m.Regexp
+	}
+	return false
+}
+
+func (m *SearchRequest) GetIgnoreCase() bool {
+	if m != nil {
+		return m.IgnoreCase
+	}
+	return false
+}
+
+func (m *SearchRequest) GetMaxResults() int32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	// Project is the project name that all responses belong to.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The list of matching lines, grouped by log stream and ordered by index
+	// within each log stream.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	// If not empty, indicates that there are more search results available.
+	// These results can be requested by repeating the Search request with the
+	// same fields and supplying this value in the Next field.
+	//
+	// A response may have fewer than "max_results" matches (or none at all)
+	// and still have a Next value, since a single request only scans a limited
+	// number of log streams.
+	Next                 string   `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc34668f0f01b99d, []int{6}
+}
+
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse.Size(m)
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetMatches() []*SearchResponse_Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// Match is a single line that matched the search pattern.
+type SearchResponse_Match struct {
+	// Path is the log stream path.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The stream index of the log entry containing the line.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// The index of the line within the log entry, starting at zero.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// The text of the line, without its delimiter.
+	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResponse_Match) Reset()         { *m = SearchResponse_Match{} }
+func (m *SearchResponse_Match) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse_Match) ProtoMessage()    {}
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc34668f0f01b99d, []int{6, 0}
+}
+
+func (m *SearchResponse_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse_Match.Unmarshal(m, b)
+}
+func (m *SearchResponse_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse_Match.Marshal(b, m, deterministic)
+}
+func (m *SearchResponse_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse_Match.Merge(m, src)
+}
+func (m *SearchResponse_Match) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse_Match.Size(m)
+}
+func (m *SearchResponse_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse_Match proto.InternalMessageInfo
+
+func (m *SearchResponse_Match) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchResponse_Match) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SearchResponse_Match) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *SearchResponse_Match) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+// FollowRequest is the request structure for the user Follow endpoint.
+type FollowRequest struct {
+	// The request project to request.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The path of the log stream to follow.
+	//
+	// This can either be a LogDog stream path or the SHA256 hash of a LogDog
+	// stream path.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// If true, requests that the log stream's state is returned in the first
+	// response.
+	State bool `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	// The initial log stream index to retrieve.
+	Index                int64    `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FollowRequest) Reset()         { *m = FollowRequest{} }
+func (m *FollowRequest) String() string { return proto.CompactTextString(m) }
+func (*FollowRequest) ProtoMessage()    {}
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc34668f0f01b99d, []int{7}
+}
+
+func (m *FollowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowRequest.Unmarshal(m, b)
+}
+func (m *FollowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowRequest.Marshal(b, m, deterministic)
+}
+func (m *FollowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowRequest.Merge(m, src)
+}
+func (m *FollowRequest) XXX_Size() int {
+	return xxx_messageInfo_FollowRequest.Size(m)
+}
+func (m *FollowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FollowRequest proto.InternalMessageInfo
+
+func (m *FollowRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *FollowRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FollowRequest) GetState() bool {
+	if m != nil {
+		return m.State
+	}
+	return false
+}
+
+func (m *FollowRequest) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// FollowResponse is a single response in the user Follow endpoint's stream.
+type FollowResponse struct {
+	// Project is the project name that these logs belong to.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The log stream descriptor and state for this stream.
+	//
+	// These are only populated in the first response, and only if the
+	// request's State field is true.
+	State *LogStreamState            `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Desc  *logpb.LogStreamDescriptor `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	// The next contiguous batch of log records, in stream index order.
+	//
+	// The first response may have no logs, if none were available yet.
+	Logs                 []*logpb.LogEntry `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FollowResponse) Reset()         { *m = FollowResponse{} }
+func (m *FollowResponse) String() string { return proto.CompactTextString(m) }
+func (*FollowResponse) ProtoMessage()    {}
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc34668f0f01b99d, []int{8}
+}
+
+func (m *FollowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FollowResponse.Unmarshal(m, b)
+}
+func (m *FollowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FollowResponse.Marshal(b, m, deterministic)
+}
+func (m *FollowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowResponse.Merge(m, src)
+}
+func (m *FollowResponse) XXX_Size() int {
+	return xxx_messageInfo_FollowResponse.Size(m)
+}
+func (m *FollowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FollowResponse proto.InternalMessageInfo
+
+func (m *FollowResponse) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *FollowResponse) GetState() *LogStreamState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *FollowResponse) GetDesc() *logpb.LogStreamDescriptor {
+	if m != nil {
+		return m.Desc
+	}
+	return nil
+}
+
+func (m *FollowResponse) GetLogs() []*logpb.LogEntry {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("logdog.QueryRequest_Trinary", QueryRequest_Trinary_name, QueryRequest_Trinary_value)
 	proto.RegisterType((*GetRequest)(nil), "logdog.GetRequest")
@@ -846,6 +1267,11 @@ func init() {
 	proto.RegisterType((*QueryRequest_StreamTypeFilter)(nil), "logdog.QueryRequest.StreamTypeFilter")
 	proto.RegisterType((*QueryResponse)(nil), "logdog.QueryResponse")
 	proto.RegisterType((*QueryResponse_Stream)(nil), "logdog.QueryResponse.Stream")
+	proto.RegisterType((*SearchRequest)(nil), "logdog.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "logdog.SearchResponse")
+	proto.RegisterType((*SearchResponse_Match)(nil), "logdog.SearchResponse.Match")
+	proto.RegisterType((*FollowRequest)(nil), "logdog.FollowRequest")
+	proto.RegisterType((*FollowResponse)(nil), "logdog.FollowResponse")
 }
 
 func init() {
@@ -853,64 +1279,78 @@ func init() {
 }
 
 var fileDescriptor_fc34668f0f01b99d = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0xae, 0xdb, 0x44,
-	0x10, 0xc6, 0x89, 0xf3, 0x37, 0x4e, 0x72, 0xc2, 0x52, 0x2a, 0x63, 0xe8, 0x69, 0x9a, 0x52, 0x91,
-	0x0b, 0x64, 0x97, 0x50, 0x7e, 0x54, 0x2e, 0x90, 0xe8, 0xbf, 0x74, 0x44, 0xe9, 0x26, 0x45, 0xe2,
-	0x2a, 0xf2, 0x89, 0xb7, 0x5b, 0x83, 0xb3, 0x6b, 0x76, 0xd7, 0x87, 0xe4, 0x19, 0xb8, 0xe2, 0x0a,
-	0x71, 0xc3, 0xcb, 0xf1, 0x22, 0x68, 0x77, 0xed, 0x38, 0x3d, 0x8d, 0xc8, 0x05, 0xed, 0x4d, 0xec,
-	0x99, 0xf9, 0x66, 0xbd, 0xf3, 0x7d, 0x33, 0x13, 0x78, 0x4c, 0x79, 0xb8, 0x7a, 0x29, 0xf8, 0x3a,
-	0x2d, 0xd6, 0x21, 0x17, 0x34, 0xca, 0x8a, 0x55, 0x1a, 0x65, 0x9c, 0x26, 0x9c, 0x46, 0x71, 0x9e,
-	0x46, 0x84, 0x25, 0x39, 0x4f, 0x99, 0x92, 0xd1, 0x8a, 0x73, 0x91, 0xa4, 0x2c, 0x56, 0x5c, 0x68,
-	0x80, 0x8c, 0x2e, 0x3e, 0x33, 0xcf, 0x30, 0x17, 0x5c, 0x71, 0xd4, 0xb6, 0x49, 0xc1, 0x93, 0xff,
-	0x77, 0xa2, 0x54, 0xb1, 0x22, 0xf6, 0xc8, 0x20, 0x3a, 0x76, 0x54, 0xc6, 0x69, 0x7e, 0xae, 0x7f,
-	0xcb, 0x84, 0xeb, 0x94, 0x73, 0x9a, 0x91, 0xc8, 0x58, 0xe7, 0xc5, 0x8b, 0x48, 0xa5, 0x6b, 0x22,
-	0x55, 0xbc, 0xce, 0x4b, 0xc0, 0xe9, 0x65, 0x40, 0x52, 0x88, 0x58, 0xa5, 0x9c, 0xd9, 0xf8, 0xe4,
-	0xf7, 0x26, 0xc0, 0x23, 0xa2, 0x30, 0xf9, 0xb5, 0x20, 0x52, 0x21, 0x1f, 0x3a, 0xb9, 0xe0, 0x3f,
-	0x93, 0x95, 0xf2, 0x9d, 0xb1, 0x33, 0xed, 0xe1, 0xca, 0x44, 0x08, 0xdc, 0x3c, 0x56, 0x2f, 0xfd,
-	0x86, 0x71, 0x9b, 0x77, 0x74, 0x05, 0x5a, 0xe6, 0xf6, 0x7e, 0x73, 0xec, 0x4c, 0xbb, 0xd8, 0x1a,
-	0xda, 0x9b, 0xb2, 0x84, 0x6c, 0x7c, 0x77, 0xec, 0x4c, 0x9b, 0xd8, 0x1a, 0xe8, 0x1a, 0xc0, 0xf9,
-	0x56, 0x91, 0xe5, 0x8a, 0x17, 0x4c, 0xf9, 0xad, 0xb1, 0x33, 0x6d, 0xe1, 0x9e, 0xf6, 0xdc, 0xd3,
-	0x0e, 0xf4, 0x21, 0xf4, 0x32, 0x4e, 0xcb, 0x68, 0xdb, 0x44, 0xbb, 0x19, 0xa7, 0x36, 0x78, 0x0b,
-	0x86, 0x8c, 0xb3, 0xe5, 0x8a, 0x33, 0x95, 0xd2, 0x82, 0x17, 0xd2, 0xef, 0x98, 0x0f, 0x0e, 0x18,
-	0x67, 0xf7, 0x76, 0x4e, 0xf4, 0x04, 0x4e, 0x28, 0x51, 0x4b, 0x99, 0x52, 0x46, 0x92, 0x65, 0x21,
-	0x32, 0xe9, 0x77, 0xc7, 0xce, 0xd4, 0x9b, 0xdd, 0x08, 0x2d, 0x85, 0x61, 0x5d, 0x69, 0x38, 0x4f,
-	0x29, 0x7b, 0x8e, 0xcf, 0x4a, 0x13, 0x0f, 0x28, 0x51, 0x73, 0x93, 0xf8, 0x5c, 0x64, 0x32, 0x28,
-	0x60, 0xf8, 0x2a, 0x00, 0x7d, 0x01, 0xdd, 0x2c, 0x7d, 0x41, 0x34, 0xbf, 0x86, 0x1a, 0x6f, 0xf6,
-	0x41, 0x68, 0xb9, 0x0d, 0x2b, 0x6e, 0xc3, 0xfb, 0x25, 0xb7, 0x78, 0x07, 0x45, 0x57, 0xa1, 0x2d,
-	0x95, 0x20, 0xf1, 0xda, 0x10, 0xd7, 0xc5, 0xa5, 0x55, 0x93, 0x54, 0x52, 0x67, 0x8c, 0xc9, 0x33,
-	0xf0, 0x16, 0x71, 0x9a, 0xbd, 0x41, 0x35, 0x26, 0xff, 0x34, 0xc0, 0x33, 0x65, 0xcb, 0x9c, 0x33,
-	0x49, 0xfe, 0xe3, 0xcc, 0x4f, 0xab, 0xfc, 0x86, 0x29, 0xef, 0x6a, 0x45, 0xda, 0x19, 0xa7, 0x73,
-	0x73, 0xe9, 0xb9, 0x8e, 0x56, 0x2a, 0x87, 0xe0, 0x26, 0x44, 0xae, 0xcc, 0xc7, 0xbc, 0x59, 0x10,
-	0x9a, 0xce, 0xac, 0xb1, 0xf7, 0x89, 0x5c, 0x89, 0x34, 0x57, 0x5c, 0x60, 0x83, 0x43, 0x37, 0xc1,
-	0xd5, 0x1d, 0xef, 0xbb, 0xe3, 0xe6, 0xd4, 0x9b, 0x9d, 0xd4, 0xf8, 0x07, 0x4c, 0x89, 0x2d, 0x36,
-	0x41, 0xf4, 0x2d, 0x78, 0xfb, 0xea, 0xb5, 0xcc, 0xd9, 0xa7, 0xaf, 0xa8, 0x67, 0xcb, 0x08, 0x6b,
-	0xad, 0x30, 0xc8, 0x5a, 0xb7, 0x0b, 0x80, 0x3a, 0x82, 0xee, 0x02, 0x90, 0x4d, 0x9e, 0x5a, 0x51,
-	0x4a, 0xd5, 0x82, 0xd7, 0x54, 0x5b, 0x54, 0x23, 0x83, 0xf7, 0xd0, 0x97, 0x84, 0xeb, 0x1d, 0x16,
-	0xae, 0x57, 0x09, 0xf7, 0x67, 0x0b, 0xfa, 0xcf, 0x0a, 0x22, 0xb6, 0x6f, 0x78, 0x90, 0xcc, 0x25,
-	0xcd, 0x20, 0x75, 0xb1, 0x35, 0x74, 0x3e, 0x23, 0x1b, 0x3b, 0x42, 0x3d, 0x6c, 0xde, 0xd1, 0x75,
-	0xf0, 0xd6, 0xf1, 0x66, 0x29, 0x88, 0x2c, 0x32, 0x25, 0xcb, 0xf9, 0x81, 0x75, 0xbc, 0xc1, 0xd6,
-	0x83, 0x6e, 0x40, 0x5f, 0x4f, 0x0f, 0x61, 0x6a, 0xa9, 0xb6, 0x39, 0xf1, 0xc1, 0x24, 0x7b, 0xa5,
-	0x6f, 0xb1, 0xcd, 0x09, 0x7a, 0x08, 0x9e, 0x2d, 0xd1, 0x22, 0x3c, 0xc3, 0xd6, 0xad, 0x8a, 0xfb,
-	0xfd, 0xe2, 0x42, 0x2b, 0xb1, 0xce, 0x7a, 0x98, 0x66, 0x8a, 0x08, 0x0c, 0x72, 0xe7, 0x41, 0xb7,
-	0xa1, 0xc5, 0xc8, 0x6f, 0x44, 0xf8, 0xfd, 0xa3, 0x7c, 0x5b, 0xa0, 0xce, 0xe0, 0x59, 0x42, 0x84,
-	0x3f, 0x38, 0x9e, 0x61, 0x80, 0xe8, 0x26, 0x0c, 0x4c, 0x70, 0x79, 0x41, 0x84, 0xd4, 0xda, 0x0e,
-	0x4d, 0x3d, 0x7d, 0xe3, 0xfc, 0xd1, 0xfa, 0xd0, 0x0c, 0x5c, 0x15, 0x53, 0xe9, 0x9f, 0x98, 0x8e,
-	0x3b, 0x3d, 0x58, 0xc9, 0x22, 0xa6, 0xb2, 0x6c, 0x40, 0x8d, 0x45, 0x77, 0xa0, 0x9d, 0x17, 0x82,
-	0x92, 0xc4, 0x1f, 0x8d, 0x9d, 0xe9, 0x70, 0xf6, 0xd1, 0xe1, 0x2c, 0x91, 0xb2, 0x58, 0x6c, 0x71,
-	0x89, 0x0d, 0xbe, 0x81, 0xd1, 0x65, 0x4a, 0xd0, 0x27, 0xd0, 0xba, 0x88, 0xb3, 0xc2, 0x2e, 0x8b,
-	0xe1, 0xec, 0xdd, 0xb2, 0xe1, 0x6b, 0x1c, 0xb6, 0xf1, 0xe0, 0x2b, 0xe8, 0xed, 0x6e, 0x81, 0x46,
-	0xd0, 0xfc, 0x85, 0x6c, 0xcb, 0x96, 0xd1, 0xaf, 0xba, 0x09, 0xec, 0x39, 0xb6, 0x5f, 0xac, 0x71,
-	0xb7, 0xf1, 0xb5, 0x33, 0xf9, 0x18, 0x3a, 0xe5, 0x45, 0x50, 0x17, 0xdc, 0xef, 0x9e, 0x2e, 0x1e,
-	0x8f, 0xde, 0x41, 0x1d, 0x68, 0xfe, 0xf4, 0x60, 0x3e, 0x72, 0x50, 0x1b, 0x1a, 0xdf, 0x3f, 0x1d,
-	0x35, 0x26, 0x7f, 0x34, 0x60, 0x50, 0x5e, 0xfe, 0xe8, 0x06, 0xf8, 0x12, 0x3a, 0x56, 0x48, 0xe9,
-	0x37, 0x0c, 0x69, 0x97, 0xcb, 0xaf, 0x86, 0xcf, 0x80, 0x70, 0x05, 0xde, 0xb5, 0x64, 0xb3, 0x6e,
-	0xc9, 0xe0, 0x2f, 0x07, 0xda, 0x16, 0xb7, 0xeb, 0x78, 0x67, 0xaf, 0xe3, 0xdf, 0xee, 0xb2, 0xb9,
-	0x06, 0xa0, 0x9f, 0xcb, 0x7a, 0x7c, 0xfa, 0xb8, 0xa7, 0x3d, 0x3f, 0x68, 0xc7, 0xec, 0x6f, 0x07,
-	0xdc, 0x33, 0xbd, 0x6f, 0x42, 0x68, 0x3e, 0x22, 0x0a, 0xa1, 0xd7, 0xff, 0x1f, 0x82, 0xf7, 0x0e,
-	0x6c, 0x1d, 0x74, 0x1b, 0x5c, 0xbd, 0x9f, 0xd1, 0x2e, 0xb8, 0xb7, 0xad, 0x0f, 0x67, 0xdc, 0x81,
-	0x96, 0xe1, 0x0e, 0x5d, 0x39, 0xd4, 0x49, 0xc1, 0xfb, 0x07, 0x09, 0x3e, 0x6f, 0x9b, 0x8b, 0x7f,
-	0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xa0, 0x4f, 0x31, 0xad, 0x08, 0x00, 0x00,
+	// 1125 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x92, 0xdb, 0x44,
+	0x10, 0x46, 0xb6, 0xfc, 0xd7, 0xb2, 0x1d, 0x33, 0x24, 0x5b, 0x42, 0x90, 0xc4, 0x71, 0x48, 0xe1,
+	0x03, 0x25, 0x2f, 0x26, 0x10, 0x48, 0x0e, 0x54, 0xb1, 0xc9, 0x26, 0x5b, 0xb5, 0x10, 0x32, 0xde,
+	0x50, 0x95, 0x93, 0x4b, 0x2b, 0xcf, 0x6a, 0x05, 0xf2, 0x8c, 0x98, 0x19, 0x6d, 0xec, 0xc7, 0x80,
+	0x0b, 0xc5, 0x91, 0xe2, 0xcc, 0x89, 0x67, 0xe0, 0x01, 0x78, 0x23, 0x6a, 0x66, 0x24, 0xcb, 0xeb,
+	0x75, 0xb1, 0x14, 0x24, 0x07, 0x2e, 0xf6, 0x74, 0xf7, 0xd7, 0xa3, 0xe9, 0xfe, 0xbe, 0x1e, 0x09,
+	0x9e, 0x44, 0xcc, 0x0f, 0x4f, 0x39, 0x9b, 0xc7, 0xd9, 0xdc, 0x67, 0x3c, 0x1a, 0x25, 0x59, 0x18,
+	0x8f, 0x12, 0x16, 0xcd, 0x58, 0x34, 0x0a, 0xd2, 0x78, 0x44, 0xe8, 0x2c, 0x65, 0x31, 0x95, 0x62,
+	0x14, 0x32, 0xc6, 0x67, 0x31, 0x0d, 0x24, 0xe3, 0x0a, 0x20, 0x46, 0x67, 0x1f, 0xea, 0x7f, 0x3f,
+	0xe5, 0x4c, 0x32, 0x54, 0x37, 0x49, 0xde, 0xc1, 0x7f, 0xdb, 0x51, 0xc8, 0x40, 0x12, 0xb3, 0xa5,
+	0x37, 0xba, 0x6c, 0xab, 0x84, 0x45, 0xe9, 0xb1, 0xfa, 0xcd, 0x13, 0x6e, 0x46, 0x8c, 0x45, 0x09,
+	0x19, 0x69, 0xeb, 0x38, 0x3b, 0x19, 0xc9, 0x78, 0x4e, 0x84, 0x0c, 0xe6, 0x69, 0x0e, 0xb8, 0xb1,
+	0x09, 0x98, 0x65, 0x3c, 0x90, 0x31, 0xa3, 0x26, 0x3e, 0xf8, 0xb5, 0x0a, 0xf0, 0x98, 0x48, 0x4c,
+	0xbe, 0xcf, 0x88, 0x90, 0xc8, 0x85, 0x46, 0xca, 0xd9, 0xb7, 0x24, 0x94, 0xae, 0xd5, 0xb7, 0x86,
+	0x2d, 0x5c, 0x98, 0x08, 0x81, 0x9d, 0x06, 0xf2, 0xd4, 0xad, 0x68, 0xb7, 0x5e, 0xa3, 0xab, 0x50,
+	0xd3, 0xa7, 0x77, 0xab, 0x7d, 0x6b, 0xd8, 0xc4, 0xc6, 0x50, 0xde, 0x98, 0xce, 0xc8, 0xc2, 0xb5,
+	0xfb, 0xd6, 0xb0, 0x8a, 0x8d, 0x81, 0xae, 0x03, 0x1c, 0x2f, 0x25, 0x99, 0x86, 0x2c, 0xa3, 0xd2,
+	0xad, 0xf5, 0xad, 0x61, 0x0d, 0xb7, 0x94, 0x67, 0x4f, 0x39, 0xd0, 0x3b, 0xd0, 0x4a, 0x58, 0x94,
+	0x47, 0xeb, 0x3a, 0xda, 0x4c, 0x58, 0x64, 0x82, 0x77, 0xa0, 0x4b, 0x19, 0x9d, 0x86, 0x8c, 0xca,
+	0x38, 0xca, 0x58, 0x26, 0xdc, 0x86, 0x7e, 0x60, 0x87, 0x32, 0xba, 0xb7, 0x72, 0xa2, 0x03, 0xb8,
+	0x12, 0x11, 0x39, 0x15, 0x71, 0x44, 0xc9, 0x6c, 0x9a, 0xf1, 0x44, 0xb8, 0xcd, 0xbe, 0x35, 0x74,
+	0xc6, 0xb7, 0x7c, 0xd3, 0x42, 0xbf, 0xac, 0xd4, 0x9f, 0xc4, 0x11, 0x7d, 0x8e, 0x0f, 0x73, 0x13,
+	0x77, 0x22, 0x22, 0x27, 0x3a, 0xf1, 0x39, 0x4f, 0x04, 0xda, 0x81, 0xfa, 0x49, 0x9c, 0x48, 0xc2,
+	0xdd, 0x96, 0xae, 0x37, 0xb7, 0xbc, 0x0c, 0xba, 0xe7, 0x13, 0xd1, 0xc7, 0xd0, 0x4c, 0xe2, 0x13,
+	0xa2, 0xfa, 0xae, 0x5b, 0xe6, 0x8c, 0xdf, 0xf6, 0x4d, 0xcf, 0xfd, 0xa2, 0xe7, 0xfe, 0xc3, 0xbc,
+	0xe7, 0x78, 0x05, 0x55, 0x0f, 0x10, 0x92, 0x93, 0x60, 0xae, 0x1b, 0xda, 0xc4, 0xb9, 0x55, 0x36,
+	0x2f, 0x6f, 0xa9, 0x36, 0x06, 0xcf, 0xc0, 0x39, 0x0a, 0xe2, 0xe4, 0x15, 0xb2, 0x34, 0xf8, 0xa5,
+	0x0a, 0x8e, 0x6e, 0x87, 0x48, 0x19, 0x15, 0xe4, 0x6f, 0xf6, 0xfc, 0xa0, 0xc8, 0xaf, 0xe8, 0xf2,
+	0x76, 0x8a, 0x66, 0x1e, 0xb2, 0x68, 0xa2, 0x0f, 0x3d, 0x51, 0xd1, 0x82, 0x7d, 0x1f, 0xec, 0x19,
+	0x11, 0xa1, 0x7e, 0x98, 0x33, 0xf6, 0x7c, 0xad, 0xd8, 0x12, 0xfb, 0x90, 0x88, 0x90, 0xc7, 0xa9,
+	0x64, 0x1c, 0x6b, 0x1c, 0xba, 0x0d, 0xb6, 0x9a, 0x04, 0xd7, 0xee, 0x57, 0x87, 0xce, 0xf8, 0x4a,
+	0x89, 0x7f, 0x44, 0x25, 0x5f, 0x62, 0x1d, 0x44, 0x9f, 0x83, 0xb3, 0xce, 0x6a, 0x4d, 0xef, 0x7d,
+	0xe3, 0x1c, 0xab, 0xa6, 0x0c, 0xbf, 0xe4, 0x10, 0x83, 0x58, 0xad, 0xbd, 0xdf, 0x2d, 0x80, 0x32,
+	0x84, 0xee, 0x03, 0x90, 0x45, 0x1a, 0x1b, 0x56, 0x72, 0xda, 0xbc, 0x0b, 0xb4, 0x1d, 0x15, 0xb3,
+	0x84, 0xd7, 0xd0, 0x1b, 0xcc, 0xb5, 0xb6, 0x33, 0xd7, 0x2a, 0x64, 0xff, 0x00, 0x3a, 0x26, 0x3e,
+	0x3d, 0x61, 0x7c, 0x1e, 0x48, 0x3d, 0x14, 0xdd, 0xf1, 0x4e, 0x59, 0xe7, 0x81, 0xc2, 0xf9, 0xfb,
+	0x3a, 0x8a, 0xdb, 0x06, 0x6c, 0xac, 0xc1, 0x4f, 0x35, 0x68, 0x3f, 0xcb, 0x08, 0x5f, 0xbe, 0xe2,
+	0xf1, 0xd4, 0x15, 0xea, 0x93, 0x34, 0xb1, 0x31, 0x54, 0x3e, 0x25, 0x0b, 0x33, 0x98, 0x2d, 0xac,
+	0xd7, 0xe8, 0x26, 0x38, 0xf3, 0x60, 0x31, 0xe5, 0x44, 0x64, 0x89, 0x14, 0xf9, 0x54, 0xc2, 0x3c,
+	0x58, 0x60, 0xe3, 0x41, 0xb7, 0xa0, 0xad, 0x66, 0x92, 0x50, 0x39, 0x95, 0xcb, 0x94, 0xb8, 0xa0,
+	0x93, 0x9d, 0xdc, 0x77, 0xb4, 0x4c, 0x09, 0xda, 0x07, 0x27, 0xaf, 0x5f, 0x23, 0x1c, 0xdd, 0xea,
+	0x3b, 0x05, 0x73, 0xeb, 0xc5, 0xf9, 0x46, 0x20, 0x2a, 0x6b, 0x5f, 0x0f, 0x1b, 0x06, 0xb1, 0xf2,
+	0xa0, 0x5d, 0xa8, 0x51, 0xf2, 0x92, 0x70, 0xb7, 0x7d, 0x29, 0x59, 0x06, 0xa8, 0x32, 0x58, 0x32,
+	0x23, 0xdc, 0xed, 0x5c, 0x9e, 0xa1, 0x81, 0xe8, 0x36, 0x74, 0x74, 0x70, 0x7a, 0x46, 0xb8, 0x50,
+	0xc2, 0xe8, 0xea, 0x7a, 0xda, 0xda, 0xf9, 0x8d, 0xf1, 0xa1, 0x31, 0xd8, 0x32, 0x88, 0x84, 0x7b,
+	0xa5, 0x5f, 0x5d, 0xd7, 0xe0, 0xb9, 0x4a, 0x8e, 0x82, 0x48, 0xe4, 0xf2, 0x55, 0x58, 0x74, 0x17,
+	0xea, 0x69, 0xc6, 0x23, 0x32, 0x73, 0x7b, 0x9a, 0xfd, 0x77, 0xb7, 0x67, 0xf1, 0x98, 0x06, 0x7c,
+	0x89, 0x73, 0xac, 0xf7, 0x00, 0x7a, 0x9b, 0x2d, 0x41, 0xef, 0x43, 0xed, 0x2c, 0x48, 0x32, 0x73,
+	0xd5, 0x74, 0xc7, 0x6f, 0xe6, 0x32, 0x2a, 0x71, 0xd8, 0xc4, 0xbd, 0x7b, 0xd0, 0x5a, 0x9d, 0x02,
+	0xf5, 0xa0, 0xfa, 0x1d, 0x59, 0xe6, 0x92, 0x51, 0x4b, 0x25, 0x02, 0xb3, 0x8f, 0xd1, 0x8b, 0x31,
+	0xee, 0x57, 0x3e, 0xb5, 0x06, 0xef, 0x41, 0x23, 0x3f, 0x08, 0x6a, 0x82, 0xfd, 0xc5, 0xd3, 0xa3,
+	0x27, 0xbd, 0x37, 0x50, 0x03, 0xaa, 0x2f, 0x1e, 0x4d, 0x7a, 0x16, 0xaa, 0x43, 0xe5, 0xab, 0xa7,
+	0xbd, 0xca, 0xe0, 0x87, 0x0a, 0x74, 0xf2, 0xc3, 0x5f, 0x7a, 0x7f, 0x7c, 0x02, 0x0d, 0x43, 0xa4,
+	0x70, 0x2b, 0xba, 0x69, 0x9b, 0xe5, 0x17, 0xa3, 0xab, 0x41, 0xb8, 0x00, 0xaf, 0x24, 0x59, 0x2d,
+	0x25, 0xe9, 0xfd, 0x6c, 0x41, 0xdd, 0xe0, 0x56, 0x8a, 0xb7, 0xd6, 0x14, 0xff, 0x7a, 0xaf, 0xaa,
+	0xeb, 0x00, 0xea, 0x7f, 0x5a, 0x8e, 0x4f, 0x1b, 0xb7, 0x94, 0xe7, 0x6b, 0xfd, 0x2a, 0xfd, 0xc3,
+	0x82, 0xce, 0x84, 0x04, 0x3c, 0x3c, 0xfd, 0x77, 0xe3, 0xaa, 0xd0, 0x81, 0x94, 0x84, 0xd3, 0xbc,
+	0xe4, 0xc2, 0x54, 0x57, 0x0e, 0x27, 0x11, 0x59, 0xa4, 0xf9, 0xcc, 0xe6, 0x96, 0x1a, 0xd0, 0x38,
+	0xa2, 0x8c, 0x93, 0x69, 0x18, 0x08, 0xa2, 0x67, 0xb7, 0x89, 0xc1, 0xb8, 0xf6, 0x02, 0x41, 0x2e,
+	0x9f, 0xe0, 0xa2, 0xc7, 0x8d, 0xb2, 0xc7, 0x83, 0x3f, 0x2d, 0xe8, 0x16, 0x75, 0xfc, 0x13, 0x72,
+	0xe7, 0x81, 0x0c, 0x4f, 0xc9, 0x05, 0x72, 0xcf, 0x6f, 0xe1, 0x7f, 0xa9, 0x50, 0xb8, 0x00, 0x6f,
+	0x25, 0xf7, 0x05, 0xd4, 0x34, 0x6a, 0x2b, 0xb5, 0xab, 0xeb, 0xb5, 0xb2, 0xfe, 0x55, 0x81, 0xc0,
+	0x4e, 0x62, 0x6a, 0x6e, 0xb8, 0x1a, 0xd6, 0x6b, 0xe5, 0x93, 0x6a, 0x6b, 0xdb, 0x64, 0xab, 0xf5,
+	0x20, 0x86, 0xce, 0x3e, 0x4b, 0x12, 0xf6, 0xf2, 0xb5, 0x7f, 0xe8, 0x0c, 0x7e, 0xb3, 0xa0, 0x5b,
+	0x3c, 0xeb, 0x7f, 0xf0, 0x6e, 0x1d, 0xff, 0x58, 0x01, 0xfb, 0x50, 0xbd, 0x64, 0x7d, 0xa8, 0x3e,
+	0x26, 0x12, 0xa1, 0x8b, 0x1f, 0x4b, 0xde, 0x5b, 0x5b, 0x5e, 0xb5, 0x68, 0x17, 0x6c, 0xf5, 0x51,
+	0x82, 0x56, 0xc1, 0xb5, 0x4f, 0x94, 0xed, 0x19, 0x77, 0xa1, 0xa6, 0x47, 0x1e, 0x5d, 0xdd, 0x76,
+	0x01, 0x7a, 0xd7, 0xb6, 0xde, 0x0b, 0xe8, 0x1e, 0xd4, 0x8d, 0x96, 0xd0, 0xb5, 0x4d, 0x6d, 0x99,
+	0xbc, 0x9d, 0xed, 0x92, 0x43, 0x9f, 0x41, 0xdd, 0x10, 0x51, 0x26, 0x9e, 0x13, 0x81, 0xb7, 0xb3,
+	0xe9, 0x36, 0x89, 0xbb, 0xd6, 0x71, 0x5d, 0xcf, 0xf8, 0x47, 0x7f, 0x0d, 0x00, 0x9c, 0x5b, 0x0c,
+	0x04, 0x2e, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -931,6 +1371,14 @@ type LogsClient interface {
 	Tail(ctx context.Context, in *TailRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	// Query returns log stream paths that match the requested query.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// Search returns log lines that match the requested pattern.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Follow streams log data for a single log stream as it becomes available.
+	//
+	// Log entries are sent in contiguous batches, in stream index order,
+	// starting at the requested index. The stream completes once the log stream
+	// has terminated and all of its log entries have been sent.
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (Logs_FollowClient, error)
 }
 type logsPRPCClient struct {
 	client *prpc.Client
@@ -967,6 +1415,23 @@ func (c *logsPRPCClient) Query(ctx context.Context, in *QueryRequest, opts ...gr
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logsPRPCClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (Logs_FollowClient, error) {
+	stream, err := c.client.CallServerStream(ctx, "logdog.Logs", "Follow", in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &logsFollowClient{stream}, nil
+}
+
 type logsClient struct {
 	cc *grpc.ClientConn
 }
@@ -1002,6 +1467,47 @@ func (c *logsClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/logdog.Logs/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logsClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (Logs_FollowClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Logs_serviceDesc.Streams[0], "/logdog.Logs/Follow", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logsFollowClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Logs_FollowClient interface {
+	Recv() (*FollowResponse, error)
+	grpc.ClientStream
+}
+
+type logsFollowClient struct {
+	grpc.ClientStream
+}
+
+func (x *logsFollowClient) Recv() (*FollowResponse, error) {
+	m := new(FollowResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LogsServer is the server API for Logs service.
 type LogsServer interface {
 	// Get returns state and log data for a single log stream.
@@ -1010,6 +1516,14 @@ type LogsServer interface {
 	Tail(context.Context, *TailRequest) (*GetResponse, error)
 	// Query returns log stream paths that match the requested query.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// Search returns log lines that match the requested pattern.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Follow streams log data for a single log stream as it becomes available.
+	//
+	// Log entries are sent in contiguous batches, in stream index order,
+	// starting at the requested index. The stream completes once the log stream
+	// has terminated and all of its log entries have been sent.
+	Follow(*FollowRequest, Logs_FollowServer) error
 }
 
 // UnimplementedLogsServer can be embedded to have forward compatible implementations.
@@ -1025,6 +1539,12 @@ func (*UnimplementedLogsServer) Tail(ctx context.Context, req *TailRequest) (*Ge
 func (*UnimplementedLogsServer) Query(ctx context.Context, req *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedLogsServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedLogsServer) Follow(req *FollowRequest, srv Logs_FollowServer) error {
+	return status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
 	s.RegisterService(&_Logs_serviceDesc, srv)
@@ -1084,6 +1604,45 @@ func _Logs_Query_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Logs_Follow_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FollowRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogsServer).Follow(m, &logsFollowServer{stream})
+}
+
+type Logs_FollowServer interface {
+	Send(*FollowResponse) error
+	grpc.ServerStream
+}
+
+type logsFollowServer struct {
+	grpc.ServerStream
+}
+
+func (x *logsFollowServer) Send(m *FollowResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -1100,7 +1659,17 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _Logs_Query_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Follow",
+			Handler:       _Logs_Follow_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1/logs.proto",
 }
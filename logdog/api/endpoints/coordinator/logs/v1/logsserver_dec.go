@@ -73,3 +73,49 @@ func (s *DecoratedLogs) Query(ctx context.Context, req *QueryRequest) (rsp *Quer
 	}
 	return
 }
+
+func (s *DecoratedLogs) Search(ctx context.Context, req *SearchRequest) (rsp *SearchResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "Search", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.Search(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "Search", rsp, err)
+	}
+	return
+}
+
+func (s *DecoratedLogs) Follow(req *FollowRequest, stream Logs_FollowServer) (err error) {
+	ctx := stream.Context()
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "Follow", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		err = s.Service.Follow(req, &decoratedLogsFollowServer{stream, ctx})
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "Follow", nil, err)
+	}
+	return
+}
+
+// decoratedLogsFollowServer is a Logs_FollowServer whose context is the one
+// returned by the Prelude.
+type decoratedLogsFollowServer struct {
+	Logs_FollowServer
+	ctx context.Context
+}
+
+func (s *decoratedLogsFollowServer) Context() context.Context {
+	return s.ctx
+}
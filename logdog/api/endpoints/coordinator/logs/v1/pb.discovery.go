@@ -12,1038 +12,966 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 11, 108, 28, 201,
-			149, 32, 200, 136, 204, 42, 22, 131, 148, 72, 165, 40, 54, 149,
-			250, 48, 186, 90, 106, 145, 82, 177, 72, 253, 186, 221, 82, 183,
-			119, 40, 137, 146, 170, 205, 38, 233, 98, 209, 114, 171, 97, 72,
-			201, 170, 168, 98, 118, 87, 101, 86, 103, 102, 145, 162, 122, 187,
-			219, 246, 181, 231, 236, 219, 157, 241, 121, 118, 198, 55, 189, 183,
-			88, 27, 119, 184, 237, 193, 238, 237, 45, 188, 187, 6, 198, 123,
-			240, 96, 62, 56, 44, 206, 103, 123, 207, 103, 99, 102, 239, 206,
-			62, 140, 225, 189, 51, 12, 207, 249, 128, 241, 28, 238, 99, 192,
-			56, 188, 23, 17, 249, 41, 82, 159, 158, 241, 224, 48, 11, 11,
-			173, 86, 69, 100, 100, 196, 123, 47, 94, 188, 120, 191, 136, 100,
-			255, 108, 142, 77, 181, 124, 191, 213, 22, 115, 221, 192, 143, 252,
-			141, 94, 115, 46, 114, 59, 34, 140, 156, 78, 183, 140, 85, 214,
-			168, 108, 80, 214, 13, 138, 151, 217, 80, 77, 183, 177, 38, 217,
-			96, 40, 234, 190, 215, 8, 39, 9, 39, 211, 70, 85, 23, 173,
-			113, 150, 243, 28, 207, 15, 39, 41, 39, 211, 185, 170, 44, 92,
-			121, 139, 29, 172, 251, 157, 114, 95, 159, 87, 246, 199, 61, 174,
-			66, 213, 42, 185, 125, 166, 229, 70, 155, 189, 141, 114, 221, 239,
-			204, 181, 252, 182, 227, 181, 18, 16, 187, 209, 78, 87, 132, 9,
-			164, 255, 55, 33, 255, 25, 53, 110, 172, 94, 249, 199, 244, 248,
-			13, 217, 243, 170, 106, 91, 190, 37, 218, 237, 15, 121, 254, 182,
-			87, 131, 119, 94, 252, 242, 44, 27, 180, 114, 199, 7, 126, 149,
-			16, 246, 245, 17, 70, 70, 44, 227, 248, 128, 117, 238, 15, 70,
-			56, 190, 81, 247, 219, 252, 74, 175, 217, 20, 65, 200, 103, 185,
-			236, 235, 84, 200, 27, 78, 228, 112, 215, 139, 68, 80, 223, 116,
-			188, 150, 224, 77, 63, 232, 56, 17, 227, 87, 253, 238, 78, 224,
-			182, 54, 35, 126, 110, 126, 254, 3, 234, 5, 94, 241, 234, 101,
-			206, 23, 218, 109, 142, 207, 66, 30, 136, 80, 4, 91, 162, 81,
-			102, 124, 51, 138, 186, 225, 165, 185, 185, 134, 216, 18, 109, 191,
-			43, 130, 80, 19, 3, 48, 237, 42, 32, 102, 55, 36, 16, 115,
-			140, 241, 170, 104, 184, 97, 20, 184, 27, 189, 200, 245, 61, 238,
-			120, 13, 222, 11, 5, 119, 61, 30, 250, 189, 160, 46, 176, 102,
-			195, 245, 156, 96, 7, 225, 10, 75, 124, 219, 141, 54, 185, 31,
-			224, 191, 126, 47, 98, 188, 227, 55, 220, 166, 91, 119, 160, 135,
-			18, 119, 2, 193, 187, 34, 232, 184, 81, 36, 26, 188, 27, 248,
-			91, 110, 67, 52, 120, 180, 233, 68, 60, 218, 4, 236, 218, 109,
-			127, 219, 245, 90, 28, 166, 210, 133, 151, 66, 120, 137, 241, 142,
-			136, 46, 49, 198, 225, 207, 233, 62, 192, 66, 238, 55, 53, 68,
-			117, 191, 33, 120, 167, 23, 70, 60, 16, 145, 227, 122, 216, 171,
-			179, 225, 111, 193, 35, 69, 49, 198, 61, 63, 114, 235, 162, 196,
-			163, 77, 55, 228, 109, 55, 140, 160, 135, 244, 136, 94, 163, 15,
-			156, 134, 27, 214, 219, 142, 219, 17, 65, 249, 65, 64, 184, 94,
-			154, 22, 26, 136, 110, 224, 55, 122, 117, 145, 192, 193, 18, 64,
-			254, 74, 112, 48, 174, 176, 107, 248, 245, 94, 71, 120, 145, 163,
-			39, 105, 206, 15, 184, 31, 109, 138, 128, 119, 156, 72, 4, 174,
-			211, 14, 19, 82, 227, 4, 69, 155, 130, 241, 52, 244, 49, 82,
-			203, 194, 197, 55, 161, 99, 207, 233, 8, 0, 40, 205, 91, 158,
-			159, 60, 67, 186, 187, 81, 8, 24, 121, 178, 43, 63, 8, 121,
-			199, 217, 225, 27, 2, 56, 165, 193, 35, 159, 11, 175, 225, 7,
-			161, 0, 166, 232, 6, 126, 199, 143, 4, 151, 52, 137, 66, 222,
-			16, 129, 187, 37, 26, 188, 25, 248, 29, 38, 169, 16, 250, 205,
-			104, 27, 216, 68, 113, 16, 15, 187, 162, 14, 28, 196, 187, 129,
-			11, 140, 21, 0, 239, 120, 146, 139, 194, 16, 97, 103, 188, 118,
-			179, 178, 198, 215, 86, 174, 215, 110, 45, 84, 23, 121, 101, 141,
-			175, 86, 87, 62, 82, 185, 182, 120, 141, 95, 121, 153, 215, 110,
-			46, 242, 171, 43, 171, 47, 87, 43, 55, 110, 214, 248, 205, 149,
-			165, 107, 139, 213, 53, 190, 176, 124, 141, 95, 93, 89, 174, 85,
-			43, 87, 214, 107, 43, 213, 53, 198, 139, 11, 107, 188, 178, 86,
-			196, 39, 11, 203, 47, 243, 197, 143, 174, 86, 23, 215, 214, 248,
-			74, 149, 87, 94, 90, 93, 170, 44, 94, 227, 183, 22, 170, 213,
-			133, 229, 90, 101, 113, 173, 196, 43, 203, 87, 151, 214, 175, 85,
-			150, 111, 148, 248, 149, 245, 26, 95, 94, 169, 49, 190, 84, 121,
-			169, 82, 91, 188, 198, 107, 43, 37, 28, 118, 247, 123, 124, 229,
-			58, 127, 105, 177, 122, 245, 230, 194, 114, 109, 225, 74, 101, 169,
-			82, 123, 25, 7, 188, 94, 169, 45, 195, 96, 215, 87, 170, 140,
-			47, 240, 213, 133, 106, 173, 114, 117, 125, 105, 161, 202, 87, 215,
-			171, 171, 43, 107, 139, 28, 48, 187, 86, 89, 187, 186, 180, 80,
-			121, 105, 241, 90, 153, 87, 150, 249, 242, 10, 95, 252, 200, 226,
-			114, 141, 175, 221, 92, 88, 90, 202, 34, 202, 248, 202, 173, 229,
-			197, 42, 64, 159, 70, 147, 95, 89, 228, 75, 149, 133, 43, 75,
-			139, 48, 20, 226, 121, 173, 82, 93, 188, 90, 3, 132, 146, 95,
-			87, 43, 215, 22, 151, 107, 11, 75, 37, 198, 215, 86, 23, 175,
-			86, 22, 150, 74, 124, 241, 163, 139, 47, 173, 46, 45, 84, 95,
-			46, 169, 78, 215, 22, 63, 188, 190, 184, 92, 171, 44, 44, 241,
-			107, 11, 47, 45, 220, 88, 92, 227, 211, 143, 162, 202, 106, 117,
-			229, 234, 122, 117, 241, 37, 128, 122, 229, 58, 95, 91, 191, 178,
-			86, 171, 212, 214, 107, 139, 252, 198, 202, 202, 53, 36, 246, 218,
-			98, 245, 35, 149, 171, 139, 107, 151, 249, 210, 202, 26, 18, 108,
-			125, 109, 177, 196, 248, 181, 133, 218, 2, 14, 189, 90, 93, 185,
-			94, 169, 173, 93, 134, 223, 87, 214, 215, 42, 72, 184, 202, 114,
-			109, 177, 90, 93, 95, 173, 85, 86, 150, 103, 248, 205, 149, 91,
-			139, 31, 89, 172, 242, 171, 11, 235, 107, 139, 215, 144, 194, 43,
-			203, 128, 45, 240, 202, 226, 74, 245, 101, 232, 22, 232, 128, 51,
-			80, 226, 183, 110, 46, 214, 110, 46, 86, 129, 168, 72, 173, 5,
-			32, 195, 90, 173, 90, 185, 90, 75, 55, 91, 169, 242, 218, 74,
-			181, 198, 82, 120, 242, 229, 197, 27, 75, 149, 27, 139, 203, 87,
-			23, 225, 241, 10, 116, 115, 171, 178, 182, 56, 195, 23, 170, 149,
-			53, 104, 80, 193, 129, 249, 173, 133, 151, 249, 202, 58, 98, 13,
-			19, 181, 190, 182, 200, 228, 239, 20, 235, 150, 112, 62, 121, 229,
-			58, 95, 184, 246, 145, 10, 64, 174, 90, 175, 174, 172, 173, 85,
-			20, 187, 32, 217, 174, 222, 84, 52, 47, 51, 86, 96, 132, 90,
-			6, 47, 60, 1, 191, 10, 150, 81, 28, 184, 204, 134, 24, 45,
-			156, 148, 63, 101, 229, 83, 3, 83, 88, 57, 37, 127, 202, 202,
-			19, 3, 87, 176, 114, 88, 254, 148, 149, 39, 7, 74, 88, 73,
-			228, 79, 89, 249, 244, 192, 28, 86, 170, 159, 178, 242, 212, 64,
-			17, 43, 153, 252, 41, 43, 167, 7, 158, 196, 202, 19, 242, 231,
-			191, 60, 194, 168, 57, 96, 229, 238, 195, 206, 103, 255, 163, 35,
-			124, 129, 199, 91, 46, 202, 71, 17, 10, 47, 10, 185, 195, 187,
-			190, 235, 69, 40, 213, 220, 14, 236, 50, 13, 209, 21, 94, 67,
-			120, 40, 21, 29, 111, 71, 214, 223, 247, 61, 193, 64, 154, 212,
-			157, 182, 240, 26, 78, 80, 74, 122, 17, 13, 238, 132, 92, 233,
-			1, 40, 61, 155, 129, 83, 79, 246, 8, 253, 0, 182, 0, 80,
-			10, 176, 12, 123, 164, 223, 150, 91, 156, 235, 241, 245, 218, 85,
-			190, 216, 245, 235, 155, 56, 92, 153, 87, 34, 238, 134, 92, 120,
-			176, 179, 192, 254, 7, 82, 24, 229, 231, 106, 224, 183, 69, 55,
-			114, 235, 252, 70, 32, 90, 126, 224, 58, 30, 191, 170, 96, 226,
-			219, 155, 110, 125, 147, 139, 123, 145, 128, 1, 65, 98, 38, 141,
-			52, 224, 140, 111, 56, 245, 215, 182, 157, 0, 90, 248, 124, 71,
-			56, 1, 247, 189, 93, 67, 58, 97, 216, 235, 192, 168, 78, 187,
-			205, 59, 174, 215, 139, 4, 238, 137, 252, 153, 121, 22, 163, 212,
-			246, 189, 86, 137, 187, 101, 81, 230, 109, 225, 116, 19, 84, 3,
-			193, 139, 97, 71, 56, 129, 104, 20, 121, 232, 203, 173, 214, 243,
-			211, 173, 24, 143, 156, 141, 182, 128, 49, 61, 33, 96, 200, 166,
-			31, 72, 165, 163, 11, 187, 40, 110, 16, 188, 138, 234, 135, 27,
-			42, 97, 61, 63, 63, 127, 118, 22, 255, 171, 205, 207, 95, 194,
-			255, 110, 3, 22, 207, 61, 247, 220, 115, 179, 103, 207, 205, 158,
-			63, 91, 59, 119, 254, 210, 197, 231, 46, 93, 124, 174, 252, 156,
-			254, 115, 187, 204, 248, 149, 29, 32, 120, 20, 184, 245, 8, 73,
-			169, 64, 10, 160, 251, 18, 223, 22, 92, 120, 97, 47, 16, 178,
-			118, 91, 240, 58, 80, 204, 247, 182, 68, 16, 241, 200, 103, 106,
-			86, 253, 14, 231, 213, 235, 87, 249, 249, 243, 231, 159, 3, 37,
-			73, 112, 232, 210, 107, 133, 101, 198, 215, 132, 224, 175, 104, 109,
-			103, 123, 123, 187, 236, 138, 168, 89, 246, 131, 214, 92, 208, 172,
-			195, 95, 120, 169, 28, 221, 139, 62, 54, 253, 56, 173, 102, 96,
-			131, 121, 138, 47, 222, 115, 58, 221, 182, 8, 25, 211, 63, 249,
-			217, 75, 252, 170, 223, 233, 246, 34, 145, 98, 105, 132, 109, 117,
-			101, 173, 242, 81, 126, 23, 56, 104, 122, 230, 110, 89, 41, 46,
-			73, 163, 88, 129, 188, 44, 159, 36, 170, 111, 40, 162, 59, 106,
-			242, 166, 241, 245, 229, 245, 165, 165, 153, 153, 61, 219, 33, 15,
-			79, 207, 207, 92, 78, 193, 116, 238, 81, 48, 181, 68, 4, 189,
-			248, 205, 134, 179, 147, 130, 45, 140, 130, 94, 61, 194, 1, 182,
-			156, 54, 143, 182, 212, 136, 153, 230, 79, 71, 91, 37, 142, 0,
-			93, 254, 203, 162, 180, 85, 142, 182, 160, 244, 48, 140, 100, 163,
-			94, 40, 234, 252, 52, 63, 59, 63, 159, 197, 240, 252, 3, 49,
-			188, 229, 122, 231, 207, 241, 187, 55, 68, 180, 182, 19, 70, 162,
-			3, 143, 23, 194, 235, 110, 91, 212, 178, 19, 113, 189, 178, 180,
-			88, 171, 188, 180, 200, 155, 145, 2, 227, 65, 239, 60, 221, 140,
-			52, 164, 235, 149, 229, 218, 51, 23, 120, 228, 214, 95, 11, 249,
-			11, 124, 122, 122, 90, 214, 204, 52, 163, 114, 99, 251, 166, 219,
-			218, 188, 230, 68, 248, 214, 12, 127, 254, 121, 126, 254, 220, 12,
-			255, 219, 28, 159, 45, 249, 219, 250, 145, 166, 219, 220, 28, 95,
-			0, 120, 27, 254, 118, 136, 93, 194, 202, 58, 59, 63, 159, 146,
-			75, 97, 57, 110, 32, 80, 30, 157, 125, 102, 247, 146, 139, 123,
-			131, 215, 207, 62, 115, 225, 194, 133, 103, 207, 63, 51, 63, 31,
-			175, 255, 13, 209, 244, 3, 193, 215, 61, 247, 158, 238, 229, 185,
-			103, 231, 251, 123, 41, 255, 229, 38, 115, 90, 226, 207, 167, 167,
-			37, 81, 230, 112, 178, 224, 207, 12, 159, 77, 131, 243, 8, 14,
-			134, 126, 128, 92, 186, 159, 147, 169, 126, 144, 1, 102, 50, 12,
-			112, 225, 129, 12, 240, 162, 179, 229, 240, 187, 114, 34, 203, 245,
-			94, 16, 8, 47, 130, 38, 47, 185, 237, 182, 27, 166, 24, 0,
-			196, 37, 239, 96, 45, 127, 129, 63, 248, 133, 135, 176, 57, 127,
-			33, 169, 45, 123, 98, 251, 74, 207, 109, 55, 68, 48, 61, 3,
-			136, 173, 41, 10, 169, 33, 36, 97, 102, 100, 95, 240, 7, 218,
-			44, 75, 220, 93, 47, 2, 204, 85, 75, 137, 186, 66, 27, 41,
-			48, 83, 222, 128, 158, 17, 150, 132, 6, 23, 31, 72, 3, 133,
-			133, 222, 68, 249, 234, 78, 180, 41, 149, 228, 12, 249, 211, 224,
-			79, 207, 244, 207, 205, 13, 17, 93, 77, 168, 49, 61, 131, 18,
-			240, 197, 181, 149, 101, 254, 146, 211, 237, 186, 94, 139, 49, 94,
-			241, 100, 141, 180, 72, 75, 184, 201, 165, 232, 180, 211, 21, 217,
-			93, 140, 59, 74, 70, 43, 195, 133, 241, 87, 180, 4, 127, 76,
-			65, 172, 134, 42, 243, 26, 236, 13, 110, 88, 146, 221, 200, 90,
-			24, 172, 248, 6, 108, 162, 111, 206, 190, 209, 241, 189, 104, 243,
-			205, 217, 55, 26, 206, 206, 155, 181, 55, 54, 253, 94, 240, 230,
-			165, 55, 58, 174, 247, 230, 165, 55, 66, 81, 127, 243, 149, 242,
-			27, 160, 24, 0, 35, 191, 249, 177, 219, 69, 198, 183, 55, 69,
-			32, 184, 124, 27, 58, 114, 218, 219, 206, 78, 200, 197, 61, 80,
-			44, 194, 120, 223, 111, 250, 189, 128, 55, 220, 150, 27, 133, 176,
-			195, 183, 5, 87, 35, 149, 56, 14, 85, 98, 92, 14, 86, 226,
-			56, 90, 9, 119, 43, 28, 18, 119, 226, 251, 34, 240, 103, 187,
-			78, 163, 33, 77, 163, 104, 219, 215, 189, 9, 167, 190, 9, 120,
-			137, 88, 99, 113, 218, 241, 238, 94, 82, 234, 4, 108, 133, 45,
-			159, 247, 186, 184, 209, 234, 87, 167, 113, 215, 151, 149, 103, 247,
-			214, 107, 102, 74, 12, 199, 247, 187, 178, 103, 57, 82, 241, 118,
-			145, 135, 189, 102, 211, 189, 7, 202, 22, 216, 232, 66, 170, 42,
-			192, 7, 160, 102, 241, 233, 226, 122, 237, 106, 113, 230, 114, 166,
-			150, 1, 129, 2, 241, 122, 207, 13, 68, 163, 204, 23, 56, 186,
-			14, 206, 75, 102, 8, 209, 222, 116, 239, 139, 128, 135, 155, 126,
-			175, 221, 208, 164, 236, 133, 2, 85, 171, 105, 39, 140, 71, 107,
-			240, 141, 29, 6, 96, 204, 192, 4, 120, 96, 225, 121, 145, 210,
-			175, 250, 89, 9, 8, 233, 100, 134, 234, 58, 65, 152, 12, 179,
-			33, 24, 71, 45, 38, 242, 185, 83, 175, 139, 110, 196, 55, 252,
-			104, 19, 199, 132, 119, 165, 65, 172, 113, 8, 119, 193, 193, 29,
-			143, 251, 205, 102, 40, 228, 126, 127, 221, 15, 184, 144, 107, 173,
-			196, 139, 231, 230, 207, 62, 11, 50, 243, 236, 197, 218, 252, 217,
-			75, 231, 231, 47, 157, 189, 88, 158, 63, 123, 187, 168, 184, 59,
-			228, 88, 142, 133, 110, 215, 9, 35, 198, 177, 37, 142, 239, 123,
-			252, 69, 199, 235, 57, 193, 14, 63, 123, 177, 196, 161, 183, 178,
-			90, 64, 206, 150, 179, 86, 15, 220, 110, 84, 2, 213, 47, 163,
-			236, 56, 28, 54, 13, 238, 111, 188, 42, 96, 99, 246, 165, 125,
-			172, 152, 61, 165, 135, 134, 145, 3, 218, 100, 131, 191, 18, 249,
-			149, 181, 149, 53, 92, 99, 211, 51, 201, 154, 138, 29, 62, 229,
-			142, 127, 223, 109, 183, 29, 92, 92, 194, 155, 93, 95, 155, 107,
-			248, 245, 112, 238, 150, 216, 152, 75, 32, 153, 171, 138, 166, 8,
-			132, 87, 23, 115, 55, 218, 254, 134, 211, 190, 179, 130, 32, 132,
-			115, 0, 207, 92, 106, 144, 143, 161, 91, 102, 211, 111, 148, 1,
-			23, 41, 104, 74, 184, 204, 21, 68, 119, 65, 51, 67, 53, 90,
-			255, 184, 171, 241, 1, 76, 55, 132, 70, 86, 128, 18, 186, 23,
-			134, 175, 220, 13, 163, 160, 137, 111, 166, 16, 242, 235, 97, 185,
-			43, 229, 26, 160, 114, 110, 174, 237, 110, 4, 78, 176, 131, 142,
-			185, 242, 102, 212, 105, 63, 133, 191, 244, 187, 51, 44, 246, 123,
-			72, 185, 168, 198, 8, 187, 162, 206, 79, 157, 124, 121, 246, 100,
-			103, 246, 100, 163, 118, 242, 230, 165, 147, 47, 93, 58, 185, 86,
-			62, 217, 188, 125, 170, 204, 151, 220, 215, 196, 182, 27, 138, 18,
-			8, 44, 160, 15, 206, 17, 67, 208, 129, 157, 161, 183, 23, 253,
-			134, 131, 172, 122, 42, 228, 175, 220, 173, 172, 173, 232, 141, 254,
-			186, 20, 85, 13, 85, 156, 158, 185, 251, 177, 105, 233, 131, 83,
-			82, 238, 85, 191, 33, 39, 2, 126, 204, 2, 84, 115, 78, 215,
-			197, 249, 208, 181, 136, 206, 156, 132, 117, 110, 119, 223, 136, 167,
-			30, 96, 118, 150, 241, 25, 160, 161, 191, 129, 126, 47, 71, 225,
-			24, 9, 176, 148, 186, 184, 52, 252, 38, 111, 9, 79, 4, 142,
-			92, 100, 122, 129, 133, 82, 32, 199, 164, 47, 51, 252, 99, 152,
-			3, 196, 50, 238, 23, 14, 176, 127, 64, 152, 105, 14, 208, 1,
-			203, 120, 155, 142, 219, 191, 78, 120, 53, 49, 219, 52, 207, 251,
-			77, 100, 117, 164, 110, 232, 122, 245, 180, 206, 193, 246, 86, 58,
-			248, 75, 189, 48, 2, 38, 192, 125, 235, 1, 6, 5, 219, 203,
-			162, 184, 205, 93, 175, 222, 238, 133, 238, 150, 40, 51, 182, 143,
-			229, 0, 58, 211, 50, 223, 166, 247, 15, 178, 17, 89, 204, 1,
-			180, 131, 186, 68, 44, 227, 237, 194, 168, 46, 25, 150, 241, 182,
-			117, 144, 253, 175, 18, 47, 98, 153, 159, 34, 212, 178, 255, 152,
-			240, 101, 223, 155, 245, 68, 203, 137, 220, 45, 145, 181, 29, 29,
-			133, 41, 7, 243, 105, 47, 25, 91, 230, 203, 234, 69, 45, 183,
-			249, 150, 211, 238, 137, 80, 178, 94, 210, 25, 58, 6, 195, 200,
-			109, 183, 249, 166, 179, 37, 184, 151, 30, 19, 187, 86, 47, 50,
-			105, 3, 213, 253, 158, 23, 193, 212, 128, 165, 168, 205, 227, 126,
-			226, 41, 211, 171, 164, 254, 178, 12, 129, 246, 35, 214, 196, 180,
-			114, 159, 34, 244, 237, 113, 69, 48, 146, 67, 188, 7, 117, 17,
-			201, 80, 216, 167, 139, 6, 20, 199, 14, 108, 228, 165, 204, 101,
-			127, 60, 206, 42, 45, 191, 92, 223, 12, 252, 142, 219, 235, 32,
-			235, 182, 123, 117, 119, 174, 237, 183, 26, 126, 11, 152, 119, 78,
-			120, 13, 52, 226, 195, 185, 186, 239, 7, 13, 215, 115, 34, 63,
-			128, 6, 225, 220, 214, 217, 185, 48, 114, 34, 229, 121, 183, 242,
-			242, 45, 251, 81, 65, 128, 226, 223, 51, 216, 254, 37, 191, 181,
-			22, 5, 194, 233, 172, 65, 15, 214, 83, 108, 31, 62, 187, 179,
-			37, 130, 208, 245, 61, 244, 255, 15, 85, 71, 176, 242, 35, 178,
-			206, 186, 192, 6, 235, 129, 0, 225, 142, 97, 128, 225, 115, 118,
-			191, 235, 191, 28, 239, 48, 85, 221, 212, 58, 201, 246, 71, 34,
-			232, 184, 158, 211, 190, 227, 122, 13, 113, 111, 210, 192, 216, 194,
-			62, 93, 91, 129, 74, 235, 121, 54, 232, 4, 245, 77, 119, 75,
-			76, 154, 216, 121, 177, 44, 241, 41, 103, 65, 45, 47, 200, 86,
-			21, 175, 233, 87, 245, 43, 214, 4, 203, 119, 123, 65, 75, 52,
-			38, 115, 156, 76, 23, 170, 170, 100, 255, 14, 97, 195, 169, 23,
-			172, 35, 108, 8, 97, 184, 211, 11, 218, 10, 199, 2, 86, 172,
-			7, 109, 235, 24, 99, 33, 14, 132, 79, 41, 62, 29, 146, 53,
-			240, 248, 48, 43, 52, 156, 200, 193, 135, 6, 62, 28, 132, 50,
-			60, 178, 89, 161, 238, 195, 198, 22, 73, 232, 11, 213, 184, 108,
-			61, 205, 70, 219, 126, 235, 142, 240, 162, 96, 231, 14, 50, 30,
-			194, 104, 84, 247, 181, 253, 214, 34, 212, 94, 133, 202, 23, 255,
-			96, 140, 229, 45, 211, 28, 152, 39, 236, 203, 4, 99, 25, 230,
-			128, 117, 238, 31, 147, 76, 88, 226, 236, 51, 168, 102, 44, 173,
-			95, 173, 240, 133, 94, 180, 233, 7, 97, 249, 1, 177, 137, 245,
-			16, 165, 147, 242, 0, 39, 158, 124, 55, 228, 45, 127, 75, 4,
-			30, 168, 96, 94, 67, 57, 166, 23, 186, 78, 29, 58, 118, 235,
-			194, 3, 241, 172, 230, 156, 159, 43, 207, 235, 53, 35, 119, 151,
-			166, 223, 243, 26, 218, 79, 190, 84, 185, 186, 184, 188, 182, 200,
-			155, 110, 91, 196, 78, 179, 124, 97, 63, 27, 98, 212, 24, 176,
-			140, 194, 224, 52, 251, 135, 4, 157, 85, 230, 190, 129, 121, 98,
-			127, 142, 240, 236, 116, 162, 102, 200, 55, 220, 134, 27, 8, 165,
-			163, 113, 100, 106, 185, 96, 165, 203, 219, 245, 248, 122, 23, 164,
-			178, 124, 145, 215, 157, 118, 59, 132, 237, 126, 119, 95, 162, 179,
-			33, 26, 13, 233, 123, 241, 248, 162, 94, 60, 168, 92, 137, 48,
-			154, 11, 68, 216, 245, 189, 80, 40, 227, 60, 44, 39, 98, 121,
-			95, 97, 130, 93, 211, 82, 121, 180, 240, 164, 253, 172, 12, 35,
-			105, 82, 184, 82, 147, 211, 188, 206, 213, 82, 65, 207, 142, 164,
-			50, 130, 146, 145, 158, 163, 133, 125, 147, 41, 233, 57, 90, 216,
-			159, 146, 158, 163, 163, 71, 83, 210, 115, 116, 138, 179, 85, 37,
-			60, 13, 171, 80, 182, 175, 226, 84, 163, 244, 71, 85, 14, 6,
-			111, 251, 45, 53, 12, 223, 118, 0, 171, 150, 27, 70, 34, 72,
-			5, 33, 248, 213, 68, 76, 196, 160, 16, 211, 50, 173, 194, 232,
-			147, 106, 56, 146, 135, 17, 226, 18, 140, 87, 156, 209, 37, 195,
-			50, 172, 210, 44, 219, 66, 80, 168, 101, 76, 20, 158, 180, 93,
-			4, 69, 13, 140, 235, 69, 178, 86, 26, 160, 83, 33, 215, 43,
-			154, 119, 68, 24, 58, 45, 81, 230, 21, 217, 74, 206, 165, 27,
-			242, 217, 179, 37, 22, 191, 135, 36, 3, 153, 45, 59, 112, 189,
-			86, 12, 48, 53, 45, 115, 162, 96, 149, 21, 80, 52, 7, 112,
-			236, 211, 37, 98, 25, 19, 251, 53, 237, 168, 97, 25, 19, 83,
-			156, 221, 4, 128, 141, 1, 203, 60, 76, 167, 13, 251, 18, 79,
-			45, 123, 12, 166, 56, 46, 198, 189, 176, 146, 55, 68, 228, 184,
-			237, 80, 205, 93, 26, 141, 50, 147, 253, 26, 48, 67, 135, 217,
-			33, 246, 97, 150, 135, 18, 48, 197, 17, 243, 176, 125, 5, 73,
-			161, 226, 55, 107, 145, 31, 56, 45, 193, 215, 171, 75, 202, 222,
-			217, 69, 19, 73, 45, 55, 30, 186, 81, 102, 108, 148, 13, 202,
-			46, 77, 203, 60, 98, 30, 126, 130, 237, 215, 21, 57, 24, 132,
-			37, 101, 98, 25, 71, 134, 199, 147, 178, 97, 25, 71, 158, 152,
-			100, 175, 40, 152, 136, 101, 28, 51, 109, 123, 233, 125, 194, 20,
-			56, 219, 122, 50, 101, 48, 116, 79, 232, 128, 105, 142, 153, 71,
-			14, 199, 163, 147, 28, 12, 151, 64, 7, 140, 115, 108, 248, 80,
-			82, 54, 44, 227, 216, 228, 97, 118, 91, 65, 71, 45, 99, 202,
-			156, 180, 63, 244, 62, 161, 115, 194, 80, 116, 54, 218, 162, 241,
-			48, 224, 128, 65, 166, 204, 99, 118, 60, 56, 176, 200, 84, 10,
-			56, 160, 205, 212, 240, 193, 164, 108, 88, 198, 212, 196, 19, 236,
-			123, 68, 65, 103, 88, 198, 9, 115, 194, 254, 6, 65, 38, 13,
-			122, 162, 132, 222, 96, 0, 5, 100, 181, 43, 66, 190, 33, 162,
-			109, 33, 60, 62, 47, 163, 134, 153, 93, 140, 111, 3, 240, 49,
-			100, 188, 210, 100, 188, 233, 180, 67, 29, 122, 76, 155, 127, 42,
-			18, 219, 183, 120, 61, 31, 20, 17, 185, 75, 180, 119, 120, 219,
-			119, 26, 40, 235, 64, 65, 67, 79, 113, 71, 52, 92, 233, 134,
-			149, 52, 139, 165, 128, 28, 213, 105, 203, 102, 240, 67, 220, 235,
-			162, 233, 152, 16, 200, 48, 45, 243, 132, 57, 53, 25, 19, 192,
-			200, 1, 194, 133, 164, 76, 44, 227, 196, 208, 129, 164, 12, 4,
-			25, 63, 196, 158, 82, 244, 49, 45, 227, 148, 121, 220, 30, 199,
-			217, 243, 122, 157, 13, 17, 192, 162, 7, 36, 146, 81, 76, 211,
-			50, 79, 153, 39, 38, 226, 94, 204, 28, 188, 54, 148, 148, 137,
-			101, 156, 98, 9, 15, 153, 134, 101, 156, 58, 122, 140, 57, 40,
-			94, 12, 203, 56, 67, 109, 187, 6, 83, 128, 58, 155, 219, 46,
-			245, 147, 42, 53, 255, 37, 21, 189, 5, 181, 218, 21, 237, 70,
-			255, 186, 6, 251, 94, 173, 236, 88, 146, 0, 29, 206, 208, 105,
-			67, 73, 11, 35, 15, 67, 106, 73, 2, 52, 56, 179, 255, 144,
-			46, 1, 56, 147, 135, 217, 219, 8, 155, 105, 25, 115, 133, 73,
-			59, 224, 149, 140, 33, 47, 21, 11, 181, 71, 161, 18, 219, 246,
-			91, 96, 178, 3, 200, 56, 213, 155, 14, 112, 14, 216, 222, 178,
-			169, 27, 114, 223, 107, 239, 48, 238, 212, 95, 243, 252, 237, 182,
-			104, 180, 164, 175, 194, 105, 116, 92, 207, 13, 163, 64, 234, 169,
-			245, 182, 11, 234, 127, 12, 57, 208, 118, 174, 112, 198, 86, 208,
-			1, 101, 231, 10, 35, 186, 68, 44, 99, 110, 159, 214, 204, 129,
-			170, 115, 19, 79, 196, 138, 229, 255, 113, 146, 29, 239, 215, 2,
-			27, 189, 64, 134, 29, 30, 144, 9, 114, 137, 21, 174, 169, 38,
-			239, 59, 17, 228, 111, 239, 157, 8, 178, 79, 119, 168, 243, 64,
-			78, 63, 58, 15, 68, 131, 249, 62, 210, 64, 254, 159, 167, 64,
-			115, 58, 62, 16, 253, 50, 11, 228, 151, 89, 32, 191, 204, 2,
-			249, 101, 22, 200, 47, 179, 64, 126, 153, 5, 242, 255, 123, 22,
-			200, 66, 146, 5, 178, 240, 240, 44, 144, 114, 146, 5, 82, 126,
-			31, 89, 32, 95, 24, 147, 134, 117, 115, 32, 34, 246, 111, 140,
-			241, 5, 174, 183, 219, 108, 14, 72, 232, 182, 60, 208, 157, 154,
-			238, 61, 209, 152, 109, 11, 175, 21, 109, 242, 176, 139, 206, 121,
-			105, 94, 166, 146, 61, 152, 140, 42, 73, 7, 89, 58, 191, 227,
-			193, 137, 31, 41, 223, 29, 203, 56, 239, 100, 206, 197, 30, 73,
-			39, 58, 91, 3, 123, 173, 251, 94, 93, 116, 35, 16, 211, 175,
-			9, 94, 108, 56, 59, 69, 204, 69, 41, 98, 24, 168, 168, 187,
-			9, 68, 27, 195, 10, 145, 159, 10, 99, 160, 160, 86, 59, 92,
-			195, 109, 42, 31, 187, 214, 219, 25, 70, 131, 146, 214, 202, 119,
-			136, 30, 135, 152, 84, 0, 130, 27, 123, 55, 100, 28, 201, 15,
-			120, 216, 219, 136, 0, 93, 160, 8, 186, 3, 157, 84, 104, 50,
-			73, 220, 112, 186, 221, 192, 191, 231, 194, 118, 208, 222, 225, 103,
-			102, 207, 206, 151, 230, 231, 231, 49, 239, 36, 124, 156, 28, 135,
-			24, 12, 28, 35, 3, 46, 238, 123, 221, 80, 244, 26, 62, 110,
-			190, 187, 51, 30, 194, 200, 9, 34, 254, 2, 47, 151, 203, 151,
-			251, 159, 9, 175, 145, 121, 18, 15, 164, 245, 43, 253, 84, 62,
-			142, 149, 67, 61, 173, 47, 64, 15, 113, 105, 86, 142, 165, 203,
-			151, 251, 94, 146, 30, 86, 249, 138, 252, 173, 95, 192, 146, 30,
-			196, 109, 242, 233, 93, 3, 61, 207, 231, 249, 211, 79, 247, 247,
-			245, 65, 62, 63, 195, 223, 208, 193, 221, 93, 47, 157, 121, 129,
-			159, 189, 188, 235, 169, 26, 250, 133, 56, 212, 61, 63, 175, 26,
-			189, 201, 69, 27, 244, 171, 20, 0, 97, 220, 217, 7, 247, 132,
-			224, 249, 135, 67, 48, 251, 16, 8, 206, 236, 5, 193, 99, 229,
-			147, 36, 197, 51, 201, 140, 189, 127, 54, 120, 224, 100, 63, 152,
-			73, 228, 163, 244, 156, 191, 144, 157, 115, 126, 102, 23, 17, 46,
-			39, 47, 105, 14, 72, 205, 122, 250, 133, 93, 108, 144, 188, 147,
-			165, 115, 134, 233, 210, 36, 78, 94, 216, 147, 186, 201, 252, 38,
-			13, 63, 152, 110, 248, 128, 49, 206, 236, 61, 198, 158, 60, 180,
-			119, 190, 76, 118, 5, 199, 193, 57, 248, 95, 67, 180, 209, 198,
-			232, 207, 23, 0, 162, 239, 110, 56, 221, 112, 118, 194, 23, 206,
-			151, 116, 62, 218, 11, 103, 117, 118, 67, 106, 22, 245, 104, 211,
-			125, 143, 202, 215, 3, 31, 243, 108, 100, 87, 81, 227, 177, 211,
-			11, 98, 248, 31, 150, 93, 16, 56, 74, 119, 117, 64, 96, 50,
-			21, 112, 44, 165, 60, 56, 170, 33, 166, 230, 41, 245, 89, 197,
-			193, 139, 97, 145, 79, 43, 111, 8, 52, 81, 164, 159, 1, 201,
-			139, 113, 239, 110, 32, 234, 152, 34, 183, 177, 35, 53, 224, 216,
-			215, 144, 196, 234, 65, 183, 78, 165, 241, 164, 178, 8, 156, 144,
-			165, 226, 251, 60, 206, 243, 201, 68, 155, 207, 199, 219, 21, 246,
-			148, 73, 9, 74, 71, 187, 53, 238, 110, 134, 80, 64, 138, 226,
-			249, 176, 88, 82, 9, 10, 231, 51, 187, 98, 38, 63, 64, 246,
-			197, 96, 43, 73, 64, 220, 171, 183, 178, 230, 174, 179, 208, 47,
-			244, 211, 215, 43, 227, 29, 183, 30, 100, 251, 125, 220, 110, 207,
-			134, 197, 76, 192, 177, 89, 24, 99, 223, 141, 3, 142, 175, 209,
-			113, 251, 155, 132, 175, 161, 90, 144, 14, 54, 226, 164, 165, 244,
-			130, 190, 128, 216, 236, 249, 179, 23, 75, 23, 159, 125, 6, 118,
-			56, 248, 139, 225, 229, 51, 125, 149, 169, 32, 25, 95, 246, 35,
-			113, 9, 122, 13, 5, 223, 240, 123, 58, 119, 178, 46, 87, 142,
-			52, 78, 46, 49, 254, 12, 166, 86, 205, 117, 92, 143, 159, 134,
-			66, 199, 245, 230, 54, 3, 126, 154, 159, 187, 192, 55, 131, 185,
-			134, 179, 195, 79, 243, 243, 207, 92, 44, 159, 187, 200, 97, 141,
-			204, 97, 86, 167, 202, 230, 145, 91, 109, 218, 229, 254, 26, 109,
-			90, 41, 151, 251, 107, 153, 128, 229, 107, 153, 128, 229, 107, 214,
-			65, 246, 31, 24, 218, 231, 30, 82, 203, 254, 63, 169, 166, 203,
-			251, 139, 84, 166, 201, 199, 18, 250, 233, 197, 21, 242, 182, 8,
-			67, 185, 126, 124, 79, 196, 189, 5, 25, 221, 75, 50, 167, 195,
-			231, 25, 191, 171, 166, 229, 174, 114, 116, 169, 84, 13, 63, 116,
-			209, 85, 228, 7, 60, 14, 111, 222, 69, 128, 84, 67, 201, 247,
-			241, 30, 135, 160, 164, 6, 244, 3, 222, 241, 3, 81, 226, 14,
-			186, 219, 238, 139, 192, 87, 190, 121, 237, 8, 207, 244, 38, 77,
-			109, 88, 24, 26, 61, 7, 67, 209, 45, 15, 184, 13, 155, 103,
-			225, 236, 231, 152, 76, 248, 20, 184, 37, 85, 177, 71, 188, 153,
-			152, 150, 25, 210, 215, 198, 117, 40, 34, 7, 147, 50, 152, 10,
-			83, 132, 113, 12, 128, 24, 150, 17, 166, 66, 169, 127, 94, 99,
-			115, 143, 10, 165, 182, 253, 86, 119, 3, 254, 175, 92, 96, 57,
-			172, 120, 100, 188, 212, 126, 132, 43, 173, 248, 191, 83, 118, 48,
-			142, 68, 93, 19, 33, 166, 124, 248, 1, 6, 37, 3, 209, 116,
-			239, 169, 72, 163, 42, 89, 22, 51, 193, 202, 87, 17, 70, 252,
-			109, 157, 99, 195, 42, 246, 8, 114, 24, 227, 139, 251, 207, 29,
-			40, 35, 132, 101, 217, 115, 109, 167, 43, 170, 42, 66, 9, 191,
-			173, 39, 217, 72, 221, 247, 34, 225, 69, 242, 37, 19, 251, 27,
-			86, 117, 216, 228, 3, 108, 40, 198, 6, 195, 142, 15, 15, 218,
-			38, 141, 173, 15, 48, 51, 114, 90, 225, 100, 158, 27, 211, 195,
-			231, 78, 40, 72, 246, 64, 179, 92, 115, 90, 33, 198, 49, 171,
-			248, 134, 245, 52, 27, 149, 238, 154, 59, 77, 183, 45, 238, 136,
-			123, 209, 228, 32, 66, 182, 79, 86, 95, 119, 219, 98, 241, 94,
-			100, 63, 203, 134, 226, 87, 173, 49, 102, 188, 38, 118, 20, 161,
-			224, 167, 53, 206, 114, 200, 154, 138, 76, 178, 112, 137, 126, 128,
-			20, 95, 101, 102, 77, 220, 139, 172, 167, 89, 174, 237, 122, 34,
-			156, 36, 8, 227, 152, 130, 17, 158, 149, 151, 92, 79, 84, 229,
-			99, 251, 18, 51, 161, 152, 244, 8, 163, 140, 168, 30, 173, 163,
-			108, 168, 33, 218, 110, 199, 141, 68, 160, 131, 190, 113, 69, 177,
-			200, 242, 87, 16, 106, 152, 181, 134, 19, 57, 216, 100, 164, 138,
-			191, 95, 52, 11, 100, 140, 22, 255, 62, 97, 133, 107, 78, 228,
-			180, 2, 167, 19, 55, 35, 73, 51, 235, 44, 27, 236, 58, 65,
-			228, 58, 109, 21, 56, 127, 66, 129, 170, 223, 42, 175, 202, 199,
-			85, 221, 206, 190, 193, 6, 85, 29, 128, 45, 227, 230, 208, 229,
-			190, 170, 44, 192, 56, 161, 123, 95, 82, 199, 172, 226, 111, 168,
-			107, 59, 97, 132, 220, 83, 168, 226, 239, 226, 127, 69, 89, 97,
-			73, 5, 154, 173, 75, 108, 24, 102, 248, 142, 204, 202, 194, 14,
-			135, 207, 29, 222, 197, 16, 90, 108, 85, 25, 180, 94, 193, 198,
-			192, 109, 146, 127, 85, 20, 95, 14, 60, 44, 235, 100, 12, 255,
-			73, 54, 162, 152, 56, 9, 244, 155, 85, 197, 216, 178, 137, 205,
-			10, 161, 120, 189, 7, 102, 26, 242, 171, 89, 141, 203, 214, 147,
-			204, 140, 128, 91, 24, 130, 53, 156, 154, 206, 155, 3, 85, 124,
-			100, 157, 98, 121, 201, 68, 147, 195, 216, 104, 159, 106, 36, 231,
-			232, 230, 64, 85, 61, 182, 102, 101, 176, 30, 136, 59, 57, 130,
-			77, 71, 251, 104, 126, 115, 160, 26, 55, 185, 50, 196, 6, 213,
-			178, 41, 254, 19, 3, 9, 38, 193, 45, 51, 179, 33, 194, 186,
-			162, 148, 253, 224, 85, 80, 197, 118, 214, 28, 27, 84, 193, 163,
-			73, 138, 76, 121, 40, 121, 5, 123, 44, 203, 149, 162, 91, 89,
-			167, 217, 1, 152, 166, 59, 25, 210, 74, 186, 141, 194, 131, 213,
-			20, 121, 117, 219, 12, 141, 205, 164, 237, 90, 138, 206, 15, 200,
-			58, 48, 251, 178, 14, 236, 175, 18, 150, 147, 188, 49, 193, 242,
-			41, 182, 48, 171, 170, 148, 153, 49, 186, 107, 198, 70, 246, 0,
-			252, 161, 60, 97, 238, 230, 137, 62, 174, 204, 189, 15, 174, 60,
-			61, 207, 88, 34, 29, 173, 2, 51, 107, 139, 31, 173, 141, 13,
-			88, 140, 229, 175, 84, 150, 23, 170, 47, 143, 17, 107, 132, 21,
-			174, 45, 212, 22, 110, 84, 23, 94, 26, 163, 47, 254, 254, 77,
-			54, 104, 229, 204, 129, 223, 161, 15, 77, 180, 184, 248, 55, 33,
-			209, 98, 95, 58, 209, 2, 126, 18, 203, 24, 26, 60, 197, 56,
-			163, 185, 1, 203, 28, 25, 24, 35, 246, 184, 138, 77, 169, 112,
-			26, 236, 20, 101, 14, 170, 97, 14, 20, 162, 145, 220, 40, 27,
-			102, 102, 14, 53, 195, 125, 116, 24, 182, 215, 156, 212, 149, 246,
-			209, 188, 46, 81, 203, 216, 55, 196, 84, 67, 98, 25, 251, 101,
-			4, 45, 39, 119, 229, 253, 180, 160, 75, 212, 50, 246, 15, 143,
-			168, 134, 212, 50, 70, 233, 168, 122, 4, 175, 141, 82, 166, 75,
-			240, 108, 223, 126, 246, 186, 116, 97, 77, 12, 124, 136, 216, 226,
-			52, 38, 116, 196, 145, 233, 120, 109, 97, 172, 166, 204, 107, 24,
-			86, 149, 122, 71, 179, 215, 6, 93, 31, 61, 74, 174, 39, 53,
-			96, 52, 98, 54, 157, 136, 169, 87, 55, 68, 40, 163, 115, 45,
-			52, 59, 116, 116, 95, 43, 197, 19, 133, 35, 236, 123, 177, 82,
-			60, 69, 199, 237, 127, 67, 88, 42, 207, 225, 20, 90, 38, 96,
-			193, 76, 235, 204, 220, 25, 149, 115, 18, 114, 63, 112, 91, 174,
-			39, 13, 26, 233, 186, 209, 218, 209, 149, 94, 212, 22, 1, 119,
-			189, 48, 114, 188, 186, 224, 219, 152, 224, 176, 9, 170, 158, 195,
-			229, 106, 134, 94, 22, 64, 239, 114, 27, 122, 136, 56, 65, 194,
-			225, 146, 157, 151, 161, 47, 141, 7, 176, 193, 165, 36, 206, 180,
-			167, 158, 83, 247, 59, 29, 223, 211, 234, 14, 198, 210, 210, 90,
-			241, 20, 157, 56, 150, 210, 138, 167, 228, 148, 41, 173, 120, 106,
-			40, 173, 21, 79, 89, 7, 217, 143, 116, 26, 159, 49, 67, 45,
-			251, 127, 86, 132, 201, 132, 233, 49, 136, 145, 37, 141, 158, 33,
-			29, 161, 232, 121, 238, 235, 61, 209, 222, 225, 110, 67, 120, 145,
-			219, 220, 145, 243, 17, 7, 193, 221, 104, 83, 219, 138, 117, 191,
-			43, 226, 168, 71, 119, 23, 153, 112, 176, 191, 86, 34, 129, 238,
-			57, 67, 167, 210, 186, 231, 76, 76, 36, 224, 242, 153, 161, 180,
-			238, 57, 51, 118, 128, 125, 64, 167, 200, 148, 232, 49, 251, 204,
-			110, 10, 169, 29, 69, 90, 214, 41, 74, 241, 84, 146, 75, 137,
-			206, 104, 107, 133, 230, 161, 167, 145, 84, 146, 75, 41, 78, 30,
-			162, 134, 101, 148, 142, 28, 101, 255, 11, 209, 113, 243, 139, 212,
-			182, 255, 251, 126, 134, 125, 208, 136, 122, 110, 148, 62, 207, 29,
-			143, 223, 172, 213, 86, 249, 85, 217, 126, 22, 132, 167, 36, 175,
-			118, 183, 118, 156, 134, 224, 206, 150, 227, 182, 117, 6, 248, 146,
-			223, 186, 230, 183, 152, 14, 83, 203, 108, 132, 215, 123, 34, 216,
-			73, 150, 23, 239, 136, 200, 145, 171, 181, 18, 73, 214, 119, 218,
-			161, 143, 67, 118, 187, 109, 87, 197, 189, 85, 56, 159, 233, 176,
-			30, 80, 13, 223, 74, 7, 237, 47, 210, 146, 230, 88, 35, 7,
-			248, 22, 82, 65, 251, 139, 67, 233, 160, 253, 197, 201, 195, 236,
-			191, 38, 58, 106, 255, 2, 61, 109, 255, 211, 189, 56, 118, 195,
-			9, 69, 234, 180, 198, 30, 244, 241, 124, 29, 230, 151, 78, 53,
-			244, 142, 239, 202, 116, 146, 27, 129, 82, 170, 92, 17, 123, 35,
-			160, 153, 27, 176, 212, 16, 78, 152, 177, 226, 229, 6, 23, 106,
-			137, 161, 211, 24, 50, 17, 255, 23, 232, 197, 56, 226, 159, 7,
-			100, 142, 164, 34, 254, 47, 28, 61, 153, 138, 248, 191, 48, 61,
-			195, 254, 158, 68, 59, 103, 25, 215, 232, 148, 253, 41, 64, 219,
-			193, 204, 42, 199, 227, 78, 176, 225, 70, 1, 16, 248, 53, 177,
-			51, 39, 87, 79, 228, 180, 184, 19, 134, 126, 221, 117, 162, 36,
-			104, 233, 134, 217, 12, 40, 53, 217, 241, 92, 195, 150, 132, 83,
-			141, 118, 98, 210, 84, 210, 180, 193, 65, 242, 58, 45, 229, 96,
-			143, 209, 201, 153, 150, 121, 141, 190, 112, 90, 129, 156, 203, 3,
-			144, 122, 222, 114, 196, 50, 174, 77, 104, 84, 115, 134, 101, 92,
-			59, 118, 156, 189, 43, 209, 201, 91, 198, 139, 244, 152, 253, 119,
-			8, 227, 149, 38, 200, 249, 146, 118, 50, 73, 185, 209, 110, 3,
-			75, 189, 234, 187, 30, 114, 84, 75, 160, 123, 170, 209, 67, 31,
-			84, 156, 29, 19, 249, 60, 16, 50, 13, 85, 6, 102, 149, 212,
-			214, 9, 103, 104, 85, 247, 49, 186, 19, 241, 231, 165, 248, 249,
-			224, 220, 153, 185, 231, 65, 238, 124, 176, 12, 6, 133, 70, 42,
-			111, 90, 230, 139, 244, 218, 148, 2, 60, 159, 3, 80, 53, 107,
-			230, 137, 101, 188, 56, 164, 23, 109, 222, 176, 140, 23, 143, 28,
-			101, 69, 6, 147, 103, 46, 15, 8, 98, 79, 112, 208, 103, 53,
-			0, 106, 189, 202, 253, 216, 4, 41, 179, 92, 24, 97, 223, 162,
-			204, 52, 137, 49, 96, 153, 31, 165, 117, 195, 254, 67, 202, 112,
-			161, 186, 173, 158, 223, 11, 57, 232, 193, 28, 13, 27, 29, 197,
-			118, 3, 30, 27, 44, 161, 228, 101, 193, 157, 32, 112, 118, 48,
-			97, 7, 155, 202, 64, 119, 216, 23, 244, 238, 98, 50, 59, 136,
-			77, 206, 103, 249, 60, 247, 3, 126, 86, 231, 36, 193, 118, 42,
-			223, 213, 21, 234, 20, 9, 168, 154, 154, 251, 119, 78, 133, 18,
-			33, 121, 98, 6, 216, 73, 58, 38, 157, 4, 36, 190, 209, 195,
-			128, 60, 200, 118, 209, 110, 38, 251, 55, 244, 62, 35, 135, 118,
-			188, 157, 148, 223, 79, 103, 69, 169, 241, 147, 190, 147, 78, 75,
-			120, 188, 7, 186, 228, 254, 182, 151, 238, 74, 97, 161, 12, 41,
-			217, 133, 204, 233, 35, 152, 211, 247, 81, 182, 159, 93, 103, 121,
-			40, 193, 198, 127, 219, 28, 183, 159, 149, 178, 194, 245, 196, 41,
-			69, 95, 53, 49, 37, 9, 183, 87, 111, 247, 26, 120, 202, 10,
-			67, 235, 10, 132, 50, 199, 52, 40, 162, 18, 249, 110, 155, 31,
-			29, 195, 52, 39, 162, 18, 249, 110, 171, 52, 40, 162, 18, 249,
-			110, 179, 209, 164, 108, 88, 198, 109, 235, 32, 251, 23, 68, 65,
-			66, 44, 99, 195, 60, 108, 255, 231, 90, 110, 73, 88, 18, 26,
-			74, 255, 42, 30, 113, 81, 170, 166, 92, 227, 162, 211, 141, 118,
-			212, 211, 56, 221, 193, 195, 93, 18, 112, 112, 189, 158, 136, 149,
-			72, 15, 57, 15, 117, 119, 32, 13, 195, 150, 165, 56, 23, 66,
-			141, 169, 149, 123, 61, 31, 13, 95, 200, 92, 53, 167, 177, 5,
-			26, 141, 202, 49, 35, 42, 67, 112, 195, 188, 61, 30, 163, 5,
-			187, 230, 134, 74, 194, 35, 42, 67, 112, 99, 56, 245, 220, 176,
-			140, 141, 39, 38, 65, 63, 52, 9, 80, 191, 65, 165, 4, 32,
-			64, 66, 163, 65, 153, 46, 229, 45, 163, 49, 60, 170, 75, 196,
-			50, 26, 99, 135, 116, 201, 176, 140, 198, 228, 97, 118, 130, 81,
-			147, 90, 230, 230, 128, 71, 236, 73, 46, 45, 192, 189, 23, 22,
-			80, 119, 179, 176, 159, 221, 128, 223, 67, 150, 241, 234, 240, 62,
-			251, 50, 191, 238, 7, 29, 17, 180, 165, 211, 89, 243, 115, 121,
-			77, 163, 47, 229, 229, 182, 19, 242, 70, 175, 219, 86, 135, 154,
-			54, 69, 0, 4, 0, 248, 233, 208, 0, 244, 36, 247, 107, 58,
-			4, 48, 166, 74, 84, 150, 166, 161, 33, 32, 218, 161, 7, 236,
-			35, 56, 181, 27, 105, 64, 85, 34, 146, 82, 9, 40, 50, 82,
-			135, 190, 42, 53, 13, 138, 108, 212, 81, 30, 48, 138, 100, 232,
-			168, 12, 48, 138, 100, 232, 140, 142, 177, 18, 131, 205, 32, 247,
-			250, 192, 127, 72, 136, 61, 197, 181, 121, 219, 71, 137, 148, 222,
-			111, 194, 6, 250, 122, 97, 140, 21, 153, 105, 26, 0, 92, 72,
-			15, 216, 135, 228, 14, 168, 45, 226, 52, 88, 6, 130, 21, 210,
-			215, 165, 166, 98, 32, 88, 218, 49, 103, 32, 88, 161, 2, 203,
-			64, 176, 194, 209, 49, 246, 9, 144, 228, 134, 49, 96, 229, 118,
-			232, 167, 136, 97, 7, 25, 206, 69, 126, 138, 215, 168, 30, 180,
-			148, 206, 237, 67, 41, 47, 151, 158, 224, 129, 80, 89, 161, 59,
-			50, 108, 32, 211, 239, 250, 179, 120, 81, 170, 232, 206, 84, 34,
-			175, 129, 139, 126, 135, 29, 96, 14, 203, 67, 9, 16, 126, 203,
-			60, 100, 87, 229, 74, 67, 171, 180, 36, 247, 123, 88, 226, 40,
-			102, 238, 139, 192, 47, 197, 70, 93, 76, 147, 102, 224, 180, 58,
-			66, 93, 168, 160, 198, 99, 233, 1, 97, 97, 24, 74, 30, 188,
-			101, 238, 200, 236, 83, 67, 201, 131, 183, 212, 194, 48, 148, 60,
-			120, 107, 120, 44, 41, 27, 150, 241, 214, 193, 113, 54, 167, 96,
-			36, 150, 249, 9, 98, 142, 219, 83, 82, 191, 115, 239, 199, 106,
-			72, 6, 71, 206, 198, 84, 7, 196, 180, 114, 159, 32, 230, 91,
-			135, 98, 24, 72, 14, 251, 72, 128, 34, 216, 233, 240, 104, 82,
-			97, 64, 133, 117, 144, 173, 171, 81, 169, 101, 190, 67, 76, 203,
-			94, 76, 82, 98, 163, 148, 209, 133, 130, 191, 127, 206, 52, 45,
-			192, 210, 117, 210, 228, 231, 236, 128, 26, 135, 154, 86, 254, 29,
-			98, 126, 130, 140, 199, 67, 211, 28, 14, 85, 72, 42, 8, 84,
-			12, 237, 75, 42, 12, 168, 24, 59, 192, 70, 144, 75, 137, 101,
-			254, 42, 161, 19, 108, 20, 153, 140, 152, 86, 254, 87, 9, 240,
-			149, 98, 80, 146, 199, 231, 67, 186, 136, 205, 217, 1, 93, 52,
-			160, 56, 126, 136, 253, 30, 97, 160, 100, 229, 63, 75, 6, 254,
-			144, 16, 251, 159, 145, 211, 140, 47, 120, 152, 171, 187, 229, 54,
-			122, 78, 146, 254, 187, 19, 235, 132, 113, 142, 169, 140, 105, 117,
-			69, 160, 236, 206, 40, 112, 188, 16, 83, 165, 64, 113, 136, 117,
-			88, 94, 137, 18, 69, 28, 89, 57, 100, 169, 248, 77, 124, 7,
-			68, 34, 151, 177, 241, 189, 104, 207, 148, 250, 204, 242, 5, 193,
-			99, 152, 160, 75, 124, 150, 20, 198, 216, 63, 129, 37, 102, 210,
-			1, 203, 252, 60, 161, 103, 236, 127, 144, 177, 6, 180, 238, 10,
-			26, 103, 114, 68, 65, 203, 69, 141, 156, 122, 14, 123, 73, 124,
-			130, 118, 79, 181, 185, 24, 43, 181, 69, 169, 87, 133, 126, 123,
-			75, 169, 85, 137, 190, 155, 28, 133, 208, 185, 101, 87, 227, 1,
-			97, 230, 76, 88, 25, 249, 207, 19, 250, 89, 98, 225, 220, 152,
-			32, 233, 1, 124, 91, 23, 9, 20, 143, 60, 173, 139, 6, 20,
-			103, 78, 75, 195, 7, 4, 185, 249, 5, 66, 237, 216, 84, 87,
-			7, 13, 84, 150, 125, 202, 160, 92, 221, 203, 116, 215, 246, 105,
-			108, 72, 74, 3, 53, 62, 38, 169, 55, 1, 238, 128, 206, 46,
-			103, 28, 246, 202, 64, 104, 95, 131, 114, 219, 49, 180, 232, 181,
-			66, 20, 83, 74, 153, 247, 202, 132, 210, 86, 112, 67, 132, 110,
-			203, 3, 121, 213, 243, 156, 206, 134, 82, 227, 218, 96, 75, 249,
-			65, 67, 168, 125, 93, 146, 7, 24, 251, 11, 132, 126, 158, 156,
-			81, 4, 128, 85, 252, 5, 66, 11, 186, 136, 4, 24, 58, 164,
-			139, 6, 20, 39, 15, 179, 255, 81, 146, 135, 90, 230, 123, 64,
-			158, 175, 61, 140, 60, 160, 196, 168, 131, 52, 123, 144, 167, 159,
-			54, 138, 20, 176, 180, 117, 90, 124, 6, 119, 48, 193, 21, 177,
-			65, 145, 144, 29, 51, 14, 166, 249, 99, 19, 34, 166, 67, 198,
-			37, 160, 141, 17, 73, 25, 16, 34, 239, 17, 250, 5, 162, 57,
-			5, 68, 200, 123, 9, 101, 128, 53, 222, 75, 40, 3, 226, 227,
-			61, 160, 204, 55, 40, 82, 198, 176, 204, 127, 78, 232, 132, 253,
-			123, 84, 45, 146, 62, 45, 71, 75, 87, 55, 8, 99, 237, 79,
-			10, 130, 248, 36, 103, 172, 34, 0, 169, 196, 189, 232, 82, 198,
-			35, 37, 181, 174, 244, 209, 24, 217, 151, 218, 193, 26, 216, 160,
-			204, 151, 84, 51, 183, 142, 231, 11, 90, 174, 167, 244, 229, 8,
-			55, 157, 50, 83, 138, 76, 182, 243, 141, 157, 40, 94, 203, 153,
-			222, 241, 129, 34, 88, 60, 146, 52, 165, 99, 77, 32, 219, 85,
-			6, 196, 68, 88, 215, 226, 46, 99, 193, 190, 233, 168, 211, 43,
-			18, 66, 9, 158, 154, 13, 195, 180, 242, 255, 156, 208, 247, 226,
-			217, 48, 114, 72, 96, 61, 27, 6, 129, 226, 208, 1, 93, 68,
-			242, 143, 31, 98, 17, 76, 70, 97, 192, 202, 255, 46, 161, 127,
-			64, 12, 187, 33, 103, 67, 19, 92, 129, 21, 31, 31, 146, 80,
-			129, 54, 16, 201, 235, 9, 120, 215, 239, 246, 218, 177, 42, 134,
-			14, 13, 198, 59, 78, 84, 223, 212, 130, 235, 84, 200, 239, 166,
-			66, 105, 119, 149, 229, 102, 22, 64, 180, 252, 46, 41, 140, 178,
-			57, 228, 8, 211, 50, 255, 21, 49, 15, 218, 79, 74, 3, 70,
-			50, 238, 37, 156, 160, 80, 31, 116, 0, 177, 172, 148, 32, 19,
-			204, 113, 120, 67, 163, 8, 98, 248, 95, 193, 142, 165, 138, 6,
-			20, 199, 44, 86, 194, 222, 115, 150, 249, 85, 98, 62, 97, 31,
-			207, 42, 166, 151, 100, 222, 121, 40, 80, 143, 136, 187, 206, 229,
-			177, 57, 211, 69, 2, 197, 97, 77, 189, 156, 1, 197, 241, 9,
-			118, 6, 187, 206, 91, 230, 239, 19, 243, 136, 125, 172, 95, 215,
-			187, 20, 87, 132, 113, 207, 121, 217, 122, 68, 23, 9, 20, 247,
-			233, 85, 146, 55, 160, 56, 105, 179, 31, 80, 70, 205, 156, 149,
-			255, 6, 25, 248, 29, 74, 236, 239, 80, 233, 139, 173, 196, 135,
-			151, 60, 125, 144, 201, 139, 124, 244, 45, 68, 179, 129, 8, 35,
-			181, 83, 224, 249, 20, 109, 121, 166, 60, 124, 32, 17, 240, 164,
-			23, 190, 235, 4, 66, 31, 150, 78, 178, 61, 228, 49, 45, 23,
-			24, 47, 107, 190, 163, 251, 207, 139, 157, 69, 253, 71, 66, 240,
-			90, 4, 57, 83, 245, 196, 56, 142, 37, 120, 51, 112, 58, 34,
-			44, 39, 42, 30, 112, 73, 87, 57, 132, 79, 161, 212, 113, 235,
-			114, 191, 143, 179, 172, 64, 48, 202, 17, 74, 202, 5, 169, 140,
-			35, 183, 35, 112, 121, 71, 190, 244, 79, 98, 231, 176, 51, 202,
-			169, 213, 155, 104, 250, 112, 78, 22, 224, 141, 182, 191, 161, 118,
-			111, 152, 219, 111, 192, 238, 253, 111, 64, 100, 231, 96, 247, 254,
-			14, 161, 83, 246, 239, 43, 145, 189, 71, 108, 41, 217, 86, 83,
-			93, 246, 139, 110, 189, 178, 97, 50, 98, 31, 150, 218, 151, 246,
-			234, 83, 223, 91, 229, 40, 39, 15, 175, 59, 30, 227, 175, 121,
-			254, 246, 46, 7, 59, 140, 170, 29, 123, 48, 109, 13, 127, 219,
-			107, 251, 78, 108, 17, 227, 192, 74, 52, 228, 112, 135, 255, 14,
-			161, 223, 80, 59, 124, 14, 119, 248, 239, 16, 122, 72, 23, 9,
-			20, 39, 108, 93, 52, 160, 120, 236, 56, 251, 47, 144, 30, 198,
-			128, 149, 255, 46, 161, 159, 162, 134, 253, 155, 132, 113, 57, 153,
-			114, 190, 93, 175, 213, 214, 88, 166, 84, 52, 145, 28, 177, 171,
-			251, 157, 174, 143, 254, 168, 102, 134, 65, 212, 198, 165, 124, 6,
-			117, 63, 144, 167, 64, 27, 234, 178, 44, 135, 165, 172, 98, 30,
-			122, 78, 55, 220, 244, 17, 243, 221, 174, 63, 185, 68, 115, 96,
-			84, 152, 223, 37, 108, 20, 236, 156, 60, 148, 97, 34, 191, 79,
-			204, 9, 251, 117, 9, 84, 90, 100, 43, 206, 16, 234, 116, 70,
-			106, 22, 213, 0, 85, 81, 247, 131, 70, 101, 69, 237, 56, 202,
-			158, 97, 9, 59, 239, 130, 25, 217, 65, 111, 71, 160, 104, 231,
-			164, 217, 145, 255, 62, 49, 191, 75, 14, 160, 26, 157, 147, 134,
-			7, 128, 197, 146, 10, 2, 21, 195, 169, 22, 6, 84, 128, 114,
-			76, 21, 38, 196, 50, 127, 76, 204, 73, 251, 159, 190, 239, 189,
-			242, 23, 182, 53, 202, 45, 7, 247, 199, 191, 57, 91, 163, 158,
-			6, 80, 226, 126, 76, 204, 239, 147, 137, 152, 200, 160, 198, 253,
-			56, 61, 13, 4, 137, 60, 124, 48, 169, 48, 160, 98, 226, 9,
-			246, 95, 106, 134, 162, 150, 249, 83, 98, 30, 181, 255, 211, 148,
-			47, 91, 130, 168, 114, 158, 49, 89, 15, 184, 35, 137, 119, 61,
-			36, 30, 179, 177, 19, 187, 115, 65, 142, 37, 17, 153, 88, 87,
-			143, 185, 77, 105, 97, 142, 18, 0, 44, 246, 246, 106, 205, 47,
-			21, 201, 210, 72, 131, 126, 246, 83, 98, 254, 152, 76, 198, 40,
-			193, 38, 248, 211, 52, 210, 192, 89, 63, 37, 195, 79, 36, 21,
-			6, 84, 216, 71, 216, 231, 52, 210, 134, 101, 254, 12, 144, 254,
-			184, 66, 58, 109, 197, 104, 123, 60, 182, 209, 126, 209, 232, 162,
-			78, 30, 47, 116, 141, 25, 232, 58, 63, 35, 230, 79, 201, 209,
-			24, 110, 208, 118, 126, 150, 198, 12, 244, 157, 159, 165, 49, 51,
-			16, 17, 251, 8, 251, 137, 198, 204, 180, 204, 119, 168, 57, 107,
-			127, 239, 113, 48, 195, 251, 81, 82, 161, 131, 48, 141, 95, 198,
-			84, 75, 194, 164, 167, 194, 140, 149, 166, 244, 166, 20, 238, 242,
-			106, 27, 141, 126, 98, 181, 165, 70, 207, 168, 236, 15, 34, 33,
-			219, 131, 134, 27, 242, 42, 201, 20, 217, 192, 206, 126, 135, 154,
-			63, 75, 145, 13, 52, 168, 119, 168, 153, 170, 0, 171, 159, 30,
-			155, 78, 42, 192, 234, 167, 103, 74, 236, 123, 20, 119, 71, 98,
-			153, 159, 163, 244, 152, 253, 77, 202, 248, 66, 74, 162, 59, 97,
-			93, 160, 44, 156, 69, 211, 65, 52, 212, 78, 161, 52, 199, 48,
-			73, 171, 195, 224, 148, 18, 233, 242, 85, 143, 237, 181, 71, 3,
-			129, 111, 105, 235, 3, 44, 88, 57, 45, 217, 110, 101, 24, 162,
-			40, 103, 173, 88, 226, 197, 116, 246, 67, 177, 196, 120, 49, 157,
-			235, 160, 18, 59, 139, 169, 228, 6, 53, 45, 97, 28, 195, 136,
-			17, 209, 155, 89, 51, 144, 210, 118, 103, 247, 232, 218, 113, 214,
-			16, 77, 215, 19, 141, 203, 220, 149, 115, 213, 213, 188, 16, 235,
-			82, 140, 119, 3, 191, 142, 33, 41, 159, 215, 55, 125, 63, 20,
-			220, 73, 186, 214, 187, 24, 58, 112, 63, 71, 105, 92, 204, 67,
-			113, 120, 76, 23, 145, 250, 7, 38, 117, 209, 128, 226, 145, 163,
-			236, 247, 164, 230, 66, 45, 243, 93, 74, 167, 164, 23, 165, 22,
-			251, 135, 164, 45, 168, 181, 197, 221, 251, 103, 204, 198, 201, 205,
-			89, 149, 166, 242, 184, 69, 168, 212, 131, 92, 147, 135, 229, 60,
-			63, 19, 221, 119, 54, 252, 94, 20, 187, 162, 88, 102, 172, 18,
-			151, 155, 38, 30, 202, 150, 215, 130, 197, 6, 171, 2, 35, 14,
-			43, 75, 69, 5, 36, 214, 187, 148, 126, 142, 30, 83, 8, 130,
-			188, 122, 151, 42, 27, 38, 135, 210, 234, 93, 58, 164, 21, 21,
-			16, 77, 239, 210, 99, 199, 53, 250, 134, 101, 126, 113, 55, 250,
-			153, 59, 18, 254, 154, 209, 79, 143, 245, 24, 232, 103, 12, 234,
-			28, 138, 181, 47, 82, 250, 46, 157, 82, 8, 130, 80, 251, 98,
-			130, 62, 136, 180, 47, 38, 232, 27, 136, 239, 177, 227, 236, 15,
-			37, 250, 166, 101, 190, 7, 43, 243, 95, 104, 244, 179, 71, 196,
-			31, 52, 246, 47, 4, 125, 57, 20, 235, 27, 235, 253, 147, 0,
-			68, 212, 123, 148, 126, 49, 38, 129, 153, 67, 172, 52, 9, 64,
-			60, 189, 71, 135, 244, 2, 0, 225, 244, 30, 61, 114, 52, 78,
-			58, 253, 127, 61, 118, 243, 175, 118, 127, 15, 252, 219, 119, 125,
-			207, 47, 238, 70, 32, 251, 253, 102, 196, 254, 213, 83, 97, 255,
-			142, 193, 216, 13, 17, 85, 229, 109, 43, 214, 36, 27, 236, 6,
-			254, 171, 162, 30, 169, 204, 78, 93, 180, 44, 102, 118, 157, 104,
-			83, 231, 192, 194, 111, 107, 156, 229, 16, 122, 149, 191, 40, 11,
-			73, 250, 163, 137, 39, 209, 85, 250, 227, 49, 198, 64, 193, 75,
-			165, 182, 229, 170, 67, 80, 131, 105, 109, 214, 17, 54, 212, 246,
-			91, 234, 105, 30, 159, 22, 218, 126, 75, 62, 60, 201, 246, 123,
-			190, 119, 39, 49, 27, 49, 63, 181, 80, 221, 231, 249, 94, 18,
-			104, 181, 42, 108, 180, 37, 162, 59, 242, 248, 220, 157, 94, 208,
-			14, 39, 11, 152, 154, 246, 164, 190, 153, 40, 193, 180, 188, 230,
-			182, 188, 245, 234, 146, 42, 86, 247, 181, 68, 36, 19, 201, 215,
-			131, 118, 104, 247, 216, 254, 108, 3, 235, 34, 43, 180, 221, 38,
-			30, 1, 121, 116, 26, 102, 220, 212, 154, 96, 121, 201, 195, 72,
-			184, 66, 85, 149, 18, 34, 41, 210, 201, 253, 231, 195, 108, 184,
-			230, 184, 237, 95, 224, 108, 20, 127, 64, 217, 48, 162, 45, 175,
-			209, 121, 72, 159, 37, 253, 190, 76, 121, 157, 216, 251, 58, 39,
-			61, 203, 58, 209, 210, 120, 204, 68, 203, 167, 152, 9, 28, 63,
-			105, 98, 150, 229, 104, 210, 94, 101, 34, 195, 67, 235, 111, 177,
-			225, 244, 236, 201, 196, 194, 227, 153, 217, 147, 104, 148, 147, 185,
-			170, 178, 48, 153, 183, 45, 198, 146, 39, 214, 37, 198, 240, 158,
-			13, 156, 148, 56, 37, 244, 193, 217, 212, 169, 214, 125, 19, 55,
-			180, 247, 196, 13, 233, 137, 251, 79, 114, 108, 228, 195, 61, 17,
-			236, 252, 130, 23, 18, 2, 169, 110, 168, 146, 5, 76, 70, 23,
-			247, 228, 18, 26, 170, 226, 111, 107, 138, 13, 119, 156, 123, 119,
-			2, 17, 246, 218, 81, 168, 214, 15, 235, 56, 247, 170, 178, 102,
-			87, 230, 57, 219, 157, 121, 126, 61, 155, 208, 46, 211, 117, 79,
-			106, 218, 167, 145, 75, 165, 183, 95, 119, 219, 145, 8, 50, 73,
-			238, 243, 44, 231, 137, 109, 17, 168, 44, 222, 135, 209, 91, 54,
-			132, 55, 252, 118, 67, 4, 147, 251, 30, 253, 6, 54, 220, 125,
-			251, 217, 254, 61, 110, 63, 59, 167, 18, 226, 71, 145, 227, 142,
-			239, 137, 73, 127, 42, 252, 133, 248, 90, 178, 49, 76, 232, 63,
-			186, 247, 91, 1, 218, 181, 241, 165, 101, 151, 217, 88, 63, 73,
-			172, 83, 233, 220, 245, 61, 79, 6, 200, 231, 127, 249, 172, 250,
-			19, 108, 80, 1, 98, 21, 152, 121, 101, 165, 118, 115, 108, 192,
-			26, 100, 198, 203, 139, 107, 99, 196, 202, 51, 186, 188, 50, 70,
-			139, 159, 163, 108, 159, 2, 254, 145, 18, 224, 25, 54, 168, 12,
-			71, 149, 12, 221, 143, 190, 94, 124, 216, 168, 170, 27, 199, 44,
-			105, 36, 44, 105, 191, 75, 88, 94, 182, 139, 57, 158, 164, 56,
-			254, 175, 87, 216, 28, 99, 12, 254, 189, 147, 44, 159, 145, 234,
-			16, 212, 224, 5, 35, 231, 254, 33, 97, 230, 18, 200, 155, 50,
-			51, 110, 136, 200, 178, 118, 239, 15, 246, 193, 61, 164, 142, 53,
-			207, 76, 144, 207, 86, 252, 48, 37, 173, 247, 126, 227, 2, 203,
-			33, 237, 172, 241, 189, 56, 201, 62, 180, 39, 129, 95, 252, 218,
-			199, 100, 202, 243, 231, 31, 158, 242, 252, 55, 237, 110, 185, 106,
-			146, 242, 252, 1, 252, 73, 45, 131, 169, 68, 104, 195, 50, 134,
-			7, 79, 177, 255, 134, 202, 4, 227, 241, 129, 15, 19, 251, 119,
-			41, 79, 102, 68, 59, 125, 212, 197, 112, 234, 62, 184, 94, 144,
-			28, 133, 234, 133, 34, 128, 23, 184, 86, 184, 226, 236, 155, 248,
-			173, 172, 51, 79, 220, 115, 195, 40, 44, 113, 71, 37, 173, 166,
-			6, 147, 89, 192, 189, 122, 93, 8, 60, 36, 223, 114, 130, 6,
-			30, 9, 243, 155, 124, 123, 83, 196, 55, 131, 100, 251, 197, 11,
-			252, 49, 5, 37, 78, 80, 3, 24, 150, 253, 72, 100, 124, 64,
-			18, 60, 188, 31, 36, 16, 81, 47, 240, 120, 19, 36, 33, 244,
-			161, 14, 157, 37, 253, 54, 100, 188, 83, 170, 216, 76, 119, 236,
-			182, 221, 104, 135, 235, 79, 20, 120, 78, 155, 215, 125, 47, 140,
-			2, 199, 245, 50, 119, 228, 141, 23, 44, 86, 214, 57, 211, 19,
-			244, 144, 253, 36, 242, 140, 38, 162, 90, 249, 50, 66, 44, 133,
-			91, 58, 9, 121, 130, 142, 143, 167, 146, 144, 39, 50, 73, 200,
-			19, 67, 99, 169, 36, 228, 137, 131, 227, 236, 95, 82, 157, 132,
-			60, 69, 45, 251, 31, 81, 28, 10, 150, 251, 110, 191, 44, 140,
-			216, 18, 73, 92, 27, 24, 74, 89, 17, 96, 100, 235, 124, 70,
-			213, 88, 246, 33, 41, 190, 118, 115, 225, 220, 197, 103, 248, 166,
-			19, 110, 74, 183, 177, 206, 115, 77, 181, 133, 110, 215, 252, 142,
-			224, 189, 8, 8, 5, 6, 5, 208, 186, 233, 122, 13, 222, 117,
-			194, 80, 134, 45, 144, 121, 29, 233, 57, 87, 227, 225, 64, 17,
-			230, 191, 214, 209, 102, 9, 253, 142, 96, 122, 14, 220, 40, 228,
-			242, 58, 6, 116, 50, 238, 32, 212, 126, 23, 201, 7, 221, 234,
-			62, 1, 76, 132, 207, 245, 194, 72, 56, 13, 153, 2, 19, 8,
-			48, 109, 182, 132, 156, 101, 121, 181, 175, 27, 101, 206, 210, 77,
-			209, 137, 67, 169, 124, 230, 169, 76, 62, 243, 84, 38, 159, 121,
-			106, 236, 0, 171, 232, 124, 230, 34, 61, 96, 63, 159, 228, 128,
-			168, 169, 220, 243, 38, 179, 83, 161, 202, 197, 193, 43, 26, 128,
-			247, 68, 35, 115, 139, 95, 145, 78, 89, 169, 91, 252, 138, 242,
-			80, 129, 74, 112, 46, 14, 142, 164, 18, 156, 139, 163, 99, 42,
-			167, 218, 176, 140, 147, 212, 82, 57, 213, 174, 231, 202, 76, 190,
-			84, 0, 72, 186, 65, 253, 152, 8, 153, 204, 225, 147, 180, 120,
-			32, 149, 57, 124, 50, 62, 66, 8, 178, 226, 100, 124, 132, 16,
-			100, 196, 201, 177, 3, 236, 127, 163, 58, 115, 120, 150, 62, 97,
-			255, 91, 201, 102, 29, 231, 158, 219, 233, 117, 82, 86, 45, 24,
-			23, 161, 26, 179, 23, 120, 101, 125, 103, 153, 180, 93, 165, 43,
-			70, 39, 57, 195, 138, 101, 169, 37, 4, 175, 97, 38, 95, 223,
-			1, 103, 189, 64, 35, 117, 20, 52, 166, 159, 202, 206, 240, 218,
-			122, 69, 135, 169, 164, 72, 176, 25, 249, 130, 254, 132, 9, 246,
-			129, 199, 11, 180, 140, 64, 104, 64, 226, 48, 45, 14, 156, 136,
-			183, 133, 19, 70, 120, 22, 20, 232, 56, 45, 182, 132, 199, 221,
-			38, 180, 220, 114, 253, 118, 124, 189, 25, 166, 26, 37, 128, 207,
-			200, 147, 167, 33, 227, 29, 199, 219, 225, 78, 67, 94, 160, 36,
-			103, 35, 4, 60, 213, 221, 25, 250, 82, 33, 113, 15, 68, 156,
-			204, 14, 144, 249, 38, 170, 167, 76, 146, 243, 44, 61, 105, 165,
-			174, 53, 155, 141, 103, 200, 36, 150, 49, 91, 176, 82, 73, 206,
-			179, 135, 38, 216, 111, 80, 157, 228, 124, 129, 78, 216, 239, 60,
-			104, 134, 80, 100, 98, 36, 37, 204, 74, 160, 56, 243, 44, 78,
-			110, 144, 147, 230, 249, 234, 162, 147, 212, 76, 197, 142, 57, 57,
-			149, 229, 236, 187, 120, 96, 61, 57, 203, 27, 119, 147, 118, 121,
-			232, 30, 226, 233, 76, 68, 82, 114, 123, 19, 227, 77, 17, 213,
-			55, 211, 76, 157, 120, 114, 195, 152, 156, 216, 8, 197, 139, 183,
-			147, 198, 47, 147, 99, 125, 129, 206, 62, 161, 243, 168, 145, 70,
-			131, 169, 28, 235, 11, 133, 3, 169, 28, 235, 11, 227, 135, 216,
-			199, 77, 157, 99, 125, 133, 218, 246, 159, 27, 153, 251, 15, 117,
-			142, 176, 220, 122, 180, 92, 143, 185, 30, 57, 62, 21, 111, 77,
-			192, 225, 11, 60, 83, 47, 95, 156, 110, 136, 166, 211, 107, 71,
-			51, 42, 157, 47, 194, 224, 175, 190, 121, 57, 76, 157, 157, 233,
-			73, 122, 51, 46, 99, 206, 192, 118, 97, 228, 119, 129, 71, 83,
-			55, 103, 9, 204, 162, 79, 197, 129, 133, 135, 51, 136, 46, 224,
-			216, 253, 227, 226, 242, 195, 212, 44, 47, 43, 51, 202, 124, 129,
-			47, 167, 173, 252, 24, 82, 132, 47, 16, 29, 127, 75, 93, 73,
-			137, 169, 209, 44, 185, 52, 108, 215, 21, 246, 161, 179, 211, 191,
-			11, 1, 31, 129, 14, 192, 253, 230, 37, 198, 95, 57, 95, 226,
-			23, 74, 252, 153, 18, 127, 246, 99, 15, 34, 16, 166, 27, 74,
-			148, 207, 107, 24, 128, 208, 151, 228, 219, 31, 43, 33, 21, 186,
-			128, 199, 134, 168, 59, 189, 80, 48, 126, 17, 15, 104, 72, 236,
-			0, 161, 93, 115, 146, 193, 8, 167, 45, 13, 74, 38, 149, 253,
-			10, 189, 48, 145, 74, 101, 191, 18, 139, 231, 60, 177, 140, 43,
-			131, 135, 82, 169, 236, 87, 38, 15, 179, 127, 75, 244, 45, 171,
-			55, 232, 138, 97, 255, 183, 120, 121, 166, 158, 187, 146, 82, 97,
-			212, 13, 187, 56, 190, 202, 158, 227, 14, 79, 12, 233, 56, 45,
-			41, 185, 50, 45, 209, 79, 164, 245, 205, 215, 171, 75, 33, 174,
-			189, 84, 25, 165, 156, 231, 71, 56, 160, 31, 164, 146, 8, 48,
-			166, 207, 226, 239, 116, 232, 11, 51, 67, 252, 8, 73, 31, 88,
-			201, 224, 114, 153, 98, 6, 117, 230, 214, 215, 27, 108, 146, 221,
-			76, 110, 125, 173, 152, 167, 237, 231, 84, 86, 182, 116, 195, 36,
-			27, 97, 2, 93, 220, 223, 134, 220, 179, 253, 50, 110, 234, 217,
-			203, 94, 43, 230, 141, 228, 198, 210, 129, 60, 244, 125, 52, 115,
-			217, 107, 229, 216, 201, 204, 101, 175, 149, 233, 25, 246, 98, 114,
-			217, 235, 146, 57, 110, 95, 230, 85, 37, 212, 211, 163, 167, 46,
-			178, 61, 21, 38, 241, 228, 248, 178, 98, 117, 188, 38, 125, 183,
-			235, 146, 89, 57, 157, 185, 219, 117, 41, 117, 59, 40, 104, 8,
-			75, 67, 163, 153, 187, 93, 151, 172, 131, 108, 49, 185, 219, 117,
-			217, 60, 104, 63, 243, 24, 192, 196, 121, 36, 177, 1, 158, 189,
-			198, 117, 217, 92, 26, 207, 92, 227, 186, 156, 130, 3, 176, 94,
-			30, 218, 159, 185, 198, 117, 249, 128, 133, 25, 216, 3, 116, 208,
-			50, 86, 233, 73, 197, 206, 131, 166, 101, 174, 210, 21, 125, 211,
-			231, 96, 30, 30, 106, 157, 114, 144, 88, 198, 234, 1, 174, 75,
-			134, 101, 172, 62, 117, 130, 253, 125, 34, 143, 102, 172, 15, 52,
-			136, 253, 107, 132, 167, 236, 175, 199, 180, 15, 224, 141, 196, 64,
-			224, 149, 72, 239, 215, 44, 73, 153, 149, 129, 91, 238, 240, 150,
-			11, 187, 110, 58, 63, 70, 114, 81, 250, 152, 81, 178, 107, 233,
-			35, 33, 235, 133, 131, 168, 115, 99, 194, 252, 173, 199, 215, 185,
-			49, 167, 222, 188, 69, 215, 227, 196, 249, 28, 188, 95, 72, 37,
-			213, 223, 82, 58, 183, 76, 170, 191, 165, 117, 110, 2, 84, 191,
-			251, 75, 157, 251, 253, 233, 220, 4, 87, 213, 93, 122, 75, 211,
-			27, 214, 212, 221, 152, 222, 48, 149, 119, 149, 206, 77, 112, 61,
-			221, 85, 58, 55, 129, 213, 84, 255, 133, 232, 220, 4, 87, 84,
-			157, 222, 181, 212, 56, 176, 158, 234, 74, 168, 19, 92, 77, 117,
-			165, 115, 19, 92, 75, 245, 209, 49, 182, 130, 7, 41, 114, 173,
-			129, 207, 16, 98, 95, 225, 41, 127, 67, 178, 8, 50, 183, 166,
-			63, 202, 74, 214, 103, 46, 90, 5, 16, 24, 234, 4, 132, 75,
-			15, 217, 31, 224, 171, 138, 91, 147, 203, 212, 177, 136, 231, 59,
-			53, 170, 161, 162, 239, 134, 192, 111, 74, 69, 190, 66, 78, 30,
-			143, 112, 105, 235, 80, 234, 120, 132, 171, 8, 44, 143, 71, 184,
-			138, 161, 229, 241, 8, 247, 224, 184, 220, 177, 16, 113, 159, 30,
-			177, 191, 70, 250, 83, 212, 82, 199, 140, 165, 206, 161, 244, 147,
-			190, 43, 221, 97, 101, 43, 13, 46, 217, 171, 54, 118, 120, 40,
-			162, 248, 96, 148, 122, 112, 42, 228, 242, 58, 250, 56, 2, 15,
-			83, 170, 239, 66, 103, 242, 86, 90, 245, 16, 200, 160, 242, 236,
-			133, 124, 235, 84, 200, 211, 153, 95, 201, 81, 8, 216, 248, 54,
-			146, 84, 150, 152, 38, 192, 116, 62, 117, 53, 77, 72, 30, 48,
-			213, 84, 0, 166, 243, 15, 76, 232, 146, 97, 25, 254, 97, 155,
-			253, 95, 146, 38, 212, 50, 182, 233, 73, 251, 71, 146, 38, 226,
-			94, 215, 241, 26, 162, 177, 103, 182, 88, 44, 186, 227, 75, 253,
-			34, 225, 233, 207, 26, 190, 184, 182, 178, 44, 173, 157, 94, 167,
-			171, 85, 37, 229, 40, 73, 124, 32, 152, 31, 157, 193, 60, 125,
-			93, 182, 222, 61, 227, 252, 206, 203, 76, 94, 181, 42, 191, 22,
-			131, 123, 173, 254, 14, 82, 35, 217, 214, 244, 107, 250, 242, 82,
-			21, 8, 79, 32, 199, 33, 89, 230, 156, 37, 197, 53, 178, 77,
-			253, 35, 138, 44, 160, 10, 111, 211, 184, 68, 44, 99, 251, 40,
-			215, 37, 195, 50, 182, 159, 58, 193, 254, 22, 147, 73, 197, 198,
-			125, 250, 148, 125, 14, 207, 169, 167, 46, 89, 148, 224, 97, 194,
-			128, 22, 17, 141, 62, 117, 93, 118, 103, 152, 208, 67, 92, 202,
-			91, 198, 253, 225, 195, 186, 68, 44, 227, 190, 125, 92, 151, 96,
-			172, 39, 139, 236, 67, 48, 176, 49, 96, 229, 222, 196, 99, 52,
-			207, 243, 155, 126, 27, 239, 216, 218, 51, 46, 156, 145, 17, 114,
-			67, 150, 153, 50, 235, 213, 37, 13, 4, 234, 56, 111, 178, 113,
-			118, 153, 229, 161, 132, 31, 161, 49, 103, 237, 210, 174, 239, 13,
-			184, 225, 46, 5, 71, 94, 52, 174, 118, 112, 170, 212, 154, 183,
-			205, 55, 229, 13, 224, 84, 169, 53, 111, 155, 199, 146, 50, 177,
-			140, 183, 143, 79, 39, 101, 195, 50, 222, 62, 83, 98, 151, 212,
-			232, 196, 50, 63, 73, 204, 9, 251, 180, 58, 234, 130, 227, 165,
-			22, 232, 122, 117, 169, 4, 86, 64, 188, 236, 202, 12, 79, 189,
-			80, 117, 234, 229, 147, 196, 124, 123, 54, 6, 135, 228, 176, 187,
-			4, 62, 130, 253, 171, 124, 55, 170, 18, 173, 62, 73, 198, 15,
-			177, 231, 20, 0, 242, 212, 203, 33, 123, 166, 31, 0, 233, 101,
-			216, 107, 252, 3, 170, 47, 117, 178, 229, 147, 42, 211, 139, 38,
-			39, 91, 18, 0, 228, 201, 150, 225, 177, 164, 2, 79, 182, 28,
-			28, 103, 93, 38, 243, 218, 205, 79, 19, 122, 204, 222, 224, 11,
-			94, 28, 21, 79, 131, 33, 39, 112, 183, 74, 183, 11, 48, 190,
-			229, 58, 140, 23, 225, 85, 117, 241, 71, 47, 104, 223, 209, 74,
-			107, 81, 133, 189, 41, 134, 189, 63, 157, 156, 158, 161, 152, 151,
-			243, 105, 66, 71, 116, 145, 64, 113, 223, 164, 46, 26, 80, 60,
-			114, 148, 85, 241, 164, 89, 254, 239, 146, 129, 127, 71, 136, 125,
-			141, 167, 93, 207, 143, 169, 46, 225, 43, 233, 173, 98, 88, 158,
-			71, 51, 255, 46, 41, 140, 179, 139, 204, 52, 13, 115, 192, 202,
-			255, 58, 161, 191, 69, 12, 251, 36, 87, 17, 137, 236, 133, 166,
-			145, 170, 148, 7, 214, 229, 162, 70, 247, 164, 249, 235, 100, 80,
-			30, 238, 52, 208, 67, 105, 254, 199, 196, 220, 103, 63, 195, 175,
-			248, 209, 102, 114, 121, 22, 136, 249, 248, 246, 44, 21, 227, 218,
-			109, 180, 171, 115, 83, 210, 57, 9, 29, 21, 146, 10, 10, 21,
-			195, 35, 200, 65, 134, 252, 218, 209, 111, 16, 115, 196, 158, 225,
-			43, 94, 123, 39, 25, 233, 49, 58, 39, 242, 221, 193, 164, 130,
-			66, 5, 27, 142, 59, 167, 150, 249, 155, 196, 28, 214, 157, 191,
-			31, 200, 1, 176, 223, 36, 102, 62, 169, 192, 206, 134, 24, 166,
-			192, 27, 64, 161, 223, 38, 143, 167, 80, 142, 234, 115, 128, 249,
-			223, 198, 185, 209, 7, 3, 115, 216, 69, 65, 23, 9, 20, 135,
-			198, 116, 209, 128, 226, 193, 113, 246, 221, 130, 62, 196, 245, 37,
-			66, 45, 251, 27, 5, 28, 80, 158, 40, 239, 58, 129, 211, 17,
-			145, 8, 116, 6, 20, 42, 112, 234, 72, 61, 136, 55, 80, 251,
-			194, 222, 70, 24, 185, 81, 47, 2, 197, 178, 213, 246, 55, 248,
-			116, 241, 116, 17, 191, 94, 152, 78, 233, 131, 87, 153, 76, 3,
-			246, 48, 191, 62, 254, 170, 161, 227, 197, 103, 67, 81, 205, 192,
-			35, 3, 66, 243, 108, 199, 113, 61, 229, 42, 80, 92, 251, 122,
-			207, 105, 187, 77, 60, 234, 147, 245, 214, 131, 2, 169, 220, 72,
-			234, 242, 217, 100, 116, 156, 119, 92, 190, 122, 191, 129, 53, 221,
-			243, 208, 36, 196, 211, 246, 237, 70, 221, 9, 26, 136, 146, 211,
-			237, 10, 39, 208, 215, 237, 42, 136, 181, 69, 160, 252, 28, 248,
-			185, 61, 84, 26, 186, 201, 17, 27, 212, 149, 36, 237, 226, 247,
-			194, 50, 47, 158, 62, 93, 140, 209, 114, 164, 51, 67, 161, 149,
-			106, 150, 236, 204, 218, 106, 151, 244, 150, 253, 197, 103, 125, 19,
-			179, 29, 159, 134, 2, 102, 9, 20, 129, 233, 226, 153, 226, 76,
-			202, 193, 184, 33, 120, 36, 63, 244, 132, 206, 192, 102, 42, 209,
-			10, 191, 30, 121, 250, 52, 200, 157, 248, 22, 92, 117, 210, 124,
-			17, 79, 45, 79, 23, 139, 51, 25, 47, 133, 19, 127, 11, 38,
-			44, 203, 134, 167, 79, 207, 157, 153, 59, 125, 250, 17, 173, 154,
-			190, 63, 183, 225, 4, 15, 105, 152, 124, 30, 167, 168, 26, 23,
-			227, 116, 176, 190, 46, 230, 206, 204, 109, 56, 247, 31, 216, 17,
-			230, 36, 123, 241, 73, 209, 76, 151, 50, 27, 184, 111, 170, 26,
-			188, 184, 225, 220, 47, 242, 105, 81, 110, 149, 75, 113, 227, 185,
-			215, 123, 247, 230, 218, 126, 91, 14, 87, 156, 201, 130, 241, 48,
-			164, 245, 53, 129, 15, 193, 228, 81, 72, 168, 30, 162, 109, 127,
-			54, 97, 61, 5, 247, 246, 166, 31, 10, 137, 137, 204, 122, 142,
-			157, 166, 48, 96, 17, 241, 194, 54, 146, 17, 161, 30, 16, 200,
-			210, 241, 193, 35, 103, 223, 212, 40, 232, 233, 158, 123, 212, 12,
-			102, 32, 198, 187, 242, 99, 225, 68, 76, 43, 255, 37, 66, 127,
-			155, 28, 210, 199, 62, 115, 40, 111, 10, 169, 67, 161, 95, 210,
-			7, 118, 228, 161, 208, 47, 145, 177, 3, 172, 129, 178, 137, 90,
-			230, 151, 9, 61, 96, 127, 36, 109, 131, 73, 207, 123, 226, 237,
-			145, 160, 156, 82, 167, 35, 250, 109, 176, 216, 86, 244, 155, 252,
-			85, 60, 152, 4, 194, 98, 85, 26, 179, 18, 70, 80, 24, 190,
-			76, 232, 151, 212, 225, 8, 3, 213, 133, 47, 19, 154, 215, 69,
-			2, 197, 193, 17, 93, 52, 160, 56, 58, 198, 62, 141, 167, 169,
-			161, 248, 21, 0, 242, 126, 2, 36, 250, 4, 195, 190, 224, 161,
-			4, 77, 26, 193, 49, 116, 248, 25, 247, 93, 42, 52, 211, 95,
-			194, 136, 65, 111, 136, 84, 51, 249, 5, 82, 45, 22, 19, 98,
-			27, 166, 149, 255, 10, 161, 95, 38, 250, 140, 173, 145, 67, 216,
-			52, 34, 176, 167, 127, 37, 65, 196, 64, 200, 71, 199, 216, 91,
-			136, 135, 105, 153, 95, 133, 141, 160, 203, 151, 197, 189, 8, 181,
-			24, 144, 58, 232, 138, 43, 237, 254, 116, 142, 27, 42, 249, 164,
-			142, 209, 234, 155, 13, 180, 180, 68, 101, 130, 165, 110, 163, 237,
-			6, 98, 203, 245, 123, 250, 181, 182, 104, 130, 110, 222, 140, 161,
-			7, 13, 232, 171, 132, 126, 37, 134, 222, 196, 243, 90, 49, 171,
-			152, 120, 0, 43, 102, 21, 19, 15, 96, 141, 29, 144, 199, 44,
-			13, 152, 179, 63, 34, 116, 18, 172, 201, 151, 226, 164, 24, 173,
-			1, 237, 142, 75, 72, 32, 244, 126, 157, 68, 144, 164, 44, 206,
-			246, 16, 71, 20, 122, 221, 174, 8, 148, 51, 81, 111, 241, 241,
-			199, 85, 203, 252, 166, 191, 45, 182, 68, 80, 210, 238, 83, 53,
-			199, 114, 16, 21, 213, 136, 191, 175, 181, 1, 210, 62, 254, 230,
-			233, 3, 66, 188, 146, 50, 57, 211, 202, 255, 17, 161, 95, 141,
-			25, 52, 39, 145, 29, 212, 69, 2, 197, 194, 65, 93, 52, 160,
-			56, 241, 4, 219, 68, 194, 228, 45, 243, 95, 19, 122, 196, 190,
-			173, 207, 253, 214, 118, 186, 162, 127, 122, 245, 167, 234, 195, 52,
-			73, 118, 201, 233, 36, 32, 208, 119, 4, 90, 194, 153, 55, 173,
-			252, 191, 38, 244, 143, 200, 164, 130, 36, 159, 195, 177, 245, 12,
-			230, 9, 20, 135, 180, 40, 200, 27, 80, 156, 180, 165, 226, 99,
-			12, 88, 249, 175, 17, 250, 13, 98, 232, 3, 246, 201, 221, 104,
-			28, 45, 212, 118, 4, 83, 231, 105, 229, 18, 143, 251, 124, 141,
-			48, 155, 93, 136, 47, 17, 48, 191, 78, 204, 41, 251, 4, 190,
-			159, 164, 225, 40, 105, 217, 215, 201, 129, 228, 94, 128, 252, 215,
-			137, 249, 53, 149, 19, 111, 72, 115, 9, 122, 74, 142, 198, 195,
-			80, 95, 39, 135, 236, 164, 194, 128, 138, 99, 199, 213, 73, 248,
-			65, 203, 252, 38, 161, 39, 20, 29, 6, 77, 43, 255, 77, 68,
-			69, 97, 58, 152, 199, 231, 122, 250, 6, 9, 20, 15, 30, 215,
-			69, 3, 138, 79, 62, 197, 106, 216, 87, 193, 50, 191, 69, 232,
-			41, 251, 58, 95, 198, 132, 129, 135, 78, 77, 93, 239, 243, 205,
-			72, 69, 99, 148, 230, 35, 207, 213, 36, 115, 83, 48, 173, 252,
-			183, 8, 253, 38, 57, 161, 70, 45, 228, 113, 156, 35, 186, 72,
-			160, 120, 244, 73, 93, 52, 160, 120, 226, 105, 182, 142, 48, 13,
-			89, 230, 183, 1, 166, 27, 124, 165, 221, 120, 92, 152, 212, 55,
-			217, 31, 2, 212, 144, 105, 229, 191, 77, 232, 183, 200, 41, 53,
-			236, 80, 30, 7, 210, 64, 13, 17, 40, 198, 64, 13, 25, 80,
-			60, 241, 52, 187, 143, 64, 49, 203, 252, 19, 66, 143, 218, 109,
-			25, 245, 141, 121, 57, 94, 66, 177, 159, 76, 67, 24, 225, 70,
-			39, 183, 187, 93, 159, 12, 148, 247, 181, 176, 140, 22, 26, 235,
-			99, 170, 81, 12, 57, 51, 173, 252, 159, 16, 250, 237, 24, 114,
-			150, 67, 104, 52, 171, 51, 2, 197, 161, 9, 93, 52, 160, 120,
-			248, 8, 251, 62, 69, 208, 135, 45, 243, 79, 9, 229, 246, 255,
-			64, 121, 205, 105, 197, 98, 74, 31, 59, 129, 170, 200, 79, 16,
-			193, 135, 82, 98, 193, 90, 148, 145, 189, 154, 163, 205, 80, 208,
-			92, 99, 39, 239, 37, 198, 103, 249, 66, 234, 118, 41, 124, 15,
-			207, 174, 232, 175, 107, 171, 111, 250, 106, 194, 200, 251, 159, 213,
-			80, 210, 131, 214, 246, 91, 120, 220, 75, 146, 42, 114, 90, 218,
-			197, 165, 196, 127, 210, 123, 215, 113, 131, 114, 60, 164, 82, 98,
-			188, 56, 206, 55, 237, 185, 237, 25, 185, 254, 30, 1, 2, 12,
-			151, 18, 126, 26, 10, 253, 249, 174, 45, 237, 138, 116, 90, 48,
-			80, 233, 65, 22, 64, 60, 67, 195, 166, 149, 255, 83, 66, 255,
-			132, 28, 85, 115, 48, 156, 71, 162, 107, 233, 51, 76, 160, 168,
-			142, 60, 26, 116, 216, 128, 226, 177, 41, 118, 11, 39, 104, 196,
-			50, 127, 64, 232, 132, 93, 225, 171, 242, 131, 97, 9, 199, 39,
-			115, 145, 226, 249, 4, 74, 63, 192, 127, 189, 83, 81, 250, 131,
-			99, 49, 88, 35, 166, 149, 255, 1, 161, 127, 74, 244, 53, 46,
-			35, 121, 28, 74, 223, 146, 49, 66, 160, 200, 180, 181, 54, 98,
-			64, 241, 224, 33, 86, 147, 151, 100, 252, 144, 12, 252, 71, 148,
-			216, 215, 121, 38, 153, 236, 113, 93, 194, 123, 90, 250, 176, 175,
-			254, 144, 20, 14, 161, 219, 27, 111, 174, 248, 17, 216, 159, 151,
-			31, 237, 22, 150, 6, 141, 28, 50, 235, 25, 78, 238, 149, 248,
-			17, 161, 63, 36, 79, 232, 155, 35, 114, 216, 121, 33, 117, 175,
-			196, 143, 180, 101, 42, 239, 149, 248, 17, 88, 166, 87, 1, 16,
-			216, 15, 254, 140, 208, 79, 80, 195, 62, 175, 175, 70, 232, 251,
-			132, 10, 158, 61, 141, 55, 242, 24, 243, 228, 72, 168, 137, 123,
-			196, 159, 1, 57, 203, 44, 15, 69, 64, 239, 39, 196, 28, 183,
-			143, 163, 14, 24, 159, 134, 204, 198, 68, 212, 238, 96, 170, 221,
-			225, 39, 196, 252, 51, 98, 161, 236, 55, 213, 241, 205, 159, 104,
-			111, 146, 169, 118, 135, 159, 232, 75, 92, 76, 181, 59, 252, 132,
-			88, 7, 217, 191, 35, 106, 88, 98, 153, 127, 65, 204, 99, 246,
-			183, 9, 143, 63, 139, 26, 253, 123, 227, 244, 214, 196, 2, 109,
-			255, 47, 136, 249, 19, 117, 169, 12, 86, 229, 17, 243, 132, 122,
-			4, 73, 113, 112, 50, 169, 48, 160, 226, 200, 81, 246, 67, 170,
-			136, 69, 45, 243, 231, 196, 60, 101, 255, 177, 140, 116, 129, 186,
-			59, 219, 117, 234, 175, 101, 125, 144, 141, 61, 60, 225, 40, 24,
-			83, 80, 139, 190, 131, 186, 161, 118, 70, 227, 228, 39, 122, 13,
-			154, 62, 234, 196, 180, 182, 197, 53, 99, 237, 69, 74, 121, 22,
-			173, 212, 119, 216, 79, 235, 132, 44, 241, 152, 199, 95, 91, 221,
-			219, 205, 126, 109, 215, 187, 15, 114, 182, 247, 121, 207, 119, 55,
-			79, 97, 147, 88, 10, 9, 108, 44, 77, 36, 61, 95, 96, 249,
-			252, 156, 152, 127, 65, 142, 197, 179, 1, 234, 226, 207, 137, 153,
-			170, 32, 80, 113, 188, 152, 84, 24, 80, 113, 242, 105, 246, 43,
-			106, 186, 12, 203, 252, 56, 53, 39, 237, 121, 117, 48, 57, 30,
-			61, 53, 89, 123, 133, 45, 18, 56, 192, 112, 249, 56, 53, 127,
-			78, 78, 197, 163, 128, 233, 242, 113, 106, 14, 37, 21, 4, 42,
-			88, 194, 89, 6, 142, 59, 241, 4, 170, 77, 120, 11, 205, 59,
-			148, 30, 183, 175, 171, 172, 0, 249, 121, 185, 140, 124, 198, 175,
-			131, 170, 35, 147, 42, 35, 44, 217, 132, 116, 44, 21, 167, 93,
-			203, 15, 12, 228, 188, 163, 15, 227, 153, 200, 205, 239, 208, 248,
-			18, 8, 130, 131, 90, 135, 83, 55, 191, 188, 67, 143, 30, 99,
-			255, 83, 124, 243, 203, 103, 40, 181, 236, 255, 142, 244, 105, 40,
-			187, 191, 67, 170, 206, 71, 117, 252, 64, 244, 153, 38, 169, 44,
-			83, 192, 44, 76, 54, 205, 189, 164, 64, 32, 186, 194, 137, 229,
-			192, 135, 211, 44, 156, 202, 236, 144, 23, 196, 192, 18, 72, 190,
-			100, 128, 170, 206, 78, 156, 36, 150, 114, 167, 9, 52, 8, 99,
-			247, 89, 114, 245, 203, 103, 40, 125, 135, 30, 79, 93, 253, 242,
-			25, 154, 185, 250, 229, 51, 52, 190, 137, 3, 152, 228, 51, 116,
-			236, 0, 91, 102, 52, 63, 96, 229, 127, 141, 14, 124, 158, 18,
-			251, 87, 228, 205, 189, 74, 24, 194, 30, 53, 219, 116, 234, 42,
-			131, 142, 59, 117, 60, 46, 9, 208, 189, 158, 217, 185, 128, 201,
-			182, 220, 186, 186, 124, 41, 15, 242, 247, 215, 104, 97, 132, 221,
-			96, 102, 30, 69, 252, 103, 41, 45, 217, 207, 97, 8, 84, 59,
-			12, 164, 60, 213, 151, 95, 160, 125, 173, 28, 149, 114, 31, 233,
-			191, 79, 32, 47, 229, 250, 103, 105, 126, 72, 23, 41, 20, 217,
-			184, 46, 26, 80, 156, 58, 13, 26, 67, 94, 250, 155, 41, 45,
-			219, 21, 153, 126, 144, 248, 41, 178, 9, 7, 125, 66, 255, 161,
-			201, 6, 114, 28, 244, 70, 211, 124, 92, 164, 80, 28, 158, 208,
-			69, 3, 138, 79, 150, 216, 50, 66, 65, 45, 243, 183, 40, 61,
-			103, 255, 74, 60, 247, 18, 140, 190, 13, 46, 76, 57, 92, 251,
-			220, 20, 41, 254, 207, 203, 213, 255, 91, 52, 63, 172, 139, 216,
-			255, 200, 164, 46, 26, 80, 124, 106, 94, 31, 190, 251, 255, 2,
-			0, 0, 255, 255, 88, 128, 148, 156, 239, 150, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 127, 144, 100, 71,
+			113, 32, 60, 85, 245, 186, 167, 167, 102, 102, 103, 166, 102, 118,
+			118, 246, 237, 175, 82, 75, 203, 254, 208, 108, 143, 88, 253, 100,
+			37, 48, 187, 218, 149, 52, 66, 172, 150, 222, 149, 249, 225, 112,
+			44, 111, 186, 107, 122, 30, 116, 191, 215, 122, 239, 245, 238, 142,
+			226, 11, 125, 230, 3, 97, 59, 224, 51, 161, 15, 108, 62, 56,
+			126, 4, 214, 113, 28, 248, 56, 34, 14, 19, 65, 152, 179, 195,
+			216, 7, 17, 134, 64, 198, 224, 192, 224, 19, 56, 56, 59, 140,
+			29, 62, 248, 3, 199, 233, 204, 133, 13, 23, 153, 149, 245, 222,
+			235, 158, 89, 237, 46, 150, 125, 225, 139, 251, 71, 218, 172, 174,
+			87, 149, 149, 149, 149, 149, 153, 149, 153, 35, 255, 59, 147, 7,
+			58, 113, 220, 233, 154, 149, 126, 18, 103, 241, 218, 96, 125, 37,
+			11, 123, 38, 205, 130, 94, 191, 129, 77, 106, 198, 118, 104, 184,
+			14, 245, 123, 229, 196, 5, 215, 71, 45, 201, 241, 212, 180, 226,
+			168, 157, 46, 49, 205, 14, 139, 166, 3, 213, 130, 172, 68, 65,
+			20, 167, 75, 92, 179, 195, 149, 166, 5, 78, 61, 41, 231, 91,
+			113, 175, 49, 50, 230, 169, 29, 249, 136, 231, 160, 233, 28, 123,
+			195, 173, 157, 48, 219, 24, 172, 53, 90, 113, 111, 165, 19, 119,
+			131, 168, 83, 160, 216, 207, 54, 251, 38, 45, 48, 253, 59, 198,
+			126, 157, 139, 7, 207, 157, 250, 4, 223, 255, 160, 29, 249, 28,
+			141, 220, 120, 173, 233, 118, 95, 21, 197, 151, 163, 11, 240, 205,
+			90, 21, 7, 185, 93, 254, 202, 46, 185, 218, 137, 27, 173, 141,
+			36, 238, 133, 131, 94, 35, 78, 58, 43, 221, 65, 43, 92, 233,
+			198, 157, 118, 220, 89, 9, 250, 225, 138, 137, 218, 253, 56, 140,
+			178, 116, 165, 21, 199, 73, 59, 140, 130, 44, 78, 160, 67, 186,
+			114, 233, 165, 43, 105, 22, 100, 180, 2, 85, 181, 95, 249, 215,
+			34, 102, 253, 187, 66, 238, 120, 36, 238, 156, 207, 18, 19, 244,
+			206, 195, 8, 234, 102, 57, 141, 221, 47, 94, 50, 73, 26, 198,
+			17, 210, 113, 162, 57, 133, 141, 63, 107, 219, 212, 29, 114, 188,
+			149, 152, 32, 51, 109, 36, 231, 228, 113, 127, 148, 132, 141, 156,
+			130, 77, 215, 85, 29, 148, 59, 50, 147, 244, 194, 40, 232, 94,
+			12, 163, 182, 185, 178, 36, 112, 143, 166, 93, 235, 42, 52, 170,
+			251, 228, 120, 144, 180, 54, 194, 75, 102, 201, 195, 193, 235, 13,
+			187, 158, 198, 48, 170, 141, 147, 182, 215, 106, 180, 30, 55, 221,
+			39, 106, 81, 86, 251, 131, 164, 99, 218, 75, 21, 205, 14, 215,
+			154, 4, 193, 186, 218, 73, 220, 239, 155, 246, 197, 181, 205, 204,
+			164, 75, 85, 156, 123, 138, 26, 79, 65, 155, 58, 36, 103, 92,
+			39, 19, 101, 73, 104, 210, 165, 113, 236, 182, 131, 154, 207, 216,
+			86, 255, 223, 50, 57, 89, 154, 94, 237, 145, 19, 184, 162, 139,
+			131, 164, 75, 20, 171, 97, 195, 99, 73, 87, 237, 147, 50, 69,
+			180, 241, 87, 142, 191, 78, 216, 22, 248, 121, 183, 172, 181, 131,
+			44, 192, 31, 5, 254, 56, 14, 48, 252, 228, 203, 90, 43, 238,
+			245, 187, 38, 179, 180, 168, 53, 115, 88, 189, 68, 206, 116, 227,
+			14, 226, 185, 121, 177, 21, 15, 162, 12, 87, 44, 154, 211, 221,
+			184, 3, 120, 110, 222, 15, 141, 15, 255, 134, 146, 85, 229, 121,
+			99, 119, 51, 249, 25, 38, 217, 148, 18, 222, 152, 58, 254, 9,
+			166, 239, 143, 251, 155, 73, 216, 217, 200, 244, 241, 219, 94, 122,
+			151, 190, 176, 97, 244, 35, 143, 221, 191, 170, 79, 14, 178, 141,
+			56, 73, 27, 250, 100, 183, 171, 177, 67, 170, 19, 147, 154, 228,
+			146, 105, 55, 164, 126, 44, 53, 58, 94, 215, 217, 70, 152, 234,
+			52, 30, 36, 45, 163, 91, 113, 219, 232, 48, 213, 157, 248, 146,
+			73, 34, 211, 214, 131, 168, 109, 18, 157, 109, 24, 125, 178, 31,
+			180, 96, 224, 176, 101, 162, 212, 44, 107, 226, 32, 125, 188, 113,
+			155, 212, 217, 70, 144, 233, 86, 16, 233, 53, 163, 215, 227, 65,
+			212, 214, 97, 132, 95, 61, 178, 122, 255, 153, 179, 231, 207, 232,
+			245, 176, 107, 26, 82, 214, 36, 227, 74, 84, 199, 102, 228, 132,
+			228, 98, 76, 137, 218, 216, 17, 249, 33, 38, 185, 55, 166, 188,
+			233, 177, 187, 153, 255, 52, 211, 195, 204, 1, 232, 4, 122, 45,
+			108, 135, 137, 105, 101, 97, 28, 5, 93, 141, 71, 68, 95, 10,
+			186, 3, 163, 7, 169, 193, 217, 30, 235, 183, 131, 204, 216, 3,
+			160, 91, 65, 183, 155, 54, 164, 220, 102, 44, 211, 91, 51, 237,
+			118, 176, 214, 53, 240, 213, 25, 119, 20, 117, 98, 30, 31, 152,
+			52, 91, 73, 76, 218, 143, 163, 212, 232, 52, 75, 6, 173, 12,
+			70, 145, 82, 120, 99, 76, 137, 233, 218, 162, 60, 45, 61, 111,
+			140, 143, 41, 49, 83, 187, 201, 191, 91, 159, 43, 29, 38, 192,
+			20, 214, 236, 78, 142, 166, 131, 167, 215, 227, 132, 168, 140, 216,
+			53, 164, 156, 146, 21, 24, 165, 2, 195, 236, 112, 16, 83, 98,
+			102, 102, 175, 131, 132, 18, 51, 7, 180, 60, 135, 243, 49, 37,
+			84, 173, 225, 223, 143, 123, 11, 226, 73, 95, 222, 48, 150, 194,
+			221, 184, 67, 227, 234, 203, 1, 236, 111, 39, 76, 51, 147, 152,
+			182, 190, 28, 102, 27, 216, 229, 254, 66, 202, 228, 115, 179, 42,
+			12, 121, 147, 131, 96, 130, 250, 17, 7, 9, 37, 212, 242, 49,
+			121, 9, 231, 230, 74, 44, 214, 110, 242, 67, 156, 155, 102, 194,
+			19, 97, 153, 167, 140, 193, 161, 84, 59, 9, 160, 123, 38, 77,
+			131, 142, 105, 232, 85, 219, 203, 238, 86, 152, 234, 99, 47, 93,
+			150, 249, 119, 72, 148, 176, 219, 165, 1, 194, 168, 147, 99, 200,
+			43, 48, 241, 180, 131, 152, 18, 139, 59, 28, 117, 184, 80, 98,
+			241, 128, 150, 15, 1, 134, 98, 76, 121, 187, 249, 97, 225, 159,
+			208, 165, 147, 172, 91, 113, 148, 5, 97, 148, 106, 18, 40, 186,
+			109, 178, 32, 236, 166, 180, 29, 101, 188, 221, 156, 2, 118, 121,
+			183, 220, 41, 95, 35, 171, 0, 193, 62, 239, 241, 118, 251, 167,
+			112, 237, 246, 6, 208, 231, 179, 56, 9, 58, 70, 63, 214, 124,
+			4, 118, 33, 49, 35, 131, 29, 74, 137, 60, 97, 62, 117, 187,
+			33, 229, 14, 57, 110, 135, 172, 192, 152, 37, 152, 41, 177, 103,
+			114, 161, 128, 133, 18, 123, 118, 45, 201, 159, 35, 20, 152, 18,
+			251, 60, 223, 127, 228, 6, 81, 72, 130, 203, 4, 104, 144, 65,
+			87, 65, 134, 85, 96, 244, 18, 12, 179, 77, 238, 44, 96, 161,
+			196, 190, 165, 221, 242, 13, 132, 12, 87, 226, 128, 183, 228, 191,
+			234, 6, 145, 9, 210, 212, 244, 214, 186, 166, 253, 66, 184, 192,
+			126, 31, 40, 225, 194, 153, 18, 7, 38, 231, 11, 88, 40, 113,
+			96, 113, 151, 252, 54, 35, 100, 132, 18, 183, 120, 139, 254, 87,
+			24, 178, 88, 50, 48, 203, 58, 232, 118, 113, 39, 72, 230, 235,
+			53, 147, 93, 54, 38, 210, 183, 233, 32, 106, 231, 188, 105, 239,
+			44, 125, 25, 112, 205, 17, 209, 171, 235, 82, 175, 7, 93, 144,
+			109, 120, 88, 195, 168, 29, 182, 130, 204, 192, 161, 14, 178, 145,
+			69, 225, 89, 139, 226, 76, 59, 41, 222, 221, 212, 221, 56, 104,
+			163, 44, 202, 98, 9, 255, 53, 73, 207, 180, 67, 16, 59, 41,
+			145, 40, 63, 180, 118, 214, 160, 107, 187, 93, 10, 186, 218, 92,
+			233, 135, 201, 16, 61, 68, 5, 214, 87, 43, 96, 166, 196, 45,
+			19, 115, 5, 12, 235, 95, 216, 41, 111, 38, 114, 120, 74, 28,
+			242, 246, 251, 11, 184, 55, 209, 160, 183, 102, 18, 56, 161, 64,
+			142, 98, 80, 175, 2, 189, 38, 10, 152, 41, 113, 72, 238, 46,
+			96, 161, 196, 161, 189, 251, 100, 0, 7, 11, 78, 217, 173, 220,
+			247, 47, 0, 129, 163, 56, 58, 22, 133, 221, 229, 81, 66, 148,
+			54, 115, 217, 82, 25, 136, 183, 30, 154, 110, 123, 244, 8, 6,
+			93, 233, 14, 97, 126, 202, 69, 21, 230, 112, 167, 92, 48, 37,
+			110, 221, 177, 211, 65, 48, 255, 210, 110, 249, 127, 35, 50, 158,
+			18, 43, 181, 37, 63, 209, 171, 165, 141, 49, 218, 106, 5, 116,
+			37, 196, 235, 58, 128, 93, 106, 232, 147, 240, 63, 187, 115, 27,
+			65, 170, 215, 128, 11, 168, 107, 152, 234, 56, 234, 110, 74, 29,
+			180, 222, 28, 197, 151, 187, 166, 221, 49, 109, 157, 197, 58, 104,
+			247, 194, 40, 76, 179, 36, 200, 64, 94, 180, 186, 161, 137, 178,
+			2, 85, 160, 221, 74, 109, 202, 65, 76, 137, 149, 233, 121, 7,
+			9, 37, 86, 22, 119, 201, 15, 50, 196, 181, 162, 196, 237, 220,
+			247, 223, 197, 70, 54, 3, 21, 22, 216, 21, 34, 30, 30, 135,
+			156, 189, 78, 13, 178, 174, 73, 52, 105, 40, 203, 58, 9, 178,
+			13, 188, 125, 131, 72, 234, 212, 68, 237, 48, 234, 44, 235, 53,
+			211, 10, 6, 246, 234, 14, 179, 84, 119, 195, 94, 152, 165, 13,
+			141, 186, 168, 142, 163, 150, 61, 127, 197, 238, 16, 215, 103, 200,
+			92, 22, 219, 10, 226, 55, 78, 184, 87, 152, 18, 183, 215, 28,
+			209, 43, 66, 137, 219, 151, 118, 203, 119, 219, 149, 84, 149, 184,
+			139, 239, 245, 159, 26, 93, 73, 249, 148, 29, 238, 134, 145, 73,
+			117, 156, 224, 241, 238, 36, 65, 47, 61, 114, 181, 85, 201, 23,
+			3, 255, 106, 5, 176, 114, 248, 87, 153, 18, 119, 213, 118, 57,
+			72, 40, 113, 151, 191, 39, 87, 200, 255, 150, 201, 253, 163, 218,
+			115, 123, 0, 91, 28, 71, 87, 179, 68, 78, 200, 218, 105, 234,
+			114, 195, 134, 200, 255, 181, 189, 33, 50, 237, 6, 116, 118, 200,
+			209, 107, 219, 33, 14, 205, 159, 194, 12, 121, 234, 162, 92, 185,
+			150, 25, 210, 141, 59, 253, 53, 104, 32, 50, 84, 176, 225, 154,
+			182, 134, 127, 13, 114, 214, 191, 207, 229, 124, 174, 119, 157, 54,
+			105, 43, 9, 251, 89, 156, 160, 66, 159, 152, 245, 240, 10, 233,
+			213, 4, 41, 37, 189, 40, 232, 25, 52, 64, 38, 154, 248, 111,
+			117, 92, 78, 146, 166, 13, 164, 64, 243, 98, 199, 241, 57, 48,
+			31, 250, 107, 141, 243, 248, 203, 133, 205, 190, 105, 146, 62, 14,
+			255, 86, 55, 201, 41, 16, 56, 38, 202, 236, 71, 160, 103, 79,
+			52, 39, 169, 13, 187, 220, 35, 39, 242, 213, 44, 85, 174, 105,
+			240, 20, 157, 213, 61, 210, 203, 130, 14, 24, 27, 226, 240, 228,
+			241, 91, 8, 147, 109, 150, 217, 184, 16, 116, 82, 212, 218, 155,
+			248, 5, 168, 247, 107, 97, 20, 36, 155, 23, 65, 9, 190, 104,
+			174, 100, 104, 138, 76, 52, 167, 109, 243, 3, 97, 215, 156, 185,
+			146, 249, 119, 203, 137, 252, 83, 53, 43, 197, 155, 205, 38, 17,
+			10, 254, 9, 220, 134, 42, 20, 145, 201, 2, 39, 248, 61, 172,
+			254, 38, 233, 93, 48, 87, 50, 245, 18, 89, 193, 131, 184, 196,
+			16, 199, 89, 194, 17, 126, 107, 60, 18, 70, 166, 105, 127, 246,
+			79, 72, 15, 192, 98, 68, 152, 101, 138, 70, 84, 123, 229, 68,
+			219, 224, 161, 52, 9, 205, 85, 52, 212, 235, 178, 122, 10, 177,
+			134, 93, 131, 211, 142, 93, 166, 154, 248, 239, 135, 189, 26, 155,
+			229, 245, 247, 51, 89, 59, 77, 130, 32, 239, 198, 138, 110, 234,
+			165, 114, 188, 31, 36, 89, 24, 116, 201, 232, 220, 69, 168, 186,
+			175, 26, 231, 236, 207, 77, 215, 207, 127, 80, 142, 83, 27, 160,
+			141, 247, 55, 18, 103, 186, 105, 1, 152, 39, 13, 159, 176, 76,
+			228, 53, 241, 223, 208, 214, 13, 210, 12, 185, 167, 214, 196, 127,
+			215, 255, 61, 151, 181, 71, 200, 172, 82, 39, 228, 36, 236, 240,
+			197, 120, 125, 61, 53, 25, 14, 56, 121, 124, 247, 22, 134, 112,
+			71, 183, 41, 161, 247, 163, 216, 25, 184, 205, 242, 47, 89, 192,
+			118, 226, 73, 219, 102, 237, 223, 155, 228, 20, 49, 113, 97, 36,
+			123, 77, 98, 108, 219, 197, 151, 181, 20, 12, 143, 168, 101, 237,
+			66, 175, 153, 195, 234, 38, 233, 101, 192, 45, 18, 209, 154, 44,
+			109, 231, 67, 99, 77, 252, 73, 29, 146, 85, 203, 68, 75, 147,
+			216, 105, 154, 58, 217, 61, 122, 104, 172, 73, 63, 171, 99, 178,
+			230, 100, 243, 210, 20, 118, 157, 25, 161, 249, 67, 99, 205, 188,
+			203, 169, 9, 57, 78, 199, 166, 254, 54, 15, 9, 102, 209, 109,
+			72, 175, 109, 210, 22, 81, 202, 191, 250, 41, 104, 98, 63, 181,
+			34, 199, 233, 146, 88, 226, 200, 148, 59, 139, 79, 112, 196, 6,
+			110, 68, 211, 245, 82, 71, 229, 28, 108, 211, 197, 33, 210, 90,
+			186, 205, 192, 15, 231, 74, 228, 117, 125, 135, 104, 236, 21, 125,
+			207, 151, 232, 124, 21, 27, 219, 27, 177, 177, 85, 67, 86, 215,
+			227, 164, 23, 100, 232, 85, 216, 113, 124, 113, 20, 223, 7, 240,
+			215, 38, 245, 242, 127, 155, 201, 10, 46, 1, 164, 91, 137, 141,
+			188, 38, 65, 67, 59, 204, 183, 236, 240, 48, 15, 137, 107, 243,
+			144, 183, 149, 135, 70, 184, 184, 114, 3, 92, 92, 63, 36, 171,
+			118, 69, 106, 74, 214, 154, 103, 238, 127, 180, 121, 122, 245, 209,
+			217, 49, 53, 35, 39, 223, 240, 200, 234, 169, 139, 15, 52, 79,
+			190, 250, 204, 249, 89, 86, 15, 228, 228, 121, 3, 106, 28, 146,
+			1, 14, 225, 90, 55, 142, 123, 78, 118, 32, 0, 254, 145, 141,
+			32, 221, 32, 2, 195, 106, 167, 155, 19, 208, 98, 137, 187, 79,
+			74, 144, 64, 244, 179, 93, 236, 4, 180, 224, 207, 71, 111, 147,
+			178, 144, 236, 170, 38, 189, 11, 103, 94, 119, 97, 118, 76, 73,
+			89, 61, 181, 122, 246, 100, 243, 245, 179, 12, 176, 60, 125, 242,
+			194, 201, 7, 155, 39, 95, 61, 203, 31, 126, 239, 107, 228, 184,
+			170, 120, 99, 223, 230, 47, 232, 18, 185, 243, 95, 130, 75, 100,
+			71, 217, 37, 2, 255, 100, 74, 76, 140, 29, 150, 90, 242, 202,
+			152, 242, 166, 198, 102, 153, 191, 64, 106, 45, 41, 71, 112, 203,
+			53, 180, 148, 82, 84, 192, 112, 157, 170, 204, 200, 73, 233, 85,
+			208, 61, 49, 205, 39, 65, 33, 2, 0, 60, 23, 188, 234, 32,
+			174, 196, 244, 132, 164, 142, 76, 137, 29, 124, 154, 58, 50, 132,
+			106, 14, 226, 74, 236, 152, 156, 162, 142, 92, 137, 25, 62, 67,
+			63, 129, 101, 54, 195, 165, 131, 224, 183, 233, 29, 242, 113, 235,
+			197, 89, 28, 123, 21, 243, 205, 81, 116, 189, 56, 45, 174, 157,
+			203, 5, 212, 16, 27, 250, 2, 216, 8, 228, 46, 89, 31, 128,
+			249, 111, 50, 32, 124, 24, 217, 115, 5, 94, 19, 32, 160, 164,
+			79, 215, 12, 56, 129, 186, 113, 167, 19, 70, 110, 249, 37, 199,
+			204, 98, 109, 15, 152, 133, 228, 153, 57, 192, 23, 252, 63, 100,
+			178, 228, 175, 56, 148, 106, 123, 206, 244, 97, 112, 243, 128, 161,
+			117, 132, 188, 67, 160, 182, 134, 29, 208, 143, 97, 228, 245, 36,
+			238, 33, 82, 105, 208, 203, 53, 215, 48, 74, 179, 0, 244, 234,
+			203, 232, 168, 216, 8, 192, 108, 212, 86, 18, 193, 40, 39, 193,
+			19, 21, 182, 221, 20, 185, 163, 35, 208, 150, 157, 207, 194, 88,
+			110, 29, 192, 6, 39, 164, 222, 200, 178, 126, 122, 98, 101, 123,
+			29, 173, 21, 247, 122, 113, 228, 84, 53, 216, 229, 212, 105, 190,
+			99, 96, 36, 243, 154, 131, 192, 68, 158, 152, 113, 16, 24, 200,
+			106, 94, 254, 53, 115, 62, 163, 35, 92, 249, 255, 153, 40, 81,
+			240, 205, 161, 84, 71, 128, 210, 48, 45, 220, 150, 160, 63, 45,
+			139, 245, 32, 10, 31, 31, 152, 238, 166, 14, 219, 38, 202, 194,
+			245, 77, 29, 148, 198, 64, 231, 18, 49, 116, 218, 138, 251, 78,
+			157, 151, 186, 191, 133, 46, 56, 217, 63, 41, 85, 88, 69, 137,
+			35, 57, 85, 128, 143, 143, 76, 56, 147, 146, 9, 37, 142, 204,
+			206, 201, 123, 156, 51, 107, 153, 239, 243, 111, 221, 74, 18, 186,
+			239, 52, 12, 92, 38, 141, 166, 113, 120, 21, 62, 117, 214, 31,
+			28, 129, 229, 233, 37, 7, 9, 37, 150, 247, 236, 149, 223, 97,
+			206, 108, 190, 147, 251, 254, 87, 71, 121, 240, 106, 83, 56, 234,
+			247, 6, 105, 6, 226, 34, 136, 244, 67, 23, 46, 156, 211, 247,
+			219, 254, 199, 46, 0, 74, 72, 192, 134, 94, 205, 96, 147, 122,
+			65, 219, 232, 224, 82, 16, 118, 209, 145, 153, 197, 112, 218, 78,
+			199, 29, 233, 140, 86, 240, 76, 69, 250, 241, 129, 73, 54, 139,
+			19, 163, 123, 38, 11, 236, 1, 92, 205, 44, 55, 7, 221, 52,
+			198, 41, 251, 253, 110, 72, 86, 48, 89, 243, 82, 91, 221, 1,
+			201, 132, 95, 57, 114, 139, 138, 18, 119, 230, 228, 6, 155, 253,
+			206, 137, 178, 205, 126, 231, 210, 110, 249, 57, 230, 140, 246, 151,
+			243, 163, 254, 39, 183, 99, 194, 181, 32, 53, 58, 215, 176, 183,
+			35, 72, 20, 59, 43, 63, 205, 130, 36, 195, 206, 91, 189, 142,
+			214, 191, 77, 74, 29, 216, 163, 230, 74, 63, 49, 41, 126, 24,
+			38, 178, 52, 69, 144, 234, 94, 216, 74, 98, 107, 208, 105, 123,
+			97, 166, 238, 212, 59, 183, 69, 110, 102, 122, 85, 37, 94, 206,
+			247, 56, 136, 41, 241, 242, 189, 7, 29, 36, 148, 120, 249, 225,
+			35, 206, 76, 174, 40, 113, 154, 31, 240, 159, 130, 117, 6, 232,
+			214, 12, 34, 29, 36, 107, 97, 150, 4, 201, 166, 126, 179, 217,
+			92, 193, 13, 212, 89, 208, 209, 65, 154, 198, 45, 112, 12, 229,
+			62, 218, 48, 45, 175, 199, 74, 166, 211, 113, 39, 223, 77, 112,
+			173, 227, 102, 162, 243, 178, 232, 106, 137, 216, 214, 32, 46, 131,
+			14, 156, 183, 129, 41, 28, 22, 149, 42, 96, 149, 27, 246, 76,
+			137, 211, 139, 190, 131, 132, 18, 167, 247, 237, 151, 239, 203, 205,
+			252, 135, 249, 62, 255, 29, 76, 234, 213, 117, 144, 198, 203, 101,
+			43, 28, 89, 101, 205, 232, 55, 197, 33, 60, 10, 100, 113, 199,
+			160, 103, 162, 61, 72, 128, 187, 114, 111, 86, 22, 235, 196, 216,
+			71, 34, 248, 92, 58, 217, 234, 220, 187, 232, 31, 26, 225, 221,
+			32, 211, 247, 89, 153, 241, 138, 149, 91, 87, 238, 3, 97, 241,
+			138, 6, 152, 44, 110, 21, 96, 236, 63, 156, 115, 27, 24, 251,
+			15, 79, 184, 131, 87, 21, 74, 60, 188, 103, 175, 172, 75, 216,
+			30, 239, 236, 152, 97, 254, 162, 190, 96, 174, 100, 110, 70, 58,
+			115, 246, 154, 244, 64, 52, 156, 173, 77, 201, 63, 226, 210, 243,
+			24, 56, 142, 95, 199, 91, 194, 255, 61, 46, 241, 176, 133, 157,
+			65, 60, 0, 143, 201, 149, 76, 131, 94, 146, 146, 67, 203, 132,
+			137, 206, 109, 160, 212, 74, 75, 112, 29, 38, 193, 38, 176, 163,
+			237, 186, 30, 119, 187, 241, 101, 186, 211, 240, 223, 64, 155, 126,
+			144, 101, 38, 137, 78, 72, 173, 245, 49, 125, 27, 56, 73, 94,
+			234, 156, 134, 112, 203, 217, 111, 93, 67, 212, 193, 207, 187, 65,
+			154, 57, 134, 222, 60, 148, 218, 5, 29, 14, 27, 166, 129, 12,
+			3, 99, 105, 29, 20, 40, 233, 181, 65, 134, 222, 200, 48, 75,
+			77, 119, 189, 184, 86, 97, 244, 35, 208, 253, 152, 14, 162, 205,
+			146, 251, 134, 38, 52, 52, 127, 49, 118, 49, 232, 178, 54, 65,
+			107, 3, 132, 186, 6, 207, 76, 105, 40, 90, 5, 217, 102, 118,
+			8, 187, 91, 12, 61, 232, 175, 147, 59, 228, 3, 178, 10, 4,
+			6, 85, 228, 13, 222, 130, 127, 183, 61, 254, 97, 100, 14, 17,
+			125, 105, 99, 150, 45, 222, 81, 171, 59, 0, 247, 22, 78, 151,
+			163, 208, 208, 232, 152, 196, 113, 42, 48, 208, 68, 1, 51, 37,
+			222, 32, 103, 10, 88, 40, 241, 6, 53, 47, 63, 205, 104, 98,
+			166, 196, 154, 183, 219, 127, 198, 73, 30, 59, 117, 62, 52, 240,
+			135, 125, 109, 0, 134, 207, 232, 242, 11, 34, 109, 122, 253, 108,
+			147, 126, 37, 79, 48, 44, 16, 126, 5, 148, 195, 104, 96, 114,
+			85, 46, 130, 133, 88, 237, 30, 172, 84, 137, 179, 56, 47, 104,
+			62, 167, 83, 247, 29, 249, 219, 177, 65, 209, 166, 131, 246, 37,
+			208, 43, 200, 231, 203, 200, 31, 191, 70, 62, 112, 70, 254, 248,
+			53, 122, 28, 96, 228, 143, 95, 219, 181, 4, 74, 153, 199, 128,
+			182, 109, 110, 15, 52, 227, 99, 30, 64, 180, 13, 124, 172, 170,
+			68, 123, 114, 198, 65, 76, 137, 246, 236, 78, 7, 9, 37, 218,
+			75, 187, 229, 45, 146, 123, 92, 121, 27, 99, 17, 243, 151, 180,
+			53, 25, 183, 63, 54, 112, 219, 109, 212, 118, 200, 7, 165, 240,
+			248, 132, 18, 111, 226, 211, 254, 189, 26, 12, 7, 147, 116, 55,
+			145, 221, 28, 183, 54, 206, 187, 213, 162, 48, 66, 63, 121, 123,
+			208, 239, 162, 43, 189, 173, 225, 189, 164, 129, 218, 167, 199, 39,
+			198, 148, 120, 211, 164, 189, 81, 249, 196, 24, 27, 130, 184, 133,
+			14, 75, 207, 227, 176, 208, 30, 159, 243, 247, 224, 78, 210, 173,
+			148, 95, 35, 120, 53, 217, 91, 154, 227, 235, 74, 143, 60, 131,
+			28, 85, 223, 30, 121, 108, 57, 178, 72, 111, 102, 86, 46, 75,
+			144, 222, 149, 199, 199, 126, 145, 49, 255, 128, 118, 6, 240, 200,
+			210, 75, 218, 181, 7, 87, 220, 227, 181, 89, 89, 151, 158, 39,
+			0, 155, 148, 207, 249, 59, 237, 29, 229, 108, 102, 210, 108, 113,
+			46, 129, 120, 164, 132, 135, 64, 60, 82, 194, 67, 32, 30, 233,
+			204, 172, 124, 11, 136, 94, 33, 198, 84, 101, 147, 63, 197, 132,
+			159, 12, 177, 34, 50, 72, 126, 198, 220, 44, 196, 145, 214, 189,
+			142, 98, 217, 30, 29, 163, 19, 67, 175, 44, 155, 192, 127, 146,
+			28, 226, 163, 111, 94, 40, 21, 220, 96, 36, 98, 5, 30, 218,
+			77, 57, 39, 3, 89, 245, 132, 61, 180, 79, 122, 59, 253, 166,
+			61, 58, 104, 155, 46, 195, 128, 9, 74, 40, 20, 19, 79, 152,
+			36, 94, 206, 109, 37, 55, 162, 94, 79, 130, 78, 15, 168, 71,
+			39, 4, 230, 147, 57, 246, 196, 233, 130, 206, 243, 147, 196, 233,
+			130, 206, 243, 147, 147, 179, 5, 44, 148, 120, 114, 126, 65, 174,
+			16, 74, 76, 121, 111, 97, 222, 130, 127, 0, 81, 2, 247, 142,
+			83, 4, 134, 150, 164, 229, 12, 13, 192, 42, 248, 133, 44, 26,
+			112, 136, 201, 153, 162, 65, 64, 131, 154, 151, 143, 209, 28, 92,
+			121, 111, 99, 158, 242, 207, 20, 15, 74, 110, 55, 114, 169, 60,
+			186, 33, 110, 161, 240, 96, 30, 148, 105, 91, 96, 194, 43, 56,
+			110, 173, 104, 96, 208, 48, 49, 93, 52, 8, 104, 152, 157, 147,
+			83, 192, 17, 156, 41, 239, 237, 140, 47, 202, 105, 216, 28, 206,
+			170, 8, 78, 56, 16, 127, 149, 115, 14, 20, 0, 46, 236, 148,
+			191, 3, 175, 232, 158, 170, 190, 139, 141, 253, 30, 99, 254, 167,
+			216, 81, 169, 79, 70, 240, 20, 25, 94, 10, 219, 131, 160, 120,
+			24, 219, 204, 245, 171, 252, 125, 6, 86, 144, 14, 250, 38, 33,
+			59, 44, 75, 130, 40, 237, 133, 105, 26, 130, 122, 153, 43, 128,
+			122, 53, 43, 180, 88, 228, 193, 84, 234, 116, 35, 30, 116, 219,
+			160, 68, 226, 99, 86, 63, 49, 89, 33, 33, 97, 6, 16, 146,
+			91, 148, 182, 17, 117, 24, 101, 130, 240, 224, 18, 127, 23, 171,
+			205, 202, 223, 128, 179, 225, 241, 49, 229, 253, 26, 227, 183, 250,
+			31, 32, 41, 78, 71, 148, 244, 64, 208, 222, 136, 179, 105, 49,
+			32, 178, 220, 226, 232, 119, 80, 197, 218, 109, 212, 90, 182, 162,
+			0, 218, 147, 174, 231, 10, 98, 29, 58, 37, 38, 141, 187, 151,
+			72, 129, 201, 127, 42, 230, 73, 251, 166, 21, 174, 135, 45, 167,
+			158, 55, 36, 110, 133, 7, 50, 23, 176, 245, 29, 200, 0, 249,
+			61, 47, 113, 160, 0, 240, 200, 81, 107, 36, 120, 176, 201, 31,
+			102, 220, 207, 45, 85, 122, 47, 167, 183, 227, 146, 121, 117, 110,
+			59, 203, 213, 89, 107, 185, 89, 101, 205, 53, 192, 31, 89, 214,
+			137, 99, 29, 128, 186, 107, 249, 23, 46, 169, 196, 56, 83, 155,
+			60, 110, 176, 127, 65, 226, 20, 143, 156, 48, 100, 221, 146, 185,
+			225, 108, 194, 182, 73, 195, 14, 60, 197, 232, 65, 20, 244, 214,
+			72, 93, 234, 130, 221, 17, 39, 109, 67, 23, 170, 93, 47, 156,
+			191, 15, 51, 94, 163, 229, 51, 92, 239, 196, 78, 7, 10, 0,
+			151, 118, 203, 111, 89, 106, 112, 229, 125, 12, 168, 241, 165, 23,
+			162, 6, 232, 6, 20, 224, 177, 13, 53, 70, 73, 65, 43, 135,
+			67, 73, 107, 29, 94, 106, 208, 203, 105, 11, 23, 182, 29, 88,
+			106, 176, 214, 175, 123, 221, 249, 178, 135, 236, 97, 167, 197, 219,
+			165, 194, 241, 255, 88, 65, 8, 216, 248, 143, 21, 132, 224, 2,
+			192, 165, 221, 242, 43, 160, 146, 122, 0, 254, 7, 198, 23, 253,
+			223, 225, 196, 241, 35, 202, 131, 19, 122, 97, 146, 230, 58, 20,
+			174, 111, 211, 74, 162, 210, 222, 35, 101, 204, 149, 236, 68, 110,
+			219, 59, 165, 132, 200, 58, 52, 22, 221, 35, 109, 212, 90, 26,
+			250, 17, 234, 22, 182, 12, 188, 158, 118, 194, 136, 180, 206, 12,
+			69, 127, 67, 146, 194, 48, 60, 56, 188, 111, 186, 131, 55, 52,
+			58, 254, 64, 244, 201, 103, 66, 153, 34, 243, 11, 120, 120, 168,
+			33, 20, 11, 169, 122, 33, 31, 210, 181, 225, 251, 46, 246, 182,
+			24, 90, 244, 136, 188, 162, 130, 244, 116, 196, 23, 12, 192, 137,
+			57, 7, 34, 181, 23, 118, 202, 12, 104, 95, 27, 83, 213, 207,
+			50, 254, 121, 38, 252, 182, 37, 190, 163, 47, 97, 65, 76, 233,
+			144, 128, 43, 24, 156, 84, 128, 113, 63, 238, 15, 186, 185, 134,
+			131, 150, 188, 212, 189, 32, 107, 109, 56, 161, 115, 40, 213, 111,
+			36, 79, 46, 104, 22, 111, 116, 40, 214, 198, 152, 242, 62, 203,
+			106, 51, 114, 5, 144, 224, 158, 242, 126, 139, 121, 243, 254, 77,
+			86, 235, 183, 108, 121, 2, 247, 35, 117, 15, 175, 96, 160, 52,
+			52, 45, 194, 171, 226, 23, 110, 137, 32, 66, 127, 139, 77, 76,
+			59, 80, 0, 56, 171, 228, 50, 142, 94, 81, 222, 111, 51, 111,
+			151, 191, 127, 88, 223, 59, 129, 23, 168, 78, 13, 94, 222, 249,
+			208, 149, 42, 118, 119, 196, 172, 48, 0, 39, 29, 245, 42, 2,
+			192, 133, 69, 121, 43, 14, 93, 85, 222, 239, 50, 111, 143, 191,
+			111, 84, 163, 58, 145, 55, 164, 249, 200, 85, 219, 123, 202, 129,
+			12, 192, 105, 119, 40, 170, 2, 192, 37, 95, 254, 57, 151, 220,
+			171, 168, 234, 87, 216, 216, 127, 226, 204, 255, 99, 110, 253, 138,
+			171, 121, 124, 77, 68, 124, 18, 70, 89, 12, 80, 144, 29, 75,
+			76, 154, 145, 148, 199, 168, 11, 103, 174, 21, 130, 31, 20, 36,
+			236, 97, 191, 5, 127, 94, 199, 68, 38, 193, 253, 91, 179, 250,
+			172, 141, 36, 10, 211, 108, 212, 200, 133, 225, 78, 70, 4, 154,
+			118, 121, 88, 64, 72, 167, 6, 30, 50, 96, 167, 90, 133, 69,
+			153, 139, 227, 245, 36, 232, 153, 180, 81, 232, 85, 192, 37, 125,
+			114, 110, 30, 66, 153, 18, 182, 236, 93, 109, 189, 160, 36, 246,
+			44, 226, 203, 228, 93, 35, 19, 35, 236, 25, 56, 172, 32, 161,
+			208, 245, 134, 131, 31, 74, 157, 223, 198, 93, 128, 229, 144, 147,
+			97, 132, 215, 186, 241, 26, 221, 188, 176, 183, 95, 129, 155, 247,
+			15, 65, 32, 87, 224, 230, 253, 99, 198, 15, 248, 191, 75, 2,
+			121, 155, 55, 158, 226, 74, 44, 13, 57, 42, 152, 221, 65, 134,
+			16, 24, 147, 14, 95, 50, 219, 141, 153, 194, 5, 22, 128, 234,
+			107, 125, 31, 224, 66, 151, 26, 226, 52, 10, 101, 143, 164, 11,
+			204, 234, 60, 90, 122, 109, 83, 183, 227, 203, 17, 196, 224, 56,
+			51, 18, 39, 166, 99, 86, 193, 219, 249, 143, 25, 223, 233, 64,
+			6, 11, 92, 244, 29, 40, 0, 220, 183, 95, 126, 20, 151, 47,
+			198, 84, 245, 57, 198, 127, 137, 11, 255, 61, 76, 106, 20, 167,
+			180, 189, 97, 4, 65, 79, 56, 118, 89, 155, 114, 77, 168, 136,
+			244, 250, 49, 220, 152, 241, 250, 16, 63, 208, 45, 68, 118, 117,
+			43, 78, 108, 168, 33, 154, 189, 192, 189, 178, 100, 74, 234, 52,
+			10, 250, 233, 70, 140, 11, 37, 241, 83, 80, 217, 45, 10, 20,
+			119, 239, 57, 38, 103, 228, 219, 184, 172, 2, 12, 251, 246, 23,
+			204, 91, 244, 255, 150, 246, 173, 44, 145, 137, 19, 76, 47, 204,
+			178, 97, 70, 160, 25, 154, 166, 21, 39, 237, 213, 71, 233, 66,
+			33, 163, 65, 230, 55, 202, 86, 164, 241, 194, 201, 111, 155, 7,
+			226, 68, 7, 186, 244, 112, 68, 227, 23, 122, 52, 248, 210, 77,
+			208, 190, 202, 101, 33, 145, 118, 224, 187, 51, 109, 48, 37, 122,
+			134, 158, 81, 202, 122, 170, 67, 230, 80, 58, 130, 111, 195, 234,
+			250, 72, 133, 10, 146, 161, 212, 192, 160, 97, 114, 174, 104, 16,
+			208, 0, 122, 179, 163, 28, 83, 222, 15, 152, 183, 228, 127, 242,
+			134, 111, 222, 23, 237, 162, 181, 23, 24, 222, 182, 255, 114, 46,
+			90, 71, 81, 80, 248, 126, 80, 166, 57, 168, 124, 63, 96, 147,
+			243, 69, 131, 128, 134, 197, 93, 242, 223, 49, 162, 57, 87, 222,
+			243, 204, 219, 235, 255, 43, 226, 214, 66, 40, 83, 248, 16, 4,
+			236, 194, 222, 230, 47, 5, 233, 85, 20, 97, 84, 213, 214, 54,
+			115, 143, 41, 200, 196, 226, 225, 34, 215, 217, 115, 78, 38, 125,
+			45, 32, 97, 34, 137, 83, 11, 29, 177, 244, 194, 227, 240, 7,
+			77, 238, 249, 242, 10, 65, 151, 123, 158, 77, 238, 42, 26, 4,
+			52, 248, 123, 228, 211, 110, 133, 66, 121, 63, 134, 21, 254, 2,
+			173, 176, 108, 186, 56, 235, 57, 55, 204, 94, 236, 181, 161, 102,
+			158, 139, 12, 135, 36, 232, 68, 63, 46, 47, 3, 180, 162, 31,
+			151, 151, 33, 16, 107, 127, 143, 252, 129, 91, 134, 167, 188, 95,
+			228, 222, 49, 255, 219, 215, 179, 140, 101, 96, 192, 146, 163, 157,
+			60, 166, 97, 186, 197, 24, 43, 30, 6, 15, 165, 67, 118, 24,
+			105, 87, 165, 133, 162, 32, 202, 215, 154, 119, 45, 207, 62, 164,
+			182, 95, 141, 94, 114, 27, 130, 193, 157, 31, 246, 76, 137, 70,
+			160, 84, 253, 34, 247, 246, 22, 13, 12, 26, 246, 29, 46, 26,
+			4, 52, 220, 186, 44, 191, 13, 138, 123, 5, 88, 225, 61, 156,
+			239, 243, 159, 229, 240, 34, 88, 72, 253, 32, 109, 217, 200, 189,
+			99, 104, 43, 152, 54, 221, 38, 164, 76, 194, 243, 51, 188, 86,
+			0, 122, 81, 39, 23, 251, 120, 97, 192, 205, 183, 205, 181, 13,
+			212, 124, 173, 51, 55, 224, 161, 218, 238, 193, 240, 176, 32, 114,
+			141, 174, 219, 45, 170, 47, 235, 122, 57, 208, 160, 190, 44, 117,
+			189, 28, 86, 80, 183, 26, 69, 189, 20, 71, 64, 123, 144, 230,
+			206, 255, 124, 33, 238, 194, 91, 7, 102, 53, 81, 107, 115, 235,
+			236, 206, 129, 213, 54, 235, 240, 98, 112, 175, 14, 173, 29, 217,
+			119, 27, 159, 171, 87, 240, 96, 24, 183, 240, 181, 38, 214, 173,
+			141, 56, 78, 225, 113, 53, 31, 58, 191, 190, 153, 135, 244, 205,
+			193, 42, 128, 147, 179, 14, 68, 234, 207, 45, 57, 80, 0, 184,
+			103, 47, 56, 69, 96, 111, 184, 242, 62, 192, 249, 1, 235, 20,
+			185, 144, 187, 114, 144, 34, 36, 111, 72, 100, 14, 83, 217, 241,
+			108, 220, 7, 93, 44, 232, 98, 48, 59, 72, 101, 164, 110, 130,
+			214, 166, 9, 225, 159, 58, 138, 135, 30, 175, 131, 181, 120, 64,
+			49, 195, 1, 152, 2, 229, 185, 150, 193, 125, 14, 31, 129, 82,
+			102, 80, 140, 230, 22, 42, 161, 145, 63, 162, 218, 245, 128, 224,
+			249, 0, 39, 19, 178, 130, 254, 163, 15, 240, 9, 167, 187, 128,
+			205, 248, 1, 190, 111, 191, 91, 173, 80, 222, 51, 91, 87, 75,
+			55, 253, 63, 203, 106, 203, 115, 93, 199, 106, 115, 20, 236, 122,
+			64, 62, 61, 83, 172, 22, 164, 211, 51, 197, 106, 65, 54, 61,
+			3, 171, 253, 61, 187, 90, 79, 121, 159, 128, 115, 247, 105, 183,
+			218, 226, 186, 118, 2, 105, 187, 169, 94, 148, 213, 218, 169, 228,
+			200, 92, 55, 190, 98, 175, 130, 139, 112, 43, 6, 19, 238, 19,
+			124, 194, 113, 179, 39, 0, 220, 179, 87, 174, 194, 130, 189, 49,
+			85, 253, 20, 231, 191, 195, 133, 255, 50, 137, 62, 120, 107, 129,
+			2, 175, 217, 224, 9, 64, 39, 216, 214, 66, 113, 222, 114, 154,
+			23, 98, 39, 188, 79, 241, 241, 105, 57, 0, 49, 143, 209, 19,
+			222, 111, 114, 111, 206, 55, 196, 56, 249, 151, 48, 67, 80, 232,
+			91, 212, 72, 33, 188, 219, 219, 6, 240, 2, 102, 218, 18, 52,
+			115, 234, 52, 44, 35, 156, 180, 133, 105, 25, 206, 59, 85, 52,
+			112, 104, 152, 153, 149, 95, 227, 132, 25, 152, 158, 220, 91, 240,
+			63, 79, 218, 217, 213, 205, 46, 224, 240, 190, 137, 224, 242, 239,
+			110, 234, 39, 186, 225, 218, 177, 81, 173, 50, 109, 72, 125, 218,
+			148, 90, 65, 248, 181, 226, 8, 222, 40, 192, 189, 213, 70, 30,
+			160, 190, 96, 230, 244, 116, 160, 93, 116, 20, 77, 11, 242, 225,
+			12, 60, 144, 97, 47, 23, 233, 211, 46, 143, 10, 41, 65, 246,
+			245, 108, 89, 167, 113, 105, 227, 93, 239, 196, 4, 109, 89, 56,
+			214, 193, 236, 137, 200, 70, 132, 8, 246, 200, 92, 166, 209, 81,
+			37, 76, 65, 39, 68, 219, 1, 150, 120, 197, 180, 115, 154, 46,
+			75, 109, 174, 180, 76, 63, 211, 253, 24, 125, 182, 155, 154, 130,
+			175, 177, 39, 216, 149, 65, 90, 40, 171, 142, 202, 160, 160, 253,
+			54, 247, 102, 138, 6, 14, 13, 106, 30, 243, 106, 42, 32, 117,
+			126, 159, 243, 5, 255, 4, 201, 251, 156, 187, 134, 101, 71, 73,
+			87, 195, 233, 10, 93, 45, 103, 112, 112, 36, 252, 126, 193, 224,
+			96, 108, 254, 62, 159, 152, 113, 160, 0, 80, 205, 203, 231, 192,
+			220, 175, 170, 234, 179, 28, 66, 186, 252, 175, 112, 169, 75, 65,
+			103, 58, 29, 244, 122, 65, 18, 62, 65, 118, 100, 201, 59, 83,
+			102, 247, 11, 103, 94, 119, 161, 132, 29, 236, 147, 85, 11, 2,
+			125, 10, 162, 212, 32, 240, 10, 30, 0, 193, 99, 143, 227, 208,
+			253, 137, 154, 115, 150, 132, 160, 33, 167, 100, 132, 246, 251, 38,
+			72, 232, 208, 74, 13, 12, 157, 180, 130, 148, 116, 247, 212, 145,
+			130, 38, 2, 237, 35, 0, 174, 135, 169, 82, 196, 27, 102, 136,
+			117, 250, 230, 176, 175, 131, 50, 181, 36, 112, 64, 20, 231, 150,
+			141, 253, 213, 249, 17, 225, 234, 0, 214, 24, 246, 202, 210, 227,
+			110, 67, 63, 0, 73, 42, 176, 211, 33, 164, 41, 164, 160, 35,
+			24, 183, 241, 230, 94, 155, 195, 162, 35, 211, 193, 44, 6, 200,
+			189, 48, 58, 138, 51, 178, 243, 171, 76, 121, 207, 242, 218, 188,
+			124, 157, 244, 188, 42, 28, 248, 175, 113, 174, 252, 135, 105, 139,
+			145, 52, 232, 66, 128, 135, 205, 172, 161, 79, 133, 153, 174, 135,
+			117, 160, 31, 154, 240, 109, 29, 164, 186, 142, 225, 126, 63, 23,
+			174, 220, 243, 243, 250, 37, 250, 240, 75, 245, 125, 247, 233, 195,
+			225, 193, 123, 142, 28, 169, 211, 150, 87, 225, 5, 204, 251, 26,
+			231, 227, 14, 100, 48, 83, 109, 218, 129, 2, 192, 217, 57, 121,
+			10, 209, 96, 202, 251, 19, 206, 151, 252, 59, 70, 69, 248, 26,
+			136, 14, 160, 24, 46, 195, 249, 251, 241, 16, 208, 86, 229, 19,
+			130, 253, 241, 39, 142, 199, 170, 168, 18, 252, 9, 159, 152, 119,
+			160, 128, 95, 23, 119, 201, 187, 113, 66, 174, 188, 231, 96, 194,
+			35, 91, 238, 12, 244, 179, 225, 140, 152, 31, 68, 71, 45, 159,
+			5, 14, 197, 115, 197, 44, 112, 21, 63, 87, 204, 2, 87, 241,
+			115, 124, 113, 87, 30, 135, 255, 71, 31, 100, 242, 161, 109, 195,
+			153, 174, 59, 31, 24, 242, 130, 71, 210, 129, 95, 188, 12, 99,
+			127, 229, 90, 67, 141, 100, 9, 252, 227, 211, 3, 158, 17, 82,
+			62, 104, 178, 38, 104, 120, 105, 6, 249, 21, 253, 36, 126, 147,
+			105, 101, 20, 237, 238, 64, 8, 223, 238, 7, 217, 6, 5, 161,
+			227, 191, 33, 238, 20, 31, 54, 41, 166, 219, 2, 69, 72, 56,
+			68, 199, 10, 23, 18, 190, 79, 74, 56, 211, 20, 110, 10, 97,
+			177, 149, 230, 4, 180, 96, 184, 169, 218, 35, 39, 32, 237, 214,
+			254, 90, 197, 95, 107, 221, 184, 99, 127, 60, 40, 119, 68, 113,
+			116, 177, 112, 225, 97, 204, 126, 173, 57, 29, 197, 81, 17, 41,
+			162, 86, 229, 76, 199, 100, 23, 225, 117, 192, 180, 47, 14, 146,
+			110, 186, 84, 195, 240, 219, 155, 92, 166, 115, 177, 210, 198, 249,
+			176, 19, 61, 214, 124, 132, 192, 230, 116, 199, 100, 208, 100, 218,
+			143, 37, 221, 20, 226, 135, 173, 96, 90, 154, 192, 245, 18, 228,
+			15, 228, 142, 225, 15, 213, 157, 178, 214, 13, 215, 13, 208, 253,
+			218, 33, 235, 121, 87, 152, 192, 10, 18, 36, 104, 173, 73, 80,
+			65, 60, 34, 41, 2, 245, 215, 200, 201, 11, 65, 216, 125, 17,
+			119, 169, 254, 17, 33, 39, 145, 28, 224, 245, 74, 205, 11, 140,
+			185, 236, 190, 135, 65, 39, 109, 16, 246, 214, 180, 113, 26, 55,
+			15, 74, 23, 215, 25, 148, 126, 179, 244, 224, 76, 45, 121, 90,
+			148, 226, 224, 221, 93, 218, 196, 31, 213, 207, 200, 201, 242, 174,
+			86, 112, 87, 247, 15, 237, 170, 93, 70, 163, 216, 195, 166, 76,
+			243, 127, 251, 159, 102, 82, 150, 182, 247, 132, 148, 152, 226, 135,
+			187, 146, 199, 207, 95, 61, 245, 164, 212, 123, 100, 231, 38, 182,
+			223, 185, 9, 199, 246, 247, 202, 105, 50, 237, 40, 146, 221, 123,
+			193, 72, 118, 10, 47, 183, 138, 100, 253, 189, 21, 57, 245, 154,
+			129, 73, 54, 95, 196, 141, 7, 60, 145, 49, 41, 243, 221, 2,
+			112, 188, 33, 126, 6, 227, 240, 39, 154, 248, 111, 117, 64, 78,
+			246, 130, 43, 23, 19, 147, 14, 186, 89, 74, 167, 82, 246, 130,
+			43, 77, 219, 178, 37, 199, 71, 110, 205, 241, 121, 96, 56, 117,
+			200, 38, 70, 28, 116, 59, 87, 94, 92, 41, 145, 232, 1, 60,
+			108, 67, 233, 68, 183, 201, 74, 100, 46, 155, 100, 105, 234, 154,
+			155, 101, 59, 170, 219, 100, 37, 238, 182, 77, 178, 52, 125, 237,
+			47, 176, 227, 214, 26, 13, 59, 182, 169, 209, 112, 156, 82, 143,
+			102, 180, 40, 243, 224, 208, 74, 70, 147, 142, 238, 200, 139, 39,
+			204, 226, 238, 239, 221, 254, 171, 4, 93, 139, 174, 180, 130, 127,
+			175, 156, 29, 37, 137, 58, 84, 206, 18, 218, 54, 7, 203, 254,
+			254, 211, 231, 47, 221, 34, 199, 9, 17, 8, 250, 63, 245, 232,
+			133, 135, 102, 199, 212, 184, 20, 175, 135, 196, 3, 85, 149, 252,
+			236, 163, 179, 188, 254, 52, 151, 211, 132, 252, 53, 229, 199, 93,
+			114, 156, 220, 121, 148, 118, 50, 186, 124, 119, 116, 177, 83, 211,
+			117, 206, 89, 82, 20, 44, 233, 191, 143, 201, 170, 93, 108, 206,
+			241, 172, 196, 241, 255, 180, 162, 106, 159, 148, 32, 218, 46, 22,
+			199, 103, 170, 57, 1, 45, 152, 69, 88, 255, 2, 147, 211, 86,
+			65, 254, 233, 142, 43, 244, 182, 241, 137, 36, 66, 28, 8, 34,
+			39, 49, 29, 115, 165, 79, 103, 150, 32, 56, 160, 97, 39, 138,
+			19, 115, 17, 244, 96, 170, 204, 33, 109, 211, 253, 65, 106, 174,
+			125, 130, 29, 141, 199, 11, 26, 215, 255, 136, 201, 29, 110, 29,
+			215, 179, 185, 248, 176, 106, 182, 108, 238, 240, 16, 141, 87, 67,
+			175, 166, 235, 188, 237, 230, 190, 94, 86, 176, 215, 182, 91, 155,
+			139, 87, 94, 214, 42, 32, 169, 44, 140, 12, 14, 83, 105, 226,
+			191, 225, 107, 120, 146, 69, 90, 77, 216, 212, 172, 122, 40, 167,
+			31, 192, 40, 208, 23, 89, 146, 110, 85, 116, 234, 159, 100, 114,
+			135, 155, 235, 154, 228, 251, 95, 127, 183, 30, 255, 255, 184, 244,
+			32, 245, 66, 53, 164, 120, 208, 100, 74, 109, 85, 150, 252, 249,
+			161, 54, 90, 213, 109, 210, 3, 165, 68, 229, 63, 150, 84, 148,
+			237, 191, 184, 67, 86, 80, 104, 168, 133, 17, 9, 96, 191, 217,
+			57, 210, 74, 95, 221, 45, 171, 150, 151, 212, 206, 81, 222, 178,
+			223, 45, 142, 54, 211, 135, 47, 131, 116, 42, 216, 136, 226, 195,
+			33, 38, 240, 23, 71, 155, 237, 135, 183, 177, 135, 63, 252, 20,
+			179, 201, 76, 191, 46, 254, 183, 170, 239, 114, 190, 72, 102, 122,
+			25, 254, 147, 43, 33, 41, 197, 73, 40, 49, 57, 118, 88, 126,
+			1, 204, 254, 49, 229, 45, 140, 189, 145, 249, 159, 229, 186, 96,
+			3, 231, 216, 162, 226, 44, 84, 147, 101, 144, 24, 122, 123, 54,
+			240, 54, 146, 192, 7, 218, 153, 60, 121, 68, 111, 254, 213, 144,
+			11, 76, 155, 43, 97, 154, 165, 203, 58, 160, 236, 148, 210, 100,
+			232, 233, 78, 7, 173, 150, 1, 191, 85, 98, 58, 65, 210, 238,
+			130, 107, 58, 94, 215, 151, 55, 12, 37, 227, 143, 142, 155, 4,
+			17, 148, 118, 8, 210, 34, 168, 29, 112, 56, 27, 103, 102, 232,
+			21, 203, 162, 167, 123, 193, 166, 78, 76, 54, 72, 34, 189, 14,
+			90, 3, 224, 6, 139, 12, 162, 210, 184, 109, 27, 202, 101, 253,
+			143, 210, 13, 28, 118, 195, 108, 19, 156, 139, 24, 104, 23, 5,
+			93, 120, 24, 133, 114, 5, 97, 52, 84, 167, 102, 161, 166, 100,
+			195, 213, 169, 89, 228, 59, 33, 150, 164, 68, 68, 146, 4, 48,
+			1, 53, 81, 32, 40, 190, 155, 138, 197, 60, 214, 30, 98, 50,
+			23, 39, 102, 29, 4, 53, 87, 230, 23, 228, 111, 114, 151, 94,
+			116, 128, 43, 255, 223, 112, 28, 27, 4, 230, 118, 158, 161, 88,
+			119, 76, 17, 146, 7, 110, 47, 242, 169, 194, 131, 130, 203, 114,
+			160, 206, 118, 12, 75, 226, 243, 15, 157, 60, 126, 231, 93, 16,
+			218, 131, 195, 186, 174, 185, 107, 25, 250, 194, 176, 231, 227, 158,
+			209, 131, 12, 40, 19, 154, 20, 137, 187, 30, 70, 109, 221, 15,
+			210, 20, 220, 39, 65, 130, 213, 136, 2, 27, 56, 64, 243, 193,
+			199, 128, 218, 154, 209, 45, 52, 252, 211, 184, 103, 164, 35, 58,
+			120, 28, 186, 38, 234, 100, 27, 24, 166, 177, 137, 124, 31, 247,
+			51, 248, 2, 134, 117, 99, 2, 154, 136, 159, 123, 211, 198, 176,
+			219, 196, 128, 163, 247, 18, 82, 1, 94, 162, 0, 137, 176, 72,
+			102, 96, 67, 249, 91, 12, 243, 183, 202, 153, 74, 7, 102, 231,
+			228, 170, 203, 84, 170, 243, 57, 255, 190, 34, 22, 149, 54, 139,
+			188, 19, 195, 148, 62, 148, 82, 192, 111, 152, 18, 119, 153, 34,
+			143, 5, 42, 171, 212, 121, 181, 148, 186, 84, 31, 207, 19, 153,
+			132, 18, 245, 153, 89, 74, 143, 18, 74, 28, 228, 138, 210, 163,
+			194, 40, 196, 80, 132, 210, 126, 210, 219, 44, 48, 142, 93, 102,
+			62, 7, 228, 4, 29, 164, 128, 103, 124, 81, 20, 7, 243, 218,
+			61, 144, 19, 116, 112, 118, 78, 254, 37, 119, 57, 65, 199, 248,
+			46, 255, 155, 150, 115, 122, 193, 149, 176, 55, 232, 149, 125, 62,
+			88, 36, 3, 185, 19, 22, 210, 112, 213, 71, 172, 115, 222, 190,
+			36, 185, 124, 37, 56, 117, 178, 116, 12, 96, 147, 48, 194, 95,
+			103, 163, 79, 1, 68, 183, 32, 49, 101, 10, 81, 172, 104, 212,
+			117, 167, 50, 79, 198, 64, 242, 130, 112, 77, 211, 65, 15, 182,
+			17, 64, 116, 67, 209, 113, 236, 26, 196, 6, 164, 134, 164, 143,
+			193, 237, 218, 53, 224, 107, 141, 35, 252, 94, 31, 54, 151, 76,
+			164, 67, 112, 148, 235, 75, 97, 220, 205, 235, 150, 96, 8, 115,
+			129, 248, 17, 124, 128, 11, 82, 136, 7, 138, 54, 33, 100, 53,
+			164, 98, 87, 118, 218, 20, 6, 0, 78, 116, 14, 66, 112, 248,
+			26, 114, 17, 134, 105, 137, 4, 249, 150, 64, 189, 146, 99, 249,
+			150, 120, 76, 137, 99, 53, 229, 32, 161, 196, 177, 157, 139, 18,
+			174, 98, 200, 6, 82, 226, 14, 190, 232, 191, 237, 106, 91, 2,
+			43, 73, 208, 249, 159, 14, 139, 141, 60, 98, 61, 143, 190, 180,
+			187, 20, 197, 26, 29, 43, 229, 173, 201, 31, 18, 237, 222, 53,
+			134, 191, 149, 176, 173, 40, 109, 81, 22, 230, 195, 148, 31, 113,
+			220, 8, 249, 254, 21, 98, 101, 141, 42, 129, 65, 237, 157, 117,
+			3, 113, 119, 221, 237, 242, 79, 211, 156, 126, 216, 9, 200, 7,
+			137, 49, 165, 245, 229, 228, 171, 32, 81, 28, 249, 160, 72, 202,
+			29, 181, 185, 82, 145, 148, 59, 22, 118, 202, 95, 240, 92, 246,
+			212, 41, 238, 251, 63, 20, 197, 97, 37, 71, 48, 112, 161, 189,
+			32, 136, 102, 116, 120, 64, 252, 67, 69, 157, 82, 140, 88, 49,
+			191, 62, 89, 142, 29, 115, 31, 30, 110, 155, 245, 96, 208, 205,
+			142, 80, 220, 127, 134, 1, 107, 112, 17, 94, 14, 146, 118, 158,
+			197, 134, 129, 220, 72, 96, 9, 149, 114, 204, 21, 100, 172, 52,
+			139, 251, 192, 133, 36, 125, 1, 45, 19, 181, 75, 149, 104, 240,
+			138, 194, 45, 195, 218, 97, 249, 11, 22, 188, 251, 72, 141, 161,
+			224, 69, 30, 33, 138, 1, 120, 161, 56, 91, 246, 134, 229, 152,
+			34, 126, 137, 233, 197, 151, 168, 22, 20, 26, 21, 120, 76, 45,
+			87, 187, 88, 33, 115, 37, 128, 163, 182, 172, 211, 96, 115, 244,
+			234, 0, 198, 9, 83, 120, 62, 89, 63, 33, 245, 207, 221, 190,
+			172, 239, 88, 214, 119, 45, 235, 187, 127, 254, 106, 4, 130, 157,
+			165, 37, 223, 238, 112, 0, 62, 57, 97, 191, 254, 121, 72, 97,
+			136, 251, 125, 216, 115, 170, 65, 35, 245, 157, 192, 117, 180, 58,
+			88, 208, 150, 61, 25, 90, 17, 140, 54, 132, 74, 206, 44, 213,
+			10, 176, 128, 19, 177, 144, 164, 118, 106, 220, 37, 222, 65, 146,
+			218, 169, 165, 221, 242, 155, 204, 85, 43, 123, 144, 191, 70, 248,
+			127, 128, 117, 171, 220, 102, 45, 147, 102, 65, 197, 231, 112, 194,
+			226, 113, 160, 112, 36, 185, 231, 179, 188, 186, 155, 116, 72, 66,
+			17, 36, 236, 6, 149, 201, 82, 60, 93, 37, 216, 61, 3, 32,
+			119, 196, 73, 41, 210, 17, 3, 15, 165, 110, 13, 146, 4, 94,
+			83, 40, 106, 82, 167, 155, 105, 102, 122, 35, 104, 21, 147, 219,
+			131, 136, 201, 82, 142, 8, 16, 141, 38, 30, 148, 75, 242, 33,
+			170, 72, 53, 166, 196, 170, 119, 212, 127, 25, 37, 96, 89, 151,
+			101, 113, 123, 21, 216, 229, 227, 173, 225, 109, 157, 197, 13, 188,
+			122, 139, 178, 85, 144, 206, 180, 234, 237, 45, 96, 166, 196, 234,
+			190, 131, 5, 44, 148, 88, 61, 124, 68, 190, 221, 213, 6, 131,
+			60, 63, 111, 193, 191, 172, 155, 36, 151, 203, 179, 57, 221, 17,
+			87, 94, 60, 106, 58, 55, 141, 123, 35, 5, 20, 240, 223, 168,
+			86, 128, 178, 144, 63, 237, 45, 235, 212, 152, 210, 174, 52, 134,
+			60, 110, 35, 5, 214, 206, 150, 138, 120, 193, 141, 127, 118, 98,
+			166, 128, 133, 18, 103, 213, 188, 60, 67, 120, 115, 37, 206, 121,
+			243, 254, 93, 215, 129, 119, 30, 7, 155, 187, 151, 138, 105, 65,
+			160, 159, 43, 77, 11, 9, 92, 231, 38, 118, 20, 176, 80, 226,
+			220, 156, 194, 60, 172, 49, 62, 174, 68, 147, 187, 196, 214, 241,
+			42, 64, 78, 233, 27, 103, 74, 52, 231, 92, 14, 244, 184, 80,
+			162, 121, 243, 45, 242, 239, 173, 216, 171, 41, 113, 145, 47, 248,
+			63, 240, 128, 215, 224, 53, 11, 217, 97, 25, 171, 107, 17, 223,
+			208, 149, 1, 91, 238, 162, 161, 49, 125, 9, 92, 75, 46, 79,
+			23, 194, 92, 131, 196, 184, 59, 212, 138, 245, 33, 89, 144, 39,
+			70, 23, 6, 64, 251, 152, 149, 151, 212, 101, 89, 99, 6, 99,
+			128, 175, 127, 185, 150, 232, 206, 136, 174, 163, 190, 208, 66, 119,
+			234, 202, 149, 99, 81, 251, 77, 105, 28, 213, 71, 51, 80, 112,
+			195, 75, 72, 129, 236, 211, 151, 55, 194, 204, 164, 253, 160, 101,
+			142, 165, 166, 31, 128, 204, 109, 235, 46, 4, 6, 219, 16, 95,
+			43, 209, 64, 133, 160, 229, 74, 10, 156, 193, 59, 0, 36, 236,
+			229, 141, 176, 181, 129, 33, 225, 122, 35, 238, 182, 151, 181, 105,
+			116, 26, 250, 141, 169, 185, 100, 146, 48, 219, 124, 197, 203, 95,
+			123, 178, 121, 118, 245, 236, 131, 122, 35, 78, 179, 151, 215, 215,
+			227, 184, 254, 198, 134, 62, 111, 32, 253, 196, 238, 118, 59, 238,
+			184, 244, 119, 64, 28, 124, 14, 169, 238, 7, 173, 55, 195, 89,
+			4, 110, 6, 157, 53, 221, 140, 178, 0, 19, 239, 155, 238, 166,
+			6, 178, 183, 227, 232, 144, 35, 62, 200, 0, 43, 151, 219, 249,
+			117, 17, 38, 195, 177, 11, 107, 46, 146, 3, 180, 109, 119, 241,
+			102, 27, 166, 151, 154, 174, 123, 78, 76, 179, 66, 30, 130, 232,
+			114, 65, 187, 240, 157, 107, 117, 49, 141, 58, 49, 189, 0, 178,
+			7, 10, 177, 218, 200, 35, 83, 47, 110, 81, 18, 112, 163, 64,
+			69, 130, 33, 33, 223, 194, 62, 131, 152, 182, 195, 37, 23, 185,
+			181, 10, 176, 159, 83, 165, 107, 76, 137, 139, 121, 41, 132, 154,
+			80, 226, 162, 154, 151, 239, 135, 164, 40, 166, 188, 246, 88, 202,
+			252, 255, 151, 233, 146, 191, 224, 58, 77, 75, 248, 162, 176, 45,
+			225, 137, 151, 212, 68, 218, 156, 128, 130, 205, 49, 150, 176, 19,
+			130, 178, 87, 98, 92, 162, 9, 69, 203, 149, 231, 35, 115, 13,
+			228, 65, 187, 54, 143, 230, 26, 38, 116, 174, 95, 191, 185, 198,
+			208, 92, 91, 39, 18, 48, 20, 138, 235, 100, 174, 217, 44, 207,
+			117, 103, 174, 49, 16, 0, 241, 255, 49, 215, 110, 204, 92, 99,
+			40, 190, 227, 156, 192, 176, 89, 49, 153, 107, 12, 205, 181, 152,
+			204, 53, 6, 133, 37, 146, 23, 197, 92, 99, 40, 188, 19, 210,
+			37, 24, 154, 107, 9, 153, 107, 12, 5, 119, 50, 51, 43, 31,
+			197, 220, 221, 202, 165, 177, 15, 49, 230, 159, 210, 37, 151, 87,
+			193, 215, 4, 95, 159, 207, 196, 165, 249, 94, 170, 205, 203, 51,
+			46, 233, 246, 10, 223, 233, 223, 3, 53, 110, 145, 1, 105, 96,
+			199, 143, 81, 30, 158, 157, 109, 152, 148, 40, 184, 102, 186, 49,
+			152, 36, 49, 173, 198, 102, 228, 94, 33, 18, 114, 228, 209, 43,
+			196, 163, 54, 35, 247, 202, 252, 130, 213, 140, 112, 165, 79, 242,
+			61, 254, 151, 216, 168, 252, 47, 244, 119, 82, 102, 73, 241, 37,
+			223, 86, 57, 206, 131, 108, 1, 34, 190, 213, 114, 82, 147, 101,
+			46, 150, 130, 126, 56, 4, 153, 100, 56, 138, 11, 52, 133, 77,
+			115, 197, 106, 37, 172, 57, 139, 233, 199, 48, 205, 147, 65, 161,
+			218, 105, 144, 65, 34, 121, 225, 249, 164, 94, 168, 194, 192, 37,
+			184, 86, 4, 94, 231, 68, 128, 106, 187, 79, 210, 165, 202, 33,
+			52, 65, 60, 57, 183, 232, 32, 161, 196, 147, 187, 125, 249, 119,
+			150, 8, 28, 242, 57, 249, 65, 255, 175, 153, 187, 142, 130, 8,
+			34, 102, 183, 241, 185, 230, 26, 139, 11, 124, 5, 191, 16, 118,
+			6, 218, 60, 124, 254, 209, 179, 40, 117, 211, 65, 175, 239, 180,
+			110, 242, 140, 21, 78, 175, 67, 233, 232, 90, 203, 53, 80, 157,
+			94, 150, 167, 55, 221, 43, 117, 12, 2, 225, 114, 152, 18, 65,
+			32, 240, 42, 232, 134, 79, 152, 118, 161, 64, 185, 207, 46, 39,
+			144, 105, 16, 57, 97, 94, 96, 142, 228, 165, 139, 146, 66, 43,
+			56, 231, 54, 209, 117, 143, 3, 49, 209, 117, 175, 118, 32, 38,
+			186, 222, 124, 139, 124, 165, 164, 180, 185, 95, 102, 252, 102, 255,
+			56, 136, 154, 34, 134, 150, 108, 107, 27, 209, 227, 14, 119, 123,
+			196, 190, 179, 3, 10, 15, 135, 200, 193, 42, 128, 147, 187, 29,
+			200, 0, 244, 247, 59, 80, 0, 120, 83, 93, 62, 2, 179, 67,
+			122, 200, 59, 25, 127, 63, 19, 254, 125, 250, 161, 184, 219, 78,
+			175, 22, 14, 57, 116, 226, 81, 93, 164, 72, 239, 199, 154, 143,
+			56, 76, 64, 111, 246, 222, 201, 228, 130, 188, 79, 86, 1, 132,
+			168, 156, 95, 97, 222, 49, 127, 185, 136, 181, 166, 210, 177, 97,
+			186, 69, 109, 198, 135, 98, 67, 97, 93, 248, 117, 21, 63, 223,
+			87, 52, 48, 104, 216, 127, 184, 104, 16, 208, 112, 235, 178, 60,
+			65, 19, 50, 229, 61, 13, 89, 35, 71, 41, 187, 26, 17, 45,
+			29, 192, 199, 154, 143, 44, 131, 249, 152, 31, 171, 210, 116, 16,
+			119, 243, 180, 11, 39, 231, 20, 247, 255, 180, 203, 181, 224, 20,
+			247, 255, 52, 91, 216, 41, 95, 70, 211, 113, 229, 189, 155, 121,
+			59, 253, 35, 163, 211, 161, 97, 249, 130, 179, 65, 252, 205, 187,
+			203, 179, 65, 4, 206, 187, 217, 228, 108, 209, 32, 160, 97, 126,
+			65, 254, 41, 163, 233, 132, 242, 222, 199, 188, 101, 176, 184, 182,
+			198, 177, 161, 130, 79, 2, 250, 250, 22, 174, 239, 119, 113, 229,
+			32, 216, 19, 151, 206, 130, 3, 161, 150, 71, 38, 22, 233, 186,
+			214, 36, 208, 39, 203, 121, 49, 114, 84, 179, 29, 138, 27, 92,
+			51, 235, 49, 152, 222, 37, 105, 22, 96, 220, 149, 51, 85, 74,
+			244, 16, 85, 92, 220, 238, 162, 129, 65, 131, 127, 168, 104, 192,
+			229, 31, 189, 85, 246, 129, 117, 33, 191, 240, 131, 140, 239, 243,
+			215, 32, 59, 220, 5, 191, 150, 247, 192, 242, 231, 86, 171, 104,
+			11, 41, 244, 165, 48, 128, 56, 242, 176, 19, 81, 97, 189, 65,
+			210, 189, 232, 236, 60, 23, 9, 198, 33, 162, 207, 251, 32, 227,
+			83, 14, 100, 128, 193, 244, 146, 3, 5, 128, 123, 246, 202, 38,
+			150, 101, 168, 126, 132, 141, 253, 136, 49, 255, 180, 46, 63, 212,
+			92, 167, 178, 134, 159, 148, 111, 53, 136, 120, 131, 248, 225, 143,
+			176, 218, 130, 188, 19, 146, 235, 33, 124, 246, 25, 198, 63, 193,
+			132, 127, 80, 211, 179, 115, 89, 124, 4, 58, 163, 70, 244, 74,
+			209, 34, 176, 254, 187, 247, 12, 27, 183, 133, 77, 4, 184, 195,
+			149, 247, 81, 230, 77, 251, 119, 233, 83, 113, 182, 145, 135, 227,
+			225, 5, 229, 130, 239, 52, 61, 131, 230, 114, 180, 116, 215, 195,
+			246, 8, 138, 125, 253, 104, 94, 29, 128, 98, 95, 63, 202, 38,
+			167, 240, 180, 64, 15, 76, 24, 246, 166, 252, 35, 250, 81, 48,
+			172, 242, 153, 174, 99, 112, 56, 138, 31, 99, 222, 120, 209, 128,
+			137, 214, 114, 50, 31, 156, 43, 239, 227, 204, 155, 116, 131, 223,
+			8, 230, 112, 242, 62, 206, 188, 106, 209, 128, 131, 77, 72, 204,
+			100, 133, 58, 25, 222, 39, 217, 245, 169, 179, 211, 174, 104, 6,
+			124, 81, 115, 32, 83, 222, 39, 217, 196, 172, 3, 5, 128, 243,
+			11, 242, 185, 154, 171, 146, 240, 69, 198, 149, 255, 149, 26, 142,
+			111, 203, 35, 129, 129, 214, 51, 80, 58, 134, 204, 57, 208, 60,
+			203, 134, 38, 152, 242, 233, 96, 45, 205, 194, 108, 144, 129, 82,
+			219, 233, 198, 107, 250, 112, 253, 104, 253, 8, 218, 215, 165, 124,
+			26, 248, 20, 46, 208, 94, 63, 142, 48, 43, 246, 2, 156, 247,
+			16, 222, 142, 34, 151, 56, 91, 50, 109, 201, 175, 107, 205, 29,
+			42, 186, 69, 76, 250, 248, 32, 232, 134, 235, 152, 109, 63, 252,
+			170, 20, 102, 185, 231, 20, 98, 76, 131, 172, 52, 59, 110, 51,
+			30, 78, 119, 77, 194, 137, 29, 68, 232, 35, 193, 242, 254, 221,
+			118, 43, 72, 218, 168, 69, 83, 164, 42, 184, 195, 11, 140, 93,
+			16, 48, 121, 250, 214, 98, 231, 49, 234, 23, 105, 239, 168, 197,
+			89, 218, 229, 223, 165, 13, 93, 63, 122, 180, 158, 47, 11, 236,
+			216, 98, 89, 165, 110, 133, 66, 225, 220, 88, 86, 89, 183, 227,
+			229, 117, 110, 10, 63, 22, 254, 74, 102, 116, 156, 232, 195, 245,
+			91, 235, 71, 74, 78, 244, 53, 163, 65, 236, 130, 108, 129, 156,
+			238, 245, 82, 46, 4, 72, 0, 64, 10, 172, 136, 51, 214, 109,
+			152, 82, 81, 165, 51, 189, 126, 182, 169, 15, 215, 235, 71, 134,
+			252, 116, 128, 53, 5, 118, 52, 108, 199, 163, 71, 87, 110, 93,
+			57, 122, 244, 26, 189, 214, 227, 120, 101, 45, 72, 94, 160, 99,
+			238, 123, 211, 117, 234, 92, 39, 44, 183, 12, 177, 114, 235, 202,
+			90, 240, 196, 85, 7, 194, 184, 238, 200, 197, 253, 14, 15, 9,
+			67, 233, 209, 173, 106, 235, 250, 90, 240, 68, 93, 31, 6, 31,
+			194, 114, 222, 121, 229, 241, 193, 149, 149, 110, 220, 181, 211, 213,
+			169, 78, 147, 251, 241, 133, 22, 109, 215, 18, 188, 208, 74, 174,
+			181, 8, 26, 33, 187, 28, 31, 203, 121, 195, 225, 125, 121, 35,
+			78, 13, 12, 165, 41, 153, 48, 127, 39, 128, 9, 235, 200, 130,
+			216, 7, 87, 135, 155, 12, 235, 27, 166, 227, 213, 103, 30, 254,
+			210, 45, 129, 190, 62, 186, 114, 173, 29, 28, 194, 24, 16, 112,
+			218, 161, 0, 203, 207, 251, 98, 33, 139, 64, 144, 126, 209, 101,
+			213, 11, 208, 217, 189, 47, 178, 217, 57, 217, 70, 81, 196, 149,
+			247, 37, 198, 231, 252, 159, 45, 91, 127, 128, 109, 201, 248, 163,
+			153, 15, 81, 10, 243, 168, 245, 151, 155, 165, 241, 186, 126, 19,
+			86, 15, 0, 217, 112, 206, 62, 115, 218, 73, 65, 245, 249, 18,
+			227, 85, 7, 50, 0, 199, 167, 28, 40, 0, 156, 153, 149, 191,
+			4, 118, 132, 224, 66, 121, 207, 2, 78, 79, 20, 56, 161, 207,
+			123, 232, 34, 205, 11, 231, 103, 113, 89, 200, 131, 174, 177, 141,
+			94, 47, 233, 207, 55, 20, 152, 182, 77, 169, 27, 24, 28, 133,
+			208, 43, 72, 9, 249, 60, 207, 22, 120, 195, 125, 252, 108, 129,
+			55, 228, 243, 60, 203, 102, 102, 229, 147, 136, 182, 167, 188, 175,
+			129, 84, 239, 235, 179, 230, 74, 134, 106, 96, 201, 179, 184, 229,
+			111, 50, 132, 41, 9, 27, 170, 66, 227, 74, 116, 57, 209, 135,
+			138, 128, 44, 253, 93, 138, 126, 98, 46, 133, 240, 106, 96, 63,
+			235, 154, 117, 48, 21, 214, 115, 100, 33, 21, 231, 107, 197, 190,
+			123, 12, 192, 124, 223, 33, 21, 231, 107, 176, 239, 88, 182, 68,
+			192, 142, 124, 147, 241, 37, 48, 89, 95, 157, 135, 56, 57, 101,
+			101, 235, 51, 154, 157, 211, 93, 173, 197, 11, 167, 149, 163, 195,
+			35, 228, 15, 96, 131, 126, 31, 28, 48, 32, 245, 243, 219, 216,
+			209, 161, 221, 208, 15, 197, 151, 193, 145, 136, 198, 88, 225, 66,
+			165, 73, 232, 17, 46, 255, 43, 43, 107, 32, 169, 215, 220, 253,
+			123, 149, 48, 2, 187, 242, 138, 93, 219, 56, 173, 28, 82, 54,
+			190, 201, 106, 243, 14, 20, 0, 46, 238, 146, 27, 72, 135, 170,
+			242, 158, 99, 124, 143, 255, 6, 87, 20, 231, 194, 102, 223, 140,
+			110, 30, 20, 102, 72, 194, 86, 150, 150, 41, 48, 124, 32, 75,
+			55, 137, 28, 245, 206, 218, 137, 171, 21, 156, 202, 237, 15, 164,
+			51, 60, 231, 170, 169, 8, 40, 254, 239, 61, 199, 150, 124, 171,
+			129, 128, 153, 246, 103, 140, 255, 23, 38, 92, 217, 40, 186, 179,
+			55, 251, 232, 58, 114, 137, 32, 145, 27, 29, 45, 177, 63, 99,
+			210, 151, 119, 80, 73, 168, 49, 229, 125, 151, 121, 7, 252, 91,
+			240, 251, 34, 232, 145, 110, 237, 145, 65, 92, 61, 39, 168, 53,
+			240, 93, 230, 45, 20, 13, 12, 26, 118, 250, 69, 131, 128, 134,
+			125, 251, 169, 226, 211, 184, 242, 254, 156, 241, 91, 104, 21, 227,
+			85, 4, 149, 3, 25, 128, 243, 251, 29, 40, 0, 188, 233, 102,
+			121, 1, 63, 173, 41, 239, 47, 25, 63, 228, 63, 160, 207, 98,
+			56, 201, 11, 82, 153, 254, 128, 151, 14, 214, 51, 122, 5, 36,
+			125, 3, 98, 78, 130, 172, 32, 115, 173, 138, 195, 238, 113, 32,
+			3, 112, 239, 77, 14, 20, 0, 222, 242, 18, 249, 24, 162, 48,
+			161, 188, 191, 2, 20, 30, 212, 143, 118, 219, 215, 139, 2, 25,
+			57, 47, 128, 195, 68, 21, 199, 117, 56, 76, 48, 0, 115, 28,
+			38, 4, 128, 183, 188, 68, 62, 129, 56, 72, 229, 253, 87, 198,
+			247, 250, 221, 145, 183, 136, 156, 181, 157, 220, 203, 17, 202, 240,
+			242, 176, 151, 207, 150, 63, 217, 4, 11, 136, 58, 114, 72, 179,
+			203, 117, 28, 234, 148, 35, 42, 43, 56, 185, 227, 73, 201, 0,
+			156, 112, 245, 187, 164, 0, 112, 247, 30, 249, 93, 240, 196, 10,
+			62, 169, 188, 255, 198, 184, 246, 191, 198, 53, 196, 203, 58, 105,
+			65, 89, 72, 16, 233, 11, 188, 153, 227, 141, 104, 91, 193, 1,
+			103, 4, 244, 159, 147, 240, 33, 217, 105, 160, 252, 229, 62, 218,
+			19, 82, 31, 211, 39, 75, 213, 70, 241, 59, 76, 199, 182, 143,
+			16, 16, 180, 57, 68, 7, 240, 230, 231, 83, 193, 174, 224, 91,
+			120, 10, 23, 168, 165, 76, 6, 213, 76, 173, 59, 139, 132, 110,
+			49, 122, 63, 8, 147, 70, 62, 37, 233, 1, 145, 123, 78, 213,
+			135, 163, 176, 123, 196, 30, 148, 107, 160, 0, 211, 229, 88, 100,
+			169, 195, 194, 253, 249, 150, 75, 206, 207, 24, 116, 96, 109, 203,
+			87, 83, 162, 243, 13, 153, 172, 34, 141, 157, 84, 152, 100, 0,
+			82, 173, 15, 193, 39, 5, 128, 251, 14, 200, 215, 226, 126, 76,
+			41, 239, 239, 160, 226, 210, 170, 62, 103, 255, 62, 76, 193, 190,
+			5, 233, 75, 12, 92, 32, 21, 39, 248, 127, 120, 95, 41, 253,
+			125, 153, 28, 139, 169, 42, 142, 236, 10, 183, 77, 49, 0, 165,
+			51, 103, 166, 4, 128, 243, 59, 229, 5, 91, 183, 237, 239, 217,
+			216, 135, 57, 243, 31, 112, 118, 239, 141, 121, 115, 183, 181, 124,
+			225, 242, 250, 123, 86, 219, 137, 46, 106, 44, 166, 246, 99, 176,
+			199, 238, 189, 182, 71, 23, 84, 37, 55, 229, 176, 83, 151, 138,
+			153, 97, 9, 1, 186, 37, 61, 20, 111, 63, 118, 150, 154, 135,
+			194, 237, 199, 108, 126, 65, 222, 15, 243, 130, 20, 126, 11, 231,
+			191, 198, 133, 127, 59, 149, 51, 26, 54, 184, 193, 233, 222, 117,
+			132, 46, 47, 180, 72, 145, 245, 80, 50, 191, 133, 203, 89, 217,
+			144, 85, 24, 19, 86, 243, 86, 72, 8, 221, 143, 74, 146, 91,
+			74, 201, 117, 67, 111, 14, 32, 113, 61, 42, 9, 242, 86, 78,
+			142, 35, 143, 100, 242, 91, 57, 213, 3, 244, 72, 38, 191, 149,
+			171, 121, 249, 23, 140, 230, 0, 31, 32, 247, 246, 249, 95, 103,
+			228, 45, 222, 58, 203, 191, 96, 215, 180, 91, 55, 20, 24, 252,
+			101, 238, 169, 156, 16, 160, 236, 254, 50, 159, 95, 42, 26, 192,
+			251, 201, 247, 236, 149, 223, 227, 68, 25, 240, 224, 113, 239, 144,
+			255, 13, 251, 158, 4, 170, 223, 49, 120, 144, 52, 237, 171, 16,
+			199, 73, 88, 160, 197, 201, 50, 138, 102, 164, 176, 76, 254, 244,
+			135, 219, 90, 168, 5, 168, 228, 83, 69, 31, 103, 117, 58, 150,
+			217, 142, 110, 97, 90, 148, 49, 46, 225, 65, 26, 148, 44, 92,
+			218, 142, 178, 87, 241, 131, 159, 222, 242, 237, 213, 188, 225, 69,
+			79, 59, 210, 150, 238, 37, 37, 167, 208, 154, 11, 220, 100, 126,
+			13, 149, 54, 7, 156, 226, 239, 230, 222, 190, 162, 1, 252, 157,
+			124, 127, 189, 104, 0, 127, 39, 63, 248, 18, 249, 74, 218, 27,
+			161, 188, 95, 229, 222, 146, 127, 155, 190, 48, 60, 85, 105, 103,
+			78, 111, 187, 51, 110, 72, 208, 216, 127, 149, 123, 19, 69, 3,
+			131, 6, 185, 80, 52, 224, 36, 139, 187, 80, 9, 193, 98, 134,
+			239, 227, 124, 191, 255, 0, 78, 233, 94, 199, 135, 36, 166, 205,
+			166, 132, 172, 108, 123, 213, 7, 229, 11, 217, 57, 99, 113, 67,
+			115, 41, 3, 53, 31, 222, 231, 106, 62, 120, 88, 8, 243, 125,
+			60, 47, 63, 6, 92, 250, 62, 174, 118, 59, 16, 220, 156, 124,
+			239, 62, 249, 167, 121, 69, 193, 15, 65, 102, 235, 151, 153, 94,
+			125, 97, 27, 194, 37, 234, 247, 226, 164, 96, 41, 186, 155, 138,
+			136, 94, 88, 89, 90, 220, 90, 219, 29, 230, 196, 244, 77, 144,
+			31, 231, 215, 148, 153, 51, 223, 122, 73, 133, 7, 129, 185, 45,
+			163, 130, 9, 140, 170, 197, 102, 30, 204, 87, 114, 9, 25, 180,
+			131, 134, 94, 74, 108, 73, 193, 15, 185, 36, 84, 15, 147, 80,
+			63, 196, 201, 72, 129, 199, 10, 0, 103, 231, 228, 151, 169, 122,
+			218, 199, 248, 216, 23, 57, 243, 255, 35, 167, 108, 234, 27, 115,
+			170, 218, 111, 202, 119, 139, 107, 234, 198, 241, 155, 209, 193, 77,
+			89, 179, 168, 44, 193, 34, 2, 87, 246, 218, 173, 97, 36, 39,
+			27, 142, 102, 215, 180, 50, 42, 15, 48, 44, 252, 80, 110, 83,
+			129, 16, 211, 30, 177, 245, 109, 90, 53, 189, 19, 244, 147, 24,
+			34, 18, 178, 13, 179, 137, 9, 213, 67, 113, 14, 144, 116, 221,
+			135, 211, 125, 158, 6, 200, 163, 63, 193, 36, 201, 179, 197, 55,
+			141, 11, 9, 13, 108, 76, 196, 246, 127, 186, 143, 110, 84, 176,
+			130, 62, 198, 107, 59, 209, 190, 192, 34, 105, 31, 231, 215, 239,
+			225, 172, 192, 99, 168, 247, 113, 183, 113, 182, 8, 217, 199, 57,
+			221, 155, 182, 8, 217, 199, 249, 252, 130, 43, 109, 193, 148, 247,
+			41, 96, 225, 79, 179, 194, 149, 73, 214, 46, 146, 207, 113, 90,
+			153, 176, 160, 168, 32, 141, 242, 87, 252, 13, 58, 105, 200, 119,
+			54, 72, 4, 236, 251, 178, 123, 61, 167, 122, 57, 40, 79, 230,
+			14, 149, 21, 112, 6, 82, 66, 123, 122, 189, 142, 48, 90, 19,
+			248, 81, 62, 85, 172, 24, 14, 237, 167, 28, 171, 86, 240, 98,
+			249, 20, 159, 157, 147, 239, 204, 11, 181, 124, 6, 8, 250, 164,
+			91, 48, 24, 168, 197, 154, 128, 215, 96, 93, 167, 54, 53, 5,
+			67, 210, 59, 37, 70, 234, 116, 161, 106, 57, 188, 101, 128, 123,
+			23, 212, 119, 148, 236, 117, 155, 185, 84, 47, 238, 74, 138, 32,
+			142, 116, 243, 204, 113, 176, 152, 59, 131, 110, 80, 14, 70, 202,
+			113, 135, 99, 246, 153, 2, 119, 56, 102, 159, 41, 118, 11, 142,
+			217, 103, 96, 183, 32, 133, 29, 171, 174, 124, 22, 54, 235, 142,
+			194, 223, 146, 149, 214, 144, 207, 248, 66, 19, 130, 220, 253, 44,
+			39, 79, 73, 5, 165, 238, 103, 161, 60, 7, 129, 56, 197, 236,
+			28, 170, 85, 88, 248, 228, 115, 156, 239, 242, 239, 188, 234, 132,
+			120, 30, 77, 27, 85, 238, 99, 97, 148, 154, 8, 92, 202, 151,
+			76, 119, 51, 159, 17, 220, 29, 159, 43, 102, 4, 141, 241, 115,
+			124, 92, 57, 80, 0, 184, 115, 81, 126, 149, 185, 130, 16, 159,
+			135, 172, 249, 207, 95, 159, 187, 35, 55, 158, 254, 249, 29, 29,
+			215, 231, 229, 168, 160, 151, 227, 243, 174, 74, 65, 133, 195, 249,
+			254, 60, 39, 47, 71, 5, 189, 28, 159, 231, 139, 187, 228, 47,
+			216, 245, 87, 149, 247, 5, 216, 227, 228, 186, 157, 83, 196, 183,
+			219, 123, 167, 64, 9, 129, 152, 167, 109, 188, 83, 244, 221, 136,
+			123, 170, 130, 238, 143, 47, 20, 44, 9, 238, 143, 47, 20, 199,
+			169, 42, 0, 156, 157, 147, 63, 107, 235, 104, 252, 1, 31, 251,
+			43, 206, 252, 135, 72, 96, 223, 168, 81, 177, 85, 242, 187, 10,
+			18, 127, 192, 107, 139, 104, 85, 96, 5, 137, 47, 243, 23, 195,
+			170, 176, 37, 35, 190, 236, 22, 87, 69, 233, 248, 101, 119, 222,
+			108, 201, 136, 47, 195, 121, 195, 121, 193, 170, 120, 150, 243, 63,
+			133, 50, 56, 250, 213, 192, 104, 67, 245, 25, 225, 66, 42, 191,
+			205, 180, 73, 237, 194, 5, 209, 17, 113, 243, 162, 109, 241, 44,
+			151, 51, 104, 91, 84, 173, 109, 241, 213, 235, 181, 45, 170, 100,
+			91, 124, 213, 217, 22, 85, 178, 45, 190, 234, 108, 139, 42, 217,
+			22, 95, 5, 219, 226, 33, 154, 130, 41, 239, 235, 48, 197, 61,
+			101, 199, 212, 80, 41, 190, 226, 45, 150, 222, 79, 114, 113, 15,
+			117, 3, 139, 185, 64, 194, 126, 221, 41, 107, 85, 82, 223, 191,
+			14, 203, 201, 27, 4, 52, 168, 121, 249, 40, 77, 206, 149, 247,
+			13, 238, 205, 251, 63, 163, 47, 140, 22, 0, 68, 194, 93, 118,
+			213, 197, 74, 88, 148, 10, 192, 231, 165, 9, 243, 25, 64, 82,
+			126, 163, 140, 3, 200, 202, 111, 112, 185, 163, 104, 16, 208, 48,
+			167, 228, 43, 8, 7, 161, 188, 111, 1, 1, 108, 48, 224, 80,
+			145, 112, 252, 35, 10, 46, 78, 127, 248, 15, 66, 20, 203, 6,
+			89, 249, 173, 50, 205, 65, 90, 126, 171, 76, 115, 240, 44, 127,
+			11, 104, 30, 187, 26, 35, 223, 129, 66, 81, 193, 144, 142, 154,
+			235, 44, 176, 240, 116, 89, 119, 146, 120, 208, 207, 29, 33, 110,
+			99, 64, 71, 115, 229, 219, 160, 158, 17, 80, 76, 186, 58, 123,
+			88, 141, 164, 232, 156, 179, 51, 168, 175, 223, 113, 234, 107, 21,
+			213, 215, 239, 184, 146, 101, 182, 62, 201, 119, 92, 201, 178, 42,
+			222, 132, 223, 1, 19, 235, 255, 17, 174, 64, 201, 247, 64, 212,
+			252, 45, 191, 33, 245, 149, 56, 252, 197, 208, 95, 233, 244, 95,
+			69, 129, 165, 226, 112, 55, 164, 188, 234, 147, 133, 200, 1, 191,
+			21, 56, 79, 40, 9, 14, 115, 62, 234, 165, 204, 225, 226, 245,
+			241, 112, 156, 64, 250, 15, 10, 205, 160, 219, 61, 34, 201, 208,
+			6, 49, 143, 35, 4, 40, 141, 157, 163, 41, 13, 161, 102, 103,
+			46, 8, 28, 250, 24, 147, 156, 182, 2, 248, 123, 224, 246, 111,
+			230, 130, 242, 89, 220, 85, 197, 254, 185, 203, 161, 138, 106, 246,
+			247, 10, 121, 4, 28, 244, 61, 39, 108, 109, 173, 151, 239, 129,
+			238, 2, 14, 156, 113, 85, 253, 27, 62, 246, 63, 208, 129, 227,
+			82, 59, 111, 68, 201, 182, 223, 140, 138, 218, 113, 166, 188, 191,
+			113, 234, 230, 56, 136, 165, 239, 223, 128, 186, 57, 142, 2, 245,
+			251, 110, 1, 227, 40, 80, 191, 239, 4, 234, 56, 10, 212, 239,
+			131, 64, 69, 229, 107, 28, 150, 247, 67, 96, 185, 39, 175, 17,
+			34, 106, 75, 123, 253, 211, 69, 137, 90, 236, 64, 172, 253, 176,
+			192, 29, 142, 203, 15, 29, 241, 199, 241, 184, 252, 16, 136, 15,
+			127, 168, 122, 28, 78, 203, 243, 156, 207, 249, 231, 11, 93, 136,
+			104, 81, 28, 147, 107, 132, 95, 58, 142, 197, 103, 74, 153, 51,
+			106, 142, 15, 48, 195, 243, 78, 83, 26, 71, 155, 235, 121, 78,
+			175, 88, 227, 200, 12, 207, 243, 153, 89, 249, 50, 196, 71, 40,
+			239, 71, 252, 70, 19, 233, 236, 192, 32, 215, 126, 228, 52, 146,
+			113, 212, 1, 127, 228, 234, 38, 141, 99, 48, 207, 143, 96, 221,
+			175, 151, 220, 171, 169, 234, 63, 240, 177, 119, 10, 230, 191, 138,
+			24, 168, 124, 195, 151, 142, 128, 107, 140, 174, 202, 112, 135, 74,
+			190, 42, 224, 188, 26, 83, 222, 63, 192, 37, 255, 128, 244, 188,
+			26, 112, 222, 79, 248, 63, 42, 24, 20, 208, 175, 33, 67, 254,
+			196, 109, 106, 13, 25, 242, 39, 142, 33, 107, 200, 144, 63, 1,
+			134, 252, 24, 48, 100, 13, 72, 252, 148, 224, 123, 252, 255, 255,
+			167, 14, 8, 181, 50, 15, 164, 35, 158, 255, 194, 19, 83, 222,
+			236, 156, 64, 16, 216, 1, 85, 219, 224, 143, 222, 145, 179, 237,
+			170, 190, 37, 96, 178, 124, 89, 32, 218, 159, 18, 220, 173, 3,
+			120, 245, 41, 49, 183, 232, 64, 1, 224, 110, 248, 67, 61, 176,
+			42, 8, 240, 20, 252, 32, 253, 8, 254, 157, 183, 11, 10, 122,
+			172, 33, 91, 189, 93, 80, 208, 99, 13, 217, 234, 237, 226, 230,
+			91, 228, 123, 44, 69, 132, 242, 222, 33, 248, 205, 249, 159, 227,
+			54, 87, 178, 114, 38, 212, 26, 92, 102, 35, 249, 122, 224, 240,
+			24, 209, 47, 146, 118, 17, 31, 51, 76, 129, 66, 44, 219, 204,
+			187, 148, 116, 92, 208, 9, 240, 158, 113, 247, 137, 222, 52, 78,
+			204, 212, 48, 146, 242, 29, 130, 46, 186, 26, 134, 164, 189, 67,
+			80, 36, 101, 13, 57, 248, 29, 130, 34, 41, 107, 200, 193, 239,
+			16, 55, 213, 229, 89, 201, 171, 99, 170, 250, 46, 49, 246, 235,
+			130, 249, 175, 4, 185, 145, 219, 18, 192, 162, 199, 214, 131, 22,
+			165, 93, 234, 160, 133, 37, 66, 97, 123, 30, 31, 242, 116, 131,
+			123, 235, 82, 216, 34, 251, 188, 10, 12, 245, 46, 81, 155, 146,
+			15, 74, 175, 138, 122, 220, 211, 130, 47, 251, 47, 195, 104, 103,
+			247, 36, 111, 185, 197, 213, 128, 199, 39, 109, 96, 157, 252, 188,
+			20, 124, 102, 87, 8, 3, 65, 128, 164, 168, 78, 56, 144, 3,
+			40, 23, 28, 40, 0, 60, 112, 20, 30, 20, 170, 168, 218, 189,
+			71, 240, 134, 191, 138, 233, 6, 36, 96, 210, 45, 233, 2, 35,
+			130, 149, 132, 212, 246, 169, 2, 118, 30, 224, 171, 247, 136, 106,
+			14, 114, 0, 39, 23, 29, 8, 69, 79, 197, 77, 203, 242, 44,
+			98, 193, 149, 247, 94, 193, 143, 251, 175, 204, 29, 81, 118, 245,
+			165, 41, 65, 196, 143, 36, 231, 228, 147, 26, 162, 116, 62, 57,
+			44, 235, 189, 162, 58, 233, 64, 28, 127, 106, 201, 129, 2, 192,
+			155, 111, 147, 15, 227, 228, 66, 121, 239, 23, 252, 78, 255, 190,
+			66, 139, 40, 102, 47, 149, 88, 219, 110, 218, 33, 237, 28, 198,
+			98, 48, 88, 117, 202, 129, 28, 192, 105, 223, 129, 56, 213, 193,
+			219, 229, 191, 230, 56, 179, 167, 188, 143, 8, 126, 159, 255, 171,
+			220, 137, 56, 231, 171, 184, 214, 102, 131, 83, 36, 132, 151, 156,
+			86, 220, 51, 67, 170, 19, 22, 64, 207, 115, 97, 64, 154, 164,
+			244, 39, 142, 70, 207, 158, 185, 218, 113, 91, 30, 46, 243, 56,
+			188, 98, 74, 167, 44, 25, 2, 46, 5, 57, 45, 254, 32, 126,
+			129, 168, 132, 60, 134, 210, 159, 197, 71, 169, 69, 185, 70, 160,
+			45, 59, 149, 61, 52, 169, 61, 203, 248, 56, 5, 40, 231, 52,
+			5, 59, 255, 35, 5, 77, 61, 14, 96, 78, 83, 8, 216, 252,
+			136, 56, 120, 171, 3, 5, 128, 203, 39, 214, 170, 253, 36, 206,
+			226, 219, 255, 231, 0, 54, 110, 17, 33, 222, 139, 0, 0},
 	)
 }
 
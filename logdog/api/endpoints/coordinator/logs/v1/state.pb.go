@@ -37,7 +37,13 @@ type LogStreamState struct {
 	Archive *LogStreamState_ArchiveInfo `protobuf:"bytes,4,opt,name=archive,proto3" json:"archive,omitempty"`
 	// Indicates the purged state of a log. A log that has been purged is only
 	// acknowledged to administrative clients.
-	Purged               bool     `protobuf:"varint,5,opt,name=purged,proto3" json:"purged,omitempty"`
+	Purged bool `protobuf:"varint,5,opt,name=purged,proto3" json:"purged,omitempty"`
+	// The number of bytes of stream data that the Butler dropped, rather than
+	// sending, because of its limits. Known once the stream is terminated.
+	DroppedBytes int64 `protobuf:"varint,6,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	// The number of log entries (lines or datagrams) that the Butler dropped
+	// because of its limits. Known once the stream is terminated.
+	DroppedEntries       int64    `protobuf:"varint,7,opt,name=dropped_entries,json=droppedEntries,proto3" json:"dropped_entries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -103,6 +109,20 @@ func (m *LogStreamState) GetPurged() bool {
 	return false
 }
 
+func (m *LogStreamState) GetDroppedBytes() int64 {
+	if m != nil {
+		return m.DroppedBytes
+	}
+	return 0
+}
+
+func (m *LogStreamState) GetDroppedEntries() int64 {
+	if m != nil {
+		return m.DroppedEntries
+	}
+	return 0
+}
+
 // ArchiveInfo contains archive details for the log stream.
 type LogStreamState_ArchiveInfo struct {
 	// The Google Storage URL where the log stream's index is archived.
@@ -192,28 +212,30 @@ func init() {
 }
 
 var fileDescriptor_e3bfde41f3abf9e4 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcd, 0x6a, 0xe3, 0x30,
-	0x14, 0x85, 0x71, 0x3c, 0x93, 0x38, 0xca, 0x24, 0x03, 0x5a, 0x0c, 0x1e, 0x0f, 0xc3, 0x98, 0x0c,
-	0x33, 0x78, 0x25, 0xd1, 0x9f, 0x65, 0x37, 0xa5, 0x74, 0x11, 0xe8, 0xca, 0x69, 0xbb, 0x35, 0x8a,
-	0xad, 0x28, 0x02, 0x59, 0xd7, 0xc8, 0x72, 0x68, 0x9f, 0xa3, 0x2f, 0xd1, 0xc7, 0x2c, 0x92, 0xe2,
-	0xd2, 0x2e, 0xef, 0x77, 0xce, 0xd5, 0x39, 0x57, 0x68, 0x23, 0x80, 0xd4, 0x07, 0x03, 0xad, 0x1c,
-	0x5a, 0x02, 0x46, 0x50, 0x35, 0xd4, 0x92, 0x2a, 0x10, 0x0d, 0x08, 0xca, 0x3a, 0x49, 0xb9, 0x6e,
-	0x3a, 0x90, 0xda, 0xf6, 0xb4, 0x06, 0x30, 0x8d, 0xd4, 0xcc, 0x82, 0x71, 0x86, 0x9e, 0x1e, 0xcf,
-	0x68, 0x6f, 0x99, 0xe5, 0xa4, 0x33, 0x60, 0x01, 0x4f, 0xc3, 0x56, 0xf6, 0x47, 0x00, 0x08, 0xc5,
-	0xa9, 0xa7, 0xbb, 0x61, 0x4f, 0xad, 0x6c, 0x79, 0x6f, 0x59, 0xdb, 0x05, 0xe3, 0xfa, 0x25, 0x46,
-	0xab, 0x3b, 0x10, 0x5b, 0x6b, 0x38, 0x6b, 0xb7, 0xee, 0x05, 0xfc, 0x17, 0x2d, 0xbd, 0x56, 0x1d,
-	0xb9, 0xe9, 0x25, 0xe8, 0x34, 0xca, 0xa3, 0x62, 0x5e, 0x7e, 0xf3, 0xf0, 0x31, 0x30, 0x7c, 0x89,
-	0x66, 0xb5, 0xe1, 0xcc, 0xf2, 0x26, 0x9d, 0xe4, 0x51, 0xb1, 0x38, 0xcf, 0x48, 0x88, 0x22, 0x63,
-	0x14, 0xb9, 0x1f, 0xa3, 0xca, 0xd1, 0x8a, 0xff, 0xa1, 0x95, 0xe5, 0xa6, 0x95, 0x9a, 0xa9, 0x4a,
-	0xea, 0x86, 0x3f, 0xa5, 0x71, 0x1e, 0x15, 0x71, 0xb9, 0x1c, 0xe9, 0xc6, 0x41, 0x7c, 0x85, 0x66,
-	0xcc, 0xd4, 0x07, 0x79, 0xe4, 0xe9, 0x17, 0xff, 0xf8, 0x9a, 0x84, 0x7b, 0xc8, 0xe7, 0xaa, 0xe4,
-	0x3a, 0xb8, 0x36, 0x7a, 0x0f, 0xe5, 0xb8, 0x82, 0x7f, 0xa0, 0x69, 0x37, 0x18, 0xc1, 0x9b, 0xf4,
-	0x6b, 0x1e, 0x15, 0x49, 0x79, 0x9a, 0xb2, 0xd7, 0x08, 0x2d, 0x3e, 0x2c, 0xe0, 0x5f, 0x68, 0xee,
-	0x3b, 0x54, 0x83, 0x51, 0xa7, 0x1b, 0x13, 0x0f, 0x1e, 0x8c, 0xc2, 0xbf, 0x11, 0xea, 0x7d, 0x90,
-	0x57, 0x27, 0x5e, 0x9d, 0x07, 0xe2, 0xe4, 0x9f, 0x28, 0x69, 0x98, 0x65, 0x5e, 0x8c, 0xbd, 0x38,
-	0x73, 0xb3, 0x93, 0x32, 0x94, 0xd4, 0xd0, 0x76, 0x8a, 0xdb, 0xd0, 0x3e, 0x29, 0xdf, 0x67, 0xfc,
-	0x1f, 0x7d, 0x57, 0x20, 0x2a, 0xae, 0xad, 0x79, 0xae, 0x6a, 0x18, 0xb4, 0xf5, 0x1d, 0xe3, 0x72,
-	0xa9, 0x40, 0xdc, 0x3a, 0x7a, 0xe3, 0xe0, 0x6e, 0xea, 0x3f, 0xf1, 0xe2, 0x2d, 0x00, 0x00, 0xff,
-	0xff, 0x06, 0xd9, 0xf9, 0xb9, 0x12, 0x02, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0x8e, 0xd3, 0x30,
+	0x14, 0x85, 0x95, 0x09, 0xb4, 0xa9, 0x67, 0xda, 0x91, 0xbc, 0x40, 0xa1, 0x08, 0x51, 0x0d, 0x02,
+	0xba, 0xb2, 0xc5, 0xcf, 0x92, 0x0d, 0x20, 0x16, 0x95, 0x58, 0x65, 0x80, 0x6d, 0xe4, 0xc6, 0x77,
+	0x3c, 0x96, 0x1c, 0xdf, 0xc8, 0x76, 0x2a, 0xfa, 0x46, 0xbc, 0x16, 0x6f, 0x82, 0x6c, 0x27, 0x88,
+	0x59, 0xde, 0xef, 0x1c, 0xfb, 0xdc, 0x63, 0x93, 0x83, 0x42, 0xd6, 0xdd, 0x3b, 0xec, 0xf5, 0xd8,
+	0x33, 0x74, 0x8a, 0x9b, 0xb1, 0xd3, 0xdc, 0xa0, 0x92, 0xa8, 0xb8, 0x18, 0x34, 0x07, 0x2b, 0x07,
+	0xd4, 0x36, 0x78, 0xde, 0x21, 0x3a, 0xa9, 0xad, 0x08, 0xe8, 0xa2, 0xc1, 0xf3, 0xd3, 0x5b, 0xee,
+	0x83, 0x08, 0xc0, 0x06, 0x87, 0x01, 0xe9, 0x22, 0x9f, 0xda, 0xbe, 0x50, 0x88, 0xca, 0x00, 0x4f,
+	0xf4, 0x38, 0xde, 0xf1, 0xa0, 0x7b, 0xf0, 0x41, 0xf4, 0x43, 0x36, 0xde, 0xfc, 0x29, 0xc9, 0xe6,
+	0x1b, 0xaa, 0xdb, 0xe0, 0x40, 0xf4, 0xb7, 0xf1, 0x06, 0xfa, 0x92, 0xac, 0x93, 0xd6, 0x9e, 0xc0,
+	0x79, 0x8d, 0xb6, 0x2e, 0x76, 0xc5, 0x7e, 0xd5, 0x5c, 0x25, 0xf8, 0x33, 0x33, 0xfa, 0x81, 0x2c,
+	0x3b, 0x07, 0x22, 0x80, 0xac, 0x2f, 0x76, 0xc5, 0xfe, 0xf2, 0xdd, 0x96, 0xe5, 0x28, 0x36, 0x47,
+	0xb1, 0xef, 0x73, 0x54, 0x33, 0x5b, 0xe9, 0x2b, 0xb2, 0x09, 0xe0, 0x7a, 0x6d, 0x85, 0x69, 0xb5,
+	0x95, 0xf0, 0xab, 0x2e, 0x77, 0xc5, 0xbe, 0x6c, 0xd6, 0x33, 0x3d, 0x44, 0x48, 0x3f, 0x92, 0xa5,
+	0x70, 0xdd, 0xbd, 0x3e, 0x41, 0xfd, 0x28, 0x5d, 0x7e, 0xc3, 0x72, 0x1f, 0xf6, 0x70, 0x55, 0xf6,
+	0x29, 0xbb, 0x0e, 0xf6, 0x0e, 0x9b, 0xf9, 0x08, 0x7d, 0x42, 0x16, 0xc3, 0xe8, 0x14, 0xc8, 0xfa,
+	0xf1, 0xae, 0xd8, 0x57, 0xcd, 0x34, 0xc5, 0x5e, 0xd2, 0xe1, 0x30, 0x80, 0x6c, 0x8f, 0xe7, 0x00,
+	0xbe, 0x5e, 0xa4, 0xec, 0xab, 0x09, 0x7e, 0x8e, 0x8c, 0xbe, 0x21, 0xd7, 0xb3, 0x09, 0x6c, 0x70,
+	0x1a, 0x7c, 0xbd, 0x4c, 0xb6, 0xcd, 0x84, 0xbf, 0x66, 0xba, 0xfd, 0x5d, 0x90, 0xcb, 0xff, 0xe2,
+	0xe9, 0x33, 0xb2, 0x4a, 0x8d, 0xda, 0xd1, 0x99, 0xe9, 0xc5, 0xaa, 0x04, 0x7e, 0x38, 0x43, 0x9f,
+	0x13, 0xe2, 0xd3, 0xda, 0x49, 0xbd, 0x48, 0xea, 0x2a, 0x93, 0x28, 0x3f, 0x25, 0x95, 0x14, 0x41,
+	0x24, 0xb1, 0x4c, 0xe2, 0x32, 0xce, 0x51, 0xda, 0x92, 0xaa, 0xc3, 0x7e, 0x30, 0x10, 0xf2, 0x5b,
+	0x54, 0xcd, 0xbf, 0x99, 0xbe, 0x26, 0xd7, 0x06, 0x55, 0xda, 0xf3, 0xdc, 0x76, 0x38, 0xda, 0x90,
+	0x1a, 0x97, 0xcd, 0xda, 0xa0, 0x8a, 0x7b, 0x9e, 0xbf, 0x44, 0x78, 0x5c, 0xa4, 0x2f, 0x79, 0xff,
+	0x77, 0x00, 0x0e, 0xcd, 0x46, 0xfc, 0x60, 0x02, 0x00, 0x00,
 }
@@ -22,3 +22,9 @@ func (r *TailRequest) GetMessageProject() string { return r.Project }
 
 // GetMessageProject implements ProjectBoundMessage.
 func (r *QueryRequest) GetMessageProject() string { return r.Project }
+
+// GetMessageProject implements ProjectBoundMessage.
+func (r *SearchRequest) GetMessageProject() string { return r.Project }
+
+// GetMessageProject implements ProjectBoundMessage.
+func (r *FollowRequest) GetMessageProject() string { return r.Project }
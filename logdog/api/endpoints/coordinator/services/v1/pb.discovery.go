@@ -12,737 +12,439 @@ func init() {
 			"logdog.Services",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 125, 109, 140, 28, 201,
-			117, 216, 84, 119, 207, 236, 108, 45, 201, 221, 237, 93, 146, 187,
-			179, 60, 178, 110, 120, 60, 238, 30, 103, 103, 191, 142, 71, 243,
-			235, 172, 253, 24, 114, 231, 110, 185, 187, 154, 153, 189, 111, 133,
-			236, 157, 169, 153, 233, 99, 79, 247, 92, 119, 207, 46, 151, 247,
-			133, 32, 178, 13, 3, 130, 20, 59, 112, 236, 216, 82, 100, 199,
-			128, 225, 88, 118, 164, 200, 150, 18, 73, 137, 33, 71, 184, 0,
-			49, 226, 192, 128, 0, 251, 143, 128, 192, 63, 244, 195, 206, 15,
-			255, 10, 144, 192, 64, 16, 188, 87, 85, 61, 61, 251, 65, 242,
-			20, 37, 1, 12, 18, 71, 105, 94, 117, 245, 123, 175, 222, 123,
-			245, 234, 213, 123, 85, 77, 250, 229, 1, 122, 167, 225, 229, 171,
-			77, 223, 107, 217, 157, 86, 222, 243, 27, 51, 78, 167, 106, 207,
-			56, 94, 163, 230, 53, 102, 172, 182, 61, 195, 221, 90, 219, 179,
-			221, 48, 152, 169, 122, 158, 95, 179, 93, 43, 244, 252, 153, 128,
-			251, 59, 118, 149, 7, 51, 59, 115, 51, 65, 104, 133, 60, 223,
-			246, 189, 208, 51, 83, 226, 205, 236, 239, 18, 122, 98, 205, 107,
-			148, 67, 159, 91, 173, 50, 116, 48, 207, 211, 227, 216, 231, 238,
-			14, 247, 3, 219, 115, 199, 8, 35, 147, 253, 165, 99, 216, 248,
-			154, 104, 51, 79, 209, 84, 192, 171, 62, 15, 199, 52, 70, 38,
-			143, 149, 36, 100, 94, 160, 39, 66, 238, 183, 108, 215, 114, 238,
-			218, 110, 141, 63, 24, 211, 25, 153, 212, 75, 199, 85, 107, 17,
-			26, 205, 12, 77, 91, 126, 181, 105, 239, 240, 218, 152, 193, 200,
-			100, 186, 20, 193, 128, 186, 221, 241, 27, 188, 54, 150, 196, 39,
-			18, 122, 229, 75, 253, 52, 101, 26, 70, 226, 25, 66, 191, 69,
-			40, 57, 102, 234, 70, 194, 156, 255, 61, 194, 150, 189, 246, 158,
-			111, 55, 154, 33, 155, 159, 157, 123, 137, 85, 154, 156, 173, 109,
-			45, 23, 217, 98, 39, 108, 122, 126, 144, 103, 139, 142, 195, 176,
-			67, 192, 124, 14, 18, 225, 181, 60, 101, 91, 1, 103, 94, 157,
-			133, 77, 59, 96, 129, 215, 241, 171, 156, 85, 189, 26, 103, 118,
-			192, 26, 222, 14, 247, 93, 94, 99, 29, 183, 198, 125, 22, 54,
-			57, 91, 108, 91, 85, 64, 108, 87, 185, 27, 240, 28, 147, 130,
-			96, 243, 249, 89, 202, 194, 166, 21, 178, 170, 229, 178, 109, 206,
-			234, 94, 199, 173, 49, 219, 197, 183, 214, 138, 203, 133, 245, 114,
-			129, 213, 109, 135, 231, 41, 77, 83, 162, 153, 122, 42, 125, 130,
-			86, 168, 102, 36, 76, 163, 63, 241, 12, 201, 172, 178, 94, 13,
-			0, 7, 240, 178, 227, 53, 88, 128, 205, 12, 85, 199, 118, 44,
-			167, 3, 76, 182, 90, 29, 215, 174, 90, 33, 175, 177, 208, 99,
-			74, 197, 121, 74, 41, 213, 141, 4, 49, 245, 254, 244, 41, 186,
-			66, 13, 35, 161, 37, 76, 125, 64, 155, 200, 92, 97, 155, 49,
-			237, 41, 2, 168, 209, 237, 78, 157, 73, 77, 179, 186, 231, 75,
-			121, 32, 217, 60, 165, 199, 105, 18, 176, 24, 166, 49, 160, 245,
-			143, 209, 99, 2, 76, 2, 214, 180, 130, 136, 169, 15, 244, 159,
-			82, 144, 110, 234, 3, 227, 25, 250, 21, 13, 233, 19, 83, 31,
-			209, 70, 50, 95, 210, 80, 45, 221, 17, 93, 12, 152, 176, 152,
-			60, 165, 108, 221, 11, 185, 144, 33, 112, 37, 218, 129, 71, 159,
-			135, 29, 80, 195, 179, 172, 2, 76, 217, 1, 243, 238, 91, 123,
-			57, 22, 216, 110, 149, 11, 70, 149, 213, 227, 67, 215, 217, 163,
-			204, 170, 86, 121, 16, 216, 219, 14, 7, 225, 132, 126, 39, 0,
-			57, 69, 66, 66, 62, 36, 9, 169, 177, 42, 104, 182, 198, 182,
-			247, 162, 94, 44, 244, 40, 72, 219, 174, 129, 216, 165, 14, 108,
-			183, 238, 249, 45, 43, 4, 65, 237, 218, 97, 211, 235, 132, 204,
-			229, 188, 102, 187, 13, 160, 212, 198, 255, 111, 114, 182, 220, 157,
-			126, 204, 118, 41, 219, 230, 225, 46, 231, 46, 227, 86, 181, 201,
-			58, 109, 64, 25, 9, 150, 24, 166, 49, 162, 13, 76, 72, 225,
-			145, 36, 136, 171, 79, 65, 32, 188, 244, 9, 5, 233, 166, 62,
-			50, 108, 210, 29, 148, 171, 102, 234, 167, 181, 137, 140, 45, 134,
-			163, 24, 172, 241, 7, 194, 164, 247, 137, 90, 77, 62, 214, 226,
-			65, 96, 53, 120, 158, 21, 69, 47, 97, 81, 118, 192, 166, 231,
-			114, 52, 122, 15, 13, 192, 118, 28, 137, 192, 118, 27, 17, 195,
-			154, 97, 26, 167, 181, 145, 17, 201, 148, 150, 4, 62, 20, 195,
-			160, 237, 211, 105, 101, 9, 154, 110, 234, 167, 199, 51, 116, 1,
-			25, 214, 77, 61, 163, 141, 102, 158, 87, 148, 99, 198, 221, 180,
-			2, 182, 13, 18, 82, 46, 32, 162, 166, 27, 166, 145, 209, 78,
-			43, 241, 232, 73, 64, 146, 82, 16, 49, 245, 76, 223, 160, 130,
-			128, 128, 57, 66, 231, 144, 154, 97, 234, 103, 52, 51, 243, 220,
-			163, 168, 9, 183, 18, 209, 50, 12, 211, 56, 163, 101, 70, 37,
-			62, 35, 9, 40, 20, 45, 131, 152, 250, 153, 190, 227, 10, 210,
-			77, 253, 204, 208, 240, 118, 10, 39, 208, 2, 253, 219, 11, 244,
-			108, 195, 243, 26, 14, 159, 81, 83, 106, 166, 214, 241, 209, 84,
-			164, 191, 29, 20, 207, 243, 234, 121, 246, 26, 77, 175, 200, 46,
-			230, 24, 237, 11, 120, 213, 115, 107, 1, 250, 90, 189, 164, 64,
-			115, 148, 38, 93, 203, 245, 2, 244, 178, 201, 146, 0, 150, 62,
-			160, 35, 85, 175, 149, 223, 135, 114, 233, 184, 66, 136, 115, 125,
-			147, 188, 245, 66, 195, 14, 155, 157, 237, 124, 213, 107, 205, 52,
-			60, 199, 114, 27, 93, 254, 218, 225, 94, 155, 7, 17, 155, 255,
-			131, 144, 127, 161, 233, 183, 55, 151, 126, 79, 59, 123, 91, 224,
-			221, 148, 93, 243, 175, 115, 199, 121, 213, 245, 118, 221, 10, 188,
-			242, 202, 255, 60, 15, 126, 248, 108, 34, 36, 244, 63, 31, 67,
-			63, 124, 54, 97, 206, 255, 201, 49, 225, 98, 170, 158, 195, 150,
-			58, 245, 58, 247, 3, 54, 205, 4, 170, 139, 1, 171, 89, 161,
-			197, 108, 55, 228, 126, 181, 105, 185, 13, 112, 147, 48, 151, 104,
-			143, 243, 158, 253, 25, 249, 2, 43, 186, 213, 60, 59, 194, 111,
-			55, 195, 176, 29, 92, 155, 153, 169, 241, 29, 238, 120, 109, 238,
-			7, 74, 18, 48, 206, 182, 100, 98, 122, 91, 48, 49, 67, 41,
-			43, 241, 154, 29, 132, 190, 189, 221, 193, 201, 107, 185, 53, 214,
-			9, 56, 248, 104, 233, 247, 161, 101, 219, 118, 45, 127, 15, 249,
-			10, 114, 56, 193, 153, 231, 171, 137, 78, 89, 203, 171, 217, 117,
-			112, 186, 182, 231, 230, 152, 229, 115, 214, 134, 73, 21, 130, 115,
-			105, 251, 222, 142, 93, 3, 111, 172, 124, 88, 221, 115, 28, 111,
-			23, 60, 2, 232, 209, 134, 151, 2, 120, 137, 178, 22, 15, 175,
-			81, 202, 224, 207, 11, 251, 24, 11, 96, 238, 198, 87, 162, 86,
-			39, 8, 193, 9, 90, 114, 53, 177, 182, 189, 29, 120, 36, 37,
-			70, 153, 235, 133, 118, 149, 231, 132, 43, 116, 236, 32, 4, 12,
-			113, 138, 110, 109, 31, 59, 53, 59, 168, 58, 150, 221, 226, 126,
-			254, 40, 38, 108, 55, 46, 11, 197, 68, 219, 247, 106, 29, 244,
-			186, 146, 15, 218, 101, 228, 255, 136, 15, 170, 214, 202, 154, 87,
-			237, 180, 184, 27, 90, 74, 73, 51, 158, 207, 188, 176, 201, 125,
-			214, 178, 66, 238, 219, 150, 19, 116, 69, 141, 10, 10, 155, 156,
-			178, 56, 247, 209, 160, 214, 185, 141, 111, 2, 98, 215, 106, 225,
-			74, 31, 183, 45, 215, 235, 62, 67, 185, 219, 97, 0, 35, 114,
-			5, 42, 207, 15, 88, 203, 218, 131, 213, 161, 19, 136, 101, 150,
-			187, 53, 207, 135, 144, 193, 7, 38, 90, 176, 100, 9, 153, 132,
-			1, 171, 113, 31, 252, 22, 171, 251, 94, 139, 170, 136, 162, 30,
-			238, 130, 153, 168, 165, 34, 104, 243, 42, 88, 16, 107, 251, 54,
-			24, 150, 15, 182, 227, 10, 43, 10, 2, 228, 157, 178, 202, 106,
-			177, 204, 202, 27, 183, 42, 175, 47, 150, 10, 172, 88, 102, 155,
-			165, 141, 215, 138, 43, 133, 21, 182, 244, 38, 171, 172, 22, 216,
-			242, 198, 230, 155, 165, 226, 237, 213, 10, 91, 221, 88, 91, 41,
-			148, 202, 108, 113, 125, 133, 45, 111, 172, 87, 74, 197, 165, 173,
-			202, 70, 169, 76, 89, 118, 177, 204, 138, 229, 44, 62, 89, 92,
-			127, 147, 21, 222, 216, 44, 21, 202, 101, 182, 81, 98, 197, 59,
-			155, 107, 197, 194, 10, 123, 125, 177, 84, 90, 92, 175, 20, 11,
-			229, 28, 43, 174, 47, 175, 109, 173, 20, 215, 111, 231, 216, 210,
-			86, 133, 173, 111, 84, 40, 91, 43, 222, 41, 86, 10, 43, 172,
-			178, 145, 67, 178, 7, 223, 99, 27, 183, 216, 157, 66, 105, 121,
-			117, 113, 189, 178, 184, 84, 92, 43, 86, 222, 68, 130, 183, 138,
-			149, 117, 32, 118, 107, 163, 68, 217, 34, 219, 92, 44, 85, 138,
-			203, 91, 107, 139, 37, 182, 185, 85, 218, 220, 40, 23, 24, 140,
-			108, 165, 88, 94, 94, 91, 44, 222, 41, 172, 228, 89, 113, 157,
-			173, 111, 176, 194, 107, 133, 245, 10, 43, 175, 46, 174, 173, 245,
-			14, 148, 178, 141, 215, 215, 11, 37, 224, 62, 62, 76, 182, 84,
-			96, 107, 197, 197, 165, 181, 2, 144, 194, 113, 174, 20, 75, 133,
-			229, 10, 12, 168, 251, 107, 185, 184, 82, 88, 175, 44, 174, 229,
-			40, 43, 111, 22, 150, 139, 139, 107, 57, 86, 120, 163, 112, 103,
-			115, 109, 177, 244, 102, 78, 34, 45, 23, 62, 187, 85, 88, 175,
-			20, 23, 215, 216, 202, 226, 157, 197, 219, 133, 50, 155, 124, 156,
-			84, 54, 75, 27, 203, 91, 165, 194, 29, 224, 122, 227, 22, 43,
-			111, 45, 149, 43, 197, 202, 86, 165, 192, 110, 111, 108, 172, 160,
-			176, 203, 133, 210, 107, 197, 229, 66, 249, 58, 91, 219, 40, 163,
-			192, 182, 202, 133, 28, 101, 43, 139, 149, 69, 36, 189, 89, 218,
-			184, 85, 172, 148, 175, 195, 239, 165, 173, 114, 17, 5, 87, 92,
-			175, 20, 74, 165, 173, 205, 74, 113, 99, 125, 138, 173, 110, 188,
-			94, 120, 173, 80, 98, 203, 139, 91, 229, 194, 10, 74, 120, 99,
-			29, 70, 11, 182, 82, 216, 40, 189, 9, 104, 65, 14, 168, 129,
-			28, 123, 125, 181, 80, 89, 45, 148, 64, 168, 40, 173, 69, 16,
-			67, 185, 82, 42, 46, 87, 226, 221, 54, 74, 172, 178, 81, 170,
-			208, 216, 56, 217, 122, 225, 246, 90, 241, 118, 97, 125, 185, 0,
-			143, 55, 0, 205, 235, 197, 114, 97, 138, 45, 150, 138, 101, 232,
-			80, 68, 194, 236, 245, 197, 55, 217, 198, 22, 142, 26, 20, 181,
-			85, 46, 80, 241, 59, 102, 186, 57, 212, 39, 43, 222, 98, 139,
-			43, 175, 21, 129, 115, 217, 123, 115, 163, 92, 46, 74, 115, 65,
-			177, 45, 175, 74, 153, 71, 225, 49, 75, 159, 134, 95, 105, 83,
-			207, 38, 174, 211, 126, 170, 165, 47, 136, 159, 162, 241, 124, 226,
-			28, 54, 158, 19, 63, 69, 227, 115, 137, 69, 108, 28, 16, 63,
-			69, 227, 133, 68, 14, 27, 137, 248, 41, 26, 159, 79, 228, 177,
-			81, 254, 20, 141, 23, 19, 89, 108, 164, 226, 167, 104, 156, 76,
-			60, 139, 141, 207, 137, 159, 95, 29, 18, 33, 123, 61, 17, 146,
-			204, 47, 15, 177, 69, 166, 150, 91, 244, 142, 60, 224, 110, 24,
-			48, 139, 5, 118, 195, 229, 181, 28, 171, 219, 15, 120, 109, 218,
-			225, 110, 35, 108, 178, 160, 109, 185, 24, 153, 217, 45, 222, 237,
-			206, 107, 148, 89, 240, 78, 213, 235, 184, 232, 51, 229, 186, 143,
-			14, 179, 238, 91, 213, 238, 178, 160, 30, 132, 12, 99, 0, 4,
-			41, 44, 139, 158, 35, 60, 31, 43, 98, 16, 12, 65, 96, 155,
-			187, 53, 46, 16, 90, 238, 30, 171, 90, 14, 119, 107, 150, 143,
-			88, 171, 158, 91, 229, 237, 16, 220, 244, 125, 206, 178, 53, 107,
-			47, 75, 193, 167, 101, 91, 158, 27, 54, 179, 10, 141, 207, 29,
-			181, 191, 168, 216, 45, 30, 132, 86, 171, 45, 28, 181, 92, 225,
-			106, 54, 44, 175, 28, 2, 113, 25, 224, 82, 22, 238, 198, 123,
-			99, 108, 137, 81, 187, 213, 21, 21, 176, 96, 71, 145, 183, 85,
-			3, 87, 238, 249, 44, 232, 108, 135, 48, 92, 144, 8, 56, 81,
-			102, 117, 17, 229, 89, 9, 35, 6, 64, 212, 110, 251, 222, 3,
-			27, 150, 3, 103, 143, 93, 154, 158, 155, 205, 205, 206, 206, 178,
-			61, 110, 249, 176, 253, 97, 231, 89, 225, 129, 213, 106, 59, 60,
-			160, 84, 253, 100, 115, 215, 216, 178, 215, 106, 119, 66, 222, 101,
-			3, 105, 244, 176, 139, 235, 94, 59, 224, 157, 154, 135, 139, 111,
-			94, 46, 210, 221, 241, 4, 161, 229, 135, 236, 38, 203, 231, 243,
-			215, 247, 63, 227, 110, 173, 231, 73, 68, 72, 197, 87, 234, 169,
-			120, 28, 5, 135, 74, 173, 55, 1, 67, 4, 77, 11, 90, 10,
-			190, 190, 239, 37, 52, 0, 249, 138, 248, 173, 94, 64, 72, 17,
-			177, 235, 108, 242, 0, 161, 27, 108, 150, 61, 255, 252, 126, 92,
-			47, 179, 217, 41, 246, 190, 120, 237, 16, 238, 46, 221, 100, 115,
-			215, 15, 60, 149, 164, 111, 178, 185, 89, 245, 71, 118, 250, 144,
-			113, 7, 226, 171, 24, 3, 65, 132, 236, 229, 67, 57, 184, 241,
-			104, 14, 166, 31, 193, 193, 165, 195, 56, 136, 233, 127, 190, 171,
-			255, 174, 194, 208, 0, 186, 224, 165, 174, 198, 62, 189, 25, 28,
-			169, 236, 163, 141, 68, 60, 138, 235, 252, 102, 175, 206, 217, 165,
-			3, 66, 184, 222, 125, 73, 89, 64, 76, 235, 241, 23, 14, 152,
-			65, 247, 157, 94, 57, 247, 24, 93, 92, 196, 221, 23, 14, 149,
-			110, 87, 191, 221, 142, 47, 199, 59, 30, 65, 227, 210, 225, 52,
-			14, 181, 161, 152, 6, 23, 142, 154, 193, 176, 151, 6, 151, 154,
-			135, 255, 169, 113, 7, 247, 24, 108, 115, 47, 108, 138, 112, 10,
-			254, 132, 32, 244, 131, 29, 39, 107, 214, 94, 112, 115, 33, 199,
-			90, 182, 219, 9, 121, 112, 115, 110, 118, 170, 119, 158, 177, 155,
-			17, 181, 201, 125, 143, 242, 183, 124, 175, 85, 137, 80, 133, 181,
-			41, 116, 62, 175, 148, 55, 214, 217, 29, 171, 221, 182, 221, 6,
-			165, 172, 232, 138, 22, 177, 213, 201, 161, 219, 140, 248, 135, 237,
-			23, 195, 4, 6, 152, 89, 77, 172, 3, 16, 200, 186, 13, 230,
-			91, 50, 118, 181, 192, 97, 82, 230, 109, 191, 203, 171, 97, 142,
-			237, 54, 185, 47, 34, 112, 217, 145, 131, 80, 101, 248, 28, 116,
-			234, 117, 251, 1, 203, 6, 89, 54, 105, 187, 53, 220, 170, 184,
-			13, 181, 112, 76, 129, 231, 165, 64, 176, 237, 243, 42, 175, 137,
-			124, 7, 70, 192, 157, 214, 54, 247, 99, 107, 140, 220, 252, 116,
-			151, 153, 128, 241, 7, 176, 98, 5, 200, 38, 141, 22, 38, 203,
-			81, 175, 228, 217, 45, 207, 103, 92, 168, 43, 199, 22, 162, 229,
-			10, 49, 205, 246, 224, 10, 154, 94, 199, 169, 177, 109, 78, 163,
-			177, 219, 61, 130, 2, 81, 100, 23, 130, 44, 140, 215, 6, 237,
-			247, 172, 138, 115, 49, 100, 18, 23, 133, 165, 164, 203, 226, 97,
-			216, 242, 202, 186, 230, 0, 47, 224, 217, 135, 149, 178, 150, 93,
-			245, 123, 241, 62, 41, 218, 185, 32, 139, 185, 55, 149, 125, 171,
-			167, 135, 232, 143, 136, 74, 191, 221, 215, 70, 51, 255, 133, 176,
-			50, 134, 5, 17, 81, 153, 165, 137, 199, 5, 121, 118, 7, 182,
-			90, 219, 92, 216, 246, 244, 194, 220, 229, 220, 229, 43, 47, 193,
-			10, 7, 127, 41, 172, 197, 151, 246, 53, 50, 219, 173, 58, 157,
-			192, 222, 225, 121, 204, 162, 93, 3, 172, 1, 103, 219, 94, 7,
-			135, 230, 99, 186, 16, 102, 142, 216, 156, 92, 163, 236, 165, 89,
-			96, 98, 166, 101, 187, 236, 5, 0, 90, 182, 59, 211, 244, 217,
-			11, 108, 254, 69, 214, 244, 103, 106, 214, 30, 123, 129, 45, 188,
-			116, 57, 63, 127, 153, 193, 28, 153, 129, 213, 149, 189, 32, 102,
-			168, 88, 106, 227, 105, 193, 251, 90, 221, 140, 165, 5, 239, 71,
-			201, 32, 144, 196, 253, 244, 96, 44, 45, 120, 223, 28, 161, 255,
-			72, 87, 105, 193, 64, 51, 51, 255, 93, 83, 114, 233, 9, 118,
-			44, 41, 166, 222, 104, 39, 22, 236, 196, 197, 71, 187, 242, 83,
-			147, 43, 96, 14, 15, 2, 49, 127, 60, 151, 71, 216, 252, 158,
-			216, 75, 24, 167, 197, 102, 41, 187, 39, 213, 114, 143, 213, 109,
-			238, 212, 208, 36, 44, 214, 246, 2, 59, 180, 119, 112, 203, 231,
-			242, 134, 133, 191, 239, 33, 67, 178, 163, 176, 251, 104, 141, 67,
-			86, 98, 4, 61, 159, 181, 60, 159, 231, 152, 197, 92, 207, 157,
-			126, 200, 125, 79, 102, 220, 234, 114, 251, 217, 131, 77, 108, 181,
-			97, 98, 168, 225, 193, 198, 21, 226, 73, 176, 54, 236, 222, 203,
-			231, 126, 139, 185, 122, 245, 106, 78, 254, 21, 214, 18, 107, 136,
-			89, 74, 60, 249, 24, 104, 247, 71, 99, 201, 199, 160, 39, 249,
-			24, 164, 143, 199, 146, 143, 65, 44, 227, 245, 245, 25, 122, 110,
-			127, 198, 43, 140, 2, 182, 35, 82, 94, 215, 105, 127, 180, 24,
-			126, 234, 156, 215, 71, 135, 231, 188, 78, 68, 24, 85, 210, 235,
-			210, 227, 147, 94, 17, 167, 159, 34, 235, 245, 173, 105, 218, 103,
-			38, 207, 38, 126, 158, 60, 77, 123, 61, 77, 123, 61, 77, 123,
-			61, 77, 123, 61, 77, 123, 61, 77, 123, 253, 127, 79, 123, 45,
-			117, 211, 94, 75, 143, 78, 123, 205, 116, 211, 94, 51, 159, 34,
-			237, 245, 71, 19, 152, 246, 74, 62, 132, 149, 47, 243, 59, 19,
-			108, 49, 182, 163, 237, 73, 124, 201, 58, 172, 43, 146, 92, 135,
-			228, 161, 176, 253, 161, 231, 114, 76, 56, 169, 172, 84, 174, 39,
-			38, 179, 130, 39, 201, 129, 209, 35, 194, 66, 219, 101, 91, 149,
-			101, 86, 104, 123, 213, 166, 140, 9, 69, 58, 75, 237, 52, 58,
-			129, 172, 210, 82, 88, 182, 29, 222, 14, 237, 42, 187, 237, 243,
-			134, 231, 219, 150, 203, 150, 85, 166, 108, 183, 105, 87, 155, 140,
-			63, 8, 113, 135, 5, 30, 179, 219, 73, 49, 78, 217, 182, 85,
-			189, 191, 107, 249, 208, 195, 195, 200, 24, 130, 191, 253, 36, 173,
-			32, 232, 180, 128, 170, 229, 56, 106, 179, 137, 11, 233, 75, 179,
-			52, 26, 146, 227, 185, 141, 28, 179, 243, 60, 207, 28, 110, 181,
-			187, 67, 245, 57, 203, 6, 45, 110, 249, 188, 150, 101, 129, 39,
-			150, 90, 215, 139, 247, 162, 44, 180, 182, 29, 220, 76, 186, 28,
-			119, 118, 117, 172, 60, 135, 220, 111, 195, 42, 42, 178, 131, 81,
-			14, 77, 56, 107, 216, 182, 76, 227, 127, 149, 217, 217, 107, 248,
-			223, 91, 48, 138, 171, 87, 175, 94, 157, 158, 155, 159, 94, 152,
-			171, 204, 47, 92, 187, 124, 245, 218, 229, 171, 249, 171, 234, 207,
-			91, 121, 202, 150, 246, 64, 224, 161, 111, 87, 67, 89, 248, 70,
-			150, 124, 64, 159, 99, 187, 156, 113, 55, 232, 248, 178, 156, 191,
-			203, 49, 211, 87, 245, 220, 29, 238, 135, 88, 85, 23, 90, 245,
-			90, 140, 149, 110, 45, 179, 133, 133, 133, 171, 76, 21, 217, 109,
-			183, 17, 228, 41, 43, 115, 206, 222, 86, 209, 206, 238, 238, 110,
-			222, 230, 97, 29, 143, 188, 248, 245, 42, 252, 133, 151, 242, 225,
-			131, 240, 115, 147, 79, 210, 107, 234, 73, 18, 131, 251, 18, 67,
-			155, 27, 229, 226, 27, 236, 30, 88, 208, 228, 212, 189, 131, 57,
-			160, 40, 128, 148, 201, 138, 110, 232, 27, 240, 240, 174, 84, 222,
-			36, 190, 190, 190, 181, 182, 54, 53, 117, 104, 63, 180, 225, 201,
-			217, 169, 235, 79, 148, 172, 146, 60, 53, 120, 8, 88, 188, 122,
-			205, 218, 139, 241, 22, 132, 126, 167, 26, 34, 129, 29, 203, 97,
-			225, 142, 164, 216, 211, 253, 249, 112, 39, 199, 144, 161, 235, 63,
-			233, 144, 118, 242, 225, 14, 64, 143, 26, 145, 232, 212, 9, 120,
-			85, 110, 27, 123, 70, 184, 112, 228, 8, 95, 183, 221, 133, 121,
-			118, 239, 54, 15, 203, 123, 65, 200, 49, 193, 178, 24, 220, 178,
-			29, 94, 233, 85, 196, 173, 226, 90, 161, 82, 188, 83, 96, 245,
-			80, 178, 113, 212, 59, 207, 215, 67, 197, 233, 86, 113, 189, 242,
-			210, 139, 44, 180, 171, 247, 3, 118, 147, 77, 78, 78, 138, 150,
-			169, 122, 152, 175, 237, 174, 218, 141, 230, 138, 21, 226, 91, 83,
-			236, 198, 13, 182, 48, 63, 197, 62, 96, 248, 108, 205, 219, 85,
-			143, 148, 220, 102, 102, 216, 34, 240, 91, 243, 118, 3, 68, 9,
-			51, 107, 110, 182, 39, 211, 145, 143, 58, 112, 244, 71, 115, 47,
-			29, 156, 114, 17, 54, 120, 125, 238, 165, 23, 95, 124, 241, 202,
-			194, 75, 179, 179, 209, 252, 223, 230, 117, 207, 231, 108, 203, 181,
-			31, 40, 44, 87, 175, 204, 238, 199, 146, 255, 201, 148, 57, 41,
-			198, 207, 38, 39, 133, 80, 102, 162, 44, 220, 20, 155, 142, 179,
-			243, 24, 11, 6, 60, 32, 46, 133, 231, 66, 12, 15, 26, 192,
-			84, 143, 1, 188, 120, 164, 1, 188, 98, 237, 88, 236, 158, 80,
-			100, 190, 218, 241, 125, 238, 134, 208, 229, 142, 237, 56, 118, 16,
-			51, 0, 112, 151, 172, 133, 173, 236, 38, 59, 250, 133, 71, 152,
-			57, 187, 25, 171, 48, 184, 124, 119, 169, 99, 59, 53, 238, 79,
-			78, 193, 192, 202, 82, 66, 146, 132, 16, 204, 148, 74, 92, 50,
-			6, 125, 214, 197, 216, 109, 55, 132, 145, 203, 158, 98, 232, 83,
-			42, 93, 2, 67, 207, 111, 3, 102, 228, 165, 43, 131, 203, 71,
-			202, 64, 142, 66, 45, 162, 251, 147, 153, 135, 177, 175, 178, 147,
-			93, 221, 220, 230, 225, 114, 87, 26, 147, 79, 156, 157, 140, 201,
-			233, 17, 233, 73, 177, 113, 161, 236, 109, 229, 193, 159, 208, 17,
-			75, 82, 121, 86, 129, 181, 193, 14, 114, 2, 141, 76, 168, 217,
-			1, 203, 190, 15, 139, 232, 135, 211, 239, 99, 45, 234, 195, 233,
-			247, 107, 214, 222, 135, 149, 247, 155, 94, 199, 255, 240, 218, 251,
-			45, 219, 253, 240, 218, 251, 1, 175, 126, 248, 118, 254, 125, 8,
-			12, 192, 144, 63, 252, 220, 91, 89, 42, 19, 163, 226, 109, 172,
-			19, 57, 187, 214, 94, 60, 109, 41, 214, 253, 186, 215, 241, 89,
-			205, 110, 216, 97, 32, 115, 139, 146, 82, 142, 33, 169, 28, 101,
-			130, 88, 142, 33, 53, 145, 41, 68, 146, 184, 18, 63, 228, 190,
-			55, 221, 22, 69, 43, 88, 248, 118, 61, 133, 141, 91, 213, 166,
-			56, 90, 214, 77, 142, 210, 88, 66, 21, 195, 9, 88, 10, 27,
-			30, 235, 180, 113, 161, 85, 175, 78, 226, 170, 47, 26, 231, 14,
-			143, 107, 166, 114, 20, 233, 123, 109, 129, 89, 80, 202, 190, 149,
-			85, 73, 95, 153, 239, 229, 34, 84, 1, 59, 128, 48, 139, 77,
-			102, 183, 42, 203, 217, 169, 235, 61, 173, 84, 148, 247, 222, 235,
-			216, 62, 175, 229, 217, 162, 56, 5, 184, 32, 140, 33, 192, 253,
-			166, 253, 144, 251, 42, 7, 42, 69, 217, 9, 56, 134, 86, 147,
-			86, 16, 81, 171, 177, 237, 61, 10, 108, 76, 129, 2, 92, 216,
-			225, 185, 161, 58, 5, 183, 207, 148, 100, 70, 45, 70, 170, 109,
-			249, 65, 151, 204, 54, 167, 204, 146, 103, 246, 172, 106, 149, 183,
-			67, 182, 237, 133, 77, 164, 9, 239, 138, 13, 177, 26, 67, 112,
-			128, 15, 134, 121, 212, 122, 192, 197, 122, 223, 147, 142, 206, 206,
-			207, 206, 93, 1, 159, 57, 119, 185, 50, 59, 119, 109, 97, 246,
-			218, 220, 229, 252, 236, 220, 91, 89, 105, 221, 1, 67, 56, 114,
-			186, 109, 43, 8, 41, 195, 158, 72, 223, 115, 217, 43, 150, 219,
-			177, 252, 61, 54, 119, 57, 199, 0, 91, 94, 78, 32, 107, 199,
-			42, 87, 125, 187, 29, 230, 48, 239, 23, 15, 118, 44, 6, 139,
-			134, 204, 221, 139, 56, 9, 162, 47, 97, 236, 177, 56, 52, 8,
-			45, 136, 38, 107, 236, 237, 208, 43, 150, 55, 202, 56, 199, 38,
-			167, 186, 115, 42, 74, 248, 228, 91, 222, 67, 219, 113, 44, 156,
-			92, 220, 157, 222, 42, 207, 212, 188, 106, 48, 243, 58, 223, 158,
-			233, 114, 50, 83, 226, 178, 48, 59, 115, 219, 241, 182, 45, 231,
-			238, 6, 178, 16, 204, 0, 63, 51, 49, 34, 159, 195, 180, 76,
-			211, 171, 229, 97, 44, 194, 209, 228, 112, 154, 75, 142, 238, 69,
-			101, 19, 245, 227, 158, 26, 143, 58, 58, 41, 6, 203, 107, 244,
-			240, 17, 190, 125, 47, 8, 253, 58, 190, 25, 27, 144, 87, 13,
-			242, 109, 225, 215, 96, 40, 243, 51, 142, 189, 237, 91, 254, 30,
-			38, 230, 242, 205, 176, 229, 156, 199, 95, 234, 221, 41, 26, 229,
-			61, 132, 95, 148, 52, 130, 54, 175, 178, 139, 23, 222, 156, 190,
-			208, 154, 190, 80, 171, 92, 88, 189, 118, 225, 206, 181, 11, 229,
-			252, 133, 250, 91, 23, 243, 108, 205, 190, 207, 119, 237, 128, 231,
-			48, 117, 111, 237, 88, 168, 35, 138, 172, 131, 57, 3, 182, 87,
-			188, 154, 168, 52, 95, 12, 216, 219, 247, 138, 229, 13, 181, 208,
-			223, 18, 174, 170, 38, 193, 201, 169, 123, 159, 155, 20, 57, 56,
-			233, 229, 222, 245, 106, 66, 17, 240, 99, 26, 184, 154, 177, 218,
-			54, 234, 67, 181, 226, 112, 102, 4, 175, 51, 7, 113, 227, 56,
-			21, 129, 233, 105, 202, 166, 64, 134, 222, 54, 230, 189, 44, 57,
-			198, 144, 195, 78, 169, 141, 83, 195, 171, 179, 6, 119, 185, 47,
-			10, 59, 97, 79, 229, 58, 38, 250, 158, 90, 196, 195, 244, 48,
-			253, 114, 84, 139, 248, 88, 27, 205, 124, 145, 176, 82, 119, 219,
-			22, 171, 71, 128, 169, 163, 116, 197, 241, 218, 110, 204, 65, 15,
-			15, 58, 122, 147, 207, 71, 108, 40, 232, 97, 59, 138, 183, 14,
-			73, 70, 39, 12, 211, 248, 88, 123, 56, 18, 171, 37, 124, 220,
-			83, 75, 248, 184, 167, 150, 240, 177, 57, 66, 127, 76, 100, 45,
-			193, 248, 57, 162, 153, 153, 191, 32, 108, 221, 115, 167, 163, 76,
-			253, 167, 43, 41, 228, 217, 186, 124, 81, 249, 109, 117, 128, 1,
-			77, 175, 139, 12, 19, 131, 226, 120, 108, 211, 218, 225, 152, 217,
-			143, 104, 138, 122, 166, 120, 81, 29, 11, 199, 179, 29, 117, 207,
-			135, 157, 162, 218, 30, 239, 23, 158, 220, 122, 117, 179, 247, 113,
-			1, 157, 80, 217, 250, 228, 207, 17, 237, 227, 81, 149, 189, 79,
-			226, 184, 251, 20, 136, 98, 72, 31, 87, 160, 14, 96, 44, 99,
-			255, 243, 244, 167, 113, 133, 32, 180, 130, 251, 65, 239, 21, 130,
-			204, 99, 142, 190, 102, 30, 87, 40, 200, 214, 233, 192, 162, 56,
-			249, 91, 177, 130, 251, 230, 24, 237, 107, 251, 30, 120, 25, 121,
-			243, 64, 129, 230, 9, 170, 217, 53, 44, 11, 244, 151, 52, 187,
-			102, 78, 208, 126, 96, 232, 174, 107, 181, 248, 88, 31, 54, 167,
-			161, 97, 221, 106, 113, 115, 136, 234, 247, 249, 30, 94, 63, 56,
-			86, 130, 159, 175, 252, 93, 159, 184, 64, 144, 249, 251, 112, 129,
-			160, 159, 106, 122, 194, 212, 211, 125, 23, 241, 39, 49, 245, 254,
-			190, 73, 90, 19, 103, 148, 142, 39, 50, 36, 243, 6, 139, 73,
-			85, 28, 195, 1, 225, 176, 247, 58, 188, 195, 197, 207, 26, 15,
-			112, 205, 232, 158, 253, 231, 242, 16, 182, 229, 200, 89, 99, 187,
-			13, 135, 211, 216, 1, 234, 216, 53, 131, 227, 233, 145, 238, 53,
-			131, 65, 237, 100, 230, 10, 202, 78, 37, 158, 229, 21, 3, 177,
-			254, 137, 18, 65, 116, 181, 0, 248, 193, 34, 37, 11, 189, 30,
-			31, 48, 168, 29, 63, 25, 243, 1, 131, 61, 215, 12, 6, 251,
-			135, 98, 62, 96, 112, 100, 148, 254, 140, 42, 39, 14, 107, 67,
-			153, 75, 72, 190, 105, 5, 77, 86, 92, 57, 120, 16, 30, 99,
-			11, 33, 146, 158, 26, 216, 176, 54, 120, 50, 86, 3, 27, 142,
-			72, 18, 192, 219, 63, 16, 171, 129, 13, 159, 24, 164, 45, 117,
-			0, 127, 84, 59, 157, 185, 135, 36, 65, 194, 159, 69, 177, 174,
-			71, 99, 183, 3, 148, 113, 78, 100, 210, 165, 58, 161, 69, 200,
-			191, 109, 183, 185, 99, 187, 60, 79, 163, 203, 13, 42, 40, 67,
-			85, 44, 46, 191, 186, 255, 220, 253, 168, 54, 60, 20, 59, 119,
-			63, 26, 241, 9, 227, 31, 237, 55, 99, 231, 238, 71, 79, 158,
-			162, 63, 36, 234, 224, 253, 184, 54, 148, 249, 132, 176, 202, 198,
-			202, 198, 100, 211, 118, 189, 251, 214, 212, 53, 86, 226, 45, 111,
-			71, 222, 162, 176, 234, 184, 206, 248, 219, 157, 6, 86, 147, 174,
-			206, 47, 92, 190, 124, 133, 226, 216, 34, 123, 184, 207, 247, 14,
-			202, 84, 222, 36, 176, 3, 124, 92, 243, 120, 224, 94, 12, 89,
-			203, 10, 171, 98, 185, 134, 86, 219, 165, 135, 222, 103, 17, 123,
-			14, 24, 53, 15, 48, 254, 15, 58, 109, 238, 215, 157, 142, 215,
-			17, 217, 191, 110, 25, 190, 198, 29, 30, 238, 187, 24, 48, 174,
-			141, 158, 142, 93, 12, 24, 143, 86, 11, 152, 12, 227, 233, 129,
-			216, 197, 128, 241, 19, 131, 145, 35, 252, 183, 38, 157, 216, 239,
-			145, 120, 171, 29, 238, 29, 85, 182, 236, 163, 201, 2, 60, 95,
-			218, 57, 188, 4, 73, 241, 169, 42, 63, 94, 124, 124, 249, 17,
-			169, 125, 138, 210, 227, 255, 26, 18, 7, 238, 23, 134, 158, 86,
-			30, 159, 86, 30, 159, 86, 30, 159, 86, 30, 159, 86, 30, 159,
-			86, 30, 255, 31, 86, 30, 95, 86, 69, 70, 248, 169, 42, 143,
-			81, 145, 241, 185, 168, 200, 120, 33, 113, 73, 21, 25, 225, 167,
-			170, 60, 70, 69, 198, 231, 163, 34, 227, 197, 110, 145, 17, 126,
-			170, 202, 99, 84, 226, 132, 159, 127, 167, 97, 48, 171, 47, 36,
-			134, 50, 127, 171, 177, 69, 177, 249, 181, 171, 12, 215, 79, 117,
-			149, 81, 44, 1, 123, 94, 7, 195, 103, 159, 79, 227, 214, 222,
-			99, 214, 142, 103, 215, 88, 141, 215, 109, 23, 221, 95, 167, 237,
-			136, 100, 17, 237, 125, 31, 221, 239, 158, 215, 241, 217, 226, 102,
-			17, 66, 125, 22, 238, 181, 237, 170, 229, 168, 252, 17, 94, 150,
-			245, 196, 250, 21, 170, 131, 88, 50, 104, 193, 66, 166, 128, 131,
-			182, 231, 2, 229, 189, 54, 23, 245, 78, 192, 23, 229, 87, 110,
-			97, 69, 46, 8, 45, 183, 202, 213, 106, 36, 247, 85, 236, 150,
-			231, 117, 79, 203, 250, 237, 42, 91, 178, 252, 201, 125, 65, 70,
-			30, 99, 140, 41, 121, 35, 54, 96, 71, 60, 143, 157, 157, 133,
-			208, 13, 243, 108, 81, 117, 213, 138, 194, 252, 123, 216, 251, 30,
-			230, 119, 81, 22, 216, 81, 102, 118, 238, 189, 255, 225, 189, 88,
-			140, 191, 144, 62, 30, 133, 78, 191, 101, 209, 141, 159, 194, 53,
-			116, 241, 123, 223, 46, 242, 167, 123, 191, 253, 167, 129, 46, 182,
-			215, 125, 236, 30, 247, 81, 17, 101, 182, 66, 147, 5, 223, 247,
-			124, 216, 175, 54, 252, 118, 245, 46, 196, 26, 184, 183, 77, 150,
-			210, 208, 176, 236, 213, 184, 121, 134, 246, 135, 190, 229, 6, 54,
-			119, 197, 165, 250, 116, 169, 219, 0, 187, 217, 86, 208, 192, 221,
-			108, 127, 9, 126, 102, 191, 79, 232, 240, 109, 30, 46, 123, 110,
-			221, 110, 148, 164, 253, 153, 57, 106, 86, 177, 229, 174, 28, 202,
-			221, 142, 239, 200, 125, 244, 144, 120, 82, 22, 15, 182, 124, 199,
-			124, 134, 210, 168, 119, 40, 55, 214, 253, 170, 87, 104, 230, 233,
-			136, 194, 34, 187, 181, 173, 176, 41, 153, 24, 150, 143, 4, 3,
-			155, 86, 216, 132, 254, 251, 136, 55, 189, 32, 196, 11, 254, 253,
-			165, 225, 30, 234, 171, 94, 16, 102, 255, 37, 161, 39, 75, 188,
-			97, 7, 33, 247, 197, 253, 247, 146, 152, 87, 143, 200, 1, 28,
-			245, 225, 129, 3, 95, 45, 208, 15, 249, 106, 129, 73, 13, 216,
-			242, 34, 71, 199, 74, 248, 251, 144, 47, 22, 36, 15, 249, 98,
-			65, 246, 125, 122, 106, 63, 171, 82, 228, 34, 43, 65, 162, 172,
-			68, 142, 38, 209, 18, 145, 193, 129, 249, 83, 121, 97, 117, 249,
-			222, 75, 254, 37, 209, 201, 60, 79, 147, 28, 140, 3, 249, 29,
-			152, 63, 174, 122, 163, 197, 148, 196, 179, 236, 103, 233, 240, 154,
-			103, 213, 158, 84, 70, 251, 243, 36, 106, 216, 58, 90, 21, 254,
-			206, 254, 14, 161, 102, 28, 103, 100, 63, 146, 121, 242, 36, 204,
-			43, 196, 90, 76, 158, 151, 168, 110, 53, 184, 28, 206, 248, 254,
-			221, 82, 94, 157, 209, 45, 65, 47, 243, 89, 122, 76, 237, 47,
-			239, 222, 231, 123, 82, 49, 3, 170, 237, 85, 190, 151, 253, 135,
-			132, 158, 170, 8, 85, 132, 252, 39, 149, 64, 215, 106, 244, 199,
-			124, 174, 194, 56, 76, 249, 255, 65, 163, 163, 50, 153, 242, 147,
-			114, 240, 60, 29, 116, 188, 198, 93, 238, 134, 254, 222, 93, 204,
-			9, 170, 47, 99, 56, 94, 163, 0, 173, 203, 208, 248, 132, 28,
-			153, 163, 202, 108, 146, 72, 65, 0, 48, 159, 197, 6, 27, 103,
-			61, 21, 243, 89, 180, 192, 116, 63, 71, 7, 228, 227, 192, 126,
-			200, 199, 6, 16, 177, 124, 163, 108, 63, 228, 224, 160, 144, 38,
-			190, 62, 42, 18, 106, 216, 32, 157, 133, 120, 136, 47, 159, 196,
-			151, 69, 119, 120, 247, 21, 35, 125, 118, 232, 220, 43, 70, 250,
-			220, 16, 43, 165, 97, 247, 9, 72, 74, 253, 248, 11, 222, 200,
-			254, 55, 141, 30, 91, 178, 194, 106, 83, 9, 47, 71, 117, 159,
-			191, 55, 70, 152, 62, 57, 48, 159, 81, 150, 22, 239, 146, 71,
-			193, 148, 160, 91, 230, 247, 53, 154, 68, 208, 92, 165, 131, 190,
-			156, 138, 119, 5, 243, 210, 90, 159, 81, 56, 14, 117, 42, 171,
-			137, 210, 9, 191, 231, 129, 121, 131, 14, 56, 158, 85, 83, 88,
-			52, 105, 179, 145, 205, 239, 155, 114, 171, 137, 18, 117, 162, 70,
-			243, 85, 58, 20, 42, 195, 84, 40, 132, 217, 159, 85, 40, 14,
-			55, 220, 213, 68, 105, 48, 236, 125, 98, 22, 232, 9, 153, 155,
-			82, 168, 12, 68, 117, 70, 161, 58, 204, 254, 86, 19, 165, 227,
-			86, 188, 125, 169, 143, 38, 49, 251, 156, 253, 167, 26, 61, 46,
-			69, 41, 167, 246, 12, 53, 32, 76, 145, 242, 158, 216, 39, 111,
-			209, 73, 10, 28, 59, 102, 126, 72, 148, 196, 71, 105, 82, 216,
-			162, 88, 180, 4, 96, 62, 75, 117, 238, 251, 82, 106, 189, 142,
-			107, 53, 81, 130, 103, 102, 241, 160, 170, 246, 73, 232, 112, 167,
-			122, 136, 174, 110, 246, 234, 74, 72, 39, 115, 152, 174, 34, 20,
-			49, 101, 117, 5, 195, 233, 177, 53, 110, 5, 92, 89, 225, 4,
-			237, 111, 89, 15, 238, 226, 106, 47, 143, 162, 167, 91, 214, 131,
-			10, 192, 230, 207, 80, 234, 64, 231, 187, 161, 221, 226, 145, 125,
-			28, 233, 211, 250, 177, 115, 197, 110, 241, 236, 53, 122, 92, 146,
-			145, 226, 159, 162, 73, 69, 3, 228, 63, 178, 79, 175, 64, 175,
-			36, 122, 192, 187, 43, 152, 240, 82, 60, 62, 249, 187, 243, 95,
-			55, 104, 90, 174, 177, 129, 249, 50, 237, 143, 66, 4, 243, 212,
-			1, 190, 49, 12, 204, 68, 246, 126, 48, 154, 216, 160, 39, 122,
-			245, 99, 62, 122, 138, 101, 30, 163, 86, 115, 153, 210, 174, 166,
-			204, 163, 103, 90, 230, 17, 138, 5, 163, 218, 55, 175, 204, 199,
-			76, 184, 204, 17, 99, 55, 11, 244, 120, 207, 188, 50, 31, 57,
-			221, 142, 68, 243, 34, 77, 226, 52, 50, 71, 15, 243, 98, 153,
-			147, 135, 206, 53, 243, 51, 116, 24, 77, 36, 166, 197, 160, 139,
-			33, 110, 164, 93, 12, 189, 54, 181, 76, 77, 97, 40, 61, 40,
-			162, 206, 61, 70, 116, 20, 243, 175, 252, 160, 72, 251, 204, 164,
-			145, 248, 27, 242, 247, 170, 46, 242, 90, 183, 46, 34, 126, 106,
-			166, 78, 101, 181, 68, 55, 245, 129, 190, 231, 232, 95, 16, 81,
-			46, 49, 19, 227, 36, 243, 159, 8, 67, 215, 37, 42, 37, 114,
-			25, 151, 119, 141, 44, 214, 40, 109, 46, 51, 92, 100, 241, 131,
-			69, 27, 149, 194, 53, 145, 183, 23, 23, 154, 3, 214, 228, 78,
-			155, 251, 172, 222, 113, 85, 157, 48, 202, 102, 213, 59, 14, 222,
-			3, 146, 213, 91, 199, 217, 203, 179, 59, 214, 222, 54, 167, 12,
-			3, 252, 182, 99, 185, 178, 56, 2, 27, 215, 158, 190, 172, 237,
-			88, 85, 206, 130, 78, 181, 137, 87, 206, 102, 102, 96, 195, 144,
-			101, 118, 29, 118, 163, 120, 58, 181, 211, 102, 219, 220, 118, 27,
-			84, 210, 138, 109, 222, 204, 244, 113, 154, 83, 5, 154, 81, 237,
-			84, 230, 28, 42, 17, 7, 131, 42, 137, 62, 248, 164, 134, 214,
-			45, 196, 140, 106, 230, 96, 172, 16, 51, 218, 83, 140, 29, 77,
-			15, 199, 10, 49, 163, 163, 39, 233, 203, 170, 16, 115, 74, 59,
-			153, 153, 99, 21, 181, 115, 193, 237, 179, 223, 193, 219, 162, 161,
-			44, 116, 88, 44, 218, 216, 236, 35, 76, 12, 211, 56, 165, 141,
-			158, 138, 149, 99, 78, 69, 31, 225, 33, 128, 189, 111, 40, 86,
-			142, 57, 53, 50, 74, 243, 170, 28, 51, 166, 13, 103, 158, 101,
-			139, 110, 116, 76, 134, 89, 65, 224, 85, 109, 60, 33, 162, 62,
-			124, 20, 175, 167, 140, 105, 167, 78, 198, 234, 41, 99, 61, 245,
-			148, 177, 254, 99, 177, 122, 202, 216, 224, 16, 253, 28, 213, 12,
-			98, 26, 207, 36, 94, 32, 153, 207, 178, 3, 206, 82, 125, 84,
-			43, 74, 5, 136, 35, 153, 29, 191, 123, 219, 171, 19, 112, 159,
-			178, 108, 244, 106, 54, 250, 124, 149, 212, 25, 140, 240, 153, 244,
-			56, 117, 169, 97, 16, 208, 25, 211, 88, 198, 66, 157, 45, 110,
-			22, 217, 86, 105, 77, 85, 96, 182, 173, 128, 179, 44, 108, 106,
-			167, 171, 18, 151, 218, 87, 179, 98, 93, 108, 235, 69, 125, 165,
-			198, 235, 86, 199, 9, 105, 148, 111, 0, 44, 187, 182, 227, 168,
-			92, 171, 148, 9, 65, 173, 51, 237, 25, 241, 181, 37, 130, 90,
-			103, 82, 38, 4, 181, 206, 250, 163, 103, 186, 169, 179, 179, 231,
-			80, 235, 4, 196, 117, 94, 27, 3, 173, 239, 171, 254, 9, 214,
-			212, 149, 215, 128, 227, 57, 24, 88, 148, 49, 113, 27, 17, 6,
-			173, 159, 215, 24, 147, 200, 65, 235, 231, 35, 194, 32, 147, 243,
-			253, 35, 10, 210, 77, 253, 252, 169, 211, 116, 29, 9, 107, 166,
-			126, 65, 123, 54, 179, 136, 132, 97, 111, 170, 8, 135, 252, 65,
-			56, 29, 29, 102, 138, 62, 255, 181, 143, 161, 200, 17, 42, 70,
-			192, 42, 46, 104, 231, 199, 36, 49, 176, 138, 11, 17, 35, 48,
-			204, 11, 253, 103, 20, 164, 155, 250, 133, 115, 140, 214, 145, 17,
-			221, 212, 167, 180, 103, 51, 111, 138, 2, 164, 23, 132, 143, 20,
-			131, 212, 147, 170, 251, 181, 125, 94, 231, 190, 143, 89, 111, 202,
-			178, 7, 247, 239, 217, 136, 65, 221, 48, 141, 41, 237, 194, 179,
-			146, 9, 61, 9, 116, 21, 131, 224, 236, 166, 34, 6, 193, 201,
-			77, 157, 99, 244, 3, 170, 25, 154, 105, 228, 19, 55, 73, 166,
-			205, 14, 93, 182, 149, 233, 130, 130, 188, 58, 171, 90, 142, 195,
-			253, 233, 160, 211, 110, 59, 54, 175, 137, 82, 146, 180, 97, 186,
-			15, 67, 207, 215, 206, 148, 148, 247, 89, 53, 8, 46, 159, 126,
-			134, 78, 82, 195, 208, 192, 170, 103, 181, 147, 153, 137, 131, 31,
-			132, 147, 62, 87, 14, 86, 67, 123, 156, 213, 242, 231, 112, 64,
-			26, 218, 227, 172, 28, 172, 134, 246, 56, 43, 203, 193, 26, 218,
-			227, 236, 200, 40, 189, 136, 36, 136, 169, 207, 107, 35, 153, 204,
-			35, 190, 57, 39, 40, 128, 225, 205, 107, 179, 39, 37, 22, 48,
-			188, 121, 233, 231, 52, 52, 188, 121, 249, 249, 53, 13, 13, 111,
-			126, 216, 164, 215, 145, 130, 102, 234, 151, 181, 137, 140, 56, 136,
-			119, 224, 51, 122, 242, 156, 228, 225, 95, 211, 211, 208, 202, 46,
-			107, 243, 35, 18, 51, 88, 217, 229, 104, 92, 192, 252, 101, 249,
-			53, 61, 13, 173, 236, 242, 120, 134, 190, 138, 84, 117, 83, 191,
-			162, 13, 103, 94, 150, 223, 176, 139, 172, 59, 218, 156, 175, 200,
-			122, 190, 40, 153, 8, 166, 142, 226, 2, 76, 233, 138, 118, 121,
-			66, 82, 2, 83, 186, 18, 141, 29, 76, 233, 74, 250, 152, 130,
-			128, 240, 224, 16, 253, 93, 130, 108, 24, 166, 126, 67, 155, 200,
-			252, 58, 137, 125, 124, 46, 254, 145, 57, 220, 29, 160, 27, 122,
-			249, 38, 155, 205, 201, 9, 25, 127, 24, 249, 159, 128, 135, 81,
-			57, 74, 196, 251, 209, 39, 85, 208, 50, 115, 34, 143, 171, 14,
-			21, 186, 92, 86, 199, 45, 151, 89, 53, 81, 236, 178, 28, 170,
-			176, 227, 155, 165, 205, 229, 104, 140, 134, 97, 26, 55, 180, 43,
-			195, 114, 28, 70, 18, 56, 87, 99, 52, 136, 169, 223, 72, 43,
-			73, 27, 186, 169, 223, 24, 207, 208, 151, 41, 252, 52, 62, 147,
-			88, 37, 153, 121, 28, 96, 228, 208, 85, 198, 89, 185, 243, 125,
-			51, 65, 16, 6, 131, 7, 233, 125, 38, 125, 150, 190, 72, 13,
-			67, 7, 131, 95, 210, 134, 50, 23, 17, 89, 124, 186, 28, 118,
-			70, 65, 178, 174, 163, 241, 47, 105, 159, 17, 62, 81, 71, 227,
-			95, 146, 70, 162, 163, 241, 47, 201, 131, 9, 58, 26, 255, 210,
-			137, 65, 122, 25, 201, 17, 83, 95, 209, 38, 50, 147, 82, 57,
-			120, 6, 177, 30, 79, 90, 243, 218, 97, 244, 96, 42, 172, 104,
-			75, 67, 18, 39, 73, 1, 154, 8, 2, 164, 195, 167, 20, 164,
-			155, 250, 202, 120, 134, 94, 66, 122, 154, 169, 223, 214, 204, 204,
-			217, 110, 244, 4, 180, 112, 89, 143, 68, 23, 81, 1, 211, 191,
-			173, 173, 76, 72, 76, 90, 10, 94, 238, 83, 16, 49, 245, 219,
-			242, 202, 177, 142, 214, 126, 123, 104, 152, 174, 82, 84, 227, 171,
-			137, 50, 201, 220, 96, 7, 118, 10, 184, 156, 8, 154, 234, 232,
-			116, 52, 104, 235, 224, 177, 21, 80, 250, 171, 233, 113, 244, 69,
-			6, 168, 230, 206, 147, 248, 34, 3, 213, 113, 71, 123, 85, 48,
-			110, 160, 58, 238, 72, 117, 24, 168, 142, 59, 210, 23, 25, 168,
-			142, 59, 35, 163, 168, 125, 244, 125, 27, 145, 246, 123, 73, 192,
-			122, 213, 107, 15, 17, 57, 208, 198, 134, 118, 231, 164, 68, 9,
-			142, 105, 35, 34, 7, 218, 216, 144, 218, 55, 80, 27, 27, 39,
-			6, 233, 53, 36, 167, 153, 122, 73, 27, 202, 76, 227, 177, 12,
-			191, 131, 135, 31, 171, 78, 167, 198, 247, 159, 192, 168, 69, 174,
-			34, 34, 10, 202, 41, 105, 27, 106, 28, 224, 151, 74, 50, 248,
-			18, 78, 181, 212, 167, 136, 130, 114, 74, 72, 20, 38, 149, 241,
-			90, 162, 70, 164, 51, 60, 114, 182, 116, 53, 23, 155, 41, 73,
-			98, 234, 175, 165, 51, 104, 186, 73, 80, 199, 27, 159, 218, 116,
-			147, 168, 155, 55, 180, 215, 196, 194, 151, 212, 18, 41, 64, 51,
-			164, 32, 98, 234, 111, 72, 211, 77, 162, 110, 222, 24, 207, 208,
-			175, 16, 36, 72, 76, 253, 29, 109, 56, 243, 37, 2, 226, 138,
-			104, 228, 228, 98, 24, 249, 215, 195, 197, 118, 168, 239, 167, 209,
-			206, 167, 219, 83, 120, 187, 238, 153, 152, 108, 79, 162, 58, 43,
-			47, 255, 219, 46, 203, 226, 152, 179, 209, 192, 192, 10, 222, 209,
-			222, 152, 144, 204, 131, 21, 188, 35, 103, 75, 18, 173, 224, 29,
-			233, 162, 147, 104, 5, 239, 12, 14, 209, 41, 28, 151, 102, 234,
-			119, 181, 243, 153, 51, 226, 0, 79, 131, 31, 233, 103, 146, 168,
-			244, 187, 218, 59, 195, 18, 15, 204, 200, 187, 90, 70, 65, 196,
-			212, 239, 78, 156, 85, 144, 110, 234, 119, 159, 205, 210, 223, 18,
-			194, 211, 77, 189, 170, 141, 103, 254, 9, 249, 41, 31, 19, 138,
-			48, 69, 107, 192, 79, 120, 76, 40, 137, 107, 92, 85, 187, 123,
-			94, 14, 0, 214, 184, 106, 36, 64, 240, 210, 213, 244, 168, 130,
-			96, 56, 167, 199, 168, 79, 53, 35, 101, 26, 205, 132, 79, 50,
-			117, 118, 120, 58, 225, 83, 197, 75, 251, 80, 28, 21, 36, 165,
-			136, 169, 55, 211, 103, 209, 49, 165, 96, 38, 188, 251, 36, 142,
-			41, 133, 198, 255, 174, 214, 20, 235, 68, 10, 29, 211, 187, 210,
-			83, 164, 208, 248, 223, 149, 142, 41, 133, 198, 255, 174, 116, 76,
-			41, 80, 173, 243, 105, 29, 83, 10, 77, 210, 209, 222, 61, 41,
-			81, 130, 73, 58, 17, 57, 48, 73, 71, 58, 166, 20, 154, 164,
-			115, 98, 16, 99, 178, 20, 152, 164, 251, 4, 49, 89, 10, 13,
-			210, 213, 28, 197, 52, 120, 33, 87, 234, 44, 133, 6, 233, 202,
-			152, 44, 133, 6, 233, 14, 155, 116, 22, 41, 232, 166, 254, 158,
-			54, 145, 57, 143, 20, 246, 197, 27, 234, 131, 26, 113, 219, 79,
-			161, 121, 188, 167, 185, 35, 18, 29, 152, 199, 123, 17, 41, 48,
-			143, 247, 100, 120, 144, 66, 243, 120, 111, 60, 67, 61, 170, 25,
-			125, 102, 114, 39, 241, 203, 132, 100, 182, 217, 97, 89, 162, 79,
-			97, 29, 189, 239, 211, 163, 108, 163, 143, 152, 250, 78, 250, 12,
-			218, 70, 31, 216, 198, 131, 39, 177, 141, 62, 180, 141, 7, 218,
-			142, 152, 191, 125, 104, 27, 15, 164, 178, 250, 208, 54, 30, 72,
-			219, 232, 67, 219, 120, 48, 50, 74, 111, 33, 9, 98, 234, 15,
-			181, 161, 204, 213, 39, 10, 89, 228, 85, 79, 43, 216, 255, 253,
-			222, 62, 180, 150, 135, 218, 131, 147, 146, 8, 88, 203, 195, 136,
-			1, 176, 150, 135, 210, 90, 250, 208, 90, 30, 158, 24, 164, 55,
-			144, 1, 205, 212, 63, 208, 206, 100, 102, 196, 142, 50, 250, 66,
-			17, 16, 229, 110, 232, 219, 120, 211, 5, 47, 152, 250, 252, 32,
-			89, 48, 161, 15, 180, 135, 106, 108, 96, 66, 31, 72, 189, 246,
-			161, 9, 125, 144, 62, 173, 32, 221, 212, 63, 200, 76, 224, 70,
-			182, 15, 128, 143, 180, 9, 185, 145, 109, 218, 141, 166, 8, 48,
-			26, 189, 95, 88, 62, 122, 188, 96, 80, 31, 105, 31, 156, 145,
-			200, 193, 160, 62, 138, 8, 131, 65, 125, 36, 13, 170, 15, 13,
-			234, 163, 241, 12, 253, 175, 4, 41, 27, 166, 241, 121, 162, 141,
-			100, 254, 28, 151, 34, 215, 11, 99, 91, 120, 159, 11, 106, 110,
-			215, 53, 70, 153, 40, 220, 67, 138, 5, 4, 243, 111, 152, 66,
-			82, 23, 99, 4, 167, 150, 43, 163, 49, 175, 138, 17, 82, 141,
-			77, 98, 190, 8, 79, 174, 115, 113, 174, 0, 63, 14, 21, 122,
-			12, 173, 20, 47, 211, 136, 239, 212, 136, 161, 226, 167, 160, 155,
-			222, 46, 235, 180, 85, 176, 30, 55, 138, 125, 70, 28, 125, 146,
-			219, 241, 26, 129, 60, 190, 222, 7, 49, 92, 242, 243, 68, 251,
-			104, 66, 138, 10, 194, 134, 207, 19, 45, 173, 64, 2, 96, 255,
-			9, 5, 234, 0, 14, 155, 244, 51, 40, 157, 164, 105, 252, 2,
-			209, 198, 101, 64, 46, 197, 30, 79, 135, 244, 58, 20, 185, 84,
-			91, 161, 149, 167, 116, 16, 17, 38, 13, 51, 245, 11, 68, 251,
-			60, 25, 145, 20, 146, 2, 167, 162, 159, 36, 0, 246, 71, 79,
-			117, 0, 79, 141, 209, 171, 72, 63, 101, 26, 191, 8, 244, 197,
-			1, 227, 192, 126, 120, 200, 138, 122, 4, 225, 148, 97, 166, 126,
-			145, 104, 191, 64, 198, 37, 234, 84, 18, 145, 245, 41, 144, 0,
-			152, 86, 132, 83, 58, 128, 167, 198, 232, 207, 34, 225, 62, 211,
-			248, 2, 137, 82, 43, 143, 27, 184, 48, 207, 30, 242, 125, 134,
-			153, 250, 2, 209, 126, 49, 34, 223, 151, 68, 148, 106, 220, 125,
-			4, 192, 126, 83, 129, 58, 128, 39, 79, 227, 185, 234, 62, 45,
-			109, 26, 95, 4, 242, 47, 60, 122, 220, 135, 208, 77, 27, 102,
-			234, 139, 68, 251, 2, 25, 147, 152, 211, 73, 196, 165, 134, 157,
-			38, 0, 166, 21, 221, 180, 14, 224, 201, 211, 116, 0, 220, 29,
-			53, 141, 127, 76, 6, 96, 213, 54, 140, 62, 154, 64, 232, 164,
-			132, 8, 64, 167, 179, 162, 99, 191, 105, 252, 18, 25, 48, 197,
-			163, 254, 4, 66, 226, 6, 68, 95, 127, 130, 244, 130, 154, 4,
-			69, 95, 124, 56, 56, 44, 31, 146, 125, 160, 38, 193, 183, 168,
-			102, 164, 205, 212, 175, 144, 196, 111, 18, 146, 89, 99, 241, 172,
-			190, 72, 100, 110, 99, 8, 227, 213, 113, 206, 237, 216, 181, 78,
-			55, 110, 193, 169, 216, 178, 238, 139, 239, 208, 247, 206, 153, 60,
-			197, 1, 128, 20, 126, 133, 164, 71, 49, 254, 77, 235, 9, 51,
-			245, 171, 68, 251, 231, 68, 207, 92, 64, 129, 87, 61, 199, 233,
-			38, 161, 145, 20, 175, 69, 232, 165, 215, 73, 235, 48, 210, 95,
-			37, 116, 144, 14, 209, 20, 128, 233, 132, 153, 250, 53, 98, 124,
-			133, 36, 233, 32, 237, 19, 45, 196, 52, 126, 13, 186, 156, 16,
-			93, 180, 132, 105, 252, 51, 146, 154, 85, 29, 32, 104, 134, 134,
-			137, 110, 3, 129, 134, 51, 151, 186, 13, 58, 52, 228, 103, 34,
-			20, 196, 52, 126, 157, 164, 38, 163, 14, 36, 133, 13, 167, 187,
-			13, 216, 99, 236, 124, 183, 65, 135, 134, 231, 47, 70, 40, 52,
-			211, 248, 13, 146, 154, 143, 58, 192, 68, 251, 13, 146, 58, 211,
-			109, 32, 208, 240, 204, 116, 183, 65, 135, 134, 217, 185, 8, 133,
-			110, 26, 95, 38, 169, 124, 212, 65, 79, 97, 67, 166, 219, 64,
-			160, 97, 98, 170, 219, 128, 175, 228, 166, 209, 18, 210, 32, 137,
-			175, 18, 77, 76, 143, 52, 46, 153, 95, 37, 26, 85, 96, 10,
-			192, 129, 33, 5, 18, 0, 135, 71, 21, 168, 3, 120, 122, 12,
-			147, 6, 253, 102, 234, 183, 73, 226, 223, 16, 146, 153, 101, 61,
-			117, 30, 97, 40, 221, 19, 98, 30, 179, 122, 12, 73, 218, 2,
-			152, 228, 111, 147, 244, 73, 250, 239, 53, 106, 24, 253, 96, 12,
-			95, 35, 218, 183, 136, 158, 249, 125, 237, 201, 172, 129, 21, 172,
-			106, 19, 215, 199, 61, 86, 245, 124, 65, 81, 124, 156, 2, 204,
-			175, 199, 120, 69, 175, 232, 86, 157, 60, 43, 139, 23, 21, 106,
-			252, 1, 34, 195, 30, 226, 80, 152, 168, 102, 88, 237, 54, 126,
-			227, 162, 131, 113, 141, 231, 215, 184, 31, 97, 176, 253, 110, 132,
-			14, 24, 120, 144, 103, 101, 175, 197, 105, 52, 112, 137, 195, 9,
-			60, 136, 212, 241, 8, 46, 126, 243, 66, 109, 240, 240, 128, 47,
-			199, 47, 212, 66, 52, 15, 75, 160, 60, 123, 12, 29, 90, 148,
-			213, 58, 40, 188, 170, 231, 6, 161, 111, 217, 110, 24, 176, 73,
-			158, 111, 228, 115, 232, 152, 114, 120, 175, 106, 74, 78, 138, 126,
-			156, 20, 95, 3, 139, 255, 89, 154, 2, 16, 244, 252, 7, 196,
-			24, 149, 49, 133, 112, 89, 114, 49, 235, 145, 76, 183, 36, 2,
-			2, 200, 83, 58, 76, 251, 4, 2, 195, 76, 253, 1, 49, 190,
-			70, 134, 209, 148, 176, 41, 137, 72, 251, 187, 13, 4, 26, 232,
-			96, 183, 65, 135, 6, 115, 4, 231, 102, 191, 152, 155, 95, 39,
-			198, 31, 201, 185, 217, 47, 231, 230, 215, 213, 220, 236, 23, 19,
-			235, 27, 36, 53, 26, 225, 128, 137, 245, 13, 146, 26, 232, 54,
-			96, 143, 99, 93, 42, 48, 177, 190, 1, 84, 20, 10, 205, 52,
-			190, 73, 82, 115, 81, 7, 152, 88, 223, 84, 19, 171, 95, 78,
-			172, 111, 146, 103, 114, 221, 6, 29, 26, 102, 102, 35, 20, 186,
-			105, 252, 33, 73, 77, 69, 29, 96, 98, 253, 33, 73, 141, 117,
-			27, 8, 52, 140, 63, 215, 109, 192, 87, 46, 78, 226, 196, 234,
-			7, 129, 127, 155, 104, 25, 161, 15, 156, 88, 223, 86, 19, 171,
-			31, 39, 214, 183, 213, 196, 234, 71, 185, 125, 155, 12, 159, 84,
-			160, 14, 224, 216, 56, 61, 70, 53, 131, 154, 169, 239, 144, 196,
-			191, 35, 4, 167, 9, 44, 0, 223, 33, 184, 109, 51, 12, 10,
-			68, 190, 75, 180, 83, 184, 234, 80, 84, 209, 119, 137, 246, 29,
-			114, 10, 17, 81, 84, 208, 119, 213, 170, 67, 145, 204, 119, 73,
-			122, 88, 129, 58, 128, 163, 39, 37, 46, 98, 26, 223, 35, 218,
-			11, 18, 23, 49, 204, 212, 247, 136, 246, 221, 8, 23, 168, 225,
-			123, 106, 64, 20, 149, 240, 61, 50, 113, 65, 129, 58, 128, 147,
-			83, 200, 242, 128, 153, 250, 99, 146, 248, 19, 201, 242, 0, 49,
-			141, 63, 134, 153, 253, 26, 53, 140, 1, 96, 249, 251, 68, 123,
-			54, 179, 138, 215, 134, 196, 55, 94, 176, 118, 207, 240, 244, 64,
-			77, 205, 87, 177, 113, 200, 51, 182, 225, 58, 123, 108, 83, 94,
-			164, 130, 13, 111, 81, 124, 203, 176, 110, 59, 78, 20, 124, 14,
-			160, 132, 191, 175, 36, 60, 128, 18, 254, 62, 25, 56, 165, 64,
-			2, 224, 233, 51, 10, 212, 1, 60, 199, 144, 221, 99, 102, 234,
-			7, 36, 241, 31, 37, 187, 199, 136, 105, 252, 0, 216, 253, 7,
-			212, 48, 142, 1, 187, 159, 0, 187, 155, 7, 216, 237, 50, 201,
-			118, 45, 87, 172, 119, 98, 7, 254, 8, 166, 163, 155, 231, 130,
-			207, 99, 200, 246, 39, 138, 237, 99, 200, 246, 39, 138, 237, 99,
-			200, 246, 39, 138, 237, 99, 200, 246, 39, 192, 118, 64, 181, 84,
-			194, 76, 253, 41, 73, 252, 13, 33, 25, 206, 212, 145, 135, 104,
-			11, 133, 78, 119, 205, 107, 172, 120, 141, 158, 96, 53, 138, 78,
-			49, 180, 197, 11, 50, 117, 171, 42, 175, 121, 82, 245, 70, 219,
-			247, 240, 95, 145, 193, 15, 168, 70, 255, 188, 206, 0, 213, 83,
-			192, 208, 159, 146, 244, 16, 229, 212, 72, 161, 87, 249, 51, 162,
-			45, 103, 94, 239, 22, 4, 153, 229, 56, 222, 46, 126, 47, 65,
-			242, 18, 122, 204, 231, 176, 107, 217, 225, 189, 137, 202, 195, 170,
-			82, 148, 181, 45, 223, 106, 241, 144, 251, 114, 137, 79, 9, 191,
-			242, 103, 36, 53, 168, 64, 13, 192, 161, 11, 10, 212, 1, 156,
-			93, 164, 155, 200, 19, 49, 141, 63, 39, 218, 157, 204, 210, 254,
-			12, 53, 150, 171, 153, 93, 227, 173, 182, 23, 138, 76, 105, 236,
-			95, 23, 82, 231, 109, 152, 215, 230, 242, 112, 171, 34, 79, 16,
-			101, 106, 84, 129, 26, 128, 39, 95, 80, 160, 14, 224, 229, 87,
-			233, 50, 146, 215, 76, 227, 135, 68, 91, 204, 92, 142, 167, 252,
-			158, 60, 75, 43, 112, 194, 32, 126, 72, 82, 67, 10, 68, 164,
-			195, 89, 5, 234, 0, 78, 255, 44, 221, 70, 138, 186, 105, 252,
-			37, 209, 214, 51, 149, 3, 201, 150, 3, 35, 142, 198, 134, 103,
-			186, 241, 31, 227, 137, 101, 6, 98, 85, 12, 26, 173, 132, 130,
-			36, 120, 187, 191, 36, 169, 147, 10, 212, 0, 60, 149, 83, 32,
-			178, 112, 101, 141, 254, 43, 130, 28, 25, 166, 241, 35, 162, 189,
-			146, 249, 42, 233, 221, 29, 61, 134, 33, 159, 87, 61, 191, 214,
-			35, 145, 139, 65, 180, 235, 235, 177, 12, 249, 149, 41, 188, 44,
-			35, 191, 228, 43, 40, 217, 65, 215, 176, 58, 109, 207, 101, 65,
-			7, 255, 69, 164, 122, 71, 253, 123, 62, 52, 194, 24, 141, 14,
-			182, 95, 63, 34, 169, 17, 5, 106, 0, 142, 78, 42, 80, 7,
-			112, 97, 149, 126, 95, 140, 46, 105, 26, 127, 69, 180, 249, 204,
-			191, 38, 98, 233, 20, 147, 45, 224, 184, 47, 247, 234, 221, 0,
-			56, 232, 108, 203, 27, 91, 182, 43, 34, 22, 228, 91, 157, 98,
-			183, 232, 190, 72, 169, 234, 185, 161, 37, 14, 236, 71, 223, 63,
-			237, 238, 254, 229, 206, 95, 222, 21, 227, 221, 96, 7, 53, 69,
-			99, 49, 143, 252, 50, 149, 29, 6, 189, 81, 73, 52, 94, 216,
-			238, 253, 21, 44, 169, 18, 212, 0, 60, 54, 166, 64, 29, 192,
-			243, 179, 244, 55, 197, 120, 83, 166, 241, 99, 162, 189, 156, 249,
-			37, 210, 189, 233, 137, 7, 113, 228, 89, 17, 43, 8, 194, 166,
-			239, 117, 26, 77, 140, 238, 218, 109, 238, 54, 108, 151, 95, 12,
-			186, 247, 61, 197, 193, 157, 60, 125, 196, 37, 76, 252, 134, 134,
-			186, 107, 87, 117, 188, 78, 45, 126, 205, 14, 93, 46, 126, 39,
-			72, 198, 95, 75, 28, 183, 91, 130, 99, 216, 69, 254, 24, 98,
-			111, 9, 106, 0, 142, 61, 167, 64, 29, 192, 153, 27, 244, 107,
-			98, 60, 125, 166, 241, 215, 68, 91, 205, 124, 57, 54, 30, 113,
-			86, 232, 78, 199, 9, 237, 79, 49, 170, 216, 91, 255, 183, 134,
-			6, 59, 212, 191, 134, 184, 67, 130, 26, 128, 227, 207, 43, 80,
-			7, 112, 238, 150, 186, 163, 240, 191, 3, 0, 0, 255, 255, 57,
-			229, 252, 247, 43, 111, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 220, 123, 91, 140, 28, 199,
+			117, 232, 84, 85, 79, 79, 79, 237, 187, 246, 221, 203, 71, 113,
+			105, 138, 75, 105, 57, 187, 92, 153, 87, 182, 76, 201, 226, 46,
+			121, 249, 22, 233, 225, 234, 113, 13, 221, 187, 183, 119, 166, 118,
+			166, 197, 158, 238, 97, 119, 15, 201, 85, 4, 33, 8, 242, 101,
+			32, 48, 146, 24, 78, 16, 199, 207, 196, 134, 237, 24, 9, 140,
+			192, 142, 157, 200, 176, 108, 56, 14, 28, 5, 136, 3, 1, 6,
+			252, 97, 4, 240, 143, 63, 252, 145, 0, 249, 72, 62, 131, 115,
+			186, 170, 231, 177, 203, 135, 4, 229, 199, 95, 187, 167, 186, 234,
+			188, 235, 156, 83, 167, 106, 248, 191, 112, 126, 173, 17, 85, 106,
+			205, 56, 106, 249, 157, 86, 37, 138, 27, 43, 65, 167, 230, 175,
+			4, 81, 163, 30, 53, 86, 188, 182, 191, 162, 194, 122, 59, 242,
+			195, 52, 89, 169, 69, 81, 92, 247, 67, 47, 141, 226, 149, 68,
+			197, 119, 252, 154, 74, 86, 238, 156, 90, 73, 82, 47, 85, 149,
+			118, 28, 165, 145, 176, 179, 149, 139, 95, 37, 124, 244, 106, 212,
+			184, 153, 198, 202, 107, 221, 132, 9, 226, 40, 31, 193, 57, 91,
+			119, 84, 156, 248, 81, 56, 71, 36, 89, 42, 87, 135, 113, 240,
+			197, 108, 76, 204, 112, 59, 81, 181, 88, 165, 115, 84, 146, 165,
+			225, 170, 134, 196, 49, 62, 154, 170, 184, 229, 135, 94, 176, 229,
+			135, 117, 117, 111, 142, 73, 178, 196, 170, 35, 102, 244, 18, 12,
+			10, 151, 59, 94, 92, 107, 250, 119, 84, 125, 206, 146, 100, 201,
+			169, 230, 48, 160, 110, 119, 226, 134, 170, 207, 21, 241, 139, 134,
+			46, 255, 200, 225, 182, 176, 172, 194, 65, 194, 191, 69, 56, 25,
+			22, 204, 42, 136, 181, 175, 19, 185, 17, 181, 119, 99, 191, 209,
+			76, 229, 218, 234, 169, 255, 37, 55, 155, 74, 94, 125, 97, 227,
+			146, 60, 219, 73, 155, 81, 156, 84, 228, 217, 32, 144, 56, 33,
+			145, 177, 2, 141, 168, 122, 133, 203, 23, 18, 37, 163, 29, 153,
+			54, 253, 68, 38, 81, 39, 174, 41, 89, 139, 234, 74, 250, 137,
+			108, 68, 119, 84, 28, 170, 186, 236, 132, 117, 21, 203, 180, 169,
+			228, 217, 182, 87, 3, 196, 126, 77, 133, 137, 90, 150, 90, 17,
+			114, 173, 178, 202, 101, 218, 244, 82, 89, 243, 66, 185, 173, 228,
+			78, 212, 9, 235, 210, 15, 113, 213, 213, 75, 27, 231, 159, 191,
+			121, 94, 238, 248, 129, 170, 112, 238, 112, 66, 5, 179, 11, 99,
+			124, 147, 83, 171, 32, 172, 114, 225, 32, 113, 47, 202, 126, 11,
+			0, 7, 176, 56, 136, 26, 50, 193, 97, 137, 166, 147, 119, 188,
+			160, 3, 76, 182, 90, 157, 208, 175, 121, 169, 170, 203, 52, 146,
+			198, 196, 21, 206, 57, 103, 86, 129, 8, 86, 118, 102, 248, 57,
+			110, 89, 5, 90, 16, 108, 136, 46, 184, 79, 201, 27, 61, 214,
+			51, 4, 208, 162, 219, 157, 29, 169, 45, 45, 119, 162, 88, 235,
+			3, 201, 86, 56, 31, 230, 69, 192, 82, 4, 52, 142, 129, 136,
+			96, 67, 229, 25, 3, 49, 193, 134, 230, 93, 254, 89, 138, 4,
+			137, 96, 147, 116, 210, 253, 36, 149, 155, 125, 34, 28, 79, 100,
+			230, 34, 21, 206, 229, 243, 81, 170, 50, 165, 1, 27, 217, 56,
+			48, 21, 171, 180, 3, 122, 63, 34, 55, 193, 42, 126, 34, 163,
+			91, 222, 238, 178, 76, 252, 176, 6, 11, 252, 68, 26, 55, 135,
+			233, 81, 24, 236, 114, 233, 213, 106, 42, 73, 252, 237, 64, 129,
+			54, 210, 184, 147, 128, 98, 114, 173, 200, 205, 46, 9, 109, 162,
+			26, 152, 178, 46, 183, 119, 243, 89, 50, 141, 56, 168, 215, 175,
+			131, 158, 181, 210, 253, 112, 39, 138, 91, 94, 10, 154, 185, 235,
+			167, 205, 168, 147, 202, 80, 169, 186, 31, 54, 128, 82, 27, 255,
+			54, 149, 220, 232, 238, 55, 233, 135, 92, 110, 171, 244, 174, 82,
+			161, 84, 94, 173, 41, 59, 109, 64, 153, 107, 146, 20, 65, 63,
+			37, 3, 129, 182, 156, 81, 3, 49, 193, 38, 39, 4, 191, 131,
+			138, 164, 130, 205, 210, 5, 215, 207, 248, 55, 28, 213, 213, 189,
+			204, 105, 7, 116, 107, 182, 151, 108, 169, 36, 241, 26, 170, 34,
+			47, 101, 179, 50, 159, 241, 19, 121, 242, 212, 50, 207, 215, 161,
+			137, 253, 32, 208, 8, 252, 176, 145, 115, 72, 139, 64, 216, 112,
+			72, 137, 96, 179, 142, 177, 53, 101, 130, 205, 206, 187, 252, 73,
+			228, 144, 9, 230, 210, 41, 247, 49, 121, 105, 144, 33, 217, 244,
+			18, 185, 13, 58, 48, 187, 58, 71, 207, 138, 176, 202, 54, 16,
+			17, 204, 45, 141, 25, 8, 48, 138, 73, 126, 10, 209, 91, 130,
+			29, 160, 194, 253, 192, 131, 208, 103, 161, 33, 71, 110, 21, 97,
+			141, 65, 110, 17, 193, 14, 148, 70, 12, 196, 4, 59, 48, 62,
+			177, 109, 163, 215, 63, 201, 255, 131, 240, 67, 141, 40, 106, 4,
+			106, 197, 236, 131, 149, 122, 39, 70, 115, 235, 32, 57, 150, 125,
+			175, 152, 239, 139, 79, 115, 231, 156, 158, 34, 230, 120, 41, 81,
+			181, 40, 172, 39, 24, 32, 89, 213, 128, 98, 138, 23, 67, 47,
+			140, 18, 12, 141, 197, 106, 6, 172, 191, 206, 39, 107, 81, 171,
+			50, 128, 114, 125, 196, 32, 196, 13, 122, 131, 124, 252, 241, 134,
+			159, 54, 59, 219, 149, 90, 212, 90, 105, 68, 129, 23, 54, 186,
+			252, 181, 211, 221, 182, 74, 114, 54, 255, 139, 144, 47, 81, 118,
+			225, 198, 250, 215, 233, 161, 11, 25, 222, 27, 26, 111, 229, 37,
+			21, 4, 87, 194, 232, 110, 184, 9, 75, 114, 169, 255, 147, 240,
+			195, 131, 82, 167, 126, 75, 37, 169, 215, 106, 223, 79, 236, 143,
+			240, 242, 166, 153, 243, 174, 229, 126, 99, 127, 185, 71, 115, 140,
+			70, 240, 39, 30, 46, 120, 206, 233, 123, 144, 252, 31, 203, 239,
+			71, 14, 77, 189, 228, 86, 210, 159, 67, 221, 135, 184, 145, 251,
+			48, 133, 47, 238, 240, 161, 179, 217, 62, 217, 244, 146, 91, 160,
+			225, 118, 28, 189, 170, 106, 169, 78, 189, 6, 20, 163, 156, 250,
+			117, 116, 171, 114, 149, 250, 117, 177, 192, 203, 192, 208, 86, 232,
+			181, 212, 92, 9, 135, 29, 24, 120, 222, 107, 41, 49, 206, 217,
+			45, 181, 139, 249, 119, 184, 10, 255, 94, 254, 126, 41, 203, 160,
+			238, 111, 66, 6, 45, 115, 202, 10, 130, 57, 133, 37, 252, 23,
+			178, 94, 225, 4, 175, 103, 121, 117, 164, 224, 18, 247, 101, 217,
+			163, 85, 96, 202, 147, 160, 28, 121, 187, 163, 58, 42, 251, 183,
+			174, 146, 90, 236, 183, 211, 110, 242, 83, 58, 100, 121, 1, 136,
+			230, 65, 234, 105, 4, 138, 247, 68, 159, 158, 60, 59, 226, 76,
+			118, 243, 236, 24, 157, 118, 159, 66, 221, 129, 57, 76, 148, 214,
+			166, 51, 217, 46, 207, 173, 192, 207, 54, 150, 10, 105, 148, 199,
+			175, 66, 17, 208, 244, 230, 217, 177, 242, 184, 129, 152, 96, 99,
+			147, 83, 252, 67, 38, 205, 78, 208, 113, 247, 9, 164, 215, 244,
+			146, 166, 188, 116, 110, 111, 98, 128, 52, 165, 35, 112, 78, 3,
+			50, 208, 68, 78, 131, 16, 193, 38, 202, 67, 6, 98, 130, 77,
+			140, 142, 241, 150, 201, 64, 83, 116, 214, 253, 255, 72, 3, 60,
+			243, 99, 168, 184, 231, 115, 233, 160, 140, 240, 146, 91, 203, 178,
+			147, 168, 220, 96, 160, 215, 76, 195, 109, 191, 173, 2, 63, 84,
+			21, 158, 167, 243, 88, 221, 238, 248, 177, 170, 163, 178, 207, 110,
+			92, 25, 76, 60, 83, 57, 99, 32, 225, 84, 89, 24, 136, 9,
+			54, 53, 61, 195, 223, 33, 38, 243, 204, 211, 113, 247, 239, 137,
+			220, 188, 126, 238, 250, 82, 211, 15, 163, 91, 222, 137, 167, 101,
+			85, 181, 162, 59, 186, 80, 240, 118, 82, 21, 203, 90, 188, 221,
+			105, 96, 72, 249, 240, 218, 147, 167, 79, 63, 5, 172, 244, 152,
+			248, 150, 218, 221, 171, 53, 157, 59, 253, 4, 63, 215, 35, 149,
+			132, 199, 83, 217, 242, 210, 90, 19, 37, 132, 81, 200, 243, 3,
+			186, 198, 26, 109, 25, 103, 128, 152, 42, 73, 193, 198, 73, 167,
+			173, 226, 157, 160, 19, 117, 18, 233, 133, 117, 153, 52, 163, 78,
+			80, 7, 55, 175, 171, 64, 165, 3, 153, 113, 62, 79, 188, 140,
+			8, 54, 239, 24, 195, 48, 38, 216, 252, 232, 88, 30, 204, 254,
+			134, 240, 133, 193, 168, 162, 90, 237, 116, 247, 126, 33, 188, 196,
+			139, 231, 225, 251, 250, 157, 253, 195, 49, 199, 175, 38, 20, 31,
+			127, 120, 40, 70, 106, 239, 33, 12, 191, 181, 197, 175, 191, 15,
+			71, 153, 172, 110, 27, 8, 196, 239, 239, 25, 201, 125, 127, 211,
+			197, 67, 211, 196, 131, 12, 186, 184, 201, 139, 231, 227, 56, 138,
+			33, 228, 55, 226, 118, 109, 11, 2, 45, 166, 135, 98, 213, 129,
+			129, 141, 168, 174, 196, 1, 94, 78, 99, 47, 76, 124, 21, 102,
+			7, 51, 167, 218, 29, 128, 132, 208, 74, 26, 152, 16, 202, 85,
+			214, 74, 26, 139, 111, 17, 62, 113, 65, 165, 27, 81, 184, 227,
+			55, 170, 42, 105, 71, 97, 162, 196, 50, 23, 53, 28, 217, 210,
+			162, 108, 117, 226, 64, 167, 162, 241, 236, 203, 205, 236, 195, 11,
+			113, 32, 14, 114, 158, 207, 78, 117, 110, 42, 155, 89, 169, 168,
+			240, 73, 131, 69, 79, 107, 123, 105, 83, 51, 49, 161, 63, 101,
+			12, 220, 240, 210, 38, 204, 207, 209, 225, 183, 173, 102, 148, 164,
+			120, 72, 44, 87, 39, 250, 168, 95, 140, 146, 116, 241, 107, 132,
+			79, 87, 85, 195, 79, 82, 21, 103, 167, 216, 106, 182, 249, 30,
+			144, 70, 239, 119, 120, 221, 115, 242, 101, 251, 156, 124, 5, 183,
+			32, 107, 32, 71, 195, 85, 252, 127, 159, 83, 111, 113, 159, 83,
+			239, 226, 111, 241, 153, 65, 86, 181, 202, 179, 196, 78, 242, 196,
+			190, 204, 139, 24, 78, 80, 159, 67, 107, 51, 149, 204, 235, 42,
+			253, 7, 197, 106, 54, 73, 28, 229, 69, 5, 206, 129, 90, 29,
+			90, 27, 49, 179, 209, 99, 170, 217, 183, 197, 143, 241, 137, 171,
+			145, 87, 127, 84, 29, 13, 150, 26, 70, 108, 32, 225, 100, 98,
+			47, 126, 133, 112, 209, 139, 51, 247, 31, 205, 60, 121, 20, 230,
+			13, 98, 218, 163, 207, 39, 56, 243, 26, 74, 139, 51, 63, 24,
+			172, 42, 166, 100, 174, 194, 44, 113, 132, 15, 155, 120, 190, 5,
+			5, 79, 102, 152, 33, 51, 118, 69, 237, 46, 254, 51, 225, 51,
+			155, 153, 41, 82, 245, 94, 53, 208, 245, 26, 246, 144, 150, 135,
+			181, 95, 203, 227, 40, 31, 169, 199, 81, 187, 173, 234, 91, 219,
+			187, 169, 74, 176, 187, 193, 170, 195, 122, 112, 29, 198, 196, 113,
+			62, 102, 38, 169, 48, 141, 125, 149, 204, 217, 56, 109, 84, 15,
+			159, 207, 70, 23, 127, 72, 249, 148, 174, 110, 222, 171, 60, 143,
+			241, 177, 32, 106, 32, 157, 221, 173, 90, 212, 9, 83, 211, 171,
+			9, 162, 6, 208, 217, 221, 128, 193, 71, 149, 111, 202, 56, 33,
+			200, 85, 214, 94, 7, 209, 33, 75, 143, 24, 67, 56, 126, 42,
+			103, 35, 16, 60, 14, 243, 33, 253, 57, 241, 95, 83, 115, 67,
+			136, 88, 175, 184, 233, 191, 166, 32, 220, 161, 78, 113, 249, 20,
+			46, 119, 112, 64, 135, 30, 252, 63, 91, 60, 141, 139, 203, 56,
+			2, 107, 47, 91, 206, 161, 241, 195, 151, 45, 231, 240, 184, 172,
+			58, 117, 47, 245, 0, 73, 181, 140, 255, 193, 138, 197, 95, 83,
+			62, 188, 14, 105, 221, 40, 111, 153, 179, 88, 221, 158, 35, 146,
+			45, 13, 173, 185, 198, 111, 123, 167, 84, 80, 49, 85, 152, 230,
+			126, 131, 242, 34, 130, 226, 34, 31, 139, 245, 198, 222, 202, 152,
+			71, 229, 15, 173, 29, 52, 56, 6, 247, 61, 210, 187, 88, 168,
+			142, 154, 117, 217, 7, 113, 134, 15, 5, 145, 87, 55, 88, 40,
+			98, 153, 55, 88, 246, 108, 224, 139, 133, 42, 15, 242, 65, 113,
+			133, 143, 107, 163, 164, 202, 160, 96, 136, 226, 144, 65, 177, 255,
+			54, 184, 88, 168, 142, 229, 43, 53, 178, 243, 124, 84, 215, 142,
+			6, 149, 133, 168, 14, 24, 84, 251, 249, 223, 197, 66, 117, 68,
+			175, 202, 208, 172, 151, 120, 17, 123, 16, 139, 159, 166, 124, 68,
+			171, 82, 7, 138, 21, 110, 197, 42, 105, 107, 125, 47, 12, 232,
+			59, 155, 164, 21, 142, 19, 221, 119, 136, 209, 248, 20, 47, 162,
+			161, 117, 10, 204, 0, 113, 132, 51, 21, 199, 115, 116, 159, 48,
+			120, 177, 80, 133, 111, 226, 210, 94, 83, 13, 104, 104, 208, 84,
+			25, 31, 251, 216, 234, 153, 126, 91, 101, 218, 113, 247, 179, 85,
+			142, 162, 199, 88, 93, 197, 40, 62, 124, 85, 121, 137, 210, 42,
+			4, 159, 111, 121, 247, 182, 160, 144, 54, 103, 108, 167, 229, 221,
+			131, 10, 60, 17, 31, 226, 60, 128, 201, 91, 112, 22, 158, 163,
+			15, 139, 144, 101, 156, 12, 103, 237, 197, 167, 249, 136, 38, 163,
+			213, 127, 130, 23, 13, 13, 240, 247, 201, 1, 187, 2, 189, 106,
+			54, 3, 214, 158, 195, 114, 213, 240, 248, 232, 107, 215, 254, 202,
+			226, 142, 206, 216, 137, 120, 150, 151, 243, 130, 67, 204, 236, 225,
+			27, 171, 80, 55, 247, 247, 189, 181, 201, 117, 62, 218, 111, 31,
+			241, 224, 45, 230, 62, 196, 172, 98, 131, 243, 174, 165, 196, 253,
+			119, 154, 251, 0, 195, 130, 83, 13, 236, 43, 241, 144, 13, 231,
+			222, 71, 118, 113, 158, 143, 104, 29, 106, 68, 15, 220, 110, 247,
+			69, 243, 65, 94, 196, 109, 36, 166, 6, 118, 85, 182, 108, 122,
+			96, 84, 203, 241, 28, 159, 64, 23, 209, 164, 192, 3, 146, 46,
+			134, 94, 39, 117, 167, 7, 70, 53, 134, 13, 46, 50, 71, 233,
+			67, 145, 79, 238, 115, 162, 251, 49, 127, 249, 83, 23, 120, 73,
+			20, 173, 194, 191, 147, 223, 168, 70, 197, 75, 221, 70, 69, 246,
+			47, 21, 140, 235, 246, 5, 52, 209, 11, 199, 248, 207, 72, 214,
+			191, 16, 133, 121, 226, 254, 132, 72, 12, 93, 192, 166, 103, 26,
+			9, 208, 169, 192, 198, 68, 163, 122, 99, 67, 98, 146, 197, 142,
+			250, 245, 205, 243, 79, 103, 199, 108, 56, 101, 250, 105, 34, 155,
+			42, 104, 171, 88, 238, 116, 66, 92, 149, 200, 150, 183, 11, 92,
+			119, 18, 181, 211, 9, 100, 43, 138, 149, 108, 168, 80, 197, 94,
+			16, 236, 86, 228, 53, 111, 119, 91, 113, 137, 199, 133, 118, 224,
+			133, 186, 91, 1, 29, 132, 190, 185, 178, 29, 120, 53, 37, 147,
+			78, 173, 41, 189, 68, 46, 174, 172, 192, 241, 99, 81, 250, 59,
+			210, 79, 161, 61, 159, 200, 78, 91, 110, 43, 63, 108, 112, 77,
+			171, 167, 99, 34, 156, 17, 190, 108, 58, 38, 83, 116, 198, 61,
+			140, 70, 68, 97, 208, 36, 249, 21, 132, 17, 77, 119, 63, 138,
+			48, 221, 28, 142, 161, 51, 50, 229, 76, 24, 8, 154, 3, 83,
+			211, 252, 89, 211, 25, 153, 161, 211, 238, 41, 185, 105, 14, 62,
+			160, 191, 52, 238, 40, 96, 49, 213, 141, 8, 79, 230, 231, 162,
+			92, 137, 186, 7, 82, 4, 4, 166, 135, 12, 253, 145, 153, 146,
+			233, 193, 16, 38, 216, 204, 228, 20, 175, 152, 254, 200, 28, 157,
+			112, 143, 200, 179, 161, 140, 176, 135, 228, 5, 210, 75, 146, 168,
+			230, 227, 221, 140, 105, 197, 27, 204, 208, 224, 152, 235, 107, 112,
+			204, 149, 135, 13, 196, 4, 155, 27, 27, 231, 255, 151, 83, 139,
+			8, 235, 96, 225, 113, 226, 126, 76, 238, 137, 127, 230, 230, 38,
+			54, 112, 146, 198, 157, 90, 218, 137, 141, 226, 208, 188, 49, 151,
+			139, 249, 210, 197, 252, 202, 68, 155, 1, 68, 58, 232, 204, 243,
+			144, 91, 22, 1, 51, 72, 42, 93, 15, 205, 112, 246, 198, 37,
+			249, 66, 245, 170, 105, 137, 108, 123, 137, 146, 139, 112, 58, 63,
+			89, 211, 184, 244, 161, 12, 91, 36, 216, 2, 200, 26, 30, 117,
+			181, 227, 117, 130, 148, 155, 239, 136, 229, 46, 92, 48, 100, 14,
+			103, 186, 28, 4, 175, 146, 164, 86, 2, 193, 171, 36, 89, 94,
+			48, 16, 19, 76, 30, 58, 140, 134, 36, 96, 200, 163, 116, 206,
+			61, 181, 167, 165, 150, 241, 162, 51, 156, 76, 84, 10, 109, 46,
+			72, 172, 114, 39, 142, 90, 57, 37, 104, 116, 29, 205, 41, 129,
+			212, 71, 203, 147, 6, 98, 130, 29, 157, 153, 229, 207, 35, 37,
+			42, 216, 49, 122, 196, 61, 139, 148, 224, 120, 106, 40, 165, 234,
+			94, 122, 50, 81, 177, 239, 5, 254, 107, 221, 75, 165, 1, 14,
+			242, 232, 101, 40, 131, 161, 143, 229, 148, 65, 144, 99, 229, 3,
+			6, 98, 130, 29, 59, 44, 249, 14, 82, 102, 130, 157, 160, 71,
+			220, 255, 131, 148, 225, 160, 251, 64, 65, 181, 234, 77, 51, 173,
+			29, 171, 29, 21, 67, 55, 13, 110, 175, 22, 7, 142, 205, 157,
+			56, 88, 204, 117, 1, 183, 46, 39, 114, 142, 32, 6, 157, 200,
+			57, 130, 222, 210, 137, 195, 146, 191, 206, 169, 69, 133, 85, 41,
+			60, 67, 220, 182, 220, 55, 155, 26, 247, 3, 157, 71, 59, 178,
+			230, 5, 129, 138, 79, 38, 157, 118, 59, 240, 85, 93, 66, 101,
+			109, 252, 144, 15, 96, 232, 187, 37, 211, 60, 14, 122, 38, 104,
+			170, 226, 28, 228, 75, 220, 178, 40, 120, 230, 42, 157, 118, 23,
+			246, 94, 36, 234, 80, 168, 165, 163, 232, 83, 171, 90, 58, 138,
+			62, 181, 170, 219, 166, 20, 125, 106, 117, 114, 138, 31, 71, 156,
+			68, 176, 53, 58, 233, 186, 15, 184, 156, 4, 51, 81, 116, 158,
+			53, 29, 111, 40, 222, 211, 173, 233, 123, 58, 138, 206, 179, 54,
+			33, 248, 71, 16, 37, 21, 236, 52, 93, 112, 43, 136, 114, 207,
+			141, 106, 146, 198, 112, 77, 184, 255, 197, 42, 69, 79, 57, 157,
+			115, 14, 242, 159, 214, 23, 171, 20, 61, 229, 244, 188, 203, 175,
+			32, 25, 38, 216, 83, 116, 194, 125, 86, 95, 103, 230, 46, 153,
+			31, 170, 207, 233, 86, 118, 20, 119, 239, 117, 239, 71, 22, 220,
+			225, 169, 92, 58, 112, 135, 167, 156, 97, 3, 1, 165, 177, 113,
+			254, 85, 104, 181, 82, 106, 9, 118, 134, 46, 184, 127, 66, 122,
+			238, 33, 123, 239, 27, 177, 240, 198, 112, 240, 236, 51, 114, 117,
+			89, 111, 155, 222, 143, 121, 28, 72, 84, 202, 77, 182, 204, 74,
+			105, 237, 217, 186, 93, 186, 44, 189, 59, 145, 95, 55, 215, 170,
+			112, 213, 138, 222, 228, 133, 210, 171, 215, 125, 152, 234, 5, 220,
+			96, 7, 80, 86, 111, 108, 228, 66, 193, 229, 223, 153, 92, 40,
+			184, 252, 59, 163, 47, 46, 41, 94, 254, 157, 153, 119, 249, 179,
+			28, 254, 181, 158, 43, 92, 36, 238, 154, 220, 236, 141, 164, 58,
+			90, 107, 157, 169, 65, 247, 205, 40, 129, 151, 130, 186, 158, 115,
+			14, 241, 15, 114, 203, 98, 224, 165, 235, 116, 220, 61, 46, 55,
+			7, 110, 130, 247, 235, 199, 107, 94, 25, 122, 236, 186, 182, 59,
+			67, 143, 93, 215, 77, 120, 134, 30, 187, 62, 58, 198, 79, 35,
+			126, 34, 216, 57, 186, 224, 46, 105, 245, 123, 105, 30, 29, 180,
+			214, 84, 125, 63, 2, 196, 134, 117, 227, 6, 2, 44, 19, 51,
+			6, 98, 130, 157, 155, 119, 249, 19, 72, 128, 10, 118, 129, 10,
+			247, 80, 183, 214, 0, 158, 49, 39, 230, 202, 201, 209, 82, 27,
+			102, 151, 12, 68, 4, 187, 224, 140, 24, 136, 9, 118, 97, 124,
+			130, 95, 228, 212, 178, 132, 117, 165, 112, 147, 184, 103, 228, 158,
+			66, 26, 35, 117, 70, 164, 214, 137, 99, 21, 166, 93, 177, 188,
+			126, 81, 64, 219, 96, 199, 43, 206, 60, 198, 4, 11, 180, 125,
+			237, 81, 98, 130, 133, 26, 190, 166, 53, 108, 161, 134, 175, 233,
+			152, 96, 161, 134, 175, 77, 78, 161, 5, 45, 216, 116, 215, 115,
+			11, 246, 227, 132, 84, 208, 111, 211, 28, 63, 4, 136, 235, 57,
+			126, 8, 16, 215, 181, 5, 45, 12, 16, 215, 71, 199, 248, 211,
+			136, 159, 10, 86, 165, 227, 238, 73, 216, 35, 80, 134, 44, 75,
+			63, 172, 5, 157, 186, 26, 188, 64, 48, 151, 81, 121, 49, 98,
+			97, 124, 168, 234, 98, 36, 11, 95, 213, 146, 161, 2, 241, 161,
+			138, 84, 168, 85, 20, 214, 139, 133, 58, 113, 43, 15, 246, 233,
+			174, 49, 122, 252, 185, 72, 4, 123, 209, 113, 209, 223, 138, 160,
+			225, 151, 223, 181, 191, 21, 105, 193, 134, 117, 227, 6, 34, 130,
+			189, 172, 253, 173, 136, 234, 126, 25, 94, 136, 64, 68, 41, 130,
+			16, 175, 208, 9, 247, 147, 4, 20, 146, 35, 93, 150, 105, 127,
+			96, 219, 95, 49, 251, 70, 89, 158, 23, 247, 221, 153, 89, 212,
+			233, 94, 218, 44, 246, 117, 118, 23, 229, 142, 175, 2, 172, 221,
+			23, 209, 251, 76, 170, 44, 98, 228, 127, 69, 187, 120, 17, 35,
+			255, 43, 58, 54, 22, 209, 176, 175, 140, 141, 243, 19, 40, 8,
+			21, 108, 139, 30, 117, 15, 32, 79, 94, 35, 87, 212, 62, 234,
+			129, 125, 179, 69, 93, 3, 17, 193, 182, 22, 14, 25, 136, 9,
+			182, 117, 100, 145, 127, 49, 83, 15, 19, 172, 70, 231, 221, 63,
+			36, 239, 243, 77, 85, 142, 73, 171, 252, 61, 223, 84, 21, 241,
+			166, 170, 150, 171, 8, 226, 97, 205, 153, 50, 16, 240, 63, 59,
+			199, 99, 78, 45, 91, 88, 205, 194, 61, 226, 238, 200, 253, 15,
+			193, 239, 170, 156, 24, 64, 113, 191, 26, 194, 38, 130, 53, 157,
+			67, 24, 47, 108, 240, 230, 87, 31, 37, 94, 216, 24, 47, 94,
+			213, 251, 217, 198, 120, 241, 170, 142, 23, 54, 58, 240, 171, 58,
+			94, 216, 224, 191, 193, 187, 141, 23, 54, 186, 85, 144, 227, 7,
+			183, 10, 116, 188, 176, 209, 173, 130, 209, 49, 172, 81, 108, 56,
+			86, 132, 143, 80, 163, 216, 24, 28, 66, 109, 6, 27, 157, 42,
+			212, 53, 138, 141, 78, 21, 78, 8, 190, 138, 40, 153, 96, 183,
+			233, 130, 123, 84, 110, 238, 205, 206, 218, 169, 250, 28, 214, 70,
+			19, 223, 206, 113, 131, 137, 111, 235, 100, 106, 163, 137, 111, 207,
+			187, 220, 67, 220, 150, 96, 29, 234, 186, 155, 136, 59, 236, 180,
+			182, 85, 12, 142, 138, 61, 110, 248, 199, 236, 97, 48, 102, 254,
+			228, 107, 189, 147, 6, 42, 150, 186, 169, 45, 183, 85, 205, 235,
+			100, 103, 116, 63, 77, 184, 12, 252, 150, 159, 38, 57, 51, 144,
+			217, 59, 57, 51, 144, 17, 58, 206, 180, 129, 152, 96, 157, 185,
+			121, 254, 18, 50, 83, 20, 236, 46, 61, 224, 94, 30, 96, 6,
+			118, 140, 238, 167, 63, 34, 15, 131, 44, 20, 17, 179, 97, 1,
+			66, 230, 93, 103, 214, 64, 76, 176, 187, 238, 2, 143, 56, 181,
+			74, 162, 248, 122, 225, 143, 9, 113, 183, 205, 227, 131, 247, 234,
+			241, 253, 235, 249, 253, 252, 189, 68, 4, 123, 221, 57, 128, 254,
+			94, 2, 127, 127, 227, 81, 252, 189, 132, 254, 254, 134, 246, 199,
+			18, 250, 251, 27, 218, 223, 75, 232, 239, 111, 76, 78, 241, 11,
+			136, 147, 8, 235, 183, 9, 29, 119, 63, 252, 72, 37, 78, 166,
+			223, 187, 94, 162, 3, 14, 134, 141, 17, 68, 75, 138, 136, 201,
+			49, 32, 34, 46, 15, 25, 144, 1, 56, 58, 198, 159, 65, 170,
+			84, 88, 191, 67, 232, 1, 119, 229, 97, 166, 188, 171, 98, 181,
+			151, 22, 45, 226, 250, 146, 1, 9, 128, 206, 172, 1, 25, 128,
+			238, 2, 255, 40, 210, 98, 194, 250, 93, 66, 23, 244, 81, 179,
+			233, 55, 154, 96, 170, 30, 153, 178, 157, 114, 127, 201, 88, 17,
+			49, 24, 106, 140, 0, 232, 204, 24, 16, 241, 207, 187, 252, 95,
+			33, 194, 151, 168, 37, 172, 79, 16, 58, 233, 254, 20, 51, 96,
+			24, 165, 61, 39, 233, 88, 101, 20, 204, 203, 57, 47, 200, 219,
+			19, 89, 119, 39, 203, 91, 224, 12, 160, 128, 8, 74, 108, 124,
+			10, 154, 41, 195, 11, 117, 229, 22, 213, 176, 184, 170, 203, 37,
+			236, 196, 200, 48, 10, 79, 34, 149, 19, 16, 219, 57, 172, 196,
+			56, 187, 11, 164, 176, 13, 173, 197, 131, 172, 153, 52, 163, 187,
+			208, 196, 209, 181, 122, 175, 201, 7, 156, 210, 56, 35, 152, 37,
+			201, 213, 1, 229, 200, 39, 186, 134, 134, 134, 198, 39, 72, 121,
+			212, 128, 12, 192, 9, 193, 159, 67, 109, 20, 133, 245, 123, 132,
+			206, 235, 114, 92, 171, 182, 183, 11, 209, 181, 195, 113, 115, 144,
+			193, 104, 146, 147, 43, 102, 40, 12, 185, 34, 1, 176, 60, 105,
+			64, 6, 224, 204, 28, 255, 48, 146, 179, 133, 245, 73, 32, 151,
+			189, 156, 129, 107, 160, 71, 166, 99, 23, 113, 173, 177, 178, 77,
+			0, 116, 12, 29, 155, 1, 56, 51, 167, 125, 170, 36, 172, 63,
+			32, 121, 251, 226, 97, 98, 101, 14, 214, 71, 173, 84, 68, 12,
+			70, 170, 18, 1, 176, 44, 12, 200, 0, 156, 158, 197, 231, 64,
+			37, 234, 8, 235, 83, 64, 237, 241, 7, 75, 181, 15, 25, 167,
+			136, 75, 141, 80, 14, 1, 208, 49, 100, 28, 6, 224, 244, 44,
+			31, 130, 80, 195, 133, 245, 105, 66, 143, 242, 97, 160, 201, 11,
+			0, 13, 77, 107, 136, 0, 52, 187, 152, 77, 44, 11, 235, 143,
+			8, 21, 217, 196, 114, 1, 160, 161, 145, 12, 101, 185, 64, 250,
+			65, 170, 193, 108, 46, 126, 28, 155, 208, 31, 201, 0, 72, 53,
+			248, 113, 78, 45, 71, 216, 159, 33, 133, 175, 16, 226, 94, 149,
+			189, 189, 109, 40, 42, 60, 185, 13, 35, 96, 89, 216, 31, 119,
+			252, 122, 167, 91, 248, 224, 182, 105, 121, 183, 178, 231, 194, 253,
+			254, 93, 225, 40, 0, 104, 225, 51, 196, 153, 194, 154, 216, 97,
+			5, 97, 127, 150, 208, 63, 39, 204, 61, 134, 250, 173, 69, 65,
+			208, 109, 197, 34, 41, 85, 207, 209, 107, 213, 58, 12, 36, 253,
+			44, 225, 99, 124, 156, 219, 150, 195, 10, 78, 65, 216, 159, 35,
+			214, 159, 145, 34, 31, 227, 165, 108, 132, 8, 235, 115, 48, 101,
+			52, 155, 66, 11, 194, 250, 60, 177, 87, 205, 4, 168, 171, 97,
+			96, 161, 59, 64, 96, 198, 129, 39, 186, 3, 12, 6, 42, 43,
+			57, 10, 34, 172, 47, 16, 123, 41, 159, 64, 108, 28, 152, 237,
+			14, 224, 140, 185, 163, 221, 1, 6, 3, 143, 29, 207, 81, 80,
+			97, 125, 145, 216, 107, 249, 4, 106, 227, 192, 129, 238, 0, 129,
+			129, 131, 39, 187, 3, 12, 6, 86, 79, 229, 40, 152, 176, 190,
+			68, 236, 74, 62, 129, 217, 56, 224, 118, 7, 8, 12, 44, 156,
+			232, 14, 224, 146, 229, 147, 232, 53, 14, 104, 226, 203, 132, 206,
+			103, 186, 164, 5, 11, 65, 173, 90, 84, 203, 151, 201, 208, 184,
+			1, 9, 124, 157, 152, 50, 32, 3, 112, 118, 14, 143, 251, 101,
+			97, 127, 141, 20, 190, 71, 136, 187, 106, 252, 68, 159, 142, 208,
+			81, 242, 179, 18, 54, 186, 123, 29, 73, 251, 66, 153, 8, 235,
+			107, 196, 153, 230, 223, 163, 220, 178, 202, 224, 12, 223, 36, 244,
+			239, 8, 115, 191, 65, 31, 205, 27, 228, 121, 120, 8, 14, 245,
+			199, 174, 172, 69, 113, 70, 177, 158, 24, 247, 235, 165, 169, 103,
+			193, 107, 115, 252, 150, 180, 85, 205, 223, 129, 210, 0, 183, 46,
+			34, 195, 25, 209, 54, 92, 6, 100, 61, 125, 175, 221, 86, 94,
+			44, 225, 121, 122, 180, 35, 163, 24, 126, 178, 96, 48, 248, 177,
+			225, 4, 50, 133, 186, 7, 15, 226, 111, 70, 45, 197, 243, 67,
+			162, 198, 17, 36, 17, 244, 104, 90, 126, 2, 47, 30, 151, 179,
+			94, 57, 156, 15, 90, 240, 86, 95, 133, 117, 89, 195, 135, 107,
+			144, 174, 218, 113, 116, 199, 207, 206, 175, 45, 46, 235, 240, 152,
+			50, 130, 102, 37, 52, 116, 224, 57, 148, 92, 82, 149, 70, 5,
+			158, 237, 191, 166, 150, 37, 92, 84, 158, 208, 155, 162, 140, 155,
+			226, 155, 224, 241, 31, 229, 54, 104, 19, 236, 252, 215, 196, 154,
+			210, 137, 30, 153, 52, 137, 167, 79, 51, 121, 47, 11, 85, 84,
+			225, 232, 55, 136, 160, 136, 24, 202, 221, 1, 2, 3, 124, 172,
+			59, 192, 96, 64, 76, 226, 70, 44, 103, 27, 241, 91, 196, 250,
+			91, 189, 17, 203, 122, 35, 126, 203, 108, 68, 192, 74, 132, 245,
+			109, 98, 79, 229, 56, 96, 23, 125, 155, 216, 67, 221, 1, 156,
+			49, 220, 165, 66, 24, 12, 136, 201, 28, 5, 21, 214, 119, 136,
+			125, 42, 159, 0, 187, 232, 59, 102, 23, 149, 245, 46, 250, 14,
+			57, 184, 220, 29, 96, 48, 176, 178, 154, 163, 96, 194, 250, 46,
+			177, 79, 228, 40, 96, 23, 125, 151, 216, 115, 221, 1, 2, 3,
+			243, 31, 232, 14, 224, 146, 227, 75, 184, 139, 202, 160, 221, 55,
+			9, 117, 51, 229, 227, 46, 122, 211, 236, 162, 50, 238, 162, 55,
+			205, 46, 42, 163, 222, 222, 36, 19, 211, 6, 100, 240, 117, 110,
+			158, 15, 115, 106, 113, 97, 127, 159, 20, 126, 72, 8, 238, 9,
+			78, 132, 245, 125, 136, 143, 64, 132, 3, 145, 183, 8, 205, 202,
+			29, 14, 149, 165, 245, 150, 201, 40, 28, 177, 190, 69, 156, 9,
+			3, 50, 152, 60, 53, 173, 151, 18, 97, 253, 128, 208, 199, 245,
+			71, 80, 242, 15, 12, 187, 28, 85, 252, 3, 178, 112, 204, 128,
+			12, 190, 46, 157, 64, 134, 134, 132, 253, 35, 82, 248, 7, 205,
+			208, 16, 17, 214, 143, 96, 147, 190, 200, 45, 107, 8, 24, 250,
+			49, 161, 71, 220, 139, 18, 175, 48, 165, 23, 103, 239, 125, 19,
+			137, 215, 225, 117, 179, 245, 178, 250, 187, 34, 229, 245, 48, 216,
+			149, 55, 178, 182, 50, 20, 72, 242, 82, 29, 23, 237, 248, 65,
+			144, 215, 121, 67, 168, 191, 31, 27, 253, 13, 161, 254, 126, 76,
+			134, 102, 12, 72, 128, 236, 236, 1, 3, 50, 0, 15, 75, 100,
+			119, 88, 216, 63, 33, 133, 127, 210, 236, 14, 19, 97, 253, 4,
+			216, 253, 127, 220, 178, 134, 129, 221, 183, 129, 221, 27, 123, 216,
+			237, 50, 41, 239, 122, 176, 179, 210, 72, 159, 198, 31, 192, 180,
+			121, 100, 171, 217, 30, 70, 182, 223, 54, 108, 15, 35, 219, 111,
+			27, 182, 135, 209, 64, 111, 27, 182, 135, 145, 237, 183, 201, 97,
+			201, 19, 78, 237, 130, 176, 127, 74, 224, 30, 214, 85, 210, 220,
+			225, 231, 39, 17, 140, 159, 87, 163, 198, 185, 168, 209, 119, 44,
+			200, 139, 66, 172, 40, 253, 48, 85, 241, 142, 7, 63, 178, 129,
+			160, 196, 205, 138, 118, 28, 225, 239, 118, 194, 134, 65, 8, 5,
+			228, 16, 103, 54, 48, 244, 83, 226, 140, 115, 197, 45, 27, 126,
+			194, 100, 189, 67, 232, 134, 251, 82, 247, 58, 76, 122, 65, 16,
+			221, 5, 242, 134, 151, 52, 146, 177, 130, 3, 222, 29, 213, 223,
+			90, 220, 239, 198, 134, 203, 182, 23, 123, 45, 149, 170, 88, 23,
+			173, 64, 134, 8, 235, 29, 98, 143, 25, 144, 2, 56, 126, 204,
+			128, 12, 192, 213, 179, 252, 6, 242, 68, 132, 245, 51, 66, 175,
+			185, 235, 131, 109, 98, 208, 73, 40, 253, 186, 106, 181, 163, 52,
+			235, 109, 246, 252, 128, 203, 60, 32, 145, 81, 91, 233, 183, 159,
+			134, 60, 248, 250, 207, 32, 224, 104, 144, 2, 56, 253, 184, 1,
+			25, 128, 167, 175, 240, 13, 36, 79, 133, 245, 115, 66, 207, 186,
+			167, 123, 59, 122, 143, 222, 87, 205, 112, 66, 244, 249, 57, 177,
+			199, 13, 136, 72, 39, 22, 13, 200, 0, 60, 249, 81, 190, 141,
+			20, 153, 176, 126, 65, 232, 243, 238, 230, 158, 62, 204, 30, 137,
+			115, 217, 96, 151, 101, 63, 127, 146, 233, 126, 119, 7, 60, 79,
+			106, 25, 73, 56, 54, 253, 130, 216, 211, 6, 164, 0, 206, 44,
+			27, 16, 89, 120, 234, 42, 255, 75, 130, 28, 89, 194, 250, 37,
+			161, 151, 221, 207, 147, 254, 67, 201, 67, 24, 138, 85, 45, 138,
+			235, 125, 26, 57, 110, 78, 116, 94, 208, 231, 25, 242, 18, 158,
+			215, 241, 113, 251, 246, 110, 207, 153, 220, 79, 186, 142, 213, 105,
+			195, 45, 79, 7, 127, 131, 6, 215, 234, 137, 62, 25, 25, 140,
+			185, 116, 112, 10, 250, 37, 177, 39, 13, 72, 1, 156, 90, 50,
+			32, 3, 240, 201, 139, 252, 173, 76, 186, 162, 176, 126, 69, 232,
+			154, 251, 77, 146, 21, 45, 192, 8, 122, 59, 180, 48, 162, 188,
+			111, 10, 239, 203, 183, 91, 126, 10, 93, 89, 63, 204, 170, 94,
+			228, 59, 251, 37, 93, 34, 61, 62, 80, 244, 212, 162, 48, 245,
+			252, 208, 92, 178, 36, 94, 171, 247, 116, 173, 79, 214, 203, 184,
+			85, 179, 31, 176, 193, 16, 116, 17, 235, 234, 30, 239, 41, 95,
+			244, 143, 224, 160, 85, 210, 87, 96, 228, 242, 194, 49, 236, 87,
+			144, 48, 53, 72, 1, 28, 158, 51, 32, 3, 240, 232, 42, 255,
+			66, 38, 175, 45, 172, 95, 19, 250, 172, 251, 251, 4, 99, 32,
+			254, 210, 0, 95, 150, 232, 199, 15, 94, 146, 164, 205, 56, 234,
+			52, 154, 224, 85, 80, 241, 132, 13, 63, 84, 199, 147, 238, 239,
+			13, 42, 56, 191, 194, 31, 240, 155, 128, 187, 77, 21, 202, 102,
+			154, 182, 147, 167, 87, 86, 106, 65, 212, 169, 155, 23, 40, 240,
+			11, 1, 204, 16, 43, 64, 80, 151, 82, 235, 202, 28, 148, 236,
+			2, 30, 247, 126, 77, 236, 89, 3, 82, 0, 231, 62, 96, 64,
+			6, 224, 202, 25, 254, 23, 153, 60, 37, 97, 253, 27, 161, 23,
+			221, 63, 237, 145, 39, 123, 252, 114, 173, 19, 164, 254, 187, 144,
+			170, 103, 213, 255, 148, 104, 37, 2, 220, 218, 198, 54, 37, 10,
+			224, 252, 99, 6, 100, 0, 158, 250, 223, 219, 118, 59, 142, 210,
+			232, 201, 255, 30, 0, 67, 170, 61, 54, 142, 60, 0, 0},
 	)
 }
 
@@ -440,7 +440,12 @@ type TerminateStreamRequest struct {
 	// The log stream's secret.
 	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	// The terminal index of the stream.
-	TerminalIndex        int64    `protobuf:"varint,4,opt,name=terminal_index,json=terminalIndex,proto3" json:"terminal_index,omitempty"`
+	TerminalIndex int64 `protobuf:"varint,4,opt,name=terminal_index,json=terminalIndex,proto3" json:"terminal_index,omitempty"`
+	// The number of bytes of stream data that the Butler dropped because of its
+	// limits.
+	DroppedBytes int64 `protobuf:"varint,5,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	// The number of log entries that the Butler dropped because of its limits.
+	DroppedEntries       int64    `protobuf:"varint,6,opt,name=dropped_entries,json=droppedEntries,proto3" json:"dropped_entries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -499,6 +504,20 @@ func (m *TerminateStreamRequest) GetTerminalIndex() int64 {
 	return 0
 }
 
+func (m *TerminateStreamRequest) GetDroppedBytes() int64 {
+	if m != nil {
+		return m.DroppedBytes
+	}
+	return 0
+}
+
+func (m *TerminateStreamRequest) GetDroppedEntries() int64 {
+	if m != nil {
+		return m.DroppedEntries
+	}
+	return 0
+}
+
 // ArchiveStreamRequest is the set of caller-supplied data for the ArchiveStream
 // service endpoint.
 type ArchiveStreamRequest struct {
@@ -1069,77 +1088,80 @@ func init() {
 }
 
 var fileDescriptor_42e88bcc2e6ba8be = []byte{
-	// 1118 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xce, 0x7a, 0xbd, 0xad, 0x7d, 0x6c, 0xa7, 0xc9, 0x34, 0x8d, 0x8c, 0xd3, 0x26, 0xae, 0x2b,
-	0x50, 0x10, 0x91, 0x2d, 0x02, 0x17, 0x08, 0x01, 0x82, 0xa6, 0x11, 0x6e, 0x1b, 0x54, 0x98, 0x04,
-	0x6e, 0x57, 0xd3, 0xdd, 0xe9, 0x7a, 0xe9, 0x7a, 0xc7, 0x9d, 0x19, 0x47, 0x49, 0xb9, 0xe2, 0x25,
-	0x78, 0x04, 0x24, 0x24, 0x24, 0x5e, 0x01, 0xf1, 0x0a, 0x3c, 0x06, 0x2f, 0x81, 0xe6, 0x6f, 0xed,
-	0x75, 0x9c, 0x34, 0xaa, 0x7a, 0x37, 0x73, 0x7e, 0xbe, 0xf9, 0xe6, 0x9c, 0xef, 0xcc, 0x2e, 0x3c,
-	0x4b, 0x58, 0x3f, 0x1a, 0x71, 0x36, 0x4e, 0xa7, 0xe3, 0x3e, 0xe3, 0xc9, 0x20, 0x9b, 0x46, 0xe9,
-	0x20, 0x63, 0x49, 0xcc, 0x92, 0x01, 0x99, 0xa4, 0x03, 0x9a, 0xc7, 0x13, 0x96, 0xe6, 0x52, 0x0c,
-	0x22, 0xc6, 0x78, 0x9c, 0xe6, 0x44, 0x32, 0x3e, 0x10, 0x94, 0x9f, 0xa6, 0x11, 0x15, 0x83, 0xd3,
-	0x8f, 0xdd, 0xba, 0x3f, 0xe1, 0x4c, 0x32, 0x74, 0xc3, 0xe4, 0x76, 0xbe, 0x7b, 0x07, 0xc0, 0x92,
-	0x48, 0x0b, 0xfb, 0x2e, 0xe0, 0x24, 0x11, 0x2f, 0x85, 0x85, 0xdb, 0x4e, 0x18, 0x4b, 0x32, 0x3a,
-	0xd0, 0xbb, 0xe7, 0xd3, 0x17, 0x83, 0x78, 0xca, 0x89, 0x4c, 0x59, 0x6e, 0xfd, 0x5b, 0x8b, 0x7e,
-	0x3a, 0x9e, 0xc8, 0x73, 0xe3, 0xec, 0x9d, 0x40, 0x70, 0xc8, 0x39, 0xe3, 0x68, 0x0b, 0xea, 0x09,
-	0x9f, 0x44, 0x61, 0xc4, 0x62, 0xda, 0xf6, 0xba, 0xde, 0x6e, 0x80, 0x6b, 0xca, 0x70, 0xc0, 0x62,
-	0x8a, 0xee, 0x42, 0x5d, 0x72, 0x92, 0x8b, 0x94, 0xe6, 0xb2, 0x5d, 0xe9, 0x7a, 0xbb, 0x35, 0x3c,
-	0x33, 0xa0, 0x35, 0xf0, 0xc7, 0x22, 0x69, 0xfb, 0x5d, 0x6f, 0xb7, 0x8e, 0xd5, 0xb2, 0xf7, 0xb7,
-	0x07, 0xeb, 0xdf, 0x52, 0x79, 0xc0, 0xf2, 0x17, 0x69, 0x82, 0xa9, 0x98, 0xb0, 0x5c, 0x50, 0xb4,
-	0x07, 0x28, 0xd2, 0x96, 0xd0, 0x5e, 0x25, 0x9c, 0xf2, 0x4c, 0x9f, 0x55, 0xc7, 0x6b, 0xc6, 0x73,
-	0x6c, 0x1c, 0x3f, 0xf2, 0x0c, 0xdd, 0x03, 0x28, 0xa2, 0xcd, 0xa1, 0x75, 0x5c, 0x77, 0x51, 0x12,
-	0xf5, 0xe1, 0xb6, 0x43, 0xb1, 0x61, 0x13, 0x22, 0x47, 0x96, 0xc4, 0xba, 0x75, 0x19, 0x02, 0xdf,
-	0x13, 0x39, 0x52, 0xf1, 0x0b, 0x87, 0x8f, 0x98, 0x90, 0xed, 0xaa, 0x89, 0x2f, 0x9d, 0x3e, 0x64,
-	0x42, 0xf6, 0xfe, 0xf0, 0xe0, 0x0e, 0xa6, 0x49, 0x2a, 0x24, 0xe5, 0xc7, 0x92, 0x53, 0x32, 0xc6,
-	0xf4, 0xd5, 0x94, 0x0a, 0x89, 0xda, 0x70, 0x73, 0xc2, 0xd9, 0xcf, 0x34, 0x92, 0x96, 0xbb, 0xdb,
-	0xa2, 0x4d, 0xb8, 0x21, 0x68, 0xc4, 0x2d, 0xdd, 0x26, 0xb6, 0x3b, 0xf4, 0x00, 0x5a, 0xba, 0xda,
-	0xe1, 0x29, 0xe5, 0x22, 0x65, 0xb9, 0x65, 0xd9, 0xd4, 0xc6, 0x9f, 0x8c, 0x0d, 0x21, 0xa8, 0xc6,
-	0x54, 0x44, 0x9a, 0x51, 0x13, 0xeb, 0x35, 0x7a, 0x1f, 0x56, 0x25, 0xe5, 0xe3, 0x34, 0x27, 0x59,
-	0x98, 0xe6, 0x31, 0x3d, 0x6b, 0x07, 0x5d, 0x6f, 0xd7, 0xc7, 0x2d, 0x67, 0x7d, 0xac, 0x8c, 0xbd,
-	0x5f, 0x60, 0x73, 0x91, 0xaa, 0x2d, 0xf9, 0x2a, 0x54, 0xd2, 0xd8, 0xd2, 0xac, 0xa4, 0x31, 0xda,
-	0x83, 0x40, 0x2b, 0x51, 0x13, 0x6c, 0xec, 0x6f, 0xf6, 0x8d, 0xea, 0xfa, 0x47, 0x2c, 0x31, 0x99,
-	0xc7, 0xca, 0x8b, 0x4d, 0x10, 0x7a, 0x00, 0x01, 0x55, 0xe2, 0xd0, 0x7c, 0x1b, 0xfb, 0x2d, 0x17,
-	0xad, 0x15, 0x83, 0x8d, 0xaf, 0xf7, 0x03, 0xac, 0x1f, 0x31, 0x12, 0x5f, 0xb7, 0x46, 0x86, 0x51,
-	0xa5, 0x60, 0xe4, 0xae, 0xed, 0x6b, 0x55, 0xe9, 0x75, 0xef, 0x77, 0x0f, 0xd0, 0x3c, 0x66, 0xa1,
-	0x1f, 0x4b, 0xde, 0xbb, 0x0e, 0x79, 0x07, 0x5c, 0x99, 0xab, 0xe7, 0x47, 0xe0, 0x93, 0x84, 0xda,
-	0xeb, 0xbc, 0xd7, 0x37, 0x83, 0xd1, 0x77, 0x83, 0xd1, 0x7f, 0x64, 0x07, 0x07, 0xab, 0x28, 0x74,
-	0x1f, 0x9a, 0x84, 0x47, 0xa3, 0xf4, 0x94, 0x64, 0xe1, 0x4b, 0x7a, 0x6e, 0x1b, 0xd3, 0x70, 0xb6,
-	0xa7, 0xf4, 0xbc, 0xf7, 0xab, 0x07, 0x9b, 0x27, 0xa6, 0x15, 0x92, 0xbe, 0x6d, 0x05, 0x66, 0xaa,
-	0xf1, 0x4b, 0xaa, 0xb9, 0xd8, 0xfc, 0xea, 0xb2, 0xe6, 0xff, 0x53, 0x81, 0x8d, 0x6f, 0x34, 0xa7,
-	0xb7, 0x66, 0xf0, 0x01, 0xdc, 0xca, 0x58, 0x12, 0xd2, 0x5c, 0xf2, 0xf3, 0x30, 0x62, 0xd3, 0xdc,
-	0x50, 0xf1, 0x71, 0x2b, 0x63, 0xc9, 0xa1, 0xb2, 0x1e, 0x28, 0xe3, 0x35, 0x19, 0xa1, 0x0d, 0x27,
-	0x9b, 0x40, 0x9f, 0x60, 0x36, 0x6a, 0x9e, 0x85, 0xe6, 0xa7, 0xa7, 0x1e, 0xcc, 0x3c, 0x1b, 0x8b,
-	0x1a, 0xf7, 0x1d, 0x68, 0x58, 0xb7, 0x48, 0x5f, 0xd3, 0x76, 0x43, 0x03, 0xdb, 0x8c, 0xe3, 0xf4,
-	0x35, 0x55, 0x0f, 0x94, 0x3e, 0x53, 0xa7, 0x6f, 0xe8, 0xf4, 0x9a, 0x36, 0xd8, 0xc7, 0xc2, 0x38,
-	0x75, 0xf2, 0x1d, 0x9d, 0x6c, 0xc2, 0x55, 0xee, 0x93, 0x6a, 0x6d, 0x7b, 0x6d, 0xe7, 0x49, 0xb5,
-	0xb6, 0xb3, 0xd6, 0xc5, 0xb5, 0x98, 0x48, 0xa2, 0x40, 0x70, 0x5d, 0xaf, 0x54, 0x46, 0xef, 0xbf,
-	0x0a, 0x34, 0x1f, 0x12, 0x19, 0x8d, 0x5c, 0xf1, 0xf6, 0xc0, 0xe7, 0xf4, 0x55, 0xdb, 0xeb, 0xfa,
-	0xbb, 0x8d, 0xfd, 0x8e, 0x53, 0xda, 0x7c, 0x48, 0x5f, 0x17, 0x06, 0xab, 0xb0, 0xce, 0x9f, 0x15,
-	0x08, 0xf4, 0x16, 0x0d, 0xe1, 0x16, 0xb7, 0xa3, 0x18, 0x1a, 0xf2, 0x56, 0xad, 0xf7, 0x1c, 0xc6,
-	0xd2, 0x47, 0x65, 0xb8, 0x82, 0x57, 0x79, 0xc9, 0x81, 0xbe, 0x80, 0x46, 0xc6, 0x48, 0xec, 0x50,
-	0x2a, 0x56, 0xb3, 0x85, 0xe6, 0x17, 0x46, 0x6e, 0xb8, 0x82, 0x21, 0x2b, 0x8c, 0xe8, 0x29, 0xac,
-	0x49, 0x27, 0x4c, 0x07, 0x61, 0x64, 0xbf, 0xed, 0x20, 0x96, 0x0b, 0x77, 0xb8, 0x82, 0x6f, 0xc9,
-	0xb2, 0x07, 0x1d, 0xc2, 0xaa, 0x51, 0x7d, 0x01, 0x55, 0xd5, 0x50, 0x77, 0x1d, 0xd4, 0x32, 0xfd,
-	0x0d, 0x57, 0x70, 0x8b, 0xcc, 0xdb, 0x1f, 0xde, 0x84, 0xe0, 0x94, 0x64, 0x53, 0xda, 0xfb, 0xad,
-	0x02, 0x2d, 0x5b, 0x4a, 0x3b, 0xda, 0x03, 0xa8, 0x72, 0x2a, 0x26, 0xb6, 0xde, 0x5b, 0x0b, 0xf5,
-	0x36, 0x41, 0xb6, 0xe0, 0x3a, 0xb0, 0xf3, 0xaf, 0xe7, 0x2a, 0xbe, 0x01, 0x81, 0xd1, 0xa2, 0xf9,
-	0x68, 0x99, 0x0d, 0xba, 0x0f, 0x3e, 0xe5, 0xdc, 0x56, 0xad, 0xfc, 0x70, 0x0d, 0x57, 0xb0, 0xf2,
-	0xa1, 0xc7, 0x17, 0x5b, 0xb5, 0x50, 0xa1, 0xe5, 0x8f, 0xea, 0x92, 0x5e, 0x7d, 0x59, 0xee, 0x95,
-	0xa9, 0x4e, 0x67, 0x59, 0xaf, 0x0a, 0x88, 0xb9, 0x66, 0xcd, 0x0a, 0x43, 0xa1, 0x79, 0x44, 0x89,
-	0xa0, 0x4e, 0x85, 0x5b, 0x50, 0x1f, 0x93, 0xb3, 0x50, 0x7f, 0xed, 0xf5, 0xfd, 0x7c, 0x5c, 0x1b,
-	0x93, 0xb3, 0x13, 0xb5, 0x47, 0x9f, 0x01, 0x64, 0x2a, 0x38, 0x94, 0xe9, 0x98, 0x16, 0xfa, 0xb8,
-	0xf4, 0x4d, 0xab, 0xeb, 0xe0, 0x93, 0x74, 0x4c, 0x7b, 0x9f, 0x43, 0xcb, 0x1e, 0x63, 0xcb, 0xff,
-	0x21, 0x04, 0xee, 0x0c, 0x55, 0xff, 0xdb, 0x0b, 0x7d, 0x55, 0xe7, 0x61, 0x13, 0xa1, 0x72, 0x1f,
-	0xd1, 0x8c, 0xca, 0x82, 0xe3, 0xf5, 0x73, 0xf7, 0xff, 0xaa, 0x42, 0xcd, 0x7e, 0x63, 0x05, 0xfa,
-	0x0a, 0xea, 0xc5, 0x2f, 0x02, 0xda, 0xbc, 0xc0, 0xfb, 0x50, 0xfd, 0xa4, 0x74, 0x0a, 0xbd, 0x5f,
-	0xfc, 0x9b, 0x78, 0x06, 0xab, 0xe5, 0xfe, 0xa0, 0xab, 0x47, 0xac, 0xf3, 0x86, 0xb6, 0xa2, 0x03,
-	0x80, 0x59, 0xa7, 0xd0, 0xe5, 0x93, 0xd6, 0xb9, 0xa2, 0xb1, 0x4a, 0x54, 0x0b, 0x73, 0x85, 0xde,
-	0x30, 0x70, 0x9d, 0x4b, 0xee, 0x8e, 0x0e, 0xa1, 0x55, 0x9a, 0x2b, 0x74, 0xe5, 0xb8, 0x5d, 0x0a,
-	0xf3, 0x29, 0x04, 0x7a, 0x8c, 0xd0, 0xc6, 0xb2, 0x57, 0xac, 0x73, 0x67, 0xe9, 0xac, 0xa1, 0xaf,
-	0x61, 0x5d, 0x4b, 0x64, 0xae, 0x8b, 0x62, 0x86, 0x30, 0x2f, 0xd2, 0x19, 0x42, 0x59, 0x53, 0x07,
-	0x80, 0x8c, 0x50, 0x4a, 0x10, 0x45, 0x70, 0x49, 0x44, 0x97, 0x91, 0x7f, 0x7e, 0x43, 0xef, 0x3f,
-	0xf9, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xf7, 0x47, 0x25, 0xdb, 0x0b, 0x00, 0x00,
+	// 1154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xcf, 0xae, 0xed, 0xd4, 0x3e, 0xfe, 0x48, 0x32, 0x4d, 0x22, 0xff, 0x9d, 0x36, 0x71, 0x5d,
+	0xfd, 0x21, 0x88, 0xc8, 0x16, 0x81, 0x0b, 0x84, 0x00, 0x41, 0xd2, 0x08, 0xb7, 0x0d, 0x2a, 0x4c,
+	0x02, 0xb7, 0xd6, 0x64, 0x77, 0xba, 0x5e, 0xba, 0xde, 0xd9, 0xce, 0x8c, 0xa3, 0xb8, 0xbc, 0x07,
+	0x8f, 0x80, 0x84, 0x84, 0xc4, 0x2b, 0x20, 0x5e, 0x80, 0x0b, 0x1e, 0x83, 0x97, 0x40, 0xf3, 0xb5,
+	0xb1, 0x1d, 0xe7, 0x43, 0x55, 0xef, 0x66, 0xce, 0xc7, 0xef, 0x9c, 0x39, 0xe7, 0x77, 0xce, 0x2e,
+	0xbc, 0x88, 0x58, 0x37, 0x18, 0x72, 0x36, 0x8a, 0xc7, 0xa3, 0x2e, 0xe3, 0x51, 0x2f, 0x19, 0x07,
+	0x71, 0x2f, 0x61, 0x51, 0xc8, 0xa2, 0x1e, 0xc9, 0xe2, 0x1e, 0x4d, 0xc3, 0x8c, 0xc5, 0xa9, 0x14,
+	0xbd, 0x80, 0x31, 0x1e, 0xc6, 0x29, 0x91, 0x8c, 0xf7, 0x04, 0xe5, 0xe7, 0x71, 0x40, 0x45, 0xef,
+	0xfc, 0x23, 0x77, 0xee, 0x66, 0x9c, 0x49, 0x86, 0x96, 0x8d, 0x6f, 0xeb, 0xdb, 0x77, 0x00, 0x2c,
+	0x89, 0xb4, 0xb0, 0xef, 0x02, 0x4e, 0x12, 0xf1, 0x4a, 0x58, 0xb8, 0xed, 0x88, 0xb1, 0x28, 0xa1,
+	0x3d, 0x7d, 0x3b, 0x1b, 0xbf, 0xec, 0x85, 0x63, 0x4e, 0x64, 0xcc, 0x52, 0xab, 0xdf, 0x9a, 0xd7,
+	0xd3, 0x51, 0x26, 0x27, 0x46, 0xd9, 0x39, 0x85, 0xd2, 0x11, 0xe7, 0x8c, 0xa3, 0x2d, 0xa8, 0x44,
+	0x3c, 0x0b, 0x06, 0x01, 0x0b, 0x69, 0xd3, 0x6b, 0x7b, 0xbb, 0x25, 0x5c, 0x56, 0x82, 0x43, 0x16,
+	0x52, 0xf4, 0x00, 0x2a, 0x92, 0x93, 0x54, 0xc4, 0x34, 0x95, 0x4d, 0xbf, 0xed, 0xed, 0x96, 0xf1,
+	0xa5, 0x00, 0xad, 0x42, 0x61, 0x24, 0xa2, 0x66, 0xa1, 0xed, 0xed, 0x56, 0xb0, 0x3a, 0x76, 0xfe,
+	0xf4, 0x60, 0xed, 0x1b, 0x2a, 0x0f, 0x59, 0xfa, 0x32, 0x8e, 0x30, 0x15, 0x19, 0x4b, 0x05, 0x45,
+	0x7b, 0x80, 0x02, 0x2d, 0x19, 0xd8, 0xa7, 0x0c, 0xc6, 0x3c, 0xd1, 0xb1, 0x2a, 0x78, 0xd5, 0x68,
+	0x4e, 0x8c, 0xe2, 0x07, 0x9e, 0xa0, 0x87, 0x00, 0xb9, 0xb5, 0x09, 0x5a, 0xc1, 0x15, 0x67, 0x25,
+	0x51, 0x17, 0xee, 0x3b, 0x14, 0x6b, 0x96, 0x11, 0x39, 0xb4, 0x49, 0xac, 0x59, 0x95, 0x49, 0xe0,
+	0x3b, 0x22, 0x87, 0xca, 0x7e, 0x2e, 0xf8, 0x90, 0x09, 0xd9, 0x2c, 0x1a, 0xfb, 0x99, 0xe8, 0x7d,
+	0x26, 0x64, 0xe7, 0x37, 0x0f, 0x36, 0x30, 0x8d, 0x62, 0x21, 0x29, 0x3f, 0x91, 0x9c, 0x92, 0x11,
+	0xa6, 0xaf, 0xc7, 0x54, 0x48, 0xd4, 0x84, 0x7b, 0x19, 0x67, 0x3f, 0xd1, 0x40, 0xda, 0xdc, 0xdd,
+	0x15, 0x6d, 0xc2, 0xb2, 0xa0, 0x01, 0xb7, 0xe9, 0xd6, 0xb0, 0xbd, 0xa1, 0xc7, 0x50, 0xd7, 0xd5,
+	0x1e, 0x9c, 0x53, 0x2e, 0x62, 0x96, 0xda, 0x2c, 0x6b, 0x5a, 0xf8, 0xa3, 0x91, 0x21, 0x04, 0xc5,
+	0x90, 0x8a, 0x40, 0x67, 0x54, 0xc3, 0xfa, 0x8c, 0xfe, 0x0f, 0x0d, 0x49, 0xf9, 0x28, 0x4e, 0x49,
+	0x32, 0x88, 0xd3, 0x90, 0x5e, 0x34, 0x4b, 0x6d, 0x6f, 0xb7, 0x80, 0xeb, 0x4e, 0xfa, 0x54, 0x09,
+	0x3b, 0x3f, 0xc3, 0xe6, 0x7c, 0xaa, 0xb6, 0xe4, 0x0d, 0xf0, 0xe3, 0xd0, 0xa6, 0xe9, 0xc7, 0x21,
+	0xda, 0x83, 0x92, 0x66, 0xa2, 0x4e, 0xb0, 0xba, 0xbf, 0xd9, 0x35, 0xac, 0xeb, 0x1e, 0xb3, 0xc8,
+	0x78, 0x9e, 0x28, 0x2d, 0x36, 0x46, 0xe8, 0x31, 0x94, 0xa8, 0x22, 0x87, 0xce, 0xb7, 0xba, 0x5f,
+	0x77, 0xd6, 0x9a, 0x31, 0xd8, 0xe8, 0x3a, 0xdf, 0xc3, 0xda, 0x31, 0x23, 0xe1, 0x5d, 0x6b, 0x64,
+	0x32, 0xf2, 0xf3, 0x8c, 0xdc, 0xb3, 0x0b, 0x9a, 0x55, 0xfa, 0xdc, 0xf9, 0xd5, 0x03, 0x34, 0x8d,
+	0x99, 0xf3, 0xc7, 0x26, 0xef, 0xdd, 0x25, 0x79, 0x07, 0xec, 0x4f, 0xd5, 0xf3, 0x43, 0x28, 0x90,
+	0x88, 0xda, 0xe7, 0xfc, 0xaf, 0x6b, 0x06, 0xa3, 0xeb, 0x06, 0xa3, 0xfb, 0xc4, 0x0e, 0x0e, 0x56,
+	0x56, 0xe8, 0x11, 0xd4, 0x08, 0x0f, 0x86, 0xf1, 0x39, 0x49, 0x06, 0xaf, 0xe8, 0xc4, 0x36, 0xa6,
+	0xea, 0x64, 0xcf, 0xe9, 0xa4, 0xf3, 0xb7, 0x07, 0x9b, 0xa7, 0xa6, 0x15, 0x92, 0xbe, 0x6d, 0x05,
+	0x2e, 0x59, 0x53, 0x98, 0x61, 0xcd, 0xd5, 0xe6, 0x17, 0x17, 0x34, 0x5f, 0x91, 0x2b, 0xe4, 0x2c,
+	0xcb, 0x68, 0x38, 0x38, 0x9b, 0x48, 0x2a, 0x2c, 0x45, 0x6a, 0x56, 0x78, 0xa0, 0x64, 0xe8, 0x7d,
+	0x58, 0x71, 0x46, 0x34, 0x95, 0x3c, 0xa6, 0xa2, 0xb9, 0xac, 0xcd, 0x1a, 0x56, 0x7c, 0x64, 0xa4,
+	0x9d, 0xbf, 0x7c, 0x58, 0xff, 0x5a, 0xbf, 0xf0, 0xad, 0xdf, 0xf3, 0x1e, 0xac, 0x24, 0x2c, 0xd2,
+	0x71, 0x26, 0x83, 0x80, 0x8d, 0x53, 0xf3, 0xb0, 0x02, 0xae, 0x27, 0x2c, 0x52, 0x71, 0x26, 0x87,
+	0x4a, 0x78, 0xd7, 0xf7, 0xad, 0x3b, 0x12, 0x96, 0x74, 0x04, 0x73, 0x51, 0xdb, 0x41, 0xe8, 0xfc,
+	0xf4, 0x0e, 0x01, 0xb3, 0x1d, 0x8c, 0x44, 0x2d, 0x8f, 0x1d, 0xa8, 0x5a, 0xb5, 0x88, 0xdf, 0xd0,
+	0x66, 0x55, 0x03, 0x5b, 0x8f, 0x93, 0xf8, 0x0d, 0x55, 0xeb, 0x4e, 0xc7, 0xd4, 0xee, 0xeb, 0xda,
+	0xbd, 0xac, 0x05, 0x76, 0xf5, 0x18, 0xa5, 0x76, 0xde, 0xd0, 0xce, 0xc6, 0x5c, 0xf9, 0x3e, 0x2b,
+	0x96, 0xb7, 0x57, 0x77, 0x9e, 0x15, 0xcb, 0x3b, 0xab, 0x6d, 0x5c, 0x0e, 0x89, 0x24, 0x0a, 0x04,
+	0x57, 0xf4, 0x49, 0x79, 0x74, 0xfe, 0xf5, 0xa1, 0x76, 0x40, 0x64, 0x30, 0x74, 0xc5, 0xdb, 0x83,
+	0x02, 0xa7, 0xaf, 0x9b, 0x5e, 0xbb, 0xb0, 0x5b, 0xdd, 0x6f, 0x39, 0xde, 0x4e, 0x9b, 0x74, 0x75,
+	0x61, 0xb0, 0x32, 0x6b, 0xfd, 0xee, 0x43, 0x49, 0x5f, 0x51, 0x1f, 0x56, 0xb8, 0x1d, 0xec, 0x81,
+	0x49, 0xde, 0x72, 0xff, 0xa1, 0xc3, 0x58, 0xb8, 0xa2, 0xfa, 0x4b, 0xb8, 0xc1, 0x67, 0x14, 0xe8,
+	0x73, 0xa8, 0x26, 0x8c, 0x84, 0x0e, 0xc5, 0xb7, 0x13, 0x90, 0x4f, 0xd0, 0xdc, 0x00, 0xf7, 0x97,
+	0x30, 0x24, 0xb9, 0x10, 0x3d, 0x87, 0x55, 0xe9, 0x68, 0xee, 0x20, 0xcc, 0x10, 0x6d, 0x3b, 0x88,
+	0xc5, 0x63, 0xd0, 0x5f, 0xc2, 0x2b, 0x72, 0x56, 0x83, 0x8e, 0xa0, 0x61, 0x66, 0x28, 0x87, 0x2a,
+	0x6a, 0xa8, 0x07, 0x0e, 0x6a, 0x11, 0xff, 0xfa, 0x4b, 0xb8, 0x4e, 0xa6, 0xe5, 0x07, 0xf7, 0xa0,
+	0x74, 0x4e, 0x92, 0x31, 0xed, 0xfc, 0xe2, 0x43, 0xdd, 0x96, 0xd2, 0x2e, 0x8a, 0x1e, 0x14, 0x39,
+	0x15, 0x99, 0xad, 0xf7, 0xd6, 0x5c, 0xbd, 0x8d, 0x91, 0x2d, 0xb8, 0x36, 0x6c, 0xfd, 0xe3, 0xb9,
+	0x8a, 0xaf, 0x43, 0xc9, 0x70, 0xd1, 0x7c, 0x02, 0xcd, 0x05, 0x3d, 0x82, 0x02, 0xe5, 0xbc, 0xe9,
+	0x2f, 0x58, 0x83, 0xfd, 0x25, 0xac, 0x74, 0xe8, 0xe9, 0xd5, 0x56, 0xcd, 0x55, 0x68, 0xf1, 0x8a,
+	0x5e, 0xd0, 0xab, 0x2f, 0x66, 0x7b, 0x65, 0xaa, 0xd3, 0x5a, 0xd4, 0xab, 0x1c, 0x62, 0xaa, 0x59,
+	0x97, 0x85, 0xa1, 0x50, 0x3b, 0xa6, 0x44, 0x50, 0xc7, 0xc2, 0x2d, 0xa8, 0x8c, 0xc8, 0xc5, 0x40,
+	0xff, 0x3b, 0xe8, 0xf7, 0x15, 0x70, 0x79, 0x44, 0x2e, 0x4e, 0xd5, 0x1d, 0x7d, 0x0a, 0x90, 0x28,
+	0xe3, 0x81, 0x8c, 0x47, 0xb4, 0xe9, 0xdf, 0xb6, 0x21, 0x2b, 0xda, 0xf8, 0x34, 0x1e, 0xd1, 0xce,
+	0x67, 0x50, 0xb7, 0x61, 0x6c, 0xf9, 0x3f, 0x80, 0x92, 0x8b, 0xa1, 0xea, 0x7f, 0x7f, 0xae, 0xaf,
+	0x2a, 0x1e, 0x36, 0x16, 0xca, 0xf7, 0x09, 0x4d, 0xa8, 0xcc, 0x73, 0xbc, 0xbb, 0xef, 0xfe, 0x1f,
+	0x45, 0x28, 0xdb, 0x2f, 0xb6, 0x40, 0x5f, 0x42, 0x25, 0xff, 0xe1, 0x40, 0x9b, 0x57, 0xf2, 0x3e,
+	0x52, 0xbf, 0x3c, 0xad, 0x9c, 0xef, 0x57, 0xff, 0x4d, 0x5e, 0x40, 0x63, 0xb6, 0x3f, 0xe8, 0xe6,
+	0x11, 0x6b, 0xdd, 0xd2, 0x56, 0x74, 0x08, 0x70, 0xd9, 0x29, 0x74, 0xfd, 0xa4, 0xb5, 0x6e, 0x68,
+	0xac, 0x22, 0xd5, 0xdc, 0x5c, 0xa1, 0x5b, 0x06, 0xae, 0x75, 0xcd, 0xdb, 0xd1, 0x11, 0xd4, 0x67,
+	0xe6, 0x0a, 0xdd, 0x38, 0x6e, 0xd7, 0xc2, 0x7c, 0x02, 0x25, 0x3d, 0x46, 0x68, 0x7d, 0xd1, 0x16,
+	0x6b, 0x6d, 0x2c, 0x9c, 0x35, 0xf4, 0x15, 0xac, 0x69, 0x8a, 0x4c, 0x75, 0x51, 0x5c, 0x22, 0x4c,
+	0x93, 0xb4, 0xb5, 0x31, 0x27, 0xcd, 0xcb, 0x89, 0x0c, 0x51, 0x66, 0x20, 0x72, 0xe3, 0x19, 0x12,
+	0x5d, 0x97, 0xfc, 0xd9, 0xb2, 0xbe, 0x7f, 0xfc, 0xdf, 0x00, 0x50, 0x71, 0xf5, 0x6d, 0x29, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,10 +284,18 @@ type ButlerLogBundle_Entry struct {
 	// Log entries attached to this record. These MUST be sequential.
 	//
 	// This is the main log entry content.
-	Logs                 []*LogEntry `protobuf:"bytes,5,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Logs []*LogEntry `protobuf:"bytes,5,rep,name=logs,proto3" json:"logs,omitempty"`
+	//
+	// If terminal is true, the number of bytes of stream data that the Butler
+	// dropped, rather than sending, because of its limits.
+	DroppedBytes int64 `protobuf:"varint,6,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	//
+	// If terminal is true, the number of log entries (lines or datagrams) that
+	// the Butler dropped because of its limits.
+	DroppedEntries       int64    `protobuf:"varint,7,opt,name=dropped_entries,json=droppedEntries,proto3" json:"dropped_entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ButlerLogBundle_Entry) Reset()         { *m = ButlerLogBundle_Entry{} }
@@ -350,6 +358,20 @@ func (m *ButlerLogBundle_Entry) GetLogs() []*LogEntry {
 	return nil
 }
 
+func (m *ButlerLogBundle_Entry) GetDroppedBytes() int64 {
+	if m != nil {
+		return m.DroppedBytes
+	}
+	return 0
+}
+
+func (m *ButlerLogBundle_Entry) GetDroppedEntries() int64 {
+	if m != nil {
+		return m.DroppedEntries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("logpb.ButlerMetadata_ContentType", ButlerMetadata_ContentType_name, ButlerMetadata_ContentType_value)
 	proto.RegisterEnum("logpb.ButlerMetadata_Compression", ButlerMetadata_Compression_name, ButlerMetadata_Compression_value)
@@ -363,36 +385,39 @@ func init() {
 }
 
 var fileDescriptor_25f3936477fa1e93 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x4d, 0x6b, 0xdb, 0x40,
-	0x10, 0x8d, 0x6c, 0xf9, 0x6b, 0x9c, 0x38, 0xee, 0x96, 0xb6, 0xc2, 0x14, 0xea, 0x38, 0x14, 0x0c,
-	0x85, 0x15, 0xb8, 0x34, 0xf4, 0xec, 0x34, 0x07, 0x83, 0x9b, 0x82, 0x1c, 0x7a, 0xe8, 0xc5, 0xc8,
-	0xd2, 0x64, 0xbb, 0x45, 0xd2, 0x2e, 0xab, 0x55, 0x88, 0x7f, 0x40, 0xff, 0x61, 0xa1, 0x7f, 0xa7,
-	0x68, 0x64, 0xd9, 0x0e, 0x85, 0xd2, 0xdb, 0xce, 0x9b, 0x37, 0xf3, 0xde, 0xbc, 0x85, 0x99, 0x50,
-	0x3c, 0xfa, 0x6e, 0x54, 0x2a, 0x8b, 0x94, 0x2b, 0x23, 0xfc, 0xa4, 0x88, 0xa4, 0x9f, 0x28, 0x11,
-	0x2b, 0xe1, 0x87, 0x9a, 0x9e, 0x7a, 0xe3, 0x6f, 0x0a, 0x9b, 0xa0, 0xe1, 0xda, 0x28, 0xab, 0x58,
-	0x8b, 0xb0, 0x91, 0xff, 0x7f, 0xa3, 0x89, 0x12, 0xd5, 0xdc, 0xe8, 0x8d, 0x50, 0x4a, 0x24, 0xe8,
-	0x53, 0xb5, 0x29, 0xee, 0x7d, 0x2b, 0x53, 0xcc, 0x6d, 0x98, 0xea, 0x8a, 0x30, 0xf9, 0xd9, 0x80,
-	0xc1, 0x9c, 0x94, 0x3e, 0xa3, 0x0d, 0xe3, 0xd0, 0x86, 0xec, 0x03, 0xb8, 0x76, 0xab, 0xd1, 0x73,
-	0xc6, 0xce, 0x74, 0x30, 0xbb, 0xe0, 0xb4, 0x93, 0x3f, 0x25, 0xf1, 0x6b, 0x95, 0x59, 0xcc, 0xec,
-	0xdd, 0x56, 0x63, 0x40, 0x74, 0x76, 0x0d, 0xfd, 0x48, 0xa5, 0xda, 0x60, 0x9e, 0x4b, 0x95, 0x79,
-	0x8d, 0x7f, 0x4f, 0xef, 0x89, 0xc1, 0xf1, 0x14, 0xbb, 0x84, 0x33, 0xf2, 0xb5, 0x7e, 0x40, 0x43,
-	0x6b, 0x9a, 0x63, 0x67, 0xda, 0x0b, 0x4e, 0x09, 0xfc, 0x5a, 0x61, 0x13, 0x1f, 0xfa, 0x47, 0xf2,
-	0xac, 0x0f, 0x9d, 0x45, 0xf6, 0x10, 0x26, 0x32, 0x1e, 0x9e, 0xb0, 0xe7, 0x70, 0x5e, 0x69, 0x2d,
-	0x95, 0x98, 0x17, 0x59, 0x9c, 0xe0, 0xd0, 0x99, 0x5c, 0x94, 0x03, 0x07, 0x91, 0x2e, 0xb8, 0xb7,
-	0x5f, 0x6e, 0x6f, 0x86, 0x27, 0xe5, 0xeb, 0xdb, 0x72, 0x31, 0x1f, 0x3a, 0x93, 0x5f, 0xcd, 0xbf,
-	0x06, 0xd9, 0x3b, 0x78, 0x16, 0xa3, 0x36, 0x18, 0x85, 0x16, 0xe3, 0x75, 0xae, 0x0a, 0x13, 0x55,
-	0xa9, 0xf4, 0x82, 0xe1, 0xa1, 0xb1, 0x22, 0x9c, 0x7d, 0x84, 0xde, 0x3e, 0x5b, 0x3a, 0xbe, 0x3f,
-	0x1b, 0xf1, 0x2a, 0x7d, 0x5e, 0xa7, 0xcf, 0xef, 0x6a, 0x46, 0x70, 0x20, 0xb3, 0x2b, 0xe8, 0x60,
-	0x66, 0x8d, 0xc4, 0xdc, 0x6b, 0x8e, 0x9b, 0xd3, 0xfe, 0xec, 0xf5, 0x93, 0xd0, 0xf6, 0x7e, 0xf8,
-	0x4d, 0x66, 0xcd, 0x36, 0xa8, 0xc9, 0xcc, 0x83, 0x8e, 0x36, 0xea, 0x07, 0x46, 0xd6, 0x73, 0xc9,
-	0x54, 0x5d, 0xb2, 0x97, 0xd0, 0xd6, 0x06, 0xef, 0xe5, 0xa3, 0xd7, 0xa2, 0xc6, 0xae, 0x2a, 0xf1,
-	0x1c, 0x23, 0x83, 0xd6, 0x6b, 0x8f, 0x9d, 0xe9, 0x69, 0xb0, 0xab, 0x46, 0xbf, 0x1d, 0x68, 0xd1,
-	0x72, 0xc6, 0xc1, 0x8d, 0x31, 0x8f, 0xe8, 0xca, 0xf2, 0x80, 0xca, 0xc8, 0x52, 0x89, 0x95, 0x35,
-	0x18, 0xa6, 0x9f, 0x30, 0x8f, 0x8c, 0xd4, 0x56, 0x99, 0x80, 0x78, 0xec, 0x0a, 0x5e, 0x1d, 0x45,
-	0x54, 0x3a, 0xdb, 0xae, 0x77, 0x12, 0x0d, 0x92, 0x78, 0x71, 0x68, 0x93, 0xc2, 0x8a, 0x9a, 0x6c,
-	0x04, 0x5d, 0x8b, 0x26, 0x95, 0x59, 0x98, 0xd0, 0x17, 0x77, 0x83, 0x7d, 0xcd, 0xde, 0xc2, 0xa0,
-	0x7e, 0xaf, 0x65, 0x16, 0xe3, 0x23, 0x9d, 0xe7, 0x06, 0x67, 0x35, 0xba, 0x28, 0x41, 0x76, 0x09,
-	0x6e, 0xa2, 0x44, 0xee, 0xb5, 0x28, 0xb3, 0xf3, 0x83, 0xd5, 0x2a, 0x26, 0x6a, 0x6e, 0xda, 0x14,
-	0xfd, 0xfb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x71, 0x53, 0x1b, 0x37, 0x74, 0x03, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x51, 0x8b, 0xda, 0x4c,
+	0x14, 0xdd, 0x68, 0x5c, 0xf5, 0xea, 0xaa, 0xdf, 0x7c, 0xb4, 0x0d, 0x52, 0xa8, 0xeb, 0x52, 0x2a,
+	0x14, 0x26, 0x60, 0xe9, 0xd2, 0x67, 0xb7, 0x3e, 0x08, 0x76, 0x0b, 0xe3, 0xd2, 0x87, 0xbe, 0x48,
+	0x4c, 0xee, 0xa6, 0x29, 0x49, 0x66, 0x98, 0x4c, 0x96, 0xf5, 0x07, 0xf4, 0x8f, 0x14, 0xfa, 0x3f,
+	0x4b, 0x6e, 0x12, 0x75, 0x29, 0x94, 0xbe, 0xcd, 0x3d, 0xf7, 0xdc, 0x39, 0xe7, 0x9e, 0x19, 0x98,
+	0x87, 0x92, 0xfb, 0xdf, 0xb4, 0x4c, 0xa2, 0x3c, 0xe1, 0x52, 0x87, 0x6e, 0x9c, 0xfb, 0x91, 0x1b,
+	0xcb, 0x30, 0x90, 0xa1, 0xeb, 0x29, 0x3a, 0xaa, 0x9d, 0xbb, 0xcb, 0x4d, 0x8c, 0x9a, 0x2b, 0x2d,
+	0x8d, 0x64, 0x2d, 0xc2, 0xc6, 0xee, 0xbf, 0x8d, 0xc6, 0x32, 0x2c, 0xe7, 0xc6, 0xaf, 0x42, 0x29,
+	0xc3, 0x18, 0x5d, 0xaa, 0x76, 0xf9, 0xbd, 0x6b, 0xa2, 0x04, 0x33, 0xe3, 0x25, 0xaa, 0x24, 0x4c,
+	0x7f, 0x34, 0x60, 0xb0, 0x20, 0xa5, 0x4f, 0x68, 0xbc, 0xc0, 0x33, 0x1e, 0x7b, 0x0f, 0xb6, 0xd9,
+	0x2b, 0x74, 0xac, 0x89, 0x35, 0x1b, 0xcc, 0x2f, 0x39, 0xdd, 0xc9, 0x9f, 0x92, 0xf8, 0x8d, 0x4c,
+	0x0d, 0xa6, 0xe6, 0x6e, 0xaf, 0x50, 0x10, 0x9d, 0xdd, 0x40, 0xcf, 0x97, 0x89, 0xd2, 0x98, 0x65,
+	0x91, 0x4c, 0x9d, 0xc6, 0xdf, 0xa7, 0x0f, 0x44, 0x71, 0x3a, 0xc5, 0xae, 0xe0, 0x82, 0x7c, 0x6d,
+	0x1f, 0x50, 0xd3, 0x35, 0xcd, 0x89, 0x35, 0xeb, 0x8a, 0x3e, 0x81, 0x5f, 0x4a, 0x6c, 0xea, 0x42,
+	0xef, 0x44, 0x9e, 0xf5, 0xa0, 0xbd, 0x4a, 0x1f, 0xbc, 0x38, 0x0a, 0x46, 0x67, 0xec, 0x7f, 0x18,
+	0x96, 0x5a, 0x6b, 0x19, 0x2e, 0xf2, 0x34, 0x88, 0x71, 0x64, 0x4d, 0x2f, 0x8b, 0x81, 0xa3, 0x48,
+	0x07, 0xec, 0xdb, 0xcf, 0xb7, 0xcb, 0xd1, 0x59, 0x71, 0xfa, 0xba, 0x5e, 0x2d, 0x46, 0xd6, 0xf4,
+	0xa7, 0xfd, 0xc7, 0x20, 0x7b, 0x0b, 0xff, 0x05, 0xa8, 0x34, 0xfa, 0x9e, 0xc1, 0x60, 0x9b, 0xc9,
+	0x5c, 0xfb, 0x65, 0x2a, 0x5d, 0x31, 0x3a, 0x36, 0x36, 0x84, 0xb3, 0x0f, 0xd0, 0x3d, 0x64, 0x4b,
+	0xcb, 0xf7, 0xe6, 0x63, 0x5e, 0xa6, 0xcf, 0xeb, 0xf4, 0xf9, 0x5d, 0xcd, 0x10, 0x47, 0x32, 0xbb,
+	0x86, 0x36, 0xa6, 0x46, 0x47, 0x98, 0x39, 0xcd, 0x49, 0x73, 0xd6, 0x9b, 0xbf, 0x7c, 0x12, 0xda,
+	0xc1, 0x0f, 0x5f, 0xa6, 0x46, 0xef, 0x45, 0x4d, 0x66, 0x0e, 0xb4, 0x95, 0x96, 0xdf, 0xd1, 0x37,
+	0x8e, 0x4d, 0xa6, 0xea, 0x92, 0x3d, 0x87, 0x73, 0xa5, 0xf1, 0x3e, 0x7a, 0x74, 0x5a, 0xd4, 0xa8,
+	0xaa, 0x02, 0xcf, 0xd0, 0xd7, 0x68, 0x9c, 0xf3, 0x89, 0x35, 0xeb, 0x8b, 0xaa, 0x1a, 0xff, 0x6a,
+	0x40, 0x8b, 0x2e, 0x67, 0x1c, 0xec, 0x00, 0x33, 0xdf, 0xb1, 0xaa, 0x05, 0x4a, 0x23, 0x6b, 0x19,
+	0x6e, 0x8c, 0x46, 0x2f, 0xf9, 0x88, 0x99, 0xaf, 0x23, 0x65, 0xa4, 0x16, 0xc4, 0x63, 0xd7, 0xf0,
+	0xe2, 0x24, 0xa2, 0xc2, 0xd9, 0x7e, 0x5b, 0x49, 0x34, 0x48, 0xe2, 0xd9, 0xb1, 0x4d, 0x0a, 0x1b,
+	0x6a, 0xb2, 0x31, 0x74, 0x0c, 0xea, 0x24, 0x4a, 0xbd, 0x98, 0x9e, 0xb8, 0x23, 0x0e, 0x35, 0x7b,
+	0x0d, 0x83, 0xfa, 0xbc, 0x8d, 0xd2, 0x00, 0x1f, 0x69, 0x3d, 0x5b, 0x5c, 0xd4, 0xe8, 0xaa, 0x00,
+	0xd9, 0x15, 0xd8, 0xb1, 0x0c, 0x33, 0xa7, 0x45, 0x99, 0x0d, 0x8f, 0x56, 0xcb, 0x98, 0xa8, 0x59,
+	0xfc, 0xa7, 0x40, 0x4b, 0xa5, 0x30, 0xd8, 0xee, 0xf6, 0x06, 0x33, 0x5a, 0xbc, 0x29, 0xfa, 0x15,
+	0xb8, 0x28, 0x30, 0xf6, 0x06, 0x86, 0x35, 0xa9, 0x7e, 0x88, 0x36, 0xd1, 0x06, 0x15, 0xbc, 0x2c,
+	0xd1, 0xdd, 0x39, 0x3d, 0xe4, 0xbb, 0xdf, 0x03, 0x00, 0x1d, 0x1b, 0x02, 0x59, 0xc2, 0x03, 0x00,
+	0x00,
 }
@@ -51,6 +51,43 @@ func (StreamType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_30887c96a468dac0, []int{0}
 }
 
+//
+// Format is the format of an archived log stream's data.
+type LogIndex_Format int32
+
+const (
+	//
+	// The log stream is a RecordIO stream of its LogStreamDescriptor followed
+	// by its LogEntry messages.
+	LogIndex_RECORDIO LogIndex_Format = 0
+	//
+	// The log stream is a series of independently zlib-compressed frames.
+	// Decompressed and concatenated, the frames form a RECORDIO stream.
+	//
+	// Each frame can be decompressed on its own, so the stream can be read
+	// starting at any frame. A new frame begins at each indexed LogEntry,
+	// except possibly at the index's last entry.
+	LogIndex_ZLIB_FRAMES LogIndex_Format = 1
+)
+
+var LogIndex_Format_name = map[int32]string{
+	0: "RECORDIO",
+	1: "ZLIB_FRAMES",
+}
+
+var LogIndex_Format_value = map[string]int32{
+	"RECORDIO":    0,
+	"ZLIB_FRAMES": 1,
+}
+
+func (x LogIndex_Format) String() string {
+	return proto.EnumName(LogIndex_Format_name, int32(x))
+}
+
+func (LogIndex_Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_30887c96a468dac0, []int{5, 0}
+}
+
 //*
 // Log stream descriptor data. This is the full set of information that
 // describes a logging stream.
@@ -631,10 +668,13 @@ type LogIndex struct {
 	//
 	// This is optional. If zero, there is either no information about the number
 	// of log entries, or there are zero entries in the stream.
-	LogEntryCount        uint64   `protobuf:"varint,5,opt,name=log_entry_count,json=logEntryCount,proto3" json:"log_entry_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LogEntryCount uint64 `protobuf:"varint,5,opt,name=log_entry_count,json=logEntryCount,proto3" json:"log_entry_count,omitempty"`
+	//
+	// The format of the log stream that this index describes.
+	Format               LogIndex_Format `protobuf:"varint,6,opt,name=format,proto3,enum=logpb.LogIndex_Format" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *LogIndex) Reset()         { *m = LogIndex{} }
@@ -697,6 +737,13 @@ func (m *LogIndex) GetLogEntryCount() uint64 {
 	return 0
 }
 
+func (m *LogIndex) GetFormat() LogIndex_Format {
+	if m != nil {
+		return m.Format
+	}
+	return LogIndex_RECORDIO
+}
+
 //
 // Entry is a single index entry.
 //
@@ -706,6 +753,9 @@ type LogIndex_Entry struct {
 	//
 	// The byte offset in the emitted log stream of the RecordIO entry for the
 	// LogEntry corresponding to this Entry.
+	//
+	// For a ZLIB_FRAMES stream, this is instead the byte offset of the
+	// compressed frame that contains the LogEntry's RecordIO entry.
 	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	//
 	// The sequence number of the first content entry.
@@ -800,8 +850,77 @@ func (m *LogIndex_Entry) GetTimeOffset() *duration.Duration {
 	return nil
 }
 
+//
+// SearchIndex summarizes the content of an archived TEXT log stream.
+//
+// It is a Bloom filter over the set of byte trigrams that appear in the
+// lowercased lines of the stream. It allows a searcher to skip a stream that
+// cannot contain a string without reading the stream itself. False positives
+// are possible; false negatives are not.
+type SearchIndex struct {
+	//
+	// The filter's bit set. Bit "i" is stored as "bloom[i/8] & (1 << (i%8))".
+	Bloom []byte `protobuf:"bytes,1,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	//
+	// The number of bits that are set for each trigram.
+	HashCount uint32 `protobuf:"varint,2,opt,name=hash_count,json=hashCount,proto3" json:"hash_count,omitempty"`
+	//
+	// The number of lines that were indexed.
+	LineCount            uint64   `protobuf:"varint,3,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchIndex) Reset()         { *m = SearchIndex{} }
+func (m *SearchIndex) String() string { return proto.CompactTextString(m) }
+func (*SearchIndex) ProtoMessage()    {}
+func (*SearchIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30887c96a468dac0, []int{6}
+}
+
+func (m *SearchIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchIndex.Unmarshal(m, b)
+}
+func (m *SearchIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchIndex.Marshal(b, m, deterministic)
+}
+func (m *SearchIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchIndex.Merge(m, src)
+}
+func (m *SearchIndex) XXX_Size() int {
+	return xxx_messageInfo_SearchIndex.Size(m)
+}
+func (m *SearchIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchIndex proto.InternalMessageInfo
+
+func (m *SearchIndex) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
+func (m *SearchIndex) GetHashCount() uint32 {
+	if m != nil {
+		return m.HashCount
+	}
+	return 0
+}
+
+func (m *SearchIndex) GetLineCount() uint64 {
+	if m != nil {
+		return m.LineCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("logpb.StreamType", StreamType_name, StreamType_value)
+	proto.RegisterEnum("logpb.LogIndex_Format", LogIndex_Format_name, LogIndex_Format_value)
 	proto.RegisterType((*LogStreamDescriptor)(nil), "logpb.LogStreamDescriptor")
 	proto.RegisterMapType((map[string]string)(nil), "logpb.LogStreamDescriptor.TagsEntry")
 	proto.RegisterType((*Text)(nil), "logpb.Text")
@@ -812,6 +931,7 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "logpb.LogEntry")
 	proto.RegisterType((*LogIndex)(nil), "logpb.LogIndex")
 	proto.RegisterType((*LogIndex_Entry)(nil), "logpb.LogIndex.Entry")
+	proto.RegisterType((*SearchIndex)(nil), "logpb.SearchIndex")
 }
 
 func init() {
@@ -819,55 +939,60 @@ func init() {
 }
 
 var fileDescriptor_30887c96a468dac0 = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
+	// 880 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0x1d, 0x27, 0x71, 0x8e, 0x53, 0x9a, 0x1d, 0x60, 0x31, 0x16, 0x82, 0x34, 0x42, 0x25,
-	0x5a, 0x09, 0x07, 0xc2, 0x05, 0x55, 0xef, 0x52, 0x52, 0xb6, 0x8b, 0x0a, 0xac, 0x66, 0x73, 0x01,
-	0x57, 0xd6, 0x24, 0x99, 0x98, 0x01, 0xdb, 0x63, 0xec, 0x09, 0x4a, 0x78, 0x14, 0x5e, 0x01, 0x89,
-	0x37, 0xe0, 0x91, 0x78, 0x07, 0x34, 0x67, 0x26, 0x3f, 0x6d, 0x17, 0xa4, 0xbd, 0x89, 0xce, 0x9c,
-	0xf3, 0x9d, 0x33, 0x9f, 0xbf, 0xf3, 0x4d, 0x60, 0x94, 0xca, 0x78, 0xf1, 0x53, 0x25, 0x73, 0xb1,
-	0xce, 0x63, 0x59, 0xa5, 0xa3, 0x6c, 0xbd, 0x10, 0xa3, 0x4c, 0xa6, 0x4b, 0x99, 0x8e, 0x58, 0x89,
-	0x61, 0x39, 0xd7, 0xbf, 0x71, 0x59, 0x49, 0x25, 0x49, 0x13, 0x13, 0xd1, 0x47, 0xa9, 0x94, 0x69,
-	0xc6, 0x47, 0x98, 0x9c, 0xaf, 0x57, 0x23, 0x25, 0x72, 0x5e, 0x2b, 0x96, 0x97, 0x06, 0x17, 0x7d,
-	0xf8, 0x10, 0xb0, 0x5c, 0x57, 0x4c, 0x09, 0x59, 0x98, 0xfa, 0xe0, 0x1f, 0x17, 0xde, 0xbe, 0x93,
-	0xe9, 0x2b, 0x55, 0x71, 0x96, 0x4f, 0x79, 0xbd, 0xa8, 0x44, 0xa9, 0x64, 0x45, 0x9e, 0x42, 0xab,
-	0xac, 0xf8, 0x4a, 0x6c, 0x42, 0xa7, 0xef, 0x0c, 0x3b, 0xd4, 0x9e, 0x08, 0x01, 0xaf, 0x60, 0x39,
-	0x0f, 0x5d, 0xcc, 0x62, 0x4c, 0xc6, 0x10, 0xd4, 0xd8, 0x9f, 0xa8, 0x6d, 0xc9, 0xc3, 0x46, 0xdf,
-	0x19, 0xbe, 0x35, 0x7e, 0x12, 0x23, 0xc3, 0xd8, 0x4c, 0x9e, 0x6d, 0x4b, 0x4e, 0xa1, 0xde, 0xc7,
-	0xe4, 0x1c, 0xba, 0x0b, 0x59, 0x28, 0x5e, 0x28, 0xd3, 0xe4, 0xe1, 0xbc, 0xc0, 0xe6, 0x10, 0x72,
-	0x09, 0x9d, 0xfd, 0xd7, 0x84, 0xcd, 0xbe, 0x33, 0x0c, 0xc6, 0x51, 0x6c, 0x3e, 0x27, 0xde, 0x7d,
-	0x4e, 0x3c, 0xdb, 0x21, 0xe8, 0x01, 0x4c, 0x2e, 0xc1, 0x53, 0x2c, 0xad, 0xc3, 0x56, 0xbf, 0x31,
-	0x0c, 0xc6, 0x1f, 0x5b, 0x26, 0xaf, 0xf9, 0xcc, 0x78, 0xc6, 0xd2, 0xfa, 0xa6, 0x50, 0xd5, 0x96,
-	0x62, 0x07, 0xb9, 0x80, 0xb3, 0xb9, 0x28, 0x58, 0xb5, 0x4d, 0x56, 0x22, 0xe3, 0x09, 0xdf, 0xa8,
-	0xb0, 0x8d, 0xcc, 0x4e, 0x4d, 0xfa, 0x6b, 0x91, 0xf1, 0x9b, 0x8d, 0x8a, 0xbe, 0x84, 0xce, 0xbe,
-	0x95, 0xf4, 0xa0, 0xf1, 0x0b, 0xdf, 0x5a, 0xa1, 0x74, 0x48, 0xde, 0x81, 0xe6, 0x6f, 0x2c, 0x5b,
-	0xef, 0x64, 0x32, 0x87, 0x2b, 0xf7, 0xd2, 0x19, 0xfc, 0x0c, 0xde, 0x8c, 0x6f, 0x14, 0xb9, 0x80,
-	0x66, 0x26, 0x0a, 0x5e, 0x87, 0x0e, 0x72, 0xec, 0x59, 0x8e, 0xba, 0x16, 0xdf, 0x89, 0x82, 0x53,
-	0x53, 0x8e, 0xae, 0xc0, 0xd3, 0xc7, 0xc3, 0x44, 0x7d, 0x4b, 0xd7, 0x4e, 0x24, 0x1f, 0x40, 0x67,
-	0xc9, 0x33, 0x91, 0x0b, 0xc5, 0x2b, 0x7b, 0xd7, 0x21, 0x31, 0x18, 0x40, 0xeb, 0x1a, 0x59, 0xeb,
-	0xad, 0x2d, 0x99, 0x62, 0x08, 0xe9, 0x52, 0x8c, 0xbf, 0xf1, 0x7c, 0xa7, 0xe7, 0x0e, 0xfe, 0x70,
-	0xc0, 0x9f, 0x32, 0xc5, 0xd2, 0x8a, 0xe5, 0x7b, 0x98, 0x73, 0x80, 0x91, 0xcf, 0xa1, 0x5d, 0xb2,
-	0x4a, 0x09, 0x96, 0x61, 0x77, 0x30, 0x7e, 0xcf, 0x52, 0xdd, 0x75, 0xc5, 0x2f, 0x4d, 0x99, 0xee,
-	0x70, 0xd1, 0x73, 0x68, 0xdb, 0x9c, 0xa6, 0x2d, 0x8a, 0x25, 0x37, 0x2e, 0x3a, 0xa5, 0xe6, 0xa0,
-	0xef, 0xa9, 0xc5, 0xef, 0x46, 0x1d, 0x8f, 0x62, 0xac, 0x73, 0x19, 0xab, 0x15, 0xba, 0xc7, 0xa7,
-	0x18, 0x0f, 0xfe, 0x72, 0xc1, 0xbf, 0x93, 0xa9, 0x51, 0xf9, 0x0a, 0x02, 0xbd, 0xe1, 0x44, 0xae,
-	0x56, 0x35, 0x57, 0x38, 0x30, 0x18, 0xbf, 0xff, 0xc8, 0x10, 0x53, 0xeb, 0x6f, 0x0a, 0x1a, 0xfd,
-	0x3d, 0x82, 0xb5, 0xdb, 0x8c, 0x7f, 0x13, 0xc3, 0xc6, 0x5c, 0x1c, 0x98, 0xdc, 0x0b, 0xe4, 0x74,
-	0x0e, 0x5d, 0x6b, 0x62, 0x03, 0x69, 0x18, 0x88, 0xc9, 0x19, 0x48, 0x04, 0x7e, 0xcd, 0x7f, 0x5d,
-	0xf3, 0x62, 0x61, 0xfc, 0xea, 0xd1, 0xfd, 0x99, 0x9c, 0x83, 0xa7, 0xb4, 0x5b, 0x00, 0x69, 0x05,
-	0x47, 0xeb, 0xbc, 0x3d, 0xa1, 0x58, 0x22, 0x9f, 0x40, 0xcb, 0x98, 0x28, 0x0c, 0x10, 0x74, 0x6a,
-	0x41, 0x66, 0x47, 0xb7, 0x27, 0xd4, 0x96, 0xc9, 0xa7, 0xe0, 0x2f, 0xad, 0xb8, 0x61, 0x17, 0xa1,
-	0x67, 0x0f, 0x34, 0xbf, 0x3d, 0xa1, 0x7b, 0xc8, 0x75, 0x07, 0xda, 0xf6, 0xd9, 0x0c, 0xfe, 0x6c,
-	0xa0, 0x60, 0x86, 0x6e, 0x0c, 0xde, 0x92, 0xd7, 0x0b, 0xab, 0x54, 0xf4, 0xdf, 0xaf, 0x80, 0x22,
-	0x8e, 0x8c, 0xa0, 0xcd, 0x0b, 0x55, 0x09, 0x5e, 0x87, 0x2e, 0x9a, 0xf2, 0xdd, 0x43, 0x0b, 0x4e,
-	0x8c, 0xcd, 0x4b, 0xd9, 0xa1, 0xc8, 0x33, 0x78, 0xa2, 0xd7, 0x94, 0xdc, 0x93, 0xd6, 0xe8, 0x76,
-	0xa6, 0x0b, 0x2f, 0x8f, 0xe4, 0xdd, 0x61, 0xef, 0x69, 0xec, 0x1d, 0xb0, 0xaf, 0x8e, 0x74, 0xbe,
-	0x80, 0xb3, 0x4c, 0xa6, 0x89, 0xbe, 0x66, 0x9b, 0x2c, 0xe4, 0xba, 0x50, 0xf8, 0xfc, 0x3d, 0x7a,
-	0x9a, 0x59, 0x33, 0x7c, 0xa5, 0x93, 0xd1, 0xdf, 0x0e, 0x34, 0x8d, 0x37, 0x9e, 0x42, 0xeb, 0xc8,
-	0x16, 0x1e, 0xb5, 0xa7, 0x7b, 0x1b, 0x73, 0x1f, 0x6d, 0xac, 0xfb, 0x1a, 0xe2, 0xff, 0xeb, 0x09,
-	0xef, 0xb1, 0x27, 0x1e, 0xb8, 0xb2, 0xf9, 0x06, 0xae, 0x7c, 0xf6, 0x19, 0xc0, 0xe1, 0xdf, 0x91,
-	0xf8, 0xe0, 0xcd, 0x6e, 0x7e, 0x98, 0xf5, 0x4e, 0x08, 0x40, 0xeb, 0xfa, 0xc5, 0x77, 0x13, 0xfa,
-	0x63, 0xcf, 0x21, 0x5d, 0xf0, 0xa7, 0x93, 0xd9, 0xe4, 0x39, 0x9d, 0x7c, 0xdb, 0x73, 0xe7, 0x2d,
-	0x1c, 0xf8, 0xc5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xc2, 0x12, 0xd9, 0x2f, 0x06, 0x00,
-	0x00,
+	0x14, 0x8e, 0x53, 0xc7, 0x49, 0x8e, 0x53, 0x92, 0x1d, 0x96, 0x62, 0x2c, 0x16, 0x5a, 0x0b, 0x75,
+	0xab, 0x95, 0x70, 0x20, 0x5c, 0x50, 0xf5, 0x2e, 0xdd, 0xa4, 0xdb, 0xa2, 0x2e, 0x5d, 0x4d, 0x73,
+	0x01, 0xdc, 0x58, 0x93, 0x64, 0xe2, 0x1a, 0x6c, 0x8f, 0xb1, 0x27, 0xa8, 0xe1, 0x9a, 0xa7, 0xe0,
+	0x21, 0x78, 0x03, 0x1e, 0x89, 0x77, 0x40, 0x73, 0x66, 0x92, 0xf4, 0x0f, 0xa4, 0xbd, 0xb1, 0x66,
+	0xce, 0xf9, 0xce, 0xcc, 0x77, 0xbe, 0xf3, 0x8d, 0xa1, 0x1f, 0x8b, 0x70, 0x76, 0x53, 0x8a, 0x2c,
+	0x59, 0x66, 0xa1, 0x28, 0xe3, 0x7e, 0xba, 0x9c, 0x25, 0xfd, 0x54, 0xc4, 0x73, 0x11, 0xf7, 0x59,
+	0x81, 0xcb, 0x62, 0xaa, 0xbe, 0x61, 0x51, 0x0a, 0x29, 0x48, 0x03, 0x03, 0xfe, 0xe7, 0xb1, 0x10,
+	0x71, 0xca, 0xfb, 0x18, 0x9c, 0x2e, 0x17, 0x7d, 0x99, 0x64, 0xbc, 0x92, 0x2c, 0x2b, 0x34, 0xce,
+	0xff, 0xec, 0x21, 0x60, 0xbe, 0x2c, 0x99, 0x4c, 0x44, 0xae, 0xf3, 0xc1, 0x3f, 0x75, 0xf8, 0xf0,
+	0x52, 0xc4, 0xd7, 0xb2, 0xe4, 0x2c, 0x1b, 0xf1, 0x6a, 0x56, 0x26, 0x85, 0x14, 0x25, 0xd9, 0x03,
+	0xa7, 0x28, 0xf9, 0x22, 0xb9, 0xf5, 0xac, 0x7d, 0xeb, 0xa8, 0x4d, 0xcd, 0x8e, 0x10, 0xb0, 0x73,
+	0x96, 0x71, 0xaf, 0x8e, 0x51, 0x5c, 0x93, 0x01, 0xb8, 0x15, 0xd6, 0x47, 0x72, 0x55, 0x70, 0x6f,
+	0x67, 0xdf, 0x3a, 0xfa, 0x60, 0xf0, 0x2c, 0x44, 0x86, 0xa1, 0x3e, 0x79, 0xb2, 0x2a, 0x38, 0x85,
+	0x6a, 0xb3, 0x26, 0x07, 0xd0, 0x99, 0x89, 0x5c, 0xf2, 0x5c, 0xea, 0x22, 0x1b, 0xcf, 0x73, 0x4d,
+	0x0c, 0x21, 0xc7, 0xd0, 0xde, 0x74, 0xe3, 0x35, 0xf6, 0xad, 0x23, 0x77, 0xe0, 0x87, 0xba, 0x9d,
+	0x70, 0xdd, 0x4e, 0x38, 0x59, 0x23, 0xe8, 0x16, 0x4c, 0x8e, 0xc1, 0x96, 0x2c, 0xae, 0x3c, 0x67,
+	0x7f, 0xe7, 0xc8, 0x1d, 0x7c, 0x61, 0x98, 0x3c, 0xd1, 0x66, 0x38, 0x61, 0x71, 0x35, 0xce, 0x65,
+	0xb9, 0xa2, 0x58, 0x41, 0x0e, 0xa1, 0x3b, 0x4d, 0x72, 0x56, 0xae, 0xa2, 0x45, 0x92, 0xf2, 0x88,
+	0xdf, 0x4a, 0xaf, 0x89, 0xcc, 0x76, 0x75, 0xf8, 0x2c, 0x49, 0xf9, 0xf8, 0x56, 0xfa, 0xdf, 0x42,
+	0x7b, 0x53, 0x4a, 0x7a, 0xb0, 0xf3, 0x0b, 0x5f, 0x19, 0xa1, 0xd4, 0x92, 0x3c, 0x87, 0xc6, 0x6f,
+	0x2c, 0x5d, 0xae, 0x65, 0xd2, 0x9b, 0x93, 0xfa, 0xb1, 0x15, 0xfc, 0x0c, 0xf6, 0x84, 0xdf, 0x4a,
+	0x72, 0x08, 0x8d, 0x34, 0xc9, 0x79, 0xe5, 0x59, 0xc8, 0xb1, 0x67, 0x38, 0xaa, 0x5c, 0x78, 0x99,
+	0xe4, 0x9c, 0xea, 0xb4, 0x7f, 0x02, 0xb6, 0xda, 0x6e, 0x4f, 0x54, 0xb7, 0x74, 0xcc, 0x89, 0xe4,
+	0x53, 0x68, 0xcf, 0x79, 0x9a, 0x64, 0x89, 0xe4, 0xa5, 0xb9, 0x6b, 0x1b, 0x08, 0x02, 0x70, 0x4e,
+	0x91, 0xb5, 0x9a, 0xda, 0x9c, 0x49, 0x86, 0x90, 0x0e, 0xc5, 0xf5, 0x77, 0x76, 0xcb, 0xea, 0xd5,
+	0x83, 0x3f, 0x2d, 0x68, 0x8d, 0x98, 0x64, 0x71, 0xc9, 0xb2, 0x0d, 0xcc, 0xda, 0xc2, 0xc8, 0xd7,
+	0xd0, 0x2c, 0x58, 0x29, 0x13, 0x96, 0x62, 0xb5, 0x3b, 0xf8, 0xd8, 0x50, 0x5d, 0x57, 0x85, 0xef,
+	0x74, 0x9a, 0xae, 0x71, 0xfe, 0x1b, 0x68, 0x9a, 0x98, 0xa2, 0x9d, 0xe4, 0x73, 0xae, 0x5d, 0xb4,
+	0x4b, 0xf5, 0x46, 0xdd, 0x53, 0x25, 0xbf, 0x6b, 0x75, 0x6c, 0x8a, 0x6b, 0x15, 0x4b, 0x59, 0x25,
+	0xd1, 0x3d, 0x2d, 0x8a, 0xeb, 0xe0, 0xaf, 0x3a, 0xb4, 0x2e, 0x45, 0xac, 0x55, 0x3e, 0x01, 0x57,
+	0x4d, 0x38, 0x12, 0x8b, 0x45, 0xc5, 0x25, 0x1e, 0xe8, 0x0e, 0x3e, 0x79, 0x64, 0x88, 0x91, 0xf1,
+	0x37, 0x05, 0x85, 0xbe, 0x42, 0xb0, 0x72, 0x9b, 0xf6, 0x6f, 0xa4, 0xd9, 0xe8, 0x8b, 0x5d, 0x1d,
+	0xbb, 0x40, 0x4e, 0x07, 0xd0, 0x31, 0x26, 0xd6, 0x90, 0x1d, 0x0d, 0xd1, 0x31, 0x0d, 0xf1, 0xa1,
+	0x55, 0xf1, 0x5f, 0x97, 0x3c, 0x9f, 0x69, 0xbf, 0xda, 0x74, 0xb3, 0x27, 0x07, 0x60, 0x4b, 0xe5,
+	0x16, 0x40, 0x5a, 0xee, 0x9d, 0x71, 0x9e, 0xd7, 0x28, 0xa6, 0xc8, 0x4b, 0x70, 0xb4, 0x89, 0x3c,
+	0x17, 0x41, 0xbb, 0x06, 0xa4, 0x67, 0x74, 0x5e, 0xa3, 0x26, 0x4d, 0xbe, 0x84, 0xd6, 0xdc, 0x88,
+	0xeb, 0x75, 0x10, 0xda, 0x7d, 0xa0, 0xf9, 0x79, 0x8d, 0x6e, 0x20, 0xa7, 0x6d, 0x68, 0x9a, 0x67,
+	0x13, 0xfc, 0x61, 0xa3, 0x60, 0x9a, 0x6e, 0x08, 0xf6, 0x9c, 0x57, 0x33, 0xa3, 0x94, 0xff, 0xdf,
+	0xaf, 0x80, 0x22, 0x8e, 0xf4, 0xa1, 0xc9, 0x73, 0x59, 0x26, 0xbc, 0xf2, 0xea, 0x68, 0xca, 0x8f,
+	0xb6, 0x25, 0x78, 0x62, 0xa8, 0x5f, 0xca, 0x1a, 0x45, 0x5e, 0xc1, 0x33, 0x35, 0xa6, 0xe8, 0x9e,
+	0xb4, 0x5a, 0xb7, 0xae, 0x4a, 0xbc, 0xbb, 0x23, 0xef, 0x1a, 0x7b, 0x4f, 0x63, 0x7b, 0x8b, 0xbd,
+	0xbe, 0xa3, 0xf3, 0x21, 0x74, 0x53, 0x11, 0x47, 0xea, 0x9a, 0x55, 0x34, 0x13, 0xcb, 0x5c, 0xe2,
+	0xf3, 0xb7, 0xe9, 0x6e, 0x6a, 0xcc, 0xf0, 0x5a, 0x05, 0x49, 0x08, 0xce, 0x42, 0x94, 0x19, 0x93,
+	0x9e, 0x83, 0xbf, 0x9c, 0xbd, 0x87, 0x7c, 0xcf, 0x30, 0x4b, 0x0d, 0xca, 0xff, 0xdb, 0x82, 0x86,
+	0xf6, 0xd2, 0x1e, 0x38, 0x77, 0x6c, 0x64, 0x53, 0xb3, 0xbb, 0x37, 0xe1, 0xfa, 0xa3, 0x09, 0x77,
+	0x9e, 0x68, 0xf4, 0x7f, 0x3d, 0x64, 0x3f, 0xf6, 0xd0, 0x03, 0x17, 0x37, 0xde, 0xc3, 0xc5, 0xc1,
+	0x4b, 0x70, 0x74, 0x47, 0xa4, 0x03, 0x2d, 0x3a, 0x7e, 0x7d, 0x45, 0x47, 0x17, 0x57, 0xbd, 0x1a,
+	0xe9, 0x82, 0xfb, 0xd3, 0xe5, 0xc5, 0x69, 0x74, 0x46, 0x87, 0x6f, 0xc7, 0xd7, 0x3d, 0x2b, 0x60,
+	0xe0, 0x5e, 0x73, 0x56, 0xce, 0x6e, 0xf4, 0x9d, 0xcf, 0xa1, 0x31, 0x4d, 0x85, 0xc8, 0xd6, 0xff,
+	0x0e, 0xdc, 0x90, 0x17, 0x00, 0x37, 0xac, 0xba, 0x31, 0x02, 0xd7, 0xf1, 0x7d, 0xb6, 0x55, 0x44,
+	0x8b, 0xfb, 0x02, 0x40, 0xfd, 0x81, 0x4c, 0x5a, 0x37, 0xdb, 0x56, 0x11, 0x4c, 0xbf, 0xfa, 0x0a,
+	0x60, 0xfb, 0x67, 0x27, 0x2d, 0xb0, 0x27, 0xe3, 0x1f, 0x26, 0xbd, 0x1a, 0x01, 0x70, 0x4e, 0x2f,
+	0xbe, 0x1f, 0xd2, 0x1f, 0x7b, 0x96, 0x62, 0x39, 0x1a, 0x4e, 0x86, 0x6f, 0xe8, 0xf0, 0x6d, 0xaf,
+	0x3e, 0x75, 0xb0, 0xb9, 0x6f, 0xfe, 0x1d, 0x00, 0xae, 0xdb, 0xac, 0x10, 0xeb, 0x06, 0x00, 0x00,
 }
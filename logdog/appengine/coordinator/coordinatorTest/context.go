@@ -37,6 +37,7 @@ import (
 	"go.chromium.org/luci/config/server/cfgclient/backend/testconfig"
 	"go.chromium.org/luci/config/server/cfgclient/textproto"
 	"go.chromium.org/luci/logdog/api/config/svcconfig"
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
 	coordcfg "go.chromium.org/luci/logdog/appengine/coordinator/config"
 	"go.chromium.org/luci/logdog/appengine/coordinator/endpoints"
@@ -317,6 +318,17 @@ func Install(useRealIndex bool) (context.Context, *Environment) {
 				Opts:    opts,
 			}, nil
 		},
+		SI: func(lst *coordinator.LogStreamState) (*logpb.SearchIndex, error) {
+			if !lst.ArchivalState().Archived() {
+				return nil, nil
+			}
+
+			p := archive.SearchIndexPath(gs.Path(lst.ArchiveIndexURL))
+			if e.GSClient.Get(p) == nil {
+				return nil, nil
+			}
+			return archive.LoadSearchIndex(&e.GSClient, p)
+		},
 	}
 	c = coordinator.WithConfigProvider(c, &e.Services)
 	c = endpoints.WithServices(c, &e.Services)
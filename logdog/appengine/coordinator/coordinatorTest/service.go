@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.chromium.org/luci/logdog/api/config/svcconfig"
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
 	"go.chromium.org/luci/logdog/appengine/coordinator/config"
 	"go.chromium.org/luci/logdog/appengine/coordinator/endpoints"
@@ -44,6 +45,12 @@ type Services struct {
 	// *ArchivalStorage instance bound to this Environment's GSClient instance
 	// if the stream is archived.
 	ST func(*coordinator.LogStreamState) (coordinator.SigningStorage, error)
+
+	// SI returns the search index for the supplied log stream.
+	//
+	// By default, this will load the search index from this Environment's
+	// GSClient instance if the stream is archived.
+	SI func(*coordinator.LogStreamState) (*logpb.SearchIndex, error)
 }
 
 var _ endpoints.Services = (*Services)(nil)
@@ -72,3 +79,11 @@ func (s *Services) StorageForStream(c context.Context, lst *coordinator.LogStrea
 	}
 	panic("not implemented")
 }
+
+// SearchIndexForStream implements coordinator.Services.
+func (s *Services) SearchIndexForStream(c context.Context, lst *coordinator.LogStreamState, project string) (*logpb.SearchIndex, error) {
+	if s.SI != nil {
+		return s.SI(lst)
+	}
+	return nil, nil
+}
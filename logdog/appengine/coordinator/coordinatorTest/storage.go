@@ -90,7 +90,12 @@ func (st *ArchivalStorage) GetSignedURLs(c context.Context, req *coordinator.URL
 
 	signURL := func(s gs.Path) string { return string(s) + "&signed=true" }
 	if req.Stream {
+		format, err := archive.Format(c, st.Storage)
+		if err != nil {
+			return nil, err
+		}
 		resp.Stream = signURL(st.Opts.Stream)
+		resp.StreamFormat = format
 	}
 	if req.Index {
 		resp.Index = signURL(st.Opts.Index)
@@ -97,6 +97,8 @@ func (s *server) TerminateStream(c context.Context, req *logdog.TerminateStreamR
 			lst.Updated = now
 			lst.TerminalIndex = req.TerminalIndex
 			lst.TerminatedTime = now
+			lst.DroppedBytes = req.DroppedBytes
+			lst.DroppedEntries = req.DroppedEntries
 
 			if err := ds.Put(c, lst); err != nil {
 				log.Fields{
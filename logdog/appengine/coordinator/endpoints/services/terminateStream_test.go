@@ -80,6 +80,19 @@ func TestTerminateStream(t *testing.T) {
 				So(tls.Put(c), ShouldBeNil)
 				ds.GetTestable(c).CatchupIndexes()
 
+				Convey(`Records the data dropped by the Butler.`, func() {
+					req.DroppedBytes = 42
+					req.DroppedEntries = 3
+					_, err := svr.TerminateStream(c, &req)
+					So(err, ShouldBeRPCOK)
+
+					tls.WithProjectNamespace(c, func(c context.Context) {
+						So(ds.Get(c, tls.State), ShouldBeNil)
+					})
+					So(tls.State.DroppedBytes, ShouldEqual, 42)
+					So(tls.State.DroppedEntries, ShouldEqual, 3)
+				})
+
 				Convey(`Can be marked terminal and schedules an archival mutation.`, func() {
 					_, err := svr.TerminateStream(c, &req)
 					So(err, ShouldBeRPCOK)
@@ -92,6 +105,7 @@ func TestTerminateStream(t *testing.T) {
 					So(tls.State.TerminalIndex, ShouldEqual, 1337)
 					So(tls.State.Terminated(), ShouldBeTrue)
 					So(tls.State.ArchivalState(), ShouldEqual, coordinator.ArchiveTasked)
+					So(tls.State.DroppedBytes, ShouldEqual, 0)
 
 					Convey(`Can be marked terminal again (idempotent).`, func() {
 						_, err := svr.TerminateStream(c, &req)
@@ -0,0 +1,223 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/types"
+
+	ds "go.chromium.org/gae/service/datastore"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// followMinPollInterval is the delay before polling a followed log stream
+	// again after a poll found new log entries.
+	followMinPollInterval = time.Second
+	// followMaxPollInterval is the maximum delay between two polls of a
+	// followed log stream. The delay doubles each time a poll finds no new log
+	// entries.
+	followMaxPollInterval = 10 * time.Second
+)
+
+// Follow streams log data for a single log stream as it becomes available.
+//
+// Log entries are read from storage as the Collector ingests them, by polling
+// the stream's storage. Once the stream has been archived, the archive is read
+// instead.
+func (s *server) Follow(req *logdog.FollowRequest, stream logdog.Logs_FollowServer) error {
+	c := stream.Context()
+	log.Fields{
+		"project": req.Project,
+		"path":    req.Path,
+		"index":   req.Index,
+	}.Debugf(c, "Received follow request.")
+
+	path := types.StreamPath(req.Path)
+	if err := path.Validate(); err != nil {
+		log.WithError(err).Errorf(c, "Invalid path supplied.")
+		return grpcutil.Errf(codes.InvalidArgument, "invalid path value")
+	}
+	if req.Index < 0 {
+		return grpcutil.Errf(codes.InvalidArgument, "index must not be negative")
+	}
+
+	ls := &coordinator.LogStream{ID: coordinator.LogStreamID(path)}
+	lst := ls.State(c)
+	if err := ds.Get(c, ls, lst); err != nil {
+		if ds.IsErrNoSuchEntity(err) {
+			log.Warningf(c, "Log stream does not exist.")
+			return grpcutil.Errf(codes.NotFound, "path not found")
+		}
+
+		log.WithError(err).Errorf(c, "Failed to look up log stream.")
+		return grpcutil.Internal
+	}
+
+	// If this log entry is Purged and we're not admin, pretend it doesn't exist.
+	if ls.Purged {
+		if authErr := coordinator.IsAdminUser(c); authErr != nil {
+			log.Fields{
+				log.ErrorKey: authErr,
+			}.Warningf(c, "Non-superuser requested purged log.")
+			return grpcutil.Errf(codes.NotFound, "path not found")
+		}
+	}
+
+	// The first response carries the state, if requested, and is sent right
+	// away even if no logs are available yet.
+	resp := &logdog.FollowResponse{Project: coordinator.Project(c)}
+	if req.State {
+		resp.State = buildLogStreamState(ls, lst)
+
+		var err error
+		resp.Desc, err = ls.DescriptorValue()
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to deserialize descriptor protobuf.")
+			return grpcutil.Internal
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		resp = nil
+	}
+
+	f := follower{
+		ls:    ls,
+		lst:   lst,
+		index: types.MessageIndex(req.Index),
+	}
+	defer f.close()
+
+	delay := followMinPollInterval
+	for {
+		// Snapshot the stream's state before reading its logs, so that logs
+		// written before it terminated are not missed.
+		terminalIndex := types.MessageIndex(lst.TerminalIndex)
+		terminated := lst.Terminated()
+		archived := lst.ArchivalState().Archived()
+
+		logs, err := f.next(c)
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to get logs.")
+			return grpcutil.Internal
+		}
+
+		if len(logs) > 0 {
+			if resp == nil {
+				resp = &logdog.FollowResponse{Project: coordinator.Project(c)}
+			}
+			resp.Logs = logs
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			resp = nil
+		}
+
+		switch {
+		case archived && len(logs) == 0:
+			// The archive is all there will ever be.
+			log.Debugf(c, "Finished following archived stream.")
+			return nil
+
+		case terminated && !archived && f.index > terminalIndex:
+			log.Fields{
+				"terminalIndex": terminalIndex,
+			}.Debugf(c, "Finished following terminated stream.")
+			return nil
+		}
+
+		if len(logs) > 0 {
+			delay = followMinPollInterval
+		} else {
+			if tr := clock.Sleep(c, delay); tr.Err != nil {
+				return tr.Err
+			}
+			if delay *= 2; delay > followMaxPollInterval {
+				delay = followMaxPollInterval
+			}
+		}
+
+		// Pick up any termination or archival since the last poll.
+		if !archived {
+			if err := ds.Get(c, lst); err != nil {
+				log.WithError(err).Errorf(c, "Failed to reload log stream state.")
+				return grpcutil.Internal
+			}
+		}
+	}
+}
+
+// follower reads successive batches of log entries from a followed log
+// stream.
+type follower struct {
+	ls    *coordinator.LogStream
+	lst   *coordinator.LogStreamState
+	index types.MessageIndex
+
+	// st is the storage that logs are read from. It is reopened once the
+	// stream is archived.
+	st       coordinator.SigningStorage
+	archived bool
+}
+
+// next returns the next batch of log entries, starting at f.index, and
+// advances f.index past them.
+//
+// Before the stream is archived, only contiguous log entries are returned,
+// since the intermediate storage may still be catching up. Gaps in archived
+// streams are permanent, so they are skipped.
+func (f *follower) next(c context.Context) ([]*logpb.LogEntry, error) {
+	archived := f.lst.ArchivalState().Archived()
+	if f.st == nil || archived != f.archived {
+		f.close()
+
+		st, err := flex.GetServices(c).StorageForStream(c, f.lst, coordinator.Project(c))
+		if err != nil {
+			return nil, err
+		}
+		f.st, f.archived = st, archived
+	}
+
+	req := logdog.GetRequest{
+		Index:         int64(f.index),
+		NonContiguous: archived,
+	}
+	logs, err := getHead(c, &req, f.st, coordinator.Project(c), f.ls.Path(), getBytesLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) > 0 {
+		f.index = types.MessageIndex(logs[len(logs)-1].StreamIndex) + 1
+	}
+	return logs, nil
+}
+
+func (f *follower) close() {
+	if f.st != nil {
+		f.st.Close()
+		f.st = nil
+	}
+}
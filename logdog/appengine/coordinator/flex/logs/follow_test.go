@@ -0,0 +1,183 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"google.golang.org/grpc"
+
+	"go.chromium.org/luci/common/clock"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	ds "go.chromium.org/gae/service/datastore"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// testFollowServer is a logdog.Logs_FollowServer that records the responses
+// sent to it.
+type testFollowServer struct {
+	grpc.ServerStream // Only Context and Send are implemented.
+
+	ctx  context.Context
+	sent []*logdog.FollowResponse
+}
+
+func (s *testFollowServer) Context() context.Context { return s.ctx }
+
+func (s *testFollowServer) Send(resp *logdog.FollowResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+// indices returns the stream indices of the log entries in each response.
+func (s *testFollowServer) indices() [][]int {
+	var got [][]int
+	for _, resp := range s.sent {
+		idx := []int{}
+		for _, le := range resp.Logs {
+			idx = append(idx, int(le.StreamIndex))
+		}
+		got = append(got, idx)
+	}
+	return got
+}
+
+func TestFollow(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Follow request`, t, func() {
+		c, env := ct.Install(true)
+		ds.GetTestable(c).Consistent(true)
+
+		svr := New()
+
+		const project = "proj-foo"
+
+		tls := ct.MakeStream(c, project, "testing/+/foo/bar")
+		So(tls.Put(c), ShouldBeNil)
+
+		putLogs := func(indices ...int) {
+			for _, i := range indices {
+				d, err := proto.Marshal(tls.LogEntry(c, i))
+				So(err, ShouldBeNil)
+				So(env.BigTable.Put(c, storage.PutRequest{
+					Project: project,
+					Path:    tls.Path,
+					Index:   types.MessageIndex(i),
+					Values:  [][]byte{d},
+				}), ShouldBeNil)
+			}
+		}
+
+		terminate := func(terminalIndex int64) {
+			tls.State.TerminalIndex = terminalIndex
+			tls.State.TerminatedTime = env.Clock.Now().UTC()
+			So(tls.Put(c), ShouldBeNil)
+		}
+
+		req := logdog.FollowRequest{
+			Project: project,
+			Path:    string(tls.Path),
+		}
+		stream := &testFollowServer{ctx: c}
+
+		Convey(`Will send the state first, if requested.`, func() {
+			putLogs(0, 1)
+			terminate(1)
+
+			req.State = true
+			So(svr.Follow(&req, stream), ShouldBeRPCOK)
+			So(stream.sent, ShouldHaveLength, 2)
+			So(stream.sent[0].State.TerminalIndex, ShouldEqual, 1)
+			So(stream.sent[0].Desc, ShouldResembleProto, tls.Desc)
+			So(stream.indices(), ShouldResemble, [][]int{{}, {0, 1}})
+		})
+
+		Convey(`Will start at the requested index.`, func() {
+			putLogs(0, 1, 2)
+			terminate(2)
+
+			req.Index = 1
+			So(svr.Follow(&req, stream), ShouldBeRPCOK)
+			So(stream.indices(), ShouldResemble, [][]int{{1, 2}})
+		})
+
+		Convey(`Will push new log entries until the stream terminates.`, func() {
+			putLogs(0)
+
+			// Each time the server waits for new log entries, the Collector
+			// ingests another one. The stream terminates after three.
+			next := 1
+			env.Clock.SetTimerCallback(func(d time.Duration, tmr clock.Timer) {
+				if next < 3 {
+					putLogs(next)
+					next++
+				} else {
+					terminate(2)
+				}
+				env.Clock.Add(d)
+			})
+
+			So(svr.Follow(&req, stream), ShouldBeRPCOK)
+			So(stream.indices(), ShouldResemble, [][]int{{0}, {1}, {2}})
+		})
+
+		Convey(`Will wait for gaps in the intermediate storage to be filled.`, func() {
+			putLogs(0, 2)
+
+			filled := false
+			env.Clock.SetTimerCallback(func(d time.Duration, tmr clock.Timer) {
+				if !filled {
+					putLogs(1)
+					terminate(2)
+					filled = true
+				}
+				env.Clock.Add(d)
+			})
+
+			So(svr.Follow(&req, stream), ShouldBeRPCOK)
+			So(stream.indices(), ShouldResemble, [][]int{{0}, {1, 2}})
+		})
+
+		Convey(`Will stop when the Context is cancelled.`, func() {
+			c, cancel := context.WithCancel(c)
+			stream.ctx = c
+			env.Clock.SetTimerCallback(func(time.Duration, clock.Timer) { cancel() })
+
+			So(svr.Follow(&req, stream), ShouldEqual, context.Canceled)
+			So(stream.sent, ShouldHaveLength, 0)
+		})
+
+		Convey(`Will fail with InvalidArgument if the index is negative.`, func() {
+			req.Index = -1
+			So(svr.Follow(&req, stream), ShouldBeRPCInvalidArgument, "index must not be negative")
+		})
+
+		Convey(`Will fail with NotFound if the log path does not exist.`, func() {
+			req.Path = "testing/+/does/not/exist"
+			So(svr.Follow(&req, stream), ShouldBeRPCNotFound, "path not found")
+		})
+	})
+}
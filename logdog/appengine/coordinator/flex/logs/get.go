@@ -22,6 +22,7 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
 	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/jsonlines"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/types"
 
@@ -109,6 +110,18 @@ func (s *server) getImpl(c context.Context, req *logdog.GetRequest, tail bool) (
 		}
 	}
 
+	var filter *jsonlines.Filter
+	if req.Filter != "" {
+		if ls.StreamType != logpb.StreamType_TEXT || ls.ContentType != types.ContentTypeJSONLines {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "filter requires a structured-record stream")
+		}
+
+		var err error
+		if filter, err = jsonlines.ParseFilter(req.Filter); err != nil {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid filter: %s", err)
+		}
+	}
+
 	resp := logdog.GetResponse{}
 	if req.State {
 		resp.State = buildLogStreamState(ls, lst)
@@ -127,6 +140,9 @@ func (s *server) getImpl(c context.Context, req *logdog.GetRequest, tail bool) (
 		log.WithError(err).Errorf(c, "Failed to get logs.")
 		return nil, grpcutil.Internal
 	}
+	if filter != nil {
+		filterLogs(resp.Logs, filter)
+	}
 
 	log.Fields{
 		"duration": clock.Now(c).Sub(startTime).String(),
@@ -187,9 +203,10 @@ func (s *server) getLogs(c context.Context, req *logdog.GetRequest, resp *logdog
 
 		default:
 			resp.SignedUrls = &logdog.GetResponse_SignedUrls{
-				Expiration: google.NewTimestamp(signedURLs.Expiration),
-				Stream:     signedURLs.Stream,
-				Index:      signedURLs.Index,
+				Expiration:   google.NewTimestamp(signedURLs.Expiration),
+				Stream:       signedURLs.Stream,
+				StreamFormat: signedURLs.StreamFormat,
+				Index:        signedURLs.Index,
 			}
 		}
 	}
@@ -282,6 +299,25 @@ func getHead(c context.Context, req *logdog.GetRequest, st coordinator.SigningSt
 	}
 }
 
+// filterLogs removes the records that don't match f from the text lines of
+// logs.
+func filterLogs(logs []*logpb.LogEntry, f *jsonlines.Filter) {
+	for _, le := range logs {
+		text := le.GetText()
+		if text == nil {
+			continue
+		}
+
+		lines := text.Lines[:0]
+		for _, line := range text.Lines {
+			if f.MatchLine(line.Value) {
+				lines = append(lines, line)
+			}
+		}
+		text.Lines = lines
+	}
+}
+
 func getTail(c context.Context, st coordinator.SigningStorage, project string, path types.StreamPath) (
 	[]*logpb.LogEntry, error) {
 
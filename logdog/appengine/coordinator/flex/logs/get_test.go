@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
 	"go.chromium.org/luci/logdog/common/archive"
+	"go.chromium.org/luci/logdog/common/jsonlines"
 	"go.chromium.org/luci/logdog/common/renderer"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/types"
@@ -298,6 +299,7 @@ func testGetImpl(t *testing.T, archived bool) {
 		})
 
 		Convey(`When testing log data is added`, func() {
+			archiveFormat := logpb.LogIndex_RECORDIO
 			putLogData := func() {
 				if !archived {
 					// Add the logs to the in-memory temporary storage.
@@ -322,6 +324,7 @@ func testGetImpl(t *testing.T, archived bool) {
 						Source:           &src,
 						LogWriter:        &lbuf,
 						IndexWriter:      &ibuf,
+						Format:           archiveFormat,
 						StreamIndexRange: 2,
 					}
 					if err := archive.Archive(m); err != nil {
@@ -501,9 +504,22 @@ func testGetImpl(t *testing.T, archived bool) {
 
 							So(resp.SignedUrls, ShouldNotBeNil)
 							So(resp.SignedUrls.Stream, ShouldEndWith, "&signed=true")
+							So(resp.SignedUrls.StreamFormat, ShouldEqual, logpb.LogIndex_RECORDIO)
 							So(resp.SignedUrls.Index, ShouldEndWith, "&signed=true")
 							So(google.TimeFromProto(resp.SignedUrls.Expiration), ShouldResemble, clock.Now(c).Add(duration))
 						})
+
+						Convey(`Will report the format of a ZLIB_FRAMES archive.`, func() {
+							archiveFormat = logpb.LogIndex_ZLIB_FRAMES
+							putLogData()
+							putLogStream(c)
+
+							resp, err := svr.Get(c, &req)
+							So(err, ShouldBeNil)
+							So(resp.SignedUrls, ShouldNotBeNil)
+							So(resp.SignedUrls.Stream, ShouldEndWith, "&signed=true")
+							So(resp.SignedUrls.StreamFormat, ShouldEqual, logpb.LogIndex_ZLIB_FRAMES)
+						})
 					} else {
 						Convey(`Will succeed, but return no URL.`, func() {
 							resp, err := svr.Get(c, &req)
@@ -558,6 +574,36 @@ func testGetImpl(t *testing.T, archived bool) {
 					So(err, ShouldBeRPCInternal)
 				})
 
+				Convey(`When filtering records`, func() {
+					req.Filter = "severity>=WARNING"
+
+					Convey(`Will fail with InvalidArgument if the stream isn't a structured-record stream.`, func() {
+						_, err := svr.Get(c, &req)
+						So(err, ShouldBeRPCInvalidArgument, "filter requires a structured-record stream")
+					})
+
+					Convey(`For a structured-record stream`, func() {
+						tls.Stream.ContentType = types.ContentTypeJSONLines
+						putLogStream(c)
+
+						Convey(`Will fail with InvalidArgument if the filter is invalid.`, func() {
+							req.Filter = "severity"
+
+							_, err := svr.Get(c, &req)
+							So(err, ShouldBeRPCInvalidArgument, "invalid filter")
+						})
+
+						Convey(`Will return the log entries without their non-matching records.`, func() {
+							resp, err := svr.Get(c, &req)
+							So(err, ShouldBeRPCOK)
+							So(resp, shouldHaveLogs, 0, 1, 2)
+							for _, le := range resp.Logs {
+								So(le.GetText().Lines, ShouldHaveLength, 0)
+							}
+						})
+					})
+				})
+
 				Convey(`Will enforce a maximum count of 2.`, func() {
 					req.LogCount = 2
 					resp, err := svr.Get(c, &req)
@@ -686,3 +732,33 @@ func TestGetArchived(t *testing.T) {
 
 	testGetImpl(t, true)
 }
+
+func TestFilterLogs(t *testing.T) {
+	t.Parallel()
+
+	Convey(`filterLogs removes non-matching records from text log entries.`, t, func() {
+		text := func(lines ...string) *logpb.LogEntry {
+			le := &logpb.LogEntry{Content: &logpb.LogEntry_Text{Text: &logpb.Text{}}}
+			for _, l := range lines {
+				le.GetText().Lines = append(le.GetText().Lines, &logpb.Text_Line{Value: []byte(l), Delimiter: "\n"})
+			}
+			return le
+		}
+		bin := &logpb.LogEntry{Content: &logpb.LogEntry_Binary{Binary: &logpb.Binary{Data: []byte("{}")}}}
+
+		f, err := jsonlines.ParseFilter("severity>=WARNING")
+		So(err, ShouldBeNil)
+
+		logs := []*logpb.LogEntry{
+			text(`{"severity": "INFO"}`, `{"severity": "ERROR", "n": 1}`, `not json`),
+			text(`{"severity": "DEBUG"}`),
+			bin,
+		}
+		filterLogs(logs, f)
+		So(logs, ShouldResembleProto, []*logpb.LogEntry{
+			text(`{"severity": "ERROR", "n": 1}`),
+			text(),
+			bin,
+		})
+	})
+}
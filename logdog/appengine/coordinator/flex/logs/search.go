@@ -0,0 +1,449 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/archive"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	ds "go.chromium.org/gae/service/datastore"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// searchResultLimit is the maximum number of matches that will be returned
+	// in a single search.
+	searchResultLimit = 500
+
+	// searchStreamLimit is the maximum number of log streams that will be
+	// scanned in a single search.
+	searchStreamLimit = 50
+
+	// searchBytesLimit is the maximum amount of log entry data that will be
+	// scanned in a single search.
+	searchBytesLimit = 64 * 1024 * 1024
+)
+
+// Search returns log lines that match the requested pattern.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	if req.Pattern == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "`pattern` is required")
+	}
+	sc, err := newSearcher(req)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"pattern":    req.Pattern,
+		}.Errorf(c, "Invalid search pattern.")
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid `pattern`: %s", err)
+	}
+
+	sc.limit = s.resultLimit
+	if sc.limit == 0 {
+		sc.limit = searchResultLimit
+	}
+	if int(req.MaxResults) > 0 && sc.limit > int(req.MaxResults) {
+		sc.limit = int(req.MaxResults)
+	}
+
+	var pos searchPosition
+	if req.Next != "" {
+		if pos, err = decodeSearchPosition(c, req.Next); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"next":       req.Next,
+			}.Errorf(c, "Failed to decode search position.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
+		}
+	}
+
+	q := ds.NewQuery("LogStream").Order("-Created")
+	if pos.cursor != nil {
+		q = q.Start(pos.cursor)
+	}
+	if req.Path != "" {
+		if q, err = coordinator.AddLogStreamPathFilter(q, req.Path); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       req.Path,
+			}.Errorf(c, "Invalid search path.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid search `path`")
+		}
+	}
+	q = q.Eq("StreamType", logpb.StreamType_TEXT)
+	q = q.Eq("Purged", false)
+	q = q.Limit(searchStreamLimit).KeysOnly(true)
+
+	// Collect the streams to search, along with the cursor following each of
+	// them, so we can resume the search at any of them.
+	var (
+		logStreams []*coordinator.LogStream
+		cursors    []ds.Cursor
+	)
+	err = ds.Run(c, q, func(sk *ds.Key, cb ds.CursorCB) error {
+		var ls coordinator.LogStream
+		ds.PopulateKey(&ls, sk)
+
+		cursor, err := cb()
+		if err != nil {
+			return err
+		}
+		logStreams = append(logStreams, &ls)
+		cursors = append(cursors, cursor)
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to execute search query.")
+		return nil, grpcutil.Internal
+	}
+
+	logStreamStates := make([]coordinator.LogStreamState, len(logStreams))
+	entities := make([]interface{}, 0, 2*len(logStreams))
+	for i, ls := range logStreams {
+		ls.PopulateState(c, &logStreamStates[i])
+		entities = append(entities, ls, &logStreamStates[i])
+	}
+	if err := ds.Get(c, entities); err != nil {
+		log.WithError(err).Errorf(c, "Failed to load log streams.")
+		return nil, grpcutil.Internal
+	}
+
+	resp := logdog.SearchResponse{
+		Project: coordinator.Project(c),
+	}
+	startTime := clock.Now(c)
+	for i, ls := range logStreams {
+		if i > 0 {
+			pos = searchPosition{cursor: cursors[i-1]}
+			if len(resp.Matches) >= sc.limit {
+				resp.Next = pos.encode()
+				break
+			}
+		}
+
+		next, err := sc.searchStream(c, ls, &logStreamStates[i], pos, &resp)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       ls.Path(),
+			}.Errorf(c, "Failed to search log stream.")
+			return nil, grpcutil.Internal
+		}
+		if next != nil {
+			// We hit one of our limits in the middle of this stream.
+			resp.Next = next.encode()
+			break
+		}
+	}
+	if resp.Next == "" && len(logStreams) == searchStreamLimit {
+		// There may be more streams to search.
+		resp.Next = searchPosition{cursor: cursors[len(cursors)-1]}.encode()
+	}
+
+	log.Fields{
+		"duration": clock.Now(c).Sub(startTime).String(),
+		"streams":  len(logStreams),
+		"matches":  len(resp.Matches),
+	}.Debugf(c, "Search request completed successfully.")
+	return &resp, nil
+}
+
+// searchPosition identifies the point at which a search resumes.
+type searchPosition struct {
+	// cursor is the datastore cursor preceding the log stream to resume at. If
+	// nil, the search resumes at the first log stream.
+	cursor ds.Cursor
+	// index is the stream index of the log entry to resume at.
+	index types.MessageIndex
+	// line is the index of the line within the log entry to resume at.
+	line int
+}
+
+func (p searchPosition) encode() string {
+	cursor := ""
+	if p.cursor != nil {
+		cursor = p.cursor.String()
+	}
+	return fmt.Sprintf("%d:%d:%s", p.index, p.line, cursor)
+}
+
+func decodeSearchPosition(c context.Context, v string) (p searchPosition, err error) {
+	parts := strings.SplitN(v, ":", 3)
+	if len(parts) != 3 {
+		return p, errors.New("malformed search position")
+	}
+	var index int64
+	if index, err = strconv.ParseInt(parts[0], 10, 64); err != nil || index < 0 {
+		return p, errors.Reason("bad log entry index %q", parts[0]).Err()
+	}
+	p.index = types.MessageIndex(index)
+	if p.line, err = strconv.Atoi(parts[1]); err != nil || p.line < 0 {
+		return p, errors.Reason("bad line index %q", parts[1]).Err()
+	}
+	if parts[2] != "" {
+		if p.cursor, err = ds.DecodeCursor(c, parts[2]); err != nil {
+			return p, errors.Annotate(err, "bad cursor").Err()
+		}
+	}
+	return p, nil
+}
+
+// searcher matches log lines against a search pattern.
+type searcher struct {
+	re *regexp.Regexp
+	// literals are strings that any matching line must contain. They are used
+	// to skip log streams via their search index.
+	literals []string
+
+	limit     int
+	bytesLeft int
+}
+
+func newSearcher(req *logdog.SearchRequest) (*searcher, error) {
+	expr := req.Pattern
+	if !req.Regexp {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if req.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &searcher{
+		re:        re,
+		literals:  requiredLiterals(parsed.Simplify()),
+		bytesLeft: searchBytesLimit,
+	}, nil
+}
+
+// mayMatch returns false if the search index proves that no line in its log
+// stream can match the search pattern.
+func (sc *searcher) mayMatch(idx *logpb.SearchIndex) bool {
+	for _, lit := range sc.literals {
+		if !archive.SearchIndexMayContain(idx, lit) {
+			return false
+		}
+	}
+	return true
+}
+
+// searchStream searches a single log stream starting at the supplied position,
+// adding matches to resp.
+//
+// If the search stops before the end of the stream because a limit was
+// reached, the position to resume at is returned.
+func (sc *searcher) searchStream(c context.Context, ls *coordinator.LogStream, lst *coordinator.LogStreamState,
+	pos searchPosition, resp *logdog.SearchResponse) (*searchPosition, error) {
+
+	svc := flex.GetServices(c)
+	project, path := coordinator.Project(c), ls.Path()
+	c = log.SetField(c, "path", path)
+
+	if len(sc.literals) > 0 {
+		switch idx, err := svc.SearchIndexForStream(c, lst, project); {
+		case err != nil:
+			// Not fatal, we can still scan the stream.
+			log.WithError(err).Warningf(c, "Failed to load search index.")
+		case idx != nil && !sc.mayMatch(idx):
+			log.Debugf(c, "Skipping log stream based on its search index.")
+			return nil, nil
+		}
+	}
+
+	st, err := svc.StorageForStream(c, lst, project)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to create storage instance").Err()
+	}
+	defer st.Close()
+
+	sreq := storage.GetRequest{
+		Project: project,
+		Path:    path,
+		Index:   pos.index,
+	}
+	firstLine := pos.line
+
+	for {
+		var (
+			ierr  error
+			count int
+			next  *searchPosition
+		)
+		err := retry.Retry(c, transient.Only(retry.Default), func() error {
+			return st.Get(c, sreq, func(e *storage.Entry) bool {
+				var le *logpb.LogEntry
+				if le, ierr = e.GetLogEntry(); ierr != nil {
+					return false
+				}
+				sidx, _ := e.GetStreamIndex() // GetLogEntry succeeded, so this must.
+				count++
+
+				if line := sc.matchEntry(path, le, firstLine, resp); line >= 0 {
+					next = &searchPosition{cursor: pos.cursor, index: sidx, line: line}
+					return false
+				}
+				firstLine = 0
+				sreq.Index = sidx + 1
+
+				if sc.bytesLeft -= len(e.D); sc.bytesLeft <= 0 {
+					next = &searchPosition{cursor: pos.cursor, index: sreq.Index}
+					return false
+				}
+				return true
+			})
+		}, func(err error, delay time.Duration) {
+			log.Fields{
+				log.ErrorKey: err,
+				"delay":      delay,
+				"nextIndex":  sreq.Index,
+			}.Warningf(c, "Transient error while searching log stream; retrying.")
+		})
+		switch {
+		case ierr != nil:
+			return nil, errors.Annotate(ierr, "failed to load log entry").Err()
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to read log stream").Err()
+		case next != nil:
+			return next, nil
+		case count == 0:
+			// We've reached the end of the stream.
+			return nil, nil
+		}
+	}
+}
+
+// matchEntry adds the lines in le starting at firstLine that match the search
+// pattern to resp.
+//
+// If the result limit is reached before all lines have been checked, the index
+// of the next line to check is returned. Otherwise, matchEntry returns -1.
+func (sc *searcher) matchEntry(path types.StreamPath, le *logpb.LogEntry, firstLine int,
+	resp *logdog.SearchResponse) int {
+
+	text := le.GetText()
+	if text == nil {
+		return -1
+	}
+
+	for i := firstLine; i < len(text.Lines); i++ {
+		if len(resp.Matches) >= sc.limit {
+			return i
+		}
+
+		line := text.Lines[i]
+		if sc.re.Match(line.Value) {
+			resp.Matches = append(resp.Matches, &logdog.SearchResponse_Match{
+				Path:  string(path),
+				Index: int64(le.StreamIndex),
+				Line:  int32(i),
+				Text:  string(line.Value),
+			})
+		}
+	}
+	if len(resp.Matches) >= sc.limit {
+		// Resume at the next entry.
+		return len(text.Lines)
+	}
+	return -1
+}
+
+// requiredLiterals returns strings that must appear in any text that re
+// matches.
+//
+// It is conservative: it may omit some required strings, but never returns one
+// that isn't required.
+func requiredLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase == 0 {
+			return []string{string(re.Rune)}
+		}
+
+		// The search index folds case via ToLower, which doesn't handle runes
+		// with non-ASCII case variants (e.g., "K" and the Kelvin sign). Only use
+		// the runs of the literal that can be folded safely.
+		var out []string
+		start := 0
+		for i, r := range re.Rune {
+			if !asciiFoldable(r) {
+				if i > start {
+					out = append(out, string(re.Rune[start:i]))
+				}
+				start = i + 1
+			}
+		}
+		if start < len(re.Rune) {
+			out = append(out, string(re.Rune[start:]))
+		}
+		return out
+
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+
+	case syntax.OpConcat:
+		var out []string
+		for _, sub := range re.Sub {
+			out = append(out, requiredLiterals(sub)...)
+		}
+		return out
+
+	default:
+		return nil
+	}
+}
+
+// asciiFoldable returns true if all case variants of r are ASCII.
+func asciiFoldable(r rune) bool {
+	if r >= utf8.RuneSelf {
+		return false
+	}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,261 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"regexp/syntax"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/gcloud/gs"
+	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+	ct "go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+	"go.chromium.org/luci/logdog/common/archive"
+	"go.chromium.org/luci/logdog/common/renderer"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	ds "go.chromium.org/gae/service/datastore"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func shouldHaveMatches(actual interface{}, expected ...interface{}) string {
+	resp := actual.(*logdog.SearchResponse)
+	var got []string
+	for _, m := range resp.Matches {
+		got = append(got, fmt.Sprintf("%s:%d:%d:%s", m.Path, m.Index, m.Line, m.Text))
+	}
+
+	var exp []string
+	for _, e := range expected {
+		exp = append(exp, e.(string))
+	}
+	return ShouldResemble(got, exp)
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install(true)
+		ds.GetTestable(c).Consistent(true)
+
+		svr := New()
+
+		const project = "proj-foo"
+
+		textEntries := func(tls *ct.TestStream, lines ...[]string) []*logpb.LogEntry {
+			entries := make([]*logpb.LogEntry, len(lines))
+			for i, l := range lines {
+				le := tls.LogEntry(c, i)
+				text := le.GetText()
+				text.Lines = nil
+				for _, v := range l {
+					text.Lines = append(text.Lines, &logpb.Text_Line{Value: []byte(v), Delimiter: "\n"})
+				}
+				entries[i] = le
+			}
+			return entries
+		}
+
+		// Streams are returned in descending Created order, so the last stream
+		// added is searched first.
+		addStream := func(path types.StreamPath, st logpb.StreamType, archived bool, lines ...[]string) {
+			tls := ct.MakeStream(c, project, path)
+			tls.Desc.StreamType = st
+			tls.Reload(c)
+
+			var entries []*logpb.LogEntry
+			if st == logpb.StreamType_TEXT {
+				entries = textEntries(tls, lines...)
+			}
+
+			if !archived {
+				for _, le := range entries {
+					d, err := proto.Marshal(le)
+					So(err, ShouldBeNil)
+					So(env.BigTable.Put(c, storage.PutRequest{
+						Project: project,
+						Path:    tls.Path,
+						Index:   types.MessageIndex(le.StreamIndex),
+						Values:  [][]byte{d},
+					}), ShouldBeNil)
+				}
+			} else {
+				src := renderer.StaticSource(entries)
+				var lbuf, ibuf, sbuf bytes.Buffer
+				So(archive.Archive(archive.Manifest{
+					Desc:              tls.Desc,
+					Source:            &src,
+					LogWriter:         &lbuf,
+					IndexWriter:       &ibuf,
+					SearchIndexWriter: &sbuf,
+				}), ShouldBeNil)
+
+				base := gs.Path("gs://testbucket").Concat(string(path))
+				env.GSClient.Put(base.Concat("logstream.entries"), lbuf.Bytes())
+				env.GSClient.Put(base.Concat("logstream.index"), ibuf.Bytes())
+				env.GSClient.Put(base.Concat(archive.SearchIndexName), sbuf.Bytes())
+
+				now := env.Clock.Now().UTC()
+				tls.State.TerminalIndex = int64(len(entries) - 1)
+				tls.State.TerminatedTime = now
+				tls.State.ArchivedTime = now
+				tls.State.ArchiveStreamURL = string(base.Concat("logstream.entries"))
+				tls.State.ArchiveIndexURL = string(base.Concat("logstream.index"))
+			}
+
+			So(tls.Put(c), ShouldBeNil)
+			env.Clock.Add(time.Second)
+		}
+
+		addStream("testing/+/archived", logpb.StreamType_TEXT, true,
+			[]string{"starting build", "compile FAILED: foo.cc"},
+			[]string{"exit code 1"})
+		addStream("testing/+/binary", logpb.StreamType_BINARY, false)
+		addStream("testing/+/live", logpb.StreamType_TEXT, false,
+			[]string{"running tests"},
+			[]string{"test failed: bar", "test failed: baz"})
+		addStream("other/+/live", logpb.StreamType_TEXT, false,
+			[]string{"failed elsewhere"})
+		ds.GetTestable(c).CatchupIndexes()
+
+		req := logdog.SearchRequest{
+			Project: project,
+			Path:    "testing/**",
+			Pattern: "failed",
+		}
+
+		Convey(`Finds substrings in live and archived streams.`, func() {
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches,
+				"testing/+/live:1:0:test failed: bar",
+				"testing/+/live:1:1:test failed: baz")
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Can ignore case.`, func() {
+			req.IgnoreCase = true
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches,
+				"testing/+/live:1:0:test failed: bar",
+				"testing/+/live:1:1:test failed: baz",
+				"testing/+/archived:0:1:compile FAILED: foo.cc")
+		})
+
+		Convey(`Supports regular expressions.`, func() {
+			req.Regexp = true
+			req.Pattern = `^(exit|running) \w+`
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches,
+				"testing/+/live:0:0:running tests",
+				"testing/+/archived:1:0:exit code 1")
+		})
+
+		Convey(`Skips archived streams using their search index.`, func() {
+			// An empty Bloom filter claims that nothing is in the stream.
+			env.Services.SI = func(lst *coordinator.LogStreamState) (*logpb.SearchIndex, error) {
+				if !lst.ArchivalState().Archived() {
+					return nil, nil
+				}
+				return &logpb.SearchIndex{Bloom: make([]byte, 8), HashCount: 1}, nil
+			}
+			req.IgnoreCase = true
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches,
+				"testing/+/live:1:0:test failed: bar",
+				"testing/+/live:1:1:test failed: baz")
+		})
+
+		Convey(`Can page through results.`, func() {
+			req.IgnoreCase = true
+			req.MaxResults = 1
+
+			var all []string
+			for {
+				resp, err := svr.Search(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(len(resp.Matches), ShouldBeLessThanOrEqualTo, 1)
+				for _, m := range resp.Matches {
+					all = append(all, fmt.Sprintf("%s:%d:%d", m.Path, m.Index, m.Line))
+				}
+				if resp.Next == "" {
+					break
+				}
+				req.Next = resp.Next
+			}
+			So(all, ShouldResemble, []string{
+				"testing/+/live:1:0",
+				"testing/+/live:1:1",
+				"testing/+/archived:0:1",
+			})
+		})
+
+		Convey(`Will fail with InvalidArgument if the pattern is empty.`, func() {
+			req.Pattern = ""
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "`pattern` is required")
+		})
+
+		Convey(`Will fail with InvalidArgument if the regexp is invalid.`, func() {
+			req.Regexp = true
+			req.Pattern = "(unclosed"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid `pattern`")
+		})
+
+		Convey(`Will fail with InvalidArgument if next is invalid.`, func() {
+			req.Next = "garbage"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid `next` value")
+		})
+	})
+}
+
+func TestRequiredLiterals(t *testing.T) {
+	t.Parallel()
+
+	Convey(`requiredLiterals`, t, func() {
+		literals := func(expr string) []string {
+			re, err := syntax.Parse(expr, syntax.Perl)
+			So(err, ShouldBeNil)
+			return requiredLiterals(re.Simplify())
+		}
+
+		So(literals(`foo`), ShouldResemble, []string{"foo"})
+		So(literals(`foo\d+bar(baz)+`), ShouldResemble, []string{"foo", "bar", "baz"})
+		So(literals(`foo|bar`), ShouldHaveLength, 0)
+
+		// Runes whose case variants aren't all ASCII ("k" and the Kelvin sign,
+		// "ü" and "Ü") split case-insensitive literals.
+		So(literals(`(?i)take a break`), ShouldResemble, []string{"TA", "E A BREA"})
+		So(literals(`(?i)über`), ShouldResemble, []string{"BER"})
+	})
+}
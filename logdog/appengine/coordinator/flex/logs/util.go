@@ -22,10 +22,12 @@ import (
 
 func buildLogStreamState(ls *coordinator.LogStream, lst *coordinator.LogStreamState) *logdog.LogStreamState {
 	lss := logdog.LogStreamState{
-		ProtoVersion:  ls.ProtoVersion,
-		Created:       google.NewTimestamp(ls.Created),
-		TerminalIndex: lst.TerminalIndex,
-		Purged:        ls.Purged,
+		ProtoVersion:   ls.ProtoVersion,
+		Created:        google.NewTimestamp(ls.Created),
+		TerminalIndex:  lst.TerminalIndex,
+		Purged:         ls.Purged,
+		DroppedBytes:   lst.DroppedBytes,
+		DroppedEntries: lst.DroppedEntries,
 	}
 
 	if ast := lst.ArchivalState(); ast.Archived() {
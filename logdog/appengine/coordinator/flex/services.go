@@ -27,6 +27,7 @@ import (
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/router"
 
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
 	"go.chromium.org/luci/logdog/appengine/coordinator/config"
 	"go.chromium.org/luci/logdog/common/storage"
@@ -59,6 +60,12 @@ type Services interface {
 	//
 	// The caller must close the returned instance if successful.
 	StorageForStream(ctx context.Context, state *coordinator.LogStreamState, project string) (coordinator.SigningStorage, error)
+
+	// SearchIndexForStream returns the search index for the supplied log stream.
+	//
+	// If the stream has no search index (e.g., it is not archived yet), this
+	// returns nil.
+	SearchIndexForStream(ctx context.Context, state *coordinator.LogStreamState, project string) (*logpb.SearchIndex, error)
 }
 
 // GlobalServices is an application singleton that stores cross-request service
@@ -251,6 +258,23 @@ func (s *flexServicesInst) StorageForStream(c context.Context, lst *coordinator.
 	return rv, nil
 }
 
+func (s *flexServicesInst) SearchIndexForStream(c context.Context, lst *coordinator.LogStreamState, project string) (
+	*logpb.SearchIndex, error) {
+
+	if !lst.ArchivalState().Archived() || lst.ArchiveIndexURL == "" {
+		return nil, nil
+	}
+
+	gsClient, err := s.gsClientFactory(c, project)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create Google Storage client.")
+		return nil, err
+	}
+	defer gsClient.Close()
+
+	return archive.LoadSearchIndex(gsClient, archive.SearchIndexPath(gs.Path(lst.ArchiveIndexURL)))
+}
+
 // noSignedURLStorage is a thin wrapper around a Storage instance that cannot
 // sign URLs.
 type noSignedURLStorage struct {
@@ -327,8 +351,12 @@ func (si *googleStorage) GetSignedURLs(c context.Context, req *coordinator.URLSi
 		return url, nil
 	}
 
-	// Sign stream URL.
+	// Sign stream URL, noting its format so that the client knows how to
+	// read it.
 	if req.Stream {
+		if resp.StreamFormat, err = archive.Format(c, si.Storage); err != nil {
+			return nil, errors.Annotate(err, "").InternalReason("failed to get stream format").Err()
+		}
 		if resp.Stream, err = doSign(si.stream); err != nil {
 			return nil, errors.Annotate(err, "").InternalReason("failed to sign stream URL").Err()
 		}
@@ -95,6 +95,13 @@ type LogStreamState struct {
 	// If this is <0, the log stream is either still streaming or has been
 	// archived with no log entries.
 	TerminalIndex int64 `gae:",noindex"`
+	// DroppedBytes is the number of bytes of stream data that the Butler
+	// dropped because of its limits. It is reported when the stream is
+	// terminated.
+	DroppedBytes int64 `gae:",noindex"`
+	// DroppedEntries is the number of log entries that the Butler dropped
+	// because of its limits. It is reported when the stream is terminated.
+	DroppedEntries int64 `gae:",noindex"`
 
 	// ArchiveRetryCount is the number of times this stream has attempted
 	// archival.
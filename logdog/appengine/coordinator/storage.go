@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/common/storage"
 )
 
@@ -60,6 +61,8 @@ type URLSigningResponse struct {
 
 	// Stream is the signed URL for the log stream, if requested.
 	Stream string
+	// StreamFormat is the format of the log stream data at Stream.
+	StreamFormat logpb.LogIndex_Format
 	// Index is the signed URL for the log stream index, if requested.
 	Index string
 }
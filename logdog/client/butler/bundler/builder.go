@@ -96,6 +96,22 @@ func (b *builder) setStreamTerminal(template *logpb.ButlerLogBundle_Entry, tidx
 		(sizeOfTerminalIndexTag + varintLength(bs.TerminalIndex)))
 }
 
+// setStreamDropped records the amount of the stream's dropped data in its
+// terminal entry.
+func (b *builder) setStreamDropped(template *logpb.ButlerLogBundle_Entry, bytes, entries int64) {
+	bs := b.getCreateBuilderStream(template)
+	if bs.DroppedBytes != 0 || bs.DroppedEntries != 0 {
+		return
+	}
+
+	bs.DroppedBytes = bytes
+	bs.DroppedEntries = entries
+
+	// Pay the cost of the additional fields.
+	bs.size += ((sizeOfDroppedBytesTag + varintLength(uint64(bytes))) +
+		(sizeOfDroppedEntriesTag + varintLength(uint64(entries))))
+}
+
 func (b *builder) bundle() *logpb.ButlerLogBundle {
 	bundle := b.template
 
@@ -225,6 +225,19 @@ func TestBuilder(t *testing.T) {
 			So(b.hasContent(), ShouldBeTrue)
 		})
 
+		Convey(`Reports dropped data in the terminal entry.`, func() {
+			be, logs := parse("a:1")
+			b.add(be, logs[0])
+			b.setStreamTerminal(be, 1)
+			b.setStreamDropped(be, 4096, 300)
+
+			bundle := b.bundle()
+			So(bundle, shouldHaveBundleEntries, "+a:1")
+			So(bundle.Entries[0].DroppedBytes, ShouldEqual, 4096)
+			So(bundle.Entries[0].DroppedEntries, ShouldEqual, 300)
+			So(b.bundleSize(), ShouldEqual, protoSize(bundle))
+		})
+
 		for _, test := range []struct {
 			title string
 
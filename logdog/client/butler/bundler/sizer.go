@@ -26,10 +26,12 @@ import (
 )
 
 var (
-	sizeOfBundleEntryTag   int
-	sizeOfLogEntryTag      int
-	sizeOfTerminalTag      int
-	sizeOfTerminalIndexTag int
+	sizeOfBundleEntryTag    int
+	sizeOfLogEntryTag       int
+	sizeOfTerminalTag       int
+	sizeOfTerminalIndexTag  int
+	sizeOfDroppedBytesTag   int
+	sizeOfDroppedEntriesTag int
 
 	errMalformedProtobufField = errors.New("malformed protobuf field")
 )
@@ -47,6 +49,8 @@ func init() {
 	sizeOfLogEntryTag = mustCalculateTagSize(be, "Logs")
 	sizeOfTerminalTag = mustCalculateTagSize(be, "Terminal")
 	sizeOfTerminalIndexTag = mustCalculateTagSize(be, "TerminalIndex")
+	sizeOfDroppedBytesTag = mustCalculateTagSize(be, "DroppedBytes")
+	sizeOfDroppedEntriesTag = mustCalculateTagSize(be, "DroppedEntries")
 }
 
 func mustCalculateTagSize(i interface{}, field string) int {
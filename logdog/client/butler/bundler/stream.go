@@ -49,6 +49,11 @@ type Stream interface {
 	// an error. The supplied Data must not be referenced after calling Append.
 	Append(Data) error
 
+	// SetDropped records the amount of the stream's data that was dropped
+	// before reaching the Stream. It is reported in the stream's terminal
+	// bundle entry, so it must be called before Close.
+	SetDropped(bytes, entries int64)
+
 	// Close closes the Stream, flushing any remaining data.
 	Close()
 }
@@ -115,6 +120,13 @@ type streamImpl struct {
 	// stateLock must be held when accessing this field.
 	lastLogEntry *logpb.LogEntry
 
+	// droppedBytes and droppedEntries are the amount of data dropped before it
+	// reached the stream, see SetDropped.
+	//
+	// stateLock must be held when accessing these fields.
+	droppedBytes   int64
+	droppedEntries int64
+
 	// appendErr is the error that should be returned by Append. It is set when
 	// stream content processing hits a fatal state.
 	appendErr error
@@ -183,6 +195,12 @@ func (s *streamImpl) signalDataConsumed() {
 	}
 }
 
+func (s *streamImpl) SetDropped(bytes, entries int64) {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+	s.droppedBytes, s.droppedEntries = bytes, entries
+}
+
 func (s *streamImpl) Close() {
 	s.stateLock.Lock()
 	defer s.stateLock.Unlock()
@@ -290,6 +308,9 @@ func (s *streamImpl) nextBundleEntry(bb *builder, aggressive bool) bool {
 	if s.noMoreDataLocked() {
 		if s.lastLogEntry != nil {
 			bb.setStreamTerminal(&s.c.template, s.lastLogEntry.StreamIndex)
+			if s.droppedBytes > 0 || s.droppedEntries > 0 {
+				bb.setStreamDropped(&s.c.template, s.droppedBytes, s.droppedEntries)
+			}
 		}
 		s.setDrained()
 	}
@@ -33,6 +33,7 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butler/bundler"
 	"go.chromium.org/luci/logdog/client/butler/output"
+	"go.chromium.org/luci/logdog/client/butler/redact"
 	"go.chromium.org/luci/logdog/client/butler/streamserver"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
 	"go.chromium.org/luci/logdog/common/types"
@@ -65,6 +66,17 @@ type Config struct {
 	// be buffered before being marked for dispatch. If this is zero,
 	// DefaultMaxBufferAge will be used.
 	MaxBufferAge time.Duration
+
+	// Redactor, if not nil, removes secrets from TEXT stream data before it is
+	// bundled. Streams that pass through it are tagged with redact.FilterTag.
+	Redactor *redact.Redactor
+	// RedactDatagrams, if true, instructs the Butler to also apply Redactor to
+	// DATAGRAM streams.
+	RedactDatagrams bool
+
+	// Limits bounds the amount of data that the Butler accepts from its
+	// streams. Data exceeding them is dropped.
+	Limits Limits
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -119,6 +131,9 @@ type Butler struct {
 
 	streams *streamTracker
 
+	// limits is the state of the Config's Limits.
+	limits *prefixLimits
+
 	// shutdownMu is a mutex to protect shutdown parameters.
 	shutdownMu sync.Mutex
 	// isShutdown is true if the Butler been shut down.
@@ -147,6 +162,7 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		streamsFinishedC: make(chan struct{}),
 
 		streams: newStreamTracker(),
+		limits:  newPrefixLimits(&config.Limits),
 
 		activateC:         make(chan struct{}),
 		streamC:           make(chan *stream),
@@ -264,10 +280,23 @@ func (b *Butler) Wait() error {
 	log.Fields{
 		"stats": b.c.Output.Stats(),
 	}.Infof(b.ctx, "Message output has closed")
+	if st := b.Stats(); st.DroppedBytes > 0 {
+		log.Fields{
+			"droppedBytes":   st.DroppedBytes,
+			"droppedEntries": st.DroppedEntries,
+			"limitedStreams": st.LimitedStreams,
+		}.Warningf(b.ctx, "Stream data was dropped because of the Butler's limits.")
+	}
 	b.shutdown(nil)
 	return b.getRunErr()
 }
 
+// Stats returns statistics about the stream data that the Butler has dropped
+// because of its Limits.
+func (b *Butler) Stats() Stats {
+	return b.limits.Stats()
+}
+
 // Streams returns a sorted list of stream names that have been registered to
 // the Butler.
 func (b *Butler) Streams() []types.StreamName {
@@ -407,6 +436,38 @@ func (b *Butler) AddStream(rc io.ReadCloser, d *logpb.LogStreamDescriptor) error
 		}
 	}
 
+	if d.ContentType == types.ContentTypeJSONLines && d.StreamType != logpb.StreamType_TEXT {
+		return fmt.Errorf("content type %q requires a TEXT stream, not %s",
+			d.ContentType, d.StreamType)
+	}
+
+	// Redact the stream's data, if configured. This comes first, so that the
+	// stages below see, and account for, the data that will be sent.
+	var r io.Reader = rc
+	rr := b.redactReader(r, d.StreamType)
+	if rr != nil {
+		if d.Tags == nil {
+			d.Tags = make(map[string]string, 1)
+		}
+		d.Tags[redact.FilterTag] = redact.FilterTagValue
+		r = rr
+	}
+
+	// Validate structured-record streams' data. Redaction may break records, so
+	// they are validated after it.
+	var recr *recordReader
+	if d.ContentType == types.ContentTypeJSONLines {
+		recr = newRecordReader(r)
+		r = recr
+	}
+
+	// Drop the stream's data once it exceeds the limits, if configured.
+	var lr *limitReader
+	if b.c.Limits.limited() {
+		lr = newLimitReader(r, d.StreamType, d.ContentType, b.limits, clock.Get(b.ctx).Now)
+		r = lr
+	}
+
 	b.maybeAddStreamCallback(d)
 	if err := b.streams.RegisterStream(types.StreamName(d.Name)); err != nil {
 		logging.WithError(err).Errorf(b.ctx, "failed to register stream")
@@ -419,9 +480,13 @@ func (b *Butler) AddStream(rc io.ReadCloser, d *logpb.LogStreamDescriptor) error
 	s := stream{
 		log:  logging.Get(streamCtx),
 		now:  clock.Get(streamCtx).Now,
-		r:    rc,
+		r:    r,
 		c:    rc,
 		name: types.StreamName(d.Name),
+
+		records:  recr,
+		limited:  lr,
+		redacted: rr,
 	}
 
 	// Register this stream with our Bundler. It will take ownership of "d", so
@@ -436,6 +501,24 @@ func (b *Butler) AddStream(rc io.ReadCloser, d *logpb.LogStreamDescriptor) error
 	return nil
 }
 
+// redactReader returns a redact.Reader that redacts the data read from r, or
+// nil if streams of type t are not redacted.
+func (b *Butler) redactReader(r io.Reader, t logpb.StreamType) redact.Reader {
+	if b.c.Redactor == nil {
+		return nil
+	}
+
+	switch t {
+	case logpb.StreamType_TEXT:
+		return b.c.Redactor.TextReader(r)
+	case logpb.StreamType_DATAGRAM:
+		if b.c.RedactDatagrams {
+			return b.c.Redactor.DatagramReader(r)
+		}
+	}
+	return nil
+}
+
 func (b *Butler) runStreams(activateC chan struct{}) {
 	streamFinishedC := make(chan *stream)
 	streamC := b.streamC
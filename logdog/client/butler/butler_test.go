@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/butler/bootstrap"
 	"go.chromium.org/luci/logdog/client/butler/output"
+	"go.chromium.org/luci/logdog/client/butler/redact"
 	"go.chromium.org/luci/logdog/client/butler/streamserver"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
 	"go.chromium.org/luci/logdog/common/types"
@@ -44,7 +45,9 @@ type testOutput struct {
 	err      error
 	maxSize  int
 	streams  map[string][]*logpb.LogEntry
+	descs    map[string]*logpb.LogStreamDescriptor
 	terminal map[string]struct{}
+	dropped  map[string][2]int64
 
 	closed bool
 }
@@ -59,15 +62,21 @@ func (to *testOutput) SendBundle(b *logpb.ButlerLogBundle) error {
 
 	if to.streams == nil {
 		to.streams = map[string][]*logpb.LogEntry{}
+		to.descs = map[string]*logpb.LogStreamDescriptor{}
 		to.terminal = map[string]struct{}{}
+		to.dropped = map[string][2]int64{}
 	}
 	for _, be := range b.Entries {
 		name := string(be.Desc.Name)
 
 		to.streams[name] = append(to.streams[name], be.Logs...)
+		to.descs[name] = be.Desc
 		if be.TerminalIndex >= 0 {
 			to.terminal[name] = struct{}{}
 		}
+		if be.Terminal && (be.DroppedBytes > 0 || be.DroppedEntries > 0) {
+			to.dropped[name] = [2]int64{be.DroppedBytes, be.DroppedEntries}
+		}
 	}
 	return nil
 }
@@ -100,6 +109,13 @@ func (to *testOutput) logs(name string) []*logpb.LogEntry {
 	return to.streams[name]
 }
 
+func (to *testOutput) desc(name string) *logpb.LogStreamDescriptor {
+	to.Lock()
+	defer to.Unlock()
+
+	return to.descs[name]
+}
+
 func (to *testOutput) isTerminal(name string) bool {
 	to.Lock()
 	defer to.Unlock()
@@ -108,6 +124,15 @@ func (to *testOutput) isTerminal(name string) bool {
 	return isTerminal
 }
 
+// droppedData returns the number of dropped bytes and entries reported in the
+// terminal entry of the stream.
+func (to *testOutput) droppedData(name string) [2]int64 {
+	to.Lock()
+	defer to.Unlock()
+
+	return to.dropped[name]
+}
+
 func shouldHaveTextLogs(actual interface{}, expected ...interface{}) string {
 	exp := make([]string, len(expected))
 	for i, e := range expected {
@@ -453,6 +478,161 @@ func TestButler(t *testing.T) {
 				}
 			})
 
+			Convey(`Will redact secrets, even when split across reads.`, func() {
+				conf.Redactor, _ = redact.New(redact.Options{
+					Secrets:  []string{"hunter2"},
+					Patterns: []string{`tok-[a-z]+`},
+				})
+				b := mkb(c, conf)
+
+				s := newTestStream(nil)
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte("password: hun"), nil)
+				s.data([]byte("ter2\ntoken: tok-"), nil)
+				s.data([]byte("abc\nbye"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("test"), shouldHaveTextLogs, "password: [REDACTED]", "token: [REDACTED]", "bye")
+				So(to.desc("test").Tags, ShouldResemble, map[string]string{redact.FilterTag: redact.FilterTagValue})
+			})
+
+			Convey(`Will not redact DATAGRAM streams unless configured to.`, func() {
+				conf.Redactor, _ = redact.New(redact.Options{Secrets: []string{"hunter2"}})
+				b := mkb(c, conf)
+
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.StreamType = logpb.StreamType_DATAGRAM
+					d.ContentType = string(types.ContentTypeLogdogDatagram)
+				})
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte("\x07hunter2"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				logs := to.logs("test")
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].GetDatagram().Data, ShouldResemble, []byte("hunter2"))
+				So(to.desc("test").Tags, ShouldBeNil)
+			})
+
+			Convey(`Will validate structured-record streams.`, func() {
+				b := mkb(c, conf)
+
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.ContentType = types.ContentTypeJSONLines
+				})
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte(`{"message": "ok", "n"`), nil)
+				s.data([]byte(": 1}\n\nnot json\n{\"a\": 2}"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("test"), shouldHaveTextLogs,
+					`{"message": "ok", "n": 1}`,
+					`{"logdog_invalid":true,"message":"not json"}`,
+					`{"a": 2}`)
+			})
+
+			Convey(`Will validate structured records after redacting them.`, func() {
+				conf.Redactor, _ = redact.New(redact.Options{Patterns: []string{`"token": "[^"]*"`}})
+				b := mkb(c, conf)
+
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.ContentType = types.ContentTypeJSONLines
+				})
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte(`{"token": "hunter2"}`+"\n"+`{"n": 1}`), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("test"), shouldHaveTextLogs,
+					`{"logdog_invalid":true,"message":"{[REDACTED]}"}`,
+					`{"n": 1}`)
+			})
+
+			Convey(`Will reject structured-record streams that aren't TEXT.`, func() {
+				b := mkb(c, conf)
+
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.StreamType = logpb.StreamType_BINARY
+					d.ContentType = types.ContentTypeJSONLines
+				})
+				So(b.AddStream(s, s.desc), ShouldErrLike, "requires a TEXT stream")
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+			})
+
+			Convey(`Will truncate a stream exceeding its byte limit.`, func() {
+				conf.Limits.StreamBytes = 8
+				b := mkb(c, conf)
+
+				s := newTestStream(nil)
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte("abc\nde"), nil)
+				s.data([]byte("f\nghi\njkl\n"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("test"), shouldHaveTextLogs,
+					"abc",
+					"def",
+					"[logdog: the stream exceeded its byte limit; dropping the rest of it]",
+					"[logdog: 8 bytes (2 entries) of this stream were dropped]")
+				So(b.Stats(), ShouldResemble, Stats{DroppedBytes: 8, DroppedEntries: 2, LimitedStreams: 1})
+				So(to.droppedData("test"), ShouldResemble, [2]int64{8, 2})
+			})
+
+			Convey(`Will drop entries exceeding the prefix entry rate limit.`, func() {
+				conf.Limits.PrefixEntryRate = 1
+				conf.Limits.EntryBurst = 2
+				b := mkb(c, conf)
+
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.ContentType = types.ContentTypeJSONLines
+				})
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte("{\"n\": 1}\n{\"n\": 2}\n{\"n\": 3}\n{\"n\": 4}\n"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				So(to.logs("test"), shouldHaveTextLogs,
+					`{"n": 1}`,
+					`{"n": 2}`,
+					`{"message":"logdog: the stream exceeded its entry rate limit; dropping entries","severity":"WARNING"}`,
+					`{"message":"logdog: 18 bytes (2 entries) of this stream were dropped","severity":"WARNING"}`)
+				So(b.Stats(), ShouldResemble, Stats{DroppedBytes: 18, DroppedEntries: 2, LimitedStreams: 1})
+				So(to.droppedData("test"), ShouldResemble, [2]int64{18, 2})
+			})
+
+			Convey(`Will drop whole datagrams without markers.`, func() {
+				conf.Limits.StreamBytes = 6
+				b := mkb(c, conf)
+
+				s := newTestStream(func(d *logpb.LogStreamDescriptor) {
+					d.StreamType = logpb.StreamType_DATAGRAM
+					d.ContentType = string(types.ContentTypeLogdogDatagram)
+				})
+				So(b.AddStream(s, s.desc), ShouldBeNil)
+				s.data([]byte("\x03abc\x03def"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldBeNil)
+
+				logs := to.logs("test")
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].GetDatagram().Data, ShouldResemble, []byte("abc"))
+				So(b.Stats(), ShouldResemble, Stats{DroppedBytes: 4, DroppedEntries: 1, LimitedStreams: 1})
+				So(to.droppedData("test"), ShouldResemble, [2]int64{4, 1})
+			})
+
 			Convey(`Shutdown with 256 in-progress streams, stream{0..256} will terminate if they emitted logs.`, func() {
 				b := mkb(c, conf)
 				streams := make([]*testStream, 256)
@@ -0,0 +1,412 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// Limits bounds the amount of data that the Butler accepts from its streams.
+//
+// Data that exceeds a limit is dropped, rather than blocking the process
+// writing the stream. Once a stream exceeds a byte limit, all of its further
+// data is dropped. Entries that exceed an entry rate limit are dropped until
+// the stream's rate falls back under the limit.
+//
+// TEXT streams get a marker line wherever data was dropped, and a final line
+// totalling the dropped data. Entries are lines for TEXT streams and datagrams
+// for DATAGRAM streams. BINARY streams have no entries, so only byte limits
+// apply to them, and no markers are added to them or to DATAGRAM streams.
+// For all stream types, the amount of dropped data is reported in the stream's
+// terminal state, see LogStreamState.dropped_bytes in the logs API.
+//
+// A zero value means that the corresponding quantity isn't limited.
+type Limits struct {
+	// StreamBytes is the maximum number of bytes that a single stream may emit.
+	StreamBytes int64
+	// PrefixBytes is the maximum number of bytes that all of the Butler's
+	// streams may emit combined.
+	PrefixBytes int64
+
+	// StreamEntryRate is the maximum sustained rate, in entries per second, at
+	// which a single stream may emit entries.
+	StreamEntryRate float64
+	// PrefixEntryRate is the maximum sustained rate, in entries per second, at
+	// which all of the Butler's streams may emit entries combined.
+	PrefixEntryRate float64
+	// EntryBurst is the number of entries that may be emitted at once in excess
+	// of an entry rate limit. If zero, it is one second's worth of entries.
+	EntryBurst int
+}
+
+// limited returns true if any limits are set.
+func (l *Limits) limited() bool {
+	return l.StreamBytes > 0 || l.PrefixBytes > 0 || l.StreamEntryRate > 0 || l.PrefixEntryRate > 0
+}
+
+// newLimiter returns a rate.Limiter for entryRate, or nil if entryRate is not
+// limited.
+func (l *Limits) newLimiter(entryRate float64) *rate.Limiter {
+	if entryRate <= 0 {
+		return nil
+	}
+	burst := l.EntryBurst
+	if burst <= 0 {
+		burst = int(math.Ceil(entryRate))
+	}
+	return rate.NewLimiter(rate.Limit(entryRate), burst)
+}
+
+// Stats are statistics about the stream data that the Butler has dropped
+// because of its Limits.
+type Stats struct {
+	// DroppedBytes is the number of bytes of stream data that were dropped.
+	DroppedBytes int64
+	// DroppedEntries is the number of entries that were dropped.
+	DroppedEntries int64
+	// LimitedStreams is the number of streams that had data dropped.
+	LimitedStreams int64
+}
+
+// prefixLimits is the state of the Limits that are shared by all of a Butler's
+// streams. It is goroutine-safe.
+type prefixLimits struct {
+	*Limits
+
+	// bytes is the number of bytes accepted from all streams.
+	bytes int64
+	// entries limits the rate of entries accepted from all streams. It is nil
+	// if the rate isn't limited.
+	entries *rate.Limiter
+
+	stats Stats
+}
+
+func newPrefixLimits(l *Limits) *prefixLimits {
+	return &prefixLimits{
+		Limits:  l,
+		entries: l.newLimiter(l.PrefixEntryRate),
+	}
+}
+
+// hasBytes returns true if n more bytes fit in the prefix byte limit. It
+// doesn't reserve them, see takeBytes.
+func (p *prefixLimits) hasBytes(n int64) bool {
+	return p.PrefixBytes <= 0 || atomic.LoadInt64(&p.bytes)+n <= p.PrefixBytes
+}
+
+// takeBytes reserves n bytes of the prefix byte limit. It returns false if the
+// limit would be exceeded.
+func (p *prefixLimits) takeBytes(n int64) bool {
+	if p.PrefixBytes <= 0 {
+		return true
+	}
+	for {
+		cur := atomic.LoadInt64(&p.bytes)
+		if cur+n > p.PrefixBytes {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&p.bytes, cur, cur+n) {
+			return true
+		}
+	}
+}
+
+// Stats returns the current statistics.
+func (p *prefixLimits) Stats() Stats {
+	return Stats{
+		DroppedBytes:   atomic.LoadInt64(&p.stats.DroppedBytes),
+		DroppedEntries: atomic.LoadInt64(&p.stats.DroppedEntries),
+		LimitedStreams: atomic.LoadInt64(&p.stats.LimitedStreams),
+	}
+}
+
+// limitReader is an io.Reader that drops the data read from a stream once it
+// exceeds the stream's or prefix's Limits.
+type limitReader struct {
+	prefix *prefixLimits
+	now    func() time.Time
+	// records is true if the stream is a structured-record stream, whose
+	// markers must be records.
+	records bool
+
+	// next returns the next entry of stream data, with its framing, or an
+	// error.
+	next func() ([]byte, error)
+	// markers is true if markers should be added to the stream.
+	markers bool
+
+	// bytes is the number of bytes accepted from the stream.
+	bytes int64
+	// entries limits the rate of entries accepted from the stream. It is nil if
+	// the rate isn't limited.
+	entries *rate.Limiter
+	// truncated is true once the stream has exceeded a byte limit.
+	truncated bool
+	// dropping is true while entries are being dropped because of an entry
+	// rate limit.
+	dropping bool
+
+	// midLine is true if the last accepted entry was a TEXT line without a
+	// terminator.
+	midLine bool
+
+	// out is accepted data waiting to be returned.
+	out []byte
+	// err is the error returned by next, if any.
+	err error
+
+	droppedBytes   int64 // atomic
+	droppedEntries int64 // atomic
+}
+
+func newLimitReader(src io.Reader, t logpb.StreamType, contentType string, p *prefixLimits, now func() time.Time) *limitReader {
+	r := &limitReader{
+		prefix:  p,
+		now:     now,
+		records: contentType == types.ContentTypeJSONLines,
+		entries: p.newLimiter(p.StreamEntryRate),
+	}
+
+	switch t {
+	case logpb.StreamType_TEXT:
+		r.next = lineSplitter(src)
+		r.markers = true
+
+	case logpb.StreamType_DATAGRAM:
+		rio := recordio.NewReader(bufio.NewReader(src), int64(types.MaxDatagramSize))
+		r.next = func() ([]byte, error) {
+			frame, err := rio.ReadFrameAll()
+			if err != nil {
+				return nil, err
+			}
+			var buf bytes.Buffer
+			if _, err := recordio.WriteFrame(&buf, frame); err != nil {
+				return nil, err
+			}
+			return buf.Bytes(), nil
+		}
+
+	default:
+		// BINARY streams have no entries.
+		r.entries = nil
+		var err error
+		r.next = func() ([]byte, error) {
+			for err == nil {
+				var chunk [limitsReadSize]byte
+				var n int
+				if n, err = src.Read(chunk[:]); n > 0 {
+					return chunk[:n], nil
+				}
+			}
+			return nil, err
+		}
+	}
+	return r
+}
+
+// limitsReadSize is the size of the reads issued against a limited stream's
+// source.
+const limitsReadSize = 4096
+
+// lineSplitter returns a function that returns successive lines read from src,
+// with their terminators. Lines longer than types.MaxLogEntryDataSize are
+// returned in pieces of that size.
+func lineSplitter(src io.Reader) func() ([]byte, error) {
+	var buf []byte
+	var err error
+	return func() ([]byte, error) {
+		for {
+			end := bytes.IndexByte(buf, '\n') + 1
+			switch {
+			case end > 0:
+			case len(buf) >= types.MaxLogEntryDataSize:
+				end = types.MaxLogEntryDataSize
+			case err != nil && len(buf) > 0:
+				end = len(buf)
+			case err != nil:
+				return nil, err
+			default:
+				var chunk [limitsReadSize]byte
+				var n int
+				n, err = src.Read(chunk[:])
+				buf = append(buf, chunk[:n]...)
+				continue
+			}
+
+			line := buf[:end:end]
+			buf = buf[end:]
+			return line, nil
+		}
+	}
+}
+
+func (r *limitReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.fill()
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// fill reads the next entry and adds it to out if it is within the limits.
+func (r *limitReader) fill() {
+	entry, err := r.next()
+	if err != nil {
+		r.err = err
+		if r.droppedBytes > 0 {
+			r.mark(fmt.Sprintf("%d bytes (%d entries) of this stream were dropped", r.droppedBytes, r.droppedEntries))
+		}
+		return
+	}
+
+	// Both limits are checked before either is spent on the entry, so that a
+	// dropped entry uses up neither the byte budget nor the entry rate.
+	n := int64(len(entry))
+	if r.truncated {
+		r.drop(n)
+		return
+	}
+	if !r.hasBytes(n) {
+		r.truncate(n)
+		return
+	}
+
+	cancel, ok := r.reserveEntry()
+	switch {
+	case !ok:
+		r.drop(n)
+		if !r.dropping {
+			r.dropping = true
+			r.mark("the stream exceeded its entry rate limit; dropping entries")
+		}
+
+	case !r.prefix.takeBytes(n):
+		// Another stream took the remaining prefix bytes since hasBytes.
+		cancel()
+		r.truncate(n)
+
+	default:
+		r.dropping = false
+		r.bytes += n
+		r.out = append(r.out, entry...)
+		r.midLine = entry[len(entry)-1] != '\n'
+	}
+}
+
+// hasBytes returns true if an entry of n bytes fits in the byte limits.
+func (r *limitReader) hasBytes(n int64) bool {
+	if r.prefix.StreamBytes > 0 && r.bytes+n > r.prefix.StreamBytes {
+		return false
+	}
+	return r.prefix.hasBytes(n)
+}
+
+// truncate drops an entry of n bytes that exceeded a byte limit, and all of
+// the stream's further data.
+func (r *limitReader) truncate(n int64) {
+	r.truncated = true
+	r.drop(n)
+	r.mark("the stream exceeded its byte limit; dropping the rest of it")
+}
+
+// reserveEntry reserves an entry under the entry rate limits. If the entry may
+// not be accepted now, it returns false and reserves nothing. Otherwise it
+// returns a function that cancels the reservation.
+func (r *limitReader) reserveEntry() (cancel func(), ok bool) {
+	now := r.now()
+	var reserved []*rate.Reservation
+	cancel = func() {
+		for _, res := range reserved {
+			res.CancelAt(now)
+		}
+	}
+
+	for _, l := range []*rate.Limiter{r.entries, r.prefix.entries} {
+		if l == nil {
+			continue
+		}
+		res := l.ReserveN(now, 1)
+		reserved = append(reserved, res)
+		if !res.OK() || res.DelayFrom(now) > 0 {
+			cancel()
+			return nil, false
+		}
+	}
+	return cancel, true
+}
+
+// drop records that an entry of n bytes was dropped.
+func (r *limitReader) drop(n int64) {
+	if atomic.AddInt64(&r.droppedBytes, n) == n {
+		atomic.AddInt64(&r.prefix.stats.LimitedStreams, 1)
+	}
+	atomic.AddInt64(&r.droppedEntries, 1)
+	atomic.AddInt64(&r.prefix.stats.DroppedBytes, n)
+	atomic.AddInt64(&r.prefix.stats.DroppedEntries, 1)
+}
+
+// mark adds a marker line with the supplied message to the stream, if the
+// stream takes markers.
+func (r *limitReader) mark(msg string) {
+	if !r.markers {
+		return
+	}
+
+	// The marker must start its own line.
+	if r.midLine {
+		r.out = append(r.out, '\n')
+		r.midLine = false
+	}
+	if r.records {
+		d, err := json.Marshal(map[string]string{
+			"severity": "WARNING",
+			"message":  "logdog: " + msg,
+		})
+		if err != nil {
+			panic(err)
+		}
+		r.out = append(r.out, d...)
+		r.out = append(r.out, '\n')
+		return
+	}
+	r.out = append(r.out, fmt.Sprintf("[logdog: %s]\n", msg)...)
+}
+
+// Dropped returns the number of bytes and entries that have been dropped so
+// far.
+//
+// It is safe to call Dropped concurrently with Read.
+func (r *limitReader) Dropped() (bytes, entries int64) {
+	return atomic.LoadInt64(&r.droppedBytes), atomic.LoadInt64(&r.droppedEntries)
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLimitReader(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A limitReader`, t, func() {
+		now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		newReader := func(p *prefixLimits, data string) *limitReader {
+			return newLimitReader(strings.NewReader(data), logpb.StreamType_TEXT, "", p, func() time.Time { return now })
+		}
+
+		Convey(`Doesn't spend the prefix byte limit on rate-limited entries.`, func() {
+			p := newPrefixLimits(&Limits{PrefixBytes: 4, PrefixEntryRate: 1, EntryBurst: 1})
+
+			r := newReader(p, "a\nb\n")
+			r.fill()
+			r.fill()
+			So(string(r.out), ShouldStartWith, "a\n[logdog: the stream exceeded its entry rate limit")
+
+			now = now.Add(time.Second)
+			r = newReader(p, "c\n")
+			r.fill()
+			So(string(r.out), ShouldEqual, "c\n")
+			So(p.bytes, ShouldEqual, 4)
+		})
+
+		Convey(`Doesn't spend the stream entry rate when the prefix rate denies an entry.`, func() {
+			p := newPrefixLimits(&Limits{StreamEntryRate: 0.1, PrefixEntryRate: 1, EntryBurst: 1})
+
+			other := newReader(p, "a\n")
+			other.fill()
+			So(string(other.out), ShouldEqual, "a\n")
+
+			r := newReader(p, "b\nc\n")
+			r.fill()
+			So(string(r.out), ShouldStartWith, "[logdog: the stream exceeded its entry rate limit")
+
+			now = now.Add(time.Second)
+			r.out = nil
+			r.fill()
+			So(string(r.out), ShouldEqual, "c\n")
+		})
+
+		Convey(`Doesn't spend entry rates on entries exceeding a byte limit.`, func() {
+			p := newPrefixLimits(&Limits{StreamBytes: 2, PrefixEntryRate: 1, EntryBurst: 1})
+
+			r := newReader(p, "abc\n")
+			r.fill()
+			So(string(r.out), ShouldStartWith, "[logdog: the stream exceeded its byte limit")
+			bytes, entries := r.Dropped()
+			So(bytes, ShouldEqual, 4)
+			So(entries, ShouldEqual, 1)
+
+			r = newReader(p, "d\n")
+			r.fill()
+			So(string(r.out), ShouldEqual, "d\n")
+		})
+	})
+}
@@ -33,8 +33,9 @@ type Options struct {
 	//
 	// All stream metadata will be written to 'path/of/stream/.meta.name' as JSON.
 	//
-	// Datagram streams will be written as 'path/of/stream/.N.name' where N is the
-	// index of the datagram in the stream (i.e. 0 is the first datagram, etc.)
+	// Datagram streams will be written as 'path/of/stream/_N.name' where N is the
+	// zero-padded index of the datagram in the stream (i.e. 00000 is the first
+	// datagram, etc.)
 	Path string
 }
 
@@ -43,7 +44,7 @@ func (opt Options) New(c context.Context) output.Output {
 	o := dirOutput{
 		Context: c,
 		Options: &opt,
-		streams: map[types.StreamPath]*stream{},
+		streams: map[types.StreamPath]*Stream{},
 	}
 	return &o
 }
@@ -60,7 +61,7 @@ type dirOutput struct {
 	sync.Mutex
 
 	// streams is a map of stream name to stream handler.
-	streams map[types.StreamPath]*stream
+	streams map[types.StreamPath]*Stream
 }
 
 func (o *dirOutput) SendBundle(b *logpb.ButlerLogBundle) error {
@@ -77,7 +78,7 @@ func (o *dirOutput) SendBundle(b *logpb.ButlerLogBundle) error {
 		s, ok := o.streams[path]
 		if !ok {
 			var err error
-			s, err = newStream(o.Path, desc)
+			s, err = NewStream(o.Path, desc)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,108 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directory
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A directory Output`, t, func() {
+		dir, err := ioutil.TempDir("", "directory_output_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		o := Options{Path: dir}.New(context.Background())
+
+		read := func(rel string) string {
+			d, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+			So(err, ShouldBeNil)
+			return string(d)
+		}
+
+		text := func(lines ...string) *logpb.LogEntry {
+			t := &logpb.Text{}
+			for _, l := range lines {
+				t.Lines = append(t.Lines, &logpb.Text_Line{Value: []byte(l), Delimiter: "\r\n"})
+			}
+			return &logpb.LogEntry{Content: &logpb.LogEntry_Text{Text: t}}
+		}
+		datagram := func(data string, last bool) *logpb.LogEntry {
+			return &logpb.LogEntry{Content: &logpb.LogEntry_Datagram{Datagram: &logpb.Datagram{
+				Data:    []byte(data),
+				Partial: &logpb.Datagram_Partial{Last: last},
+			}}}
+		}
+
+		Convey(`Writes streams and their metadata.`, func() {
+			So(o.SendBundle(&logpb.ButlerLogBundle{
+				Entries: []*logpb.ButlerLogBundle_Entry{
+					{
+						Desc: &logpb.LogStreamDescriptor{
+							Prefix:      "pre",
+							Name:        "a/stdout",
+							StreamType:  logpb.StreamType_TEXT,
+							ContentType: "text/plain",
+						},
+						Logs: []*logpb.LogEntry{text("hello", "world")},
+					},
+					{
+						Desc: &logpb.LogStreamDescriptor{
+							Prefix:      "pre",
+							Name:        "a/dg",
+							StreamType:  logpb.StreamType_DATAGRAM,
+							ContentType: "application/x-logdog-datagram",
+						},
+						Logs:     []*logpb.LogEntry{datagram("fir", false), datagram("st", true), datagram("second", true)},
+						Terminal: true,
+					},
+				},
+			}), ShouldBeNil)
+			o.Close()
+
+			So(read("a/stdout"), ShouldEqual, "hello\nworld\n")
+			So(read("a/_00000.dg"), ShouldEqual, "first")
+			So(read("a/_00001.dg"), ShouldEqual, "second")
+			So(read("a/.meta.stdout"), ShouldContainSubstring, `"name": "a/stdout"`)
+			So(read("a/.meta.dg"), ShouldContainSubstring, `"stream_type": "DATAGRAM"`)
+		})
+
+		Convey(`Stream writes the same layout.`, func() {
+			s, err := NewStream(dir, &logpb.LogStreamDescriptor{
+				Prefix:     "pre",
+				Name:       "b/stdout",
+				StreamType: logpb.StreamType_TEXT,
+			})
+			So(err, ShouldBeNil)
+			So(s.WriteLogEntry(text("hello")), ShouldBeNil)
+			So(s.WriteLogEntry(text("world")), ShouldBeNil)
+			s.Close()
+			o.Close()
+
+			So(read("b/stdout"), ShouldEqual, "hello\nworld\n")
+			So(read("b/.meta.stdout"), ShouldContainSubstring, `"name": "b/stdout"`)
+		})
+	})
+}
@@ -25,8 +25,12 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 )
 
-// stream is the stateful output for a single log stream.
-type stream struct {
+// Stream writes a single log stream to disk, in the same layout as the
+// directory Output (see Options.Path).
+//
+// It can be used to write log streams that have been fetched from LogDog, so
+// that they can be compared with the output of a local Butler.
+type Stream struct {
 	curFile *os.File // nil if no file open
 
 	basePath      string
@@ -35,7 +39,10 @@ type stream struct {
 	datagramCount int
 }
 
-func newStream(basePath string, desc *logpb.LogStreamDescriptor) (*stream, error) {
+// NewStream creates the metadata file for the log stream described by desc
+// under basePath, and returns a Stream that writes the stream's data next to
+// it.
+func NewStream(basePath string, desc *logpb.LogStreamDescriptor) (*Stream, error) {
 	relPath := filepath.Clean(desc.Name)
 	dir, fname := filepath.Split(relPath)
 	basePath = filepath.Join(basePath, dir)
@@ -54,7 +61,7 @@ func newStream(basePath string, desc *logpb.LogStreamDescriptor) (*stream, error
 		return nil, errors.Annotate(err, "writing meta file for %s", relPath).Err()
 	}
 
-	ret := stream{basePath: basePath, fname: fname}
+	ret := Stream{basePath: basePath, fname: fname}
 	if desc.StreamType == logpb.StreamType_DATAGRAM {
 		ret.isDatagram = true
 	} else {
@@ -63,7 +70,7 @@ func newStream(basePath string, desc *logpb.LogStreamDescriptor) (*stream, error
 	return &ret, err
 }
 
-func (s *stream) getCurFile() (*os.File, error) {
+func (s *Stream) getCurFile() (*os.File, error) {
 	if s.curFile != nil {
 		return s.curFile, nil
 	}
@@ -85,7 +92,7 @@ func (s *stream) getCurFile() (*os.File, error) {
 	return s.curFile, nil
 }
 
-func (s *stream) closeCurFile() {
+func (s *Stream) closeCurFile() {
 	if s.curFile != nil {
 		s.curFile.Close()
 		s.curFile = nil
@@ -95,34 +102,9 @@ func (s *stream) closeCurFile() {
 // ingestBundleEntry writes the data from `be` to disk
 //
 // Returns closed == true if `be` was terminal and the stream can be closed now.
-func (s *stream) ingestBundleEntry(be *logpb.ButlerLogBundle_Entry) (closed bool, err error) {
+func (s *Stream) ingestBundleEntry(be *logpb.ButlerLogBundle_Entry) (closed bool, err error) {
 	for _, le := range be.GetLogs() {
-		curFile, err := s.getCurFile()
-		if err != nil {
-			return false, err
-		}
-
-		switch x := le.Content.(type) {
-		case *logpb.LogEntry_Datagram:
-			dg := x.Datagram
-			_, err = s.curFile.Write(dg.Data)
-			if err == nil {
-				if dg.Partial == nil || dg.Partial.Last {
-					s.closeCurFile()
-				}
-			}
-		case *logpb.LogEntry_Text:
-			for _, line := range x.Text.Lines {
-				_, err = curFile.Write(line.Value)
-				if err == nil {
-					_, err = curFile.WriteString("\n")
-				}
-			}
-		case *logpb.LogEntry_Binary:
-			_, err = curFile.Write(x.Binary.Data)
-		}
-
-		if err != nil {
+		if err := s.WriteLogEntry(le); err != nil {
 			return false, err
 		}
 	}
@@ -133,6 +115,38 @@ func (s *stream) ingestBundleEntry(be *logpb.ButlerLogBundle_Entry) (closed bool
 	return false, nil
 }
 
-func (s *stream) Close() {
+// WriteLogEntry writes the data from `le` to disk.
+//
+// Log entries must be written in order.
+func (s *Stream) WriteLogEntry(le *logpb.LogEntry) error {
+	curFile, err := s.getCurFile()
+	if err != nil {
+		return err
+	}
+
+	switch x := le.Content.(type) {
+	case *logpb.LogEntry_Datagram:
+		dg := x.Datagram
+		_, err = curFile.Write(dg.Data)
+		if err == nil {
+			if dg.Partial == nil || dg.Partial.Last {
+				s.closeCurFile()
+			}
+		}
+	case *logpb.LogEntry_Text:
+		for _, line := range x.Text.Lines {
+			_, err = curFile.Write(line.Value)
+			if err == nil {
+				_, err = curFile.WriteString("\n")
+			}
+		}
+	case *logpb.LogEntry_Binary:
+		_, err = curFile.Write(x.Binary.Data)
+	}
+	return err
+}
+
+// Close closes the stream's open file, if any.
+func (s *Stream) Close() {
 	s.closeCurFile()
 }
@@ -0,0 +1,117 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package butler
+
+import (
+	"bytes"
+	"io"
+	"sync/atomic"
+
+	"go.chromium.org/luci/logdog/common/jsonlines"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// recordsReadSize is the size of the reads issued against a structured-record
+// stream's source.
+const recordsReadSize = 4096
+
+// maxRecordSize is the largest record that a recordReader will buffer. Longer
+// lines are split into pieces of this size, each of which is invalid.
+var maxRecordSize = types.MaxLogEntryDataSize
+
+// recordReader is an io.Reader that validates the lines of a structured-record
+// stream (see jsonlines).
+//
+// Valid records are passed through verbatim. Blank lines are dropped, and any
+// other line that isn't a JSON object is replaced by a record wrapping its
+// text (see jsonlines.Wrap).
+type recordReader struct {
+	src io.Reader
+
+	// buf is data read from src that hasn't been validated yet.
+	buf []byte
+	// out is validated data waiting to be returned.
+	out []byte
+	// err is the error returned by src, if any.
+	err error
+
+	invalid int64
+}
+
+func newRecordReader(src io.Reader) *recordReader {
+	return &recordReader{src: src}
+}
+
+func (r *recordReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.fill()
+	}
+
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// Invalid returns the number of invalid lines that have been replaced so far.
+//
+// It is safe to call Invalid concurrently with Read.
+func (r *recordReader) Invalid() int64 { return atomic.LoadInt64(&r.invalid) }
+
+// fill reads from src and validates any complete lines into out.
+func (r *recordReader) fill() {
+	var chunk [recordsReadSize]byte
+	n, err := r.src.Read(chunk[:])
+	r.buf = append(r.buf, chunk[:n]...)
+	r.err = err
+
+	for len(r.buf) > 0 {
+		end := bytes.IndexByte(r.buf, '\n') + 1
+		switch {
+		case end > 0:
+		case r.err != nil:
+			end = len(r.buf)
+		case len(r.buf) >= maxRecordSize:
+			end = maxRecordSize
+		default:
+			// Wait for the rest of the line.
+			return
+		}
+
+		line := r.buf[:end]
+		r.buf = r.buf[end:]
+
+		text := bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(bytes.TrimSpace(text)) == 0:
+			// Drop blank lines.
+		case jsonlines.Valid(text):
+			r.out = append(r.out, line...)
+		default:
+			// Always end the replacement record, since text may be a piece of
+			// an overlong line.
+			r.out = append(r.out, jsonlines.Wrap(text)...)
+			if eol := line[len(text):]; len(eol) > 0 {
+				r.out = append(r.out, eol...)
+			} else {
+				r.out = append(r.out, '\n')
+			}
+			atomic.AddInt64(&r.invalid, 1)
+		}
+	}
+	r.buf = nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync/atomic"
+
+	"go.chromium.org/luci/common/data/recordio"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// readSize is the size of the reads issued against a text stream's source.
+const readSize = 4096
+
+// maxLineSize is the largest line that a text Reader will buffer. Longer lines
+// are redacted in pieces of at most this size.
+var maxLineSize = types.MaxLogEntryDataSize
+
+// Reader is an io.Reader that returns redacted stream data.
+type Reader interface {
+	io.Reader
+
+	// Redacted returns the number of regions that have been redacted so far.
+	//
+	// It is safe to call Redacted concurrently with Read.
+	Redacted() int64
+}
+
+// TextReader returns a Reader that redacts the TEXT stream data read from src.
+//
+// Data is redacted a line at a time, so secrets that are split across reads
+// from src are still redacted. Both "\n" and "\r" end a line. As a
+// consequence, a partial line is not returned until it has been completed or
+// src has been exhausted.
+//
+// Lines longer than maxLineSize are redacted in pieces, cut so that no secret
+// is split between pieces. Pattern matches that would span pieces may be
+// missed.
+func (r *Redactor) TextReader(src io.Reader) Reader {
+	return &textReader{
+		src: src,
+		rd:  r,
+	}
+}
+
+type textReader struct {
+	src io.Reader
+	rd  *Redactor
+
+	// buf is data read from src that hasn't been redacted yet.
+	buf []byte
+	// out is redacted data waiting to be returned.
+	out []byte
+	// err is the error returned by src, if any.
+	err error
+
+	redacted int64
+}
+
+func (t *textReader) Read(p []byte) (int, error) {
+	for len(t.out) == 0 {
+		if t.err != nil {
+			return 0, t.err
+		}
+		t.fill()
+	}
+
+	n := copy(p, t.out)
+	t.out = t.out[n:]
+	return n, nil
+}
+
+func (t *textReader) Redacted() int64 { return atomic.LoadInt64(&t.redacted) }
+
+// fill reads from src and redacts any complete lines into out.
+func (t *textReader) fill() {
+	var chunk [readSize]byte
+	n, err := t.src.Read(chunk[:])
+	t.buf = append(t.buf, chunk[:n]...)
+	t.err = err
+
+	for len(t.buf) > 0 {
+		end := bytes.IndexAny(t.buf, "\r\n") + 1
+		switch {
+		case end > 0:
+		case t.err != nil:
+			end = len(t.buf)
+		case len(t.buf) >= maxLineSize:
+			end = t.rd.cut(t.buf[:maxLineSize])
+		default:
+			// Wait for the rest of the line.
+			return
+		}
+
+		line, count := t.rd.Redact(t.buf[:end])
+		t.out = append(t.out, line...)
+		t.buf = t.buf[end:]
+		atomic.AddInt64(&t.redacted, int64(count))
+	}
+	t.buf = nil
+}
+
+// DatagramReader returns a Reader that redacts the DATAGRAM stream data read
+// from src.
+//
+// Each datagram is redacted in its entirety and re-framed, so redaction may
+// change its size. Datagrams holding binary data may be corrupted by
+// redaction.
+func (r *Redactor) DatagramReader(src io.Reader) Reader {
+	return &datagramReader{
+		rio: recordio.NewReader(bufio.NewReader(src), int64(types.MaxDatagramSize)),
+		rd:  r,
+	}
+}
+
+type datagramReader struct {
+	rio recordio.Reader
+	rd  *Redactor
+
+	// out is redacted, framed data waiting to be returned.
+	out bytes.Buffer
+	// err is the error encountered reading datagrams, if any.
+	err error
+
+	redacted int64
+}
+
+func (d *datagramReader) Read(p []byte) (int, error) {
+	for d.out.Len() == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+	return d.out.Read(p)
+}
+
+func (d *datagramReader) Redacted() int64 { return atomic.LoadInt64(&d.redacted) }
+
+// fill reads the next datagram and writes its redacted frame into out.
+func (d *datagramReader) fill() {
+	frame, err := d.rio.ReadFrameAll()
+	if err != nil {
+		d.err = err
+		return
+	}
+
+	frame, count := d.rd.Redact(frame)
+	if _, err := recordio.WriteFrame(&d.out, frame); err != nil {
+		d.err = err
+		return
+	}
+	atomic.AddInt64(&d.redacted, int64(count))
+}
@@ -0,0 +1,204 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redact implements a filter that removes secrets from log stream data
+// before it is bundled by the Butler.
+//
+// Secrets are specified as literal strings and as RE2 regular expressions.
+// Every occurrence is replaced with a marker.
+package redact
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+const (
+	// DefaultMarker is the marker that replaces redacted data if none is
+	// specified.
+	DefaultMarker = "[REDACTED]"
+
+	// FilterTag is the log stream tag that the Butler adds to streams whose
+	// data is passed through a Redactor.
+	//
+	// A stream's descriptor is registered along with its first log entries, so
+	// the tag records that the stream's data was filtered, not that any secret
+	// was actually found in it.
+	FilterTag = "logdog.redaction_filter"
+	// FilterTagValue is the value of FilterTag.
+	FilterTagValue = "enabled"
+)
+
+// Options is the set of Redactor configuration parameters.
+type Options struct {
+	// Secrets are literal strings to redact. Empty strings are ignored.
+	//
+	// TEXT streams are redacted a line at a time, so secrets must not contain
+	// line breaks.
+	Secrets []string
+	// Patterns are RE2 regular expressions whose matches are redacted.
+	//
+	// In TEXT streams, patterns are matched within a line, see TextReader.
+	Patterns []string
+
+	// Marker replaces each redacted region. If empty, DefaultMarker will be used.
+	Marker string
+}
+
+// Redactor replaces secrets in log data with a marker.
+//
+// A Redactor is immutable, and is safe for concurrent use.
+type Redactor struct {
+	marker   []byte
+	secrets  [][]byte
+	patterns []*regexp.Regexp
+
+	// maxSecret is the length of the longest secret.
+	maxSecret int
+}
+
+// New creates a Redactor from the supplied Options.
+//
+// If the Options contain no secrets or patterns, New returns nil.
+func New(o Options) (*Redactor, error) {
+	r := Redactor{
+		marker: []byte(o.Marker),
+	}
+	if len(r.marker) == 0 {
+		r.marker = []byte(DefaultMarker)
+	}
+
+	for i, s := range o.Secrets {
+		switch {
+		case s == "":
+			continue
+		case strings.ContainsAny(s, "\r\n"):
+			// Don't include the secret in the error.
+			return nil, errors.Reason("secret #%d contains a line break", i).Err()
+		}
+		r.secrets = append(r.secrets, []byte(s))
+		if len(s) > r.maxSecret {
+			r.maxSecret = len(s)
+		}
+	}
+	// Prefer longer secrets, so that a secret containing another secret is
+	// redacted in full.
+	sort.SliceStable(r.secrets, func(i, j int) bool { return len(r.secrets[i]) > len(r.secrets[j]) })
+
+	for _, p := range o.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, errors.Annotate(err, "invalid redaction pattern %q", p).Err()
+		}
+		r.patterns = append(r.patterns, re)
+	}
+
+	if len(r.secrets) == 0 && len(r.patterns) == 0 {
+		return nil, nil
+	}
+	return &r, nil
+}
+
+// Redact returns a copy of d with every secret and pattern match replaced by
+// the marker, along with the number of regions that were replaced.
+//
+// Overlapping and adjacent matches are merged into a single region. If nothing
+// was redacted, d itself is returned.
+func (r *Redactor) Redact(d []byte) ([]byte, int) {
+	regions := r.regions(d)
+	if len(regions) == 0 {
+		return d, 0
+	}
+
+	out := make([]byte, 0, len(d))
+	last := 0
+	for _, reg := range regions {
+		out = append(out, d[last:reg[0]]...)
+		out = append(out, r.marker...)
+		last = reg[1]
+	}
+	return append(out, d[last:]...), len(regions)
+}
+
+// regions returns the regions of d to redact, as [start, end) offsets sorted
+// by start. Overlapping and adjacent matches are merged.
+func (r *Redactor) regions(d []byte) [][2]int {
+	var matches [][2]int
+	for _, s := range r.secrets {
+		for off := 0; ; {
+			idx := bytes.Index(d[off:], s)
+			if idx < 0 {
+				break
+			}
+			start := off + idx
+			matches = append(matches, [2]int{start, start + len(s)})
+			off = start + 1
+		}
+	}
+	for _, re := range r.patterns {
+		for _, m := range re.FindAllIndex(d, -1) {
+			if m[1] > m[0] {
+				matches = append(matches, [2]int{m[0], m[1]})
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i][0] < matches[j][0] })
+
+	regions := matches[:1]
+	for _, m := range matches[1:] {
+		last := &regions[len(regions)-1]
+		switch {
+		case m[0] > last[1]:
+			regions = append(regions, m)
+		case m[1] > last[1]:
+			last[1] = m[1]
+		}
+	}
+	return regions
+}
+
+// cut returns the length of a prefix of d, a piece of a longer line, that can
+// be redacted on its own.
+//
+// Every secret that starts in the prefix ends within d, and no region ends
+// past the prefix, so no secret is split between the prefix and the rest of
+// the line.
+func (r *Redactor) cut(d []byte) int {
+	cut := len(d) - (r.maxSecret - 1)
+	if r.maxSecret == 0 || cut <= 0 {
+		return len(d)
+	}
+	for _, reg := range r.regions(d) {
+		switch {
+		case reg[1] <= cut:
+			continue
+		case reg[0] >= cut:
+			return cut
+		case reg[0] > 0:
+			// Leave the straddling region to the rest of the line.
+			return reg[0]
+		default:
+			return reg[1]
+		}
+	}
+	return cut
+}
@@ -0,0 +1,173 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"go.chromium.org/luci/common/data/recordio"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Redactor`, t, func() {
+		r, err := New(Options{
+			Secrets:  []string{"hunter2", "hunter", ""},
+			Patterns: []string{`tok-[a-z]+`, `x*`},
+		})
+		So(err, ShouldBeNil)
+
+		redact := func(s string) (string, int) {
+			d, count := r.Redact([]byte(s))
+			return string(d), count
+		}
+
+		Convey(`Returns nil if nothing is configured.`, func() {
+			r, err := New(Options{Secrets: []string{""}})
+			So(err, ShouldBeNil)
+			So(r, ShouldBeNil)
+		})
+
+		Convey(`Rejects invalid patterns.`, func() {
+			_, err := New(Options{Patterns: []string{`(`}})
+			So(err, ShouldErrLike, "invalid redaction pattern")
+		})
+
+		Convey(`Rejects secrets with line breaks.`, func() {
+			_, err := New(Options{Secrets: []string{"ok", "multi\nline"}})
+			So(err, ShouldErrLike, "secret #1 contains a line break")
+			So(err.Error(), ShouldNotContainSubstring, "multi")
+
+			_, err = New(Options{Secrets: []string{"carriage\r"}})
+			So(err, ShouldErrLike, "contains a line break")
+		})
+
+		Convey(`Leaves data without secrets alone.`, func() {
+			d, count := redact("nothing to see here")
+			So(d, ShouldEqual, "nothing to see here")
+			So(count, ShouldEqual, 0)
+		})
+
+		Convey(`Redacts literals and pattern matches.`, func() {
+			d, count := redact("pw=hunter2 token=tok-abc pw=hunter")
+			So(d, ShouldEqual, "pw=[REDACTED] token=[REDACTED] pw=[REDACTED]")
+			So(count, ShouldEqual, 3)
+		})
+
+		Convey(`Merges overlapping and adjacent matches.`, func() {
+			d, count := redact("hunter2tok-abc!")
+			So(d, ShouldEqual, "[REDACTED]!")
+			So(count, ShouldEqual, 1)
+		})
+
+		Convey(`Uses a custom marker.`, func() {
+			r, err := New(Options{Secrets: []string{"hunter2"}, Marker: "***"})
+			So(err, ShouldBeNil)
+			d, _ := r.Redact([]byte("pw=hunter2"))
+			So(string(d), ShouldEqual, "pw=***")
+		})
+	})
+}
+
+func TestTextReader(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A text Reader`, t, func() {
+		rd, err := New(Options{Secrets: []string{"hunter2"}})
+		So(err, ShouldBeNil)
+
+		read := func(src io.Reader) (string, int64) {
+			r := rd.TextReader(src)
+			d, err := ioutil.ReadAll(r)
+			So(err, ShouldBeNil)
+			return string(d), r.Redacted()
+		}
+
+		Convey(`Redacts secrets split across reads.`, func() {
+			d, count := read(iotest.OneByteReader(bytes.NewBufferString("a hunter2\r\nb hunter2 c\nhunter2")))
+			So(d, ShouldEqual, "a [REDACTED]\r\nb [REDACTED] c\n[REDACTED]")
+			So(count, ShouldEqual, 3)
+		})
+
+		Convey(`Redacts lines longer than the maximum line size in pieces.`, func() {
+			defer func(v int) { maxLineSize = v }(maxLineSize)
+			maxLineSize = 8
+
+			d, _ := read(iotest.OneByteReader(bytes.NewBufferString("hunter2 hunter2")))
+			So(d, ShouldEqual, "[REDACTED] [REDACTED]")
+		})
+
+		Convey(`Redacts secrets straddling the maximum line size.`, func() {
+			defer func(v int) { maxLineSize = v }(maxLineSize)
+			maxLineSize = 8
+
+			for _, line := range []string{"abcdehunter2xyz", "abhunter2hunter2", "hunter2hunter2hunter2"} {
+				d, _ := read(iotest.OneByteReader(bytes.NewBufferString(line)))
+				So(strings.Replace(d, DefaultMarker, "", -1), ShouldEqual, strings.Replace(line, "hunter2", "", -1))
+			}
+		})
+
+		Convey(`Redacts long lines with only patterns.`, func() {
+			defer func(v int) { maxLineSize = v }(maxLineSize)
+			maxLineSize = 8
+
+			rd, err := New(Options{Patterns: []string{`tok-[a-z]`}})
+			So(err, ShouldBeNil)
+			d, err := ioutil.ReadAll(rd.TextReader(bytes.NewBufferString("tok-a ab tok-b cdefg")))
+			So(err, ShouldBeNil)
+			So(string(d), ShouldEqual, "[REDACTED] ab [REDACTED] cdefg")
+		})
+
+		Convey(`Returns errors from its source.`, func() {
+			r := rd.TextReader(iotest.TimeoutReader(bytes.NewBufferString("hunter2 and")))
+			d, err := ioutil.ReadAll(r)
+			So(err, ShouldEqual, iotest.ErrTimeout)
+			So(string(d), ShouldEqual, "[REDACTED] and")
+		})
+	})
+}
+
+func TestDatagramReader(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A datagram Reader`, t, func() {
+		rd, err := New(Options{Secrets: []string{"hunter2"}})
+		So(err, ShouldBeNil)
+
+		var src bytes.Buffer
+		for _, dg := range []string{"hunter2", "", "hello", "a hunter2 b"} {
+			_, err := recordio.WriteFrame(&src, []byte(dg))
+			So(err, ShouldBeNil)
+		}
+
+		r := rd.DatagramReader(iotest.OneByteReader(&src))
+		d, err := ioutil.ReadAll(r)
+		So(err, ShouldBeNil)
+		So(r.Redacted(), ShouldEqual, 2)
+
+		frames, err := recordio.Split(d)
+		So(err, ShouldBeNil)
+		So(frames, ShouldResemble, [][]byte{[]byte("[REDACTED]"), {}, []byte("hello"), []byte("a [REDACTED] b")})
+	})
+}
@@ -20,6 +20,7 @@ import (
 
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/logdog/client/butler/bundler"
+	"go.chromium.org/luci/logdog/client/butler/redact"
 	"go.chromium.org/luci/logdog/common/types"
 )
 
@@ -32,6 +33,15 @@ type stream struct {
 	r  io.Reader
 	c  io.Closer
 	bs bundler.Stream
+
+	// records, if not nil, is the reader validating this structured-record
+	// stream's data.
+	records *recordReader
+	// limited, if not nil, is the reader enforcing the Butler's limits on this
+	// stream's data.
+	limited *limitReader
+	// redacted, if not nil, is the Reader redacting this stream's data.
+	redacted redact.Reader
 }
 
 func (s *stream) readChunk() bool {
@@ -80,5 +90,21 @@ func (s *stream) closeStream() {
 	if err := s.c.Close(); err != nil {
 		s.log.Warningf("Error closing stream: ", err)
 	}
+	if s.records != nil {
+		if n := s.records.Invalid(); n > 0 {
+			s.log.Warningf("Replaced %d invalid record(s).", n)
+		}
+	}
+	if s.limited != nil {
+		if bytes, entries := s.limited.Dropped(); bytes > 0 {
+			s.log.Warningf("Dropped %d byte(s) (%d entries) of stream data because of the Butler's limits.", bytes, entries)
+			s.bs.SetDropped(bytes, entries)
+		}
+	}
+	if s.redacted != nil {
+		if n := s.redacted.Redacted(); n > 0 {
+			s.log.Infof("Redacted %d region(s) of stream data.", n)
+		}
+	}
 	s.bs.Close()
 }
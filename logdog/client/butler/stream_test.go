@@ -35,6 +35,9 @@ type testBundlerStream struct {
 	err      error
 
 	data []*testBundlerData
+
+	droppedBytes   int64
+	droppedEntries int64
 }
 
 func (bs *testBundlerStream) LeaseData() bundler.Data {
@@ -57,6 +60,10 @@ func (bs *testBundlerStream) Append(d bundler.Data) error {
 	return nil
 }
 
+func (bs *testBundlerStream) SetDropped(bytes, entries int64) {
+	bs.droppedBytes, bs.droppedEntries = bytes, entries
+}
+
 func (bs *testBundlerStream) Close() {
 	if bs.closed {
 		panic("double close")
@@ -52,7 +52,8 @@ type dialer interface {
 
 // Client is a client to a local LogDog Butler.
 //
-// The methods here allow you to open a stream (text, binary or datagram) which
+// The methods here allow you to open a stream (text, binary, datagram or
+// record) which
 // you can then use to send data to LogDog.
 type Client struct {
 	dial dialer
@@ -161,3 +162,31 @@ func (c *Client) NewDatagramStream(ctx context.Context, name types.StreamName, o
 	ret, err := c.dial.DialDgramStream(fullOpts.desc)
 	return ret, errors.Annotate(err, "attempting to connect datagram stream %q", name).Err()
 }
+
+// NewRecordStream returns a new structured-record stream to the butler.
+//
+// A structured-record stream is a text stream with the content type
+// types.ContentTypeJSONLines, each of whose lines is a JSON object. Processes
+// that already emit such lines can write them to a text stream opened with
+// WithContentType(types.ContentTypeJSONLines) instead.
+//
+// NOTE: It is an error to pass ForProcess or WithContentType as an Option.
+func (c *Client) NewRecordStream(ctx context.Context, name types.StreamName, opts ...Option) (RecordStream, error) {
+	fullOpts, err := c.mkOptions(ctx, name, logpb.StreamType_TEXT, opts)
+	if err != nil {
+		return nil, err
+	}
+	if fullOpts.forProcess {
+		return nil, errors.Reason("cannot specify ForProcess on a record stream").Err()
+	}
+	if fullOpts.desc.ContentType != string(fullOpts.desc.Type.DefaultContentType()) {
+		return nil, errors.Reason("cannot specify a content type on a record stream").Err()
+	}
+	fullOpts.desc.ContentType = types.ContentTypeJSONLines
+
+	ret, err := c.dial.DialStream(false, fullOpts.desc)
+	if err != nil {
+		return nil, errors.Annotate(err, "attempting to connect record stream %q", name).Err()
+	}
+	return &recordStreamWriter{ret}, nil
+}
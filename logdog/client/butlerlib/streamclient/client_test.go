@@ -42,6 +42,20 @@ func TestClientGeneral(t *testing.T) {
 			So(err, ShouldErrLike, "cannot specify ForProcess on a datagram stream")
 		})
 
+		Convey(`ForProcess used with record stream`, func() {
+			client := NewFake("")
+
+			_, err := client.NewRecordStream(ctx, "test", ForProcess())
+			So(err, ShouldErrLike, "cannot specify ForProcess on a record stream")
+		})
+
+		Convey(`WithContentType used with record stream`, func() {
+			client := NewFake("")
+
+			_, err := client.NewRecordStream(ctx, "test", WithContentType("application/json"))
+			So(err, ShouldErrLike, "cannot specify a content type on a record stream")
+		})
+
 		Convey(`bad options`, func() {
 			client := NewFake("")
 
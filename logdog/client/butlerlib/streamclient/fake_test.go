@@ -102,6 +102,29 @@ func TestFakeProtocol(t *testing.T) {
 					Tags:        nil,
 				})
 			})
+
+			Convey(`can use a record stream`, func() {
+				stream, err := client.NewRecordStream(ctx, "test")
+				So(err, ShouldBeNil)
+
+				So(stream.WriteRecord(map[string]interface{}{"severity": "INFO", "n": 1}), ShouldBeNil)
+				So(stream.WriteRecord(struct {
+					Message string `json:"message"`
+				}{"hi"}), ShouldBeNil)
+				So(stream.WriteRecord([]string{"not", "an", "object"}), ShouldErrLike, "must be a JSON object")
+				So(stream.Close(), ShouldBeNil)
+
+				streamData := client.GetFakeData()["namespace/test"]
+				So(streamData, ShouldNotBeNil)
+				So(streamData.GetStreamData(), ShouldEqual, "{\"n\":1,\"severity\":\"INFO\"}\n{\"message\":\"hi\"}\n")
+				So(streamData.GetFlags(), ShouldResemble, streamproto.Flags{
+					Name:        "namespace/test",
+					ContentType: "application/x-ndjson",
+					Type:        streamproto.StreamType(logpb.StreamType_TEXT),
+					Timestamp:   clockflag.Time(testclock.TestTimeUTC),
+					Tags:        nil,
+				})
+			})
 		})
 
 		Convey(`bad`, func() {
@@ -42,6 +42,8 @@ type Option func(*options)
 //   * Text streams have the type "text/plain"
 //   * Binary streams have the type "application/octet-stream"
 //   * Datagram streams have the type "application/x-logdog-datagram"
+//   * Record streams have the type "application/x-ndjson", which cannot be
+//     changed
 func WithContentType(contentType string) Option {
 	return func(o *options) {
 		o.desc.ContentType = contentType
@@ -0,0 +1,55 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamclient
+
+import (
+	"encoding/json"
+	"io"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// RecordStream is the interface for structured-record streams.
+type RecordStream interface {
+	io.Closer
+
+	// WriteRecord writes `rec`, which must marshal to a JSON object, as a
+	// single record.
+	WriteRecord(rec interface{}) error
+}
+
+// recordStreamWriter implements a RecordStream on top of a raw text stream
+// writer.
+type recordStreamWriter struct {
+	Raw io.WriteCloser
+}
+
+// Close implements io.Closer.
+func (w *recordStreamWriter) Close() error {
+	return w.Raw.Close()
+}
+
+// WriteRecord implements RecordStream.
+func (w *recordStreamWriter) WriteRecord(rec interface{}) error {
+	d, err := json.Marshal(rec)
+	if err != nil {
+		return errors.Annotate(err, "marshalling record").Err()
+	}
+	if len(d) == 0 || d[0] != '{' {
+		return errors.Reason("record must be a JSON object, not %s", d).Err()
+	}
+	_, err = w.Raw.Write(append(d, '\n'))
+	return err
+}
@@ -30,6 +30,7 @@ import (
 	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/client/coordinator"
 	"go.chromium.org/luci/logdog/common/fetcher"
+	"go.chromium.org/luci/logdog/common/jsonlines"
 	"go.chromium.org/luci/logdog/common/renderer"
 	"go.chromium.org/luci/logdog/common/types"
 
@@ -65,6 +66,8 @@ type catCommandRun struct {
 	fetchSize  int
 	fetchBytes int
 	raw        bool
+	follow     bool
+	filter     string
 
 	timestamps      timestampsFlag
 	showStreamIndex bool
@@ -90,6 +93,12 @@ func newCatCommand() *subcommands.Command {
 			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
 			cmd.Flags.BoolVar(&cmd.raw, "raw", false,
 				"Reproduce original log stream, instead of attempting to render for humans.")
+			cmd.Flags.StringVar(&cmd.filter, "filter", "",
+				"Only show the records of a structured-record stream that match this expression, "+
+					`e.g. 'severity>=WARNING host="foo"'. Cannot be combined with -follow.`)
+			cmd.Flags.BoolVar(&cmd.follow, "follow", false,
+				"Have the Coordinator push new log entries as they are ingested, instead of polling for them. "+
+					"Cannot be combined with -count, -fetch-size or -fetch-bytes.")
 			return cmd
 		},
 	}
@@ -143,6 +152,15 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 			"value": cmd.buffer,
 		}.Errorf(a, "Buffer size must be >0.")
 	}
+	if cmd.follow && (cmd.count != 0 || cmd.fetchSize != 0 || cmd.fetchBytes != 0) {
+		log.Errorf(a, "-follow cannot be combined with -count, -fetch-size or -fetch-bytes.")
+		return 1
+	}
+
+	if cmd.follow && cmd.filter != "" {
+		log.Errorf(a, "-follow cannot be combined with -filter.")
+		return 1
+	}
 
 	coords := make(map[string]*coordinator.Client, len(addrs))
 	for _, addr := range addrs {
@@ -179,14 +197,30 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 	return 0
 }
 
+// catSource is a renderer.Source that knows its stream's descriptor.
+type catSource interface {
+	renderer.Source
+
+	Descriptor() *logpb.LogStreamDescriptor
+}
+
 func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client, addr *types.StreamAddr) error {
 	// Pull stream information.
-	f := coord.Stream(addr.Project, addr.Path).Fetcher(c, &fetcher.Options{
-		Index:       types.MessageIndex(cmd.index),
-		Count:       cmd.count,
-		BufferCount: cmd.fetchSize,
-		BufferBytes: int64(cmd.fetchBytes),
-	})
+	stream := coord.Stream(addr.Project, addr.Path)
+	var f catSource
+	if cmd.follow {
+		var err error
+		if f, err = stream.Follow(c, types.MessageIndex(cmd.index)); err != nil {
+			return err
+		}
+	} else {
+		f = stream.Fetcher(c, &fetcher.Options{
+			Index:       types.MessageIndex(cmd.index),
+			Count:       cmd.count,
+			BufferCount: cmd.fetchSize,
+			BufferBytes: int64(cmd.fetchBytes),
+		}, cmd.getParams()...)
+	}
 
 	rend := renderer.Renderer{
 		Source: f,
@@ -199,6 +233,14 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 			}
 			return cmd.getTextPrefix(desc, le)
 		},
+		TextWriter: func(w io.Writer, v []byte) bool {
+			desc := f.Descriptor()
+			if desc == nil {
+				log.Errorf(c, "Failed to get stream descriptor.")
+				return false
+			}
+			return getTextWriter(c, desc)(w, v)
+		},
 		DatagramWriter: func(w io.Writer, dg []byte) bool {
 			desc := f.Descriptor()
 			if desc == nil {
@@ -214,6 +256,14 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 	return nil
 }
 
+// getParams returns the additional parameters for the stream's Get requests.
+func (cmd *catCommandRun) getParams() []coordinator.GetParam {
+	if cmd.filter != "" {
+		return []coordinator.GetParam{coordinator.Filter(cmd.filter)}
+	}
+	return nil
+}
+
 func (cmd *catCommandRun) getTextPrefix(desc *logpb.LogStreamDescriptor, le *logpb.LogEntry) string {
 	var parts []string
 	if cmd.timestamps != timestampsOff {
@@ -237,6 +287,29 @@ func (cmd *catCommandRun) getTextPrefix(desc *logpb.LogStreamDescriptor, le *log
 	return strings.Join(parts, " ") + "| "
 }
 
+// getTextWriter returns a text writer function that can be used as a
+// Renderer's TextWriter. The writer is bound to desc.
+func getTextWriter(c context.Context, desc *logpb.LogStreamDescriptor) renderer.TextWriter {
+	return func(w io.Writer, v []byte) bool {
+		switch desc.ContentType {
+		case types.ContentTypeJSONLines:
+			r, err := jsonlines.Parse(v)
+			if err != nil {
+				log.WithError(err).Debugf(c, "Failed to parse record.")
+				return false
+			}
+			if err := jsonlines.Format(w, r); err != nil {
+				log.WithError(err).Errorf(c, "Failed to format record.")
+				return false
+			}
+			return true
+
+		default:
+			return false
+		}
+	}
+}
+
 // getDatagramWriter returns a datagram writer function that can be used as a
 // Renderer's DatagramWriter. The writer is bound to desc.
 func getDatagramWriter(c context.Context, desc *logpb.LogStreamDescriptor) renderer.DatagramWriter {
@@ -0,0 +1,255 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/sync/parallel"
+	"go.chromium.org/luci/logdog/client/butler/output/directory"
+	"go.chromium.org/luci/logdog/client/coordinator"
+
+	"github.com/maruel/subcommands"
+)
+
+const (
+	// defaultDownloadWorkers is the default number of log streams to download
+	// in parallel.
+	defaultDownloadWorkers = 8
+)
+
+type downloadCommandRun struct {
+	subcommands.CommandRunBase
+
+	out     string
+	zip     string
+	workers int
+	wait    bool
+}
+
+func newDownloadCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "download [options] path",
+		ShortDesc: "Download all log streams matching a path.",
+		LongDesc: "Download all log streams matching a path (which may include globbing, as in " +
+			"'query', e.g. 'project/prefix/+/**') in parallel. The streams are written in the " +
+			"same layout as the Butler's directory output: each stream's data is written to a " +
+			"file named after the stream, next to a '.meta.<name>' file holding its descriptor " +
+			"as JSON. If the path matches streams in several prefixes, each prefix's streams " +
+			"are written to a directory named after it.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &downloadCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.out, "out", "", "Write the log streams into this directory.")
+			fs.StringVar(&cmd.zip, "zip", "", "Write the log streams into this zip file.")
+			fs.IntVar(&cmd.workers, "workers", defaultDownloadWorkers,
+				"The number of log streams to download in parallel.")
+			fs.BoolVar(&cmd.wait, "wait", false,
+				"Wait for log streams that haven't terminated yet to complete, instead of skipping them.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *downloadCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one path must be supplied.")
+		return 1
+	}
+	if (cmd.out == "") == (cmd.zip == "") {
+		log.Errorf(a, "Exactly one of -out or -zip must be supplied.")
+		return 1
+	}
+	if cmd.workers <= 0 {
+		log.Errorf(a, "-workers must be >0.")
+		return 1
+	}
+
+	project, path, _, err := a.splitPath(args[0])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	dir := cmd.out
+	if cmd.zip != "" {
+		if dir, err = ioutil.TempDir("", "logdog_download"); err != nil {
+			errors.Log(a, errors.Annotate(err, "could not create temporary directory").Err())
+			return 1
+		}
+		defer os.RemoveAll(dir)
+	}
+
+	tctx, _ := a.timeoutCtx(a)
+	if err := cmd.download(tctx, coord, project, path, dir); err != nil {
+		errors.Log(a, err)
+
+		if errors.Contains(err, context.DeadlineExceeded) {
+			return 2
+		}
+		return 1
+	}
+
+	if cmd.zip != "" {
+		if err := writeZip(dir, cmd.zip); err != nil {
+			errors.Log(a, errors.Annotate(err, "could not write zip file %q", cmd.zip).Err())
+			return 1
+		}
+	}
+	return 0
+}
+
+// download downloads all of the log streams matching path into dir.
+func (cmd *downloadCommandRun) download(c context.Context, coord *coordinator.Client, project, path, dir string) error {
+	log.Debugf(c, "Issuing query...")
+
+	var streams []*coordinator.LogStream
+	prefixes := map[string]struct{}{}
+	skipped := 0
+	err := coord.Query(c, project, path, coordinator.QueryOptions{State: true}, func(s *coordinator.LogStream) bool {
+		if s.State.TerminalIndex < 0 && !cmd.wait {
+			log.Fields{
+				"path": s.Path,
+			}.Warningf(c, "Skipping log stream that hasn't terminated yet.")
+			skipped++
+			return true
+		}
+
+		streams = append(streams, s)
+		prefixes[s.Desc.Prefix] = struct{}{}
+		return true
+	})
+	if err != nil {
+		return errors.Annotate(err, "query failed").Err()
+	}
+	log.Fields{
+		"count":   len(streams),
+		"skipped": skipped,
+	}.Infof(c, "Downloading log streams.")
+
+	err = parallel.WorkPool(cmd.workers, func(taskC chan<- func() error) {
+		for _, s := range streams {
+			s := s
+			base := dir
+			if len(prefixes) > 1 {
+				base = filepath.Join(dir, filepath.FromSlash(s.Desc.Prefix))
+			}
+
+			taskC <- func() error {
+				if err := downloadStream(c, coord, s, base); err != nil {
+					return errors.Annotate(err, "failed to download %q", s.Path).Err()
+				}
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Fields{
+		"count": len(streams),
+	}.Infof(c, "Download completed.")
+	return nil
+}
+
+// downloadStream fetches the log stream s into the directory base.
+func downloadStream(c context.Context, coord *coordinator.Client, s *coordinator.LogStream, base string) error {
+	w, err := directory.NewStream(base, &s.Desc)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	f := coord.Stream(s.Project, s.Path).Fetcher(c, nil)
+	for {
+		le, err := f.NextLogEntry()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if err := w.WriteLogEntry(le); err != nil {
+			return err
+		}
+	}
+}
+
+// writeZip writes the files in the directory tree rooted at dir into a new zip
+// file at path.
+func writeZip(dir, path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	zw := zip.NewWriter(f)
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		hdr.Method = zip.Deflate
+
+		w, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return err
+		}
+		src, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(w, src)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return zw.Close()
+}
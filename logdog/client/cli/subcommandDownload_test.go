@@ -0,0 +1,135 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1/fakelogs"
+	"go.chromium.org/luci/logdog/appengine/coordinator/coordinatorTest"
+	"go.chromium.org/luci/logdog/client/coordinator"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDownload(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A download command`, t, func() {
+		c := fakelogs.NewClient()
+		ctx := gologger.StdConfig.Use(context.Background())
+		coord := &coordinator.Client{C: c, Host: "testing-host.example.com"}
+
+		dir, err := ioutil.TempDir("", "logdog_download_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		// Two complete streams and an incomplete one under "some/prefix", and a
+		// complete stream under "other/prefix".
+		st, err := c.OpenTextStream("some/prefix", "a/stdout")
+		So(err, ShouldBeNil)
+		fmt.Fprintf(st, "hello\nworld")
+		So(st.Close(), ShouldBeNil)
+
+		sd, err := c.OpenDatagramStream("some/prefix", "a/dg")
+		So(err, ShouldBeNil)
+		fmt.Fprintf(sd, "first")
+		fmt.Fprintf(sd, "second")
+		So(sd.Close(), ShouldBeNil)
+
+		open, err := c.OpenTextStream("some/prefix", "b/stdout")
+		So(err, ShouldBeNil)
+		fmt.Fprintf(open, "still running")
+
+		so, err := c.OpenTextStream("other/prefix", "c/stdout")
+		So(err, ShouldBeNil)
+		fmt.Fprintf(so, "other")
+		So(so.Close(), ShouldBeNil)
+
+		cmd := &downloadCommandRun{workers: 2}
+		download := func(path string) error {
+			return cmd.download(ctx, coord, coordinatorTest.AllAccessProject, path, dir)
+		}
+		read := func(rel string) string {
+			d, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+			So(err, ShouldBeNil)
+			return string(d)
+		}
+		exists := func(rel string) bool {
+			_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(rel)))
+			return err == nil
+		}
+
+		Convey(`Downloads the streams of one prefix into the directory.`, func() {
+			So(download("some/prefix/+/**"), ShouldBeNil)
+
+			So(read("a/stdout"), ShouldEqual, "hello\nworld\n")
+			So(read("a/.meta.stdout"), ShouldContainSubstring, `"name": "a/stdout"`)
+			So(read("a/_00000.dg"), ShouldEqual, "first")
+			So(read("a/_00001.dg"), ShouldEqual, "second")
+			So(read("a/.meta.dg"), ShouldContainSubstring, `"stream_type": "DATAGRAM"`)
+
+			// The incomplete stream is skipped.
+			So(exists("b/stdout"), ShouldBeFalse)
+			So(exists("b/.meta.stdout"), ShouldBeFalse)
+		})
+
+		Convey(`Downloads the streams of several prefixes into their own directories.`, func() {
+			So(download("**/+/**"), ShouldBeNil)
+
+			So(read("some/prefix/a/stdout"), ShouldEqual, "hello\nworld\n")
+			So(read("some/prefix/a/_00001.dg"), ShouldEqual, "second")
+			So(read("other/prefix/c/stdout"), ShouldEqual, "other\n")
+			So(exists("a/stdout"), ShouldBeFalse)
+
+			Convey(`Writes them into a zip file with the same layout.`, func() {
+				zipDir, err := ioutil.TempDir("", "logdog_download_test")
+				So(err, ShouldBeNil)
+				defer os.RemoveAll(zipDir)
+
+				zipPath := filepath.Join(zipDir, "out.zip")
+				So(writeZip(dir, zipPath), ShouldBeNil)
+
+				zr, err := zip.OpenReader(zipPath)
+				So(err, ShouldBeNil)
+				defer zr.Close()
+
+				files := map[string]string{}
+				for _, f := range zr.File {
+					r, err := f.Open()
+					So(err, ShouldBeNil)
+					d, err := ioutil.ReadAll(r)
+					r.Close()
+					So(err, ShouldBeNil)
+					files[f.Name] = string(d)
+				}
+				So(files, ShouldContainKey, "some/prefix/a/.meta.stdout")
+				So(files, ShouldContainKey, "some/prefix/a/.meta.dg")
+				So(files, ShouldContainKey, "other/prefix/c/.meta.stdout")
+				So(files["some/prefix/a/stdout"], ShouldEqual, "hello\nworld\n")
+				So(files["some/prefix/a/_00000.dg"], ShouldEqual, "first")
+				So(files["some/prefix/a/_00001.dg"], ShouldEqual, "second")
+				So(files["other/prefix/c/stdout"], ShouldEqual, "other\n")
+			})
+		})
+	})
+}
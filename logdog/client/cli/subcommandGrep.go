@@ -0,0 +1,149 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/client/coordinator"
+
+	"github.com/maruel/subcommands"
+)
+
+const (
+	// defaultGrepResults is the default number of matching lines to return.
+	defaultGrepResults = 200
+)
+
+type grepCommandRun struct {
+	subcommands.CommandRunBase
+
+	regexp     bool
+	ignoreCase bool
+	results    int
+	json       bool
+}
+
+func newGrepCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "grep [options] pattern path",
+		ShortDesc: "Search text log streams for lines matching a pattern.",
+		LongDesc: "Search all text log streams matching a path (which may include " +
+			"globbing, as in 'query') for lines containing a pattern. Each matching line " +
+			"is written to STDOUT, prefixed with its stream path, log entry index and " +
+			"line index.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &grepCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.BoolVar(&cmd.regexp, "E", false, "Interpret the pattern as an RE2 regular expression.")
+			fs.BoolVar(&cmd.ignoreCase, "i", false, "Match the pattern case-insensitively.")
+			fs.IntVar(&cmd.results, "results", defaultGrepResults,
+				"The maximum number of matching lines to return. If 0, no limit will be applied.")
+			fs.BoolVar(&cmd.json, "json", false, "Output matches as JSON lines.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *grepCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 2 {
+		log.Errorf(a, "Exactly two arguments, the pattern and the path, must be supplied.")
+		return 1
+	}
+	pattern := args[0]
+
+	project, path, unified, err := a.splitPath(args[1])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+	enc := json.NewEncoder(bw)
+
+	so := coordinator.SearchOptions{
+		Regexp:     cmd.regexp,
+		IgnoreCase: cmd.ignoreCase,
+	}
+	count := 0
+	log.Debugf(a, "Issuing search...")
+
+	tctx, _ := a.timeoutCtx(a)
+	ierr := error(nil)
+	err = coord.Search(tctx, project, path, pattern, so, func(m *coordinator.SearchMatch) bool {
+		streamPath := string(m.Path)
+		if unified {
+			streamPath = makeUnifiedPath(m.Project, m.Path)
+		}
+
+		if cmd.json {
+			ierr = enc.Encode(struct {
+				Path  string `json:"path"`
+				Index int64  `json:"index"`
+				Line  int    `json:"line"`
+				Text  string `json:"text"`
+			}{streamPath, int64(m.Index), m.Line, m.Text})
+		} else {
+			_, ierr = fmt.Fprintf(bw, "%s:%d:%d: %s\n", streamPath, m.Index, m.Line, m.Text)
+		}
+		if ierr != nil {
+			return false
+		}
+
+		count++
+		return !(cmd.results > 0 && count >= cmd.results)
+	})
+	if err == nil {
+		// Propagate internal error.
+		err = ierr
+	}
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"count":      count,
+		}.Errorf(a, "Search failed.")
+
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+	log.Fields{
+		"count": count,
+	}.Infof(a, "Search completed.")
+
+	if count == 0 {
+		// Like grep, signal that nothing matched.
+		return 1
+	}
+	return 0
+}
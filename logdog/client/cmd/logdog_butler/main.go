@@ -33,6 +33,7 @@ import (
 	"go.chromium.org/luci/common/data/rand/mathrand"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/flag/multiflag"
+	"go.chromium.org/luci/common/flag/stringlistflag"
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/common/runtime/paniccatcher"
@@ -42,8 +43,10 @@ import (
 	grpcLogging "go.chromium.org/luci/grpc/logging"
 	"go.chromium.org/luci/logdog/client/butler"
 	"go.chromium.org/luci/logdog/client/butler/output"
+	"go.chromium.org/luci/logdog/client/butler/redact"
 	"go.chromium.org/luci/logdog/client/butlerlib/streamproto"
 	"go.chromium.org/luci/logdog/common/types"
+	"go.chromium.org/luci/lucictx"
 
 	"go.chromium.org/luci/hardcoded/chromeinfra"
 )
@@ -80,6 +83,13 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	redactSecrets   stringlistflag.Flag
+	redactPatterns  stringlistflag.Flag
+	redactDatagrams bool
+	redactor        *redact.Redactor
+
+	limits butler.Limits
+
 	prof profiling.Profiler
 
 	client *http.Client
@@ -119,6 +129,47 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&a.noBufferLogs, "output-no-buffer", false,
 		"If true, dispatch logs immediately. Setting this flag simplifies output at the expense "+
 			"of wire-format efficiency.")
+	fs.Var(&a.redactSecrets, "redact-secret",
+		"A literal secret, without line breaks, to redact from TEXT streams. Can be specified "+
+			"multiple times. Command lines may be visible to other processes; prefer the "+
+			"\"redaction\" LUCI_CONTEXT section.")
+	fs.Var(&a.redactPatterns, "redact-pattern",
+		"An RE2 regular expression whose matches are redacted from TEXT streams. Can be specified "+
+			"multiple times.")
+	fs.BoolVar(&a.redactDatagrams, "redact-datagrams", false,
+		"If true, also redact DATAGRAM streams. This may corrupt binary datagrams.")
+	fs.Int64Var(&a.limits.StreamBytes, "max-stream-bytes", 0,
+		"The maximum number of bytes that a single stream may emit. Data beyond it is dropped. "+
+			"0 means unlimited.")
+	fs.Int64Var(&a.limits.PrefixBytes, "max-prefix-bytes", 0,
+		"The maximum number of bytes that all streams may emit combined. Data beyond it is "+
+			"dropped. 0 means unlimited.")
+	fs.Float64Var(&a.limits.StreamEntryRate, "max-stream-entry-rate", 0,
+		"The maximum rate, in lines or datagrams per second, at which a single stream may emit "+
+			"entries. Entries beyond it are dropped. 0 means unlimited.")
+	fs.Float64Var(&a.limits.PrefixEntryRate, "max-prefix-entry-rate", 0,
+		"The maximum rate, in lines or datagrams per second, at which all streams may emit "+
+			"entries combined. Entries beyond it are dropped. 0 means unlimited.")
+	fs.IntVar(&a.limits.EntryBurst, "entry-burst", 0,
+		"The number of entries that may be emitted at once in excess of an entry rate limit. "+
+			"0 means one second's worth of entries.")
+}
+
+// loadRedactor builds the application's Redactor from its flags and from the
+// "redaction" LUCI_CONTEXT section.
+func (a *application) loadRedactor() error {
+	opts := redact.Options{
+		Secrets:  a.redactSecrets,
+		Patterns: a.redactPatterns,
+	}
+	if rc := lucictx.GetRedaction(a); rc != nil {
+		opts.Secrets = append(opts.Secrets, rc.Secrets...)
+		opts.Patterns = append(opts.Patterns, rc.Patterns...)
+	}
+
+	var err error
+	a.redactor, err = redact.New(opts)
+	return err
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -155,6 +206,11 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 		MaxBufferAge: time.Duration(a.maxBufferAge),
 		BufferLogs:   !a.noBufferLogs,
 		Output:       out,
+
+		Redactor:        a.redactor,
+		RedactDatagrams: a.redactDatagrams,
+
+		Limits: a.limits,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -306,6 +362,11 @@ func mainImpl(ctx context.Context, defaultAuthOpts auth.Options, argv []string)
 		return configErrorReturnCode
 	}
 
+	if err := a.loadRedactor(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid redaction configuration.")
+		return configErrorReturnCode
+	}
+
 	// Run our subcommand (and parse subcommand flags).
 	return subcommands.Run(a, flags.Args())
 }
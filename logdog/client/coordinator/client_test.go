@@ -48,3 +48,11 @@ func (s *testLogsServiceBase) Tail(c context.Context, req *logdog.TailRequest) (
 func (s *testLogsServiceBase) Query(c context.Context, req *logdog.QueryRequest) (*logdog.QueryResponse, error) {
 	panic("not implemented")
 }
+
+func (s *testLogsServiceBase) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	panic("not implemented")
+}
+
+func (s *testLogsServiceBase) Follow(req *logdog.FollowRequest, stream logdog.Logs_FollowServer) error {
+	panic("not implemented")
+}
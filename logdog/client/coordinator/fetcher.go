@@ -43,6 +43,9 @@ type coordinatorSource struct {
 
 	requireCompleteStream bool
 
+	// params are additional parameters to apply to each Get request.
+	params []GetParam
+
 	streamState *LogStream
 }
 
@@ -56,6 +59,7 @@ func (s *coordinatorSource) LogEntries(c context.Context, req *fetcher.LogReques
 		LimitCount(req.Count),
 		Index(req.Index),
 	)
+	params = append(params, s.params...)
 
 	// If we haven't terminated, use this opportunity to fetch/update our stream
 	// state.
@@ -113,13 +117,16 @@ func (s *coordinatorSource) Descriptor() *logpb.LogStreamDescriptor {
 //
 // If you pass a nil fetcher.Options, a default option set will be used. The
 // o.Source field will always be overwritten to be based off this stream.
-func (s *Stream) Fetcher(c context.Context, o *fetcher.Options) *fetcher.Fetcher {
+//
+// Any params, such as Filter, are applied to each of the Fetcher's Get
+// requests.
+func (s *Stream) Fetcher(c context.Context, o *fetcher.Options, params ...GetParam) *fetcher.Fetcher {
 	if o == nil {
 		o = &fetcher.Options{}
 	} else {
 		o = &(*o)
 	}
 	o.Source = &coordinatorSource{
-		stream: s, tidx: -1, requireCompleteStream: o.RequireCompleteStream}
+		stream: s, tidx: -1, requireCompleteStream: o.RequireCompleteStream, params: params}
 	return fetcher.New(c, *o)
 }
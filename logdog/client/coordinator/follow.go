@@ -0,0 +1,111 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"io"
+
+	"go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/renderer"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// Follower reads a log stream's entries as the Coordinator pushes them over
+// its streaming Follow endpoint.
+//
+// Follower implements renderer.Source. A Follower is not goroutine-safe.
+type Follower struct {
+	stream logdog.Logs_FollowClient
+	path   types.StreamPath
+
+	state *LogStream
+	// buf holds the received log entries that haven't been returned yet.
+	buf []*logpb.LogEntry
+	// err is the error that ended the stream. It is io.EOF if the log stream
+	// was followed to its end.
+	err error
+}
+
+var _ renderer.Source = (*Follower)(nil)
+
+// Follow begins following the log stream, starting at the supplied index.
+//
+// The returned Follower returns log entries until the log stream has
+// terminated and all of its entries have been returned, or until ctx is
+// cancelled.
+func (s *Stream) Follow(ctx context.Context, index types.MessageIndex) (*Follower, error) {
+	req := logdog.FollowRequest{
+		Project: string(s.project),
+		Path:    string(s.path),
+		State:   true,
+		Index:   int64(index),
+	}
+
+	stream, err := s.c.C.Follow(ctx, &req)
+	if err != nil {
+		return nil, normalizeError(err)
+	}
+	return &Follower{stream: stream, path: s.path}, nil
+}
+
+// NextLogEntry implements renderer.Source.
+//
+// It blocks until the next log entry is available. Once the log stream has
+// been followed to its end, it returns io.EOF.
+func (f *Follower) NextLogEntry() (*logpb.LogEntry, error) {
+	for len(f.buf) == 0 {
+		if f.err != nil {
+			return nil, f.err
+		}
+
+		resp, err := f.stream.Recv()
+		switch {
+		case err == io.EOF:
+			f.err = err
+			continue
+		case err != nil:
+			f.err = normalizeError(err)
+			continue
+		}
+
+		if resp.State != nil || resp.Desc != nil {
+			path := f.path
+			if resp.Desc != nil {
+				path = resp.Desc.Path()
+			}
+			f.state = loadLogStream(resp.Project, path, resp.State, resp.Desc)
+		}
+		f.buf = resp.Logs
+	}
+
+	le := f.buf[0]
+	f.buf = f.buf[1:]
+	return le, nil
+}
+
+// State returns the log stream's state at the time it was first followed, or
+// nil if it hasn't been received yet.
+func (f *Follower) State() *LogStream { return f.state }
+
+// Descriptor returns the log stream's descriptor, or nil if it hasn't been
+// received yet.
+func (f *Follower) Descriptor() *logpb.LogStreamDescriptor {
+	if f.state != nil {
+		return &f.state.Desc
+	}
+	return nil
+}
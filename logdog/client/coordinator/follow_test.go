@@ -0,0 +1,148 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/prpctest"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type testFollowLogsService struct {
+	testLogsServiceBase
+
+	FR logdog.FollowRequest
+	// FH returns the responses to send, and the error to end the stream with.
+	FH func(*logdog.FollowRequest) ([]*logdog.FollowResponse, error)
+}
+
+func (s *testFollowLogsService) Follow(req *logdog.FollowRequest, stream logdog.Logs_FollowServer) error {
+	s.FR = *req
+	resps, err := s.FH(req)
+	for _, resp := range resps {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func TestStreamFollow(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing Client`, t, func() {
+		c := context.Background()
+
+		ts := prpctest.Server{}
+		svc := testFollowLogsService{}
+		logdog.RegisterLogsServer(&ts, &svc)
+
+		// Create a testing server and client.
+		ts.Start(c)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		if err != nil {
+			panic(err)
+		}
+		client := Client{
+			C: logdog.NewLogsPRPCClient(prpcClient),
+		}
+		s := client.Stream("myproj", "test/+/a")
+
+		readAll := func(f *Follower) ([]string, error) {
+			var got []string
+			for {
+				le, err := f.NextLogEntry()
+				if err != nil {
+					return got, err
+				}
+				got = append(got, string(le.GetText().Lines[0].Value))
+			}
+		}
+
+		Convey(`Will return followed log entries, then io.EOF.`, func() {
+			svc.FH = func(*logdog.FollowRequest) ([]*logdog.FollowResponse, error) {
+				return []*logdog.FollowResponse{
+					{
+						Project: "myproj",
+						State:   &logdog.LogStreamState{TerminalIndex: -1},
+						Desc: &logpb.LogStreamDescriptor{
+							Prefix:     "test",
+							Name:       "a",
+							StreamType: logpb.StreamType_TEXT,
+						},
+					},
+					{Logs: []*logpb.LogEntry{genLog(10, "ohai"), genLog(11, "there")}},
+					{Logs: []*logpb.LogEntry{genLog(12, "kthxbye")}},
+				}, nil
+			}
+
+			f, err := s.Follow(c, 10)
+			So(err, ShouldBeNil)
+
+			got, err := readAll(f)
+			So(err, ShouldEqual, io.EOF)
+			So(got, ShouldResemble, []string{"ohai", "there", "kthxbye"})
+			So(svc.FR, ShouldResemble, logdog.FollowRequest{
+				Project: "myproj",
+				Path:    "test/+/a",
+				State:   true,
+				Index:   10,
+			})
+
+			So(f.Descriptor().Name, ShouldEqual, "a")
+			So(f.State().Path, ShouldEqual, "test/+/a")
+			So(f.State().State.TerminalIndex, ShouldEqual, -1)
+		})
+
+		Convey(`Will return the error that ends the stream.`, func() {
+			svc.FH = func(*logdog.FollowRequest) ([]*logdog.FollowResponse, error) {
+				return []*logdog.FollowResponse{
+					{Logs: []*logpb.LogEntry{genLog(0, "ohai")}},
+				}, grpcutil.Errf(codes.Unauthenticated, "go away")
+			}
+
+			f, err := s.Follow(c, 0)
+			So(err, ShouldBeNil)
+
+			got, err := readAll(f)
+			So(err, ShouldEqual, ErrNoAccess)
+			So(got, ShouldResemble, []string{"ohai"})
+		})
+
+		Convey(`Will return ErrNoSuchStream if the stream does not exist.`, func() {
+			svc.FH = func(*logdog.FollowRequest) ([]*logdog.FollowResponse, error) {
+				return nil, grpcutil.Errf(codes.NotFound, "path not found")
+			}
+
+			f, err := s.Follow(c, 0)
+			if err == nil {
+				_, err = f.NextLogEntry()
+			}
+			So(err, ShouldErrLike, ErrNoSuchStream)
+		})
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/prpctest"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testSearchLogsService struct {
+	testLogsServiceBase
+
+	LR logdog.SearchRequest
+	H  func(*logdog.SearchRequest) (*logdog.SearchResponse, error)
+}
+
+func (s *testSearchLogsService) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	s.LR = *req
+	if h := s.H; h != nil {
+		return s.H(req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestClientSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing Client`, t, func() {
+		c := context.Background()
+
+		ts := prpctest.Server{}
+		svc := testSearchLogsService{}
+		logdog.RegisterLogsServer(&ts, &svc)
+
+		// Create a testing server and client.
+		ts.Start(c)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		if err != nil {
+			panic(err)
+		}
+		client := Client{
+			C: logdog.NewLogsPRPCClient(prpcClient),
+		}
+
+		Convey(`When making a search request`, func() {
+			const project = "myproj"
+			const path = "foo/**"
+			o := SearchOptions{Regexp: true, IgnoreCase: true}
+
+			var results []string
+			accumulate := func(m *SearchMatch) bool {
+				results = append(results, string(m.Path)+":"+m.Text)
+				return true
+			}
+
+			match := func(name string) *logdog.SearchResponse_Match {
+				return &logdog.SearchResponse_Match{Path: "foo/+/" + name, Text: name}
+			}
+
+			// This handler returns a single match per request, progressing
+			// "" => "b" => "final" => "".
+			svc.H = func(req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+				r := logdog.SearchResponse{Project: project}
+				switch req.Next {
+				case "":
+					r.Matches = append(r.Matches, match("a"))
+					r.Next = "b"
+				case "b":
+					// A page may have no matches.
+					r.Next = "final"
+				case "final":
+					r.Matches = append(r.Matches, match("final"))
+				default:
+					return nil, errors.New("invalid cursor")
+				}
+				return &r, nil
+			}
+
+			Convey(`Can accumulate results across searches.`, func() {
+				So(client.Search(c, project, path, "f.o", o, accumulate), ShouldBeNil)
+				So(results, ShouldResemble, []string{"foo/+/a:a", "foo/+/final:final"})
+				So(svc.LR.Pattern, ShouldEqual, "f.o")
+				So(svc.LR.Regexp, ShouldBeTrue)
+				So(svc.LR.IgnoreCase, ShouldBeTrue)
+			})
+
+			Convey(`Will stop searching if callback returns false.`, func() {
+				So(client.Search(c, project, path, "f.o", o, func(*SearchMatch) bool { return false }), ShouldBeNil)
+				So(svc.LR.Next, ShouldEqual, "")
+			})
+
+			Convey(`Will return ErrNoAccess if unauthenticated.`, func() {
+				svc.H = func(*logdog.SearchRequest) (*logdog.SearchResponse, error) {
+					return nil, grpcutil.Unauthenticated
+				}
+				So(client.Search(c, project, path, "f.o", o, accumulate), ShouldEqual, ErrNoAccess)
+			})
+		})
+	})
+}
@@ -42,6 +42,12 @@ type StreamState struct {
 	// In this case, FinishedIndex is the index of that terminal message.
 	TerminalIndex types.MessageIndex
 
+	// DroppedBytes and DroppedEntries are the amount of stream data that the
+	// Butler dropped because of its limits. They are known once the stream has
+	// terminated.
+	DroppedBytes   int64
+	DroppedEntries int64
+
 	// Archived is true if the stream is marked as archived.
 	Archived bool
 	// ArchiveIndexURL is the Google Storage URL where the log stream's index is
@@ -85,9 +91,11 @@ func loadLogStream(proj string, path types.StreamPath, s *logdog.LogStreamState,
 	}
 	if s != nil {
 		ls.State = StreamState{
-			Created:       google.TimeFromProto(s.Created),
-			TerminalIndex: types.MessageIndex(s.TerminalIndex),
-			Purged:        s.Purged,
+			Created:        google.TimeFromProto(s.Created),
+			TerminalIndex:  types.MessageIndex(s.TerminalIndex),
+			DroppedBytes:   s.DroppedBytes,
+			DroppedEntries: s.DroppedEntries,
+			Purged:         s.Purged,
 		}
 
 		if a := s.Archive; a != nil {
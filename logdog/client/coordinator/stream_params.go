@@ -142,6 +142,20 @@ func NonContiguous() GetParam { return nonContiguousGetParam{} }
 
 func (nonContiguousGetParam) applyGet(param *getParamsInst) { param.r.NonContiguous = true }
 
+type filterGetParam struct {
+	expr string
+}
+
+// Filter returns a stream Get parameter that causes the Get request to return
+// only the records that match the filter expression expr. It may only be used
+// with structured-record streams (see the jsonlines package).
+//
+// Non-matching records are removed from the returned log entries, but the log
+// entries themselves are still returned.
+func Filter(expr string) GetParam { return &filterGetParam{expr} }
+
+func (p *filterGetParam) applyGet(param *getParamsInst) { param.r.Filter = p.expr }
+
 type completeTailParam struct{}
 
 // Complete instructs the Tail call to retrieve a complete record.
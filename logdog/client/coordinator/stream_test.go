@@ -170,6 +170,22 @@ func TestStreamGet(t *testing.T) {
 					})
 				})
 
+				Convey(`Will send a record filter if one is supplied.`, func() {
+					svc.GH = func(*logdog.GetRequest) (*logdog.GetResponse, error) {
+						return &logdog.GetResponse{}, nil
+					}
+
+					_, err := s.Get(c, Filter("severity>=WARNING"))
+					So(err, ShouldBeNil)
+
+					// Validate the correct parameters were sent.
+					So(svc.GR, ShouldResemble, logdog.GetRequest{
+						Project: "myproj",
+						Path:    "test/+/a",
+						Filter:  "severity>=WARNING",
+					})
+				})
+
 				Convey(`Will request a specific number of logs if a constraint is supplied.`, func() {
 					svc.GH = func(*logdog.GetRequest) (*logdog.GetResponse, error) {
 						return &logdog.GetResponse{
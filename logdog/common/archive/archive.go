@@ -41,6 +41,15 @@ type Manifest struct {
 	// IndexWriter, if not nil, is the Writer to which the log stream Index
 	// protobuf stream will be written.
 	IndexWriter io.Writer
+	// SearchIndexWriter, if not nil, is the Writer to which the log stream's
+	// SearchIndex protobuf will be written. Only TEXT log entries are indexed.
+	SearchIndexWriter io.Writer
+
+	// Format is the format in which the log stream is written.
+	//
+	// In the ZLIB_FRAMES format, a frame is started for each index entry, so the
+	// index constraints below also determine the size of the compressed frames.
+	Format logpb.LogIndex_Format
 
 	// StreamIndexRange, if >0, is the maximum number of log entry stream indices
 	// in between successive index entries.
@@ -92,24 +101,36 @@ func Archive(m Manifest) error {
 		idx = &indexBuilder{
 			Manifest: &m,
 			index: logpb.LogIndex{
-				Desc: m.Desc,
+				Desc:   m.Desc,
+				Format: m.Format,
 			},
 			sizeFunc: m.sizeFunc,
 		}
 	}
 
+	// Likewise for the search index.
+	var sidx *searchIndexBuilder
+	if m.SearchIndexWriter != nil {
+		sidx = &searchIndexBuilder{}
+	}
+
 	return parallel.FanOutIn(func(taskC chan<- func() error) {
 		logC := make(chan *logpb.LogEntry)
 
 		taskC <- func() error {
-			if err := archiveLogs(m.LogWriter, m.Desc, logC, idx); err != nil {
+			if err := archiveLogs(m.LogWriter, m.Format, m.Desc, logC, idx, sidx); err != nil {
 				return err
 			}
 
-			// If we're building an index, emit it now that the log stream has
+			// If we're building indexes, emit them now that the log stream has
 			// finished.
 			if idx != nil {
-				return idx.emit(m.IndexWriter)
+				if err := idx.emit(m.IndexWriter); err != nil {
+					return err
+				}
+			}
+			if sidx != nil {
+				return sidx.emit(m.SearchIndexWriter)
 			}
 			return nil
 		}
@@ -136,7 +157,15 @@ func Archive(m Manifest) error {
 	})
 }
 
-func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
+func archiveLogs(w io.Writer, f logpb.LogIndex_Format, d *logpb.LogStreamDescriptor,
+	logC <-chan *logpb.LogEntry, idx *indexBuilder, sidx *searchIndexBuilder) error {
+
+	var fw *frameWriter
+	if f == logpb.LogIndex_ZLIB_FRAMES {
+		fw = newFrameWriter(w)
+		w = fw
+	}
+
 	offset := int64(0)
 	out := func(pb proto.Message) error {
 		d, err := proto.Marshal(pb)
@@ -157,11 +186,29 @@ func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.L
 			continue
 		}
 
-		// Add this LogEntry to our index, noting the current offset.
+		// Add this LogEntry to our index, noting the current offset. If we're
+		// writing frames, each indexed LogEntry starts a new one, and the index
+		// notes the offset of the frame instead.
 		if idx != nil {
-			idx.addLogEntry(le, offset)
+			indexed := idx.shouldIndex(le)
+			entryOffset := offset
+			if fw != nil {
+				if indexed {
+					if err = fw.newFrame(); err != nil {
+						continue
+					}
+				}
+				entryOffset = fw.frameOffset()
+			}
+			idx.addLogEntry(le, entryOffset, indexed)
+		}
+		if sidx != nil {
+			sidx.addLogEntry(le)
 		}
 		err = out(le)
 	}
+	if err == nil && fw != nil {
+		err = fw.Close()
+	}
 	return err
 }
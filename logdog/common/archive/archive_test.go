@@ -416,5 +416,54 @@ func TestArchive(t *testing.T) {
 				})
 			})
 		})
+
+		Convey(`When writing ZLIB_FRAMES, index entries point to seekable frames.`, func() {
+			ts.add(0, 1, 2, 3, 4, 5)
+			m.Format = logpb.LogIndex_ZLIB_FRAMES
+			m.StreamIndexRange = 2
+			So(Archive(m), ShouldBeNil)
+
+			var index logpb.LogIndex
+			So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+			So(index.Format, ShouldEqual, logpb.LogIndex_ZLIB_FRAMES)
+
+			var indices []uint64
+			for _, e := range index.Entries {
+				indices = append(indices, e.StreamIndex)
+			}
+			So(indices, ShouldResemble, []uint64{0, 2, 4, 5})
+
+			// readFrom returns the stream indices of the log entries read from the
+			// frame at offset.
+			readFrom := func(offset uint64) (indices []uint64) {
+				rio := recordio.NewReader(NewFrameReader(bytes.NewReader(logB.Bytes()[offset:])), 1024)
+				for {
+					d, err := rio.ReadFrameAll()
+					if err == io.EOF {
+						return
+					}
+					So(err, ShouldBeNil)
+
+					le := logpb.LogEntry{}
+					So(proto.Unmarshal(d, &le), ShouldBeNil)
+					indices = append(indices, le.StreamIndex)
+				}
+			}
+
+			// The descriptor has a frame of its own.
+			d, err := recordio.NewReader(NewFrameReader(bytes.NewReader(logB.Bytes())), 1024).ReadFrameAll()
+			So(err, ShouldBeNil)
+			readDesc := logpb.LogStreamDescriptor{}
+			So(proto.Unmarshal(d, &readDesc), ShouldBeNil)
+			So(&readDesc, ShouldResembleProto, desc)
+			So(index.Entries[0].Offset, ShouldBeGreaterThan, 0)
+
+			So(readFrom(index.Entries[0].Offset), ShouldResemble, []uint64{0, 1, 2, 3, 4, 5})
+			So(readFrom(index.Entries[1].Offset), ShouldResemble, []uint64{2, 3, 4, 5})
+			So(readFrom(index.Entries[2].Offset), ShouldResemble, []uint64{4, 5})
+
+			// The terminal entry shares the last indexed entry's frame.
+			So(index.Entries[3].Offset, ShouldEqual, index.Entries[2].Offset)
+		})
 	})
 }
@@ -0,0 +1,126 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+
+	"go.chromium.org/luci/common/iotools"
+)
+
+// frameWriter writes a log stream in the logpb.LogIndex_ZLIB_FRAMES format.
+//
+// Data is compressed into the current frame until newFrame is called.
+type frameWriter struct {
+	w iotools.CountingWriter
+
+	// zw compresses the current frame. It is nil until the first write.
+	zw *zlib.Writer
+	// open is true if the current frame has had data written to it.
+	open bool
+	// start is the offset of the current frame, if it is open.
+	start int64
+}
+
+func newFrameWriter(w io.Writer) *frameWriter {
+	return &frameWriter{w: iotools.CountingWriter{Writer: w}}
+}
+
+func (w *frameWriter) Write(d []byte) (int, error) {
+	if !w.open {
+		if w.zw == nil {
+			w.zw = zlib.NewWriter(&w.w)
+		} else {
+			w.zw.Reset(&w.w)
+		}
+		w.open = true
+		w.start = w.w.Count
+	}
+	return w.zw.Write(d)
+}
+
+// newFrame ends the current frame, so that subsequent data is written to a new
+// one.
+func (w *frameWriter) newFrame() error {
+	if !w.open {
+		return nil
+	}
+	w.open = false
+	return w.zw.Close()
+}
+
+// frameOffset returns the offset of the frame that the next write will go to.
+func (w *frameWriter) frameOffset() int64 {
+	if w.open {
+		return w.start
+	}
+	return w.w.Count
+}
+
+// Close ends the current frame.
+func (w *frameWriter) Close() error { return w.newFrame() }
+
+// NewFrameReader returns a Reader that decompresses a log stream in the
+// logpb.LogIndex_ZLIB_FRAMES format. r must be positioned at the start of a
+// frame.
+func NewFrameReader(r io.Reader) io.Reader {
+	// zlib won't read past the end of a frame if its Reader is an
+	// io.ByteReader.
+	return &frameReader{r: bufio.NewReader(r)}
+}
+
+type frameReader struct {
+	r *bufio.Reader
+
+	// zr decompresses the current frame. It is nil until the first read.
+	zr io.ReadCloser
+	// open is true if zr is positioned within a frame.
+	open bool
+}
+
+func (r *frameReader) Read(d []byte) (int, error) {
+	for {
+		if !r.open {
+			// Stop cleanly at the end of the last frame.
+			if _, err := r.r.Peek(1); err != nil {
+				return 0, err
+			}
+
+			var err error
+			if r.zr == nil {
+				r.zr, err = zlib.NewReader(r.r)
+			} else {
+				err = r.zr.(zlib.Resetter).Reset(r.r, nil)
+			}
+			if err != nil {
+				return 0, err
+			}
+			r.open = true
+		}
+
+		n, err := r.zr.Read(d)
+		if err == io.EOF {
+			// Continue with the next frame.
+			r.open = false
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
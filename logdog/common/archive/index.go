@@ -35,12 +35,25 @@ type indexBuilder struct {
 	sizeFunc func(proto.Message) int
 }
 
-func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
+// shouldIndex accounts for le, and returns true if it is the next LogEntry to
+// be indexed.
+func (i *indexBuilder) shouldIndex(le *logpb.LogEntry) bool {
 	// Only calculate the size if we actually use it.
 	if i.ByteRange > 0 {
 		i.lastBytes += uint64(i.size(le))
 	}
 
+	if len(i.index.Entries) == 0 {
+		return true
+	}
+	return (i.StreamIndexRange > 0 && (le.StreamIndex-i.lastStreamIndex) >= uint64(i.StreamIndexRange)) ||
+		(i.PrefixIndexRange > 0 && (le.PrefixIndex-i.lastPrefixIndex) >= uint64(i.PrefixIndexRange)) ||
+		(i.ByteRange > 0 && i.lastBytes >= uint64(i.ByteRange))
+}
+
+// addLogEntry adds le, whose record is at offset, to the index. indexed is the
+// result of shouldIndex for le.
+func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64, indexed bool) {
 	// Update our stream properties.
 	i.index.LastPrefixIndex = le.PrefixIndex
 	i.index.LastStreamIndex = le.StreamIndex
@@ -55,18 +68,15 @@ func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
 	}
 
 	// Do we index this LogEntry?
-	if len(i.index.Entries) > 0 {
-		if !((i.StreamIndexRange > 0 && (le.StreamIndex-i.lastStreamIndex) >= uint64(i.StreamIndexRange)) ||
-			(i.PrefixIndexRange > 0 && (le.PrefixIndex-i.lastPrefixIndex) >= uint64(i.PrefixIndexRange)) ||
-			(i.ByteRange > 0 && i.lastBytes >= uint64(i.ByteRange))) {
-			// Not going to index this entry. Buffer it as a terminator.
-			i.latestBufferedEntry = &entry
-			return
-		}
+	if !indexed {
+		// Not going to index this entry. Buffer it as a terminator.
+		i.latestBufferedEntry = &entry
+		return
+	}
 
+	if len(i.index.Entries) > 0 {
 		i.lastBytes = 0
 	}
-
 	i.index.Entries = append(i.index.Entries, &entry)
 	i.latestBufferedEntry = nil
 
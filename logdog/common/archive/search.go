@@ -0,0 +1,137 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.chromium.org/luci/logdog/api/logpb"
+)
+
+// SearchIndexName is the name of the search index file that is stored next to
+// an archived log stream's entries and index.
+const SearchIndexName = "logstream.search"
+
+const (
+	// searchIndexBitsPerTrigram is the number of filter bits allocated for each
+	// distinct trigram. Together with searchIndexHashCount, this yields a false
+	// positive rate of about 1%.
+	searchIndexBitsPerTrigram = 10
+	// searchIndexHashCount is the number of bits set for each trigram.
+	searchIndexHashCount = 7
+	// searchIndexMinBits is the minimum size of the filter, in bits.
+	searchIndexMinBits = 64
+)
+
+// searchIndexBuilder is a stateful engine that constructs a search index.
+type searchIndexBuilder struct {
+	trigrams  map[uint32]struct{}
+	lineCount uint64
+}
+
+func (b *searchIndexBuilder) addLogEntry(le *logpb.LogEntry) {
+	text := le.GetText()
+	if text == nil {
+		return
+	}
+
+	if b.trigrams == nil {
+		b.trigrams = make(map[uint32]struct{})
+	}
+	for _, line := range text.Lines {
+		forEachTrigram(bytes.ToLower(line.Value), func(t uint32) {
+			b.trigrams[t] = struct{}{}
+		})
+		b.lineCount++
+	}
+}
+
+func (b *searchIndexBuilder) emit(w io.Writer) error {
+	bits := uint64(len(b.trigrams)) * searchIndexBitsPerTrigram
+	if bits < searchIndexMinBits {
+		bits = searchIndexMinBits
+	}
+
+	idx := logpb.SearchIndex{
+		Bloom:     make([]byte, (bits+7)/8),
+		HashCount: searchIndexHashCount,
+		LineCount: b.lineCount,
+	}
+	for t := range b.trigrams {
+		forEachBit(&idx, t, func(byteIdx int, mask byte) bool {
+			idx.Bloom[byteIdx] |= mask
+			return true
+		})
+	}
+
+	d, err := proto.Marshal(&idx)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(d)
+	return err
+}
+
+// SearchIndexMayContain returns false if no line indexed by idx contains s,
+// ignoring case.
+//
+// It returns true if s may be present. Strings shorter than three bytes can't
+// be checked against the index, and always yield true.
+func SearchIndexMayContain(idx *logpb.SearchIndex, s string) bool {
+	if len(idx.Bloom) == 0 || idx.HashCount == 0 {
+		return true
+	}
+
+	found := true
+	forEachTrigram([]byte(strings.ToLower(s)), func(t uint32) {
+		if !found {
+			return
+		}
+		forEachBit(idx, t, func(byteIdx int, mask byte) bool {
+			if idx.Bloom[byteIdx]&mask == 0 {
+				found = false
+			}
+			return found
+		})
+	})
+	return found
+}
+
+// forEachTrigram calls cb with each byte trigram in v, packed into the low 24
+// bits of an integer.
+func forEachTrigram(v []byte, cb func(uint32)) {
+	for i := 2; i < len(v); i++ {
+		cb(uint32(v[i-2])<<16 | uint32(v[i-1])<<8 | uint32(v[i]))
+	}
+}
+
+// forEachBit calls cb with the location of each filter bit associated with the
+// trigram t, stopping if cb returns false.
+//
+// Bit locations are derived by double hashing.
+func forEachBit(idx *logpb.SearchIndex, t uint32, cb func(byteIdx int, mask byte) bool) {
+	bits := uint64(len(idx.Bloom)) * 8
+	h1 := uint64(t * 0x9E3779B1)
+	h2 := uint64(t*0x85EBCA77) | 1
+	for i := uint64(0); i < uint64(idx.HashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		if !cb(int(bit/8), byte(1)<<(bit%8)) {
+			return
+		}
+	}
+}
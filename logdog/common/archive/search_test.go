@@ -0,0 +1,91 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A search index for a text stream`, t, func() {
+		ts := testSource{}
+		for i, text := range []string{"Hello, World!", "compile step FAILED", "exit code 1"} {
+			le := gen(i)
+			le.GetText().Lines[0].Value = []byte(text)
+			ts.addLogEntry(le)
+		}
+
+		var logB, sidxB bytes.Buffer
+		So(Archive(Manifest{
+			Desc:              &logpb.LogStreamDescriptor{Prefix: "foo", Name: "bar"},
+			Source:            &ts,
+			LogWriter:         &logB,
+			SearchIndexWriter: &sidxB,
+		}), ShouldBeNil)
+
+		var idx logpb.SearchIndex
+		So(proto.Unmarshal(sidxB.Bytes(), &idx), ShouldBeNil)
+		So(idx.LineCount, ShouldEqual, 3)
+		So(idx.HashCount, ShouldEqual, searchIndexHashCount)
+
+		Convey(`Reports strings that are present, ignoring case.`, func() {
+			for _, s := range []string{"hello", "WORLD", "step failed", "code 1", "ex", ""} {
+				So(SearchIndexMayContain(&idx, s), ShouldBeTrue)
+			}
+		})
+
+		Convey(`Rejects strings that are absent.`, func() {
+			for _, s := range []string{"panic", "hello world", "exit code 2"} {
+				So(SearchIndexMayContain(&idx, s), ShouldBeFalse)
+			}
+		})
+	})
+
+	Convey(`A search index with many trigrams has a low false positive rate.`, t, func() {
+		b := searchIndexBuilder{}
+		for i := 0; i < 1000; i++ {
+			le := gen(i)
+			le.GetText().Lines[0].Value = []byte(fmt.Sprintf("line %08x", i*7919))
+			b.addLogEntry(le)
+		}
+
+		var buf bytes.Buffer
+		So(b.emit(&buf), ShouldBeNil)
+		var idx logpb.SearchIndex
+		So(proto.Unmarshal(buf.Bytes(), &idx), ShouldBeNil)
+
+		falsePositives := 0
+		for i := 0; i < 1000; i++ {
+			if SearchIndexMayContain(&idx, fmt.Sprintf("zq%dxj", i)) {
+				falsePositives++
+			}
+		}
+		So(falsePositives, ShouldBeLessThan, 50)
+	})
+
+	Convey(`An empty index matches anything.`, t, func() {
+		So(SearchIndexMayContain(&logpb.SearchIndex{}, "foo"), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// Filter selects records by the values of their fields.
+type Filter struct {
+	conds []condition
+}
+
+type condition struct {
+	path  []string
+	op    string
+	value string
+}
+
+// operators are the supported condition operators. Longer operators come
+// first, so that they are matched in preference to their prefixes.
+var operators = []string{"!=", "<=", ">=", "=", "<", ">", ":"}
+
+// ParseFilter parses a filter expression.
+//
+// An expression is a whitespace-separated list of conditions, all of which
+// must hold for a record to match. Each condition has the form FIELD OP VALUE,
+// e.g. "severity>=WARNING" or `message:"disk full"`, where:
+//
+//   - FIELD is the name of a record field. Fields of nested objects are
+//     named by joining their names with ".", e.g. "labels.host".
+//   - OP is one of "=", "!=", "<", "<=", ">", ">=", or ":", which matches
+//     fields whose value contains VALUE.
+//   - VALUE is a bare word or a double-quoted Go string literal.
+//
+// Severities are compared by their level, numbers numerically, and other
+// values as strings. A condition never matches a record that doesn't have its
+// field.
+func ParseFilter(expr string) (*Filter, error) {
+	var f Filter
+	s := strings.TrimSpace(expr)
+	for s != "" {
+		var c condition
+
+		// FIELD
+		end := strings.IndexAny(s, "=!<>:")
+		if end <= 0 {
+			return nil, errors.Reason("invalid condition %q: missing field or operator", s).Err()
+		}
+		field := s[:end]
+		if strings.IndexFunc(field, unicode.IsSpace) >= 0 {
+			return nil, errors.Reason("invalid condition %q: missing operator", field).Err()
+		}
+		c.path = strings.Split(field, ".")
+		s = s[end:]
+
+		// OP
+		for _, op := range operators {
+			if strings.HasPrefix(s, op) {
+				c.op = op
+				break
+			}
+		}
+		if c.op == "" {
+			return nil, errors.Reason("invalid condition on %q: unknown operator", field).Err()
+		}
+		s = s[len(c.op):]
+
+		// VALUE
+		if strings.HasPrefix(s, `"`) {
+			end = quotedLen(s)
+			if end < 0 {
+				return nil, errors.Reason("invalid condition on %q: unterminated string", field).Err()
+			}
+			var err error
+			if c.value, err = strconv.Unquote(s[:end]); err != nil {
+				return nil, errors.Annotate(err, "invalid condition on %q", field).Err()
+			}
+		} else {
+			if end = strings.IndexFunc(s, unicode.IsSpace); end < 0 {
+				end = len(s)
+			}
+			c.value = s[:end]
+		}
+		s = strings.TrimLeftFunc(s[end:], unicode.IsSpace)
+
+		f.conds = append(f.conds, c)
+	}
+	return &f, nil
+}
+
+// quotedLen returns the length of the double-quoted string literal at the start
+// of s, or -1 if it is unterminated.
+func quotedLen(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// Match returns true if r satisfies all of the filter's conditions.
+func (f *Filter) Match(r Record) bool {
+	for _, c := range f.conds {
+		v, ok := r.lookup(c.path)
+		if !ok || !c.match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchLine returns true if line is a record that satisfies all of the
+// filter's conditions.
+func (f *Filter) MatchLine(line []byte) bool {
+	r, err := Parse(line)
+	return err == nil && f.Match(r)
+}
+
+func (c *condition) match(v interface{}) bool {
+	if c.op == ":" {
+		return strings.Contains(valueString(v), c.value)
+	}
+
+	switch t := v.(type) {
+	case string:
+		if a, ok := severityRank(t); ok {
+			if b, ok := severityRank(c.value); ok {
+				return compare(c.op, a-b)
+			}
+		}
+		return compare(c.op, strings.Compare(t, c.value))
+
+	case float64:
+		if f, err := strconv.ParseFloat(c.value, 64); err == nil {
+			switch {
+			case t < f:
+				return compare(c.op, -1)
+			case t > f:
+				return compare(c.op, 1)
+			default:
+				return compare(c.op, 0)
+			}
+		}
+		return false
+
+	case map[string]interface{}, []interface{}:
+		// Objects and arrays can only be searched.
+		return false
+
+	default:
+		// Booleans and null can only be tested for equality.
+		switch c.op {
+		case "=":
+			return valueString(v) == c.value
+		case "!=":
+			return valueString(v) != c.value
+		}
+		return false
+	}
+}
+
+// compare returns the result of applying op to two values whose comparison
+// (as by strings.Compare) is cmp.
+func compare(op string, cmp int) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// valueString returns the string form of a decoded JSON value. Strings are
+// returned verbatim, and all other values as JSON.
+func valueString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	}
+	d, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(d)
+}
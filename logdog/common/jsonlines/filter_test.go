@@ -0,0 +1,117 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseFilter`, t, func() {
+		Convey(`Parses conditions.`, func() {
+			f, err := ParseFilter(` severity>=WARNING  labels.host="foo bar"	n!=3 msg: `)
+			So(err, ShouldBeNil)
+			So(f.conds, ShouldResemble, []condition{
+				{[]string{"severity"}, ">=", "WARNING"},
+				{[]string{"labels", "host"}, "=", "foo bar"},
+				{[]string{"n"}, "!=", "3"},
+				{[]string{"msg"}, ":", ""},
+			})
+		})
+
+		Convey(`Parses an empty expression.`, func() {
+			f, err := ParseFilter("  ")
+			So(err, ShouldBeNil)
+			So(f.conds, ShouldBeEmpty)
+		})
+
+		Convey(`Rejects invalid expressions.`, func() {
+			for expr, want := range map[string]string{
+				`severity`:         "missing field or operator",
+				`=WARNING`:         "missing field or operator",
+				`a b=c`:            "missing operator",
+				`severity!WARNING`: "unknown operator",
+				`msg="foo`:         "unterminated string",
+				`msg="\q"`:         "invalid syntax",
+			} {
+				_, err := ParseFilter(expr)
+				So(err, ShouldErrLike, want)
+			}
+		})
+	})
+
+	Convey(`Filter.MatchLine`, t, func() {
+		match := func(expr, line string) bool {
+			f, err := ParseFilter(expr)
+			So(err, ShouldBeNil)
+			return f.MatchLine([]byte(line))
+		}
+
+		Convey(`Compares severities by level.`, func() {
+			So(match(`severity>=WARNING`, `{"severity": "ERROR"}`), ShouldBeTrue)
+			So(match(`severity>=WARNING`, `{"severity": "warn"}`), ShouldBeTrue)
+			So(match(`severity>=WARNING`, `{"severity": "INFO"}`), ShouldBeFalse)
+			So(match(`severity<error`, `{"severity": "DEBUG"}`), ShouldBeTrue)
+		})
+
+		Convey(`Compares numbers numerically.`, func() {
+			So(match(`n>9`, `{"n": 10}`), ShouldBeTrue)
+			So(match(`n=10`, `{"n": 10.0}`), ShouldBeTrue)
+			So(match(`n<9`, `{"n": 10}`), ShouldBeFalse)
+			So(match(`n>foo`, `{"n": 10}`), ShouldBeFalse)
+		})
+
+		Convey(`Compares strings.`, func() {
+			So(match(`host=foo`, `{"host": "foo"}`), ShouldBeTrue)
+			So(match(`host!=foo`, `{"host": "bar"}`), ShouldBeTrue)
+			So(match(`host>bar`, `{"host": "foo"}`), ShouldBeTrue)
+			So(match(`message:"is full"`, `{"message": "disk is full"}`), ShouldBeTrue)
+			So(match(`message:empty`, `{"message": "disk is full"}`), ShouldBeFalse)
+		})
+
+		Convey(`Compares booleans and null for equality.`, func() {
+			So(match(`ok=true`, `{"ok": true}`), ShouldBeTrue)
+			So(match(`ok!=true`, `{"ok": false}`), ShouldBeTrue)
+			So(match(`ok>false`, `{"ok": true}`), ShouldBeFalse)
+			So(match(`v=null`, `{"v": null}`), ShouldBeTrue)
+		})
+
+		Convey(`Looks up nested fields.`, func() {
+			So(match(`labels.host=foo`, `{"labels": {"host": "foo"}}`), ShouldBeTrue)
+			So(match(`labels:foo`, `{"labels": {"host": "foo"}}`), ShouldBeTrue)
+			So(match(`labels=foo`, `{"labels": {"host": "foo"}}`), ShouldBeFalse)
+			So(match(`labels.host.x=foo`, `{"labels": {"host": "foo"}}`), ShouldBeFalse)
+		})
+
+		Convey(`Requires all conditions to match.`, func() {
+			So(match(`severity>=WARNING host=foo`, `{"severity": "ERROR", "host": "foo"}`), ShouldBeTrue)
+			So(match(`severity>=WARNING host=foo`, `{"severity": "ERROR", "host": "bar"}`), ShouldBeFalse)
+		})
+
+		Convey(`Doesn't match records missing the field.`, func() {
+			So(match(`host!=foo`, `{}`), ShouldBeFalse)
+		})
+
+		Convey(`Doesn't match invalid records.`, func() {
+			So(match(``, `not json`), ShouldBeFalse)
+			So(match(``, `{}`), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Format writes a human-readable rendering of the record r to w.
+//
+// The rendering is a single line holding the record's time, severity and
+// message, followed by its other fields as sorted "key=value" pairs:
+//
+//   2019-10-01T12:00:00Z WARNING disk is almost full host=foo used=0.97
+//
+// Records synthesized from invalid lines are rendered as their original text.
+func Format(w io.Writer, r Record) error {
+	if invalid, _ := r[InvalidField].(bool); invalid {
+		msg, _ := r[MessageField].(string)
+		_, err := io.WriteString(w, msg)
+		return err
+	}
+
+	var parts []string
+	seen := make(map[string]struct{}, 3)
+	wellKnown := func(fields []string) {
+		if f, v, ok := r.get(fields); ok {
+			parts = append(parts, valueString(v))
+			seen[f] = struct{}{}
+		}
+	}
+
+	wellKnown(timeFields)
+	wellKnown(severityFields)
+	wellKnown(messageFields)
+
+	keys := make([]string, 0, len(r))
+	for k := range r {
+		if _, ok := seen[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := valueString(r[k])
+		if v == "" || strings.ContainsAny(v, " \t\"=") {
+			v = strconv.Quote(v)
+		}
+		parts = append(parts, k+"="+v)
+	}
+
+	_, err := io.WriteString(w, strings.Join(parts, " "))
+	return err
+}
@@ -0,0 +1,152 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonlines implements LogDog's structured-record streams.
+//
+// A structured-record stream is a TEXT stream whose content type is
+// types.ContentTypeJSONLines. Each of its lines is a single JSON object, called
+// a record. A few record fields are well-known:
+//
+//   - "severity" (or "level") is the record's severity, e.g. "WARNING".
+//   - "message" (or "msg") is the record's human-readable message.
+//   - "time" (or "timestamp") is the time at which the record was emitted.
+//
+// The Butler validates each line of a structured-record stream. Lines that
+// aren't JSON objects are replaced by a record holding their text in its
+// "message" field and marked with InvalidField.
+package jsonlines
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+const (
+	// InvalidField is the field set, to true, on records that the Butler
+	// synthesized from lines that weren't JSON objects.
+	InvalidField = "logdog_invalid"
+
+	// MessageField is the field that holds a record's message.
+	MessageField = "message"
+)
+
+var (
+	severityFields = []string{"severity", "level"}
+	messageFields  = []string{MessageField, "msg"}
+	timeFields     = []string{"time", "timestamp"}
+)
+
+// severities are the well-known severity levels, in increasing order. They
+// match Cloud Logging's LogSeverity.
+var severities = []string{
+	"DEFAULT",
+	"DEBUG",
+	"INFO",
+	"NOTICE",
+	"WARNING",
+	"ERROR",
+	"CRITICAL",
+	"ALERT",
+	"EMERGENCY",
+}
+
+// severityAliases maps commonly used severity names to well-known ones.
+var severityAliases = map[string]string{
+	"WARN":  "WARNING",
+	"FATAL": "CRITICAL",
+}
+
+// severityRank returns the position of the severity s in severities. Matching
+// is case-insensitive.
+func severityRank(s string) (int, bool) {
+	s = strings.ToUpper(s)
+	if alias, ok := severityAliases[s]; ok {
+		s = alias
+	}
+	for i, sev := range severities {
+		if sev == s {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Record is a decoded structured record.
+type Record map[string]interface{}
+
+// Parse decodes a single line of a structured-record stream.
+//
+// It returns an error if the line isn't a JSON object.
+func Parse(line []byte) (Record, error) {
+	var r Record
+	if err := json.Unmarshal(line, &r); err != nil {
+		return nil, errors.Annotate(err, "invalid record").Err()
+	}
+	if r == nil {
+		return nil, errors.New("invalid record: not a JSON object")
+	}
+	return r, nil
+}
+
+// Valid returns true if line is a valid record.
+//
+// It is cheaper than Parse, since it doesn't decode the record.
+func Valid(line []byte) bool {
+	line = bytes.TrimSpace(line)
+	return len(line) > 0 && line[0] == '{' && json.Valid(line)
+}
+
+// Wrap returns a record holding the text of line, which isn't a valid record.
+//
+// The record's MessageField is line, and its InvalidField is true.
+func Wrap(line []byte) []byte {
+	d, err := json.Marshal(map[string]interface{}{
+		MessageField: string(line),
+		InvalidField: true,
+	})
+	if err != nil {
+		// A string and a bool can always be marshalled.
+		panic(err)
+	}
+	return d
+}
+
+// get returns the value of the first of fields that r has.
+func (r Record) get(fields []string) (string, interface{}, bool) {
+	for _, f := range fields {
+		if v, ok := r[f]; ok {
+			return f, v, true
+		}
+	}
+	return "", nil, false
+}
+
+// lookup returns the value of the field at path, descending into nested
+// objects.
+func (r Record) lookup(path []string) (interface{}, bool) {
+	var v interface{} = map[string]interface{}(r)
+	for _, name := range path {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[name]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
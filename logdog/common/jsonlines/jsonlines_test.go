@@ -0,0 +1,73 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonlines
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRecords(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Parse`, t, func() {
+		r, err := Parse([]byte(`{"severity": "INFO", "n": 3}`))
+		So(err, ShouldBeNil)
+		So(r, ShouldResemble, Record{"severity": "INFO", "n": 3.0})
+
+		for _, line := range []string{`[1, 2]`, `null`, `"foo"`, `{"a": 1`, ``} {
+			_, err := Parse([]byte(line))
+			So(err, ShouldErrLike, "invalid record")
+		}
+	})
+
+	Convey(`Valid`, t, func() {
+		So(Valid([]byte(`{"a": {"b": [1]}}`)), ShouldBeTrue)
+		So(Valid([]byte("{}\r")), ShouldBeTrue)
+		So(Valid([]byte(`[{"a": 1}]`)), ShouldBeFalse)
+		So(Valid([]byte(`{"a": 1} {"b": 2}`)), ShouldBeFalse)
+		So(Valid([]byte(`plain text`)), ShouldBeFalse)
+		So(Valid(nil), ShouldBeFalse)
+	})
+
+	Convey(`Wrap`, t, func() {
+		line := Wrap([]byte(`oops "quoted"`))
+		So(Valid(line), ShouldBeTrue)
+
+		r, err := Parse(line)
+		So(err, ShouldBeNil)
+		So(r, ShouldResemble, Record{MessageField: `oops "quoted"`, InvalidField: true})
+	})
+
+	Convey(`Format`, t, func() {
+		format := func(line string) string {
+			r, err := Parse([]byte(line))
+			So(err, ShouldBeNil)
+
+			var buf bytes.Buffer
+			So(Format(&buf, r), ShouldBeNil)
+			return buf.String()
+		}
+
+		So(format(`{"msg": "disk is full", "level": "WARNING", "time": "2019-10-01T12:00:00Z", "used": 0.97, "host": "foo"}`),
+			ShouldEqual, "2019-10-01T12:00:00Z WARNING disk is full host=foo used=0.97")
+		So(format(`{"message": "hi", "labels": {"a": "b"}, "who": "the world", "empty": ""}`),
+			ShouldEqual, `hi empty="" labels="{\"a\":\"b\"}" who="the world"`)
+		So(format(string(Wrap([]byte("not json")))), ShouldEqual, "not json")
+	})
+}
@@ -37,6 +37,13 @@ import (
 // be.
 type DatagramWriter func(io.Writer, []byte) bool
 
+// TextWriter is a callback function that, given a text line's value, writes a
+// rendering of it to the specified io.Writer.
+//
+// Returns true if the line was successfully rendered, false if it could not
+// be.
+type TextWriter func(io.Writer, []byte) bool
+
 // Renderer is a stateful instance that provides an io.Reader interface to a
 // log stream.
 type Renderer struct {
@@ -56,6 +63,11 @@ type Renderer struct {
 	// resulting string is prepended to that text line on render.
 	TextPrefix func(le *logpb.LogEntry, line *logpb.Text_Line) string
 
+	// TextWriter is a function to call to render each text line. If it returns
+	// false, or if nil, the line will be rendered verbatim. It is not used when
+	// Raw is true.
+	TextWriter TextWriter
+
 	// DatagramWriter is a function to call to render a complete datagram stream.
 	// If it returns false, or if nil, a hex dump renderer will be used to
 	// render the datagram.
@@ -106,7 +118,9 @@ func (r *Renderer) bufferNext() error {
 					r.buf.WriteString(r.TextPrefix(le, line))
 				}
 
-				r.buf.Write(line.Value)
+				if f := r.TextWriter; r.Raw || f == nil || !r.writeText(f, line.Value) {
+					r.buf.Write(line.Value)
+				}
 				if !r.Raw {
 					r.buf.WriteRune('\n')
 				} else {
@@ -158,6 +172,17 @@ func (r *Renderer) bufferNext() error {
 	return err
 }
 
+// writeText renders a text line's value into the buffer using f. If f fails,
+// anything that it wrote is discarded.
+func (r *Renderer) writeText(f TextWriter, v []byte) bool {
+	n := r.buf.Len()
+	if !f(&r.buf, v) {
+		r.buf.Truncate(n)
+		return false
+	}
+	return true
+}
+
 func dumpHex(w io.Writer, data []byte) (err error) {
 	// Hex dump.
 	d := hex.Dumper(w)
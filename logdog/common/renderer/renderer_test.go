@@ -119,6 +119,31 @@ func TestRenderer(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(b.String(), ShouldEqual, "1DELIM2DELIM")
 			})
+
+			Convey(`When deferring to a text writer`, func() {
+				r.TextWriter = func(w io.Writer, v []byte) bool {
+					if string(v) == "2" {
+						w.Write([]byte("partial"))
+						return false
+					}
+					w.Write([]byte("rendered " + string(v)))
+					return true
+				}
+
+				Convey(`Uses the writer, or renders verbatim when it returns false.`, func() {
+					_, err := b.ReadFrom(r)
+					So(err, ShouldBeNil)
+					So(b.String(), ShouldEqual, "rendered 1\n2\n")
+				})
+
+				Convey(`Doesn't use the writer when configured to render raw.`, func() {
+					r.Raw = true
+
+					_, err := b.ReadFrom(r)
+					So(err, ShouldBeNil)
+					So(b.String(), ShouldEqual, "1DELIM2DELIM")
+				})
+			})
 		})
 
 		Convey(`With BINARY log entries {{0x00}, {0x01, 0x02}, {}, {0x03}}`, func() {
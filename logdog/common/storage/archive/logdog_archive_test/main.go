@@ -36,6 +36,7 @@ import (
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/logdog/api/logpb"
+	logarchive "go.chromium.org/luci/logdog/common/archive"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/storage/archive"
 	"go.chromium.org/luci/logdog/common/types"
@@ -214,7 +215,8 @@ func unmarshalAndDump(c context.Context, out io.Writer, data []byte, msg proto.M
 type cmdRunDumpStream struct {
 	subcommands.CommandRunBase
 
-	path string
+	path       string
+	zlibFrames bool
 }
 
 var subcommandDumpStream = subcommands.Command{
@@ -224,6 +226,7 @@ var subcommandDumpStream = subcommands.Command{
 		var cmd cmdRunDumpStream
 
 		cmd.Flags.StringVar(&cmd.path, "path", "", "Google Storage path to the stream protobuf.")
+		cmd.Flags.BoolVar(&cmd.zlibFrames, "zlib-frames", false, "The stream is in the ZLIB_FRAMES format.")
 
 		return &cmd
 	},
@@ -252,8 +255,13 @@ func (cmd *cmdRunDumpStream) Run(baseApp subcommands.Application, args []string,
 	}
 	defer reader.Close()
 
+	var r io.Reader = reader
+	if cmd.zlibFrames {
+		r = logarchive.NewFrameReader(reader)
+	}
+
 	descFrame := true
-	err = dumpRecordIO(c, reader, func(c context.Context, d []byte) error {
+	err = dumpRecordIO(c, r, func(c context.Context, d []byte) error {
 		if descFrame {
 			descFrame = false
 
@@ -0,0 +1,63 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"io/ioutil"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/gcloud/gs"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/archive"
+
+	cloudStorage "cloud.google.com/go/storage"
+)
+
+// SearchIndexPath returns the path of the search index that the archivist
+// stores alongside the log stream index at indexPath.
+func SearchIndexPath(indexPath gs.Path) gs.Path {
+	bucket, filename := indexPath.Split()
+	return gs.MakePath(bucket, path.Join(path.Dir(filename), archive.SearchIndexName))
+}
+
+// LoadSearchIndex loads a log stream's search index from Google Storage.
+//
+// If there is no search index at the path (e.g., the stream was archived
+// before search indexes were introduced), LoadSearchIndex returns nil.
+func LoadSearchIndex(client gs.Client, p gs.Path) (*logpb.SearchIndex, error) {
+	r, err := client.NewReader(p, 0, -1)
+	switch errors.Unwrap(err) {
+	case nil:
+	case cloudStorage.ErrObjectNotExist, cloudStorage.ErrBucketNotExist:
+		return nil, nil
+	default:
+		return nil, errors.Annotate(err, "failed to create search index Reader").Err()
+	}
+	defer r.Close()
+
+	d, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read search index").Err()
+	}
+
+	var idx logpb.SearchIndex
+	if err := proto.Unmarshal(d, &idx); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal search index").Err()
+	}
+	return &idx, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/gcloud/gs"
+	"go.chromium.org/luci/logdog/api/logpb"
+
+	cloudStorage "cloud.google.com/go/storage"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type searchGSClient struct {
+	gs.Client
+
+	data []byte
+}
+
+func (c *searchGSClient) NewReader(p gs.Path, offset, length int64) (io.ReadCloser, error) {
+	if c.data == nil {
+		return nil, cloudStorage.ErrObjectNotExist
+	}
+	return ioutil.NopCloser(bytes.NewReader(c.data)), nil
+}
+
+func TestSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	Convey(`SearchIndexPath is next to the index.`, t, func() {
+		So(SearchIndexPath("gs://bucket/proj/foo/+/bar/logstream.index"), ShouldEqual,
+			gs.Path("gs://bucket/proj/foo/+/bar/logstream.search"))
+	})
+
+	Convey(`LoadSearchIndex`, t, func() {
+		client := searchGSClient{}
+
+		Convey(`Returns nil if there is no search index.`, func() {
+			idx, err := LoadSearchIndex(&client, "gs://bucket/logstream.search")
+			So(err, ShouldBeNil)
+			So(idx, ShouldBeNil)
+		})
+
+		Convey(`Loads the search index.`, func() {
+			exp := &logpb.SearchIndex{Bloom: []byte{0x01, 0x02}, HashCount: 7, LineCount: 3}
+			client.data, _ = proto.Marshal(exp)
+
+			idx, err := LoadSearchIndex(&client, "gs://bucket/logstream.search")
+			So(err, ShouldBeNil)
+			So(idx, ShouldResembleProto, exp)
+		})
+
+		Convey(`Fails on a corrupt search index.`, func() {
+			client.data = []byte{0xff}
+
+			_, err := LoadSearchIndex(&client, "gs://bucket/logstream.search")
+			So(err, ShouldErrLike, "failed to unmarshal search index")
+		})
+	})
+}
@@ -37,6 +37,7 @@ import (
 	"go.chromium.org/luci/common/iotools"
 	log "go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/common/archive"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/types"
 
@@ -153,15 +154,26 @@ func (s *storageImpl) getLogEntriesIter(c context.Context, st *getStrategy, cb s
 		}
 	}()
 
+	// Decompress the stream, if necessary. Our start offset is at the start of
+	// a frame.
+	var dataReader io.Reader = storageReader
+	if st.format == logpb.LogIndex_ZLIB_FRAMES {
+		dataReader = archive.NewFrameReader(storageReader)
+	}
+
 	// Count how many bytes we've read.
-	cr := iotools.CountingReader{Reader: storageReader}
+	cr := iotools.CountingReader{Reader: dataReader}
 
 	// Iteratively update our strategy's start offset each time we read a complete
 	// frame.
+	//
+	// If we read from offset 0, the first frame will be the log stream's
+	// descriptor, which we can discard.
 	var (
-		rio       = recordio.NewReader(&cr, maxStreamRecordSize)
-		buf       bytes.Buffer
-		remaining = st.count
+		rio         = recordio.NewReader(&cr, maxStreamRecordSize)
+		buf         bytes.Buffer
+		remaining   = st.count
+		discardDesc = (offset == 0)
 	)
 	for {
 		// Reset the count so we know how much we read for this frame.
@@ -189,11 +201,9 @@ func (s *storageImpl) getLogEntriesIter(c context.Context, st *getStrategy, cb s
 			return errors.Annotate(io.EOF, "incomplete frame read").Err()
 		}
 
-		// If we read from offset 0, the first frame will be the log stream's
-		// descriptor, which we can discard.
-		discardFrame := (offset == 0)
 		offset += uint64(cr.Count)
-		if discardFrame {
+		if discardDesc {
+			discardDesc = false
 			continue
 		}
 
@@ -285,6 +295,16 @@ func (s *storageImpl) Tail(c context.Context, project string, path types.StreamP
 	}
 }
 
+// Format returns the format of the log stream archived in st, as recorded in
+// its index. st must be a Storage instance returned by New.
+func Format(c context.Context, st storage.Storage) (logpb.LogIndex_Format, error) {
+	idx, err := st.(*storageImpl).getIndex(c)
+	if err != nil {
+		return 0, err
+	}
+	return idx.Format, nil
+}
+
 // getIndex returns the cached log stream index, fetching it if necessary.
 func (s *storageImpl) getIndex(c context.Context) (*logpb.LogIndex, error) {
 	idx := s.index.Load()
@@ -366,6 +386,8 @@ func loadIndex(c context.Context, client gs.Client, path gs.Path, cache storage.
 type getStrategy struct {
 	// startIndex is desired initial log entry index.
 	startIndex types.MessageIndex
+	// format is the format of the log stream.
+	format logpb.LogIndex_Format
 
 	// startOffset is the beginning byte offset of the log entry stream. This may
 	// be lower than the offset of the starting record if the index is sparse.
@@ -397,6 +419,7 @@ func (gs *getStrategy) setCount(v uint64) {
 func buildGetStrategy(req *storage.GetRequest, idx *logpb.LogIndex) *getStrategy {
 	st := getStrategy{
 		startIndex: req.Index,
+		format:     idx.Format,
 	}
 
 	// If the user has requested an index past the end of the stream, return no
@@ -422,6 +445,13 @@ func buildGetStrategy(req *storage.GetRequest, idx *logpb.LogIndex) *getStrategy
 	if req.Limit > 0 {
 		st.setCount(uint64(req.Limit))
 
+		// Frames can't be cut short, so read up to the frame following the one
+		// holding the last entry that we are going to return.
+		if idx.Format == logpb.LogIndex_ZLIB_FRAMES {
+			st.endOffset = frameOffsetAfter(idx.Entries, req.Index+types.MessageIndex(req.Limit)-1)
+			return &st
+		}
+
 		// Find the index entry for the stream entry AFTER the last one we are going
 		// to return.
 		entryAfterGetBlock := req.Index + types.MessageIndex(req.Limit)
@@ -482,3 +512,24 @@ func indexEntryFor(entries []*logpb.LogIndex_Entry, i types.MessageIndex) int {
 	// will return nil.
 	return s - 1
 }
+
+// frameOffsetAfter returns the offset of the first ZLIB_FRAMES frame after the
+// one holding the log entry at index i, or 0 if there is no such frame.
+//
+// Index entries hold the offset of the frame containing their log entry.
+// Every frame but the first starts at an index entry, so the frame holding i
+// is that of the closest index entry (<=) to i.
+func frameOffsetAfter(entries []*logpb.LogIndex_Entry, i types.MessageIndex) uint64 {
+	e := indexEntryFor(entries, i)
+
+	start := uint64(0)
+	if e >= 0 {
+		start = entries[e].Offset
+	}
+	for _, entry := range entries[e+1:] {
+		if entry.Offset > start {
+			return entry.Offset
+		}
+	}
+	return 0
+}
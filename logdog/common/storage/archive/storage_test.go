@@ -43,7 +43,8 @@ const (
 )
 
 type logStreamGenerator struct {
-	lines []string
+	lines  []string
+	format logpb.LogIndex_Format
 
 	indexBuf  bytes.Buffer
 	streamBuf bytes.Buffer
@@ -90,6 +91,7 @@ func (g *logStreamGenerator) generate(lines ...string) {
 		Source:      &src,
 		LogWriter:   &g.streamBuf,
 		IndexWriter: &g.indexBuf,
+		Format:      g.format,
 	})
 	if err != nil {
 		panic(err)
@@ -216,12 +218,12 @@ func (c *fakeGSClient) NewReader(p gs.Path, offset, length int64) (io.ReadCloser
 	return ioutil.NopCloser(&errReader{bytes.NewReader(data), readerErr}), nil
 }
 
-func testArchiveStorage(t *testing.T, limit int64) {
+func testArchiveStorage(t *testing.T, limit int64, format logpb.LogIndex_Format) {
 	Convey(`A testing archive instance`, t, func() {
 		var (
 			c      = context.Background()
 			client fakeGSClient
-			gen    logStreamGenerator
+			gen    = logStreamGenerator{format: format}
 		)
 		defer client.Close()
 
@@ -258,15 +260,23 @@ func testArchiveStorage(t *testing.T, limit int64) {
 			for _, tc := range []struct {
 				title string
 				mod   func()
+
+				// needsIndex is true if the test case can't be read without the
+				// index, which holds the stream's format.
+				needsIndex bool
 			}{
-				{`Complete index`, func() {}},
-				{`Empty index protobuf`, func() { gen.sparseIndex() }},
-				{`No index provided`, func() { stImpl.Index = "" }},
-				{`Invalid index path`, func() { stImpl.Index = "does-not-exist" }},
-				{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }},
-				{`Sparse index with a terminal entry`, func() { gen.sparseIndex(1, 3, 4) }},
-				{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }},
+				{`Complete index`, func() {}, false},
+				{`Empty index protobuf`, func() { gen.sparseIndex() }, false},
+				{`No index provided`, func() { stImpl.Index = "" }, true},
+				{`Invalid index path`, func() { stImpl.Index = "does-not-exist" }, true},
+				{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }, false},
+				{`Sparse index with a terminal entry`, func() { gen.sparseIndex(1, 3, 4) }, false},
+				{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }, false},
 			} {
+				if tc.needsIndex && format != logpb.LogIndex_RECORDIO {
+					continue
+				}
+
 				Convey(fmt.Sprintf(`Test Case: %q`, tc.title), func() {
 					tc.mod()
 
@@ -413,10 +423,20 @@ func testArchiveStorage(t *testing.T, limit int64) {
 
 func TestArchiveStorage(t *testing.T) {
 	t.Parallel()
-	testArchiveStorage(t, -1)
+	testArchiveStorage(t, -1, logpb.LogIndex_RECORDIO)
 }
 
 func TestArchiveStorageWithLimit(t *testing.T) {
 	t.Parallel()
-	testArchiveStorage(t, 4)
+	testArchiveStorage(t, 4, logpb.LogIndex_RECORDIO)
+}
+
+func TestArchiveStorageZlibFrames(t *testing.T) {
+	t.Parallel()
+	testArchiveStorage(t, -1, logpb.LogIndex_ZLIB_FRAMES)
+}
+
+func TestArchiveStorageZlibFramesWithLimit(t *testing.T) {
+	t.Parallel()
+	testArchiveStorage(t, 4, logpb.LogIndex_ZLIB_FRAMES)
 }
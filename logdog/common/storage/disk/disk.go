@@ -0,0 +1,503 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// DefaultMaxSegmentSize is the default maximum size of a segment file, in
+// bytes.
+const DefaultMaxSegmentSize = 64 * 1024 * 1024
+
+// segmentExt is the file extension of segment files.
+const segmentExt = ".seg"
+
+// Options is the set of options for opening a disk Storage.
+type Options struct {
+	// Dir is the directory that holds the Storage's segment files. It will be
+	// created if it doesn't exist.
+	Dir string
+
+	// MaxSegmentSize is the size, in bytes, after which the current segment file
+	// is closed and a new one is started. If <= 0, DefaultMaxSegmentSize will be
+	// used.
+	MaxSegmentSize int64
+}
+
+type streamKey struct {
+	project string
+	path    types.StreamPath
+}
+
+type entryKey struct {
+	streamKey
+	index types.MessageIndex
+}
+
+// location is the location of a log entry's data within a segment.
+type location struct {
+	seg    *segment
+	offset int64
+	size   int
+}
+
+type logStream struct {
+	entries map[types.MessageIndex]location
+
+	// contiguous is the number of log entries, starting with index 0, that are
+	// present without any gaps.
+	contiguous types.MessageIndex
+}
+
+// updateContiguous advances contiguous past any log entries that are present.
+func (ls *logStream) updateContiguous() {
+	for {
+		if _, ok := ls.entries[ls.contiguous]; !ok {
+			return
+		}
+		ls.contiguous++
+	}
+}
+
+// segment is a single segment file.
+type segment struct {
+	id   uint64
+	path string
+	fd   *os.File
+
+	// size is the size of the valid data in the segment file.
+	size int64
+	// modified is the time of the segment's most recent write.
+	modified time.Time
+
+	// keys is the set of log entries that are stored in this segment.
+	keys []entryKey
+}
+
+func (seg *segment) read(loc location) ([]byte, error) {
+	d := make([]byte, loc.size)
+	if _, err := seg.fd.ReadAt(d, loc.offset); err != nil {
+		return nil, errors.Annotate(err, "failed to read from segment %q", seg.path).Err()
+	}
+	return d, nil
+}
+
+// Storage is an implementation of the storage.Storage interface that stores
+// log entries in segment files on local disk.
+//
+// Tail follows the same semantics as the BigTable implementation: it returns
+// the last log entry in the contiguous run of log entries starting at index 0.
+type Storage struct {
+	opts Options
+
+	mu        sync.RWMutex
+	maxLogAge time.Duration
+	segments  []*segment
+	streams   map[streamKey]*logStream
+	closed    bool
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// Open opens the disk Storage described by o, loading the index of any log
+// entries that are already stored in it.
+//
+// If the last segment ends with an incomplete or corrupt record (e.g., due to a
+// crash during a write), it will be truncated to remove that record.
+func Open(o Options) (*Storage, error) {
+	if o.Dir == "" {
+		return nil, errors.New("a directory is required")
+	}
+	if o.MaxSegmentSize <= 0 {
+		o.MaxSegmentSize = DefaultMaxSegmentSize
+	}
+	if err := os.MkdirAll(o.Dir, 0755); err != nil {
+		return nil, errors.Annotate(err, "failed to create directory").Err()
+	}
+
+	s := Storage{
+		opts:    o,
+		streams: make(map[streamKey]*logStream),
+	}
+
+	ids, err := s.listSegments()
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range ids {
+		seg, err := s.loadSegment(id)
+		if err != nil {
+			s.Close()
+			return nil, err
+		}
+
+		// Only the last segment can be written to, and so only the last segment
+		// may be truncated.
+		if i == len(ids)-1 {
+			if err := seg.fd.Truncate(seg.size); err != nil {
+				s.Close()
+				return nil, errors.Annotate(err, "failed to truncate segment %q", seg.path).Err()
+			}
+		}
+	}
+
+	if len(s.segments) == 0 || s.activeSegment().size >= o.MaxSegmentSize {
+		if err := s.addSegmentLocked(time.Time{}); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+	return &s, nil
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, seg := range s.segments {
+		seg.fd.Close()
+	}
+	s.segments = nil
+	s.streams = nil
+	s.closed = true
+}
+
+// Config implements storage.Storage.
+//
+// If a maximum log age is configured, segments whose most recent log entry
+// exceeds it will be deleted.
+func (s *Storage) Config(c context.Context, cfg storage.Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errors.New("storage is closed")
+	}
+	s.maxLogAge = cfg.MaxLogAge
+	return s.expireLocked(c)
+}
+
+// Put implements storage.Storage.
+func (s *Storage) Put(c context.Context, req storage.PutRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errors.New("storage is closed")
+	}
+
+	sk := streamKey{req.Project, req.Path}
+	if ls := s.streams[sk]; ls != nil {
+		for i := range req.Values {
+			if _, ok := ls.entries[req.Index+types.MessageIndex(i)]; ok {
+				return storage.ErrExists
+			}
+		}
+	}
+
+	// Write all of the records in a single operation.
+	seg := s.activeSegment()
+	var buf []byte
+	locs := make([]location, len(req.Values))
+	for i, v := range req.Values {
+		start := len(buf)
+		var dataOffset int
+		buf, dataOffset = appendRecord(buf, req.Project, req.Path, req.Index+types.MessageIndex(i), v)
+		locs[i] = location{
+			seg:    seg,
+			offset: seg.size + int64(start+dataOffset),
+			size:   len(v),
+		}
+	}
+
+	if _, err := seg.fd.WriteAt(buf, seg.size); err != nil {
+		// Discard anything that was partially written.
+		seg.fd.Truncate(seg.size)
+		return errors.Annotate(err, "failed to write to segment %q", seg.path).Err()
+	}
+	if err := seg.fd.Sync(); err != nil {
+		seg.fd.Truncate(seg.size)
+		return errors.Annotate(err, "failed to sync segment %q", seg.path).Err()
+	}
+	seg.size += int64(len(buf))
+	seg.modified = clock.Now(c)
+
+	for i, loc := range locs {
+		s.addEntryLocked(entryKey{sk, req.Index + types.MessageIndex(i)}, loc)
+	}
+
+	if seg.size >= s.opts.MaxSegmentSize {
+		if err := s.addSegmentLocked(seg.modified); err != nil {
+			return err
+		}
+		if err := s.expireLocked(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(c context.Context, req storage.GetRequest, cb storage.GetCallback) error {
+	var entries []*storage.Entry
+	err := func() error {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		if s.closed {
+			return errors.New("storage is closed")
+		}
+
+		ls := s.streams[streamKey{req.Project, req.Path}]
+		if ls == nil {
+			return storage.ErrDoesNotExist
+		}
+
+		indexes := make([]types.MessageIndex, 0, len(ls.entries))
+		for idx := range ls.entries {
+			if idx >= req.Index {
+				indexes = append(indexes, idx)
+			}
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+		if req.Limit > 0 && len(indexes) > req.Limit {
+			indexes = indexes[:req.Limit]
+		}
+
+		entries = make([]*storage.Entry, len(indexes))
+		for i, idx := range indexes {
+			var d []byte
+			if !req.KeysOnly {
+				loc := ls.entries[idx]
+				var err error
+				if d, err = loc.seg.read(loc); err != nil {
+					return err
+				}
+			}
+			entries[i] = storage.MakeEntry(d, idx)
+		}
+		return nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if !cb(e) {
+			break
+		}
+	}
+	return nil
+}
+
+// Tail implements storage.Storage.
+func (s *Storage) Tail(c context.Context, project string, path types.StreamPath) (*storage.Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil, errors.New("storage is closed")
+	}
+
+	ls := s.streams[streamKey{project, path}]
+	if ls == nil || ls.contiguous == 0 {
+		return nil, storage.ErrDoesNotExist
+	}
+
+	idx := ls.contiguous - 1
+	loc := ls.entries[idx]
+	d, err := loc.seg.read(loc)
+	if err != nil {
+		return nil, err
+	}
+	return storage.MakeEntry(d, idx), nil
+}
+
+func (s *Storage) activeSegment() *segment { return s.segments[len(s.segments)-1] }
+
+func (s *Storage) segmentPath(id uint64) string {
+	return filepath.Join(s.opts.Dir, fmt.Sprintf("%016x%s", id, segmentExt))
+}
+
+// listSegments returns the IDs of the segment files in the Storage's
+// directory, in ascending order.
+func (s *Storage) listSegments() ([]uint64, error) {
+	files, err := ioutil.ReadDir(s.opts.Dir)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list directory").Err()
+	}
+
+	var ids []uint64
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 16, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// loadSegment opens the segment with the supplied ID and adds its log entries
+// to the index.
+//
+// Loading stops at the first incomplete or corrupt record. The segment's size
+// is set to the end of the last valid record.
+func (s *Storage) loadSegment(id uint64) (*segment, error) {
+	path := s.segmentPath(id)
+	fd, err := os.OpenFile(path, os.O_RDWR, 0644)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to open segment %q", path).Err()
+	}
+	st, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return nil, errors.Annotate(err, "failed to stat segment %q", path).Err()
+	}
+
+	seg := &segment{
+		id:       id,
+		path:     path,
+		fd:       fd,
+		modified: st.ModTime(),
+	}
+	s.segments = append(s.segments, seg)
+
+	err = scanRecords(fd, func(rec *record, offset int64, size int) {
+		seg.size = offset + int64(size)
+
+		key := entryKey{streamKey{rec.project, rec.path}, rec.index}
+		if ls := s.streams[key.streamKey]; ls != nil {
+			if _, ok := ls.entries[key.index]; ok {
+				// Keep the first copy of any duplicate entries.
+				return
+			}
+		}
+		s.addEntryLocked(key, location{
+			seg:    seg,
+			offset: offset + int64(rec.dataOffset),
+			size:   len(rec.data),
+		})
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read segment %q", path).Err()
+	}
+	return seg, nil
+}
+
+// addSegmentLocked creates a new, empty segment and makes it the active
+// segment.
+func (s *Storage) addSegmentLocked(now time.Time) error {
+	var id uint64
+	if len(s.segments) > 0 {
+		id = s.activeSegment().id + 1
+	}
+
+	path := s.segmentPath(id)
+	fd, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Annotate(err, "failed to create segment %q", path).Err()
+	}
+	s.segments = append(s.segments, &segment{
+		id:       id,
+		path:     path,
+		fd:       fd,
+		modified: now,
+	})
+	return nil
+}
+
+func (s *Storage) addEntryLocked(key entryKey, loc location) {
+	ls := s.streams[key.streamKey]
+	if ls == nil {
+		ls = &logStream{
+			entries: make(map[types.MessageIndex]location),
+		}
+		s.streams[key.streamKey] = ls
+	}
+	ls.entries[key.index] = loc
+	ls.updateContiguous()
+
+	loc.seg.keys = append(loc.seg.keys, key)
+}
+
+// expireLocked deletes all segments, other than the active segment, whose most
+// recent write is older than the configured maximum log age.
+func (s *Storage) expireLocked(c context.Context) error {
+	if s.maxLogAge <= 0 {
+		return nil
+	}
+
+	cutoff := clock.Now(c).Add(-s.maxLogAge)
+	var keep []*segment
+	for i, seg := range s.segments {
+		if i == len(s.segments)-1 || !seg.modified.Before(cutoff) {
+			keep = append(keep, seg)
+			continue
+		}
+
+		seg.fd.Close()
+		if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+			return errors.Annotate(err, "failed to delete segment %q", seg.path).Err()
+		}
+		s.removeSegmentEntriesLocked(seg)
+	}
+	s.segments = keep
+	return nil
+}
+
+func (s *Storage) removeSegmentEntriesLocked(seg *segment) {
+	touched := make(map[streamKey]*logStream)
+	for _, key := range seg.keys {
+		ls := s.streams[key.streamKey]
+		if ls == nil {
+			continue
+		}
+		if loc, ok := ls.entries[key.index]; ok && loc.seg == seg {
+			delete(ls.entries, key.index)
+			touched[key.streamKey] = ls
+		}
+	}
+
+	for sk, ls := range touched {
+		if len(ls.entries) == 0 {
+			delete(s.streams, sk)
+			continue
+		}
+		ls.contiguous = 0
+		ls.updateContiguous()
+	}
+}
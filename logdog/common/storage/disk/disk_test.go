@@ -0,0 +1,335 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func mustGetIndex(e *storage.Entry) types.MessageIndex {
+	idx, err := e.GetStreamIndex()
+	if err != nil {
+		panic(err)
+	}
+	return idx
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A disk Storage instance`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+
+		dir, err := ioutil.TempDir("", "logdog_disk_storage")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		opts := Options{Dir: dir}
+		s, err := Open(opts)
+		So(err, ShouldBeNil)
+		defer func() { s.Close() }()
+
+		reopen := func() {
+			s.Close()
+			s, err = Open(opts)
+			So(err, ShouldBeNil)
+		}
+
+		project := "test-project"
+		get := func(path string, index int, limit int, keysOnly bool) ([]string, error) {
+			req := storage.GetRequest{
+				Project:  project,
+				Path:     types.StreamPath(path),
+				Index:    types.MessageIndex(index),
+				Limit:    limit,
+				KeysOnly: keysOnly,
+			}
+			var got []string
+			err := s.Get(c, req, func(e *storage.Entry) bool {
+				if keysOnly {
+					So(e.D, ShouldBeNil)
+					got = append(got, strconv.Itoa(int(mustGetIndex(e))))
+				} else {
+					got = append(got, string(e.D))
+				}
+				return true
+			})
+			return got, err
+		}
+
+		put := func(path string, index int, d ...string) error {
+			data := make([][]byte, len(d))
+			for i, v := range d {
+				data[i] = []byte(v)
+			}
+
+			return s.Put(c, storage.PutRequest{
+				Project: project,
+				Path:    types.StreamPath(path),
+				Index:   types.MessageIndex(index),
+				Values:  data,
+			})
+		}
+
+		tail := func(path string) (string, error) {
+			e, err := s.Tail(c, project, types.StreamPath(path))
+			if err != nil {
+				return "", err
+			}
+			return string(e.D), nil
+		}
+
+		Convey(`With data: A{0, 1, 2, 3, 4}, B{10, 12, 13}, C{0, 1, 2, 4}`, func() {
+			So(put("A", 0, "0", "1", "2"), ShouldBeNil)
+			So(put("A", 3, "33", "444"), ShouldBeNil)
+			So(put("B", 10, "xxxxxxxxxxx"), ShouldBeNil)
+			So(put("B", 12, "xxxxxxxxxxxxx", "xxxxxxxxxxxxxx"), ShouldBeNil)
+			So(put("C", 0, "0", "11", "222"), ShouldBeNil)
+			So(put("C", 4, "44444"), ShouldBeNil)
+
+			// Every test runs both against the live instance and against an
+			// instance that has reloaded its index from disk.
+			for _, reload := range []bool{false, true} {
+				reload := reload
+				name := "Live"
+				if reload {
+					name = "Reopened"
+				}
+
+				Convey(name, func() {
+					if reload {
+						reopen()
+					}
+
+					Convey(`Put()`, func() {
+						Convey(`Will return ErrExists when putting an existing entry.`, func() {
+							So(put("A", 4, "foo"), ShouldEqual, storage.ErrExists)
+
+							Convey(`And will not have written anything.`, func() {
+								got, err := get("A", 4, 0, false)
+								So(err, ShouldBeNil)
+								So(got, ShouldResemble, []string{"444"})
+							})
+						})
+
+						Convey(`Will return ErrExists if any entry in the request exists.`, func() {
+							So(put("C", 3, "333", "foo"), ShouldEqual, storage.ErrExists)
+						})
+
+						Convey(`Will fill gaps.`, func() {
+							So(put("C", 3, "3333"), ShouldBeNil)
+
+							got, err := tail("C")
+							So(err, ShouldBeNil)
+							So(got, ShouldEqual, "44444")
+						})
+					})
+
+					Convey(`Get()`, func() {
+						Convey(`Can fetch the full stream, "A".`, func() {
+							got, err := get("A", 0, 0, false)
+							So(err, ShouldBeNil)
+							So(got, ShouldResemble, []string{"0", "1", "2", "33", "444"})
+						})
+
+						Convey(`Will fetch A{1, 2, 3, 4} with index=1.`, func() {
+							got, err := get("A", 1, 0, false)
+							So(err, ShouldBeNil)
+							So(got, ShouldResemble, []string{"1", "2", "33", "444"})
+						})
+
+						Convey(`Will fetch A{1, 2} with index=1 and limit=2.`, func() {
+							got, err := get("A", 1, 2, false)
+							So(err, ShouldBeNil)
+							So(got, ShouldResemble, []string{"1", "2"})
+						})
+
+						Convey(`Will fetch B{12, 13} when index=11.`, func() {
+							got, err := get("B", 11, 0, true)
+							So(err, ShouldBeNil)
+							So(got, ShouldResemble, []string{"12", "13"})
+						})
+
+						Convey(`Can fetch keys only.`, func() {
+							got, err := get("C", 0, 0, true)
+							So(err, ShouldBeNil)
+							So(got, ShouldResemble, []string{"0", "1", "2", "4"})
+						})
+
+						Convey(`Will stop iterating if callback returns false.`, func() {
+							count := 0
+							err := s.Get(c, storage.GetRequest{Project: project, Path: "A"}, func(*storage.Entry) bool {
+								count++
+								return false
+							})
+							So(err, ShouldBeNil)
+							So(count, ShouldEqual, 1)
+						})
+
+						Convey(`Will fail to retrieve records if the project doesn't exist.`, func() {
+							project = "project-does-not-exist"
+							_, err := get("A", 0, 0, false)
+							So(err, ShouldEqual, storage.ErrDoesNotExist)
+						})
+
+						Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+							_, err := get("INVALID", 0, 0, false)
+							So(err, ShouldEqual, storage.ErrDoesNotExist)
+						})
+					})
+
+					Convey(`Tail()`, func() {
+						Convey(`A tail request for "A" returns A{4}.`, func() {
+							got, err := tail("A")
+							So(err, ShouldBeNil)
+							So(got, ShouldEqual, "444")
+						})
+
+						Convey(`A tail request for "B" returns nothing (no contiguous logs).`, func() {
+							_, err := tail("B")
+							So(err, ShouldEqual, storage.ErrDoesNotExist)
+						})
+
+						Convey(`A tail request for "C" returns C{2}.`, func() {
+							got, err := tail("C")
+							So(err, ShouldBeNil)
+							So(got, ShouldEqual, "222")
+						})
+
+						Convey(`A tail request for "INVALID" errors NOT FOUND.`, func() {
+							_, err := tail("INVALID")
+							So(err, ShouldEqual, storage.ErrDoesNotExist)
+						})
+					})
+				})
+			}
+
+			Convey(`Will truncate an incomplete record when reopened.`, func() {
+				segPath := s.activeSegment().path
+				s.Close()
+
+				fd, err := os.OpenFile(segPath, os.O_WRONLY|os.O_APPEND, 0644)
+				So(err, ShouldBeNil)
+				_, err = fd.Write([]byte{0x00, 0x00, 0x00, 0x10, 0xFF})
+				So(err, ShouldBeNil)
+				So(fd.Close(), ShouldBeNil)
+
+				s, err = Open(opts)
+				So(err, ShouldBeNil)
+
+				So(put("A", 5, "55555"), ShouldBeNil)
+				reopen()
+
+				got, err := get("A", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"0", "1", "2", "33", "444", "55555"})
+			})
+
+			Convey(`Will ignore a corrupt record when reopened.`, func() {
+				segPath := s.activeSegment().path
+				s.Close()
+
+				d, err := ioutil.ReadFile(segPath)
+				So(err, ShouldBeNil)
+				d[len(d)-1] ^= 0xFF
+				So(ioutil.WriteFile(segPath, d, 0644), ShouldBeNil)
+
+				s, err = Open(opts)
+				So(err, ShouldBeNil)
+
+				got, err := get("C", 0, 0, true)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"0", "1", "2"})
+			})
+		})
+
+		Convey(`With a small maximum segment size`, func() {
+			s.Close()
+			opts.MaxSegmentSize = 64
+			s, err = Open(opts)
+			So(err, ShouldBeNil)
+
+			for i := 0; i < 10; i++ {
+				So(put("A", i, "0123456789012345678901234567890123456789"), ShouldBeNil)
+				tc.Add(time.Minute)
+			}
+			So(put("B", 0, "b"), ShouldBeNil)
+
+			segments := func() int {
+				matches, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+				So(err, ShouldBeNil)
+				return len(matches)
+			}
+
+			Convey(`Will write multiple segments.`, func() {
+				So(segments(), ShouldEqual, 11)
+
+				got, err := get("A", 0, 0, true)
+				So(err, ShouldBeNil)
+				So(got, ShouldHaveLength, 10)
+
+				reopen()
+				got, err = get("A", 0, 0, true)
+				So(err, ShouldBeNil)
+				So(got, ShouldHaveLength, 10)
+			})
+
+			Convey(`Will expire old segments.`, func() {
+				So(s.Config(c, storage.Config{MaxLogAge: 5*time.Minute + time.Second}), ShouldBeNil)
+				So(segments(), ShouldEqual, 6)
+
+				got, err := get("A", 0, 0, true)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"5", "6", "7", "8", "9"})
+
+				_, err = tail("A")
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+				got, err = get("B", 0, 0, false)
+				So(err, ShouldBeNil)
+				So(got, ShouldResemble, []string{"b"})
+			})
+
+			Convey(`Will delete streams whose entries have all expired.`, func() {
+				So(s.Config(c, storage.Config{MaxLogAge: time.Second}), ShouldBeNil)
+
+				_, err := get("A", 0, 0, true)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+			})
+		})
+
+		Convey(`Will fail once closed.`, func() {
+			s.Close()
+			So(put("A", 0, "0"), ShouldErrLike, "storage is closed")
+			_, err := get("A", 0, 0, false)
+			So(err, ShouldErrLike, "storage is closed")
+			_, err = tail("A")
+			So(err, ShouldErrLike, "storage is closed")
+		})
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package disk provides an implementation of the Storage interface backed by
+// files on local disk. It is suitable for small deployments and for tests that
+// need durable intermediate storage without BigTable.
+//
+// Segments
+//
+// Log entries are appended to segment files in a single directory. Segment
+// files are named after their sequence number, and only the newest segment is
+// ever written to. Once a segment exceeds its maximum size, a new segment is
+// started.
+//
+// Each log entry is stored as a single record, consisting of its size, a
+// CRC-32C checksum, the project and path of its log stream, its stream index,
+// and its raw LogEntry protobuf data. Each Put is synced to disk before it
+// returns.
+//
+// Index
+//
+// An in-memory index maps each log stream to the locations of its log entries.
+// It is rebuilt by scanning the segment files when the Storage is opened. If the
+// newest segment ends with an incomplete or corrupt record, such as one left
+// behind by a crash, the segment is truncated to its last valid record.
+//
+// Expiration
+//
+// When a maximum log age is configured, whole segments are deleted once their
+// most recent write is older than that age.
+package disk
@@ -0,0 +1,172 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+// A record is stored in a segment as:
+//
+//	[4] big-endian payload size
+//	[4] big-endian CRC-32C of the payload
+//	payload:
+//	  uvarint project length, project
+//	  uvarint path length, path
+//	  uvarint stream index
+//	  log entry data
+const recordHeaderSize = 8
+
+// maxRecordSize is the maximum size of a record's payload. Larger sizes are
+// treated as corruption.
+const maxRecordSize = 2 * types.MaxLogEntryDataSize
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errCorruptRecord is returned when a record payload can't be decoded.
+var errCorruptRecord = errors.New("corrupt record")
+
+type record struct {
+	project string
+	path    types.StreamPath
+	index   types.MessageIndex
+	data    []byte
+
+	// dataOffset is the offset of data from the beginning of the record,
+	// including its header.
+	dataOffset int
+}
+
+// appendRecord appends a record for the supplied log entry to buf. It returns
+// the extended buffer, and the offset of the log entry data relative to the
+// start of the record.
+func appendRecord(buf []byte, project string, path types.StreamPath, index types.MessageIndex, data []byte) ([]byte, int) {
+	start := len(buf)
+	buf = append(buf, make([]byte, recordHeaderSize)...)
+
+	var tmp [binary.MaxVarintLen64]byte
+	appendString := func(v string) {
+		buf = append(buf, tmp[:binary.PutUvarint(tmp[:], uint64(len(v)))]...)
+		buf = append(buf, v...)
+	}
+	appendString(project)
+	appendString(string(path))
+	buf = append(buf, tmp[:binary.PutUvarint(tmp[:], uint64(index))]...)
+
+	dataOffset := len(buf) - start
+	buf = append(buf, data...)
+
+	payload := buf[start+recordHeaderSize:]
+	binary.BigEndian.PutUint32(buf[start:], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[start+4:], crc32.Checksum(payload, crcTable))
+	return buf, dataOffset
+}
+
+// decodeRecord decodes a record payload.
+func decodeRecord(payload []byte) (*record, error) {
+	var rec record
+	pos := 0
+
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(payload[pos:])
+		if n <= 0 {
+			return 0, errCorruptRecord
+		}
+		pos += n
+		return v, nil
+	}
+	readString := func() (string, error) {
+		size, err := readUvarint()
+		if err != nil {
+			return "", err
+		}
+		if size > uint64(len(payload)-pos) {
+			return "", errCorruptRecord
+		}
+		v := string(payload[pos : pos+int(size)])
+		pos += int(size)
+		return v, nil
+	}
+
+	var err error
+	if rec.project, err = readString(); err != nil {
+		return nil, err
+	}
+	path, err := readString()
+	if err != nil {
+		return nil, err
+	}
+	rec.path = types.StreamPath(path)
+	index, err := readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	rec.index = types.MessageIndex(index)
+
+	rec.data = payload[pos:]
+	rec.dataOffset = recordHeaderSize + pos
+	return &rec, nil
+}
+
+// scanRecords reads the records in r, invoking cb with each record along with
+// its offset and total size.
+//
+// Scanning stops without error at the end of r, or at the first incomplete or
+// corrupt record.
+func scanRecords(r io.Reader, cb func(rec *record, offset int64, size int)) error {
+	br := bufio.NewReader(r)
+	header := make([]byte, recordHeaderSize)
+
+	var offset int64
+	for {
+		switch _, err := io.ReadFull(br, header); err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			return nil
+		default:
+			return err
+		}
+
+		size := binary.BigEndian.Uint32(header)
+		if size > maxRecordSize {
+			return nil
+		}
+		payload := make([]byte, size)
+		switch _, err := io.ReadFull(br, payload); err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			return nil
+		default:
+			return err
+		}
+
+		if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(header[4:]) {
+			return nil
+		}
+		rec, err := decodeRecord(payload)
+		if err != nil {
+			return nil
+		}
+
+		cb(rec, offset, recordHeaderSize+int(size))
+		offset += int64(recordHeaderSize + int(size))
+	}
+}
@@ -25,6 +25,9 @@ const (
 	ContentTypeText ContentType = "text/plain"
 	// ContentTypeBinary is a stream content type for binary streams.
 	ContentTypeBinary = "application/octet-stream"
+	// ContentTypeJSONLines is a content type for text streams whose lines are
+	// each a single JSON object (a structured record).
+	ContentTypeJSONLines = "application/x-ndjson"
 
 	// ContentTypeLogdogDatagram is a content type for size-prefixed datagram
 	// frame stream.
@@ -42,6 +42,7 @@ import (
 const (
 	tsEntriesField = "entries"
 	tsIndexField   = "index"
+	tsSearchField  = "search"
 )
 
 var (
@@ -125,6 +126,10 @@ type Settings struct {
 	// IndexByteRange is the maximum number of stream data bytes in between index
 	// entries. See archive.Manifest for more information.
 	IndexByteRange int
+
+	// Format is the format in which log streams are archived. See
+	// archive.Manifest for more information.
+	Format logpb.LogIndex_Format
 }
 
 // SettingsLoader returns archival Settings for a given project.
@@ -290,6 +295,9 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task *logdog.ArchiveTask)
 
 		staged.stream.addMetrics(c, tsEntriesField, streamType)
 		staged.index.addMetrics(c, tsIndexField, streamType)
+		if staged.search.enabled() {
+			staged.search.addMetrics(c, tsSearchField, streamType)
+		}
 
 		tsLogEntries.Add(c, float64(staged.logEntryCount), streamType)
 		tsTotalLogEntries.Add(c, staged.logEntryCount, streamType)
@@ -377,6 +385,10 @@ func (a *Archivist) makeStagedArchival(c context.Context, project string,
 	// Construct our staged archival paths.
 	sa.stream = sa.makeStagingPaths("logstream.entries", uid)
 	sa.index = sa.makeStagingPaths("logstream.index", uid)
+	if sa.desc.StreamType == logpb.StreamType_TEXT {
+		// Only TEXT streams can be searched.
+		sa.search = sa.makeStagingPaths(archive.SearchIndexName, uid)
+	}
 
 	return &sa, nil
 }
@@ -391,6 +403,7 @@ type stagedArchival struct {
 
 	stream stagingPaths
 	index  stagingPaths
+	search stagingPaths
 
 	finalized     bool
 	terminalIndex types.MessageIndex
@@ -523,6 +536,14 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	}
 	defer closeWriter(indexWriter, sa.index.staged)
 
+	var searchWriter gs.Writer
+	if sa.search.enabled() {
+		if searchWriter, err = createWriter(sa.search.staged); err != nil {
+			return err
+		}
+		defer closeWriter(searchWriter, sa.search.staged)
+	}
+
 	// Read our log entries from intermediate storage.
 	ss := storageSource{
 		Context:       c,
@@ -534,13 +555,15 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	}
 
 	m := archive.Manifest{
-		Desc:             &sa.desc,
-		Source:           &ss,
-		LogWriter:        streamWriter,
-		IndexWriter:      indexWriter,
-		StreamIndexRange: sa.IndexStreamRange,
-		PrefixIndexRange: sa.IndexPrefixRange,
-		ByteRange:        sa.IndexByteRange,
+		Desc:              &sa.desc,
+		Source:            &ss,
+		LogWriter:         streamWriter,
+		IndexWriter:       indexWriter,
+		SearchIndexWriter: searchWriter,
+		Format:            sa.Format,
+		StreamIndexRange:  sa.IndexStreamRange,
+		PrefixIndexRange:  sa.IndexPrefixRange,
+		ByteRange:         sa.IndexByteRange,
 
 		Logger: log.Get(c),
 	}
@@ -567,6 +590,9 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	sa.logEntryCount = ss.logEntryCount
 	sa.stream.bytesWritten = streamWriter.Count()
 	sa.index.bytesWritten = indexWriter.Count()
+	if searchWriter != nil {
+		sa.search.bytesWritten = searchWriter.Count()
+	}
 	return
 }
 
@@ -649,6 +675,7 @@ func (sa *stagedArchival) getStagingPaths() []*stagingPaths {
 	return []*stagingPaths{
 		&sa.stream,
 		&sa.index,
+		&sa.search,
 	}
 }
 
@@ -502,7 +502,7 @@ func TestHandleArchive(t *testing.T) {
 			stream.State.TerminalIndex = 3
 			addTestEntry(project, 0, 1, 2, 3)
 
-			for _, failName := range []string{"/logstream.entries", "/logstream.index"} {
+			for _, failName := range []string{"/logstream.entries", "/logstream.index", "/logstream.search"} {
 				for _, testCase := range []struct {
 					name  string
 					setup func()
@@ -31,6 +31,7 @@ import (
 	montypes "go.chromium.org/luci/common/tsmon/types"
 	"go.chromium.org/luci/logdog/api/config/svcconfig"
 	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
+	"go.chromium.org/luci/logdog/api/logpb"
 	"go.chromium.org/luci/logdog/appengine/coordinator"
 	"go.chromium.org/luci/logdog/server/archivist"
 	"go.chromium.org/luci/logdog/server/bundleServicesClient"
@@ -306,6 +307,9 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 			IndexPrefixRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.PrefixRange }),
 			IndexByteRange:   indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.ByteRange }),
 		}
+		if acfg.ArchiveZlibFrames {
+			st.Format = logpb.LogIndex_ZLIB_FRAMES
+		}
 
 		// Fold project settings into loaded ones.
 		return &st, nil
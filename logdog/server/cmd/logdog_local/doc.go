@@ -0,0 +1,25 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main implements logdog_local, a self-contained LogDog service for
+// development and testing.
+//
+// logdog_local hosts the Coordinator's Registration, Services, and Logs pRPC
+// services in a single process, backed by in-memory datastore and intermediate
+// storage. It also runs a Collector, fed by a minimal Cloud Pub/Sub emulator,
+// and an Archivist that archives log streams into a local directory.
+//
+// Install via:
+//   go install go.chromium.org/luci/logdog/server/cmd/logdog_local
+package main
@@ -0,0 +1,168 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/gcloud/gs"
+
+	gcst "cloud.google.com/go/storage"
+)
+
+// localGSClient is a gs.Client implementation that stores objects in a local
+// directory.
+//
+// The object "gs://<bucket>/<name>" is stored at "<root>/<bucket>/<name>".
+type localGSClient struct {
+	root string
+}
+
+var _ gs.Client = (*localGSClient)(nil)
+
+func (c *localGSClient) Close() error { return nil }
+
+func (c *localGSClient) Attrs(p gs.Path) (*gcst.ObjectAttrs, error) {
+	path, err := c.localPath(p)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, c.translateErr(err)
+	}
+
+	bucket, name := p.Split()
+	return &gcst.ObjectAttrs{
+		Bucket:  bucket,
+		Name:    name,
+		Size:    st.Size(),
+		Created: st.ModTime(),
+		Updated: st.ModTime(),
+	}, nil
+}
+
+func (c *localGSClient) NewReader(p gs.Path, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		panic(errors.Reason("offset (%d) must be >= 0", offset).Err())
+	}
+
+	path, err := c.localPath(p)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, c.translateErr(err)
+	}
+	if _, err := fd.Seek(offset, io.SeekStart); err != nil {
+		fd.Close()
+		return nil, err
+	}
+
+	if length < 0 {
+		return fd, nil
+	}
+	return &limitedReadCloser{io.LimitReader(fd, length), fd}, nil
+}
+
+func (c *localGSClient) NewWriter(p gs.Path) (gs.Writer, error) {
+	path, err := c.localPath(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	fd, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &localGSWriter{File: fd}, nil
+}
+
+func (c *localGSClient) Delete(p gs.Path) error {
+	path, err := c.localPath(p)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *localGSClient) Rename(src, dst gs.Path) error {
+	srcPath, err := c.localPath(src)
+	if err != nil {
+		return err
+	}
+	dstPath, err := c.localPath(dst)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0755); err != nil {
+		return err
+	}
+	return c.translateErr(os.Rename(srcPath, dstPath))
+}
+
+// localPath returns the local filesystem path for the object at p.
+func (c *localGSClient) localPath(p gs.Path) (string, error) {
+	bucket, name := p.Split()
+	if bucket == "" || name == "" {
+		return "", errors.Reason("invalid object path: %q", p).Err()
+	}
+	return filepath.Join(c.root, bucket, filepath.FromSlash(name)), nil
+}
+
+// translateErr converts missing file errors into the error that the Google
+// Storage client returns for missing objects.
+func (c *localGSClient) translateErr(err error) error {
+	if os.IsNotExist(err) {
+		return gcst.ErrObjectNotExist
+	}
+	return err
+}
+
+// localGSWriter is a gs.Writer that writes to a local file.
+type localGSWriter struct {
+	*os.File
+
+	count int64
+}
+
+func (w *localGSWriter) Write(d []byte) (int, error) {
+	n, err := w.File.Write(d)
+	w.count += int64(n)
+	return n, err
+}
+
+func (w *localGSWriter) Count() int64 { return w.count }
+
+// limitedReadCloser reads from a length-limited view of a file, and closes the
+// file when closed.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
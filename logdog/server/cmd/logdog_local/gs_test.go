@@ -0,0 +1,120 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"go.chromium.org/luci/common/gcloud/gs"
+
+	gcst "cloud.google.com/go/storage"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLocalGSClient(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local Google Storage client`, t, func() {
+		root, err := ioutil.TempDir("", "logdog_local_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+
+		client := &localGSClient{root: root}
+
+		put := func(p gs.Path, data string) {
+			w, err := client.NewWriter(p)
+			So(err, ShouldBeNil)
+			_, err = w.Write([]byte(data))
+			So(err, ShouldBeNil)
+			So(w.Count(), ShouldEqual, int64(len(data)))
+			So(w.Close(), ShouldBeNil)
+		}
+		get := func(p gs.Path, offset, length int64) (string, error) {
+			r, err := client.NewReader(p, offset, length)
+			if err != nil {
+				return "", err
+			}
+			defer r.Close()
+			data, err := ioutil.ReadAll(r)
+			return string(data), err
+		}
+
+		p := gs.MakePath("bucket", "path/to/object")
+		put(p, "hello, world")
+
+		Convey(`Can read an object.`, func() {
+			data, err := get(p, 0, -1)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "hello, world")
+
+			attrs, err := client.Attrs(p)
+			So(err, ShouldBeNil)
+			So(attrs.Bucket, ShouldEqual, "bucket")
+			So(attrs.Name, ShouldEqual, "path/to/object")
+			So(attrs.Size, ShouldEqual, 12)
+		})
+
+		Convey(`Can read a range of an object.`, func() {
+			data, err := get(p, 7, 3)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "wor")
+		})
+
+		Convey(`Can overwrite an object.`, func() {
+			put(p, "bye")
+			data, err := get(p, 0, -1)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "bye")
+		})
+
+		Convey(`Can rename an object.`, func() {
+			dst := gs.MakePath("other", "renamed")
+			So(client.Rename(p, dst), ShouldBeNil)
+
+			data, err := get(dst, 0, -1)
+			So(err, ShouldBeNil)
+			So(data, ShouldEqual, "hello, world")
+
+			_, err = get(p, 0, -1)
+			So(err, ShouldEqual, gcst.ErrObjectNotExist)
+		})
+
+		Convey(`Can delete an object.`, func() {
+			So(client.Delete(p), ShouldBeNil)
+
+			_, err := client.Attrs(p)
+			So(err, ShouldEqual, gcst.ErrObjectNotExist)
+			_, err = get(p, 0, -1)
+			So(err, ShouldEqual, gcst.ErrObjectNotExist)
+
+			// Deleting a missing object is not an error.
+			So(client.Delete(p), ShouldBeNil)
+		})
+
+		Convey(`Reports missing objects.`, func() {
+			missing := gs.MakePath("bucket", "missing")
+			_, err := client.Attrs(missing)
+			So(err, ShouldEqual, gcst.ErrObjectNotExist)
+			So(client.Rename(missing, gs.MakePath("bucket", "dst")), ShouldEqual, gcst.ErrObjectNotExist)
+		})
+
+		Convey(`Rejects paths without an object name.`, func() {
+			_, err := client.NewWriter(gs.MakePath("bucket", ""))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,396 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/rand/mathrand"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag/stringlistflag"
+	"go.chromium.org/luci/common/gcloud/gs"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	configPB "go.chromium.org/luci/common/proto/config"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/common/system/signals"
+	"go.chromium.org/luci/config"
+	cfgmem "go.chromium.org/luci/config/impl/memory"
+	"go.chromium.org/luci/config/server/cfgclient"
+	"go.chromium.org/luci/config/server/cfgclient/backend/testconfig"
+	"go.chromium.org/luci/grpc/discovery"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/logdog/api/config/svcconfig"
+	logsPb "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	registrationPb "go.chromium.org/luci/logdog/api/endpoints/coordinator/registration/v1"
+	servicesPb "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
+	coordcfg "go.chromium.org/luci/logdog/appengine/coordinator/config"
+	"go.chromium.org/luci/logdog/appengine/coordinator/endpoints/registration"
+	"go.chromium.org/luci/logdog/appengine/coordinator/endpoints/services"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex/logs"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/storage/disk"
+	memStorage "go.chromium.org/luci/logdog/common/storage/memory"
+	"go.chromium.org/luci/logdog/server/archivist"
+	"go.chromium.org/luci/logdog/server/collector"
+	"go.chromium.org/luci/logdog/server/collector/coordinator"
+	"go.chromium.org/luci/server/caching"
+	"go.chromium.org/luci/server/router"
+	"go.chromium.org/luci/server/settings"
+
+	gaemem "go.chromium.org/gae/impl/memory"
+	ds "go.chromium.org/gae/service/datastore"
+	"go.chromium.org/gae/service/taskqueue"
+
+	pubsubPb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/grpc"
+)
+
+const (
+	// appID is the App ID of the in-memory AppEngine instance. It also names the
+	// service's luci-config config set.
+	appID = "dev~logdog-local"
+
+	// adminGroup, serviceGroup, and projectGroup are the auth groups named in
+	// the generated configuration. All callers are members of all of them.
+	adminGroup   = "admin"
+	serviceGroup = "services"
+	projectGroup = "all"
+
+	// defaultProject is the project that is configured if none are supplied.
+	defaultProject = "local"
+
+	// pubsubProject and pubsubTopic name the Pub/Sub topic that Butlers are told
+	// to publish to.
+	pubsubProject = "logdog-local"
+	pubsubTopic   = "logs"
+
+	// archiveBucket and stagingBucket are the Google Storage buckets, mapped
+	// into the archive directory, that hold archived and staged log streams.
+	archiveBucket = "archive"
+	stagingBucket = "staging"
+
+	// archiveBatchSize is the maximum number of archival tasks to lease at a
+	// time.
+	archiveBatchSize = 50
+	// archiveLeaseTime is the amount of time to lease archival tasks for.
+	archiveLeaseTime = 5 * time.Minute
+)
+
+// application is the logdog_local application state.
+type application struct {
+	addr                string
+	pubsubAddr          string
+	archiveDir          string
+	storageDir          string
+	archivePollInterval time.Duration
+	projects            stringlistflag.Flag
+
+	// storage is the intermediate storage instance shared by the Collector, the
+	// Archivist, and the Coordinator.
+	storage storage.Storage
+	// gsClient is the archival storage client.
+	gsClient *localGSClient
+}
+
+func (a *application) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&a.addr, "addr", "localhost:8080",
+		"The address to serve the Coordinator's pRPC services on.")
+	fs.StringVar(&a.pubsubAddr, "pubsub-addr", "localhost:8085",
+		"The address to serve the Pub/Sub emulator on. Butlers should set PUBSUB_EMULATOR_HOST to this.")
+	fs.StringVar(&a.archiveDir, "archive-dir", "",
+		"The directory to archive log streams into. If empty, a temporary directory will be used.")
+	fs.StringVar(&a.storageDir, "storage-dir", "",
+		"The directory to keep intermediate storage in. If empty, intermediate storage is held in memory.")
+	fs.DurationVar(&a.archivePollInterval, "archive-poll-interval", 5*time.Second,
+		"The amount of time to wait in between checks for archival tasks.")
+	fs.Var(&a.projects, "project",
+		fmt.Sprintf("A project to configure. May be specified multiple times. If none are supplied, %q "+
+			"will be used.", defaultProject))
+}
+
+// installServices installs the in-memory AppEngine services, settings, and
+// configuration into c.
+func (a *application) installServices(c context.Context) context.Context {
+	c = gaemem.UseWithAppID(c, appID)
+	c = caching.WithEmptyProcessCache(c)
+	c = settings.Use(c, settings.New(&settings.MemoryStorage{}))
+
+	// Queries are served by the in-memory datastore, which needs to be told to
+	// build its own indexes.
+	dst := ds.GetTestable(c)
+	dst.AutoIndex(true)
+	dst.Consistent(true)
+
+	taskqueue.GetTestable(c).CreatePullQueue(services.ArchiveQueueName)
+
+	return testconfig.WithCommonClient(c, cfgmem.New(a.configs(c)))
+}
+
+// configs generates the luci-config configuration for the service and each
+// of its projects.
+func (a *application) configs(c context.Context) map[config.Set]cfgmem.Files {
+	configs := make(map[config.Set]cfgmem.Files)
+
+	configSet, configPath := coordcfg.ServiceConfigPath(c)
+	configs[configSet] = cfgmem.Files{
+		configPath: proto.MarshalTextString(&svcconfig.Config{
+			Transport: &svcconfig.Transport{
+				Type: &svcconfig.Transport_Pubsub{
+					Pubsub: &svcconfig.Transport_PubSub{
+						Project: pubsubProject,
+						Topic:   pubsubTopic,
+					},
+				},
+			},
+			Coordinator: &svcconfig.Coordinator{
+				AdminAuthGroup:   adminGroup,
+				ServiceAuthGroup: serviceGroup,
+				PrefixExpiration: google.NewDuration(24 * time.Hour),
+			},
+		}),
+	}
+
+	projects := []string(a.projects)
+	if len(projects) == 0 {
+		projects = []string{defaultProject}
+	}
+	for _, project := range projects {
+		configs[config.ProjectSet(project)] = cfgmem.Files{
+			coordcfg.ProjectConfigPath(c): proto.MarshalTextString(&svcconfig.ProjectConfig{
+				ReaderAuthGroups: []string{projectGroup},
+				WriterAuthGroups: []string{projectGroup},
+				ArchiveGsBucket:  archiveBucket,
+			}),
+			cfgclient.ProjectConfigPath: proto.MarshalTextString(&configPB.ProjectCfg{
+				Name:   project,
+				Access: []string{"group:" + projectGroup},
+			}),
+		}
+	}
+	return configs
+}
+
+// archivalSettings is an archivist.SettingsLoader that archives into the local
+// archive directory.
+func (a *application) archivalSettings(c context.Context, project string) (*archivist.Settings, error) {
+	return &archivist.Settings{
+		GSBase:        gs.MakePath(archiveBucket, project),
+		GSStagingBase: gs.MakePath(stagingBucket, project),
+	}, nil
+}
+
+// runArchivist leases and executes archival tasks until c is cancelled.
+func (a *application) runArchivist(c context.Context, ar *archivist.Archivist) {
+	for {
+		if a.archiveOnce(c, ar) < archiveBatchSize {
+			if r := <-clock.After(c, a.archivePollInterval); r.Err != nil {
+				return
+			}
+		}
+	}
+}
+
+// archiveOnce leases and executes one batch of archival tasks. It returns the
+// number of tasks leased.
+func (a *application) archiveOnce(c context.Context, ar *archivist.Archivist) int {
+	resp, err := ar.Service.LeaseArchiveTasks(c, &servicesPb.LeaseRequest{
+		MaxTasks:  archiveBatchSize,
+		LeaseTime: ptypes.DurationProto(archiveLeaseTime),
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to lease archival tasks.")
+	}
+
+	var done []*servicesPb.ArchiveTask
+	for _, task := range resp.GetTasks() {
+		if err := ar.ArchiveTask(c, task); err != nil {
+			log.WithError(err).Warningf(c, "Failed to archive log stream.")
+			continue
+		}
+		done = append(done, task)
+	}
+	if len(done) > 0 {
+		if _, err := ar.Service.DeleteArchiveTasks(c, &servicesPb.DeleteRequest{Tasks: done}); err != nil {
+			log.WithError(err).Errorf(c, "Failed to delete completed archival tasks.")
+		}
+	}
+	return len(resp.GetTasks())
+}
+
+// instance is a running logdog_local instance.
+type instance struct {
+	// ctx is the Context that the instance's services run in.
+	ctx context.Context
+
+	// coordinatorAddr is the address that the Coordinator is served on.
+	coordinatorAddr string
+	// pubsubAddr is the address that the Pub/Sub emulator is served on.
+	pubsubAddr string
+	// archivist archives the instance's terminated log streams.
+	archivist *archivist.Archivist
+
+	// closers release the instance's resources, in reverse order.
+	closers []func()
+}
+
+// Close stops the instance's servers and releases its resources.
+func (i *instance) Close() {
+	for j := len(i.closers) - 1; j >= 0; j-- {
+		i.closers[j]()
+	}
+}
+
+// start sets up the application's storage and starts serving the Coordinator
+// and the Pub/Sub emulator. The returned instance must be closed.
+func (a *application) start(c context.Context) (_ *instance, err error) {
+	// Release whatever was set up if we fail part way through. inst is not the
+	// named result, so returning nil does not clear it.
+	inst := &instance{}
+	defer func() {
+		if err != nil {
+			inst.Close()
+		}
+	}()
+
+	if a.archiveDir == "" {
+		dir, err := ioutil.TempDir("", "logdog_local")
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to create archive directory").Err()
+		}
+		inst.closers = append(inst.closers, func() { os.RemoveAll(dir) })
+		a.archiveDir = dir
+	}
+	log.Infof(c, "Archiving log streams into: %s", a.archiveDir)
+
+	if a.storageDir == "" {
+		a.storage = &memStorage.Storage{}
+	} else {
+		st, err := disk.Open(disk.Options{Dir: a.storageDir})
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to open intermediate storage in %q", a.storageDir).Err()
+		}
+		log.Infof(c, "Using on-disk intermediate storage in: %s", a.storageDir)
+		a.storage = st
+	}
+	inst.closers = append(inst.closers, a.storage.Close)
+	a.gsClient = &localGSClient{root: a.archiveDir}
+
+	c = a.installServices(c)
+	inst.ctx = c
+
+	// Serve the Coordinator's pRPC services.
+	svr := &prpc.Server{
+		Authenticator: prpc.NoAuthentication,
+	}
+	logsPb.RegisterLogsServer(svr, logs.New())
+	registrationPb.RegisterRegistrationServer(svr, registration.New())
+	servicesPb.RegisterServicesServer(svr, services.New())
+	discovery.Enable(svr)
+
+	r := router.NewWithRootContext(c)
+	svr.InstallHandlers(r, router.NewMiddlewareChain(a.base))
+
+	httpListener, err := net.Listen("tcp", a.addr)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to listen on %q", a.addr).Err()
+	}
+	httpServer := http.Server{Handler: r}
+	go httpServer.Serve(httpListener)
+	inst.closers = append(inst.closers, func() { httpServer.Shutdown(c) })
+	inst.coordinatorAddr = httpListener.Addr().String()
+	log.Infof(c, "Serving Coordinator on: %s", inst.coordinatorAddr)
+
+	// The Collector and Archivist talk to the Coordinator over pRPC, just as
+	// they would in production.
+	clientOpts := prpc.DefaultOptions()
+	clientOpts.Insecure = true
+	coordClient := servicesPb.NewServicesPRPCClient(&prpc.Client{
+		Host:    inst.coordinatorAddr,
+		Options: clientOpts,
+	})
+
+	coll := &collector.Collector{
+		Coordinator: coordinator.NewCoordinator(coordClient),
+		Storage:     a.storage,
+	}
+	inst.closers = append(inst.closers, coll.Close)
+
+	// Serve the Pub/Sub emulator, which feeds the Collector.
+	grpcServer := grpc.NewServer()
+	pubsubPb.RegisterPublisherServer(grpcServer, &publisher{ctx: c, coll: coll})
+
+	pubsubListener, err := net.Listen("tcp", a.pubsubAddr)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to listen on %q", a.pubsubAddr).Err()
+	}
+	go grpcServer.Serve(pubsubListener)
+	inst.closers = append(inst.closers, grpcServer.Stop)
+	inst.pubsubAddr = pubsubListener.Addr().String()
+	log.Infof(c, "Serving Pub/Sub emulator on: %s", inst.pubsubAddr)
+
+	inst.archivist = &archivist.Archivist{
+		Service:        coordClient,
+		SettingsLoader: a.archivalSettings,
+		Storage:        a.storage,
+		GSClientFactory: func(context.Context, string) (gs.Client, error) {
+			return a.gsClient, nil
+		},
+	}
+	return inst, nil
+}
+
+// run is the main execution function.
+func (a *application) run(c context.Context) error {
+	inst, err := a.start(c)
+	if err != nil {
+		return err
+	}
+	defer inst.Close()
+
+	c, cancelFunc := context.WithCancel(inst.ctx)
+	defer cancelFunc()
+	defer signals.HandleInterrupt(cancelFunc)()
+
+	a.runArchivist(c, inst.archivist)
+
+	log.Infof(c, "Shutting down.")
+	return nil
+}
+
+// Entry point.
+func main() {
+	mathrand.SeedRandomly()
+
+	a := application{}
+	a.addFlags(flag.CommandLine)
+	flag.Parse()
+
+	c := gologger.StdConfig.Use(context.Background())
+	if err := a.run(c); err != nil {
+		log.WithError(err).Errorf(c, "logdog_local failed.")
+		os.Exit(1)
+	}
+}
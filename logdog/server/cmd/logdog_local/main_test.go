@@ -0,0 +1,128 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/grpc/prpc"
+	logsPb "go.chromium.org/luci/logdog/api/endpoints/coordinator/logs/v1"
+	registrationPb "go.chromium.org/luci/logdog/api/endpoints/coordinator/registration/v1"
+
+	pubsubPb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/grpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestLogDogLocal(t *testing.T) {
+	Convey(`A logdog_local instance`, t, func() {
+		// The services run on a test clock, so that archival tasks, which are
+		// delayed, can be made due.
+		c, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx := context.Background()
+
+		archiveDir, err := ioutil.TempDir("", "logdog_local_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(archiveDir)
+
+		a := application{
+			addr:       "localhost:0",
+			pubsubAddr: "localhost:0",
+			archiveDir: archiveDir,
+		}
+		inst, err := a.start(c)
+		So(err, ShouldBeNil)
+		defer inst.Close()
+
+		opts := prpc.DefaultOptions()
+		opts.Insecure = true
+		prpcClient := &prpc.Client{Host: inst.coordinatorAddr, Options: opts}
+
+		conn, err := grpc.Dial(inst.pubsubAddr, grpc.WithInsecure())
+		So(err, ShouldBeNil)
+		defer conn.Close()
+
+		Convey(`Can register, publish, archive, and get a log stream.`, func() {
+			reg, err := registrationPb.NewRegistrationPRPCClient(prpcClient).RegisterPrefix(ctx, &registrationPb.RegisterPrefixRequest{
+				Project: defaultProject,
+				Prefix:  "test",
+			})
+			So(err, ShouldBeNil)
+
+			_, err = pubsubPb.NewPublisherClient(conn).Publish(ctx, &pubsubPb.PublishRequest{
+				Topic: fmt.Sprintf("projects/%s/topics/%s", pubsubProject, pubsubTopic),
+				Messages: []*pubsubPb.PubsubMessage{
+					{Data: testBundle(c, defaultProject, "test", "stdout", reg.Secret, "hello", "world")},
+				},
+			})
+			So(err, ShouldBeNil)
+
+			// Make the archival task due, and run it.
+			tc.Add(time.Hour)
+			So(a.archiveOnce(ctx, inst.archivist), ShouldEqual, 1)
+
+			resp, err := logsPb.NewLogsPRPCClient(prpcClient).Get(ctx, &logsPb.GetRequest{
+				Project: defaultProject,
+				Path:    "test/+/stdout",
+				State:   true,
+			})
+			So(err, ShouldBeNil)
+			So(resp.State.TerminalIndex, ShouldEqual, 1)
+			So(resp.State.Archive, ShouldNotBeNil)
+			So(resp.State.Archive.Complete, ShouldBeTrue)
+
+			var lines []string
+			for _, le := range resp.Logs {
+				for _, l := range le.GetText().GetLines() {
+					lines = append(lines, string(l.Value))
+				}
+			}
+			So(lines, ShouldResemble, []string{"hello", "world"})
+		})
+	})
+}
+
+func TestLogDogLocalStartFailure(t *testing.T) {
+	Convey(`A logdog_local instance that can't listen`, t, func() {
+		c := context.Background()
+
+		busy, err := net.Listen("tcp", "localhost:0")
+		So(err, ShouldBeNil)
+		defer busy.Close()
+
+		a := application{
+			addr:       "localhost:0",
+			pubsubAddr: busy.Addr().String(),
+		}
+		Convey(`Fails and releases what it set up.`, func() {
+			inst, err := a.start(c)
+			So(err, ShouldErrLike, "failed to listen on")
+			So(inst, ShouldBeNil)
+
+			// The temporary archive directory was removed.
+			So(a.archiveDir, ShouldNotEqual, "")
+			_, err = os.Stat(a.archiveDir)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
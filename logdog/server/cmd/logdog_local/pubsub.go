@@ -0,0 +1,104 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/logdog/server/collector"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// publisher is a minimal Cloud Pub/Sub Publisher service.
+//
+// It stands in for the Pub/Sub transport layer: rather than queueing published
+// messages for a subscriber, it hands them directly to a Collector. Butlers
+// publish to it by pointing their Pub/Sub client at it via the
+// PUBSUB_EMULATOR_HOST environment variable.
+type publisher struct {
+	// ctx is the base Context, used to supply logging to RPCs.
+	ctx context.Context
+	// coll is the Collector that ingests published messages.
+	coll *collector.Collector
+
+	// nextID is used to generate message IDs.
+	nextID uint64
+}
+
+var _ pb.PublisherServer = (*publisher)(nil)
+
+func (p *publisher) Publish(c context.Context, req *pb.PublishRequest) (*pb.PublishResponse, error) {
+	c = log.SetFactory(c, log.GetFactory(p.ctx))
+
+	resp := pb.PublishResponse{
+		MessageIds: make([]string, len(req.Messages)),
+	}
+	for i, msg := range req.Messages {
+		// Mirror the Collector service: messages that fail with non-transient
+		// errors are consumed, and transient failures are returned so that the
+		// publisher retries them.
+		if err := p.coll.Process(c, msg.Data); err != nil {
+			if transient.Tag.In(err) {
+				log.WithError(err).Warningf(c, "Transient error ingesting published message.")
+				return nil, grpcutil.Errf(codes.Unavailable, "transient error ingesting message")
+			}
+			log.WithError(err).Errorf(c, "Non-transient error ingesting published message; discarding.")
+		}
+		resp.MessageIds[i] = strconv.FormatUint(atomic.AddUint64(&p.nextID, 1), 10)
+	}
+	return &resp, nil
+}
+
+func (p *publisher) CreateTopic(c context.Context, req *pb.Topic) (*pb.Topic, error) {
+	return req, nil
+}
+
+func (p *publisher) GetTopic(c context.Context, req *pb.GetTopicRequest) (*pb.Topic, error) {
+	return &pb.Topic{Name: req.Topic}, nil
+}
+
+func (p *publisher) UpdateTopic(context.Context, *pb.UpdateTopicRequest) (*pb.Topic, error) {
+	return nil, errUnimplemented
+}
+
+func (p *publisher) ListTopics(context.Context, *pb.ListTopicsRequest) (*pb.ListTopicsResponse, error) {
+	return nil, errUnimplemented
+}
+
+func (p *publisher) ListTopicSubscriptions(context.Context, *pb.ListTopicSubscriptionsRequest) (
+	*pb.ListTopicSubscriptionsResponse, error) {
+
+	return nil, errUnimplemented
+}
+
+func (p *publisher) ListTopicSnapshots(context.Context, *pb.ListTopicSnapshotsRequest) (
+	*pb.ListTopicSnapshotsResponse, error) {
+
+	return nil, errUnimplemented
+}
+
+func (p *publisher) DeleteTopic(context.Context, *pb.DeleteTopicRequest) (*empty.Empty, error) {
+	return nil, errUnimplemented
+}
+
+var errUnimplemented = grpcutil.Errf(codes.Unimplemented, "not supported by logdog_local")
@@ -0,0 +1,154 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/google"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/client/pubsubprotocol"
+	"go.chromium.org/luci/logdog/common/storage/memory"
+	"go.chromium.org/luci/logdog/server/collector"
+	cc "go.chromium.org/luci/logdog/server/collector/coordinator"
+
+	pb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testCoordinator is a collector Coordinator that records the streams that it
+// is asked to register and terminate.
+type testCoordinator struct {
+	// err, if not nil, is returned by all calls.
+	err error
+
+	registered []string
+	terminated []string
+}
+
+func (c *testCoordinator) RegisterStream(ctx context.Context, s *cc.LogStreamState, desc []byte) (*cc.LogStreamState, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.registered = append(c.registered, string(s.Path))
+
+	st := *s
+	st.ID = string(s.Path)
+	return &st, nil
+}
+
+func (c *testCoordinator) TerminateStream(ctx context.Context, r *cc.TerminateRequest) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.terminated = append(c.terminated, string(r.Path))
+	return nil
+}
+
+// testBundle returns a framed Butler bundle containing a complete TEXT stream,
+// "<prefix>/+/<name>", with the supplied lines.
+func testBundle(c context.Context, project, prefix, name string, secret []byte, lines ...string) []byte {
+	now := google.NewTimestamp(clock.Now(c))
+	be := &logpb.ButlerLogBundle_Entry{
+		Desc: &logpb.LogStreamDescriptor{
+			Prefix:      prefix,
+			Name:        name,
+			ContentType: "text/plain",
+			StreamType:  logpb.StreamType_TEXT,
+			Timestamp:   now,
+		},
+		Terminal:      true,
+		TerminalIndex: uint64(len(lines) - 1),
+	}
+	for i, line := range lines {
+		be.Logs = append(be.Logs, &logpb.LogEntry{
+			StreamIndex: uint64(i),
+			Sequence:    uint64(i),
+			Content: &logpb.LogEntry_Text{
+				Text: &logpb.Text{
+					Lines: []*logpb.Text_Line{{Value: []byte(line), Delimiter: "\n"}},
+				},
+			},
+		})
+	}
+
+	buf := bytes.Buffer{}
+	w := pubsubprotocol.Writer{Compress: true}
+	if err := w.Write(&buf, &logpb.ButlerLogBundle{
+		Timestamp: now,
+		Project:   project,
+		Prefix:    prefix,
+		Secret:    secret,
+		Entries:   []*logpb.ButlerLogBundle_Entry{be},
+	}); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func TestPublisher(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Pub/Sub emulator publisher`, t, func() {
+		c := context.Background()
+
+		tcc := &testCoordinator{}
+		coll := &collector.Collector{
+			Coordinator: tcc,
+			Storage:     &memory.Storage{},
+		}
+		defer coll.Close()
+
+		p := &publisher{ctx: c, coll: coll}
+		publish := func(data ...[]byte) (*pb.PublishResponse, error) {
+			req := &pb.PublishRequest{Topic: fmt.Sprintf("projects/%s/topics/%s", pubsubProject, pubsubTopic)}
+			for _, d := range data {
+				req.Messages = append(req.Messages, &pb.PubsubMessage{Data: d})
+			}
+			return p.Publish(c, req)
+		}
+		secret := bytes.Repeat([]byte{0xAA}, 32)
+
+		Convey(`Hands published messages to the Collector.`, func() {
+			resp, err := publish(
+				testBundle(c, "proj", "foo", "bar", secret, "hello"),
+				testBundle(c, "proj", "foo", "baz", secret, "world"))
+			So(err, ShouldBeNil)
+			So(resp.MessageIds, ShouldResemble, []string{"1", "2"})
+			So(tcc.registered, ShouldResemble, []string{"foo/+/bar", "foo/+/baz"})
+			So(tcc.terminated, ShouldResemble, []string{"foo/+/bar", "foo/+/baz"})
+		})
+
+		Convey(`Consumes messages that can't be ingested.`, func() {
+			resp, err := publish([]byte("garbage"))
+			So(err, ShouldBeNil)
+			So(resp.MessageIds, ShouldResemble, []string{"1"})
+		})
+
+		Convey(`Returns transient ingestion errors so that they are retried.`, func() {
+			tcc.err = errors.New("coordinator is down", transient.Tag)
+			_, err := publish(testBundle(c, "proj", "foo", "bar", secret, "hello"))
+			So(grpcutil.Code(err), ShouldEqual, codes.Unavailable)
+		})
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/gcloud/gs"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/api/logpb"
+	"go.chromium.org/luci/logdog/appengine/coordinator"
+	"go.chromium.org/luci/logdog/appengine/coordinator/endpoints"
+	"go.chromium.org/luci/logdog/appengine/coordinator/flex"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/storage/archive"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/router"
+)
+
+// localGroups is the set of auth groups that every caller is a member of.
+//
+// logdog_local does not authenticate its callers. Every caller is treated as
+// an administrator, a service, and a reader and writer of every project.
+var localGroups = []string{adminGroup, serviceGroup, projectGroup}
+
+// localServices is a Coordinator Services implementation backed by the
+// application's intermediate storage and local archive directory. A unique
+// instance is bound to each request.
+type localServices struct {
+	// LUCIConfigProvider satisfies the ConfigProvider interface requirement.
+	coordinator.LUCIConfigProvider
+
+	app *application
+}
+
+var _ endpoints.Services = (*localServices)(nil)
+var _ flex.Services = (*localServices)(nil)
+
+// base is Middleware that installs a localServices instance and a fully
+// privileged authentication state into the request Context.
+func (a *application) base(c *router.Context, next router.Handler) {
+	svc := localServices{app: a}
+
+	c.Context = auth.WithState(c.Context, &authtest.FakeState{
+		IdentityGroups: localGroups,
+	})
+	c.Context = coordinator.WithConfigProvider(c.Context, &svc)
+	c.Context = endpoints.WithServices(c.Context, &svc)
+	c.Context = flex.WithServices(c.Context, &svc)
+	next(c)
+}
+
+func (s *localServices) StorageForStream(c context.Context, lst *coordinator.LogStreamState, project string) (
+	coordinator.SigningStorage, error) {
+
+	if !lst.ArchivalState().Archived() {
+		log.Debugf(c, "Log is not archived. Fetching from intermediate storage.")
+		return &noSignedURLStorage{intermediateStorage{s.app.storage}}, nil
+	}
+
+	log.Fields{
+		"indexURL":    lst.ArchiveIndexURL,
+		"streamURL":   lst.ArchiveStreamURL,
+		"archiveTime": lst.ArchivedTime,
+	}.Debugf(c, "Log is archived. Fetching from archive storage.")
+
+	st, err := archive.New(archive.Options{
+		Index:  gs.Path(lst.ArchiveIndexURL),
+		Stream: gs.Path(lst.ArchiveStreamURL),
+		Client: s.app.gsClient,
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create archive storage instance.")
+		return nil, err
+	}
+	return &noSignedURLStorage{st}, nil
+}
+
+func (s *localServices) SearchIndexForStream(c context.Context, lst *coordinator.LogStreamState, project string) (
+	*logpb.SearchIndex, error) {
+
+	if !lst.ArchivalState().Archived() || lst.ArchiveIndexURL == "" {
+		return nil, nil
+	}
+	return archive.LoadSearchIndex(s.app.gsClient, archive.SearchIndexPath(gs.Path(lst.ArchiveIndexURL)))
+}
+
+// noSignedURLStorage is a thin wrapper around a Storage instance that cannot
+// sign URLs.
+type noSignedURLStorage struct {
+	storage.Storage
+}
+
+func (*noSignedURLStorage) GetSignedURLs(context.Context, *coordinator.URLSigningRequest) (
+	*coordinator.URLSigningResponse, error) {
+
+	return nil, nil
+}
+
+// intermediateStorage wraps the application's intermediate storage so that it
+// can be handed out to requests, which close their Storage when finished.
+type intermediateStorage struct {
+	storage.Storage
+}
+
+// Close is implemented here so that the shared intermediate storage isn't
+// closed by the Coordinator.
+func (intermediateStorage) Close() {}
@@ -436,11 +436,13 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 			taskC <- func() error {
 				// Sentinel task: Update the terminal bundle state.
 				treq := coordinator.TerminateRequest{
-					Project:       state.Project,
-					Path:          state.Path,
-					ID:            state.ID,
-					Secret:        state.Secret,
-					TerminalIndex: types.MessageIndex(h.be.TerminalIndex),
+					Project:        state.Project,
+					Path:           state.Path,
+					ID:             state.ID,
+					Secret:         state.Secret,
+					TerminalIndex:  types.MessageIndex(h.be.TerminalIndex),
+					DroppedBytes:   h.be.DroppedBytes,
+					DroppedEntries: h.be.DroppedEntries,
 				}
 
 				log.Fields{
@@ -108,6 +108,25 @@ func testCollectorImpl(t *testing.T, caching bool) {
 			So(terminateCalled, ShouldBeTrue)
 		})
 
+		Convey(`Will pass the data dropped by the Butler to the Coordinator.`, func() {
+			var treq cc.TerminateRequest
+			tcc.terminateCallback = func(tr cc.TerminateRequest) error {
+				treq = tr
+				return nil
+			}
+
+			bb.addStreamEntries("foo/+/bar", -1, 0, 1)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			be := bb.genBundleEntry("foo/+/bar", 2, 2)
+			be.DroppedBytes = 42
+			be.DroppedEntries = 3
+			bb.addBundleEntry(be)
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+			So(treq.DroppedBytes, ShouldEqual, 42)
+			So(treq.DroppedEntries, ShouldEqual, 3)
+		})
+
 		Convey(`Will return a transient error if a transient error happened while terminating.`, func() {
 			tcc.terminateCallback = func(cc.TerminateRequest) error { return errors.New("test error", transient.Tag) }
 
@@ -79,6 +79,11 @@ type TerminateRequest struct {
 	TerminalIndex types.MessageIndex
 	// Secret is the log stream's prefix secret.
 	Secret types.PrefixSecret
+
+	// DroppedBytes and DroppedEntries are the amount of stream data dropped by
+	// the Butler because of its limits.
+	DroppedBytes   int64
+	DroppedEntries int64
 }
 
 type coordinatorImpl struct {
@@ -144,10 +149,12 @@ func (c *coordinatorImpl) TerminateStream(ctx context.Context, r *TerminateReque
 	}
 
 	req := logdog.TerminateStreamRequest{
-		Project:       string(r.Project),
-		Id:            r.ID,
-		Secret:        []byte(r.Secret),
-		TerminalIndex: int64(r.TerminalIndex),
+		Project:        string(r.Project),
+		Id:             r.ID,
+		Secret:         []byte(r.Secret),
+		TerminalIndex:  int64(r.TerminalIndex),
+		DroppedBytes:   r.DroppedBytes,
+		DroppedEntries: r.DroppedEntries,
 	}
 
 	if _, err := c.c.TerminateStream(ctx, &req); err != nil {
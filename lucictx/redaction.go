@@ -0,0 +1,57 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lucictx
+
+import (
+	"context"
+	"fmt"
+)
+
+// Redaction is a struct that may be used with the "redaction" section of
+// LUCI_CONTEXT. It describes secrets that must not appear in logs.
+type Redaction struct {
+	// Secrets are literal strings to redact. They must not contain line breaks.
+	Secrets []string `json:"secrets,omitempty"`
+	// Patterns are RE2 regular expressions whose matches are redacted.
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+// GetRedaction calls Lookup and returns a copy of the current Redaction from
+// LUCI_CONTEXT if it was present. If no Redaction is in the context, this
+// returns nil.
+func GetRedaction(ctx context.Context) *Redaction {
+	ret := Redaction{}
+	ok, err := Lookup(ctx, "redaction", &ret)
+	if err != nil {
+		panic(err)
+	}
+	if !ok {
+		return nil
+	}
+	return &ret
+}
+
+// SetRedaction sets the Redaction in the LUCI_CONTEXT.
+func SetRedaction(ctx context.Context, r *Redaction) context.Context {
+	var raw interface{}
+	if r != nil {
+		raw = r
+	}
+	ctx, err := Set(ctx, "redaction", raw)
+	if err != nil {
+		panic(fmt.Errorf("impossible: %s", err))
+	}
+	return ctx
+}
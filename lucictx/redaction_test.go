@@ -0,0 +1,43 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lucictx
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRedaction(t *testing.T) {
+	Convey(`test redaction`, t, func() {
+		ctx := context.Background()
+
+		Convey(`can get from empty ctx`, func() {
+			So(GetRedaction(ctx), ShouldResemble, (*Redaction)(nil))
+		})
+
+		Convey(`can set in ctx`, func() {
+			r := &Redaction{Secrets: []string{"hunter2"}, Patterns: []string{`token-\w+`}}
+			ctx = SetRedaction(ctx, r)
+			So(GetRedaction(ctx), ShouldResemble, r)
+
+			Convey(`setting nil clears it out`, func() {
+				ctx = SetRedaction(ctx, nil)
+				So(GetRedaction(ctx), ShouldResemble, (*Redaction)(nil))
+			})
+		})
+	})
+}
@@ -21,7 +21,9 @@ import (
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/system/environ"
 	"go.chromium.org/luci/logdog/client/butler"
+	"go.chromium.org/luci/logdog/client/butler/redact"
 	"go.chromium.org/luci/logdog/client/butler/streamserver"
+	"go.chromium.org/luci/lucictx"
 )
 
 // overridden in tests
@@ -34,10 +36,21 @@ func startButler(ctx context.Context, opts *Options) (*butler.Butler, error) {
 		butlerCtx = logging.SetLevel(ctx, opts.ButlerLogLevel)
 	}
 
+	var redactOpts redact.Options
+	if rc := lucictx.GetRedaction(ctx); rc != nil {
+		redactOpts.Secrets = rc.Secrets
+		redactOpts.Patterns = rc.Patterns
+	}
+	redactor, err := redact.New(redactOpts)
+	if err != nil {
+		return nil, err
+	}
+
 	butler, err := butler.New(butlerCtx, butler.Config{
 		BufferLogs: bufferLogs,
 		GlobalTags: opts.logdogTags,
 		Output:     opts.LogdogOutput,
+		Redactor:   redactor,
 	})
 	if err != nil {
 		return nil, err
@@ -55,6 +55,9 @@ func application(p Params) *cli.Application {
 		Commands: []*subcommands.Command{
 			cmdLs(p),
 			cmdDerive(p),
+			cmdHistory(p),
+			cmdDiff(p),
+			cmdConvert(),
 			// TODO(crbug.com/1021849): add subcommand upload
 			// TODO(crbug.com/1021849): add subcommand run
 
@@ -0,0 +1,131 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/resultdb/cmd/recorder/chromium/formats"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+const convertUsage = `convert -format FORMAT [flags] [FILE]`
+
+func cmdConvert() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: convertUsage,
+		ShortDesc: "convert test results from a test harness format",
+		LongDesc: text.Doc(`
+			Converts test results in a test harness format to ResultDB test results
+			and prints them, one JSON object per line, like the ls subcommand
+			with -json. Reads the results from FILE, or from stdin if FILE is
+			omitted.
+
+			Does not contact ResultDB; it is useful to check how test results
+			will be interpreted before they are uploaded.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &convertRun{}
+			r.registerFlags()
+			return r
+		},
+	}
+}
+
+type convertRun struct {
+	baseCommandRun
+	format         string
+	testPathPrefix string
+	path           string
+}
+
+func (r *convertRun) registerFlags() {
+	r.Flags.StringVar(&r.format, "format", "", text.Doc(`
+		Format of the test results. Required. One of `+strings.Join(formats.Formats, ", ")+`.
+	`))
+	r.Flags.StringVar(&r.testPathPrefix, "test-path-prefix", "", text.Doc(`
+		Prefix to prepend to the test path of every test result.
+	`))
+}
+
+func (r *convertRun) parseArgs(args []string) error {
+	if len(args) > 1 {
+		return errors.Reason("usage: %s", convertUsage).Err()
+	}
+	if len(args) == 1 {
+		r.path = args[0]
+	}
+
+	for _, f := range formats.Formats {
+		if r.format == f {
+			return nil
+		}
+	}
+	return errors.Reason("-format must be one of %s", strings.Join(formats.Formats, ", ")).Err()
+}
+
+func (r *convertRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.parseArgs(args); err != nil {
+		return r.done(err)
+	}
+
+	return r.done(r.convert(ctx))
+}
+
+// convert reads the test results and prints them in JSON format to stdout.
+func (r *convertRun) convert(ctx context.Context) error {
+	var in io.Reader = os.Stdin
+	if r.path != "" {
+		f, err := os.Open(r.path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	inv := &pb.Invocation{}
+	results, err := formats.ConvertResults(ctx, r.format, in, r.testPathPrefix, inv)
+	if err != nil {
+		return errors.Annotate(err, "failed to convert test results").Err()
+	}
+	if inv.State == pb.Invocation_INTERRUPTED {
+		logging.Warningf(ctx, "The test results indicate that the test run was interrupted")
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	for _, tr := range results {
+		obj := map[string]interface{}{
+			"testResult": json.RawMessage(msgToJSON(tr)),
+		}
+		if err := enc.Encode(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
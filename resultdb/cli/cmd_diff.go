@@ -0,0 +1,402 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/maruel/subcommands"
+	"golang.org/x/sync/errgroup"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	typepb "go.chromium.org/luci/resultdb/proto/type"
+)
+
+const diffUsage = `diff [flags] BASE_INVOCATION_ID NEW_INVOCATION_ID`
+
+func cmdDiff(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: diffUsage,
+		ShortDesc: "print test variants that changed between two invocations",
+		LongDesc: text.Doc(`
+			Compares results of two invocations, including their inclusions,
+			and prints test variants that changed between them.
+
+			A test variant fails if none of its results are expected.
+			Reported changes:
+			  - new failure: the variant passed in the base invocation, but fails in
+			    the new one.
+			  - fixed: the variant failed in the base invocation, but passes in the
+			    new one.
+			  - added: the variant has results only in the new invocation.
+			  - removed: the variant has results only in the base invocation.
+			  - duration regression: the average duration of the variant grew by
+			    at least -duration-ratio times and by at least -min-duration-delta.
+
+			Example, compare a try build with its base CI build:
+			  rdb diff build-8901234567890123456 build-8909876543210987654
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &diffRun{}
+			r.registerFlags(p)
+			return r
+		},
+	}
+}
+
+// changeKind is a kind of a test variant change between two invocations.
+type changeKind string
+
+// Kinds of changes, in the order they are printed.
+const (
+	changeNewFailure         changeKind = "NEW_FAILURE"
+	changeFixed              changeKind = "FIXED"
+	changeAdded              changeKind = "ADDED"
+	changeRemoved            changeKind = "REMOVED"
+	changeDurationRegression changeKind = "DURATION_REGRESSION"
+)
+
+var changeKindOrder = map[changeKind]int{
+	changeNewFailure:         0,
+	changeFixed:              1,
+	changeAdded:              2,
+	changeRemoved:            3,
+	changeDurationRegression: 4,
+}
+
+var changeKindTitles = map[changeKind]string{
+	changeNewFailure:         "New failures",
+	changeFixed:              "Fixed",
+	changeAdded:              "Added",
+	changeRemoved:            "Removed",
+	changeDurationRegression: "Duration regressions",
+}
+
+type diffRun struct {
+	baseCommandRun
+	testPath         string
+	durationRatio    float64
+	minDurationDelta time.Duration
+	baseInvID        string
+	newInvID         string
+}
+
+func (r *diffRun) registerFlags(p Params) {
+	r.RegisterGlobalFlags(p)
+	r.RegisterJSONFlag(text.Doc(`
+		Print changes in JSON format separated by newline.
+		One change takes exactly one line. Change object properties:
+		  - kind: one of NEW_FAILURE, FIXED, ADDED, REMOVED, DURATION_REGRESSION.
+		  - testPath: a string.
+		  - variant: luci.resultdb.type.Variant message.
+		  - baseStatuses, newStatuses: statuses of the results of the test variant
+		    in the base and new invocations respectively.
+		  - baseDuration, newDuration: average durations of the results, e.g.
+		    "1.5s". Set only for DURATION_REGRESSION.
+	`))
+
+	r.Flags.StringVar(&r.testPath, "test-path", "", text.Doc(`
+		A regular expression for test path. Implicitly wrapped with ^ and $.
+
+		Example: gn://chrome/test:browser_tests/.+
+	`))
+	r.Flags.Float64Var(&r.durationRatio, "duration-ratio", 2, text.Doc(`
+		Minimum ratio of the new average duration of a test variant to the base
+		one, to report a duration regression.
+	`))
+	r.Flags.DurationVar(&r.minDurationDelta, "min-duration-delta", time.Second, text.Doc(`
+		Minimum difference between the new and base average durations of a test
+		variant, to report a duration regression.
+	`))
+}
+
+func (r *diffRun) parseArgs(args []string) error {
+	if len(args) != 2 {
+		return errors.Reason("usage: %s", diffUsage).Err()
+	}
+	r.baseInvID = args[0]
+	r.newInvID = args[1]
+
+	for _, id := range args {
+		if err := pbutil.ValidateInvocationID(id); err != nil {
+			return errors.Annotate(err, "invocation id %q", id).Err()
+		}
+	}
+
+	switch {
+	case r.durationRatio < 1:
+		return errors.Reason("-duration-ratio must be at least 1").Err()
+	case r.minDurationDelta < 0:
+		return errors.Reason("-min-duration-delta must be non-negative").Err()
+	}
+	return nil
+}
+
+func (r *diffRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.parseArgs(args); err != nil {
+		return r.done(err)
+	}
+
+	if err := r.initClients(ctx); err != nil {
+		return r.done(err)
+	}
+
+	return r.done(r.diff(ctx))
+}
+
+// diff fetches results of both invocations, compares them and prints the
+// changes.
+func (r *diffRun) diff(ctx context.Context) error {
+	var baseVariants, newVariants map[string]*variantResults
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() (err error) {
+		baseVariants, err = r.fetchVariants(ctx, r.baseInvID)
+		return
+	})
+	eg.Go(func() (err error) {
+		newVariants, err = r.fetchVariants(ctx, r.newInvID)
+		return
+	})
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	changes := r.compare(baseVariants, newVariants)
+	if r.json {
+		r.printChangesJSON(changes)
+	} else {
+		printChanges(changes)
+	}
+	return nil
+}
+
+// variantResults are results of a test variant in one invocation.
+type variantResults struct {
+	testPath string
+	variant  *typepb.Variant
+	statuses []pb.TestStatus
+	// passed is true if at least one result was expected.
+	passed bool
+
+	totalDuration time.Duration
+	durationCount int
+}
+
+// avgDuration returns the average duration of the results that have a
+// duration, or 0 if there are none.
+func (v *variantResults) avgDuration() time.Duration {
+	if v.durationCount == 0 {
+		return 0
+	}
+	return v.totalDuration / time.Duration(v.durationCount)
+}
+
+// fetchVariants fetches all results of the invocation, including its
+// inclusions, and groups them by test variant.
+// The returned map is keyed by pbutil.TestVariantKey.
+func (r *diffRun) fetchVariants(ctx context.Context, invID string) (map[string]*variantResults, error) {
+	ret := map[string]*variantResults{}
+	req := &pb.QueryTestResultsRequest{
+		Invocations: []string{pbutil.InvocationName(invID)},
+		Predicate: &pb.TestResultPredicate{
+			TestPathRegexp: r.testPath,
+			Expectancy:     pb.TestResultPredicate_ALL,
+		},
+		PageSize: 1000,
+	}
+	for {
+		res, err := r.resultdb.QueryTestResults(ctx, req)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to query results of %q", invID).Err()
+		}
+
+		for _, tr := range res.TestResults {
+			key := pbutil.TestVariantKey(tr.TestPath, tr.Variant)
+			v := ret[key]
+			if v == nil {
+				v = &variantResults{testPath: tr.TestPath, variant: tr.Variant}
+				ret[key] = v
+			}
+			v.statuses = append(v.statuses, tr.Status)
+			v.passed = v.passed || tr.Expected
+			if tr.Duration != nil {
+				d, err := ptypes.Duration(tr.Duration)
+				if err != nil {
+					return nil, errors.Annotate(err, "invalid duration of %q", tr.Name).Err()
+				}
+				v.totalDuration += d
+				v.durationCount++
+			}
+		}
+
+		if res.NextPageToken == "" {
+			return ret, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// variantChange is a change of a test variant between two invocations.
+type variantChange struct {
+	kind     changeKind
+	testPath string
+	variant  *typepb.Variant
+	base     *variantResults // nil if the variant was added
+	new      *variantResults // nil if the variant was removed
+}
+
+// compare returns changes between test variants of the base and new
+// invocations, sorted by kind, test path and variant.
+func (r *diffRun) compare(baseVariants, newVariants map[string]*variantResults) []*variantChange {
+	var ret []*variantChange
+	add := func(kind changeKind, b, n *variantResults) {
+		c := &variantChange{kind: kind, base: b, new: n}
+		if n != nil {
+			c.testPath, c.variant = n.testPath, n.variant
+		} else {
+			c.testPath, c.variant = b.testPath, b.variant
+		}
+		ret = append(ret, c)
+	}
+
+	for key, n := range newVariants {
+		b, ok := baseVariants[key]
+		if !ok {
+			add(changeAdded, nil, n)
+			continue
+		}
+
+		switch {
+		case b.passed && !n.passed:
+			add(changeNewFailure, b, n)
+		case !b.passed && n.passed:
+			add(changeFixed, b, n)
+		}
+
+		if r.isDurationRegression(b.avgDuration(), n.avgDuration()) {
+			add(changeDurationRegression, b, n)
+		}
+	}
+	for key, b := range baseVariants {
+		if _, ok := newVariants[key]; !ok {
+			add(changeRemoved, b, nil)
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if a.kind != b.kind {
+			return changeKindOrder[a.kind] < changeKindOrder[b.kind]
+		}
+		if a.testPath != b.testPath {
+			return a.testPath < b.testPath
+		}
+		return formatVariant(a.variant) < formatVariant(b.variant)
+	})
+	return ret
+}
+
+// isDurationRegression returns true if the change of the average duration
+// from b to n exceeds the thresholds specified by the flags.
+func (r *diffRun) isDurationRegression(b, n time.Duration) bool {
+	if b == 0 || n == 0 {
+		return false
+	}
+	return n-b >= r.minDurationDelta && float64(n) >= float64(b)*r.durationRatio
+}
+
+// printChanges prints changes in a human-readable format to stdout.
+func printChanges(changes []*variantChange) {
+	if len(changes) == 0 {
+		fmt.Println("no differences")
+		return
+	}
+
+	for i, c := range changes {
+		if i == 0 || changes[i-1].kind != c.kind {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("%s:\n", changeKindTitles[c.kind])
+		}
+
+		fmt.Printf("  %s {%s}", c.testPath, formatVariant(c.variant))
+		switch c.kind {
+		case changeNewFailure, changeFixed:
+			fmt.Printf(": %s -> %s", formatStatuses(c.base.statuses), formatStatuses(c.new.statuses))
+		case changeDurationRegression:
+			fmt.Printf(": %s -> %s", c.base.avgDuration(), c.new.avgDuration())
+		}
+		fmt.Println()
+	}
+}
+
+// printChangesJSON prints changes in JSON format to stdout.
+// Each change takes exactly one line and is followed by newline.
+func (r *diffRun) printChangesJSON(changes []*variantChange) {
+	enc := json.NewEncoder(os.Stdout)
+	for _, c := range changes {
+		variant := c.variant
+		if variant == nil {
+			variant = &typepb.Variant{}
+		}
+		obj := map[string]interface{}{
+			"kind":     c.kind,
+			"testPath": c.testPath,
+			"variant":  json.RawMessage(msgToJSON(variant)),
+		}
+		if c.base != nil {
+			obj["baseStatuses"] = statusStrings(c.base.statuses)
+		}
+		if c.new != nil {
+			obj["newStatuses"] = statusStrings(c.new.statuses)
+		}
+		if c.kind == changeDurationRegression {
+			obj["baseDuration"] = c.base.avgDuration().String()
+			obj["newDuration"] = c.new.avgDuration().String()
+		}
+		enc.Encode(obj) // prints \n in the end
+	}
+}
+
+func formatVariant(vr *typepb.Variant) string {
+	return strings.Join(pbutil.VariantToStrings(vr), ", ")
+}
+
+func formatStatuses(statuses []pb.TestStatus) string {
+	return strings.Join(statusStrings(statuses), ", ")
+}
+
+func statusStrings(statuses []pb.TestStatus) []string {
+	ret := make([]string, len(statuses))
+	for i, s := range statuses {
+		ret[i] = s.String()
+	}
+	return ret
+}
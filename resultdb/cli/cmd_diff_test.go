@@ -0,0 +1,116 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	Convey(`compare`, t, func() {
+		r := &diffRun{durationRatio: 2, minDurationDelta: time.Second}
+
+		// results returns results of test "t" with one result of the duration.
+		results := func(passed bool, d time.Duration) *variantResults {
+			v := &variantResults{testPath: "t", variant: pbutil.Variant("k", "v"), passed: passed}
+			if d > 0 {
+				v.totalDuration = d
+				v.durationCount = 1
+			}
+			return v
+		}
+		// variants returns a map with one test variant, or an empty map if v
+		// is nil.
+		variants := func(v *variantResults) map[string]*variantResults {
+			ret := map[string]*variantResults{}
+			if v != nil {
+				ret[pbutil.TestVariantKey(v.testPath, v.variant)] = v
+			}
+			return ret
+		}
+
+		cases := []struct {
+			name     string
+			base     *variantResults
+			new      *variantResults
+			expected []changeKind
+		}{
+			{"new failure", results(true, 0), results(false, 0), []changeKind{changeNewFailure}},
+			{"fixed", results(false, 0), results(true, 0), []changeKind{changeFixed}},
+			{"added", nil, results(true, 0), []changeKind{changeAdded}},
+			{"removed", results(true, 0), nil, []changeKind{changeRemoved}},
+			{"unchanged", results(true, time.Second), results(true, time.Second), nil},
+			{"duration regression", results(true, time.Second), results(true, 3*time.Second), []changeKind{changeDurationRegression}},
+			{"new failure and duration regression", results(true, time.Second), results(false, 3*time.Second), []changeKind{changeNewFailure, changeDurationRegression}},
+			{"small delta is not a regression", results(true, 100*time.Millisecond), results(true, 300*time.Millisecond), nil},
+			{"small ratio is not a regression", results(true, 10*time.Second), results(true, 15*time.Second), nil},
+			{"unknown base duration is not a regression", results(true, 0), results(true, 3*time.Second), nil},
+			{"unknown new duration is not a regression", results(true, time.Second), results(true, 0), nil},
+		}
+		for _, c := range cases {
+			c := c
+			Convey(c.name, func() {
+				var kinds []changeKind
+				for _, ch := range r.compare(variants(c.base), variants(c.new)) {
+					So(ch.testPath, ShouldEqual, "t")
+					So(ch.base, ShouldEqual, c.base)
+					So(ch.new, ShouldEqual, c.new)
+					kinds = append(kinds, ch.kind)
+				}
+				So(kinds, ShouldResemble, c.expected)
+			})
+		}
+
+		Convey(`sorts changes by kind, test path and variant`, func() {
+			v := func(testPath string, passed bool, vr ...string) *variantResults {
+				return &variantResults{testPath: testPath, variant: pbutil.Variant(vr...), passed: passed}
+			}
+			baseVariants := map[string]*variantResults{}
+			newVariants := map[string]*variantResults{}
+			for _, pair := range [][2]*variantResults{
+				{v("b", true), v("b", false)},
+				{v("a", true, "k", "2"), v("a", false, "k", "2")},
+				{v("a", true, "k", "1"), v("a", false, "k", "1")},
+				{nil, v("c", true)},
+				{v("d", false), v("d", true)},
+			} {
+				if b := pair[0]; b != nil {
+					baseVariants[pbutil.TestVariantKey(b.testPath, b.variant)] = b
+				}
+				n := pair[1]
+				newVariants[pbutil.TestVariantKey(n.testPath, n.variant)] = n
+			}
+
+			var got []string
+			for _, ch := range r.compare(baseVariants, newVariants) {
+				got = append(got, string(ch.kind)+" "+ch.testPath+" {"+formatVariant(ch.variant)+"}")
+			}
+			So(got, ShouldResemble, []string{
+				"NEW_FAILURE a {k:1}",
+				"NEW_FAILURE a {k:2}",
+				"NEW_FAILURE b {}",
+				"FIXED d {}",
+				"ADDED c {}",
+			})
+		})
+	})
+}
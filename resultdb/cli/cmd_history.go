@@ -0,0 +1,181 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/strpair"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+const historyUsage = `history [flags] TEST_PATH_PREFIX`
+
+func cmdHistory(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: historyUsage,
+		ShortDesc: "print recent results and flakiness of tests",
+		LongDesc: text.Doc(`
+			Prints flakiness of test variants with recent results of tests whose
+			test path starts with TEST_PATH_PREFIX, across invocations of a realm
+			or a project.
+
+			Results are grouped by invocation. An invocation where a test variant
+			had both expected and unexpected results, e.g. the test failed, but
+			passed on retry, is counted as flaky.
+
+			Example:
+			  rdb history -realm chromium gn://chrome/test:browser_tests/
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &historyRun{variant: strpair.Map{}}
+			r.registerFlags(p)
+			return r
+		},
+	}
+}
+
+type historyRun struct {
+	baseCommandRun
+	realm          string
+	variant        strpair.Map
+	maxAge         time.Duration
+	limit          int
+	testPathPrefix string
+}
+
+func (r *historyRun) registerFlags(p Params) {
+	r.RegisterGlobalFlags(p)
+	r.RegisterJSONFlag(text.Doc(`
+		Print results in JSON format separated by newline.
+		One object takes exactly one line. Object properties:
+		  - testVariant: luci.resultdb.rpc.v1.TestVariantFlakiness message.
+		  - testResult: luci.resultdb.rpc.v1.TestResult message.
+
+		testVariant and testResult properties are mutually exclusive.
+		Test variants are printed before test results.
+	`))
+
+	r.Flags.StringVar(&r.realm, "realm", "", text.Doc(`
+		Realm of the invocations to search, e.g. "chromium/public", or a project,
+		e.g. "chromium", to search invocations of all its realms. Required.
+	`))
+	r.Flags.Var(flag.StringPairs(r.variant), "variant", text.Doc(`
+		A key:value pair that the test variant must have. May be specified
+		multiple times.
+	`))
+	r.Flags.DurationVar(&r.maxAge, "max-age", 0, text.Doc(`
+		Search only results uploaded within this duration, e.g. 24h.
+		If 0, the server default is used.
+	`))
+	r.Flags.IntVar(&r.limit, "n", 0, text.Doc(`
+		Consider up to n most recent results. If 0, the server default is used.
+		Flakiness is computed from these results only, so rarely run variants
+		may be missing if there are more results; use -variant to focus on one.
+	`))
+}
+
+func (r *historyRun) parseArgs(args []string) error {
+	if len(args) != 1 {
+		return errors.Reason("usage: %s", historyUsage).Err()
+	}
+	r.testPathPrefix = args[0]
+
+	switch {
+	case r.realm == "":
+		return errors.Reason("-realm is required").Err()
+	case r.maxAge < 0:
+		return errors.Reason("-max-age must be non-negative").Err()
+	case r.limit < 0:
+		return errors.Reason("-n must be non-negative").Err()
+	}
+	return nil
+}
+
+func (r *historyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.parseArgs(args); err != nil {
+		return r.done(err)
+	}
+
+	if err := r.initClients(ctx); err != nil {
+		return r.done(err)
+	}
+
+	return r.done(r.history(ctx))
+}
+
+// history fetches test history and prints it.
+func (r *historyRun) history(ctx context.Context) error {
+	req := &pb.GetTestHistoryRequest{
+		Realm:          r.realm,
+		TestPathPrefix: r.testPathPrefix,
+		MaxResults:     int32(r.limit),
+	}
+	if len(r.variant) > 0 {
+		vr, err := pbutil.VariantFromStrings(r.variant.Format())
+		if err != nil {
+			return errors.Annotate(err, "-variant").Err()
+		}
+		req.Variant = &pb.VariantPredicate{
+			Predicate: &pb.VariantPredicate_Contains{Contains: vr},
+		}
+	}
+	if r.maxAge > 0 {
+		req.MaxAge = ptypes.DurationProto(r.maxAge)
+	}
+
+	res, err := r.resultdb.GetTestHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if r.json {
+		enc := json.NewEncoder(os.Stdout)
+		for _, v := range res.TestVariants {
+			enc.Encode(map[string]interface{}{"testVariant": json.RawMessage(msgToJSON(v))})
+		}
+		for _, tr := range res.TestResults {
+			enc.Encode(map[string]interface{}{"testResult": json.RawMessage(msgToJSON(tr))})
+		}
+		return nil
+	}
+
+	if len(res.TestVariants) == 0 {
+		fmt.Println("no results")
+		return nil
+	}
+	for _, v := range res.TestVariants {
+		fmt.Printf("%s {%s}: %d passed, %d failed, %d flaky\n",
+			v.TestPath, strings.Join(pbutil.VariantToStrings(v.Variant), ", "),
+			v.PassCount, v.FailCount, v.FlakeCount)
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/auth"
+
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/pbutil"
+	bqpb "go.chromium.org/luci/resultdb/proto/bq/v1"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+const (
+	// maxInvocationGraphSize is the maximum number of invocations reachable
+	// from an exported invocation.
+	maxInvocationGraphSize = 1000
+
+	// waitForInclusionsInterval is how long to wait before retrying an export
+	// of an invocation that includes unfinalized invocations.
+	waitForInclusionsInterval = time.Minute
+)
+
+// inserter inserts rows into a BigQuery table.
+type inserter interface {
+	// Put uploads one or more rows to the table.
+	// Implemented by *bigquery.Uploader.
+	Put(ctx context.Context, src interface{}) error
+
+	// Close releases resources held by the inserter.
+	Close() error
+}
+
+// bqExporter exports test results of finalized invocations to BigQuery.
+type bqExporter struct {
+	// newInserter returns an inserter for the table of the export.
+	newInserter func(ctx context.Context, bqExport *pb.BigQueryExport) (inserter, error)
+
+	// taskBatchSize is the maximum number of tasks to look at at a time.
+	taskBatchSize int
+
+	// resultBatchSize is the maximum number of test results to insert at
+	// a time.
+	resultBatchSize int
+
+	// leaseDuration is how long a worker owns a task without saving progress.
+	leaseDuration time.Duration
+
+	// idleSleep is how long to sleep when there are no tasks to process.
+	idleSleep time.Duration
+}
+
+// run processes export tasks until ctx is done.
+func (b *bqExporter) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := b.processTasks(ctx)
+		if err != nil {
+			logging.Errorf(ctx, "failed to process tasks: %s", err)
+		}
+		if err != nil || n == 0 {
+			clock.Sleep(ctx, b.idleSleep)
+		}
+	}
+}
+
+// processTasks processes a batch of tasks that are ready.
+// Returns the number of tasks it leased.
+func (b *bqExporter) processTasks(ctx context.Context) (int, error) {
+	keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), b.taskBatchSize)
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to peek tasks").Err()
+	}
+
+	leased := 0
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			break
+		}
+
+		task, progress, err := span.LeaseInvocationTask(ctx, key, b.leaseDuration)
+		switch {
+		case err != nil:
+			logging.Errorf(ctx, "failed to lease task %q of %s: %s", key.PayloadHash, key.InvocationID.Name(), err)
+			continue
+		case task == nil:
+			// Another worker leased it first.
+			continue
+		}
+		leased++
+
+		// On failure, the task will be retried when the lease expires.
+		if err := b.processTask(ctx, key, task, progress); err != nil {
+			logging.Errorf(ctx, "failed to process task %q of %s: %s", key.PayloadHash, key.InvocationID.Name(), err)
+		}
+	}
+	return leased, nil
+}
+
+// processTask processes a leased task.
+func (b *bqExporter) processTask(ctx context.Context, key span.InvocationTaskKey, task *internalpb.InvocationTask, progress string) error {
+	if task.BigqueryExport == nil {
+		logging.Errorf(ctx, "deleting task %q of %s: unsupported payload", key.PayloadHash, key.InvocationID.Name())
+		return applyMutations(ctx, span.DeleteInvocationTask(key))
+	}
+
+	err := b.exportResults(ctx, key, task.BigqueryExport, progress)
+	switch code := grpcutil.Code(err); {
+	case code == codes.NotFound || code == codes.InvalidArgument || code == codes.Unimplemented || span.TooManyInvocationsTag.In(err):
+		// Retrying will not help.
+		logging.Errorf(ctx, "deleting task %q of %s: %s", key.PayloadHash, key.InvocationID.Name(), err)
+		return applyMutations(ctx, span.DeleteInvocationTask(key))
+	default:
+		return err
+	}
+}
+
+// exportResults exports test results of the invocation, including its
+// transitive inclusions, to the BigQuery table, starting from progress.
+//
+// Saves progress after each batch, so that a restarted export does not insert
+// the same rows again. Insert IDs are derived from test result names, so
+// that BigQuery deduplicates the rows inserted by a batch that was retried.
+// Deletes the task when all test results are exported.
+func (b *bqExporter) exportResults(ctx context.Context, key span.InvocationTaskKey, bqExport *pb.BigQueryExport, progress string) error {
+	txn := span.Client(ctx).ReadOnlyTransaction()
+	defer txn.Close()
+
+	invIDs, err := span.ReadReachableInvocations(ctx, txn, maxInvocationGraphSize, span.NewInvocationIDSet(key.InvocationID))
+	if err != nil {
+		return err
+	}
+	invs, err := span.ReadInvocationsFull(ctx, txn, invIDs)
+	if err != nil {
+		return err
+	}
+	for id, inv := range invs {
+		if !pbutil.IsFinalized(inv.State) {
+			logging.Infof(ctx, "postponing export of %s: %s is not finalized", key.InvocationID.Name(), id.Name())
+			return applyMutations(ctx, span.PostponeInvocationTask(key, clock.Now(ctx).Add(waitForInclusionsInterval)))
+		}
+	}
+
+	exonerated, err := readExoneratedTestVariants(ctx, txn, invIDs)
+	if err != nil {
+		return err
+	}
+
+	ins, err := b.newInserter(ctx, bqExport)
+	if err != nil {
+		return errors.Annotate(err, "failed to create an inserter").Err()
+	}
+	defer ins.Close()
+
+	rowInv := &bqpb.TestResultRow_Invocation{
+		Id:   string(key.InvocationID),
+		Tags: invs[key.InvocationID].Tags,
+	}
+	q := span.TestResultQuery{
+		InvocationIDs: invIDs,
+		Predicate:     bqExport.GetTestResults().GetPredicate(),
+		PageSize:      b.resultBatchSize,
+		PageToken:     progress,
+	}
+	for {
+		trs, nextPageToken, err := span.QueryTestResults(ctx, txn, q)
+		if err != nil {
+			return errors.Annotate(err, "failed to query test results").Err()
+		}
+
+		rows := make([]*bq.Row, len(trs))
+		for i, tr := range trs {
+			rows[i] = &bq.Row{
+				Message: &bqpb.TestResultRow{
+					Invocation: rowInv,
+					Result:     tr,
+					Exoneration: &bqpb.TestResultRow_TestExoneration{
+						Exonerated: exonerated[pbutil.TestVariantKey(tr.TestPath, tr.Variant)],
+					},
+				},
+				InsertID: insertID(key.InvocationID, tr.Name),
+			}
+		}
+		if err := insertRows(ctx, ins, rows); err != nil {
+			return err
+		}
+
+		if nextPageToken == "" {
+			return applyMutations(ctx, span.DeleteInvocationTask(key))
+		}
+		leaseEnd := clock.Now(ctx).Add(b.leaseDuration)
+		if err := applyMutations(ctx, span.SaveInvocationTaskProgress(key, nextPageToken, leaseEnd)); err != nil {
+			return errors.Annotate(err, "failed to save progress").Err()
+		}
+		q.PageToken = nextPageToken
+	}
+}
+
+// insertRows inserts rows into BigQuery, retrying transient failures.
+//
+// If BigQuery rejects rows, e.g. because they don't match the table schema,
+// the returned error is tagged with InvalidArgument code, so that the task is
+// deleted instead of failing the same way over and over.
+func insertRows(ctx context.Context, ins inserter, rows []*bq.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	err := retry.Retry(ctx, transient.Only(retry.Default), func() error {
+		err := ins.Put(ctx, rows)
+		if _, ok := err.(bigquery.PutMultiError); err != nil && !ok {
+			// Row errors are permanent, others are likely not.
+			err = transient.Tag.Apply(err)
+		}
+		return err
+	}, retry.LogCallback(ctx, "bigquery insert"))
+	if _, ok := err.(bigquery.PutMultiError); ok {
+		return errors.Annotate(err, "rows were rejected").Tag(grpcutil.InvalidArgumentTag).Err()
+	}
+	return errors.Annotate(err, "failed to insert rows").Err()
+}
+
+// readExoneratedTestVariants returns a set of keys of the test variants
+// exonerated in the invocations, see pbutil.TestVariantKey.
+func readExoneratedTestVariants(ctx context.Context, txn *spanner.ReadOnlyTransaction, invIDs span.InvocationIDSet) (map[string]bool, error) {
+	ret := map[string]bool{}
+	q := span.TestExonerationQuery{
+		InvocationIDs: invIDs,
+		PageSize:      1000,
+	}
+	for {
+		tes, nextPageToken, err := span.QueryTestExonerations(ctx, txn, q)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to query test exonerations").Err()
+		}
+		for _, te := range tes {
+			ret[pbutil.TestVariantKey(te.TestPath, te.Variant)] = true
+		}
+		if nextPageToken == "" {
+			return ret, nil
+		}
+		q.PageToken = nextPageToken
+	}
+}
+
+// insertID returns a BigQuery insert ID for a test result exported as a part
+// of the invocation.
+func insertID(exportedInvID span.InvocationID, testResultName string) string {
+	h := sha256.Sum256([]byte(string(exportedInvID) + "\n" + testResultName))
+	return hex.EncodeToString(h[:])
+}
+
+func applyMutations(ctx context.Context, ms ...*spanner.Mutation) error {
+	_, err := span.Client(ctx).Apply(ctx, ms)
+	return err
+}
+
+// prodInserter is an inserter that inserts rows into a production table.
+type prodInserter struct {
+	*bigquery.Uploader
+	client *bigquery.Client
+}
+
+func (i *prodInserter) Close() error {
+	return i.client.Close()
+}
+
+// newProdInserter creates an inserter for the table of the export, using
+// the server's own credentials.
+func newProdInserter(ctx context.Context, bqExport *pb.BigQueryExport) (inserter, error) {
+	tr, err := auth.GetRPCTransport(ctx, auth.AsSelf, auth.WithScopes(bigquery.Scope))
+	if err != nil {
+		return nil, err
+	}
+	client, err := bigquery.NewClient(ctx, bqExport.Project, option.WithHTTPClient(&http.Client{Transport: tr}))
+	if err != nil {
+		return nil, err
+	}
+	return &prodInserter{
+		Uploader: client.Dataset(bqExport.Dataset).Table(bqExport.Table).Uploader(),
+		client:   client,
+	}, nil
+}
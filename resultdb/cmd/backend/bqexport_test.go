@@ -0,0 +1,191 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/pbutil"
+	bqpb "go.chromium.org/luci/resultdb/proto/bq/v1"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeInserter records inserted rows.
+type fakeInserter struct {
+	rows []*bq.Row
+	// putsBeforeFailure is the number of successful Put calls, after which
+	// Put fails with putErr. Ignored if negative.
+	putsBeforeFailure int
+	putErr            error
+}
+
+func (f *fakeInserter) Put(ctx context.Context, src interface{}) error {
+	if f.putsBeforeFailure == 0 {
+		return f.putErr
+	}
+	f.putsBeforeFailure--
+	f.rows = append(f.rows, src.([]*bq.Row)...)
+	return nil
+}
+
+func (f *fakeInserter) Close() error {
+	return nil
+}
+
+func TestExportResults(t *testing.T) {
+	Convey(`TestExportResults`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		ct := testclock.TestRecentTimeUTC
+
+		ins := &fakeInserter{putsBeforeFailure: -1}
+		exporter := &bqExporter{
+			newInserter: func(ctx context.Context, bqExport *pb.BigQueryExport) (inserter, error) {
+				return ins, nil
+			},
+			taskBatchSize:   10,
+			resultBatchSize: 10,
+			leaseDuration:   time.Minute,
+		}
+
+		bqExport := &pb.BigQueryExport{Project: "project", Dataset: "dataset", Table: "table"}
+		testutil.MustApply(ctx, testutil.CombineMutations(
+			testutil.InsertInvocationWithInclusions("a", "b"),
+			[]*spanner.Mutation{
+				testutil.InsertInvocation("b", pb.Invocation_COMPLETED, "", ct),
+				span.InsertInvocationTask("a", &internalpb.InvocationTask{BigqueryExport: bqExport}, false),
+			},
+			testutil.InsertTestResults(testutil.MakeTestResults("a", "A", pb.TestStatus_PASS, pb.TestStatus_FAIL)),
+			testutil.InsertTestResults(testutil.MakeTestResults("b", "B", pb.TestStatus_CRASH)),
+			testutil.InsertTestExonerations("b", "B", pbutil.Variant("k1", "v1", "k2", "v2"), 1),
+		)...)
+
+		keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+		So(err, ShouldBeNil)
+		So(keys, ShouldHaveLength, 1)
+		key := keys[0]
+
+		task := &internalpb.InvocationTask{BigqueryExport: bqExport}
+		readProgress := func() string {
+			var progress string
+			testutil.MustReadRow(ctx, "InvocationTasks", key.Key(), map[string]interface{}{
+				"Progress": &progress,
+			})
+			return progress
+		}
+
+		Convey(`exports all results`, func() {
+			So(exporter.processTask(ctx, key, task, ""), ShouldBeNil)
+
+			So(ins.rows, ShouldHaveLength, 3)
+			exonerated := map[string]bool{}
+			insertIDs := map[string]bool{}
+			for _, r := range ins.rows {
+				row := r.Message.(*bqpb.TestResultRow)
+				So(row.Invocation.Id, ShouldEqual, "a")
+				exonerated[row.Result.Name] = row.Exoneration.Exonerated
+				insertIDs[r.InsertID] = true
+			}
+			So(exonerated, ShouldResemble, map[string]bool{
+				"invocations/a/tests/A/results/0": false,
+				"invocations/a/tests/A/results/1": false,
+				"invocations/b/tests/B/results/0": true,
+			})
+			So(insertIDs, ShouldHaveLength, 3)
+
+			keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+			So(err, ShouldBeNil)
+			So(keys, ShouldBeEmpty)
+		})
+
+		Convey(`resumes from progress`, func() {
+			// Let retries of the transient error below give up quickly.
+			tc := clock.Get(ctx).(testclock.TestClock)
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) { tc.Add(d) })
+
+			exporter.resultBatchSize = 1
+			ins.putsBeforeFailure = 2
+			ins.putErr = errors.New("connection reset")
+			So(exporter.processTask(ctx, key, task, ""), ShouldErrLike, "failed to insert rows")
+			So(ins.rows, ShouldHaveLength, 2)
+
+			progress := readProgress()
+			So(progress, ShouldNotEqual, "")
+
+			ins.putsBeforeFailure = -1
+			So(exporter.processTask(ctx, key, task, progress), ShouldBeNil)
+			So(ins.rows, ShouldHaveLength, 3)
+			names := map[string]bool{}
+			for _, r := range ins.rows {
+				names[r.Message.(*bqpb.TestResultRow).Result.Name] = true
+			}
+			So(names, ShouldHaveLength, 3)
+		})
+
+		Convey(`deletes tasks with rejected rows`, func() {
+			ins.putsBeforeFailure = 0
+			ins.putErr = bigquery.PutMultiError{}
+			So(exporter.processTask(ctx, key, task, ""), ShouldBeNil)
+			So(ins.rows, ShouldBeEmpty)
+
+			keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+			So(err, ShouldBeNil)
+			So(keys, ShouldBeEmpty)
+		})
+
+		Convey(`waits for included invocations`, func() {
+			testutil.MustApply(ctx,
+				testutil.InsertInvocation("c", pb.Invocation_ACTIVE, "", ct),
+				testutil.InsertInclusion("b", "c"),
+			)
+
+			So(exporter.processTask(ctx, key, task, ""), ShouldBeNil)
+			So(ins.rows, ShouldBeEmpty)
+
+			var processAfter time.Time
+			testutil.MustReadRow(ctx, "InvocationTasks", key.Key(), map[string]interface{}{
+				"ProcessAfter": &processAfter,
+			})
+			So(processAfter, ShouldHappenWithin, time.Millisecond, ct.Add(waitForInclusionsInterval))
+		})
+
+		Convey(`deletes tasks with unsupported predicates`, func() {
+			bqExport.TestResults = &pb.BigQueryExport_TestResults{
+				Predicate: &pb.TestResultPredicate{Variant: &pb.VariantPredicate{}},
+			}
+			So(exporter.processTask(ctx, key, task, ""), ShouldBeNil)
+			So(ins.rows, ShouldBeEmpty)
+
+			keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+			So(err, ShouldBeNil)
+			So(keys, ShouldBeEmpty)
+		})
+	})
+}
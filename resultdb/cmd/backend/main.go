@@ -0,0 +1,48 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command backend runs background tasks of ResultDB, such as exporting
+// test results of finalized invocations to BigQuery and finalizing invocations
+// past their deadline.
+package main
+
+import (
+	"io"
+	"time"
+
+	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/router"
+
+	"go.chromium.org/luci/resultdb/internal"
+	"go.chromium.org/luci/resultdb/internal/sweeper"
+)
+
+func main() {
+	internal.Main(func(srv *server.Server) error {
+		srv.Routes.GET("/", router.MiddlewareChain{}, func(c *router.Context) {
+			io.WriteString(c.Writer, "OK")
+		})
+
+		exporter := &bqExporter{
+			newInserter:     newProdInserter,
+			taskBatchSize:   100,
+			resultBatchSize: 500,
+			leaseDuration:   10 * time.Minute,
+			idleSleep:       10 * time.Second,
+		}
+		srv.RunInBackground("resultdb.bqexport", exporter.run)
+		srv.RunInBackground("resultdb.sweeper", sweeper.Run)
+		return nil
+	})
+}
@@ -15,15 +15,22 @@
 package formats
 
 import (
+	"io"
 	"math"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"golang.org/x/net/context"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
 )
 
 const (
 	// OriginalFormatTagKey is a key of the tag indicating the format of the
-	// source data. Possible values: FormatJTR, FormatGTest.
+	// source data. Possible values: FormatJTR, FormatGTest, FormatJUnit,
+	// FormatGoTest, FormatTAP.
 	OriginalFormatTagKey = "orig_format"
 
 	// FormatJTR is Chromium's JSON Test Results format.
@@ -31,8 +38,87 @@ const (
 
 	// FormatGTest is Chromium's GTest format.
 	FormatGTest = "chromium_gtest"
+
+	// FormatJUnit is the JUnit/xUnit XML format.
+	FormatJUnit = "junit_xml"
+
+	// FormatGoTest is the event stream format of `go test -json`
+	// (see `go doc test2json`).
+	FormatGoTest = "go_test_json"
+
+	// FormatTAP is the Test Anything Protocol, https://testanything.org.
+	FormatTAP = "tap"
 )
 
+// maxInlineArtifactSize is the maximum size of the contents of an artifact
+// stored inline with a test result.
+const maxInlineArtifactSize = 8 * 1024
+
+// Formats are the formats that ConvertResults supports.
+var Formats = []string{FormatJTR, FormatGTest, FormatJUnit, FormatGoTest, FormatTAP}
+
+// ConvertResults reads test results in the given format from reader and
+// converts them to TestResult protos, updating inv in-place accordingly.
+// If an error is returned, inv is left unchanged.
+//
+// Does not populate TestResult.Name.
+func ConvertResults(ctx context.Context, format string, reader io.Reader, testPathPrefix string, inv *pb.Invocation) ([]*pb.TestResult, error) {
+	switch format {
+	case FormatJTR:
+		r := &JSONTestResults{}
+		if err := r.ConvertFromJSON(ctx, reader); err != nil {
+			return nil, err
+		}
+		return r.ToProtos(ctx, testPathPrefix, inv, nil)
+
+	case FormatGTest:
+		r := &GTestResults{}
+		if err := r.ConvertFromJSON(ctx, reader); err != nil {
+			return nil, err
+		}
+		return r.ToProtos(ctx, testPathPrefix, inv)
+
+	case FormatJUnit:
+		r := &JUnitResults{}
+		if err := r.ConvertFromXML(ctx, reader); err != nil {
+			return nil, err
+		}
+		return r.ToProtos(ctx, testPathPrefix, inv)
+
+	case FormatGoTest:
+		r := &GoTestResults{}
+		if err := r.ConvertFromJSON(ctx, reader); err != nil {
+			return nil, err
+		}
+		return r.ToProtos(ctx, testPathPrefix, inv)
+
+	case FormatTAP:
+		r := &TAPResults{}
+		if err := r.ConvertFromTAP(ctx, reader); err != nil {
+			return nil, err
+		}
+		return r.ToProtos(ctx, testPathPrefix, inv)
+
+	default:
+		return nil, errors.Reason("unknown test result format %q", format).Err()
+	}
+}
+
+// textArtifact returns an inline text artifact holding contents, truncated to
+// maxInlineArtifactSize if necessary.
+func textArtifact(name, contents string) *pb.Artifact {
+	a := &pb.Artifact{
+		Name:        name,
+		ContentType: "text/plain",
+		Size:        int64(len(contents)),
+	}
+	if len(contents) > maxInlineArtifactSize {
+		contents = contents[:maxInlineArtifactSize]
+	}
+	a.Contents = []byte(contents)
+	return a
+}
+
 // secondsToTimestamp converts a UTC float64 timestamp to a ptypes Timestamp.
 func secondsToTimestamp(t float64) *timestamp.Timestamp {
 	if t < 0 {
@@ -15,13 +15,44 @@
 package formats
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
+func TestConvertResults(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`ConvertResults`, t, func() {
+		Convey(`dispatches on format`, func() {
+			inv := &pb.Invocation{}
+			testResults, err := ConvertResults(ctx, FormatTAP, strings.NewReader("1..1\nok 1 - a\n"), "tap://", inv)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 1)
+			So(testResults[0].TestPath, ShouldEqual, "tap://a")
+		})
+
+		Convey(`rejects unknown formats`, func() {
+			_, err := ConvertResults(ctx, "csv", strings.NewReader(""), "", &pb.Invocation{})
+			So(err, ShouldErrLike, `unknown test result format "csv"`)
+		})
+	})
+
+	Convey(`textArtifact truncates long contents`, t, func() {
+		a := textArtifact("a.txt", strings.Repeat("x", maxInlineArtifactSize+1))
+		So(a.Size, ShouldEqual, maxInlineArtifactSize+1)
+		So(a.Contents, ShouldHaveLength, maxInlineArtifactSize)
+	})
+}
+
 func TestTimeConversion(t *testing.T) {
 	Convey(`Works`, t, func() {
 		Convey(`with whole seconds`, func() {
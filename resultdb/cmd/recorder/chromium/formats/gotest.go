@@ -0,0 +1,209 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+// GoTestResults represents the event stream emitted by `go test -json`.
+// See `go doc test2json` for the format.
+type GoTestResults struct {
+	Events []*GoTestEvent
+}
+
+// GoTestEvent is a single event of a `go test -json` stream.
+type GoTestEvent struct {
+	Time    time.Time `json:"Time"`
+	Action  string    `json:"Action"`
+	Package string    `json:"Package"`
+	Test    string    `json:"Test"`
+	// Elapsed is the duration in seconds of the test or package, for the
+	// "pass", "fail" and "skip" actions.
+	Elapsed float64 `json:"Elapsed"`
+	Output  string  `json:"Output"`
+}
+
+// goTestRun is a single run of a test, accumulated from its events.
+type goTestRun struct {
+	pkg, test string
+	start     time.Time
+	// action is the terminal action of the run, or "" if the run didn't end.
+	action  string
+	elapsed float64
+	output  strings.Builder
+}
+
+// ConvertFromJSON reads the provided reader into the receiver.
+//
+// The receiver is cleared and its fields overwritten.
+func (r *GoTestResults) ConvertFromJSON(ctx context.Context, reader io.Reader) error {
+	*r = GoTestResults{}
+
+	dec := json.NewDecoder(reader)
+	for {
+		ev := &GoTestEvent{}
+		switch err := dec.Decode(ev); {
+		case err == io.EOF:
+			if len(r.Events) == 0 {
+				return errors.Reason("no events in JSON").Err()
+			}
+			return nil
+		case err != nil:
+			return errors.Annotate(err, "event %d", len(r.Events)).Err()
+		}
+		r.Events = append(r.Events, ev)
+	}
+}
+
+// ToProtos converts test results in r []*pb.TestResult and updates inv
+// in-place accordingly.
+// If an error is returned, inv is left unchanged.
+//
+// Does not populate TestResult.Name.
+func (r *GoTestResults) ToProtos(ctx context.Context, testPathPrefix string, inv *pb.Invocation) ([]*pb.TestResult, error) {
+	type key struct{ pkg, test string }
+
+	// A test may run several times, e.g. with -count, so each "run" event
+	// starts a new run of its test.
+	var runs []*goTestRun
+	running := map[key]*goTestRun{}
+	pkgs := map[string]*goTestRun{}
+	var pkgOrder []string
+	for i, ev := range r.Events {
+		if ev.Test == "" {
+			p := pkgs[ev.Package]
+			if p == nil {
+				p = &goTestRun{pkg: ev.Package}
+				pkgs[ev.Package] = p
+				pkgOrder = append(pkgOrder, ev.Package)
+			}
+			switch ev.Action {
+			case "output":
+				p.output.WriteString(ev.Output)
+			case "pass", "fail", "skip":
+				p.action = ev.Action
+				p.elapsed = ev.Elapsed
+			}
+			continue
+		}
+
+		k := key{ev.Package, ev.Test}
+		run := running[k]
+		// A "run" event always starts a new run; if the previous one didn't
+		// end, it is reported as aborted. Streams filtered by hand may lack
+		// "run" events.
+		if ev.Action == "run" || (run == nil && ev.Action != "pause" && ev.Action != "cont") {
+			run = &goTestRun{pkg: ev.Package, test: ev.Test, start: ev.Time}
+			running[k] = run
+			runs = append(runs, run)
+		}
+
+		switch ev.Action {
+		case "run", "pause", "cont":
+		case "output":
+			run.output.WriteString(ev.Output)
+		case "pass", "fail", "skip", "bench":
+			run.action = ev.Action
+			run.elapsed = ev.Elapsed
+			delete(running, k)
+		default:
+			return nil, errors.Reason("event %d: unknown action %q", i, ev.Action).Err()
+		}
+	}
+
+	// Assume the invocation was not interrupted; if any tests didn't end,
+	// e.g. because the test binary timed out or crashed, we'll mark as
+	// otherwise.
+	interrupted := false
+
+	ret := make([]*pb.TestResult, 0, len(runs))
+	unexpected := map[string]bool{}
+	for _, run := range runs {
+		rpb := run.toProto(testPathPrefix + run.pkg + "." + run.test)
+		if run.action == "" {
+			interrupted = true
+		}
+		if !rpb.Expected {
+			unexpected[run.pkg] = true
+		}
+		ret = append(ret, rpb)
+	}
+
+	// A package can fail without any of its tests failing, e.g. if it doesn't
+	// build or TestMain fails. Report the package itself in that case.
+	for _, pkg := range pkgOrder {
+		if p := pkgs[pkg]; p.action == "fail" && !unexpected[pkg] {
+			ret = append(ret, p.toProto(testPathPrefix+pkg))
+		}
+	}
+
+	// The code below does not return errors, so it is safe to make in-place
+	// modifications of inv.
+
+	if interrupted {
+		inv.State = pb.Invocation_INTERRUPTED
+	}
+	inv.Tags = append(inv.Tags, pbutil.StringPair(OriginalFormatTagKey, FormatGoTest))
+
+	pbutil.NormalizeInvocation(inv)
+	return ret, nil
+}
+
+func (run *goTestRun) toProto(testPath string) *pb.TestResult {
+	var status pb.TestStatus
+	switch run.action {
+	case "pass", "bench":
+		status = pb.TestStatus_PASS
+	case "fail":
+		status = pb.TestStatus_FAIL
+	case "skip":
+		status = pb.TestStatus_SKIP
+	default:
+		status = pb.TestStatus_ABORT
+	}
+
+	action := run.action
+	if action == "" {
+		action = "none"
+	}
+	rpb := &pb.TestResult{
+		TestPath: testPath,
+		// Skipped tests have been disabled on purpose, e.g. with -short.
+		Expected: status == pb.TestStatus_PASS || status == pb.TestStatus_SKIP,
+		Status:   status,
+		Tags:     pbutil.StringPairs("go_test_action", action),
+	}
+	if !run.start.IsZero() {
+		rpb.StartTime = pbutil.MustTimestampProto(run.start)
+	}
+	if run.action != "" {
+		rpb.Duration = secondsToDuration(run.elapsed)
+	}
+	if run.output.Len() > 0 {
+		rpb.OutputArtifacts = []*pb.Artifact{textArtifact("output.txt", run.output.String())}
+	}
+	return rpb
+}
@@ -0,0 +1,158 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestGoTestConversions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`From JSON works`, t, func() {
+		buf := `{"Time":"2019-10-01T10:00:00Z","Action":"run","Package":"example.com/foo","Test":"TestA"}
+			{"Time":"2019-10-01T10:00:00.1Z","Action":"output","Package":"example.com/foo","Test":"TestA","Output":"=== RUN   TestA\n"}
+			{"Time":"2019-10-01T10:00:01Z","Action":"pass","Package":"example.com/foo","Test":"TestA","Elapsed":1.5}`
+
+		results := &GoTestResults{}
+		So(results.ConvertFromJSON(ctx, strings.NewReader(buf)), ShouldBeNil)
+		So(results.Events, ShouldHaveLength, 3)
+		So(results.Events[2], ShouldResemble, &GoTestEvent{
+			Time:    time.Date(2019, 10, 1, 10, 0, 1, 0, time.UTC),
+			Action:  "pass",
+			Package: "example.com/foo",
+			Test:    "TestA",
+			Elapsed: 1.5,
+		})
+
+		Convey(`with no events`, func() {
+			So((&GoTestResults{}).ConvertFromJSON(ctx, strings.NewReader("")), ShouldErrLike, "no events")
+		})
+
+		Convey(`with invalid JSON`, func() {
+			err := (&GoTestResults{}).ConvertFromJSON(ctx, strings.NewReader(buf+"\nFAIL"))
+			So(err, ShouldErrLike, "event 3")
+		})
+	})
+
+	Convey(`ToProtos works`, t, func() {
+		convert := func(buf string) ([]*pb.TestResult, *pb.Invocation) {
+			results := &GoTestResults{}
+			So(results.ConvertFromJSON(ctx, strings.NewReader(buf)), ShouldBeNil)
+			inv := &pb.Invocation{}
+			testResults, err := results.ToProtos(ctx, "go://", inv)
+			So(err, ShouldBeNil)
+			return testResults, inv
+		}
+
+		Convey(`with passing, failing and skipped tests`, func() {
+			testResults, inv := convert(`
+				{"Time":"2019-10-01T10:00:00Z","Action":"run","Package":"example.com/foo","Test":"TestA"}
+				{"Time":"2019-10-01T10:00:00Z","Action":"run","Package":"example.com/foo","Test":"TestB"}
+				{"Time":"2019-10-01T10:00:00Z","Action":"output","Package":"example.com/foo","Test":"TestB","Output":"foo_test.go:10: bad\n"}
+				{"Time":"2019-10-01T10:00:00Z","Action":"run","Package":"example.com/foo","Test":"TestB/sub"}
+				{"Time":"2019-10-01T10:00:01Z","Action":"skip","Package":"example.com/foo","Test":"TestB/sub","Elapsed":0}
+				{"Time":"2019-10-01T10:00:01Z","Action":"fail","Package":"example.com/foo","Test":"TestB","Elapsed":0.25}
+				{"Time":"2019-10-01T10:00:01Z","Action":"pass","Package":"example.com/foo","Test":"TestA","Elapsed":1}
+				{"Time":"2019-10-01T10:00:01Z","Action":"output","Package":"example.com/foo","Output":"FAIL\n"}
+				{"Time":"2019-10-01T10:00:01Z","Action":"fail","Package":"example.com/foo","Elapsed":1.1}`)
+
+			start := pbutil.MustTimestampProto(time.Date(2019, 10, 1, 10, 0, 0, 0, time.UTC))
+			So(testResults, ShouldResembleProto, []*pb.TestResult{
+				{
+					TestPath:  "go://example.com/foo.TestA",
+					Expected:  true,
+					Status:    pb.TestStatus_PASS,
+					StartTime: start,
+					Duration:  &duration.Duration{Seconds: 1},
+					Tags:      pbutil.StringPairs("go_test_action", "pass"),
+				},
+				{
+					TestPath:  "go://example.com/foo.TestB",
+					Status:    pb.TestStatus_FAIL,
+					StartTime: start,
+					Duration:  &duration.Duration{Nanos: 25e7},
+					Tags:      pbutil.StringPairs("go_test_action", "fail"),
+					OutputArtifacts: []*pb.Artifact{
+						{Name: "output.txt", ContentType: "text/plain", Size: 20, Contents: []byte("foo_test.go:10: bad\n")},
+					},
+				},
+				{
+					TestPath:  "go://example.com/foo.TestB/sub",
+					Expected:  true,
+					Status:    pb.TestStatus_SKIP,
+					StartTime: start,
+					Duration:  &duration.Duration{},
+					Tags:      pbutil.StringPairs("go_test_action", "skip"),
+				},
+			})
+			So(inv.State, ShouldEqual, pb.Invocation_STATE_UNSPECIFIED)
+			So(inv.Tags, ShouldResembleProto, pbutil.StringPairs(OriginalFormatTagKey, FormatGoTest))
+		})
+
+		Convey(`with repeated runs`, func() {
+			testResults, _ := convert(`
+				{"Action":"run","Package":"p","Test":"TestA"}
+				{"Action":"fail","Package":"p","Test":"TestA","Elapsed":1}
+				{"Action":"run","Package":"p","Test":"TestA"}
+				{"Action":"pass","Package":"p","Test":"TestA","Elapsed":2}`)
+			So(testResults, ShouldHaveLength, 2)
+			So(testResults[0].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(testResults[1].Status, ShouldEqual, pb.TestStatus_PASS)
+		})
+
+		Convey(`with tests that did not end`, func() {
+			testResults, inv := convert(`
+				{"Action":"run","Package":"p","Test":"TestA"}
+				{"Action":"output","Package":"p","Test":"TestA","Output":"panic: test timed out\n"}
+				{"Action":"fail","Package":"p","Elapsed":600}`)
+			So(testResults, ShouldHaveLength, 1)
+			So(testResults[0].Status, ShouldEqual, pb.TestStatus_ABORT)
+			So(testResults[0].Expected, ShouldBeFalse)
+			So(testResults[0].Duration, ShouldBeNil)
+			So(pbutil.StringPairsContain(testResults[0].Tags, pbutil.StringPair("go_test_action", "none")), ShouldBeTrue)
+			So(inv.State, ShouldEqual, pb.Invocation_INTERRUPTED)
+		})
+
+		Convey(`with a package that failed without failing tests`, func() {
+			testResults, _ := convert(`
+				{"Action":"output","Package":"p","Output":"# p\n./p.go:3:1: syntax error\n"}
+				{"Action":"output","Package":"p","Output":"FAIL\tp [build failed]\n"}
+				{"Action":"fail","Package":"p","Elapsed":0}`)
+			So(testResults, ShouldHaveLength, 1)
+			So(testResults[0].TestPath, ShouldEqual, "go://p")
+			So(testResults[0].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(string(testResults[0].OutputArtifacts[0].Contents), ShouldContainSubstring, "syntax error")
+		})
+
+		Convey(`with an unknown action`, func() {
+			results := &GoTestResults{Events: []*GoTestEvent{{Action: "explode", Package: "p", Test: "TestA"}}}
+			_, err := results.ToProtos(ctx, "go://", &pb.Invocation{})
+			So(err, ShouldErrLike, `unknown action "explode"`)
+		})
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	typepb "go.chromium.org/luci/resultdb/proto/type"
+)
+
+// Parametrized JUnit test names end with the parameters' index or
+// description in brackets, e.g. "testFoo[1]" or "testFoo(String)[2]".
+var junitParamRE = regexp.MustCompile(`^(.+)\[([^\]]*)\]$`)
+
+// JUnitResults represents the JUnit XML format, as emitted by Ant, Maven
+// Surefire, Gradle and many xUnit-style test runners. There is no formal
+// specification; the de facto one is
+// https://github.com/windyroad/JUnit-Schema/blob/master/JUnit.xsd
+//
+// Fields not used by Test Results are omitted.
+type JUnitResults struct {
+	Suites []*JUnitTestSuite
+}
+
+// JUnitTestSuite represents a <testsuite> element.
+type JUnitTestSuite struct {
+	Name string `xml:"name,attr"`
+
+	// Suites are nested test suites, which some runners emit.
+	Suites []*JUnitTestSuite `xml:"testsuite"`
+	Cases  []*JUnitTestCase  `xml:"testcase"`
+}
+
+// JUnitTestCase represents a <testcase> element.
+type JUnitTestCase struct {
+	Name      string `xml:"name,attr"`
+	ClassName string `xml:"classname,attr"`
+	// Time is the duration of the test case in seconds.
+	Time string `xml:"time,attr"`
+
+	Failures []*JUnitFailure `xml:"failure"`
+	Errors   []*JUnitFailure `xml:"error"`
+	Skipped  *JUnitFailure   `xml:"skipped"`
+
+	SystemOut string `xml:"system-out"`
+	SystemErr string `xml:"system-err"`
+}
+
+// JUnitFailure represents a <failure>, <error> or <skipped> element.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ConvertFromXML reads the provided reader into the receiver.
+//
+// The root element may be either <testsuites> or a single <testsuite>.
+//
+// The receiver is cleared and its fields overwritten.
+func (r *JUnitResults) ConvertFromXML(ctx context.Context, reader io.Reader) error {
+	*r = JUnitResults{}
+
+	dec := xml.NewDecoder(reader)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return errors.Reason("missing root element in XML").Err()
+		}
+		if err != nil {
+			return err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "testsuites":
+			var root struct {
+				Suites []*JUnitTestSuite `xml:"testsuite"`
+			}
+			if err := dec.DecodeElement(&root, &start); err != nil {
+				return err
+			}
+			r.Suites = root.Suites
+
+		case "testsuite":
+			suite := &JUnitTestSuite{}
+			if err := dec.DecodeElement(suite, &start); err != nil {
+				return err
+			}
+			r.Suites = []*JUnitTestSuite{suite}
+
+		default:
+			return errors.Reason("unexpected root element <%s> in XML", start.Name.Local).Err()
+		}
+		return nil
+	}
+}
+
+// ToProtos converts test results in r []*pb.TestResult and updates inv
+// in-place accordingly.
+// If an error is returned, inv is left unchanged.
+//
+// Does not populate TestResult.Name.
+func (r *JUnitResults) ToProtos(ctx context.Context, testPathPrefix string, inv *pb.Invocation) ([]*pb.TestResult, error) {
+	var ret []*pb.TestResult
+	var convertSuite func(s *JUnitTestSuite) error
+	convertSuite = func(s *JUnitTestSuite) error {
+		for _, c := range s.Cases {
+			rpb, err := c.toProto(testPathPrefix, s.Name)
+			if err != nil {
+				return errors.Annotate(err, "test case %q of suite %q", c.Name, s.Name).Err()
+			}
+			ret = append(ret, rpb)
+		}
+		for _, nested := range s.Suites {
+			if err := convertSuite(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, s := range r.Suites {
+		if err := convertSuite(s); err != nil {
+			return nil, err
+		}
+	}
+
+	// The code below does not return errors, so it is safe to make in-place
+	// modifications of inv.
+	inv.Tags = append(inv.Tags, pbutil.StringPair(OriginalFormatTagKey, FormatJUnit))
+	pbutil.NormalizeInvocation(inv)
+	return ret, nil
+}
+
+func (c *JUnitTestCase) toProto(testPathPrefix, suiteName string) (*pb.TestResult, error) {
+	name := c.Name
+	var params map[string]string
+	if match := junitParamRE.FindStringSubmatch(name); match != nil {
+		name = match[1]
+		params = map[string]string{testParameterKey: match[2]}
+	}
+
+	// The class name is usually fully qualified, so prefer it to the suite's.
+	group := c.ClassName
+	if group == "" {
+		group = suiteName
+	}
+	testPath := testPathPrefix + name
+	if group != "" {
+		testPath = fmt.Sprintf("%s%s.%s", testPathPrefix, group, name)
+	}
+
+	status, junitStatus := pb.TestStatus_PASS, "pass"
+	switch {
+	case len(c.Errors) > 0:
+		// An error is an unexpected exception, e.g. from the code under test,
+		// rather than an assertion failure. It is a failure nonetheless.
+		status, junitStatus = pb.TestStatus_FAIL, "error"
+	case len(c.Failures) > 0:
+		status, junitStatus = pb.TestStatus_FAIL, "failure"
+	case c.Skipped != nil:
+		status, junitStatus = pb.TestStatus_SKIP, "skipped"
+	}
+
+	rpb := &pb.TestResult{
+		TestPath: testPath,
+		// Skipped tests have been disabled on purpose.
+		Expected: status == pb.TestStatus_PASS || status == pb.TestStatus_SKIP,
+		Status:   status,
+		Tags:     pbutil.StringPairs("junit_status", junitStatus),
+	}
+	if len(params) > 0 {
+		rpb.Variant = &typepb.Variant{Def: params}
+	}
+
+	// Do not set duration if it is unknown. Some runners format large times
+	// with thousands separators.
+	if t := strings.Replace(c.Time, ",", "", -1); t != "" {
+		secs, err := strconv.ParseFloat(t, 64)
+		if err != nil || secs < 0 {
+			return nil, errors.Reason("invalid time %q", c.Time).Err()
+		}
+		rpb.Duration = secondsToDuration(secs)
+	}
+
+	var failure strings.Builder
+	for _, f := range append(c.Errors, c.Failures...) {
+		if f.Message != "" {
+			fmt.Fprintln(&failure, f.Message)
+		}
+		if text := strings.TrimSpace(f.Text); text != "" {
+			fmt.Fprintln(&failure, text)
+		}
+	}
+	if failure.Len() > 0 {
+		rpb.OutputArtifacts = append(rpb.OutputArtifacts, textArtifact("failure.txt", failure.String()))
+	}
+	if c.Skipped != nil && c.Skipped.Message != "" {
+		rpb.Tags = append(rpb.Tags, pbutil.StringPair("junit_skipped_message", c.Skipped.Message))
+	}
+	if c.SystemOut != "" {
+		rpb.OutputArtifacts = append(rpb.OutputArtifacts, textArtifact("stdout.txt", c.SystemOut))
+	}
+	if c.SystemErr != "" {
+		rpb.OutputArtifacts = append(rpb.OutputArtifacts, textArtifact("stderr.txt", c.SystemErr))
+	}
+
+	return rpb, nil
+}
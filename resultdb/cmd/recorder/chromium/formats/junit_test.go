@@ -0,0 +1,156 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/duration"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	typepb "go.chromium.org/luci/resultdb/proto/type"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestJUnitConversions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`From XML works`, t, func() {
+		Convey(`with testsuites root`, func() {
+			buf := `<?xml version="1.0" encoding="UTF-8"?>
+				<testsuites>
+					<testsuite name="FooSuite" tests="2">
+						<testcase name="testA" classname="com.example.Foo" time="0.5"/>
+						<testsuite name="Nested">
+							<testcase name="testB" time="1,234.5">
+								<skipped message="not supported"/>
+							</testcase>
+						</testsuite>
+					</testsuite>
+				</testsuites>`
+
+			results := &JUnitResults{}
+			So(results.ConvertFromXML(ctx, strings.NewReader(buf)), ShouldBeNil)
+			So(results.Suites, ShouldHaveLength, 1)
+			So(results.Suites[0].Name, ShouldEqual, "FooSuite")
+			So(results.Suites[0].Cases, ShouldResemble, []*JUnitTestCase{
+				{Name: "testA", ClassName: "com.example.Foo", Time: "0.5"},
+			})
+			So(results.Suites[0].Suites[0].Cases[0].Skipped, ShouldResemble, &JUnitFailure{Message: "not supported"})
+		})
+
+		Convey(`with testsuite root`, func() {
+			results := &JUnitResults{}
+			err := results.ConvertFromXML(ctx, strings.NewReader(`<testsuite name="A"><testcase name="a"/></testsuite>`))
+			So(err, ShouldBeNil)
+			So(results.Suites, ShouldHaveLength, 1)
+			So(results.Suites[0].Cases[0].Name, ShouldEqual, "a")
+		})
+
+		Convey(`with unexpected root`, func() {
+			err := (&JUnitResults{}).ConvertFromXML(ctx, strings.NewReader(`<foo/>`))
+			So(err, ShouldErrLike, "unexpected root element <foo>")
+		})
+
+		Convey(`with no root`, func() {
+			err := (&JUnitResults{}).ConvertFromXML(ctx, strings.NewReader(`<?xml version="1.0"?>`))
+			So(err, ShouldErrLike, "missing root element")
+		})
+	})
+
+	Convey(`ToProtos works`, t, func() {
+		buf := `<testsuites>
+				<testsuite name="FooSuite">
+					<testcase name="testPass" classname="com.example.Foo" time="0.5">
+						<system-out>hello</system-out>
+					</testcase>
+					<testcase name="testFail[1]" classname="com.example.Foo" time="2">
+						<failure message="expected 1" type="AssertionError">at Foo.java:10</failure>
+					</testcase>
+					<testcase name="testError" classname="com.example.Foo">
+						<error message="boom"/>
+						<system-err>oops</system-err>
+					</testcase>
+					<testcase name="testSkip">
+						<skipped/>
+					</testcase>
+				</testsuite>
+			</testsuites>`
+
+		results := &JUnitResults{}
+		So(results.ConvertFromXML(ctx, strings.NewReader(buf)), ShouldBeNil)
+
+		inv := &pb.Invocation{}
+		testResults, err := results.ToProtos(ctx, "junit://", inv)
+		So(err, ShouldBeNil)
+		So(testResults, ShouldResembleProto, []*pb.TestResult{
+			{
+				TestPath: "junit://com.example.Foo.testPass",
+				Expected: true,
+				Status:   pb.TestStatus_PASS,
+				Duration: &duration.Duration{Nanos: 5e8},
+				Tags:     pbutil.StringPairs("junit_status", "pass"),
+				OutputArtifacts: []*pb.Artifact{
+					{Name: "stdout.txt", ContentType: "text/plain", Size: 5, Contents: []byte("hello")},
+				},
+			},
+			{
+				TestPath: "junit://com.example.Foo.testFail",
+				Variant:  &typepb.Variant{Def: map[string]string{"param/id": "1"}},
+				Status:   pb.TestStatus_FAIL,
+				Duration: &duration.Duration{Seconds: 2},
+				Tags:     pbutil.StringPairs("junit_status", "failure"),
+				OutputArtifacts: []*pb.Artifact{
+					{
+						Name:        "failure.txt",
+						ContentType: "text/plain",
+						Size:        26,
+						Contents:    []byte("expected 1\nat Foo.java:10\n"),
+					},
+				},
+			},
+			{
+				TestPath: "junit://com.example.Foo.testError",
+				Status:   pb.TestStatus_FAIL,
+				Tags:     pbutil.StringPairs("junit_status", "error"),
+				OutputArtifacts: []*pb.Artifact{
+					{Name: "failure.txt", ContentType: "text/plain", Size: 5, Contents: []byte("boom\n")},
+					{Name: "stderr.txt", ContentType: "text/plain", Size: 4, Contents: []byte("oops")},
+				},
+			},
+			{
+				TestPath: "junit://FooSuite.testSkip",
+				Expected: true,
+				Status:   pb.TestStatus_SKIP,
+				Tags:     pbutil.StringPairs("junit_status", "skipped"),
+			},
+		})
+		So(inv.Tags, ShouldResembleProto, pbutil.StringPairs(OriginalFormatTagKey, FormatJUnit))
+
+		Convey(`with invalid time`, func() {
+			results.Suites[0].Cases[0].Time = "soon"
+			inv := &pb.Invocation{}
+			_, err := results.ToProtos(ctx, "junit://", inv)
+			So(err, ShouldErrLike, `invalid time "soon"`)
+			So(inv.Tags, ShouldBeEmpty)
+		})
+	})
+}
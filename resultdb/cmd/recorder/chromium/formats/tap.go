@@ -0,0 +1,250 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+var (
+	tapVersionRE = regexp.MustCompile(`^TAP version (\d+)\s*$`)
+	tapPlanRE    = regexp.MustCompile(`^1\.\.(\d+)\s*(?:#\s*(.*))?$`)
+	// Groups: "not ", number, description, directive.
+	tapTestRE      = regexp.MustCompile(`^(not )?ok\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(.*))?$`)
+	tapBailOutRE   = regexp.MustCompile(`^Bail out!\s*(.*)$`)
+	tapYAMLStartRE = regexp.MustCompile(`^(\s+)---\s*$`)
+	tapYAMLEndRE   = regexp.MustCompile(`^\s+\.\.\.\s*$`)
+
+	// tapDurationRE matches the test duration, as reported in the YAML
+	// diagnostics by node-tap and others.
+	tapDurationRE = regexp.MustCompile(`(?m)^duration_ms:\s*([0-9.]+)\s*$`)
+)
+
+// TAPResults represents the Test Anything Protocol output of a test run,
+// versions 12 and 13. See https://testanything.org/tap-version-13-specification.html
+type TAPResults struct {
+	// Version is the TAP version, or 12 if the output has no version line.
+	Version int
+	// Plan is the number of tests that the run planned to run, or -1 if the
+	// output has no plan.
+	Plan int
+	// BailedOut is true if the run bailed out, and BailOut is the reason it
+	// gave.
+	BailedOut bool
+	BailOut   string
+
+	Tests []*TAPTest
+}
+
+// TAPTest is a single test line of TAP output.
+type TAPTest struct {
+	OK          bool
+	Number      int
+	Description string
+	// Directive is "SKIP", "TODO" or "".
+	Directive string
+	// Reason is the explanation that follows the directive.
+	Reason string
+	// Diagnostics is the YAML diagnostics block that follows the test line,
+	// unindented, if any.
+	Diagnostics string
+}
+
+// ConvertFromTAP reads the provided reader into the receiver.
+//
+// Lines that are not part of the protocol, e.g. comments and unparsed output,
+// are ignored.
+//
+// The receiver is cleared and its fields overwritten.
+func (r *TAPResults) ConvertFromTAP(ctx context.Context, reader io.Reader) error {
+	*r = TAPResults{Version: 12, Plan: -1}
+
+	s := bufio.NewScanner(reader)
+	s.Buffer(nil, 1024*1024)
+
+	// yaml is the diagnostics block of the last test being read, and indent is
+	// its indentation.
+	var yaml *strings.Builder
+	indent := ""
+	for lineNo := 1; s.Scan(); lineNo++ {
+		line := strings.TrimRight(s.Text(), "\r")
+
+		if yaml != nil {
+			if tapYAMLEndRE.MatchString(line) {
+				r.Tests[len(r.Tests)-1].Diagnostics = yaml.String()
+				yaml = nil
+				continue
+			}
+			yaml.WriteString(strings.TrimPrefix(line, indent))
+			yaml.WriteByte('\n')
+			continue
+		}
+
+		switch {
+		case tapVersionRE.MatchString(line):
+			v, err := strconv.Atoi(tapVersionRE.FindStringSubmatch(line)[1])
+			if err != nil {
+				return errors.Annotate(err, "line %d: invalid version", lineNo).Err()
+			}
+			r.Version = v
+
+		case tapPlanRE.MatchString(line):
+			if r.Plan >= 0 {
+				return errors.Reason("line %d: more than one plan", lineNo).Err()
+			}
+			n, err := strconv.Atoi(tapPlanRE.FindStringSubmatch(line)[1])
+			if err != nil {
+				return errors.Annotate(err, "line %d: invalid plan", lineNo).Err()
+			}
+			r.Plan = n
+
+		case tapTestRE.MatchString(line):
+			match := tapTestRE.FindStringSubmatch(line)
+			t := &TAPTest{
+				OK:          match[1] == "",
+				Number:      len(r.Tests) + 1,
+				Description: match[3],
+			}
+			if match[2] != "" {
+				n, err := strconv.Atoi(match[2])
+				if err != nil {
+					return errors.Annotate(err, "line %d: invalid test number", lineNo).Err()
+				}
+				t.Number = n
+			}
+			if directive := match[4]; directive != "" {
+				// Directives are case-insensitive and may be abbreviated,
+				// e.g. "# skipped" or "# Todo not implemented".
+				switch upper := strings.ToUpper(directive); {
+				case strings.HasPrefix(upper, "SKIP"):
+					t.Directive = "SKIP"
+				case strings.HasPrefix(upper, "TODO"):
+					t.Directive = "TODO"
+				}
+				if t.Directive != "" {
+					reason := directive[len(t.Directive):]
+					if i := strings.IndexAny(reason, " \t"); i >= 0 {
+						t.Reason = strings.TrimSpace(reason[i:])
+					}
+				}
+			}
+			r.Tests = append(r.Tests, t)
+
+		case tapBailOutRE.MatchString(line):
+			r.BailedOut = true
+			r.BailOut = tapBailOutRE.FindStringSubmatch(line)[1]
+
+		case tapYAMLStartRE.MatchString(line) && len(r.Tests) > 0:
+			yaml = &strings.Builder{}
+			indent = tapYAMLStartRE.FindStringSubmatch(line)[1]
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	if len(r.Tests) == 0 && r.Plan < 0 && !r.BailedOut {
+		return errors.Reason("no TAP plan or test lines found").Err()
+	}
+	return nil
+}
+
+// ToProtos converts test results in r []*pb.TestResult and updates inv
+// in-place accordingly.
+// If an error is returned, inv is left unchanged.
+//
+// Does not populate TestResult.Name.
+func (r *TAPResults) ToProtos(ctx context.Context, testPathPrefix string, inv *pb.Invocation) ([]*pb.TestResult, error) {
+	ret := make([]*pb.TestResult, 0, len(r.Tests))
+	for _, t := range r.Tests {
+		rpb, err := t.toProto(testPathPrefix)
+		if err != nil {
+			return nil, errors.Annotate(err, "test %d", t.Number).Err()
+		}
+		ret = append(ret, rpb)
+	}
+
+	// The code below does not return errors, so it is safe to make in-place
+	// modifications of inv.
+
+	// The run was cut short if it bailed out or didn't run all planned tests.
+	if r.BailedOut || len(r.Tests) < r.Plan {
+		inv.State = pb.Invocation_INTERRUPTED
+	}
+	if r.BailOut != "" {
+		inv.Tags = append(inv.Tags, pbutil.StringPair("tap_bail_out", r.BailOut))
+	}
+	inv.Tags = append(inv.Tags, pbutil.StringPair(OriginalFormatTagKey, FormatTAP))
+
+	pbutil.NormalizeInvocation(inv)
+	return ret, nil
+}
+
+func (t *TAPTest) toProto(testPathPrefix string) (*pb.TestResult, error) {
+	name := t.Description
+	if name == "" {
+		name = strconv.Itoa(t.Number)
+	}
+
+	status := pb.TestStatus_FAIL
+	switch {
+	case t.Directive == "SKIP":
+		status = pb.TestStatus_SKIP
+	case t.OK:
+		status = pb.TestStatus_PASS
+	}
+
+	rpb := &pb.TestResult{
+		TestPath: testPathPrefix + name,
+		// TODO tests are expected to fail, and skipped tests have been
+		// disabled on purpose.
+		Expected: status != pb.TestStatus_FAIL || t.Directive == "TODO",
+		Status:   status,
+		Tags:     pbutil.StringPairs("tap_number", strconv.Itoa(t.Number)),
+	}
+	if t.Directive != "" {
+		rpb.Tags = append(rpb.Tags, pbutil.StringPair("tap_directive", t.Directive))
+		if t.Reason != "" {
+			rpb.Tags = append(rpb.Tags, pbutil.StringPair("tap_reason", t.Reason))
+		}
+	}
+
+	if t.Diagnostics != "" {
+		if match := tapDurationRE.FindStringSubmatch(t.Diagnostics); match != nil {
+			ms, err := strconv.ParseFloat(match[1], 64)
+			if err != nil {
+				return nil, errors.Annotate(err, "invalid duration_ms").Err()
+			}
+			rpb.Duration = secondsToDuration(ms / 1000)
+		}
+
+		a := textArtifact("diagnostics.yaml", t.Diagnostics)
+		a.ContentType = "text/x-yaml"
+		rpb.OutputArtifacts = []*pb.Artifact{a}
+	}
+
+	return rpb, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formats
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/duration"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTAPConversions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const buf = `TAP version 13
+1..5
+# Running the suite
+ok 1 - parses input
+not ok 2 - handles errors
+  ---
+  message: 'expected 1, got 2'
+  duration_ms: 1500
+  ...
+ok 3 - network # SKIP no network access
+not ok 4 - new feature # TODO not implemented
+ok
+some unrelated output
+`
+
+	Convey(`From TAP works`, t, func() {
+		results := &TAPResults{}
+		So(results.ConvertFromTAP(ctx, strings.NewReader(buf)), ShouldBeNil)
+		So(results.Version, ShouldEqual, 13)
+		So(results.Plan, ShouldEqual, 5)
+		So(results.BailedOut, ShouldBeFalse)
+		So(results.Tests, ShouldResemble, []*TAPTest{
+			{OK: true, Number: 1, Description: "parses input"},
+			{
+				Number:      2,
+				Description: "handles errors",
+				Diagnostics: "message: 'expected 1, got 2'\nduration_ms: 1500\n",
+			},
+			{OK: true, Number: 3, Description: "network", Directive: "SKIP", Reason: "no network access"},
+			{Number: 4, Description: "new feature", Directive: "TODO", Reason: "not implemented"},
+			{OK: true, Number: 5},
+		})
+
+		Convey(`with no plan or tests`, func() {
+			err := (&TAPResults{}).ConvertFromTAP(ctx, strings.NewReader("hello\n"))
+			So(err, ShouldErrLike, "no TAP plan or test lines")
+		})
+
+		Convey(`with two plans`, func() {
+			err := (&TAPResults{}).ConvertFromTAP(ctx, strings.NewReader("1..2\n1..3\n"))
+			So(err, ShouldErrLike, "line 2: more than one plan")
+		})
+	})
+
+	Convey(`ToProtos works`, t, func() {
+		results := &TAPResults{}
+		So(results.ConvertFromTAP(ctx, strings.NewReader(buf)), ShouldBeNil)
+
+		inv := &pb.Invocation{}
+		testResults, err := results.ToProtos(ctx, "tap://", inv)
+		So(err, ShouldBeNil)
+		So(testResults, ShouldResembleProto, []*pb.TestResult{
+			{
+				TestPath: "tap://parses input",
+				Expected: true,
+				Status:   pb.TestStatus_PASS,
+				Tags:     pbutil.StringPairs("tap_number", "1"),
+			},
+			{
+				TestPath: "tap://handles errors",
+				Status:   pb.TestStatus_FAIL,
+				Duration: &duration.Duration{Seconds: 1, Nanos: 5e8},
+				Tags:     pbutil.StringPairs("tap_number", "2"),
+				OutputArtifacts: []*pb.Artifact{
+					{
+						Name:        "diagnostics.yaml",
+						ContentType: "text/x-yaml",
+						Size:        47,
+						Contents:    []byte("message: 'expected 1, got 2'\nduration_ms: 1500\n"),
+					},
+				},
+			},
+			{
+				TestPath: "tap://network",
+				Expected: true,
+				Status:   pb.TestStatus_SKIP,
+				Tags: pbutil.StringPairs(
+					"tap_number", "3",
+					"tap_directive", "SKIP",
+					"tap_reason", "no network access",
+				),
+			},
+			{
+				TestPath: "tap://new feature",
+				Expected: true,
+				Status:   pb.TestStatus_FAIL,
+				Tags: pbutil.StringPairs(
+					"tap_number", "4",
+					"tap_directive", "TODO",
+					"tap_reason", "not implemented",
+				),
+			},
+			{
+				TestPath: "tap://5",
+				Expected: true,
+				Status:   pb.TestStatus_PASS,
+				Tags:     pbutil.StringPairs("tap_number", "5"),
+			},
+		})
+		So(inv.State, ShouldEqual, pb.Invocation_STATE_UNSPECIFIED)
+		So(inv.Tags, ShouldResembleProto, pbutil.StringPairs(OriginalFormatTagKey, FormatTAP))
+
+		Convey(`with an interrupted run`, func() {
+			results := &TAPResults{}
+			So(results.ConvertFromTAP(ctx, strings.NewReader("1..3\nok 1\nBail out! Database unavailable.\n")), ShouldBeNil)
+
+			inv := &pb.Invocation{}
+			testResults, err := results.ToProtos(ctx, "tap://", inv)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 1)
+			So(inv.State, ShouldEqual, pb.Invocation_INTERRUPTED)
+			So(pbutil.StringPairsContain(inv.Tags, pbutil.StringPair("tap_bail_out", "Database unavailable.")), ShouldBeTrue)
+		})
+
+		Convey(`with fewer tests than planned`, func() {
+			results := &TAPResults{}
+			So(results.ConvertFromTAP(ctx, strings.NewReader("1..3\nok 1\nok 2\n")), ShouldBeNil)
+
+			inv := &pb.Invocation{}
+			_, err := results.ToProtos(ctx, "tap://", inv)
+			So(err, ShouldBeNil)
+			So(inv.State, ShouldEqual, pb.Invocation_INTERRUPTED)
+		})
+	})
+}
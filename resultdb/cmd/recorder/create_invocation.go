@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 	"google.golang.org/grpc/codes"
@@ -32,6 +33,7 @@ import (
 	"go.chromium.org/luci/grpc/grpcutil"
 	"go.chromium.org/luci/grpc/prpc"
 
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
 	"go.chromium.org/luci/resultdb/internal/span"
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
@@ -152,10 +154,16 @@ func (s *recorderServer) CreateInvocation(ctx context.Context, in *pb.CreateInvo
 			}
 		}
 
-		return txn.BufferWrite([]*spanner.Mutation{
-			insertInvocation(ctx, inv, updateToken, in.RequestId),
-			// TODO(chanli): insert invocation to InvocationsToBeExported.
-		})
+		ms := []*spanner.Mutation{insertInvocation(ctx, inv, updateToken, in.RequestId)}
+		// Export the results after the invocation is finalized.
+		// Identical exports would be identical tasks, so skip duplicates.
+		for i, bqExport := range in.BigqueryExports {
+			if !containsBigQueryExport(in.BigqueryExports[:i], bqExport) {
+				task := &internalpb.InvocationTask{BigqueryExport: bqExport}
+				ms = append(ms, span.InsertInvocationTask(invID, task, true))
+			}
+		}
+		return txn.BufferWrite(ms)
 	})
 
 	switch {
@@ -168,6 +176,15 @@ func (s *recorderServer) CreateInvocation(ctx context.Context, in *pb.CreateInvo
 	}
 }
 
+func containsBigQueryExport(exports []*pb.BigQueryExport, e *pb.BigQueryExport) bool {
+	for _, cur := range exports {
+		if proto.Equal(cur, e) {
+			return true
+		}
+	}
+	return false
+}
+
 func invocationAlreadyExists() error {
 	return errors.Reason("invocation already exists").Tag(grpcutil.AlreadyExistsTag).Err()
 }
@@ -30,6 +30,7 @@ import (
 	"go.chromium.org/luci/grpc/grpcutil"
 	"go.chromium.org/luci/grpc/prpc"
 
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
 	"go.chromium.org/luci/resultdb/internal/span"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 	"go.chromium.org/luci/resultdb/pbutil"
@@ -204,6 +205,34 @@ func TestCreateInvocation(t *testing.T) {
 			So(inv.Name, ShouldEqual, "invocations/u:inv")
 		})
 
+		Convey(`with bigquery exports`, func() {
+			bqExport := &pb.BigQueryExport{Project: "project", Dataset: "dataset", Table: "table"}
+			req.BigqueryExports = []*pb.BigQueryExport{bqExport, bqExport}
+			_, err := recorder.CreateInvocation(ctx, req)
+			So(err, ShouldBeNil)
+
+			var payloads [][]byte
+			var processAfter []spanner.NullTime
+			err = span.Client(ctx).Single().Read(ctx, "InvocationTasks", spanner.AllKeys(), []string{"Payload", "ProcessAfter"}).Do(func(row *spanner.Row) error {
+				var payload []byte
+				var t spanner.NullTime
+				if err := row.Columns(&payload, &t); err != nil {
+					return err
+				}
+				payloads = append(payloads, payload)
+				processAfter = append(processAfter, t)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(payloads, ShouldHaveLength, 1)
+			// The task must wait for the invocation to be finalized.
+			So(processAfter[0].Valid, ShouldBeFalse)
+
+			task := &internalpb.InvocationTask{}
+			So(proto.Unmarshal(payloads[0], task), ShouldBeNil)
+			So(task.BigqueryExport, ShouldResembleProto, bqExport)
+		})
+
 		Convey(`idempotent`, func() {
 			req := &pb.CreateInvocationRequest{
 				InvocationId: "u:inv",
@@ -66,14 +66,16 @@ func (s *recorderServer) FinalizeInvocation(ctx context.Context, in *pb.Finalize
 		now := clock.Now(ctx)
 
 		var updateToken spanner.NullString
+		var interruptionReason string
 
 		err = span.ReadInvocation(ctx, txn, invID, map[string]interface{}{
-			"UpdateToken":  &updateToken,
-			"State":        &ret.State,
-			"CreateTime":   &ret.CreateTime,
-			"FinalizeTime": &ret.FinalizeTime,
-			"Deadline":     &ret.Deadline,
-			"Tags":         &ret.Tags,
+			"UpdateToken":        &updateToken,
+			"State":              &ret.State,
+			"CreateTime":         &ret.CreateTime,
+			"FinalizeTime":       &ret.FinalizeTime,
+			"Deadline":           &ret.Deadline,
+			"InterruptionReason": &ret.InterruptionReason,
+			"Tags":               &ret.Tags,
 		})
 
 		switch {
@@ -90,6 +92,8 @@ func (s *recorderServer) FinalizeInvocation(ctx context.Context, in *pb.Finalize
 		case pbutil.MustTimestamp(ret.Deadline).Before(now):
 			ret.State = pb.Invocation_INTERRUPTED
 			ret.FinalizeTime = ret.Deadline
+			interruptionReason = span.DeadlineExceededReason
+			ret.InterruptionReason = interruptionReason
 
 			if !in.Interrupted {
 				retErr = getUnmatchedStateError(invID)
@@ -104,7 +108,8 @@ func (s *recorderServer) FinalizeInvocation(ctx context.Context, in *pb.Finalize
 			return err
 		}
 
-		return finalizeInvocation(txn, invID, in.Interrupted, ret.FinalizeTime)
+		interrupted := ret.State == pb.Invocation_INTERRUPTED
+		return span.FinalizeInvocation(ctx, txn, invID, interrupted, interruptionReason, pbutil.MustTimestamp(ret.FinalizeTime))
 	})
 
 	if err != nil {
@@ -18,11 +18,13 @@ import (
 	"testing"
 	"time"
 
+	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc/metadata"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
 
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
 	"go.chromium.org/luci/resultdb/internal/span"
 	"go.chromium.org/luci/resultdb/internal/testutil"
 	"go.chromium.org/luci/resultdb/pbutil"
@@ -78,6 +80,16 @@ func TestFinalizeInvocation(t *testing.T) {
 			inv, err := recorder.FinalizeInvocation(ctx, &pb.FinalizeInvocationRequest{Name: "invocations/inv"})
 			So(err, ShouldErrLike, `"invocations/inv" has already been finalized with different state`)
 			So(inv, ShouldBeNil)
+
+			// The invocation is interrupted anyway.
+			var state pb.Invocation_State
+			var reason spanner.NullString
+			testutil.MustReadRow(ctx, "Invocations", span.InvocationID("inv").Key(), map[string]interface{}{
+				"State":              &state,
+				"InterruptionReason": &reason,
+			})
+			So(state, ShouldEqual, pb.Invocation_INTERRUPTED)
+			So(reason.StringVal, ShouldEqual, span.DeadlineExceededReason)
 		})
 
 		Convey(`interrupt expired invocation passed`, func() {
@@ -90,6 +102,7 @@ func TestFinalizeInvocation(t *testing.T) {
 			inv, err := recorder.FinalizeInvocation(ctx, &pb.FinalizeInvocationRequest{Name: "invocations/inv", Interrupted: true})
 			So(err, ShouldBeNil)
 			So(inv.State, ShouldEqual, pb.Invocation_INTERRUPTED)
+			So(inv.InterruptionReason, ShouldEqual, span.DeadlineExceededReason)
 		})
 
 		Convey(`idempotent`, func() {
@@ -124,5 +137,27 @@ func TestFinalizeInvocation(t *testing.T) {
 			So(inv.State, ShouldEqual, pb.Invocation_COMPLETED)
 			So(inv.FinalizeTime, ShouldResemble, pbutil.MustTimestampProto(testclock.TestRecentTimeUTC))
 		})
+
+		Convey(`makes tasks available`, func() {
+			task := &internalpb.InvocationTask{
+				BigqueryExport: &pb.BigQueryExport{Project: "project", Dataset: "dataset", Table: "table"},
+			}
+			testutil.MustApply(ctx,
+				testutil.InsertInvocation("inv", pb.Invocation_ACTIVE, token, ct),
+				span.InsertInvocationTask("inv", task, true),
+			)
+
+			keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+			So(err, ShouldBeNil)
+			So(keys, ShouldBeEmpty)
+
+			_, err = recorder.FinalizeInvocation(ctx, &pb.FinalizeInvocationRequest{Name: "invocations/inv"})
+			So(err, ShouldBeNil)
+
+			keys, err = span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+			So(err, ShouldBeNil)
+			So(keys, ShouldHaveLength, 1)
+			So(keys[0].InvocationID, ShouldEqual, span.InvocationID("inv"))
+		})
 	})
 }
@@ -19,7 +19,6 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
-	tspb "github.com/golang/protobuf/ptypes/timestamp"
 	"google.golang.org/grpc/metadata"
 
 	"go.chromium.org/luci/common/clock"
@@ -87,7 +86,7 @@ func mutateInvocation(ctx context.Context, id span.InvocationID, f func(context.
 			retErr = errors.Reason("%q is not active", id.Name()).Tag(grpcutil.FailedPreconditionTag).Err()
 
 			// The invocation has exceeded deadline, finalize it now.
-			return finalizeInvocation(txn, id, true, pbutil.MustTimestampProto(deadline))
+			return span.FinalizeInvocation(ctx, txn, id, true, span.DeadlineExceededReason, deadline)
 		}
 
 		if err = validateUserUpdateToken(updateToken, userToken); err != nil {
@@ -124,21 +123,6 @@ func extractUserUpdateToken(ctx context.Context) (string, error) {
 	}
 }
 
-func finalizeInvocation(txn *spanner.ReadWriteTransaction, id span.InvocationID, interrupted bool, finalizeTime *tspb.Timestamp) error {
-	state := pb.Invocation_COMPLETED
-	if interrupted {
-		state = pb.Invocation_INTERRUPTED
-	}
-
-	return txn.BufferWrite([]*spanner.Mutation{
-		span.UpdateMap("Invocations", map[string]interface{}{
-			"InvocationId": id,
-			"State":        state,
-			"FinalizeTime": finalizeTime,
-		}),
-	})
-}
-
 func validateUserUpdateToken(updateToken spanner.NullString, userToken string) error {
 	if !updateToken.Valid {
 		return errors.Reason("no update token in active invocation").Tag(grpcutil.InternalTag).Err()
@@ -177,6 +161,10 @@ func rowOfInvocation(ctx context.Context, inv *pb.Invocation, updateToken, creat
 		"Tags": inv.Tags,
 	}
 
+	if inv.State == pb.Invocation_ACTIVE {
+		row["ActiveDeadline"] = inv.Deadline
+	}
+
 	if inv.FinalizeTime != nil {
 		row["FinalizeTime"] = inv.FinalizeTime
 	}
@@ -83,6 +83,8 @@ func (s *recorderServer) UpdateInvocation(ctx context.Context, in *pb.UpdateInvo
 
 			case "deadline":
 				values["Deadline"] = in.Invocation.Deadline
+				// mutateInvocation ensures the invocation is active.
+				values["ActiveDeadline"] = in.Invocation.Deadline
 				ret.Deadline = in.Invocation.Deadline
 
 			default:
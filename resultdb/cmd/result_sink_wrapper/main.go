@@ -18,24 +18,55 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/auth"
 	"go.chromium.org/luci/common/data/strpair"
 	"go.chromium.org/luci/common/errors"
 	luciflag "go.chromium.org/luci/common/flag"
+	"go.chromium.org/luci/common/isolatedclient"
+	"go.chromium.org/luci/common/lhttp"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/common/system/exec2"
 	"go.chromium.org/luci/common/system/exitcode"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/hardcoded/chromeinfra"
 
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	sinkpb "go.chromium.org/luci/resultdb/proto/sink/v1"
 	"go.chromium.org/luci/resultdb/sink"
 )
 
 const (
 	wrapperErrorCode = 1001
+
+	// updateTokenMetadataKey is the key of the CreateInvocation response header
+	// with the token required to write to the invocation.
+	updateTokenMetadataKey = "update-token"
 )
 
+// resultFileFormats maps the formats accepted by -result-file to the formats
+// of result files understood by the sink.
+var resultFileFormats = map[string]sinkpb.TestResultFile_Format{
+	"luci":           sinkpb.TestResultFile_LUCI,
+	"chromium_jtr":   sinkpb.TestResultFile_CHROMIUM_JSON_TEST_RESULTS,
+	"chromium_gtest": sinkpb.TestResultFile_GOOGLE_TEST,
+	"junit_xml":      sinkpb.TestResultFile_JUNIT_XML,
+	"go_test_json":   sinkpb.TestResultFile_GO_TEST_JSON,
+	"tap":            sinkpb.TestResultFile_TAP,
+}
+
 type wrapperArgs struct {
 	port                        int
 	recorder                    string
@@ -46,6 +77,8 @@ type wrapperArgs struct {
 	invocationTags              strpair.Map
 	baseTestVariant             map[string]string
 	completeInvocationExitCodes []int
+	isolateServer               string
+	isolateNamespace            string
 }
 
 func parseArgs() (wrapperArgs, error) {
@@ -65,7 +98,7 @@ func parseArgs() (wrapperArgs, error) {
 	flag.StringVar(&args.logFile, "log-file", "", "File to log to")
 
 	flag.Var(luciflag.StringPairs(args.resultFiles), "result-file",
-		"Files to read and upload after running the subprocess, of form format:path, may be set more than once. Valid formats are luci, chromium_jtr, and chromium_gtest")
+		"Files to read and upload after running the subprocess, of form format:path, may be set more than once. Valid formats are luci, chromium_jtr, chromium_gtest, junit_xml, go_test_json and tap")
 
 	flag.StringVar(&args.testPathPrefix, "test-path-prefix", "",
 		"Prefix to prepepend before the test path of every test result")
@@ -73,6 +106,11 @@ func parseArgs() (wrapperArgs, error) {
 	flag.Var(luciflag.StringPairs(args.invocationTags), "invocation-tag",
 		"Tag to add to the Invocation, of form key:value, may be set more than once")
 
+	flag.StringVar(&args.isolateServer, "isolate-server", "",
+		"URL of the isolate server to upload artifacts to. If unset, artifacts are stored inline with test results, which limits their size")
+	flag.StringVar(&args.isolateNamespace, "isolate-namespace", isolatedclient.DefaultNamespace,
+		"Isolate namespace to upload artifacts to")
+
 	// TODO(sajjadm): Add new function to flag package that decodes to a map[string]string and
 	// enforces unique keys, then use that function to implement a -base-test-variant flag.
 	// The description for the flag will be:
@@ -99,6 +137,23 @@ func parseArgs() (wrapperArgs, error) {
 		args.completeInvocationExitCodes = []int{0}
 	}
 
+	if args.recorder == "" {
+		return args, errors.Reason("-recorder is required").Err()
+	}
+
+	if args.isolateServer != "" {
+		var err error
+		if args.isolateServer, err = lhttp.CheckURL(args.isolateServer); err != nil {
+			return args, errors.Annotate(err, "invalid -isolate-server").Err()
+		}
+	}
+
+	for format := range args.resultFiles {
+		if _, ok := resultFileFormats[format]; !ok {
+			return args, errors.Reason("unknown -result-file format %q", format).Err()
+		}
+	}
+
 	return args, nil
 }
 
@@ -109,6 +164,16 @@ type wrapper struct {
 	cmdName       string
 	cmdArgs       []string
 	childExitCode int
+
+	recorderHost                string
+	invocationIDFile            string
+	invocationTags              strpair.Map
+	completeInvocationExitCodes []int
+
+	resultFiles strpair.Map
+
+	isolateServer    string
+	isolateNamespace string
 }
 
 func (w *wrapper) init() error {
@@ -124,6 +189,14 @@ func (w *wrapper) init() error {
 	w.cmdArgs = flag.Args()[1:]
 
 	w.serverCfg.Port = args.port
+	w.serverCfg.TestPathPrefix = args.testPathPrefix
+	w.recorderHost = args.recorder
+	w.invocationIDFile = args.invocationIDFile
+	w.invocationTags = args.invocationTags
+	w.completeInvocationExitCodes = args.completeInvocationExitCodes
+	w.resultFiles = args.resultFiles
+	w.isolateServer = args.isolateServer
+	w.isolateNamespace = args.isolateNamespace
 
 	if args.logFile == "" {
 		w.logCfg.Out = os.Stderr
@@ -165,13 +238,42 @@ func (w *wrapper) run(ctx context.Context) (int, error) {
 	return 0, nil
 }
 
-func (w *wrapper) main(ctx context.Context) error {
+func (w *wrapper) main(ctx context.Context) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	// TODO(sajjadm): Use https://godoc.org/go.chromium.org/luci/common/system/signals
 	// to handle interrupts
 
+	authClient, err := auth.NewAuthenticator(ctx, auth.SilentLogin, chromeinfra.DefaultAuthOptions()).Client()
+	if err != nil {
+		return errors.Annotate(err, "failed to create an authenticated client").Err()
+	}
+
+	if w.isolateServer != "" {
+		if w.serverCfg.ArtifactUploader, err = w.newArtifactUploader(authClient); err != nil {
+			return errors.Annotate(err, "failed to create an artifact uploader").Err()
+		}
+	}
+
+	rpcOpts := prpc.DefaultOptions()
+	rpcOpts.Insecure = lhttp.IsLocalHost(w.recorderHost)
+	recorder := pb.NewRecorderPRPCClient(&prpc.Client{
+		C:       authClient,
+		Host:    w.recorderHost,
+		Options: rpcOpts,
+	})
+	if err := w.createInvocation(ctx, recorder); err != nil {
+		return errors.Annotate(err, "failed to create an invocation").Err()
+	}
+	defer func() {
+		// Finalize the invocation even if something failed, marking it
+		// interrupted, so that it doesn't stay active until its deadline.
+		if ferr := w.finalizeInvocation(ctx, recorder, err != nil); err == nil {
+			err = ferr
+		}
+	}()
+
 	server, err := sink.NewServer(ctx, w.serverCfg)
 	if err != nil {
 		return err
@@ -189,8 +291,101 @@ func (w *wrapper) main(ctx context.Context) error {
 		return err
 	}
 
-	// TODO(sajjadm): Add post-command work such as uploading from results file
+	return w.processResultFiles(ctx, server)
+}
+
+// createInvocation creates an invocation that the sink uploads test results
+// to and writes its ID to -invocation-id-file.
+func (w *wrapper) createInvocation(ctx context.Context, recorder pb.RecorderClient) error {
+	id := "u:" + uuid.New().String()
+	md := metadata.MD{}
+	inv, err := recorder.CreateInvocation(ctx, &pb.CreateInvocationRequest{
+		InvocationId: id,
+		Invocation:   &pb.Invocation{Tags: pbutil.FromStrpairMap(w.invocationTags)},
+		RequestId:    uuid.New().String(),
+	}, prpc.Header(&md))
+	if err != nil {
+		return err
+	}
+
+	tokens := md.Get(updateTokenMetadataKey)
+	if len(tokens) != 1 {
+		return errors.Reason("expected 1 %s header, got %d", updateTokenMetadataKey, len(tokens)).Err()
+	}
+	w.serverCfg.Invocation = inv.Name
+	w.serverCfg.UpdateToken = tokens[0]
+	logging.Infof(ctx, "Created invocation %q", inv.Name)
 
+	if w.invocationIDFile != "" {
+		if err := ioutil.WriteFile(w.invocationIDFile, []byte(id), 0644); err != nil {
+			return errors.Annotate(err, "failed to write the invocation ID").Err()
+		}
+	}
+	return nil
+}
+
+// finalizeInvocation finalizes the invocation created by createInvocation.
+//
+// The invocation is marked interrupted if failed is true or the subprocess
+// exit code is not in -complete-invocation-exit-codes.
+func (w *wrapper) finalizeInvocation(ctx context.Context, recorder pb.RecorderClient, failed bool) error {
+	interrupted := true
+	if !failed {
+		for _, code := range w.completeInvocationExitCodes {
+			if code == w.childExitCode {
+				interrupted = false
+				break
+			}
+		}
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, updateTokenMetadataKey, w.serverCfg.UpdateToken)
+	_, err := recorder.FinalizeInvocation(ctx, &pb.FinalizeInvocationRequest{
+		Name:        w.serverCfg.Invocation,
+		Interrupted: interrupted,
+	})
+	return errors.Annotate(err, "failed to finalize the invocation").Err()
+}
+
+// newArtifactUploader returns an uploader of artifacts to the isolate server
+// specified by -isolate-server.
+func (w *wrapper) newArtifactUploader(authClient *http.Client) (*sink.ArtifactUploader, error) {
+	u, err := url.Parse(w.isolateServer)
+	if err != nil {
+		return nil, err
+	}
+	return &sink.ArtifactUploader{
+		Client:    isolatedclient.New(nil, authClient, w.isolateServer, w.isolateNamespace, nil, nil),
+		Host:      u.Host,
+		Namespace: w.isolateNamespace,
+	}, nil
+}
+
+// processResultFiles sends test results from the result files written by the
+// subprocess through the sink.
+func (w *wrapper) processResultFiles(ctx context.Context, server *sink.Server) error {
+	names := make([]string, 0, len(w.resultFiles))
+	for name := range w.resultFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, path := range w.resultFiles[name] {
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return err
+			}
+			err = server.Process(ctx, &sinkpb.SinkMessageContainer{
+				Msg: &sinkpb.SinkMessageContainer_TestResultFile{
+					TestResultFile: &sinkpb.TestResultFile{Path: absPath, Format: resultFileFormats[name]},
+				},
+			})
+			if err != nil {
+				return errors.Annotate(err, "failed to process result file %q", path).Err()
+			}
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,207 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/isolated"
+	"go.chromium.org/luci/common/isolatedclient"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/router"
+
+	"go.chromium.org/luci/resultdb/internal"
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+// artifactContentPath is the URL path of the endpoint that serves contents of
+// artifacts. Query parameters:
+//   - result: name of the test result, e.g.
+//     "invocations/a/tests/t/results/r".
+//   - artifact: name of the artifact within the test result, e.g.
+//     "screenshot.png".
+const artifactContentPath = "/artifact-content"
+
+// maxIsolatedContentSize is the maximum size of isolated artifact contents that
+// the handler serves. The contents are buffered in memory, see serve.
+const maxIsolatedContentSize = 32 * 1024 * 1024
+
+// artifactContentHandler serves contents of artifacts to users who have access
+// to the invocation containing the artifact.
+//
+// Like all ResultDB RPCs, it checks only that the caller is allowed to access
+// ResultDB, since realm ACLs are not implemented yet (crbug.com/1013316).
+// Contents stored in isolate are fetched with the caller's own credentials,
+// so the handler never serves isolated data the caller can't fetch directly.
+type artifactContentHandler struct {
+	// isolateHosts is a set of isolate servers that the handler fetches
+	// artifact contents from. Artifacts stored elsewhere are not served,
+	// so that the server does not forward credentials to arbitrary hosts.
+	isolateHosts stringset.Set
+
+	// fetchIsolate writes contents of an isolated object to w.
+	fetchIsolate func(ctx context.Context, host, namespace string, digest isolated.HexDigest, w io.Writer) error
+}
+
+// install registers the handler in the router.
+func (h *artifactContentHandler) install(r *router.Router, mw router.MiddlewareChain) {
+	r.GET(artifactContentPath, mw, h.handle)
+}
+
+func (h *artifactContentHandler) handle(c *router.Context) {
+	ctx := c.Context
+	err := h.serve(ctx, c.Writer, c.Request.FormValue("result"), c.Request.FormValue("artifact"))
+	if err == nil {
+		return
+	}
+
+	switch code := grpcutil.Code(err); code {
+	case codes.Internal, codes.Unknown:
+		logging.Errorf(ctx, "failed to serve artifact contents: %s", err)
+		http.Error(c.Writer, "Internal server error", http.StatusInternalServerError)
+	default:
+		http.Error(c.Writer, err.Error(), grpcutil.CodeStatus(code))
+	}
+}
+
+// serve writes the contents of the artifact to w.
+func (h *artifactContentHandler) serve(ctx context.Context, w http.ResponseWriter, resultName, artName string) error {
+	// TODO(crbug.com/1013316): check the realm of the invocation, once realm
+	// ACLs are implemented, see artifactContentHandler.
+	if err := internal.VerifyAccess(ctx); err != nil {
+		return err
+	}
+
+	if err := pbutil.ValidateTestResultName(resultName); err != nil {
+		return errors.Annotate(err, "result").Tag(grpcutil.InvalidArgumentTag).Err()
+	}
+	if artName == "" {
+		return errors.Reason("artifact: unspecified").Tag(grpcutil.InvalidArgumentTag).Err()
+	}
+
+	art, err := readArtifact(ctx, resultName, artName)
+	if err != nil {
+		return err
+	}
+
+	if len(art.Contents) > 0 || art.FetchUrl == "" {
+		setContentHeaders(w, art)
+		_, err := w.Write(art.Contents)
+		return err
+	}
+
+	host, ns, digest, err := parseIsolateURL(art.FetchUrl)
+	switch {
+	case err != nil:
+		return errors.Annotate(err, "cannot serve contents of artifact %q", artName).Tag(grpcutil.UnimplementedTag).Err()
+	case !h.isolateHosts.Has(host):
+		return errors.Reason("cannot serve contents of artifact %q: isolate server %q is not allowed", artName, host).Tag(grpcutil.UnimplementedTag).Err()
+	case art.Size > maxIsolatedContentSize:
+		return errors.Reason("cannot serve contents of artifact %q: larger than %d bytes", artName, maxIsolatedContentSize).Tag(grpcutil.UnimplementedTag).Err()
+	}
+
+	// Buffer the contents, so that a failed fetch produces a proper error
+	// response instead of a truncated 200. The size is capped in case the
+	// artifact does not record it.
+	buf := &cappedBuffer{max: maxIsolatedContentSize}
+	if err := h.fetchIsolate(ctx, host, ns, digest, buf); err != nil {
+		return err
+	}
+	setContentHeaders(w, art)
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// readArtifact reads the artifact of the test result.
+func readArtifact(ctx context.Context, resultName, artName string) (*pb.Artifact, error) {
+	tr, err := span.ReadTestResult(ctx, span.Client(ctx).Single(), resultName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, arts := range [][]*pb.Artifact{tr.InputArtifacts, tr.OutputArtifacts} {
+		for _, a := range arts {
+			if a.Name == artName {
+				return a, nil
+			}
+		}
+	}
+	return nil, errors.Reason("artifact %q not found in %q", artName, resultName).Tag(grpcutil.NotFoundTag).Err()
+}
+
+// setContentHeaders sets the headers describing the contents of the artifact.
+//
+// Browsers must not sniff the contents: artifacts are produced by tests and
+// may well contain HTML.
+func setContentHeaders(w http.ResponseWriter, art *pb.Artifact) {
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if art.ContentType != "" {
+		w.Header().Set("Content-Type", art.ContentType)
+	}
+}
+
+// cappedBuffer is a bytes.Buffer that refuses to grow beyond max bytes.
+type cappedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.max {
+		return 0, errors.Reason("contents are larger than %d bytes", b.max).Err()
+	}
+	return b.Buffer.Write(p)
+}
+
+// parseIsolateURL parses a fetch URL of an isolated object,
+// "isolate://{host}/{namespace}/{digest}".
+func parseIsolateURL(fetchURL string) (host, ns string, digest isolated.HexDigest, err error) {
+	u, err := url.Parse(fetchURL)
+	if err != nil {
+		return "", "", "", err
+	}
+	if u.Scheme != "isolate" {
+		return "", "", "", errors.Reason("unsupported fetch URL scheme %q", u.Scheme).Err()
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if u.Host == "" || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", errors.Reason("fetch URL %q does not match isolate://{host}/{namespace}/{digest}", fetchURL).Err()
+	}
+	return u.Host, parts[0], isolated.HexDigest(parts[1]), nil
+}
+
+// fetchIsolate fetches an isolated object using credentials of the caller.
+func fetchIsolate(ctx context.Context, host, namespace string, digest isolated.HexDigest, w io.Writer) error {
+	tr, err := auth.GetRPCTransport(ctx, auth.AsCredentialsForwarder)
+	if err != nil {
+		return err
+	}
+	client := isolatedclient.New(nil, &http.Client{Transport: tr}, "https://"+host, namespace, nil, nil)
+	return errors.Annotate(client.Fetch(ctx, digest, w), "failed to fetch %s from %s", digest, host).Err()
+}
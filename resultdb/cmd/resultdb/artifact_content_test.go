@@ -0,0 +1,184 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/isolated"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/router"
+
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestParseIsolateURL(t *testing.T) {
+	t.Parallel()
+	Convey(`parseIsolateURL`, t, func() {
+		Convey(`Valid`, func() {
+			host, ns, digest, err := parseIsolateURL("isolate://isolate.example.com/default-gzip/deadbeef")
+			So(err, ShouldBeNil)
+			So(host, ShouldEqual, "isolate.example.com")
+			So(ns, ShouldEqual, "default-gzip")
+			So(digest, ShouldEqual, isolated.HexDigest("deadbeef"))
+		})
+
+		Convey(`Unsupported scheme`, func() {
+			_, _, _, err := parseIsolateURL("gs://bucket/object")
+			So(err, ShouldErrLike, `unsupported fetch URL scheme "gs"`)
+		})
+
+		Convey(`Missing digest`, func() {
+			_, _, _, err := parseIsolateURL("isolate://isolate.example.com/default-gzip")
+			So(err, ShouldErrLike, "does not match")
+		})
+	})
+}
+
+func TestArtifactContent(t *testing.T) {
+	Convey(`ArtifactContent`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:someone@example.com",
+			IdentityGroups: []string{"luci-resultdb-access"},
+		})
+
+		testutil.MustApply(ctx,
+			testutil.InsertInvocation("inv", pb.Invocation_COMPLETED, "", clock.Now(ctx)),
+			span.InsertMap("TestResults", map[string]interface{}{
+				"InvocationId":    span.InvocationID("inv"),
+				"TestPath":        "t",
+				"ResultId":        "r",
+				"Variant":         pbutil.Variant(),
+				"VariantHash":     pbutil.VariantHash(pbutil.Variant()),
+				"CommitTimestamp": spanner.CommitTimestamp,
+				"Status":          pb.TestStatus_FAIL,
+				"RunDurationUsec": 1,
+				"OutputArtifacts": []*pb.Artifact{
+					{Name: "inline.txt", ContentType: "plain/text", Contents: []byte("inline contents")},
+					{Name: "isolated.png", ContentType: "image/png", FetchUrl: "isolate://isolate.example.com/default-gzip/deadbeef"},
+					{Name: "huge", FetchUrl: "isolate://isolate.example.com/default-gzip/deadbeef", Size: maxIsolatedContentSize + 1},
+					{Name: "elsewhere", FetchUrl: "isolate://evil.example.com/default-gzip/deadbeef"},
+					{Name: "gs", FetchUrl: "gs://bucket/object"},
+				},
+			}))
+
+		var fetched []string
+		var fetchErr error
+		h := &artifactContentHandler{
+			isolateHosts: stringset.NewFromSlice("isolate.example.com"),
+			fetchIsolate: func(ctx context.Context, host, namespace string, digest isolated.HexDigest, w io.Writer) error {
+				fetched = append(fetched, host+"/"+namespace+"/"+string(digest))
+				if _, err := io.WriteString(w, "isolated contents"); err != nil {
+					return err
+				}
+				return fetchErr
+			},
+		}
+
+		get := func(result, artifact string) *httptest.ResponseRecorder {
+			q := url.Values{"result": {result}, "artifact": {artifact}}
+			req := httptest.NewRequest("GET", artifactContentPath+"?"+q.Encode(), nil)
+			rec := httptest.NewRecorder()
+			h.handle(&router.Context{Context: ctx, Writer: rec, Request: req})
+			return rec
+		}
+		resultName := pbutil.TestResultName("inv", "t", "r")
+
+		Convey(`Inline`, func() {
+			rec := get(resultName, "inline.txt")
+			So(rec.Code, ShouldEqual, http.StatusOK)
+			So(rec.Header().Get("Content-Type"), ShouldEqual, "plain/text")
+			So(rec.Header().Get("X-Content-Type-Options"), ShouldEqual, "nosniff")
+			So(rec.Body.String(), ShouldEqual, "inline contents")
+			So(fetched, ShouldBeEmpty)
+		})
+
+		Convey(`Isolated`, func() {
+			rec := get(resultName, "isolated.png")
+			So(rec.Code, ShouldEqual, http.StatusOK)
+			So(rec.Header().Get("Content-Type"), ShouldEqual, "image/png")
+			So(rec.Header().Get("X-Content-Type-Options"), ShouldEqual, "nosniff")
+			So(rec.Body.String(), ShouldEqual, "isolated contents")
+			So(fetched, ShouldResemble, []string{"isolate.example.com/default-gzip/deadbeef"})
+		})
+
+		Convey(`Too large isolated contents`, func() {
+			rec := get(resultName, "huge")
+			So(rec.Code, ShouldEqual, http.StatusNotImplemented)
+			So(rec.Body.String(), ShouldContainSubstring, "larger than")
+			So(fetched, ShouldBeEmpty)
+		})
+
+		Convey(`Failed isolate fetch`, func() {
+			fetchErr = errors.New("connection reset")
+			rec := get(resultName, "isolated.png")
+			So(rec.Code, ShouldEqual, http.StatusInternalServerError)
+			So(rec.Header().Get("Content-Type"), ShouldNotEqual, "image/png")
+			So(rec.Body.String(), ShouldNotContainSubstring, "isolated contents")
+		})
+
+		Convey(`Disallowed isolate server`, func() {
+			rec := get(resultName, "elsewhere")
+			So(rec.Code, ShouldEqual, http.StatusNotImplemented)
+			So(rec.Body.String(), ShouldContainSubstring, "is not allowed")
+			So(fetched, ShouldBeEmpty)
+		})
+
+		Convey(`Unsupported scheme`, func() {
+			rec := get(resultName, "gs")
+			So(rec.Code, ShouldEqual, http.StatusNotImplemented)
+		})
+
+		Convey(`Artifact not found`, func() {
+			rec := get(resultName, "missing")
+			So(rec.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey(`Test result not found`, func() {
+			rec := get(pbutil.TestResultName("inv", "t", "missing"), "inline.txt")
+			So(rec.Code, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey(`Bad request`, func() {
+			rec := get("bad", "inline.txt")
+			So(rec.Code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey(`Permission denied`, func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:stranger@example.com"})
+			rec := get(resultName, "inline.txt")
+			So(rec.Code, ShouldEqual, http.StatusForbidden)
+			So(rec.Body.String(), ShouldNotContainSubstring, "inline contents")
+		})
+	})
+}
@@ -0,0 +1,190 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+const (
+	defaultTestHistoryMaxAge = 7 * 24 * time.Hour
+	maxTestHistoryMaxAge     = 60 * 24 * time.Hour
+
+	defaultTestHistoryMaxResults = 100
+	maxTestHistoryMaxResults     = 1000
+
+	// testHistoryScanLimit is the maximum number of test results under the test
+	// path prefix that GetTestHistory reads, see GetTestHistoryRequest.
+	testHistoryScanLimit = 10000
+)
+
+// realmRe matches a realm, e.g. "chromium/public", or a project, e.g.
+// "chromium".
+var realmRe = regexp.MustCompile(`^[a-z0-9\-_]+(/[a-z0-9\-_.]+)?$`)
+
+// validateGetTestHistoryRequest returns a non-nil error if req is determined
+// to be invalid.
+func validateGetTestHistoryRequest(req *pb.GetTestHistoryRequest) error {
+	switch {
+	case req.Realm == "":
+		return errors.Reason("realm: unspecified").Err()
+	case !realmRe.MatchString(req.Realm):
+		return errors.Reason("realm: does not match %s", realmRe).Err()
+	case req.TestPathPrefix == "":
+		return errors.Reason("test_path_prefix: unspecified").Err()
+	case req.MaxResults < 0:
+		return errors.Reason("max_results: negative").Err()
+	}
+
+	if req.Variant != nil {
+		if err := pbutil.ValidateVariantPredicate(req.Variant); err != nil {
+			return errors.Annotate(err, "variant").Err()
+		}
+	}
+
+	if req.MaxAge != nil {
+		switch d, err := ptypes.Duration(req.MaxAge); {
+		case err != nil:
+			return errors.Annotate(err, "max_age").Err()
+		case d <= 0 || d > maxTestHistoryMaxAge:
+			return errors.Reason("max_age: must be positive and not exceed %s", maxTestHistoryMaxAge).Err()
+		}
+	}
+
+	return nil
+}
+
+// GetTestHistory implements pb.ResultDBServer.
+func (s *resultDBServer) GetTestHistory(ctx context.Context, in *pb.GetTestHistoryRequest) (*pb.GetTestHistoryResponse, error) {
+	if err := validateGetTestHistoryRequest(in); err != nil {
+		return nil, errors.Annotate(err, "bad request").Tag(grpcutil.InvalidArgumentTag).Err()
+	}
+
+	maxAge := defaultTestHistoryMaxAge
+	if in.MaxAge != nil {
+		maxAge, _ = ptypes.Duration(in.MaxAge)
+	}
+	limit := int(in.MaxResults)
+	switch {
+	case limit == 0:
+		limit = defaultTestHistoryMaxResults
+	case limit > maxTestHistoryMaxResults:
+		limit = maxTestHistoryMaxResults
+	}
+
+	txn := span.Client(ctx).ReadOnlyTransaction()
+	defer txn.Close()
+	trs, err := span.QueryTestHistory(ctx, txn, span.TestHistoryQuery{
+		Realm:          in.Realm,
+		TestPathPrefix: in.TestPathPrefix,
+		Variant:        in.Variant,
+		Since:          clock.Now(ctx).Add(-maxAge),
+		ScanLimit:      testHistoryScanLimit,
+		Limit:          limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTestHistoryResponse{
+		TestResults:  trs,
+		TestVariants: summarizeFlakiness(trs),
+	}, nil
+}
+
+// summarizeFlakiness computes flakiness of test variants of the test results.
+//
+// Test results of a test variant are grouped by invocation, and each
+// invocation is counted as a pass, a fail or a flake depending on whether the
+// results were expected.
+//
+// trs are the most recent results only, so the summary is biased if the query
+// hit the limit, see GetTestHistoryResponse.test_variants.
+func summarizeFlakiness(trs []*pb.TestResult) []*pb.TestVariantFlakiness {
+	type variantKey struct {
+		testPath    string
+		variantHash string
+	}
+	type invocationKey struct {
+		variantKey
+		invID span.InvocationID
+	}
+	// Whether an invocation had expected and unexpected results of a variant.
+	type outcome struct {
+		expected, unexpected bool
+	}
+
+	variants := map[variantKey]*pb.TestVariantFlakiness{}
+	outcomes := map[invocationKey]*outcome{}
+	for _, tr := range trs {
+		vk := variantKey{testPath: tr.TestPath, variantHash: pbutil.VariantHash(tr.Variant)}
+		if _, ok := variants[vk]; !ok {
+			variants[vk] = &pb.TestVariantFlakiness{TestPath: tr.TestPath, Variant: tr.Variant}
+		}
+
+		invID, _, _ := span.MustParseTestResultName(tr.Name)
+		ik := invocationKey{variantKey: vk, invID: invID}
+		o := outcomes[ik]
+		if o == nil {
+			o = &outcome{}
+			outcomes[ik] = o
+		}
+		if tr.Expected {
+			o.expected = true
+		} else {
+			o.unexpected = true
+		}
+	}
+
+	for ik, o := range outcomes {
+		v := variants[ik.variantKey]
+		switch {
+		case o.expected && o.unexpected:
+			v.FlakeCount++
+		case o.expected:
+			v.PassCount++
+		default:
+			v.FailCount++
+		}
+	}
+
+	ret := make([]*pb.TestVariantFlakiness, 0, len(variants))
+	for _, v := range variants {
+		ret = append(ret, v)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].TestPath != ret[j].TestPath {
+			return ret[i].TestPath < ret[j].TestPath
+		}
+		vi := strings.Join(pbutil.VariantToStrings(ret[i].Variant), "\n")
+		vj := strings.Join(pbutil.VariantToStrings(ret[j].Variant), "\n")
+		return vi < vj
+	})
+	return ret
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	durpb "github.com/golang/protobuf/ptypes/duration"
+
+	"go.chromium.org/luci/common/clock"
+
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestValidateGetTestHistoryRequest(t *testing.T) {
+	t.Parallel()
+	Convey(`ValidateGetTestHistoryRequest`, t, func() {
+		req := &pb.GetTestHistoryRequest{
+			Realm:          "chromium/public",
+			TestPathPrefix: "gn://chrome/test:browser_tests/",
+			MaxAge:         &durpb.Duration{Seconds: 3600},
+			MaxResults:     10,
+		}
+
+		Convey(`Valid`, func() {
+			So(validateGetTestHistoryRequest(req), ShouldBeNil)
+		})
+
+		Convey(`Valid project`, func() {
+			req.Realm = "chromium"
+			So(validateGetTestHistoryRequest(req), ShouldBeNil)
+		})
+
+		Convey(`No realm`, func() {
+			req.Realm = ""
+			So(validateGetTestHistoryRequest(req), ShouldErrLike, "realm: unspecified")
+		})
+
+		Convey(`Invalid realm`, func() {
+			req.Realm = "Chromium/public/x"
+			So(validateGetTestHistoryRequest(req), ShouldErrLike, "realm: does not match")
+		})
+
+		Convey(`No test path prefix`, func() {
+			req.TestPathPrefix = ""
+			So(validateGetTestHistoryRequest(req), ShouldErrLike, "test_path_prefix: unspecified")
+		})
+
+		Convey(`Invalid variant`, func() {
+			req.Variant = &pb.VariantPredicate{}
+			So(validateGetTestHistoryRequest(req), ShouldErrLike, "variant: unspecified")
+		})
+
+		Convey(`Too large max age`, func() {
+			req.MaxAge = &durpb.Duration{Seconds: 100 * 24 * 3600}
+			So(validateGetTestHistoryRequest(req), ShouldErrLike, "max_age: must be positive")
+		})
+
+		Convey(`Negative max results`, func() {
+			req.MaxResults = -1
+			So(validateGetTestHistoryRequest(req), ShouldErrLike, "max_results: negative")
+		})
+	})
+}
+
+func TestSummarizeFlakiness(t *testing.T) {
+	t.Parallel()
+	Convey(`summarizeFlakiness`, t, func() {
+		var trs []*pb.TestResult
+		trs = append(trs, testutil.MakeTestResults("inv0", "A", pb.TestStatus_PASS)...)
+		trs = append(trs, testutil.MakeTestResults("inv1", "A", pb.TestStatus_FAIL, pb.TestStatus_PASS)...)
+		trs = append(trs, testutil.MakeTestResults("inv2", "A", pb.TestStatus_FAIL, pb.TestStatus_CRASH)...)
+		trs = append(trs, testutil.MakeTestResults("inv0", "B", pb.TestStatus_PASS)...)
+		other := testutil.MakeTestResults("inv1", "A", pb.TestStatus_PASS)
+		other[0].Variant = pbutil.Variant("k1", "v0")
+		trs = append(trs, other...)
+
+		vr := pbutil.Variant("k1", "v1", "k2", "v2")
+		So(summarizeFlakiness(trs), ShouldResembleProto, []*pb.TestVariantFlakiness{
+			{TestPath: "A", Variant: pbutil.Variant("k1", "v0"), PassCount: 1},
+			{TestPath: "A", Variant: vr, PassCount: 1, FailCount: 1, FlakeCount: 1},
+			{TestPath: "B", Variant: vr, PassCount: 1},
+		})
+	})
+}
+
+func TestGetTestHistory(t *testing.T) {
+	Convey(`GetTestHistory`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		now := clock.Now(ctx)
+
+		insertInv := func(id span.InvocationID, realm string) {
+			testutil.MustApply(ctx,
+				testutil.InsertInvocation(id, pb.Invocation_COMPLETED, "", now),
+				span.UpdateMap("Invocations", map[string]interface{}{
+					"InvocationId": id,
+					"Realm":        realm,
+				}),
+			)
+		}
+		insertInv("public", "chromium/public")
+		insertInv("internal", "chromium/internal")
+		insertInv("other", "v8/public")
+		testutil.MustApply(ctx, testutil.CombineMutations(
+			testutil.InsertTestResults(testutil.MakeTestResults("public", "suite/A", pb.TestStatus_FAIL, pb.TestStatus_PASS)),
+			testutil.InsertTestResults(testutil.MakeTestResults("internal", "suite/B", pb.TestStatus_PASS)),
+			testutil.InsertTestResults(testutil.MakeTestResults("other", "suite/A", pb.TestStatus_PASS)),
+			testutil.InsertTestResults(testutil.MakeTestResults("public", "other/C", pb.TestStatus_PASS)),
+		)...)
+
+		srv := &resultDBServer{}
+		names := func(res *pb.GetTestHistoryResponse) []string {
+			ret := make([]string, len(res.TestResults))
+			for i, tr := range res.TestResults {
+				ret[i] = tr.Name
+			}
+			return ret
+		}
+
+		Convey(`Realm`, func() {
+			res, err := srv.GetTestHistory(ctx, &pb.GetTestHistoryRequest{
+				Realm:          "chromium/public",
+				TestPathPrefix: "suite/",
+			})
+			So(err, ShouldBeNil)
+			So(names(res), ShouldResemble, []string{
+				"invocations/public/tests/suite%2FA/results/0",
+				"invocations/public/tests/suite%2FA/results/1",
+			})
+			So(res.TestVariants, ShouldHaveLength, 1)
+			So(res.TestVariants[0].FlakeCount, ShouldEqual, 1)
+		})
+
+		Convey(`Project`, func() {
+			res, err := srv.GetTestHistory(ctx, &pb.GetTestHistoryRequest{
+				Realm:          "chromium",
+				TestPathPrefix: "suite/",
+			})
+			So(err, ShouldBeNil)
+			So(res.TestResults, ShouldHaveLength, 3)
+			So(res.TestVariants, ShouldHaveLength, 2)
+		})
+
+		Convey(`Exact test path`, func() {
+			res, err := srv.GetTestHistory(ctx, &pb.GetTestHistoryRequest{
+				Realm:          "chromium",
+				TestPathPrefix: "suite/B",
+			})
+			So(err, ShouldBeNil)
+			So(names(res), ShouldResemble, []string{"invocations/internal/tests/suite%2FB/results/0"})
+		})
+
+		Convey(`Scan limit`, func() {
+			txn := span.Client(ctx).ReadOnlyTransaction()
+			defer txn.Close()
+
+			// The first 3 results under the prefix are those of suite/A,
+			// including the one of another project, so suite/B is not reached.
+			trs, err := span.QueryTestHistory(ctx, txn, span.TestHistoryQuery{
+				Realm:          "chromium",
+				TestPathPrefix: "suite/",
+				Since:          now.Add(-defaultTestHistoryMaxAge),
+				ScanLimit:      3,
+				Limit:          10,
+			})
+			So(err, ShouldBeNil)
+			So(trs, ShouldHaveLength, 2)
+			for _, tr := range trs {
+				So(tr.TestPath, ShouldEqual, "suite/A")
+			}
+		})
+
+		Convey(`Variant`, func() {
+			res, err := srv.GetTestHistory(ctx, &pb.GetTestHistoryRequest{
+				Realm:          "chromium",
+				TestPathPrefix: "suite/",
+				Variant: &pb.VariantPredicate{
+					Predicate: &pb.VariantPredicate_Contains{Contains: pbutil.Variant("k1", "v2")},
+				},
+			})
+			So(err, ShouldBeNil)
+			So(res.TestResults, ShouldBeEmpty)
+		})
+	})
+}
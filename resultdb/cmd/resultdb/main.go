@@ -15,9 +15,14 @@
 package main
 
 import (
+	"flag"
 	"io"
 
+	clientauth "go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/data/stringset"
+	luciflag "go.chromium.org/luci/common/flag"
 	"go.chromium.org/luci/server"
+	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/router"
 
 	"go.chromium.org/luci/resultdb/internal"
@@ -40,10 +45,25 @@ func NewResultDBServer() pb.ResultDBServer {
 }
 
 func main() {
+	var isolateHosts []string
+	flag.Var(luciflag.StringSlice(&isolateHosts), "artifact-isolate-host",
+		"Isolate server to serve artifact contents from, e.g. isolateserver.appspot.com. May be set more than once")
+
 	internal.Main(func(srv *server.Server) error {
 		srv.Routes.GET("/", router.MiddlewareChain{}, func(c *router.Context) {
 			io.WriteString(c.Writer, "OK")
 		})
+
+		artifacts := &artifactContentHandler{
+			isolateHosts: stringset.NewFromSlice(isolateHosts...),
+			fetchIsolate: fetchIsolate,
+		}
+		artifacts.install(srv.Routes, router.NewMiddlewareChain(
+			auth.Authenticate(&auth.GoogleOAuth2Method{
+				Scopes: []string{clientauth.OAuthScopeEmail},
+			}),
+		))
+
 		pb.RegisterResultDBServer(srv.PRPC, NewResultDBServer())
 
 		// Register an empty Recorder server only to make the discovery service
@@ -55,7 +55,7 @@ func CommonPrelude(ctx context.Context, methodName string, req proto.Message) (r
 	}
 	ctx = WithHTTPClient(ctx, &http.Client{Transport: tr})
 
-	if err := verifyAccess(ctx); err != nil {
+	if err := VerifyAccess(ctx); err != nil {
 		return nil, err
 	}
 	return ctx, nil
@@ -79,7 +79,9 @@ func CommonPostlude(ctx context.Context, methodName string, rsp proto.Message, e
 	return grpcutil.GRPCifyAndLogErr(ctx, err)
 }
 
-func verifyAccess(ctx context.Context) error {
+// VerifyAccess returns a PermissionDenied-tagged error if the current identity
+// is not allowed to access ResultDB.
+func VerifyAccess(ctx context.Context) error {
 	// TODO(crbug.com/1013316): use realms.
 
 	// WARNING: removing this restriction requires removing AsSelf HTTP client
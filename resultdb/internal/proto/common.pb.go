@@ -107,9 +107,53 @@ func (m *Artifacts) GetArtifactsV1() []*v1.Artifact {
 	return nil
 }
 
+// A task to perform on an invocation.
+// Used to store tasks in the InvocationTasks Spanner table.
+type InvocationTask struct {
+	// Export the test results of the invocation, and of the invocations it
+	// includes transitively, to a BigQuery table.
+	BigqueryExport       *v1.BigQueryExport `protobuf:"bytes,1,opt,name=bigquery_export,json=bigqueryExport,proto3" json:"bigquery_export,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *InvocationTask) Reset()         { *m = InvocationTask{} }
+func (m *InvocationTask) String() string { return proto.CompactTextString(m) }
+func (*InvocationTask) ProtoMessage()    {}
+func (*InvocationTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ccc4743443d652e, []int{2}
+}
+
+func (m *InvocationTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvocationTask.Unmarshal(m, b)
+}
+func (m *InvocationTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvocationTask.Marshal(b, m, deterministic)
+}
+func (m *InvocationTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvocationTask.Merge(m, src)
+}
+func (m *InvocationTask) XXX_Size() int {
+	return xxx_messageInfo_InvocationTask.Size(m)
+}
+func (m *InvocationTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvocationTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvocationTask proto.InternalMessageInfo
+
+func (m *InvocationTask) GetBigqueryExport() *v1.BigQueryExport {
+	if m != nil {
+		return m.BigqueryExport
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PageToken)(nil), "luci.resultdb.internal.PageToken")
 	proto.RegisterType((*Artifacts)(nil), "luci.resultdb.internal.Artifacts")
+	proto.RegisterType((*InvocationTask)(nil), "luci.resultdb.internal.InvocationTask")
 }
 
 func init() {
@@ -117,18 +161,22 @@ func init() {
 }
 
 var fileDescriptor_4ccc4743443d652e = []byte{
-	// 202 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x8f, 0x31, 0x4b, 0xc0, 0x30,
-	0x10, 0x85, 0x11, 0x41, 0x6c, 0xda, 0xa9, 0x83, 0x48, 0x07, 0x91, 0x2e, 0x3a, 0x5d, 0x88, 0x4e,
-	0x82, 0x4b, 0xfd, 0x01, 0x22, 0x45, 0x1c, 0x5c, 0x4a, 0x1a, 0x63, 0x0d, 0x36, 0xb9, 0x70, 0xb9,
-	0xf6, 0xf7, 0x8b, 0x2d, 0x29, 0xb8, 0x38, 0x3e, 0xee, 0x7b, 0x1f, 0xef, 0xc4, 0xc3, 0x84, 0x60,
-	0xbe, 0x08, 0xbd, 0x5b, 0x3c, 0x20, 0x4d, 0x72, 0x5e, 0x8c, 0x93, 0x64, 0xd3, 0x32, 0xf3, 0xc7,
-	0x28, 0x5d, 0x60, 0x4b, 0x41, 0xcf, 0x32, 0x12, 0x32, 0x4a, 0x83, 0xde, 0x63, 0x80, 0x2d, 0xd4,
-	0x17, 0xbf, 0x28, 0x64, 0x14, 0x32, 0xda, 0x3c, 0xfe, 0xaf, 0xdc, 0x4d, 0x14, 0x8d, 0x5c, 0x95,
-	0x64, 0x9b, 0x78, 0xd8, 0x4f, 0xbb, 0xb5, 0xbd, 0x11, 0xc5, 0x8b, 0x9e, 0xec, 0x2b, 0x7e, 0xdb,
-	0x50, 0x37, 0xe2, 0x3c, 0x62, 0x72, 0xec, 0x30, 0x5c, 0x9e, 0x5c, 0x9f, 0xde, 0x16, 0xfd, 0x91,
-	0xdb, 0x67, 0x51, 0x74, 0xc4, 0xee, 0x53, 0x1b, 0x4e, 0x75, 0x27, 0x2a, 0x9d, 0xc3, 0xb0, 0xaa,
-	0x0d, 0x2e, 0xef, 0xae, 0xe0, 0xef, 0x44, 0x8a, 0x06, 0x56, 0x05, 0xb9, 0xd6, 0x97, 0x47, 0xe7,
-	0x4d, 0x3d, 0x55, 0xef, 0x22, 0xbf, 0x10, 0xc7, 0xf1, 0x6c, 0x5b, 0x73, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x95, 0x16, 0x1c, 0xa6, 0x20, 0x01, 0x00, 0x00,
+	// 272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xc1, 0x4b, 0xf3, 0x30,
+	0x18, 0xc6, 0x19, 0x1f, 0x7c, 0xd8, 0x74, 0x4c, 0xe8, 0x41, 0x46, 0x0f, 0x32, 0x8a, 0xe0, 0x4e,
+	0x09, 0x9d, 0x27, 0xc1, 0xcb, 0x06, 0x0a, 0x1e, 0x14, 0x2d, 0xc3, 0x83, 0x97, 0x92, 0xc6, 0x18,
+	0xc3, 0xda, 0xbc, 0xf1, 0x4d, 0x5a, 0xf4, 0xbf, 0x97, 0xb6, 0xa4, 0x20, 0x88, 0xe0, 0xf1, 0x21,
+	0xbf, 0xe7, 0x17, 0x9e, 0x97, 0x5c, 0x2a, 0xa0, 0xe2, 0x0d, 0xa1, 0xd1, 0x6d, 0x43, 0x01, 0x15,
+	0xab, 0x5b, 0xa1, 0x19, 0x4a, 0xd7, 0xd6, 0xfe, 0xa5, 0x62, 0xda, 0x78, 0x89, 0x86, 0xd7, 0xcc,
+	0x22, 0x78, 0x60, 0x02, 0x9a, 0x06, 0x0c, 0x1d, 0x42, 0x72, 0xd2, 0xa3, 0x34, 0xa0, 0x34, 0xa0,
+	0xe9, 0xcd, 0xef, 0xca, 0xd1, 0x84, 0x56, 0xb0, 0x2e, 0x67, 0xda, 0x74, 0x20, 0xb8, 0xd7, 0x60,
+	0x4a, 0x8b, 0x20, 0xa4, 0x73, 0xda, 0xa8, 0xd1, 0x9f, 0x5e, 0xfd, 0xc1, 0xe3, 0xa5, 0xf3, 0xe5,
+	0xf8, 0x34, 0xb6, 0xb3, 0x73, 0x12, 0x3d, 0x70, 0x25, 0xf7, 0x70, 0x90, 0x26, 0x49, 0xc9, 0x91,
+	0x05, 0xa7, 0xfb, 0x7f, 0x96, 0xb3, 0xd5, 0xbf, 0x75, 0x54, 0x4c, 0x39, 0xbb, 0x27, 0xd1, 0x16,
+	0xbd, 0x7e, 0xe5, 0xc2, 0xbb, 0x64, 0x4b, 0xe6, 0x3c, 0x84, 0xb2, 0xcb, 0x07, 0x38, 0xde, 0x9c,
+	0xd2, 0xef, 0x53, 0xd1, 0x0a, 0xda, 0xe5, 0x34, 0xd4, 0x8a, 0x78, 0xea, 0x3c, 0xe5, 0x59, 0x49,
+	0x16, 0xb7, 0xd3, 0xaa, 0x3d, 0x77, 0x87, 0xe4, 0x8e, 0x1c, 0x57, 0x5a, 0xbd, 0xb7, 0x12, 0x3f,
+	0x4b, 0xf9, 0x61, 0x01, 0xfd, 0x72, 0xb6, 0x9a, 0xad, 0xe3, 0xcd, 0xd9, 0xcf, 0xde, 0x9d, 0x56,
+	0x8f, 0x3d, 0x7c, 0x3d, 0xb0, 0xc5, 0x22, 0x94, 0xc7, 0xbc, 0x9b, 0x3f, 0x93, 0x70, 0x6b, 0x5b,
+	0x55, 0xff, 0x87, 0xb9, 0x17, 0x5f, 0x03, 0x00, 0xa6, 0x47, 0x75, 0xf0, 0xc9, 0x01, 0x00, 0x00,
 }
@@ -57,6 +57,22 @@ CREATE TABLE Invocations (
   -- When to force invocation finalization with state INTERRUPTED.
   Deadline TIMESTAMP NOT NULL,
 
+  -- Value of Deadline if the invocation is active, otherwise NULL.
+  -- Keeps InvocationsByActiveDeadline index thin.
+  --
+  -- Active invocations created before this column was added have NULL
+  -- ActiveDeadline, so the sweeper does not see them. When adding the column
+  -- to an existing database, backfill it with partitioned DML:
+  --   UPDATE Invocations SET ActiveDeadline = Deadline
+  --   WHERE State = 1 AND ActiveDeadline IS NULL
+  -- where 1 is ACTIVE in Invocation.State enum.
+  ActiveDeadline TIMESTAMP,
+
+  -- Why the server finalized the invocation with state INTERRUPTED,
+  -- e.g. because the deadline was exceeded.
+  -- NULL if the invocation was not interrupted or the client interrupted it.
+  InterruptionReason STRING(MAX),
+
   -- List of colon-separated key-value tags.
   -- Corresponds to Invocation.tags in invocation.proto.
   Tags ARRAY<STRING(MAX)>,
@@ -76,6 +92,11 @@ CREATE INDEX InvocationsByInvocationExpiration
 CREATE NULL_FILTERED INDEX InvocationsByExpectedTestResultsExpiration
   ON Invocations (ShardId DESC, ExpectedTestResultsExpirationTime, InvocationId);
 
+-- Index of active invocations by deadline.
+-- Used by a sweeper that finalizes invocations past their deadline.
+CREATE NULL_FILTERED INDEX InvocationsByActiveDeadline
+  ON Invocations (ShardId DESC, ActiveDeadline);
+
 -- Stores ids of invocations included in another invocation.
 -- Interleaved in Invocations table.
 CREATE TABLE IncludedInvocations (
@@ -153,6 +174,11 @@ CREATE NULL_FILTERED INDEX UnexpectedTestResults
   ON TestResults (InvocationId, TestPath, IsUnexpected) STORING (VariantHash),
   INTERLEAVE IN Invocations;
 
+-- Index of test results by test path and upload time.
+-- Used to query the history of tests across invocations.
+CREATE INDEX TestResultsByTestPath
+  ON TestResults (TestPath, CommitTimestamp DESC) STORING (VariantHash);
+
 
 -- Stores test exonerations, see TestExoneration in test_result.proto
 CREATE TABLE TestExonerations (
@@ -190,7 +216,6 @@ CREATE TABLE InvocationTasks (
   InvocationId STRING(MAX) NOT NULL,
 
   -- Binary-encoded luci.resultdb.internal.InvocationTask.
-  -- TODO(chanli): define luci.resultdb.internal.InvocationTask.
   Payload BYTES(MAX) NOT NULL,
 
   -- A hex-encoded sha256 of payload.
@@ -210,4 +235,14 @@ CREATE TABLE InvocationTasks (
   -- If true, set ProcessAfter to NOW when finalizing the invocation,
   -- otherwise don't set it.
   ResetOnFinalize BOOL,
+
+  -- Position of the task's progress, for tasks that process data in batches,
+  -- e.g. a page token of the test results exported so far.
+  -- Allows a task to resume where it left off when a worker restarts.
+  Progress STRING(MAX),
 ) PRIMARY KEY (InvocationId, PayloadHash);
+
+-- Index of invocation tasks by the time they can be processed.
+-- Used by workers to find tasks to process.
+CREATE NULL_FILTERED INDEX InvocationTasksByProcessAfter
+  ON InvocationTasks (ProcessAfter);
@@ -17,6 +17,7 @@ package span
 import (
 	"context"
 	"sync"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"golang.org/x/sync/errgroup"
@@ -34,6 +35,10 @@ import (
 // Column Invocations.ShardId is a value in range [0, InvocationShards).
 const InvocationShards = 100
 
+// DeadlineExceededReason is the interruption reason of invocations that were
+// finalized because their deadline was exceeded.
+const DeadlineExceededReason = "deadline exceeded"
+
 // ReadInvocation reads one invocation from Spanner.
 // If the invocation does not exist, the returned error is annotated with
 // NotFound GRPC code.
@@ -160,6 +165,7 @@ func ReadInvocationsFull(ctx context.Context, txn Txn, ids InvocationIDSet) (map
 		 i.CreateTime,
 		 i.FinalizeTime,
 		 i.Deadline,
+		 i.InterruptionReason,
 		 i.Tags,
 		 ARRAY(SELECT IncludedInvocationId FROM IncludedInvocations incl WHERE incl.InvocationID = i.InvocationId)
 		FROM Invocations i
@@ -180,6 +186,7 @@ func ReadInvocationsFull(ctx context.Context, txn Txn, ids InvocationIDSet) (map
 			&inv.CreateTime,
 			&inv.FinalizeTime,
 			&inv.Deadline,
+			&inv.InterruptionReason,
 			&inv.Tags,
 			&included)
 		if err != nil {
@@ -203,3 +210,61 @@ func ReadInvocationsFull(ctx context.Context, txn Txn, ids InvocationIDSet) (map
 	}
 	return ret, nil
 }
+
+// FinalizeInvocation finalizes the invocation at finalizeTime and makes its
+// tasks that wait for finalization, e.g. BigQuery exports, available to
+// process.
+//
+// interruptionReason is recorded only if interrupted is true. It must be empty
+// if the client interrupted the invocation.
+func FinalizeInvocation(ctx context.Context, txn *spanner.ReadWriteTransaction, id InvocationID, interrupted bool, interruptionReason string, finalizeTime time.Time) error {
+	values := map[string]interface{}{
+		"InvocationId":   id,
+		"State":          pb.Invocation_COMPLETED,
+		"FinalizeTime":   finalizeTime,
+		"ActiveDeadline": spanner.NullTime{},
+	}
+	if interrupted {
+		values["State"] = pb.Invocation_INTERRUPTED
+		if interruptionReason != "" {
+			values["InterruptionReason"] = interruptionReason
+		}
+	}
+	if err := txn.BufferWrite([]*spanner.Mutation{UpdateMap("Invocations", values)}); err != nil {
+		return err
+	}
+
+	return resetInvocationTasksOnFinalize(ctx, txn, id, finalizeTime)
+}
+
+// ReadExpiredInvocations returns IDs of up to limit active invocations whose
+// deadline is not after now.
+func ReadExpiredInvocations(ctx context.Context, txn Txn, now time.Time, limit int) (InvocationIDSet, error) {
+	// InvocationsByActiveDeadline index has rows only for active invocations,
+	// so it is cheap to scan all shards.
+	st := spanner.NewStatement(`
+		SELECT InvocationId
+		FROM Invocations@{FORCE_INDEX=InvocationsByActiveDeadline}
+		WHERE ActiveDeadline <= @now
+		LIMIT @limit
+	`)
+	st.Params = ToSpannerMap(map[string]interface{}{
+		"now":   now,
+		"limit": limit,
+	})
+
+	ret := InvocationIDSet{}
+	var b Buffer
+	err := query(ctx, txn, st, func(row *spanner.Row) error {
+		var id InvocationID
+		if err := b.FromSpanner(row, &id); err != nil {
+			return err
+		}
+		ret.Add(id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
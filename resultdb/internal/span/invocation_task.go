@@ -0,0 +1,171 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package span
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
+)
+
+// InvocationTaskKey identifies a row in the InvocationTasks table.
+type InvocationTaskKey struct {
+	InvocationID InvocationID
+	PayloadHash  string
+}
+
+// Key returns the spanner key of the task.
+func (k InvocationTaskKey) Key() spanner.Key {
+	return k.InvocationID.Key(k.PayloadHash)
+}
+
+// InsertInvocationTask returns a spanner mutation that inserts an invocation
+// task.
+//
+// If resetOnFinalize is true, the task can be processed only after the
+// invocation is finalized. Otherwise it can be processed right away.
+func InsertInvocationTask(invID InvocationID, task *internalpb.InvocationTask, resetOnFinalize bool) *spanner.Mutation {
+	payload, err := proto.Marshal(task)
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(payload)
+
+	row := map[string]interface{}{
+		"InvocationId":    invID,
+		"Payload":         payload,
+		"PayloadHash":     hex.EncodeToString(hash[:]),
+		"ResetOnFinalize": resetOnFinalize,
+	}
+	if !resetOnFinalize {
+		row["ProcessAfter"] = spanner.CommitTimestamp
+	}
+	return InsertMap("InvocationTasks", row)
+}
+
+// resetInvocationTasksOnFinalize makes the tasks of the invocation that wait
+// for its finalization available to process at finalizeTime.
+// Must be called in the transaction that finalizes the invocation.
+func resetInvocationTasksOnFinalize(ctx context.Context, txn *spanner.ReadWriteTransaction, invID InvocationID, finalizeTime time.Time) error {
+	st := spanner.NewStatement(`
+		UPDATE InvocationTasks
+		SET ProcessAfter = @finalizeTime
+		WHERE InvocationId = @invID AND ResetOnFinalize
+	`)
+	st.Params = ToSpannerMap(map[string]interface{}{
+		"invID":        invID,
+		"finalizeTime": finalizeTime,
+	})
+	_, err := txn.Update(ctx, st)
+	return err
+}
+
+// PeekInvocationTasks returns keys of up to limit tasks that can be processed
+// now.
+func PeekInvocationTasks(ctx context.Context, txn Txn, limit int) ([]InvocationTaskKey, error) {
+	st := spanner.NewStatement(`
+		SELECT InvocationId, PayloadHash
+		FROM InvocationTasks@{FORCE_INDEX=InvocationTasksByProcessAfter}
+		WHERE ProcessAfter <= CURRENT_TIMESTAMP()
+		LIMIT @limit
+	`)
+	st.Params["limit"] = limit
+
+	var ret []InvocationTaskKey
+	var b Buffer
+	err := query(ctx, txn, st, func(row *spanner.Row) error {
+		var key InvocationTaskKey
+		if err := b.FromSpanner(row, &key.InvocationID, &key.PayloadHash); err != nil {
+			return err
+		}
+		ret = append(ret, key)
+		return nil
+	})
+	return ret, err
+}
+
+// LeaseInvocationTask leases the task for the given duration, so that other
+// workers do not pick it up, and returns its payload and progress.
+//
+// Returns a nil task if the task does not exist or cannot be processed now,
+// e.g. because another worker leased it first.
+func LeaseInvocationTask(ctx context.Context, key InvocationTaskKey, duration time.Duration) (task *internalpb.InvocationTask, progress string, err error) {
+	_, err = ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		task = nil
+
+		var payload []byte
+		var processAfter spanner.NullTime
+		err := ReadRow(ctx, txn, "InvocationTasks", key.Key(), map[string]interface{}{
+			"Payload":      &payload,
+			"ProcessAfter": &processAfter,
+			"Progress":     &progress,
+		})
+		now := clock.Now(ctx)
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+			return nil
+		case err != nil:
+			return err
+		case !processAfter.Valid || processAfter.Time.After(now):
+			return nil
+		}
+
+		task = &internalpb.InvocationTask{}
+		if err := proto.Unmarshal(payload, task); err != nil {
+			return errors.Annotate(err, "invalid payload").Err()
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{
+			PostponeInvocationTask(key, now.Add(duration)),
+		})
+	})
+	return
+}
+
+// PostponeInvocationTask returns a spanner mutation that makes the task
+// available to process at processAfter.
+func PostponeInvocationTask(key InvocationTaskKey, processAfter time.Time) *spanner.Mutation {
+	return UpdateMap("InvocationTasks", map[string]interface{}{
+		"InvocationId": key.InvocationID,
+		"PayloadHash":  key.PayloadHash,
+		"ProcessAfter": processAfter,
+	})
+}
+
+// SaveInvocationTaskProgress returns a spanner mutation that records the
+// progress of the task and extends its lease until processAfter.
+func SaveInvocationTaskProgress(key InvocationTaskKey, progress string, processAfter time.Time) *spanner.Mutation {
+	return UpdateMap("InvocationTasks", map[string]interface{}{
+		"InvocationId": key.InvocationID,
+		"PayloadHash":  key.PayloadHash,
+		"Progress":     progress,
+		"ProcessAfter": processAfter,
+	})
+}
+
+// DeleteInvocationTask returns a spanner mutation that deletes the task.
+func DeleteInvocationTask(key InvocationTaskKey) *spanner.Mutation {
+	return spanner.Delete("InvocationTasks", key.Key())
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package span
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+// TestHistoryQuery specifies test results to fetch across invocations.
+type TestHistoryQuery struct {
+	// Realm is a realm, e.g. "chromium/public", or a project, e.g. "chromium",
+	// of the invocations to search.
+	Realm          string
+	TestPathPrefix string
+	Variant        *pb.VariantPredicate // may be nil
+	Since          time.Time            // lower bound of upload time
+
+	// ScanLimit is the maximum number of test results under TestPathPrefix to
+	// read, before filtering them by realm and variant. Must be positive.
+	ScanLimit int
+	Limit     int // must be positive
+}
+
+// QueryTestHistory reads test results matching the query, most recent first.
+//
+// The query reads TestResultsByTestPath index rows under the test path prefix
+// in the index order, i.e. by test path and then most recent first, and stops
+// after q.ScanLimit rows. Thus results of tests that sort last may be missing
+// if the prefix matches more results.
+func QueryTestHistory(ctx context.Context, txn Txn, q TestHistoryQuery) ([]*pb.TestResult, error) {
+	switch {
+	case q.ScanLimit <= 0:
+		panic("ScanLimit <= 0")
+	case q.Limit <= 0:
+		panic("Limit <= 0")
+	}
+
+	var conds []string
+	if strings.Contains(q.Realm, "/") {
+		conds = append(conds, "inv.Realm = @realm")
+	} else {
+		conds = append(conds, "STARTS_WITH(inv.Realm, @realm)")
+		q.Realm += "/"
+	}
+
+	params := map[string]interface{}{
+		"realm":          q.Realm,
+		"testPathPrefix": q.TestPathPrefix,
+		"since":          q.Since,
+		"scanLimit":      q.ScanLimit,
+		"limit":          q.Limit,
+	}
+	switch pr := q.Variant.GetPredicate().(type) {
+	case *pb.VariantPredicate_Exact:
+		conds = append(conds, "tr.VariantHash = @variantHash")
+		params["variantHash"] = pbutil.VariantHash(pr.Exact)
+	case *pb.VariantPredicate_Contains:
+		conds = append(conds, `NOT EXISTS (
+			SELECT 1 FROM UNNEST(@variantContains) kv
+			WHERE kv NOT IN UNNEST(tr.Variant)
+		)`)
+		params["variantContains"] = pbutil.VariantToStrings(pr.Contains)
+	case nil:
+	default:
+		panic(fmt.Sprintf("unexpected variant predicate %T", pr))
+	}
+
+	st := spanner.NewStatement(fmt.Sprintf(`
+		SELECT
+			tr.InvocationId,
+			tr.TestPath,
+			tr.ResultId,
+			tr.Variant,
+			tr.IsUnexpected,
+			tr.Status,
+			tr.SummaryMarkdown,
+			tr.StartTime,
+			tr.RunDurationUsec,
+			tr.Tags,
+			tr.InputArtifacts,
+			tr.OutputArtifacts
+		FROM (
+			SELECT *
+			FROM TestResults@{FORCE_INDEX=TestResultsByTestPath}
+			WHERE STARTS_WITH(TestPath, @testPathPrefix)
+				AND CommitTimestamp >= @since
+			ORDER BY TestPath, CommitTimestamp DESC
+			LIMIT @scanLimit
+		) tr
+		JOIN Invocations inv ON tr.InvocationId = inv.InvocationId
+		WHERE %s
+		ORDER BY tr.CommitTimestamp DESC, tr.InvocationId, tr.TestPath, tr.ResultId
+		LIMIT @limit
+	`, strings.Join(conds, " AND ")))
+	st.Params = ToSpannerMap(params)
+
+	trs := make([]*pb.TestResult, 0, q.Limit)
+	var b Buffer
+	err := query(ctx, txn, st, func(row *spanner.Row) error {
+		tr, err := testResultFromRow(&b, row)
+		if err != nil {
+			return err
+		}
+		trs = append(trs, tr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return trs, nil
+}
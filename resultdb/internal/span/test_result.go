@@ -173,36 +173,12 @@ func QueryTestResults(ctx context.Context, txn *spanner.ReadOnlyTransaction, q T
 	}
 
 	trs = make([]*pb.TestResult, 0, q.PageSize)
-	var summaryMarkdown Snappy
 	var b Buffer
 	err = query(ctx, txn, st, func(row *spanner.Row) error {
-		var invID InvocationID
-		var maybeUnexpected spanner.NullBool
-		var micros int64
-		tr := &pb.TestResult{}
-		err = b.FromSpanner(row,
-			&invID,
-			&tr.TestPath,
-			&tr.ResultId,
-			&tr.Variant,
-			&maybeUnexpected,
-			&tr.Status,
-			&summaryMarkdown,
-			&tr.StartTime,
-			&micros,
-			&tr.Tags,
-			&tr.InputArtifacts,
-			&tr.OutputArtifacts,
-		)
+		tr, err := testResultFromRow(&b, row)
 		if err != nil {
 			return err
 		}
-
-		tr.Name = pbutil.TestResultName(string(invID), tr.TestPath, tr.ResultId)
-		tr.SummaryMarkdown = string(summaryMarkdown)
-		populateExpectedField(tr, maybeUnexpected)
-		populateDurationField(tr, micros)
-
 		trs = append(trs, tr)
 		return nil
 	})
@@ -221,6 +197,41 @@ func QueryTestResults(ctx context.Context, txn *spanner.ReadOnlyTransaction, q T
 	return
 }
 
+// testResultFromRow converts a row to a test result.
+// The row must have columns InvocationId, TestPath, ResultId, Variant,
+// IsUnexpected, Status, SummaryMarkdown, StartTime, RunDurationUsec, Tags,
+// InputArtifacts and OutputArtifacts, in this order.
+func testResultFromRow(b *Buffer, row *spanner.Row) (*pb.TestResult, error) {
+	var invID InvocationID
+	var maybeUnexpected spanner.NullBool
+	var summaryMarkdown Snappy
+	var micros int64
+	tr := &pb.TestResult{}
+	err := b.FromSpanner(row,
+		&invID,
+		&tr.TestPath,
+		&tr.ResultId,
+		&tr.Variant,
+		&maybeUnexpected,
+		&tr.Status,
+		&summaryMarkdown,
+		&tr.StartTime,
+		&micros,
+		&tr.Tags,
+		&tr.InputArtifacts,
+		&tr.OutputArtifacts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tr.Name = pbutil.TestResultName(string(invID), tr.TestPath, tr.ResultId)
+	tr.SummaryMarkdown = string(summaryMarkdown)
+	populateExpectedField(tr, maybeUnexpected)
+	populateDurationField(tr, micros)
+	return tr, nil
+}
+
 func populateDurationField(tr *pb.TestResult, micros int64) {
 	tr.Duration = FromMicros(micros)
 }
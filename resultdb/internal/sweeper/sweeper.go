@@ -0,0 +1,103 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sweeper finalizes invocations that are past their deadline.
+//
+// An invocation that is never finalized, e.g. because the bot that created it
+// died, would otherwise stay active forever and block processing of
+// invocations that include it, such as BigQuery exports.
+// Finalizing it makes the tasks of the invocation, and eventually of the
+// invocations including it, available to process.
+//
+// The sweeper finds expired invocations by their ActiveDeadline column,
+// see init_db.sql for how to backfill it in an existing database.
+package sweeper
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/resultdb/internal/span"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+)
+
+const (
+	// batchSize is the maximum number of invocations to finalize at a time.
+	batchSize = 100
+
+	// idleInterval is how long to wait before the next sweep if the last
+	// sweep finalized all expired invocations.
+	idleInterval = time.Minute
+)
+
+// Run finalizes invocations past their deadline until ctx is done.
+func Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := sweep(ctx)
+		if err != nil {
+			logging.Errorf(ctx, "failed to sweep expired invocations: %s", err)
+		}
+		if err != nil || n < batchSize {
+			clock.Sleep(ctx, idleInterval)
+		}
+	}
+}
+
+// sweep finalizes up to batchSize expired invocations with state INTERRUPTED.
+// Returns the number of expired invocations it found.
+func sweep(ctx context.Context) (int, error) {
+	ids, err := span.ReadExpiredInvocations(ctx, span.Client(ctx).Single(), clock.Now(ctx), batchSize)
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to read expired invocations").Err()
+	}
+
+	for id := range ids {
+		if err := finalizeExpiredInvocation(ctx, id); err != nil {
+			return len(ids), errors.Annotate(err, "failed to finalize %s", id.Name()).Err()
+		}
+	}
+	return len(ids), nil
+}
+
+// finalizeExpiredInvocation finalizes the invocation with state INTERRUPTED if
+// it is still active and past its deadline.
+func finalizeExpiredInvocation(ctx context.Context, id span.InvocationID) error {
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		var state pb.Invocation_State
+		var deadline time.Time
+		err := span.ReadInvocation(ctx, txn, id, map[string]interface{}{
+			"State":    &state,
+			"Deadline": &deadline,
+		})
+		switch {
+		case err != nil:
+			return err
+
+		case state != pb.Invocation_ACTIVE || deadline.After(clock.Now(ctx)):
+			// The invocation was finalized or its deadline was extended since
+			// we read the index.
+			return nil
+		}
+
+		logging.Infof(ctx, "interrupting %s: deadline %s exceeded", id.Name(), deadline)
+		return span.FinalizeInvocation(ctx, txn, id, true, span.DeadlineExceededReason, deadline)
+	})
+	return err
+}
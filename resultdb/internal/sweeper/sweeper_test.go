@@ -0,0 +1,158 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sweeper
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"go.chromium.org/luci/common/clock"
+
+	internalpb "go.chromium.org/luci/resultdb/internal/proto"
+	"go.chromium.org/luci/resultdb/internal/span"
+	"go.chromium.org/luci/resultdb/internal/testutil"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSweep(t *testing.T) {
+	Convey(`TestSweep`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		now := clock.Now(ctx)
+
+		// InsertInvocation sets the deadline to an hour after creation.
+		task := &internalpb.InvocationTask{
+			BigqueryExport: &pb.BigQueryExport{Project: "project", Dataset: "dataset", Table: "table"},
+		}
+		testutil.MustApply(ctx,
+			testutil.InsertInvocation("expired", pb.Invocation_ACTIVE, "", now.Add(-2*time.Hour)),
+			testutil.InsertInvocation("active", pb.Invocation_ACTIVE, "", now),
+			testutil.InsertInvocation("finalized", pb.Invocation_COMPLETED, "", now.Add(-2*time.Hour)),
+			span.InsertInvocationTask("expired", task, true),
+		)
+
+		readState := func(id span.InvocationID) (state pb.Invocation_State, activeDeadline spanner.NullTime, reason spanner.NullString) {
+			testutil.MustReadRow(ctx, "Invocations", id.Key(), map[string]interface{}{
+				"State":              &state,
+				"ActiveDeadline":     &activeDeadline,
+				"InterruptionReason": &reason,
+			})
+			return
+		}
+
+		n, err := sweep(ctx)
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, 1)
+
+		state, activeDeadline, reason := readState("expired")
+		So(state, ShouldEqual, pb.Invocation_INTERRUPTED)
+		So(activeDeadline.Valid, ShouldBeFalse)
+		So(reason.StringVal, ShouldEqual, span.DeadlineExceededReason)
+
+		var finalizeTime time.Time
+		testutil.MustReadRow(ctx, "Invocations", span.InvocationID("expired").Key(), map[string]interface{}{
+			"FinalizeTime": &finalizeTime,
+		})
+		So(finalizeTime, ShouldHappenWithin, time.Millisecond, now.Add(-time.Hour))
+
+		state, activeDeadline, _ = readState("active")
+		So(state, ShouldEqual, pb.Invocation_ACTIVE)
+		So(activeDeadline.Valid, ShouldBeTrue)
+
+		state, _, reason = readState("finalized")
+		So(state, ShouldEqual, pb.Invocation_COMPLETED)
+		So(reason.Valid, ShouldBeFalse)
+
+		// The tasks of the interrupted invocation are available to process.
+		keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+		So(err, ShouldBeNil)
+		So(keys, ShouldHaveLength, 1)
+		So(keys[0].InvocationID, ShouldEqual, span.InvocationID("expired"))
+
+		Convey(`does not find finalized invocations again`, func() {
+			n, err := sweep(ctx)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+		})
+
+		Convey(`skips invocations with extended deadlines`, func() {
+			// Simulate a deadline extension that happened after the index was read.
+			testutil.MustApply(ctx, span.UpdateMap("Invocations", map[string]interface{}{
+				"InvocationId":   span.InvocationID("active"),
+				"ActiveDeadline": now.Add(-time.Minute),
+			}))
+			n, err := sweep(ctx)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 1)
+
+			state, _, _ := readState("active")
+			So(state, ShouldEqual, pb.Invocation_ACTIVE)
+		})
+	})
+}
+
+func TestSweepIncluded(t *testing.T) {
+	Convey(`TestSweepIncluded`, t, func() {
+		ctx := testutil.SpannerTestContext(t)
+		now := clock.Now(ctx)
+
+		// "including" is finalized and has a BigQuery export, which waits for
+		// "included" to be finalized. "included" is never finalized by its
+		// client.
+		task := &internalpb.InvocationTask{
+			BigqueryExport: &pb.BigQueryExport{Project: "project", Dataset: "dataset", Table: "table"},
+		}
+		testutil.MustApply(ctx,
+			testutil.InsertInvocation("including", pb.Invocation_COMPLETED, "", now.Add(-2*time.Hour)),
+			testutil.InsertInvocation("included", pb.Invocation_ACTIVE, "", now.Add(-2*time.Hour)),
+			testutil.InsertInclusion("including", "included"),
+			span.InsertInvocationTask("including", task, false),
+		)
+
+		// readReachable reads the invocations that the export of "including"
+		// waits for.
+		readReachable := func() map[span.InvocationID]*pb.Invocation {
+			txn := span.Client(ctx).ReadOnlyTransaction()
+			defer txn.Close()
+			ids, err := span.ReadReachableInvocations(ctx, txn, 100, span.NewInvocationIDSet("including"))
+			So(err, ShouldBeNil)
+			invs, err := span.ReadInvocationsFull(ctx, txn, ids)
+			So(err, ShouldBeNil)
+			return invs
+		}
+		So(readReachable()["included"].State, ShouldEqual, pb.Invocation_ACTIVE)
+
+		n, err := sweep(ctx)
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, 1)
+
+		// All invocations reachable from "including" are finalized, so its
+		// export can proceed.
+		invs := readReachable()
+		So(invs, ShouldHaveLength, 2)
+		So(invs["including"].State, ShouldEqual, pb.Invocation_COMPLETED)
+		So(invs["including"].InterruptionReason, ShouldEqual, "")
+		So(invs["included"].State, ShouldEqual, pb.Invocation_INTERRUPTED)
+		So(invs["included"].InterruptionReason, ShouldEqual, span.DeadlineExceededReason)
+
+		keys, err := span.PeekInvocationTasks(ctx, span.Client(ctx).Single(), 10)
+		So(err, ShouldBeNil)
+		So(keys, ShouldHaveLength, 1)
+		So(keys[0].InvocationID, ShouldEqual, span.InvocationID("including"))
+	})
+}
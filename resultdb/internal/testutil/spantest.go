@@ -156,8 +156,10 @@ func spannerTestMain(m *testing.M) (exitCode int, err error) {
 // cleanupDatabase deletes all data from all tables.
 func cleanupDatabase(ctx context.Context, client *spanner.Client) error {
 	_, err := client.Apply(ctx, []*spanner.Mutation{
-		// All tables are interleaved in Invocations table.
+		// All other tables except InvocationTasks are interleaved in
+		// Invocations table.
 		spanner.Delete("Invocations", spanner.AllKeys()),
+		spanner.Delete("InvocationTasks", spanner.AllKeys()),
 	})
 	return err
 }
@@ -210,6 +212,9 @@ func InsertInvocation(id span.InvocationID, state pb.Invocation_State, updateTok
 		"CreateTime":                        ct,
 		"Deadline":                          ct.Add(time.Hour),
 	}
+	if state == pb.Invocation_ACTIVE {
+		values["ActiveDeadline"] = ct.Add(time.Hour)
+	}
 	if pbutil.IsFinalized(state) {
 		values["FinalizeTime"] = ct.Add(time.Hour)
 	}
@@ -109,3 +109,9 @@ func VariantHash(vr *typepb.Variant) string {
 	}
 	return hex.EncodeToString(h.Sum(nil)[:8])
 }
+
+// TestVariantKey returns a string that identifies a test variant, i.e. a test
+// path and a variant, e.g. to use as a map key.
+func TestVariantKey(testPath string, vr *typepb.Variant) string {
+	return testPath + "\n" + VariantHash(vr)
+}
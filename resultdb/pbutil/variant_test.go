@@ -76,4 +76,11 @@ func TestVariantUtils(t *testing.T) {
 		)
 		So(SortedVariantKeys(vr), ShouldResemble, []string{"k1", "k2", "k3"})
 	})
+
+	Convey(`Test variant keys identify test variants`, t, func() {
+		key := TestVariantKey("a/b", Variant("k1", "v1", "k2", "v2"))
+		So(TestVariantKey("a/b", Variant("k2", "v2", "k1", "v1")), ShouldEqual, key)
+		So(TestVariantKey("a/c", Variant("k1", "v1", "k2", "v2")), ShouldNotEqual, key)
+		So(TestVariantKey("a/b", Variant("k1", "v1")), ShouldNotEqual, key)
+	})
 }
@@ -92,6 +92,10 @@ type Invocation struct {
 	// Timestamp when the invocation will be forcefully finalized.
 	// Can be extended with UpdateInvocation until finalized.
 	Deadline *timestamp.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Why the server finalized the invocation with state INTERRUPTED, e.g.
+	// "deadline exceeded".
+	// Empty if the invocation is not INTERRUPTED or the client interrupted it.
+	InterruptionReason string `protobuf:"bytes,8,opt,name=interruption_reason,json=interruptionReason,proto3" json:"interruption_reason,omitempty"`
 	// Names of invocations included into this one. Overall results of this
 	// invocation is a UNION of results directly included into this invocation
 	// and results from the included invocations, recursively.
@@ -181,6 +185,13 @@ func (m *Invocation) GetDeadline() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Invocation) GetInterruptionReason() string {
+	if m != nil {
+		return m.InterruptionReason
+	}
+	return ""
+}
+
 func (m *Invocation) GetIncludedInvocations() []string {
 	if m != nil {
 		return m.IncludedInvocations
@@ -198,33 +209,34 @@ func init() {
 }
 
 var fileDescriptor_4005c8951497aaef = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8f, 0x93, 0x40,
-	0x1c, 0xc5, 0x65, 0x69, 0xab, 0xfd, 0xd7, 0xd5, 0x3a, 0xae, 0x09, 0xe9, 0x41, 0xc9, 0x1e, 0x0c,
-	0xa7, 0x19, 0x17, 0xe3, 0x5e, 0xf6, 0x44, 0x5b, 0x4c, 0x30, 0xba, 0x36, 0x94, 0xf5, 0xe0, 0xa5,
-	0x19, 0x86, 0x29, 0x3b, 0x09, 0x30, 0x64, 0x18, 0x9a, 0xe8, 0xa7, 0xed, 0xc9, 0xcf, 0x61, 0x80,
-	0xa5, 0x4d, 0x8c, 0xc9, 0xf6, 0xc8, 0xe3, 0xf7, 0xe6, 0xbd, 0x37, 0x19, 0xb8, 0x49, 0x25, 0x66,
-	0xf7, 0x4a, 0xe6, 0xa2, 0xce, 0xb1, 0x54, 0x29, 0xc9, 0x6a, 0x26, 0x88, 0xe2, 0x55, 0x9d, 0xe9,
-	0x24, 0x26, 0xa5, 0x92, 0x5a, 0x12, 0x55, 0x32, 0xb2, 0xbb, 0x22, 0xa2, 0xd8, 0x49, 0x46, 0xb5,
-	0x90, 0x05, 0x6e, 0x75, 0x74, 0xd1, 0xc0, 0xb8, 0x87, 0xb1, 0x2a, 0x19, 0xde, 0x5d, 0xcd, 0xde,
-	0xa5, 0x52, 0xa6, 0x19, 0x27, 0xb4, 0x14, 0x64, 0x2b, 0x78, 0x96, 0x6c, 0x62, 0x7e, 0x4f, 0x77,
-	0x42, 0xaa, 0xce, 0x76, 0x00, 0xda, 0xaf, 0xb8, 0xde, 0x12, 0x2d, 0x72, 0x5e, 0x69, 0x9a, 0x97,
-	0x0f, 0xc0, 0xa7, 0x53, 0x4a, 0xe9, 0x5f, 0x25, 0x27, 0x4c, 0xe6, 0x79, 0x5f, 0xe7, 0xf2, 0x8f,
-	0x09, 0x10, 0x1c, 0x3a, 0xa2, 0x19, 0x0c, 0x0a, 0x9a, 0x73, 0xcb, 0xb0, 0x0d, 0x67, 0x3c, 0x1f,
-	0xed, 0x3d, 0x73, 0xef, 0x0d, 0xc3, 0x56, 0x43, 0x1e, 0x0c, 0x2b, 0x4d, 0x35, 0xb7, 0xce, 0x6c,
-	0xc3, 0x79, 0xe1, 0xbe, 0xc7, 0xff, 0x5b, 0x82, 0x8f, 0x87, 0xe1, 0x75, 0x43, 0xcf, 0xcd, 0xbd,
-	0x67, 0x86, 0x9d, 0x13, 0x2d, 0x60, 0xc2, 0x14, 0xa7, 0x9a, 0x6f, 0x9a, 0xfa, 0x96, 0x69, 0x1b,
-	0xce, 0xc4, 0x9d, 0xe1, 0x6e, 0x1b, 0xee, 0xb7, 0xe1, 0xa8, 0xdf, 0x76, 0x68, 0x00, 0x9d, 0xad,
-	0xf9, 0x81, 0x5c, 0x18, 0x68, 0x9a, 0x56, 0xd6, 0xc0, 0x36, 0x9d, 0x89, 0xfb, 0xf6, 0x9f, 0x1a,
-	0xcd, 0x44, 0xbc, 0xd6, 0x4a, 0x14, 0xe9, 0x8a, 0x0a, 0x15, 0xb6, 0x2c, 0x5a, 0xc2, 0xf9, 0x56,
-	0x14, 0x34, 0x13, 0xbf, 0x1f, 0xa2, 0x87, 0x8f, 0x46, 0xb7, 0xbd, 0x9f, 0xf7, 0xae, 0x36, 0xf9,
-	0x1a, 0x9e, 0x25, 0x9c, 0x26, 0x99, 0x28, 0xb8, 0x35, 0x7a, 0xec, 0x80, 0xf0, 0xc0, 0xa2, 0x6b,
-	0xb8, 0x10, 0x05, 0xcb, 0xea, 0x84, 0x27, 0x9b, 0xe3, 0x83, 0xa8, 0xac, 0xa7, 0xb6, 0xe9, 0x8c,
-	0xbb, 0xa0, 0xd7, 0x3d, 0x70, 0xbc, 0xbf, 0xea, 0xf2, 0x0b, 0x0c, 0xdb, 0x3b, 0x44, 0x6f, 0xe0,
-	0xd5, 0x3a, 0xf2, 0x22, 0x7f, 0x73, 0x77, 0xbb, 0x5e, 0xf9, 0x8b, 0xe0, 0x73, 0xe0, 0x2f, 0xa7,
-	0x4f, 0x10, 0xc0, 0xc8, 0x5b, 0x44, 0xc1, 0x0f, 0x7f, 0x6a, 0xa0, 0x73, 0x18, 0x2f, 0xbe, 0x7f,
-	0x5b, 0x7d, 0xf5, 0x23, 0x7f, 0x39, 0x3d, 0x43, 0x2f, 0x61, 0x12, 0xdc, 0x46, 0x7e, 0x18, 0xde,
-	0xad, 0x1a, 0xc1, 0x9c, 0xbb, 0x3f, 0x3f, 0x9c, 0xfe, 0x6c, 0x6f, 0x54, 0xc9, 0xca, 0x38, 0x1e,
-	0xb5, 0xda, 0xc7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x64, 0x1a, 0x76, 0x2f, 0xf1, 0x02, 0x00,
-	0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe9, 0xd2, 0x96, 0xed, 0x95, 0x41, 0xf1, 0x86, 0x14, 0xf5, 0x00, 0xd1, 0x0e, 0xa8,
+	0x27, 0x9b, 0x05, 0xd8, 0x65, 0xa7, 0xb4, 0x0d, 0x52, 0x10, 0x8c, 0x2a, 0xcd, 0x38, 0x70, 0x89,
+	0x9c, 0xc4, 0xcd, 0x2c, 0x25, 0xb1, 0xe5, 0x38, 0x95, 0xe0, 0xb3, 0xf0, 0xe1, 0xfa, 0x51, 0x50,
+	0xdc, 0xa5, 0x45, 0x08, 0x69, 0x3b, 0xfa, 0xbd, 0xdf, 0xdf, 0xef, 0xff, 0x7f, 0x7a, 0x70, 0x9d,
+	0x0b, 0x9c, 0xde, 0x29, 0x51, 0xf2, 0xa6, 0xc4, 0x42, 0xe5, 0xa4, 0x68, 0x52, 0x4e, 0x14, 0xab,
+	0x9b, 0x42, 0x67, 0x09, 0x91, 0x4a, 0x68, 0x41, 0x94, 0x4c, 0xc9, 0xe6, 0x92, 0xf0, 0x6a, 0x23,
+	0x52, 0xaa, 0xb9, 0xa8, 0xb0, 0xa9, 0xa3, 0xf3, 0x16, 0xc6, 0x1d, 0x8c, 0x95, 0x4c, 0xf1, 0xe6,
+	0x72, 0xf2, 0x26, 0x17, 0x22, 0x2f, 0x18, 0xa1, 0x92, 0x93, 0x35, 0x67, 0x45, 0x16, 0x27, 0xec,
+	0x8e, 0x6e, 0xb8, 0x50, 0x3b, 0xd9, 0x1e, 0x30, 0xaf, 0xa4, 0x59, 0x13, 0xcd, 0x4b, 0x56, 0x6b,
+	0x5a, 0xca, 0x7b, 0xe0, 0xe3, 0x63, 0x4c, 0xe9, 0x9f, 0x92, 0x91, 0x54, 0x94, 0x65, 0x67, 0xe7,
+	0xe2, 0x77, 0x1f, 0x20, 0xd8, 0x7b, 0x44, 0x13, 0xe8, 0x57, 0xb4, 0x64, 0x76, 0xcf, 0xe9, 0x4d,
+	0x4f, 0x66, 0xc3, 0xad, 0x67, 0x6d, 0xbd, 0x41, 0x68, 0x6a, 0xc8, 0x83, 0x41, 0xad, 0xa9, 0x66,
+	0xf6, 0x91, 0xd3, 0x9b, 0x3e, 0x77, 0xdf, 0xe2, 0xff, 0x25, 0xc1, 0x87, 0xcf, 0xf0, 0xaa, 0xa5,
+	0x67, 0xd6, 0xd6, 0xb3, 0xc2, 0x9d, 0x12, 0xcd, 0x61, 0x94, 0x2a, 0x46, 0x35, 0x8b, 0x5b, 0xfb,
+	0xb6, 0xe5, 0xf4, 0xa6, 0x23, 0x77, 0x82, 0x77, 0xd9, 0x70, 0x97, 0x0d, 0x47, 0x5d, 0xb6, 0xbd,
+	0x03, 0xd8, 0xc9, 0xda, 0x06, 0x72, 0xa1, 0xaf, 0x69, 0x5e, 0xdb, 0x7d, 0xc7, 0x9a, 0x8e, 0xdc,
+	0xd7, 0xff, 0xd8, 0x68, 0x23, 0xe2, 0x95, 0x56, 0xbc, 0xca, 0x97, 0x94, 0xab, 0xd0, 0xb0, 0x68,
+	0x01, 0xa7, 0x6b, 0x5e, 0xd1, 0x82, 0xff, 0xba, 0x1f, 0x3d, 0x78, 0x70, 0xb4, 0xf1, 0xfd, 0xac,
+	0x53, 0x99, 0xc9, 0x57, 0x70, 0x9c, 0x31, 0x9a, 0x15, 0xbc, 0x62, 0xf6, 0xf0, 0xa1, 0x0f, 0xc2,
+	0x3d, 0x8b, 0x3e, 0xc0, 0x19, 0xaf, 0x34, 0x53, 0xaa, 0x91, 0xed, 0x62, 0x62, 0xc5, 0x68, 0x2d,
+	0x2a, 0xfb, 0xd8, 0x2c, 0xd9, 0xcc, 0x41, 0x7f, 0xf7, 0x43, 0xd3, 0x46, 0x57, 0x70, 0xce, 0xab,
+	0xb4, 0x68, 0x32, 0x96, 0xc5, 0x87, 0x33, 0xaa, 0xed, 0xa7, 0x8e, 0xd5, 0xc9, 0xce, 0x3a, 0xe0,
+	0xb0, 0xf5, 0xfa, 0xe2, 0x33, 0x0c, 0xcc, 0xe6, 0xd1, 0x2b, 0x78, 0xb9, 0x8a, 0xbc, 0xc8, 0x8f,
+	0x6f, 0x6f, 0x56, 0x4b, 0x7f, 0x1e, 0x7c, 0x0a, 0xfc, 0xc5, 0xf8, 0x09, 0x02, 0x18, 0x7a, 0xf3,
+	0x28, 0xf8, 0xee, 0x8f, 0x7b, 0xe8, 0x14, 0x4e, 0xe6, 0xdf, 0xbe, 0x2e, 0xbf, 0xf8, 0x91, 0xbf,
+	0x18, 0x1f, 0xa1, 0x17, 0x30, 0x0a, 0x6e, 0x22, 0x3f, 0x0c, 0x6f, 0x97, 0x6d, 0xc1, 0x9a, 0xb9,
+	0x3f, 0xde, 0x3d, 0xfe, 0xd8, 0xaf, 0x95, 0x4c, 0x65, 0x92, 0x0c, 0x4d, 0xed, 0xfd, 0x9f, 0x01,
+	0x00, 0x3d, 0xf7, 0x02, 0x5a, 0x27, 0x03, 0x00, 0x00,
 }
@@ -12,2940 +12,1533 @@ func init() {
 			"luci.resultdb.rpc.v1.Recorder", "luci.resultdb.rpc.v1.ResultDB",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 228, 189, 109, 148, 92, 201,
-			85, 32, 88, 239, 43, 43, 43, 234, 43, 43, 164, 238, 150, 82,
-			82, 43, 84, 146, 186, 170, 212, 89, 41, 181, 212, 159, 146, 219,
-			158, 84, 85, 74, 202, 118, 169, 178, 200, 204, 234, 15, 123, 218,
-			213, 175, 50, 35, 171, 94, 43, 243, 189, 244, 123, 47, 85, 93,
-			237, 129, 241, 177, 61, 152, 93, 220, 199, 102, 204, 120, 188, 96,
-			56, 24, 207, 224, 1, 60, 107, 198, 176, 204, 97, 119, 96, 7,
-			60, 195, 97, 109, 15, 12, 6, 108, 108, 12, 6, 108, 176, 89,
-			47, 198, 6, 206, 49, 6, 123, 123, 207, 189, 241, 241, 94, 102,
-			149, 186, 219, 31, 205, 252, 88, 157, 238, 83, 121, 227, 69, 196,
-			187, 113, 227, 198, 141, 123, 111, 220, 184, 143, 124, 244, 235, 38,
-			97, 91, 65, 176, 213, 225, 103, 123, 97, 16, 7, 155, 253, 246,
-			217, 22, 143, 154, 161, 215, 139, 131, 176, 136, 101, 116, 90, 212,
-			40, 170, 26, 179, 215, 201, 204, 21, 175, 195, 151, 117, 197, 58,
-			143, 233, 131, 196, 110, 123, 29, 126, 200, 96, 214, 252, 248, 249,
-			83, 197, 161, 70, 197, 193, 22, 107, 80, 92, 195, 22, 179, 159,
-			183, 201, 129, 125, 158, 82, 74, 108, 223, 237, 66, 143, 198, 252,
-			88, 13, 127, 211, 67, 100, 180, 231, 54, 111, 184, 91, 252, 144,
-			137, 197, 10, 164, 119, 18, 210, 226, 61, 238, 183, 184, 223, 220,
-			61, 100, 49, 107, 126, 172, 150, 42, 161, 119, 147, 153, 94, 127,
-			179, 227, 53, 55, 82, 213, 8, 179, 230, 157, 90, 78, 60, 88,
-			78, 42, 207, 145, 233, 29, 238, 222, 72, 87, 29, 199, 170, 83,
-			80, 156, 170, 184, 68, 38, 186, 60, 138, 220, 45, 190, 17, 239,
-			246, 248, 33, 27, 71, 207, 246, 140, 126, 120, 228, 227, 178, 85,
-			99, 183, 199, 105, 137, 140, 113, 191, 223, 21, 61, 56, 183, 160,
-			95, 217, 239, 119, 135, 123, 201, 66, 51, 217, 197, 104, 196, 195,
-			155, 94, 147, 31, 202, 96, 7, 115, 123, 58, 168, 139, 231, 195,
-			125, 168, 118, 116, 137, 140, 241, 103, 98, 238, 71, 94, 224, 31,
-			26, 197, 78, 78, 239, 51, 139, 188, 211, 26, 238, 34, 105, 71,
-			239, 39, 163, 65, 47, 246, 2, 63, 58, 148, 101, 198, 252, 248,
-			249, 163, 251, 50, 66, 85, 212, 169, 169, 202, 180, 66, 114, 81,
-			208, 15, 155, 124, 163, 25, 180, 248, 134, 231, 183, 131, 67, 99,
-			216, 193, 241, 189, 3, 193, 138, 75, 65, 139, 87, 252, 118, 80,
-			155, 138, 6, 96, 122, 59, 201, 68, 187, 126, 236, 62, 115, 104,
-			2, 57, 68, 66, 179, 191, 148, 33, 211, 47, 133, 197, 46, 17,
-			167, 13, 163, 60, 100, 126, 43, 52, 16, 109, 6, 137, 152, 249,
-			54, 137, 88, 34, 227, 62, 143, 98, 222, 18, 28, 97, 189, 68,
-			158, 34, 162, 209, 94, 150, 178, 191, 45, 150, 122, 156, 76, 107,
-			148, 54, 66, 215, 223, 82, 188, 121, 246, 197, 48, 41, 150, 85,
-			187, 26, 52, 171, 77, 241, 1, 152, 46, 19, 18, 248, 60, 104,
-			111, 180, 120, 179, 115, 40, 123, 11, 42, 85, 161, 202, 30, 42,
-			5, 162, 180, 217, 161, 15, 37, 172, 54, 122, 11, 78, 185, 46,
-			22, 217, 30, 110, 91, 39, 83, 33, 7, 190, 231, 45, 57, 178,
-			49, 68, 162, 248, 162, 35, 171, 201, 102, 98, 96, 147, 97, 26,
-			164, 39, 137, 46, 216, 64, 182, 34, 40, 133, 38, 84, 225, 170,
-			219, 229, 249, 103, 201, 212, 32, 121, 232, 65, 226, 68, 177, 27,
-			198, 200, 133, 78, 77, 0, 52, 71, 44, 238, 183, 80, 202, 57,
-			53, 248, 73, 255, 73, 50, 96, 11, 7, 124, 215, 222, 25, 29,
-			232, 121, 120, 220, 249, 7, 200, 228, 192, 0, 94, 234, 171, 103,
-			255, 25, 185, 109, 223, 174, 233, 227, 228, 96, 223, 247, 252, 152,
-			135, 189, 144, 3, 199, 138, 87, 29, 250, 194, 232, 45, 120, 110,
-			61, 93, 91, 244, 82, 59, 208, 223, 91, 120, 102, 44, 251, 197,
-			209, 220, 27, 223, 248, 198, 55, 154, 179, 239, 206, 144, 131, 251,
-			173, 153, 125, 151, 239, 237, 36, 227, 247, 187, 155, 60, 68, 34,
-			57, 53, 9, 209, 18, 113, 58, 238, 38, 239, 28, 178, 153, 49,
-			63, 117, 254, 238, 151, 180, 42, 139, 43, 208, 164, 38, 90, 210,
-			87, 18, 91, 138, 104, 232, 225, 204, 75, 235, 1, 214, 82, 13,
-			219, 209, 35, 100, 12, 254, 10, 222, 200, 32, 206, 89, 40, 0,
-			190, 160, 121, 146, 197, 101, 210, 226, 106, 107, 211, 48, 48, 86,
-			139, 183, 221, 126, 39, 222, 184, 233, 118, 250, 28, 25, 126, 172,
-			54, 33, 11, 31, 133, 50, 122, 156, 140, 139, 85, 229, 249, 45,
-			254, 12, 74, 79, 167, 38, 22, 90, 5, 74, 224, 245, 79, 71,
-			129, 175, 88, 19, 95, 1, 5, 248, 250, 7, 134, 5, 247, 177,
-			253, 135, 55, 204, 83, 179, 191, 104, 18, 27, 229, 197, 52, 25,
-			111, 60, 177, 86, 222, 88, 174, 174, 95, 94, 41, 231, 12, 58,
-			69, 8, 22, 92, 89, 169, 150, 26, 57, 83, 195, 149, 213, 198,
-			253, 247, 230, 44, 221, 96, 93, 20, 216, 233, 10, 23, 206, 231,
-			28, 154, 35, 19, 162, 131, 202, 227, 229, 229, 251, 239, 205, 101,
-			6, 75, 46, 156, 207, 141, 210, 73, 50, 134, 37, 151, 171, 213,
-			149, 92, 86, 247, 89, 111, 212, 42, 171, 87, 115, 99, 186, 207,
-			171, 181, 234, 250, 90, 142, 232, 30, 174, 151, 235, 245, 210, 213,
-			114, 110, 92, 215, 184, 252, 68, 163, 92, 207, 77, 12, 160, 117,
-			225, 124, 110, 82, 191, 162, 188, 186, 126, 61, 55, 69, 103, 200,
-			164, 120, 133, 66, 98, 122, 168, 232, 254, 123, 115, 185, 4, 17,
-			209, 203, 204, 64, 193, 253, 247, 230, 232, 236, 18, 113, 144, 187,
-			40, 37, 83, 43, 165, 203, 229, 149, 141, 234, 90, 163, 82, 93,
-			45, 173, 228, 140, 164, 172, 86, 254, 158, 245, 74, 173, 188, 156,
-			51, 211, 101, 107, 229, 82, 163, 188, 156, 179, 102, 155, 228, 224,
-			126, 114, 114, 223, 149, 145, 154, 98, 243, 22, 83, 140, 125, 237,
-			153, 226, 207, 153, 228, 192, 62, 123, 197, 190, 47, 121, 21, 113,
-			4, 139, 138, 221, 115, 97, 223, 77, 7, 25, 118, 207, 14, 138,
-			237, 210, 26, 132, 117, 11, 13, 2, 186, 216, 35, 211, 159, 220,
-			35, 211, 197, 182, 119, 255, 75, 217, 246, 176, 236, 91, 147, 237,
-			206, 62, 178, 253, 18, 153, 217, 211, 209, 75, 150, 177, 111, 54,
-			200, 161, 91, 17, 231, 69, 36, 157, 57, 32, 233, 46, 13, 83,
-			240, 196, 173, 39, 97, 207, 92, 191, 223, 32, 183, 239, 175, 41,
-			238, 139, 195, 43, 73, 166, 203, 227, 237, 64, 105, 75, 123, 183,
-			164, 235, 248, 120, 120, 178, 101, 171, 244, 38, 110, 221, 74, 221,
-			19, 216, 236, 193, 244, 127, 50, 201, 109, 251, 118, 190, 47, 162,
-			199, 8, 241, 252, 94, 63, 22, 26, 145, 16, 176, 99, 88, 130,
-			194, 11, 132, 103, 63, 214, 207, 45, 124, 78, 68, 17, 86, 120,
-			48, 65, 212, 70, 68, 239, 188, 197, 72, 247, 48, 230, 57, 146,
-			107, 118, 60, 238, 199, 27, 81, 28, 114, 183, 235, 249, 91, 184,
-			131, 100, 47, 58, 109, 183, 19, 241, 218, 180, 120, 92, 87, 79,
-			161, 5, 50, 80, 152, 106, 145, 25, 104, 33, 30, 235, 22, 179,
-			255, 114, 140, 140, 167, 244, 106, 122, 130, 76, 60, 237, 222, 116,
-			55, 148, 173, 36, 40, 49, 14, 101, 107, 210, 94, 58, 71, 14,
-			98, 149, 160, 31, 243, 112, 163, 217, 113, 163, 8, 137, 150, 197,
-			170, 20, 158, 85, 225, 209, 146, 122, 66, 239, 35, 7, 176, 69,
-			183, 223, 137, 189, 94, 135, 111, 128, 245, 22, 225, 78, 162, 49,
-			155, 129, 26, 215, 101, 5, 192, 40, 162, 203, 228, 24, 54, 219,
-			226, 62, 15, 221, 152, 111, 240, 215, 247, 221, 78, 180, 225, 250,
-			173, 141, 109, 55, 218, 62, 116, 16, 58, 184, 108, 30, 50, 106,
-			135, 161, 226, 85, 89, 175, 140, 213, 74, 126, 235, 154, 27, 109,
-			211, 139, 228, 118, 236, 37, 138, 67, 207, 223, 218, 104, 110, 243,
-			230, 141, 141, 126, 220, 126, 240, 208, 145, 244, 251, 17, 195, 58,
-			214, 89, 130, 42, 235, 113, 251, 65, 90, 39, 19, 48, 25, 93,
-			239, 89, 190, 209, 14, 66, 220, 26, 167, 246, 17, 77, 41, 10,
-			22, 171, 178, 193, 245, 160, 197, 47, 58, 245, 181, 114, 121, 185,
-			54, 174, 122, 185, 18, 132, 192, 80, 91, 129, 38, 240, 184, 96,
-			168, 173, 64, 145, 247, 62, 114, 160, 217, 20, 99, 246, 154, 27,
-			210, 198, 138, 14, 229, 6, 136, 213, 108, 94, 21, 21, 36, 143,
-			71, 244, 33, 114, 91, 66, 172, 116, 195, 153, 61, 163, 28, 110,
-			122, 31, 57, 208, 219, 221, 219, 144, 14, 188, 177, 183, 59, 220,
-			236, 1, 114, 176, 183, 221, 219, 219, 238, 76, 186, 29, 237, 109,
-			247, 134, 27, 158, 70, 131, 59, 228, 77, 55, 230, 173, 67, 119,
-			164, 171, 167, 30, 208, 179, 36, 215, 108, 110, 112, 223, 221, 236,
-			240, 13, 55, 228, 190, 27, 29, 58, 158, 174, 60, 213, 108, 150,
-			241, 105, 9, 31, 210, 51, 100, 38, 216, 124, 186, 41, 88, 114,
-			163, 23, 242, 182, 247, 204, 161, 83, 72, 223, 105, 120, 128, 12,
-			185, 134, 197, 116, 129, 228, 154, 209, 182, 27, 246, 80, 38, 71,
-			61, 183, 201, 15, 157, 22, 85, 69, 249, 170, 42, 134, 37, 17,
-			237, 120, 237, 88, 245, 56, 39, 150, 4, 150, 201, 222, 230, 73,
-			14, 72, 49, 240, 226, 121, 172, 54, 213, 219, 238, 165, 223, 123,
-			146, 76, 66, 205, 228, 165, 11, 66, 33, 235, 109, 167, 222, 120,
-			47, 185, 29, 42, 117, 121, 236, 182, 220, 216, 77, 213, 46, 96,
-			109, 160, 251, 117, 249, 112, 0, 207, 176, 191, 185, 171, 57, 107,
-			81, 224, 9, 101, 138, 183, 94, 54, 165, 123, 246, 34, 153, 72,
-			51, 62, 29, 35, 130, 245, 115, 6, 104, 65, 75, 213, 101, 208,
-			95, 94, 83, 206, 153, 160, 71, 173, 84, 26, 229, 141, 218, 250,
-			106, 163, 114, 189, 156, 179, 82, 10, 251, 35, 118, 246, 174, 220,
-			220, 236, 111, 155, 100, 106, 208, 2, 163, 175, 32, 119, 40, 119,
-			73, 196, 227, 141, 29, 47, 196, 21, 217, 117, 197, 238, 168, 121,
-			226, 160, 172, 85, 231, 241, 99, 94, 8, 235, 173, 235, 198, 116,
-			133, 28, 247, 131, 141, 40, 118, 253, 150, 27, 182, 54, 18, 71,
-			213, 134, 219, 108, 242, 40, 10, 196, 78, 168, 123, 57, 234, 7,
-			117, 89, 57, 217, 34, 74, 178, 234, 16, 255, 90, 183, 226, 223,
-			35, 100, 172, 235, 246, 54, 184, 31, 135, 187, 168, 119, 103, 107,
-			217, 174, 219, 43, 3, 252, 143, 98, 254, 60, 98, 103, 179, 185,
-			177, 71, 236, 236, 88, 142, 204, 254, 169, 69, 38, 210, 122, 56,
-			152, 53, 77, 220, 178, 12, 20, 106, 39, 95, 80, 107, 47, 46,
-			193, 94, 118, 49, 35, 180, 227, 154, 104, 9, 122, 4, 48, 27,
-			23, 218, 72, 182, 38, 33, 122, 149, 100, 158, 142, 176, 239, 12,
-			246, 189, 159, 79, 47, 213, 247, 35, 117, 236, 124, 236, 145, 250,
-			198, 106, 181, 118, 189, 180, 82, 147, 205, 233, 97, 98, 119, 220,
-			103, 119, 7, 119, 61, 44, 122, 169, 147, 112, 152, 216, 59, 220,
-			189, 49, 184, 215, 96, 209, 203, 184, 24, 206, 18, 7, 233, 69,
-			9, 145, 20, 203, 141, 208, 44, 177, 151, 170, 53, 88, 16, 57,
-			50, 33, 74, 55, 214, 42, 229, 165, 114, 206, 156, 189, 143, 100,
-			4, 17, 96, 177, 104, 50, 228, 70, 36, 40, 251, 48, 212, 211,
-			245, 235, 151, 203, 181, 156, 57, 56, 213, 118, 206, 153, 141, 200,
-			68, 90, 17, 255, 199, 49, 178, 255, 179, 65, 198, 83, 138, 53,
-			104, 68, 110, 167, 19, 236, 108, 184, 29, 207, 141, 36, 107, 16,
-			44, 42, 65, 201, 75, 157, 186, 127, 164, 37, 226, 228, 50, 179,
-			63, 110, 144, 220, 176, 102, 59, 132, 166, 241, 63, 18, 205, 217,
-			247, 24, 100, 106, 80, 157, 29, 66, 239, 196, 255, 80, 244, 254,
-			216, 36, 147, 3, 74, 236, 75, 197, 238, 245, 100, 198, 107, 241,
-			110, 47, 136, 185, 223, 220, 221, 232, 240, 155, 188, 115, 104, 22,
-			133, 198, 94, 103, 225, 192, 27, 138, 149, 164, 221, 10, 52, 187,
-			120, 160, 178, 92, 190, 190, 86, 109, 148, 87, 151, 158, 216, 88,
-			95, 125, 245, 106, 245, 177, 213, 90, 206, 27, 170, 246, 50, 46,
-			251, 53, 146, 27, 70, 138, 222, 65, 246, 67, 43, 55, 66, 15,
-			144, 233, 213, 234, 70, 189, 178, 92, 222, 40, 95, 185, 82, 94,
-			106, 212, 133, 227, 67, 215, 110, 12, 44, 240, 217, 31, 177, 200,
-			129, 125, 48, 161, 37, 105, 178, 8, 43, 106, 241, 165, 96, 95,
-			4, 157, 97, 205, 13, 99, 105, 225, 44, 16, 160, 146, 31, 123,
-			109, 143, 135, 210, 79, 36, 236, 152, 233, 164, 92, 184, 138, 10,
-			132, 246, 130, 200, 139, 189, 155, 124, 195, 243, 149, 83, 9, 236,
-			26, 187, 150, 83, 79, 42, 126, 172, 107, 251, 124, 203, 29, 170,
-			13, 194, 220, 170, 229, 212, 19, 93, 251, 4, 153, 104, 5, 125,
-			80, 246, 68, 61, 216, 59, 140, 218, 184, 40, 211, 85, 164, 26,
-			159, 120, 179, 38, 106, 227, 162, 76, 84, 153, 35, 211, 238, 214,
-			86, 8, 157, 171, 142, 132, 97, 50, 165, 139, 177, 98, 254, 17,
-			146, 85, 116, 128, 173, 26, 40, 177, 209, 19, 214, 182, 57, 63,
-			86, 203, 250, 234, 225, 9, 50, 225, 69, 27, 137, 115, 222, 100,
-			230, 124, 182, 54, 238, 69, 218, 177, 57, 251, 126, 147, 76, 13,
-			30, 46, 208, 101, 146, 237, 4, 77, 23, 89, 75, 156, 108, 205,
-			191, 200, 121, 68, 113, 69, 214, 175, 233, 150, 249, 223, 52, 72,
-			86, 21, 211, 219, 137, 221, 115, 227, 109, 236, 206, 185, 108, 230,
-			140, 26, 194, 80, 30, 245, 92, 31, 89, 64, 150, 3, 12, 243,
-			218, 225, 110, 11, 173, 158, 160, 219, 229, 126, 28, 169, 121, 149,
-			229, 75, 178, 152, 222, 77, 102, 226, 208, 245, 58, 3, 117, 109,
-			172, 155, 83, 15, 116, 229, 139, 228, 176, 234, 183, 197, 99, 183,
-			185, 205, 91, 73, 163, 12, 122, 55, 238, 144, 21, 150, 229, 115,
-			213, 118, 246, 183, 13, 50, 163, 236, 180, 150, 38, 214, 117, 66,
-			92, 223, 15, 226, 52, 185, 246, 178, 242, 158, 118, 197, 146, 110,
-			84, 75, 117, 144, 239, 18, 146, 60, 185, 37, 217, 142, 147, 113,
-			121, 114, 132, 199, 143, 194, 178, 39, 162, 8, 12, 58, 122, 144,
-			56, 155, 124, 203, 243, 165, 63, 88, 0, 202, 255, 98, 107, 255,
-			203, 229, 183, 27, 228, 64, 51, 232, 14, 227, 123, 57, 55, 228,
-			94, 136, 174, 25, 175, 121, 229, 150, 23, 111, 247, 55, 139, 205,
-			160, 123, 118, 43, 232, 184, 254, 86, 114, 126, 138, 63, 154, 139,
-			91, 220, 95, 220, 10, 82, 167, 169, 151, 146, 159, 127, 103, 24,
-			63, 105, 90, 87, 215, 46, 255, 140, 153, 191, 42, 94, 183, 166,
-			200, 83, 227, 237, 14, 111, 194, 144, 31, 249, 192, 175, 152, 100,
-			148, 58, 115, 35, 127, 157, 49, 200, 207, 76, 19, 99, 130, 90,
-			115, 35, 244, 252, 175, 78, 48, 172, 222, 12, 58, 236, 114, 191,
-			221, 230, 97, 196, 22, 153, 232, 104, 46, 98, 96, 79, 48, 20,
-			23, 205, 109, 215, 223, 226, 76, 104, 216, 132, 45, 5, 189, 221,
-			208, 219, 218, 142, 217, 249, 115, 231, 30, 148, 13, 88, 197, 111,
-			22, 25, 43, 117, 58, 12, 159, 69, 76, 185, 180, 138, 132, 109,
-			199, 113, 47, 186, 120, 246, 108, 11, 164, 96, 208, 227, 97, 164,
-			200, 3, 35, 239, 73, 36, 22, 55, 5, 18, 103, 9, 97, 53,
-			222, 242, 96, 33, 111, 246, 97, 8, 204, 245, 91, 172, 31, 113,
-			230, 249, 76, 76, 9, 150, 108, 122, 190, 27, 238, 34, 94, 81,
-			129, 237, 120, 241, 54, 11, 66, 252, 27, 244, 99, 194, 186, 65,
-			203, 107, 123, 98, 185, 20, 152, 27, 114, 214, 227, 97, 215, 139,
-			99, 222, 98, 189, 48, 184, 233, 181, 120, 139, 197, 219, 110, 204,
-			226, 109, 24, 29, 232, 36, 158, 191, 197, 154, 129, 223, 242, 112,
-			91, 129, 70, 132, 117, 121, 124, 145, 16, 6, 255, 206, 12, 33,
-			22, 177, 160, 173, 48, 106, 6, 45, 206, 186, 253, 40, 102, 33,
-			143, 93, 207, 199, 94, 221, 205, 224, 38, 60, 146, 20, 35, 204,
-			15, 98, 175, 201, 11, 44, 222, 246, 34, 214, 241, 162, 24, 122,
-			72, 191, 209, 111, 13, 161, 211, 242, 162, 102, 199, 245, 186, 60,
-			44, 222, 10, 9, 207, 79, 211, 66, 33, 209, 11, 131, 86, 191,
-			201, 19, 60, 72, 130, 200, 119, 132, 7, 97, 114, 116, 173, 160,
-			217, 135, 149, 236, 170, 73, 58, 27, 132, 44, 136, 183, 121, 200,
-			186, 110, 204, 67, 207, 237, 68, 9, 169, 113, 130, 226, 109, 78,
-			88, 26, 123, 61, 168, 85, 238, 97, 75, 232, 24, 196, 45, 32,
-			148, 230, 45, 63, 72, 158, 33, 221, 189, 56, 130, 17, 249, 162,
-			171, 32, 140, 88, 215, 221, 101, 155, 28, 56, 165, 197, 226, 128,
-			113, 191, 21, 132, 17, 7, 166, 232, 133, 65, 55, 136, 57, 19,
-			52, 137, 35, 214, 226, 161, 119, 147, 183, 88, 59, 12, 186, 68,
-			80, 33, 10, 218, 241, 14, 176, 137, 228, 32, 22, 245, 120, 19,
-			56, 136, 245, 66, 15, 24, 43, 4, 222, 241, 5, 23, 69, 17,
-			226, 78, 88, 227, 90, 165, 206, 234, 213, 43, 141, 199, 74, 181,
-			50, 171, 212, 217, 90, 173, 250, 104, 101, 185, 188, 204, 46, 63,
-			193, 26, 215, 202, 108, 169, 186, 246, 68, 173, 114, 245, 90, 131,
-			93, 171, 174, 44, 151, 107, 117, 86, 90, 93, 102, 75, 213, 213,
-			70, 173, 114, 121, 189, 81, 173, 213, 9, 155, 45, 213, 89, 165,
-			62, 139, 79, 74, 171, 79, 176, 242, 227, 107, 181, 114, 189, 206,
-			170, 53, 86, 185, 190, 182, 82, 41, 47, 179, 199, 74, 181, 90,
-			105, 181, 81, 41, 215, 11, 172, 178, 186, 180, 178, 190, 92, 89,
-			189, 90, 96, 151, 215, 27, 108, 181, 218, 32, 108, 165, 114, 189,
-			210, 40, 47, 179, 70, 181, 128, 175, 221, 219, 142, 85, 175, 176,
-			235, 229, 218, 210, 181, 210, 106, 163, 116, 185, 178, 82, 105, 60,
-			129, 47, 188, 82, 105, 172, 194, 203, 174, 84, 107, 132, 149, 216,
-			90, 169, 214, 168, 44, 173, 175, 148, 106, 108, 109, 189, 182, 86,
-			173, 151, 25, 140, 108, 185, 82, 95, 90, 41, 85, 174, 151, 151,
-			139, 172, 178, 202, 86, 171, 172, 252, 104, 121, 181, 193, 234, 215,
-			74, 43, 43, 131, 3, 37, 172, 250, 216, 106, 185, 6, 216, 167,
-			135, 201, 46, 151, 217, 74, 165, 116, 121, 165, 12, 175, 194, 113,
-			46, 87, 106, 229, 165, 6, 12, 40, 249, 181, 84, 89, 46, 175,
-			54, 74, 43, 5, 194, 234, 107, 229, 165, 74, 105, 165, 192, 202,
-			143, 151, 175, 175, 173, 148, 106, 79, 20, 100, 167, 245, 242, 247,
-			172, 151, 87, 27, 149, 210, 10, 91, 46, 93, 47, 93, 45, 215,
-			217, 252, 139, 81, 101, 173, 86, 93, 90, 175, 149, 175, 3, 214,
-			213, 43, 172, 190, 126, 185, 222, 168, 52, 214, 27, 101, 118, 181,
-			90, 93, 70, 98, 215, 203, 181, 71, 43, 75, 229, 250, 37, 182,
-			82, 173, 35, 193, 214, 235, 229, 2, 97, 203, 165, 70, 9, 95,
-			189, 86, 171, 94, 169, 52, 234, 151, 224, 247, 229, 245, 122, 5,
-			9, 87, 89, 109, 148, 107, 181, 117, 60, 118, 89, 96, 215, 170,
-			143, 149, 31, 45, 215, 216, 82, 105, 189, 94, 94, 70, 10, 87,
-			87, 97, 180, 192, 43, 229, 106, 237, 9, 232, 22, 232, 128, 51,
-			80, 96, 143, 93, 43, 55, 174, 149, 107, 64, 84, 164, 86, 9,
-			200, 0, 70, 222, 82, 35, 93, 173, 90, 99, 141, 106, 173, 65,
-			82, 227, 100, 171, 229, 171, 43, 149, 171, 229, 213, 165, 50, 60,
-			174, 66, 55, 143, 85, 234, 229, 5, 86, 170, 85, 234, 80, 161,
-			130, 47, 102, 143, 149, 158, 96, 213, 117, 28, 53, 76, 212, 122,
-			189, 76, 196, 239, 20, 235, 22, 112, 62, 89, 229, 10, 43, 45,
-			63, 90, 1, 204, 101, 237, 181, 106, 189, 94, 145, 236, 130, 100,
-			91, 186, 38, 105, 94, 36, 231, 255, 192, 100, 165, 126, 188, 29,
-			132, 23, 217, 13, 238, 199, 129, 255, 79, 18, 193, 206, 230, 95,
-			141, 69, 236, 81, 55, 108, 185, 11, 132, 177, 203, 46, 172, 204,
-			192, 103, 65, 232, 109, 121, 190, 219, 217, 187, 1, 181, 120, 228,
-			109, 249, 108, 115, 151, 48, 86, 119, 253, 167, 221, 93, 118, 117,
-			155, 119, 221, 29, 55, 46, 176, 71, 120, 187, 205, 150, 185, 11,
-			226, 220, 111, 9, 73, 19, 225, 34, 220, 230, 76, 250, 122, 34,
-			33, 156, 188, 136, 193, 70, 206, 196, 110, 185, 41, 164, 96, 139,
-			183, 61, 95, 10, 184, 118, 208, 247, 91, 80, 87, 108, 209, 88,
-			59, 42, 194, 2, 184, 233, 118, 188, 86, 186, 152, 53, 93, 31,
-			228, 74, 28, 186, 126, 212, 1, 157, 131, 181, 188, 144, 55, 227,
-			206, 46, 136, 25, 151, 237, 19, 118, 68, 180, 20, 113, 253, 93,
-			41, 19, 61, 95, 108, 161, 32, 44, 231, 121, 113, 171, 168, 235,
-			132, 66, 65, 2, 145, 198, 188, 110, 47, 8, 227, 104, 161, 72,
-			72, 150, 24, 38, 181, 22, 178, 119, 192, 175, 44, 181, 206, 140,
-			172, 147, 49, 98, 102, 199, 197, 79, 81, 120, 247, 72, 1, 11,
-			13, 241, 83, 20, 22, 70, 238, 193, 66, 249, 83, 20, 46, 142,
-			60, 128, 133, 167, 197, 79, 81, 88, 28, 57, 129, 133, 167, 196,
-			79, 81, 120, 118, 228, 56, 22, 30, 23, 63, 69, 225, 133, 145,
-			163, 228, 159, 19, 51, 59, 134, 63, 243, 49, 27, 14, 241, 18,
-			27, 207, 38, 103, 202, 193, 221, 130, 253, 8, 196, 40, 111, 177,
-			77, 222, 116, 97, 11, 15, 181, 98, 178, 184, 9, 236, 64, 152,
-			219, 217, 10, 66, 47, 222, 238, 70, 172, 21, 248, 115, 49, 219,
-			9, 194, 27, 172, 213, 7, 53, 158, 109, 6, 65, 28, 197, 161,
-			219, 235, 121, 254, 86, 145, 144, 167, 137, 105, 143, 80, 251, 129,
-			145, 135, 140, 252, 235, 112, 222, 149, 250, 192, 154, 65, 183, 231,
-			117, 120, 136, 211, 37, 206, 92, 246, 204, 77, 157, 199, 184, 101,
-			184, 158, 15, 189, 3, 83, 8, 220, 137, 96, 0, 230, 197, 172,
-			231, 134, 17, 176, 2, 33, 196, 178, 71, 12, 106, 61, 144, 61,
-			76, 198, 137, 109, 143, 152, 35, 212, 122, 208, 156, 39, 19, 196,
-			1, 192, 6, 136, 40, 40, 67, 173, 7, 199, 239, 84, 144, 65,
-			173, 7, 143, 159, 84, 144, 69, 173, 7, 239, 154, 35, 103, 137,
-			105, 27, 212, 126, 197, 200, 19, 70, 254, 36, 91, 150, 172, 25,
-			49, 23, 113, 239, 240, 152, 167, 217, 78, 98, 96, 24, 212, 122,
-			69, 246, 8, 121, 136, 216, 182, 1, 24, 60, 108, 30, 153, 45,
-			8, 198, 132, 189, 176, 192, 66, 222, 65, 227, 9, 152, 49, 12,
-			130, 56, 165, 148, 196, 33, 231, 2, 67, 3, 241, 125, 216, 212,
-			144, 67, 173, 135, 199, 103, 20, 100, 80, 235, 97, 122, 187, 130,
-			44, 106, 61, 124, 56, 79, 206, 224, 43, 13, 106, 189, 210, 188,
-			115, 246, 24, 67, 150, 157, 109, 7, 193, 108, 1, 255, 20, 55,
-			221, 112, 182, 192, 120, 220, 44, 170, 94, 13, 27, 42, 107, 200,
-			161, 214, 43, 245, 59, 96, 32, 175, 164, 135, 21, 100, 81, 235,
-			149, 71, 143, 145, 123, 241, 29, 38, 181, 74, 230, 137, 252, 28,
-			91, 85, 187, 187, 156, 14, 92, 12, 192, 62, 187, 201, 162, 214,
-			111, 51, 109, 104, 166, 33, 135, 90, 37, 253, 54, 64, 187, 68,
-			143, 42, 200, 162, 86, 233, 56, 35, 223, 131, 111, 179, 168, 181,
-			100, 206, 231, 151, 25, 70, 52, 136, 247, 1, 43, 136, 0, 190,
-			228, 165, 18, 7, 169, 236, 232, 112, 61, 161, 47, 161, 74, 165,
-			81, 177, 108, 232, 83, 67, 14, 181, 150, 198, 115, 10, 50, 168,
-			181, 52, 51, 171, 32, 120, 251, 233, 57, 242, 44, 162, 98, 83,
-			235, 138, 121, 87, 190, 59, 140, 202, 14, 119, 111, 188, 52, 68,
-			138, 132, 93, 9, 66, 169, 42, 45, 162, 194, 14, 146, 181, 235,
-			109, 133, 66, 212, 4, 126, 103, 183, 200, 150, 3, 208, 249, 64,
-			55, 210, 56, 219, 248, 114, 13, 57, 212, 186, 162, 113, 182, 13,
-			106, 93, 153, 97, 10, 178, 168, 117, 229, 228, 105, 114, 63, 226,
-			236, 80, 171, 98, 22, 242, 11, 168, 237, 199, 65, 111, 17, 61,
-			53, 3, 210, 53, 45, 131, 245, 251, 28, 27, 26, 106, 40, 67,
-			173, 202, 120, 94, 65, 6, 181, 42, 71, 230, 20, 100, 81, 171,
-			114, 230, 110, 92, 117, 134, 153, 161, 214, 35, 230, 162, 124, 148,
-			177, 1, 82, 157, 100, 224, 153, 92, 117, 134, 153, 49, 168, 245,
-			200, 241, 121, 5, 89, 212, 122, 228, 238, 130, 236, 100, 148, 90,
-			175, 54, 139, 242, 209, 168, 13, 144, 234, 100, 52, 67, 173, 87,
-			143, 159, 80, 144, 65, 173, 87, 207, 46, 40, 200, 162, 214, 171,
-			11, 139, 178, 147, 44, 181, 86, 116, 39, 89, 27, 32, 213, 73,
-			54, 67, 173, 149, 241, 227, 10, 50, 168, 181, 194, 84, 39, 89,
-			139, 90, 43, 186, 147, 49, 106, 173, 154, 39, 229, 163, 49, 27,
-			32, 213, 201, 88, 134, 90, 171, 227, 106, 25, 142, 25, 212, 90,
-			189, 67, 13, 110, 204, 162, 214, 234, 137, 89, 242, 183, 6, 246,
-			66, 168, 213, 48, 207, 230, 255, 220, 96, 13, 65, 104, 222, 105,
-			41, 209, 22, 49, 225, 198, 114, 59, 3, 123, 142, 187, 9, 123,
-			13, 176, 144, 222, 127, 83, 182, 75, 145, 176, 39, 130, 62, 234,
-			208, 145, 219, 230, 157, 93, 22, 242, 46, 88, 47, 56, 145, 220,
-			143, 189, 144, 203, 215, 168, 109, 107, 219, 13, 187, 32, 70, 195,
-			190, 31, 123, 93, 78, 88, 187, 239, 55, 197, 139, 189, 120, 87,
-			177, 114, 178, 77, 68, 108, 113, 17, 139, 210, 88, 121, 17, 243,
-			57, 111, 161, 98, 208, 217, 197, 157, 95, 218, 137, 96, 90, 176,
-			56, 8, 58, 145, 102, 33, 98, 195, 176, 53, 148, 161, 86, 99,
-			92, 73, 20, 98, 80, 171, 145, 63, 163, 32, 139, 90, 141, 197,
-			34, 121, 18, 169, 53, 78, 173, 199, 205, 59, 243, 107, 184, 99,
-			136, 72, 78, 189, 232, 83, 2, 87, 60, 238, 247, 228, 178, 67,
-			143, 17, 90, 129, 108, 22, 171, 157, 159, 69, 197, 67, 0, 23,
-			102, 53, 90, 227, 54, 244, 175, 33, 135, 90, 143, 107, 65, 52,
-			110, 80, 235, 113, 122, 72, 65, 22, 181, 30, 63, 114, 140, 204,
-			17, 211, 54, 169, 253, 79, 71, 254, 153, 145, 63, 50, 176, 21,
-			72, 61, 134, 197, 187, 61, 181, 5, 128, 44, 251, 167, 217, 59,
-			144, 127, 76, 216, 2, 158, 52, 143, 96, 127, 38, 10, 245, 39,
-			229, 155, 77, 20, 234, 79, 202, 55, 155, 40, 212, 159, 148, 66,
-			221, 68, 161, 254, 228, 225, 188, 236, 196, 160, 214, 134, 121, 70,
-			62, 2, 169, 189, 161, 59, 49, 50, 212, 218, 144, 156, 108, 162,
-			212, 222, 96, 167, 21, 100, 81, 107, 99, 126, 65, 118, 98, 82,
-			235, 41, 185, 28, 76, 20, 198, 79, 233, 78, 96, 209, 62, 165,
-			59, 129, 215, 61, 37, 151, 131, 137, 194, 248, 41, 185, 28, 16,
-			216, 52, 239, 150, 143, 64, 140, 110, 234, 78, 172, 12, 181, 54,
-			165, 136, 48, 81, 140, 110, 30, 185, 75, 65, 208, 110, 225, 140,
-			236, 196, 166, 86, 83, 138, 8, 19, 229, 90, 83, 119, 98, 103,
-			168, 213, 148, 34, 194, 68, 185, 214, 148, 34, 194, 68, 185, 214,
-			188, 187, 64, 38, 160, 19, 107, 132, 218, 220, 124, 218, 18, 207,
-			44, 160, 30, 39, 135, 200, 36, 201, 0, 4, 100, 111, 219, 199,
-			200, 20, 25, 21, 160, 13, 240, 68, 2, 59, 212, 106, 79, 210,
-			4, 54, 168, 213, 62, 112, 40, 129, 45, 106, 181, 143, 28, 213,
-			221, 25, 212, 218, 178, 143, 232, 199, 48, 5, 91, 169, 238, 96,
-			235, 220, 74, 117, 7, 211, 176, 117, 224, 246, 4, 182, 168, 181,
-			117, 56, 175, 187, 51, 169, 229, 217, 103, 245, 99, 32, 136, 151,
-			234, 14, 166, 195, 155, 156, 77, 96, 131, 90, 222, 201, 51, 9,
-			108, 81, 203, 91, 44, 74, 106, 58, 212, 186, 161, 231, 21, 164,
-			246, 13, 77, 77, 144, 218, 55, 228, 146, 51, 81, 106, 223, 200,
-			171, 121, 5, 169, 125, 67, 207, 107, 134, 90, 93, 243, 172, 124,
-			4, 82, 187, 171, 59, 1, 169, 221, 213, 204, 1, 82, 187, 203,
-			20, 47, 130, 212, 238, 106, 76, 70, 169, 21, 152, 106, 202, 65,
-			106, 7, 186, 19, 144, 218, 129, 198, 4, 164, 118, 144, 63, 161,
-			32, 139, 90, 193, 169, 211, 228, 103, 12, 156, 88, 131, 218, 177,
-			185, 99, 229, 127, 212, 96, 24, 76, 5, 75, 95, 185, 167, 88,
-			236, 110, 49, 17, 242, 20, 21, 89, 109, 159, 82, 20, 137, 176,
-			119, 42, 215, 2, 136, 40, 20, 132, 17, 11, 66, 166, 125, 193,
-			12, 227, 197, 244, 54, 29, 185, 93, 109, 148, 164, 58, 150, 149,
-			186, 238, 46, 58, 131, 88, 112, 147, 135, 29, 183, 39, 69, 137,
-			105, 193, 68, 199, 228, 14, 114, 4, 39, 22, 21, 190, 190, 125,
-			108, 118, 130, 85, 252, 102, 167, 31, 121, 168, 115, 136, 105, 19,
-			42, 93, 95, 79, 179, 80, 234, 250, 154, 107, 132, 90, 215, 215,
-			76, 40, 20, 187, 254, 145, 163, 186, 115, 131, 90, 55, 237, 35,
-			179, 19, 172, 252, 204, 222, 206, 129, 37, 111, 166, 58, 7, 150,
-			188, 153, 234, 28, 48, 189, 169, 89, 82, 104, 116, 55, 181, 128,
-			201, 82, 235, 25, 179, 32, 231, 3, 182, 202, 103, 244, 204, 193,
-			86, 249, 204, 248, 33, 5, 25, 212, 122, 230, 240, 156, 130, 44,
-			106, 61, 115, 230, 110, 242, 38, 156, 57, 216, 43, 223, 96, 158,
-			206, 247, 19, 250, 137, 29, 8, 157, 64, 5, 182, 179, 237, 53,
-			183, 247, 153, 31, 53, 61, 251, 77, 5, 152, 120, 91, 222, 77,
-			238, 11, 47, 19, 52, 22, 27, 15, 79, 120, 34, 240, 155, 74,
-			109, 49, 113, 139, 126, 131, 70, 126, 204, 161, 214, 27, 180, 136,
-			133, 45, 250, 13, 84, 113, 50, 108, 209, 111, 152, 61, 69, 198,
-			9, 72, 22, 231, 251, 70, 222, 98, 24, 40, 192, 65, 116, 125,
-			95, 246, 24, 169, 18, 219, 182, 204, 17, 106, 191, 209, 48, 47,
-			230, 75, 194, 126, 1, 115, 35, 100, 81, 28, 132, 92, 109, 220,
-			104, 135, 180, 2, 30, 129, 57, 20, 242, 102, 176, 229, 123, 207,
-			114, 182, 205, 67, 94, 100, 117, 206, 181, 242, 57, 73, 28, 232,
-			208, 198, 30, 53, 152, 1, 112, 252, 78, 5, 26, 0, 30, 191,
-			160, 64, 11, 192, 251, 31, 34, 175, 1, 196, 28, 106, 191, 217,
-			48, 15, 231, 175, 179, 37, 12, 31, 139, 208, 122, 66, 85, 142,
-			179, 102, 63, 138, 131, 110, 130, 147, 159, 48, 187, 84, 84, 189,
-			40, 97, 241, 52, 94, 32, 80, 45, 103, 4, 58, 159, 60, 36,
-			94, 236, 0, 30, 111, 54, 38, 103, 20, 104, 2, 120, 219, 33,
-			114, 129, 128, 196, 206, 188, 213, 24, 249, 61, 195, 200, 159, 30,
-			216, 12, 19, 125, 195, 243, 147, 189, 177, 72, 200, 56, 177, 108,
-			176, 165, 222, 106, 100, 143, 146, 41, 98, 219, 182, 61, 66, 51,
-			63, 96, 152, 239, 55, 44, 124, 129, 13, 166, 155, 253, 3, 198,
-			232, 56, 169, 147, 140, 45, 172, 55, 251, 7, 13, 251, 104, 126,
-			137, 157, 3, 173, 67, 207, 54, 24, 169, 60, 12, 131, 16, 236,
-			255, 106, 216, 2, 83, 61, 98, 59, 220, 11, 197, 179, 109, 15,
-			38, 199, 107, 186, 29, 48, 212, 163, 192, 7, 125, 100, 154, 140,
-			138, 78, 13, 236, 117, 58, 41, 48, 161, 32, 15, 162, 93, 188,
-			214, 160, 246, 219, 12, 251, 168, 174, 96, 136, 130, 169, 164, 192,
-			132, 130, 252, 17, 178, 35, 91, 152, 212, 126, 59, 32, 186, 197,
-			86, 131, 152, 189, 198, 219, 122, 141, 187, 197, 184, 15, 90, 90,
-			171, 200, 216, 170, 60, 44, 211, 2, 42, 118, 111, 112, 118, 207,
-			57, 182, 185, 27, 243, 168, 200, 216, 122, 196, 89, 42, 74, 152,
-			121, 109, 194, 212, 9, 91, 90, 169, 233, 120, 55, 120, 103, 55,
-			53, 24, 192, 245, 237, 105, 212, 4, 42, 169, 193, 88, 212, 126,
-			71, 122, 48, 32, 91, 223, 145, 30, 190, 101, 66, 65, 106, 48,
-			54, 181, 223, 249, 93, 27, 204, 133, 243, 47, 125, 48, 192, 30,
-			239, 76, 15, 6, 20, 174, 119, 166, 7, 227, 80, 251, 135, 211,
-			131, 113, 12, 44, 200, 37, 5, 38, 20, 164, 90, 100, 168, 253,
-			175, 210, 45, 50, 6, 22, 36, 45, 50, 38, 20, 164, 90, 140,
-			82, 251, 93, 233, 22, 163, 6, 22, 76, 38, 5, 38, 20, 164,
-			90, 100, 169, 253, 175, 211, 45, 178, 6, 22, 36, 36, 206, 154,
-			80, 144, 63, 66, 254, 212, 144, 77, 198, 168, 253, 30, 195, 62,
-			150, 255, 109, 131, 53, 220, 173, 197, 22, 239, 120, 93, 15, 52,
-			88, 125, 204, 89, 36, 236, 106, 24, 244, 123, 168, 86, 2, 123,
-			39, 231, 238, 168, 210, 130, 252, 76, 20, 95, 207, 23, 106, 241,
-			133, 34, 187, 22, 236, 240, 155, 60, 44, 8, 87, 221, 5, 2,
-			70, 105, 135, 107, 175, 127, 196, 162, 237, 160, 223, 105, 177, 40,
-			246, 58, 29, 16, 162, 238, 102, 7, 61, 17, 40, 215, 80, 252,
-			110, 225, 139, 119, 208, 126, 64, 181, 31, 222, 72, 88, 28, 114,
-			55, 150, 15, 165, 188, 118, 35, 214, 247, 111, 248, 193, 142, 47,
-			75, 82, 211, 57, 102, 224, 32, 147, 233, 28, 51, 161, 32, 127,
-			148, 44, 74, 42, 16, 106, 255, 152, 97, 31, 155, 61, 198, 86,
-			184, 191, 21, 111, 239, 79, 7, 221, 158, 24, 88, 63, 153, 59,
-			98, 66, 65, 254, 40, 57, 41, 59, 28, 167, 246, 79, 0, 89,
-			15, 176, 85, 190, 3, 68, 185, 201, 67, 220, 233, 207, 167, 186,
-			25, 55, 176, 86, 130, 215, 184, 9, 5, 249, 163, 122, 66, 39,
-			168, 253, 94, 195, 62, 166, 43, 76, 24, 88, 144, 76, 232, 132,
-			9, 5, 169, 22, 147, 212, 254, 201, 116, 139, 73, 3, 11, 18,
-			166, 153, 52, 161, 32, 213, 98, 138, 218, 239, 75, 183, 152, 50,
-			176, 96, 38, 41, 48, 161, 32, 213, 98, 154, 218, 255, 38, 221,
-			98, 218, 192, 130, 164, 197, 180, 9, 5, 249, 163, 100, 78, 182,
-			200, 81, 251, 223, 2, 125, 239, 128, 53, 25, 13, 44, 101, 225,
-			156, 83, 45, 115, 6, 214, 76, 6, 152, 51, 161, 32, 213, 213,
-			12, 181, 127, 234, 37, 117, 53, 99, 96, 205, 164, 171, 25, 19,
-			10, 242, 74, 232, 27, 52, 243, 211, 134, 249, 191, 106, 161, 15,
-			194, 245, 167, 141, 209, 9, 114, 6, 223, 4, 250, 147, 253, 179,
-			32, 126, 242, 183, 20, 250, 234, 101, 168, 46, 65, 101, 154, 20,
-			152, 80, 160, 215, 38, 40, 76, 246, 7, 146, 181, 137, 58, 16,
-			20, 36, 45, 64, 150, 127, 32, 221, 194, 164, 246, 191, 79, 183,
-			128, 46, 254, 125, 186, 133, 168, 145, 63, 130, 123, 166, 13, 248,
-			254, 156, 97, 30, 17, 195, 193, 157, 253, 231, 212, 206, 110, 131,
-			126, 103, 255, 156, 49, 62, 163, 64, 3, 64, 122, 187, 2, 45,
-			0, 15, 231, 101, 79, 6, 181, 255, 131, 97, 30, 149, 15, 13,
-			27, 65, 213, 147, 225, 0, 56, 158, 83, 32, 86, 158, 185, 67,
-			129, 22, 128, 26, 39, 147, 218, 31, 74, 112, 2, 137, 254, 161,
-			164, 39, 144, 137, 31, 74, 122, 130, 215, 126, 200, 152, 81, 56,
-			193, 134, 241, 33, 192, 233, 61, 6, 118, 101, 81, 251, 23, 65,
-			223, 120, 206, 96, 149, 54, 211, 247, 152, 96, 106, 34, 30, 203,
-			243, 72, 159, 243, 150, 210, 232, 34, 30, 23, 25, 212, 221, 12,
-			240, 248, 208, 147, 103, 147, 170, 37, 65, 225, 159, 180, 213, 62,
-			104, 31, 213, 124, 125, 229, 166, 192, 210, 23, 118, 64, 113, 79,
-			46, 244, 20, 213, 88, 44, 27, 209, 211, 96, 6, 192, 241, 105,
-			5, 26, 0, 230, 14, 42, 16, 199, 114, 199, 33, 242, 31, 77,
-			28, 154, 77, 237, 95, 54, 76, 150, 255, 119, 38, 58, 228, 148,
-			29, 15, 216, 114, 191, 223, 69, 148, 35, 137, 165, 23, 13, 156,
-			115, 194, 111, 180, 247, 113, 164, 234, 9, 97, 120, 201, 35, 18,
-			39, 167, 46, 155, 43, 206, 21, 64, 49, 244, 34, 214, 238, 119,
-			58, 187, 139, 175, 239, 187, 29, 175, 237, 225, 62, 90, 141, 183,
-			121, 184, 227, 69, 188, 192, 150, 238, 190, 123, 17, 118, 67, 22,
-			53, 131, 158, 231, 111, 17, 22, 246, 59, 114, 151, 84, 103, 163,
-			109, 79, 30, 240, 226, 126, 48, 239, 21, 121, 145, 181, 189, 48,
-			18, 254, 33, 113, 5, 84, 96, 172, 180, 47, 192, 155, 36, 163,
-			66, 162, 187, 97, 115, 155, 183, 96, 76, 220, 79, 234, 161, 78,
-			203, 253, 184, 192, 2, 159, 193, 150, 19, 96, 97, 24, 4, 49,
-			97, 58, 124, 121, 65, 83, 221, 22, 148, 211, 160, 3, 160, 102,
-			114, 216, 198, 127, 217, 160, 138, 251, 108, 11, 192, 59, 143, 147,
-			239, 67, 162, 59, 212, 254, 85, 195, 60, 158, 239, 33, 205, 19,
-			245, 244, 133, 233, 204, 54, 185, 231, 111, 49, 121, 51, 14, 200,
-			87, 1, 178, 18, 16, 15, 65, 231, 166, 216, 1, 19, 203, 193,
-			245, 125, 30, 194, 230, 164, 185, 78, 227, 238, 216, 136, 128, 6,
-			17, 31, 141, 59, 40, 20, 191, 106, 208, 188, 2, 45, 0, 143,
-			221, 73, 254, 131, 224, 152, 12, 181, 127, 195, 48, 79, 229, 127,
-			82, 112, 140, 223, 239, 242, 208, 107, 42, 70, 209, 206, 188, 1,
-			143, 93, 204, 159, 17, 135, 251, 60, 210, 39, 239, 114, 100, 168,
-			12, 73, 119, 240, 102, 16, 116, 184, 11, 116, 152, 141, 195, 62,
-			159, 5, 134, 159, 197, 144, 187, 89, 89, 67, 196, 73, 13, 191,
-			71, 222, 5, 20, 175, 129, 39, 104, 19, 204, 195, 98, 228, 81,
-			211, 237, 9, 210, 184, 254, 46, 219, 113, 119, 23, 212, 203, 64,
-			85, 27, 234, 104, 73, 215, 23, 104, 137, 216, 16, 172, 201, 94,
-			249, 48, 187, 231, 252, 131, 200, 67, 178, 82, 145, 176, 70, 117,
-			185, 58, 47, 14, 17, 23, 46, 138, 179, 194, 197, 251, 239, 149,
-			154, 226, 171, 20, 129, 51, 54, 210, 76, 131, 14, 128, 154, 222,
-			160, 142, 253, 134, 65, 143, 43, 208, 2, 112, 246, 36, 121, 139,
-			16, 62, 163, 212, 254, 152, 97, 158, 200, 223, 132, 85, 134, 34,
-			3, 12, 193, 72, 186, 36, 91, 28, 125, 130, 46, 195, 203, 142,
-			138, 3, 210, 167, 69, 187, 61, 62, 23, 177, 228, 210, 49, 17,
-			14, 120, 150, 118, 193, 122, 194, 135, 7, 186, 172, 152, 23, 55,
-			22, 45, 52, 203, 140, 218, 136, 134, 6, 29, 0, 181, 252, 4,
-			253, 240, 99, 198, 140, 18, 219, 163, 22, 128, 199, 25, 249, 123,
-			49, 132, 44, 181, 63, 14, 67, 248, 146, 193, 30, 169, 87, 87,
-			83, 156, 173, 48, 40, 162, 85, 137, 68, 151, 82, 21, 12, 226,
-			61, 167, 100, 69, 41, 105, 8, 136, 132, 144, 109, 187, 162, 170,
-			203, 102, 245, 237, 206, 89, 105, 249, 193, 50, 78, 250, 47, 200,
-			49, 225, 163, 185, 72, 188, 137, 176, 29, 169, 254, 129, 128, 41,
-			166, 165, 145, 23, 207, 129, 182, 217, 234, 55, 101, 184, 133, 136,
-			43, 129, 174, 230, 34, 129, 255, 230, 46, 144, 249, 38, 15, 99,
-			148, 86, 94, 12, 34, 163, 233, 118, 121, 103, 201, 141, 146, 181,
-			150, 181, 113, 240, 26, 116, 0, 212, 115, 15, 106, 242, 199, 19,
-			57, 145, 181, 0, 188, 147, 201, 45, 108, 140, 218, 191, 107, 152,
-			167, 228, 195, 49, 27, 65, 213, 211, 88, 6, 192, 113, 181, 251,
-			129, 166, 249, 187, 198, 33, 197, 69, 99, 22, 128, 179, 39, 201,
-			44, 1, 217, 148, 249, 125, 99, 228, 15, 12, 35, 127, 112, 192,
-			80, 85, 83, 12, 118, 41, 172, 250, 223, 7, 187, 20, 94, 237,
-			192, 142, 254, 41, 181, 123, 58, 184, 163, 127, 74, 189, 218, 193,
-			29, 253, 83, 106, 16, 14, 238, 232, 159, 82, 59, 186, 131, 59,
-			250, 167, 212, 142, 238, 192, 214, 250, 105, 53, 8, 7, 119, 244,
-			79, 39, 61, 25, 25, 0, 229, 32, 28, 220, 209, 63, 173, 6,
-			225, 224, 142, 254, 105, 24, 196, 28, 49, 237, 12, 205, 252, 145,
-			49, 242, 119, 134, 145, 63, 156, 30, 132, 159, 236, 83, 114, 36,
-			176, 158, 254, 200, 200, 10, 61, 32, 3, 35, 249, 172, 26, 73,
-			6, 71, 242, 89, 245, 254, 12, 142, 228, 179, 106, 36, 25, 28,
-			201, 103, 213, 72, 50, 56, 146, 207, 170, 145, 100, 96, 36, 127,
-			98, 152, 69, 249, 16, 70, 242, 39, 73, 79, 48, 146, 63, 49,
-			198, 79, 42, 16, 43, 159, 90, 80, 160, 5, 96, 97, 81, 246,
-			100, 82, 251, 115, 134, 169, 234, 194, 38, 252, 185, 164, 39, 144,
-			176, 159, 51, 198, 21, 18, 240, 218, 207, 25, 119, 220, 169, 64,
-			11, 192, 19, 179, 228, 131, 32, 142, 51, 214, 8, 205, 124, 201,
-			48, 191, 108, 88, 249, 31, 55, 247, 113, 20, 42, 241, 44, 108,
-			206, 148, 75, 79, 26, 161, 251, 185, 9, 185, 31, 135, 222, 144,
-			79, 16, 136, 188, 175, 67, 112, 200, 31, 8, 246, 50, 87, 1,
-			106, 98, 47, 107, 121, 81, 236, 249, 205, 88, 172, 163, 23, 204,
-			42, 32, 94, 233, 198, 204, 139, 9, 52, 245, 148, 251, 144, 69,
-			253, 230, 182, 122, 132, 222, 30, 183, 215, 11, 131, 94, 232, 185,
-			177, 56, 245, 145, 123, 11, 98, 44, 207, 125, 60, 63, 190, 112,
-			158, 176, 86, 208, 117, 61, 95, 46, 200, 140, 5, 115, 252, 37,
-			131, 28, 33, 71, 73, 6, 64, 96, 144, 255, 199, 216, 227, 173,
-			4, 77, 55, 35, 92, 230, 240, 120, 34, 41, 112, 160, 96, 146,
-			38, 5, 6, 20, 28, 56, 148, 20, 88, 80, 112, 228, 168, 126,
-			131, 65, 237, 191, 52, 208, 101, 185, 223, 27, 128, 145, 254, 50,
-			253, 6, 80, 115, 255, 50, 253, 6, 3, 59, 56, 112, 123, 82,
-			96, 65, 193, 225, 188, 240, 21, 103, 128, 39, 254, 198, 48, 207,
-			237, 239, 43, 190, 37, 11, 12, 62, 24, 102, 5, 2, 18, 14,
-			215, 150, 124, 62, 204, 14, 12, 118, 19, 87, 156, 215, 222, 146,
-			53, 72, 138, 55, 4, 3, 131, 186, 250, 55, 9, 183, 131, 186,
-			250, 55, 198, 248, 81, 5, 26, 0, 30, 187, 91, 129, 56, 178,
-			226, 89, 210, 193, 113, 218, 212, 254, 26, 232, 30, 175, 75, 94,
-			151, 96, 120, 75, 255, 106, 200, 133, 124, 223, 215, 133, 74, 246,
-			243, 161, 138, 151, 219, 226, 117, 26, 116, 0, 212, 178, 2, 84,
-			188, 175, 169, 109, 59, 131, 42, 222, 215, 64, 86, 221, 67, 76,
-			123, 148, 102, 254, 193, 24, 121, 163, 57, 28, 49, 33, 240, 84,
-			158, 65, 33, 186, 164, 212, 130, 45, 244, 31, 140, 172, 16, 253,
-			163, 192, 148, 223, 80, 82, 107, 20, 153, 240, 27, 10, 147, 81,
-			100, 193, 111, 40, 76, 70, 145, 1, 191, 161, 164, 214, 40, 178,
-			223, 55, 148, 212, 26, 5, 230, 251, 166, 178, 168, 70, 145, 217,
-			190, 153, 244, 4, 172, 246, 77, 181, 143, 143, 34, 163, 125, 83,
-			89, 84, 163, 200, 102, 223, 84, 22, 213, 40, 72, 173, 231, 13,
-			115, 94, 62, 132, 201, 120, 62, 233, 9, 164, 214, 243, 198, 184,
-			194, 24, 94, 251, 188, 113, 244, 164, 2, 45, 0, 239, 154, 35,
-			167, 136, 105, 103, 105, 230, 205, 230, 200, 15, 152, 70, 254, 246,
-			1, 234, 200, 251, 151, 146, 32, 176, 53, 190, 217, 204, 222, 137,
-			47, 207, 2, 65, 222, 98, 74, 130, 100, 145, 32, 111, 49, 229,
-			203, 179, 72, 144, 183, 152, 146, 32, 89, 36, 200, 91, 76, 73,
-			144, 44, 18, 228, 45, 166, 36, 72, 22, 48, 251, 23, 166, 89,
-			144, 15, 129, 32, 255, 34, 233, 9, 196, 248, 191, 48, 199, 153,
-			2, 177, 242, 137, 57, 5, 90, 0, 158, 185, 91, 246, 100, 82,
-			251, 173, 166, 121, 151, 124, 8, 4, 121, 107, 210, 19, 16, 228,
-			173, 230, 248, 97, 5, 26, 0, 230, 79, 40, 208, 2, 240, 212,
-			105, 114, 142, 152, 246, 24, 205, 252, 160, 57, 242, 191, 152, 70,
-			126, 118, 232, 84, 53, 222, 14, 90, 66, 191, 27, 36, 14, 236,
-			246, 63, 104, 102, 143, 33, 34, 99, 64, 156, 183, 41, 226, 140,
-			33, 113, 222, 166, 16, 25, 67, 226, 188, 77, 17, 103, 12, 137,
-			243, 54, 69, 156, 49, 36, 206, 219, 128, 56, 255, 218, 192, 174,
-			12, 106, 191, 195, 52, 79, 228, 127, 192, 96, 21, 31, 163, 145,
-			252, 150, 10, 76, 66, 43, 4, 87, 25, 170, 143, 60, 18, 38,
-			213, 190, 246, 199, 142, 187, 203, 220, 136, 176, 125, 19, 131, 104,
-			147, 164, 192, 54, 251, 177, 138, 253, 109, 243, 80, 132, 166, 13,
-			157, 38, 11, 68, 97, 162, 222, 145, 140, 10, 56, 247, 29, 201,
-			168, 12, 196, 155, 30, 85, 160, 5, 224, 113, 38, 233, 99, 82,
-			251, 135, 76, 115, 86, 62, 132, 137, 250, 161, 164, 39, 48, 199,
-			126, 40, 233, 9, 40, 240, 67, 38, 61, 166, 64, 11, 64, 118,
-			66, 246, 100, 81, 251, 157, 166, 121, 90, 62, 4, 89, 246, 206,
-			164, 39, 144, 101, 239, 52, 199, 15, 41, 208, 0, 240, 48, 83,
-			32, 182, 61, 121, 138, 148, 177, 39, 155, 218, 239, 50, 205, 251,
-			242, 15, 176, 138, 186, 162, 18, 49, 175, 205, 196, 141, 120, 38,
-			174, 191, 71, 76, 221, 56, 87, 229, 42, 98, 80, 189, 211, 22,
-			253, 104, 208, 1, 80, 90, 255, 99, 40, 164, 222, 101, 230, 20,
-			10, 32, 164, 222, 5, 40, 72, 48, 11, 224, 233, 123, 21, 56,
-			10, 96, 241, 130, 68, 208, 161, 246, 187, 247, 67, 80, 92, 192,
-			223, 139, 160, 44, 31, 70, 16, 140, 205, 119, 39, 8, 58, 216,
-			173, 70, 16, 212, 206, 119, 39, 8, 130, 177, 249, 238, 4, 65,
-			39, 11, 160, 70, 208, 25, 5, 176, 120, 129, 124, 112, 138, 152,
-			54, 161, 153, 95, 54, 71, 254, 163, 101, 156, 95, 101, 15, 127,
-			231, 255, 8, 147, 247, 178, 200, 249, 255, 62, 201, 202, 110, 115,
-			59, 137, 5, 73, 98, 132, 68, 108, 60, 108, 30, 219, 238, 77,
-			206, 102, 85, 70, 6, 230, 198, 226, 158, 72, 122, 101, 16, 246,
-			52, 112, 118, 114, 175, 35, 74, 109, 78, 34, 178, 16, 3, 240,
-			227, 0, 54, 33, 149, 36, 160, 197, 162, 142, 183, 181, 29, 119,
-			118, 89, 203, 107, 183, 121, 200, 253, 24, 246, 169, 32, 20, 237,
-			132, 109, 199, 182, 61, 48, 114, 219, 65, 40, 251, 0, 221, 168,
-			235, 250, 94, 175, 223, 113, 193, 94, 213, 198, 147, 154, 16, 208,
-			206, 212, 121, 25, 116, 180, 255, 121, 153, 27, 237, 57, 47, 227,
-			236, 140, 36, 77, 170, 47, 57, 202, 84, 93, 181, 227, 238, 114,
-			220, 117, 133, 71, 220, 21, 161, 137, 104, 137, 122, 176, 222, 163,
-			64, 57, 91, 34, 17, 245, 136, 74, 2, 30, 36, 38, 254, 0,
-			165, 108, 116, 139, 140, 85, 252, 40, 230, 110, 11, 244, 62, 121,
-			220, 8, 15, 208, 160, 87, 214, 171, 63, 128, 100, 226, 239, 105,
-			186, 157, 14, 111, 177, 253, 46, 203, 21, 211, 230, 47, 202, 31,
-			156, 79, 45, 190, 164, 151, 174, 25, 6, 81, 196, 220, 78, 103,
-			31, 18, 176, 199, 184, 240, 33, 245, 35, 158, 50, 53, 241, 24,
-			33, 16, 179, 32, 108, 213, 20, 141, 118, 208, 231, 196, 217, 102,
-			223, 235, 180, 152, 155, 10, 51, 42, 0, 169, 4, 119, 244, 2,
-			207, 143, 241, 165, 56, 135, 145, 64, 109, 147, 115, 159, 8, 186,
-			137, 211, 143, 40, 192, 58, 169, 222, 65, 24, 35, 217, 97, 170,
-			245, 221, 46, 125, 72, 133, 188, 50, 56, 219, 242, 242, 65, 115,
-			59, 136, 184, 15, 115, 47, 174, 78, 68, 23, 9, 59, 131, 94,
-			18, 85, 81, 96, 134, 246, 178, 58, 120, 70, 123, 65, 159, 114,
-			194, 20, 119, 56, 168, 229, 29, 121, 125, 133, 5, 33, 97, 140,
-			5, 225, 150, 235, 123, 207, 202, 27, 45, 65, 40, 156, 216, 207,
-			244, 120, 232, 225, 129, 76, 71, 189, 163, 128, 132, 148, 71, 229,
-			18, 229, 251, 206, 157, 59, 119, 14, 122, 137, 183, 195, 160, 191,
-			181, 205, 30, 130, 127, 202, 245, 37, 253, 116, 187, 65, 95, 220,
-			156, 136, 250, 161, 92, 8, 80, 212, 210, 193, 131, 98, 26, 152,
-			156, 89, 236, 26, 177, 208, 162, 75, 98, 80, 124, 129, 81, 111,
-			202, 72, 203, 104, 91, 146, 31, 135, 47, 130, 47, 49, 2, 76,
-			247, 6, 111, 242, 124, 21, 240, 24, 163, 53, 18, 123, 160, 153,
-			242, 197, 174, 235, 201, 89, 221, 236, 183, 23, 183, 58, 193, 166,
-			219, 89, 212, 51, 184, 24, 242, 45, 47, 138, 195, 221, 84, 64,
-			58, 14, 62, 80, 26, 106, 42, 112, 67, 135, 127, 212, 189, 110,
-			175, 179, 171, 174, 172, 192, 208, 241, 234, 200, 211, 188, 25, 11,
-			85, 23, 195, 182, 161, 159, 234, 38, 20, 122, 55, 249, 226, 18,
-			235, 117, 250, 91, 158, 191, 128, 67, 25, 104, 178, 195, 55, 35,
-			47, 230, 108, 222, 107, 51, 247, 166, 235, 117, 220, 205, 14, 95,
-			144, 65, 112, 33, 159, 139, 152, 31, 64, 103, 232, 239, 6, 178,
-			63, 211, 235, 160, 60, 10, 118, 144, 236, 176, 214, 124, 124, 34,
-			41, 223, 45, 178, 245, 168, 239, 118, 58, 187, 248, 28, 217, 7,
-			27, 7, 62, 210, 106, 120, 72, 69, 12, 233, 19, 39, 250, 96,
-			96, 240, 61, 243, 36, 175, 77, 65, 71, 129, 159, 162, 9, 162,
-			37, 166, 119, 115, 151, 245, 250, 113, 44, 227, 165, 165, 184, 136,
-			250, 155, 139, 3, 39, 255, 232, 243, 19, 43, 66, 45, 239, 72,
-			196, 121, 179, 160, 45, 216, 14, 47, 12, 69, 146, 105, 221, 110,
-			175, 195, 97, 113, 176, 111, 245, 122, 24, 116, 34, 74, 79, 6,
-			74, 76, 73, 191, 185, 23, 177, 184, 31, 130, 176, 237, 199, 114,
-			43, 16, 242, 3, 100, 2, 76, 135, 210, 253, 52, 35, 70, 60,
-			102, 253, 158, 228, 12, 183, 31, 7, 93, 55, 246, 154, 72, 97,
-			55, 194, 171, 8, 210, 7, 166, 120, 68, 40, 141, 196, 160, 246,
-			47, 155, 217, 3, 34, 26, 147, 128, 214, 248, 97, 211, 60, 153,
-			255, 51, 131, 213, 121, 44, 60, 138, 143, 184, 55, 93, 38, 243,
-			111, 128, 180, 10, 65, 241, 112, 163, 136, 71, 169, 253, 73, 58,
-			196, 188, 72, 69, 126, 75, 196, 8, 235, 117, 220, 38, 238, 129,
-			151, 119, 149, 87, 182, 144, 10, 88, 84, 29, 195, 210, 141, 120,
-			75, 104, 127, 202, 79, 16, 180, 99, 144, 112, 158, 159, 50, 237,
-			117, 4, 254, 64, 251, 72, 173, 109, 63, 8, 187, 56, 108, 60,
-			115, 16, 108, 177, 233, 54, 111, 236, 184, 97, 43, 82, 150, 191,
-			212, 88, 133, 2, 66, 80, 59, 254, 176, 210, 71, 8, 106, 199,
-			31, 86, 218, 31, 65, 237, 248, 195, 38, 189, 83, 129, 22, 128,
-			39, 102, 201, 255, 105, 34, 201, 12, 106, 127, 212, 52, 239, 206,
-			255, 156, 169, 189, 177, 32, 131, 209, 231, 42, 201, 164, 189, 133,
-			233, 203, 24, 120, 67, 43, 116, 123, 202, 21, 45, 197, 37, 97,
-			152, 130, 72, 180, 213, 119, 205, 82, 102, 170, 114, 211, 162, 88,
-			133, 173, 47, 16, 71, 74, 168, 70, 223, 67, 216, 60, 127, 125,
-			223, 187, 233, 118, 68, 208, 169, 112, 186, 119, 90, 108, 118, 113,
-			49, 240, 249, 6, 166, 211, 193, 60, 179, 146, 33, 196, 114, 199,
-			198, 231, 217, 60, 78, 47, 97, 174, 194, 212, 237, 236, 184, 187,
-			81, 114, 99, 36, 18, 218, 184, 148, 236, 136, 163, 152, 177, 93,
-			25, 114, 187, 227, 226, 107, 9, 202, 0, 175, 233, 129, 202, 210,
-			220, 14, 2, 121, 132, 46, 70, 5, 195, 88, 208, 212, 7, 45,
-			254, 163, 9, 245, 65, 139, 255, 104, 66, 125, 3, 233, 75, 239,
-			82, 160, 5, 224, 194, 25, 242, 62, 11, 169, 111, 82, 251, 119,
-			76, 243, 161, 252, 15, 91, 146, 250, 44, 14, 251, 92, 158, 228,
-			104, 238, 69, 117, 72, 50, 43, 94, 173, 236, 116, 52, 239, 162,
-			33, 213, 115, 241, 103, 17, 200, 35, 174, 80, 136, 229, 13, 42,
-			95, 18, 25, 46, 5, 69, 1, 141, 116, 113, 119, 71, 173, 67,
-			161, 57, 105, 197, 35, 125, 25, 3, 39, 172, 143, 39, 57, 160,
-			30, 169, 195, 40, 80, 32, 252, 32, 62, 3, 171, 87, 158, 83,
-			121, 126, 228, 181, 4, 161, 82, 76, 32, 206, 154, 48, 28, 108,
-			191, 20, 85, 69, 150, 196, 54, 12, 181, 20, 111, 81, 17, 13,
-			36, 181, 92, 227, 64, 43, 141, 194, 139, 141, 87, 101, 183, 120,
-			156, 152, 101, 243, 11, 202, 216, 116, 35, 182, 195, 59, 29, 248,
-			235, 250, 187, 36, 69, 144, 148, 162, 49, 68, 0, 25, 48, 47,
-			38, 13, 12, 155, 223, 73, 102, 24, 204, 136, 223, 81, 250, 62,
-			65, 235, 234, 119, 204, 220, 73, 5, 90, 0, 222, 53, 175, 192,
-			44, 128, 11, 15, 42, 112, 20, 192, 243, 15, 144, 5, 156, 126,
-			139, 218, 159, 48, 205, 114, 254, 136, 88, 19, 82, 198, 181, 2,
-			14, 91, 18, 168, 33, 91, 26, 9, 48, 204, 62, 145, 32, 97,
-			57, 0, 106, 36, 192, 48, 251, 132, 153, 91, 84, 32, 118, 124,
-			238, 188, 2, 179, 0, 94, 88, 198, 211, 112, 0, 241, 241, 189,
-			75, 228, 207, 133, 12, 176, 169, 253, 71, 166, 249, 138, 252, 39,
-			205, 23, 224, 194, 243, 47, 204, 134, 90, 101, 39, 168, 217, 236,
-			8, 71, 246, 51, 77, 46, 198, 4, 106, 34, 76, 50, 148, 186,
-			113, 204, 187, 61, 84, 119, 186, 174, 48, 22, 164, 144, 119, 153,
-			31, 248, 139, 235, 141, 43, 139, 15, 18, 60, 164, 98, 17, 127,
-			125, 159, 251, 77, 46, 23, 46, 30, 150, 201, 3, 22, 194, 100,
-			62, 162, 212, 101, 38, 129, 84, 43, 208, 106, 111, 145, 36, 236,
-			229, 166, 2, 231, 164, 86, 38, 152, 203, 109, 2, 154, 81, 242,
-			242, 193, 119, 11, 219, 32, 153, 159, 109, 23, 170, 50, 222, 110,
-			131, 118, 33, 7, 151, 40, 143, 248, 238, 14, 40, 28, 50, 32,
-			95, 207, 161, 45, 8, 173, 65, 7, 64, 61, 135, 96, 217, 254,
-			145, 153, 83, 162, 2, 44, 219, 63, 50, 23, 238, 86, 96, 22,
-			192, 194, 37, 5, 142, 2, 120, 223, 69, 178, 2, 51, 104, 143,
-			208, 204, 159, 154, 230, 23, 76, 43, 255, 10, 166, 175, 228, 107,
-			1, 46, 47, 206, 237, 119, 31, 76, 25, 91, 145, 247, 172, 198,
-			19, 67, 246, 254, 212, 28, 61, 64, 150, 129, 95, 68, 200, 222,
-			231, 76, 123, 106, 246, 94, 221, 121, 114, 85, 10, 155, 67, 135,
-			210, 42, 42, 128, 80, 241, 220, 142, 82, 144, 133, 91, 152, 200,
-			24, 189, 207, 153, 246, 88, 82, 96, 66, 193, 196, 36, 185, 42,
-			223, 99, 80, 251, 207, 76, 155, 230, 51, 226, 54, 211, 236, 89,
-			12, 59, 75, 46, 209, 87, 123, 40, 193, 117, 236, 147, 148, 73,
-			98, 161, 171, 40, 37, 34, 131, 251, 254, 204, 148, 145, 58, 68,
-			6, 247, 253, 153, 153, 155, 209, 175, 50, 169, 253, 231, 166, 125,
-			219, 236, 3, 233, 33, 181, 192, 6, 0, 14, 147, 156, 181, 2,
-			179, 168, 238, 96, 15, 77, 169, 234, 25, 144, 254, 115, 83, 198,
-			47, 17, 25, 172, 247, 231, 230, 129, 131, 232, 95, 33, 32, 45,
-			190, 104, 154, 15, 201, 121, 115, 108, 4, 21, 11, 56, 25, 0,
-			229, 105, 17, 65, 223, 193, 23, 205, 67, 167, 20, 104, 1, 56,
-			167, 100, 137, 147, 5, 80, 203, 18, 103, 20, 192, 243, 15, 144,
-			63, 21, 171, 56, 67, 237, 191, 50, 205, 217, 252, 239, 152, 137,
-			242, 115, 53, 24, 82, 125, 162, 56, 196, 139, 215, 223, 138, 234,
-			83, 105, 179, 64, 92, 216, 47, 236, 233, 84, 234, 111, 233, 123,
-			220, 131, 23, 214, 81, 181, 92, 196, 195, 208, 77, 55, 226, 233,
-			16, 0, 173, 59, 225, 253, 42, 214, 115, 227, 237, 2, 243, 218,
-			250, 170, 122, 81, 52, 77, 29, 98, 166, 91, 69, 177, 27, 11,
-			38, 24, 216, 180, 80, 116, 203, 110, 240, 252, 100, 255, 94, 134,
-			113, 25, 104, 172, 46, 214, 104, 105, 161, 87, 112, 198, 70, 34,
-			107, 208, 1, 80, 111, 246, 25, 3, 64, 233, 178, 35, 120, 238,
-			253, 87, 230, 241, 19, 228, 119, 109, 156, 160, 81, 106, 127, 19,
-			54, 251, 255, 106, 179, 186, 136, 214, 147, 121, 250, 212, 54, 28,
-			13, 58, 77, 60, 95, 236, 224, 29, 215, 223, 234, 187, 91, 252,
-			85, 140, 205, 202, 188, 125, 179, 186, 137, 8, 188, 193, 184, 65,
-			117, 79, 30, 68, 165, 191, 11, 235, 49, 246, 154, 160, 117, 179,
-			218, 218, 18, 139, 118, 163, 24, 221, 15, 141, 109, 190, 139, 141,
-			146, 55, 225, 181, 62, 78, 24, 42, 151, 131, 98, 62, 218, 131,
-			6, 155, 215, 119, 106, 91, 34, 79, 129, 219, 145, 198, 87, 180,
-			80, 36, 236, 234, 240, 160, 118, 184, 244, 132, 160, 125, 115, 195,
-			243, 209, 1, 172, 84, 15, 249, 38, 16, 172, 73, 228, 35, 30,
-			218, 185, 97, 103, 87, 197, 250, 161, 227, 102, 56, 249, 8, 217,
-			231, 101, 130, 26, 59, 160, 31, 128, 54, 18, 242, 86, 58, 198,
-			210, 243, 89, 219, 189, 25, 96, 76, 128, 88, 233, 18, 113, 34,
-			172, 236, 193, 237, 44, 77, 81, 97, 81, 222, 154, 164, 33, 111,
-			7, 33, 47, 16, 41, 146, 116, 0, 71, 192, 48, 220, 163, 200,
-			88, 21, 111, 134, 181, 184, 180, 193, 133, 57, 29, 193, 254, 177,
-			135, 13, 68, 48, 231, 128, 22, 138, 187, 50, 216, 125, 160, 20,
-			135, 253, 100, 83, 25, 181, 145, 173, 52, 232, 0, 168, 55, 149,
-			81, 3, 64, 173, 157, 140, 90, 0, 106, 237, 100, 52, 11, 160,
-			150, 40, 163, 200, 161, 231, 31, 144, 146, 43, 75, 237, 255, 215,
-			52, 213, 142, 147, 181, 17, 84, 239, 201, 58, 0, 234, 247, 100,
-			13, 0, 115, 167, 21, 104, 1, 56, 127, 70, 129, 216, 213, 221,
-			23, 21, 56, 10, 224, 189, 15, 201, 247, 140, 81, 251, 249, 68,
-			66, 142, 217, 8, 170, 247, 140, 57, 0, 234, 247, 140, 25, 0,
-			234, 241, 140, 89, 0, 234, 241, 140, 101, 1, 212, 227, 25, 27,
-			5, 80, 143, 135, 80, 251, 141, 150, 169, 144, 32, 54, 130, 234,
-			61, 196, 1, 80, 191, 7, 44, 203, 55, 90, 57, 37, 137, 137,
-			5, 224, 220, 130, 2, 179, 0, 158, 81, 40, 147, 81, 0, 47,
-			60, 72, 254, 70, 152, 161, 227, 212, 254, 126, 203, 60, 151, 255,
-			188, 193, 42, 209, 192, 45, 120, 197, 136, 175, 34, 76, 124, 119,
-			2, 88, 48, 16, 242, 43, 118, 195, 45, 30, 131, 212, 141, 219,
-			65, 216, 149, 161, 83, 176, 119, 243, 174, 23, 67, 253, 36, 82,
-			88, 187, 95, 137, 80, 244, 111, 242, 112, 23, 245, 197, 180, 2,
-			139, 190, 41, 47, 214, 66, 90, 109, 217, 157, 93, 230, 109, 249,
-			65, 200, 91, 151, 84, 117, 104, 79, 88, 135, 187, 81, 156, 132,
-			108, 185, 34, 78, 88, 237, 228, 248, 38, 53, 4, 161, 134, 117,
-			82, 86, 232, 184, 141, 163, 214, 160, 3, 160, 166, 231, 184, 1,
-			96, 46, 175, 64, 11, 192, 99, 199, 21, 152, 5, 144, 157, 85,
-			224, 40, 128, 11, 69, 242, 207, 145, 156, 19, 212, 254, 159, 45,
-			243, 254, 252, 235, 153, 72, 45, 42, 182, 54, 176, 161, 131, 54,
-			19, 73, 72, 17, 183, 196, 42, 223, 55, 9, 65, 113, 192, 240,
-			36, 66, 26, 197, 65, 74, 12, 106, 131, 55, 8, 217, 210, 221,
-			119, 235, 161, 77, 216, 136, 129, 6, 29, 0, 245, 208, 38, 12,
-			0, 165, 195, 159, 152, 19, 22, 128, 39, 213, 74, 152, 200, 2,
-			120, 215, 125, 10, 28, 5, 240, 236, 189, 228, 95, 10, 86, 153,
-			164, 246, 219, 45, 115, 33, 255, 230, 148, 199, 34, 80, 78, 45,
-			214, 148, 134, 144, 72, 95, 42, 5, 135, 135, 48, 250, 226, 208,
-			18, 2, 115, 61, 213, 132, 236, 55, 160, 161, 61, 190, 40, 36,
-			22, 94, 250, 12, 148, 172, 210, 195, 157, 180, 17, 41, 13, 58,
-			0, 234, 77, 110, 210, 0, 144, 170, 21, 56, 105, 1, 120, 215,
-			60, 169, 224, 120, 166, 168, 253, 47, 45, 115, 62, 127, 137, 233,
-			36, 168, 72, 206, 61, 56, 93, 82, 175, 141, 148, 153, 47, 55,
-			118, 141, 198, 148, 141, 125, 105, 208, 1, 80, 163, 49, 101, 0,
-			72, 103, 21, 104, 1, 120, 122, 142, 252, 144, 80, 134, 166, 169,
-			253, 110, 203, 60, 149, 127, 147, 153, 114, 220, 176, 250, 142, 215,
-			142, 211, 123, 27, 46, 13, 188, 234, 176, 215, 165, 3, 122, 223,
-			146, 138, 126, 194, 248, 141, 144, 131, 74, 4, 172, 63, 87, 156,
-			19, 186, 126, 223, 111, 241, 48, 106, 6, 33, 215, 217, 145, 68,
-			168, 72, 160, 38, 77, 5, 59, 70, 103, 163, 221, 238, 102, 208,
-			137, 136, 50, 54, 139, 236, 49, 97, 96, 121, 169, 11, 71, 73,
-			166, 30, 84, 84, 118, 5, 138, 218, 33, 47, 3, 202, 196, 9,
-			2, 121, 161, 215, 232, 183, 40, 2, 78, 219, 72, 19, 13, 58,
-			0, 106, 122, 78, 27, 0, 106, 55, 209, 180, 5, 224, 137, 147,
-			24, 223, 73, 204, 28, 181, 223, 3, 211, 218, 75, 184, 180, 183,
-			221, 123, 169, 220, 9, 85, 247, 48, 0, 217, 135, 43, 151, 229,
-			60, 121, 17, 3, 11, 113, 87, 227, 158, 179, 17, 1, 13, 58,
-			0, 106, 220, 115, 6, 128, 154, 23, 114, 22, 128, 167, 231, 200,
-			175, 136, 53, 54, 67, 237, 247, 90, 230, 233, 252, 7, 13, 52,
-			39, 82, 244, 70, 71, 130, 72, 171, 165, 115, 26, 33, 203, 6,
-			237, 253, 145, 214, 40, 146, 4, 199, 61, 179, 168, 31, 13, 42,
-			172, 168, 110, 166, 227, 243, 96, 97, 0, 51, 196, 60, 236, 38,
-			137, 48, 52, 18, 122, 240, 51, 54, 14, 64, 131, 14, 128, 122,
-			240, 51, 6, 128, 84, 137, 210, 25, 11, 192, 217, 83, 228, 35,
-			98, 240, 148, 218, 239, 183, 204, 98, 254, 255, 248, 14, 6, 175,
-			146, 30, 107, 42, 144, 189, 51, 245, 162, 84, 72, 92, 135, 123,
-			8, 65, 228, 214, 242, 98, 132, 160, 54, 14, 70, 131, 14, 128,
-			154, 16, 212, 0, 144, 42, 93, 128, 90, 0, 222, 189, 72, 126,
-			77, 16, 226, 0, 181, 63, 0, 18, 225, 231, 95, 140, 16, 106,
-			190, 130, 54, 11, 251, 155, 187, 223, 1, 19, 160, 86, 206, 227,
-			111, 139, 13, 240, 213, 195, 34, 241, 128, 141, 131, 208, 160, 3,
-			160, 38, 192, 1, 3, 64, 189, 132, 15, 88, 0, 158, 56, 73,
-			254, 25, 142, 255, 32, 181, 127, 206, 50, 47, 230, 253, 111, 235,
-			186, 35, 209, 167, 11, 131, 217, 198, 212, 190, 59, 91, 85, 199,
-			198, 234, 184, 33, 125, 55, 146, 152, 7, 109, 124, 189, 6, 51,
-			0, 142, 43, 84, 15, 26, 0, 202, 187, 145, 196, 60, 104, 1,
-			120, 255, 67, 228, 173, 6, 177, 108, 208, 204, 126, 193, 50, 15,
-			231, 159, 253, 142, 47, 71, 126, 251, 163, 64, 13, 210, 25, 1,
-			76, 228, 77, 74, 130, 55, 41, 127, 193, 146, 55, 41, 9, 222,
-			164, 252, 5, 235, 182, 67, 226, 100, 98, 140, 218, 191, 104, 141,
-			79, 137, 134, 99, 35, 8, 77, 138, 154, 99, 208, 48, 13, 154,
-			18, 156, 32, 166, 61, 78, 51, 191, 100, 141, 252, 157, 101, 96,
-			55, 160, 54, 253, 146, 149, 189, 157, 252, 190, 67, 108, 123, 220,
-			28, 161, 246, 199, 44, 243, 21, 249, 255, 203, 1, 65, 140, 134,
-			64, 234, 92, 10, 61, 229, 184, 208, 238, 81, 30, 12, 168, 149,
-			190, 242, 213, 30, 8, 207, 87, 142, 173, 212, 174, 131, 53, 244,
-			137, 195, 34, 232, 140, 110, 236, 109, 122, 152, 74, 66, 187, 183,
-			134, 122, 39, 178, 251, 34, 195, 147, 46, 121, 23, 45, 57, 171,
-			244, 132, 78, 149, 228, 96, 18, 23, 56, 47, 50, 86, 137, 231,
-			34, 214, 225, 81, 68, 24, 111, 183, 189, 166, 135, 23, 22, 182,
-			65, 163, 227, 59, 60, 100, 109, 238, 198, 253, 144, 71, 194, 93,
-			13, 83, 9, 91, 45, 106, 178, 30, 42, 195, 67, 121, 167, 244,
-			197, 19, 229, 208, 229, 207, 184, 152, 33, 106, 224, 36, 152, 233,
-			234, 87, 130, 128, 189, 65, 228, 190, 147, 235, 246, 22, 41, 205,
-			217, 195, 72, 237, 75, 162, 110, 138, 199, 238, 133, 9, 232, 186,
-			207, 224, 147, 239, 29, 12, 23, 229, 169, 131, 123, 208, 217, 197,
-			185, 55, 144, 65, 161, 39, 238, 215, 93, 74, 17, 52, 146, 65,
-			124, 88, 53, 61, 85, 4, 67, 247, 7, 185, 27, 173, 82, 12,
-			98, 82, 227, 6, 163, 22, 13, 84, 165, 3, 95, 18, 9, 131,
-			80, 151, 80, 193, 9, 155, 50, 148, 52, 18, 103, 1, 17, 90,
-			10, 176, 107, 75, 113, 159, 132, 87, 92, 150, 167, 85, 218, 38,
-			80, 39, 113, 133, 84, 98, 197, 120, 7, 143, 115, 227, 208, 107,
-			234, 132, 141, 56, 251, 220, 111, 7, 97, 83, 218, 243, 241, 126,
-			89, 162, 164, 128, 24, 199, 67, 172, 143, 41, 1, 49, 142, 135,
-			88, 31, 83, 58, 246, 56, 186, 14, 63, 102, 229, 230, 20, 104,
-			1, 120, 230, 110, 5, 102, 1, 148, 190, 209, 113, 115, 100, 20,
-			192, 251, 46, 146, 47, 25, 184, 104, 12, 106, 255, 182, 101, 46,
-			231, 63, 99, 176, 101, 47, 74, 44, 136, 148, 231, 65, 122, 129,
-			84, 54, 122, 54, 219, 74, 29, 36, 204, 50, 149, 145, 94, 133,
-			98, 54, 93, 31, 115, 30, 182, 59, 94, 51, 86, 247, 130, 132,
-			71, 89, 245, 164, 66, 46, 212, 121, 23, 74, 33, 113, 186, 196,
-			186, 160, 112, 234, 204, 64, 82, 17, 146, 235, 150, 187, 145, 199,
-			195, 75, 204, 231, 59, 210, 9, 33, 22, 147, 123, 51, 240, 20,
-			187, 200, 19, 149, 20, 146, 179, 154, 142, 134, 141, 163, 213, 160,
-			3, 160, 166, 163, 129, 180, 200, 157, 85, 160, 5, 224, 249, 11,
-			10, 204, 2, 120, 239, 146, 2, 71, 1, 124, 248, 50, 249, 178,
-			160, 163, 73, 237, 79, 90, 230, 217, 252, 103, 19, 179, 86, 177,
-			247, 203, 102, 217, 166, 214, 208, 183, 104, 206, 74, 107, 150, 188,
-			100, 115, 54, 197, 246, 98, 252, 166, 141, 3, 214, 160, 3, 160,
-			38, 37, 176, 213, 39, 149, 69, 59, 142, 231, 62, 159, 180, 142,
-			221, 169, 192, 44, 128, 199, 139, 10, 28, 5, 112, 126, 145, 252,
-			120, 6, 73, 105, 81, 251, 203, 150, 121, 103, 254, 237, 25, 80,
-			28, 116, 194, 77, 69, 78, 177, 208, 6, 207, 193, 83, 234, 152,
-			219, 195, 136, 246, 93, 177, 242, 37, 153, 8, 148, 171, 203, 39,
-			68, 220, 8, 74, 74, 132, 212, 99, 80, 242, 138, 87, 243, 221,
-			198, 110, 143, 23, 24, 38, 93, 134, 159, 175, 132, 242, 13, 193,
-			192, 15, 179, 123, 46, 145, 68, 71, 104, 165, 34, 122, 88, 39,
-			8, 110, 68, 120, 197, 91, 117, 39, 17, 190, 238, 246, 48, 44,
-			18, 63, 164, 160, 4, 106, 90, 168, 170, 143, 46, 12, 138, 209,
-			164, 134, 219, 97, 18, 45, 118, 131, 239, 74, 36, 246, 84, 209,
-			8, 75, 59, 232, 97, 118, 94, 86, 251, 94, 241, 71, 203, 176,
-			65, 132, 134, 70, 71, 88, 101, 232, 246, 52, 134, 161, 137, 115,
-			94, 144, 91, 41, 175, 128, 152, 23, 133, 254, 195, 184, 227, 106,
-			134, 220, 236, 199, 120, 113, 135, 185, 204, 23, 55, 225, 97, 110,
-			188, 1, 174, 215, 110, 211, 56, 96, 219, 176, 65, 195, 179, 27,
-			124, 87, 92, 160, 148, 33, 236, 130, 224, 169, 115, 172, 210, 90,
-			5, 149, 25, 188, 37, 160, 207, 59, 68, 60, 7, 158, 89, 169,
-			96, 21, 204, 210, 231, 70, 132, 121, 237, 228, 70, 143, 96, 247,
-			97, 129, 158, 240, 198, 106, 181, 81, 190, 168, 114, 145, 73, 207,
-			162, 214, 91, 135, 114, 49, 178, 146, 56, 68, 87, 58, 6, 242,
-			148, 200, 221, 68, 148, 33, 42, 46, 59, 201, 14, 164, 192, 82,
-			65, 85, 94, 119, 192, 135, 41, 92, 205, 210, 28, 80, 187, 128,
-			140, 2, 75, 118, 3, 203, 198, 5, 162, 65, 7, 64, 189, 244,
-			44, 3, 192, 220, 97, 5, 226, 106, 58, 122, 140, 156, 2, 141,
-			105, 140, 218, 95, 177, 198, 39, 103, 239, 192, 227, 230, 142, 23,
-			195, 86, 46, 15, 164, 54, 59, 92, 168, 114, 227, 160, 145, 125,
-			197, 26, 159, 16, 93, 160, 70, 150, 6, 77, 9, 30, 151, 61,
-			126, 21, 122, 164, 216, 163, 239, 250, 193, 134, 27, 109, 64, 207,
-			170, 51, 3, 107, 168, 214, 198, 16, 104, 74, 176, 138, 75, 223,
-			166, 246, 223, 90, 223, 189, 180, 35, 227, 120, 170, 248, 183, 9,
-			173, 236, 12, 128, 227, 74, 16, 217, 6, 128, 199, 149, 136, 183,
-			45, 0, 101, 218, 145, 113, 135, 218, 95, 179, 94, 158, 180, 35,
-			227, 160, 44, 127, 77, 41, 203, 227, 168, 44, 127, 77, 41, 203,
-			227, 168, 44, 127, 13, 148, 101, 208, 121, 39, 104, 230, 239, 173,
-			145, 223, 179, 133, 206, 59, 97, 80, 251, 239, 173, 236, 65, 242,
-			62, 147, 216, 246, 4, 232, 188, 207, 131, 5, 251, 195, 38, 82,
-			12, 63, 115, 146, 48, 171, 58, 208, 194, 192, 167, 187, 239, 30,
-			62, 164, 150, 202, 177, 155, 4, 158, 146, 91, 92, 219, 148, 161,
-			142, 219, 174, 15, 228, 215, 113, 56, 59, 192, 204, 69, 166, 13,
-			7, 117, 38, 64, 52, 97, 54, 121, 39, 216, 81, 219, 252, 160,
-			241, 183, 203, 227, 100, 241, 38, 209, 5, 65, 143, 171, 12, 218,
-			128, 13, 108, 84, 156, 45, 46, 178, 40, 8, 195, 221, 2, 219,
-			225, 115, 157, 14, 67, 249, 30, 136, 11, 67, 45, 142, 23, 142,
-			49, 64, 180, 15, 250, 176, 58, 22, 57, 33, 102, 125, 2, 213,
-			167, 231, 21, 19, 76, 96, 238, 153, 231, 45, 121, 11, 98, 2,
-			213, 167, 231, 45, 121, 27, 124, 2, 213, 167, 231, 173, 195, 121,
-			5, 102, 1, 60, 178, 168, 192, 81, 0, 239, 42, 224, 213, 254,
-			9, 123, 132, 102, 222, 104, 155, 111, 181, 197, 213, 254, 9, 60,
-			28, 126, 163, 61, 10, 107, 35, 3, 32, 204, 207, 155, 108, 123,
-			58, 63, 173, 157, 2, 93, 204, 99, 135, 7, 162, 19, 242, 220,
-			247, 77, 182, 157, 42, 48, 161, 96, 114, 10, 227, 17, 38, 196,
-			185, 239, 91, 108, 121, 90, 59, 33, 143, 111, 223, 98, 219, 217,
-			164, 192, 132, 130, 241, 9, 221, 194, 164, 246, 247, 219, 246, 109,
-			186, 2, 116, 241, 253, 182, 60, 133, 157, 144, 167, 176, 223, 111,
-			31, 56, 72, 62, 45, 184, 200, 160, 246, 115, 182, 121, 36, 255,
-			81, 83, 174, 187, 230, 13, 222, 82, 211, 37, 15, 200, 197, 119,
-			173, 132, 9, 164, 133, 103, 47, 244, 186, 248, 9, 5, 165, 124,
-			97, 96, 38, 138, 18, 230, 10, 115, 68, 91, 46, 123, 88, 75,
-			204, 55, 152, 17, 69, 86, 115, 229, 62, 239, 250, 186, 119, 80,
-			244, 119, 66, 79, 69, 245, 97, 190, 43, 117, 175, 62, 9, 225,
-			225, 130, 129, 10, 233, 171, 96, 110, 24, 186, 187, 232, 127, 16,
-			57, 92, 112, 15, 208, 161, 77, 157, 225, 204, 27, 155, 157, 96,
-			179, 200, 42, 42, 161, 72, 65, 136, 103, 117, 190, 4, 146, 57,
-			22, 153, 111, 49, 250, 9, 143, 172, 132, 162, 37, 244, 78, 121,
-			86, 38, 136, 150, 202, 15, 33, 56, 6, 116, 206, 231, 108, 205,
-			124, 160, 115, 62, 103, 75, 105, 61, 129, 147, 249, 156, 157, 83,
-			204, 7, 58, 231, 115, 246, 225, 60, 249, 17, 7, 39, 198, 164,
-			246, 143, 216, 230, 133, 252, 15, 58, 56, 49, 226, 43, 67, 58,
-			20, 70, 122, 69, 120, 18, 203, 87, 71, 101, 68, 80, 72, 185,
-			79, 84, 140, 117, 32, 239, 210, 202, 27, 184, 233, 93, 9, 76,
-			92, 157, 119, 30, 7, 15, 237, 238, 191, 151, 109, 226, 202, 138,
-			249, 86, 232, 118, 144, 246, 109, 239, 25, 117, 233, 158, 176, 121,
-			207, 143, 239, 191, 183, 192, 250, 242, 111, 36, 255, 98, 37, 44,
-			144, 191, 22, 138, 140, 149, 82, 137, 146, 212, 64, 244, 167, 130,
-			136, 200, 123, 33, 249, 3, 39, 44, 61, 30, 17, 218, 162, 12,
-			12, 164, 122, 196, 58, 65, 132, 131, 2, 37, 213, 195, 176, 21,
-			17, 97, 3, 252, 186, 237, 246, 64, 140, 96, 236, 137, 203, 58,
-			160, 108, 36, 215, 141, 229, 61, 94, 233, 130, 101, 237, 78, 32,
-			116, 92, 17, 136, 157, 188, 182, 72, 88, 29, 5, 218, 46, 60,
-			213, 223, 49, 210, 170, 183, 28, 4, 218, 128, 3, 70, 19, 111,
-			165, 145, 151, 49, 255, 68, 43, 9, 169, 103, 179, 34, 100, 115,
-			86, 101, 86, 192, 72, 0, 190, 237, 222, 244, 130, 48, 117, 31,
-			1, 5, 135, 152, 43, 194, 244, 231, 150, 240, 202, 228, 128, 174,
-			166, 243, 174, 198, 194, 242, 31, 144, 185, 250, 238, 108, 32, 39,
-			59, 125, 68, 45, 34, 215, 68, 56, 170, 219, 66, 7, 187, 8,
-			35, 222, 10, 130, 173, 98, 215, 141, 183, 139, 21, 224, 3, 173,
-			134, 76, 160, 5, 240, 35, 9, 99, 155, 25, 0, 165, 191, 109,
-			2, 101, 206, 143, 216, 244, 144, 2, 45, 0, 143, 28, 85, 96,
-			22, 192, 99, 231, 21, 56, 10, 224, 252, 61, 82, 170, 26, 52,
-			243, 163, 182, 249, 111, 180, 84, 133, 69, 242, 163, 246, 232, 36,
-			166, 102, 153, 16, 9, 83, 126, 204, 182, 105, 254, 14, 233, 173,
-			76, 29, 41, 139, 59, 68, 66, 208, 137, 108, 41, 63, 150, 8,
-			79, 145, 45, 229, 199, 236, 220, 12, 89, 144, 93, 25, 212, 254,
-			9, 232, 234, 48, 118, 181, 135, 231, 162, 84, 103, 134, 168, 155,
-			116, 6, 130, 247, 39, 210, 157, 153, 212, 126, 223, 190, 157, 37,
-			145, 185, 170, 45, 188, 248, 125, 233, 206, 68, 227, 220, 12, 249,
-			171, 9, 92, 250, 22, 181, 127, 205, 54, 23, 242, 127, 60, 161,
-			2, 34, 82, 151, 14, 54, 181, 1, 210, 113, 159, 245, 58, 187,
-			175, 98, 108, 197, 125, 118, 87, 7, 142, 170, 227, 59, 169, 129,
-			44, 2, 89, 84, 66, 64, 17, 78, 15, 246, 118, 36, 92, 49,
-			59, 42, 146, 77, 132, 23, 166, 236, 44, 188, 7, 131, 59, 189,
-			120, 91, 65, 72, 16, 15, 115, 77, 200, 122, 115, 81, 146, 131,
-			1, 133, 162, 188, 134, 41, 241, 219, 236, 199, 74, 17, 22, 138,
-			156, 8, 221, 16, 114, 89, 184, 198, 36, 223, 15, 244, 42, 229,
-			107, 51, 22, 145, 228, 73, 127, 136, 172, 76, 48, 130, 185, 64,
-			132, 251, 65, 249, 186, 100, 108, 49, 12, 223, 197, 59, 51, 48,
-			218, 33, 123, 198, 13, 57, 107, 135, 156, 11, 215, 54, 218, 53,
-			59, 202, 206, 68, 141, 136, 48, 238, 110, 241, 16, 76, 234, 14,
-			80, 85, 93, 105, 9, 249, 150, 27, 182, 58, 60, 138, 6, 210,
-			89, 104, 125, 79, 221, 53, 209, 81, 117, 68, 239, 29, 3, 30,
-			117, 48, 147, 162, 254, 214, 22, 143, 226, 40, 241, 134, 105, 247,
-			143, 139, 217, 234, 65, 117, 242, 184, 72, 253, 224, 162, 37, 5,
-			253, 12, 224, 51, 144, 243, 0, 243, 193, 5, 161, 244, 65, 166,
-			150, 246, 102, 16, 220, 184, 193, 185, 200, 178, 18, 220, 228, 225,
-			54, 204, 69, 188, 219, 147, 182, 179, 204, 108, 59, 16, 58, 230,
-			237, 17, 32, 42, 238, 146, 185, 34, 178, 47, 157, 139, 218, 143,
-			121, 216, 150, 135, 35, 174, 63, 112, 40, 16, 180, 192, 156, 117,
-			59, 29, 21, 112, 26, 121, 91, 190, 240, 90, 178, 144, 119, 221,
-			212, 29, 194, 34, 99, 87, 250, 33, 76, 3, 232, 13, 192, 106,
-			33, 119, 91, 139, 145, 219, 230, 58, 81, 47, 73, 189, 204, 75,
-			227, 147, 74, 191, 45, 16, 190, 132, 65, 46, 177, 10, 127, 83,
-			47, 131, 222, 80, 24, 195, 216, 133, 111, 73, 95, 55, 16, 47,
-			68, 118, 110, 246, 67, 113, 15, 11, 247, 156, 14, 199, 232, 199,
-			193, 14, 129, 233, 61, 191, 207, 137, 184, 175, 241, 250, 62, 40,
-			30, 92, 229, 168, 148, 108, 89, 36, 3, 119, 228, 135, 243, 146,
-			237, 181, 172, 241, 107, 175, 170, 59, 237, 210, 18, 215, 110, 8,
-			110, 101, 207, 238, 14, 94, 108, 96, 13, 236, 57, 42, 224, 144,
-			128, 45, 42, 81, 69, 172, 91, 239, 89, 222, 154, 95, 80, 138,
-			214, 192, 234, 38, 248, 238, 144, 199, 253, 80, 50, 36, 191, 9,
-			11, 171, 45, 19, 141, 164, 151, 226, 182, 27, 49, 252, 154, 8,
-			46, 129, 1, 204, 82, 62, 114, 159, 195, 128, 221, 112, 87, 7,
-			241, 7, 42, 170, 108, 159, 62, 209, 114, 144, 151, 176, 2, 17,
-			80, 39, 214, 183, 231, 179, 0, 211, 27, 138, 109, 10, 63, 22,
-			131, 209, 229, 64, 152, 2, 200, 121, 238, 106, 141, 176, 215, 15,
-			123, 129, 8, 117, 0, 194, 16, 181, 50, 64, 221, 240, 135, 247,
-			70, 233, 157, 68, 114, 71, 47, 72, 111, 162, 29, 198, 24, 40,
-			21, 129, 112, 147, 153, 169, 189, 56, 77, 113, 229, 183, 79, 133,
-			62, 165, 230, 70, 73, 203, 65, 52, 176, 107, 249, 29, 153, 51,
-			34, 6, 255, 140, 68, 195, 195, 79, 18, 13, 160, 130, 126, 189,
-			51, 24, 29, 124, 134, 188, 80, 181, 65, 217, 164, 228, 89, 16,
-			138, 129, 166, 220, 103, 219, 160, 11, 111, 114, 238, 75, 138, 235,
-			253, 220, 178, 113, 199, 209, 160, 3, 160, 86, 84, 45, 3, 64,
-			153, 88, 106, 2, 221, 10, 191, 102, 223, 161, 182, 119, 43, 11,
-			224, 225, 121, 5, 142, 2, 56, 59, 71, 190, 104, 224, 94, 102,
-			83, 251, 55, 108, 243, 108, 254, 83, 233, 152, 31, 144, 89, 47,
-			155, 107, 84, 57, 165, 163, 111, 207, 49, 42, 51, 73, 189, 164,
-			56, 31, 153, 3, 80, 140, 220, 22, 67, 213, 160, 3, 160, 38,
-			162, 109, 0, 152, 83, 182, 165, 109, 1, 40, 221, 162, 19, 24,
-			197, 252, 27, 182, 116, 139, 78, 96, 20, 243, 111, 216, 243, 139,
-			228, 85, 72, 67, 135, 218, 31, 181, 205, 51, 249, 123, 190, 245,
-			84, 250, 162, 63, 199, 198, 30, 52, 136, 29, 106, 212, 28, 3,
-			64, 61, 191, 142, 5, 224, 29, 135, 21, 152, 5, 48, 191, 160,
-			192, 81, 0, 79, 206, 163, 215, 102, 2, 52, 191, 223, 178, 191,
-			123, 94, 155, 9, 140, 36, 253, 173, 4, 213, 12, 190, 96, 92,
-			209, 41, 99, 0, 40, 189, 54, 19, 24, 73, 250, 91, 182, 244,
-			218, 76, 56, 212, 254, 184, 253, 242, 120, 109, 38, 156, 17, 232,
-			92, 122, 109, 38, 208, 107, 243, 113, 91, 122, 109, 38, 208, 107,
-			243, 113, 251, 182, 67, 228, 40, 224, 49, 70, 237, 223, 181, 199,
-			39, 103, 167, 101, 138, 250, 22, 123, 26, 149, 48, 209, 211, 216,
-			8, 62, 22, 94, 176, 9, 244, 176, 165, 65, 83, 130, 19, 196,
-			180, 39, 105, 230, 147, 246, 200, 31, 74, 255, 207, 164, 65, 237,
-			79, 218, 217, 131, 72, 249, 73, 204, 219, 243, 93, 164, 252, 164,
-			72, 253, 163, 40, 63, 137, 174, 146, 79, 41, 202, 79, 138, 212,
-			63, 138, 242, 147, 34, 245, 143, 162, 252, 164, 67, 237, 207, 188,
-			76, 148, 159, 4, 202, 127, 70, 81, 126, 18, 41, 255, 25, 69,
-			249, 73, 164, 252, 103, 108, 233, 47, 155, 162, 153, 207, 218, 35,
-			127, 45, 233, 53, 101, 80, 251, 179, 118, 246, 0, 121, 138, 216,
-			246, 20, 198, 234, 219, 38, 203, 215, 196, 9, 241, 96, 152, 131,
-			58, 48, 198, 47, 191, 178, 174, 248, 158, 75, 226, 27, 19, 249,
-			182, 241, 219, 31, 113, 114, 135, 130, 168, 148, 102, 136, 203, 20,
-			18, 240, 115, 138, 128, 83, 120, 84, 247, 57, 181, 202, 166, 68,
-			148, 191, 157, 59, 162, 64, 11, 192, 59, 143, 147, 63, 51, 16,
-			61, 131, 218, 127, 1, 114, 242, 19, 137, 156, 148, 169, 87, 94,
-			198, 19, 36, 113, 225, 233, 229, 149, 146, 120, 144, 170, 105, 100,
-			216, 56, 78, 13, 58, 0, 106, 26, 25, 72, 5, 41, 36, 167,
-			208, 37, 242, 23, 74, 72, 78, 225, 49, 220, 95, 40, 33, 57,
-			133, 199, 112, 127, 1, 66, 242, 56, 76, 246, 24, 181, 191, 100,
-			191, 128, 51, 122, 10, 214, 221, 151, 212, 66, 155, 194, 117, 151,
-			6, 77, 9, 86, 113, 54, 76, 106, 127, 249, 187, 184, 184, 166,
-			208, 98, 254, 114, 50, 110, 144, 155, 95, 86, 139, 107, 10, 45,
-			194, 47, 171, 197, 53, 133, 22, 243, 151, 213, 226, 154, 114, 168,
-			253, 213, 151, 105, 113, 77, 193, 226, 250, 170, 90, 92, 83, 184,
-			184, 190, 170, 22, 215, 20, 46, 174, 175, 170, 197, 53, 77, 51,
-			127, 107, 143, 188, 209, 17, 139, 107, 218, 160, 246, 223, 218, 217,
-			67, 228, 107, 192, 190, 211, 176, 186, 190, 14, 236, 251, 197, 33,
-			246, 21, 86, 210, 203, 206, 196, 226, 61, 47, 247, 73, 104, 42,
-			71, 146, 156, 216, 105, 92, 244, 95, 87, 19, 59, 141, 139, 254,
-			235, 138, 161, 167, 113, 209, 127, 93, 49, 244, 52, 46, 250, 175,
-			43, 134, 158, 70, 7, 243, 215, 21, 67, 79, 163, 131, 249, 235,
-			192, 208, 85, 36, 169, 65, 237, 111, 124, 23, 121, 112, 26, 215,
-			222, 55, 18, 84, 141, 12, 128, 227, 10, 25, 3, 223, 39, 121,
-			112, 26, 215, 222, 55, 20, 15, 78, 59, 212, 126, 254, 101, 226,
-			193, 105, 224, 193, 231, 21, 15, 78, 35, 15, 62, 175, 120, 112,
-			26, 121, 240, 121, 197, 131, 57, 154, 121, 147, 51, 242, 195, 146,
-			7, 115, 6, 181, 223, 228, 100, 111, 39, 159, 178, 136, 109, 231,
-			128, 7, 159, 115, 204, 115, 233, 115, 248, 228, 30, 231, 203, 200,
-			128, 242, 37, 47, 55, 247, 169, 91, 16, 69, 114, 254, 139, 6,
-			90, 150, 23, 153, 72, 186, 163, 147, 40, 220, 163, 179, 39, 92,
-			56, 175, 18, 246, 36, 249, 132, 245, 183, 83, 181, 234, 88, 91,
-			91, 34, 140, 177, 118, 232, 118, 249, 78, 16, 222, 40, 50, 246,
-			24, 103, 110, 47, 232, 4, 91, 192, 73, 152, 94, 62, 112, 195,
-			150, 180, 186, 162, 84, 30, 244, 128, 5, 253, 48, 226, 157, 155,
-			60, 146, 7, 190, 140, 237, 112, 113, 169, 197, 237, 128, 57, 189,
-			43, 29, 23, 120, 61, 163, 19, 248, 91, 108, 19, 47, 131, 64,
-			181, 22, 111, 122, 210, 245, 160, 14, 122, 212, 199, 246, 160, 35,
-			249, 189, 61, 201, 186, 57, 92, 101, 207, 57, 166, 6, 29, 0,
-			229, 42, 203, 225, 42, 123, 206, 145, 171, 44, 135, 171, 236, 57,
-			71, 6, 209, 231, 112, 149, 61, 231, 200, 32, 250, 28, 174, 178,
-			231, 156, 133, 34, 174, 178, 28, 102, 65, 114, 190, 123, 171, 44,
-			39, 178, 23, 37, 168, 194, 42, 123, 135, 35, 87, 89, 78, 100,
-			47, 114, 228, 42, 203, 137, 236, 69, 142, 92, 101, 57, 135, 218,
-			239, 116, 94, 158, 85, 150, 131, 85, 246, 78, 71, 174, 178, 28,
-			174, 178, 119, 58, 114, 149, 229, 112, 149, 189, 211, 145, 171, 108,
-			134, 102, 222, 229, 140, 252, 188, 92, 101, 51, 6, 181, 223, 229,
-			100, 111, 35, 159, 128, 85, 54, 3, 171, 236, 189, 176, 202, 62,
-			147, 142, 118, 65, 255, 206, 203, 28, 236, 2, 239, 120, 249, 99,
-			93, 228, 141, 201, 255, 191, 45, 177, 25, 92, 98, 239, 85, 124,
-			59, 131, 75, 236, 189, 106, 137, 205, 224, 18, 123, 175, 90, 98,
-			51, 184, 196, 222, 171, 150, 216, 12, 46, 177, 247, 170, 37, 54,
-			131, 75, 236, 189, 176, 196, 190, 2, 202, 193, 140, 61, 66, 51,
-			63, 229, 152, 63, 227, 88, 131, 33, 82, 210, 41, 216, 226, 139,
-			226, 202, 240, 34, 122, 102, 231, 131, 80, 184, 234, 60, 159, 93,
-			107, 52, 214, 96, 77, 118, 92, 191, 201, 23, 196, 228, 171, 175,
-			214, 195, 180, 6, 33, 243, 133, 63, 229, 85, 162, 46, 126, 179,
-			17, 239, 160, 13, 187, 94, 18, 119, 219, 213, 114, 3, 152, 99,
-			83, 220, 246, 117, 219, 226, 27, 202, 48, 237, 34, 152, 114, 109,
-			61, 245, 60, 121, 157, 246, 254, 41, 151, 246, 208, 25, 205, 90,
-			181, 222, 80, 196, 196, 195, 224, 159, 114, 70, 239, 192, 115, 217,
-			25, 113, 24, 252, 126, 71, 230, 89, 159, 145, 103, 191, 239, 119,
-			236, 59, 146, 2, 19, 10, 242, 71, 200, 41, 217, 194, 160, 246,
-			191, 115, 236, 163, 179, 7, 69, 164, 8, 143, 82, 184, 16, 221,
-			204, 16, 213, 14, 36, 5, 38, 20, 228, 143, 144, 7, 100, 63,
-			38, 181, 127, 26, 250, 153, 75, 147, 78, 36, 99, 83, 185, 173,
-			48, 61, 129, 152, 131, 40, 233, 26, 48, 248, 105, 71, 126, 57,
-			96, 70, 30, 29, 255, 180, 131, 201, 227, 65, 16, 24, 52, 243,
-			179, 142, 249, 1, 103, 78, 206, 58, 8, 190, 159, 77, 24, 8,
-			4, 223, 207, 58, 50, 77, 224, 12, 34, 250, 179, 206, 209, 69,
-			5, 90, 212, 254, 128, 147, 201, 42, 48, 11, 224, 216, 93, 10,
-			28, 5, 144, 158, 70, 25, 61, 3, 239, 253, 224, 119, 81, 70,
-			207, 160, 54, 254, 193, 4, 85, 208, 198, 63, 168, 100, 244, 12,
-			14, 252, 131, 74, 70, 207, 160, 54, 254, 65, 37, 163, 103, 28,
-			106, 127, 232, 101, 146, 209, 51, 32, 163, 63, 164, 100, 244, 12,
-			202, 232, 15, 41, 25, 61, 131, 50, 250, 67, 32, 163, 127, 208,
-			34, 166, 77, 105, 230, 63, 57, 35, 255, 221, 49, 242, 223, 48,
-			89, 73, 187, 248, 244, 65, 42, 72, 5, 87, 91, 184, 9, 217,
-			180, 51, 95, 83, 73, 222, 255, 18, 209, 181, 110, 175, 199, 93,
-			113, 185, 85, 141, 66, 166, 166, 106, 134, 60, 117, 39, 86, 135,
-			40, 93, 188, 184, 38, 19, 124, 117, 220, 72, 220, 128, 208, 25,
-			0, 131, 160, 163, 82, 6, 70, 82, 182, 225, 25, 14, 230, 196,
-			2, 4, 147, 186, 242, 166, 86, 84, 28, 184, 51, 58, 132, 130,
-			231, 167, 131, 238, 100, 11, 249, 173, 90, 225, 207, 22, 248, 37,
-			221, 94, 188, 40, 187, 152, 95, 16, 226, 66, 126, 117, 124, 79,
-			181, 165, 160, 183, 219, 8, 230, 23, 22, 228, 65, 22, 230, 100,
-			192, 197, 177, 158, 206, 41, 166, 19, 143, 169, 172, 101, 34, 223,
-			14, 53, 168, 253, 159, 156, 236, 17, 242, 81, 147, 216, 54, 181,
-			70, 104, 230, 215, 28, 243, 191, 58, 86, 254, 127, 23, 97, 21,
-			233, 43, 213, 3, 73, 202, 146, 131, 35, 204, 67, 39, 19, 57,
-			232, 89, 20, 201, 50, 183, 228, 117, 110, 194, 92, 214, 10, 226,
-			69, 149, 216, 164, 165, 34, 105, 189, 104, 35, 201, 224, 224, 69,
-			194, 157, 225, 181, 219, 169, 214, 233, 46, 253, 84, 166, 50, 54,
-			223, 226, 126, 16, 171, 28, 13, 34, 53, 62, 76, 213, 0, 15,
-			68, 61, 222, 140, 134, 67, 224, 22, 138, 132, 149, 139, 91, 197,
-			194, 27, 216, 107, 229, 119, 87, 49, 64, 226, 201, 2, 123, 237,
-			236, 166, 27, 22, 55, 221, 103, 103, 11, 136, 12, 22, 189, 190,
-			255, 140, 174, 194, 190, 55, 133, 17, 17, 223, 107, 157, 151, 109,
-			22, 138, 80, 83, 46, 86, 138, 105, 124, 127, 205, 33, 20, 101,
-			41, 21, 105, 124, 63, 236, 216, 179, 40, 151, 168, 76, 220, 251,
-			97, 71, 166, 213, 165, 50, 113, 239, 135, 157, 201, 3, 73, 129,
-			1, 5, 7, 143, 37, 5, 22, 20, 176, 19, 186, 79, 131, 218,
-			255, 197, 177, 79, 234, 10, 32, 204, 254, 75, 186, 79, 195, 129,
-			130, 201, 153, 164, 0, 155, 208, 59, 147, 2, 11, 10, 78, 204,
-			226, 90, 166, 128, 229, 175, 59, 166, 72, 46, 73, 17, 199, 95,
-			87, 18, 135, 162, 115, 237, 215, 157, 241, 3, 10, 52, 0, 60,
-			120, 72, 129, 22, 128, 71, 142, 138, 175, 82, 80, 64, 238, 35,
-			142, 57, 151, 127, 206, 72, 37, 79, 127, 1, 110, 42, 192, 76,
-			237, 108, 187, 49, 114, 49, 70, 42, 160, 6, 22, 220, 224, 176,
-			224, 67, 2, 91, 129, 200, 243, 136, 217, 246, 220, 72, 125, 207,
-			88, 31, 162, 148, 229, 245, 0, 249, 157, 10, 177, 120, 101, 4,
-			163, 252, 236, 133, 26, 11, 144, 234, 35, 201, 208, 128, 80, 31,
-			113, 100, 48, 0, 69, 50, 125, 196, 145, 119, 208, 40, 18, 233,
-			35, 206, 233, 187, 36, 145, 76, 106, 127, 212, 49, 23, 228, 67,
-			16, 203, 31, 77, 122, 66, 191, 119, 210, 19, 166, 100, 114, 232,
-			41, 5, 90, 0, 206, 205, 203, 158, 44, 106, 127, 204, 145, 41,
-			107, 41, 30, 104, 124, 44, 233, 201, 114, 0, 148, 97, 95, 20,
-			15, 52, 62, 230, 204, 156, 84, 32, 182, 189, 107, 78, 246, 100,
-			83, 251, 191, 57, 166, 122, 104, 11, 80, 245, 100, 59, 0, 106,
-			156, 108, 3, 64, 121, 181, 136, 162, 87, 255, 191, 37, 44, 224,
-			80, 251, 55, 29, 83, 13, 221, 177, 17, 84, 61, 57, 248, 84,
-			227, 228, 24, 0, 206, 40, 126, 113, 44, 0, 101, 50, 82, 138,
-			110, 118, 71, 230, 159, 165, 194, 71, 158, 244, 148, 113, 0, 212,
-			56, 161, 143, 220, 161, 39, 20, 104, 1, 120, 234, 52, 249, 180,
-			65, 76, 251, 0, 205, 124, 210, 25, 249, 253, 140, 145, 127, 154,
-			149, 253, 166, 219, 139, 100, 18, 201, 151, 246, 37, 86, 145, 66,
-			41, 12, 186, 42, 30, 135, 236, 247, 117, 113, 182, 227, 166, 50,
-			105, 20, 201, 249, 167, 190, 155, 105, 59, 7, 191, 11, 139, 136,
-			11, 41, 124, 192, 160, 246, 39, 157, 236, 237, 228, 77, 51, 196,
-			182, 15, 192, 10, 124, 115, 198, 60, 145, 255, 155, 28, 43, 177,
-			149, 64, 230, 42, 244, 146, 20, 167, 46, 235, 121, 92, 156, 93,
-			15, 246, 152, 100, 202, 194, 1, 227, 88, 9, 107, 6, 97, 200,
-			163, 94, 224, 183, 100, 190, 172, 212, 217, 95, 146, 57, 84, 95,
-			108, 24, 252, 136, 172, 72, 150, 199, 197, 221, 84, 113, 195, 173,
-			221, 239, 0, 80, 89, 46, 227, 215, 41, 90, 242, 43, 15, 60,
-			140, 10, 67, 247, 176, 146, 155, 185, 50, 61, 149, 215, 245, 58,
-			110, 72, 244, 231, 103, 229, 231, 69, 48, 107, 93, 129, 69, 238,
-			46, 24, 0, 226, 82, 141, 24, 130, 142, 77, 191, 229, 5, 31,
-			32, 171, 76, 35, 20, 4, 58, 208, 252, 123, 9, 91, 225, 120,
-			23, 41, 8, 110, 48, 55, 22, 121, 78, 147, 184, 208, 100, 220,
-			216, 251, 11, 117, 245, 58, 25, 181, 254, 186, 215, 233, 63, 240,
-			223, 235, 94, 7, 15, 93, 249, 112, 179, 137, 127, 90, 156, 177,
-			54, 99, 91, 219, 30, 1, 115, 73, 103, 236, 212, 233, 83, 88,
-			71, 206, 167, 8, 224, 143, 122, 174, 207, 24, 166, 74, 97, 131,
-			255, 210, 187, 12, 99, 175, 117, 11, 222, 2, 99, 175, 101, 247,
-			22, 216, 185, 2, 59, 95, 96, 231, 216, 147, 88, 15, 4, 235,
-			206, 118, 208, 217, 59, 176, 162, 108, 184, 57, 212, 176, 192, 238,
-			133, 182, 208, 176, 227, 110, 242, 14, 155, 87, 163, 95, 16, 77,
-			154, 133, 214, 158, 38, 247, 169, 38, 226, 195, 54, 130, 76, 178,
-			62, 47, 180, 247, 212, 191, 71, 213, 23, 249, 29, 219, 65, 32,
-			43, 111, 21, 182, 247, 84, 190, 160, 43, 139, 212, 136, 243, 247,
-			44, 168, 68, 253, 64, 166, 69, 86, 210, 100, 147, 49, 0, 58,
-			17, 179, 14, 235, 148, 1, 37, 113, 196, 59, 109, 249, 225, 29,
-			121, 94, 142, 73, 194, 88, 154, 233, 197, 71, 73, 100, 98, 80,
-			47, 94, 72, 221, 233, 233, 171, 128, 29, 145, 220, 10, 67, 220,
-			131, 182, 138, 216, 140, 68, 206, 92, 198, 192, 8, 22, 161, 40,
-			220, 111, 118, 130, 104, 48, 105, 158, 188, 232, 35, 52, 152, 34,
-			27, 100, 114, 140, 105, 139, 189, 48, 201, 44, 137, 209, 156, 205,
-			27, 108, 190, 23, 68, 145, 183, 217, 217, 77, 127, 29, 69, 135,
-			122, 36, 154, 79, 42, 219, 175, 80, 251, 48, 219, 163, 184, 137,
-			38, 67, 38, 52, 185, 118, 182, 193, 116, 68, 254, 66, 170, 233,
-			131, 160, 217, 68, 195, 159, 213, 84, 68, 139, 127, 224, 91, 212,
-			174, 47, 168, 85, 132, 105, 184, 174, 112, 209, 76, 156, 216, 98,
-			250, 146, 20, 188, 75, 17, 84, 196, 52, 70, 42, 168, 81, 165,
-			20, 212, 244, 75, 39, 232, 199, 175, 170, 244, 66, 180, 80, 225,
-			197, 34, 177, 173, 30, 62, 38, 47, 146, 89, 189, 89, 55, 136,
-			208, 169, 16, 108, 222, 244, 130, 126, 164, 136, 171, 190, 75, 36,
-			198, 214, 154, 149, 116, 117, 183, 92, 207, 215, 233, 65, 85, 70,
-			217, 116, 50, 212, 244, 52, 12, 126, 70, 32, 106, 6, 61, 94,
-			16, 97, 187, 24, 164, 160, 211, 173, 238, 51, 234, 65, 86, 157,
-			139, 196, 242, 86, 65, 63, 226, 218, 5, 230, 248, 148, 92, 229,
-			197, 145, 84, 92, 101, 93, 193, 43, 2, 35, 201, 46, 169, 241,
-			112, 62, 43, 194, 149, 6, 120, 33, 33, 160, 178, 129, 68, 112,
-			22, 244, 178, 201, 183, 60, 31, 217, 72, 106, 93, 195, 148, 17,
-			119, 48, 163, 109, 55, 20, 166, 197, 80, 186, 94, 21, 196, 35,
-			210, 140, 98, 27, 28, 228, 35, 34, 214, 68, 196, 204, 184, 251,
-			141, 56, 61, 204, 40, 232, 202, 200, 154, 225, 154, 208, 179, 54,
-			236, 186, 220, 213, 223, 214, 16, 93, 128, 209, 36, 99, 67, 135,
-			23, 17, 155, 197, 47, 28, 206, 74, 11, 22, 133, 36, 230, 157,
-			116, 133, 132, 130, 145, 165, 178, 47, 235, 149, 57, 240, 69, 136,
-			36, 17, 98, 194, 209, 208, 81, 178, 97, 162, 143, 42, 208, 201,
-			24, 100, 60, 49, 116, 42, 179, 136, 121, 33, 90, 148, 129, 159,
-			4, 19, 234, 207, 73, 176, 69, 182, 148, 228, 7, 18, 95, 44,
-			193, 27, 0, 82, 239, 77, 45, 35, 169, 161, 246, 194, 96, 211,
-			221, 20, 193, 131, 45, 30, 121, 91, 62, 250, 193, 48, 95, 47,
-			186, 9, 89, 188, 29, 168, 148, 20, 41, 207, 129, 200, 90, 17,
-			187, 126, 171, 0, 74, 49, 6, 177, 139, 240, 216, 160, 157, 122,
-			75, 83, 100, 33, 98, 226, 131, 18, 205, 32, 108, 165, 50, 36,
-			226, 61, 4, 169, 28, 31, 64, 189, 255, 205, 25, 83, 131, 25,
-			0, 165, 222, 127, 0, 245, 254, 55, 103, 14, 30, 85, 160, 5,
-			224, 113, 134, 14, 150, 3, 96, 69, 190, 37, 99, 126, 50, 35,
-			98, 97, 15, 160, 33, 244, 150, 12, 161, 228, 205, 163, 36, 3,
-			48, 104, 56, 239, 201, 216, 103, 242, 127, 157, 73, 103, 110, 151,
-			185, 165, 221, 48, 86, 236, 122, 43, 29, 77, 221, 182, 149, 223,
-			112, 33, 122, 140, 152, 86, 58, 21, 98, 47, 125, 167, 41, 79,
-			40, 195, 248, 39, 41, 212, 100, 242, 44, 140, 216, 114, 133, 148,
-			4, 37, 81, 220, 109, 13, 131, 32, 222, 23, 3, 149, 223, 4,
-			36, 146, 76, 195, 22, 15, 36, 97, 87, 203, 56, 181, 136, 189,
-			8, 187, 199, 77, 31, 55, 191, 11, 184, 249, 61, 128, 59, 37,
-			73, 100, 243, 69, 33, 130, 59, 188, 168, 238, 45, 195, 60, 206,
-			95, 88, 96, 236, 236, 89, 108, 167, 174, 209, 21, 113, 84, 243,
-			15, 44, 104, 149, 225, 236, 89, 236, 82, 87, 128, 173, 119, 126,
-			33, 165, 83, 156, 61, 203, 238, 73, 162, 223, 212, 250, 221, 103,
-			136, 3, 47, 23, 183, 183, 211, 36, 188, 23, 177, 212, 91, 239,
-			48, 125, 6, 26, 63, 204, 238, 189, 68, 112, 181, 12, 191, 67,
-			116, 185, 167, 243, 243, 131, 157, 239, 247, 117, 6, 166, 238, 254,
-			157, 151, 93, 239, 251, 9, 7, 212, 60, 246, 116, 127, 207, 190,
-			250, 30, 214, 149, 119, 9, 19, 161, 32, 110, 67, 35, 91, 36,
-			95, 52, 211, 91, 43, 126, 244, 34, 249, 110, 182, 248, 200, 224,
-			14, 87, 193, 51, 130, 137, 58, 110, 20, 43, 102, 220, 51, 249,
-			48, 243, 154, 53, 134, 246, 231, 65, 157, 46, 17, 242, 243, 58,
-			37, 159, 22, 238, 68, 45, 23, 161, 206, 41, 121, 133, 23, 45,
-			212, 17, 92, 215, 107, 6, 157, 192, 95, 144, 225, 220, 7, 164,
-			251, 225, 61, 25, 233, 42, 56, 32, 221, 15, 239, 201, 200, 175,
-			250, 28, 144, 238, 135, 247, 100, 14, 220, 145, 20, 88, 80, 144,
-			63, 146, 20, 100, 161, 224, 232, 2, 201, 145, 172, 44, 48, 161,
-			228, 216, 60, 249, 184, 41, 23, 187, 65, 237, 247, 193, 98, 255,
-			85, 83, 93, 61, 220, 198, 20, 254, 194, 80, 143, 183, 67, 206,
-			69, 210, 245, 126, 168, 245, 172, 139, 50, 95, 114, 199, 243, 193,
-			46, 192, 223, 205, 160, 211, 239, 250, 5, 194, 96, 171, 134, 7,
-			137, 226, 90, 72, 5, 110, 186, 81, 212, 239, 242, 150, 216, 150,
-			221, 40, 213, 209, 66, 1, 155, 138, 126, 244, 247, 1, 220, 80,
-			223, 30, 242, 252, 116, 58, 97, 65, 123, 188, 180, 35, 239, 3,
-			53, 119, 139, 44, 21, 26, 11, 125, 10, 254, 19, 93, 234, 3,
-			16, 232, 242, 89, 30, 6, 139, 194, 173, 15, 154, 135, 14, 93,
-			222, 13, 250, 98, 155, 144, 169, 137, 153, 219, 106, 17, 118, 15,
-			222, 66, 2, 193, 37, 207, 65, 90, 94, 212, 235, 184, 187, 114,
-			255, 113, 241, 107, 114, 169, 185, 51, 108, 36, 106, 50, 119, 134,
-			3, 5, 169, 185, 51, 144, 236, 169, 185, 51, 44, 40, 72, 205,
-			157, 145, 133, 130, 212, 220, 25, 48, 119, 239, 131, 185, 251, 183,
-			19, 114, 238, 76, 106, 255, 110, 198, 94, 200, 255, 240, 132, 206,
-			4, 94, 71, 139, 19, 54, 182, 138, 223, 14, 6, 93, 126, 58,
-			137, 104, 138, 99, 85, 162, 97, 23, 83, 42, 237, 130, 57, 218,
-			149, 186, 52, 234, 43, 94, 114, 248, 131, 155, 118, 59, 150, 151,
-			167, 211, 92, 47, 173, 118, 108, 160, 162, 111, 213, 247, 44, 20,
-			195, 167, 183, 118, 66, 88, 9, 243, 150, 202, 45, 16, 157, 220,
-			123, 223, 27, 248, 24, 46, 203, 155, 125, 188, 16, 6, 213, 34,
-			145, 46, 146, 249, 129, 224, 41, 34, 220, 79, 67, 173, 196, 54,
-			172, 235, 139, 67, 189, 88, 122, 154, 221, 40, 109, 9, 224, 75,
-			1, 161, 14, 119, 65, 159, 216, 104, 113, 129, 247, 134, 70, 8,
-			59, 184, 193, 121, 15, 54, 63, 119, 43, 116, 123, 219, 136, 182,
-			174, 128, 236, 38, 16, 32, 138, 88, 243, 155, 253, 24, 245, 166,
-			102, 224, 251, 34, 156, 60, 14, 22, 132, 143, 91, 132, 130, 171,
-			213, 84, 20, 91, 162, 238, 27, 99, 252, 149, 39, 118, 115, 87,
-			100, 188, 25, 30, 76, 144, 80, 44, 217, 76, 133, 170, 144, 88,
-			11, 250, 2, 114, 21, 47, 111, 108, 39, 77, 228, 253, 138, 116,
-			38, 146, 75, 250, 97, 215, 13, 111, 192, 58, 17, 30, 240, 179,
-			103, 23, 132, 89, 5, 178, 24, 243, 143, 7, 125, 165, 240, 9,
-			53, 85, 209, 161, 160, 104, 8, 252, 16, 203, 236, 70, 200, 52,
-			62, 115, 163, 152, 135, 94, 116, 35, 249, 100, 162, 238, 110, 175,
-			196, 68, 147, 14, 115, 96, 2, 99, 4, 201, 61, 62, 225, 32,
-			8, 163, 184, 72, 216, 42, 223, 65, 154, 32, 231, 202, 107, 155,
-			201, 149, 79, 252, 222, 144, 248, 138, 133, 202, 185, 63, 176, 177,
-			96, 62, 14, 237, 71, 192, 189, 119, 73, 14, 63, 205, 184, 237,
-			32, 64, 187, 248, 22, 143, 55, 221, 176, 184, 79, 183, 155, 110,
-			40, 118, 191, 253, 246, 178, 77, 247, 89, 246, 48, 187, 112, 233,
-			5, 187, 125, 86, 189, 181, 228, 75, 205, 28, 40, 177, 167, 206,
-			11, 244, 241, 250, 254, 51, 178, 143, 23, 235, 73, 213, 212, 136,
-			182, 130, 254, 102, 135, 67, 185, 80, 15, 100, 7, 203, 114, 97,
-			104, 62, 17, 159, 142, 9, 183, 212, 81, 143, 180, 164, 20, 19,
-			4, 33, 139, 67, 215, 195, 11, 4, 138, 69, 100, 87, 226, 173,
-			76, 181, 215, 186, 142, 176, 0, 97, 66, 55, 59, 174, 127, 67,
-			48, 189, 90, 13, 242, 194, 164, 208, 0, 177, 27, 176, 40, 138,
-			47, 142, 94, 178, 180, 240, 19, 230, 251, 204, 137, 168, 246, 48,
-			187, 79, 204, 202, 25, 118, 57, 205, 216, 154, 90, 168, 186, 157,
-			17, 233, 208, 113, 216, 108, 69, 142, 85, 177, 119, 36, 171, 40,
-			38, 151, 202, 70, 145, 157, 57, 251, 130, 61, 75, 43, 130, 157,
-			97, 91, 33, 38, 7, 148, 13, 134, 24, 75, 60, 100, 15, 179,
-			251, 245, 172, 200, 248, 4, 214, 26, 26, 126, 148, 218, 142, 76,
-			27, 247, 137, 100, 59, 50, 29, 40, 144, 39, 25, 88, 96, 64,
-			193, 193, 83, 73, 129, 5, 5, 115, 243, 120, 146, 1, 5, 22,
-			181, 127, 47, 99, 159, 209, 21, 44, 27, 11, 146, 62, 45, 7,
-			10, 82, 125, 90, 6, 20, 28, 60, 157, 20, 96, 31, 243, 11,
-			186, 79, 155, 218, 159, 200, 216, 231, 117, 5, 91, 20, 36, 125,
-			218, 14, 20, 164, 250, 180, 13, 40, 56, 184, 152, 20, 88, 80,
-			112, 238, 30, 242, 101, 131, 152, 246, 65, 154, 249, 195, 204, 200,
-			95, 103, 140, 252, 103, 141, 212, 135, 208, 132, 84, 236, 8, 51,
-			107, 219, 235, 177, 77, 30, 239, 112, 238, 15, 93, 43, 18, 246,
-			118, 28, 13, 187, 167, 85, 98, 254, 82, 146, 235, 91, 239, 175,
-			233, 20, 34, 81, 20, 52, 61, 87, 159, 121, 233, 207, 139, 232,
-			183, 144, 180, 191, 59, 57, 44, 87, 185, 245, 81, 195, 196, 12,
-			232, 192, 98, 73, 148, 176, 108, 52, 144, 38, 66, 184, 166, 15,
-			26, 212, 254, 195, 76, 246, 48, 121, 134, 216, 246, 65, 176, 219,
-			254, 56, 99, 158, 206, 63, 205, 74, 62, 43, 233, 112, 23, 181,
-			5, 69, 194, 212, 71, 31, 0, 168, 164, 252, 25, 220, 51, 134,
-			136, 128, 46, 57, 181, 167, 16, 229, 7, 81, 201, 123, 252, 173,
-			52, 30, 210, 30, 61, 136, 202, 234, 31, 43, 123, 244, 32, 218,
-			163, 127, 156, 25, 191, 77, 129, 6, 128, 183, 51, 5, 90, 0,
-			158, 60, 133, 246, 232, 65, 176, 71, 255, 36, 99, 126, 85, 218,
-			163, 7, 209, 30, 253, 147, 12, 57, 72, 222, 110, 144, 12, 192,
-			48, 174, 207, 131, 138, 250, 207, 211, 230, 40, 198, 104, 14, 238,
-			124, 195, 199, 10, 105, 76, 83, 159, 107, 194, 248, 98, 225, 69,
-			199, 109, 89, 57, 137, 220, 97, 237, 169, 168, 188, 251, 69, 225,
-			63, 66, 174, 59, 40, 117, 243, 207, 43, 70, 61, 40, 117, 243,
-			207, 43, 253, 238, 160, 212, 205, 63, 175, 244, 187, 131, 82, 55,
-			255, 188, 210, 239, 14, 74, 221, 252, 243, 74, 191, 59, 168, 116,
-			243, 207, 131, 126, 87, 149, 227, 54, 168, 253, 133, 140, 125, 42,
-			255, 170, 225, 113, 35, 15, 96, 54, 100, 97, 155, 168, 47, 119,
-			236, 59, 254, 20, 222, 160, 151, 126, 33, 141, 55, 232, 165, 95,
-			80, 11, 236, 160, 212, 75, 191, 144, 57, 120, 60, 41, 176, 160,
-			96, 246, 36, 217, 145, 72, 153, 212, 254, 82, 198, 62, 150, 223,
-			26, 70, 10, 53, 121, 177, 123, 183, 35, 142, 147, 34, 62, 124,
-			45, 103, 103, 144, 207, 100, 6, 104, 92, 150, 73, 252, 123, 234,
-			0, 81, 156, 195, 167, 144, 7, 113, 241, 165, 52, 242, 32, 197,
-			190, 148, 38, 58, 16, 236, 75, 25, 249, 33, 213, 131, 82, 138,
-			125, 41, 115, 228, 40, 249, 3, 197, 74, 22, 181, 191, 146, 177,
-			143, 228, 63, 98, 236, 225, 37, 25, 86, 246, 82, 144, 151, 23,
-			40, 95, 0, 121, 236, 69, 228, 105, 225, 190, 130, 83, 39, 157,
-			32, 23, 122, 110, 20, 167, 204, 208, 144, 119, 248, 77, 176, 58,
-			240, 107, 8, 243, 242, 59, 103, 34, 181, 128, 254, 140, 59, 172,
-			217, 135, 177, 203, 69, 161, 47, 45, 164, 40, 4, 50, 249, 43,
-			105, 10, 129, 76, 254, 74, 154, 66, 32, 147, 191, 146, 145, 31,
-			130, 61, 40, 101, 242, 87, 50, 135, 243, 228, 167, 14, 147, 227,
-			34, 225, 247, 89, 183, 231, 157, 197, 133, 178, 161, 46, 142, 11,
-			70, 162, 68, 102, 4, 119, 123, 94, 94, 102, 7, 63, 171, 178,
-			131, 159, 77, 66, 40, 68, 237, 51, 111, 32, 147, 104, 248, 95,
-			150, 157, 208, 59, 73, 254, 74, 165, 188, 178, 188, 113, 185, 124,
-			173, 244, 104, 165, 90, 219, 88, 95, 173, 175, 149, 151, 42, 87,
-			42, 229, 229, 220, 8, 157, 32, 217, 234, 90, 163, 82, 93, 45,
-			173, 228, 12, 128, 106, 229, 239, 89, 175, 212, 202, 203, 57, 147,
-			78, 147, 241, 234, 122, 99, 109, 189, 177, 81, 93, 93, 121, 34,
-			103, 209, 41, 66, 42, 171, 26, 182, 233, 36, 25, 171, 92, 191,
-			190, 222, 40, 93, 94, 41, 231, 156, 139, 79, 145, 169, 193, 33,
-			208, 99, 197, 225, 124, 230, 136, 157, 12, 189, 56, 244, 158, 44,
-			179, 230, 167, 206, 31, 46, 38, 99, 44, 14, 160, 95, 155, 108,
-			167, 193, 203, 61, 50, 213, 12, 186, 169, 234, 151, 233, 64, 125,
-			244, 114, 172, 25, 175, 41, 201, 26, 91, 65, 199, 245, 183, 138,
-			65, 184, 117, 118, 139, 251, 136, 196, 89, 241, 200, 237, 121, 17,
-			18, 61, 21, 161, 120, 41, 245, 251, 39, 77, 251, 106, 105, 173,
-			242, 200, 63, 220, 70, 50, 212, 158, 30, 89, 53, 200, 175, 216,
-			196, 152, 160, 214, 244, 8, 61, 255, 243, 54, 91, 10, 122, 187,
-			161, 183, 181, 29, 179, 243, 231, 238, 121, 72, 70, 11, 178, 149,
-			149, 37, 208, 118, 86, 188, 38, 247, 35, 252, 138, 93, 75, 154,
-			110, 165, 30, 168, 10, 234, 73, 129, 61, 42, 178, 155, 176, 243,
-			197, 115, 108, 30, 253, 208, 242, 209, 236, 194, 37, 162, 63, 214,
-			51, 152, 95, 208, 195, 51, 143, 38, 239, 225, 90, 17, 25, 2,
-			93, 191, 201, 147, 164, 133, 178, 143, 34, 193, 36, 133, 248, 185,
-			220, 77, 220, 231, 192, 16, 237, 169, 251, 183, 170, 26, 115, 99,
-			34, 92, 99, 219, 113, 220, 187, 120, 246, 236, 206, 206, 78, 209,
-			69, 68, 145, 102, 29, 81, 45, 58, 187, 82, 89, 42, 175, 214,
-			203, 139, 231, 139, 231, 8, 97, 235, 62, 94, 80, 212, 151, 23,
-			55, 119, 213, 23, 228, 64, 133, 237, 184, 59, 232, 80, 220, 10,
-			101, 250, 38, 207, 87, 249, 63, 10, 44, 10, 218, 241, 14, 90,
-			54, 45, 15, 244, 192, 205, 126, 60, 64, 37, 133, 152, 252, 2,
-			181, 170, 16, 224, 135, 119, 103, 75, 117, 86, 169, 207, 178, 203,
-			165, 122, 165, 94, 32, 236, 177, 74, 227, 90, 117, 189, 193, 30,
-			43, 213, 106, 165, 213, 70, 165, 92, 103, 213, 26, 91, 170, 174,
-			46, 87, 128, 163, 235, 172, 122, 133, 149, 86, 159, 96, 175, 174,
-			172, 46, 23, 212, 101, 77, 254, 12, 24, 231, 17, 198, 31, 98,
-			48, 94, 43, 149, 50, 83, 189, 94, 135, 131, 171, 236, 248, 58,
-			195, 213, 86, 112, 147, 135, 104, 47, 97, 246, 133, 72, 126, 195,
-			207, 111, 17, 134, 121, 72, 164, 91, 121, 207, 136, 138, 132, 144,
-			44, 49, 76, 106, 205, 100, 41, 25, 35, 166, 53, 66, 173, 3,
-			163, 11, 80, 152, 165, 214, 109, 35, 143, 67, 97, 118, 92, 252,
-			20, 133, 183, 143, 204, 98, 33, 17, 63, 69, 225, 29, 35, 23,
-			176, 80, 254, 20, 133, 135, 70, 230, 176, 208, 16, 63, 69, 225,
-			97, 217, 252, 148, 250, 105, 140, 82, 251, 200, 200, 130, 65, 62,
-			109, 17, 115, 116, 132, 90, 243, 230, 197, 252, 111, 90, 172, 36,
-			29, 235, 41, 31, 158, 30, 184, 74, 84, 32, 147, 89, 204, 171,
-			73, 47, 168, 207, 203, 130, 198, 85, 192, 111, 153, 44, 96, 40,
-			149, 90, 233, 3, 41, 22, 7, 13, 194, 33, 7, 35, 123, 237,
-			124, 106, 253, 15, 74, 144, 5, 246, 48, 83, 18, 233, 73, 52,
-			20, 234, 177, 27, 203, 47, 99, 188, 148, 198, 41, 1, 38, 218,
-			15, 11, 164, 229, 190, 244, 167, 196, 113, 7, 58, 20, 235, 225,
-			69, 122, 77, 164, 224, 254, 157, 54, 188, 46, 143, 98, 183, 219,
-			3, 118, 243, 66, 190, 17, 123, 98, 172, 47, 169, 247, 20, 206,
-			5, 233, 185, 126, 17, 116, 148, 16, 126, 242, 18, 33, 132, 88,
-			163, 35, 38, 181, 142, 140, 158, 20, 191, 109, 152, 104, 89, 158,
-			161, 214, 252, 184, 44, 55, 168, 53, 127, 234, 188, 248, 109, 81,
-			107, 254, 190, 135, 200, 255, 109, 18, 211, 25, 161, 246, 61, 35,
-			171, 70, 254, 15, 77, 188, 126, 237, 183, 188, 38, 230, 153, 146,
-			178, 35, 157, 216, 196, 149, 95, 61, 19, 92, 50, 223, 78, 159,
-			71, 9, 7, 77, 74, 251, 211, 242, 2, 213, 238, 215, 247, 121,
-			20, 139, 123, 189, 162, 15, 55, 82, 44, 133, 201, 38, 164, 137,
-			229, 130, 54, 208, 235, 199, 11, 234, 142, 250, 153, 51, 234, 116,
-			236, 204, 153, 116, 74, 99, 141, 150, 226, 193, 102, 208, 97, 242,
-			67, 126, 242, 12, 252, 18, 152, 178, 34, 54, 82, 4, 202, 69,
-			131, 45, 193, 20, 1, 145, 41, 115, 35, 108, 7, 59, 172, 180,
-			86, 193, 192, 12, 224, 215, 109, 215, 111, 117, 180, 50, 168, 210,
-			204, 97, 96, 121, 67, 95, 146, 58, 115, 166, 235, 238, 158, 57,
-			195, 66, 222, 228, 222, 77, 142, 57, 46, 7, 63, 197, 174, 79,
-			149, 8, 177, 28, 152, 132, 123, 28, 74, 94, 69, 108, 7, 116,
-			110, 235, 130, 121, 34, 127, 158, 45, 5, 254, 77, 145, 11, 15,
-			15, 132, 69, 84, 50, 82, 23, 111, 224, 165, 238, 5, 227, 206,
-			128, 65, 167, 142, 131, 26, 176, 117, 193, 60, 170, 32, 147, 90,
-			23, 142, 51, 242, 94, 3, 123, 55, 168, 245, 160, 57, 157, 127,
-			167, 33, 243, 225, 72, 239, 171, 34, 133, 114, 220, 187, 145, 182,
-			128, 139, 132, 61, 134, 121, 19, 48, 203, 130, 72, 98, 176, 31,
-			121, 221, 144, 179, 196, 239, 44, 34, 99, 197, 137, 178, 148, 35,
-			42, 255, 53, 239, 246, 182, 221, 200, 195, 47, 18, 167, 62, 24,
-			168, 241, 55, 16, 71, 13, 153, 212, 122, 112, 114, 138, 252, 103,
-			129, 191, 73, 173, 135, 205, 233, 252, 135, 192, 202, 220, 131, 178,
-			98, 46, 157, 202, 64, 176, 45, 79, 101, 230, 16, 117, 207, 156,
-			233, 246, 35, 224, 157, 77, 158, 36, 4, 118, 163, 129, 51, 54,
-			201, 157, 5, 225, 201, 110, 187, 94, 7, 191, 81, 26, 176, 86,
-			192, 34, 249, 245, 32, 121, 208, 235, 51, 30, 134, 32, 24, 251,
-			242, 107, 153, 79, 85, 86, 31, 45, 173, 84, 150, 55, 74, 181,
-			171, 235, 215, 203, 171, 141, 167, 22, 244, 240, 96, 10, 30, 214,
-			195, 195, 1, 77, 78, 145, 111, 138, 225, 89, 212, 90, 50, 105,
-			254, 175, 246, 29, 94, 74, 216, 190, 232, 8, 211, 153, 142, 113,
-			169, 69, 189, 0, 118, 241, 130, 204, 226, 210, 236, 244, 213, 13,
-			5, 146, 62, 14, 150, 163, 30, 248, 54, 155, 80, 77, 228, 71,
-			162, 5, 229, 212, 177, 171, 23, 139, 109, 15, 75, 113, 61, 138,
-			175, 162, 37, 52, 113, 69, 134, 198, 8, 211, 30, 165, 50, 208,
-			205, 69, 242, 195, 69, 77, 158, 208, 198, 50, 96, 252, 147, 10,
-			50, 169, 181, 148, 155, 33, 63, 46, 104, 99, 83, 235, 154, 57,
-			147, 127, 199, 190, 180, 65, 249, 240, 109, 146, 70, 73, 33, 121,
-			116, 77, 134, 206, 185, 117, 43, 88, 109, 105, 127, 167, 246, 117,
-			10, 116, 109, 3, 16, 156, 80, 144, 73, 173, 107, 211, 57, 242,
-			62, 129, 188, 67, 173, 235, 102, 46, 255, 175, 246, 71, 190, 219,
-			237, 199, 160, 55, 189, 40, 238, 106, 69, 113, 24, 107, 147, 15,
-			206, 89, 28, 200, 160, 110, 230, 18, 140, 211, 6, 91, 52, 241,
-			118, 200, 100, 62, 66, 92, 182, 132, 203, 15, 79, 21, 244, 8,
-			28, 3, 176, 28, 87, 144, 73, 173, 235, 83, 211, 155, 25, 145,
-			74, 141, 124, 240, 172, 54, 84, 180, 237, 17, 171, 61, 78, 26,
-			42, 211, 67, 155, 224, 236, 37, 50, 166, 247, 65, 122, 136, 140,
-			70, 188, 25, 248, 173, 232, 144, 193, 140, 121, 171, 166, 64, 122,
-			144, 56, 190, 235, 7, 209, 33, 147, 25, 243, 78, 77, 0, 151,
-			191, 143, 28, 72, 105, 250, 170, 207, 203, 83, 186, 71, 165, 234,
-			223, 189, 229, 197, 219, 253, 77, 252, 128, 171, 80, 247, 19, 20,
-			123, 226, 107, 17, 26, 211, 191, 51, 140, 159, 52, 173, 171, 107,
-			151, 127, 198, 188, 83, 40, 236, 197, 53, 181, 101, 63, 198, 59,
-			157, 87, 251, 193, 142, 223, 128, 54, 143, 252, 111, 139, 100, 148,
-			58, 119, 142, 124, 191, 97, 144, 143, 77, 160, 226, 127, 231, 8,
-			61, 255, 171, 19, 250, 226, 141, 186, 117, 195, 22, 147, 171, 66,
-			248, 5, 1, 12, 102, 144, 59, 147, 240, 120, 144, 1, 107, 225,
-			220, 131, 202, 90, 168, 248, 205, 34, 195, 148, 215, 248, 44, 210,
-			23, 144, 138, 228, 91, 253, 84, 45, 33, 172, 198, 181, 202, 140,
-			161, 47, 242, 91, 29, 158, 202, 195, 136, 37, 155, 158, 239, 134,
-			226, 120, 95, 29, 235, 224, 119, 14, 49, 180, 137, 176, 110, 208,
-			194, 141, 65, 156, 82, 225, 57, 160, 206, 40, 167, 87, 78, 194,
-			152, 58, 132, 15, 166, 210, 211, 121, 153, 240, 22, 206, 69, 105,
-			90, 156, 25, 66, 44, 26, 142, 209, 68, 249, 17, 114, 253, 169,
-			75, 113, 196, 220, 84, 20, 35, 192, 189, 120, 39, 19, 55, 150,
-			142, 23, 197, 226, 32, 40, 121, 163, 252, 122, 93, 130, 78, 203,
-			139, 154, 29, 215, 235, 114, 113, 84, 176, 31, 18, 158, 159, 166,
-			133, 66, 66, 222, 41, 72, 240, 32, 9, 34, 223, 17, 30, 234,
-			154, 193, 80, 52, 168, 235, 183, 206, 130, 42, 133, 166, 73, 215,
-			141, 49, 139, 107, 74, 72, 41, 195, 46, 101, 47, 169, 48, 70,
-			24, 212, 170, 52, 106, 226, 212, 189, 132, 52, 111, 249, 65, 242,
-			44, 146, 110, 69, 204, 234, 45, 186, 10, 66, 189, 81, 99, 144,
-			31, 166, 125, 108, 5, 97, 196, 229, 253, 138, 110, 16, 115, 121,
-			207, 34, 142, 6, 190, 128, 39, 79, 233, 149, 61, 167, 131, 227,
-			180, 225, 208, 11, 65, 165, 2, 195, 47, 230, 126, 202, 88, 194,
-			140, 84, 215, 42, 117, 86, 175, 94, 105, 60, 86, 170, 149, 89,
-			165, 206, 214, 106, 213, 71, 43, 203, 229, 101, 118, 249, 9, 214,
-			184, 86, 102, 75, 213, 181, 39, 106, 149, 171, 215, 26, 236, 90,
-			117, 101, 185, 92, 171, 179, 210, 234, 50, 88, 118, 141, 90, 229,
-			242, 122, 163, 90, 171, 19, 109, 13, 194, 19, 176, 242, 202, 143,
-			175, 213, 202, 117, 52, 1, 43, 215, 215, 86, 42, 229, 229, 148,
-			97, 88, 96, 149, 213, 165, 149, 245, 229, 202, 234, 213, 2, 187,
-			188, 222, 96, 171, 213, 6, 97, 43, 149, 235, 149, 70, 121, 153,
-			53, 170, 5, 124, 237, 222, 118, 96, 66, 94, 47, 215, 150, 174,
-			149, 86, 27, 165, 203, 149, 149, 74, 227, 9, 124, 225, 149, 74,
-			99, 21, 94, 118, 165, 90, 35, 172, 196, 214, 74, 181, 70, 101,
-			105, 125, 165, 84, 99, 107, 235, 181, 181, 106, 189, 204, 96, 100,
-			203, 149, 250, 210, 74, 169, 114, 189, 188, 92, 100, 149, 85, 182,
-			90, 101, 229, 71, 203, 171, 13, 86, 191, 86, 90, 89, 25, 28,
-			40, 97, 213, 199, 86, 203, 53, 105, 192, 234, 97, 178, 203, 101,
-			182, 82, 1, 213, 30, 94, 133, 227, 92, 174, 212, 202, 75, 13,
-			24, 80, 242, 107, 169, 178, 92, 94, 109, 148, 86, 10, 132, 161,
-			115, 167, 180, 82, 96, 229, 199, 203, 215, 215, 86, 74, 181, 39,
-			10, 178, 211, 122, 249, 123, 214, 203, 171, 141, 74, 105, 133, 45,
-			151, 174, 151, 174, 150, 235, 108, 254, 197, 168, 178, 86, 171, 46,
-			173, 215, 202, 160, 199, 0, 41, 234, 235, 151, 235, 141, 74, 99,
-			189, 81, 102, 87, 171, 213, 101, 36, 118, 189, 92, 123, 180, 178,
-			84, 174, 95, 98, 43, 213, 58, 18, 108, 189, 94, 46, 16, 182,
-			92, 106, 148, 240, 213, 107, 181, 234, 149, 74, 163, 126, 9, 126,
-			95, 94, 175, 87, 144, 112, 149, 213, 70, 185, 86, 91, 71, 215,
-			211, 2, 187, 86, 125, 172, 252, 104, 185, 198, 150, 74, 235, 245,
-			242, 50, 82, 184, 186, 10, 163, 5, 94, 41, 87, 107, 79, 64,
-			183, 64, 7, 156, 129, 2, 123, 236, 90, 185, 113, 173, 92, 3,
-			162, 34, 181, 74, 64, 134, 122, 163, 86, 89, 106, 164, 171, 85,
-			107, 172, 81, 173, 53, 72, 106, 156, 108, 181, 124, 117, 165, 114,
-			181, 188, 186, 84, 134, 199, 85, 232, 230, 177, 74, 189, 188, 192,
-			74, 181, 74, 29, 42, 84, 240, 197, 236, 177, 210, 19, 96, 136,
-			193, 139, 97, 162, 214, 235, 101, 34, 126, 167, 88, 183, 128, 243,
-			201, 42, 87, 88, 105, 249, 209, 10, 96, 46, 107, 175, 85, 235,
-			245, 138, 100, 23, 36, 219, 210, 53, 73, 243, 162, 114, 3, 176,
-			236, 29, 210, 78, 159, 29, 185, 132, 118, 250, 105, 241, 83, 20,
-			158, 28, 57, 142, 133, 199, 197, 79, 81, 120, 106, 228, 178, 242,
-			18, 192, 79, 81, 120, 122, 164, 160, 108, 127, 248, 41, 10, 239,
-			26, 57, 171, 188, 4, 240, 83, 20, 206, 37, 254, 132, 57, 237,
-			79, 152, 31, 57, 161, 188, 4, 240, 243, 23, 142, 16, 211, 30,
-			161, 206, 179, 176, 243, 229, 127, 234, 8, 43, 177, 196, 152, 29,
-			8, 108, 16, 121, 54, 65, 170, 129, 109, 155, 254, 118, 190, 204,
-			31, 135, 229, 207, 226, 247, 226, 131, 144, 53, 221, 14, 247, 91,
-			110, 88, 24, 78, 16, 42, 245, 0, 161, 106, 135, 110, 51, 217,
-			35, 212, 3, 216, 2, 64, 41, 64, 88, 220, 66, 235, 171, 60,
-			222, 235, 141, 37, 86, 238, 5, 205, 109, 124, 93, 145, 85, 226,
-			116, 194, 88, 125, 163, 150, 192, 182, 221, 225, 189, 216, 107, 178,
-			171, 33, 223, 10, 66, 207, 245, 217, 146, 196, 73, 198, 82, 136,
-			64, 80, 249, 85, 81, 93, 73, 33, 78, 146, 79, 134, 224, 199,
-			18, 185, 27, 178, 192, 223, 243, 74, 140, 181, 193, 227, 201, 78,
-			135, 117, 61, 191, 31, 203, 227, 242, 251, 207, 17, 61, 164, 78,
-			224, 111, 21, 24, 70, 100, 119, 184, 219, 75, 134, 26, 114, 54,
-			27, 117, 185, 27, 242, 214, 44, 67, 111, 180, 11, 251, 78, 186,
-			22, 97, 168, 47, 138, 100, 109, 92, 125, 206, 68, 71, 80, 202,
-			56, 206, 26, 170, 31, 94, 36, 133, 245, 185, 115, 231, 238, 89,
-			196, 255, 26, 231, 206, 93, 196, 255, 94, 3, 163, 120, 232, 161,
-			135, 30, 90, 188, 231, 252, 226, 133, 123, 26, 231, 47, 92, 188,
-			239, 161, 139, 247, 61, 84, 124, 72, 253, 123, 77, 145, 176, 203,
-			187, 201, 103, 47, 84, 224, 167, 27, 179, 16, 186, 47, 176, 29,
-			206, 184, 31, 161, 113, 132, 41, 40, 241, 35, 32, 42, 51, 43,
-			158, 175, 138, 89, 13, 186, 140, 213, 174, 44, 177, 11, 23, 46,
-			60, 4, 74, 18, 79, 242, 114, 162, 239, 237, 181, 74, 219, 217,
-			217, 217, 41, 122, 60, 110, 163, 251, 49, 108, 55, 225, 127, 104,
-			84, 140, 159, 137, 159, 156, 127, 41, 181, 48, 76, 254, 164, 246,
-			56, 37, 206, 39, 118, 207, 69, 182, 20, 116, 123, 253, 152, 167,
-			88, 26, 113, 91, 171, 214, 43, 143, 179, 167, 128, 131, 230, 23,
-			158, 42, 74, 197, 37, 169, 164, 21, 72, 153, 137, 63, 81, 125,
-			35, 30, 111, 200, 201, 155, 199, 230, 171, 235, 43, 43, 11, 11,
-			251, 214, 67, 30, 158, 63, 183, 112, 41, 133, 211, 249, 255, 143,
-			189, 183, 1, 142, 227, 186, 239, 4, 241, 186, 123, 6, 131, 7,
-			81, 4, 31, 41, 126, 52, 191, 30, 71, 252, 0, 200, 193, 12,
-			8, 126, 72, 4, 9, 146, 32, 192, 15, 72, 224, 135, 0, 80,
-			142, 228, 149, 193, 198, 76, 3, 104, 113, 102, 122, 212, 221, 3,
-			18, 148, 20, 221, 90, 21, 235, 214, 217, 120, 157, 56, 177, 111,
-			181, 231, 91, 95, 78, 155, 56, 87, 246, 186, 174, 188, 201, 37,
-			218, 75, 42, 190, 196, 231, 85, 85, 178, 246, 217, 41, 91, 85,
-			231, 59, 123, 207, 229, 173, 93, 39, 183, 57, 159, 157, 148, 189,
-			185, 74, 109, 189, 255, 251, 232, 215, 61, 51, 32, 72, 201, 114,
-			92, 161, 170, 196, 194, 235, 233, 126, 159, 255, 247, 255, 120, 239,
-			255, 255, 255, 238, 214, 167, 69, 55, 98, 181, 248, 11, 21, 103,
-			69, 235, 27, 79, 9, 14, 13, 44, 59, 85, 26, 45, 139, 22,
-			19, 175, 239, 143, 150, 11, 20, 58, 116, 242, 126, 135, 180, 92,
-			140, 150, 89, 105, 181, 17, 241, 151, 154, 161, 91, 166, 7, 233,
-			225, 161, 161, 228, 8, 143, 116, 28, 225, 251, 188, 250, 145, 97,
-			122, 227, 162, 27, 205, 192, 181, 26, 251, 121, 44, 188, 224, 85,
-			221, 217, 228, 66, 92, 152, 156, 58, 63, 59, 121, 249, 60, 93,
-			136, 68, 55, 58, 125, 179, 127, 33, 146, 61, 189, 62, 121, 101,
-			246, 248, 81, 26, 121, 229, 155, 33, 29, 165, 253, 253, 253, 252,
-			201, 192, 66, 84, 172, 220, 186, 228, 45, 46, 77, 56, 17, 124,
-			53, 64, 79, 157, 162, 71, 134, 7, 232, 75, 20, 126, 155, 242,
-			111, 201, 159, 228, 188, 149, 74, 116, 140, 245, 183, 226, 223, 10,
-			161, 74, 182, 179, 14, 15, 13, 105, 124, 41, 44, 170, 23, 92,
-			224, 71, 135, 143, 183, 110, 57, 85, 27, 251, 252, 240, 241, 163,
-			71, 143, 62, 118, 228, 248, 208, 144, 218, 255, 194, 143, 233, 122,
-			221, 187, 45, 107, 57, 241, 216, 80, 186, 150, 226, 253, 45, 102,
-			63, 31, 63, 237, 239, 231, 147, 82, 130, 197, 98, 255, 13, 208,
-			65, 189, 59, 119, 161, 96, 86, 15, 155, 46, 89, 207, 62, 173,
-			30, 32, 128, 129, 4, 1, 28, 237, 72, 0, 0, 252, 127, 131,
-			47, 100, 81, 56, 104, 177, 87, 46, 123, 213, 170, 23, 106, 4,
-			0, 217, 16, 106, 240, 148, 142, 210, 206, 31, 172, 66, 230, 116,
-			52, 126, 90, 172, 187, 183, 206, 53, 189, 106, 197, 13, 250, 7,
-			216, 192, 102, 196, 12, 137, 38, 248, 196, 12, 196, 224, 28, 236,
-			157, 43, 124, 236, 94, 61, 98, 35, 23, 111, 242, 161, 139, 97,
-			195, 12, 12, 20, 33, 34, 26, 250, 18, 207, 193, 177, 142, 115,
-			32, 253, 210, 132, 16, 165, 215, 86, 162, 37, 174, 36, 39, 166,
-			95, 239, 126, 255, 64, 122, 109, 46, 186, 209, 120, 60, 27, 253,
-			3, 192, 1, 159, 152, 185, 122, 133, 94, 230, 233, 207, 48, 166,
-			147, 117, 254, 132, 91, 164, 220, 153, 87, 155, 167, 149, 134, 155,
-			148, 98, 49, 20, 61, 55, 92, 48, 125, 191, 228, 224, 107, 100,
-			196, 10, 48, 75, 37, 218, 228, 39, 78, 28, 107, 202, 11, 105,
-			254, 69, 38, 68, 95, 30, 124, 177, 230, 215, 163, 165, 151, 7,
-			95, 172, 56, 43, 47, 207, 190, 184, 228, 55, 131, 151, 71, 94,
-			172, 121, 245, 151, 71, 94, 12, 221, 242, 203, 239, 47, 190, 200,
-			20, 3, 70, 200, 47, 63, 247, 108, 30, 11, 7, 115, 254, 53,
-			120, 129, 112, 31, 90, 113, 181, 163, 228, 62, 184, 207, 86, 188,
-			69, 47, 10, 69, 254, 87, 209, 82, 129, 66, 83, 5, 76, 121,
-			99, 5, 10, 173, 241, 99, 32, 104, 50, 246, 87, 109, 64, 30,
-			109, 16, 124, 183, 124, 89, 155, 235, 148, 151, 248, 69, 179, 212,
-			88, 156, 170, 146, 238, 26, 130, 18, 93, 244, 105, 179, 1, 130,
-			86, 126, 202, 227, 176, 248, 195, 195, 237, 245, 154, 129, 2, 78,
-			28, 169, 242, 150, 242, 207, 230, 105, 216, 92, 88, 240, 110, 39,
-			206, 136, 92, 160, 3, 166, 102, 209, 254, 252, 245, 217, 241, 252,
-			192, 201, 196, 211, 196, 209, 123, 145, 142, 137, 236, 248, 156, 24,
-			36, 106, 136, 27, 40, 12, 166, 24, 9, 133, 169, 86, 253, 78,
-			124, 34, 197, 33, 174, 242, 207, 230, 7, 120, 240, 82, 35, 240,
-			234, 42, 135, 107, 138, 148, 120, 172, 137, 222, 148, 76, 245, 32,
-			111, 227, 49, 5, 45, 38, 242, 33, 201, 103, 35, 226, 161, 42,
-			172, 77, 246, 173, 240, 63, 20, 99, 8, 91, 250, 1, 97, 93,
-			112, 191, 63, 208, 18, 50, 153, 31, 30, 58, 252, 24, 227, 153,
-			135, 143, 205, 14, 29, 30, 57, 50, 52, 114, 248, 88, 113, 232,
-			240, 179, 121, 65, 221, 33, 133, 178, 98, 186, 220, 37, 0, 222,
-			132, 246, 253, 58, 125, 194, 169, 55, 157, 96, 133, 30, 62, 86,
-			160, 172, 182, 162, 216, 64, 42, 21, 120, 129, 59, 131, 106, 202,
-			142, 67, 153, 208, 16, 30, 20, 92, 79, 82, 30, 47, 186, 30,
-			170, 240, 183, 222, 31, 249, 147, 51, 87, 103, 96, 143, 245, 15,
-			196, 123, 74, 29, 248, 20, 107, 254, 29, 175, 90, 117, 96, 115,
-			185, 245, 193, 235, 51, 165, 138, 95, 14, 75, 239, 115, 231, 75,
-			113, 79, 74, 211, 210, 131, 169, 116, 177, 234, 207, 59, 213, 185,
-			171, 60, 153, 66, 137, 245, 167, 164, 53, 242, 156, 76, 142, 2,
-			32, 9, 156, 209, 20, 96, 155, 139, 30, 221, 96, 154, 25, 168,
-			209, 242, 143, 27, 114, 60, 2, 61, 66, 229, 220, 199, 237, 71,
-			248, 254, 27, 97, 20, 44, 192, 151, 218, 128, 252, 114, 88, 108,
-			112, 190, 198, 134, 50, 92, 170, 122, 243, 129, 19, 172, 192, 193,
-			92, 113, 41, 170, 85, 31, 133, 191, 228, 183, 3, 56, 190, 208,
-			6, 190, 40, 218, 8, 27, 110, 153, 30, 216, 247, 204, 224, 190,
-			218, 224, 190, 202, 236, 190, 75, 35, 251, 46, 143, 236, 155, 41,
-			238, 91, 120, 246, 64, 145, 78, 121, 55, 93, 14, 126, 239, 241,
-			149, 130, 53, 226, 64, 2, 42, 103, 191, 95, 113, 128, 84, 15,
-			132, 244, 253, 55, 38, 103, 174, 74, 65, 127, 129, 179, 170, 138,
-			40, 246, 15, 220, 120, 174, 31, 235, 183, 226, 207, 251, 21, 190,
-			16, 236, 143, 65, 214, 171, 146, 211, 240, 96, 61, 228, 83, 24,
-			78, 137, 247, 181, 212, 90, 55, 140, 83, 54, 48, 56, 136, 233,
-			0, 100, 5, 146, 215, 243, 210, 55, 138, 89, 74, 13, 216, 26,
-			254, 130, 238, 1, 166, 184, 189, 134, 248, 204, 43, 198, 240, 159,
-			105, 117, 33, 98, 222, 201, 109, 224, 153, 6, 224, 50, 233, 21,
-			99, 147, 253, 17, 68, 167, 99, 179, 77, 210, 188, 191, 0, 164,
-			14, 179, 27, 122, 245, 178, 174, 115, 224, 246, 74, 7, 189, 44,
-			178, 41, 131, 220, 234, 96, 80, 224, 118, 22, 197, 179, 252, 232,
-			60, 244, 84, 218, 22, 238, 205, 245, 138, 113, 103, 35, 126, 136,
-			23, 51, 172, 183, 221, 178, 132, 136, 249, 74, 110, 189, 44, 153,
-			196, 124, 133, 108, 228, 217, 53, 193, 65, 235, 23, 144, 65, 236,
-			175, 35, 122, 197, 175, 15, 214, 221, 69, 14, 75, 149, 176, 29,
-			29, 49, 82, 202, 204, 167, 118, 60, 182, 72, 175, 136, 15, 37,
-			223, 150, 215, 116, 64, 122, 113, 101, 112, 48, 200, 241, 168, 32,
-			188, 177, 174, 183, 9, 85, 139, 15, 133, 115, 85, 217, 111, 114,
-			63, 88, 102, 41, 74, 243, 56, 61, 121, 194, 244, 42, 136, 255,
-			113, 98, 130, 30, 134, 81, 35, 139, 100, 126, 1, 25, 175, 108,
-			18, 19, 134, 50, 48, 238, 110, 89, 132, 105, 200, 173, 147, 69,
-			147, 21, 251, 54, 168, 19, 251, 191, 220, 140, 143, 45, 250, 197,
-			242, 82, 224, 215, 188, 102, 141, 187, 115, 52, 203, 94, 137, 223,
-			199, 84, 230, 249, 17, 114, 137, 113, 235, 82, 217, 175, 213, 252,
-			186, 56, 199, 39, 236, 181, 162, 124, 173, 200, 94, 200, 175, 224,
-			238, 167, 29, 102, 13, 71, 228, 56, 54, 43, 238, 194, 86, 68,
-			205, 254, 222, 225, 189, 197, 214, 151, 139, 226, 205, 226, 132, 187,
-			0, 208, 100, 211, 236, 3, 251, 56, 206, 201, 7, 164, 15, 155,
-			55, 221, 21, 184, 8, 232, 153, 102, 127, 146, 77, 56, 3, 179,
-			8, 151, 0, 61, 211, 188, 48, 98, 60, 142, 242, 71, 49, 230,
-			236, 235, 154, 227, 5, 107, 253, 242, 220, 225, 103, 75, 107, 29,
-			253, 73, 246, 79, 99, 254, 137, 223, 222, 132, 179, 196, 122, 184,
-			235, 52, 194, 127, 204, 61, 125, 30, 238, 34, 195, 191, 215, 226,
-			233, 195, 100, 241, 212, 245, 241, 73, 58, 6, 9, 254, 195, 159,
-			73, 127, 159, 7, 14, 63, 239, 129, 195, 15, 63, 232, 235, 203,
-			217, 226, 168, 141, 116, 93, 144, 199, 119, 236, 207, 55, 123, 225,
-			168, 205, 42, 117, 29, 69, 246, 103, 123, 233, 24, 189, 233, 174,
-			12, 242, 140, 48, 53, 167, 33, 242, 35, 205, 243, 240, 26, 151,
-			46, 243, 109, 197, 185, 91, 228, 134, 76, 40, 67, 51, 64, 145,
-			224, 76, 171, 158, 42, 180, 167, 219, 110, 185, 41, 80, 185, 98,
-			223, 102, 166, 225, 21, 168, 230, 172, 65, 253, 58, 214, 126, 191,
-			58, 83, 160, 23, 175, 93, 151, 247, 67, 241, 15, 34, 81, 148,
-			76, 37, 84, 160, 65, 179, 206, 5, 116, 213, 89, 132, 25, 118,
-			151, 221, 58, 78, 127, 150, 64, 46, 226, 157, 108, 56, 129, 83,
-			115, 153, 180, 3, 167, 17, 85, 244, 238, 48, 18, 114, 195, 8,
-			50, 18, 141, 169, 65, 199, 129, 164, 76, 60, 114, 224, 9, 109,
-			82, 224, 221, 120, 244, 2, 173, 84, 45, 102, 155, 90, 32, 95,
-			97, 181, 42, 90, 139, 235, 193, 244, 98, 211, 171, 184, 60, 8,
-			130, 195, 46, 181, 124, 203, 189, 165, 32, 11, 3, 92, 217, 6,
-			205, 170, 75, 23, 217, 103, 33, 164, 234, 225, 208, 135, 151, 175,
-			207, 204, 114, 39, 3, 144, 189, 234, 254, 39, 153, 140, 98, 76,
-			70, 69, 185, 183, 27, 60, 98, 41, 190, 48, 151, 222, 53, 122,
-			15, 185, 145, 6, 73, 11, 33, 36, 162, 30, 122, 140, 240, 188,
-			136, 231, 115, 224, 240, 14, 253, 60, 53, 28, 199, 124, 89, 168,
-			58, 55, 189, 234, 202, 64, 145, 78, 46, 136, 48, 114, 175, 214,
-			240, 131, 72, 4, 219, 169, 117, 242, 224, 250, 24, 114, 12, 240,
-			52, 30, 101, 55, 0, 94, 82, 241, 106, 50, 193, 20, 68, 39,
-			73, 88, 130, 91, 238, 60, 95, 43, 176, 86, 20, 173, 181, 92,
-			42, 82, 26, 122, 17, 220, 36, 50, 139, 160, 204, 54, 163, 23,
-			250, 60, 52, 96, 0, 172, 194, 186, 136, 66, 145, 115, 230, 0,
-			66, 38, 151, 167, 114, 185, 69, 252, 126, 186, 51, 14, 191, 248,
-			226, 1, 77, 110, 24, 205, 133, 77, 47, 114, 153, 194, 41, 114,
-			119, 92, 149, 97, 144, 5, 136, 103, 14, 121, 50, 44, 109, 67,
-			241, 212, 5, 94, 5, 18, 172, 8, 95, 15, 56, 194, 230, 70,
-			136, 95, 135, 77, 118, 247, 181, 190, 114, 117, 77, 235, 45, 110,
-			134, 197, 207, 241, 150, 15, 221, 136, 50, 235, 85, 174, 27, 171,
-			70, 128, 254, 1, 1, 8, 186, 88, 242, 194, 200, 15, 86, 160,
-			178, 132, 217, 227, 45, 208, 139, 87, 168, 19, 44, 242, 229, 112,
-			106, 126, 125, 81, 141, 49, 110, 166, 225, 120, 65, 40, 38, 221,
-			169, 240, 64, 26, 86, 87, 221, 189, 37, 190, 23, 61, 12, 53,
-			255, 106, 133, 220, 146, 164, 69, 135, 171, 84, 172, 155, 204, 180,
-			85, 93, 3, 99, 105, 92, 8, 223, 130, 140, 244, 212, 59, 3,
-			125, 132, 29, 52, 223, 44, 223, 116, 163, 17, 206, 58, 153, 88,
-			229, 15, 56, 112, 21, 205, 151, 189, 60, 127, 11, 142, 107, 18,
-			175, 193, 19, 249, 94, 213, 171, 55, 111, 15, 6, 110, 21, 94,
-			143, 9, 97, 68, 39, 16, 44, 4, 31, 179, 74, 202, 97, 82,
-			61, 144, 133, 82, 24, 148, 75, 236, 123, 175, 190, 88, 130, 70,
-			230, 253, 168, 148, 162, 172, 88, 225, 46, 229, 214, 227, 87, 164,
-			190, 125, 196, 216, 101, 7, 192, 142, 53, 110, 161, 48, 134, 36,
-			167, 122, 210, 93, 209, 112, 81, 21, 72, 201, 178, 83, 245, 42,
-			52, 214, 117, 210, 0, 170, 5, 26, 114, 161, 229, 5, 152, 163,
-			226, 4, 142, 199, 99, 142, 98, 149, 250, 136, 81, 218, 32, 213,
-			230, 44, 235, 208, 35, 154, 74, 125, 100, 243, 54, 77, 165, 62,
-			178, 99, 39, 126, 26, 67, 116, 207, 99, 93, 167, 145, 253, 4,
-			227, 69, 252, 196, 39, 73, 45, 69, 58, 171, 66, 117, 21, 26,
-			93, 228, 44, 46, 114, 1, 239, 186, 116, 178, 190, 44, 131, 54,
-			34, 103, 49, 228, 179, 131, 16, 49, 31, 203, 17, 252, 36, 182,
-			0, 235, 203, 28, 49, 54, 216, 167, 233, 180, 187, 232, 222, 30,
-			161, 31, 120, 191, 51, 120, 231, 57, 246, 207, 208, 224, 137, 185,
-			231, 14, 246, 151, 82, 15, 6, 14, 238, 197, 244, 178, 115, 91,
-			56, 227, 143, 208, 227, 71, 197, 72, 17, 140, 116, 196, 120, 108,
-			19, 140, 6, 129, 241, 48, 98, 228, 100, 9, 17, 115, 164, 231,
-			33, 89, 50, 137, 57, 178, 190, 15, 239, 134, 94, 128, 255, 213,
-			70, 155, 36, 42, 30, 62, 118, 92, 213, 140, 44, 98, 141, 26,
-			35, 27, 196, 215, 40, 195, 62, 144, 53, 179, 17, 141, 246, 60,
-			44, 75, 38, 49, 71, 55, 16, 165, 113, 191, 190, 23, 159, 92,
-			139, 206, 25, 52, 202, 165, 229, 195, 37, 47, 158, 51, 174, 119,
-			111, 74, 170, 210, 65, 163, 92, 92, 62, 108, 223, 45, 62, 192,
-			190, 155, 95, 142, 125, 127, 102, 64, 254, 223, 155, 24, 199, 235,
-			74, 108, 108, 177, 109, 196, 53, 239, 115, 217, 111, 143, 153, 223,
-			30, 203, 76, 195, 51, 50, 134, 51, 224, 143, 11, 42, 248, 195,
-			195, 251, 139, 237, 70, 82, 212, 136, 4, 124, 120, 207, 153, 223,
-			30, 51, 167, 249, 151, 100, 28, 247, 114, 255, 37, 240, 148, 221,
-			106, 82, 212, 223, 59, 108, 183, 68, 15, 168, 83, 38, 213, 3,
-			204, 63, 99, 63, 144, 97, 108, 49, 242, 219, 106, 129, 109, 178,
-			171, 157, 109, 18, 111, 175, 105, 120, 151, 76, 224, 117, 11, 94,
-			29, 206, 192, 120, 211, 153, 187, 54, 13, 253, 126, 72, 126, 5,
-			45, 31, 199, 185, 138, 235, 84, 152, 214, 176, 53, 123, 183, 10,
-			166, 213, 187, 228, 56, 222, 36, 189, 202, 230, 98, 130, 8, 183,
-			118, 83, 179, 191, 135, 55, 180, 81, 190, 16, 207, 95, 152, 127,
-			2, 103, 96, 14, 201, 35, 120, 195, 204, 236, 216, 236, 249, 84,
-			84, 7, 198, 217, 177, 241, 217, 201, 167, 207, 247, 33, 178, 14,
-			247, 140, 95, 189, 124, 109, 234, 252, 172, 12, 234, 80, 119, 239,
-			231, 39, 250, 204, 115, 195, 207, 14, 173, 157, 108, 79, 6, 141,
-			114, 99, 254, 137, 223, 216, 197, 109, 37, 239, 129, 173, 244, 192,
-			86, 122, 87, 109, 165, 29, 50, 54, 130, 116, 247, 195, 159, 136,
-			152, 27, 197, 159, 6, 49, 55, 117, 159, 86, 193, 17, 23, 165,
-			49, 197, 254, 252, 43, 196, 141, 169, 29, 93, 30, 178, 255, 3,
-			98, 122, 150, 95, 103, 212, 208, 116, 170, 50, 216, 211, 5, 159,
-			117, 78, 215, 97, 145, 78, 74, 215, 75, 238, 79, 41, 55, 52,
-			27, 242, 100, 50, 201, 106, 181, 42, 191, 82, 185, 155, 202, 112,
-			157, 227, 112, 140, 58, 87, 134, 55, 208, 240, 150, 19, 192, 237,
-			127, 228, 132, 55, 11, 152, 107, 41, 92, 163, 225, 127, 23, 232,
-			248, 83, 212, 137, 34, 183, 6, 200, 164, 227, 126, 173, 225, 135,
-			172, 19, 35, 96, 179, 73, 40, 100, 174, 122, 106, 44, 129, 11,
-			90, 113, 76, 36, 153, 116, 49, 214, 67, 118, 228, 8, 254, 117,
-			121, 240, 103, 237, 49, 246, 90, 246, 199, 16, 29, 231, 102, 160,
-			116, 238, 82, 73, 226, 120, 14, 117, 85, 187, 80, 162, 188, 58,
-			157, 134, 97, 78, 156, 43, 94, 116, 163, 152, 225, 96, 58, 125,
-			109, 188, 8, 199, 236, 53, 39, 26, 209, 251, 85, 122, 113, 242,
-			202, 211, 87, 199, 199, 24, 77, 205, 77, 78, 188, 204, 201, 197,
-			169, 134, 190, 210, 180, 28, 175, 81, 172, 184, 203, 165, 195, 195,
-			195, 9, 93, 101, 143, 177, 99, 147, 118, 252, 183, 71, 200, 89,
-			174, 171, 236, 233, 89, 167, 233, 42, 123, 250, 54, 200, 15, 115,
-			196, 218, 67, 246, 154, 120, 61, 206, 65, 241, 191, 205, 117, 17,
-			51, 111, 13, 106, 15, 16, 49, 31, 181, 14, 65, 186, 205, 46,
-			70, 19, 251, 141, 83, 38, 175, 12, 166, 106, 127, 247, 67, 16,
-			237, 217, 197, 149, 182, 126, 203, 182, 207, 74, 165, 13, 28, 237,
-			57, 20, 16, 215, 247, 21, 220, 44, 204, 161, 0, 214, 227, 209,
-			39, 94, 72, 125, 238, 26, 9, 135, 118, 221, 188, 66, 196, 106,
-			124, 36, 46, 27, 196, 236, 223, 186, 13, 79, 136, 6, 17, 49,
-			15, 89, 235, 237, 99, 2, 43, 115, 89, 37, 165, 115, 226, 180,
-			204, 112, 121, 2, 151, 34, 33, 168, 229, 146, 98, 227, 86, 16,
-			84, 163, 149, 13, 98, 30, 90, 247, 48, 254, 22, 18, 205, 24,
-			196, 60, 108, 17, 251, 203, 40, 221, 14, 176, 74, 65, 232, 34,
-			79, 11, 236, 13, 78, 229, 220, 249, 157, 83, 186, 244, 242, 196,
-			122, 144, 181, 70, 246, 208, 101, 101, 181, 10, 164, 251, 102, 228,
-			158, 164, 205, 122, 213, 187, 233, 82, 77, 204, 240, 25, 147, 103,
-			21, 201, 238, 40, 15, 104, 232, 78, 221, 135, 219, 94, 55, 184,
-			203, 12, 176, 137, 60, 108, 173, 139, 203, 108, 196, 125, 27, 240,
-			87, 12, 49, 3, 38, 49, 79, 90, 155, 236, 63, 52, 238, 58,
-			3, 224, 239, 206, 140, 55, 25, 19, 254, 110, 205, 4, 207, 167,
-			211, 242, 38, 184, 234, 4, 205, 6, 247, 169, 117, 107, 128, 163,
-			89, 5, 179, 105, 166, 89, 94, 210, 251, 26, 199, 174, 86, 188,
-			176, 236, 4, 21, 224, 78, 87, 23, 34, 1, 245, 218, 161, 63,
-			112, 227, 23, 5, 158, 132, 83, 125, 119, 166, 156, 177, 226, 147,
-			214, 250, 184, 108, 16, 243, 36, 217, 136, 75, 226, 88, 222, 60,
-			99, 156, 181, 243, 84, 220, 68, 139, 77, 226, 75, 40, 202, 229,
-			56, 29, 217, 58, 121, 192, 109, 157, 81, 59, 211, 64, 89, 86,
-			129, 188, 1, 96, 4, 126, 38, 39, 89, 0, 83, 181, 207, 244,
-			73, 195, 134, 113, 255, 51, 228, 140, 216, 240, 136, 115, 128, 51,
-			155, 70, 241, 16, 116, 196, 32, 214, 57, 227, 188, 101, 231, 233,
-			251, 36, 34, 110, 251, 125, 166, 122, 98, 88, 236, 147, 51, 103,
-			69, 253, 70, 150, 152, 231, 140, 237, 178, 132, 136, 121, 110, 199,
-			1, 89, 50, 137, 121, 238, 224, 33, 249, 97, 142, 88, 231, 10,
-			231, 37, 51, 50, 120, 87, 198, 21, 51, 50, 56, 51, 154, 176,
-			14, 65, 246, 123, 32, 202, 73, 99, 216, 62, 167, 89, 76, 131,
-			85, 119, 217, 173, 182, 183, 188, 66, 56, 215, 186, 233, 174, 200,
-			243, 60, 153, 102, 134, 187, 237, 91, 16, 125, 108, 78, 26, 170,
-			148, 37, 230, 100, 111, 191, 44, 33, 98, 78, 14, 12, 202, 18,
-			107, 123, 232, 48, 254, 49, 23, 18, 22, 177, 174, 25, 79, 29,
-			181, 255, 25, 234, 56, 77, 106, 151, 8, 79, 181, 40, 112, 234,
-			33, 216, 180, 156, 202, 149, 86, 73, 253, 0, 183, 219, 237, 42,
-			63, 146, 10, 163, 8, 153, 97, 47, 49, 16, 148, 27, 121, 135,
-			205, 89, 196, 195, 151, 233, 232, 40, 189, 192, 31, 240, 23, 222,
-			73, 210, 97, 177, 106, 22, 140, 125, 114, 88, 76, 140, 149, 37,
-			230, 53, 181, 220, 22, 34, 230, 181, 29, 3, 178, 100, 18, 243,
-			90, 65, 78, 161, 149, 35, 230, 83, 217, 35, 98, 113, 45, 190,
-			218, 79, 229, 14, 227, 15, 242, 57, 205, 16, 243, 186, 49, 96,
-			55, 181, 203, 240, 91, 237, 230, 86, 228, 41, 89, 240, 131, 178,
-			187, 208, 132, 51, 58, 77, 247, 24, 151, 71, 183, 60, 201, 48,
-			215, 95, 175, 55, 42, 78, 228, 198, 84, 67, 155, 245, 200, 171,
-			234, 223, 137, 193, 101, 44, 98, 93, 55, 158, 58, 42, 186, 156,
-			201, 178, 62, 201, 193, 101, 16, 49, 175, 239, 216, 43, 75, 38,
-			49, 175, 31, 232, 199, 31, 204, 66, 239, 179, 196, 90, 52, 150,
-			142, 218, 63, 200, 208, 43, 202, 93, 60, 22, 244, 122, 248, 139,
-			212, 32, 192, 253, 241, 234, 178, 27, 164, 52, 36, 1, 40, 151,
-			88, 86, 135, 94, 191, 50, 121, 245, 138, 166, 129, 209, 138, 23,
-			184, 144, 240, 172, 77, 213, 158, 166, 124, 136, 99, 38, 248, 70,
-			37, 46, 210, 190, 209, 148, 164, 192, 45, 55, 131, 208, 91, 6,
-			126, 154, 206, 143, 121, 46, 173, 140, 233, 93, 100, 246, 128, 212,
-			190, 244, 97, 43, 167, 249, 37, 246, 65, 66, 191, 11, 69, 207,
-			228, 137, 159, 159, 152, 9, 21, 47, 9, 106, 31, 166, 174, 74,
-			209, 45, 125, 38, 20, 176, 12, 247, 230, 19, 231, 142, 234, 27,
-			201, 187, 121, 22, 29, 79, 206, 151, 136, 113, 115, 3, 117, 202,
-			239, 68, 144, 253, 21, 14, 53, 221, 10, 164, 246, 225, 169, 86,
-			228, 1, 50, 227, 37, 169, 229, 20, 178, 41, 92, 114, 26, 170,
-			85, 222, 80, 156, 124, 20, 174, 163, 139, 244, 125, 108, 159, 242,
-			66, 12, 203, 205, 195, 194, 216, 32, 49, 13, 92, 167, 188, 196,
-			125, 82, 181, 6, 32, 105, 32, 45, 175, 148, 171, 194, 1, 22,
-			0, 25, 165, 122, 19, 184, 180, 238, 251, 13, 54, 198, 167, 154,
-			110, 176, 50, 235, 134, 209, 180, 88, 99, 142, 255, 16, 234, 199,
-			158, 218, 194, 75, 54, 180, 236, 210, 114, 213, 15, 249, 172, 225,
-			228, 216, 48, 32, 183, 79, 243, 124, 158, 65, 113, 82, 172, 235,
-			244, 181, 113, 128, 83, 247, 43, 222, 194, 138, 198, 154, 20, 59,
-			205, 90, 196, 92, 84, 236, 52, 155, 33, 230, 98, 175, 20, 59,
-			89, 68, 204, 69, 178, 95, 150, 76, 98, 46, 14, 28, 148, 165,
-			28, 49, 151, 20, 103, 200, 114, 206, 176, 148, 59, 172, 14, 135,
-			62, 188, 19, 143, 220, 195, 225, 80, 35, 112, 185, 175, 203, 59,
-			59, 27, 186, 207, 163, 159, 95, 53, 240, 198, 120, 61, 174, 201,
-			190, 144, 126, 220, 7, 231, 160, 13, 39, 90, 154, 11, 220, 69,
-			247, 118, 67, 220, 196, 62, 204, 158, 95, 115, 162, 37, 56, 221,
-			107, 144, 179, 184, 91, 156, 119, 194, 153, 80, 111, 167, 51, 33,
-			113, 85, 172, 154, 152, 150, 159, 145, 89, 140, 185, 70, 229, 212,
-			203, 43, 112, 30, 244, 240, 240, 209, 246, 149, 180, 233, 106, 241,
-			188, 250, 118, 90, 171, 39, 127, 18, 227, 248, 23, 210, 141, 205,
-			177, 169, 169, 190, 46, 178, 23, 211, 167, 199, 166, 39, 199, 174,
-			204, 206, 204, 49, 187, 121, 238, 250, 149, 243, 63, 119, 237, 252,
-			248, 236, 249, 137, 185, 233, 243, 51, 215, 167, 102, 103, 250, 80,
-			254, 67, 8, 111, 101, 109, 157, 191, 237, 115, 175, 12, 191, 254,
-			83, 153, 155, 252, 63, 65, 184, 47, 253, 43, 57, 130, 51, 144,
-			63, 18, 90, 237, 29, 222, 190, 202, 205, 252, 165, 174, 105, 254,
-			46, 57, 129, 115, 82, 255, 23, 157, 185, 203, 119, 234, 245, 115,
-			189, 184, 71, 81, 233, 125, 157, 33, 253, 229, 102, 126, 134, 52,
-			243, 224, 12, 233, 193, 25, 210, 123, 112, 134, 116, 90, 165, 218,
-			184, 24, 167, 218, 184, 136, 127, 79, 29, 28, 141, 34, 251, 51,
-			9, 103, 41, 135, 46, 52, 235, 224, 6, 68, 99, 30, 67, 7,
-			79, 211, 121, 223, 175, 22, 49, 61, 15, 73, 34, 101, 54, 178,
-			154, 19, 149, 151, 92, 97, 71, 107, 146, 139, 209, 235, 101, 38,
-			158, 203, 2, 100, 138, 131, 230, 203, 92, 167, 112, 237, 39, 108,
-			199, 57, 101, 113, 250, 119, 231, 72, 137, 195, 159, 237, 248, 19,
-			202, 235, 107, 183, 177, 203, 254, 71, 72, 94, 136, 11, 101, 4,
-			110, 153, 4, 138, 132, 184, 129, 143, 150, 120, 159, 185, 58, 2,
-			54, 227, 34, 96, 1, 136, 21, 4, 221, 139, 231, 229, 175, 174,
-			72, 27, 96, 201, 213, 126, 22, 119, 201, 85, 175, 236, 49, 53,
-			238, 86, 224, 64, 114, 73, 216, 45, 31, 0, 57, 191, 55, 113,
-			226, 179, 219, 216, 177, 83, 59, 241, 217, 157, 56, 241, 217, 221,
-			163, 223, 78, 237, 222, 177, 19, 110, 142, 192, 176, 204, 27, 187,
-			237, 211, 171, 12, 72, 94, 44, 134, 78, 228, 133, 11, 43, 106,
-			64, 177, 24, 213, 141, 206, 188, 177, 123, 151, 102, 116, 230, 13,
-			162, 25, 157, 249, 141, 182, 102, 116, 230, 119, 238, 194, 151, 228,
-			49, 210, 62, 227, 49, 211, 30, 161, 23, 188, 42, 104, 66, 9,
-			237, 132, 103, 181, 77, 16, 74, 81, 157, 8, 36, 116, 12, 88,
-			176, 125, 221, 27, 240, 109, 253, 8, 234, 33, 219, 131, 56, 224,
-			68, 165, 124, 56, 222, 138, 200, 241, 192, 182, 225, 149, 201, 43,
-			23, 71, 148, 59, 41, 179, 168, 188, 197, 58, 68, 236, 214, 133,
-			34, 29, 184, 78, 232, 134, 42, 234, 159, 134, 222, 29, 110, 230,
-			87, 157, 8, 210, 245, 166, 207, 170, 186, 147, 103, 85, 184, 23,
-			127, 194, 140, 15, 171, 134, 173, 1, 251, 53, 179, 221, 220, 207,
-			187, 224, 150, 15, 169, 120, 19, 183, 194, 96, 215, 45, 57, 220,
-			65, 195, 15, 32, 245, 58, 166, 205, 122, 202, 195, 33, 132, 200,
-			169, 114, 242, 132, 114, 193, 141, 202, 75, 220, 21, 88, 251, 0,
-			130, 136, 184, 27, 3, 78, 59, 74, 132, 50, 11, 71, 108, 79,
-			170, 101, 7, 46, 167, 231, 69, 79, 221, 95, 131, 233, 157, 182,
-			19, 188, 133, 244, 128, 120, 139, 140, 31, 92, 27, 155, 153, 129,
-			222, 44, 65, 202, 9, 104, 31, 211, 247, 95, 24, 155, 156, 42,
-			80, 254, 47, 123, 229, 57, 121, 191, 174, 153, 69, 130, 54, 83,
-			29, 20, 73, 56, 177, 126, 69, 44, 215, 93, 220, 192, 235, 68,
-			172, 159, 255, 13, 91, 123, 19, 231, 127, 195, 7, 250, 241, 191,
-			65, 226, 8, 196, 60, 101, 236, 176, 223, 108, 203, 4, 96, 207,
-			167, 246, 199, 154, 232, 119, 53, 46, 198, 152, 118, 224, 85, 92,
-			221, 222, 215, 18, 140, 202, 163, 85, 172, 101, 125, 134, 31, 217,
-			164, 86, 157, 0, 208, 238, 52, 130, 77, 28, 205, 156, 50, 30,
-			51, 181, 163, 153, 83, 60, 207, 130, 56, 154, 57, 181, 110, 139,
-			118, 52, 115, 202, 222, 142, 159, 231, 119, 218, 99, 93, 151, 144,
-			253, 129, 85, 216, 185, 166, 198, 173, 157, 167, 187, 241, 71, 97,
-			49, 190, 231, 30, 203, 81, 238, 118, 11, 23, 221, 19, 198, 46,
-			251, 35, 106, 238, 181, 79, 126, 218, 92, 152, 223, 156, 79, 24,
-			99, 121, 237, 230, 124, 34, 113, 115, 62, 33, 184, 48, 191, 57,
-			159, 216, 177, 19, 95, 147, 55, 231, 23, 141, 221, 246, 248, 221,
-			70, 181, 86, 86, 204, 175, 218, 47, 26, 19, 187, 228, 117, 122,
-			150, 181, 64, 180, 171, 246, 139, 130, 21, 243, 171, 246, 139, 59,
-			119, 225, 163, 216, 176, 12, 98, 61, 217, 53, 131, 236, 254, 14,
-			75, 43, 116, 213, 120, 73, 97, 145, 88, 255, 159, 204, 109, 133,
-			59, 1, 35, 215, 69, 172, 41, 99, 154, 19, 149, 145, 99, 195,
-			158, 202, 109, 192, 103, 216, 111, 108, 253, 174, 88, 3, 246, 176,
-			230, 124, 38, 61, 52, 220, 23, 154, 78, 149, 15, 71, 243, 239,
-			16, 89, 219, 5, 127, 55, 192, 239, 226, 138, 181, 75, 150, 16,
-			49, 175, 236, 222, 43, 75, 38, 49, 175, 28, 232, 199, 79, 64,
-			83, 136, 152, 79, 89, 5, 123, 52, 110, 234, 64, 152, 62, 1,
-			228, 141, 203, 147, 105, 158, 99, 91, 250, 127, 115, 207, 36, 94,
-			51, 155, 190, 167, 84, 171, 108, 250, 158, 218, 125, 64, 150, 76,
-			98, 62, 117, 240, 144, 50, 70, 127, 180, 30, 95, 184, 47, 79,
-			133, 57, 225, 190, 229, 213, 23, 223, 153, 97, 250, 14, 108, 225,
-			252, 199, 13, 252, 240, 57, 111, 17, 78, 13, 206, 223, 110, 248,
-			65, 68, 118, 226, 238, 70, 224, 63, 239, 10, 235, 7, 110, 206,
-			141, 105, 249, 140, 253, 92, 113, 34, 39, 116, 185, 197, 37, 127,
-			22, 207, 200, 54, 156, 129, 195, 112, 176, 50, 197, 143, 252, 9,
-			153, 193, 15, 129, 85, 39, 152, 248, 86, 11, 108, 164, 161, 246,
-			6, 91, 178, 83, 154, 89, 26, 78, 247, 70, 113, 193, 126, 26,
-			247, 106, 191, 145, 139, 154, 33, 37, 140, 183, 129, 53, 27, 186,
-			211, 239, 208, 8, 251, 110, 47, 55, 194, 14, 62, 48, 194, 30,
-			24, 97, 239, 129, 17, 54, 222, 222, 8, 187, 195, 109, 176, 109,
-			93, 7, 145, 93, 167, 201, 141, 148, 206, 136, 36, 21, 42, 201,
-			5, 219, 94, 151, 185, 240, 169, 136, 83, 146, 245, 9, 32, 133,
-			5, 237, 62, 65, 51, 162, 182, 229, 54, 227, 130, 180, 161, 182,
-			91, 231, 236, 221, 112, 14, 46, 169, 73, 85, 34, 184, 74, 194,
-			176, 217, 110, 109, 219, 170, 25, 54, 219, 45, 172, 25, 54, 219,
-			123, 137, 102, 216, 108, 127, 100, 179, 44, 229, 136, 185, 125, 203,
-			88, 242, 38, 123, 251, 182, 51, 162, 23, 136, 152, 187, 58, 247,
-			98, 130, 51, 175, 132, 97, 179, 203, 218, 126, 78, 26, 47, 25,
-			246, 53, 214, 12, 155, 93, 170, 23, 76, 28, 236, 82, 189, 96,
-			171, 177, 75, 245, 66, 220, 166, 237, 218, 118, 6, 31, 148, 170,
-			228, 30, 235, 172, 189, 179, 125, 47, 102, 121, 230, 42, 77, 89,
-			219, 99, 237, 146, 125, 48, 50, 236, 91, 172, 41, 107, 123, 122,
-			251, 52, 101, 109, 207, 70, 121, 253, 111, 228, 136, 185, 231, 145,
-			51, 201, 107, 180, 61, 91, 70, 65, 247, 232, 50, 187, 136, 181,
-			215, 234, 207, 216, 231, 52, 237, 180, 61, 109, 36, 205, 167, 22,
-			106, 144, 54, 152, 201, 150, 101, 239, 67, 91, 240, 13, 102, 232,
-			64, 178, 107, 243, 64, 182, 223, 126, 10, 142, 141, 99, 173, 24,
-			82, 230, 179, 113, 38, 234, 133, 150, 90, 43, 7, 163, 93, 205,
-			140, 200, 233, 5, 57, 127, 187, 68, 246, 234, 3, 217, 189, 219,
-			184, 202, 110, 114, 247, 204, 3, 89, 173, 140, 136, 121, 192, 126,
-			52, 46, 155, 196, 60, 176, 255, 0, 238, 149, 23, 135, 3, 214,
-			30, 49, 207, 166, 69, 172, 1, 171, 63, 163, 221, 250, 13, 88,
-			235, 181, 91, 191, 129, 190, 29, 218, 173, 223, 192, 110, 170, 68,
-			255, 239, 18, 188, 61, 237, 48, 8, 88, 23, 157, 146, 120, 117,
-			227, 12, 168, 196, 231, 150, 219, 39, 228, 194, 240, 171, 76, 198,
-			117, 224, 238, 201, 184, 160, 181, 123, 72, 196, 245, 183, 125, 76,
-			66, 237, 234, 58, 210, 247, 32, 15, 215, 131, 60, 92, 15, 242,
-			112, 61, 200, 195, 245, 32, 15, 215, 131, 60, 92, 239, 97, 30,
-			174, 211, 82, 81, 101, 127, 202, 60, 92, 42, 229, 214, 94, 149,
-			114, 107, 95, 215, 33, 153, 114, 139, 253, 41, 243, 112, 169, 148,
-			91, 251, 85, 202, 173, 3, 113, 202, 45, 246, 167, 204, 195, 165,
-			18, 126, 177, 63, 255, 198, 0, 149, 216, 60, 210, 213, 103, 255,
-			165, 65, 199, 120, 40, 184, 87, 22, 200, 84, 242, 128, 10, 68,
-			0, 179, 163, 202, 78, 157, 6, 238, 32, 152, 81, 62, 117, 150,
-			125, 79, 226, 30, 51, 246, 215, 4, 187, 5, 244, 225, 196, 247,
-			192, 126, 87, 252, 102, 64, 199, 174, 77, 134, 69, 58, 166, 98,
-			117, 116, 248, 88, 159, 203, 47, 0, 151, 215, 210, 227, 66, 90,
-			47, 225, 39, 199, 143, 238, 32, 245, 3, 135, 99, 29, 187, 54,
-			169, 178, 13, 92, 128, 252, 84, 97, 196, 172, 56, 41, 141, 152,
-			124, 245, 202, 9, 236, 106, 74, 131, 70, 153, 158, 115, 130, 254,
-			180, 235, 60, 232, 24, 3, 202, 47, 160, 195, 239, 34, 101, 205,
-			203, 194, 109, 2, 178, 78, 40, 231, 12, 206, 230, 153, 197, 115,
-			3, 222, 190, 1, 129, 116, 48, 23, 240, 162, 200, 115, 112, 227,
-			197, 151, 111, 104, 54, 193, 145, 220, 58, 165, 58, 125, 112, 20,
-			183, 224, 47, 240, 195, 141, 154, 19, 222, 236, 164, 63, 237, 193,
-			61, 128, 80, 112, 217, 9, 111, 146, 77, 56, 211, 112, 162, 165,
-			16, 162, 167, 123, 166, 121, 225, 220, 63, 66, 29, 114, 157, 170,
-			15, 165, 122, 117, 98, 21, 88, 131, 54, 125, 58, 25, 255, 185,
-			86, 109, 235, 215, 78, 242, 180, 167, 127, 243, 32, 237, 233, 3,
-			117, 235, 129, 186, 245, 64, 221, 122, 160, 110, 61, 80, 183, 222,
-			83, 117, 75, 105, 86, 143, 42, 205, 106, 111, 156, 204, 116, 175,
-			74, 102, 186, 47, 214, 172, 246, 41, 205, 106, 127, 172, 89, 237,
-			87, 154, 213, 129, 174, 107, 82, 133, 99, 127, 254, 235, 2, 104,
-			86, 217, 31, 33, 38, 229, 236, 207, 20, 232, 13, 37, 104, 111,
-			36, 83, 156, 74, 176, 246, 149, 218, 188, 95, 85, 96, 40, 32,
-			181, 19, 249, 19, 36, 207, 135, 95, 70, 104, 126, 161, 232, 228,
-			211, 79, 230, 139, 149, 60, 198, 244, 146, 27, 184, 244, 198, 66,
-			170, 33, 149, 133, 31, 98, 139, 0, 101, 91, 104, 104, 5, 122,
-			195, 185, 1, 44, 246, 198, 252, 13, 172, 129, 48, 48, 254, 38,
-			181, 192, 5, 191, 89, 135, 207, 111, 44, 220, 224, 73, 204, 110,
-			84, 110, 168, 106, 213, 175, 192, 80, 21, 120, 30, 123, 187, 56,
-			15, 57, 246, 46, 136, 4, 243, 224, 210, 26, 196, 92, 145, 115,
-			181, 149, 4, 114, 189, 232, 65, 242, 16, 12, 11, 221, 76, 28,
-			147, 211, 69, 246, 110, 67, 222, 15, 130, 15, 64, 133, 131, 116,
-			241, 212, 93, 77, 112, 103, 142, 95, 41, 166, 250, 0, 225, 67,
-			252, 66, 177, 220, 12, 35, 191, 198, 149, 52, 200, 223, 5, 9,
-			14, 66, 215, 5, 7, 132, 91, 34, 221, 39, 255, 250, 50, 124,
-			237, 213, 153, 178, 194, 212, 57, 0, 175, 199, 220, 193, 157, 199,
-			239, 240, 121, 3, 36, 214, 219, 34, 199, 66, 67, 189, 91, 224,
-			112, 5, 92, 151, 149, 243, 228, 7, 152, 141, 126, 80, 3, 29,
-			92, 0, 15, 16, 62, 24, 184, 245, 0, 144, 16, 238, 23, 155,
-			188, 169, 19, 147, 229, 132, 56, 70, 194, 80, 139, 199, 180, 198,
-			22, 215, 3, 249, 139, 124, 171, 17, 184, 203, 158, 223, 12, 177,
-			174, 145, 195, 61, 4, 95, 162, 54, 61, 118, 66, 33, 138, 67,
-			73, 151, 11, 177, 138, 237, 208, 17, 58, 60, 44, 75, 243, 241,
-			15, 148, 86, 232, 136, 4, 168, 97, 255, 221, 102, 111, 202, 226,
-			203, 242, 143, 21, 246, 210, 17, 172, 63, 188, 51, 66, 31, 23,
-			58, 183, 240, 51, 0, 15, 119, 129, 195, 11, 179, 161, 101, 226,
-			128, 152, 119, 182, 119, 196, 220, 220, 46, 240, 104, 248, 59, 24,
-			110, 136, 188, 64, 132, 92, 41, 200, 90, 55, 138, 209, 140, 193,
-			137, 128, 83, 184, 8, 189, 82, 120, 36, 0, 211, 134, 5, 48,
-			195, 192, 8, 190, 255, 161, 191, 28, 219, 17, 99, 49, 232, 122,
-			2, 10, 66, 186, 50, 139, 123, 42, 161, 23, 2, 162, 92, 195,
-			15, 85, 220, 191, 131, 57, 3, 16, 209, 29, 144, 24, 129, 145,
-			156, 226, 55, 210, 236, 16, 213, 106, 169, 35, 82, 123, 72, 228,
-			78, 140, 247, 20, 39, 1, 48, 206, 52, 112, 22, 72, 147, 151,
-			106, 97, 65, 123, 1, 211, 37, 135, 237, 87, 183, 30, 211, 227,
-			64, 210, 129, 198, 81, 112, 19, 225, 205, 216, 23, 188, 238, 150,
-			25, 117, 5, 94, 149, 223, 130, 173, 136, 53, 193, 52, 242, 27,
-			34, 132, 37, 77, 136, 144, 102, 14, 242, 177, 192, 62, 155, 62,
-			63, 51, 219, 118, 80, 90, 123, 114, 84, 42, 50, 64, 172, 188,
-			172, 90, 66, 152, 164, 170, 197, 66, 45, 77, 84, 44, 183, 81,
-			24, 185, 78, 69, 159, 47, 64, 57, 246, 234, 21, 111, 217, 171,
-			52, 157, 170, 236, 46, 142, 177, 156, 5, 43, 147, 56, 26, 80,
-			123, 187, 209, 8, 222, 196, 237, 220, 2, 22, 250, 108, 236, 27,
-			144, 80, 51, 139, 140, 15, 5, 110, 220, 53, 217, 37, 65, 233,
-			152, 150, 171, 174, 19, 84, 87, 148, 174, 12, 59, 124, 81, 228,
-			90, 97, 26, 177, 7, 218, 104, 12, 1, 46, 58, 60, 118, 109,
-			178, 72, 233, 36, 68, 74, 172, 64, 31, 249, 4, 184, 28, 199,
-			200, 239, 48, 172, 146, 252, 35, 212, 83, 68, 226, 24, 15, 137,
-			237, 82, 56, 23, 104, 199, 98, 121, 68, 10, 189, 42, 231, 60,
-			132, 237, 162, 173, 165, 215, 202, 230, 67, 69, 117, 161, 200, 145,
-			41, 232, 150, 223, 43, 97, 26, 57, 193, 162, 27, 233, 83, 207,
-			196, 209, 162, 47, 82, 79, 179, 153, 132, 42, 43, 60, 37, 38,
-			99, 188, 137, 174, 43, 46, 28, 227, 66, 37, 147, 15, 41, 134,
-			220, 158, 31, 243, 88, 147, 170, 203, 68, 15, 123, 6, 11, 26,
-			210, 102, 61, 242, 155, 229, 37, 214, 44, 236, 95, 213, 59, 15,
-			178, 71, 74, 201, 226, 99, 153, 179, 137, 127, 45, 250, 170, 242,
-			119, 72, 89, 193, 1, 116, 194, 84, 247, 156, 106, 85, 201, 119,
-			206, 59, 151, 117, 33, 3, 226, 66, 50, 144, 86, 174, 20, 15,
-			103, 1, 144, 172, 90, 230, 94, 144, 196, 109, 15, 50, 155, 224,
-			184, 138, 36, 58, 21, 95, 129, 120, 132, 10, 251, 124, 217, 13,
-			164, 85, 35, 122, 20, 67, 91, 21, 147, 12, 164, 181, 119, 176,
-			40, 146, 105, 138, 150, 90, 185, 37, 189, 1, 220, 242, 6, 78,
-			243, 203, 148, 252, 109, 89, 186, 150, 170, 34, 13, 53, 39, 188,
-			41, 166, 4, 183, 159, 147, 122, 98, 98, 146, 109, 181, 159, 19,
-			47, 196, 250, 124, 20, 233, 69, 158, 166, 41, 126, 85, 84, 208,
-			70, 254, 222, 187, 196, 45, 199, 47, 129, 72, 226, 216, 238, 98,
-			40, 247, 210, 208, 80, 27, 1, 7, 227, 247, 90, 38, 204, 227,
-			170, 131, 166, 189, 230, 229, 203, 81, 74, 204, 207, 223, 95, 227,
-			45, 227, 186, 228, 223, 114, 151, 221, 64, 41, 65, 114, 128, 172,
-			59, 183, 156, 150, 254, 112, 109, 186, 165, 71, 66, 31, 189, 183,
-			46, 173, 113, 230, 39, 153, 38, 91, 225, 1, 244, 144, 245, 72,
-			202, 202, 3, 50, 76, 60, 173, 167, 104, 179, 218, 12, 35, 198,
-			89, 117, 102, 3, 188, 166, 85, 189, 145, 33, 231, 82, 255, 19,
-			71, 34, 146, 250, 138, 204, 134, 168, 151, 121, 154, 211, 116, 127,
-			98, 77, 192, 215, 249, 84, 65, 86, 67, 157, 216, 19, 83, 158,
-			200, 198, 160, 103, 218, 121, 89, 170, 54, 173, 215, 128, 216, 87,
-			241, 177, 80, 88, 100, 181, 124, 64, 161, 98, 128, 21, 174, 158,
-			171, 45, 156, 32, 174, 132, 178, 227, 75, 98, 230, 243, 213, 78,
-			197, 193, 109, 117, 28, 173, 202, 132, 146, 3, 206, 185, 45, 58,
-			142, 198, 161, 98, 213, 26, 48, 200, 192, 196, 43, 47, 185, 53,
-			135, 186, 203, 34, 61, 168, 134, 98, 87, 115, 157, 186, 192, 30,
-			86, 182, 143, 43, 220, 96, 99, 5, 233, 102, 29, 144, 207, 184,
-			103, 48, 36, 51, 131, 76, 238, 236, 167, 5, 15, 242, 204, 121,
-			117, 54, 16, 237, 252, 92, 3, 172, 143, 169, 192, 11, 228, 10,
-			21, 85, 248, 172, 199, 36, 208, 45, 167, 30, 233, 210, 185, 160,
-			39, 184, 147, 167, 233, 172, 191, 66, 26, 50, 211, 137, 247, 17,
-			84, 68, 200, 234, 44, 236, 57, 156, 152, 188, 130, 56, 233, 130,
-			76, 96, 18, 186, 205, 91, 96, 93, 103, 171, 55, 38, 50, 162,
-			38, 52, 55, 33, 204, 170, 210, 241, 38, 77, 66, 32, 220, 99,
-			105, 24, 182, 17, 135, 177, 182, 197, 39, 67, 242, 92, 14, 35,
-			18, 10, 173, 69, 87, 121, 111, 122, 117, 158, 186, 184, 189, 150,
-			211, 134, 137, 225, 216, 123, 139, 107, 15, 75, 62, 71, 149, 140,
-			13, 55, 208, 109, 30, 165, 227, 34, 145, 158, 80, 83, 152, 192,
-			184, 52, 59, 123, 141, 107, 151, 220, 182, 129, 50, 235, 131, 184,
-			225, 72, 11, 19, 161, 209, 8, 167, 102, 173, 31, 106, 239, 139,
-			85, 190, 54, 54, 59, 126, 73, 41, 166, 254, 2, 189, 118, 125,
-			54, 177, 153, 165, 171, 56, 180, 24, 186, 53, 167, 30, 121, 229,
-			16, 211, 126, 246, 34, 156, 216, 130, 60, 149, 199, 151, 96, 76,
-			53, 171, 85, 209, 163, 80, 152, 196, 96, 49, 159, 151, 22, 179,
-			191, 160, 43, 112, 113, 62, 248, 66, 203, 222, 76, 166, 128, 231,
-			201, 9, 69, 116, 58, 207, 187, 206, 77, 26, 56, 239, 14, 221,
-			134, 19, 168, 148, 220, 180, 236, 215, 106, 78, 145, 55, 20, 242,
-			147, 89, 175, 206, 245, 109, 112, 116, 102, 202, 92, 34, 133, 116,
-			9, 162, 58, 153, 90, 16, 12, 150, 157, 154, 91, 101, 31, 137,
-			131, 117, 1, 128, 25, 10, 34, 116, 234, 177, 157, 172, 210, 30,
-			38, 143, 157, 99, 18, 82, 42, 178, 50, 129, 229, 111, 215, 2,
-			31, 60, 12, 149, 48, 184, 30, 186, 1, 155, 199, 128, 142, 210,
-			195, 39, 229, 211, 107, 75, 204, 164, 108, 192, 191, 163, 116, 248,
-			100, 194, 224, 149, 117, 193, 167, 170, 34, 49, 71, 21, 47, 108,
-			84, 157, 149, 57, 137, 110, 123, 50, 245, 187, 83, 169, 128, 103,
-			158, 94, 43, 172, 6, 183, 98, 157, 180, 222, 114, 67, 244, 248,
-			6, 108, 238, 170, 239, 3, 155, 13, 155, 229, 37, 53, 52, 246,
-			170, 234, 134, 148, 145, 108, 72, 69, 189, 51, 249, 244, 27, 48,
-			186, 124, 162, 15, 156, 34, 34, 153, 79, 84, 146, 68, 10, 177,
-			7, 56, 187, 108, 93, 53, 204, 94, 78, 181, 123, 197, 169, 185,
-			133, 116, 51, 73, 75, 130, 177, 204, 171, 117, 87, 146, 103, 152,
-			58, 141, 138, 2, 215, 137, 52, 41, 228, 179, 87, 67, 250, 60,
-			219, 4, 78, 236, 43, 207, 95, 40, 170, 109, 44, 12, 203, 52,
-			101, 164, 137, 97, 6, 232, 233, 178, 40, 169, 145, 64, 35, 60,
-			135, 32, 255, 83, 211, 28, 146, 216, 197, 71, 79, 198, 191, 204,
-			52, 231, 101, 77, 97, 115, 126, 78, 182, 49, 74, 79, 156, 108,
-			163, 120, 205, 38, 121, 21, 15, 131, 89, 125, 69, 181, 69, 100,
-			53, 92, 13, 86, 127, 93, 235, 132, 246, 85, 44, 5, 197, 40,
-			87, 26, 242, 158, 164, 63, 31, 15, 57, 175, 220, 43, 25, 163,
-			29, 96, 253, 19, 24, 146, 226, 28, 77, 156, 108, 8, 246, 25,
-			175, 40, 125, 218, 13, 212, 85, 154, 76, 62, 194, 102, 57, 190,
-			252, 17, 240, 80, 241, 13, 177, 224, 156, 75, 192, 113, 226, 147,
-			11, 232, 154, 58, 25, 133, 21, 149, 194, 66, 28, 60, 46, 179,
-			182, 86, 146, 233, 0, 18, 103, 180, 60, 62, 159, 217, 189, 160,
-			255, 183, 98, 179, 198, 226, 142, 117, 9, 152, 148, 23, 106, 247,
-			229, 76, 217, 105, 214, 107, 78, 163, 33, 252, 249, 122, 249, 205,
-			176, 245, 35, 148, 219, 160, 220, 69, 173, 255, 140, 140, 29, 246,
-			46, 30, 81, 175, 29, 147, 242, 149, 145, 19, 21, 187, 139, 254,
-			103, 100, 168, 98, 134, 21, 123, 85, 122, 35, 196, 138, 100, 139,
-			44, 154, 172, 104, 111, 143, 211, 122, 239, 195, 187, 210, 151, 208,
-			21, 129, 96, 221, 233, 10, 122, 4, 231, 36, 200, 245, 61, 195,
-			112, 190, 212, 254, 106, 122, 157, 172, 80, 222, 76, 31, 188, 187,
-			227, 159, 236, 230, 61, 248, 254, 253, 248, 81, 238, 251, 23, 61,
-			184, 140, 126, 112, 25, 253, 224, 50, 250, 193, 101, 244, 131, 203,
-			232, 7, 151, 209, 63, 117, 12, 206, 177, 24, 131, 115, 108, 117,
-			12, 206, 98, 140, 193, 89, 188, 7, 12, 206, 127, 222, 199, 195,
-			97, 22, 186, 34, 100, 255, 74, 31, 29, 163, 82, 220, 166, 174,
-			167, 189, 197, 186, 91, 41, 208, 5, 239, 182, 91, 25, 228, 233,
-			140, 105, 216, 112, 184, 61, 238, 213, 92, 93, 113, 199, 220, 160,
-			227, 240, 36, 58, 186, 102, 103, 216, 77, 13, 57, 5, 39, 160,
-			83, 56, 226, 101, 27, 200, 79, 137, 149, 41, 51, 11, 242, 116,
-			114, 144, 13, 48, 95, 113, 86, 242, 224, 50, 152, 7, 16, 174,
-			188, 172, 38, 112, 171, 142, 200, 155, 23, 231, 205, 242, 234, 177,
-			132, 147, 9, 234, 203, 42, 177, 17, 6, 44, 174, 248, 109, 121,
-			162, 192, 6, 168, 166, 138, 117, 193, 83, 1, 230, 28, 197, 203,
-			15, 152, 110, 30, 177, 225, 178, 25, 1, 235, 211, 209, 128, 225,
-			98, 216, 76, 167, 209, 8, 252, 219, 30, 19, 7, 213, 21, 122,
-			104, 240, 240, 80, 97, 104, 104, 8, 80, 63, 195, 181, 32, 76,
-			170, 110, 240, 188, 69, 122, 119, 65, 238, 53, 66, 183, 89, 241,
-			65, 248, 182, 226, 77, 134, 145, 19, 68, 116, 148, 22, 139, 197,
-			147, 233, 223, 220, 122, 37, 241, 139, 106, 72, 234, 87, 242, 87,
-			254, 179, 82, 14, 229, 178, 142, 178, 26, 84, 105, 144, 183, 37,
-			203, 39, 83, 31, 113, 124, 27, 254, 9, 255, 91, 126, 0, 37,
-			217, 136, 183, 64, 251, 91, 26, 58, 69, 135, 232, 254, 253, 233,
-			186, 78, 211, 161, 129, 216, 86, 105, 249, 232, 144, 110, 49, 167,
-			62, 29, 28, 85, 64, 131, 67, 67, 210, 118, 166, 110, 149, 233,
-			87, 90, 7, 66, 85, 217, 233, 182, 61, 56, 181, 122, 15, 6,
-			87, 233, 193, 161, 118, 61, 88, 19, 154, 103, 92, 60, 20, 175,
-			216, 189, 147, 65, 199, 197, 238, 76, 36, 252, 39, 125, 205, 71,
-			147, 107, 78, 15, 181, 76, 194, 201, 248, 35, 73, 1, 218, 170,
-			235, 31, 180, 144, 65, 252, 77, 114, 158, 19, 68, 167, 79, 113,
-			252, 65, 219, 217, 141, 215, 55, 126, 241, 180, 254, 98, 135, 54,
-			14, 181, 111, 163, 45, 13, 181, 71, 43, 77, 238, 96, 5, 141,
-			198, 254, 169, 184, 85, 176, 49, 210, 104, 141, 108, 210, 91, 95,
-			236, 175, 56, 43, 225, 232, 145, 130, 68, 3, 30, 61, 44, 177,
-			37, 181, 85, 148, 173, 245, 167, 126, 42, 94, 8, 124, 64, 57,
-			229, 85, 69, 149, 53, 131, 59, 170, 254, 175, 134, 237, 24, 56,
-			66, 119, 117, 184, 133, 204, 253, 17, 10, 226, 164, 143, 231, 186,
-			133, 23, 225, 76, 86, 168, 207, 2, 133, 48, 31, 230, 105, 191,
-			8, 192, 131, 155, 51, 62, 245, 3, 60, 78, 148, 199, 252, 151,
-			1, 160, 88, 94, 24, 54, 107, 243, 60, 7, 115, 140, 148, 200,
-			116, 107, 13, 68, 85, 195, 112, 116, 66, 172, 161, 43, 82, 133,
-			178, 154, 240, 152, 57, 162, 196, 21, 212, 148, 0, 100, 213, 221,
-			147, 228, 216, 189, 196, 68, 177, 169, 200, 31, 9, 243, 5, 1,
-			15, 121, 36, 33, 21, 19, 232, 140, 188, 46, 44, 2, 254, 68,
-			23, 219, 213, 86, 148, 212, 117, 152, 213, 203, 234, 73, 213, 138,
-			105, 205, 43, 7, 201, 122, 215, 90, 237, 225, 48, 159, 128, 123,
-			91, 200, 245, 225, 111, 170, 196, 63, 55, 141, 77, 246, 191, 69,
-			116, 6, 212, 2, 29, 234, 141, 7, 249, 198, 122, 65, 10, 142,
-			108, 240, 200, 225, 99, 133, 99, 143, 29, 103, 18, 142, 253, 15,
-			247, 232, 135, 82, 15, 53, 136, 50, 56, 68, 2, 40, 142, 208,
-			165, 243, 126, 83, 34, 87, 139, 180, 183, 220, 56, 25, 193, 244,
-			56, 0, 219, 150, 106, 94, 157, 30, 100, 133, 154, 87, 47, 45,
-			5, 244, 32, 29, 62, 74, 151, 130, 82, 197, 89, 161, 7, 233,
-			145, 227, 199, 138, 195, 199, 40, 219, 35, 37, 192, 212, 22, 88,
-			170, 92, 212, 234, 167, 38, 55, 141, 5, 162, 5, 217, 222, 76,
-			192, 197, 221, 76, 192, 197, 221, 36, 27, 241, 171, 166, 12, 163,
-			13, 13, 98, 255, 149, 33, 231, 229, 222, 112, 226, 244, 233, 195,
-			241, 252, 201, 205, 21, 82, 8, 18, 135, 253, 227, 215, 93, 85,
-			91, 144, 208, 189, 36, 32, 209, 16, 166, 55, 196, 178, 220, 16,
-			39, 68, 2, 40, 211, 23, 25, 13, 253, 128, 42, 112, 185, 27,
-			208, 33, 241, 34, 167, 123, 37, 227, 160, 43, 90, 131, 34, 205,
-			78, 129, 58, 0, 80, 119, 199, 13, 124, 113, 143, 40, 131, 188,
-			19, 181, 201, 148, 22, 88, 13, 207, 1, 32, 192, 197, 186, 140,
-			241, 72, 245, 51, 77, 49, 9, 240, 58, 70, 45, 218, 131, 54,
-			104, 127, 200, 34, 86, 104, 220, 220, 164, 69, 39, 135, 137, 92,
-			191, 97, 34, 215, 111, 168, 1, 217, 253, 233, 85, 124, 234, 30,
-			178, 69, 104, 233, 26, 222, 89, 138, 138, 187, 28, 179, 253, 196,
-			112, 55, 62, 146, 193, 56, 14, 115, 94, 21, 119, 131, 226, 30,
-			149, 115, 48, 206, 108, 145, 153, 206, 201, 140, 131, 228, 81, 220,
-			195, 27, 156, 243, 42, 34, 189, 69, 246, 219, 99, 153, 111, 143,
-			25, 211, 57, 254, 195, 100, 133, 140, 196, 9, 9, 173, 187, 230,
-			0, 228, 45, 168, 52, 141, 187, 113, 78, 166, 9, 2, 228, 140,
-			156, 232, 129, 124, 72, 70, 113, 54, 140, 156, 168, 25, 2, 46,
-			198, 195, 195, 180, 115, 106, 139, 25, 120, 143, 87, 32, 62, 34,
-			69, 220, 23, 54, 107, 53, 39, 88, 153, 171, 57, 193, 205, 138,
-			127, 171, 190, 181, 59, 30, 233, 122, 241, 227, 101, 241, 27, 57,
-			141, 49, 104, 42, 28, 203, 35, 183, 22, 44, 143, 204, 116, 15,
-			124, 2, 64, 30, 35, 56, 39, 215, 121, 107, 15, 124, 189, 173,
-			229, 107, 185, 247, 197, 80, 229, 251, 228, 49, 1, 63, 130, 215,
-			2, 63, 194, 63, 230, 24, 36, 83, 120, 189, 87, 111, 52, 163,
-			57, 39, 136, 188, 5, 167, 28, 133, 91, 123, 219, 214, 33, 38,
-			107, 76, 188, 198, 235, 120, 24, 190, 149, 207, 66, 114, 5, 247,
-			113, 39, 72, 173, 186, 135, 214, 94, 221, 122, 254, 177, 170, 47,
-			255, 37, 132, 115, 178, 68, 182, 36, 200, 49, 73, 139, 144, 187,
-			107, 174, 25, 84, 19, 180, 8, 79, 175, 7, 85, 178, 11, 231,
-			150, 61, 247, 22, 188, 96, 198, 47, 116, 179, 135, 236, 247, 253,
-			248, 33, 240, 203, 173, 71, 115, 108, 174, 128, 22, 197, 59, 189,
-			226, 135, 217, 149, 134, 203, 186, 16, 122, 119, 56, 80, 139, 41,
-			186, 192, 30, 48, 90, 20, 239, 113, 98, 123, 72, 244, 64, 62,
-			204, 255, 7, 132, 215, 167, 50, 63, 173, 186, 191, 182, 183, 236,
-			47, 109, 107, 29, 139, 119, 141, 121, 215, 93, 19, 111, 152, 65,
-			252, 176, 150, 58, 137, 109, 75, 43, 209, 242, 58, 237, 215, 201,
-			10, 57, 142, 55, 185, 183, 27, 85, 167, 206, 95, 87, 123, 32,
-			19, 79, 206, 70, 237, 5, 185, 15, 14, 254, 28, 103, 34, 124,
-			75, 145, 205, 152, 204, 204, 142, 205, 94, 159, 73, 193, 196, 228,
-			176, 117, 109, 108, 102, 166, 15, 177, 191, 46, 140, 77, 78, 245,
-			25, 164, 7, 103, 198, 167, 199, 102, 46, 245, 153, 236, 207, 177,
-			115, 87, 167, 103, 251, 44, 246, 251, 204, 147, 147, 215, 250, 50,
-			79, 252, 246, 4, 238, 38, 153, 135, 187, 222, 70, 15, 82, 198,
-			60, 72, 25, 243, 30, 164, 140, 57, 16, 99, 191, 240, 167, 38,
-			49, 31, 233, 62, 141, 255, 150, 71, 200, 90, 187, 187, 34, 100,
-			127, 223, 0, 95, 237, 24, 57, 81, 230, 3, 115, 170, 58, 86,
-			166, 128, 78, 80, 126, 13, 49, 100, 36, 179, 143, 36, 128, 97,
-			173, 89, 141, 60, 102, 13, 130, 72, 87, 94, 61, 234, 177, 72,
-			4, 82, 192, 169, 138, 0, 31, 46, 249, 170, 106, 0, 222, 22,
-			119, 19, 49, 86, 39, 255, 98, 85, 176, 78, 193, 56, 66, 76,
-			251, 5, 46, 103, 65, 225, 112, 2, 236, 102, 129, 186, 81, 121,
-			128, 39, 11, 247, 194, 216, 9, 66, 122, 38, 201, 32, 142, 40,
-			198, 222, 140, 150, 56, 198, 77, 108, 124, 56, 203, 142, 87, 21,
-			41, 188, 19, 161, 37, 112, 10, 231, 214, 230, 93, 240, 123, 138,
-			235, 215, 130, 100, 119, 231, 8, 254, 109, 67, 94, 133, 238, 55,
-			6, 44, 251, 55, 140, 213, 161, 103, 180, 124, 42, 237, 177, 103,
-			98, 13, 40, 133, 61, 131, 105, 126, 21, 244, 25, 80, 255, 194,
-			210, 139, 215, 167, 167, 230, 206, 207, 140, 143, 93, 59, 63, 49,
-			55, 123, 126, 102, 118, 238, 218, 216, 236, 165, 151, 133, 242, 21,
-			150, 94, 228, 41, 84, 217, 23, 249, 34, 166, 109, 223, 166, 162,
-			155, 192, 253, 169, 27, 150, 29, 153, 84, 47, 190, 23, 212, 226,
-			112, 27, 55, 23, 75, 117, 55, 42, 53, 131, 106, 233, 81, 38,
-			32, 206, 195, 23, 107, 71, 195, 217, 111, 236, 214, 209, 112, 246,
-			39, 114, 163, 238, 79, 160, 225, 236, 79, 160, 225, 236, 39, 3,
-			41, 52, 156, 3, 105, 52, 156, 126, 235, 16, 254, 245, 140, 52,
-			135, 70, 141, 113, 251, 87, 51, 144, 75, 7, 104, 129, 25, 13,
-			205, 186, 247, 66, 83, 162, 61, 46, 120, 220, 74, 87, 20, 3,
-			124, 18, 120, 185, 74, 126, 20, 227, 248, 189, 127, 100, 164, 17,
-			120, 245, 104, 100, 228, 185, 231, 14, 237, 21, 14, 127, 209, 45,
-			95, 64, 131, 202, 48, 30, 208, 113, 181, 164, 135, 141, 192, 93,
-			240, 110, 11, 250, 82, 6, 188, 131, 193, 126, 113, 170, 141, 37,
-			167, 222, 172, 65, 196, 123, 121, 201, 129, 3, 219, 0, 206, 53,
-			86, 148, 247, 143, 11, 150, 147, 19, 72, 172, 212, 192, 111, 54,
-			138, 234, 88, 22, 192, 37, 243, 78, 105, 190, 84, 206, 171, 63,
-			43, 241, 159, 238, 200, 109, 189, 176, 34, 11, 11, 121, 177, 145,
-			120, 226, 208, 48, 117, 155, 8, 173, 240, 202, 199, 170, 85, 234,
-			69, 110, 45, 212, 82, 161, 50, 147, 12, 94, 81, 217, 47, 197,
-			60, 138, 241, 139, 81, 231, 157, 18, 180, 247, 62, 47, 90, 146,
-			71, 221, 240, 153, 244, 25, 13, 194, 136, 30, 229, 183, 162, 220,
-			253, 121, 149, 74, 1, 249, 85, 213, 59, 223, 177, 102, 53, 108,
-			96, 68, 106, 220, 171, 181, 194, 170, 110, 219, 123, 246, 105, 190,
-			40, 102, 74, 207, 225, 234, 213, 233, 245, 201, 34, 230, 212, 162,
-			120, 16, 92, 199, 55, 2, 55, 138, 9, 0, 78, 147, 156, 250,
-			138, 184, 119, 189, 229, 172, 36, 172, 197, 81, 99, 192, 210, 172,
-			197, 81, 181, 29, 56, 8, 163, 158, 203, 106, 52, 145, 203, 106,
-			116, 203, 185, 100, 46, 171, 209, 109, 103, 241, 243, 18, 25, 102,
-			204, 152, 176, 236, 127, 64, 39, 37, 157, 135, 50, 17, 167, 144,
-			29, 94, 12, 154, 160, 229, 21, 3, 198, 173, 177, 78, 141, 246,
-			157, 176, 236, 121, 35, 207, 61, 247, 226, 225, 194, 145, 225, 151,
-			247, 38, 114, 97, 141, 25, 163, 227, 90, 46, 172, 49, 53, 6,
-			182, 11, 199, 212, 24, 12, 147, 152, 99, 143, 108, 214, 48, 101,
-			198, 182, 76, 164, 48, 101, 206, 89, 135, 146, 152, 50, 227, 214,
-			65, 124, 75, 164, 134, 178, 46, 25, 147, 195, 182, 71, 39, 192,
-			221, 178, 33, 143, 45, 224, 120, 64, 202, 233, 91, 14, 40, 57,
-			65, 179, 94, 151, 240, 19, 108, 68, 5, 76, 5, 138, 174, 122,
-			83, 226, 175, 10, 128, 85, 113, 66, 17, 139, 43, 53, 68, 211,
-			98, 45, 79, 88, 90, 26, 170, 75, 198, 14, 45, 13, 213, 165,
-			157, 123, 181, 52, 84, 151, 14, 40, 96, 154, 28, 49, 39, 179,
-			135, 197, 128, 76, 62, 194, 201, 92, 9, 255, 15, 166, 192, 166,
-			49, 167, 141, 179, 246, 175, 153, 244, 125, 2, 105, 88, 115, 241,
-			22, 158, 89, 92, 106, 115, 145, 41, 114, 160, 74, 155, 179, 8,
-			231, 161, 142, 74, 101, 33, 129, 104, 233, 248, 84, 129, 158, 56,
-			177, 239, 144, 170, 67, 102, 244, 226, 240, 197, 234, 235, 235, 161,
-			27, 240, 135, 113, 182, 90, 160, 96, 55, 228, 58, 23, 148, 185,
-			79, 107, 107, 86, 99, 240, 209, 111, 253, 129, 238, 25, 213, 58,
-			46, 112, 134, 139, 2, 200, 3, 218, 82, 170, 130, 136, 163, 96,
-			202, 160, 142, 42, 197, 190, 41, 133, 55, 189, 70, 169, 28, 56,
-			225, 18, 15, 129, 129, 175, 150, 156, 160, 206, 84, 55, 166, 23,
-			56, 50, 178, 196, 41, 3, 246, 29, 55, 164, 113, 172, 10, 171,
-			42, 253, 186, 219, 207, 79, 107, 83, 40, 61, 169, 217, 22, 16,
-			86, 78, 224, 133, 26, 124, 147, 101, 17, 107, 90, 67, 209, 201,
-			176, 69, 203, 106, 40, 58, 211, 221, 235, 53, 20, 157, 105, 178,
-			81, 67, 209, 153, 222, 116, 38, 137, 162, 51, 189, 121, 20, 63,
-			35, 65, 116, 158, 54, 38, 236, 41, 122, 217, 41, 47, 121, 117,
-			119, 48, 112, 157, 10, 104, 38, 124, 36, 9, 193, 36, 180, 58,
-			29, 126, 185, 213, 208, 73, 96, 227, 60, 109, 76, 159, 213, 176,
-			113, 158, 86, 201, 132, 51, 136, 152, 79, 175, 219, 168, 97, 227,
-			60, 189, 89, 166, 22, 206, 228, 136, 249, 244, 214, 113, 209, 229,
-			12, 239, 242, 211, 246, 152, 224, 43, 89, 98, 62, 107, 60, 97,
-			63, 71, 47, 53, 107, 78, 61, 238, 176, 102, 152, 105, 30, 210,
-			205, 42, 19, 159, 210, 82, 131, 125, 55, 162, 180, 3, 86, 42,
-			114, 110, 203, 108, 61, 80, 45, 134, 138, 195, 39, 74, 114, 12,
-			89, 139, 88, 207, 26, 79, 79, 104, 16, 37, 207, 42, 190, 146,
-			69, 196, 124, 86, 165, 81, 207, 154, 196, 124, 86, 37, 92, 207,
-			230, 136, 249, 236, 174, 201, 36, 68, 201, 179, 244, 34, 207, 111,
-			110, 116, 19, 235, 57, 227, 3, 195, 246, 8, 80, 85, 195, 247,
-			4, 130, 181, 87, 115, 99, 16, 163, 152, 126, 225, 196, 68, 120,
-			171, 112, 181, 85, 205, 114, 183, 197, 170, 122, 246, 9, 209, 110,
-			119, 150, 152, 207, 41, 4, 162, 110, 68, 204, 231, 118, 72, 16,
-			149, 110, 147, 152, 207, 41, 16, 149, 238, 28, 49, 63, 160, 216,
-			66, 55, 239, 225, 7, 114, 37, 124, 20, 122, 152, 35, 150, 99,
-			204, 15, 219, 251, 227, 155, 141, 52, 41, 196, 252, 64, 245, 38,
-			103, 177, 207, 62, 32, 201, 52, 151, 37, 166, 99, 200, 20, 239,
-			140, 145, 58, 219, 31, 149, 37, 147, 152, 206, 126, 137, 244, 149,
-			203, 17, 115, 94, 245, 38, 199, 123, 51, 159, 43, 225, 26, 244,
-			166, 135, 88, 11, 198, 226, 176, 61, 71, 47, 187, 145, 3, 62,
-			103, 220, 54, 74, 106, 183, 92, 207, 174, 129, 85, 172, 197, 134,
-			65, 220, 88, 16, 121, 101, 145, 233, 89, 114, 49, 97, 93, 233,
-			232, 254, 188, 55, 61, 22, 49, 23, 20, 48, 77, 79, 150, 152,
-			11, 10, 231, 171, 7, 17, 115, 65, 225, 124, 245, 152, 196, 92,
-			24, 146, 227, 237, 201, 17, 115, 81, 141, 162, 135, 143, 98, 49,
-			87, 194, 127, 196, 111, 13, 48, 177, 106, 70, 125, 216, 254, 87,
-			136, 170, 19, 31, 80, 176, 154, 53, 121, 111, 147, 30, 144, 186,
-			44, 27, 225, 34, 2, 156, 154, 57, 147, 117, 233, 213, 25, 234,
-			213, 68, 152, 22, 99, 52, 245, 208, 91, 150, 40, 115, 245, 193,
-			138, 27, 49, 219, 176, 238, 133, 145, 87, 102, 54, 47, 230, 26,
-			29, 63, 46, 7, 136, 206, 138, 244, 153, 131, 123, 42, 56, 218,
-			162, 242, 28, 75, 203, 53, 159, 152, 28, 108, 17, 179, 166, 38,
-			7, 103, 137, 89, 235, 149, 27, 25, 35, 98, 214, 54, 201, 37,
-			198, 38, 49, 107, 251, 229, 196, 225, 28, 49, 235, 106, 114, 48,
-			159, 156, 122, 174, 132, 95, 130, 185, 233, 37, 86, 96, 132, 195,
-			118, 93, 155, 26, 225, 155, 214, 97, 106, 98, 229, 147, 70, 129,
-			83, 118, 195, 2, 173, 250, 204, 66, 11, 203, 129, 235, 214, 195,
-			37, 63, 10, 11, 180, 230, 214, 252, 96, 133, 86, 154, 181, 70,
-			8, 193, 23, 11, 94, 149, 41, 220, 112, 238, 166, 6, 213, 107,
-			17, 51, 80, 131, 234, 205, 18, 51, 80, 131, 234, 69, 196, 12,
-			54, 73, 225, 218, 107, 18, 51, 56, 32, 33, 203, 122, 115, 196,
-			12, 213, 160, 122, 249, 160, 194, 92, 9, 63, 142, 141, 76, 23,
-			201, 220, 234, 250, 56, 66, 246, 161, 213, 216, 171, 147, 90, 112,
-			140, 205, 12, 179, 66, 110, 101, 0, 235, 60, 3, 151, 77, 47,
-			26, 219, 236, 128, 242, 3, 38, 128, 139, 139, 109, 77, 126, 116,
-			112, 197, 143, 100, 204, 166, 80, 12, 133, 68, 210, 69, 239, 73,
-			8, 93, 113, 67, 126, 41, 153, 140, 128, 82, 81, 123, 245, 208,
-			141, 116, 16, 133, 12, 55, 138, 94, 52, 54, 201, 146, 65, 204,
-			23, 183, 108, 197, 253, 208, 57, 68, 204, 159, 55, 122, 237, 237,
-			177, 124, 4, 222, 192, 236, 114, 30, 172, 169, 106, 65, 240, 106,
-			86, 150, 12, 98, 254, 124, 15, 198, 255, 61, 130, 106, 12, 98,
-			189, 138, 140, 94, 251, 87, 80, 155, 138, 152, 44, 230, 7, 36,
-			205, 197, 69, 176, 115, 148, 187, 10, 248, 83, 138, 99, 14, 48,
-			158, 0, 58, 205, 15, 2, 184, 88, 133, 248, 101, 120, 8, 86,
-			97, 195, 15, 67, 111, 190, 10, 0, 0, 58, 66, 129, 254, 17,
-			156, 200, 240, 79, 0, 5, 65, 176, 218, 12, 232, 145, 172, 143,
-			89, 89, 132, 46, 247, 96, 252, 47, 249, 8, 76, 98, 253, 215,
-			200, 120, 200, 254, 231, 237, 70, 0, 58, 132, 91, 161, 149, 38,
-			191, 223, 141, 57, 39, 188, 236, 55, 163, 50, 219, 208, 162, 35,
-			242, 70, 103, 164, 237, 16, 57, 127, 243, 3, 241, 7, 247, 20,
-			199, 52, 49, 104, 57, 180, 67, 240, 177, 62, 62, 53, 28, 19,
-			65, 135, 187, 101, 209, 96, 69, 220, 139, 23, 97, 52, 22, 177,
-			254, 49, 27, 205, 51, 109, 6, 35, 196, 17, 111, 137, 17, 163,
-			51, 207, 115, 178, 206, 243, 48, 172, 5, 198, 115, 150, 32, 164,
-			20, 144, 129, 193, 154, 9, 92, 39, 244, 235, 35, 32, 227, 252,
-			102, 220, 13, 11, 65, 75, 178, 27, 150, 193, 138, 184, 23, 255,
-			186, 1, 253, 200, 16, 235, 215, 24, 93, 124, 204, 72, 117, 164,
-			226, 85, 96, 232, 74, 32, 38, 109, 209, 217, 37, 93, 95, 85,
-			138, 85, 181, 202, 147, 79, 36, 20, 91, 198, 50, 154, 229, 37,
-			190, 43, 34, 200, 181, 70, 133, 171, 108, 33, 49, 62, 13, 63,
-			20, 24, 103, 162, 1, 72, 38, 162, 169, 149, 183, 156, 144, 213,
-			195, 84, 200, 6, 16, 239, 96, 59, 21, 178, 190, 224, 45, 74,
-			1, 27, 71, 218, 38, 201, 19, 198, 11, 90, 23, 91, 103, 173,
-			74, 49, 103, 25, 4, 147, 36, 9, 51, 99, 176, 98, 15, 198,
-			139, 128, 205, 144, 125, 29, 117, 253, 43, 132, 236, 103, 32, 96,
-			188, 234, 42, 174, 90, 210, 37, 79, 130, 211, 195, 57, 138, 134,
-			77, 145, 190, 225, 208, 179, 177, 132, 194, 77, 31, 33, 98, 189,
-			142, 114, 125, 184, 44, 128, 25, 172, 79, 34, 227, 180, 125, 157,
-			142, 209, 176, 234, 132, 75, 131, 113, 244, 18, 248, 160, 49, 73,
-			197, 207, 67, 188, 216, 60, 4, 77, 90, 180, 82, 212, 68, 95,
-			158, 51, 248, 146, 83, 140, 110, 71, 121, 72, 244, 43, 240, 21,
-			178, 159, 68, 198, 235, 136, 72, 192, 133, 12, 52, 155, 147, 69,
-			196, 138, 61, 235, 100, 209, 100, 69, 113, 156, 131, 224, 56, 231,
-			147, 136, 140, 226, 62, 156, 131, 34, 99, 222, 214, 39, 209, 166,
-			147, 248, 31, 34, 1, 195, 96, 125, 10, 25, 227, 118, 216, 202,
-			191, 175, 79, 79, 197, 8, 42, 42, 203, 73, 61, 82, 26, 179,
-			54, 140, 167, 157, 170, 87, 225, 65, 143, 76, 88, 229, 189, 208,
-			175, 58, 145, 155, 47, 208, 252, 98, 200, 254, 173, 250, 139, 21,
-			127, 145, 253, 21, 204, 187, 131, 101, 39, 140, 135, 136, 44, 146,
-			253, 20, 50, 62, 137, 78, 75, 32, 135, 12, 116, 74, 14, 17,
-			65, 31, 123, 228, 4, 32, 147, 21, 133, 121, 139, 152, 137, 110,
-			125, 10, 109, 57, 39, 134, 200, 109, 116, 235, 83, 104, 219, 89,
-			252, 42, 31, 162, 65, 172, 55, 144, 113, 206, 110, 10, 117, 154,
-			211, 132, 62, 68, 126, 68, 82, 85, 35, 100, 44, 75, 25, 106,
-			14, 188, 197, 51, 187, 48, 69, 36, 246, 100, 129, 32, 175, 178,
-			83, 167, 203, 158, 123, 171, 196, 148, 239, 170, 239, 84, 196, 212,
-			44, 120, 101, 152, 26, 49, 72, 195, 34, 217, 55, 144, 241, 41,
-			52, 46, 250, 205, 182, 253, 27, 241, 32, 217, 66, 188, 129, 122,
-			228, 194, 49, 86, 251, 6, 218, 244, 136, 44, 230, 88, 113, 243,
-			152, 24, 36, 183, 225, 173, 55, 208, 214, 51, 96, 179, 35, 246,
-			250, 167, 145, 113, 193, 246, 232, 101, 183, 226, 57, 42, 113, 98,
-			106, 153, 166, 252, 197, 180, 29, 154, 111, 84, 29, 175, 94, 138,
-			220, 219, 17, 63, 199, 209, 84, 139, 244, 171, 160, 137, 149, 26,
-			245, 197, 120, 241, 76, 139, 100, 63, 141, 140, 55, 208, 57, 209,
-			85, 51, 3, 93, 145, 227, 98, 44, 248, 211, 168, 71, 14, 196,
-			132, 142, 110, 221, 38, 139, 57, 86, 180, 207, 139, 113, 113, 203,
-			221, 250, 52, 218, 49, 142, 127, 131, 47, 158, 69, 172, 207, 34,
-			99, 212, 254, 4, 162, 51, 222, 29, 55, 142, 202, 172, 242, 128,
-			229, 249, 149, 8, 210, 5, 140, 167, 143, 140, 216, 186, 86, 220,
-			178, 87, 129, 21, 227, 70, 191, 36, 102, 167, 30, 171, 128, 0,
-			216, 204, 163, 203, 168, 7, 81, 204, 85, 175, 238, 66, 80, 50,
-			216, 229, 225, 146, 127, 139, 58, 180, 234, 213, 111, 82, 111, 65,
-			200, 205, 200, 247, 57, 202, 140, 154, 7, 203, 34, 217, 207, 34,
-			227, 211, 232, 130, 24, 154, 149, 129, 158, 119, 203, 34, 98, 197,
-			220, 67, 178, 104, 178, 226, 250, 62, 89, 204, 177, 226, 134, 83,
-			98, 30, 184, 13, 107, 125, 22, 109, 28, 193, 255, 11, 159, 135,
-			12, 177, 62, 143, 140, 49, 251, 179, 136, 142, 119, 216, 134, 113,
-			247, 194, 8, 130, 82, 249, 80, 98, 67, 61, 165, 90, 66, 174,
-			74, 208, 137, 20, 211, 19, 31, 186, 213, 208, 5, 50, 47, 210,
-			89, 57, 103, 92, 124, 200, 55, 11, 20, 64, 134, 212, 13, 45,
-			99, 168, 108, 121, 4, 11, 167, 167, 70, 233, 227, 79, 158, 83,
-			179, 147, 177, 72, 246, 243, 200, 248, 44, 26, 21, 3, 206, 240,
-			241, 200, 217, 97, 236, 253, 243, 140, 179, 138, 162, 201, 138, 27,
-			55, 201, 98, 142, 21, 31, 57, 43, 102, 135, 155, 203, 214, 231,
-			209, 150, 211, 248, 207, 16, 32, 184, 100, 223, 68, 93, 111, 35,
-			100, 127, 17, 209, 73, 45, 129, 187, 186, 20, 225, 73, 113, 250,
-			197, 201, 212, 248, 212, 0, 40, 63, 243, 161, 95, 149, 161, 15,
-			116, 190, 234, 212, 32, 196, 143, 39, 187, 104, 57, 109, 241, 211,
-			40, 78, 105, 152, 167, 40, 13, 6, 197, 84, 186, 144, 67, 79,
-			241, 163, 232, 122, 69, 69, 84, 140, 79, 49, 107, 69, 44, 87,
-			221, 143, 48, 29, 159, 58, 192, 180, 64, 174, 32, 247, 114, 132,
-			25, 235, 77, 148, 219, 130, 191, 110, 8, 28, 153, 236, 23, 144,
-			241, 199, 200, 178, 191, 184, 150, 203, 16, 237, 218, 87, 221, 136,
-			224, 150, 43, 17, 29, 179, 40, 117, 45, 114, 255, 183, 34, 58,
-			166, 81, 233, 197, 243, 63, 119, 245, 202, 249, 105, 245, 237, 93,
-			175, 71, 228, 244, 254, 228, 239, 74, 214, 115, 8, 29, 11, 38,
-			246, 77, 196, 153, 146, 1, 18, 246, 11, 146, 131, 1, 224, 142,
-			245, 5, 41, 97, 1, 113, 199, 250, 2, 147, 176, 226, 235, 28,
-			251, 154, 252, 49, 50, 129, 58, 13, 41, 99, 255, 87, 100, 13,
-			106, 79, 16, 177, 254, 8, 89, 135, 240, 136, 192, 233, 177, 190,
-			132, 140, 45, 118, 129, 223, 153, 196, 151, 36, 5, 26, 38, 213,
-			18, 53, 9, 170, 187, 76, 90, 126, 9, 232, 64, 116, 136, 73,
-			203, 47, 197, 221, 69, 80, 185, 144, 150, 0, 213, 99, 125, 137,
-			73, 203, 127, 135, 160, 105, 131, 88, 127, 130, 140, 1, 251, 171,
-			40, 125, 186, 27, 105, 128, 97, 218, 57, 8, 239, 146, 4, 65,
-			2, 31, 4, 184, 151, 246, 110, 186, 73, 108, 175, 40, 112, 228,
-			218, 205, 1, 210, 80, 65, 129, 10, 41, 192, 35, 135, 199, 149,
-			199, 9, 141, 112, 170, 101, 129, 71, 165, 246, 5, 83, 173, 231,
-			193, 138, 135, 45, 20, 227, 85, 23, 231, 157, 208, 157, 75, 16,
-			140, 156, 33, 38, 106, 255, 4, 25, 95, 66, 91, 196, 28, 24,
-			89, 24, 244, 14, 89, 68, 172, 184, 115, 175, 44, 154, 172, 120,
-			160, 31, 123, 48, 65, 38, 201, 126, 5, 25, 95, 67, 150, 253,
-			76, 226, 84, 191, 158, 64, 168, 90, 219, 177, 62, 143, 71, 0,
-			131, 52, 24, 132, 124, 203, 2, 107, 157, 119, 148, 201, 206, 175,
-			32, 227, 79, 208, 128, 232, 10, 147, 157, 95, 137, 151, 146, 201,
-			206, 175, 160, 30, 57, 12, 38, 59, 191, 130, 236, 237, 242, 235,
-			28, 251, 122, 199, 215, 20, 229, 9, 233, 249, 191, 199, 148, 103,
-			114, 202, 251, 42, 163, 188, 6, 140, 206, 34, 214, 55, 144, 113,
-			197, 158, 167, 211, 96, 172, 48, 75, 109, 222, 93, 242, 196, 69,
-			114, 130, 105, 120, 117, 42, 221, 67, 238, 235, 124, 145, 119, 147,
-			9, 198, 111, 192, 132, 138, 97, 48, 193, 248, 141, 120, 144, 76,
-			48, 126, 3, 245, 236, 148, 69, 147, 21, 233, 30, 89, 204, 177,
-			98, 254, 178, 24, 144, 16, 140, 223, 64, 123, 159, 84, 254, 124,
-			191, 116, 19, 159, 184, 7, 127, 190, 64, 98, 56, 191, 35, 103,
-			190, 213, 48, 47, 236, 187, 102, 117, 182, 223, 37, 180, 44, 251,
-			228, 125, 213, 35, 62, 126, 71, 94, 144, 249, 191, 66, 120, 203,
-			120, 224, 38, 64, 228, 167, 121, 52, 53, 233, 199, 235, 180, 94,
-			123, 21, 29, 68, 235, 161, 248, 151, 201, 10, 57, 139, 113, 92,
-			22, 136, 193, 29, 60, 250, 180, 102, 180, 111, 200, 78, 140, 69,
-			16, 183, 114, 73, 156, 238, 17, 79, 38, 43, 228, 42, 238, 155,
-			247, 22, 1, 116, 101, 142, 3, 171, 132, 91, 45, 112, 94, 219,
-			187, 22, 208, 173, 233, 245, 242, 107, 94, 14, 243, 159, 68, 120,
-			75, 26, 60, 95, 142, 251, 124, 98, 52, 104, 109, 163, 225, 211,
-			162, 15, 233, 36, 238, 229, 153, 55, 128, 100, 196, 172, 180, 58,
-			29, 170, 192, 246, 105, 204, 95, 103, 127, 231, 159, 198, 219, 46,
-			8, 16, 255, 214, 14, 182, 120, 219, 25, 202, 219, 174, 23, 46,
-			164, 130, 102, 35, 114, 43, 208, 100, 110, 90, 127, 148, 255, 121,
-			252, 176, 64, 61, 151, 149, 29, 199, 155, 120, 144, 188, 87, 95,
-			156, 75, 141, 91, 84, 190, 81, 189, 16, 247, 133, 28, 197, 27,
-			101, 112, 253, 92, 106, 241, 197, 103, 68, 254, 30, 127, 149, 255,
-			23, 138, 222, 98, 169, 35, 123, 242, 104, 203, 188, 247, 180, 206,
-			234, 5, 220, 171, 81, 241, 234, 180, 22, 55, 33, 234, 137, 225,
-			214, 238, 66, 112, 249, 127, 137, 240, 206, 115, 78, 84, 94, 74,
-			119, 54, 188, 167, 222, 78, 226, 156, 168, 51, 220, 106, 0, 189,
-			14, 182, 239, 106, 135, 57, 153, 86, 159, 223, 173, 195, 46, 222,
-			213, 169, 191, 60, 71, 34, 25, 79, 129, 214, 33, 232, 207, 93,
-			167, 46, 1, 82, 151, 255, 61, 132, 119, 196, 77, 104, 122, 231,
-			61, 77, 203, 117, 129, 138, 174, 9, 42, 177, 146, 251, 58, 119,
-			71, 107, 140, 215, 184, 62, 74, 57, 109, 222, 101, 138, 126, 23,
-			225, 124, 106, 142, 180, 175, 239, 109, 97, 175, 180, 44, 236, 240,
-			221, 22, 182, 117, 178, 214, 190, 186, 43, 248, 209, 85, 123, 46,
-			150, 120, 26, 111, 72, 207, 171, 92, 231, 181, 77, 236, 116, 95,
-			106, 78, 195, 252, 23, 17, 222, 50, 1, 209, 229, 173, 140, 168,
-			130, 215, 133, 183, 156, 160, 198, 88, 71, 196, 152, 28, 103, 150,
-			35, 237, 219, 234, 80, 75, 113, 70, 84, 49, 235, 132, 55, 133,
-			116, 9, 181, 71, 246, 4, 126, 72, 127, 133, 236, 198, 185, 37,
-			63, 140, 210, 44, 80, 61, 36, 27, 177, 225, 85, 116, 78, 100,
-			120, 149, 225, 239, 119, 227, 220, 180, 208, 26, 136, 139, 251, 210,
-			82, 143, 172, 186, 51, 91, 122, 109, 223, 85, 34, 228, 187, 88,
-			51, 105, 33, 211, 169, 153, 14, 194, 104, 77, 205, 120, 152, 180,
-			10, 11, 82, 106, 255, 101, 71, 177, 178, 166, 166, 38, 113, 183,
-			144, 31, 164, 131, 228, 77, 138, 23, 123, 115, 139, 192, 131, 19,
-			11, 62, 57, 105, 102, 181, 250, 26, 180, 112, 199, 78, 61, 142,
-			95, 204, 119, 145, 95, 64, 120, 115, 123, 206, 72, 142, 116, 208,
-			29, 86, 227, 251, 246, 209, 123, 251, 136, 239, 204, 124, 23, 137,
-			240, 35, 109, 119, 47, 185, 15, 198, 97, 175, 109, 55, 231, 187,
-			200, 175, 34, 188, 125, 21, 206, 65, 30, 95, 211, 104, 218, 176,
-			73, 251, 196, 125, 124, 169, 38, 195, 197, 125, 105, 110, 208, 105,
-			237, 59, 112, 141, 181, 80, 235, 125, 65, 159, 254, 255, 79, 113,
-			71, 246, 215, 140, 7, 142, 236, 15, 28, 217, 223, 3, 71, 246,
-			189, 177, 35, 251, 64, 236, 200, 126, 21, 254, 180, 136, 185, 185,
-			123, 2, 254, 204, 16, 115, 75, 247, 121, 145, 25, 98, 91, 140,
-			147, 202, 254, 252, 174, 129, 141, 108, 23, 177, 30, 237, 154, 69,
-			246, 219, 6, 157, 17, 121, 31, 225, 76, 145, 137, 188, 196, 229,
-			58, 35, 212, 180, 84, 139, 179, 116, 11, 165, 61, 164, 14, 173,
-			73, 23, 150, 155, 238, 10, 205, 115, 235, 100, 48, 242, 111, 186,
-			245, 60, 63, 22, 145, 231, 196, 34, 217, 177, 72, 58, 46, 50,
-			230, 52, 35, 117, 32, 169, 221, 91, 166, 89, 100, 17, 211, 171,
-			236, 253, 91, 158, 128, 132, 79, 100, 193, 92, 112, 188, 42, 39,
-			242, 235, 87, 198, 174, 207, 94, 58, 127, 101, 118, 114, 124, 108,
-			246, 252, 132, 72, 208, 37, 3, 228, 89, 27, 252, 46, 18, 154,
-			117, 25, 209, 120, 250, 224, 32, 215, 215, 133, 177, 201, 169, 243,
-			19, 115, 215, 166, 207, 43, 82, 209, 234, 17, 25, 102, 245, 35,
-			83, 158, 213, 159, 203, 203, 10, 247, 120, 200, 118, 33, 98, 62,
-			154, 235, 195, 63, 52, 176, 149, 5, 151, 135, 146, 113, 209, 254,
-			132, 33, 70, 198, 230, 173, 238, 222, 210, 42, 41, 202, 28, 248,
-			34, 71, 153, 74, 117, 205, 243, 147, 197, 109, 85, 68, 110, 138,
-			80, 93, 209, 21, 227, 252, 249, 124, 113, 150, 92, 135, 145, 87,
-			205, 133, 101, 17, 105, 244, 195, 212, 218, 196, 251, 147, 167, 128,
-			140, 154, 108, 219, 137, 44, 144, 5, 170, 140, 185, 52, 226, 45,
-			157, 92, 208, 251, 163, 120, 11, 63, 32, 155, 156, 160, 78, 53,
-			112, 157, 202, 10, 79, 138, 28, 22, 20, 240, 214, 216, 212, 244,
-			249, 177, 137, 103, 230, 206, 255, 220, 228, 204, 236, 12, 78, 172,
-			205, 240, 101, 58, 58, 170, 157, 248, 133, 116, 244, 29, 252, 7,
-			30, 26, 89, 238, 231, 81, 202, 110, 150, 37, 131, 152, 165, 45,
-			69, 89, 50, 137, 89, 58, 49, 142, 79, 193, 250, 32, 98, 14,
-			27, 23, 237, 146, 72, 76, 46, 142, 0, 69, 82, 231, 186, 95,
-			31, 84, 235, 155, 88, 50, 81, 23, 98, 159, 171, 118, 216, 46,
-			30, 86, 237, 32, 147, 152, 195, 39, 198, 241, 69, 104, 199, 32,
-			230, 49, 227, 73, 123, 132, 206, 6, 78, 61, 20, 89, 167, 226,
-			217, 211, 230, 85, 220, 84, 134, 64, 167, 23, 38, 175, 140, 77,
-			77, 62, 11, 222, 136, 188, 90, 214, 227, 99, 217, 173, 178, 196,
-			234, 221, 54, 44, 75, 38, 49, 143, 141, 94, 196, 83, 208, 164,
-			73, 204, 199, 141, 179, 246, 25, 58, 169, 182, 108, 162, 33, 225,
-			139, 202, 59, 112, 215, 161, 50, 102, 244, 120, 118, 157, 44, 25,
-			196, 124, 252, 225, 29, 178, 196, 154, 58, 112, 10, 255, 10, 130,
-			134, 45, 98, 142, 26, 23, 237, 227, 116, 172, 193, 147, 178, 38,
-			157, 165, 193, 29, 171, 115, 123, 156, 34, 102, 117, 71, 219, 119,
-			129, 34, 44, 68, 204, 81, 181, 82, 150, 65, 204, 81, 181, 82,
-			150, 73, 204, 209, 19, 227, 248, 25, 232, 125, 134, 88, 103, 140,
-			179, 135, 237, 39, 233, 88, 228, 215, 228, 245, 176, 26, 201, 60,
-			83, 96, 90, 92, 129, 239, 50, 38, 209, 80, 6, 17, 243, 76,
-			86, 206, 90, 198, 32, 230, 153, 157, 35, 178, 100, 18, 243, 236,
-			250, 65, 60, 9, 157, 200, 18, 107, 220, 152, 200, 219, 39, 211,
-			115, 168, 31, 79, 175, 173, 209, 44, 34, 230, 120, 118, 187, 44,
-			25, 196, 28, 223, 241, 184, 44, 153, 196, 156, 88, 191, 11, 207,
-			65, 163, 221, 196, 186, 104, 92, 58, 110, 63, 181, 150, 145, 235,
-			54, 100, 155, 158, 224, 118, 93, 233, 70, 196, 188, 152, 221, 35,
-			75, 6, 49, 47, 230, 39, 100, 201, 36, 230, 165, 245, 195, 248,
-			171, 38, 244, 37, 71, 204, 25, 227, 162, 253, 87, 6, 229, 10,
-			94, 154, 120, 213, 33, 188, 92, 1, 145, 148, 71, 121, 109, 75,
-			19, 145, 70, 144, 1, 159, 78, 11, 102, 196, 83, 102, 243, 108,
-			104, 113, 125, 39, 219, 98, 64, 7, 110, 20, 120, 46, 123, 179,
-			25, 66, 246, 123, 117, 177, 54, 229, 133, 90, 176, 17, 40, 13,
-			234, 55, 56, 88, 212, 126, 4, 46, 125, 117, 226, 234, 8, 157,
-			118, 107, 254, 50, 120, 111, 243, 244, 204, 53, 215, 169, 71, 210,
-			91, 167, 214, 240, 3, 39, 88, 1, 52, 67, 169, 102, 192, 209,
-			92, 205, 173, 120, 236, 135, 48, 146, 112, 19, 60, 241, 229, 45,
-			57, 16, 253, 18, 79, 108, 231, 196, 232, 233, 228, 4, 227, 198,
-			90, 206, 142, 37, 103, 25, 96, 19, 194, 155, 33, 173, 57, 55,
-			93, 46, 32, 37, 124, 134, 220, 131, 151, 189, 176, 252, 142, 216,
-			113, 106, 15, 230, 16, 49, 103, 212, 30, 204, 25, 196, 156, 81,
-			123, 48, 103, 18, 115, 230, 196, 56, 254, 16, 226, 225, 121, 207,
-			116, 85, 145, 61, 0, 209, 121, 201, 68, 207, 108, 106, 210, 218,
-			73, 17, 15, 95, 125, 55, 197, 8, 235, 178, 12, 83, 123, 38,
-			183, 27, 127, 79, 229, 202, 152, 51, 46, 216, 223, 68, 90, 67,
-			137, 107, 189, 121, 183, 236, 67, 232, 159, 138, 47, 74, 202, 240,
-			98, 221, 169, 185, 50, 202, 37, 92, 9, 33, 10, 232, 242, 216,
-			51, 180, 12, 3, 74, 172, 35, 79, 113, 226, 149, 221, 234, 138,
-			200, 232, 193, 244, 93, 88, 73, 161, 43, 97, 154, 7, 79, 218,
-			193, 195, 195, 71, 142, 30, 59, 254, 216, 227, 39, 134, 242, 69,
-			8, 47, 226, 26, 22, 207, 227, 29, 114, 45, 140, 25, 11, 23,
-			175, 79, 78, 0, 53, 170, 64, 20, 103, 240, 206, 115, 236, 159,
-			161, 193, 19, 115, 255, 96, 240, 185, 131, 123, 19, 177, 101, 115,
-			198, 51, 123, 180, 216, 178, 185, 68, 108, 217, 92, 207, 102, 45,
-			182, 108, 110, 155, 173, 193, 211, 207, 109, 63, 159, 12, 45, 155,
-			219, 57, 142, 7, 100, 32, 217, 188, 177, 195, 222, 161, 79, 33,
-			40, 43, 96, 15, 132, 110, 144, 196, 166, 159, 55, 230, 46, 200,
-			40, 157, 44, 251, 244, 33, 45, 158, 103, 126, 221, 22, 45, 158,
-			103, 222, 222, 142, 255, 103, 36, 209, 231, 23, 141, 173, 246, 111,
-			33, 58, 214, 38, 68, 77, 57, 92, 11, 210, 42, 178, 173, 27,
-			5, 158, 12, 152, 56, 114, 156, 142, 205, 140, 79, 78, 198, 17,
-			100, 33, 248, 255, 5, 78, 189, 226, 215, 232, 245, 235, 147, 19,
-			60, 1, 89, 217, 175, 213, 220, 122, 5, 12, 133, 89, 173, 66,
-			5, 93, 225, 85, 216, 174, 142, 0, 155, 7, 128, 42, 226, 51,
-			188, 27, 60, 211, 13, 207, 21, 153, 8, 255, 89, 52, 230, 119,
-			104, 225, 63, 139, 137, 240, 159, 197, 158, 141, 90, 248, 207, 226,
-			230, 45, 248, 159, 34, 137, 244, 126, 211, 40, 217, 191, 136, 104,
-			250, 34, 68, 3, 187, 191, 197, 52, 224, 36, 214, 124, 127, 56,
-			192, 21, 99, 201, 250, 68, 2, 223, 132, 154, 43, 216, 33, 175,
-			144, 70, 190, 204, 62, 218, 16, 161, 178, 28, 217, 42, 70, 140,
-			145, 108, 239, 33, 25, 240, 99, 222, 84, 46, 201, 102, 150, 152,
-			55, 123, 183, 105, 241, 62, 55, 237, 131, 90, 188, 207, 205, 193,
-			34, 62, 1, 254, 126, 150, 223, 213, 68, 246, 96, 7, 30, 144,
-			62, 16, 227, 142, 13, 176, 109, 25, 109, 248, 185, 221, 120, 175,
-			112, 224, 51, 95, 48, 46, 218, 91, 116, 138, 139, 124, 161, 239,
-			138, 153, 7, 15, 60, 235, 5, 195, 231, 244, 142, 0, 102, 255,
-			5, 65, 108, 224, 126, 103, 190, 32, 136, 13, 188, 239, 204, 23,
-			236, 237, 178, 148, 35, 230, 11, 59, 46, 0, 189, 75, 223, 59,
-			243, 133, 93, 19, 120, 72, 120, 222, 153, 145, 81, 176, 31, 5,
-			61, 93, 38, 30, 149, 128, 0, 9, 104, 26, 217, 19, 70, 246,
-			145, 241, 194, 69, 81, 63, 35, 251, 200, 144, 173, 177, 161, 69,
-			59, 14, 200, 146, 73, 204, 232, 224, 33, 124, 10, 220, 99, 50,
-			183, 187, 254, 43, 132, 236, 82, 135, 9, 107, 61, 216, 211, 166,
-			140, 245, 243, 118, 110, 15, 30, 22, 78, 40, 230, 29, 99, 212,
-			222, 71, 175, 136, 196, 164, 41, 83, 36, 242, 149, 217, 35, 186,
-			13, 14, 22, 214, 29, 227, 54, 119, 153, 7, 247, 10, 243, 142,
-			32, 93, 240, 174, 48, 239, 136, 96, 84, 112, 174, 48, 239, 244,
-			109, 144, 165, 28, 49, 239, 144, 83, 48, 129, 210, 177, 194, 188,
-			179, 105, 4, 255, 27, 36, 220, 40, 204, 87, 140, 45, 246, 155,
-			136, 94, 168, 58, 139, 156, 79, 112, 130, 150, 152, 31, 73, 131,
-			76, 187, 206, 42, 242, 160, 210, 160, 233, 22, 116, 167, 2, 174,
-			90, 167, 144, 194, 84, 86, 203, 243, 19, 39, 57, 3, 5, 147,
-			115, 252, 234, 229, 107, 83, 231, 103, 153, 2, 78, 167, 165, 255,
-			77, 75, 93, 82, 94, 51, 129, 46, 210, 230, 67, 250, 122, 248,
-			85, 77, 17, 91, 217, 87, 140, 59, 163, 98, 224, 40, 195, 70,
-			150, 149, 37, 54, 206, 110, 34, 75, 38, 49, 95, 121, 100, 51,
-			30, 198, 76, 59, 205, 190, 138, 186, 62, 130, 144, 189, 183, 195,
-			210, 10, 5, 95, 172, 103, 47, 54, 45, 19, 92, 188, 115, 155,
-			241, 111, 179, 73, 52, 141, 46, 98, 189, 134, 140, 203, 246, 111,
-			160, 85, 214, 84, 152, 246, 0, 57, 193, 29, 66, 38, 91, 68,
-			87, 194, 25, 170, 174, 85, 5, 162, 136, 7, 1, 242, 191, 19,
-			149, 107, 176, 127, 162, 21, 136, 130, 79, 104, 41, 9, 133, 113,
-			61, 206, 176, 110, 91, 36, 251, 26, 50, 94, 69, 91, 97, 10,
-			77, 240, 218, 121, 77, 186, 21, 152, 224, 181, 243, 154, 116, 43,
-			48, 193, 107, 231, 53, 233, 86, 96, 130, 95, 236, 107, 40, 63,
-			5, 110, 5, 166, 244, 217, 121, 13, 237, 125, 2, 127, 133, 79,
-			11, 34, 214, 47, 33, 99, 202, 254, 194, 26, 166, 229, 158, 103,
-			164, 211, 232, 210, 83, 77, 29, 188, 234, 236, 73, 233, 162, 213,
-			32, 15, 86, 244, 195, 7, 62, 99, 200, 34, 217, 95, 66, 198,
-			107, 232, 178, 152, 4, 148, 129, 49, 202, 25, 67, 48, 228, 158,
-			29, 178, 104, 178, 226, 110, 42, 139, 57, 86, 220, 243, 164, 152,
-			49, 225, 102, 251, 75, 232, 209, 73, 124, 18, 131, 147, 199, 47,
-			163, 174, 79, 163, 206, 92, 57, 125, 124, 163, 145, 36, 227, 232,
-			191, 140, 114, 187, 241, 56, 182, 44, 139, 81, 228, 199, 144, 49,
-			110, 31, 75, 204, 124, 195, 9, 56, 146, 157, 28, 106, 135, 89,
-			135, 209, 90, 64, 31, 31, 67, 198, 47, 35, 190, 228, 22, 208,
-			199, 199, 228, 104, 45, 160, 143, 143, 161, 158, 141, 178, 104, 178,
-			226, 230, 45, 178, 152, 99, 197, 173, 220, 169, 216, 146, 244, 241,
-			49, 100, 159, 197, 191, 100, 64, 39, 193, 21, 220, 184, 100, 255,
-			173, 22, 10, 17, 91, 177, 92, 107, 99, 203, 163, 194, 211, 121,
-			230, 120, 30, 19, 92, 73, 128, 98, 86, 220, 74, 179, 33, 231,
-			44, 228, 30, 80, 152, 171, 5, 122, 181, 234, 84, 37, 206, 246,
-			208, 174, 226, 196, 73, 11, 248, 252, 37, 233, 182, 160, 99, 28,
-			241, 38, 153, 218, 88, 118, 93, 14, 99, 230, 55, 26, 190, 242,
-			42, 228, 134, 17, 247, 187, 75, 158, 213, 240, 163, 26, 53, 221,
-			140, 184, 94, 71, 198, 199, 132, 123, 179, 197, 132, 19, 155, 160,
-			135, 100, 17, 230, 107, 221, 86, 89, 52, 89, 113, 251, 14, 89,
-			204, 177, 226, 206, 139, 98, 186, 5, 113, 189, 142, 118, 159, 199,
-			63, 182, 96, 186, 13, 98, 253, 38, 50, 182, 218, 223, 179, 126,
-			70, 20, 183, 216, 189, 19, 192, 199, 252, 250, 98, 117, 69, 175,
-			159, 245, 170, 225, 55, 154, 85, 39, 114, 181, 104, 89, 56, 76,
-			171, 53, 0, 236, 18, 98, 99, 244, 48, 90, 73, 48, 34, 45,
-			158, 74, 35, 87, 119, 131, 24, 49, 145, 211, 65, 65, 34, 230,
-			128, 44, 227, 201, 10, 105, 212, 108, 84, 93, 218, 31, 247, 184,
-			0, 41, 119, 111, 207, 249, 11, 115, 226, 225, 64, 94, 164, 106,
-			196, 116, 144, 198, 47, 242, 88, 33, 49, 91, 23, 97, 182, 22,
-			116, 188, 38, 149, 145, 177, 25, 186, 1, 251, 52, 93, 47, 171,
-			96, 136, 105, 144, 237, 216, 0, 56, 91, 195, 23, 113, 84, 43,
-			124, 133, 41, 101, 223, 172, 122, 173, 38, 15, 171, 248, 25, 4,
-			247, 97, 101, 54, 117, 255, 243, 229, 37, 175, 94, 117, 221, 129,
-			17, 26, 128, 117, 45, 176, 143, 228, 236, 242, 40, 84, 238, 105,
-			168, 48, 100, 56, 219, 21, 135, 195, 156, 180, 13, 139, 100, 127,
-			19, 25, 175, 163, 75, 130, 90, 141, 12, 16, 163, 228, 36, 140,
-			21, 252, 102, 204, 73, 12, 147, 21, 55, 111, 193, 191, 194, 236,
-			213, 12, 201, 126, 22, 117, 253, 1, 66, 246, 145, 14, 124, 177,
-			253, 224, 248, 64, 184, 237, 250, 110, 29, 120, 49, 219, 149, 177,
-			219, 12, 119, 69, 223, 7, 236, 54, 195, 216, 237, 231, 222, 33,
-			187, 205, 0, 187, 253, 28, 50, 62, 139, 14, 192, 52, 100, 128,
-			221, 126, 78, 78, 82, 6, 216, 237, 231, 228, 36, 101, 128, 221,
-			126, 78, 178, 219, 12, 176, 219, 207, 73, 118, 155, 145, 236, 246,
-			115, 140, 221, 254, 159, 8, 58, 137, 136, 245, 59, 200, 24, 178,
-			255, 45, 162, 211, 146, 107, 41, 62, 155, 186, 163, 80, 124, 237,
-			253, 222, 115, 197, 54, 162, 81, 222, 234, 128, 255, 59, 19, 209,
-			47, 64, 16, 161, 223, 114, 2, 202, 246, 174, 202, 78, 147, 168,
-			85, 219, 25, 119, 173, 85, 223, 69, 117, 156, 206, 121, 195, 39,
-			128, 233, 127, 191, 35, 49, 60, 50, 192, 60, 127, 7, 245, 230,
-			101, 17, 70, 255, 232, 33, 89, 52, 89, 177, 88, 194, 255, 47,
-			159, 27, 131, 88, 191, 207, 120, 227, 191, 251, 89, 49, 106, 239,
-			159, 55, 10, 106, 99, 91, 242, 247, 145, 241, 59, 104, 72, 76,
-			9, 219, 146, 191, 31, 83, 27, 35, 151, 223, 143, 169, 141, 109,
-			201, 223, 103, 212, 118, 14, 27, 86, 150, 100, 191, 128, 186, 254,
-			24, 33, 251, 40, 207, 240, 148, 196, 58, 190, 219, 150, 132, 29,
-			148, 5, 151, 240, 220, 126, 188, 15, 91, 86, 150, 237, 160, 63,
-			66, 198, 1, 123, 75, 114, 171, 114, 226, 148, 230, 91, 22, 236,
-			160, 63, 146, 139, 156, 101, 134, 164, 245, 71, 168, 247, 17, 89,
-			4, 23, 241, 205, 121, 89, 52, 89, 113, 223, 126, 124, 22, 27,
-			86, 55, 201, 126, 9, 117, 125, 13, 33, 123, 248, 174, 218, 85,
-			139, 71, 63, 244, 184, 27, 188, 194, 115, 123, 97, 207, 119, 179,
-			30, 191, 245, 14, 247, 124, 55, 236, 249, 183, 144, 241, 37, 180,
-			31, 250, 220, 13, 123, 254, 45, 185, 10, 221, 48, 162, 183, 228,
-			42, 116, 195, 136, 222, 146, 123, 190, 27, 246, 252, 91, 114, 207,
-			119, 203, 61, 255, 22, 219, 243, 67, 208, 71, 4, 94, 220, 127,
-			138, 14, 219, 20, 84, 172, 244, 224, 52, 53, 75, 116, 8, 113,
-			199, 239, 183, 132, 18, 210, 13, 251, 232, 79, 144, 177, 65, 22,
-			193, 241, 155, 228, 101, 17, 28, 191, 247, 201, 222, 51, 37, 228,
-			79, 81, 118, 72, 116, 72, 40, 33, 127, 138, 114, 69, 252, 187,
-			8, 122, 100, 16, 235, 171, 232, 103, 236, 244, 136, 207, 12, 219,
-			48, 95, 133, 201, 20, 131, 101, 27, 230, 171, 241, 82, 25, 224,
-			37, 174, 150, 138, 109, 152, 175, 178, 165, 186, 128, 13, 43, 71,
-			178, 95, 71, 93, 255, 30, 33, 251, 241, 181, 137, 48, 221, 115,
-			67, 35, 193, 28, 34, 214, 215, 81, 238, 32, 144, 96, 142, 145,
-			224, 219, 239, 144, 4, 115, 64, 130, 111, 35, 227, 235, 168, 0,
-			61, 207, 1, 9, 190, 45, 199, 149, 3, 18, 124, 91, 142, 43,
-			7, 36, 248, 182, 36, 193, 28, 144, 224, 219, 146, 4, 115, 146,
-			4, 223, 102, 36, 8, 209, 18, 57, 54, 45, 223, 66, 198, 49,
-			251, 171, 109, 197, 78, 122, 176, 63, 131, 162, 39, 7, 162, 231,
-			91, 146, 43, 229, 96, 203, 124, 11, 245, 30, 144, 69, 152, 129,
-			254, 33, 89, 52, 89, 241, 200, 81, 252, 151, 124, 126, 12, 98,
-			125, 151, 237, 136, 111, 255, 172, 236, 136, 251, 23, 61, 156, 224,
-			216, 70, 250, 46, 50, 190, 133, 142, 137, 25, 97, 27, 233, 187,
-			49, 193, 49, 138, 249, 110, 76, 112, 108, 35, 125, 151, 17, 220,
-			69, 108, 88, 61, 36, 251, 31, 81, 215, 95, 32, 100, 159, 88,
-			163, 228, 233, 176, 147, 122, 16, 177, 254, 35, 202, 29, 194, 7,
-			177, 101, 245, 176, 157, 244, 231, 200, 56, 108, 239, 224, 226, 39,
-			113, 253, 150, 148, 65, 61, 32, 131, 254, 92, 174, 118, 15, 200,
-			160, 63, 71, 189, 182, 44, 34, 86, 220, 94, 144, 69, 147, 21,
-			75, 67, 248, 31, 51, 85, 22, 147, 236, 247, 81, 215, 107, 70,
-			103, 19, 63, 237, 112, 165, 43, 177, 239, 214, 141, 145, 84, 98,
-			49, 34, 214, 247, 81, 110, 55, 30, 196, 150, 133, 205, 46, 146,
-			253, 33, 50, 94, 53, 76, 123, 103, 50, 23, 87, 234, 178, 15,
-			70, 134, 77, 54, 208, 31, 34, 188, 25, 247, 227, 44, 43, 178,
-			57, 252, 107, 100, 141, 219, 91, 169, 116, 24, 165, 75, 62, 63,
-			146, 21, 31, 110, 192, 221, 252, 77, 139, 100, 255, 26, 89, 63,
-			68, 91, 241, 122, 249, 40, 3, 95, 227, 248, 1, 98, 15, 122,
-			55, 198, 15, 76, 246, 96, 243, 150, 248, 65, 142, 61, 216, 122,
-			14, 19, 140, 197, 3, 96, 62, 127, 205, 152, 207, 255, 104, 138,
-			94, 33, 98, 125, 208, 176, 70, 237, 127, 106, 210, 201, 9, 8,
-			192, 79, 28, 28, 49, 90, 229, 17, 33, 220, 255, 67, 94, 146,
-			240, 19, 9, 246, 134, 56, 88, 131, 148, 121, 145, 179, 24, 142,
-			96, 74, 23, 235, 115, 28, 84, 154, 167, 94, 184, 120, 69, 98,
-			76, 235, 230, 165, 87, 117, 227, 128, 70, 1, 169, 37, 99, 107,
-			193, 213, 133, 82, 104, 1, 83, 145, 222, 139, 87, 6, 215, 87,
-			226, 176, 42, 201, 209, 225, 232, 10, 222, 134, 36, 96, 140, 139,
-			39, 62, 225, 169, 193, 218, 124, 83, 196, 148, 199, 5, 66, 174,
-			176, 17, 234, 240, 147, 52, 14, 87, 37, 220, 208, 194, 145, 82,
-			73, 249, 234, 113, 175, 81, 129, 109, 92, 246, 107, 234, 151, 82,
-			24, 148, 75, 135, 74, 53, 39, 140, 220, 0, 34, 90, 188, 250,
-			98, 137, 159, 175, 249, 81, 41, 110, 36, 44, 54, 86, 170, 80,
-			185, 154, 18, 97, 103, 164, 207, 238, 52, 186, 96, 202, 199, 7,
-			13, 235, 175, 209, 184, 90, 100, 148, 129, 245, 139, 233, 2, 193,
-			130, 246, 174, 139, 31, 152, 236, 1, 4, 19, 138, 7, 57, 246,
-			128, 156, 82, 116, 33, 212, 144, 15, 26, 155, 70, 240, 40, 35,
-			117, 70, 170, 31, 50, 140, 41, 187, 36, 246, 91, 250, 92, 146,
-			227, 195, 44, 185, 49, 37, 139, 158, 50, 14, 134, 129, 126, 63,
-			100, 176, 173, 194, 247, 2, 240, 128, 15, 25, 198, 195, 178, 136,
-			88, 113, 253, 14, 89, 52, 89, 81, 28, 3, 98, 160, 219, 15,
-			25, 226, 24, 16, 75, 170, 253, 144, 241, 232, 164, 138, 199, 250,
-			239, 230, 238, 49, 30, 75, 184, 97, 254, 68, 147, 171, 191, 163,
-			64, 169, 145, 123, 248, 184, 17, 184, 252, 10, 226, 93, 9, 178,
-			42, 225, 77, 23, 221, 104, 237, 113, 60, 226, 131, 214, 8, 153,
-			142, 31, 172, 224, 205, 41, 143, 133, 123, 138, 102, 216, 142, 123,
-			26, 206, 162, 59, 7, 9, 180, 57, 208, 99, 142, 61, 152, 241,
-			238, 184, 100, 39, 198, 240, 35, 56, 151, 201, 208, 4, 246, 100,
-			22, 188, 205, 62, 132, 240, 150, 150, 182, 223, 197, 144, 19, 178,
-			31, 175, 175, 187, 183, 163, 57, 173, 19, 60, 233, 246, 58, 246,
-			248, 154, 234, 72, 9, 111, 107, 13, 135, 150, 211, 64, 244, 153,
-			19, 147, 246, 243, 120, 187, 236, 248, 125, 199, 129, 188, 147, 153,
-			123, 29, 225, 29, 237, 59, 240, 147, 11, 231, 88, 243, 108, 254,
-			130, 129, 183, 164, 29, 93, 228, 204, 64, 152, 153, 242, 100, 128,
-			30, 245, 76, 235, 143, 200, 20, 238, 81, 91, 72, 68, 246, 12,
-			220, 109, 213, 175, 201, 15, 248, 44, 199, 21, 144, 211, 120, 93,
-			205, 185, 61, 23, 70, 78, 213, 173, 187, 97, 40, 50, 171, 119,
-			78, 193, 63, 253, 80, 205, 185, 61, 35, 95, 79, 46, 146, 181,
-			234, 34, 101, 210, 139, 244, 26, 194, 91, 91, 231, 225, 167, 65,
-			223, 255, 196, 192, 59, 84, 79, 218, 17, 236, 221, 151, 229, 169,
-			214, 101, 41, 174, 137, 144, 254, 110, 174, 205, 63, 67, 120, 103,
-			135, 25, 249, 233, 239, 160, 225, 223, 202, 226, 156, 244, 25, 35,
-			115, 120, 93, 66, 4, 144, 131, 237, 155, 111, 39, 39, 214, 20,
-			152, 195, 27, 208, 66, 105, 58, 55, 112, 127, 113, 52, 13, 188,
-			62, 197, 230, 73, 161, 253, 103, 237, 37, 145, 221, 33, 190, 163,
-			131, 236, 200, 119, 145, 58, 38, 173, 12, 189, 83, 88, 83, 71,
-			214, 191, 246, 88, 153, 87, 240, 166, 118, 236, 152, 28, 94, 189,
-			227, 237, 130, 99, 58, 196, 244, 172, 198, 237, 243, 93, 36, 196,
-			125, 105, 86, 211, 41, 42, 166, 3, 107, 182, 59, 108, 231, 78,
-			28, 44, 223, 69, 62, 136, 240, 35, 109, 55, 81, 167, 192, 164,
-			213, 120, 144, 221, 33, 164, 106, 213, 93, 122, 159, 129, 58, 63,
-			156, 228, 129, 58, 63, 126, 128, 56, 241, 32, 80, 231, 61, 70,
-			156, 152, 136, 3, 117, 198, 227, 64, 29, 25, 157, 179, 53, 142,
-			206, 97, 127, 30, 226, 193, 57, 219, 187, 198, 144, 189, 59, 25,
-			155, 227, 180, 68, 230, 200, 16, 147, 237, 185, 62, 124, 64, 70,
-			152, 236, 52, 198, 108, 155, 78, 11, 111, 229, 148, 171, 116, 81,
-			15, 138, 216, 153, 221, 168, 5, 69, 236, 220, 212, 175, 5, 69,
-			236, 60, 50, 138, 151, 101, 80, 68, 62, 93, 101, 50, 175, 212,
-			79, 198, 73, 31, 177, 134, 85, 15, 217, 92, 231, 85, 15, 145,
-			73, 204, 252, 145, 81, 252, 155, 72, 196, 83, 88, 253, 198, 192,
-			65, 251, 191, 65, 90, 39, 147, 14, 225, 140, 132, 91, 143, 154,
-			217, 238, 230, 64, 116, 234, 108, 78, 229, 155, 23, 1, 51, 201,
-			122, 252, 5, 233, 173, 163, 59, 229, 132, 60, 225, 55, 77, 115,
-			78, 234, 213, 195, 200, 117, 42, 137, 120, 141, 254, 236, 35, 90,
-			188, 70, 255, 230, 130, 22, 175, 49, 176, 126, 63, 30, 20, 241,
-			26, 86, 193, 24, 52, 237, 221, 173, 211, 174, 233, 39, 137, 128,
-			140, 130, 10, 4, 97, 116, 87, 80, 129, 32, 140, 244, 10, 163,
-			83, 248, 127, 146, 1, 25, 214, 17, 227, 104, 201, 254, 245, 150,
-			201, 74, 28, 35, 190, 199, 51, 150, 56, 247, 76, 79, 155, 133,
-			136, 121, 36, 107, 107, 241, 26, 71, 182, 31, 215, 226, 53, 142,
-			174, 63, 132, 87, 100, 188, 198, 9, 99, 228, 144, 125, 179, 35,
-			33, 64, 100, 64, 114, 75, 208, 153, 102, 67, 122, 199, 106, 103,
-			242, 144, 174, 148, 119, 85, 189, 224, 84, 150, 25, 139, 174, 8,
-			47, 87, 200, 6, 170, 197, 115, 156, 80, 14, 237, 25, 131, 152,
-			39, 148, 67, 123, 198, 36, 230, 200, 250, 3, 248, 231, 101, 60,
-			199, 25, 227, 236, 144, 221, 88, 125, 1, 126, 98, 61, 205, 66,
-			228, 137, 30, 4, 114, 38, 17, 4, 114, 118, 125, 1, 31, 231,
-			158, 247, 19, 93, 23, 145, 125, 176, 195, 225, 111, 66, 255, 212,
-			252, 71, 25, 111, 153, 200, 237, 192, 79, 72, 71, 249, 11, 198,
-			168, 61, 10, 162, 181, 222, 209, 177, 78, 52, 208, 233, 242, 39,
-			118, 68, 191, 96, 76, 236, 210, 28, 209, 47, 36, 28, 209, 47,
-			36, 64, 78, 46, 8, 191, 82, 238, 136, 126, 65, 248, 149, 42,
-			71, 244, 11, 155, 70, 96, 156, 136, 88, 79, 116, 93, 94, 117,
-			156, 45, 78, 108, 210, 181, 248, 137, 220, 14, 252, 164, 116, 45,
-			158, 50, 70, 237, 211, 45, 227, 76, 57, 138, 37, 6, 170, 197,
-			54, 106, 3, 229, 30, 200, 83, 198, 19, 187, 164, 95, 113, 134,
-			85, 158, 211, 60, 144, 167, 196, 64, 185, 7, 242, 148, 24, 40,
-			247, 64, 158, 18, 3, 85, 30, 200, 83, 155, 70, 240, 227, 224,
-			22, 108, 93, 235, 114, 145, 93, 232, 48, 208, 116, 32, 75, 210,
-			37, 248, 90, 110, 23, 62, 35, 93, 130, 167, 141, 113, 123, 184,
-			131, 159, 164, 72, 62, 151, 4, 217, 241, 42, 47, 231, 19, 254,
-			193, 211, 198, 53, 170, 249, 7, 79, 39, 252, 131, 167, 133, 107,
-			59, 247, 15, 158, 22, 89, 244, 185, 127, 240, 244, 214, 115, 73,
-			255, 224, 105, 251, 44, 119, 140, 0, 254, 250, 140, 177, 217, 254,
-			191, 185, 139, 94, 205, 185, 237, 213, 154, 53, 13, 52, 54, 29,
-			167, 197, 253, 221, 228, 1, 122, 40, 196, 45, 211, 180, 68, 24,
-			232, 130, 123, 75, 198, 201, 128, 214, 6, 105, 165, 185, 43, 113,
-			179, 174, 82, 219, 22, 168, 19, 209, 154, 31, 70, 244, 240, 208,
-			80, 178, 17, 233, 89, 204, 235, 19, 215, 89, 113, 215, 120, 154,
-			106, 47, 4, 192, 210, 147, 18, 133, 220, 153, 247, 151, 93, 120,
-			164, 190, 47, 251, 110, 80, 230, 26, 24, 123, 158, 240, 35, 126,
-			198, 152, 30, 215, 252, 136, 159, 17, 176, 152, 220, 143, 248, 153,
-			220, 6, 205, 143, 248, 153, 77, 143, 224, 127, 97, 138, 188, 112,
-			102, 197, 216, 106, 127, 194, 164, 99, 60, 205, 41, 216, 167, 5,
-			26, 184, 101, 215, 83, 73, 18, 29, 218, 8, 220, 101, 207, 111,
-			134, 244, 70, 138, 62, 110, 208, 178, 83, 173, 22, 49, 189, 198,
-			175, 218, 68, 42, 66, 95, 133, 74, 9, 164, 223, 249, 144, 209,
-			90, 61, 130, 86, 216, 92, 191, 111, 201, 173, 179, 130, 87, 119,
-			184, 30, 25, 71, 2, 55, 156, 192, 169, 185, 145, 27, 196, 23,
-			120, 172, 198, 214, 166, 47, 95, 159, 153, 197, 180, 230, 168, 76,
-			181, 172, 14, 112, 45, 142, 191, 131, 123, 5, 57, 48, 214, 240,
-			4, 19, 85, 87, 174, 206, 210, 197, 166, 19, 56, 245, 200, 149,
-			235, 2, 9, 232, 171, 213, 54, 178, 162, 188, 196, 83, 202, 51,
-			253, 139, 41, 130, 145, 87, 197, 180, 220, 12, 66, 240, 244, 93,
-			114, 154, 33, 112, 176, 102, 93, 128, 184, 38, 248, 218, 45, 71,
-			139, 9, 166, 50, 251, 177, 199, 55, 13, 32, 244, 224, 150, 77,
-			39, 47, 78, 229, 2, 51, 129, 93, 49, 158, 217, 44, 22, 209,
-			200, 176, 101, 147, 123, 133, 17, 124, 69, 237, 21, 166, 66, 84,
-			54, 111, 129, 144, 9, 147, 88, 75, 93, 47, 200, 155, 187, 54,
-			23, 143, 157, 55, 59, 83, 38, 150, 114, 187, 241, 105, 225, 45,
-			110, 62, 111, 28, 176, 15, 167, 157, 94, 195, 248, 218, 33, 78,
-			241, 156, 214, 52, 185, 219, 182, 249, 188, 161, 74, 89, 98, 62,
-			223, 251, 136, 44, 33, 98, 62, 191, 57, 47, 75, 38, 49, 159,
-			223, 183, 31, 255, 133, 244, 199, 54, 27, 198, 78, 251, 255, 66,
-			116, 76, 146, 38, 143, 117, 19, 88, 65, 161, 203, 227, 229, 110,
-			196, 135, 43, 55, 90, 168, 175, 238, 222, 150, 116, 7, 238, 255,
-			9, 239, 74, 191, 230, 69, 144, 242, 59, 130, 4, 191, 183, 216,
-			63, 117, 63, 77, 177, 97, 106, 225, 112, 188, 68, 188, 202, 116,
-			208, 1, 211, 133, 212, 170, 23, 0, 84, 87, 205, 25, 99, 45,
-			78, 163, 225, 58, 1, 173, 58, 145, 27, 136, 101, 6, 95, 109,
-			171, 97, 60, 127, 64, 204, 5, 219, 199, 13, 177, 204, 224, 168,
-			109, 54, 122, 182, 202, 146, 73, 204, 198, 246, 29, 194, 11, 219,
-			138, 186, 110, 117, 14, 244, 232, 148, 244, 19, 22, 154, 233, 85,
-			81, 110, 15, 190, 46, 156, 176, 205, 101, 131, 216, 151, 218, 11,
-			176, 84, 172, 105, 139, 20, 211, 90, 208, 69, 25, 184, 101, 91,
-			203, 70, 196, 99, 65, 192, 41, 219, 92, 22, 3, 3, 159, 108,
-			115, 89, 136, 50, 112, 201, 54, 151, 251, 54, 224, 211, 224, 70,
-			153, 185, 211, 245, 139, 8, 217, 135, 239, 34, 172, 218, 220, 152,
-			99, 238, 242, 104, 222, 201, 61, 10, 18, 43, 195, 198, 246, 210,
-			59, 144, 88, 224, 237, 104, 189, 100, 220, 217, 7, 61, 5, 95,
-			71, 243, 37, 49, 10, 112, 117, 52, 95, 18, 187, 16, 60, 29,
-			205, 151, 132, 196, 2, 71, 71, 243, 37, 33, 177, 164, 159, 163,
-			249, 146, 125, 22, 255, 141, 114, 115, 124, 21, 25, 155, 237, 191,
-			88, 85, 100, 165, 3, 108, 127, 114, 114, 43, 209, 210, 79, 78,
-			120, 61, 44, 157, 32, 51, 175, 34, 227, 165, 113, 233, 231, 152,
-			129, 217, 232, 214, 188, 32, 95, 69, 185, 13, 154, 23, 228, 171,
-			104, 211, 35, 248, 31, 26, 210, 11, 242, 195, 200, 216, 106, 255,
-			127, 232, 158, 37, 152, 78, 52, 63, 13, 49, 150, 104, 95, 128,
-			15, 221, 155, 44, 139, 221, 34, 63, 140, 140, 87, 209, 102, 205,
-			45, 242, 195, 73, 183, 200, 15, 39, 221, 34, 63, 140, 54, 111,
-			1, 23, 195, 44, 201, 126, 4, 117, 125, 66, 185, 24, 174, 34,
-			35, 58, 120, 165, 100, 17, 177, 62, 130, 114, 123, 241, 132, 116,
-			138, 252, 40, 50, 14, 219, 199, 99, 81, 209, 106, 209, 172, 34,
-			47, 98, 159, 201, 143, 38, 125, 38, 63, 42, 253, 85, 184, 207,
-			228, 71, 165, 191, 10, 247, 153, 252, 40, 42, 13, 225, 255, 7,
-			65, 23, 16, 177, 62, 142, 254, 62, 8, 141, 245, 48, 124, 100,
-			145, 236, 199, 145, 241, 81, 225, 228, 151, 133, 13, 244, 113, 185,
-			252, 89, 216, 64, 31, 71, 61, 91, 101, 209, 100, 69, 33, 57,
-			186, 1, 67, 225, 95, 175, 18, 191, 211, 114, 156, 145, 116, 46,
-			125, 29, 229, 118, 227, 239, 34, 233, 93, 250, 41, 100, 228, 237,
-			175, 199, 135, 10, 73, 93, 65, 59, 3, 160, 28, 244, 95, 227,
-			181, 5, 21, 109, 46, 194, 2, 68, 9, 211, 254, 101, 207, 209,
-			173, 193, 54, 89, 36, 67, 192, 45, 157, 225, 8, 165, 76, 143,
-			83, 192, 169, 122, 4, 181, 23, 82, 247, 133, 166, 183, 236, 84,
-			217, 10, 69, 62, 133, 176, 88, 8, 207, 171, 235, 111, 10, 16,
-			21, 149, 216, 6, 250, 42, 104, 19, 252, 95, 217, 56, 177, 230,
-			253, 250, 41, 212, 187, 65, 243, 126, 253, 20, 34, 59, 53, 239,
-			215, 79, 33, 186, 7, 135, 194, 185, 213, 122, 3, 25, 87, 109,
-			151, 81, 102, 18, 46, 48, 210, 211, 182, 64, 226, 228, 208, 137,
-			188, 112, 65, 224, 3, 197, 14, 6, 152, 66, 6, 80, 237, 73,
-			146, 136, 228, 25, 110, 194, 63, 22, 48, 8, 242, 154, 127, 236,
-			27, 200, 120, 68, 243, 143, 125, 3, 109, 222, 173, 249, 199, 190,
-			129, 242, 143, 106, 254, 177, 111, 160, 189, 87, 146, 254, 177, 111,
-			160, 253, 83, 248, 147, 134, 244, 143, 253, 12, 50, 6, 237, 143,
-			112, 32, 147, 101, 113, 12, 46, 131, 222, 120, 60, 36, 219, 246,
-			124, 19, 38, 6, 90, 9, 156, 91, 33, 71, 177, 169, 250, 183,
-			56, 131, 156, 119, 49, 133, 235, 65, 218, 108, 168, 228, 230, 202,
-			177, 35, 21, 95, 7, 231, 228, 53, 231, 54, 85, 23, 138, 172,
-			225, 99, 181, 66, 106, 66, 21, 160, 140, 56, 160, 194, 138, 42,
-			155, 141, 170, 239, 48, 170, 60, 90, 163, 206, 162, 95, 196, 116,
-			124, 201, 247, 67, 14, 71, 5, 217, 254, 3, 213, 186, 144, 174,
-			149, 102, 57, 206, 207, 195, 118, 99, 189, 188, 82, 196, 244, 178,
-			72, 119, 125, 106, 244, 200, 80, 45, 225, 132, 251, 25, 100, 188,
-			129, 174, 74, 55, 219, 44, 76, 152, 173, 57, 225, 126, 6, 109,
-			239, 215, 156, 112, 63, 131, 14, 21, 240, 247, 249, 174, 130, 156,
-			248, 127, 95, 204, 89, 62, 99, 38, 135, 13, 248, 12, 26, 20,
-			115, 98, 234, 176, 1, 221, 144, 32, 251, 243, 82, 37, 232, 134,
-			4, 217, 159, 103, 42, 193, 143, 249, 140, 89, 196, 122, 19, 130,
-			198, 214, 174, 18, 164, 185, 220, 123, 169, 14, 180, 182, 125, 63,
-			102, 45, 159, 57, 203, 34, 217, 55, 145, 241, 121, 161, 10, 116,
-			67, 214, 237, 55, 99, 135, 111, 11, 192, 5, 148, 195, 55, 51,
-			16, 223, 100, 170, 192, 41, 238, 240, 253, 7, 168, 235, 127, 67,
-			200, 46, 118, 82, 5, 86, 17, 6, 57, 68, 172, 63, 64, 57,
-			10, 177, 17, 224, 230, 253, 135, 60, 54, 226, 50, 27, 134, 219,
-			114, 109, 177, 78, 58, 114, 179, 247, 84, 49, 203, 138, 34, 54,
-			130, 187, 113, 255, 161, 140, 141, 224, 110, 220, 127, 136, 246, 237,
-			199, 191, 165, 188, 180, 191, 200, 228, 252, 235, 127, 135, 229, 188,
-			240, 31, 102, 44, 248, 139, 200, 248, 67, 164, 28, 172, 51, 208,
-			249, 156, 230, 111, 253, 69, 41, 163, 185, 191, 245, 23, 153, 140,
-			62, 203, 253, 135, 223, 66, 93, 127, 190, 74, 20, 72, 251, 3,
-			244, 164, 227, 240, 91, 76, 69, 131, 180, 37, 224, 57, 252, 101,
-			38, 168, 255, 143, 180, 160, 118, 147, 7, 240, 107, 149, 214, 88,
-			19, 215, 127, 183, 164, 53, 247, 124, 254, 178, 230, 249, 156, 97,
-			69, 33, 173, 185, 231, 243, 151, 165, 180, 230, 158, 207, 95, 102,
-			210, 250, 14, 204, 19, 34, 217, 175, 33, 227, 207, 208, 97, 251,
-			121, 41, 175, 83, 96, 0, 63, 41, 161, 221, 3, 20, 243, 53,
-			100, 124, 89, 8, 237, 30, 16, 218, 95, 147, 82, 163, 7, 40,
-			230, 107, 104, 251, 94, 89, 52, 89, 241, 64, 191, 44, 230, 136,
-			245, 103, 50, 168, 165, 71, 10, 237, 63, 67, 185, 34, 23, 218,
-			61, 76, 104, 127, 243, 129, 208, 238, 32, 180, 123, 64, 104, 127,
-			19, 214, 94, 204, 40, 19, 218, 223, 140, 167, 159, 49, 159, 111,
-			74, 161, 221, 3, 66, 251, 155, 76, 104, 255, 13, 223, 97, 38,
-			177, 190, 243, 247, 208, 160, 231, 115, 199, 196, 247, 119, 144, 241,
-			77, 33, 190, 123, 64, 124, 127, 71, 138, 239, 30, 16, 223, 223,
-			145, 226, 187, 7, 196, 247, 119, 152, 248, 126, 149, 83, 166, 69,
-			172, 239, 49, 241, 253, 195, 251, 16, 223, 63, 45, 147, 190, 125,
-			7, 238, 219, 166, 239, 1, 65, 254, 61, 100, 124, 71, 8, 242,
-			30, 16, 228, 223, 147, 2, 163, 7, 4, 249, 247, 164, 32, 239,
-			1, 65, 254, 61, 38, 200, 199, 120, 196, 198, 127, 66, 93, 63,
-			86, 193, 199, 171, 9, 242, 14, 18, 3, 35, 98, 253, 39, 148,
-			219, 135, 95, 71, 210, 249, 252, 7, 204, 170, 255, 69, 212, 193,
-			172, 135, 49, 74, 64, 112, 141, 237, 9, 16, 200, 160, 226, 6,
-			210, 192, 229, 120, 248, 2, 99, 113, 181, 3, 2, 167, 150, 100,
-			243, 78, 192, 145, 218, 188, 197, 166, 223, 148, 58, 4, 120, 182,
-			179, 206, 97, 205, 175, 253, 7, 242, 172, 128, 251, 181, 255, 64,
-			158, 21, 112, 191, 246, 31, 160, 210, 16, 215, 33, 48, 219, 198,
-			63, 250, 153, 208, 33, 48, 72, 132, 31, 33, 227, 7, 130, 37,
-			97, 208, 33, 126, 36, 73, 2, 131, 68, 248, 145, 212, 33, 48,
-			72, 132, 31, 161, 237, 59, 164, 131, 254, 127, 9, 0, 0, 255,
-			255, 9, 23, 235, 142, 244, 220, 1, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 13, 148, 92, 73,
+			117, 32, 8, 231, 251, 201, 172, 172, 168, 82, 85, 42, 244, 87,
+			122, 250, 11, 165, 212, 82, 73, 170, 202, 146, 170, 213, 77, 119,
+			169, 213, 221, 89, 63, 146, 178, 187, 84, 165, 206, 170, 106, 232,
+			246, 52, 201, 171, 204, 168, 172, 135, 50, 223, 75, 222, 123, 41,
+			169, 26, 27, 48, 96, 96, 14, 216, 198, 128, 109, 134, 177, 61,
+			152, 97, 192, 51, 159, 25, 142, 199, 158, 25, 27, 127, 204, 2,
+			254, 1, 206, 49, 94, 140, 119, 13, 103, 177, 215, 204, 174, 217,
+			245, 24, 207, 206, 250, 119, 237, 195, 89, 159, 61, 247, 70, 196,
+			123, 47, 179, 178, 74, 37, 53, 13, 246, 184, 213, 231, 84, 103,
+			196, 139, 223, 27, 55, 110, 220, 123, 227, 222, 27, 228, 47, 174,
+			16, 86, 247, 188, 122, 131, 79, 180, 124, 47, 244, 86, 219, 107,
+			19, 53, 30, 84, 125, 167, 21, 122, 126, 1, 243, 232, 176, 40,
+			81, 80, 37, 242, 215, 201, 238, 43, 78, 131, 207, 70, 5, 151,
+			120, 72, 31, 33, 230, 154, 211, 224, 35, 26, 51, 70, 7, 38,
+			79, 22, 186, 42, 21, 58, 107, 220, 128, 236, 50, 214, 200, 255,
+			157, 73, 246, 244, 248, 74, 41, 49, 93, 187, 9, 45, 106, 163,
+			253, 101, 252, 77, 71, 72, 95, 203, 174, 222, 180, 235, 124, 68,
+			199, 108, 149, 164, 71, 9, 169, 241, 22, 119, 107, 220, 173, 110,
+			140, 24, 204, 24, 237, 47, 39, 114, 232, 57, 178, 187, 213, 94,
+			109, 56, 213, 74, 162, 24, 97, 198, 104, 186, 156, 19, 31, 102,
+			227, 194, 167, 201, 240, 109, 110, 223, 76, 22, 29, 192, 162, 67,
+			144, 157, 40, 56, 67, 6, 155, 60, 8, 236, 58, 175, 132, 27,
+			45, 62, 98, 226, 236, 217, 166, 217, 119, 207, 124, 64, 214, 90,
+			222, 104, 113, 90, 36, 253, 220, 109, 55, 69, 11, 233, 45, 224,
+			55, 231, 182, 155, 221, 173, 100, 161, 154, 108, 162, 47, 224, 254,
+			45, 167, 202, 71, 50, 216, 192, 233, 77, 13, 44, 137, 239, 221,
+			109, 168, 122, 116, 134, 244, 243, 59, 33, 119, 3, 199, 115, 71,
+			250, 176, 145, 7, 54, 53, 114, 197, 225, 141, 90, 119, 19, 113,
+			61, 250, 48, 233, 243, 90, 161, 227, 185, 193, 72, 150, 105, 163,
+			3, 147, 135, 123, 52, 209, 224, 139, 162, 76, 89, 21, 166, 37,
+			146, 11, 188, 182, 95, 229, 149, 170, 87, 227, 21, 199, 93, 243,
+			70, 250, 177, 129, 99, 155, 26, 88, 194, 130, 51, 94, 141, 151,
+			220, 53, 175, 60, 20, 116, 164, 233, 126, 146, 9, 54, 220, 208,
+			190, 51, 50, 136, 24, 34, 83, 128, 58, 188, 230, 64, 191, 35,
+			187, 240, 131, 74, 230, 127, 37, 67, 134, 119, 130, 124, 151, 72,
+			122, 13, 230, 63, 162, 223, 11, 116, 68, 157, 78, 240, 102, 238,
+			19, 188, 69, 50, 224, 242, 32, 228, 53, 129, 43, 198, 14, 177,
+			141, 136, 74, 155, 145, 205, 188, 47, 100, 123, 13, 25, 142, 134,
+			84, 241, 109, 183, 174, 176, 118, 226, 110, 35, 41, 204, 169, 122,
+			101, 168, 86, 30, 138, 218, 193, 52, 157, 37, 196, 115, 185, 183,
+			86, 169, 241, 106, 99, 36, 187, 5, 148, 22, 161, 72, 247, 240,
+			250, 177, 226, 44, 175, 54, 232, 163, 49, 18, 246, 109, 129, 67,
+			215, 197, 246, 219, 132, 135, 43, 100, 200, 231, 176, 35, 120, 77,
+			206, 172, 31, 7, 81, 184, 235, 204, 202, 178, 26, 78, 164, 188,
+			75, 181, 130, 73, 122, 130, 68, 25, 21, 160, 99, 72, 120, 250,
+			203, 131, 42, 115, 193, 110, 114, 235, 69, 50, 212, 9, 30, 186,
+			151, 164, 131, 208, 246, 67, 36, 129, 233, 178, 72, 208, 28, 49,
+			184, 91, 67, 250, 151, 46, 195, 79, 250, 100, 60, 97, 3, 39,
+			124, 106, 211, 112, 59, 91, 238, 158, 183, 245, 42, 178, 171, 99,
+			2, 59, 237, 58, 255, 9, 147, 236, 235, 217, 54, 125, 13, 217,
+			219, 118, 29, 55, 228, 126, 203, 231, 128, 178, 98, 136, 35, 255,
+			103, 223, 22, 72, 183, 146, 44, 45, 90, 41, 239, 233, 104, 66,
+			100, 210, 231, 200, 0, 224, 135, 237, 219, 144, 148, 187, 113, 114,
+			103, 83, 46, 204, 198, 53, 167, 141, 119, 105, 122, 57, 217, 22,
+			93, 39, 131, 183, 184, 239, 172, 57, 85, 44, 129, 224, 28, 154,
+			124, 100, 135, 109, 63, 155, 168, 186, 20, 218, 33, 159, 34, 43,
+			11, 207, 206, 149, 75, 87, 74, 115, 179, 229, 142, 150, 173, 95,
+			212, 200, 64, 98, 44, 64, 182, 220, 118, 115, 149, 251, 18, 226,
+			50, 69, 15, 145, 254, 181, 118, 163, 33, 208, 6, 0, 223, 95,
+			206, 66, 6, 160, 12, 28, 145, 146, 16, 64, 62, 254, 166, 39,
+			200, 128, 19, 84, 124, 222, 226, 118, 200, 107, 35, 38, 211, 70,
+			179, 211, 250, 136, 86, 38, 78, 80, 150, 185, 212, 34, 89, 133,
+			123, 35, 105, 40, 81, 142, 210, 226, 155, 172, 157, 81, 223, 68,
+			58, 127, 145, 236, 222, 52, 73, 58, 76, 6, 102, 231, 102, 230,
+			139, 229, 226, 114, 105, 113, 33, 151, 162, 67, 36, 49, 239, 156,
+			118, 182, 63, 251, 199, 125, 185, 183, 188, 229, 45, 111, 209, 243,
+			159, 205, 144, 189, 189, 168, 92, 79, 130, 27, 195, 196, 232, 128,
+			73, 145, 164, 27, 246, 42, 111, 224, 228, 134, 38, 207, 237, 136,
+			142, 22, 230, 161, 74, 89, 212, 164, 143, 75, 200, 193, 228, 135,
+			38, 207, 238, 172, 5, 32, 160, 18, 202, 135, 72, 63, 252, 95,
+			44, 75, 70, 44, 11, 100, 224, 178, 88, 36, 139, 132, 173, 198,
+			163, 37, 83, 105, 32, 5, 53, 190, 102, 183, 27, 97, 229, 150,
+			221, 104, 115, 36, 81, 253, 229, 65, 153, 249, 44, 228, 209, 99,
+			100, 0, 201, 89, 197, 113, 107, 252, 14, 158, 132, 233, 178, 32,
+			141, 37, 200, 1, 172, 120, 125, 224, 185, 138, 152, 64, 11, 89,
+			200, 192, 238, 95, 21, 147, 3, 113, 8, 31, 233, 61, 189, 110,
+			42, 64, 79, 147, 97, 44, 241, 160, 220, 171, 118, 99, 100, 55,
+			34, 192, 144, 200, 94, 148, 185, 249, 255, 160, 19, 19, 128, 1,
+			75, 191, 252, 220, 141, 185, 202, 236, 226, 202, 244, 252, 92, 78,
+			131, 165, 199, 140, 43, 243, 139, 197, 229, 156, 30, 165, 75, 11,
+			203, 15, 95, 204, 25, 81, 133, 21, 145, 97, 38, 11, 60, 56,
+			153, 75, 211, 28, 25, 196, 244, 149, 210, 107, 230, 102, 31, 190,
+			152, 203, 116, 230, 60, 56, 153, 235, 163, 187, 72, 63, 230, 76,
+			47, 46, 206, 231, 178, 81, 155, 75, 203, 229, 210, 194, 213, 92,
+			127, 212, 230, 213, 242, 226, 202, 141, 28, 137, 90, 184, 62, 183,
+			180, 84, 188, 58, 151, 27, 136, 74, 76, 63, 183, 60, 183, 148,
+			27, 236, 24, 214, 131, 147, 185, 93, 81, 23, 115, 11, 43, 215,
+			115, 67, 116, 55, 217, 133, 201, 37, 53, 136, 225, 174, 172, 135,
+			47, 230, 114, 241, 64, 68, 43, 187, 59, 50, 30, 190, 152, 163,
+			249, 25, 146, 70, 52, 164, 148, 12, 205, 23, 167, 231, 230, 43,
+			139, 55, 96, 211, 20, 231, 115, 90, 156, 87, 158, 123, 102, 165,
+			84, 158, 155, 205, 233, 201, 188, 27, 115, 197, 229, 185, 217, 156,
+			145, 175, 146, 189, 189, 142, 192, 158, 91, 40, 129, 11, 250, 22,
+			184, 128, 109, 117, 227, 66, 254, 127, 215, 201, 158, 30, 108, 64,
+			207, 78, 158, 32, 105, 129, 203, 130, 20, 159, 217, 212, 5, 52,
+			132, 152, 221, 213, 90, 89, 212, 75, 178, 141, 198, 22, 108, 35,
+			52, 177, 9, 97, 95, 216, 116, 92, 11, 142, 230, 225, 158, 213,
+			187, 58, 199, 188, 123, 59, 182, 211, 61, 142, 237, 75, 100, 247,
+			166, 134, 118, 124, 124, 190, 77, 35, 35, 91, 1, 231, 46, 36,
+			81, 239, 32, 137, 151, 186, 33, 120, 188, 39, 8, 176, 159, 77,
+			107, 253, 113, 141, 236, 239, 45, 30, 244, 28, 195, 227, 36, 211,
+			228, 225, 186, 167, 24, 225, 205, 220, 198, 117, 252, 220, 213, 86,
+			89, 214, 74, 242, 103, 198, 22, 252, 153, 28, 205, 166, 145, 254,
+			83, 157, 236, 235, 217, 120, 207, 129, 30, 33, 196, 113, 91, 237,
+			80, 48, 187, 0, 176, 254, 114, 63, 230, 32, 241, 2, 42, 219,
+			14, 163, 239, 6, 126, 39, 34, 11, 11, 60, 18, 15, 212, 196,
+			129, 30, 221, 98, 166, 221, 227, 164, 231, 73, 174, 218, 112, 184,
+			27, 86, 130, 208, 231, 118, 211, 113, 235, 226, 156, 157, 74, 175,
+			217, 141, 128, 151, 135, 197, 231, 37, 245, 21, 106, 32, 2, 249,
+			137, 26, 153, 142, 26, 226, 115, 84, 35, 255, 99, 253, 100, 32,
+			33, 76, 209, 227, 100, 240, 245, 246, 45, 187, 162, 4, 100, 13,
+			5, 228, 1, 200, 187, 33, 133, 228, 243, 100, 47, 36, 43, 94,
+			59, 228, 126, 165, 218, 176, 131, 0, 0, 133, 178, 90, 127, 153,
+			194, 183, 69, 248, 52, 163, 190, 208, 135, 200, 30, 200, 173, 52,
+			219, 141, 208, 105, 53, 120, 5, 68, 246, 96, 132, 36, 71, 182,
+			27, 74, 92, 151, 5, 96, 68, 1, 157, 37, 71, 32, 179, 82,
+			231, 46, 247, 237, 144, 87, 248, 27, 218, 118, 35, 168, 216, 110,
+			173, 178, 110, 7, 235, 35, 123, 35, 182, 228, 32, 20, 188, 42,
+			203, 205, 97, 177, 162, 91, 187, 102, 7, 235, 116, 138, 236, 135,
+			143, 0, 17, 199, 173, 87, 170, 235, 188, 122, 179, 210, 14, 215,
+			30, 25, 57, 148, 236, 31, 71, 184, 132, 101, 102, 160, 200, 74,
+			184, 246, 8, 93, 34, 131, 176, 118, 77, 231, 69, 94, 89, 243,
+			124, 60, 67, 135, 38, 207, 108, 39, 142, 22, 22, 101, 133, 235,
+			94, 141, 79, 165, 151, 110, 204, 205, 205, 150, 7, 84, 43, 87,
+			60, 159, 30, 33, 164, 238, 69, 0, 30, 64, 168, 245, 215, 61,
+			5, 222, 135, 200, 158, 106, 85, 204, 217, 169, 86, 164, 96, 29,
+			140, 228, 58, 128, 85, 173, 226, 100, 157, 170, 196, 241, 128, 62,
+			74, 246, 197, 192, 74, 86, 220, 157, 172, 184, 39, 130, 83, 162,
+			234, 67, 100, 79, 107, 99, 115, 69, 218, 209, 99, 107, 163, 187,
+			218, 171, 200, 222, 214, 122, 107, 115, 189, 179, 201, 122, 180, 181,
+			222, 234, 174, 248, 0, 106, 89, 124, 94, 5, 110, 112, 228, 64,
+			178, 120, 226, 3, 45, 144, 92, 181, 90, 225, 174, 189, 218, 224,
+			21, 219, 231, 174, 29, 140, 28, 195, 194, 102, 232, 183, 121, 121,
+			168, 90, 157, 195, 143, 69, 252, 70, 207, 146, 221, 222, 234, 235,
+			171, 2, 35, 43, 45, 159, 175, 57, 119, 70, 78, 34, 120, 135,
+			225, 3, 226, 227, 13, 204, 166, 103, 72, 174, 26, 172, 219, 126,
+			11, 73, 114, 208, 178, 171, 124, 228, 1, 81, 84, 228, 47, 168,
+			108, 216, 17, 193, 109, 103, 45, 84, 45, 158, 198, 98, 3, 152,
+			39, 91, 27, 37, 57, 128, 68, 71, 199, 163, 88, 108, 168, 181,
+			222, 74, 246, 123, 130, 236, 106, 173, 39, 59, 61, 131, 197, 6,
+			91, 235, 137, 30, 47, 146, 253, 80, 168, 201, 67, 187, 102, 135,
+			118, 162, 244, 24, 150, 6, 176, 95, 151, 31, 59, 198, 233, 183,
+			87, 55, 34, 196, 26, 199, 178, 3, 144, 167, 80, 235, 101, 147,
+			166, 242, 83, 100, 48, 137, 247, 180, 159, 8, 204, 207, 105, 192,
+			4, 205, 44, 206, 206, 85, 150, 74, 207, 207, 229, 116, 96, 163,
+			230, 75, 203, 115, 149, 242, 202, 194, 114, 233, 250, 92, 206, 72,
+			48, 246, 79, 153, 217, 83, 185, 211, 249, 95, 53, 200, 80, 167,
+			108, 77, 31, 35, 7, 148, 138, 44, 224, 97, 229, 182, 227, 227,
+			134, 108, 218, 226, 112, 140, 240, 103, 175, 44, 181, 196, 195, 87,
+			59, 62, 191, 226, 249, 77, 59, 164, 243, 228, 152, 235, 85, 130,
+			208, 118, 107, 182, 95, 171, 196, 202, 201, 138, 93, 173, 242, 32,
+			240, 252, 17, 61, 217, 202, 97, 215, 91, 146, 133, 227, 19, 162,
+			40, 139, 118, 161, 175, 177, 21, 250, 30, 34, 253, 77, 187, 85,
+			225, 110, 232, 111, 32, 127, 158, 45, 103, 155, 118, 107, 14, 210,
+			244, 89, 114, 42, 46, 90, 105, 240, 186, 93, 221, 168, 0, 239,
+			93, 65, 69, 79, 165, 234, 185, 107, 13, 167, 26, 6, 35, 3,
+			17, 141, 203, 199, 53, 230, 177, 194, 83, 129, 231, 162, 24, 52,
+			163, 74, 191, 124, 43, 220, 185, 74, 102, 46, 253, 148, 153, 77,
+			231, 50, 79, 153, 217, 76, 174, 239, 41, 51, 155, 205, 245, 63,
+			101, 102, 251, 115, 36, 255, 67, 253, 100, 48, 41, 25, 208, 34,
+			73, 87, 241, 108, 132, 149, 26, 154, 60, 177, 173, 28, 81, 152,
+			129, 67, 115, 42, 35, 216, 240, 178, 168, 9, 12, 11, 160, 53,
+			7, 102, 1, 68, 9, 153, 162, 87, 73, 230, 245, 1, 148, 192,
+			83, 110, 104, 242, 228, 246, 109, 63, 181, 132, 141, 247, 63, 181,
+			84, 89, 88, 44, 95, 47, 206, 151, 101, 117, 122, 144, 152, 13,
+			251, 197, 141, 206, 227, 21, 179, 104, 129, 12, 183, 93, 33, 117,
+			195, 82, 65, 169, 225, 100, 169, 161, 248, 235, 60, 148, 223, 33,
+			122, 28, 36, 38, 168, 129, 59, 15, 65, 204, 162, 163, 100, 176,
+			198, 87, 219, 245, 138, 207, 107, 118, 53, 236, 36, 253, 3, 248,
+			169, 140, 95, 232, 211, 164, 31, 22, 206, 133, 233, 161, 148, 53,
+			52, 57, 190, 61, 8, 228, 18, 171, 74, 229, 184, 62, 125, 138,
+			100, 66, 219, 175, 243, 16, 41, 255, 208, 100, 97, 39, 45, 45,
+			99, 13, 0, 43, 158, 195, 178, 5, 122, 141, 244, 137, 95, 193,
+			200, 30, 102, 220, 123, 99, 101, 85, 253, 101, 164, 89, 19, 36,
+			141, 200, 70, 9, 145, 232, 150, 75, 209, 44, 49, 103, 22, 203,
+			179, 57, 13, 8, 149, 200, 173, 220, 40, 205, 205, 204, 229, 244,
+			252, 67, 36, 35, 48, 8, 104, 90, 132, 67, 185, 148, 76, 202,
+			54, 52, 245, 117, 229, 250, 244, 92, 57, 167, 231, 87, 200, 112,
+			23, 212, 233, 62, 178, 187, 60, 183, 60, 183, 0, 82, 91, 101,
+			101, 225, 233, 133, 197, 87, 131, 202, 163, 35, 91, 17, 72, 141,
+			238, 37, 185, 56, 123, 105, 113, 165, 140, 163, 121, 143, 78, 114,
+			221, 96, 163, 7, 200, 158, 229, 98, 249, 234, 220, 114, 5, 69,
+			198, 184, 233, 189, 36, 151, 252, 112, 165, 132, 130, 246, 49, 114,
+			40, 153, 59, 247, 154, 229, 185, 133, 37, 232, 165, 92, 92, 184,
+			10, 212, 186, 171, 61, 37, 251, 26, 48, 131, 228, 135, 43, 165,
+			185, 249, 217, 156, 217, 157, 189, 184, 48, 183, 120, 37, 151, 238,
+			238, 29, 229, 225, 12, 181, 200, 254, 238, 220, 202, 220, 194, 114,
+			249, 185, 92, 95, 119, 199, 75, 115, 229, 103, 75, 51, 115, 185,
+			44, 221, 79, 104, 242, 195, 245, 185, 229, 107, 139, 179, 185, 254,
+			77, 244, 41, 31, 144, 193, 164, 80, 250, 93, 33, 141, 249, 15,
+			234, 100, 32, 33, 100, 130, 14, 198, 110, 52, 188, 219, 21, 187,
+			225, 216, 129, 164, 94, 4, 179, 138, 144, 179, 83, 106, 177, 243,
+			243, 34, 243, 247, 241, 188, 72, 231, 50, 249, 159, 209, 72, 174,
+			91, 122, 236, 154, 190, 182, 213, 244, 191, 43, 107, 247, 83, 26,
+			25, 146, 60, 106, 239, 225, 29, 255, 158, 14, 239, 27, 58, 217,
+			213, 33, 40, 238, 116, 116, 111, 32, 187, 157, 26, 111, 182, 188,
+			16, 110, 27, 43, 13, 126, 139, 55, 70, 242, 72, 226, 39, 182,
+			23, 69, 11, 165, 184, 222, 60, 84, 155, 218, 83, 154, 157, 187,
+			126, 99, 113, 121, 110, 97, 230, 57, 69, 184, 202, 185, 68, 243,
+			88, 236, 229, 3, 72, 254, 6, 201, 117, 15, 10, 104, 69, 143,
+			97, 229, 82, 116, 15, 25, 94, 88, 172, 44, 149, 102, 231, 42,
+			115, 87, 174, 204, 205, 44, 47, 9, 229, 98, 84, 122, 57, 167,
+			39, 65, 252, 33, 131, 236, 233, 49, 18, 90, 148, 106, 1, 161,
+			169, 24, 223, 201, 232, 11, 192, 152, 223, 176, 253, 80, 106, 17,
+			206, 16, 128, 146, 27, 58, 107, 14, 247, 165, 210, 214, 64, 165,
+			237, 112, 156, 143, 91, 131, 142, 17, 218, 242, 2, 39, 116, 110,
+			193, 29, 166, 210, 240, 130, 238, 192, 44, 231, 212, 151, 146, 27,
+			70, 165, 93, 94, 183, 187, 74, 3, 31, 99, 148, 115, 234, 75,
+			84, 250, 56, 25, 172, 121, 109, 16, 168, 68, 171, 64, 45, 180,
+			242, 128, 200, 139, 138, 72, 81, 57, 86, 45, 15, 150, 7, 68,
+			158, 40, 114, 154, 12, 219, 245, 186, 15, 141, 171, 134, 132, 240,
+			63, 20, 101, 99, 65, 235, 41, 146, 85, 112, 0, 109, 51, 64,
+			162, 210, 18, 26, 45, 29, 180, 205, 174, 250, 120, 156, 12, 58,
+			65, 37, 186, 200, 27, 209, 153, 62, 154, 45, 15, 56, 65, 116,
+			71, 146, 255, 184, 78, 134, 58, 111, 109, 233, 44, 201, 54, 60,
+			121, 201, 34, 76, 6, 70, 239, 114, 209, 91, 152, 151, 229, 203,
+			81, 77, 235, 183, 53, 146, 85, 217, 116, 63, 49, 91, 118, 184,
+			142, 22, 8, 233, 105, 61, 167, 149, 49, 13, 249, 65, 203, 118,
+			71, 244, 56, 31, 210, 32, 72, 54, 184, 93, 67, 205, 130, 215,
+			108, 114, 55, 12, 228, 69, 202, 176, 204, 159, 145, 217, 96, 60,
+			16, 250, 182, 211, 232, 40, 107, 98, 217, 156, 250, 16, 21, 158,
+			34, 7, 85, 187, 53, 30, 218, 213, 117, 94, 139, 43, 193, 205,
+			111, 127, 249, 128, 44, 48, 43, 191, 171, 186, 249, 47, 235, 100,
+			183, 210, 133, 212, 34, 96, 93, 39, 196, 118, 93, 47, 76, 130,
+			107, 51, 42, 111, 170, 87, 40, 70, 149, 202, 137, 6, 172, 111,
+			105, 132, 196, 159, 182, 132, 219, 49, 50, 32, 239, 228, 65, 231,
+			35, 213, 103, 68, 100, 93, 113, 26, 168, 228, 92, 229, 117, 199,
+			149, 183, 51, 34, 161, 148, 156, 102, 164, 228, 164, 101, 146, 13,
+			120, 211, 118, 67, 167, 138, 216, 61, 52, 249, 240, 61, 13, 190,
+			176, 36, 107, 151, 163, 118, 242, 163, 36, 171, 114, 129, 241, 91,
+			88, 92, 152, 203, 165, 104, 31, 49, 150, 230, 150, 115, 26, 8,
+			177, 197, 249, 82, 113, 41, 167, 79, 255, 168, 70, 246, 84, 189,
+			102, 119, 143, 211, 185, 46, 13, 98, 112, 77, 123, 254, 241, 186,
+			19, 174, 183, 87, 11, 85, 175, 57, 81, 247, 26, 182, 91, 143,
+			237, 98, 240, 71, 117, 188, 206, 221, 241, 186, 151, 176, 146, 185,
+			20, 255, 252, 27, 77, 251, 136, 110, 92, 189, 49, 253, 111, 116,
+			235, 170, 232, 238, 134, 236, 174, 80, 230, 107, 13, 94, 5, 128,
+			147, 143, 29, 36, 199, 164, 213, 141, 221, 114, 38, 4, 27, 176,
+			202, 215, 237, 91, 78, 100, 116, 67, 228, 112, 237, 150, 99, 221,
+			213, 68, 231, 236, 27, 201, 46, 228, 14, 166, 101, 35, 244, 40,
+			177, 144, 175, 171, 76, 207, 93, 43, 62, 91, 90, 44, 87, 86,
+			22, 150, 110, 204, 205, 136, 27, 185, 20, 29, 36, 217, 196, 197,
+			195, 32, 201, 38, 174, 28, 134, 201, 192, 226, 202, 242, 141, 149,
+			229, 202, 226, 194, 252, 115, 57, 3, 201, 238, 66, 148, 54, 129,
+			73, 46, 93, 191, 190, 178, 92, 132, 43, 159, 244, 212, 235, 200,
+			80, 231, 20, 232, 246, 247, 77, 35, 63, 5, 23, 251, 67, 147,
+			7, 85, 41, 187, 229, 20, 58, 134, 95, 222, 181, 150, 76, 78,
+			183, 200, 80, 98, 5, 237, 150, 51, 77, 59, 202, 35, 144, 111,
+			104, 207, 23, 101, 9, 177, 116, 5, 207, 175, 79, 212, 185, 139,
+			32, 154, 16, 159, 236, 150, 19, 32, 208, 227, 237, 16, 92, 74,
+			252, 254, 136, 110, 94, 45, 222, 40, 61, 245, 237, 125, 36, 67,
+			205, 225, 212, 130, 70, 254, 147, 73, 180, 65, 106, 12, 167, 232,
+			228, 47, 154, 108, 198, 107, 109, 248, 78, 125, 61, 100, 147, 231,
+			47, 60, 202, 196, 42, 179, 249, 249, 153, 2, 33, 108, 222, 169,
+			114, 55, 224, 53, 214, 118, 107, 220, 103, 225, 58, 103, 197, 22,
+			108, 112, 245, 101, 140, 61, 203, 125, 184, 54, 102, 147, 133, 243,
+			108, 20, 10, 228, 229, 167, 252, 153, 75, 132, 109, 120, 109, 214,
+			180, 55, 152, 235, 133, 172, 29, 112, 22, 174, 59, 1, 131, 221,
+			199, 248, 157, 42, 111, 133, 204, 113, 89, 213, 107, 182, 26, 142,
+			237, 86, 57, 187, 237, 132, 235, 44, 140, 155, 47, 16, 246, 156,
+			108, 193, 91, 13, 109, 199, 101, 54, 171, 122, 173, 13, 230, 173,
+			37, 139, 49, 59, 36, 132, 193, 191, 245, 48, 108, 77, 77, 76,
+			220, 190, 125, 187, 96, 227, 64, 17, 102, 13, 81, 44, 152, 152,
+			47, 205, 204, 45, 44, 205, 141, 79, 22, 206, 19, 194, 86, 220,
+			6, 15, 2, 230, 243, 55, 180, 29, 159, 215, 216, 234, 6, 179,
+			91, 173, 134, 83, 5, 69, 30, 107, 216, 183, 153, 231, 51, 187,
+			238, 115, 94, 99, 161, 7, 67, 189, 237, 59, 161, 227, 214, 199,
+			88, 224, 173, 133, 183, 109, 159, 19, 86, 115, 224, 52, 90, 109,
+			135, 29, 80, 82, 3, 115, 130, 142, 2, 158, 203, 108, 151, 229,
+			139, 75, 172, 180, 148, 103, 211, 197, 165, 210, 210, 24, 97, 175,
+			46, 45, 95, 91, 92, 89, 102, 175, 46, 150, 203, 197, 133, 229,
+			210, 220, 18, 91, 44, 179, 153, 197, 133, 217, 18, 96, 244, 18,
+			91, 188, 194, 138, 11, 207, 177, 167, 75, 11, 179, 99, 140, 59,
+			225, 58, 247, 25, 191, 211, 242, 97, 244, 158, 207, 28, 128, 31,
+			175, 21, 8, 91, 226, 188, 163, 251, 53, 79, 44, 90, 208, 226,
+			85, 184, 169, 103, 128, 70, 109, 187, 206, 89, 221, 187, 197, 125,
+			215, 113, 235, 172, 197, 253, 166, 19, 192, 26, 6, 204, 118, 107,
+			132, 53, 156, 166, 35, 177, 103, 243, 140, 10, 132, 144, 44, 209,
+			116, 106, 236, 78, 237, 33, 253, 68, 55, 82, 212, 216, 147, 58,
+			11, 153, 89, 106, 236, 75, 189, 6, 50, 179, 3, 226, 167, 200,
+			220, 159, 202, 99, 38, 17, 63, 69, 230, 129, 212, 131, 152, 41,
+			127, 138, 204, 145, 212, 105, 204, 212, 196, 79, 145, 121, 80, 86,
+			63, 169, 126, 106, 125, 212, 60, 148, 58, 163, 145, 255, 197, 32,
+			122, 95, 138, 26, 163, 250, 148, 245, 219, 6, 43, 178, 26, 15,
+			156, 186, 139, 99, 7, 20, 177, 227, 137, 227, 254, 99, 106, 67,
+			179, 81, 181, 232, 99, 76, 92, 143, 48, 207, 109, 108, 140, 49,
+			30, 86, 11, 103, 8, 44, 181, 218, 233, 76, 106, 241, 2, 216,
+			15, 115, 119, 236, 102, 171, 193, 131, 41, 68, 55, 88, 120, 183,
+			206, 128, 113, 96, 151, 217, 5, 246, 125, 163, 241, 134, 46, 116,
+			82, 144, 51, 236, 50, 83, 20, 233, 133, 75, 80, 25, 45, 13,
+			88, 128, 127, 119, 80, 57, 65, 192, 68, 253, 110, 130, 52, 219,
+			246, 197, 188, 195, 176, 1, 163, 129, 50, 236, 110, 173, 150, 22,
+			182, 111, 116, 217, 105, 242, 32, 180, 155, 45, 64, 55, 208, 115,
+			134, 78, 147, 239, 184, 245, 196, 152, 199, 68, 5, 118, 151, 225,
+			40, 34, 252, 194, 37, 66, 8, 49, 250, 82, 58, 53, 14, 245,
+			157, 16, 191, 77, 88, 104, 153, 159, 161, 198, 232, 128, 204, 215,
+			168, 49, 122, 114, 82, 252, 54, 168, 49, 250, 208, 163, 228, 79,
+			116, 162, 167, 83, 212, 188, 144, 90, 208, 172, 63, 208, 89, 209,
+			101, 142, 91, 3, 75, 21, 207, 87, 180, 67, 117, 12, 105, 155,
+			213, 157, 91, 220, 149, 88, 50, 10, 219, 134, 139, 165, 30, 99,
+			225, 186, 29, 50, 91, 124, 34, 204, 73, 208, 11, 199, 197, 223,
+			60, 8, 131, 49, 230, 249, 178, 13, 59, 80, 40, 181, 218, 14,
+			153, 83, 119, 61, 160, 45, 118, 192, 240, 158, 238, 76, 129, 176,
+			101, 32, 130, 103, 207, 214, 60, 30, 0, 97, 60, 123, 150, 85,
+			215, 225, 122, 182, 115, 88, 10, 7, 171, 94, 131, 173, 182, 215,
+			214, 184, 31, 48, 39, 12, 120, 99, 237, 18, 115, 4, 190, 18,
+			86, 227, 174, 23, 242, 160, 179, 166, 237, 214, 144, 100, 218, 107,
+			107, 188, 26, 178, 117, 239, 54, 43, 222, 40, 177, 208, 243, 128,
+			173, 99, 235, 182, 91, 107, 200, 58, 56, 43, 192, 236, 5, 47,
+			228, 83, 98, 100, 96, 77, 199, 206, 158, 109, 218, 27, 103, 207,
+			50, 159, 87, 185, 115, 139, 51, 151, 223, 102, 200, 93, 195, 60,
+			68, 213, 118, 216, 246, 145, 30, 16, 35, 157, 210, 168, 113, 33,
+			77, 201, 19, 196, 76, 167, 244, 20, 53, 30, 212, 143, 91, 147,
+			108, 198, 115, 111, 129, 64, 1, 54, 22, 76, 26, 132, 48, 132,
+			174, 219, 110, 6, 5, 54, 235, 117, 156, 12, 5, 66, 6, 73,
+			26, 26, 208, 160, 133, 195, 42, 165, 83, 227, 193, 99, 140, 252,
+			172, 134, 173, 107, 212, 120, 68, 31, 182, 222, 175, 177, 37, 185,
+			187, 237, 70, 99, 35, 2, 133, 92, 42, 92, 7, 105, 222, 81,
+			32, 236, 213, 235, 112, 232, 216, 141, 134, 248, 26, 244, 4, 175,
+			237, 243, 168, 14, 44, 188, 19, 32, 28, 87, 35, 2, 202, 107,
+			68, 12, 191, 217, 90, 183, 3, 39, 96, 206, 26, 28, 25, 190,
+			215, 242, 29, 59, 228, 209, 248, 53, 28, 99, 148, 210, 169, 241,
+			200, 174, 33, 242, 25, 49, 126, 157, 26, 151, 245, 97, 235, 223,
+			105, 108, 118, 243, 144, 21, 114, 41, 52, 145, 104, 139, 203, 101,
+			135, 241, 154, 193, 2, 181, 131, 240, 236, 89, 182, 202, 97, 34,
+			183, 156, 154, 192, 50, 16, 105, 20, 138, 75, 236, 28, 35, 64,
+			216, 217, 154, 237, 52, 218, 62, 135, 163, 172, 230, 177, 192, 99,
+			183, 157, 70, 131, 85, 109, 56, 151, 109, 151, 113, 223, 7, 194,
+			216, 14, 218, 8, 206, 215, 149, 22, 158, 45, 206, 151, 102, 43,
+			197, 242, 213, 149, 235, 115, 11, 203, 175, 59, 19, 77, 79, 215,
+			96, 10, 81, 10, 38, 180, 107, 136, 252, 191, 98, 122, 6, 53,
+			102, 116, 106, 253, 183, 158, 211, 75, 16, 219, 187, 206, 208, 9,
+			226, 137, 225, 86, 11, 90, 158, 27, 240, 96, 76, 108, 44, 183,
+			218, 104, 131, 172, 3, 53, 136, 170, 2, 12, 130, 156, 53, 83,
+			251, 107, 29, 10, 33, 107, 2, 23, 152, 220, 103, 2, 114, 114,
+			107, 194, 94, 194, 99, 15, 115, 161, 252, 89, 22, 174, 251, 222,
+			237, 24, 38, 54, 76, 193, 231, 65, 187, 17, 65, 22, 187, 59,
+			13, 3, 228, 1, 119, 171, 60, 134, 141, 161, 193, 252, 119, 169,
+			148, 78, 141, 153, 220, 110, 242, 51, 2, 54, 38, 53, 174, 233,
+			187, 173, 247, 246, 132, 141, 227, 222, 63, 104, 20, 21, 130, 117,
+			70, 120, 84, 61, 95, 0, 12, 65, 20, 213, 130, 221, 38, 32,
+			39, 106, 138, 245, 136, 6, 111, 106, 48, 192, 65, 149, 210, 169,
+			113, 109, 56, 71, 254, 165, 24, 124, 154, 26, 215, 245, 156, 245,
+			227, 189, 7, 223, 108, 182, 67, 224, 155, 238, 58, 118, 181, 163,
+			56, 204, 181, 202, 59, 215, 44, 244, 88, 213, 7, 203, 60, 102,
+			19, 88, 113, 148, 205, 196, 130, 43, 54, 114, 149, 75, 114, 137,
+			51, 245, 185, 189, 22, 114, 63, 154, 65, 90, 131, 81, 14, 168,
+			148, 78, 141, 235, 67, 195, 171, 25, 60, 209, 30, 36, 255, 143,
+			22, 9, 42, 234, 140, 155, 8, 213, 25, 183, 149, 119, 192, 37,
+			210, 31, 157, 131, 96, 116, 29, 240, 170, 231, 214, 2, 212, 16,
+			26, 101, 149, 4, 225, 209, 181, 93, 47, 144, 214, 48, 34, 49,
+			253, 166, 222, 178, 218, 80, 212, 162, 98, 245, 207, 109, 39, 169,
+			193, 181, 82, 16, 143, 52, 33, 150, 29, 237, 22, 203, 94, 205,
+			27, 141, 167, 93, 239, 182, 11, 58, 248, 32, 154, 249, 231, 246,
+			147, 135, 234, 94, 161, 186, 238, 123, 77, 167, 221, 20, 108, 113,
+			187, 234, 76, 8, 188, 174, 173, 10, 185, 112, 2, 122, 154, 0,
+			225, 222, 115, 37, 60, 104, 163, 93, 117, 10, 170, 88, 1, 10,
+			228, 55, 72, 223, 179, 182, 239, 216, 110, 72, 31, 38, 70, 141,
+			175, 73, 25, 254, 100, 97, 115, 225, 130, 44, 89, 152, 229, 107,
+			120, 225, 88, 134, 10, 214, 195, 36, 171, 50, 64, 194, 190, 201,
+			55, 164, 125, 135, 113, 147, 111, 208, 189, 177, 1, 22, 232, 36,
+			132, 85, 213, 148, 254, 136, 150, 191, 72, 136, 176, 140, 184, 97,
+			59, 254, 78, 107, 78, 95, 120, 126, 98, 167, 179, 191, 4, 127,
+			90, 171, 79, 189, 107, 47, 72, 76, 67, 169, 199, 53, 242, 235,
+			66, 98, 26, 74, 209, 201, 95, 217, 36, 49, 45, 3, 115, 188,
+			50, 83, 98, 197, 118, 184, 238, 249, 193, 63, 72, 185, 233, 21,
+			193, 233, 187, 32, 56, 9, 185, 41, 151, 58, 36, 5, 27, 154,
+			186, 162, 132, 37, 248, 249, 169, 1, 162, 155, 41, 106, 78, 164,
+			46, 106, 214, 39, 6, 88, 145, 221, 228, 27, 227, 136, 204, 172,
+			105, 183, 64, 184, 169, 250, 206, 42, 116, 237, 185, 156, 221, 18,
+			219, 10, 206, 122, 155, 133, 64, 60, 171, 54, 118, 131, 24, 25,
+			128, 80, 18, 229, 178, 170, 237, 2, 163, 192, 239, 240, 42, 2,
+			220, 113, 89, 205, 1, 166, 135, 187, 33, 187, 109, 111, 4, 99,
+			44, 193, 244, 50, 207, 37, 137, 239, 139, 75, 99, 236, 234, 141,
+			149, 96, 76, 224, 89, 252, 1, 48, 16, 112, 82, 26, 137, 141,
+			49, 191, 237, 2, 145, 98, 107, 13, 187, 142, 162, 41, 191, 197,
+			93, 210, 93, 77, 242, 145, 18, 23, 113, 144, 45, 219, 183, 155,
+			60, 228, 190, 96, 190, 163, 164, 243, 34, 200, 222, 112, 182, 1,
+			227, 92, 140, 38, 93, 227, 107, 142, 139, 190, 47, 172, 106, 183,
+			128, 17, 13, 146, 64, 193, 178, 241, 236, 177, 255, 132, 48, 216,
+			163, 21, 39, 96, 85, 187, 209, 144, 189, 197, 237, 16, 118, 181,
+			237, 212, 120, 195, 113, 121, 128, 32, 234, 81, 87, 72, 157, 83,
+			132, 141, 139, 163, 207, 111, 55, 56, 171, 67, 181, 128, 221, 6,
+			233, 225, 38, 223, 8, 216, 245, 149, 165, 101, 88, 3, 193, 50,
+			132, 138, 141, 142, 219, 41, 192, 86, 44, 178, 192, 113, 235, 168,
+			31, 105, 241, 42, 44, 85, 204, 120, 40, 41, 37, 57, 66, 216,
+			40, 220, 245, 218, 245, 117, 60, 59, 61, 55, 112, 128, 226, 56,
+			33, 52, 214, 178, 3, 104, 141, 141, 226, 21, 69, 232, 32, 99,
+			183, 214, 176, 111, 58, 141, 141, 51, 5, 86, 90, 3, 230, 7,
+			184, 216, 102, 203, 243, 67, 152, 85, 232, 197, 235, 228, 224, 49,
+			92, 245, 189, 32, 128, 198, 108, 86, 229, 62, 210, 146, 154, 211,
+			20, 190, 2, 108, 148, 23, 234, 5, 118, 123, 157, 227, 206, 186,
+			205, 87, 17, 122, 130, 145, 142, 112, 13, 161, 239, 249, 136, 5,
+			94, 27, 7, 22, 56, 33, 7, 197, 3, 48, 120, 96, 201, 194,
+			156, 192, 107, 224, 206, 57, 3, 124, 55, 119, 225, 143, 207, 35,
+			152, 217, 176, 21, 4, 7, 28, 45, 55, 178, 57, 108, 211, 96,
+			108, 33, 143, 175, 249, 94, 19, 7, 83, 9, 218, 78, 200, 131,
+			66, 107, 163, 1, 40, 196, 216, 34, 52, 125, 219, 1, 26, 108,
+			35, 132, 96, 37, 146, 27, 10, 217, 226, 117, 167, 198, 153, 29,
+			241, 204, 176, 201, 92, 15, 106, 2, 154, 21, 200, 78, 214, 122,
+			97, 113, 71, 235, 45, 101, 63, 249, 57, 222, 242, 1, 15, 25,
+			15, 130, 104, 221, 160, 153, 48, 136, 183, 140, 196, 139, 117, 39,
+			8, 61, 127, 3, 27, 187, 146, 148, 91, 157, 53, 118, 117, 129,
+			217, 126, 93, 44, 135, 221, 244, 220, 122, 52, 199, 184, 155, 150,
+			237, 248, 129, 4, 186, 93, 67, 110, 209, 134, 182, 64, 224, 19,
+			245, 37, 187, 37, 186, 22, 23, 68, 97, 116, 146, 116, 224, 34,
+			112, 159, 114, 152, 78, 24, 196, 67, 35, 172, 228, 178, 25, 121,
+			248, 142, 177, 112, 163, 229, 84, 237, 70, 114, 48, 56, 70, 220,
+			65, 171, 237, 234, 77, 30, 78, 97, 227, 120, 172, 138, 140, 49,
+			134, 168, 150, 175, 58, 121, 81, 202, 105, 212, 184, 223, 81, 12,
+			115, 84, 185, 134, 227, 182, 239, 140, 251, 188, 129, 197, 99, 68,
+			152, 74, 34, 8, 145, 7, 95, 48, 53, 49, 81, 13, 58, 217,
+			3, 149, 152, 8, 252, 234, 4, 212, 119, 220, 250, 4, 118, 187,
+			234, 133, 19, 93, 152, 133, 66, 176, 9, 66, 240, 68, 118, 152,
+			188, 153, 152, 166, 20, 130, 143, 90, 62, 91, 238, 88, 117, 5,
+			56, 57, 249, 2, 97, 79, 243, 13, 148, 207, 36, 89, 68, 49,
+			100, 21, 10, 52, 156, 26, 139, 121, 29, 9, 166, 168, 224, 24,
+			11, 132, 182, 207, 241, 9, 110, 124, 184, 183, 113, 67, 224, 63,
+			6, 73, 26, 6, 144, 1, 33, 122, 159, 74, 129, 72, 189, 255,
+			160, 74, 25, 212, 120, 240, 240, 17, 242, 44, 209, 77, 141, 154,
+			175, 74, 61, 174, 89, 79, 177, 162, 82, 105, 117, 162, 71, 129,
+			45, 139, 21, 107, 108, 128, 168, 94, 67, 58, 24, 218, 245, 186,
+			56, 209, 57, 103, 37, 247, 150, 188, 180, 42, 132, 118, 61, 16,
+			224, 0, 33, 248, 85, 89, 74, 158, 38, 166, 169, 1, 56, 166,
+			244, 221, 214, 227, 172, 204, 235, 252, 206, 20, 123, 237, 247, 217,
+			227, 47, 190, 0, 127, 206, 143, 63, 90, 121, 225, 236, 232, 68,
+			87, 198, 153, 179, 39, 9, 187, 110, 223, 97, 13, 238, 214, 195,
+			245, 41, 246, 240, 69, 57, 53, 77, 79, 165, 161, 181, 172, 74,
+			105, 212, 152, 234, 31, 84, 41, 131, 26, 83, 195, 57, 114, 12,
+			187, 69, 73, 117, 143, 69, 59, 90, 154, 124, 232, 225, 168, 41,
+			45, 13, 37, 84, 83, 48, 230, 203, 253, 67, 42, 101, 80, 227,
+			242, 110, 26, 49, 209, 63, 116, 138, 92, 218, 9, 27, 233, 183,
+			170, 19, 183, 46, 76, 56, 49, 84, 48, 159, 238, 237, 228, 142,
+			253, 86, 181, 112, 235, 130, 117, 183, 171, 147, 168, 192, 86, 34,
+			139, 117, 127, 156, 125, 254, 167, 76, 66, 226, 149, 163, 86, 210,
+			224, 124, 58, 243, 141, 162, 241, 141, 98, 90, 94, 25, 23, 209,
+			252, 63, 20, 92, 245, 208, 228, 169, 46, 62, 95, 204, 164, 144,
+			64, 3, 84, 111, 78, 27, 223, 40, 26, 232, 229, 23, 114, 58,
+			67, 6, 132, 104, 87, 1, 22, 65, 26, 202, 91, 221, 146, 81,
+			33, 18, 140, 162, 17, 16, 81, 13, 62, 208, 73, 98, 2, 130,
+			73, 63, 207, 163, 93, 195, 0, 206, 189, 16, 239, 152, 50, 150,
+			165, 179, 100, 215, 154, 227, 218, 13, 48, 156, 198, 174, 211, 119,
+			237, 26, 199, 61, 168, 106, 65, 62, 125, 152, 100, 107, 220, 174,
+			1, 35, 48, 146, 185, 91, 3, 229, 168, 44, 189, 72, 246, 224,
+			133, 188, 223, 70, 38, 169, 226, 115, 59, 240, 92, 113, 71, 45,
+			224, 67, 147, 223, 203, 248, 153, 62, 76, 246, 42, 49, 189, 18,
+			163, 17, 184, 127, 26, 170, 218, 30, 85, 32, 134, 122, 144, 127,
+			138, 164, 17, 242, 96, 252, 180, 180, 92, 92, 158, 235, 186, 38,
+			35, 36, 83, 156, 89, 46, 61, 59, 167, 140, 97, 175, 223, 152,
+			159, 91, 86, 183, 100, 165, 133, 229, 185, 114, 121, 229, 6, 100,
+			24, 211, 147, 207, 159, 223, 57, 178, 95, 242, 91, 213, 214, 234,
+			83, 95, 63, 38, 132, 38, 247, 21, 161, 233, 21, 161, 233, 59,
+			42, 52, 29, 81, 151, 77, 52, 117, 6, 127, 106, 212, 216, 35,
+			127, 234, 212, 216, 155, 122, 66, 74, 85, 251, 83, 87, 149, 84,
+			5, 63, 255, 74, 19, 82, 213, 225, 148, 171, 89, 127, 172, 1,
+			195, 5, 106, 167, 86, 216, 182, 27, 240, 19, 22, 26, 184, 186,
+			53, 201, 104, 7, 5, 86, 82, 186, 44, 161, 160, 82, 100, 0,
+			166, 92, 10, 153, 207, 37, 71, 23, 160, 58, 89, 214, 130, 6,
+			2, 175, 201, 241, 54, 179, 45, 174, 207, 46, 41, 102, 44, 152,
+			98, 193, 109, 219, 7, 23, 24, 22, 218, 193, 205, 49, 34, 216,
+			21, 193, 218, 136, 223, 99, 108, 230, 25, 102, 135, 33, 111, 182,
+			194, 2, 97, 51, 94, 179, 229, 5, 48, 136, 41, 20, 222, 228,
+			102, 103, 130, 7, 77, 144, 4, 193, 6, 224, 231, 32, 58, 105,
+			10, 49, 67, 114, 56, 75, 201, 71, 52, 201, 145, 152, 199, 245,
+			147, 38, 104, 206, 103, 132, 60, 136, 167, 121, 232, 49, 159, 175,
+			1, 224, 61, 161, 243, 142, 91, 151, 220, 148, 227, 178, 50, 78,
+			115, 118, 186, 112, 149, 135, 49, 193, 33, 172, 124, 99, 166, 64,
+			152, 176, 109, 159, 74, 142, 107, 226, 141, 165, 133, 103, 23, 103,
+			208, 131, 182, 82, 154, 253, 1, 129, 46, 118, 35, 240, 34, 150,
+			203, 118, 90, 133, 26, 191, 53, 113, 97, 114, 82, 30, 199, 41,
+			60, 217, 143, 203, 227, 88, 220, 3, 28, 239, 223, 165, 82, 6,
+			53, 142, 231, 118, 147, 93, 226, 91, 150, 154, 199, 233, 73, 131,
+			12, 147, 44, 38, 127, 42, 155, 162, 70, 222, 28, 79, 100, 104,
+			212, 56, 97, 158, 35, 131, 48, 125, 64, 130, 83, 250, 99, 134,
+			104, 12, 97, 115, 170, 111, 144, 44, 146, 12, 164, 128, 63, 25,
+			53, 45, 235, 73, 197, 174, 129, 67, 171, 224, 180, 10, 66, 170,
+			195, 223, 176, 197, 16, 104, 142, 224, 226, 240, 104, 131, 76, 175,
+			233, 132, 33, 168, 237, 201, 16, 233, 19, 13, 194, 189, 148, 185,
+			47, 78, 235, 212, 24, 29, 57, 72, 102, 101, 135, 26, 53, 206,
+			153, 195, 214, 67, 216, 97, 12, 56, 118, 219, 14, 164, 14, 180,
+			134, 108, 33, 184, 5, 180, 194, 0, 25, 114, 133, 162, 113, 47,
+			192, 176, 156, 51, 19, 105, 157, 26, 231, 118, 13, 145, 63, 212,
+			100, 55, 58, 53, 46, 152, 212, 250, 31, 181, 238, 126, 156, 32,
+			198, 108, 236, 72, 110, 6, 129, 214, 48, 57, 133, 218, 74, 129,
+			75, 152, 29, 4, 94, 21, 228, 194, 90, 18, 207, 113, 200, 145,
+			188, 138, 98, 104, 179, 213, 14, 249, 37, 214, 118, 27, 206, 77,
+			206, 18, 231, 138, 184, 241, 84, 90, 138, 206, 225, 68, 58, 100,
+			28, 142, 235, 177, 134, 231, 214, 185, 127, 23, 8, 192, 101, 196,
+			5, 115, 87, 156, 134, 25, 231, 118, 147, 47, 235, 18, 2, 6,
+			53, 46, 153, 123, 173, 207, 232, 119, 133, 0, 222, 24, 128, 216,
+			38, 65, 241, 29, 131, 68, 65, 76, 183, 187, 100, 116, 240, 243,
+			26, 107, 249, 188, 105, 131, 34, 3, 212, 255, 132, 45, 181, 171,
+			235, 201, 177, 6, 235, 94, 187, 81, 131, 61, 91, 115, 130, 170,
+			237, 215, 0, 215, 216, 226, 90, 40, 100, 229, 173, 198, 3, 18,
+			42, 15, 125, 164, 215, 223, 57, 144, 195, 29, 199, 37, 115, 56,
+			78, 235, 212, 184, 68, 247, 144, 9, 216, 103, 176, 30, 79, 232,
+			79, 90, 121, 54, 211, 246, 65, 143, 32, 55, 137, 60, 69, 227,
+			254, 163, 61, 175, 101, 160, 70, 159, 74, 65, 253, 172, 218, 243,
+			154, 65, 141, 39, 114, 187, 85, 42, 75, 141, 39, 232, 19, 114,
+			135, 107, 98, 203, 63, 177, 247, 50, 57, 143, 61, 235, 212, 156,
+			214, 231, 76, 43, 207, 94, 45, 117, 8, 91, 108, 172, 168, 107,
+			61, 67, 141, 105, 253, 144, 74, 105, 212, 152, 62, 124, 90, 165,
+			12, 106, 76, 159, 61, 39, 201, 141, 158, 165, 230, 244, 216, 156,
+			34, 55, 186, 232, 123, 38, 34, 55, 186, 32, 55, 179, 230, 57,
+			178, 136, 131, 49, 168, 81, 210, 39, 173, 233, 132, 112, 52, 142,
+			246, 174, 189, 133, 172, 0, 117, 86, 160, 230, 144, 186, 58, 21,
+			162, 32, 26, 171, 97, 66, 139, 81, 42, 67, 141, 210, 192, 168,
+			74, 105, 212, 40, 157, 25, 87, 41, 232, 251, 252, 5, 242, 183,
+			130, 238, 155, 212, 188, 161, 63, 115, 209, 250, 231, 218, 150, 112,
+			137, 246, 193, 24, 115, 10, 188, 192, 66, 223, 118, 193, 184, 211,
+			115, 5, 30, 71, 140, 34, 243, 124, 210, 107, 63, 131, 50, 73,
+			242, 91, 242, 170, 41, 0, 161, 93, 48, 11, 241, 198, 217, 106,
+			251, 21, 200, 228, 117, 118, 249, 50, 187, 34, 50, 68, 129, 203,
+			247, 255, 79, 65, 201, 204, 80, 227, 70, 180, 190, 112, 189, 117,
+			227, 240, 25, 149, 50, 168, 113, 99, 76, 193, 204, 204, 82, 227,
+			153, 204, 131, 114, 53, 77, 177, 188, 207, 100, 47, 144, 183, 10,
+			32, 166, 169, 177, 162, 159, 177, 218, 44, 98, 242, 217, 237, 94,
+			192, 4, 229, 209, 42, 242, 66, 85, 14, 97, 45, 54, 146, 147,
+			84, 231, 174, 12, 158, 32, 117, 99, 43, 173, 154, 29, 242, 24,
+			77, 88, 219, 13, 157, 70, 178, 158, 28, 99, 58, 3, 131, 80,
+			179, 129, 171, 174, 149, 195, 39, 85, 202, 160, 198, 202, 233, 81,
+			242, 75, 98, 184, 25, 106, 60, 175, 47, 90, 31, 131, 37, 223,
+			96, 137, 203, 207, 168, 213, 205, 35, 15, 215, 229, 86, 77, 172,
+			175, 224, 0, 8, 203, 43, 129, 6, 13, 183, 120, 141, 215, 242,
+			5, 194, 230, 154, 173, 112, 131, 57, 107, 221, 109, 201, 155, 198,
+			36, 158, 72, 222, 80, 120, 9, 119, 80, 62, 71, 222, 63, 154,
+			41, 61, 147, 134, 97, 171, 211, 63, 163, 81, 227, 249, 254, 195,
+			242, 244, 207, 24, 212, 120, 254, 24, 83, 169, 44, 53, 158, 63,
+			190, 32, 151, 43, 35, 150, 235, 249, 19, 243, 228, 173, 25, 156,
+			127, 31, 53, 27, 122, 243, 162, 245, 23, 105, 6, 86, 191, 200,
+			159, 197, 3, 12, 146, 151, 160, 138, 237, 1, 197, 30, 91, 188,
+			197, 253, 46, 182, 14, 208, 154, 116, 205, 206, 102, 43, 11, 165,
+			197, 133, 4, 219, 200, 106, 142, 207, 171, 97, 99, 163, 87, 211,
+			113, 101, 113, 21, 175, 234, 128, 18, 74, 194, 46, 170, 163, 74,
+			6, 99, 96, 124, 209, 246, 3, 231, 22, 158, 9, 157, 218, 61,
+			155, 77, 119, 115, 144, 137, 186, 104, 182, 32, 219, 76, 54, 9,
+			227, 117, 194, 128, 176, 234, 186, 211, 168, 117, 50, 165, 129, 28,
+			153, 210, 87, 122, 29, 144, 80, 66, 144, 224, 85, 9, 227, 110,
+			213, 110, 5, 237, 134, 29, 202, 43, 120, 0, 36, 247, 193, 206,
+			35, 8, 253, 118, 53, 148, 90, 211, 168, 142, 58, 127, 234, 190,
+			221, 90, 7, 16, 10, 120, 73, 75, 7, 238, 115, 69, 247, 236,
+			144, 53, 189, 0, 46, 135, 57, 227, 181, 58, 24, 234, 132, 183,
+			57, 119, 35, 245, 55, 80, 203, 174, 229, 148, 231, 107, 176, 110,
+			183, 162, 94, 69, 71, 202, 8, 128, 53, 129, 183, 246, 11, 236,
+			213, 112, 132, 139, 68, 0, 195, 128, 251, 118, 185, 63, 224, 118,
+			31, 110, 157, 237, 234, 58, 48, 222, 29, 29, 176, 229, 245, 118,
+			192, 170, 27, 85, 176, 156, 65, 125, 42, 248, 205, 72, 190, 1,
+			210, 174, 231, 181, 96, 142, 207, 180, 185, 191, 177, 204, 131, 176,
+			44, 215, 216, 231, 97, 219, 119, 131, 164, 210, 54, 177, 240, 138,
+			208, 222, 226, 172, 218, 240, 2, 1, 181, 36, 182, 193, 185, 203,
+			86, 2, 206, 202, 188, 234, 249, 53, 238, 23, 74, 114, 93, 203,
+			55, 102, 128, 52, 55, 189, 154, 179, 182, 145, 32, 190, 209, 110,
+			234, 51, 169, 209, 136, 14, 140, 190, 52, 53, 26, 3, 234, 36,
+			237, 211, 168, 209, 160, 167, 84, 202, 160, 70, 227, 204, 89, 149,
+			202, 82, 163, 25, 145, 194, 62, 177, 183, 154, 217, 11, 145, 30,
+			236, 127, 56, 76, 166, 118, 174, 26, 152, 104, 249, 92, 216, 2,
+			188, 52, 53, 216, 125, 106, 185, 62, 160, 147, 61, 241, 122, 220,
+			80, 99, 161, 163, 36, 7, 75, 82, 1, 211, 240, 138, 15, 74,
+			201, 150, 188, 71, 30, 130, 252, 27, 118, 184, 142, 170, 202, 22,
+			68, 163, 146, 218, 90, 84, 127, 13, 108, 165, 254, 146, 23, 221,
+			81, 23, 101, 85, 141, 46, 19, 34, 248, 99, 27, 34, 239, 129,
+			234, 107, 104, 242, 98, 239, 70, 122, 12, 181, 48, 23, 213, 45,
+			39, 218, 201, 95, 34, 36, 254, 2, 166, 226, 197, 249, 249, 92,
+			138, 158, 36, 236, 217, 98, 185, 84, 92, 88, 94, 170, 128, 176,
+			95, 89, 89, 152, 123, 205, 141, 185, 153, 229, 185, 217, 74, 121,
+			110, 105, 101, 30, 92, 79, 242, 239, 208, 200, 8, 244, 53, 119,
+			199, 67, 43, 123, 199, 115, 191, 39, 176, 201, 255, 168, 70, 114,
+			221, 95, 233, 131, 36, 205, 239, 128, 191, 41, 244, 58, 48, 121,
+			104, 27, 187, 130, 107, 169, 178, 40, 75, 31, 37, 89, 37, 195,
+			140, 232, 59, 169, 23, 21, 159, 30, 32, 253, 17, 150, 222, 151,
+			226, 235, 163, 251, 133, 226, 107, 233, 21, 197, 215, 43, 138, 175,
+			239, 130, 226, 75, 105, 187, 246, 197, 218, 46, 248, 249, 43, 145,
+			182, 235, 178, 102, 253, 188, 198, 202, 9, 101, 21, 91, 107, 187,
+			232, 175, 193, 98, 26, 195, 198, 31, 103, 171, 158, 215, 136, 120,
+			57, 105, 237, 204, 154, 118, 88, 93, 231, 82, 23, 144, 56, 185,
+			224, 52, 186, 14, 199, 179, 96, 228, 2, 118, 27, 229, 210, 219,
+			112, 113, 26, 122, 120, 136, 42, 249, 183, 162, 14, 187, 208, 187,
+			59, 69, 234, 208, 88, 29, 34, 63, 169, 52, 86, 198, 49, 253,
+			168, 245, 79, 53, 117, 157, 47, 153, 17, 188, 35, 91, 183, 111,
+			193, 21, 173, 180, 31, 8, 215, 197, 152, 1, 214, 136, 255, 62,
+			175, 183, 27, 118, 180, 130, 200, 123, 193, 221, 37, 72, 216, 74,
+			202, 89, 231, 137, 207, 128, 69, 184, 198, 85, 7, 216, 184, 219,
+			190, 221, 106, 169, 235, 235, 215, 226, 57, 127, 50, 58, 90, 65,
+			77, 117, 172, 67, 77, 117, 172, 63, 121, 183, 118, 236, 240, 17,
+			188, 247, 66, 105, 56, 175, 31, 179, 30, 223, 102, 6, 146, 18,
+			178, 192, 14, 157, 96, 109, 35, 154, 65, 124, 110, 170, 110, 65,
+			82, 206, 235, 84, 165, 160, 237, 61, 150, 74, 25, 212, 200, 31,
+			57, 74, 174, 41, 101, 215, 3, 250, 171, 12, 107, 138, 93, 113,
+			26, 200, 235, 36, 122, 15, 216, 170, 13, 244, 166, 3, 21, 10,
+			145, 222, 162, 131, 139, 192, 37, 121, 160, 111, 55, 185, 35, 213,
+			41, 168, 40, 27, 180, 28, 86, 236, 66, 13, 57, 126, 103, 67,
+			218, 242, 130, 63, 195, 66, 105, 225, 234, 20, 107, 7, 209, 156,
+			192, 82, 2, 182, 136, 237, 74, 86, 25, 238, 67, 120, 16, 89,
+			119, 178, 192, 121, 81, 40, 35, 26, 54, 186, 85, 38, 212, 14,
+			48, 146, 81, 179, 47, 78, 235, 212, 24, 37, 3, 228, 39, 13,
+			57, 52, 141, 26, 147, 230, 25, 235, 157, 70, 47, 96, 175, 114,
+			80, 39, 1, 199, 36, 113, 70, 129, 29, 101, 211, 117, 48, 78,
+			117, 57, 236, 243, 166, 7, 126, 29, 109, 55, 130, 135, 156, 95,
+			129, 149, 66, 86, 237, 84, 156, 174, 241, 176, 186, 206, 86, 189,
+			112, 61, 89, 1, 134, 47, 205, 44, 72, 183, 33, 71, 160, 172,
+			173, 99, 153, 56, 90, 103, 192, 64, 188, 41, 6, 190, 50, 220,
+			124, 191, 142, 234, 131, 110, 73, 192, 89, 235, 158, 144, 232, 17,
+			118, 252, 141, 226, 210, 18, 2, 19, 166, 39, 251, 39, 236, 251,
+			174, 20, 75, 243, 99, 76, 252, 133, 34, 47, 168, 251, 255, 132,
+			224, 35, 145, 177, 107, 128, 171, 28, 237, 133, 73, 242, 10, 91,
+			173, 59, 15, 58, 11, 39, 86, 14, 180, 148, 147, 230, 201, 56,
+			173, 83, 99, 242, 244, 40, 249, 130, 38, 245, 54, 198, 99, 250,
+			97, 235, 83, 61, 183, 57, 238, 234, 174, 13, 177, 35, 252, 221,
+			142, 78, 129, 112, 227, 59, 181, 232, 168, 228, 168, 91, 195, 185,
+			41, 43, 13, 80, 0, 19, 101, 171, 208, 0, 181, 76, 59, 0,
+			130, 200, 26, 16, 13, 162, 19, 97, 163, 13, 10, 250, 164, 199,
+			244, 193, 132, 62, 233, 177, 93, 7, 84, 202, 160, 198, 99, 214,
+			33, 242, 122, 113, 231, 94, 76, 93, 211, 172, 215, 110, 67, 161,
+			19, 156, 217, 206, 201, 52, 143, 43, 5, 146, 166, 2, 240, 139,
+			89, 70, 126, 74, 83, 23, 241, 179, 250, 81, 235, 71, 34, 96,
+			39, 170, 124, 175, 9, 171, 184, 217, 159, 149, 132, 85, 67, 194,
+			58, 43, 9, 171, 184, 217, 159, 61, 124, 132, 220, 80, 55, 251,
+			87, 245, 99, 214, 204, 221, 166, 177, 83, 234, 170, 33, 117, 189,
+			42, 169, 171, 48, 5, 184, 42, 169, 171, 134, 212, 245, 234, 145,
+			163, 228, 34, 1, 179, 104, 243, 233, 212, 146, 102, 141, 110, 177,
+			120, 146, 193, 140, 23, 13, 143, 54, 24, 240, 211, 217, 17, 188,
+			140, 208, 179, 41, 106, 206, 235, 101, 113, 25, 161, 103, 97, 158,
+			243, 217, 221, 228, 9, 248, 6, 87, 17, 11, 230, 25, 107, 50,
+			97, 239, 166, 140, 66, 48, 234, 152, 24, 127, 194, 164, 4, 217,
+			95, 208, 17, 136, 230, 208, 242, 99, 193, 60, 170, 82, 26, 53,
+			22, 142, 157, 84, 41, 131, 26, 11, 167, 71, 201, 83, 216, 149,
+			70, 141, 103, 204, 49, 235, 114, 220, 213, 233, 160, 91, 49, 41,
+			144, 66, 178, 202, 44, 92, 247, 2, 105, 173, 36, 117, 38, 170,
+			87, 0, 223, 51, 81, 175, 0, 190, 103, 142, 157, 86, 41, 131,
+			26, 207, 156, 61, 23, 73, 144, 255, 191, 97, 114, 229, 190, 44,
+			41, 42, 210, 98, 204, 113, 235, 47, 77, 154, 124, 9, 2, 108,
+			254, 39, 116, 50, 52, 237, 212, 81, 212, 159, 187, 3, 182, 115,
+			244, 8, 233, 107, 249, 222, 235, 185, 20, 89, 240, 142, 94, 47,
+			171, 60, 248, 12, 225, 179, 2, 30, 142, 232, 137, 207, 50, 143,
+			30, 36, 105, 212, 194, 143, 24, 241, 71, 145, 3, 97, 223, 96,
+			151, 42, 102, 10, 221, 144, 7, 38, 207, 247, 150, 178, 58, 7,
+			149, 144, 37, 131, 242, 64, 24, 39, 172, 103, 201, 64, 226, 27,
+			189, 154, 144, 126, 164, 196, 117, 166, 119, 7, 113, 173, 88, 146,
+			123, 137, 146, 211, 7, 7, 132, 228, 116, 246, 21, 201, 233, 21,
+			201, 233, 187, 32, 57, 205, 246, 150, 156, 94, 20, 130, 211, 193,
+			212, 89, 205, 114, 89, 231, 70, 234, 118, 102, 81, 60, 146, 162,
+			130, 61, 239, 233, 56, 18, 6, 240, 24, 11, 189, 168, 61, 134,
+			222, 42, 74, 9, 175, 174, 193, 148, 228, 115, 48, 187, 159, 140,
+			41, 193, 231, 144, 57, 109, 29, 67, 229, 181, 194, 166, 168, 17,
+			73, 85, 36, 189, 23, 151, 230, 135, 204, 40, 165, 81, 227, 208,
+			128, 18, 18, 224, 208, 60, 180, 111, 191, 74, 101, 169, 113, 232,
+			64, 177, 243, 206, 252, 208, 193, 39, 100, 183, 26, 53, 142, 110,
+			221, 237, 172, 160, 86, 81, 183, 96, 58, 119, 52, 234, 22, 8,
+			254, 209, 168, 91, 56, 47, 143, 70, 221, 130, 164, 122, 244, 64,
+			177, 243, 222, 238, 232, 193, 39, 200, 89, 197, 255, 29, 55, 159,
+			180, 142, 244, 238, 118, 25, 232, 96, 212, 169, 14, 6, 2, 81,
+			167, 112, 168, 30, 31, 200, 169, 20, 24, 8, 236, 217, 171, 82,
+			89, 106, 28, 223, 247, 68, 231, 133, 221, 241, 3, 151, 145, 127,
+			72, 25, 41, 106, 158, 52, 71, 211, 214, 116, 130, 135, 236, 189,
+			220, 9, 150, 52, 232, 177, 192, 106, 104, 6, 44, 225, 201, 193,
+			3, 228, 117, 32, 142, 24, 40, 189, 158, 206, 140, 90, 207, 160,
+			250, 54, 34, 146, 192, 126, 190, 1, 151, 177, 163, 93, 148, 6,
+			54, 55, 142, 194, 115, 4, 10, 233, 97, 37, 248, 104, 232, 33,
+			67, 141, 211, 153, 131, 113, 90, 163, 198, 105, 235, 68, 156, 54,
+			168, 113, 250, 212, 105, 50, 160, 110, 36, 207, 152, 199, 37, 116,
+			140, 12, 164, 134, 85, 74, 163, 198, 153, 156, 186, 106, 49, 12,
+			106, 156, 57, 198, 162, 211, 250, 63, 106, 228, 144, 60, 79, 149,
+			173, 219, 4, 216, 169, 108, 108, 229, 50, 213, 71, 210, 120, 53,
+			52, 125, 171, 183, 251, 19, 193, 175, 202, 245, 233, 244, 221, 93,
+			159, 176, 183, 251, 112, 123, 250, 175, 218, 230, 247, 96, 80, 60,
+			168, 52, 237, 224, 230, 86, 195, 63, 78, 250, 175, 64, 161, 235,
+			118, 112, 19, 28, 138, 128, 9, 14, 208, 197, 169, 191, 44, 18,
+			211, 239, 217, 34, 8, 199, 80, 84, 81, 205, 238, 209, 109, 98,
+			56, 244, 24, 211, 165, 248, 231, 125, 204, 247, 47, 52, 114, 180,
+			123, 190, 53, 233, 25, 190, 213, 108, 167, 72, 86, 57, 143, 223,
+			179, 123, 219, 247, 247, 134, 194, 46, 213, 160, 2, 194, 217, 187,
+			47, 177, 26, 230, 125, 204, 250, 221, 215, 201, 99, 247, 192, 206,
+			37, 248, 169, 151, 198, 67, 222, 5, 212, 214, 177, 238, 239, 223,
+			33, 195, 221, 31, 73, 19, 18, 19, 173, 109, 13, 119, 25, 233,
+			143, 52, 249, 49, 235, 153, 46, 103, 149, 30, 159, 158, 128, 184,
+			141, 208, 78, 197, 169, 73, 254, 51, 243, 141, 98, 26, 88, 80,
+			120, 66, 161, 221, 8, 75, 53, 58, 21, 171, 249, 205, 187, 106,
+			214, 69, 15, 170, 2, 61, 6, 143, 7, 128, 70, 66, 61, 205,
+			32, 71, 160, 50, 233, 101, 146, 129, 123, 231, 118, 32, 35, 103,
+			178, 173, 121, 79, 48, 110, 109, 7, 162, 1, 89, 9, 98, 243,
+			6, 237, 102, 211, 246, 55, 42, 77, 219, 191, 89, 243, 110, 195,
+			115, 63, 209, 76, 135, 229, 199, 235, 242, 27, 125, 156, 16, 12,
+			101, 46, 236, 144, 179, 119, 181, 67, 198, 86, 250, 177, 10, 100,
+			210, 41, 146, 85, 235, 44, 159, 244, 57, 184, 169, 182, 194, 127,
+			57, 85, 85, 158, 190, 74, 218, 47, 147, 157, 216, 47, 139, 202,
+			88, 129, 206, 147, 97, 244, 15, 174, 216, 126, 232, 172, 217, 16,
+			93, 111, 160, 103, 27, 18, 88, 69, 89, 76, 180, 49, 132, 117,
+			85, 94, 64, 23, 72, 78, 6, 10, 143, 155, 27, 220, 121, 115,
+			195, 162, 114, 212, 94, 254, 243, 26, 201, 170, 20, 61, 208, 129,
+			142, 157, 184, 136, 250, 178, 74, 219, 111, 116, 224, 34, 230, 174,
+			248, 13, 122, 148, 100, 111, 57, 252, 54, 22, 136, 68, 33, 64,
+			37, 135, 223, 134, 239, 167, 200, 32, 200, 161, 16, 134, 28, 96,
+			53, 98, 198, 101, 6, 228, 7, 25, 122, 210, 12, 156, 23, 101,
+			232, 49, 9, 70, 200, 0, 92, 148, 229, 4, 178, 13, 202, 17,
+			168, 204, 252, 31, 107, 100, 184, 75, 249, 178, 237, 254, 58, 180,
+			105, 127, 37, 182, 214, 67, 241, 174, 49, 238, 186, 107, 226, 13,
+			51, 78, 134, 18, 202, 12, 216, 150, 166, 218, 150, 216, 243, 174,
+			196, 215, 82, 13, 108, 197, 249, 157, 86, 195, 118, 49, 35, 222,
+			3, 233, 120, 15, 236, 73, 20, 80, 251, 224, 236, 107, 4, 17,
+			17, 91, 10, 98, 89, 46, 45, 23, 151, 87, 150, 186, 44, 198,
+			179, 196, 4, 21, 97, 78, 131, 168, 84, 160, 50, 204, 233, 180,
+			159, 164, 103, 202, 197, 165, 107, 57, 3, 126, 22, 167, 23, 203,
+			203, 57, 19, 190, 47, 61, 93, 186, 145, 75, 63, 245, 23, 69,
+			210, 71, 211, 67, 169, 175, 105, 175, 200, 116, 175, 200, 116, 223,
+			5, 153, 110, 52, 54, 3, 23, 185, 6, 8, 117, 79, 144, 191,
+			211, 133, 84, 119, 44, 21, 106, 214, 159, 233, 172, 40, 217, 109,
+			144, 168, 98, 133, 157, 45, 117, 167, 194, 127, 86, 26, 85, 218,
+			202, 17, 51, 118, 35, 117, 130, 216, 169, 81, 61, 31, 192, 224,
+			16, 9, 34, 29, 123, 148, 45, 217, 250, 49, 210, 213, 16, 250,
+			140, 117, 22, 141, 58, 8, 100, 160, 142, 176, 195, 127, 87, 212,
+			216, 214, 129, 87, 18, 142, 128, 176, 81, 233, 171, 59, 22, 249,
+			230, 162, 43, 46, 6, 54, 58, 3, 55, 15, 104, 202, 172, 180,
+			199, 145, 113, 140, 88, 170, 141, 248, 198, 1, 200, 25, 24, 245,
+			37, 69, 18, 251, 150, 237, 52, 164, 97, 12, 150, 84, 205, 160,
+			232, 194, 155, 171, 188, 22, 200, 160, 41, 226, 67, 66, 178, 61,
+			150, 165, 228, 223, 235, 82, 180, 53, 79, 233, 103, 76, 235, 99,
+			250, 246, 86, 232, 9, 233, 168, 183, 25, 122, 204, 1, 117, 153,
+			161, 19, 150, 223, 198, 16, 29, 125, 247, 130, 137, 55, 174, 148,
+			231, 43, 115, 75, 51, 197, 27, 115, 179, 149, 229, 185, 165, 229,
+			202, 141, 226, 242, 181, 31, 144, 204, 87, 48, 241, 70, 97, 42,
+			1, 166, 235, 96, 233, 214, 179, 52, 147, 195, 68, 238, 138, 241,
+			160, 106, 43, 189, 54, 137, 140, 220, 19, 108, 127, 235, 102, 125,
+			194, 229, 225, 68, 219, 111, 76, 156, 128, 3, 98, 14, 107, 236,
+			220, 48, 254, 84, 199, 141, 227, 169, 14, 195, 248, 83, 29, 134,
+			241, 167, 232, 153, 46, 195, 248, 211, 221, 134, 241, 163, 230, 57,
+			242, 145, 180, 18, 251, 47, 235, 51, 214, 7, 210, 40, 10, 227,
+			101, 42, 56, 199, 182, 93, 231, 13, 109, 229, 242, 9, 49, 65,
+			21, 9, 132, 57, 195, 106, 216, 130, 120, 71, 234, 136, 216, 183,
+			239, 251, 166, 166, 90, 190, 227, 134, 83, 83, 47, 188, 112, 14,
+			180, 251, 172, 180, 198, 194, 219, 158, 244, 15, 150, 170, 121, 193,
+			212, 38, 46, 26, 196, 123, 5, 18, 161, 220, 90, 32, 136, 179,
+			77, 152, 235, 185, 227, 118, 163, 181, 110, 187, 237, 38, 188, 240,
+			0, 62, 169, 190, 93, 13, 185, 143, 87, 87, 27, 145, 231, 51,
+			220, 156, 69, 49, 112, 108, 86, 247, 189, 118, 171, 144, 136, 219,
+			197, 198, 89, 222, 158, 88, 157, 168, 162, 95, 40, 254, 172, 197,
+			63, 249, 212, 157, 100, 98, 67, 37, 214, 242, 114, 231, 160, 210,
+			93, 94, 116, 173, 121, 96, 2, 6, 212, 12, 123, 17, 141, 195,
+			165, 168, 19, 242, 102, 144, 184, 111, 244, 92, 48, 123, 243, 218,
+			173, 232, 138, 73, 194, 81, 206, 95, 206, 58, 111, 79, 96, 127,
+			175, 118, 194, 117, 212, 44, 217, 161, 168, 54, 38, 163, 167, 248,
+			65, 200, 46, 130, 101, 105, 19, 136, 74, 123, 117, 124, 155, 70,
+			225, 104, 138, 219, 93, 221, 178, 229, 104, 218, 72, 121, 162, 121,
+			111, 215, 11, 52, 221, 115, 244, 0, 178, 188, 138, 255, 34, 9,
+			22, 238, 109, 199, 101, 43, 165, 2, 17, 216, 18, 17, 157, 40,
+			78, 109, 140, 0, 160, 74, 99, 182, 187, 33, 221, 92, 110, 219,
+			234, 78, 35, 213, 225, 167, 153, 146, 126, 154, 73, 101, 211, 229,
+			14, 101, 211, 229, 3, 211, 157, 202, 166, 203, 7, 159, 36, 175,
+			151, 202, 38, 179, 168, 207, 154, 214, 63, 97, 37, 133, 216, 112,
+			123, 147, 32, 55, 204, 137, 141, 13, 19, 170, 61, 0, 80, 52,
+			210, 78, 100, 183, 131, 170, 227, 76, 189, 240, 194, 27, 47, 140,
+			61, 56, 249, 3, 39, 163, 65, 131, 178, 170, 24, 13, 26, 246,
+			89, 49, 26, 52, 168, 99, 138, 251, 246, 203, 77, 11, 230, 229,
+			197, 3, 179, 93, 230, 229, 211, 230, 185, 68, 6, 68, 22, 50,
+			207, 146, 219, 82, 153, 99, 94, 211, 75, 147, 150, 195, 84, 216,
+			79, 233, 92, 236, 185, 137, 163, 247, 54, 68, 78, 92, 131, 184,
+			12, 174, 178, 211, 132, 41, 140, 17, 38, 157, 229, 163, 146, 202,
+			205, 90, 250, 81, 35, 58, 72, 168, 224, 9, 20, 205, 9, 20,
+			71, 215, 244, 72, 85, 4, 17, 131, 142, 40, 35, 100, 80, 28,
+			93, 59, 29, 25, 165, 103, 169, 81, 202, 92, 144, 51, 48, 196,
+			66, 148, 178, 19, 228, 95, 26, 210, 46, 221, 40, 235, 79, 90,
+			63, 110, 176, 87, 203, 8, 2, 177, 175, 5, 140, 58, 62, 121,
+			197, 177, 39, 175, 18, 149, 220, 8, 231, 19, 44, 148, 114, 39,
+			87, 14, 230, 108, 102, 126, 140, 61, 250, 232, 3, 231, 162, 54,
+			36, 77, 151, 97, 9, 162, 218, 43, 129, 10, 250, 21, 223, 242,
+			34, 82, 226, 227, 162, 24, 164, 74, 29, 117, 61, 172, 1, 8,
+			129, 176, 114, 155, 62, 176, 227, 151, 19, 3, 151, 241, 3, 10,
+			210, 196, 21, 251, 82, 31, 165, 206, 15, 25, 186, 168, 17, 176,
+			41, 176, 157, 198, 68, 112, 211, 105, 77, 84, 125, 59, 88, 199,
+			186, 162, 201, 117, 219, 119, 121, 16, 192, 22, 111, 217, 50, 6,
+			2, 179, 171, 232, 202, 38, 132, 97, 25, 107, 67, 222, 191, 226,
+			68, 225, 142, 110, 52, 56, 35, 57, 139, 232, 182, 219, 9, 186,
+			161, 45, 29, 84, 108, 223, 9, 18, 206, 25, 102, 154, 26, 101,
+			61, 163, 82, 26, 53, 202, 125, 74, 107, 104, 26, 212, 40, 211,
+			61, 42, 149, 165, 70, 121, 239, 19, 157, 22, 244, 229, 253, 151,
+			201, 115, 202, 128, 254, 89, 125, 214, 154, 103, 215, 237, 234, 186,
+			227, 242, 113, 159, 219, 53, 100, 39, 196, 208, 213, 24, 34, 224,
+			21, 72, 71, 28, 133, 205, 210, 73, 52, 198, 116, 6, 218, 86,
+			183, 238, 96, 23, 255, 236, 46, 53, 170, 180, 65, 141, 103, 247,
+			171, 59, 248, 116, 150, 26, 207, 142, 204, 200, 49, 166, 5, 74,
+			62, 107, 21, 37, 109, 64, 171, 249, 167, 172, 23, 216, 181, 118,
+			211, 118, 227, 17, 38, 196, 39, 53, 76, 169, 63, 2, 23, 117,
+			33, 79, 225, 166, 155, 138, 206, 112, 72, 129, 186, 171, 233, 185,
+			32, 145, 33, 3, 112, 190, 48, 249, 232, 196, 118, 182, 238, 202,
+			132, 8, 109, 221, 15, 31, 81, 169, 44, 53, 158, 63, 90, 234,
+			178, 117, 103, 87, 133, 169, 143, 222, 71, 205, 23, 244, 215, 78,
+			90, 16, 190, 143, 179, 150, 231, 200, 96, 19, 16, 136, 37, 114,
+			81, 136, 81, 18, 21, 25, 2, 211, 36, 55, 25, 193, 177, 47,
+			67, 141, 23, 34, 255, 2, 48, 17, 126, 225, 112, 210, 68, 248,
+			133, 14, 19, 225, 215, 102, 46, 116, 154, 8, 191, 54, 59, 65,
+			46, 226, 144, 178, 212, 180, 245, 213, 73, 235, 20, 83, 138, 144,
+			77, 171, 27, 239, 233, 168, 251, 108, 134, 26, 182, 174, 236, 153,
+			128, 71, 177, 15, 157, 80, 41, 131, 26, 246, 41, 229, 154, 147,
+			205, 82, 99, 53, 234, 62, 43, 186, 95, 205, 78, 144, 38, 118,
+			223, 79, 205, 53, 189, 62, 105, 85, 152, 122, 154, 72, 10, 37,
+			157, 108, 37, 16, 144, 144, 53, 49, 248, 173, 12, 174, 35, 165,
+			69, 96, 32, 156, 170, 180, 114, 80, 164, 199, 243, 59, 103, 16,
+			141, 187, 223, 164, 198, 90, 100, 103, 221, 159, 161, 198, 90, 228,
+			152, 211, 175, 81, 99, 45, 114, 204, 233, 55, 168, 177, 118, 126,
+			82, 165, 178, 212, 168, 71, 179, 232, 23, 179, 168, 103, 39, 200,
+			175, 129, 165, 70, 74, 39, 212, 108, 234, 238, 164, 245, 75, 26,
+			139, 84, 45, 200, 232, 180, 155, 34, 90, 237, 230, 9, 69, 33,
+			74, 167, 132, 79, 0, 16, 125, 91, 132, 222, 224, 108, 113, 137,
+			57, 77, 96, 216, 37, 17, 117, 193, 181, 64, 58, 126, 185, 227,
+			53, 8, 191, 211, 116, 92, 39, 8, 157, 42, 68, 184, 69, 35,
+			159, 13, 36, 154, 62, 7, 65, 88, 90, 54, 181, 131, 68, 60,
+			75, 166, 20, 72, 9, 195, 170, 14, 224, 16, 147, 26, 205, 8,
+			56, 36, 67, 141, 230, 128, 218, 155, 68, 163, 70, 115, 175, 90,
+			98, 98, 80, 163, 121, 74, 1, 142, 100, 169, 225, 70, 192, 33,
+			2, 56, 110, 118, 130, 124, 63, 194, 102, 128, 154, 190, 30, 76,
+			90, 110, 2, 52, 45, 223, 171, 181, 171, 91, 129, 38, 102, 2,
+			193, 187, 169, 10, 113, 4, 27, 94, 61, 24, 99, 65, 213, 231,
+			220, 13, 214, 61, 8, 160, 215, 228, 77, 207, 223, 96, 181, 118,
+			179, 21, 140, 177, 150, 239, 65, 176, 98, 191, 35, 78, 30, 244,
+			110, 82, 195, 143, 38, 53, 144, 161, 134, 31, 77, 106, 64, 163,
+			134, 191, 87, 157, 136, 3, 6, 53, 252, 211, 202, 229, 104, 32,
+			75, 141, 32, 154, 212, 128, 152, 84, 144, 157, 32, 143, 96, 188,
+			210, 244, 237, 212, 79, 104, 154, 117, 110, 59, 18, 105, 119, 206,
+			42, 138, 190, 121, 59, 141, 129, 71, 210, 120, 237, 244, 70, 253,
+			160, 229, 51, 161, 217, 65, 255, 174, 88, 200, 19, 50, 251, 130,
+			135, 230, 154, 9, 6, 77, 30, 35, 137, 198, 131, 75, 194, 103,
+			40, 128, 197, 182, 59, 125, 98, 113, 63, 217, 46, 152, 201, 129,
+			201, 103, 194, 98, 80, 70, 239, 124, 163, 190, 87, 165, 116, 106,
+			188, 241, 192, 8, 25, 85, 193, 59, 223, 164, 15, 88, 135, 226,
+			67, 13, 176, 5, 5, 98, 136, 197, 195, 107, 29, 49, 52, 223,
+			164, 103, 18, 49, 52, 223, 212, 79, 84, 12, 80, 157, 154, 111,
+			211, 244, 1, 235, 125, 90, 143, 134, 224, 208, 133, 134, 216, 82,
+			187, 94, 71, 121, 35, 50, 181, 131, 199, 216, 149, 126, 1, 58,
+			7, 147, 79, 183, 234, 249, 224, 138, 34, 227, 74, 98, 38, 250,
+			41, 183, 188, 32, 112, 86, 27, 156, 196, 166, 122, 155, 42, 161,
+			42, 68, 84, 65, 147, 63, 184, 157, 219, 165, 130, 100, 194, 24,
+			51, 42, 137, 67, 238, 39, 228, 223, 170, 48, 153, 230, 187, 52,
+			125, 208, 250, 23, 189, 102, 128, 7, 63, 175, 177, 90, 27, 98,
+			89, 36, 73, 37, 22, 246, 218, 97, 21, 54, 180, 28, 8, 248,
+			131, 192, 86, 158, 234, 57, 69, 65, 223, 192, 174, 17, 127, 0,
+			38, 172, 170, 0, 145, 106, 210, 106, 106, 231, 176, 114, 114, 126,
+			209, 116, 12, 13, 7, 220, 167, 146, 58, 36, 201, 0, 169, 203,
+			192, 150, 230, 123, 96, 54, 207, 245, 152, 140, 60, 112, 68, 79,
+			128, 140, 246, 42, 222, 155, 178, 85, 190, 6, 1, 56, 215, 128,
+			230, 64, 144, 78, 244, 128, 148, 82, 5, 152, 133, 122, 238, 20,
+			170, 87, 188, 118, 60, 12, 176, 156, 123, 79, 60, 12, 176, 197,
+			122, 143, 70, 6, 200, 71, 116, 25, 163, 210, 252, 113, 192, 139,
+			247, 235, 93, 120, 81, 115, 192, 189, 53, 148, 160, 228, 73, 114,
+			0, 146, 17, 20, 78, 80, 122, 197, 13, 53, 100, 132, 251, 14,
+			110, 20, 72, 6, 120, 231, 218, 74, 108, 32, 140, 177, 85, 199,
+			181, 253, 141, 177, 142, 249, 37, 92, 122, 145, 112, 118, 116, 128,
+			193, 2, 226, 54, 161, 34, 180, 3, 124, 95, 11, 145, 119, 188,
+			23, 223, 231, 174, 57, 117, 117, 162, 70, 251, 185, 11, 61, 17,
+			240, 50, 114, 86, 71, 147, 18, 102, 105, 141, 154, 63, 30, 35,
+			102, 90, 135, 100, 63, 33, 117, 180, 75, 204, 124, 72, 75, 253,
+			146, 166, 89, 207, 177, 162, 80, 154, 42, 170, 58, 145, 60, 121,
+			58, 40, 61, 42, 48, 98, 109, 76, 161, 251, 106, 33, 25, 90,
+			14, 84, 188, 3, 196, 48, 53, 141, 154, 31, 210, 178, 57, 82,
+			149, 70, 137, 230, 79, 107, 250, 227, 214, 10, 196, 32, 106, 216,
+			193, 250, 120, 192, 33, 8, 28, 160, 137, 207, 27, 248, 164, 136,
+			212, 75, 68, 234, 8, 201, 254, 202, 94, 226, 21, 157, 98, 121,
+			65, 224, 39, 236, 66, 120, 39, 204, 11, 220, 129, 78, 210, 216,
+			75, 86, 37, 53, 72, 246, 239, 82, 73, 3, 146, 82, 139, 162,
+			161, 22, 229, 167, 53, 122, 153, 228, 72, 22, 190, 34, 173, 54,
+			127, 90, 219, 123, 137, 252, 160, 38, 13, 16, 205, 15, 107, 250,
+			140, 21, 108, 38, 215, 43, 229, 249, 216, 58, 24, 70, 169, 238,
+			23, 20, 106, 41, 216, 20, 8, 123, 22, 227, 60, 5, 213, 117,
+			222, 228, 193, 20, 203, 139, 80, 104, 60, 63, 198, 242, 245, 0,
+			254, 54, 188, 122, 205, 171, 195, 47, 127, 149, 143, 87, 237, 32,
+			158, 145, 150, 166, 230, 135, 227, 25, 1, 80, 63, 172, 245, 83,
+			149, 52, 32, 41, 69, 76, 13, 228, 98, 243, 195, 218, 129, 105,
+			57, 35, 225, 61, 109, 126, 88, 59, 248, 36, 121, 155, 152, 145,
+			78, 205, 143, 106, 250, 180, 213, 150, 252, 175, 88, 241, 228, 140,
+			96, 236, 136, 21, 114, 66, 64, 144, 34, 217, 201, 198, 82, 168,
+			90, 109, 1, 155, 113, 27, 99, 184, 65, 149, 118, 192, 125, 52,
+			157, 134, 219, 157, 9, 224, 150, 27, 158, 93, 147, 144, 0, 107,
+			112, 69, 104, 76, 77, 135, 61, 252, 209, 120, 78, 186, 6, 201,
+			126, 181, 44, 32, 247, 126, 84, 219, 187, 79, 37, 179, 144, 220,
+			95, 148, 115, 18, 98, 179, 249, 81, 109, 228, 9, 20, 147, 53,
+			40, 254, 115, 154, 126, 197, 114, 216, 117, 94, 115, 80, 88, 228,
+			61, 22, 97, 222, 171, 119, 75, 130, 249, 86, 195, 118, 220, 137,
+			144, 223, 9, 243, 184, 125, 19, 124, 66, 119, 81, 100, 171, 38,
+			90, 110, 61, 94, 26, 35, 141, 61, 171, 105, 0, 249, 252, 57,
+			173, 95, 141, 219, 192, 113, 141, 28, 84, 201, 44, 36, 173, 57,
+			57, 13, 33, 42, 155, 63, 167, 29, 158, 33, 31, 19, 75, 99,
+			82, 243, 19, 154, 126, 217, 250, 73, 141, 45, 57, 47, 70, 83,
+			128, 181, 24, 3, 110, 127, 117, 35, 228, 65, 236, 96, 28, 171,
+			93, 96, 213, 106, 188, 10, 182, 206, 42, 78, 95, 132, 153, 182,
+			27, 193, 0, 103, 88, 115, 130, 86, 3, 60, 71, 67, 240, 64,
+			4, 111, 95, 140, 107, 142, 130, 112, 0, 97, 196, 109, 214, 112,
+			220, 155, 224, 240, 43, 2, 7, 134, 158, 39, 204, 161, 163, 105,
+			155, 105, 28, 104, 159, 74, 106, 144, 204, 14, 170, 164, 1, 201,
+			225, 156, 74, 102, 33, 185, 251, 49, 57, 109, 33, 50, 154, 159,
+			208, 246, 76, 145, 255, 191, 152, 118, 154, 154, 191, 168, 233, 69,
+			235, 19, 26, 155, 217, 98, 11, 197, 163, 9, 66, 15, 110, 84,
+			196, 200, 99, 65, 184, 131, 95, 146, 246, 211, 64, 145, 84, 3,
+			81, 69, 222, 8, 56, 226, 108, 129, 45, 43, 16, 9, 74, 175,
+			74, 142, 1, 187, 68, 88, 116, 139, 9, 60, 134, 243, 98, 68,
+			109, 217, 99, 151, 217, 35, 79, 79, 71, 192, 72, 139, 225, 43,
+			96, 0, 225, 253, 69, 160, 121, 50, 105, 64, 114, 207, 94, 149,
+			204, 66, 114, 223, 147, 18, 24, 66, 54, 53, 127, 81, 59, 240,
+			56, 249, 159, 192, 171, 71, 167, 153, 79, 105, 112, 165, 102, 253,
+			134, 198, 74, 9, 11, 37, 57, 195, 160, 189, 10, 166, 166, 81,
+			24, 196, 153, 249, 51, 0, 20, 123, 53, 240, 26, 183, 120, 77,
+			184, 156, 174, 54, 236, 38, 39, 146, 123, 235, 161, 188, 136, 25,
+			76, 121, 167, 208, 237, 109, 16, 117, 39, 191, 163, 182, 34, 16,
+			30, 16, 8, 113, 192, 35, 25, 233, 17, 21, 49, 129, 39, 87,
+			199, 245, 66, 194, 102, 230, 79, 3, 127, 134, 75, 129, 231, 0,
+			108, 239, 79, 105, 217, 3, 228, 247, 116, 105, 251, 156, 249, 172,
+			166, 255, 186, 102, 90, 191, 177, 147, 251, 129, 196, 77, 104, 116,
+			73, 64, 54, 221, 18, 36, 46, 115, 187, 111, 10, 238, 255, 162,
+			32, 209, 117, 48, 241, 198, 185, 215, 44, 46, 204, 149, 163, 186,
+			119, 189, 49, 80, 224, 125, 249, 175, 15, 0, 187, 116, 60, 251,
+			62, 171, 200, 145, 142, 103, 223, 103, 213, 217, 135, 70, 225, 230,
+			103, 181, 220, 110, 50, 44, 10, 103, 105, 230, 179, 26, 253, 117,
+			205, 64, 100, 212, 213, 233, 247, 57, 205, 28, 79, 228, 104, 212,
+			252, 53, 205, 60, 71, 166, 164, 41, 185, 249, 121, 77, 63, 96,
+			141, 137, 75, 132, 248, 214, 96, 140, 5, 157, 252, 65, 52, 231,
+			104, 116, 112, 142, 125, 62, 30, 29, 156, 99, 159, 87, 231, 24,
+			26, 143, 155, 159, 215, 246, 237, 39, 255, 89, 195, 158, 116, 106,
+			254, 150, 166, 159, 177, 190, 162, 117, 235, 62, 147, 94, 41, 50,
+			9, 157, 137, 17, 200, 123, 118, 160, 219, 192, 180, 172, 136, 216,
+			52, 137, 113, 241, 59, 161, 111, 87, 100, 253, 74, 20, 24, 83,
+			152, 185, 43, 39, 34, 224, 243, 219, 141, 70, 194, 252, 158, 116,
+			245, 44, 125, 32, 34, 172, 7, 173, 218, 42, 74, 207, 176, 180,
+			137, 112, 32, 5, 240, 98, 169, 116, 160, 131, 2, 136, 158, 193,
+			57, 30, 86, 73, 13, 146, 71, 78, 170, 164, 1, 201, 211, 163,
+			196, 65, 120, 24, 52, 243, 101, 77, 255, 93, 205, 180, 158, 235,
+			208, 105, 187, 29, 62, 17, 59, 83, 106, 151, 112, 212, 40, 247,
+			249, 227, 234, 177, 115, 201, 59, 66, 87, 105, 106, 126, 57, 94,
+			40, 56, 213, 190, 172, 245, 31, 80, 73, 3, 146, 214, 33, 137,
+			70, 70, 22, 6, 118, 248, 119, 35, 52, 146, 231, 218, 239, 196,
+			104, 100, 8, 52, 250, 10, 160, 81, 11, 39, 99, 82, 243, 171,
+			154, 190, 96, 173, 178, 50, 138, 0, 32, 255, 172, 242, 117, 71,
+			222, 139, 38, 102, 132, 231, 158, 178, 118, 184, 47, 69, 156, 24,
+			53, 28, 89, 95, 141, 231, 4, 18, 198, 87, 181, 254, 35, 42,
+			105, 64, 146, 29, 87, 201, 44, 36, 243, 215, 229, 248, 229, 145,
+			245, 85, 237, 228, 211, 145, 53, 218, 207, 174, 147, 71, 239, 193,
+			26, 205, 87, 126, 253, 47, 201, 20, 109, 59, 251, 204, 205, 239,
+			109, 197, 54, 134, 178, 196, 119, 200, 25, 195, 122, 41, 225, 49,
+			173, 151, 100, 195, 151, 255, 43, 141, 28, 152, 193, 184, 89, 241,
+			14, 43, 139, 103, 5, 232, 40, 217, 149, 24, 181, 83, 75, 250,
+			104, 12, 198, 95, 74, 53, 250, 36, 33, 113, 26, 13, 122, 6,
+			182, 178, 71, 75, 116, 147, 168, 3, 79, 234, 203, 215, 12, 34,
+			131, 58, 120, 217, 24, 115, 74, 53, 186, 72, 114, 171, 78, 29,
+			13, 128, 43, 194, 130, 88, 69, 179, 60, 217, 187, 27, 101, 252,
+			59, 135, 133, 203, 195, 170, 182, 72, 7, 249, 159, 214, 200, 129,
+			238, 8, 50, 106, 222, 115, 29, 179, 209, 118, 54, 27, 1, 150,
+			228, 148, 46, 145, 129, 54, 246, 128, 22, 170, 35, 250, 22, 38,
+			115, 145, 233, 107, 153, 136, 226, 215, 237, 224, 102, 254, 89, 114,
+			80, 70, 245, 233, 49, 192, 77, 182, 98, 122, 100, 43, 54, 144,
+			136, 17, 35, 31, 171, 77, 102, 229, 223, 68, 134, 100, 36, 12,
+			213, 88, 20, 52, 19, 94, 42, 236, 154, 183, 108, 92, 6, 205,
+			116, 220, 122, 60, 22, 17, 162, 115, 83, 176, 205, 216, 66, 77,
+			47, 83, 245, 61, 174, 149, 255, 87, 17, 190, 197, 71, 136, 26,
+			201, 9, 66, 122, 247, 159, 132, 234, 21, 50, 144, 192, 226, 237,
+			113, 45, 238, 66, 182, 19, 123, 243, 220, 5, 225, 242, 255, 86,
+			35, 71, 166, 193, 129, 175, 123, 176, 193, 61, 141, 182, 68, 178,
+			178, 77, 48, 1, 6, 124, 29, 239, 61, 212, 45, 96, 82, 142,
+			170, 223, 109, 192, 156, 28, 221, 106, 188, 194, 229, 146, 206, 116,
+			249, 68, 129, 101, 246, 14, 64, 215, 225, 3, 149, 255, 21, 141,
+			28, 142, 187, 72, 176, 136, 247, 4, 150, 21, 25, 41, 35, 113,
+			46, 201, 149, 124, 96, 235, 225, 36, 58, 19, 203, 57, 28, 118,
+			230, 222, 13, 68, 191, 172, 145, 124, 23, 140, 18, 181, 239, 109,
+			97, 23, 54, 45, 236, 100, 239, 145, 111, 7, 172, 157, 175, 238,
+			6, 57, 177, 237, 200, 229, 18, 151, 201, 238, 110, 184, 170, 117,
+			222, 25, 96, 203, 185, 46, 152, 6, 249, 223, 208, 200, 129, 89,
+			238, 59, 183, 122, 16, 162, 26, 217, 165, 162, 33, 85, 32, 26,
+			18, 66, 107, 96, 114, 170, 119, 95, 91, 180, 82, 88, 146, 77,
+			44, 219, 193, 77, 121, 186, 168, 86, 33, 203, 154, 37, 131, 201,
+			34, 96, 142, 186, 238, 5, 97, 55, 9, 140, 50, 233, 30, 162,
+			59, 181, 36, 37, 210, 157, 218, 228, 159, 245, 145, 172, 138, 6,
+			68, 57, 201, 117, 159, 122, 116, 219, 157, 185, 105, 212, 214, 22,
+			27, 39, 46, 152, 79, 65, 55, 221, 135, 204, 86, 221, 108, 113,
+			24, 237, 168, 27, 135, 208, 205, 135, 5, 157, 232, 93, 115, 203,
+			99, 101, 71, 93, 149, 72, 159, 60, 63, 232, 22, 39, 111, 231,
+			241, 98, 237, 223, 116, 224, 161, 46, 65, 0, 39, 70, 103, 73,
+			149, 183, 93, 131, 4, 77, 218, 126, 196, 113, 193, 124, 138, 254,
+			144, 70, 246, 247, 166, 140, 244, 193, 222, 213, 123, 151, 86, 19,
+			186, 120, 111, 149, 196, 206, 204, 167, 104, 72, 246, 197, 159, 19,
+			59, 140, 222, 7, 225, 176, 118, 182, 155, 243, 41, 250, 1, 141,
+			28, 234, 26, 90, 162, 64, 64, 31, 217, 209, 108, 146, 85, 212,
+			16, 30, 189, 143, 154, 17, 48, 56, 201, 117, 83, 3, 58, 126,
+			79, 84, 99, 39, 216, 122, 95, 158, 181, 239, 93, 20, 102, 216,
+			239, 212, 95, 49, 195, 126, 197, 12, 251, 187, 96, 134, 253, 64,
+			108, 134, 125, 54, 54, 195, 190, 129, 63, 77, 106, 236, 79, 205,
+			225, 207, 52, 53, 14, 164, 174, 72, 55, 220, 131, 177, 27, 46,
+			252, 252, 166, 78, 244, 76, 138, 154, 39, 82, 203, 154, 245, 53,
+			157, 45, 113, 255, 150, 83, 197, 71, 11, 133, 160, 156, 84, 231,
+			34, 162, 118, 159, 106, 113, 100, 14, 201, 180, 195, 53, 93, 83,
+			217, 129, 64, 128, 213, 188, 144, 78, 198, 67, 239, 38, 119, 243,
+			194, 10, 68, 105, 112, 197, 37, 52, 116, 7, 177, 15, 133, 25,
+			98, 83, 69, 211, 45, 223, 152, 73, 92, 254, 197, 164, 65, 234,
+			143, 72, 252, 182, 140, 52, 17, 194, 253, 45, 98, 130, 130, 158,
+			84, 134, 251, 92, 40, 174, 44, 95, 155, 91, 88, 46, 205, 20,
+			33, 50, 166, 120, 100, 16, 110, 94, 97, 58, 208, 135, 184, 208,
+			195, 110, 57, 32, 77, 204, 173, 201, 104, 130, 24, 187, 101, 110,
+			182, 114, 163, 60, 23, 161, 74, 162, 29, 25, 145, 147, 108, 29,
+			109, 21, 204, 6, 50, 224, 175, 122, 34, 155, 35, 127, 169, 19,
+			51, 131, 118, 3, 19, 250, 85, 235, 39, 117, 57, 51, 128, 27,
+			196, 255, 141, 27, 145, 215, 207, 106, 94, 234, 2, 82, 190, 12,
+			147, 232, 75, 24, 138, 56, 33, 152, 170, 225, 61, 81, 160, 194,
+			63, 70, 139, 179, 206, 109, 160, 43, 77, 142, 230, 57, 50, 186,
+			68, 208, 181, 54, 241, 254, 4, 108, 22, 47, 142, 18, 38, 138,
+			4, 99, 114, 129, 1, 145, 187, 28, 170, 193, 218, 56, 49, 247,
+			136, 182, 8, 245, 87, 105, 150, 217, 13, 176, 237, 218, 96, 252,
+			142, 19, 128, 90, 95, 5, 105, 44, 206, 151, 231, 138, 179, 207,
+			85, 230, 94, 83, 90, 90, 94, 34, 29, 107, 35, 34, 212, 198,
+			136, 22, 188, 148, 0, 181, 151, 209, 88, 34, 35, 140, 37, 38,
+			50, 251, 85, 74, 167, 198, 196, 129, 130, 74, 25, 212, 152, 120,
+			116, 134, 60, 134, 235, 163, 81, 99, 82, 191, 106, 77, 48, 193,
+			96, 73, 5, 31, 152, 235, 184, 117, 180, 224, 137, 214, 55, 49,
+			247, 130, 234, 7, 44, 102, 39, 163, 126, 224, 33, 178, 201, 168,
+			31, 176, 159, 157, 124, 116, 134, 92, 197, 126, 116, 106, 60, 164,
+			63, 13, 230, 100, 81, 16, 224, 32, 1, 189, 4, 92, 229, 133,
+			32, 24, 167, 112, 118, 165, 180, 80, 156, 47, 61, 175, 204, 242,
+			160, 33, 141, 26, 15, 101, 70, 84, 10, 218, 61, 56, 169, 82,
+			6, 53, 30, 186, 124, 149, 204, 99, 151, 6, 53, 30, 209, 159,
+			180, 158, 96, 146, 229, 10, 186, 176, 94, 90, 97, 138, 1, 220,
+			117, 170, 16, 158, 250, 145, 204, 46, 149, 130, 215, 87, 135, 14,
+			171, 20, 116, 117, 250, 49, 242, 62, 13, 59, 54, 193, 168, 248,
+			170, 245, 48, 43, 182, 90, 104, 112, 46, 111, 63, 228, 117, 8,
+			144, 129, 109, 250, 19, 24, 177, 156, 32, 72, 47, 57, 102, 49,
+			140, 9, 222, 160, 137, 86, 202, 132, 215, 85, 163, 149, 50, 13,
+			106, 92, 126, 116, 6, 76, 42, 51, 41, 184, 41, 125, 66, 127,
+			242, 130, 245, 52, 43, 134, 94, 83, 94, 70, 218, 209, 76, 86,
+			129, 91, 218, 100, 4, 123, 151, 57, 201, 142, 192, 136, 242, 137,
+			140, 130, 90, 90, 167, 198, 19, 71, 166, 84, 202, 160, 198, 147,
+			195, 227, 164, 132, 131, 200, 80, 115, 70, 159, 205, 91, 151, 186,
+			97, 152, 16, 221, 118, 216, 41, 24, 65, 206, 100, 14, 169, 148,
+			78, 141, 153, 195, 143, 168, 148, 65, 141, 217, 225, 163, 164, 130,
+			157, 246, 81, 243, 170, 126, 237, 97, 235, 153, 157, 204, 60, 41,
+			67, 246, 24, 9, 233, 53, 20, 48, 126, 188, 154, 57, 174, 82,
+			58, 53, 174, 230, 103, 85, 10, 172, 156, 135, 39, 201, 87, 12,
+			28, 75, 150, 26, 75, 250, 85, 235, 175, 116, 38, 24, 188, 110,
+			228, 141, 84, 236, 106, 5, 240, 38, 78, 90, 229, 57, 237, 102,
+			103, 24, 222, 2, 92, 95, 201, 136, 177, 24, 136, 10, 154, 76,
+			66, 235, 82, 103, 99, 210, 63, 71, 68, 121, 135, 146, 24, 108,
+			45, 113, 7, 54, 239, 4, 9, 87, 25, 124, 149, 47, 250, 214,
+			29, 172, 22, 72, 231, 242, 226, 236, 226, 20, 43, 243, 166, 119,
+			75, 61, 125, 128, 46, 61, 112, 117, 34, 77, 94, 224, 185, 56,
+			219, 223, 96, 126, 171, 26, 177, 25, 168, 154, 107, 194, 29, 187,
+			191, 1, 97, 164, 235, 28, 224, 202, 110, 175, 59, 213, 117, 118,
+			91, 77, 36, 49, 143, 64, 210, 147, 142, 217, 179, 210, 44, 80,
+			99, 91, 90, 131, 219, 46, 70, 113, 34, 50, 64, 113, 211, 190,
+			201, 225, 2, 79, 5, 15, 134, 144, 71, 98, 15, 94, 119, 130,
+			234, 75, 34, 199, 242, 63, 181, 252, 89, 141, 26, 75, 209, 30,
+			204, 234, 212, 88, 138, 246, 96, 214, 160, 198, 210, 163, 51, 228,
+			29, 50, 214, 226, 115, 169, 134, 102, 157, 65, 223, 50, 113, 46,
+			42, 191, 40, 0, 77, 55, 119, 82, 32, 147, 139, 223, 201, 99,
+			228, 242, 229, 216, 201, 234, 185, 236, 49, 242, 39, 81, 224, 196,
+			138, 126, 197, 250, 125, 45, 209, 81, 199, 149, 220, 42, 7, 11,
+			178, 32, 225, 44, 211, 121, 134, 23, 64, 39, 161, 92, 54, 130,
+			141, 0, 93, 90, 174, 23, 159, 147, 17, 244, 59, 214, 17, 143,
+			87, 215, 169, 130, 157, 19, 248, 141, 64, 112, 229, 154, 88, 73,
+			201, 43, 17, 150, 71, 115, 212, 241, 11, 147, 15, 94, 124, 232,
+			225, 87, 61, 242, 232, 249, 124, 1, 3, 8, 10, 14, 75, 5,
+			105, 67, 46, 12, 132, 133, 171, 43, 165, 89, 228, 25, 122, 190,
+			22, 246, 79, 198, 95, 56, 27, 59, 89, 128, 103, 84, 37, 50,
+			164, 6, 64, 84, 250, 149, 47, 8, 68, 63, 169, 28, 84, 38,
+			197, 169, 44, 53, 42, 135, 230, 58, 29, 163, 42, 71, 102, 200,
+			25, 229, 6, 181, 170, 31, 182, 14, 39, 97, 134, 220, 9, 10,
+			0, 1, 247, 19, 161, 79, 50, 80, 86, 89, 156, 195, 217, 186,
+			26, 197, 121, 131, 211, 116, 213, 58, 68, 254, 99, 20, 220, 174,
+			174, 143, 88, 255, 26, 226, 173, 109, 246, 168, 138, 236, 146, 37,
+			242, 20, 96, 227, 134, 190, 163, 156, 1, 30, 124, 152, 21, 151,
+			102, 74, 165, 216, 225, 9, 172, 60, 138, 204, 183, 221, 154, 215,
+			100, 43, 43, 165, 89, 96, 237, 128, 55, 110, 54, 49, 166, 188,
+			114, 192, 81, 216, 168, 98, 92, 59, 53, 222, 20, 239, 64, 2,
+			123, 104, 179, 215, 201, 2, 21, 167, 246, 186, 228, 131, 210, 209,
+			28, 193, 98, 191, 30, 193, 21, 160, 83, 239, 87, 70, 174, 186,
+			65, 141, 250, 254, 3, 228, 159, 105, 42, 178, 200, 77, 125, 194,
+			122, 183, 198, 186, 239, 54, 18, 177, 84, 240, 41, 204, 206, 80,
+			38, 224, 151, 208, 43, 162, 78, 7, 231, 42, 41, 156, 104, 144,
+			133, 158, 124, 134, 84, 61, 212, 14, 50, 37, 196, 212, 241, 220,
+			132, 93, 126, 252, 10, 30, 188, 154, 112, 51, 50, 213, 5, 231,
+			149, 155, 3, 202, 204, 30, 24, 133, 155, 150, 178, 105, 7, 105,
+			229, 230, 120, 129, 60, 138, 118, 112, 166, 151, 106, 107, 214, 248,
+			22, 219, 186, 91, 199, 5, 84, 41, 17, 110, 207, 203, 30, 35,
+			39, 85, 180, 189, 55, 232, 87, 173, 3, 73, 156, 10, 61, 201,
+			194, 202, 65, 106, 24, 199, 229, 13, 18, 157, 68, 212, 187, 55,
+			72, 116, 210, 144, 9, 124, 131, 117, 72, 165, 178, 212, 120, 195,
+			225, 43, 100, 56, 97, 149, 102, 188, 225, 232, 44, 57, 175, 130,
+			226, 133, 250, 152, 117, 2, 121, 237, 134, 3, 97, 212, 215, 212,
+			139, 247, 210, 222, 23, 123, 86, 171, 44, 130, 222, 133, 210, 230,
+			31, 45, 200, 140, 80, 190, 128, 161, 193, 197, 187, 17, 158, 61,
+			71, 30, 67, 243, 147, 244, 157, 212, 91, 52, 205, 154, 216, 2,
+			36, 155, 181, 113, 9, 160, 0, 246, 220, 201, 30, 39, 147, 210,
+			200, 195, 120, 81, 191, 108, 61, 208, 17, 239, 39, 177, 226, 96,
+			160, 36, 91, 147, 227, 68, 3, 6, 227, 69, 137, 141, 104, 191,
+			96, 188, 40, 253, 31, 209, 124, 193, 120, 81, 62, 18, 130, 214,
+			11, 198, 139, 244, 49, 50, 156, 48, 93, 48, 94, 220, 59, 37,
+			162, 77, 34, 87, 250, 102, 253, 0, 68, 155, 188, 210, 176, 235,
+			176, 201, 20, 142, 246, 126, 201, 32, 113, 233, 36, 223, 185, 240,
+			219, 124, 44, 177, 160, 5, 193, 0, 171, 167, 31, 192, 32, 58,
+			244, 146, 143, 100, 92, 18, 254, 108, 40, 24, 70, 47, 105, 224,
+			65, 47, 13, 90, 54, 181, 165, 78, 213, 38, 183, 209, 113, 203,
+			91, 99, 220, 174, 202, 247, 25, 34, 152, 104, 105, 106, 188, 89,
+			250, 230, 160, 213, 132, 241, 230, 62, 170, 82, 6, 53, 222, 188,
+			111, 63, 153, 36, 112, 133, 157, 121, 155, 150, 250, 17, 77, 179,
+			78, 110, 177, 120, 146, 239, 150, 43, 6, 134, 57, 112, 181, 255,
+			54, 45, 187, 159, 252, 123, 128, 154, 1, 22, 154, 239, 212, 244,
+			235, 240, 162, 196, 214, 171, 38, 37, 110, 120, 11, 192, 219, 244,
+			50, 164, 60, 81, 58, 204, 137, 220, 68, 83, 171, 219, 61, 104,
+			144, 12, 143, 36, 123, 65, 215, 234, 14, 230, 33, 46, 47, 13,
+			22, 12, 64, 27, 243, 157, 234, 114, 223, 0, 188, 49, 223, 169,
+			46, 247, 13, 64, 28, 243, 157, 234, 114, 223, 0, 204, 49, 223,
+			169, 229, 231, 241, 114, 223, 144, 168, 99, 190, 83, 59, 249, 20,
+			249, 178, 128, 130, 70, 205, 31, 214, 244, 121, 235, 179, 59, 128,
+			194, 61, 3, 96, 171, 201, 116, 67, 22, 222, 198, 223, 14, 88,
+			234, 4, 72, 180, 160, 212, 27, 17, 211, 27, 1, 8, 76, 111,
+			126, 56, 6, 16, 152, 222, 252, 176, 214, 127, 88, 37, 13, 72,
+			30, 99, 42, 153, 133, 228, 241, 167, 37, 128, 164, 9, 233, 15,
+			107, 39, 74, 228, 18, 209, 77, 147, 102, 126, 76, 75, 253, 156,
+			182, 53, 221, 236, 214, 153, 36, 16, 14, 104, 238, 143, 105, 217,
+			99, 100, 134, 152, 166, 9, 248, 246, 126, 77, 159, 177, 30, 234,
+			0, 52, 184, 189, 185, 97, 98, 102, 91, 0, 25, 199, 107, 226,
+			234, 191, 95, 77, 206, 196, 213, 127, 191, 214, 191, 71, 37, 13,
+			232, 99, 255, 1, 149, 204, 66, 114, 68, 216, 199, 154, 106, 245,
+			223, 175, 89, 79, 146, 31, 6, 227, 52, 83, 71, 155, 101, 253,
+			154, 245, 119, 9, 155, 253, 88, 82, 20, 156, 17, 0, 95, 217,
+			211, 36, 222, 250, 96, 78, 13, 141, 70, 149, 45, 91, 141, 215,
+			218, 45, 5, 34, 80, 101, 20, 0, 53, 226, 88, 190, 178, 86,
+			164, 185, 136, 227, 1, 244, 106, 184, 67, 155, 193, 28, 151, 116,
+			97, 165, 140, 239, 155, 80, 74, 5, 160, 198, 170, 114, 112, 217,
+			135, 200, 199, 173, 150, 39, 7, 166, 52, 33, 208, 51, 233, 210,
+			135, 8, 117, 72, 4, 93, 45, 131, 240, 24, 84, 73, 4, 207,
+			174, 17, 149, 52, 32, 121, 232, 176, 74, 102, 33, 121, 228, 170,
+			132, 174, 68, 157, 15, 105, 199, 230, 200, 223, 154, 8, 93, 157,
+			154, 31, 215, 244, 17, 235, 79, 204, 127, 32, 156, 82, 108, 220,
+			72, 224, 77, 39, 207, 173, 55, 54, 146, 237, 195, 58, 183, 188,
+			22, 60, 146, 18, 169, 191, 133, 159, 12, 43, 53, 91, 13, 240,
+			71, 224, 83, 137, 15, 209, 227, 114, 241, 195, 97, 176, 110, 44,
+			104, 217, 174, 203, 125, 249, 122, 84, 180, 236, 99, 66, 184, 2,
+			151, 207, 70, 3, 30, 100, 1, 205, 95, 216, 134, 144, 18, 163,
+			241, 136, 65, 75, 86, 227, 119, 42, 222, 90, 69, 102, 158, 201,
+			67, 77, 14, 110, 167, 227, 106, 238, 21, 7, 123, 183, 21, 180,
+			174, 34, 180, 214, 96, 136, 209, 116, 193, 238, 95, 25, 115, 67,
+			213, 238, 118, 161, 129, 243, 192, 193, 245, 218, 228, 104, 72, 140,
+			53, 212, 86, 150, 181, 64, 121, 239, 184, 172, 235, 178, 69, 202,
+			163, 242, 134, 68, 233, 127, 132, 88, 143, 36, 3, 197, 212, 209,
+			215, 131, 39, 105, 131, 243, 51, 83, 204, 71, 129, 85, 198, 99,
+			84, 208, 133, 121, 186, 210, 254, 180, 234, 185, 183, 56, 250, 101,
+			32, 13, 149, 250, 86, 129, 156, 160, 73, 249, 120, 76, 39, 96,
+			163, 127, 60, 166, 19, 96, 68, 254, 113, 109, 255, 1, 242, 62,
+			144, 248, 210, 52, 243, 9, 45, 245, 159, 52, 205, 122, 112, 11,
+			34, 215, 123, 46, 0, 5, 37, 253, 125, 167, 84, 70, 32, 253,
+			193, 97, 157, 22, 102, 214, 15, 32, 237, 76, 3, 237, 252, 228,
+			75, 164, 157, 105, 164, 157, 159, 84, 48, 73, 35, 237, 252, 164,
+			130, 73, 26, 105, 231, 39, 21, 237, 76, 35, 237, 252, 164, 162,
+			157, 105, 69, 59, 63, 9, 180, 243, 15, 224, 228, 76, 3, 72,
+			255, 131, 166, 159, 183, 126, 91, 99, 114, 85, 131, 152, 104, 38,
+			105, 30, 200, 55, 18, 170, 193, 247, 57, 47, 20, 226, 241, 70,
+			74, 122, 117, 13, 2, 215, 175, 128, 87, 50, 42, 178, 151, 152,
+			91, 44, 73, 200, 149, 233, 106, 85, 254, 6, 188, 191, 107, 171,
+			137, 178, 130, 180, 118, 132, 56, 17, 0, 208, 76, 156, 95, 148,
+			204, 64, 114, 32, 175, 146, 56, 251, 19, 231, 84, 210, 128, 100,
+			97, 130, 252, 223, 2, 54, 58, 53, 63, 13, 148, 239, 63, 107,
+			255, 221, 83, 62, 9, 1, 216, 112, 159, 142, 145, 11, 176, 227,
+			211, 49, 114, 193, 134, 251, 52, 32, 215, 52, 209, 205, 12, 216,
+			34, 167, 126, 93, 211, 172, 139, 172, 24, 223, 31, 236, 116, 195,
+			33, 111, 145, 65, 115, 231, 236, 41, 242, 0, 49, 205, 12, 236,
+			143, 95, 211, 244, 211, 214, 129, 206, 141, 24, 63, 14, 8, 195,
+			200, 232, 41, 19, 203, 69, 201, 12, 36, 7, 246, 169, 36, 154,
+			63, 239, 207, 171, 164, 1, 201, 7, 78, 145, 39, 137, 110, 246,
+			209, 204, 231, 181, 212, 239, 106, 26, 6, 241, 86, 80, 78, 14,
+			185, 231, 189, 114, 98, 196, 125, 104, 2, 157, 61, 137, 59, 186,
+			15, 70, 252, 197, 151, 184, 163, 251, 112, 71, 127, 81, 1, 189,
+			15, 119, 244, 23, 21, 208, 251, 112, 71, 127, 81, 237, 232, 62,
+			220, 209, 95, 84, 59, 186, 79, 237, 232, 47, 194, 142, 6, 81,
+			179, 79, 215, 104, 230, 183, 52, 253, 75, 218, 5, 139, 33, 55,
+			212, 61, 151, 4, 71, 36, 155, 4, 126, 225, 183, 52, 125, 183,
+			74, 162, 81, 51, 205, 171, 36, 26, 53, 63, 112, 74, 37, 179,
+			212, 252, 146, 150, 57, 47, 251, 151, 252, 194, 151, 180, 108, 129,
+			252, 50, 236, 154, 62, 8, 228, 241, 21, 237, 31, 152, 102, 69,
+			204, 13, 176, 255, 43, 241, 66, 0, 246, 127, 37, 94, 8, 192,
+			254, 175, 192, 66, 92, 33, 96, 110, 156, 249, 61, 45, 245, 127,
+			104, 154, 245, 200, 206, 78, 155, 196, 18, 36, 119, 64, 86, 163,
+			230, 239, 105, 217, 179, 136, 79, 89, 192, 167, 175, 189, 68, 124,
+			202, 34, 62, 125, 77, 77, 35, 139, 248, 244, 53, 53, 141, 44,
+			226, 211, 215, 20, 62, 101, 17, 159, 190, 166, 240, 41, 171, 240,
+			233, 107, 128, 79, 104, 214, 159, 5, 40, 252, 161, 166, 63, 100,
+			125, 165, 231, 9, 209, 61, 183, 127, 128, 167, 68, 22, 79, 137,
+			63, 84, 20, 37, 11, 186, 23, 243, 15, 181, 129, 211, 42, 137,
+			16, 24, 61, 175, 146, 6, 36, 31, 188, 72, 254, 155, 128, 143,
+			78, 205, 111, 2, 190, 127, 227, 191, 255, 83, 66, 0, 0, 182,
+			201, 55, 99, 252, 2, 4, 249, 102, 140, 95, 176, 77, 190, 9,
+			248, 117, 149, 232, 102, 63, 205, 252, 23, 45, 245, 167, 154, 102,
+			61, 186, 195, 67, 98, 139, 125, 210, 175, 81, 243, 191, 104, 217,
+			115, 24, 153, 186, 31, 246, 201, 183, 52, 253, 130, 117, 152, 45,
+			111, 186, 73, 234, 60, 46, 250, 241, 184, 248, 150, 90, 220, 126,
+			208, 233, 153, 223, 210, 6, 44, 149, 212, 160, 169, 67, 99, 42,
+			105, 64, 114, 226, 60, 121, 15, 240, 148, 132, 102, 254, 76, 75,
+			189, 83, 223, 90, 112, 238, 182, 29, 74, 114, 147, 223, 169, 203,
+			15, 197, 77, 18, 141, 154, 127, 6, 146, 248, 56, 49, 77, 98,
+			164, 104, 230, 47, 53, 253, 109, 186, 97, 29, 233, 12, 153, 212,
+			161, 175, 144, 96, 32, 16, 5, 219, 252, 75, 141, 236, 39, 163,
+			36, 3, 181, 1, 134, 127, 173, 153, 51, 214, 8, 83, 182, 143,
+			12, 236, 27, 145, 232, 136, 138, 195, 164, 79, 148, 76, 99, 209,
+			68, 134, 6, 25, 3, 123, 226, 12, 3, 50, 246, 31, 136, 51,
+			178, 144, 49, 50, 77, 40, 33, 50, 3, 9, 203, 95, 3, 97,
+			249, 55, 134, 28, 130, 70, 205, 183, 234, 230, 101, 235, 159, 25,
+			172, 52, 11, 29, 119, 170, 90, 64, 78, 19, 158, 12, 40, 88,
+			71, 186, 126, 60, 215, 80, 179, 36, 53, 79, 112, 25, 197, 32,
+			30, 235, 20, 97, 172, 238, 86, 66, 240, 125, 12, 65, 134, 227,
+			236, 234, 2, 19, 201, 14, 25, 14, 236, 157, 148, 27, 146, 116,
+			18, 143, 66, 46, 160, 137, 6, 4, 211, 178, 131, 155, 132, 201,
+			8, 76, 162, 49, 140, 148, 37, 213, 59, 157, 196, 25, 149, 61,
+			88, 26, 227, 52, 1, 65, 238, 168, 130, 185, 93, 4, 93, 62,
+			192, 201, 144, 205, 174, 96, 56, 167, 41, 184, 4, 6, 157, 2,
+			92, 70, 18, 101, 62, 5, 254, 50, 145, 141, 153, 176, 118, 12,
+			188, 182, 95, 229, 224, 64, 19, 125, 153, 8, 252, 234, 196, 185,
+			137, 166, 29, 132, 220, 199, 112, 122, 142, 91, 159, 192, 78, 86,
+			189, 112, 34, 238, 36, 40, 180, 54, 26, 216, 120, 4, 18, 201,
+			238, 119, 107, 187, 18, 72, 0, 10, 169, 183, 234, 102, 34, 3,
+			87, 111, 96, 87, 156, 97, 64, 70, 110, 119, 156, 145, 133, 12,
+			250, 88, 132, 4, 146, 91, 120, 171, 190, 119, 138, 92, 6, 36,
+			6, 36, 124, 135, 174, 207, 91, 19, 114, 39, 117, 171, 237, 162,
+			7, 57, 151, 186, 134, 5, 219, 149, 224, 102, 126, 135, 174, 15,
+			169, 164, 6, 205, 13, 31, 86, 73, 3, 146, 82, 75, 70, 16,
+			39, 223, 161, 75, 45, 25, 81, 24, 249, 14, 253, 68, 41, 242,
+			17, 250, 192, 15, 220, 163, 143, 144, 52, 13, 124, 89, 195, 85,
+			191, 36, 231, 157, 151, 240, 158, 202, 75, 115, 252, 185, 223, 32,
+			218, 19, 100, 239, 85, 30, 198, 20, 85, 242, 28, 91, 186, 164,
+			200, 10, 177, 128, 113, 215, 10, 27, 100, 127, 215, 229, 251, 61,
+			25, 230, 31, 34, 253, 224, 70, 95, 129, 192, 197, 104, 2, 158,
+			46, 103, 33, 3, 252, 191, 193, 202, 30, 126, 87, 208, 134, 77,
+			89, 217, 67, 206, 50, 100, 228, 223, 161, 145, 3, 155, 250, 254,
+			14, 122, 79, 208, 83, 100, 216, 229, 119, 194, 74, 98, 16, 58,
+			14, 98, 23, 100, 223, 136, 6, 50, 65, 14, 110, 118, 194, 85,
+			96, 160, 73, 200, 73, 160, 189, 137, 28, 82, 3, 79, 30, 209,
+			223, 53, 200, 125, 72, 35, 135, 123, 15, 224, 229, 243, 76, 216,
+			49, 52, 127, 72, 39, 7, 186, 109, 54, 20, 100, 24, 25, 136,
+			65, 160, 94, 43, 72, 102, 209, 249, 228, 51, 63, 250, 61, 62,
+			243, 35, 118, 66, 220, 0, 125, 156, 236, 106, 218, 119, 42, 65,
+			104, 55, 56, 68, 39, 25, 49, 238, 18, 11, 189, 60, 216, 180,
+			239, 44, 169, 226, 157, 139, 100, 110, 187, 72, 233, 238, 69, 122,
+			167, 70, 70, 54, 195, 225, 123, 129, 223, 63, 170, 147, 195, 209,
+			72, 122, 33, 236, 221, 151, 229, 153, 205, 203, 82, 216, 122, 176,
+			137, 62, 254, 126, 174, 205, 63, 215, 200, 145, 45, 32, 242, 247,
+			96, 7, 253, 141, 70, 246, 73, 130, 116, 205, 9, 66, 207, 223,
+			80, 11, 117, 144, 164, 125, 110, 55, 154, 73, 162, 34, 114, 232,
+			120, 242, 57, 100, 17, 85, 53, 233, 147, 19, 189, 137, 124, 3,
+			63, 37, 223, 68, 54, 238, 235, 77, 100, 58, 73, 250, 96, 111,
+			217, 117, 62, 98, 222, 109, 229, 50, 77, 251, 78, 177, 206, 233,
+			49, 50, 0, 117, 20, 214, 195, 158, 73, 151, 73, 211, 190, 35,
+			81, 26, 28, 1, 247, 119, 79, 253, 59, 185, 101, 22, 201, 174,
+			164, 47, 187, 242, 38, 59, 187, 117, 43, 18, 0, 87, 224, 169,
+			76, 64, 211, 242, 96, 24, 231, 6, 249, 47, 106, 100, 111, 175,
+			98, 157, 65, 247, 181, 173, 131, 238, 235, 247, 16, 116, 31, 41,
+			78, 16, 84, 170, 94, 91, 174, 91, 26, 176, 58, 8, 102, 32,
+			3, 144, 30, 44, 184, 229, 103, 83, 124, 134, 28, 241, 249, 24,
+			25, 192, 232, 95, 242, 187, 4, 62, 102, 97, 129, 201, 15, 162,
+			207, 22, 128, 96, 118, 154, 86, 200, 174, 14, 214, 131, 110, 1,
+			163, 94, 252, 201, 78, 188, 69, 100, 7, 241, 66, 109, 211, 65,
+			98, 53, 183, 239, 32, 46, 152, 79, 209, 22, 25, 86, 135, 164,
+			90, 254, 177, 222, 213, 186, 138, 169, 89, 140, 239, 176, 116, 228,
+			103, 227, 18, 42, 71, 155, 216, 247, 116, 98, 219, 121, 37, 74,
+			170, 126, 119, 70, 96, 242, 41, 250, 102, 178, 87, 13, 38, 241,
+			33, 160, 23, 182, 31, 120, 178, 172, 234, 115, 242, 94, 170, 68,
+			19, 14, 72, 46, 34, 163, 18, 28, 91, 57, 22, 117, 151, 83,
+			29, 23, 118, 90, 60, 234, 244, 173, 26, 217, 23, 125, 78, 14,
+			140, 78, 222, 165, 173, 206, 89, 136, 254, 31, 188, 167, 58, 209,
+			32, 154, 100, 168, 147, 78, 209, 115, 219, 46, 115, 68, 205, 68,
+			175, 99, 59, 43, 172, 186, 187, 47, 215, 170, 223, 178, 133, 107,
+			213, 23, 245, 87, 94, 184, 120, 229, 133, 139, 239, 238, 11, 23,
+			115, 241, 11, 23, 179, 177, 107, 213, 149, 216, 181, 234, 137, 222,
+			174, 85, 231, 132, 103, 213, 225, 84, 73, 179, 142, 117, 58, 86,
+			217, 155, 220, 170, 148, 127, 208, 225, 108, 142, 156, 86, 238, 65,
+			71, 245, 162, 101, 129, 121, 58, 154, 154, 119, 217, 185, 71, 166,
+			243, 80, 237, 104, 102, 143, 74, 233, 212, 56, 186, 119, 84, 165,
+			224, 89, 192, 7, 47, 147, 91, 202, 163, 229, 68, 119, 147, 201,
+			129, 40, 235, 238, 239, 212, 117, 185, 26, 33, 88, 202, 157, 136,
+			70, 8, 96, 63, 17, 141, 16, 108, 30, 79, 60, 120, 153, 124,
+			92, 147, 206, 48, 230, 25, 253, 236, 89, 235, 131, 90, 98, 144,
+			73, 88, 33, 50, 216, 74, 211, 214, 1, 14, 124, 230, 123, 42,
+			214, 70, 71, 145, 239, 165, 183, 83, 7, 204, 97, 243, 73, 35,
+			175, 164, 45, 23, 232, 232, 225, 249, 192, 110, 154, 13, 6, 201,
+			33, 183, 107, 17, 212, 1, 152, 103, 50, 251, 84, 74, 167, 198,
+			153, 253, 99, 42, 101, 80, 227, 236, 240, 41, 50, 46, 157, 109,
+			204, 113, 189, 96, 88, 199, 54, 131, 61, 193, 145, 71, 13, 3,
+			254, 141, 71, 94, 60, 224, 221, 55, 30, 121, 241, 128, 201, 236,
+			248, 229, 121, 242, 11, 202, 155, 198, 188, 168, 63, 52, 97, 125,
+			100, 19, 176, 146, 172, 254, 119, 27, 98, 201, 83, 102, 19, 216,
+			76, 141, 26, 23, 51, 150, 4, 27, 56, 219, 92, 60, 244, 176,
+			74, 129, 143, 210, 240, 57, 178, 161, 156, 109, 166, 244, 75, 231,
+			172, 155, 221, 115, 83, 195, 65, 21, 98, 215, 150, 96, 75, 237,
+			150, 178, 131, 86, 185, 194, 109, 45, 144, 67, 141, 10, 216, 181,
+			91, 224, 8, 91, 147, 246, 204, 16, 83, 71, 13, 18, 156, 113,
+			166, 34, 111, 4, 112, 198, 153, 138, 188, 17, 32, 190, 249, 165,
+			225, 211, 228, 77, 202, 25, 231, 73, 189, 120, 222, 106, 109, 191,
+			0, 47, 219, 72, 193, 131, 231, 201, 14, 15, 158, 39, 59, 60,
+			120, 138, 195, 99, 228, 255, 210, 164, 11, 143, 113, 77, 95, 180,
+			190, 145, 196, 21, 159, 87, 1, 39, 186, 87, 25, 210, 234, 45,
+			144, 132, 17, 156, 124, 121, 194, 174, 250, 94, 208, 97, 50, 46,
+			3, 37, 163, 240, 198, 4, 178, 69, 79, 148, 44, 225, 107, 112,
+			206, 139, 60, 192, 120, 189, 40, 80, 68, 230, 181, 157, 145, 239,
+			112, 231, 173, 181, 27, 61, 66, 40, 202, 97, 128, 0, 208, 246,
+			241, 60, 2, 79, 73, 207, 239, 8, 18, 12, 237, 111, 116, 120,
+			20, 93, 203, 236, 77, 120, 20, 93, 219, 119, 54, 233, 81, 244,
+			208, 211, 228, 97, 225, 81, 50, 159, 90, 212, 172, 179, 91, 220,
+			4, 117, 8, 5, 242, 230, 74, 121, 128, 204, 103, 15, 227, 195,
+			222, 232, 0, 178, 160, 95, 182, 46, 35, 3, 146, 52, 50, 77,
+			172, 110, 124, 105, 185, 213, 61, 175, 116, 155, 72, 83, 99, 33,
+			114, 4, 128, 126, 22, 58, 158, 158, 89, 144, 166, 215, 194, 193,
+			98, 129, 62, 214, 233, 96, 177, 176, 119, 10, 39, 166, 81, 179,
+			156, 90, 217, 118, 98, 49, 129, 75, 76, 12, 168, 117, 57, 123,
+			152, 60, 173, 12, 234, 151, 245, 203, 214, 227, 155, 38, 150, 64,
+			154, 196, 117, 236, 166, 224, 115, 137, 153, 97, 68, 88, 99, 89,
+			206, 76, 216, 221, 47, 203, 153, 9, 187, 251, 101, 57, 51, 97,
+			119, 191, 76, 31, 235, 180, 187, 95, 222, 59, 5, 145, 187, 33,
+			8, 241, 107, 82, 77, 205, 26, 219, 98, 102, 138, 211, 87, 180,
+			59, 158, 27, 80, 237, 215, 100, 143, 198, 15, 191, 63, 175, 207,
+			88, 147, 108, 161, 231, 130, 201, 8, 135, 157, 143, 27, 57, 181,
+			31, 200, 203, 249, 8, 35, 121, 245, 166, 128, 48, 146, 127, 94,
+			186, 108, 8, 35, 249, 231, 229, 67, 8, 194, 72, 254, 249, 145,
+			233, 78, 35, 249, 231, 173, 39, 133, 73, 18, 26, 201, 219, 250,
+			126, 235, 127, 19, 150, 174, 77, 251, 142, 211, 108, 55, 153, 219,
+			110, 174, 114, 63, 114, 172, 83, 91, 52, 50, 27, 85, 119, 102,
+			129, 100, 46, 192, 123, 95, 122, 44, 175, 241, 219, 202, 165, 11,
+			111, 169, 111, 217, 141, 54, 152, 188, 150, 214, 32, 134, 184, 10,
+			101, 60, 198, 236, 144, 53, 225, 118, 240, 194, 249, 243, 157, 157,
+			40, 243, 122, 209, 158, 188, 157, 142, 135, 134, 237, 193, 70, 188,
+			112, 254, 252, 249, 75, 34, 74, 57, 68, 191, 4, 163, 64, 200,
+			138, 234, 87, 61, 238, 3, 153, 13, 61, 204, 143, 96, 7, 198,
+			244, 182, 222, 167, 82, 0, 128, 172, 114, 41, 0, 166, 192, 222,
+			187, 143, 252, 43, 67, 6, 32, 52, 26, 250, 136, 245, 147, 6,
+			43, 50, 80, 124, 49, 84, 56, 129, 23, 113, 149, 163, 183, 158,
+			56, 10, 224, 105, 156, 91, 142, 215, 14, 216, 235, 186, 48, 224,
+			117, 12, 60, 22, 11, 132, 221, 16, 166, 33, 50, 162, 37, 130,
+			17, 169, 54, 174, 124, 208, 94, 13, 0, 155, 92, 160, 119, 104,
+			69, 192, 94, 13, 246, 140, 45, 187, 238, 184, 182, 224, 152, 99,
+			47, 117, 8, 151, 220, 132, 24, 254, 241, 5, 60, 236, 131, 205,
+			93, 131, 37, 3, 97, 77, 59, 138, 77, 12, 109, 160, 125, 125,
+			92, 111, 157, 39, 38, 6, 29, 207, 194, 73, 188, 176, 184, 204,
+			234, 109, 219, 183, 221, 144, 171, 133, 128, 27, 60, 108, 32, 185,
+			86, 56, 255, 234, 186, 120, 51, 0, 216, 75, 96, 121, 67, 167,
+			65, 88, 181, 237, 7, 96, 177, 119, 103, 221, 110, 7, 64, 106,
+			88, 91, 136, 11, 93, 180, 9, 226, 104, 71, 198, 234, 76, 133,
+			183, 134, 39, 45, 208, 161, 197, 15, 66, 194, 186, 102, 166, 182,
+			94, 180, 162, 122, 154, 26, 141, 104, 55, 0, 74, 55, 162, 221,
+			0, 14, 76, 141, 253, 7, 208, 217, 199, 160, 102, 43, 181, 161,
+			238, 222, 37, 235, 177, 179, 253, 11, 204, 81, 43, 123, 140, 60,
+			46, 125, 36, 12, 95, 63, 109, 93, 232, 182, 14, 151, 0, 73,
+			136, 12, 29, 28, 139, 28, 176, 161, 167, 18, 207, 10, 24, 112,
+			231, 104, 248, 3, 251, 84, 10, 158, 21, 216, 159, 87, 41, 120,
+			86, 224, 129, 83, 228, 79, 149, 91, 130, 113, 71, 63, 98, 253,
+			175, 96, 25, 34, 113, 17, 109, 131, 213, 179, 113, 1, 156, 170,
+			118, 192, 94, 23, 171, 71, 95, 183, 9, 221, 64, 127, 42, 17,
+			13, 189, 92, 34, 131, 12, 216, 85, 94, 211, 9, 49, 136, 59,
+			88, 191, 112, 118, 27, 254, 184, 94, 55, 138, 6, 93, 43, 21,
+			89, 211, 168, 38, 59, 214, 88, 134, 199, 140, 150, 121, 140, 53,
+			33, 30, 188, 130, 25, 16, 15, 112, 233, 181, 125, 6, 22, 34,
+			126, 4, 38, 216, 169, 119, 228, 186, 162, 199, 130, 113, 167, 127,
+			68, 165, 12, 106, 220, 57, 116, 88, 186, 35, 152, 223, 159, 122,
+			243, 214, 30, 75, 242, 192, 73, 240, 135, 137, 149, 5, 198, 240,
+			251, 179, 199, 201, 10, 218, 166, 167, 224, 217, 2, 106, 93, 235,
+			125, 234, 36, 152, 171, 158, 71, 79, 162, 135, 228, 201, 106, 34,
+			189, 126, 147, 156, 9, 186, 39, 24, 111, 146, 231, 143, 137, 75,
+			252, 166, 220, 110, 242, 132, 176, 57, 126, 171, 150, 122, 191, 166,
+			89, 23, 238, 114, 196, 36, 186, 82, 120, 170, 12, 132, 223, 170,
+			101, 79, 144, 39, 149, 129, 240, 219, 181, 251, 60, 105, 164, 193,
+			111, 26, 155, 80, 6, 156, 96, 244, 241, 118, 101, 155, 35, 172,
+			131, 223, 174, 108, 191, 210, 104, 251, 245, 246, 77, 214, 193, 111,
+			7, 19, 141, 111, 71, 214, 193, 239, 214, 244, 253, 214, 159, 110,
+			123, 222, 116, 48, 177, 47, 235, 161, 211, 209, 211, 203, 119, 242,
+			72, 115, 224, 52, 53, 223, 173, 194, 68, 167, 1, 161, 205, 119,
+			107, 217, 221, 42, 105, 192, 215, 189, 251, 200, 15, 234, 202, 88,
+			248, 125, 96, 6, 246, 231, 218, 61, 159, 62, 73, 228, 248, 94,
+			28, 65, 29, 253, 203, 215, 157, 238, 237, 28, 146, 246, 193, 105,
+			132, 129, 66, 62, 48, 12, 123, 95, 140, 124, 32, 224, 190, 15,
+			144, 15, 44, 113, 51, 52, 243, 19, 90, 234, 95, 68, 150, 184,
+			219, 80, 247, 45, 182, 14, 216, 14, 255, 132, 150, 61, 73, 102,
+			149, 237, 240, 7, 193, 34, 236, 225, 152, 200, 119, 32, 203, 221,
+			40, 189, 180, 22, 54, 177, 153, 164, 105, 241, 7, 149, 173, 152,
+			48, 45, 254, 160, 178, 21, 19, 166, 197, 31, 212, 38, 206, 147,
+			255, 10, 155, 37, 3, 211, 253, 25, 237, 31, 3, 185, 23, 211,
+			7, 131, 160, 159, 81, 171, 157, 193, 237, 241, 51, 90, 255, 136,
+			74, 26, 240, 85, 82, 252, 62, 154, 249, 136, 150, 250, 181, 109,
+			28, 208, 54, 233, 81, 226, 133, 6, 147, 235, 143, 128, 217, 219,
+			55, 1, 204, 104, 115, 253, 115, 154, 158, 183, 126, 47, 150, 80,
+			59, 15, 245, 132, 242, 1, 230, 26, 36, 103, 27, 140, 69, 49,
+			10, 64, 2, 5, 15, 83, 17, 177, 128, 176, 209, 91, 142, 157,
+			148, 181, 122, 196, 30, 13, 240, 173, 214, 37, 196, 160, 13, 224,
+			176, 162, 199, 98, 19, 101, 96, 157, 32, 60, 206, 45, 184, 181,
+			69, 151, 83, 140, 42, 11, 197, 61, 55, 89, 82, 190, 95, 35,
+			251, 65, 86, 43, 224, 18, 188, 125, 192, 116, 192, 67, 5, 81,
+			18, 223, 45, 24, 80, 70, 218, 41, 124, 183, 128, 30, 81, 73,
+			124, 183, 128, 29, 39, 129, 180, 1, 55, 127, 94, 211, 23, 45,
+			206, 138, 73, 208, 72, 128, 36, 182, 27, 196, 206, 14, 236, 208,
+			9, 214, 54, 228, 11, 91, 234, 210, 19, 60, 66, 17, 235, 226,
+			156, 120, 228, 76, 90, 159, 130, 30, 57, 26, 48, 88, 205, 254,
+			188, 166, 239, 83, 73, 13, 146, 251, 143, 169, 164, 1, 201, 252,
+			9, 149, 204, 66, 242, 228, 66, 167, 25, 249, 207, 107, 167, 230,
+			201, 79, 235, 202, 140, 252, 151, 52, 125, 220, 250, 17, 241, 100,
+			204, 45, 169, 121, 151, 103, 162, 116, 199, 133, 77, 45, 182, 88,
+			199, 188, 106, 190, 125, 59, 128, 65, 218, 240, 20, 40, 16, 44,
+			240, 177, 38, 224, 166, 219, 0, 87, 235, 40, 88, 125, 100, 105,
+			213, 229, 254, 137, 58, 141, 166, 125, 135, 69, 87, 245, 112, 216,
+			61, 212, 28, 235, 130, 95, 244, 116, 143, 84, 132, 145, 8, 9,
+			219, 45, 120, 74, 131, 215, 216, 197, 38, 179, 235, 94, 129, 176,
+			153, 117, 207, 131, 24, 32, 204, 22, 111, 51, 248, 81, 239, 242,
+			104, 172, 181, 171, 145, 251, 21, 178, 86, 110, 117, 163, 64, 216,
+			117, 25, 224, 252, 177, 203, 15, 158, 111, 70, 208, 6, 149, 210,
+			47, 105, 186, 165, 146, 26, 36, 15, 141, 170, 164, 1, 201, 115,
+			99, 228, 207, 196, 158, 49, 168, 249, 171, 218, 63, 22, 185, 81,
+			128, 0, 30, 250, 248, 85, 117, 122, 247, 65, 36, 52, 243, 87,
+			213, 233, 221, 7, 122, 122, 243, 87, 225, 189, 146, 191, 21, 0,
+			50, 33, 138, 62, 56, 57, 238, 252, 244, 238, 38, 89, 223, 205,
+			147, 123, 115, 223, 247, 35, 61, 10, 80, 64, 156, 245, 207, 41,
+			58, 222, 167, 131, 191, 239, 231, 212, 169, 221, 7, 202, 86, 243,
+			115, 112, 106, 67, 176, 129, 44, 205, 252, 166, 150, 250, 109, 77,
+			179, 10, 91, 157, 218, 221, 35, 75, 156, 216, 224, 235, 240, 155,
+			90, 150, 161, 183, 15, 250, 58, 124, 65, 120, 251, 92, 7, 94,
+			131, 215, 58, 240, 67, 14, 47, 139, 116, 240, 11, 138, 14, 102,
+			65, 250, 50, 191, 160, 188, 125, 178, 120, 36, 127, 65, 121, 251,
+			8, 231, 134, 47, 104, 15, 156, 34, 255, 58, 242, 93, 248, 18,
+			28, 201, 31, 250, 123, 124, 36, 203, 161, 195, 113, 250, 37, 181,
+			12, 89, 60, 78, 191, 164, 142, 211, 44, 146, 207, 47, 105, 135,
+			14, 35, 243, 212, 79, 51, 191, 163, 165, 254, 98, 27, 55, 166,
+			104, 21, 182, 96, 158, 192, 156, 254, 119, 128, 121, 194, 184, 52,
+			104, 79, 255, 85, 77, 207, 91, 95, 239, 62, 83, 59, 120, 168,
+			157, 31, 172, 36, 113, 178, 254, 253, 58, 88, 133, 63, 192, 87,
+			21, 66, 245, 131, 172, 103, 126, 85, 29, 172, 253, 136, 80, 95,
+			85, 7, 107, 63, 34, 20, 62, 51, 240, 34, 194, 73, 163, 153,
+			175, 107, 250, 239, 107, 23, 172, 215, 179, 226, 38, 8, 189, 156,
+			231, 107, 63, 122, 165, 124, 93, 81, 252, 126, 68, 144, 175, 107,
+			135, 78, 170, 164, 65, 205, 175, 107, 167, 71, 85, 50, 75, 205,
+			223, 87, 110, 90, 253, 202, 77, 235, 247, 193, 77, 11, 207, 215,
+			126, 144, 87, 254, 232, 149, 243, 117, 139, 243, 181, 31, 207, 215,
+			63, 138, 161, 13, 44, 213, 31, 169, 243, 181, 31, 101, 153, 63,
+			210, 206, 141, 9, 57, 185, 31, 146, 223, 250, 71, 42, 39, 247,
+			227, 227, 35, 223, 82, 39, 109, 63, 158, 180, 223, 82, 39, 109,
+			63, 158, 180, 223, 130, 147, 246, 109, 2, 239, 76, 106, 254, 57,
+			200, 201, 127, 121, 31, 39, 237, 247, 74, 80, 238, 61, 128, 251,
+			150, 148, 251, 65, 115, 4, 80, 200, 170, 164, 6, 64, 145, 103,
+			110, 63, 158, 185, 127, 14, 103, 110, 81, 248, 32, 253, 181, 150,
+			250, 65, 93, 249, 181, 111, 119, 230, 110, 65, 237, 193, 113, 232,
+			175, 193, 13, 253, 67, 154, 114, 186, 248, 54, 200, 202, 239, 78,
+			196, 203, 232, 192, 24, 100, 35, 212, 195, 228, 9, 146, 37, 159,
+			185, 132, 0, 231, 74, 108, 196, 247, 118, 212, 43, 146, 219, 137,
+			221, 118, 179, 147, 68, 219, 62, 104, 94, 220, 208, 169, 183, 189,
+			118, 32, 33, 67, 144, 58, 127, 91, 81, 103, 225, 224, 241, 109,
+			37, 129, 19, 164, 206, 223, 86, 18, 184, 112, 240, 248, 54, 72,
+			224, 120, 220, 19, 216, 164, 111, 209, 255, 33, 28, 247, 4, 143,
+			251, 183, 232, 18, 3, 8, 82, 243, 183, 232, 242, 184, 39, 120,
+			220, 191, 69, 63, 116, 24, 245, 224, 3, 52, 243, 118, 61, 245,
+			179, 186, 102, 157, 219, 94, 99, 42, 109, 168, 18, 43, 63, 0,
+			250, 63, 61, 123, 132, 252, 14, 0, 104, 0, 206, 249, 119, 233,
+			250, 227, 214, 231, 224, 156, 199, 91, 216, 110, 117, 0, 110, 161,
+			128, 219, 126, 117, 93, 105, 27, 149, 9, 214, 68, 171, 189, 218,
+			112, 170, 24, 153, 23, 223, 118, 219, 100, 6, 144, 159, 200, 203,
+			144, 38, 234, 237, 85, 180, 177, 146, 183, 188, 221, 237, 229, 199,
+			136, 244, 235, 138, 59, 135, 11, 226, 70, 67, 92, 17, 71, 79,
+			202, 201, 250, 24, 168, 69, 12, 45, 58, 22, 7, 240, 252, 126,
+			151, 2, 228, 0, 98, 200, 187, 244, 254, 33, 149, 132, 247, 92,
+			245, 221, 84, 37, 179, 144, 220, 35, 94, 143, 28, 80, 26, 207,
+			119, 233, 251, 46, 145, 63, 0, 242, 52, 0, 40, 244, 94, 93,
+			47, 89, 191, 173, 239, 68, 118, 70, 199, 179, 228, 221, 55, 10,
+			142, 234, 164, 95, 115, 238, 160, 235, 242, 90, 91, 93, 196, 224,
+			253, 120, 192, 225, 249, 210, 32, 58, 202, 188, 53, 228, 95, 160,
+			64, 242, 44, 224, 190, 188, 162, 73, 80, 247, 243, 231, 163, 90,
+			177, 173, 146, 184, 111, 135, 128, 34, 113, 39, 132, 225, 227, 69,
+			99, 241, 99, 174, 210, 120, 1, 0, 218, 4, 219, 47, 4, 49,
+			194, 95, 93, 172, 179, 34, 91, 245, 225, 253, 71, 121, 129, 223,
+			180, 55, 128, 147, 226, 190, 104, 2, 204, 166, 228, 149, 63, 210,
+			184, 0, 194, 190, 53, 236, 32, 188, 132, 198, 110, 54, 107, 120,
+			110, 157, 251, 170, 54, 58, 218, 221, 18, 67, 84, 199, 234, 0,
+			162, 253, 123, 227, 213, 2, 180, 127, 175, 222, 127, 80, 37, 13,
+			72, 30, 62, 162, 146, 89, 72, 30, 189, 38, 87, 75, 50, 49,
+			239, 213, 217, 21, 242, 2, 46, 150, 78, 205, 15, 232, 250, 49,
+			107, 241, 30, 214, 74, 206, 86, 177, 101, 2, 36, 224, 10, 155,
+			164, 115, 162, 127, 224, 1, 62, 160, 235, 10, 121, 0, 55, 62,
+			160, 239, 177, 84, 210, 128, 228, 145, 163, 106, 111, 65, 92, 29,
+			93, 63, 13, 123, 107, 7, 163, 89, 229, 49, 11, 3, 72, 227,
+			184, 157, 28, 19, 81, 43, 151, 136, 201, 210, 227, 228, 151, 175,
+			63, 227, 174, 125, 21, 171, 217, 27, 65, 7, 71, 243, 240, 121,
+			204, 83, 108, 21, 248, 6, 3, 63, 212, 226, 190, 227, 213, 96,
+			123, 69, 15, 13, 38, 6, 172, 136, 241, 128, 110, 64, 36, 33,
+			93, 50, 65, 3, 120, 178, 127, 72, 63, 148, 87, 73, 156, 241,
+			3, 167, 200, 255, 44, 0, 96, 82, 243, 195, 186, 62, 98, 253,
+			230, 189, 41, 25, 64, 223, 136, 249, 104, 99, 130, 119, 136, 5,
+			178, 121, 166, 155, 120, 28, 213, 208, 203, 203, 222, 12, 224, 89,
+			253, 97, 93, 178, 55, 3, 40, 31, 127, 88, 207, 238, 81, 73,
+			120, 204, 85, 223, 127, 128, 76, 17, 221, 28, 164, 153, 143, 234,
+			169, 127, 167, 43, 147, 131, 30, 103, 245, 150, 164, 122, 16, 30,
+			84, 213, 179, 71, 201, 35, 196, 52, 7, 129, 82, 127, 12, 176,
+			233, 108, 79, 233, 184, 55, 204, 96, 68, 131, 120, 130, 126, 76,
+			151, 39, 232, 32, 158, 160, 31, 211, 165, 192, 60, 136, 244, 241,
+			99, 186, 20, 152, 7, 241, 4, 253, 152, 254, 192, 41, 242, 78,
+			8, 246, 52, 8, 40, 254, 11, 186, 62, 105, 253, 141, 193, 34,
+			187, 254, 104, 233, 228, 214, 137, 108, 127, 148, 95, 194, 152, 10,
+			139, 36, 121, 183, 228, 55, 48, 237, 145, 207, 74, 111, 196, 228,
+			73, 158, 18, 171, 27, 73, 171, 158, 37, 7, 172, 98, 85, 84,
+			32, 217, 32, 70, 114, 136, 184, 136, 132, 39, 69, 7, 4, 58,
+			96, 67, 100, 27, 171, 142, 13, 238, 173, 34, 120, 160, 15, 79,
+			218, 114, 113, 147, 25, 179, 55, 137, 106, 83, 108, 13, 183, 153,
+			11, 18, 172, 223, 118, 137, 26, 26, 4, 32, 241, 110, 215, 224,
+			173, 121, 230, 219, 62, 68, 83, 245, 219, 46, 144, 236, 64, 113,
+			23, 192, 178, 175, 10, 10, 41, 110, 255, 229, 171, 120, 94, 163,
+			134, 161, 148, 226, 19, 14, 15, 56, 213, 178, 170, 135, 206, 9,
+			10, 120, 248, 0, 53, 24, 29, 38, 79, 8, 183, 177, 49, 70,
+			196, 241, 41, 223, 51, 239, 174, 139, 33, 128, 193, 47, 2, 212,
+			218, 54, 62, 255, 41, 13, 238, 18, 79, 32, 198, 52, 14, 182,
+			223, 154, 87, 133, 183, 235, 113, 42, 170, 68, 132, 69, 16, 18,
+			225, 23, 98, 44, 2, 225, 243, 23, 244, 129, 99, 42, 137, 120,
+			194, 198, 85, 210, 128, 228, 249, 11, 228, 195, 224, 53, 191, 139,
+			102, 126, 89, 79, 125, 81, 215, 172, 31, 211, 58, 177, 72, 158,
+			148, 113, 95, 157, 38, 164, 242, 184, 151, 159, 113, 189, 234, 190,
+			215, 110, 9, 99, 229, 24, 136, 5, 54, 7, 65, 23, 19, 80,
+			5, 250, 189, 9, 18, 99, 18, 14, 18, 34, 242, 21, 120, 184,
+			82, 216, 165, 81, 243, 151, 245, 236, 97, 114, 145, 152, 230, 46,
+			216, 107, 159, 210, 245, 3, 214, 169, 56, 68, 220, 38, 251, 164,
+			200, 177, 69, 66, 104, 23, 50, 30, 159, 82, 71, 217, 46, 220,
+			88, 159, 210, 229, 227, 152, 187, 112, 99, 125, 74, 223, 183, 159,
+			60, 132, 93, 64, 32, 29, 93, 63, 99, 157, 102, 203, 9, 8,
+			108, 234, 165, 115, 21, 118, 161, 204, 255, 105, 93, 63, 172, 146,
+			216, 140, 124, 111, 114, 23, 130, 253, 211, 250, 233, 81, 242, 60,
+			246, 161, 83, 243, 51, 48, 141, 121, 182, 16, 145, 220, 24, 70,
+			129, 124, 247, 25, 100, 148, 46, 128, 39, 23, 69, 112, 180, 234,
+			88, 136, 6, 2, 102, 150, 159, 81, 68, 112, 23, 216, 33, 153,
+			159, 209, 179, 106, 178, 32, 255, 126, 6, 38, 251, 2, 78, 214,
+			160, 230, 175, 195, 64, 22, 217, 66, 187, 185, 202, 253, 251, 31,
+			8, 73, 188, 133, 27, 141, 5, 228, 205, 95, 143, 199, 2, 167,
+			210, 175, 199, 99, 1, 121, 243, 215, 97, 44, 239, 135, 83, 105,
+			23, 60, 129, 255, 5, 56, 149, 222, 174, 109, 11, 150, 77, 221,
+			175, 219, 53, 241, 124, 174, 234, 31, 118, 117, 114, 60, 106, 240,
+			146, 173, 141, 26, 128, 173, 167, 4, 34, 249, 108, 133, 120, 32,
+			194, 223, 136, 166, 0, 103, 202, 23, 226, 41, 192, 153, 242, 5,
+			117, 166, 236, 66, 249, 239, 11, 250, 254, 3, 171, 153, 150, 239,
+			133, 222, 131, 255, 223, 0, 98, 185, 166, 208, 63, 1, 1, 0,
+		},
 	)
 }
 
@@ -174,6 +174,26 @@ func (mr *MockResultDBClientMockRecorder) QueryTestExonerations(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestExonerations", reflect.TypeOf((*MockResultDBClient)(nil).QueryTestExonerations), varargs...)
 }
 
+// GetTestHistory mocks base method
+func (m *MockResultDBClient) GetTestHistory(ctx context.Context, in *GetTestHistoryRequest, opts ...grpc.CallOption) (*GetTestHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTestHistory", varargs...)
+	ret0, _ := ret[0].(*GetTestHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestHistory indicates an expected call of GetTestHistory
+func (mr *MockResultDBClientMockRecorder) GetTestHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestHistory", reflect.TypeOf((*MockResultDBClient)(nil).GetTestHistory), varargs...)
+}
+
 // MockResultDBServer is a mock of ResultDBServer interface
 type MockResultDBServer struct {
 	ctrl     *gomock.Controller
@@ -301,3 +321,18 @@ func (mr *MockResultDBServerMockRecorder) QueryTestExonerations(arg0, arg1 inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTestExonerations", reflect.TypeOf((*MockResultDBServer)(nil).QueryTestExonerations), arg0, arg1)
 }
+
+// GetTestHistory mocks base method
+func (m *MockResultDBServer) GetTestHistory(arg0 context.Context, arg1 *GetTestHistoryRequest) (*GetTestHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestHistory", arg0, arg1)
+	ret0, _ := ret[0].(*GetTestHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTestHistory indicates an expected call of GetTestHistory
+func (mr *MockResultDBServerMockRecorder) GetTestHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestHistory", reflect.TypeOf((*MockResultDBServer)(nil).GetTestHistory), arg0, arg1)
+}
@@ -10,6 +10,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	duration "github.com/golang/protobuf/ptypes/duration"
+	_type "go.chromium.org/luci/resultdb/proto/type"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -692,6 +693,237 @@ func (m *QueryTestExonerationsResponse) GetNextPageToken() string {
 	return ""
 }
 
+// A request message for GetTestHistory RPC.
+type GetTestHistoryRequest struct {
+	// Realm of the invocations to search, e.g. "chromium/public".
+	// If it does not contain "/", then it is a LUCI project, e.g. "chromium",
+	// and invocations of all realms of the project are searched.
+	Realm string `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// A test result in the response must have a test path with this prefix.
+	// A full test path selects results of one test.
+	//
+	// The server reads at most 10000 results under the prefix, in test path
+	// order, before filtering them by realm and variant. A broad prefix may
+	// therefore miss tests that sort last; use a longer prefix to cover them.
+	TestPathPrefix string `protobuf:"bytes,2,opt,name=test_path_prefix,json=testPathPrefix,proto3" json:"test_path_prefix,omitempty"`
+	// A test result in the response must have a variant satisfying this
+	// predicate.
+	Variant *VariantPredicate `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	// A test result in the response must be uploaded within this duration
+	// before the request.
+	// If unspecified, defaults to 7 days.
+	// Must be <=60 days, the retention period of expected test results.
+	MaxAge *duration.Duration `protobuf:"bytes,4,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// The maximum number of test results to return, most recent first.
+	//
+	// If unspecified, at most 100 test results will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	MaxResults           int32    `protobuf:"varint,5,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTestHistoryRequest) Reset()         { *m = GetTestHistoryRequest{} }
+func (m *GetTestHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTestHistoryRequest) ProtoMessage()    {}
+func (*GetTestHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48732830a4fcfbd, []int{11}
+}
+
+func (m *GetTestHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTestHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetTestHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTestHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTestHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTestHistoryRequest.Merge(m, src)
+}
+func (m *GetTestHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTestHistoryRequest.Size(m)
+}
+func (m *GetTestHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTestHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTestHistoryRequest proto.InternalMessageInfo
+
+func (m *GetTestHistoryRequest) GetRealm() string {
+	if m != nil {
+		return m.Realm
+	}
+	return ""
+}
+
+func (m *GetTestHistoryRequest) GetTestPathPrefix() string {
+	if m != nil {
+		return m.TestPathPrefix
+	}
+	return ""
+}
+
+func (m *GetTestHistoryRequest) GetVariant() *VariantPredicate {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (m *GetTestHistoryRequest) GetMaxAge() *duration.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+func (m *GetTestHistoryRequest) GetMaxResults() int32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+// A response message for GetTestHistory RPC.
+type GetTestHistoryResponse struct {
+	// Matched test results, most recent first.
+	TestResults []*TestResult `protobuf:"bytes,1,rep,name=test_results,json=testResults,proto3" json:"test_results,omitempty"`
+	// Flakiness of test variants of test_results, computed from test_results.
+	// Sorted by test path, then by variant.
+	//
+	// Since it is computed only from the max_results most recent test results,
+	// it is biased if there are more matching test results: frequently run
+	// variants crowd out rarely run ones, which may be missing, and the oldest
+	// invocation of a variant may be counted from some of its results only,
+	// e.g. a flake may be counted as a pass or a fail.
+	// Use the variant predicate to focus on one variant.
+	TestVariants         []*TestVariantFlakiness `protobuf:"bytes,2,rep,name=test_variants,json=testVariants,proto3" json:"test_variants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetTestHistoryResponse) Reset()         { *m = GetTestHistoryResponse{} }
+func (m *GetTestHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTestHistoryResponse) ProtoMessage()    {}
+func (*GetTestHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48732830a4fcfbd, []int{12}
+}
+
+func (m *GetTestHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTestHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetTestHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTestHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTestHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTestHistoryResponse.Merge(m, src)
+}
+func (m *GetTestHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTestHistoryResponse.Size(m)
+}
+func (m *GetTestHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTestHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTestHistoryResponse proto.InternalMessageInfo
+
+func (m *GetTestHistoryResponse) GetTestResults() []*TestResult {
+	if m != nil {
+		return m.TestResults
+	}
+	return nil
+}
+
+func (m *GetTestHistoryResponse) GetTestVariants() []*TestVariantFlakiness {
+	if m != nil {
+		return m.TestVariants
+	}
+	return nil
+}
+
+// Flakiness of a test variant.
+//
+// Test results of the variant are grouped by invocation. Each invocation is
+// counted as a pass, a fail or a flake.
+type TestVariantFlakiness struct {
+	// Test path, see TestResult.test_path.
+	TestPath string `protobuf:"bytes,1,opt,name=test_path,json=testPath,proto3" json:"test_path,omitempty"`
+	// Test variant, see TestResult.variant.
+	Variant *_type.Variant `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	// Number of invocations where all results of the test variant were expected.
+	PassCount int32 `protobuf:"varint,3,opt,name=pass_count,json=passCount,proto3" json:"pass_count,omitempty"`
+	// Number of invocations where all results of the test variant were
+	// unexpected.
+	FailCount int32 `protobuf:"varint,4,opt,name=fail_count,json=failCount,proto3" json:"fail_count,omitempty"`
+	// Number of invocations where the test variant had both expected and
+	// unexpected results, e.g. the test failed, but passed on retry.
+	FlakeCount           int32    `protobuf:"varint,5,opt,name=flake_count,json=flakeCount,proto3" json:"flake_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestVariantFlakiness) Reset()         { *m = TestVariantFlakiness{} }
+func (m *TestVariantFlakiness) String() string { return proto.CompactTextString(m) }
+func (*TestVariantFlakiness) ProtoMessage()    {}
+func (*TestVariantFlakiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48732830a4fcfbd, []int{13}
+}
+
+func (m *TestVariantFlakiness) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestVariantFlakiness.Unmarshal(m, b)
+}
+func (m *TestVariantFlakiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestVariantFlakiness.Marshal(b, m, deterministic)
+}
+func (m *TestVariantFlakiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestVariantFlakiness.Merge(m, src)
+}
+func (m *TestVariantFlakiness) XXX_Size() int {
+	return xxx_messageInfo_TestVariantFlakiness.Size(m)
+}
+func (m *TestVariantFlakiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestVariantFlakiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestVariantFlakiness proto.InternalMessageInfo
+
+func (m *TestVariantFlakiness) GetTestPath() string {
+	if m != nil {
+		return m.TestPath
+	}
+	return ""
+}
+
+func (m *TestVariantFlakiness) GetVariant() *_type.Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (m *TestVariantFlakiness) GetPassCount() int32 {
+	if m != nil {
+		return m.PassCount
+	}
+	return 0
+}
+
+func (m *TestVariantFlakiness) GetFailCount() int32 {
+	if m != nil {
+		return m.FailCount
+	}
+	return 0
+}
+
+func (m *TestVariantFlakiness) GetFlakeCount() int32 {
+	if m != nil {
+		return m.FlakeCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GetInvocationRequest)(nil), "luci.resultdb.rpc.v1.GetInvocationRequest")
 	proto.RegisterType((*GetTestResultRequest)(nil), "luci.resultdb.rpc.v1.GetTestResultRequest")
@@ -704,6 +936,9 @@ func init() {
 	proto.RegisterType((*QueryTestResultsResponse)(nil), "luci.resultdb.rpc.v1.QueryTestResultsResponse")
 	proto.RegisterType((*QueryTestExonerationsRequest)(nil), "luci.resultdb.rpc.v1.QueryTestExonerationsRequest")
 	proto.RegisterType((*QueryTestExonerationsResponse)(nil), "luci.resultdb.rpc.v1.QueryTestExonerationsResponse")
+	proto.RegisterType((*GetTestHistoryRequest)(nil), "luci.resultdb.rpc.v1.GetTestHistoryRequest")
+	proto.RegisterType((*GetTestHistoryResponse)(nil), "luci.resultdb.rpc.v1.GetTestHistoryResponse")
+	proto.RegisterType((*TestVariantFlakiness)(nil), "luci.resultdb.rpc.v1.TestVariantFlakiness")
 }
 
 func init() {
@@ -711,50 +946,66 @@ func init() {
 }
 
 var fileDescriptor_b48732830a4fcfbd = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x8d, 0xd3, 0x3f, 0x6a, 0x26, 0xad, 0xda, 0xdf, 0xaa, 0x3f, 0x9a, 0xba, 0x2d, 0x44, 0x41,
-	0xa0, 0x82, 0x60, 0x4d, 0xdd, 0x13, 0x14, 0x21, 0x51, 0x8a, 0x10, 0x52, 0x0f, 0xad, 0xdb, 0x13,
-	0x97, 0xc8, 0x71, 0xb6, 0xa9, 0x45, 0xe2, 0x35, 0xbb, 0xeb, 0x28, 0xed, 0x01, 0x24, 0x24, 0xe0,
-	0xc8, 0x1d, 0x3e, 0x01, 0xdf, 0x8a, 0x8f, 0x82, 0x62, 0x3b, 0xf6, 0x26, 0xde, 0x24, 0xce, 0x85,
-	0x72, 0xf4, 0xec, 0xbc, 0xd9, 0xb7, 0xef, 0xcd, 0xce, 0x1a, 0x9e, 0xb6, 0x28, 0x76, 0x2e, 0x19,
-	0xed, 0xb8, 0x41, 0x07, 0x53, 0xd6, 0x32, 0xda, 0x81, 0xe3, 0x1a, 0x8c, 0xf0, 0xa0, 0x2d, 0x9a,
-	0x0d, 0xc3, 0x67, 0x54, 0x50, 0x83, 0xf9, 0x8e, 0xd1, 0xdd, 0x4b, 0xa2, 0x38, 0x8c, 0xa2, 0xf5,
-	0x7e, 0x2a, 0x4e, 0x82, 0xcc, 0x77, 0x70, 0x77, 0x4f, 0xbf, 0xd3, 0xa2, 0xb4, 0xd5, 0x26, 0x86,
-	0xed, 0xbb, 0xc6, 0x85, 0x4b, 0xda, 0xcd, 0x7a, 0x83, 0x5c, 0xda, 0x5d, 0x97, 0xb2, 0x08, 0xa6,
-	0xdf, 0x8e, 0x13, 0xc2, 0xaf, 0x46, 0x70, 0x61, 0x34, 0x03, 0x66, 0x0b, 0x97, 0x7a, 0xf1, 0xfa,
-	0xc1, 0x0c, 0x8c, 0x5c, 0xaf, 0x4b, 0x1d, 0x19, 0xfc, 0x6c, 0x06, 0xb0, 0xcf, 0x48, 0xd3, 0x75,
-	0x6c, 0x41, 0x62, 0xec, 0xf3, 0x19, 0xb0, 0x82, 0x70, 0x51, 0x8f, 0x96, 0x22, 0x74, 0xcd, 0x80,
-	0xf5, 0x37, 0x44, 0xbc, 0x4d, 0x08, 0x59, 0xe4, 0x43, 0x40, 0xb8, 0x40, 0x1b, 0x30, 0xef, 0xd9,
-	0x1d, 0x52, 0xd1, 0xaa, 0xda, 0x6e, 0xe9, 0x70, 0xee, 0xf7, 0xcb, 0xa2, 0x15, 0x06, 0x62, 0xc0,
-	0x39, 0xe1, 0xc2, 0x0a, 0xeb, 0x4c, 0x05, 0x5c, 0xc1, 0xad, 0x63, 0x97, 0x4b, 0x08, 0x3e, 0x80,
-	0xdc, 0x05, 0x48, 0x95, 0x90, 0x81, 0x52, 0x18, 0x6d, 0x41, 0xc9, 0xb7, 0x5b, 0xa4, 0xce, 0xdd,
-	0x6b, 0x52, 0x29, 0x56, 0xb5, 0xdd, 0x05, 0x6b, 0xa9, 0x1f, 0x38, 0x73, 0xaf, 0x09, 0xda, 0x01,
-	0x08, 0x17, 0x05, 0x7d, 0x4f, 0xbc, 0xca, 0x5c, 0xbf, 0x82, 0x15, 0xa6, 0x9f, 0xf7, 0x03, 0xb5,
-	0xaf, 0x1a, 0x6c, 0x64, 0xf6, 0xe6, 0x3e, 0xf5, 0x38, 0x41, 0xaf, 0x60, 0x59, 0x52, 0x83, 0x57,
-	0xb4, 0xea, 0xdc, 0x6e, 0xd9, 0xac, 0x62, 0x55, 0x77, 0x60, 0xe9, 0xb8, 0x65, 0x91, 0x16, 0x43,
-	0xf7, 0x61, 0xd5, 0x23, 0x3d, 0x51, 0x97, 0x48, 0x14, 0x43, 0x12, 0x2b, 0xfd, 0xf0, 0x49, 0x42,
-	0xc4, 0x80, 0xcd, 0x58, 0xb4, 0xd7, 0x3d, 0xea, 0x11, 0x36, 0x24, 0x35, 0x92, 0x95, 0x8b, 0x45,
-	0xfb, 0x08, 0x5b, 0x03, 0xe2, 0x12, 0xe2, 0xef, 0x29, 0xf7, 0x43, 0x83, 0x6d, 0x35, 0x81, 0x58,
-	0x3e, 0x0b, 0xfe, 0x0b, 0xe5, 0x23, 0xd2, 0x62, 0xac, 0xe1, 0xbd, 0xf1, 0x1a, 0xca, 0xa7, 0x5f,
-	0x13, 0x23, 0xb5, 0x73, 0xab, 0xf9, 0xa5, 0x08, 0x1b, 0xa7, 0x01, 0x61, 0x57, 0x8a, 0x9e, 0xaa,
-	0x42, 0x39, 0x95, 0x20, 0x62, 0x54, 0xb2, 0xe4, 0x10, 0x3a, 0x86, 0x52, 0x72, 0x85, 0xc2, 0xfa,
-	0x65, 0xf3, 0xc1, 0x34, 0xd7, 0x4f, 0x06, 0x80, 0x48, 0xe5, 0xb4, 0x00, 0x7a, 0x01, 0x2b, 0x1d,
-	0xbb, 0x57, 0xe7, 0xc2, 0x6e, 0x13, 0x8f, 0x70, 0x1e, 0x4a, 0x59, 0x36, 0x37, 0x71, 0x34, 0x2e,
-	0xf0, 0x60, 0x5c, 0xe0, 0xa3, 0x78, 0x5c, 0x58, 0xcb, 0x1d, 0xbb, 0x77, 0x36, 0x48, 0x1f, 0x36,
-	0x69, 0x7e, 0xa2, 0x49, 0x0b, 0xa3, 0x26, 0x7d, 0xd3, 0xa0, 0x92, 0xd5, 0xe1, 0x26, 0xfa, 0xfb,
-	0x7b, 0x11, 0xb6, 0x13, 0x26, 0xaa, 0x86, 0x9d, 0x6e, 0xcb, 0x69, 0xd6, 0x16, 0x9c, 0xab, 0x91,
-	0xfe, 0x4d, 0x6f, 0x7e, 0x6a, 0xb0, 0x33, 0x46, 0x91, 0x9b, 0xbf, 0x41, 0xe6, 0xaf, 0x45, 0x58,
-	0x8a, 0x3c, 0x3e, 0x3a, 0x44, 0x75, 0x58, 0x19, 0x7a, 0x02, 0xd0, 0x43, 0xf5, 0xf6, 0xaa, 0x77,
-	0x42, 0x1f, 0xd3, 0x50, 0x69, 0x62, 0xad, 0x10, 0x6f, 0x90, 0xf6, 0xd8, 0x84, 0x0d, 0x32, 0xef,
-	0x8a, 0x3e, 0xb5, 0x63, 0x6b, 0x05, 0xe4, 0xc3, 0xea, 0xc8, 0x98, 0x47, 0x8f, 0xd4, 0x30, 0xf5,
-	0x4b, 0xa4, 0x3f, 0xce, 0x99, 0x1d, 0x59, 0x57, 0x2b, 0x20, 0x0f, 0x50, 0x76, 0xa0, 0x23, 0x63,
-	0xe2, 0xb9, 0xb2, 0xa3, 0x5f, 0xcf, 0x67, 0x74, 0xad, 0x80, 0x3e, 0xc1, 0xba, 0x6a, 0x1c, 0xa3,
-	0xbd, 0xc9, 0xc4, 0x15, 0x57, 0x51, 0x37, 0x67, 0x81, 0x24, 0x07, 0xe6, 0xb0, 0x36, 0x3a, 0x6a,
-	0xd0, 0x18, 0xd5, 0xc6, 0x8c, 0x66, 0x1d, 0xe7, 0x4d, 0x4f, 0x36, 0xfd, 0xac, 0xc1, 0xff, 0xca,
-	0x4b, 0x84, 0xcc, 0x29, 0xb5, 0x54, 0x07, 0xdf, 0x9f, 0x09, 0x33, 0x20, 0x71, 0x68, 0xbe, 0x7b,
-	0x92, 0xff, 0x0f, 0xeb, 0x80, 0xf9, 0x8e, 0xdf, 0x68, 0x2c, 0x86, 0xb1, 0xfd, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x2a, 0xe2, 0xb2, 0xf7, 0xa7, 0x0a, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x75, 0x13, 0x1f, 0x27, 0x6d, 0x19, 0xa5, 0x8d, 0xb3, 0x69, 0x89, 0x65, 0x44,
+	0x15, 0xa0, 0xdd, 0x25, 0xae, 0x7a, 0x01, 0x45, 0x88, 0xa6, 0xe5, 0x4f, 0xaa, 0x44, 0xba, 0xad,
+	0xb8, 0xe0, 0x66, 0x35, 0xde, 0x8c, 0x9d, 0x51, 0x76, 0x77, 0x96, 0x99, 0x59, 0xcb, 0xee, 0x05,
+	0x48, 0x48, 0xc0, 0x25, 0xb7, 0x08, 0xde, 0x81, 0xf7, 0xe0, 0x2d, 0x78, 0x0c, 0x2e, 0xd1, 0xce,
+	0xce, 0xfe, 0xd8, 0x5e, 0xff, 0x49, 0x08, 0x7a, 0xe9, 0x73, 0xce, 0x77, 0xe6, 0xcc, 0xf7, 0x9d,
+	0x39, 0x7b, 0x0c, 0x1f, 0x0c, 0x98, 0xe5, 0x5d, 0x70, 0x16, 0xd0, 0x38, 0xb0, 0x18, 0x1f, 0xd8,
+	0x7e, 0xec, 0x51, 0x9b, 0x13, 0x11, 0xfb, 0xf2, 0xbc, 0x67, 0x47, 0x9c, 0x49, 0x66, 0xf3, 0xc8,
+	0xb3, 0x87, 0x27, 0xb9, 0xd5, 0x52, 0x56, 0xb4, 0x97, 0x84, 0x5a, 0xb9, 0x91, 0x47, 0x9e, 0x35,
+	0x3c, 0x31, 0x8f, 0x06, 0x8c, 0x0d, 0x7c, 0x62, 0xe3, 0x88, 0xda, 0x7d, 0x4a, 0xfc, 0x73, 0xb7,
+	0x47, 0x2e, 0xf0, 0x90, 0x32, 0x9e, 0xc2, 0xcc, 0x37, 0x75, 0x80, 0xfa, 0xd5, 0x8b, 0xfb, 0xf6,
+	0x79, 0xcc, 0xb1, 0xa4, 0x2c, 0xd4, 0xfe, 0x47, 0x6b, 0x54, 0x44, 0xc3, 0x21, 0xf3, 0xca, 0xe0,
+	0x0f, 0xd7, 0x00, 0x47, 0x9c, 0x9c, 0x53, 0x0f, 0x4b, 0xa2, 0xb1, 0x1f, 0xad, 0x81, 0x95, 0x44,
+	0x48, 0x37, 0x75, 0x69, 0xf4, 0xc3, 0x55, 0xd0, 0x72, 0x1c, 0x11, 0xdb, 0x63, 0x41, 0x90, 0x15,
+	0xdc, 0xb1, 0x61, 0xef, 0x73, 0x22, 0xbf, 0xcc, 0xef, 0xe1, 0x90, 0x6f, 0x63, 0x22, 0x24, 0xda,
+	0x87, 0x2b, 0x21, 0x0e, 0x48, 0xcb, 0x68, 0x1b, 0xc7, 0x8d, 0xd3, 0xcd, 0xbf, 0x1e, 0xd7, 0x1c,
+	0x65, 0xd0, 0x80, 0x97, 0x44, 0x48, 0x47, 0xe5, 0x5e, 0x0a, 0x18, 0xc3, 0xad, 0x67, 0x54, 0x94,
+	0x10, 0x22, 0x83, 0xbc, 0x05, 0x50, 0x10, 0x58, 0x06, 0x96, 0xcc, 0xe8, 0x10, 0x1a, 0x11, 0x1e,
+	0x10, 0x57, 0xd0, 0x57, 0xa4, 0x55, 0x6b, 0x1b, 0xc7, 0x75, 0x67, 0x3b, 0x31, 0xbc, 0xa0, 0xaf,
+	0x08, 0xba, 0x03, 0xa0, 0x9c, 0x92, 0x5d, 0x92, 0xb0, 0xb5, 0x99, 0x64, 0x70, 0x54, 0xf8, 0xcb,
+	0xc4, 0xd0, 0xf9, 0xc9, 0x80, 0xfd, 0x99, 0xb3, 0x45, 0xc4, 0x42, 0x41, 0xd0, 0x13, 0xd8, 0x29,
+	0x91, 0x28, 0x5a, 0x46, 0x7b, 0xf3, 0xb8, 0xd9, 0x6d, 0x5b, 0x55, 0x4d, 0x65, 0x95, 0xae, 0xdb,
+	0x94, 0x45, 0x32, 0x74, 0x17, 0xae, 0x87, 0x64, 0x24, 0xdd, 0x52, 0x11, 0x35, 0x55, 0xc4, 0x6e,
+	0x62, 0x3e, 0xcb, 0x0b, 0xb1, 0xe1, 0x40, 0x93, 0xf6, 0xe9, 0x88, 0x85, 0x84, 0x4f, 0x50, 0x8d,
+	0xca, 0xcc, 0x69, 0xd2, 0xbe, 0x83, 0xc3, 0xac, 0xf0, 0x12, 0xe2, 0xbf, 0x63, 0xee, 0x37, 0x03,
+	0x6e, 0x57, 0x17, 0xa0, 0xe9, 0x73, 0xe0, 0x0d, 0x45, 0x1f, 0x29, 0x39, 0x35, 0x87, 0x6f, 0xcf,
+	0xe7, 0xb0, 0x7c, 0xfb, 0x1b, 0x72, 0x2a, 0xf7, 0xca, 0x6c, 0xfe, 0x58, 0x83, 0xfd, 0xe7, 0x31,
+	0xe1, 0xe3, 0x8a, 0x9e, 0x6a, 0x43, 0xb3, 0xa0, 0x20, 0xad, 0xa8, 0xe1, 0x94, 0x4d, 0xe8, 0x19,
+	0x34, 0xf2, 0x97, 0xa7, 0xf2, 0x37, 0xbb, 0xef, 0x2c, 0x53, 0xfd, 0x2c, 0x03, 0xa4, 0x2c, 0x17,
+	0x09, 0xd0, 0xc7, 0xb0, 0x1b, 0xe0, 0x91, 0x2b, 0x24, 0xf6, 0x49, 0x48, 0x84, 0x50, 0x54, 0x36,
+	0xbb, 0x07, 0x56, 0x3a, 0x65, 0xac, 0x6c, 0xca, 0x58, 0x4f, 0xf5, 0x94, 0x71, 0x76, 0x02, 0x3c,
+	0x7a, 0x91, 0x85, 0x4f, 0x8a, 0x74, 0x65, 0xa1, 0x48, 0xf5, 0x69, 0x91, 0x7e, 0x36, 0xa0, 0x35,
+	0xcb, 0xc3, 0xff, 0xd1, 0xdf, 0xbf, 0xd4, 0xe0, 0x76, 0x5e, 0x49, 0x55, 0xc3, 0x2e, 0x97, 0xe5,
+	0xf9, 0xac, 0x2c, 0xd6, 0x4a, 0x8d, 0xf4, 0x7a, 0x6a, 0xf3, 0xbb, 0x01, 0x77, 0xe6, 0x30, 0xf2,
+	0x1a, 0xbc, 0xa0, 0xbf, 0x0d, 0xb8, 0xa9, 0x07, 0xd2, 0x17, 0x54, 0x48, 0xc6, 0xc7, 0x99, 0x50,
+	0x07, 0x50, 0xe7, 0x04, 0xfb, 0x41, 0x79, 0xa8, 0xa4, 0x16, 0x74, 0x1f, 0xd4, 0x81, 0x6e, 0x84,
+	0xe5, 0x85, 0x1b, 0x71, 0xd2, 0xa7, 0xa3, 0x56, 0xad, 0x88, 0xba, 0x96, 0x38, 0xcf, 0xb0, 0xbc,
+	0x38, 0x53, 0x2e, 0xf4, 0x09, 0x6c, 0x0d, 0x31, 0xa7, 0x38, 0x94, 0x9a, 0xf7, 0xbb, 0xd5, 0xb7,
+	0xfa, 0x3a, 0x0d, 0xca, 0x65, 0x74, 0x32, 0x18, 0xea, 0xc2, 0x56, 0xa2, 0x1f, 0x1e, 0xa4, 0xec,
+	0x2f, 0x54, 0xee, 0x6a, 0x80, 0x47, 0x8f, 0x07, 0x04, 0x1d, 0x41, 0x33, 0xc1, 0x64, 0x5d, 0x5f,
+	0x57, 0xaa, 0x41, 0x80, 0x47, 0xba, 0xa5, 0x3b, 0x7f, 0x18, 0x70, 0x6b, 0xfa, 0xea, 0xff, 0xe6,
+	0x93, 0xf9, 0x0a, 0x76, 0x55, 0x12, 0x7d, 0x09, 0xd1, 0xaa, 0xa9, 0x2c, 0xef, 0xce, 0xcf, 0xa2,
+	0x09, 0xf8, 0xcc, 0xc7, 0x97, 0x34, 0xe9, 0x3b, 0x67, 0x47, 0x16, 0x56, 0xd1, 0xf9, 0xd3, 0x80,
+	0xbd, 0xaa, 0xb0, 0xa4, 0x3d, 0x73, 0x3d, 0xf4, 0xc7, 0x63, 0x3b, 0xd3, 0x00, 0x3d, 0x2c, 0xd8,
+	0x4f, 0x1f, 0xd3, 0xe1, 0x54, 0x01, 0xc9, 0x2a, 0x90, 0x71, 0x5f, 0x50, 0xae, 0xba, 0x5a, 0x08,
+	0xd7, 0x63, 0xb1, 0xd6, 0xad, 0x9e, 0x74, 0xb5, 0x10, 0x4f, 0x58, 0x9c, 0xba, 0xfb, 0x98, 0xfa,
+	0xda, 0x9d, 0x3e, 0x89, 0x46, 0x62, 0x49, 0xdd, 0x47, 0xd0, 0xec, 0xfb, 0xf8, 0x92, 0x68, 0xbf,
+	0x26, 0x5f, 0x99, 0x54, 0x40, 0xf7, 0xd7, 0x2d, 0xd8, 0x4e, 0x89, 0x7a, 0x7a, 0x8a, 0x5c, 0xd8,
+	0x9d, 0x58, 0x3d, 0xd0, 0x1c, 0x8e, 0xaa, 0xf6, 0x13, 0x73, 0x8e, 0x2a, 0x45, 0x60, 0x67, 0x43,
+	0x1f, 0x50, 0x08, 0xb5, 0xe0, 0x80, 0x99, 0x7d, 0xc6, 0x5c, 0x2a, 0x7b, 0x67, 0x03, 0x45, 0x70,
+	0x7d, 0x6a, 0xbd, 0x40, 0xf7, 0xaa, 0x61, 0xd5, 0x1b, 0x90, 0x79, 0x7f, 0xc5, 0xe8, 0xb4, 0x41,
+	0x3b, 0x1b, 0x28, 0x04, 0x34, 0xbb, 0x48, 0x20, 0x7b, 0xe1, 0xbd, 0x66, 0x57, 0x0e, 0x73, 0xb5,
+	0x01, 0xd3, 0xd9, 0x40, 0xdf, 0xc3, 0x5e, 0xd5, 0x1a, 0x80, 0x4e, 0x16, 0x17, 0x5e, 0xf1, 0x09,
+	0x30, 0xbb, 0xeb, 0x40, 0xf2, 0x0b, 0x0b, 0xb8, 0x31, 0xfd, 0x89, 0x43, 0x73, 0x58, 0x9b, 0xb3,
+	0x12, 0x98, 0xd6, 0xaa, 0xe1, 0xf9, 0xa1, 0x3f, 0x18, 0x70, 0xb3, 0x72, 0x78, 0xa3, 0xee, 0x92,
+	0x5c, 0x55, 0x17, 0x7f, 0xb0, 0x16, 0x26, 0x2f, 0x22, 0x80, 0x6b, 0x93, 0x73, 0x0a, 0xbd, 0xb7,
+	0x50, 0xe6, 0xc9, 0x41, 0x6e, 0xde, 0x5b, 0x2d, 0x38, 0x3b, 0xee, 0xb4, 0xfb, 0xcd, 0xfb, 0xab,
+	0xff, 0xff, 0x78, 0xc4, 0x23, 0x2f, 0xea, 0xf5, 0xae, 0x2a, 0xdb, 0x83, 0x7f, 0x06, 0x00, 0xfc,
+	0xca, 0xa9, 0x7c, 0xc5, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -793,6 +1044,12 @@ type ResultDBClient interface {
 	// Supports invocation inclusions.
 	// Supports advanced filtering.
 	QueryTestExonerations(ctx context.Context, in *QueryTestExonerationsRequest, opts ...grpc.CallOption) (*QueryTestExonerationsResponse, error)
+	// Retrieves recent test results of tests with a test path prefix across
+	// invocations of a realm or a project.
+	// Summarizes flakiness of each test variant.
+	//
+	// Useful to decide whether a test failure is new or the test is flaky.
+	GetTestHistory(ctx context.Context, in *GetTestHistoryRequest, opts ...grpc.CallOption) (*GetTestHistoryResponse, error)
 }
 type resultDBPRPCClient struct {
 	client *prpc.Client
@@ -865,6 +1122,15 @@ func (c *resultDBPRPCClient) QueryTestExonerations(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *resultDBPRPCClient) GetTestHistory(ctx context.Context, in *GetTestHistoryRequest, opts ...grpc.CallOption) (*GetTestHistoryResponse, error) {
+	out := new(GetTestHistoryResponse)
+	err := c.client.Call(ctx, "luci.resultdb.rpc.v1.ResultDB", "GetTestHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type resultDBClient struct {
 	cc *grpc.ClientConn
 }
@@ -936,6 +1202,15 @@ func (c *resultDBClient) QueryTestExonerations(ctx context.Context, in *QueryTes
 	return out, nil
 }
 
+func (c *resultDBClient) GetTestHistory(ctx context.Context, in *GetTestHistoryRequest, opts ...grpc.CallOption) (*GetTestHistoryResponse, error) {
+	out := new(GetTestHistoryResponse)
+	err := c.cc.Invoke(ctx, "/luci.resultdb.rpc.v1.ResultDB/GetTestHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResultDBServer is the server API for ResultDB service.
 type ResultDBServer interface {
 	// Retrieves an invocation.
@@ -962,6 +1237,12 @@ type ResultDBServer interface {
 	// Supports invocation inclusions.
 	// Supports advanced filtering.
 	QueryTestExonerations(context.Context, *QueryTestExonerationsRequest) (*QueryTestExonerationsResponse, error)
+	// Retrieves recent test results of tests with a test path prefix across
+	// invocations of a realm or a project.
+	// Summarizes flakiness of each test variant.
+	//
+	// Useful to decide whether a test failure is new or the test is flaky.
+	GetTestHistory(context.Context, *GetTestHistoryRequest) (*GetTestHistoryResponse, error)
 }
 
 // UnimplementedResultDBServer can be embedded to have forward compatible implementations.
@@ -989,6 +1270,9 @@ func (*UnimplementedResultDBServer) QueryTestResults(ctx context.Context, req *Q
 func (*UnimplementedResultDBServer) QueryTestExonerations(ctx context.Context, req *QueryTestExonerationsRequest) (*QueryTestExonerationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTestExonerations not implemented")
 }
+func (*UnimplementedResultDBServer) GetTestHistory(ctx context.Context, req *GetTestHistoryRequest) (*GetTestHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestHistory not implemented")
+}
 
 func RegisterResultDBServer(s prpc.Registrar, srv ResultDBServer) {
 	s.RegisterService(&_ResultDB_serviceDesc, srv)
@@ -1120,6 +1404,24 @@ func _ResultDB_QueryTestExonerations_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResultDB_GetTestHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTestHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultDBServer).GetTestHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.resultdb.rpc.v1.ResultDB/GetTestHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultDBServer).GetTestHistory(ctx, req.(*GetTestHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ResultDB_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "luci.resultdb.rpc.v1.ResultDB",
 	HandlerType: (*ResultDBServer)(nil),
@@ -1152,6 +1454,10 @@ var _ResultDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryTestExonerations",
 			Handler:    _ResultDB_QueryTestExonerations_Handler,
 		},
+		{
+			MethodName: "GetTestHistory",
+			Handler:    _ResultDB_GetTestHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/resultdb/proto/rpc/v1/resultdb.proto",
@@ -141,3 +141,20 @@ func (s *DecoratedResultDB) QueryTestExonerations(ctx context.Context, req *Quer
 	}
 	return
 }
+
+func (s *DecoratedResultDB) GetTestHistory(ctx context.Context, req *GetTestHistoryRequest) (rsp *GetTestHistoryResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "GetTestHistory", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.GetTestHistory(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "GetTestHistory", rsp, err)
+	}
+	return
+}
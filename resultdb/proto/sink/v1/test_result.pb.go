@@ -98,18 +98,34 @@ const (
 	// Implementation:
 	// https://cs.chromium.org/chromium/src/base/test/launcher/test_results_tracker.cc
 	TestResultFile_GOOGLE_TEST TestResultFile_Format = 2
+	// JUnit/xUnit XML format, as emitted by Ant, Maven Surefire, Gradle and
+	// many xUnit-style test runners.
+	// https://github.com/windyroad/JUnit-Schema/blob/master/JUnit.xsd
+	TestResultFile_JUNIT_XML TestResultFile_Format = 3
+	// The event stream emitted by `go test -json`.
+	// https://golang.org/cmd/test2json
+	TestResultFile_GO_TEST_JSON TestResultFile_Format = 4
+	// Test Anything Protocol, versions 12 and 13.
+	// https://testanything.org
+	TestResultFile_TAP TestResultFile_Format = 5
 )
 
 var TestResultFile_Format_name = map[int32]string{
 	0: "LUCI",
 	1: "CHROMIUM_JSON_TEST_RESULTS",
 	2: "GOOGLE_TEST",
+	3: "JUNIT_XML",
+	4: "GO_TEST_JSON",
+	5: "TAP",
 }
 
 var TestResultFile_Format_value = map[string]int32{
 	"LUCI":                       0,
 	"CHROMIUM_JSON_TEST_RESULTS": 1,
 	"GOOGLE_TEST":                2,
+	"JUNIT_XML":                  3,
+	"GO_TEST_JSON":               4,
+	"TAP":                        5,
 }
 
 func (x TestResultFile_Format) String() string {
@@ -415,51 +431,53 @@ func init() {
 }
 
 var fileDescriptor_c45f29128cb6d695 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5b, 0x73, 0xc2, 0x44,
-	0x14, 0x6e, 0xb8, 0x19, 0x0e, 0x1d, 0x88, 0x5b, 0x75, 0x22, 0xd5, 0x96, 0xf2, 0x84, 0x8e, 0x93,
-	0x4c, 0xd1, 0x3a, 0xd6, 0xcb, 0x03, 0xa5, 0xd0, 0xc6, 0xd2, 0xc2, 0x6c, 0x82, 0xe3, 0xf8, 0x60,
-	0x66, 0x81, 0x85, 0x66, 0x4a, 0x2e, 0xb3, 0xd9, 0x60, 0xf9, 0x55, 0xfe, 0x13, 0x7f, 0x93, 0xb3,
-	0x9b, 0x44, 0x4b, 0x45, 0xab, 0x33, 0x3e, 0x65, 0xf7, 0x3b, 0xdf, 0x77, 0xae, 0x39, 0x0b, 0xdf,
-	0xad, 0x42, 0x63, 0xfe, 0xc8, 0x42, 0xdf, 0x4b, 0x7c, 0x23, 0x64, 0x2b, 0x73, 0x9d, 0xcc, 0x3d,
-	0x93, 0xd1, 0x38, 0x59, 0xf3, 0xc5, 0xcc, 0x8c, 0x58, 0xc8, 0x43, 0x33, 0xf6, 0x82, 0x27, 0x73,
-	0x73, 0x6e, 0x72, 0x1a, 0x73, 0x37, 0xb5, 0x19, 0xd2, 0x82, 0xde, 0x17, 0x74, 0x23, 0xa7, 0x1b,
-	0x82, 0x68, 0x6c, 0xce, 0x9b, 0x27, 0xab, 0x30, 0x5c, 0xad, 0x69, 0x2a, 0x9f, 0x25, 0x4b, 0x73,
-	0x91, 0x30, 0xc2, 0xbd, 0x30, 0x48, 0x65, 0xcd, 0xd3, 0xd7, 0x76, 0xee, 0xf9, 0x34, 0xe6, 0xc4,
-	0x8f, 0x32, 0xc2, 0xc5, 0xbf, 0x49, 0x8b, 0x6f, 0x23, 0x6a, 0xce, 0x43, 0xdf, 0xcf, 0xfd, 0xb6,
-	0x7f, 0xab, 0x00, 0x38, 0x34, 0xe6, 0x58, 0x12, 0xd1, 0x31, 0x54, 0x65, 0xca, 0x11, 0xe1, 0x8f,
-	0xba, 0xd2, 0x52, 0x3a, 0x55, 0xac, 0x0a, 0x60, 0x42, 0xf8, 0xa3, 0x30, 0xa6, 0xfe, 0x5c, 0x6f,
-	0xa1, 0x17, 0x52, 0x63, 0x0a, 0x58, 0x0b, 0x74, 0x07, 0x47, 0xf4, 0x99, 0x33, 0xe2, 0x6e, 0x08,
-	0xf3, 0x48, 0x20, 0x5c, 0x78, 0x2c, 0xd6, 0x8b, 0x2d, 0xa5, 0x53, 0xeb, 0x1e, 0x1b, 0xbb, 0x55,
-	0x8b, 0x3c, 0x8c, 0x1f, 0x52, 0x22, 0x7e, 0x57, 0xea, 0xb2, 0xdb, 0x44, 0xa8, 0x50, 0x13, 0x54,
-	0xfa, 0x1c, 0xd1, 0x39, 0xa7, 0x0b, 0xbd, 0xd4, 0x52, 0x3a, 0x2a, 0xfe, 0xe3, 0x8e, 0x2e, 0xa1,
-	0x12, 0x73, 0xc2, 0x93, 0x58, 0x2f, 0xb7, 0x94, 0x4e, 0xbd, 0x7b, 0x66, 0xec, 0xed, 0xa8, 0x21,
-	0xaa, 0xb2, 0x25, 0x11, 0x67, 0x02, 0xf4, 0x09, 0x68, 0x71, 0xe2, 0xfb, 0x84, 0x6d, 0x5d, 0x9f,
-	0xb0, 0xa7, 0x45, 0xf8, 0x4b, 0xa0, 0x57, 0x64, 0x1d, 0x8d, 0x0c, 0xbf, 0xcf, 0x60, 0x74, 0x09,
-	0x10, 0x73, 0xc2, 0xb8, 0x2b, 0xfa, 0xac, 0xbf, 0x23, 0xab, 0x68, 0x1a, 0xe9, 0x10, 0x8c, 0x7c,
-	0x08, 0x86, 0x93, 0x0f, 0x01, 0x57, 0x25, 0x5b, 0xdc, 0xd1, 0xb7, 0x70, 0xc8, 0x92, 0xc0, 0xcd,
-	0x07, 0xa8, 0xab, 0x52, 0xfc, 0xe1, 0x5f, 0xc4, 0xd7, 0x19, 0x01, 0xd7, 0x58, 0x12, 0xe4, 0x17,
-	0xd4, 0x85, 0x12, 0x27, 0xab, 0x58, 0xaf, 0xb6, 0x8a, 0x9d, 0x5a, 0xf7, 0x64, 0x5f, 0xe3, 0x6c,
-	0xce, 0xbc, 0x60, 0x25, 0x3a, 0x85, 0x25, 0x17, 0xfd, 0x0c, 0x0d, 0x2f, 0x88, 0x12, 0xee, 0x12,
-	0xc6, 0xbd, 0x25, 0x99, 0xf3, 0x58, 0x07, 0x29, 0xbf, 0xf8, 0x87, 0xde, 0xa4, 0x13, 0x37, 0x2c,
-	0x21, 0xec, 0xe5, 0xba, 0x41, 0xc0, 0xd9, 0x16, 0xd7, 0xbd, 0x1d, 0x10, 0x11, 0xd0, 0xc2, 0x84,
-	0xef, 0x06, 0xa8, 0xc9, 0x00, 0x5f, 0xbe, 0x1d, 0x60, 0x2c, 0x95, 0xaf, 0x22, 0x34, 0xc2, 0x5d,
-	0xb4, 0x39, 0x83, 0xa3, 0x3d, 0x99, 0x20, 0x0d, 0x8a, 0x4f, 0x74, 0x9b, 0xfd, 0x89, 0xe2, 0x88,
-	0x2e, 0xa0, 0xbc, 0x21, 0xeb, 0x84, 0xca, 0x1f, 0xb0, 0xd6, 0x3d, 0xfd, 0x9b, 0x04, 0x72, 0x3f,
-	0x38, 0x65, 0x7f, 0x5d, 0xf8, 0x4a, 0x69, 0xce, 0xe1, 0xbd, 0x7d, 0xc9, 0xfc, 0xaf, 0x41, 0xda,
-	0x0c, 0xd4, 0x1c, 0x46, 0x1f, 0x43, 0x75, 0xe9, 0xad, 0xe9, 0x8b, 0x6d, 0xba, 0x3d, 0xc0, 0xaa,
-	0x80, 0xe4, 0x3e, 0x7d, 0x04, 0xea, 0x3c, 0x0c, 0x38, 0x0d, 0x78, 0x2c, 0x03, 0x1d, 0x0a, 0x6b,
-	0x8e, 0xa0, 0x33, 0x38, 0xcc, 0xce, 0xae, 0x98, 0xba, 0xdc, 0xa4, 0x2a, 0xae, 0x65, 0x98, 0xb3,
-	0x8d, 0xe8, 0x55, 0x05, 0x4a, 0xb3, 0x70, 0xb1, 0x6d, 0xff, 0xaa, 0x40, 0xfd, 0xcf, 0x8e, 0x0f,
-	0xbd, 0x35, 0x45, 0x08, 0x4a, 0x2f, 0x76, 0x58, 0x9e, 0xd1, 0x35, 0x54, 0x96, 0x21, 0xf3, 0x09,
-	0x97, 0xd1, 0xea, 0xdd, 0xcf, 0xde, 0x1c, 0x9e, 0x70, 0x65, 0x0c, 0xa5, 0x06, 0x67, 0xda, 0x76,
-	0x1f, 0x2a, 0x29, 0x82, 0x54, 0x28, 0x8d, 0xa6, 0x7d, 0x4b, 0x3b, 0x40, 0x27, 0xd0, 0xec, 0xdf,
-	0xe2, 0xf1, 0xbd, 0x35, 0xbd, 0x77, 0xbf, 0xb7, 0xc7, 0x0f, 0xae, 0x33, 0xb0, 0x1d, 0x17, 0x0f,
-	0xec, 0xe9, 0xc8, 0xb1, 0x35, 0x05, 0x35, 0xa0, 0x76, 0x33, 0x1e, 0xdf, 0x8c, 0x06, 0xd2, 0xa0,
-	0x15, 0x3e, 0xfd, 0x31, 0x7d, 0x75, 0xd2, 0xfd, 0x44, 0x1f, 0x00, 0xb2, 0x9d, 0x9e, 0x33, 0xb5,
-	0xdd, 0xe9, 0x83, 0x3d, 0x19, 0xf4, 0xad, 0xa1, 0x35, 0xb8, 0xd6, 0x0e, 0x44, 0x80, 0x49, 0xcf,
-	0x16, 0x0e, 0x54, 0x28, 0x0d, 0x7b, 0xd6, 0x48, 0x2b, 0xa0, 0x2a, 0x94, 0xfb, 0xb8, 0x67, 0xdf,
-	0x6a, 0x45, 0x71, 0xec, 0x5d, 0x8d, 0xb1, 0xa3, 0x95, 0x84, 0xdd, 0xbe, 0xb3, 0x26, 0x5a, 0xf9,
-	0xea, 0x8b, 0x9f, 0xba, 0xff, 0xe1, 0x81, 0xfe, 0x46, 0x7c, 0xa3, 0xd9, 0xac, 0x22, 0xd1, 0xcf,
-	0x7f, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x95, 0x3e, 0x3f, 0xdd, 0x05, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5d, 0x93, 0xe2, 0x44,
+	0x14, 0x9d, 0x0c, 0x81, 0x4d, 0x2e, 0x23, 0xc4, 0x5e, 0xb5, 0x22, 0xab, 0xb3, 0x2c, 0x4f, 0x68,
+	0x59, 0x49, 0x2d, 0x3a, 0x96, 0xeb, 0xc7, 0x03, 0xc3, 0xc0, 0x4c, 0x76, 0x61, 0xa0, 0x3a, 0xc1,
+	0xda, 0xf2, 0xc1, 0x54, 0x03, 0x0d, 0x93, 0x1a, 0xf2, 0x51, 0x9d, 0x0e, 0x2e, 0x7f, 0xd2, 0x77,
+	0xff, 0x8d, 0xd5, 0x9d, 0xc4, 0x1d, 0x14, 0x5d, 0xad, 0xf2, 0x89, 0xee, 0x73, 0xcf, 0xb9, 0xe7,
+	0xf6, 0xbd, 0xb9, 0xc0, 0x0f, 0x9b, 0xd8, 0x5a, 0xde, 0xb1, 0x38, 0x0c, 0xb2, 0xd0, 0x8a, 0xd9,
+	0xc6, 0xde, 0x66, 0xcb, 0xc0, 0x66, 0x34, 0xcd, 0xb6, 0x7c, 0xb5, 0xb0, 0x13, 0x16, 0xf3, 0xd8,
+	0x4e, 0x83, 0xe8, 0xde, 0xde, 0x3d, 0xb7, 0x39, 0x4d, 0xb9, 0x9f, 0xc7, 0x2c, 0x19, 0x41, 0x1f,
+	0x0a, 0xba, 0x55, 0xd2, 0x2d, 0x41, 0xb4, 0x76, 0xcf, 0x5b, 0xe7, 0x9b, 0x38, 0xde, 0x6c, 0x69,
+	0x2e, 0x5f, 0x64, 0x6b, 0x7b, 0x95, 0x31, 0xc2, 0x83, 0x38, 0xca, 0x65, 0xad, 0xa7, 0x7f, 0x8e,
+	0xf3, 0x20, 0xa4, 0x29, 0x27, 0x61, 0x52, 0x10, 0x2e, 0xfe, 0x4d, 0x59, 0x7c, 0x9f, 0x50, 0x7b,
+	0x19, 0x87, 0x61, 0x99, 0xb7, 0xf3, 0x6b, 0x0d, 0xc0, 0xa3, 0x29, 0xc7, 0x92, 0x88, 0x9e, 0x80,
+	0x2e, 0x4b, 0x4e, 0x08, 0xbf, 0x33, 0x95, 0xb6, 0xd2, 0xd5, 0xb1, 0x26, 0x80, 0x19, 0xe1, 0x77,
+	0x22, 0x98, 0xe7, 0xf3, 0x83, 0x95, 0x79, 0x9a, 0x07, 0x73, 0xc0, 0x59, 0xa1, 0x57, 0xf0, 0x98,
+	0xbe, 0xe1, 0x8c, 0xf8, 0x3b, 0xc2, 0x02, 0x12, 0x89, 0x14, 0x01, 0x4b, 0xcd, 0x4a, 0x5b, 0xe9,
+	0xd6, 0x7b, 0x4f, 0xac, 0xc3, 0x57, 0x8b, 0x3a, 0xac, 0x1f, 0x73, 0x22, 0x7e, 0x5f, 0xea, 0x8a,
+	0xdb, 0x4c, 0xa8, 0x50, 0x0b, 0x34, 0xfa, 0x26, 0xa1, 0x4b, 0x4e, 0x57, 0xa6, 0xda, 0x56, 0xba,
+	0x1a, 0xfe, 0xe3, 0x8e, 0x5e, 0x40, 0x2d, 0xe5, 0x84, 0x67, 0xa9, 0x59, 0x6d, 0x2b, 0xdd, 0x46,
+	0xef, 0x99, 0x75, 0xb4, 0xa3, 0x96, 0x78, 0x95, 0x2b, 0x89, 0xb8, 0x10, 0xa0, 0xcf, 0xc0, 0x48,
+	0xb3, 0x30, 0x24, 0x6c, 0xef, 0x87, 0x84, 0xdd, 0xaf, 0xe2, 0x5f, 0x22, 0xb3, 0x26, 0xdf, 0xd1,
+	0x2c, 0xf0, 0x49, 0x01, 0xa3, 0x17, 0x00, 0x29, 0x27, 0x8c, 0xfb, 0xa2, 0xcf, 0xe6, 0x23, 0xf9,
+	0x8a, 0x96, 0x95, 0x0f, 0xc1, 0x2a, 0x87, 0x60, 0x79, 0xe5, 0x10, 0xb0, 0x2e, 0xd9, 0xe2, 0x8e,
+	0xbe, 0x87, 0x33, 0x96, 0x45, 0x7e, 0x39, 0x40, 0x53, 0x93, 0xe2, 0x8f, 0xff, 0x22, 0xbe, 0x2a,
+	0x08, 0xb8, 0xce, 0xb2, 0xa8, 0xbc, 0xa0, 0x1e, 0xa8, 0x9c, 0x6c, 0x52, 0x53, 0x6f, 0x57, 0xba,
+	0xf5, 0xde, 0xf9, 0xb1, 0xc6, 0xb9, 0x9c, 0x05, 0xd1, 0x46, 0x74, 0x0a, 0x4b, 0x2e, 0xfa, 0x19,
+	0x9a, 0x41, 0x94, 0x64, 0xdc, 0x27, 0x8c, 0x07, 0x6b, 0xb2, 0xe4, 0xa9, 0x09, 0x52, 0x7e, 0xf1,
+	0x0f, 0xbd, 0xc9, 0x27, 0x6e, 0x39, 0x42, 0xd8, 0x2f, 0x75, 0xc3, 0x88, 0xb3, 0x3d, 0x6e, 0x04,
+	0x07, 0x20, 0x22, 0x60, 0xc4, 0x19, 0x3f, 0x34, 0xa8, 0x4b, 0x83, 0xaf, 0xdf, 0x6d, 0x30, 0xcd,
+	0xf8, 0xc3, 0x64, 0xb9, 0x43, 0x33, 0x3e, 0x44, 0x5b, 0x0b, 0x78, 0x7c, 0xa4, 0x12, 0x64, 0x40,
+	0xe5, 0x9e, 0xee, 0x8b, 0x2f, 0x51, 0x1c, 0xd1, 0x05, 0x54, 0x77, 0x64, 0x9b, 0x51, 0xf9, 0x01,
+	0xd6, 0x7b, 0x4f, 0xff, 0xa6, 0x80, 0x32, 0x0f, 0xce, 0xd9, 0xdf, 0x9e, 0x7e, 0xa3, 0xb4, 0x96,
+	0xf0, 0xc1, 0xb1, 0x62, 0xfe, 0x57, 0x93, 0x0e, 0x03, 0xad, 0x84, 0xd1, 0xa7, 0xa0, 0xaf, 0x83,
+	0x2d, 0x7d, 0xb0, 0x4d, 0x37, 0x27, 0x58, 0x13, 0x90, 0xdc, 0xa7, 0x4f, 0x40, 0x5b, 0xc6, 0x11,
+	0xa7, 0x11, 0x4f, 0xa5, 0xd1, 0x99, 0x88, 0x96, 0x08, 0x7a, 0x06, 0x67, 0xc5, 0xd9, 0x17, 0x53,
+	0x97, 0x9b, 0xa4, 0xe3, 0x7a, 0x81, 0x79, 0xfb, 0x84, 0x5e, 0xd6, 0x40, 0x5d, 0xc4, 0xab, 0x7d,
+	0xe7, 0x37, 0x05, 0x1a, 0x6f, 0x3b, 0x3e, 0x0a, 0xb6, 0x14, 0x21, 0x50, 0x1f, 0xec, 0xb0, 0x3c,
+	0xa3, 0x2b, 0xa8, 0xad, 0x63, 0x16, 0x12, 0x2e, 0xdd, 0x1a, 0xbd, 0x2f, 0xde, 0x39, 0x3c, 0x91,
+	0xca, 0x1a, 0x49, 0x0d, 0x2e, 0xb4, 0x9d, 0x10, 0x6a, 0x39, 0x82, 0x34, 0x50, 0xc7, 0xf3, 0x81,
+	0x63, 0x9c, 0xa0, 0x73, 0x68, 0x0d, 0x6e, 0xf0, 0x74, 0xe2, 0xcc, 0x27, 0xfe, 0x4b, 0x77, 0x7a,
+	0xeb, 0x7b, 0x43, 0xd7, 0xf3, 0xf1, 0xd0, 0x9d, 0x8f, 0x3d, 0xd7, 0x50, 0x50, 0x13, 0xea, 0xd7,
+	0xd3, 0xe9, 0xf5, 0x78, 0x28, 0x03, 0xc6, 0x29, 0x7a, 0x0f, 0xf4, 0x97, 0xf3, 0x5b, 0xc7, 0xf3,
+	0x5f, 0x4f, 0xc6, 0x46, 0x05, 0x19, 0x70, 0x76, 0x3d, 0xcd, 0x45, 0x42, 0x6e, 0xa8, 0xe8, 0x11,
+	0x54, 0xbc, 0xfe, 0xcc, 0xa8, 0x7e, 0xfe, 0x1a, 0xe0, 0xed, 0x26, 0xa3, 0x8f, 0x00, 0xb9, 0x5e,
+	0xdf, 0x9b, 0xbb, 0xfe, 0xfc, 0xd6, 0x9d, 0x0d, 0x07, 0xce, 0xc8, 0x19, 0x5e, 0x19, 0x27, 0xa2,
+	0x94, 0x59, 0xdf, 0x15, 0x56, 0x1a, 0xa8, 0xa3, 0xbe, 0x33, 0x36, 0x4e, 0x91, 0x0e, 0xd5, 0x01,
+	0xee, 0xbb, 0x37, 0x46, 0x45, 0x1c, 0xfb, 0x97, 0x53, 0xec, 0x19, 0xaa, 0x88, 0xbb, 0xaf, 0x9c,
+	0x99, 0x51, 0xbd, 0xfc, 0xea, 0xa7, 0xde, 0x7f, 0xf8, 0x2b, 0xff, 0x4e, 0xfc, 0x26, 0x8b, 0x45,
+	0x4d, 0xa2, 0x5f, 0xfe, 0x3e, 0x00, 0x99, 0xc4, 0x39, 0x3d, 0x07, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,160 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	isolateservice "go.chromium.org/luci/common/api/isolate/isolateservice/v1"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/isolated"
+	"go.chromium.org/luci/common/isolatedclient"
+	"go.chromium.org/luci/common/retry/transient"
+
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	sinkpb "go.chromium.org/luci/resultdb/proto/sink/v1"
+)
+
+// maxInlineArtifactSize is the maximum size of artifact contents stored inline
+// with a test result, see pb.Artifact.Contents.
+const maxInlineArtifactSize = 8 * 1024
+
+// ArtifactUploader uploads contents of artifacts to an isolate server.
+type ArtifactUploader struct {
+	// Client is the client to the isolate server.
+	Client *isolatedclient.Client
+
+	// Host is the hostname of the isolate server, e.g.
+	// "isolateserver.appspot.com". Used in fetch URLs of uploaded artifacts.
+	Host string
+
+	// Namespace is the isolate namespace that Client talks to.
+	Namespace string
+}
+
+// Upload uploads the contents of the artifact, unless the isolate server has
+// them already, and returns a pb.Artifact with a fetch URL referring to them.
+func (u *ArtifactUploader) Upload(ctx context.Context, name string, art *sinkpb.Artifact) (*pb.Artifact, error) {
+	var digest isolateservice.HandlersEndpointsV1Digest
+	var src isolatedclient.Source
+	switch body := art.Body.(type) {
+	case *sinkpb.Artifact_FilePath:
+		var err error
+		if digest, err = isolated.HashFile(u.Client.Hash(), body.FilePath); err != nil {
+			return nil, errors.Annotate(err, "failed to hash %q", body.FilePath).Err()
+		}
+		src = func() (io.ReadCloser, error) { return os.Open(body.FilePath) }
+
+	case *sinkpb.Artifact_Contents:
+		digest = isolateservice.HandlersEndpointsV1Digest{
+			Digest: string(isolated.HashBytes(u.Client.Hash(), body.Contents)),
+			Size:   int64(len(body.Contents)),
+		}
+		src = isolatedclient.NewBytesSource(body.Contents)
+
+	default:
+		return nil, errors.Reason("artifact %q has neither file_path nor contents", name).Err()
+	}
+
+	// The client retries transient HTTP failures itself. Whatever it gives up on
+	// says nothing about the validity of the artifact, so tag it as transient
+	// for the caller not to drop the test result.
+	states, err := u.Client.Contains(ctx, []*isolateservice.HandlersEndpointsV1Digest{&digest})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to check presence of artifact %q", name).Tag(transient.Tag).Err()
+	}
+	// A nil state means that the server has the contents already.
+	if states[0] != nil {
+		if err := u.Client.Push(ctx, states[0], src); err != nil {
+			return nil, errors.Annotate(err, "failed to upload artifact %q", name).Tag(transient.Tag).Err()
+		}
+	}
+
+	return &pb.Artifact{
+		Name:        name,
+		FetchUrl:    fmt.Sprintf("isolate://%s/%s/%s", u.Host, u.Namespace, digest.Digest),
+		ContentType: art.ContentType,
+		Size:        digest.Size,
+	}, nil
+}
+
+// convertArtifacts converts artifacts received from a test harness to
+// pb.Artifacts, sorted by name.
+//
+// If the server has an ArtifactUploader, uploads the contents of all artifacts.
+// Otherwise stores them inline, which is possible only for small artifacts.
+func (s *Server) convertArtifacts(ctx context.Context, arts map[string]*sinkpb.Artifact) ([]*pb.Artifact, error) {
+	if len(arts) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(arts))
+	for name := range arts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret := make([]*pb.Artifact, len(names))
+	for i, name := range names {
+		var err error
+		if s.cfg.ArtifactUploader != nil {
+			ret[i], err = s.cfg.ArtifactUploader.Upload(ctx, name, arts[name])
+		} else {
+			ret[i], err = inlineArtifact(name, arts[name])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// inlineArtifact returns a pb.Artifact with the contents of the artifact
+// stored inline.
+func inlineArtifact(name string, art *sinkpb.Artifact) (*pb.Artifact, error) {
+	var contents []byte
+	switch body := art.Body.(type) {
+	case *sinkpb.Artifact_FilePath:
+		switch st, err := os.Stat(body.FilePath); {
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to stat artifact %q", name).Err()
+		case st.Size() > maxInlineArtifactSize:
+			return nil, errors.Reason("artifact %q is larger than %d bytes and no isolate server is configured", name, maxInlineArtifactSize).Err()
+		}
+		var err error
+		if contents, err = ioutil.ReadFile(body.FilePath); err != nil {
+			return nil, errors.Annotate(err, "failed to read artifact %q", name).Err()
+		}
+	case *sinkpb.Artifact_Contents:
+		contents = body.Contents
+	default:
+		return nil, errors.Reason("artifact %q has neither file_path nor contents", name).Err()
+	}
+
+	if len(contents) > maxInlineArtifactSize {
+		return nil, errors.Reason("artifact %q is larger than %d bytes and no isolate server is configured", name, maxInlineArtifactSize).Err()
+	}
+	return &pb.Artifact{
+		Name:        name,
+		ContentType: art.ContentType,
+		Size:        int64(len(contents)),
+		Contents:    contents,
+	}, nil
+}
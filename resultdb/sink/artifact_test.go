@@ -0,0 +1,204 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/isolated"
+	"go.chromium.org/luci/common/isolatedclient"
+	"go.chromium.org/luci/common/isolatedclient/isolatedfake"
+	"go.chromium.org/luci/common/retry/transient"
+
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	sinkpb "go.chromium.org/luci/resultdb/proto/sink/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestArtifacts(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Artifacts`, t, func() {
+		ctx := context.Background()
+
+		tmpDir, err := ioutil.TempDir("", "resultsink")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+
+		bigContents := bytes.Repeat([]byte("a"), maxInlineArtifactSize+1)
+		bigFile := filepath.Join(tmpDir, "big.txt")
+		So(ioutil.WriteFile(bigFile, bigContents, 0600), ShouldBeNil)
+
+		arts := map[string]*sinkpb.Artifact{
+			"small": {
+				Body:        &sinkpb.Artifact_Contents{Contents: []byte("hello")},
+				ContentType: "plain/text",
+			},
+			"big": {
+				Body: &sinkpb.Artifact_FilePath{FilePath: bigFile},
+			},
+		}
+
+		Convey(`Upload`, func() {
+			isoServer := isolatedfake.New()
+			ts := httptest.NewServer(isoServer)
+			defer ts.Close()
+
+			const ns = isolatedclient.DefaultNamespace
+			cfg := testServerConfig(&fakeRecorder{})
+			cfg.ArtifactUploader = &ArtifactUploader{
+				Client:    isolatedclient.New(nil, nil, ts.URL, ns, nil, nil),
+				Host:      "isolate.example.com",
+				Namespace: ns,
+			}
+			s, err := NewServer(ctx, cfg)
+			So(err, ShouldBeNil)
+
+			h := isolated.GetHash(ns)
+			smallDigest := isolated.HashBytes(h, []byte("hello"))
+			bigDigest := isolated.HashBytes(h, bigContents)
+
+			Convey(`uploads missing contents`, func() {
+				actual, err := s.convertArtifacts(ctx, arts)
+				So(err, ShouldBeNil)
+				So(actual, ShouldResembleProto, []*pb.Artifact{
+					{
+						Name:     "big",
+						FetchUrl: "isolate://isolate.example.com/default-gzip/" + string(bigDigest),
+						Size:     int64(len(bigContents)),
+					},
+					{
+						Name:        "small",
+						FetchUrl:    "isolate://isolate.example.com/default-gzip/" + string(smallDigest),
+						ContentType: "plain/text",
+						Size:        5,
+					},
+				})
+
+				So(isoServer.Error(), ShouldBeNil)
+				contents := isoServer.Contents()[ns]
+				So(contents[smallDigest], ShouldResemble, []byte("hello"))
+				So(contents[bigDigest], ShouldResemble, bigContents)
+			})
+
+			Convey(`skips present contents`, func() {
+				isoServer.Inject(ns, []byte("hello"))
+				actual, err := s.convertArtifacts(ctx, map[string]*sinkpb.Artifact{"small": arts["small"]})
+				So(err, ShouldBeNil)
+				So(actual, ShouldHaveLength, 1)
+				So(actual[0].FetchUrl, ShouldEndWith, string(smallDigest))
+				So(isoServer.Error(), ShouldBeNil)
+			})
+
+			Convey(`missing file`, func() {
+				_, err := s.convertArtifacts(ctx, map[string]*sinkpb.Artifact{
+					"missing": {Body: &sinkpb.Artifact_FilePath{FilePath: filepath.Join(tmpDir, "missing")}},
+				})
+				So(err, ShouldErrLike, "failed to hash")
+			})
+		})
+
+		Convey(`Upload failure`, func() {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			}))
+			defer ts.Close()
+
+			// Don't wait between the client's retries.
+			ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) { tc.Add(d) })
+
+			rec := &fakeRecorder{}
+			cfg := testServerConfig(rec)
+			cfg.ArtifactUploader = &ArtifactUploader{
+				Client:    isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil),
+				Host:      "isolate.example.com",
+				Namespace: isolatedclient.DefaultNamespace,
+			}
+			s, err := NewServer(ctx, cfg)
+			So(err, ShouldBeNil)
+
+			Convey(`is transient`, func() {
+				_, err := s.convertArtifacts(ctx, map[string]*sinkpb.Artifact{"small": arts["small"]})
+				So(err, ShouldErrLike, `failed to check presence of artifact "small"`)
+				So(transient.Tag.In(err), ShouldBeTrue)
+			})
+
+			Convey(`fails the message instead of dropping the test result`, func() {
+				err := s.processMessage(ctx, &sinkpb.SinkMessageContainer{
+					Msg: &sinkpb.SinkMessageContainer_TestResult{TestResult: &sinkpb.TestResult{
+						TestPath:        "a/b",
+						ResultId:        "1",
+						OutputArtifacts: map[string]*sinkpb.Artifact{"small": arts["small"]},
+					}},
+				})
+				So(err, ShouldErrLike, `test result "1" of "a/b"`)
+				So(rec.results, ShouldBeEmpty)
+			})
+		})
+
+		Convey(`Inline`, func() {
+			s, err := NewServer(ctx, testServerConfig(&fakeRecorder{}))
+			So(err, ShouldBeNil)
+
+			Convey(`small artifacts`, func() {
+				actual, err := s.convertArtifacts(ctx, map[string]*sinkpb.Artifact{"small": arts["small"]})
+				So(err, ShouldBeNil)
+				So(actual, ShouldResembleProto, []*pb.Artifact{{
+					Name:        "small",
+					ContentType: "plain/text",
+					Size:        5,
+					Contents:    []byte("hello"),
+				}})
+			})
+
+			Convey(`big artifacts`, func() {
+				_, err := s.convertArtifacts(ctx, arts)
+				So(err, ShouldErrLike, `artifact "big" is larger than 8192 bytes`)
+			})
+		})
+
+		Convey(`Test result`, func() {
+			cfg := testServerConfig(&fakeRecorder{})
+			cfg.TestPathPrefix = "prefix/"
+			s, err := NewServer(ctx, cfg)
+			So(err, ShouldBeNil)
+
+			tr, err := s.convertTestResult(ctx, &sinkpb.TestResult{
+				TestPath:        "a/b",
+				ResultId:        "1",
+				Status:          sinkpb.TestStatus_FAIL,
+				OutputArtifacts: map[string]*sinkpb.Artifact{"small": arts["small"]},
+			})
+			So(err, ShouldBeNil)
+			So(tr.TestPath, ShouldEqual, "prefix/a/b")
+			So(tr.Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(tr.OutputArtifacts, ShouldHaveLength, 1)
+			So(tr.InputArtifacts, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/resultdb/cmd/recorder/chromium/formats"
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	sinkpb "go.chromium.org/luci/resultdb/proto/sink/v1"
+)
+
+// maxBatchSize is the maximum number of test results sent to the Recorder in
+// one request.
+const maxBatchSize = 500
+
+// resultFileFormats maps formats of test result files to the formats
+// understood by the formats package. LUCI format is handled by the sink itself.
+var resultFileFormats = map[sinkpb.TestResultFile_Format]string{
+	sinkpb.TestResultFile_CHROMIUM_JSON_TEST_RESULTS: formats.FormatJTR,
+	sinkpb.TestResultFile_GOOGLE_TEST:                formats.FormatGTest,
+	sinkpb.TestResultFile_JUNIT_XML:                  formats.FormatJUnit,
+	sinkpb.TestResultFile_GO_TEST_JSON:               formats.FormatGoTest,
+	sinkpb.TestResultFile_TAP:                        formats.FormatTAP,
+}
+
+// processTestResultFile sends test results from the file to the Recorder.
+//
+// A file that can't be read or parsed is rejected as a whole. In LUCI format,
+// invalid test results are rejected individually. Failures to upload artifacts
+// are returned.
+func (s *Server) processTestResultFile(ctx context.Context, f *sinkpb.TestResultFile) error {
+	trs, err := s.readTestResultFile(ctx, f)
+	switch {
+	case transient.Tag.In(err):
+		return errors.Annotate(err, "test result file %q", f.Path).Err()
+	case err != nil:
+		logging.Errorf(ctx, "Rejecting test result file %q: %s", f.Path, err)
+		return nil
+	}
+
+	for len(trs) > 0 {
+		batch := trs
+		if len(batch) > maxBatchSize {
+			batch = batch[:maxBatchSize]
+		}
+		if err := s.reportTestResults(ctx, batch); err != nil {
+			return errors.Annotate(err, "test result file %q", f.Path).Err()
+		}
+		trs = trs[len(batch):]
+	}
+	return nil
+}
+
+// readTestResultFile reads and converts test results from the file.
+func (s *Server) readTestResultFile(ctx context.Context, f *sinkpb.TestResultFile) ([]*pb.TestResult, error) {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if f.Format == sinkpb.TestResultFile_LUCI {
+		return s.readLUCITestResults(ctx, file)
+	}
+
+	format, ok := resultFileFormats[f.Format]
+	if !ok {
+		return nil, errors.Reason("unsupported format %s", f.Format).Err()
+	}
+	return formats.ConvertResults(ctx, format, file, s.cfg.TestPathPrefix, &pb.Invocation{})
+}
+
+// readLUCITestResults reads a sequence of sinkpb.TestResult JSON objects.
+func (s *Server) readLUCITestResults(ctx context.Context, r io.Reader) ([]*pb.TestResult, error) {
+	var ret []*pb.TestResult
+	dc := json.NewDecoder(r)
+	for i := 1; ; i++ {
+		in := &sinkpb.TestResult{}
+		switch err := jsonpb.UnmarshalNext(dc, in); {
+		case err == io.EOF:
+			return ret, nil
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to parse test result #%d", i).Err()
+		}
+
+		tr, err := s.convertTestResult(ctx, in)
+		switch {
+		case transient.Tag.In(err):
+			return nil, errors.Annotate(err, "test result %q of %q", in.ResultId, in.TestPath).Err()
+		case err != nil:
+			logging.Errorf(ctx, "Rejecting test result %q of %q: %s", in.ResultId, in.TestPath, err)
+			continue
+		}
+		ret = append(ret, tr)
+	}
+}
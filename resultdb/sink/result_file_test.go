@@ -0,0 +1,96 @@
+// Copyright 2019 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+	sinkpb "go.chromium.org/luci/resultdb/proto/sink/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResultFiles(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Result files`, t, func() {
+		ctx := context.Background()
+
+		tmpDir, err := ioutil.TempDir("", "resultsink")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+
+		rec := &fakeRecorder{}
+		cfg := testServerConfig(rec)
+		cfg.TestPathPrefix = "prefix/"
+		s, err := NewServer(ctx, cfg)
+		So(err, ShouldBeNil)
+
+		process := func(format sinkpb.TestResultFile_Format, contents string) error {
+			path := filepath.Join(tmpDir, "results")
+			So(ioutil.WriteFile(path, []byte(contents), 0600), ShouldBeNil)
+			return s.Process(ctx, &sinkpb.SinkMessageContainer{
+				Msg: &sinkpb.SinkMessageContainer_TestResultFile{
+					TestResultFile: &sinkpb.TestResultFile{Path: path, Format: format},
+				},
+			})
+		}
+
+		Convey(`LUCI`, func() {
+			big := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("a", maxInlineArtifactSize+1)))
+			err := process(sinkpb.TestResultFile_LUCI, `
+				{"testPath": "a", "resultId": "1", "status": "PASS"}
+				{"testPath": "big", "resultId": "1", "outputArtifacts": {"big": {"contents": "`+big+`"}}}
+				{"testPath": "b", "resultId": "1", "status": "FAIL"}
+			`)
+			So(err, ShouldBeNil)
+			So(rec.updateTokens, ShouldResemble, []string{"token"})
+			So(rec.results, ShouldHaveLength, 2)
+			So(rec.results[0].TestPath, ShouldEqual, "prefix/a")
+			So(rec.results[1].TestPath, ShouldEqual, "prefix/b")
+			So(rec.results[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+		})
+
+		Convey(`TAP`, func() {
+			err := process(sinkpb.TestResultFile_TAP, "TAP version 13\n1..2\nok 1 - first\nnot ok 2 - second\n")
+			So(err, ShouldBeNil)
+			So(rec.results, ShouldHaveLength, 2)
+			So(rec.results[0].TestPath, ShouldEqual, "prefix/first")
+			So(rec.results[1].TestPath, ShouldEqual, "prefix/second")
+		})
+
+		Convey(`Malformed file is rejected`, func() {
+			So(process(sinkpb.TestResultFile_LUCI, `{"testPath": "a"} garbage`), ShouldBeNil)
+			So(rec.results, ShouldBeEmpty)
+		})
+
+		Convey(`Missing file is rejected`, func() {
+			err := s.Process(ctx, &sinkpb.SinkMessageContainer{
+				Msg: &sinkpb.SinkMessageContainer_TestResultFile{
+					TestResultFile: &sinkpb.TestResultFile{Path: filepath.Join(tmpDir, "missing")},
+				},
+			})
+			So(err, ShouldBeNil)
+			So(rec.results, ShouldBeEmpty)
+		})
+	})
+}
@@ -27,10 +27,14 @@ import (
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"go.chromium.org/luci/common/data/rand/cryptorand"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/lucictx"
 
 	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
@@ -40,12 +44,17 @@ import (
 const (
 	// DefaultPort is the TCP port that the Server listens on by default.
 	DefaultPort = 62115
+
+	// updateTokenMetadataKey is the metadata.MD key for the token that allows
+	// writes to the invocation, see ServerConfig.UpdateToken.
+	updateTokenMetadataKey = "update-token"
 )
 
 // ServerConfig defines the parameters of the server.
 type ServerConfig struct {
 	// Recorder is the gRPC client to the Recorder service exposed by ResultDB.
-	Recorder *pb.RecorderClient
+	// Required.
+	Recorder pb.RecorderClient
 
 	// AuthToken is a secret token to expect from clients. If it is "" then it
 	// will be randomly generated in a secure way.
@@ -54,13 +63,17 @@ type ServerConfig struct {
 	Port int
 
 	// Invocation is the name of the invocation that test results should append
-	// to.
+	// to. Required.
 	Invocation string
 	// UpdateToken is the token that allows writes to Invocation.
 	UpdateToken string
 
 	// TestPathPrefix will be prepended to the test_path of each TestResult.
 	TestPathPrefix string
+
+	// ArtifactUploader uploads contents of artifacts attached to test results.
+	// If nil, artifacts are stored inline, which limits their size.
+	ArtifactUploader *ArtifactUploader
 }
 
 // Server contains state relevant to the server itself.
@@ -76,6 +89,13 @@ type Server struct {
 
 // NewServer creates a Server value and populates optional values with defaults.
 func NewServer(ctx context.Context, cfg ServerConfig) (*Server, error) {
+	switch {
+	case cfg.Recorder == nil:
+		return nil, errors.Reason("Recorder is required").Err()
+	case cfg.Invocation == "":
+		return nil, errors.Reason("Invocation is required").Err()
+	}
+
 	if cfg.AuthToken == "" {
 		buf := make([]byte, 32)
 		if _, err := cryptorand.Read(ctx, buf); err != nil {
@@ -202,8 +222,8 @@ func (s *Server) Close() error {
 }
 
 // Process handles a message as if it had been sent over the TCP interface.
-func (s *Server) Process(msg *sinkpb.SinkMessageContainer) error {
-	return errors.New("not implemented yet")
+func (s *Server) Process(ctx context.Context, msg *sinkpb.SinkMessageContainer) error {
+	return s.processMessage(ctx, msg)
 }
 
 // Export exports lucictx.ResultDB derived from the server configuration into
@@ -229,22 +249,101 @@ func (s *Server) handleConnection(ctx context.Context, c net.Conn) error {
 	}
 	logging.Debugf(ctx, "Successful handshake")
 
-	if err := processMessages(dc); err != nil && err != io.EOF {
+	if err := s.processMessages(ctx, dc); err != nil && err != io.EOF {
 		return err
 	}
 
 	return nil
 }
 
-func processMessages(dc *json.Decoder) error {
+func (s *Server) processMessages(ctx context.Context, dc *json.Decoder) error {
 	for {
 		msgp := &sinkpb.SinkMessageContainer{}
 		if err := readMessage(dc, msgp); err != nil {
 			return errors.Annotate(err, "failed to read message").Err()
 		}
 
-		// TODO(sajjadm): msgp is valid, do something with it
+		if err := s.processMessage(ctx, msgp); err != nil {
+			return errors.Annotate(err, "failed to process message").Err()
+		}
+	}
+}
+
+// processMessage sends test results in the message to the Recorder.
+//
+// Invalid test results are rejected without failing the whole message stream,
+// the returned error means that the server can't process any more messages.
+// Failures to upload artifacts are not a sign of an invalid test result, so
+// they are returned too.
+func (s *Server) processMessage(ctx context.Context, msg *sinkpb.SinkMessageContainer) error {
+	switch m := msg.Msg.(type) {
+	case *sinkpb.SinkMessageContainer_TestResult:
+		tr, err := s.convertTestResult(ctx, m.TestResult)
+		switch {
+		case transient.Tag.In(err):
+			return errors.Annotate(err, "test result %q of %q", m.TestResult.ResultId, m.TestResult.TestPath).Err()
+		case err != nil:
+			logging.Errorf(ctx, "Rejecting test result %q of %q: %s", m.TestResult.ResultId, m.TestResult.TestPath, err)
+			return nil
+		}
+		return s.reportTestResults(ctx, []*pb.TestResult{tr})
+
+	case *sinkpb.SinkMessageContainer_TestResultFile:
+		return s.processTestResultFile(ctx, m.TestResultFile)
+
+	default:
+		logging.Warningf(ctx, "Ignoring unsupported message %T", msg.Msg)
+		return nil
+	}
+}
+
+// reportTestResults sends the test results to the invocation.
+//
+// If the Recorder rejects them as invalid, logs the error and returns nil.
+func (s *Server) reportTestResults(ctx context.Context, trs []*pb.TestResult) error {
+	req := &pb.BatchCreateTestResultsRequest{
+		Invocation: s.cfg.Invocation,
+		Requests:   make([]*pb.CreateTestResultRequest, len(trs)),
+	}
+	for i, tr := range trs {
+		req.Requests[i] = &pb.CreateTestResultRequest{TestResult: tr}
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, updateTokenMetadataKey, s.cfg.UpdateToken)
+	switch _, err := s.cfg.Recorder.BatchCreateTestResults(ctx, req); {
+	case status.Code(err) == codes.InvalidArgument:
+		logging.Errorf(ctx, "Recorder rejected %d test results: %s", len(trs), err)
+		return nil
+	case err != nil:
+		return errors.Annotate(err, "failed to send %d test results", len(trs)).Err()
+	default:
+		return nil
+	}
+}
+
+// convertTestResult converts a test result received from a test harness to
+// a pb.TestResult, uploading its artifacts.
+func (s *Server) convertTestResult(ctx context.Context, tr *sinkpb.TestResult) (*pb.TestResult, error) {
+	ret := &pb.TestResult{
+		TestPath:        s.cfg.TestPathPrefix + tr.TestPath,
+		ResultId:        tr.ResultId,
+		Variant:         tr.ExtraVariantPairs,
+		Expected:        tr.Expected,
+		Status:          pb.TestStatus(tr.Status),
+		SummaryMarkdown: tr.SummaryMarkdown,
+		StartTime:       tr.StartTime,
+		Duration:        tr.RunDuration,
+		Tags:            tr.Tags,
+	}
+
+	var err error
+	if ret.InputArtifacts, err = s.convertArtifacts(ctx, tr.InputArtifacts); err != nil {
+		return nil, errors.Annotate(err, "input artifacts").Err()
+	}
+	if ret.OutputArtifacts, err = s.convertArtifacts(ctx, tr.OutputArtifacts); err != nil {
+		return nil, errors.Annotate(err, "output artifacts").Err()
 	}
+	return ret, nil
 }
 
 func readMessage(dc *json.Decoder, dest proto.Message) error {
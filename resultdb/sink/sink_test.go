@@ -16,6 +16,7 @@ package sink
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,13 +25,52 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/lucictx"
 
+	pb "go.chromium.org/luci/resultdb/proto/rpc/v1"
+
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
 )
 
+// fakeRecorder is a pb.RecorderClient that remembers created test results.
+type fakeRecorder struct {
+	pb.RecorderClient // nil, other RPCs are not expected
+
+	err          error
+	updateTokens []string
+	results      []*pb.TestResult
+}
+
+func (r *fakeRecorder) BatchCreateTestResults(ctx context.Context, in *pb.BatchCreateTestResultsRequest, opts ...grpc.CallOption) (*pb.BatchCreateTestResultsResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	r.updateTokens = append(r.updateTokens, md.Get(updateTokenMetadataKey)...)
+	if r.err != nil {
+		return nil, r.err
+	}
+	res := &pb.BatchCreateTestResultsResponse{}
+	for _, req := range in.Requests {
+		r.results = append(r.results, req.TestResult)
+		res.TestResults = append(res.TestResults, req.TestResult)
+	}
+	return res, nil
+}
+
+// testServerConfig returns a valid ServerConfig that sends test results to rec.
+func testServerConfig(rec *fakeRecorder) ServerConfig {
+	return ServerConfig{
+		Recorder:    rec,
+		Invocation:  "invocations/inv",
+		UpdateToken: "token",
+	}
+}
+
 func handshakeCheck(msg, token string) error {
 	dc := json.NewDecoder(strings.NewReader(msg))
 	return processHandshake(dc, token)
@@ -52,13 +92,21 @@ func Test(t *testing.T) {
 		}()
 
 		Convey("Default server config", func() {
-			s, err := NewServer(ctx, ServerConfig{})
+			s, err := NewServer(ctx, testServerConfig(&fakeRecorder{}))
 			So(err, ShouldBeNil)
 
 			cfg := s.Config()
 			So(cfg.AuthToken, ShouldNotBeEmpty)
 		})
 
+		Convey("Required server config", func() {
+			_, err := NewServer(ctx, ServerConfig{Invocation: "invocations/inv"})
+			So(err, ShouldErrLike, "Recorder is required")
+
+			_, err = NewServer(ctx, ServerConfig{Recorder: &fakeRecorder{}})
+			So(err, ShouldErrLike, "Invocation is required")
+		})
+
 		Convey("Handshake processing", func() {
 			authToken := "hello"
 			Convey("Successful handshake", func() {
@@ -74,7 +122,9 @@ func Test(t *testing.T) {
 		})
 
 		Convey("Tests with a real server", func() {
-			server, err := NewServer(ctx, ServerConfig{AuthToken: "hello"})
+			cfg := testServerConfig(&fakeRecorder{})
+			cfg.AuthToken = "hello"
+			server, err := NewServer(ctx, cfg)
 			So(err, ShouldBeNil)
 
 			Convey("Tests with Start", func() {
@@ -156,18 +206,51 @@ func Test(t *testing.T) {
 
 		Convey("Message-processing check", func() {
 			badInput := `blah`
+			rec := &fakeRecorder{}
+			s, err := NewServer(ctx, testServerConfig(rec))
+			So(err, ShouldBeNil)
 			goodInput := `{"testResult":{"testPath":"foo/bar/baz","resultId":"result000001","expected":true,"status":"PASS","summaryMarkdown":"hello","startTime":"2019-11-12T00:02:54.855213790Z","tags":[{"key":"foo","value":"bar"}]}}{"testResult":{"testPath":"sdhg/jgdsh/yeuwt","resultId":"result000002","status":"FAIL","summaryMarkdown":"iuuujn","startTime":"2019-11-12T00:02:54.855214521Z","tags":[{"key":"dskhnfjsd","value":"bar"}]}}`
 			Convey("Garbage data", func() {
 				dc := json.NewDecoder(strings.NewReader(badInput))
-				err := processMessages(dc)
+				err := s.processMessages(ctx, dc)
 				So(err, ShouldErrLike, "invalid")
 			})
 
 			Convey("Two populated messages", func() {
 				dc := json.NewDecoder(strings.NewReader(goodInput))
-				err := processMessages(dc)
+				err := s.processMessages(ctx, dc)
+				So(err, ShouldErrLike, io.EOF)
+
+				So(rec.updateTokens, ShouldResemble, []string{"token", "token"})
+				So(rec.results, ShouldHaveLength, 2)
+				So(rec.results[0].TestPath, ShouldEqual, "foo/bar/baz")
+				So(rec.results[1].TestPath, ShouldEqual, "sdhg/jgdsh/yeuwt")
+			})
+
+			Convey("Invalid test result is rejected alone", func() {
+				big := strings.Repeat("a", maxInlineArtifactSize+1)
+				input := `{"testResult":{"testPath":"big","resultId":"1","outputArtifacts":{"big":{"contents":"` +
+					base64.StdEncoding.EncodeToString([]byte(big)) + `"}}}}` + goodInput
+				dc := json.NewDecoder(strings.NewReader(input))
+				err := s.processMessages(ctx, dc)
+				So(err, ShouldErrLike, io.EOF)
+				So(rec.results, ShouldHaveLength, 2)
+				So(rec.results[0].TestPath, ShouldEqual, "foo/bar/baz")
+			})
+
+			Convey("Test results rejected by the Recorder", func() {
+				rec.err = status.Errorf(codes.InvalidArgument, "bad test result")
+				dc := json.NewDecoder(strings.NewReader(goodInput))
+				err := s.processMessages(ctx, dc)
 				So(err, ShouldErrLike, io.EOF)
 			})
+
+			Convey("Recorder failure", func() {
+				rec.err = status.Errorf(codes.Unavailable, "try later")
+				dc := json.NewDecoder(strings.NewReader(goodInput))
+				err := s.processMessages(ctx, dc)
+				So(err, ShouldErrLike, "failed to send 1 test results")
+			})
 		})
 	})
 }
@@ -175,7 +258,10 @@ func Test(t *testing.T) {
 func TestExport(t *testing.T) {
 	Convey("Export check", t, func() {
 		ctx := context.Background()
-		s, err := NewServer(ctx, ServerConfig{Port: 42, AuthToken: "hello"})
+		cfg := testServerConfig(&fakeRecorder{})
+		cfg.Port = 42
+		cfg.AuthToken = "hello"
+		s, err := NewServer(ctx, cfg)
 		So(err, ShouldBeNil)
 		ctx = s.Export(ctx)
 		db := lucictx.GetResultDB(ctx)